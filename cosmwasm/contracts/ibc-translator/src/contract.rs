@@ -13,7 +13,7 @@ use crate::{
         TransferType,
     },
     msg::{ExecuteMsg, InstantiateMsg, QueryMsg, COMPLETE_TRANSFER_REPLY_ID},
-    query::query_ibc_channel,
+    query::{query_ibc_channel, query_last_transfer_sender},
     reply::handle_complete_transfer_reply,
     state::TOKEN_BRIDGE_CONTRACT,
 };
@@ -103,5 +103,6 @@ pub fn reply(
 pub fn query(deps: Deps, _env: Env, msg: QueryMsg) -> StdResult<Binary> {
     match msg {
         QueryMsg::IbcChannel { chain_id } => to_binary(&query_ibc_channel(deps, chain_id)?),
+        QueryMsg::LastTransferSender {} => to_binary(&query_last_transfer_sender(deps)?),
     }
 }
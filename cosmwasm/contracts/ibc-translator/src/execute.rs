@@ -18,14 +18,16 @@ use wormhole_bindings::{
 };
 use wormhole_sdk::{
     ibc_translator::{Action, GovernancePacket},
+    token,
     vaa::{Body, Header},
     Chain,
 };
 
 use crate::{
-    msg::COMPLETE_TRANSFER_REPLY_ID,
+    msg::{TransferSender, COMPLETE_TRANSFER_REPLY_ID},
     state::{
-        CHAIN_TO_CHANNEL_MAP, CURRENT_TRANSFER, CW_DENOMS, TOKEN_BRIDGE_CONTRACT, VAA_ARCHIVE,
+        CHAIN_TO_CHANNEL_MAP, CURRENT_TRANSFER, CW_DENOMS, LAST_TRANSFER_SENDER,
+        TOKEN_BRIDGE_CONTRACT, VAA_ARCHIVE,
     },
 };
 
@@ -64,7 +66,7 @@ pub fn complete_transfer_and_convert(
     );
 
     // craft the token bridge query message to parse the payload3 vaa
-    let token_bridge_query_msg = to_binary(&TokenBridgeQueryMsg::TransferInfo { vaa })
+    let token_bridge_query_msg = to_binary(&TokenBridgeQueryMsg::TransferInfo { vaa: vaa.clone() })
         .context("could not serialize token bridge transfer_info query msg")?;
 
     let transfer_info: TransferInfoResponse = deps
@@ -85,6 +87,26 @@ pub fn complete_transfer_and_convert(
         "vaa recipient must be this contract"
     );
 
+    // record the sender of the transfer, so that the funds can be returned to it
+    // if the ibc transfer to the destination chain fails
+    let (_, data) = serde_wormhole::from_slice::<(Header, &RawMessage)>(&vaa)
+        .context("failed to parse VAA header")?;
+    let body = serde_wormhole::from_slice::<Body<token::Message<&RawMessage>>>(data)
+        .context("failed to parse VAA body")?;
+    let sender_address = match body.payload {
+        token::Message::TransferWithPayload { sender_address, .. } => sender_address,
+        _ => bail!("vaa is not a payload3 transfer"),
+    };
+    LAST_TRANSFER_SENDER
+        .save(
+            deps.storage,
+            &TransferSender {
+                chain: body.emitter_chain.into(),
+                sender: Binary::from(sender_address.0.to_vec()),
+            },
+        )
+        .context("failed to save transfer sender to storage")?;
+
     // save interim state
     CURRENT_TRANSFER
         .save(deps.storage, &transfer_info)
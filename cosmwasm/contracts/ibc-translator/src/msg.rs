@@ -62,6 +62,12 @@ pub enum ExecuteMsg {
 pub enum QueryMsg {
     #[returns(ChannelResponse)]
     IbcChannel { chain_id: u16 },
+
+    /// The sender of the most recent payload3 transfer completed by this contract. The ibc composability
+    /// middleware reads it when the contract sends the ibc transfer, so that the funds can be returned
+    /// to the sender through the token bridge if the ibc transfer fails.
+    #[returns(TransferSender)]
+    LastTransferSender {},
 }
 
 #[cw_serde]
@@ -69,6 +75,14 @@ pub struct ChannelResponse {
     pub channel: String,
 }
 
+#[cw_serde]
+pub struct TransferSender {
+    /// The chain the transfer was sent from.
+    pub chain: u16,
+    /// The 32 byte address of the sender on that chain.
+    pub sender: Binary,
+}
+
 #[cw_serde]
 pub enum GatewayIbcTokenBridgePayload {
     GatewayTransfer {
@@ -1,9 +1,16 @@
 use cosmwasm_std::{Deps, StdResult};
 
-use crate::{msg::ChannelResponse, state::CHAIN_TO_CHANNEL_MAP};
+use crate::{
+    msg::{ChannelResponse, TransferSender},
+    state::{CHAIN_TO_CHANNEL_MAP, LAST_TRANSFER_SENDER},
+};
 
 pub fn query_ibc_channel(deps: Deps, chain_id: u16) -> StdResult<ChannelResponse> {
     let channel = CHAIN_TO_CHANNEL_MAP.load(deps.storage, chain_id)?;
 
     Ok(ChannelResponse { channel })
 }
+
+pub fn query_last_transfer_sender(deps: Deps) -> StdResult<TransferSender> {
+    LAST_TRANSFER_SENDER.load(deps.storage)
+}
@@ -1,11 +1,16 @@
 use cw_storage_plus::{Item, Map};
 use cw_token_bridge::msg::TransferInfoResponse;
 
+use crate::msg::TransferSender;
+
 pub const TOKEN_BRIDGE_CONTRACT: Item<String> = Item::new("token_bridge_contract");
 
 // Holds temp state for the wormhole message that the contract is currently processing
 pub const CURRENT_TRANSFER: Item<TransferInfoResponse> = Item::new("current_transfer");
 
+// Holds the sender of the most recent payload3 transfer, which is kept after the transfer completes
+pub const LAST_TRANSFER_SENDER: Item<TransferSender> = Item::new("last_transfer_sender");
+
 // Maps cw20 address -> bank token denom
 pub const CW_DENOMS: Map<String, String> = Map::new("cw_denoms");
 
@@ -10,8 +10,8 @@ use ibc_translator::{
         complete_transfer_and_convert, contract_addr_from_base58, convert_and_transfer,
         parse_bank_token_factory_contract, submit_update_chain_to_channel_map, TransferType,
     },
-    msg::COMPLETE_TRANSFER_REPLY_ID,
-    state::{CURRENT_TRANSFER, CW_DENOMS, TOKEN_BRIDGE_CONTRACT},
+    msg::{TransferSender, COMPLETE_TRANSFER_REPLY_ID},
+    state::{CURRENT_TRANSFER, CW_DENOMS, LAST_TRANSFER_SENDER, TOKEN_BRIDGE_CONTRACT},
 };
 use wormhole_bindings::tokenfactory::{TokenFactoryMsg, TokenMsg};
 
@@ -91,7 +91,7 @@ fn complete_transfer_and_convert_happy_path() {
         .unwrap();
 
     let info = mock_info(WORMHOLE_USER_ADDR, &[]);
-    let vaa = Binary::from_base64("AAAAAA").unwrap();
+    let vaa = Binary::from_base64("AQAAAAAAAAAAAQAAAAEAAgAAAAAAAAAAAAAAAD7hiyIUr/lwANl0z2R+fDR+j6WFAAAAAAAAAAEBAwAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAD0JAAAAAAAAAAAAAAAAAnDySg9PkSFRpfNItP6okDPsDKIkABSOq5ihAQU1p68JgI9ETL1nu8xbIIiLaRkTaqoMupWNJACAAAAAAAAAAAAAAAAAe+eFcO78FVYYLUAm1FyICcTTVOnsiYmFzaWNfcmVjaXBpZW50Ijp7InJlY2lwaWVudCI6ImMyVnBNV1Y2Y3pWdFpHMTNPSGQ2ZG1Oek9YWjRPWGszWkd0MGNXZGxNM2w2YmpSM01HdzVialEwIn19").unwrap();
 
     let response = complete_transfer_and_convert(deps.as_mut(), env, info, vaa).unwrap();
 
@@ -105,7 +105,7 @@ fn complete_transfer_and_convert_happy_path() {
         response.messages[0].msg,
         CosmosMsg::Wasm(WasmMsg::Execute {
             contract_addr: token_bridge_addr,
-            msg: Binary::from_base64("eyJjb21wbGV0ZV90cmFuc2Zlcl93aXRoX3BheWxvYWQiOnsiZGF0YSI6IkFBQUFBQT09IiwicmVsYXllciI6Indvcm1ob2xlMXZoa20ycXY3ODRydWx4OHlscnUwenB2eXZ3M20zY3k5OWU2d3kwIn19").unwrap(),
+            msg: Binary::from_base64("eyJjb21wbGV0ZV90cmFuc2Zlcl93aXRoX3BheWxvYWQiOnsiZGF0YSI6IkFRQUFBQUFBQUFBQUFRQUFBQUVBQWdBQUFBQUFBQUFBQUFBQUFEN2hpeUlVci9sd0FObDB6MlIrZkRSK2o2V0ZBQUFBQUFBQUFBRUJBd0FBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBRDBKQUFBQUFBQUFBQUFBQUFBQUFuRHlTZzlQa1NGUnBmTkl0UDZva0RQc0RLSWtBQlNPcTVpaEFRVTFwNjhKZ0k5RVRMMW51OHhiSUlpTGFSa1RhcW9NdXBXTkpBQ0FBQUFBQUFBQUFBQUFBQUFBZStlRmNPNzhGVllZTFVBbTFGeUlDY1RUVk9uc2lZbUZ6YVdOZmNtVmphWEJwWlc1MElqcDdJbkpsWTJsd2FXVnVkQ0k2SW1NeVZuQk5WMVkyWTNwV2RGcEhNVE5QU0dRMlpHMU9lazlZV2pSUFdHc3pXa2QwTUdOWFpHeE5NMncyWW1wU00wMUhkelZpYWxFd0luMTkiLCJyZWxheWVyIjoid29ybWhvbGUxdmhrbTJxdjc4NHJ1bHg4eWxydTB6cHZ5dnczbTNjeTk5ZTZ3eTAifX0=").unwrap(),
             funds: vec![]
         })
     );
@@ -126,6 +126,16 @@ fn complete_transfer_and_convert_happy_path() {
     // finally, validate that the state was saved into storage
     let saved_transfer = CURRENT_TRANSFER.load(deps.as_mut().storage).unwrap();
     assert_eq!(saved_transfer, transfer_info_response);
+
+    // and that the sender of the transfer was recorded
+    let saved_sender = LAST_TRANSFER_SENDER.load(deps.as_mut().storage).unwrap();
+    assert_eq!(
+        saved_sender,
+        TransferSender {
+            chain: 2,
+            sender: Binary::from_base64("AAAAAAAAAAAAAAAAHvnhXDu/BVWGC1AJtRciAnE01To=").unwrap(),
+        }
+    );
 }
 
 // 2. Failure: no token bridge address in state
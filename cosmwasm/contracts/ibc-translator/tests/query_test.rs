@@ -1,11 +1,18 @@
-use ibc_translator::{msg::ChannelResponse, query::query_ibc_channel, state::CHAIN_TO_CHANNEL_MAP};
+use ibc_translator::{
+    msg::{ChannelResponse, TransferSender},
+    query::{query_ibc_channel, query_last_transfer_sender},
+    state::{CHAIN_TO_CHANNEL_MAP, LAST_TRANSFER_SENDER},
+};
 
-use cosmwasm_std::testing::mock_dependencies;
+use cosmwasm_std::{testing::mock_dependencies, Binary};
 
 // Tests
 // 1. query_ibc_channel
 //    1. happy path
 //    2. No chain id to channel mapping
+// 2. query_last_transfer_sender
+//    1. happy path
+//    2. No transfer completed yet
 
 // 1. happy path
 #[test]
@@ -30,3 +37,32 @@ fn query_ibc_channel_no_chain_id() {
     let err = query_ibc_channel(deps.as_ref(), 0).unwrap_err();
     assert_eq!(err.to_string(), "alloc::string::String not found");
 }
+
+// TESTS: query_last_transfer_sender
+// 1. happy path
+#[test]
+fn query_last_transfer_sender_happy_path() {
+    let mut deps = mock_dependencies();
+
+    let sender = TransferSender {
+        chain: 2,
+        sender: Binary::from([1u8; 32].to_vec()),
+    };
+    LAST_TRANSFER_SENDER
+        .save(deps.as_mut().storage, &sender)
+        .unwrap();
+
+    let response = query_last_transfer_sender(deps.as_ref()).unwrap();
+    assert_eq!(sender, response);
+}
+// 2. No transfer completed yet
+#[test]
+fn query_last_transfer_sender_no_transfer() {
+    let deps = mock_dependencies();
+
+    let err = query_last_transfer_sender(deps.as_ref()).unwrap_err();
+    assert_eq!(
+        err.to_string(),
+        "ibc_translator::msg::TransferSender not found"
+    );
+}
@@ -39,6 +39,7 @@ type httpServer struct {
 	signerKey        *ecdsa.PrivateKey
 	pendingResponses *PendingResponses
 	loggingMap       *LoggingMap
+	quotas           *QuotaTracker
 }
 
 func (s *httpServer) handleQuery(w http.ResponseWriter, r *http.Request) {
@@ -95,6 +96,13 @@ func (s *httpServer) handleQuery(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if !s.quotas.Allow(permEntry.userName, permEntry.dailyQuota, time.Now()) {
+		s.logger.Debug("denying request due to daily quota", zap.String("userId", permEntry.userName))
+		http.Error(w, "daily quota exceeded", http.StatusTooManyRequests)
+		quotaExceededByUser.WithLabelValues(permEntry.userName).Inc()
+		return
+	}
+
 	totalRequestsByUser.WithLabelValues(permEntry.userName).Inc()
 
 	queryRequestBytes, err := hex.DecodeString(q.Bytes)
@@ -240,6 +248,7 @@ func NewHTTPServer(addr string, t *pubsub.Topic, permissions *Permissions, signe
 		logger:           logger,
 		env:              env,
 		loggingMap:       loggingMap,
+		quotas:           NewQuotaTracker(),
 	}
 	r := mux.NewRouter()
 	r.HandleFunc("/v1/query", s.handleQuery).Methods("PUT", "POST", "OPTIONS")
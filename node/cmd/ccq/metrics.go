@@ -51,6 +51,12 @@ var (
 			Help: "Total number of queries rejected due to rate limiting per user name",
 		}, []string{"user_name"})
 
+	quotaExceededByUser = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ccq_server_quota_exceeded_by_user",
+			Help: "Total number of queries rejected due to the daily quota per user name",
+		}, []string{"user_name"})
+
 	failedQueriesByUser = promauto.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "ccq_server_failed_queries_by_user",
@@ -164,7 +164,7 @@ func TestParseConfigUnsupportedCallType(t *testing.T) {
 
 	_, err := parseConfig([]byte(str), common.MainNet)
 	require.Error(t, err)
-	assert.Equal(t, `unsupported call type for user "Test User", must be "ethCall", "ethCallByTimestamp", "ethCallWithFinality", "solAccount" or "solPDA"`, err.Error())
+	assert.Equal(t, `unsupported call type for user "Test User", must be "ethCall", "ethCallByTimestamp", "ethCallWithFinality", "ethGetStorageAt", "solAccount" or "solPDA"`, err.Error())
 }
 
 func TestParseConfigInvalidContractAddress(t *testing.T) {
@@ -310,7 +310,14 @@ func TestParseConfigSuccess(t *testing.T) {
             "contractAddress": "0xDDb64fE46a91D46ee29420539FC25FD07c5FEa3E",
             "call": "0x313ce567"
           }
-        },        
+        },
+        {
+          "ethGetStorageAt": {
+            "note:": "Storage of WETH on Devnet",
+            "chain": 2,
+            "contractAddress": "0xDDb64fE46a91D46ee29420539FC25FD07c5FEa3E"
+          }
+        },
         {
           "solAccount": {
             "note:": "Example NFT on Devnet",
@@ -337,7 +344,7 @@ func TestParseConfigSuccess(t *testing.T) {
 	perm, exists := perms["my_secret_key"]
 	require.True(t, exists)
 
-	assert.Equal(t, 5, len(perm.allowedCalls))
+	assert.Equal(t, 6, len(perm.allowedCalls))
 
 	_, exists = perm.allowedCalls["ethCall:2:000000000000000000000000b4fbf271143f4fbf7b91a5ded31805e42b2208d6:06fdde03"]
 	assert.True(t, exists)
@@ -348,6 +355,9 @@ func TestParseConfigSuccess(t *testing.T) {
 	_, exists = perm.allowedCalls["ethCallWithFinality:2:000000000000000000000000ddb64fe46a91d46ee29420539fc25fd07c5fea3e:313ce567"]
 	assert.True(t, exists)
 
+	_, exists = perm.allowedCalls["ethGetStorageAt:2:000000000000000000000000ddb64fe46a91d46ee29420539fc25fd07c5fea3e"]
+	assert.True(t, exists)
+
 	_, exists = perm.allowedCalls["solAccount:1:BVxyYhm498L79r4HMQ9sxZ5bi41DmJmeWZ7SCS7Cyvna"]
 	assert.True(t, exists)
 
@@ -960,3 +970,72 @@ func TestParseConfigWithRateLimiterButPerUserBurstSizeSetToZero(t *testing.T) {
 	_, err := parseConfig([]byte(str), common.MainNet)
 	assert.Equal(t, "if rate limiting is enabled, the burst size may not be zero", err.Error())
 }
+
+func TestParseConfigWithDailyQuota(t *testing.T) {
+	str := `
+	{
+  "defaultDailyQuota": 1000,
+  "permissions": [
+    {
+      "userName": "Test User",
+      "apiKey": "My_secret_key",
+      "dailyQuota": 50,
+      "allowedCalls": [
+        {
+          "ethCall": {
+            "note:": "Name of WETH on Goerli",
+            "chain": 2,
+            "contractAddress": "B4FBF271143F4FBf7B91A5ded31805e42b2208d6",
+            "call": "0x06fdde03"
+          }
+        }
+      ]
+    },
+    {
+      "userName": "Test User 2",
+      "apiKey": "My_secret_key_2",
+      "allowedCalls": [
+        {
+          "ethCall": {
+            "note:": "Name of WETH on Goerli",
+            "chain": 2,
+            "contractAddress": "B4FBF271143F4FBf7B91A5ded31805e42b2208d6",
+            "call": "0x06fdde03"
+          }
+        }
+      ]
+    },
+    {
+      "userName": "Test User 3",
+      "apiKey": "My_secret_key_disabling_quota",
+      "dailyQuota": 0,
+      "allowedCalls": [
+        {
+          "ethCall": {
+            "note:": "Name of WETH on Goerli",
+            "chain": 2,
+            "contractAddress": "B4FBF271143F4FBf7B91A5ded31805e42b2208d6",
+            "call": "0x06fdde03"
+          }
+        }
+      ]
+    }
+  ]
+}`
+
+	perms, err := parseConfig([]byte(str), common.MainNet)
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(perms))
+
+	perm, exists := perms["my_secret_key"]
+	require.True(t, exists)
+	assert.Equal(t, uint64(50), perm.dailyQuota)
+
+	perm, exists = perms["my_secret_key_2"]
+	require.True(t, exists)
+	assert.Equal(t, uint64(1000), perm.dailyQuota)
+
+	perm, exists = perms["my_secret_key_disabling_quota"]
+	require.True(t, exists)
+	assert.Equal(t, uint64(0), perm.dailyQuota)
+}
@@ -26,6 +26,7 @@ type (
 		AllowAnythingSupported bool    `json:"AllowAnythingSupported"`
 		DefaultRateLimit       float64 `json:"DefaultRateLimit"`
 		DefaultBurstSize       int     `json:"DefaultBurstSize"`
+		DefaultDailyQuota      uint64  `json:"DefaultDailyQuota"`
 		Permissions            []User  `json:"Permissions"`
 	}
 
@@ -36,6 +37,7 @@ type (
 		AllowAnything bool          `json:"allowAnything"`
 		RateLimit     *float64      `json:"RateLimit"`
 		BurstSize     *int          `json:"BurstSize"`
+		DailyQuota    *uint64       `json:"DailyQuota"`
 		LogResponses  bool          `json:"logResponses"`
 		AllowedCalls  []AllowedCall `json:"allowedCalls"`
 	}
@@ -44,6 +46,7 @@ type (
 		EthCall             *EthCall             `json:"ethCall"`
 		EthCallByTimestamp  *EthCallByTimestamp  `json:"ethCallByTimestamp"`
 		EthCallWithFinality *EthCallWithFinality `json:"ethCallWithFinality"`
+		EthGetStorageAt     *EthGetStorageAt     `json:"ethGetStorageAt"`
 		SolanaAccount       *SolanaAccount       `json:"solAccount"`
 		SolanaPda           *SolanaPda           `json:"solPDA"`
 	}
@@ -66,6 +69,12 @@ type (
 		Call            string `json:"call"`
 	}
 
+	EthGetStorageAt struct {
+		Chain           int    `json:"chain"`
+		ContractAddress string `json:"contractAddress"`
+		// As a future enhancement, we may want to specify the allowed slots.
+	}
+
 	SolanaAccount struct {
 		Chain   int    `json:"chain"`
 		Account string `json:"account"`
@@ -83,6 +92,7 @@ type (
 		userName      string
 		apiKey        string
 		rateLimiter   *rate.Limiter
+		dailyQuota    uint64 // Zero means unlimited.
 		allowUnsigned bool
 		allowAnything bool
 		logResponses  bool
@@ -245,6 +255,11 @@ func parseConfig(byteValue []byte, env common.Environment) (PermissionsMap, erro
 			rateLimiter = rate.NewLimiter(rate.Limit(rateLimit), burstSize)
 		}
 
+		dailyQuota := config.DefaultDailyQuota
+		if user.DailyQuota != nil {
+			dailyQuota = *user.DailyQuota
+		}
+
 		// Build the list of allowed calls for this API key.
 		allowedCalls := make(allowedCallsForUser)
 		for _, ac := range user.AllowedCalls {
@@ -266,6 +281,12 @@ func parseConfig(byteValue []byte, env common.Environment) (PermissionsMap, erro
 				chain = ac.EthCallWithFinality.Chain
 				contractAddressStr = ac.EthCallWithFinality.ContractAddress
 				callStr = ac.EthCallWithFinality.Call
+			} else if ac.EthGetStorageAt != nil {
+				contractAddr, err := vaa.StringToAddress(ac.EthGetStorageAt.ContractAddress)
+				if err != nil {
+					return nil, fmt.Errorf(`invalid contract address "%s" for user "%s"`, ac.EthGetStorageAt.ContractAddress, user.UserName)
+				}
+				callKey = fmt.Sprintf("ethGetStorageAt:%d:%s", ac.EthGetStorageAt.Chain, contractAddr.String())
 			} else if ac.SolanaAccount != nil {
 				// We assume the account is base58, but if it starts with "0x" it should be 32 bytes of hex.
 				account := ac.SolanaAccount.Account
@@ -307,7 +328,7 @@ func parseConfig(byteValue []byte, env common.Environment) (PermissionsMap, erro
 				}
 				callKey = fmt.Sprintf("solPDA:%d:%s", ac.SolanaPda.Chain, pa)
 			} else {
-				return nil, fmt.Errorf(`unsupported call type for user "%s", must be "ethCall", "ethCallByTimestamp", "ethCallWithFinality", "solAccount" or "solPDA"`, user.UserName)
+				return nil, fmt.Errorf(`unsupported call type for user "%s", must be "ethCall", "ethCallByTimestamp", "ethCallWithFinality", "ethGetStorageAt", "solAccount" or "solPDA"`, user.UserName)
 			}
 
 			if callKey == "" {
@@ -345,6 +366,7 @@ func parseConfig(byteValue []byte, env common.Environment) (PermissionsMap, erro
 			userName:      user.UserName,
 			apiKey:        apiKey,
 			rateLimiter:   rateLimiter,
+			dailyQuota:    dailyQuota,
 			allowUnsigned: user.AllowUnsigned,
 			allowAnything: user.AllowAnything,
 			logResponses:  user.LogResponses,
@@ -48,6 +48,8 @@ var (
 	monitorPeers           *bool
 	gossipAdvertiseAddress *string
 	verifyPermissions      *bool
+	tlsCertFile            *string
+	tlsKeyFile             *string
 )
 
 const DEV_NETWORK_ID = "/wormhole/dev"
@@ -70,6 +72,8 @@ func init() {
 	promRemoteURL = QueryServerCmd.Flags().String("promRemoteURL", "", "Prometheus remote write URL (Grafana)")
 	monitorPeers = QueryServerCmd.Flags().Bool("monitorPeers", false, "Should monitor bootstrap peers and attempt to reconnect")
 	gossipAdvertiseAddress = QueryServerCmd.Flags().String("gossipAdvertiseAddress", "", "External IP to advertize on P2P (use if behind a NAT or running in k8s)")
+	tlsCertFile = QueryServerCmd.Flags().String("tlsCertFile", "", "Path to TLS certificate file, if set the query server is served over TLS (requires --tlsKeyFile)")
+	tlsKeyFile = QueryServerCmd.Flags().String("tlsKeyFile", "", "Path to TLS private key file (requires --tlsCertFile)")
 	verifyPermissions = QueryServerCmd.Flags().Bool("verifyPermissions", false, `parse and verify the permissions file and then exit with 0 if success, 1 if failure`)
 
 	// The default health check monitoring is every five seconds, with a five second timeout, and you have to miss two, for 20 seconds total.
@@ -174,6 +178,9 @@ func runQueryServer(cmd *cobra.Command, args []string) {
 	if *ethContract == "" {
 		logger.Fatal("Please specify --ethContract")
 	}
+	if (*tlsCertFile == "") != (*tlsKeyFile == "") {
+		logger.Fatal("--tlsCertFile and --tlsKeyFile must be specified together")
+	}
 
 	permissions, err := NewPermissions(*permFile, env)
 	if err != nil {
@@ -212,8 +219,14 @@ func runQueryServer(cmd *cobra.Command, args []string) {
 	// Start the HTTP server
 	go func() {
 		s := NewHTTPServer(*listenAddr, p2p.topic_req, permissions, signerKey, pendingResponses, logger, env, loggingMap)
-		logger.Sugar().Infof("Server listening on %s", *listenAddr)
-		err := s.ListenAndServe()
+		var err error
+		if *tlsCertFile != "" {
+			logger.Sugar().Infof("Server listening on %s using TLS", *listenAddr)
+			err = s.ListenAndServeTLS(*tlsCertFile, *tlsKeyFile)
+		} else {
+			logger.Sugar().Infof("Server listening on %s", *listenAddr)
+			err = s.ListenAndServe()
+		}
 		if err != nil && err != http.ErrServerClosed {
 			logger.Fatal("Server closed unexpectedly", zap.Error(err))
 		}
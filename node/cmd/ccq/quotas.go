@@ -0,0 +1,51 @@
+package ccq
+
+import (
+	"sync"
+	"time"
+)
+
+// QuotaTracker is used to enforce the daily query quotas. It contains a map keyed by user name where the payload is the usage for the current day.
+// It is keyed by user name rather than being part of the permissions entry so that the usage is not reset when the permissions file is reloaded.
+type QuotaTracker struct {
+	quotaLock sync.Mutex
+	usage     map[string]*quotaUsage
+}
+
+// quotaUsage is the number of queries made by a user on a given day.
+type quotaUsage struct {
+	day   time.Time
+	count uint64
+}
+
+// NewQuotaTracker creates the map used to track query quotas.
+func NewQuotaTracker() *QuotaTracker {
+	return &QuotaTracker{
+		usage: make(map[string]*quotaUsage),
+	}
+}
+
+// Allow returns true and counts the query if the user has not yet reached their daily quota. A quota of zero means unlimited.
+// Days are based on UTC, so all quotas reset at midnight UTC.
+func (qt *QuotaTracker) Allow(userName string, dailyQuota uint64, now time.Time) bool {
+	if dailyQuota == 0 {
+		return true
+	}
+
+	day := now.UTC().Truncate(24 * time.Hour)
+
+	qt.quotaLock.Lock()
+	defer qt.quotaLock.Unlock()
+	usage, exists := qt.usage[userName]
+	if !exists || !usage.day.Equal(day) {
+		usage = &quotaUsage{day: day}
+		qt.usage[userName] = usage
+	}
+
+	if usage.count >= dailyQuota {
+		return false
+	}
+
+	usage.count++
+	return true
+}
@@ -0,0 +1,32 @@
+package ccq
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuotaTrackerEnforcesDailyQuota(t *testing.T) {
+	qt := NewQuotaTracker()
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.True(t, qt.Allow("Test User", 2, now))
+	assert.True(t, qt.Allow("Test User", 2, now.Add(time.Hour)))
+	assert.False(t, qt.Allow("Test User", 2, now.Add(2*time.Hour)))
+
+	// Other users are not affected.
+	assert.True(t, qt.Allow("Other User", 2, now))
+
+	// The quota resets at midnight UTC.
+	assert.True(t, qt.Allow("Test User", 2, time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestQuotaTrackerZeroQuotaIsUnlimited(t *testing.T) {
+	qt := NewQuotaTracker()
+	now := time.Now()
+
+	for count := 0; count < 1000; count++ {
+		assert.True(t, qt.Allow("Test User", 0, now))
+	}
+}
@@ -111,6 +111,8 @@ func validateRequest(logger *zap.Logger, env common.Environment, perms *Permissi
 			status, err = validateCallData(logger, permsForUser, "ethCallByTimestamp", pcq.ChainId, q.CallData)
 		case *query.EthCallWithFinalityQueryRequest:
 			status, err = validateCallData(logger, permsForUser, "ethCallWithFinality", pcq.ChainId, q.CallData)
+		case *query.EthGetStorageAtQueryRequest:
+			status, err = validateStorageSlots(logger, permsForUser, "ethGetStorageAt", pcq.ChainId, q.Slots)
 		case *query.SolanaAccountQueryRequest:
 			status, err = validateSolanaAccountQuery(logger, permsForUser, "solAccount", pcq.ChainId, q)
 		case *query.SolanaPdaQueryRequest:
@@ -165,6 +167,30 @@ func validateCallData(logger *zap.Logger, permsForUser *permissionEntry, callTag
 	return http.StatusOK, nil
 }
 
+// validateStorageSlots performs verification on all of the storage slots in an eth_get_storage_at query.
+func validateStorageSlots(logger *zap.Logger, permsForUser *permissionEntry, callTag string, chainId vaa.ChainID, slots []*query.EthStorageSlot) (int, error) {
+	for _, slot := range slots {
+		contractAddress, err := vaa.BytesToAddress(slot.Address)
+		if err != nil {
+			logger.Debug("failed to parse contract address", zap.String("userName", permsForUser.userName), zap.String("contract", hex.EncodeToString(slot.Address)), zap.Error(err))
+			invalidQueryRequestReceived.WithLabelValues("invalid_contract_address").Inc()
+			return http.StatusBadRequest, fmt.Errorf("failed to parse contract address: %w", err)
+		}
+		if !permsForUser.allowAnything {
+			callKey := fmt.Sprintf("%s:%d:%s", callTag, chainId, contractAddress)
+			if _, exists := permsForUser.allowedCalls[callKey]; !exists {
+				logger.Debug("requested call not authorized", zap.String("userName", permsForUser.userName), zap.String("callKey", callKey))
+				invalidQueryRequestReceived.WithLabelValues("call_not_authorized").Inc()
+				return http.StatusForbidden, fmt.Errorf(`call "%s" not authorized`, callKey)
+			}
+		}
+
+		totalRequestedCallsByChain.WithLabelValues(chainId.String()).Inc()
+	}
+
+	return http.StatusOK, nil
+}
+
 // validateSolanaAccountQuery performs verification on a Solana sol_account query.
 func validateSolanaAccountQuery(logger *zap.Logger, permsForUser *permissionEntry, callTag string, chainId vaa.ChainID, q *query.SolanaAccountQueryRequest) (int, error) {
 	if !permsForUser.allowAnything {
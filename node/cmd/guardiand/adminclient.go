@@ -17,7 +17,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/types"
 	"github.com/davecgh/go-spew/spew"
 	ethcommon "github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/mr-tron/base58"
 	"github.com/spf13/pflag"
 	"golang.org/x/crypto/sha3"
@@ -42,8 +41,15 @@ var (
 	clientSocketPath *string
 	shouldBackfill   *bool
 	unsafeDevnetMode *bool
+
+	exportVaasStartTime *string
+	exportVaasEndTime   *string
+	exportVaasChain     *string
 )
 
+// importVaasBatchSize is the number of VAAs sent to the node per ImportSignedVAAs call.
+const importVaasBatchSize = 100
+
 func init() {
 	// Shared flags for all admin commands
 	pf := pflag.NewFlagSet("commonAdminFlags", pflag.ContinueOnError)
@@ -56,6 +62,10 @@ func init() {
 	shouldBackfill = AdminClientFindMissingMessagesCmd.Flags().Bool(
 		"backfill", false, "backfill missing VAAs from public RPC")
 
+	exportVaasStartTime = ExportVaasCmd.Flags().String("startTime", "", "only export VAAs with a timestamp at or after this RFC3339 time")
+	exportVaasEndTime = ExportVaasCmd.Flags().String("endTime", "", "only export VAAs with a timestamp before this RFC3339 time")
+	exportVaasChain = ExportVaasCmd.Flags().String("chain", "", "only export VAAs of this chain ID or chain name")
+
 	AdminClientInjectGuardianSetUpdateCmd.Flags().AddFlagSet(pf)
 	AdminClientFindMissingMessagesCmd.Flags().AddFlagSet(pf)
 	AdminClientListNodes.Flags().AddFlagSet(pf)
@@ -67,7 +77,16 @@ func init() {
 	ClientChainGovernorDropPendingVAACmd.Flags().AddFlagSet(pf)
 	ClientChainGovernorReleasePendingVAACmd.Flags().AddFlagSet(pf)
 	ClientChainGovernorResetReleaseTimerCmd.Flags().AddFlagSet(pf)
+	ClientAccountantStatusCmd.Flags().AddFlagSet(pf)
+	ClientAccountantDropPendingTransferCmd.Flags().AddFlagSet(pf)
+	ClientAccountantReleasePendingTransferCmd.Flags().AddFlagSet(pf)
 	PurgePythNetVaasCmd.Flags().AddFlagSet(pf)
+	PruneVaasCmd.Flags().AddFlagSet(pf)
+	ExportVaasCmd.Flags().AddFlagSet(pf)
+	ImportVaasCmd.Flags().AddFlagSet(pf)
+	ObservationConflictsCmd.Flags().AddFlagSet(pf)
+	GuardianKeyStatusCmd.Flags().AddFlagSet(pf)
+	BackfillCmd.Flags().AddFlagSet(pf)
 	SignExistingVaaCmd.Flags().AddFlagSet(pf)
 	SignExistingVaasFromCSVCmd.Flags().AddFlagSet(pf)
 	GetAndObserveMissingVAAs.Flags().AddFlagSet(pf)
@@ -89,7 +108,16 @@ func init() {
 	AdminCmd.AddCommand(ClientChainGovernorDropPendingVAACmd)
 	AdminCmd.AddCommand(ClientChainGovernorReleasePendingVAACmd)
 	AdminCmd.AddCommand(ClientChainGovernorResetReleaseTimerCmd)
+	AdminCmd.AddCommand(ClientAccountantStatusCmd)
+	AdminCmd.AddCommand(ClientAccountantDropPendingTransferCmd)
+	AdminCmd.AddCommand(ClientAccountantReleasePendingTransferCmd)
 	AdminCmd.AddCommand(PurgePythNetVaasCmd)
+	AdminCmd.AddCommand(PruneVaasCmd)
+	AdminCmd.AddCommand(ExportVaasCmd)
+	AdminCmd.AddCommand(ImportVaasCmd)
+	AdminCmd.AddCommand(ObservationConflictsCmd)
+	AdminCmd.AddCommand(GuardianKeyStatusCmd)
+	AdminCmd.AddCommand(BackfillCmd)
 	AdminCmd.AddCommand(SignExistingVaaCmd)
 	AdminCmd.AddCommand(SignExistingVaasFromCSVCmd)
 	AdminCmd.AddCommand(Keccak256Hash)
@@ -102,10 +130,10 @@ var AdminCmd = &cobra.Command{
 }
 
 var AdminClientSignWormchainAddress = &cobra.Command{
-	Use:   "sign-wormchain-address [vaa-signer-uri] [wormchain-validator-address]",
-	Short: "Sign a wormchain validator address.  Only sign the address that you control the key for and will be for your validator.",
+	Use:   "sign-wormchain-address [vaa-signer-uri] [wormchain-validator-address] [wormchain-chain-id]",
+	Short: "Sign a wormchain validator address for the wormchain network with the given chain id.  Only sign the address that you control the key for and will be for your validator.",
 	RunE:  runSignWormchainValidatorAddress,
-	Args:  cobra.ExactArgs(2),
+	Args:  cobra.ExactArgs(3),
 }
 
 var AdminClientInjectGuardianSetUpdateCmd = &cobra.Command{
@@ -171,6 +199,27 @@ var ClientChainGovernorResetReleaseTimerCmd = &cobra.Command{
 	Args:  cobra.RangeArgs(1, 2),
 }
 
+var ClientAccountantStatusCmd = &cobra.Command{
+	Use:   "accountant-status",
+	Short: "Displays the transfers pending in the accountant, listing token bridge and NTT transfers separately",
+	Run:   runAccountantStatus,
+	Args:  cobra.ExactArgs(0),
+}
+
+var ClientAccountantDropPendingTransferCmd = &cobra.Command{
+	Use:   "accountant-drop-pending-transfer [MSG_ID]",
+	Short: "Removes the specified transfer (chain/emitter/seq) from the accountant pending list without publishing it",
+	Run:   runAccountantDropPendingTransfer,
+	Args:  cobra.ExactArgs(1),
+}
+
+var ClientAccountantReleasePendingTransferCmd = &cobra.Command{
+	Use:   "accountant-release-pending-transfer [MSG_ID]",
+	Short: "Releases the specified transfer (chain/emitter/seq) from the accountant pending list, publishing it without waiting for approval",
+	Run:   runAccountantReleasePendingTransfer,
+	Args:  cobra.ExactArgs(1),
+}
+
 var PurgePythNetVaasCmd = &cobra.Command{
 	Use:   "purge-pythnet-vaas [DAYS_OLD] <logonly>",
 	Short: "Deletes PythNet VAAs from the database that are more than [DAYS_OLD] days only (if logonly is specified, doesn't delete anything)",
@@ -178,6 +227,48 @@ var PurgePythNetVaasCmd = &cobra.Command{
 	Args:  cobra.RangeArgs(1, 2),
 }
 
+var PruneVaasCmd = &cobra.Command{
+	Use:   "prune-vaas [DAYS_OLD] <logonly>",
+	Short: "Deletes VAAs of all chains except governance VAAs from the database that are more than [DAYS_OLD] days old and compacts it (if logonly is specified, doesn't delete anything)",
+	Run:   runPruneVaas,
+	Args:  cobra.RangeArgs(1, 2),
+}
+
+var ExportVaasCmd = &cobra.Command{
+	Use:   "export-vaas [OUT_FILE]",
+	Short: "Exports the signed VAAs in the database, optionally restricted to a time range and chain, to a CSV [VAA_ID,VAA_HEX]",
+	Run:   runExportVaas,
+	Args:  cobra.ExactArgs(1),
+}
+
+var ImportVaasCmd = &cobra.Command{
+	Use:   "import-vaas [IN_FILE]",
+	Short: "Imports a CSV [VAA_ID,VAA_HEX] of signed VAAs into the database. Each VAA is verified against the guardian set it was signed with.",
+	Run:   runImportVaas,
+	Args:  cobra.ExactArgs(1),
+}
+
+var ObservationConflictsCmd = &cobra.Command{
+	Use:   "observation-conflicts",
+	Short: "Displays the most recent messages for which guardians signed different digests",
+	Run:   runObservationConflicts,
+	Args:  cobra.ExactArgs(0),
+}
+
+var GuardianKeyStatusCmd = &cobra.Command{
+	Use:   "guardian-key-status",
+	Short: "Displays the guardian key the node signs with, and during a key rotation whether it switched to the new key",
+	Run:   runGuardianKeyStatus,
+	Args:  cobra.ExactArgs(0),
+}
+
+var BackfillCmd = &cobra.Command{
+	Use:   "backfill [CHAIN_ID|CHAIN_NAME] [FROM_BLOCK] [TO_BLOCK]",
+	Short: "Scans the given block range (slot range on Solana) for messages the watcher missed and observes them",
+	Run:   runBackfill,
+	Args:  cobra.ExactArgs(3),
+}
+
 var SignExistingVaaCmd = &cobra.Command{
 	Use:   "sign-existing-vaa [VAA] [NEW_GUARDIANS] [NEW_GUARDIAN_SET_INDEX]",
 	Short: "Signs an existing VAA for a new guardian set using the local guardian key. This only works if the new VAA would have quorum.",
@@ -240,6 +331,7 @@ func runSignWormchainValidatorAddress(cmd *cobra.Command, args []string) error {
 
 	guardianSignerUri := args[0]
 	wormchainAddress := args[1]
+	wormchainChainID := args[2]
 	if !strings.HasPrefix(wormchainAddress, "wormhole") || strings.HasPrefix(wormchainAddress, "wormholeval") {
 		return errors.New("must provide a bech32 address that has 'wormhole' prefix")
 	}
@@ -254,8 +346,8 @@ func runSignWormchainValidatorAddress(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to decode wormchain address: %w", err)
 	}
 
-	// Hash and sign address
-	addrHash := crypto.Keccak256Hash(sdk.SignedWormchainAddressPrefix, addr)
+	// Hash and sign address along with the chain id, so the signature can't be used on another wormchain network
+	addrHash := sdk.SignedWormchainRegistrationDigest(wormchainChainID, addr)
 	sig, err := guardianSigner.Sign(ctx, addrHash.Bytes())
 	if err != nil {
 		return fmt.Errorf("failed to sign wormchain address: %w", err)
@@ -545,6 +637,67 @@ func runChainGovernorReleasePendingVAA(cmd *cobra.Command, args []string) {
 	fmt.Println(resp.Response)
 }
 
+func runAccountantStatus(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	msg := nodev1.AccountantStatusRequest{}
+	resp, err := c.AccountantStatus(ctx, &msg)
+	if err != nil {
+		log.Fatalf("failed to run AccountantStatus RPC: %s", err)
+	}
+
+	fmt.Println(resp.Response)
+}
+
+func runAccountantDropPendingTransfer(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	msg := nodev1.AccountantDropPendingTransferRequest{
+		MsgId: args[0],
+	}
+	resp, err := c.AccountantDropPendingTransfer(ctx, &msg)
+	if err != nil {
+		log.Fatalf("failed to run AccountantDropPendingTransfer RPC: %s", err)
+	}
+
+	fmt.Println(resp.Response)
+}
+
+func runAccountantReleasePendingTransfer(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	msg := nodev1.AccountantReleasePendingTransferRequest{
+		MsgId: args[0],
+	}
+	resp, err := c.AccountantReleasePendingTransfer(ctx, &msg)
+	if err != nil {
+		log.Fatalf("failed to run AccountantReleasePendingTransfer RPC: %s", err)
+	}
+
+	fmt.Println(resp.Response)
+}
+
 func runChainGovernorResetReleaseTimer(cmd *cobra.Command, args []string) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -619,6 +772,282 @@ func runPurgePythNetVaas(cmd *cobra.Command, args []string) {
 	fmt.Println(resp.Response)
 }
 
+func runPruneVaas(cmd *cobra.Command, args []string) {
+	daysOld, err := strconv.Atoi(args[0])
+	if err != nil {
+		log.Fatalf("invalid DAYS_OLD: %v", err)
+	}
+
+	if daysOld <= 0 {
+		log.Fatalf("DAYS_OLD must be positive")
+	}
+
+	logOnly := false
+	if len(args) > 1 {
+		if args[1] != "logonly" {
+			log.Fatalf("invalid option, only \"logonly\" is supported")
+		}
+
+		logOnly = true
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	msg := nodev1.PruneVaasRequest{
+		DaysOld: uint64(daysOld),
+		LogOnly: logOnly,
+	}
+	resp, err := c.PruneVaas(ctx, &msg)
+	if err != nil {
+		log.Fatalf("failed to run PruneVaas RPC: %s", err)
+	}
+
+	fmt.Println(resp.Response)
+}
+
+func runExportVaas(cmd *cobra.Command, args []string) {
+	msg := nodev1.ExportSignedVAAsRequest{}
+	if *exportVaasStartTime != "" {
+		t, err := time.Parse(time.RFC3339, *exportVaasStartTime)
+		if err != nil {
+			log.Fatalf("invalid start time: %v", err)
+		}
+		msg.StartTime = t.Unix()
+	}
+	if *exportVaasEndTime != "" {
+		t, err := time.Parse(time.RFC3339, *exportVaasEndTime)
+		if err != nil {
+			log.Fatalf("invalid end time: %v", err)
+		}
+		msg.EndTime = t.Unix()
+	}
+	if *exportVaasChain != "" {
+		chainID, err := parseChainID(*exportVaasChain)
+		if err != nil {
+			log.Fatalf("invalid chain: %v", err)
+		}
+		msg.EmitterChain = uint32(chainID)
+	}
+
+	outFile, err := os.OpenFile(args[0], os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		log.Fatalf("failed to create output file: %v", err)
+	}
+	defer outFile.Close()
+	writer := csv.NewWriter(outFile)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	stream, err := c.ExportSignedVAAs(ctx, &msg)
+	if err != nil {
+		log.Fatalf("failed to run ExportSignedVAAs RPC: %s", err)
+	}
+
+	count := 0
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatalf("failed to receive VAAs: %s", err)
+		}
+
+		for _, b := range resp.Vaas {
+			v, err := vaa.Unmarshal(b)
+			if err != nil {
+				log.Fatalf("failed to unmarshal exported VAA: %v", err)
+			}
+			if err := writer.Write([]string{v.MessageID(), hex.EncodeToString(b)}); err != nil {
+				log.Fatalf("failed to write VAA to output file: %v", err)
+			}
+		}
+		count += len(resp.Vaas)
+		log.Printf("Exported %d VAAs", count)
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		log.Fatalf("failed to write output file: %v", err)
+	}
+	log.Printf("Successfully exported %d VAAs to %s", count, args[0])
+}
+
+func runImportVaas(cmd *cobra.Command, args []string) {
+	inFile, err := os.Open(args[0])
+	if err != nil {
+		log.Fatalf("failed to open input file: %v", err)
+	}
+	defer inFile.Close()
+	reader := csv.NewReader(inFile)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	var imported, skipped, rejected uint32
+	batch := make([][]byte, 0, importVaasBatchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		resp, err := c.ImportSignedVAAs(ctx, &nodev1.ImportSignedVAAsRequest{Vaas: batch})
+		if err != nil {
+			log.Fatalf("failed to run ImportSignedVAAs RPC: %s", err)
+		}
+		for _, reason := range resp.Rejected {
+			log.Printf("rejected VAA: %s", reason)
+		}
+		imported += resp.Imported
+		skipped += resp.Skipped
+		rejected += uint32(len(resp.Rejected))
+		log.Printf("Imported %d VAAs, skipped %d already present, rejected %d", imported, skipped, rejected)
+		batch = batch[:0]
+	}
+
+	row := 0
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatalf("failed to parse VAA CSV: %v", err)
+		}
+		if len(record) != 2 {
+			log.Fatalf("row [%d] does not have 2 elements", row)
+		}
+		vaaBytes, err := hex.DecodeString(record[1])
+		if err != nil {
+			log.Fatalf("row [%d] (%s) has an invalid VAA: %v", row, record[0], err)
+		}
+		row++
+
+		batch = append(batch, vaaBytes)
+		if len(batch) == importVaasBatchSize {
+			flush()
+		}
+	}
+	flush()
+
+	log.Printf("Finished importing %s: imported %d VAAs, skipped %d already present, rejected %d", args[0], imported, skipped, rejected)
+}
+
+func runObservationConflicts(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	resp, err := c.GetObservationConflicts(ctx, &nodev1.GetObservationConflictsRequest{})
+	if err != nil {
+		log.Fatalf("failed to run GetObservationConflicts RPC: %s", err)
+	}
+
+	if len(resp.Conflicts) == 0 {
+		fmt.Println("No conflicts detected")
+		return
+	}
+
+	for _, conflict := range resp.Conflicts {
+		fmt.Printf("%s (first detected %s, last updated %s)\n", conflict.MessageId,
+			time.Unix(conflict.FirstDetected, 0).UTC().Format(time.RFC3339), time.Unix(conflict.LastUpdated, 0).UTC().Format(time.RFC3339))
+		for _, d := range conflict.Digests {
+			fmt.Printf("  %s: %s\n", d.Digest, strings.Join(d.Signers, ", "))
+		}
+	}
+}
+
+func runGuardianKeyStatus(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	resp, err := c.GetGuardianKeyStatus(ctx, &nodev1.GetGuardianKeyStatusRequest{})
+	if err != nil {
+		log.Fatalf("failed to run GetGuardianKeyStatus RPC: %s", err)
+	}
+
+	fmt.Printf("Active key: %s (in guardian set %d: %t)\n", resp.ActiveAddress, resp.GuardianSetIndex, resp.InGuardianSet)
+	if !resp.Rotating {
+		fmt.Println("No key rotation configured")
+		return
+	}
+
+	activeKey := "old"
+	if resp.UsingNewKey {
+		activeKey = "new"
+	}
+	fmt.Printf("Key rotation configured, signing with the %s key\n", activeKey)
+	fmt.Printf("  old key: %s\n", resp.OldAddress)
+	fmt.Printf("  new key: %s\n", resp.NewAddress)
+}
+
+func runBackfill(cmd *cobra.Command, args []string) {
+	chainID, err := parseChainID(args[0])
+	if err != nil {
+		log.Fatalf("invalid chain ID: %v", err)
+	}
+
+	fromBlock, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		log.Fatalf("invalid from block: %v", err)
+	}
+
+	toBlock, err := strconv.ParseUint(args[2], 10, 64)
+	if err != nil {
+		log.Fatalf("invalid to block: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	_, err = c.Backfill(ctx, &nodev1.BackfillRequest{
+		ChainId:   uint32(chainID),
+		FromBlock: fromBlock,
+		ToBlock:   toBlock,
+	})
+	if err != nil {
+		log.Fatalf("failed to run Backfill RPC: %s", err)
+	}
+
+	fmt.Printf("Backfill of blocks %d to %d of %s queued, see the node logs for its progress\n", fromBlock, toBlock, chainID)
+}
+
 func runSignExistingVaa(cmd *cobra.Command, args []string) {
 	existingVAA := ethcommon.Hex2Bytes(args[0])
 	if len(existingVAA) == 0 {
@@ -24,7 +24,7 @@ var (
 )
 
 func init() {
-	AdminClientListNodes.Flags().BoolVar(&showDetails, "showDetails", false, "Show error counter and contract addresses")
+	AdminClientListNodes.Flags().BoolVar(&showDetails, "showDetails", false, "Show error counter, safe and finalized heights, contract addresses, watcher versions and finality strategies")
 	AdminClientListNodes.Flags().StringSliceVar(&only, "only", nil, "Show only networks with the given name")
 }
 
@@ -163,10 +163,18 @@ func runListNodes(cmd *cobra.Command, args []string) {
 		boot := time.Unix(0, h.RawHeartbeat.BootTimestamp)
 
 		heights := map[vaa.ChainID]int64{}
+		safeHeights := map[vaa.ChainID]int64{}
+		finalizedHeights := map[vaa.ChainID]int64{}
+		watcherVersions := map[vaa.ChainID]string{}
+		finalities := map[vaa.ChainID]string{}
 		truncAddrs := make(map[vaa.ChainID]string)
 		errors := map[vaa.ChainID]uint64{}
 		for _, n := range h.RawHeartbeat.Networks {
 			heights[vaa.ChainID(n.Id)] = n.Height
+			safeHeights[vaa.ChainID(n.Id)] = n.SafeHeight
+			finalizedHeights[vaa.ChainID(n.Id)] = n.FinalizedHeight
+			watcherVersions[vaa.ChainID(n.Id)] = n.WatcherVersion
+			finalities[vaa.ChainID(n.Id)] = n.Finality
 			errors[vaa.ChainID(n.Id)] = n.ErrorCount
 			if len(n.ContractAddress) >= 16 {
 				truncAddrs[vaa.ChainID(n.Id)] = n.ContractAddress[:16]
@@ -189,8 +197,8 @@ func runListNodes(cmd *cobra.Command, args []string) {
 
 		for _, n := range networks {
 			if showDetails {
-				fields = append(fields, fmt.Sprintf("%s %d (%d)",
-					truncAddrs[n.ChainID], heights[n.ChainID], errors[n.ChainID]))
+				fields = append(fields, fmt.Sprintf("%s %d/%d/%d (%d) %s %s",
+					truncAddrs[n.ChainID], heights[n.ChainID], safeHeights[n.ChainID], finalizedHeights[n.ChainID], errors[n.ChainID], watcherVersions[n.ChainID], finalities[n.ChainID]))
 			} else {
 				fields = append(fields, fmt.Sprintf("%d", heights[n.ChainID]))
 			}
@@ -72,6 +72,10 @@ var accountantModifyBalanceAction *string
 var accountantModifyBalanceAmount *string
 var accountantModifyBalanceReason *string
 
+var governorChainConfigUpdateChainId *string
+var governorChainConfigUpdateDailyLimit *string
+var governorChainConfigUpdateBigTransactionSize *string
+
 var governanceContractAddress *string
 var governanceTargetAddress *string
 var governanceTargetChain *string
@@ -204,6 +208,14 @@ func init() {
 	AdminClientAccountantModifyBalanceCmd.Flags().AddFlagSet(moduleFlagSet)
 	TemplateCmd.AddCommand(AdminClientAccountantModifyBalanceCmd)
 
+	// flags for the governor-chain-config-update command
+	governorChainConfigUpdateFlagSet := pflag.NewFlagSet("governor-chain-config-update", pflag.ExitOnError)
+	governorChainConfigUpdateChainId = governorChainConfigUpdateFlagSet.String("chain-id", "", "Emitter chain ID whose governor limits should be updated")
+	governorChainConfigUpdateDailyLimit = governorChainConfigUpdateFlagSet.String("daily-limit", "", "Daily notional limit in USD")
+	governorChainConfigUpdateBigTransactionSize = governorChainConfigUpdateFlagSet.String("big-transaction-size", "0", "Notional value in USD at or above which a transfer is delayed (0 disables the check)")
+	AdminClientGovernorChainConfigUpdateCmd.Flags().AddFlagSet(governorChainConfigUpdateFlagSet)
+	TemplateCmd.AddCommand(AdminClientGovernorChainConfigUpdateCmd)
+
 	// flags for general-purpose governance call command
 	generalPurposeGovernanceFlagSet := pflag.NewFlagSet("general-purpose-governance", pflag.ExitOnError)
 	governanceContractAddress = generalPurposeGovernanceFlagSet.String("governance-contract", "", "Governance contract address")
@@ -337,6 +349,12 @@ var AdminClientIbcTranslatorUpdateChannelChainCmd = &cobra.Command{
 	Run:   runIbcTranslatorUpdateChannelChainTemplate,
 }
 
+var AdminClientGovernorChainConfigUpdateCmd = &cobra.Command{
+	Use:   "governor-chain-config-update",
+	Short: "Generate a 'governor chain config update' template for specified chain and limits",
+	Run:   runGovernorChainConfigUpdateTemplate,
+}
+
 var AdminClientWormholeRelayerSetDefaultDeliveryProviderCmd = &cobra.Command{
 	Use:   "wormhole-relayer-set-default-delivery-provider",
 	Short: "Generate a 'set default delivery provider' template for specified chain and address",
@@ -1186,6 +1204,50 @@ func runGeneralPurposeGovernanceSolanaCallTemplate(cmd *cobra.Command, args []st
 	fmt.Print(string(b))
 }
 
+func runGovernorChainConfigUpdateTemplate(cmd *cobra.Command, args []string) {
+	if *governorChainConfigUpdateChainId == "" {
+		log.Fatal("--chain-id must be specified")
+	}
+	chainID, err := parseChainID(*governorChainConfigUpdateChainId)
+	if err != nil {
+		log.Fatal("failed to parse chain id: ", err)
+	}
+	if *governorChainConfigUpdateDailyLimit == "" {
+		log.Fatal("--daily-limit must be specified")
+	}
+	dailyLimit, err := strconv.ParseUint(*governorChainConfigUpdateDailyLimit, 10, 64)
+	if err != nil {
+		log.Fatal("failed to parse daily limit as uint64: ", err)
+	}
+	bigTransactionSize, err := strconv.ParseUint(*governorChainConfigUpdateBigTransactionSize, 10, 64)
+	if err != nil {
+		log.Fatal("failed to parse big transaction size as uint64: ", err)
+	}
+
+	m := &nodev1.InjectGovernanceVAARequest{
+		CurrentSetIndex: uint32(*templateGuardianIndex),
+		Messages: []*nodev1.GovernanceMessage{
+			{
+				Sequence: rand.Uint64(),
+				Nonce:    rand.Uint32(),
+				Payload: &nodev1.GovernanceMessage_GovernorChainConfigUpdate{
+					GovernorChainConfigUpdate: &nodev1.GovernorChainConfigUpdate{
+						EmitterChain:       uint32(chainID),
+						DailyLimit:         dailyLimit,
+						BigTransactionSize: bigTransactionSize,
+					},
+				},
+			},
+		},
+	}
+
+	b, err := prototext.MarshalOptions{Multiline: true}.Marshal(m)
+	if err != nil {
+		log.Fatal("failed to marshal request: ", err)
+	}
+	fmt.Print(string(b))
+}
+
 // parseAddress parses either a hex-encoded address and returns
 // a left-padded 32 byte hex string.
 func parseAddress(s string) (string, error) {
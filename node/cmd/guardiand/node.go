@@ -13,6 +13,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/certusone/wormhole/node/pkg/finality"
 	"github.com/certusone/wormhole/node/pkg/guardiansigner"
 	"github.com/certusone/wormhole/node/pkg/watchers"
 	"github.com/certusone/wormhole/node/pkg/watchers/ibc"
@@ -26,6 +27,7 @@ import (
 	"github.com/certusone/wormhole/node/pkg/watchers/near"
 	"github.com/certusone/wormhole/node/pkg/watchers/solana"
 	"github.com/certusone/wormhole/node/pkg/watchers/sui"
+	"github.com/certusone/wormhole/node/pkg/watchers/wormchain"
 	"github.com/certusone/wormhole/node/pkg/wormconn"
 
 	"github.com/certusone/wormhole/node/pkg/db"
@@ -38,6 +40,7 @@ import (
 	"github.com/certusone/wormhole/node/pkg/devnet"
 	"github.com/certusone/wormhole/node/pkg/node"
 	"github.com/certusone/wormhole/node/pkg/p2p"
+	"github.com/certusone/wormhole/node/pkg/processor"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
 	promremotew "github.com/certusone/wormhole/node/pkg/telemetry/prom_remote_write"
 	libp2p_crypto "github.com/libp2p/go-libp2p/core/crypto"
@@ -59,13 +62,22 @@ var (
 	adminSocketPath      *string
 	publicGRPCSocketPath *string
 
+	observationBatchMaxSize  *int
+	observationBatchMaxDelay *time.Duration
+
 	dataDir *string
 
+	vaaRetentionDays      *uint
+	dbMaintenanceInterval *time.Duration
+
 	statusAddr *string
 
-	guardianKeyPath   *string
-	guardianSignerUri *string
-	solanaContract    *string
+	guardianKeyPath      *string
+	guardianSignerUri    *string
+	guardianKeyNewPath   *string
+	guardianSignerUriNew *string
+	gossipSignerUri      *string
+	solanaContract       *string
 
 	ethRPC      *string
 	ethContract *string
@@ -145,6 +157,7 @@ var (
 	accountantNttContract      *string
 	accountantNttKeyPath       *string
 	accountantNttKeyPassPhrase *string
+	accountantNttCheckEnabled  *bool
 
 	aptosRPC     *string
 	aptosAccount *string
@@ -223,6 +236,8 @@ var (
 	publicRpcLogDetailStr   *string
 	publicRpcLogToTelemetry *bool
 
+	publicRpcReobservationsEnabled *bool
+
 	unsafeDevMode *bool
 	testnetMode   *bool
 	nodeName      *string
@@ -254,6 +269,13 @@ var (
 	ccqAllowedPeers      *string
 	ccqBackfillCache     *bool
 
+	evmChainRegistry      *string
+	cosmwasmChainRegistry *string
+	evmBackupRPCs         *[]string
+	finalityOverrides     *[]string
+
+	transferVerifierChains *[]string
+
 	gatewayRelayerContract      *string
 	gatewayRelayerKeyPath       *string
 	gatewayRelayerKeyPassPhrase *string
@@ -261,6 +283,11 @@ var (
 	// This is the externally reachable address advertised over gossip for guardian p2p and ccq p2p.
 	gossipAdvertiseAddress *string
 
+	// These configure the libp2p transports used for guardian p2p.
+	p2pTransports   *string
+	p2pNATPortMap   *bool
+	p2pHolePunching *bool
+
 	// env is the mode we are running in, Mainnet, Testnet or UnsafeDevnet.
 	env common.Environment
 
@@ -271,6 +298,8 @@ func init() {
 	p2pNetworkID = NodeCmd.Flags().String("network", "", "P2P network identifier (optional, overrides default for environment)")
 	p2pPort = NodeCmd.Flags().Uint("port", p2p.DefaultPort, "P2P UDP listener port")
 	p2pBootstrap = NodeCmd.Flags().String("bootstrap", "", "P2P bootstrap peers (optional for mainnet or testnet, overrides default, required for unsafeDevMode)")
+	observationBatchMaxSize = NodeCmd.Flags().Int("observationBatchMaxSize", processor.DefaultObservationBatchConfig.MaxSize, "Maximum number of our observations gossiped in a single batch")
+	observationBatchMaxDelay = NodeCmd.Flags().Duration("observationBatchMaxDelay", processor.DefaultObservationBatchConfig.MaxDelay, "Longest time our observations are held back to be gossiped in a batch (at most 5s)")
 
 	statusAddr = NodeCmd.Flags().String("statusAddr", "[::]:6060", "Listen address for status server (disabled if blank)")
 
@@ -280,9 +309,14 @@ func init() {
 	publicGRPCSocketPath = NodeCmd.Flags().String("publicGRPCSocket", "", "Public gRPC service UNIX domain socket path")
 
 	dataDir = NodeCmd.Flags().String("dataDir", "", "Data directory")
+	vaaRetentionDays = NodeCmd.Flags().Uint("vaaRetentionDays", 0, "Number of days to keep VAAs in the database, governance VAAs are always kept (0 keeps VAAs forever)")
+	dbMaintenanceInterval = NodeCmd.Flags().Duration("dbMaintenanceInterval", 24*time.Hour, "Interval at which old VAAs are pruned and the database is compacted")
 
 	guardianKeyPath = NodeCmd.Flags().String("guardianKey", "", "Path to guardian key")
 	guardianSignerUri = NodeCmd.Flags().String("guardianSignerUri", "", "Guardian signer URI")
+	guardianKeyNewPath = NodeCmd.Flags().String("guardianKeyNew", "", "Path to the new guardian key during a guardian key rotation")
+	guardianSignerUriNew = NodeCmd.Flags().String("guardianSignerUriNew", "", "Guardian signer URI of the new guardian key during a guardian key rotation")
+	gossipSignerUri = NodeCmd.Flags().String("gossipSignerUri", "", "Signer URI of the gossip key, which signs heartbeats and governor messages instead of the guardian key (optional)")
 	solanaContract = NodeCmd.Flags().String("solanaContract", "", "Address of the Solana program (required)")
 
 	ethRPC = node.RegisterFlagWithValidationOrFail(NodeCmd, "ethRPC", "Ethereum RPC URL", "ws://eth-devnet:8545", []string{"ws", "wss"})
@@ -363,6 +397,7 @@ func init() {
 	accountantNttContract = NodeCmd.Flags().String("accountantNttContract", "", "Address of the NTT accountant smart contract on wormchain")
 	accountantNttKeyPath = NodeCmd.Flags().String("accountantNttKeyPath", "", "path to NTT accountant private key for signing transactions")
 	accountantNttKeyPassPhrase = NodeCmd.Flags().String("accountantNttKeyPassPhrase", "", "pass phrase used to unarmor the NTT accountant key file")
+	accountantNttCheckEnabled = NodeCmd.Flags().Bool("accountantNttCheckEnabled", true, "Should NTT accountant be enforced on transfers from emitters configured to be enforced")
 
 	aptosRPC = node.RegisterFlagWithValidationOrFail(NodeCmd, "aptosRPC", "Aptos RPC URL", "http://aptos:8080", []string{"http", "https"})
 	aptosAccount = NodeCmd.Flags().String("aptosAccount", "", "aptos account")
@@ -440,6 +475,7 @@ func init() {
 	logLevel = NodeCmd.Flags().String("logLevel", "info", "Logging level (debug, info, warn, error, dpanic, panic, fatal)")
 	publicRpcLogDetailStr = NodeCmd.Flags().String("publicRpcLogDetail", "full", "The detail with which public RPC requests shall be logged (none=no logging, minimal=only log gRPC methods, full=log gRPC method, payload (up to 200 bytes) and user agent (up to 200 bytes))")
 	publicRpcLogToTelemetry = NodeCmd.Flags().Bool("logPublicRpcToTelemetry", true, "whether or not to include publicRpc request logs in telemetry")
+	publicRpcReobservationsEnabled = NodeCmd.Flags().Bool("publicRpcReobservationsEnabled", false, "Allow GetSignedVAA requests that include a tx_hash to request a (rate limited) re-observation if the VAA is not found")
 
 	unsafeDevMode = NodeCmd.Flags().Bool("unsafeDevMode", false, "Launch node in unsafe, deterministic devnet mode")
 	testnetMode = NodeCmd.Flags().Bool("testnetMode", false, "Launch node in testnet mode (enables testnet-only features)")
@@ -471,8 +507,19 @@ func init() {
 	ccqP2pBootstrap = NodeCmd.Flags().String("ccqP2pBootstrap", "", "CCQ P2P bootstrap peers (optional for mainnet or testnet, overrides default, required for unsafeDevMode)")
 	ccqAllowedPeers = NodeCmd.Flags().String("ccqAllowedPeers", "", "CCQ allowed P2P peers (comma-separated)")
 	ccqBackfillCache = NodeCmd.Flags().Bool("ccqBackfillCache", true, "Should EVM chains backfill CCQ timestamp cache on startup")
+	evmChainRegistry = NodeCmd.Flags().String("evmChainRegistry", "", "Path to a JSON or YAML file declaring additional EVM chains to watch, reloaded on SIGHUP")
+	cosmwasmChainRegistry = NodeCmd.Flags().String("cosmwasmChainRegistry", "", "Path to a JSON or YAML file declaring additional CosmWasm chains to watch, along with the event mapping of each chain")
+	evmBackupRPCs = NodeCmd.Flags().StringArray("evmBackupRPC", nil, "Backup RPC URL of an EVM chain configured by flags, as <networkId>=<url>, e.g. 'eth=wss://eth-backup:8545'. May be repeated")
+	finalityOverrides = NodeCmd.Flags().StringArray("finalityOverride", nil, "Finality strategy of an EVM chain configured by flags, as <chain>=<strategy>, where the strategy is instant, finalized, safe or depth:<blocks>, e.g. 'base=depth:64'. May be repeated")
+
+	transferVerifierChains = NodeCmd.Flags().StringArray("transferVerifierChain", nil, "Name or ID of an EVM chain configured by flags whose token bridge transfers are verified against the deposits in their transaction before being signed, e.g. 'ethereum'. May be repeated")
+
 	gossipAdvertiseAddress = NodeCmd.Flags().String("gossipAdvertiseAddress", "", "External IP to advertize on Guardian and CCQ p2p (use if behind a NAT or running in k8s)")
 
+	p2pTransports = NodeCmd.Flags().String("p2pTransports", "quic", "Comma-separated list of P2P transports in order of preference, e.g. 'quic,tcp'. TCP listens on the same port number as QUIC")
+	p2pNATPortMap = NodeCmd.Flags().Bool("p2pNATPortMap", false, "Attempt to open the P2P port in the local router using UPnP / NAT-PMP")
+	p2pHolePunching = NodeCmd.Flags().Bool("p2pHolePunching", false, "Enable libp2p hole punching for nodes behind a NAT")
+
 	gatewayRelayerContract = NodeCmd.Flags().String("gatewayRelayerContract", "", "Address of the smart contract on wormchain to receive relayed VAAs")
 	gatewayRelayerKeyPath = NodeCmd.Flags().String("gatewayRelayerKeyPath", "", "Path to gateway relayer private key for signing transactions")
 	gatewayRelayerKeyPassPhrase = NodeCmd.Flags().String("gatewayRelayerKeyPassPhrase", "", "Pass phrase used to unarmor the gateway relayer key file")
@@ -668,6 +715,12 @@ func runNode(cmd *cobra.Command, args []string) {
 		// just like to ignore the new guardianSignerUri altogether.
 		*guardianSignerUri = fmt.Sprintf("file://%s", *guardianKeyPath)
 	}
+	if *guardianKeyNewPath != "" {
+		if *guardianSignerUriNew != "" {
+			logger.Fatal("Please only specify --guardianKeyNew or --guardianSignerUriNew")
+		}
+		*guardianSignerUriNew = fmt.Sprintf("file://%s", *guardianKeyNewPath)
+	}
 	if *adminSocketPath == "" {
 		logger.Fatal("Please specify --adminSocket")
 	}
@@ -701,8 +754,14 @@ func runNode(cmd *cobra.Command, args []string) {
 	rootCtx, rootCtxCancel = context.WithCancel(context.Background())
 	defer rootCtxCancel()
 
-	// Create the Guardian Signer
-	guardianSigner, err := guardiansigner.NewGuardianSignerFromUri(rootCtx, *guardianSignerUri, env == common.UnsafeDevNet)
+	// Create the Guardian Signer. During a guardian key rotation, the signer holds both the old and the new key
+	// and signs with the one that is part of the active guardian set.
+	var guardianSigner guardiansigner.GuardianSigner
+	if *guardianSignerUriNew != "" {
+		guardianSigner, err = guardiansigner.NewDualKeySignerFromUris(rootCtx, *guardianSignerUri, *guardianSignerUriNew, env == common.UnsafeDevNet)
+	} else {
+		guardianSigner, err = guardiansigner.NewGuardianSignerFromUri(rootCtx, *guardianSignerUri, env == common.UnsafeDevNet)
+	}
 	if err != nil {
 		logger.Fatal("failed to create a new guardian signer", zap.Error(err))
 	}
@@ -710,6 +769,28 @@ func runNode(cmd *cobra.Command, args []string) {
 	logger.Info("Created the guardian signer", zap.String(
 		"address", ethcrypto.PubkeyToAddress(guardianSigner.PublicKey(rootCtx)).String()))
 
+	// Create the signer of the gossip key, if configured. The gossip key must not be a guardian key, as the
+	// point of it is to keep the guardian keys out of the signing of non-consensus messages.
+	var gossipKeySigner guardiansigner.GuardianSigner
+	if *gossipSignerUri != "" {
+		gossipKeySigner, err = guardiansigner.NewGuardianSignerFromUri(rootCtx, *gossipSignerUri, env == common.UnsafeDevNet)
+		if err != nil {
+			logger.Fatal("failed to create the gossip key signer", zap.Error(err))
+		}
+
+		gossipKeyAddr := ethcrypto.PubkeyToAddress(gossipKeySigner.PublicKey(rootCtx))
+		isGuardianKey := gossipKeyAddr == ethcrypto.PubkeyToAddress(guardianSigner.PublicKey(rootCtx))
+		if signer, ok := guardiansigner.AsGuardianSetAware(guardianSigner); ok {
+			keyStatus := signer.KeyStatus(rootCtx)
+			isGuardianKey = isGuardianKey || gossipKeyAddr == keyStatus.OldAddress || gossipKeyAddr == keyStatus.NewAddress
+		}
+		if isGuardianKey {
+			logger.Fatal("The gossip key must not be a guardian key", zap.String("address", gossipKeyAddr.String()))
+		}
+
+		logger.Info("Created the gossip key signer", zap.String("address", gossipKeyAddr.String()))
+	}
+
 	// Load p2p private key
 	var p2pKey libp2p_crypto.PrivKey
 	if env == common.UnsafeDevNet {
@@ -1563,9 +1644,8 @@ func runNode(cmd *cobra.Command, args []string) {
 	}
 
 	if shouldStart(gatewayWS) {
-		wc := &cosmwasm.WatcherConfig{
+		wc := &wormchain.WatcherConfig{
 			NetworkID: "gateway",
-			ChainID:   vaa.ChainIDWormchain,
 			Websocket: *gatewayWS,
 			Lcd:       *gatewayLCD,
 			Contract:  *gatewayContract,
@@ -1660,6 +1740,121 @@ func runNode(cmd *cobra.Command, args []string) {
 		}
 	}
 
+	if len(*evmBackupRPCs) != 0 {
+		backupRpcs, err := evm.ParseBackupRpcs(*evmBackupRPCs)
+		if err != nil {
+			logger.Fatal("invalid --evmBackupRPC", zap.Error(err))
+		}
+		for _, wc := range watcherConfigs {
+			evmWc, ok := wc.(*evm.WatcherConfig)
+			if !ok {
+				continue
+			}
+			if backups, exists := backupRpcs[evmWc.NetworkID]; exists {
+				evmWc.BackupRpcs = backups
+				delete(backupRpcs, evmWc.NetworkID)
+			}
+		}
+		for networkID := range backupRpcs {
+			logger.Fatal("--evmBackupRPC is set for a network that is not an EVM chain configured by flags", zap.String("networkId", string(networkID)))
+		}
+	}
+
+	if len(*finalityOverrides) != 0 {
+		overrides, err := finality.ParseOverrides(*finalityOverrides)
+		if err != nil {
+			logger.Fatal("invalid --finalityOverride", zap.Error(err))
+		}
+		for _, wc := range watcherConfigs {
+			evmWc, ok := wc.(*evm.WatcherConfig)
+			if !ok {
+				continue
+			}
+			if strategy, exists := overrides[evmWc.ChainID]; exists {
+				if err := strategy.Check(finality.ModeInstant, finality.ModeFinalized, finality.ModeSafe, finality.ModeDepth); err != nil {
+					logger.Fatal("invalid --finalityOverride", zap.Stringer("chainID", evmWc.ChainID), zap.Error(err))
+				}
+				evmWc.Finality = strategy
+				delete(overrides, evmWc.ChainID)
+			}
+		}
+		for chainID := range overrides {
+			logger.Fatal("--finalityOverride is set for a chain that is not an EVM chain configured by flags", zap.Stringer("chainID", chainID))
+		}
+	}
+
+	if len(*transferVerifierChains) != 0 {
+		chains, err := evm.ParseTransferVerifierChains(*transferVerifierChains)
+		if err != nil {
+			logger.Fatal("invalid --transferVerifierChain", zap.Error(err))
+		}
+		for _, wc := range watcherConfigs {
+			evmWc, ok := wc.(*evm.WatcherConfig)
+			if !ok {
+				continue
+			}
+			if _, exists := chains[evmWc.ChainID]; exists {
+				evmWc.TransferVerifier = true
+				delete(chains, evmWc.ChainID)
+			}
+		}
+		for chainID := range chains {
+			logger.Fatal("--transferVerifierChain is set for a chain that is not an EVM chain configured by flags", zap.Stringer("chainID", chainID))
+		}
+	}
+
+	if *evmChainRegistry != "" {
+		registry, err := evm.LoadChainRegistry(*evmChainRegistry)
+		if err != nil {
+			logger.Fatal("failed to load the EVM chain registry", zap.Error(err))
+		}
+
+		configuredChains := make(map[vaa.ChainID]struct{}, len(watcherConfigs))
+		for _, wc := range watcherConfigs {
+			configuredChains[wc.GetChainID()] = struct{}{}
+		}
+		for _, wc := range registry.WatcherConfigs(*ccqBackfillCache) {
+			if _, exists := configuredChains[wc.GetChainID()]; exists {
+				logger.Fatal("chain in the EVM chain registry is already configured by flags", zap.Stringer("chainID", wc.GetChainID()))
+			}
+			watcherConfigs = append(watcherConfigs, wc)
+		}
+
+		registryLogger := logger.With(zap.String("component", "evm_chain_registry"))
+		errC := make(chan error)
+		common.StartRunnable(rootCtx, errC, false, "evm_chain_registry", func(ctx context.Context) error {
+			return registry.RunReloadOnSIGHUP(ctx, registryLogger)
+		})
+	}
+
+	if *cosmwasmChainRegistry != "" {
+		registry, err := cosmwasm.LoadChainRegistry(*cosmwasmChainRegistry)
+		if err != nil {
+			logger.Fatal("failed to load the CosmWasm chain registry", zap.Error(err))
+		}
+
+		configuredChains := make(map[vaa.ChainID]struct{}, len(watcherConfigs))
+		for _, wc := range watcherConfigs {
+			configuredChains[wc.GetChainID()] = struct{}{}
+		}
+		for _, wc := range registry.WatcherConfigs() {
+			if _, exists := configuredChains[wc.GetChainID()]; exists {
+				logger.Fatal("chain in the CosmWasm chain registry is already configured", zap.Stringer("chainID", wc.GetChainID()))
+			}
+			watcherConfigs = append(watcherConfigs, wc)
+		}
+	}
+
+	transports, err := p2p.ParseTransports(*p2pTransports)
+	if err != nil {
+		logger.Fatal("invalid --p2pTransports", zap.Error(err))
+	}
+	p2pTransportConfig := p2p.TransportConfig{
+		Transports:   transports,
+		NATPortMap:   *p2pNATPortMap,
+		HolePunching: *p2pHolePunching,
+	}
+
 	var ibcWatcherConfig *node.IbcWatcherConfig = nil
 	if shouldStart(ibcWS) {
 		ibcWatcherConfig = &node.IbcWatcherConfig{
@@ -1677,22 +1872,23 @@ func runNode(cmd *cobra.Command, args []string) {
 
 	guardianOptions := []*node.GuardianOption{
 		node.GuardianOptionDatabase(db),
+		node.GuardianOptionDatabaseMaintenance(time.Duration(*vaaRetentionDays)*24*time.Hour, *dbMaintenanceInterval),
 		node.GuardianOptionWatchers(watcherConfigs, ibcWatcherConfig),
-		node.GuardianOptionAccountant(*accountantWS, *accountantContract, *accountantCheckEnabled, accountantWormchainConn, *accountantNttContract, accountantNttWormchainConn),
+		node.GuardianOptionAccountant(*accountantWS, *accountantContract, *accountantCheckEnabled, accountantWormchainConn, *accountantNttContract, *accountantNttCheckEnabled, accountantNttWormchainConn),
 		node.GuardianOptionGovernor(*chainGovernorEnabled, *governorFlowCancelEnabled, *coinGeckoApiKey),
 		node.GuardianOptionGatewayRelayer(*gatewayRelayerContract, gatewayRelayerWormchainConn),
 		node.GuardianOptionQueryHandler(*ccqEnabled, *ccqAllowedRequesters),
 		node.GuardianOptionAdminService(*adminSocketPath, ethRPC, ethContract, rpcMap),
-		node.GuardianOptionP2P(p2pKey, *p2pNetworkID, *p2pBootstrap, *nodeName, *subscribeToVAAs, *disableHeartbeatVerify, *p2pPort, *ccqP2pBootstrap, *ccqP2pPort, *ccqAllowedPeers, *gossipAdvertiseAddress, ibc.GetFeatures),
+		node.GuardianOptionP2P(p2pKey, *p2pNetworkID, *p2pBootstrap, *nodeName, *subscribeToVAAs, *disableHeartbeatVerify, *p2pPort, *ccqP2pBootstrap, *ccqP2pPort, *ccqAllowedPeers, *gossipAdvertiseAddress, p2pTransportConfig, ibc.GetFeatures, gossipKeySigner),
 		node.GuardianOptionStatusServer(*statusAddr),
-		node.GuardianOptionProcessor(*p2pNetworkID),
+		node.GuardianOptionProcessor(*p2pNetworkID, processor.ObservationBatchConfig{MaxSize: *observationBatchMaxSize, MaxDelay: *observationBatchMaxDelay}),
 	}
 
 	if shouldStart(publicGRPCSocketPath) {
-		guardianOptions = append(guardianOptions, node.GuardianOptionPublicRpcSocket(*publicGRPCSocketPath, publicRpcLogDetail))
+		guardianOptions = append(guardianOptions, node.GuardianOptionPublicRpcSocket(*publicGRPCSocketPath, publicRpcLogDetail, *publicRpcReobservationsEnabled))
 
 		if shouldStart(publicRPC) {
-			guardianOptions = append(guardianOptions, node.GuardianOptionPublicrpcTcpService(*publicRPC, publicRpcLogDetail))
+			guardianOptions = append(guardianOptions, node.GuardianOptionPublicrpcTcpService(*publicRPC, publicRpcLogDetail, *publicRpcReobservationsEnabled))
 		}
 
 		if shouldStart(publicWeb) {
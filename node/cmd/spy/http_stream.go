@@ -0,0 +1,305 @@
+package spy
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
+	spyv1 "github.com/certusone/wormhole/node/pkg/proto/spy/v1"
+	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"nhooyr.io/websocket"
+)
+
+// The HTTP server streams the same signed VAAs as the gRPC SubscribeSignedVAA RPC over WebSocket and Server-Sent Events,
+// so that browser-based and non-gRPC clients can consume them. Each VAA is sent as a JSON encoded SubscribeSignedVAAResponse.
+//
+// The subscription is configured using the following query parameters, all of which are optional:
+//   - emitter_chain: chain ID or name, may be repeated
+//   - emitter_address: hex-encoded emitter address, may be repeated
+//   - sequence_min, sequence_max: inclusive sequence range
+//   - payload_prefix: hex-encoded bytes that the payload must start with
+//   - resume_token, resume_from_timestamp: see SubscribeSignedVAARequest
+//
+// For Server-Sent Events, the resume token is used as the event ID, so reconnecting EventSource clients resume automatically.
+
+const (
+	wsStreamPath  = "/v1/signed_vaa/ws"
+	sseStreamPath = "/v1/signed_vaa/sse"
+)
+
+var jsonMarshaler = protojson.MarshalOptions{UseProtoNames: true}
+
+// httpSubscribeStream adapts an HTTP connection to the gRPC server stream interface used by SubscribeSignedVAA.
+type httpSubscribeStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	send func(*spyv1.SubscribeSignedVAAResponse) error
+}
+
+func (h *httpSubscribeStream) Context() context.Context {
+	return h.ctx
+}
+
+func (h *httpSubscribeStream) Send(resp *spyv1.SubscribeSignedVAAResponse) error {
+	return h.send(resp)
+}
+
+// subscribeRequestFromQuery builds a subscription request from the query parameters of an HTTP request.
+func subscribeRequestFromQuery(r *http.Request) (*spyv1.SubscribeSignedVAARequest, error) {
+	q := r.URL.Query()
+	req := &spyv1.SubscribeSignedVAARequest{}
+	filter := &spyv1.VAAPropertiesFilter{}
+	hasFilter := false
+
+	for _, c := range q["emitter_chain"] {
+		chainID, err := parseChainID(c)
+		if err != nil {
+			return nil, err
+		}
+		filter.EmitterChains = append(filter.EmitterChains, publicrpcv1.ChainID(chainID))
+		hasFilter = true
+	}
+
+	if addrs := q["emitter_address"]; len(addrs) != 0 {
+		filter.EmitterAddresses = addrs
+		hasFilter = true
+	}
+
+	if str := q.Get("sequence_min"); str != "" {
+		seq, err := strconv.ParseUint(str, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sequence_min: %w", err)
+		}
+		filter.SequenceMin = seq
+		hasFilter = true
+	}
+
+	if str := q.Get("sequence_max"); str != "" {
+		seq, err := strconv.ParseUint(str, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sequence_max: %w", err)
+		}
+		filter.SequenceMax = seq
+		hasFilter = true
+	}
+
+	if str := q.Get("payload_prefix"); str != "" {
+		prefix, err := hex.DecodeString(str)
+		if err != nil {
+			return nil, fmt.Errorf("invalid payload_prefix: %w", err)
+		}
+		filter.PayloadPrefix = prefix
+		hasFilter = true
+	}
+
+	if hasFilter {
+		req.Filters = []*spyv1.FilterEntry{{Filter: &spyv1.FilterEntry_VaaPropertiesFilter{VaaPropertiesFilter: filter}}}
+	}
+
+	req.ResumeToken = q.Get("resume_token")
+	if lastEventID := r.Header.Get("Last-Event-ID"); lastEventID != "" {
+		// Set by EventSource clients when reconnecting, so it takes precedence.
+		req.ResumeToken = lastEventID
+	}
+
+	if str := q.Get("resume_from_timestamp"); str != "" {
+		ts, err := strconv.ParseInt(str, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid resume_from_timestamp: %w", err)
+		}
+		req.ResumeFromTimestamp = ts
+	}
+
+	return req, nil
+}
+
+// parseChainID parses a chain ID given either as a number or a name.
+func parseChainID(str string) (vaa.ChainID, error) {
+	if n, err := strconv.ParseUint(str, 10, 16); err == nil {
+		return vaa.ChainIDFromNumber(n)
+	}
+	return vaa.ChainIDFromString(str)
+}
+
+// httpSubscribeRequest parses and validates the subscription request of an HTTP request. If it is invalid,
+// an error response is written and nil is returned.
+func (s *spyServer) httpSubscribeRequest(w http.ResponseWriter, r *http.Request) *spyv1.SubscribeSignedVAARequest {
+	req, err := subscribeRequestFromQuery(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil
+	}
+
+	// Validate up front so errors can be reported with a proper status code before streaming starts.
+	if _, err := parseFilters(req); err != nil {
+		st := status.Convert(err)
+		http.Error(w, st.Message(), runtime.HTTPStatusFromCode(st.Code()))
+		return nil
+	}
+	if _, _, err := s.resumeCursor(req); err != nil {
+		st := status.Convert(err)
+		http.Error(w, st.Message(), runtime.HTTPStatusFromCode(st.Code()))
+		return nil
+	}
+
+	return req
+}
+
+// handleWebSocket streams signed VAAs over a WebSocket connection.
+func (s *spyServer) handleWebSocket(w http.ResponseWriter, r *http.Request) {
+	req := s.httpSubscribeRequest(w, r)
+	if req == nil {
+		return
+	}
+
+	// The stream only contains public data and does not rely on cookies, so connections from any origin are allowed.
+	c, err := websocket.Accept(w, r, &websocket.AcceptOptions{InsecureSkipVerify: true})
+	if err != nil {
+		s.logger.Debug("failed to accept websocket connection", zap.Error(err))
+		return
+	}
+	defer c.Close(websocket.StatusInternalError, "")
+
+	// The client is not expected to send anything. This also cancels the context when the client disconnects.
+	ctx := c.CloseRead(r.Context())
+
+	err = s.SubscribeSignedVAA(req, &httpSubscribeStream{
+		ctx: ctx,
+		send: func(resp *spyv1.SubscribeSignedVAAResponse) error {
+			b, err := jsonMarshaler.Marshal(resp)
+			if err != nil {
+				return err
+			}
+			return c.Write(ctx, websocket.MessageText, b)
+		},
+	})
+
+	if err != nil && ctx.Err() == nil {
+		c.Close(websocket.StatusPolicyViolation, truncateCloseReason(status.Convert(err).Message()))
+		return
+	}
+	c.Close(websocket.StatusNormalClosure, "")
+}
+
+// truncateCloseReason truncates a websocket close reason to the maximum allowed length.
+func truncateCloseReason(reason string) string {
+	const maxCloseReasonLength = 123
+	if len(reason) > maxCloseReasonLength {
+		return reason[:maxCloseReasonLength]
+	}
+	return reason
+}
+
+// handleSSE streams signed VAAs as Server-Sent Events.
+func (s *spyServer) handleSSE(w http.ResponseWriter, r *http.Request) {
+	req := s.httpSubscribeRequest(w, r)
+	if req == nil {
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+	rc := http.NewResponseController(w)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	// Sends may time out and continue in the background, so make sure nothing is written once the handler returned.
+	var mu sync.Mutex
+	done := false
+	defer func() {
+		mu.Lock()
+		done = true
+		mu.Unlock()
+	}()
+
+	write := func(event string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if done {
+			return errors.New("stream closed")
+		}
+		// Bound the write so that a stuck client cannot hold the lock indefinitely.
+		_ = rc.SetWriteDeadline(time.Now().Add(*sendTimeout))
+		if _, err := fmt.Fprint(w, event); err != nil {
+			return err
+		}
+		flusher.Flush()
+		return nil
+	}
+
+	err := s.SubscribeSignedVAA(req, &httpSubscribeStream{
+		ctx: r.Context(),
+		send: func(resp *spyv1.SubscribeSignedVAAResponse) error {
+			b, err := jsonMarshaler.Marshal(resp)
+			if err != nil {
+				return err
+			}
+			if resp.ResumeToken != "" {
+				return write(fmt.Sprintf("id: %s\ndata: %s\n\n", resp.ResumeToken, b))
+			}
+			return write(fmt.Sprintf("data: %s\n\n", b))
+		},
+	})
+
+	if err != nil && r.Context().Err() == nil {
+		_ = write(fmt.Sprintf("event: error\ndata: %s\n\n", status.Convert(err).Message()))
+	}
+}
+
+// spyHTTPServerRunnable returns a runnable serving the WebSocket and Server-Sent Events streams.
+func spyHTTPServerRunnable(s *spyServer, logger *zap.Logger, listenAddr string) (supervisor.Runnable, error) {
+	l, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen: %w", err)
+	}
+
+	logger.Info("spy HTTP server listening", zap.String("addr", l.Addr().String()))
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(wsStreamPath, s.handleWebSocket)
+	mux.HandleFunc(sseStreamPath, s.handleSSE)
+
+	srv := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 3 * time.Second,
+	}
+
+	return func(ctx context.Context) error {
+		supervisor.Signal(ctx, supervisor.SignalHealthy)
+		errC := make(chan error)
+		go func() {
+			errC <- srv.Serve(l)
+		}()
+		select {
+		case <-ctx.Done():
+			// non-graceful shutdown
+			if err := srv.Close(); err != nil {
+				return err
+			}
+			return ctx.Err()
+		case err := <-errC:
+			return err
+		}
+	}, nil
+}
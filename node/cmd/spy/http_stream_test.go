@@ -0,0 +1,136 @@
+package spy
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
+	spyv1 "github.com/certusone/wormhole/node/pkg/proto/spy/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"nhooyr.io/websocket"
+)
+
+func TestSubscribeRequestFromQuery(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, sseStreamPath+"?emitter_chain=2&emitter_chain=solana&emitter_address="+govEmitter.String()+
+		"&sequence_min=5&sequence_max=10&payload_prefix=0102&resume_from_timestamp=1700000000", nil)
+	req, err := subscribeRequestFromQuery(r)
+	require.NoError(t, err)
+	require.Len(t, req.Filters, 1)
+	f := req.Filters[0].GetVaaPropertiesFilter()
+	require.NotNil(t, f)
+	assert.Equal(t, []publicrpcv1.ChainID{publicrpcv1.ChainID(vaa.ChainIDEthereum), publicrpcv1.ChainID(vaa.ChainIDSolana)}, f.EmitterChains)
+	assert.Equal(t, []string{govEmitter.String()}, f.EmitterAddresses)
+	assert.Equal(t, uint64(5), f.SequenceMin)
+	assert.Equal(t, uint64(10), f.SequenceMax)
+	assert.Equal(t, []byte{1, 2}, f.PayloadPrefix)
+	assert.Equal(t, int64(1700000000), req.ResumeFromTimestamp)
+
+	// No parameters means no filters.
+	req, err = subscribeRequestFromQuery(httptest.NewRequest(http.MethodGet, sseStreamPath, nil))
+	require.NoError(t, err)
+	assert.Len(t, req.Filters, 0)
+
+	// The Last-Event-ID header takes precedence over the query parameter.
+	r = httptest.NewRequest(http.MethodGet, sseStreamPath+"?resume_token=1", nil)
+	r.Header.Set("Last-Event-ID", "2")
+	req, err = subscribeRequestFromQuery(r)
+	require.NoError(t, err)
+	assert.Equal(t, "2", req.ResumeToken)
+
+	for _, query := range []string{"emitter_chain=notachain", "sequence_min=abc", "payload_prefix=xyz", "resume_from_timestamp=abc"} {
+		_, err = subscribeRequestFromQuery(httptest.NewRequest(http.MethodGet, sseStreamPath+"?"+query, nil))
+		assert.Error(t, err, query)
+	}
+}
+
+func newHTTPTestServer(t *testing.T) (*spyServer, *httptest.Server) {
+	s := newSpyServer(zap.NewNop())
+	mux := http.NewServeMux()
+	mux.HandleFunc(wsStreamPath, s.handleWebSocket)
+	mux.HandleFunc(sseStreamPath, s.handleSSE)
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return s, srv
+}
+
+func TestSpyHTTPInvalidRequest(t *testing.T) {
+	_, srv := newHTTPTestServer(t)
+
+	resp, err := http.Get(srv.URL + sseStreamPath + "?emitter_address=nothex")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	// Resuming requires a replay buffer.
+	resp, err = http.Get(srv.URL + wsStreamPath + "?resume_token=1")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestSpySSE(t *testing.T) {
+	s, srv := newHTTPTestServer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+sseStreamPath+"?emitter_chain=ethereum", nil)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(httpReq)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	waitForClientSubscriptionInit(s)
+
+	// Filtered out.
+	b, err := getVAA(vaa.ChainIDSolana, govEmitter).Marshal()
+	require.NoError(t, err)
+	require.NoError(t, s.PublishSignedVAA(b))
+
+	expected, err := getVAA(vaa.ChainIDEthereum, govEmitter).Marshal()
+	require.NoError(t, err)
+	require.NoError(t, s.PublishSignedVAA(expected))
+
+	reader := bufio.NewReader(resp.Body)
+	line, err := reader.ReadString('\n')
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(line, "data: "), line)
+
+	var msg spyv1.SubscribeSignedVAAResponse
+	require.NoError(t, protojson.Unmarshal([]byte(strings.TrimSpace(strings.TrimPrefix(line, "data: "))), &msg))
+	assert.Equal(t, expected, msg.VaaBytes)
+}
+
+func TestSpyWebSocket(t *testing.T) {
+	s, srv := newHTTPTestServer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	c, _, err := websocket.Dial(ctx, "ws"+strings.TrimPrefix(srv.URL, "http")+wsStreamPath, nil)
+	require.NoError(t, err)
+	defer c.Close(websocket.StatusNormalClosure, "")
+
+	waitForClientSubscriptionInit(s)
+
+	expected, err := getVAA(vaa.ChainIDEthereum, govEmitter).Marshal()
+	require.NoError(t, err)
+	require.NoError(t, s.PublishSignedVAA(expected))
+
+	typ, data, err := c.Read(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, websocket.MessageText, typ)
+
+	var msg spyv1.SubscribeSignedVAAResponse
+	require.NoError(t, protojson.Unmarshal(data, &msg))
+	assert.Equal(t, expected, msg.VaaBytes)
+}
@@ -0,0 +1,309 @@
+package spy
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/dgraph-io/badger/v3"
+	"go.uber.org/zap"
+)
+
+// replayBufferKeyPrefix is the prefix of the keys of the VAAs stored in the replay buffer. It is followed by the big endian cursor.
+var replayBufferKeyPrefix = []byte("SPY:VAA:")
+
+// replayBufferPruneInterval is how often VAAs older than the retention period are removed from the replay buffer.
+const replayBufferPruneInterval = 10 * time.Minute
+
+// replayBatchSize is the maximum number of VAAs read from the replay buffer at once while replaying to a subscriber.
+const replayBatchSize = 1000
+
+// errResumeTokenExpired is returned when the VAAs after a resume token have already been pruned from the replay buffer.
+var errResumeTokenExpired = errors.New("resume token has expired from the replay buffer")
+
+// replayBuffer is a disk-backed buffer of the signed VAAs received over the last retention period. Each VAA is assigned a
+// monotonically increasing cursor, which allows subscribers to resume streaming after a disconnect without any gaps.
+type replayBuffer struct {
+	logger    *zap.Logger
+	db        *badger.DB
+	retention time.Duration
+
+	// nextCursor is the cursor that will be assigned to the next stored VAA. It is only incremented while holding
+	// the spy server subscription mutex, which serializes publishing, but may be read concurrently by subscribers.
+	nextCursor atomic.Uint64
+}
+
+// replayEntry is a VAA read from the replay buffer.
+type replayEntry struct {
+	cursor   uint64
+	received time.Time
+	vaaBytes []byte
+}
+
+// openReplayBuffer opens the replay buffer stored in dir. If dir is empty, the buffer is kept in memory.
+func openReplayBuffer(logger *zap.Logger, dir string, retention time.Duration) (*replayBuffer, error) {
+	options := badger.DefaultOptions(dir).WithLogger(nil)
+	if dir == "" {
+		options = options.WithInMemory(true)
+	}
+
+	db, err := badger.Open(options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open replay buffer: %w", err)
+	}
+
+	rb := &replayBuffer{
+		logger:    logger.Named("replaybuffer"),
+		db:        db,
+		retention: retention,
+	}
+	rb.nextCursor.Store(1)
+
+	// Continue after the newest stored VAA so that cursors remain valid across restarts.
+	err = db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Reverse = true
+		opts.PrefetchValues = false
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		it.Seek(replayBufferKey(^uint64(0)))
+		if it.ValidForPrefix(replayBufferKeyPrefix) {
+			rb.nextCursor.Store(replayBufferCursor(it.Item().Key()) + 1)
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to read the last replay buffer cursor: %w", err)
+	}
+
+	return rb, nil
+}
+
+// close closes the underlying database.
+func (rb *replayBuffer) close() error {
+	return rb.db.Close()
+}
+
+// replayBufferKey returns the database key of the VAA with the specified cursor.
+func replayBufferKey(cursor uint64) []byte {
+	key := make([]byte, len(replayBufferKeyPrefix)+8)
+	copy(key, replayBufferKeyPrefix)
+	binary.BigEndian.PutUint64(key[len(replayBufferKeyPrefix):], cursor)
+	return key
+}
+
+// replayBufferCursor returns the cursor encoded in a database key.
+func replayBufferCursor(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key[len(replayBufferKeyPrefix):])
+}
+
+// encodeReplayValue encodes the receipt time and the VAA bytes as a database value.
+func encodeReplayValue(received time.Time, vaaBytes []byte) []byte {
+	value := make([]byte, 8+len(vaaBytes))
+	binary.BigEndian.PutUint64(value, uint64(received.UnixNano()))
+	copy(value[8:], vaaBytes)
+	return value
+}
+
+// decodeReplayValue decodes a database value into the receipt time and the VAA bytes.
+func decodeReplayValue(value []byte) (time.Time, []byte, error) {
+	if len(value) < 8 {
+		return time.Time{}, nil, errors.New("replay buffer entry too short")
+	}
+	return time.Unix(0, int64(binary.BigEndian.Uint64(value[:8]))), value[8:], nil
+}
+
+// store adds a VAA to the buffer and returns its cursor. Calls must be serialized.
+func (rb *replayBuffer) store(vaaBytes []byte, received time.Time) (uint64, error) {
+	cursor := rb.nextCursor.Load()
+	value := encodeReplayValue(received, vaaBytes)
+
+	if err := rb.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(replayBufferKey(cursor), value)
+	}); err != nil {
+		return 0, fmt.Errorf("failed to store VAA in replay buffer: %w", err)
+	}
+
+	// Only advance the cursor once the VAA is committed, so that readers never see a cursor without its VAA.
+	rb.nextCursor.Store(cursor + 1)
+	return cursor, nil
+}
+
+// readAfter returns up to limit VAAs with a cursor greater than after, in order. A limit of zero means unlimited.
+// If VAAs after the cursor have already been pruned, errResumeTokenExpired is returned.
+func (rb *replayBuffer) readAfter(after uint64, limit int) ([]replayEntry, error) {
+	// Load this before reading so every VAA below it is guaranteed to be visible, unless pruned.
+	nextCursor := rb.nextCursor.Load()
+	entries := []replayEntry{}
+	err := rb.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+
+		first := true
+		for it.Seek(replayBufferKey(after + 1)); it.ValidForPrefix(replayBufferKeyPrefix); it.Next() {
+			if limit != 0 && len(entries) >= limit {
+				break
+			}
+
+			cursor := replayBufferCursor(it.Item().Key())
+			if first && cursor != after+1 && after != 0 {
+				return errResumeTokenExpired
+			}
+			first = false
+
+			value, err := it.Item().ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			received, vaaBytes, err := decodeReplayValue(value)
+			if err != nil {
+				return fmt.Errorf("invalid replay buffer entry for cursor %d: %w", cursor, err)
+			}
+
+			entries = append(entries, replayEntry{
+				cursor:   cursor,
+				received: received,
+				vaaBytes: vaaBytes,
+			})
+		}
+
+		if first && after+1 < nextCursor {
+			// Nothing was found although VAAs were stored after the cursor, so they must have been pruned.
+			return errResumeTokenExpired
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// cursorBefore returns the cursor after which all VAAs were received at or after the specified time.
+func (rb *replayBuffer) cursorBefore(t time.Time) (uint64, error) {
+	cursor := rb.oldestCursor() - 1
+	err := rb.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+
+		for it.Seek(replayBufferKeyPrefix); it.ValidForPrefix(replayBufferKeyPrefix); it.Next() {
+			value, err := it.Item().ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			received, _, err := decodeReplayValue(value)
+			if err != nil {
+				return err
+			}
+			if !received.Before(t) {
+				return nil
+			}
+			cursor = replayBufferCursor(it.Item().Key())
+		}
+		return nil
+	})
+	return cursor, err
+}
+
+// oldestCursor returns the cursor of the oldest VAA in the buffer, or the next cursor if the buffer is empty.
+func (rb *replayBuffer) oldestCursor() uint64 {
+	cursor := rb.nextCursor.Load()
+	_ = rb.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		it.Seek(replayBufferKeyPrefix)
+		if it.ValidForPrefix(replayBufferKeyPrefix) {
+			cursor = replayBufferCursor(it.Item().Key())
+		}
+		return nil
+	})
+	return cursor
+}
+
+// prune deletes the VAAs that were received before the retention period and returns the number deleted.
+func (rb *replayBuffer) prune(now time.Time) (int, error) {
+	cutoff := now.Add(-rb.retention)
+
+	keys := [][]byte{}
+	err := rb.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+
+		for it.Seek(replayBufferKeyPrefix); it.ValidForPrefix(replayBufferKeyPrefix); it.Next() {
+			value, err := it.Item().ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			// Invalid entries are pruned as well.
+			received, _, err := decodeReplayValue(value)
+			if err == nil && !received.Before(cutoff) {
+				// VAAs are stored in the order they are received, so the remaining ones are newer.
+				break
+			}
+			keys = append(keys, it.Item().KeyCopy(nil))
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to find expired VAAs in replay buffer: %w", err)
+	}
+
+	wb := rb.db.NewWriteBatch()
+	defer wb.Cancel()
+	for _, key := range keys {
+		if err := wb.Delete(key); err != nil {
+			return 0, fmt.Errorf("failed to delete expired VAA from replay buffer: %w", err)
+		}
+	}
+	if err := wb.Flush(); err != nil {
+		return 0, fmt.Errorf("failed to delete expired VAAs from replay buffer: %w", err)
+	}
+
+	return len(keys), nil
+}
+
+// runPruner periodically prunes the buffer until the context is canceled.
+func (rb *replayBuffer) runPruner(ctx context.Context) {
+	ticker := time.NewTicker(replayBufferPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			count, err := rb.prune(time.Now())
+			if err != nil {
+				rb.logger.Error("failed to prune replay buffer", zap.Error(err))
+				continue
+			}
+			if count != 0 {
+				rb.logger.Info("pruned replay buffer", zap.Int("count", count))
+			}
+		}
+	}
+}
+
+// encodeResumeToken returns the resume token for a cursor.
+func encodeResumeToken(cursor uint64) string {
+	return strconv.FormatUint(cursor, 16)
+}
+
+// decodeResumeToken returns the cursor of a resume token.
+func decodeResumeToken(token string) (uint64, error) {
+	cursor, err := strconv.ParseUint(token, 16, 64)
+	if err != nil {
+		return 0, errors.New("invalid resume token")
+	}
+	return cursor, nil
+}
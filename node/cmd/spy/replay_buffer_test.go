@@ -0,0 +1,199 @@
+package spy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
+	spyv1 "github.com/certusone/wormhole/node/pkg/proto/spy/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReplayBufferStoreAndRead(t *testing.T) {
+	rb, err := openReplayBuffer(zap.NewNop(), "", time.Hour)
+	require.NoError(t, err)
+	defer rb.close()
+
+	now := time.Unix(1700000000, 0)
+	for i := 0; i < 5; i++ {
+		cursor, err := rb.store([]byte{byte(i)}, now.Add(time.Duration(i)*time.Minute))
+		require.NoError(t, err)
+		assert.Equal(t, uint64(i+1), cursor)
+	}
+
+	entries, err := rb.readAfter(0, 0)
+	require.NoError(t, err)
+	require.Len(t, entries, 5)
+	for i, e := range entries {
+		assert.Equal(t, uint64(i+1), e.cursor)
+		assert.Equal(t, []byte{byte(i)}, e.vaaBytes)
+		assert.True(t, now.Add(time.Duration(i)*time.Minute).Equal(e.received))
+	}
+
+	entries, err = rb.readAfter(2, 2)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, uint64(3), entries[0].cursor)
+	assert.Equal(t, uint64(4), entries[1].cursor)
+
+	entries, err = rb.readAfter(5, 0)
+	require.NoError(t, err)
+	assert.Len(t, entries, 0)
+
+	cursor, err := rb.cursorBefore(now.Add(2 * time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), cursor)
+
+	cursor, err = rb.cursorBefore(now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), cursor)
+}
+
+func TestReplayBufferPrune(t *testing.T) {
+	rb, err := openReplayBuffer(zap.NewNop(), "", time.Hour)
+	require.NoError(t, err)
+	defer rb.close()
+
+	now := time.Unix(1700000000, 0)
+	for i := 0; i < 4; i++ {
+		_, err := rb.store([]byte{byte(i)}, now.Add(time.Duration(i)*time.Hour))
+		require.NoError(t, err)
+	}
+
+	count, err := rb.prune(now.Add(2*time.Hour + time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, uint64(3), rb.oldestCursor())
+
+	// Resuming after a pruned VAA should fail rather than silently skip VAAs.
+	_, err = rb.readAfter(1, 0)
+	assert.ErrorIs(t, err, errResumeTokenExpired)
+
+	entries, err := rb.readAfter(2, 0)
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+
+	// Resuming from a time before the oldest VAA should start at the oldest VAA.
+	cursor, err := rb.cursorBefore(now)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), cursor)
+
+	// Once everything is pruned, resuming from the last cursor is still valid.
+	count, err = rb.prune(now.Add(10 * time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	_, err = rb.readAfter(4, 0)
+	require.NoError(t, err)
+	_, err = rb.readAfter(3, 0)
+	assert.ErrorIs(t, err, errResumeTokenExpired)
+}
+
+func TestReplayBufferCursorSurvivesRestart(t *testing.T) {
+	dir := t.TempDir()
+	rb, err := openReplayBuffer(zap.NewNop(), dir, time.Hour)
+	require.NoError(t, err)
+	_, err = rb.store([]byte{1}, time.Now())
+	require.NoError(t, err)
+	_, err = rb.store([]byte{2}, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, rb.close())
+
+	rb, err = openReplayBuffer(zap.NewNop(), dir, time.Hour)
+	require.NoError(t, err)
+	defer rb.close()
+	cursor, err := rb.store([]byte{3}, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), cursor)
+}
+
+func TestResumeToken(t *testing.T) {
+	cursor, err := decodeResumeToken(encodeResumeToken(123456789))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(123456789), cursor)
+
+	_, err = decodeResumeToken("not a token")
+	assert.Error(t, err)
+}
+
+// mockSubscribeStream implements spyv1.SpyRPCService_SubscribeSignedVAAServer for tests.
+type mockSubscribeStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *spyv1.SubscribeSignedVAAResponse
+}
+
+func (m *mockSubscribeStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockSubscribeStream) Send(r *spyv1.SubscribeSignedVAAResponse) error {
+	m.sent <- r
+	return nil
+}
+
+func TestSpyResumeFromReplayBuffer(t *testing.T) {
+	s := newSpyServer(zap.NewNop())
+	var err error
+	s.replayBuffer, err = openReplayBuffer(zap.NewNop(), "", time.Hour)
+	require.NoError(t, err)
+	defer s.replayBuffer.close()
+
+	publish := func(sequence uint64, chainID vaa.ChainID) []byte {
+		v := getVAA(chainID, govEmitter)
+		v.Sequence = sequence
+		b, err := v.Marshal()
+		require.NoError(t, err)
+		require.NoError(t, s.PublishSignedVAA(b))
+		return b
+	}
+
+	// These are published before anyone subscribes.
+	first := publish(1, vaa.ChainIDEthereum)
+	publish(2, vaa.ChainIDSolana)
+	third := publish(3, vaa.ChainIDEthereum)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &mockSubscribeStream{ctx: ctx, sent: make(chan *spyv1.SubscribeSignedVAAResponse, 10)}
+	req := &spyv1.SubscribeSignedVAARequest{
+		ResumeToken: encodeResumeToken(0),
+		Filters: []*spyv1.FilterEntry{{Filter: &spyv1.FilterEntry_VaaPropertiesFilter{
+			VaaPropertiesFilter: &spyv1.VAAPropertiesFilter{EmitterChains: []publicrpcv1.ChainID{publicrpcv1.ChainID(vaa.ChainIDEthereum)}},
+		}}},
+	}
+
+	errC := make(chan error, 1)
+	go func() { errC <- s.SubscribeSignedVAA(req, stream) }()
+
+	// The buffered VAAs matching the filter should be replayed in order.
+	r := <-stream.sent
+	assert.Equal(t, first, r.VaaBytes)
+	assert.Equal(t, encodeResumeToken(1), r.ResumeToken)
+	r = <-stream.sent
+	assert.Equal(t, third, r.VaaBytes)
+	assert.Equal(t, encodeResumeToken(3), r.ResumeToken)
+
+	// Followed by the live ones.
+	waitForClientSubscriptionInit(s)
+	fourth := publish(4, vaa.ChainIDEthereum)
+	r = <-stream.sent
+	assert.Equal(t, fourth, r.VaaBytes)
+	assert.Equal(t, encodeResumeToken(4), r.ResumeToken)
+
+	cancel()
+	assert.ErrorIs(t, <-errC, context.Canceled)
+}
+
+func TestSpyResumeRequiresReplayBuffer(t *testing.T) {
+	s := newSpyServer(zap.NewNop())
+	stream := &mockSubscribeStream{ctx: context.Background(), sent: make(chan *spyv1.SubscribeSignedVAAResponse, 1)}
+	err := s.SubscribeSignedVAA(&spyv1.SubscribeSignedVAARequest{ResumeToken: encodeResumeToken(1)}, stream)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
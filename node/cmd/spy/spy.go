@@ -1,12 +1,14 @@
 package spy
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"net"
 	"net/http"
 	"os"
+	"slices"
 	"sync"
 	"time"
 
@@ -46,12 +48,16 @@ var (
 
 	logLevel *string
 
-	spyRPC *string
+	spyRPC  *string
+	spyHTTP *string
 
 	sendTimeout *time.Duration
 
 	ethRPC      *string
 	ethContract *string
+
+	replayBufferPath      *string
+	replayBufferRetention *time.Duration
 )
 
 func init() {
@@ -67,11 +73,15 @@ func init() {
 	logLevel = SpyCmd.Flags().String("logLevel", "info", "Logging level (debug, info, warn, error, dpanic, panic, fatal)")
 
 	spyRPC = SpyCmd.Flags().String("spyRPC", "", "Listen address for gRPC interface")
+	spyHTTP = SpyCmd.Flags().String("spyHTTP", "", "Listen address for WebSocket and Server-Sent Events interface (disabled if blank)")
 
 	sendTimeout = SpyCmd.Flags().Duration("sendTimeout", 5*time.Second, "Timeout for sending a message to a subscriber")
 
 	ethRPC = SpyCmd.Flags().String("ethRPC", "", "Ethereum RPC for verifying VAAs (optional)")
 	ethContract = SpyCmd.Flags().String("ethContract", "", "Ethereum core bridge address for verifying VAAs (required if ethRPC is specified)")
+
+	replayBufferPath = SpyCmd.Flags().String("replayBufferPath", "", "Directory in which to buffer signed VAAs so that subscribers can resume after a disconnect (optional)")
+	replayBufferRetention = SpyCmd.Flags().Duration("replayBufferRetention", 24*time.Hour, "How long signed VAAs are kept in the replay buffer")
 }
 
 // SpyCmd represents the node command
@@ -87,21 +97,86 @@ type spyServer struct {
 	subsSignedVaa   map[string]*subscriptionSignedVaa
 	subsSignedVaaMu sync.Mutex
 	vaaVerifier     *VaaVerifier
+	replayBuffer    *replayBuffer
 }
 
 type message struct {
 	vaaBytes []byte
+	// cursor is the position of the VAA in the replay buffer, or zero if it is not buffered.
+	cursor uint64
 }
 
+// filterSignedVaa matches VAAs that satisfy all of its conditions. Empty conditions are not checked.
 type filterSignedVaa struct {
-	chainId     vaa.ChainID
-	emitterAddr vaa.Address
+	chainIds      []vaa.ChainID
+	emitterAddrs  []vaa.Address
+	sequenceMin   uint64
+	sequenceMax   uint64 // Zero means unbounded.
+	payloadPrefix []byte
+}
+
+// matches returns true if the VAA satisfies all conditions of the filter.
+func (fi *filterSignedVaa) matches(v *vaa.VAA) bool {
+	if len(fi.chainIds) != 0 && !slices.Contains(fi.chainIds, v.EmitterChain) {
+		return false
+	}
+	if len(fi.emitterAddrs) != 0 && !slices.Contains(fi.emitterAddrs, v.EmitterAddress) {
+		return false
+	}
+	if v.Sequence < fi.sequenceMin {
+		return false
+	}
+	if fi.sequenceMax != 0 && v.Sequence > fi.sequenceMax {
+		return false
+	}
+	return bytes.HasPrefix(v.Payload, fi.payloadPrefix)
 }
+
+// newVaaPropertiesFilter converts a VAAPropertiesFilter request into a filterSignedVaa.
+func newVaaPropertiesFilter(f *spyv1.VAAPropertiesFilter) (filterSignedVaa, error) {
+	if f.SequenceMax != 0 && f.SequenceMax < f.SequenceMin {
+		return filterSignedVaa{}, errors.New("sequence_max may not be less than sequence_min")
+	}
+
+	fi := filterSignedVaa{
+		sequenceMin:   f.SequenceMin,
+		sequenceMax:   f.SequenceMax,
+		payloadPrefix: f.PayloadPrefix,
+	}
+
+	for _, c := range f.EmitterChains {
+		fi.chainIds = append(fi.chainIds, vaa.ChainID(c))
+	}
+
+	for _, a := range f.EmitterAddresses {
+		addr, err := vaa.StringToAddress(a)
+		if err != nil {
+			return filterSignedVaa{}, fmt.Errorf("failed to decode emitter address: %w", err)
+		}
+		fi.emitterAddrs = append(fi.emitterAddrs, addr)
+	}
+
+	return fi, nil
+}
+
 type subscriptionSignedVaa struct {
 	filters []filterSignedVaa
 	ch      chan message
 }
 
+// matches returns true if the subscription has no filters or the VAA matches any of them.
+func (sub *subscriptionSignedVaa) matches(v *vaa.VAA) bool {
+	if len(sub.filters) == 0 {
+		return true
+	}
+	for _, fi := range sub.filters {
+		if fi.matches(v) {
+			return true
+		}
+	}
+	return false
+}
+
 func subscriptionId() string {
 	return uuid.New().String()
 }
@@ -114,6 +189,23 @@ func (s *spyServer) PublishSignedVAA(vaaBytes []byte) error {
 	var err error
 	verified := s.vaaVerifier == nil
 
+	msg := message{vaaBytes: vaaBytes}
+	if s.replayBuffer != nil {
+		// Only verified VAAs may be buffered since replayed VAAs are not verified again.
+		if !verified {
+			verified = true
+			v, err = s.verifyVAA(v, vaaBytes)
+			if err != nil {
+				return err
+			}
+		}
+		msg.cursor, err = s.replayBuffer.store(vaaBytes, time.Now())
+		if err != nil {
+			// Keep publishing to the live subscribers, only resuming will be affected.
+			s.logger.Error("failed to store VAA in replay buffer", zap.Error(err))
+		}
+	}
+
 	for _, sub := range s.subsSignedVaa {
 		if len(sub.filters) == 0 {
 			if !verified {
@@ -123,7 +215,7 @@ func (s *spyServer) PublishSignedVAA(vaaBytes []byte) error {
 					return err
 				}
 			}
-			sub.ch <- message{vaaBytes: vaaBytes}
+			sub.ch <- msg
 			continue
 		}
 
@@ -135,7 +227,7 @@ func (s *spyServer) PublishSignedVAA(vaaBytes []byte) error {
 		}
 
 		for _, fi := range sub.filters {
-			if fi.chainId == v.EmitterChain && fi.emitterAddr == v.EmitterAddress {
+			if fi.matches(v) {
 				if !verified {
 					verified = true
 					v, err = s.verifyVAA(v, vaaBytes)
@@ -143,7 +235,9 @@ func (s *spyServer) PublishSignedVAA(vaaBytes []byte) error {
 						return err
 					}
 				}
-				sub.ch <- message{vaaBytes: vaaBytes}
+				sub.ch <- msg
+				// The filters are ORed, so only send the VAA once.
+				break
 			}
 		}
 
@@ -178,31 +272,52 @@ func (s *spyServer) verifyVAA(v *vaa.VAA, vaaBytes []byte) (*vaa.VAA, error) {
 }
 
 func (s *spyServer) SubscribeSignedVAA(req *spyv1.SubscribeSignedVAARequest, resp spyv1.SpyRPCService_SubscribeSignedVAAServer) error {
-	var fi []filterSignedVaa
-	if req.Filters != nil {
-		for _, f := range req.Filters {
-			switch t := f.Filter.(type) {
-			case *spyv1.FilterEntry_EmitterFilter:
-				addr, err := vaa.StringToAddress(t.EmitterFilter.EmitterAddress)
-				if err != nil {
-					return status.Error(codes.InvalidArgument, fmt.Sprintf("failed to decode emitter address: %v", err))
-				}
-				fi = append(fi, filterSignedVaa{
-					chainId:     vaa.ChainID(t.EmitterFilter.ChainId),
-					emitterAddr: addr,
-				})
-			default:
-				return status.Error(codes.InvalidArgument, "unsupported filter type")
-			}
-		}
+	fi, err := parseFilters(req)
+	if err != nil {
+		return err
+	}
+
+	after, resuming, err := s.resumeCursor(req)
+	if err != nil {
+		return err
 	}
 
-	s.subsSignedVaaMu.Lock()
-	id := subscriptionId()
 	sub := &subscriptionSignedVaa{
 		ch:      make(chan message, 1),
 		filters: fi,
 	}
+
+	if resuming {
+		// Replay the bulk of the buffer without blocking the publishing of new VAAs.
+		for {
+			entries, err := s.replayBuffer.readAfter(after, replayBatchSize)
+			if err != nil {
+				return replayError(err)
+			}
+			if err := sendReplayed(resp, sub, entries); err != nil {
+				return err
+			}
+			if len(entries) != 0 {
+				after = entries[len(entries)-1].cursor
+			}
+			if len(entries) < replayBatchSize {
+				break
+			}
+		}
+	}
+
+	s.subsSignedVaaMu.Lock()
+	var pending []replayEntry
+	if resuming {
+		// Publishing is blocked while we hold the lock, so whatever was buffered since the replay above can be
+		// read here to hand over to the live stream without any gaps or duplicates.
+		pending, err = s.replayBuffer.readAfter(after, 0)
+		if err != nil {
+			s.subsSignedVaaMu.Unlock()
+			return replayError(err)
+		}
+	}
+	id := subscriptionId()
 	s.subsSignedVaa[id] = sub
 	s.subsSignedVaaMu.Unlock()
 
@@ -220,20 +335,114 @@ func (s *spyServer) SubscribeSignedVAA(req *spyv1.SubscribeSignedVAARequest, res
 		}
 	}()
 
+	if err := sendReplayed(resp, sub, pending); err != nil {
+		return err
+	}
+
 	for {
 		select {
 		case <-resp.Context().Done():
 			return resp.Context().Err()
 		case msg := <-sub.ch:
-			if err := DoWithTimeout(func() error {
-				return resp.Send(&spyv1.SubscribeSignedVAAResponse{VaaBytes: msg.vaaBytes})
-			}, *sendTimeout); err != nil {
+			if err := sendSignedVAA(resp, msg); err != nil {
 				return err
 			}
 		}
 	}
 }
 
+// parseFilters converts the filters of a subscription request.
+func parseFilters(req *spyv1.SubscribeSignedVAARequest) ([]filterSignedVaa, error) {
+	var fi []filterSignedVaa
+	for _, f := range req.Filters {
+		switch t := f.Filter.(type) {
+		case *spyv1.FilterEntry_EmitterFilter:
+			addr, err := vaa.StringToAddress(t.EmitterFilter.EmitterAddress)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("failed to decode emitter address: %v", err))
+			}
+			fi = append(fi, filterSignedVaa{
+				chainIds:     []vaa.ChainID{vaa.ChainID(t.EmitterFilter.ChainId)},
+				emitterAddrs: []vaa.Address{addr},
+			})
+		case *spyv1.FilterEntry_VaaPropertiesFilter:
+			if t.VaaPropertiesFilter == nil {
+				return nil, status.Error(codes.InvalidArgument, "vaa properties filter may not be nil")
+			}
+			f, err := newVaaPropertiesFilter(t.VaaPropertiesFilter)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid vaa properties filter: %v", err))
+			}
+			fi = append(fi, f)
+		default:
+			return nil, status.Error(codes.InvalidArgument, "unsupported filter type")
+		}
+	}
+	return fi, nil
+}
+
+// resumeCursor returns the replay buffer cursor after which the subscription should be resumed, if requested.
+func (s *spyServer) resumeCursor(req *spyv1.SubscribeSignedVAARequest) (uint64, bool, error) {
+	if req.ResumeToken == "" && req.ResumeFromTimestamp == 0 {
+		return 0, false, nil
+	}
+	if s.replayBuffer == nil {
+		return 0, false, status.Error(codes.FailedPrecondition, "resuming requires the spy to run with a replay buffer")
+	}
+	if req.ResumeToken != "" && req.ResumeFromTimestamp != 0 {
+		return 0, false, status.Error(codes.InvalidArgument, "resume_token and resume_from_timestamp may not both be specified")
+	}
+
+	if req.ResumeToken != "" {
+		cursor, err := decodeResumeToken(req.ResumeToken)
+		if err != nil {
+			return 0, false, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return cursor, true, nil
+	}
+
+	cursor, err := s.replayBuffer.cursorBefore(time.Unix(req.ResumeFromTimestamp, 0))
+	if err != nil {
+		return 0, false, status.Error(codes.Internal, fmt.Sprintf("failed to read replay buffer: %v", err))
+	}
+	return cursor, true, nil
+}
+
+// replayError converts an error reading the replay buffer into a grpc error.
+func replayError(err error) error {
+	if errors.Is(err, errResumeTokenExpired) {
+		return status.Error(codes.OutOfRange, err.Error())
+	}
+	return status.Error(codes.Internal, fmt.Sprintf("failed to read replay buffer: %v", err))
+}
+
+// sendReplayed sends the VAAs read from the replay buffer that match the subscription.
+func sendReplayed(resp spyv1.SpyRPCService_SubscribeSignedVAAServer, sub *subscriptionSignedVaa, entries []replayEntry) error {
+	for _, e := range entries {
+		if len(sub.filters) != 0 {
+			v, err := vaa.Unmarshal(e.vaaBytes)
+			if err != nil || !sub.matches(v) {
+				continue
+			}
+		}
+		if err := sendSignedVAA(resp, message{vaaBytes: e.vaaBytes, cursor: e.cursor}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sendSignedVAA sends a VAA to the subscriber, along with its resume token if it is buffered.
+func sendSignedVAA(resp spyv1.SpyRPCService_SubscribeSignedVAAServer, msg message) error {
+	r := &spyv1.SubscribeSignedVAAResponse{VaaBytes: msg.vaaBytes}
+	if msg.cursor != 0 {
+		r.ResumeToken = encodeResumeToken(msg.cursor)
+	}
+	return DoWithTimeout(func() error {
+		return resp.Send(r)
+	}, *sendTimeout)
+}
+
 func newSpyServer(logger *zap.Logger) *spyServer {
 	return &spyServer{
 		logger:        logger.Named("spyserver"),
@@ -350,6 +559,15 @@ func runSpy(cmd *cobra.Command, args []string) {
 		logger.Fatal("failed to start RPC server", zap.Error(err))
 	}
 
+	// HTTP server (optional)
+	var httpSvc supervisor.Runnable
+	if *spyHTTP != "" {
+		httpSvc, err = spyHTTPServerRunnable(s, logger, *spyHTTP)
+		if err != nil {
+			logger.Fatal("failed to start HTTP server", zap.Error(err))
+		}
+	}
+
 	// VAA verifier (optional)
 	if *ethRPC != "" {
 		if *ethContract == "" {
@@ -361,6 +579,19 @@ func runSpy(cmd *cobra.Command, args []string) {
 		}
 	}
 
+	// Replay buffer (optional)
+	if *replayBufferPath != "" {
+		if *replayBufferRetention <= 0 {
+			logger.Fatal(`"--replayBufferRetention" must be positive`)
+		}
+		s.replayBuffer, err = openReplayBuffer(logger, *replayBufferPath, *replayBufferRetention)
+		if err != nil {
+			logger.Fatal("Failed to open replay buffer", zap.Error(err))
+		}
+		defer s.replayBuffer.close()
+		go s.replayBuffer.runPruner(rootCtx)
+	}
+
 	// Log signed VAAs
 	go func() {
 		for {
@@ -411,6 +642,12 @@ func runSpy(cmd *cobra.Command, args []string) {
 			return err
 		}
 
+		if httpSvc != nil {
+			if err := supervisor.Run(ctx, "spyhttp", httpSvc); err != nil {
+				return err
+			}
+		}
+
 		logger.Info("Started internal services")
 
 		<-ctx.Done()
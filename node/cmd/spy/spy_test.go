@@ -47,6 +47,13 @@ func getVAA(chainID vaa.ChainID, emitterAddr vaa.Address) *vaa.VAA {
 	return vaa
 }
 
+// helper method for *vaa.VAA creation with a specific sequence number
+func getVAAWithSequence(sequence uint64) *vaa.VAA {
+	v := getVAA(vaa.ChainIDEthereum, govEmitter)
+	v.Sequence = sequence
+	return v
+}
+
 // wait for the server to establish a client subscription before returning.
 func waitForClientSubscriptionInit(server *spyServer) {
 	for {
@@ -294,3 +301,79 @@ func TestSpyHandleEmitterFilter(t *testing.T) {
 
 	<-doneCh
 }
+
+// Tests the matching of the filters built from a spyv1.VAAPropertiesFilter
+func TestVaaPropertiesFilterMatches(t *testing.T) {
+	otherEmitter := vaa.Address{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}
+
+	type test struct {
+		label    string
+		filter   *spyv1.VAAPropertiesFilter
+		vaa      *vaa.VAA
+		expected bool
+	}
+
+	// getVAA returns a VAA with sequence 1 and payload "aaaaaa".
+	tests := []test{
+		{label: "empty filter", filter: &spyv1.VAAPropertiesFilter{}, vaa: getVAA(vaa.ChainIDEthereum, govEmitter), expected: true},
+		{
+			label:    "chain in list",
+			filter:   &spyv1.VAAPropertiesFilter{EmitterChains: []publicrpcv1.ChainID{publicrpcv1.ChainID(vaa.ChainIDSolana), publicrpcv1.ChainID(vaa.ChainIDEthereum)}},
+			vaa:      getVAA(vaa.ChainIDEthereum, govEmitter),
+			expected: true,
+		},
+		{
+			label:    "chain not in list",
+			filter:   &spyv1.VAAPropertiesFilter{EmitterChains: []publicrpcv1.ChainID{publicrpcv1.ChainID(vaa.ChainIDSolana)}},
+			vaa:      getVAA(vaa.ChainIDEthereum, govEmitter),
+			expected: false,
+		},
+		{
+			label:    "emitter in list",
+			filter:   &spyv1.VAAPropertiesFilter{EmitterAddresses: []string{otherEmitter.String(), govEmitter.String()}},
+			vaa:      getVAA(vaa.ChainIDEthereum, govEmitter),
+			expected: true,
+		},
+		{
+			label:    "emitter not in list",
+			filter:   &spyv1.VAAPropertiesFilter{EmitterAddresses: []string{otherEmitter.String()}},
+			vaa:      getVAA(vaa.ChainIDEthereum, govEmitter),
+			expected: false,
+		},
+		{
+			label:    "chain matches but emitter does not",
+			filter:   &spyv1.VAAPropertiesFilter{EmitterChains: []publicrpcv1.ChainID{publicrpcv1.ChainID(vaa.ChainIDEthereum)}, EmitterAddresses: []string{otherEmitter.String()}},
+			vaa:      getVAA(vaa.ChainIDEthereum, govEmitter),
+			expected: false,
+		},
+		{label: "sequence in range", filter: &spyv1.VAAPropertiesFilter{SequenceMin: 1, SequenceMax: 1}, vaa: getVAA(vaa.ChainIDEthereum, govEmitter), expected: true},
+		{label: "sequence below range", filter: &spyv1.VAAPropertiesFilter{SequenceMin: 2}, vaa: getVAA(vaa.ChainIDEthereum, govEmitter), expected: false},
+		{label: "sequence above range", filter: &spyv1.VAAPropertiesFilter{SequenceMax: 50}, vaa: getVAAWithSequence(100), expected: false},
+		{label: "sequence unbounded above", filter: &spyv1.VAAPropertiesFilter{SequenceMin: 50}, vaa: getVAAWithSequence(100), expected: true},
+		{label: "payload prefix matches", filter: &spyv1.VAAPropertiesFilter{PayloadPrefix: []byte("aaa")}, vaa: getVAA(vaa.ChainIDEthereum, govEmitter), expected: true},
+		{label: "payload prefix does not match", filter: &spyv1.VAAPropertiesFilter{PayloadPrefix: []byte("aab")}, vaa: getVAA(vaa.ChainIDEthereum, govEmitter), expected: false},
+		{label: "payload prefix longer than payload", filter: &spyv1.VAAPropertiesFilter{PayloadPrefix: []byte("aaaaaaa")}, vaa: getVAA(vaa.ChainIDEthereum, govEmitter), expected: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.label, func(t *testing.T) {
+			fi, err := newVaaPropertiesFilter(tc.filter)
+			if err != nil {
+				t.Fatalf("newVaaPropertiesFilter failed: %v", err)
+			}
+			if fi.matches(tc.vaa) != tc.expected {
+				t.Fatalf("expected match to be %v", tc.expected)
+			}
+		})
+	}
+}
+
+// Tests that invalid spyv1.VAAPropertiesFilters are rejected
+func TestVaaPropertiesFilterInvalid(t *testing.T) {
+	if _, err := newVaaPropertiesFilter(&spyv1.VAAPropertiesFilter{SequenceMin: 10, SequenceMax: 5}); err == nil {
+		t.Fatal("expected an error for an invalid sequence range")
+	}
+	if _, err := newVaaPropertiesFilter(&spyv1.VAAPropertiesFilter{EmitterAddresses: []string{"not hex"}}); err == nil {
+		t.Fatal("expected an error for an invalid emitter address")
+	}
+}
@@ -85,7 +85,6 @@ type Accountant struct {
 	enforceFlag          bool
 	guardianSigner       guardiansigner.GuardianSigner
 	gst                  *common.GuardianSetState
-	guardianAddr         ethCommon.Address
 	msgChan              chan<- *common.MessagePublication
 	tokenBridges         validEmitters
 	pendingTransfersLock sync.Mutex
@@ -95,6 +94,7 @@ type Accountant struct {
 
 	nttContract       string
 	nttWormchainConn  AccountantWormchainConn
+	nttEnforceFlag    bool
 	nttDirectEmitters validEmitters
 	nttArEmitters     validEmitters
 	nttSubChan        chan *common.MessagePublication
@@ -108,6 +108,12 @@ func (acct *Accountant) baseEnabled() bool {
 	return acct.contract != ""
 }
 
+// guardianAddress returns the address of the key the guardian currently signs with, which can change during a
+// guardian key rotation.
+func (acct *Accountant) guardianAddress() ethCommon.Address {
+	return ethCrypto.PubkeyToAddress(acct.guardianSigner.PublicKey(acct.ctx))
+}
+
 // NewAccountant creates a new instance of the Accountant object.
 func NewAccountant(
 	ctx context.Context,
@@ -120,6 +126,7 @@ func NewAccountant(
 	enforceFlag bool, // whether or not accountant should be enforced
 	nttContract string, // the address of the NTT smart contract on wormchain
 	nttWormchainConn AccountantWormchainConn, // used for communicating with the NTT smart contract
+	nttEnforceFlag bool, // whether or not NTT accounting should be enforced for emitters configured to be enforced
 	guardianSigner guardiansigner.GuardianSigner, // the guardian signer used for signing observation requests
 	gst *common.GuardianSetState, // used to get the current guardian set index when sending observation requests
 	msgChan chan<- *common.MessagePublication, // the channel where transfers received by the accountant runnable should be published
@@ -136,7 +143,6 @@ func NewAccountant(
 		enforceFlag:      enforceFlag,
 		guardianSigner:   guardianSigner,
 		gst:              gst,
-		guardianAddr:     ethCrypto.PubkeyToAddress(guardianSigner.PublicKey(ctx)),
 		msgChan:          msgChan,
 		tokenBridges:     make(validEmitters),
 		pendingTransfers: make(map[string]*pendingEntry),
@@ -145,6 +151,7 @@ func NewAccountant(
 
 		nttContract:       nttContract,
 		nttWormchainConn:  nttWormchainConn,
+		nttEnforceFlag:    nttEnforceFlag,
 		nttDirectEmitters: make(validEmitters),
 		nttArEmitters:     make(validEmitters),
 		nttSubChan:        make(chan *common.MessagePublication, subChanSize),
@@ -153,7 +160,7 @@ func NewAccountant(
 
 // Start initializes the accountant and starts the worker and watcher runnables.
 func (acct *Accountant) Start(ctx context.Context) error {
-	acct.logger.Debug("entering Start", zap.Bool("enforceFlag", acct.enforceFlag), zap.Bool("baseEnabled", acct.baseEnabled()), zap.Bool("nttEnabled", acct.nttEnabled()))
+	acct.logger.Debug("entering Start", zap.Bool("enforceFlag", acct.enforceFlag), zap.Bool("baseEnabled", acct.baseEnabled()), zap.Bool("nttEnabled", acct.nttEnabled()), zap.Bool("nttEnforceFlag", acct.nttEnforceFlag))
 	acct.pendingTransfersLock.Lock()
 	defer acct.pendingTransfersLock.Unlock()
 
@@ -246,7 +253,11 @@ func (acct *Accountant) FeatureString() string {
 		if ret != "" {
 			ret += ":"
 		}
-		ret += "ntt-acct"
+		if !acct.nttEnforceFlag {
+			ret += "ntt-acct-logonly"
+		} else {
+			ret += "ntt-acct"
+		}
 	}
 
 	return ret
@@ -374,7 +385,7 @@ func (acct *Accountant) addPendingTransferAlreadyLocked(pe *pendingEntry) error
 	}
 
 	acct.pendingTransfers[pe.msgId] = pe
-	transfersOutstanding.Set(float64(len(acct.pendingTransfers)))
+	acct.updateOutstandingMetricsAlreadyLocked()
 	return nil
 }
 
@@ -390,7 +401,7 @@ func (acct *Accountant) deletePendingTransferAlreadyLocked(msgId string) {
 	acct.logger.Debug("deletePendingTransfer", zap.String("msgId", msgId))
 	if _, exists := acct.pendingTransfers[msgId]; exists {
 		delete(acct.pendingTransfers, msgId)
-		transfersOutstanding.Set(float64(len(acct.pendingTransfers)))
+		acct.updateOutstandingMetricsAlreadyLocked()
 	}
 	if err := acct.db.AcctDeletePendingTransfer(msgId); err != nil {
 		acct.logger.Error("failed to delete pending transfer from the db", zap.String("msgId", msgId), zap.Error(err))
@@ -398,6 +409,20 @@ func (acct *Accountant) deletePendingTransferAlreadyLocked(msgId string) {
 	}
 }
 
+// updateOutstandingMetricsAlreadyLocked updates the outstanding transfer metrics. The base metric counts all pending transfers,
+// while the NTT metric only counts NTT transfers. It assumes the caller holds the lock.
+func (acct *Accountant) updateOutstandingMetricsAlreadyLocked() {
+	numNtt := 0
+	for _, pe := range acct.pendingTransfers {
+		if pe.isNTT {
+			numNtt++
+		}
+	}
+
+	transfersOutstanding.Set(float64(len(acct.pendingTransfers)))
+	nttTransfersOutstanding.Set(float64(numNtt))
+}
+
 // loadPendingTransfers loads any pending transfers that are present in the database. This method assumes the caller holds the lock.
 func (acct *Accountant) loadPendingTransfers() error {
 	pendingTransfers, err := acct.db.AcctGetData(acct.logger)
@@ -424,7 +449,7 @@ func (acct *Accountant) loadPendingTransfers() error {
 		acct.pendingTransfers[msgId] = pe
 	}
 
-	transfersOutstanding.Set(float64(len(acct.pendingTransfers)))
+	acct.updateOutstandingMetricsAlreadyLocked()
 	if len(acct.pendingTransfers) != 0 {
 		acct.logger.Info("reloaded pending transfers", zap.Int("total", len(acct.pendingTransfers)))
 	} else {
@@ -126,6 +126,7 @@ func newAccountantForTest(
 		accountantCheckEnabled,
 		"",
 		nil,
+		false,
 		guardianSigner,
 		gst,
 		acctWriteC,
@@ -0,0 +1,86 @@
+package accountant
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// Status returns a description of the transfers currently pending in the accountant, with token bridge and NTT transfers listed separately.
+// It grabs the lock.
+func (acct *Accountant) Status() string {
+	acct.pendingTransfersLock.Lock()
+	defer acct.pendingTransfersLock.Unlock()
+
+	var base, ntt []*pendingEntry
+	for _, pe := range acct.pendingTransfers {
+		if pe.isNTT {
+			ntt = append(ntt, pe)
+		} else {
+			base = append(base, pe)
+		}
+	}
+
+	var sb strings.Builder
+	if acct.baseEnabled() {
+		acct.statusSection(&sb, "accountant", acct.enforceFlag, base)
+	}
+	if acct.nttEnabled() {
+		acct.statusSection(&sb, "ntt-accountant", acct.nttEnforceFlag, ntt)
+	}
+	return sb.String()
+}
+
+// statusSection writes the status of one flavor of the accountant to the string builder. It assumes the caller holds the lock.
+func (acct *Accountant) statusSection(sb *strings.Builder, tag string, enforceFlag bool, entries []*pendingEntry) {
+	sort.Slice(entries, func(i, j int) bool { return entries[i].msgId < entries[j].msgId })
+	fmt.Fprintf(sb, "%s: enforcing: %t, pending transfers: %d\n", tag, enforceFlag, len(entries))
+	for _, pe := range entries {
+		fmt.Fprintf(sb, "   %s digest: %s enforcing: %t submitPending: %t updTime: %s\n",
+			pe.msgId, pe.digest, pe.enforceFlag, pe.submitPending(), pe.updTime().String())
+	}
+}
+
+// DropPendingTransfer removes a transfer from the pending list without publishing it. It can be used to clear a transfer that
+// will never be approved by the contract. If the transfer is observed again, it will be resubmitted. It grabs the lock.
+func (acct *Accountant) DropPendingTransfer(msgId string) (string, error) {
+	acct.pendingTransfersLock.Lock()
+	defer acct.pendingTransfersLock.Unlock()
+
+	pe, exists := acct.pendingTransfers[msgId]
+	if !exists {
+		return "", fmt.Errorf("transfer %s is not pending in the accountant", msgId)
+	}
+
+	acct.logger.Info("admin: dropping pending transfer", zap.String("msgId", msgId), zap.Bool("isNTT", pe.isNTT))
+	acct.deletePendingTransferAlreadyLocked(msgId)
+	adminOverrides.WithLabelValues("drop").Inc()
+	return fmt.Sprintf("dropped %s transfer %s", pe.tag(), msgId), nil
+}
+
+// ReleasePendingTransfer publishes a pending transfer without waiting for it to be approved by the contract, and removes it from the
+// pending list. Transfers that are not being enforced have already been published, so they are only removed. It grabs the lock.
+func (acct *Accountant) ReleasePendingTransfer(msgId string) (string, error) {
+	acct.pendingTransfersLock.Lock()
+	defer acct.pendingTransfersLock.Unlock()
+
+	pe, exists := acct.pendingTransfers[msgId]
+	if !exists {
+		return "", fmt.Errorf("transfer %s is not pending in the accountant", msgId)
+	}
+
+	acct.logger.Info("admin: releasing pending transfer", zap.String("msgId", msgId), zap.Bool("isNTT", pe.isNTT), zap.Bool("enforcing", pe.enforceFlag))
+	acct.publishTransferAlreadyLocked(pe)
+	adminOverrides.WithLabelValues("release").Inc()
+	return fmt.Sprintf("released %s transfer %s", pe.tag(), msgId), nil
+}
+
+// tag returns the name of the accountant handling this pending transfer, for use in messages.
+func (pe *pendingEntry) tag() string {
+	if pe.isNTT {
+		return "ntt-accountant"
+	}
+	return "accountant"
+}
@@ -0,0 +1,129 @@
+package accountant
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
+	"github.com/certusone/wormhole/node/pkg/devnet"
+	"github.com/certusone/wormhole/node/pkg/guardiansigner"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// newNttAccountantForTest creates an accountant with only NTT accounting enabled.
+func newNttAccountantForTest(t *testing.T, ctx context.Context, nttEnforceFlag bool, acctWriteC chan<- *common.MessagePublication) *Accountant {
+	var db db.MockAccountantDB
+
+	pk := devnet.InsecureDeterministicEcdsaKeyByIndex(ethCrypto.S256(), uint64(0))
+	guardianSigner, err := guardiansigner.GenerateSignerWithPrivatekeyUnsafe(pk)
+	require.NoError(t, err)
+
+	gst := common.NewGuardianSetState(nil)
+	gst.Set(&common.GuardianSet{Keys: []ethCommon.Address{ethCommon.HexToAddress("0xbeFA429d57cD18b7F8A4d91A2da9AB4AF05d0FBe")}})
+
+	acct := NewAccountant(
+		ctx,
+		zap.NewNop(),
+		&db,
+		make(chan *gossipv1.ObservationRequest, 10),
+		"",     // accountantContract
+		"none", // accountantWS
+		nil,
+		false,
+		"0xdeadbeef", // accountantNttContract
+		nil,
+		nttEnforceFlag,
+		guardianSigner,
+		gst,
+		acctWriteC,
+		common.GoTest,
+	)
+
+	require.NoError(t, acct.Start(ctx))
+	return acct
+}
+
+// buildNttMsgForTest creates a direct NTT transfer from one of the devnet NTT emitters.
+func buildNttMsgForTest(t *testing.T) *common.MessagePublication {
+	emitterAddr, err := vaa.StringToAddress("000000000000000000000000855FA758c77D68a04990E992aA4dcdeF899F654A")
+	require.NoError(t, err)
+
+	payload, err := hex.DecodeString(goodPayload)
+	require.NoError(t, err)
+
+	return &common.MessagePublication{
+		TxHash:           hashFromString("0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4063"),
+		Timestamp:        time.Unix(int64(1654543099), 0),
+		Nonce:            uint32(42),
+		Sequence:         uint64(123456),
+		EmitterChain:     vaa.ChainIDEthereum,
+		EmitterAddress:   emitterAddr,
+		ConsistencyLevel: uint8(0),
+		Payload:          payload,
+	}
+}
+
+func TestNttTransferNotBlockedWhenNttEnforcementDisabled(t *testing.T) {
+	acctChan := make(chan *common.MessagePublication, 10)
+	acct := newNttAccountantForTest(t, context.Background(), false, acctChan)
+	assert.Contains(t, acct.FeatureString(), "ntt-acct-logonly")
+
+	msg := buildNttMsgForTest(t)
+	shouldPublish, err := acct.SubmitObservation(msg)
+	require.NoError(t, err)
+	assert.True(t, shouldPublish)
+
+	pe, exists := acct.pendingTransfers[msg.MessageIDString()]
+	require.True(t, exists)
+	assert.True(t, pe.isNTT)
+	assert.False(t, pe.enforceFlag)
+	assert.Contains(t, acct.Status(), "ntt-accountant: enforcing: false, pending transfers: 1")
+}
+
+func TestDropPendingNttTransfer(t *testing.T) {
+	acctChan := make(chan *common.MessagePublication, 10)
+	acct := newNttAccountantForTest(t, context.Background(), true, acctChan)
+
+	msg := buildNttMsgForTest(t)
+	shouldPublish, err := acct.SubmitObservation(msg)
+	require.NoError(t, err)
+	assert.False(t, shouldPublish)
+	assert.Contains(t, acct.Status(), "ntt-accountant: enforcing: true, pending transfers: 1")
+
+	_, err = acct.DropPendingTransfer("2/0000000000000000000000000000000000000000000000000000000000000000/1")
+	assert.ErrorContains(t, err, "is not pending in the accountant")
+
+	resp, err := acct.DropPendingTransfer(msg.MessageIDString())
+	require.NoError(t, err)
+	assert.Equal(t, "dropped ntt-accountant transfer "+msg.MessageIDString(), resp)
+	assert.Equal(t, 0, len(acct.pendingTransfers))
+	assert.Equal(t, 0, len(acctChan))
+}
+
+func TestReleasePendingNttTransfer(t *testing.T) {
+	acctChan := make(chan *common.MessagePublication, 10)
+	acct := newNttAccountantForTest(t, context.Background(), true, acctChan)
+
+	msg := buildNttMsgForTest(t)
+	shouldPublish, err := acct.SubmitObservation(msg)
+	require.NoError(t, err)
+	assert.False(t, shouldPublish)
+
+	resp, err := acct.ReleasePendingTransfer(msg.MessageIDString())
+	require.NoError(t, err)
+	assert.Equal(t, "released ntt-accountant transfer "+msg.MessageIDString(), resp)
+	assert.Equal(t, 0, len(acct.pendingTransfers))
+	require.Equal(t, 1, len(acctChan))
+	assert.Equal(t, msg.MessageIDString(), (<-acctChan).MessageIDString())
+}
@@ -283,7 +283,7 @@ func (acct *Accountant) queryMissingObservations(wormchainConn AccountantWormcha
 		return nil, fmt.Errorf("failed to get guardian set")
 	}
 
-	guardianIndex, found := gs.KeyIndex(acct.guardianAddr)
+	guardianIndex, found := gs.KeyIndex(acct.guardianAddress())
 	if !found {
 		return nil, fmt.Errorf("failed to get guardian index")
 	}
@@ -22,6 +22,26 @@ var (
 			Name: "global_accountant_transfer_vaas_submitted_and_approved",
 			Help: "Total number of accountant transfer vaas that were submitted and approved",
 		})
+	nttTransfersOutstanding = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "global_accountant_ntt_transfer_vaas_outstanding",
+			Help: "Current number of NTT accountant transfer vaas in the pending state",
+		})
+	nttTransfersSubmitted = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "global_accountant_ntt_transfer_vaas_submitted",
+			Help: "Total number of NTT accountant transfer vaas submitted",
+		})
+	nttTransfersApproved = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "global_accountant_ntt_transfer_vaas_submitted_and_approved",
+			Help: "Total number of NTT accountant transfer vaas that were submitted and approved",
+		})
+	adminOverrides = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "global_accountant_admin_overrides_total",
+			Help: "Total number of pending accountant transfers dropped or released by an admin command",
+		}, []string{"action"})
 	eventsReceived = promauto.NewCounter(
 		prometheus.CounterOpts{
 			Name: "global_accountant_events_received",
@@ -37,6 +57,11 @@ var (
 			Name: "global_accountant_submit_failures",
 			Help: "Total number of accountant transfer vaas submit failures",
 		})
+	submitRetries = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "global_accountant_submit_retries_total",
+			Help: "Total number of times a batch of accountant observations was submitted again after its broadcast failed",
+		})
 	balanceErrors = promauto.NewCounter(
 		prometheus.CounterOpts{
 			Name: "global_accountant_total_balance_errors",
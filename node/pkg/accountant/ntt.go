@@ -26,6 +26,13 @@ func (acct *Accountant) nttStart(ctx context.Context) error {
 		return fmt.Errorf("failed to set up NTT emitters: %w", err)
 	}
 
+	// If NTT accounting is not being enforced, all emitters are monitored in log only mode, regardless of their configuration.
+	if !acct.nttEnforceFlag {
+		for emitter := range acct.nttDirectEmitters {
+			acct.nttDirectEmitters[emitter] = false
+		}
+	}
+
 	for emitter, enforceFlag := range acct.nttDirectEmitters {
 		tag := ""
 		if !enforceFlag {
@@ -15,6 +15,7 @@ import (
 
 	wasmdtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 
 	"go.uber.org/zap"
@@ -23,6 +24,19 @@ import (
 const batchSize = 10
 const delayInMS = 100 * time.Millisecond
 
+// A batch whose broadcast failed is retried a few times with an exponential backoff before it is left to the audit.
+const maxSubmitAttempts = 3
+const initialSubmitRetryDelay = time.Second
+
+var (
+	// errBroadcastFailed is returned when the tx could not be broadcast to wormchain, for instance because the connection failed.
+	errBroadcastFailed = errors.New("failed to send broadcast")
+
+	// errSequenceMismatch is returned when wormchain rejected the tx because its account sequence number was wrong. The
+	// connection queries the account again for the next tx, so submitting again should succeed.
+	errSequenceMismatch = errors.New("account sequence mismatch")
+)
+
 // baseWorker is the entry point for the base accountant worker.
 func (acct *Accountant) baseWorker(ctx context.Context) error {
 	return acct.worker(ctx, false)
@@ -52,7 +66,7 @@ func (acct *Accountant) worker(ctx context.Context, isNTT bool) error {
 		case <-ctx.Done():
 			return nil
 		default:
-			if err := acct.handleBatch(ctx, subChan, wormchainConn, contract, prefix, tag); err != nil {
+			if err := acct.handleBatch(ctx, subChan, wormchainConn, contract, prefix, tag, isNTT); err != nil {
 				return err
 			}
 		}
@@ -61,7 +75,7 @@ func (acct *Accountant) worker(ctx context.Context, isNTT bool) error {
 
 // handleBatch reads a batch of events from the channel, either until a timeout occurs or the batch is full,
 // and submits them to the smart contract.
-func (acct *Accountant) handleBatch(ctx context.Context, subChan chan *common.MessagePublication, wormchainConn AccountantWormchainConn, contract string, prefix []byte, tag string) error {
+func (acct *Accountant) handleBatch(ctx context.Context, subChan chan *common.MessagePublication, wormchainConn AccountantWormchainConn, contract string, prefix []byte, tag string, isNTT bool) error {
 	ctx, cancel := context.WithTimeout(ctx, delayInMS)
 	defer cancel()
 
@@ -83,13 +97,16 @@ func (acct *Accountant) handleBatch(ctx context.Context, subChan chan *common.Me
 		return fmt.Errorf("failed to get guardian set for %s", tag)
 	}
 
-	guardianIndex, found := gs.KeyIndex(acct.guardianAddr)
+	guardianIndex, found := gs.KeyIndex(acct.guardianAddress())
 	if !found {
 		return fmt.Errorf("failed to get guardian index for %s", tag)
 	}
 
 	acct.submitObservationsToContract(msgs, gs.Index, uint32(guardianIndex), wormchainConn, contract, prefix, tag)
 	transfersSubmitted.Add(float64(len(msgs)))
+	if isNTT {
+		nttTransfersSubmitted.Add(float64(len(msgs)))
+	}
 	return nil
 }
 
@@ -198,7 +215,7 @@ func (sb SignatureBytes) MarshalJSON() ([]byte, error) {
 // submitObservationsToContract makes a call to the smart contract to submit a batch of observation requests.
 // It should be called from a go routine because it can block.
 func (acct *Accountant) submitObservationsToContract(msgs []*common.MessagePublication, gsIndex uint32, guardianIndex uint32, wormchainConn AccountantWormchainConn, contract string, prefix []byte, tag string) {
-	txResp, err := SubmitObservationsToContract(acct.ctx, acct.logger, acct.guardianSigner, gsIndex, guardianIndex, wormchainConn, contract, prefix, msgs)
+	txResp, err := acct.submitObservationsWithRetry(msgs, gsIndex, guardianIndex, wormchainConn, contract, prefix, tag)
 	if err != nil {
 		// This means the whole batch failed. They will all get retried the next audit cycle.
 		acct.logger.Error(fmt.Sprintf("failed to submit any observations in batch to %s", tag), zap.Int("numMsgs", len(msgs)), zap.Error(err))
@@ -265,6 +282,39 @@ func (acct *Accountant) submitObservationsToContract(msgs []*common.MessagePubli
 	acct.clearSubmitPendingFlags(msgs)
 }
 
+// submitObservationsWithRetry submits a batch of observations to the smart contract. If the broadcast failed in a way
+// that may succeed when tried again, it is retried up to maxSubmitAttempts times, doubling the delay after each attempt.
+func (acct *Accountant) submitObservationsWithRetry(msgs []*common.MessagePublication, gsIndex uint32, guardianIndex uint32, wormchainConn AccountantWormchainConn, contract string, prefix []byte, tag string) (*sdktx.BroadcastTxResponse, error) {
+	delay := initialSubmitRetryDelay
+	for attempt := 1; ; attempt++ {
+		txResp, err := SubmitObservationsToContract(acct.ctx, acct.logger, acct.guardianSigner, gsIndex, guardianIndex, wormchainConn, contract, prefix, msgs)
+		if err == nil || attempt >= maxSubmitAttempts || !isRetryableSubmitError(err) {
+			return txResp, err
+		}
+
+		submitRetries.Inc()
+		acct.logger.Warn(fmt.Sprintf("failed to submit batch to %s, will retry", tag),
+			zap.Int("numMsgs", len(msgs)),
+			zap.Int("attempt", attempt),
+			zap.Stringer("delay", delay),
+			zap.Error(err),
+		)
+
+		select {
+		case <-acct.ctx.Done():
+			return txResp, err
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// isRetryableSubmitError returns true if submitting the batch again may succeed. Errors returned by the contract are
+// not retried, as the same batch would fail again.
+func isRetryableSubmitError(err error) bool {
+	return errors.Is(err, errBroadcastFailed) || errors.Is(err, errSequenceMismatch)
+}
+
 // handleCommittedTransfer updates the pending map and publishes a committed transfer. It grabs the lock.
 func (acct *Accountant) handleCommittedTransfer(msgId string) {
 	acct.pendingTransfersLock.Lock()
@@ -274,6 +324,9 @@ func (acct *Accountant) handleCommittedTransfer(msgId string) {
 		acct.logger.Info("transfer has been committed, publishing it", zap.String("msgId", msgId))
 		acct.publishTransferAlreadyLocked(pe)
 		transfersApproved.Inc()
+		if pe.isNTT {
+			nttTransfersApproved.Inc()
+		}
 	} else {
 		acct.logger.Debug("transfer has been committed but it is no longer in our map", zap.String("msgId", msgId))
 	}
@@ -380,7 +433,7 @@ func SubmitObservationsToContract(
 	start := time.Now()
 	txResp, err := wormchainConn.SignAndBroadcastTx(ctx, &subMsg)
 	if err != nil {
-		return txResp, fmt.Errorf("failed to send broadcast: %w", err)
+		return txResp, fmt.Errorf("%w: %w", errBroadcastFailed, err)
 	}
 
 	if txResp == nil {
@@ -391,6 +444,10 @@ func SubmitObservationsToContract(
 		return txResp, fmt.Errorf("sent broadcast but returned txResp.TxResponse is nil")
 	}
 
+	if txResp.TxResponse.Codespace == sdkerrors.ErrWrongSequence.Codespace() && txResp.TxResponse.Code == sdkerrors.ErrWrongSequence.ABCICode() {
+		return txResp, fmt.Errorf("%w: %s", errSequenceMismatch, txResp.TxResponse.RawLog)
+	}
+
 	if txResp.TxResponse.RawLog == "" {
 		return txResp, fmt.Errorf("sent broadcast but raw_log is not set, unable to analyze the result")
 	}
@@ -3,6 +3,8 @@ package accountant
 import (
 	// "encoding/hex"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
@@ -65,3 +67,9 @@ func TestParseObservationResponseData(t *testing.T) {
 	assert.Equal(t, expectedResult0, responses[0])
 	assert.Equal(t, expectedResult1, responses[1])
 }
+
+func TestIsRetryableSubmitError(t *testing.T) {
+	assert.True(t, isRetryableSubmitError(fmt.Errorf("%w: %w", errBroadcastFailed, errors.New("connection refused"))))
+	assert.True(t, isRetryableSubmitError(fmt.Errorf("%w: %s", errSequenceMismatch, "account sequence mismatch, expected 5, got 4")))
+	assert.False(t, isRetryableSubmitError(errors.New("sent broadcast but raw_log is not set, unable to analyze the result")))
+}
@@ -210,6 +210,9 @@ func (acct *Accountant) processPendingTransfer(xfer *WasmObservation, tag string
 		acct.logger.Info("acctwatch: pending transfer has been approved", zap.String("msgId", msgId))
 		acct.publishTransferAlreadyLocked(pe)
 		transfersApproved.Inc()
+		if pe.isNTT {
+			nttTransfersApproved.Inc()
+		}
 	} else {
 		// TODO: We could issue a reobservation request here since it looks like other guardians have seen this transfer but we haven't.
 		acct.logger.Info("acctwatch: unknown transfer has been approved, ignoring it", zap.String("msgId", msgId))
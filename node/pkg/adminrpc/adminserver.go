@@ -19,16 +19,19 @@ import (
 	"time"
 
 	"github.com/certusone/wormhole/node/pkg/guardiansigner"
+	"github.com/certusone/wormhole/node/pkg/watchers"
 	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors"
 	"github.com/holiman/uint256"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"golang.org/x/exp/slices"
 
+	"github.com/certusone/wormhole/node/pkg/accountant"
 	"github.com/certusone/wormhole/node/pkg/db"
 	"github.com/certusone/wormhole/node/pkg/governor"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/mr-tron/base58"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -54,17 +57,20 @@ var (
 
 type nodePrivilegedService struct {
 	nodev1.UnimplementedNodePrivilegedServiceServer
-	db              *db.Database
-	injectC         chan<- *common.MessagePublication
-	obsvReqSendC    chan<- *gossipv1.ObservationRequest
-	logger          *zap.Logger
-	signedInC       chan<- *gossipv1.SignedVAAWithQuorum
-	governor        *governor.ChainGovernor
-	evmConnector    connectors.Connector
-	gsCache         sync.Map
-	guardianSigner  guardiansigner.GuardianSigner
-	guardianAddress ethcommon.Address
-	rpcMap          map[string]string
+	db             *db.Database
+	injectC        chan<- *common.MessagePublication
+	obsvReqSendC   chan<- *gossipv1.ObservationRequest
+	logger         *zap.Logger
+	signedInC      chan<- *gossipv1.SignedVAAWithQuorum
+	governor       *governor.ChainGovernor
+	accountant     *accountant.Accountant
+	evmConnector   connectors.Connector
+	gsCache        sync.Map
+	guardianSigner guardiansigner.GuardianSigner
+	rpcMap         map[string]string
+	conflicts      *common.ObservationConflicts
+	gst            *common.GuardianSetState
+	backfillers    map[vaa.ChainID]watchers.Backfiller
 }
 
 func NewPrivService(
@@ -74,26 +80,37 @@ func NewPrivService(
 	logger *zap.Logger,
 	signedInC chan<- *gossipv1.SignedVAAWithQuorum,
 	governor *governor.ChainGovernor,
+	accountant *accountant.Accountant,
 	evmConnector connectors.Connector,
 	guardianSigner guardiansigner.GuardianSigner,
-	guardianAddress ethcommon.Address,
 	rpcMap map[string]string,
-
+	conflicts *common.ObservationConflicts,
+	gst *common.GuardianSetState,
+	backfillers map[vaa.ChainID]watchers.Backfiller,
 ) *nodePrivilegedService {
 	return &nodePrivilegedService{
-		db:              db,
-		injectC:         injectC,
-		obsvReqSendC:    obsvReqSendC,
-		logger:          logger,
-		signedInC:       signedInC,
-		governor:        governor,
-		evmConnector:    evmConnector,
-		guardianSigner:  guardianSigner,
-		guardianAddress: guardianAddress,
-		rpcMap:          rpcMap,
+		db:             db,
+		injectC:        injectC,
+		obsvReqSendC:   obsvReqSendC,
+		logger:         logger,
+		signedInC:      signedInC,
+		governor:       governor,
+		accountant:     accountant,
+		evmConnector:   evmConnector,
+		guardianSigner: guardianSigner,
+		rpcMap:         rpcMap,
+		conflicts:      conflicts,
+		gst:            gst,
+		backfillers:    backfillers,
 	}
 }
 
+// guardianAddress returns the address of the active guardian key. It is derived on every call since a dual key
+// signer switches to the new key when a guardian set containing it is activated.
+func (s *nodePrivilegedService) guardianAddress(ctx context.Context) ethcommon.Address {
+	return ethcrypto.PubkeyToAddress(s.guardianSigner.PublicKey(ctx))
+}
+
 // adminGuardianSetUpdateToVAA converts a nodev1.GuardianSetUpdate message to its canonical VAA representation.
 // Returns an error if the data is invalid.
 func adminGuardianSetUpdateToVAA(req *nodev1.GuardianSetUpdate, timestamp time.Time, guardianSetIndex uint32, nonce uint32, sequence uint64) (*vaa.VAA, error) {
@@ -146,7 +163,7 @@ func adminContractUpgradeToVAA(req *nodev1.ContractUpgrade, timestamp time.Time,
 		return nil, errors.New("invalid new_contract address")
 	}
 
-	if req.ChainId > math.MaxUint16 {
+	if _, err := vaa.ChainIDFromNumber(req.ChainId); err != nil {
 		return nil, errors.New("invalid chain_id")
 	}
 
@@ -169,7 +186,7 @@ func adminContractUpgradeToVAA(req *nodev1.ContractUpgrade, timestamp time.Time,
 // tokenBridgeRegisterChain converts a nodev1.TokenBridgeRegisterChain message to its canonical VAA representation.
 // Returns an error if the data is invalid.
 func tokenBridgeRegisterChain(req *nodev1.BridgeRegisterChain, timestamp time.Time, guardianSetIndex uint32, nonce uint32, sequence uint64) (*vaa.VAA, error) {
-	if req.ChainId > math.MaxUint16 {
+	if _, err := vaa.ChainIDFromNumber(req.ChainId); err != nil {
 		return nil, errors.New("invalid chain_id")
 	}
 
@@ -214,7 +231,7 @@ func recoverChainId(req *nodev1.RecoverChainId, timestamp time.Time, guardianSet
 		return nil, errors.New("evm_chain_id overflow")
 	}
 
-	if req.NewChainId > math.MaxUint16 {
+	if _, err := vaa.ChainIDFromNumber(req.NewChainId); err != nil {
 		return nil, errors.New("invalid new_chain_id")
 	}
 
@@ -235,13 +252,13 @@ func recoverChainId(req *nodev1.RecoverChainId, timestamp time.Time, guardianSet
 // accountantModifyBalance converts a nodev1.AccountantModifyBalance message to its canonical VAA representation.
 // Returns an error if the data is invalid.
 func accountantModifyBalance(req *nodev1.AccountantModifyBalance, timestamp time.Time, guardianSetIndex uint32, nonce uint32, sequence uint64) (*vaa.VAA, error) {
-	if req.TargetChainId > math.MaxUint16 {
+	if _, err := vaa.ChainIDFromNumber(req.TargetChainId); err != nil {
 		return nil, errors.New("invalid target_chain_id")
 	}
-	if req.ChainId > math.MaxUint16 {
+	if _, err := vaa.ChainIDFromNumber(req.ChainId); err != nil {
 		return nil, errors.New("invalid chain_id")
 	}
-	if req.TokenChain > math.MaxUint16 {
+	if _, err := vaa.ChainIDFromNumber(req.TokenChain); err != nil {
 		return nil, errors.New("invalid token_chain")
 	}
 
@@ -297,7 +314,7 @@ func accountantModifyBalance(req *nodev1.AccountantModifyBalance, timestamp time
 // tokenBridgeUpgradeContract converts a nodev1.TokenBridgeRegisterChain message to its canonical VAA representation.
 // Returns an error if the data is invalid.
 func tokenBridgeUpgradeContract(req *nodev1.BridgeUpgradeContract, timestamp time.Time, guardianSetIndex uint32, nonce uint32, sequence uint64) (*vaa.VAA, error) {
-	if req.TargetChainId > math.MaxUint16 {
+	if _, err := vaa.ChainIDFromNumber(req.TargetChainId); err != nil {
 		return nil, errors.New("invalid target_chain_id")
 	}
 
@@ -493,7 +510,7 @@ func gatewayIbcComposabilityMwSetContract(
 // circleIntegrationUpdateWormholeFinality converts a nodev1.CircleIntegrationUpdateWormholeFinality to its canonical VAA representation
 // Returns an error if the data is invalid
 func circleIntegrationUpdateWormholeFinality(req *nodev1.CircleIntegrationUpdateWormholeFinality, timestamp time.Time, guardianSetIndex uint32, nonce uint32, sequence uint64) (*vaa.VAA, error) {
-	if req.TargetChainId > math.MaxUint16 {
+	if _, err := vaa.ChainIDFromNumber(req.TargetChainId); err != nil {
 		return nil, fmt.Errorf("invalid target chain id, must be <= %d", math.MaxUint16)
 	}
 	if req.Finality > math.MaxUint8 {
@@ -516,10 +533,10 @@ func circleIntegrationUpdateWormholeFinality(req *nodev1.CircleIntegrationUpdate
 // circleIntegrationRegisterEmitterAndDomain converts a nodev1.CircleIntegrationRegisterEmitterAndDomain to its canonical VAA representation
 // Returns an error if the data is invalid
 func circleIntegrationRegisterEmitterAndDomain(req *nodev1.CircleIntegrationRegisterEmitterAndDomain, timestamp time.Time, guardianSetIndex uint32, nonce uint32, sequence uint64) (*vaa.VAA, error) {
-	if req.TargetChainId > math.MaxUint16 {
+	if _, err := vaa.ChainIDFromNumber(req.TargetChainId); err != nil {
 		return nil, fmt.Errorf("invalid target chain id, must be <= %d", math.MaxUint16)
 	}
-	if req.ForeignEmitterChainId > math.MaxUint16 {
+	if _, err := vaa.ChainIDFromNumber(req.ForeignEmitterChainId); err != nil {
 		return nil, fmt.Errorf("invalid foreign emitter chain id, must be <= %d", math.MaxUint16)
 	}
 	b, err := hex.DecodeString(req.ForeignEmitterAddress)
@@ -552,7 +569,7 @@ func circleIntegrationRegisterEmitterAndDomain(req *nodev1.CircleIntegrationRegi
 // circleIntegrationUpgradeContractImplementation converts a nodev1.CircleIntegrationUpgradeContractImplementation to its canonical VAA representation
 // Returns an error if the data is invalid
 func circleIntegrationUpgradeContractImplementation(req *nodev1.CircleIntegrationUpgradeContractImplementation, timestamp time.Time, guardianSetIndex uint32, nonce uint32, sequence uint64) (*vaa.VAA, error) {
-	if req.TargetChainId > math.MaxUint16 {
+	if _, err := vaa.ChainIDFromNumber(req.TargetChainId); err != nil {
 		return nil, fmt.Errorf("invalid target chain id, must be <= %d", math.MaxUint16)
 	}
 	b, err := hex.DecodeString(req.NewImplementationAddress)
@@ -588,11 +605,11 @@ func ibcUpdateChannelChain(
 	sequence uint64,
 ) (*vaa.VAA, error) {
 	// validate parameters
-	if req.TargetChainId > math.MaxUint16 {
+	if _, err := vaa.ChainIDFromNumber(req.TargetChainId); err != nil {
 		return nil, fmt.Errorf("invalid target chain id, must be <= %d", math.MaxUint16)
 	}
 
-	if req.ChainId > math.MaxUint16 {
+	if _, err := vaa.ChainIDFromNumber(req.ChainId); err != nil {
 		return nil, fmt.Errorf("invalid chain id, must be <= %d", math.MaxUint16)
 	}
 
@@ -630,7 +647,7 @@ func ibcUpdateChannelChain(
 // wormholeRelayerSetDefaultDeliveryProvider converts a nodev1.WormholeRelayerSetDefaultDeliveryProvider message to its canonical VAA representation.
 // Returns an error if the data is invalid.
 func wormholeRelayerSetDefaultDeliveryProvider(req *nodev1.WormholeRelayerSetDefaultDeliveryProvider, timestamp time.Time, guardianSetIndex uint32, nonce uint32, sequence uint64) (*vaa.VAA, error) {
-	if req.ChainId > math.MaxUint16 {
+	if _, err := vaa.ChainIDFromNumber(req.ChainId); err != nil {
 		return nil, errors.New("invalid target_chain_id")
 	}
 
@@ -714,6 +731,31 @@ func solanaCallToVaa(solanaCall *nodev1.SolanaCall, timestamp time.Time, guardia
 	return v, nil
 }
 
+// governorChainConfigUpdate converts a nodev1.GovernorChainConfigUpdate message to its canonical VAA representation.
+// Returns an error if the data is invalid.
+func governorChainConfigUpdate(req *nodev1.GovernorChainConfigUpdate, timestamp time.Time, guardianSetIndex uint32, nonce uint32, sequence uint64) (*vaa.VAA, error) {
+	if _, err := vaa.ChainIDFromNumber(req.EmitterChain); err != nil {
+		return nil, errors.New("invalid emitter_chain")
+	}
+
+	if req.DailyLimit == 0 && req.BigTransactionSize != 0 {
+		return nil, errors.New("big_transaction_size must be zero if daily_limit is zero")
+	}
+
+	body, err := vaa.BodyGovernorChainConfigUpdate{
+		EmitterChain:       vaa.ChainID(req.EmitterChain),
+		DailyLimit:         req.DailyLimit,
+		BigTransactionSize: req.BigTransactionSize,
+	}.Serialize()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize governance body: %w", err)
+	}
+
+	v := vaa.CreateGovernanceVAA(timestamp, nonce, sequence, guardianSetIndex, body)
+	return v, nil
+}
+
 func GovMsgToVaa(message *nodev1.GovernanceMessage, currentSetIndex uint32, timestamp time.Time) (*vaa.VAA, error) {
 	var (
 		v   *vaa.VAA
@@ -761,6 +803,8 @@ func GovMsgToVaa(message *nodev1.GovernanceMessage, currentSetIndex uint32, time
 		v, err = evmCallToVaa(payload.EvmCall, timestamp, currentSetIndex, message.Nonce, message.Sequence)
 	case *nodev1.GovernanceMessage_SolanaCall:
 		v, err = solanaCallToVaa(payload.SolanaCall, timestamp, currentSetIndex, message.Nonce, message.Sequence)
+	case *nodev1.GovernanceMessage_GovernorChainConfigUpdate:
+		v, err = governorChainConfigUpdate(payload.GovernorChainConfigUpdate, timestamp, currentSetIndex, message.Nonce, message.Sequence)
 	default:
 		err = fmt.Errorf("unsupported VAA type: %T", payload)
 	}
@@ -954,6 +998,16 @@ func (s *nodePrivilegedService) FindMissingMessages(ctx context.Context, req *no
 }
 
 func (s *nodePrivilegedService) SendObservationRequest(ctx context.Context, req *nodev1.SendObservationRequestRequest) (*nodev1.SendObservationRequestResponse, error) {
+	if req.ObservationRequest == nil {
+		return nil, errors.New("missing observation_request")
+	}
+	if req.ObservationRequest.ChainId == 0 || req.ObservationRequest.ChainId > math.MaxUint16 {
+		return nil, errors.New("invalid chain_id")
+	}
+	if len(req.ObservationRequest.TxHash) == 0 {
+		return nil, errors.New("missing tx_hash")
+	}
+
 	if err := common.PostObservationRequest(s.obsvReqSendC, req.ObservationRequest); err != nil {
 		return nil, err
 	}
@@ -1048,6 +1102,54 @@ func (s *nodePrivilegedService) ChainGovernorResetReleaseTimer(ctx context.Conte
 	}, nil
 }
 
+func (s *nodePrivilegedService) AccountantStatus(ctx context.Context, req *nodev1.AccountantStatusRequest) (*nodev1.AccountantStatusResponse, error) {
+	if s.accountant == nil {
+		return nil, fmt.Errorf("accountant is not enabled")
+	}
+
+	return &nodev1.AccountantStatusResponse{
+		Response: s.accountant.Status(),
+	}, nil
+}
+
+func (s *nodePrivilegedService) AccountantDropPendingTransfer(ctx context.Context, req *nodev1.AccountantDropPendingTransferRequest) (*nodev1.AccountantDropPendingTransferResponse, error) {
+	if s.accountant == nil {
+		return nil, fmt.Errorf("accountant is not enabled")
+	}
+
+	if len(req.MsgId) == 0 {
+		return nil, fmt.Errorf("the message id must be specified as \"chainId/emitterAddress/seqNum\"")
+	}
+
+	resp, err := s.accountant.DropPendingTransfer(req.MsgId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &nodev1.AccountantDropPendingTransferResponse{
+		Response: resp,
+	}, nil
+}
+
+func (s *nodePrivilegedService) AccountantReleasePendingTransfer(ctx context.Context, req *nodev1.AccountantReleasePendingTransferRequest) (*nodev1.AccountantReleasePendingTransferResponse, error) {
+	if s.accountant == nil {
+		return nil, fmt.Errorf("accountant is not enabled")
+	}
+
+	if len(req.MsgId) == 0 {
+		return nil, fmt.Errorf("the message id must be specified as \"chainId/emitterAddress/seqNum\"")
+	}
+
+	resp, err := s.accountant.ReleasePendingTransfer(req.MsgId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &nodev1.AccountantReleasePendingTransferResponse{
+		Response: resp,
+	}, nil
+}
+
 func (s *nodePrivilegedService) PurgePythNetVaas(ctx context.Context, req *nodev1.PurgePythNetVaasRequest) (*nodev1.PurgePythNetVaasResponse, error) {
 	prefix := db.VAAID{EmitterChain: vaa.ChainIDPythNet}
 	oldestTime := time.Now().Add(-time.Hour * 24 * time.Duration(req.DaysOld))
@@ -1061,6 +1163,266 @@ func (s *nodePrivilegedService) PurgePythNetVaas(ctx context.Context, req *nodev
 	}, nil
 }
 
+func (s *nodePrivilegedService) PruneVaas(ctx context.Context, req *nodev1.PruneVaasRequest) (*nodev1.PruneVaasResponse, error) {
+	if req.DaysOld == 0 {
+		return nil, errors.New("days_old must be greater than zero")
+	}
+
+	oldestTime := time.Now().Add(-time.Hour * 24 * time.Duration(req.DaysOld))
+	result, err := s.db.PruneVaas(oldestTime, req.LogOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.LogOnly {
+		return &nodev1.PruneVaasResponse{
+			Response: fmt.Sprintf("Would prune VAAs older than %v: %s", oldestTime.String(), result),
+		}, nil
+	}
+
+	rewritten, err := s.db.Compact()
+	if err != nil {
+		return nil, fmt.Errorf("pruned VAAs (%s) but failed to compact the database: %w", result, err)
+	}
+
+	s.logger.Info("pruned VAAs", zap.Time("oldestTime", oldestTime), zap.Stringer("result", result), zap.Int("rewrittenFiles", rewritten))
+	return &nodev1.PruneVaasResponse{
+		Response: fmt.Sprintf("Pruned VAAs older than %v: %s. Rewrote %d value log files.", oldestTime.String(), result, rewritten),
+	}, nil
+}
+
+// exportBatchSize is the maximum number of VAAs sent in a single ExportSignedVAAsResponse.
+const exportBatchSize = 1000
+
+// exportBatchBytes is the size in bytes after which an ExportSignedVAAsResponse is sent, even if it holds fewer than
+// exportBatchSize VAAs. This keeps the responses well below the default gRPC message size limit.
+const exportBatchBytes = 1024 * 1024
+
+// maxImportBatchSize is the maximum number of VAAs accepted in a single ImportSignedVAAsRequest.
+const maxImportBatchSize = 1000
+
+func (s *nodePrivilegedService) ExportSignedVAAs(req *nodev1.ExportSignedVAAsRequest, stream nodev1.NodePrivilegedService_ExportSignedVAAsServer) error {
+	if req.EmitterChain > math.MaxUint16 {
+		return status.Errorf(codes.InvalidArgument, "invalid emitter chain %d", req.EmitterChain)
+	}
+	if req.StartTime < 0 || req.EndTime < 0 {
+		return status.Error(codes.InvalidArgument, "start_time and end_time must not be negative")
+	}
+	if req.EndTime != 0 && req.EndTime <= req.StartTime {
+		return status.Error(codes.InvalidArgument, "end_time must be after start_time")
+	}
+
+	filter := db.ExportFilter{EmitterChain: vaa.ChainID(req.EmitterChain)}
+	if req.StartTime != 0 {
+		filter.StartTime = time.Unix(req.StartTime, 0)
+	}
+	if req.EndTime != 0 {
+		filter.EndTime = time.Unix(req.EndTime, 0)
+	}
+
+	var (
+		batch      [][]byte
+		batchBytes int
+		total      int
+	)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := stream.Send(&nodev1.ExportSignedVAAsResponse{Vaas: batch}); err != nil {
+			return err
+		}
+		total += len(batch)
+		batch = nil
+		batchBytes = 0
+		return nil
+	}
+
+	err := s.db.ExportVaas(filter, func(b []byte) error {
+		if err := stream.Context().Err(); err != nil {
+			return err
+		}
+		batch = append(batch, bytes.Clone(b))
+		batchBytes += len(b)
+		if len(batch) >= exportBatchSize || batchBytes >= exportBatchBytes {
+			return flush()
+		}
+		return nil
+	})
+	if err == nil {
+		err = flush()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to export VAAs: %w", err)
+	}
+
+	s.logger.Info("exported signed VAAs",
+		zap.Int64("startTime", req.StartTime),
+		zap.Int64("endTime", req.EndTime),
+		zap.Uint32("emitterChain", req.EmitterChain),
+		zap.Int("count", total),
+	)
+	return nil
+}
+
+func (s *nodePrivilegedService) ImportSignedVAAs(ctx context.Context, req *nodev1.ImportSignedVAAsRequest) (*nodev1.ImportSignedVAAsResponse, error) {
+	if len(req.Vaas) > maxImportBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "too many VAAs in batch, maximum is %d", maxImportBatchSize)
+	}
+	if s.evmConnector == nil {
+		return nil, errors.New("the node needs to have an Ethereum connection configured to verify imported VAAs")
+	}
+
+	resp := &nodev1.ImportSignedVAAsResponse{}
+	valid := make([]*vaa.VAA, 0, len(req.Vaas))
+	for i, b := range req.Vaas {
+		v, err := vaa.Unmarshal(b)
+		if err != nil {
+			resp.Rejected = append(resp.Rejected, fmt.Sprintf("VAA %d in batch: failed to unmarshal: %v", i, err))
+			continue
+		}
+
+		exists, err := s.db.HasVAA(*db.VaaIDFromVAA(v))
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up VAA %s: %w", v.MessageID(), err)
+		}
+		if exists {
+			resp.Skipped++
+			continue
+		}
+
+		// Failing to load a guardian set is not specific to this VAA, so the whole batch is failed and can be retried.
+		gs, err := s.getGuardianSet(ctx, v.GuardianSetIndex)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := v.Verify(gs.Keys); err != nil {
+			resp.Rejected = append(resp.Rejected, fmt.Sprintf("%s: failed to verify against guardian set %d: %v", v.MessageID(), v.GuardianSetIndex, err))
+			continue
+		}
+
+		valid = append(valid, v)
+	}
+
+	if len(valid) != 0 {
+		if err := s.db.StoreSignedVAABatch(valid); err != nil {
+			return nil, fmt.Errorf("failed to store VAAs: %w", err)
+		}
+	}
+	resp.Imported = uint32(len(valid))
+
+	s.logger.Info("imported signed VAAs",
+		zap.Uint32("imported", resp.Imported),
+		zap.Uint32("skipped", resp.Skipped),
+		zap.Int("rejected", len(resp.Rejected)),
+	)
+	return resp, nil
+}
+
+func (s *nodePrivilegedService) GetObservationConflicts(ctx context.Context, req *nodev1.GetObservationConflictsRequest) (*nodev1.GetObservationConflictsResponse, error) {
+	if s.conflicts == nil {
+		return nil, status.Error(codes.Unavailable, "the processor is not running on this node")
+	}
+
+	resp := &nodev1.GetObservationConflictsResponse{}
+	for _, c := range s.conflicts.List() {
+		conflict := &nodev1.GetObservationConflictsResponse_Conflict{
+			MessageId:     c.MessageID,
+			FirstDetected: c.FirstDetected.Unix(),
+			LastUpdated:   c.LastUpdated.Unix(),
+		}
+		for _, d := range c.Digests {
+			signers := make([]string, len(d.Signers))
+			for i, addr := range d.Signers {
+				signers[i] = addr.Hex()
+			}
+			conflict.Digests = append(conflict.Digests, &nodev1.GetObservationConflictsResponse_ConflictingDigest{
+				Digest:  d.Digest,
+				Signers: signers,
+			})
+		}
+		resp.Conflicts = append(resp.Conflicts, conflict)
+	}
+	return resp, nil
+}
+
+func (s *nodePrivilegedService) GetGuardianKeyStatus(ctx context.Context, req *nodev1.GetGuardianKeyStatusRequest) (*nodev1.GetGuardianKeyStatusResponse, error) {
+	if signer, ok := guardiansigner.AsGuardianSetAware(s.guardianSigner); ok {
+		keyStatus := signer.KeyStatus(ctx)
+		return &nodev1.GetGuardianKeyStatusResponse{
+			ActiveAddress:    keyStatus.ActiveAddress.Hex(),
+			Rotating:         true,
+			OldAddress:       keyStatus.OldAddress.Hex(),
+			NewAddress:       keyStatus.NewAddress.Hex(),
+			UsingNewKey:      keyStatus.UsingNewKey,
+			GuardianSetIndex: keyStatus.GuardianSetIndex,
+			InGuardianSet:    keyStatus.InGuardianSet,
+		}, nil
+	}
+
+	activeAddress := s.guardianAddress(ctx)
+	resp := &nodev1.GetGuardianKeyStatusResponse{
+		ActiveAddress: activeAddress.Hex(),
+	}
+	if s.gst != nil {
+		if gs := s.gst.Get(); gs != nil {
+			_, resp.InGuardianSet = gs.KeyIndex(activeAddress)
+			resp.GuardianSetIndex = gs.Index
+		}
+	}
+	return resp, nil
+}
+
+// Backfill queues a scan of a block range for messages the watcher of the chain missed. The scan runs in the background,
+// so the response only means the watcher accepted the range.
+func (s *nodePrivilegedService) Backfill(ctx context.Context, req *nodev1.BackfillRequest) (*nodev1.BackfillResponse, error) {
+	if req.ChainId > math.MaxUint16 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid chain id: %d", req.ChainId)
+	}
+	chainID := vaa.ChainID(req.ChainId)
+
+	backfiller, ok := s.backfillers[chainID]
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "no watcher for chain %s supports backfills", chainID)
+	}
+
+	if err := backfiller.Backfill(req.FromBlock, req.ToBlock); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	s.logger.Info("queued backfill",
+		zap.Stringer("chain", chainID),
+		zap.Uint64("fromBlock", req.FromBlock),
+		zap.Uint64("toBlock", req.ToBlock),
+	)
+
+	return &nodev1.BackfillResponse{}, nil
+}
+
+// getGuardianSet returns the guardian set with the specified index, loading it from the Ethereum core contract
+// if it is not cached yet. It requires the Ethereum connector to be configured.
+func (s *nodePrivilegedService) getGuardianSet(ctx context.Context, index uint32) (*common.GuardianSet, error) {
+	if cachedGs, exists := s.gsCache.Load(index); exists {
+		gs, ok := cachedGs.(*common.GuardianSet)
+		if !ok {
+			return nil, fmt.Errorf("internal error")
+		}
+		return gs, nil
+	}
+
+	evmGs, err := s.evmConnector.GetGuardianSet(ctx, index)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load guardian set [%d]: %w", index, err)
+	}
+	gs := &common.GuardianSet{
+		Keys:  evmGs.Keys,
+		Index: index,
+	}
+	s.gsCache.Store(index, gs)
+	return gs, nil
+}
+
 func (s *nodePrivilegedService) SignExistingVAA(ctx context.Context, req *nodev1.SignExistingVAARequest) (*nodev1.SignExistingVAAResponse, error) {
 	v, err := vaa.Unmarshal(req.Vaa)
 	if err != nil {
@@ -1075,26 +1437,13 @@ func (s *nodePrivilegedService) SignExistingVAA(ctx context.Context, req *nodev1
 		return nil, errors.New("the node needs to have an Ethereum connection configured to sign existing VAAs")
 	}
 
-	var gs *common.GuardianSet
-	if cachedGs, exists := s.gsCache.Load(v.GuardianSetIndex); exists {
-		var ok bool
-		gs, ok = cachedGs.(*common.GuardianSet)
-		if !ok {
-			return nil, fmt.Errorf("internal error")
-		}
-	} else {
-		evmGs, err := s.evmConnector.GetGuardianSet(ctx, v.GuardianSetIndex)
-		if err != nil {
-			return nil, fmt.Errorf("failed to load guardian set [%d]: %w", v.GuardianSetIndex, err)
-		}
-		gs = &common.GuardianSet{
-			Keys:  evmGs.Keys,
-			Index: v.GuardianSetIndex,
-		}
-		s.gsCache.Store(v.GuardianSetIndex, gs)
+	gs, err := s.getGuardianSet(ctx, v.GuardianSetIndex)
+	if err != nil {
+		return nil, err
 	}
 
-	if slices.Index(gs.Keys, s.guardianAddress) != -1 {
+	guardianAddress := s.guardianAddress(ctx)
+	if slices.Index(gs.Keys, guardianAddress) != -1 {
 		return nil, fmt.Errorf("local guardian is already on the old set")
 	}
 
@@ -1123,7 +1472,7 @@ func (s *nodePrivilegedService) SignExistingVAA(ctx context.Context, req *nodev1
 		return nil, fmt.Errorf("duplicate guardians in the guardian set")
 	}
 
-	localGuardianIndex := slices.Index(newGS, s.guardianAddress)
+	localGuardianIndex := slices.Index(newGS, guardianAddress)
 	if localGuardianIndex == -1 {
 		return nil, fmt.Errorf("local guardian is not a member of the new guardian set")
 	}
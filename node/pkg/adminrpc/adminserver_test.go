@@ -4,6 +4,7 @@ package adminrpc
 import (
 	"bytes"
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/certusone/wormhole/node/pkg/governor"
 	"github.com/certusone/wormhole/node/pkg/guardiansigner"
 	nodev1 "github.com/certusone/wormhole/node/pkg/proto/node/v1"
+	"github.com/certusone/wormhole/node/pkg/watchers"
 	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors"
 	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors/ethabi"
 	ethereum "github.com/ethereum/go-ethereum"
@@ -25,6 +27,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/encoding/prototext"
 )
 
@@ -156,15 +159,14 @@ func setupAdminServerForVAASigning(gsIndex uint32, gsAddrs []common.Address) *no
 	}
 
 	return &nodePrivilegedService{
-		db:              nil,
-		injectC:         nil,
-		obsvReqSendC:    nil,
-		logger:          zap.L(),
-		signedInC:       nil,
-		governor:        nil,
-		evmConnector:    connector,
-		guardianSigner:  guardianSigner,
-		guardianAddress: ethcrypto.PubkeyToAddress(guardianSigner.PublicKey(context.Background())),
+		db:             nil,
+		injectC:        nil,
+		obsvReqSendC:   nil,
+		logger:         zap.L(),
+		signedInC:      nil,
+		governor:       nil,
+		evmConnector:   connector,
+		guardianSigner: guardianSigner,
 	}
 }
 
@@ -199,7 +201,7 @@ func TestSignExistingVAA_InvalidVAA(t *testing.T) {
 
 	v := generateMockVAA(0, signers[:2], t)
 
-	gsAddrs = append(gsAddrs, s.guardianAddress)
+	gsAddrs = append(gsAddrs, s.guardianAddress(context.Background()))
 	_, err := s.SignExistingVAA(context.Background(), &nodev1.SignExistingVAARequest{
 		Vaa:                 v,
 		NewGuardianAddrs:    addrsToHexStrings(gsAddrs),
@@ -214,8 +216,8 @@ func TestSignExistingVAA_DuplicateGuardian(t *testing.T) {
 
 	v := generateMockVAA(0, signers, t)
 
-	gsAddrs = append(gsAddrs, s.guardianAddress)
-	gsAddrs = append(gsAddrs, s.guardianAddress)
+	gsAddrs = append(gsAddrs, s.guardianAddress(context.Background()))
+	gsAddrs = append(gsAddrs, s.guardianAddress(context.Background()))
 	_, err := s.SignExistingVAA(context.Background(), &nodev1.SignExistingVAARequest{
 		Vaa:                 v,
 		NewGuardianAddrs:    addrsToHexStrings(gsAddrs),
@@ -228,13 +230,13 @@ func TestSignExistingVAA_AlreadyGuardian(t *testing.T) {
 	signers, gsAddrs := generateGuardianSigners(5)
 	s := setupAdminServerForVAASigning(0, gsAddrs)
 	s.evmConnector = mockEVMConnector{
-		guardianAddrs:    append(gsAddrs, s.guardianAddress),
+		guardianAddrs:    append(gsAddrs, s.guardianAddress(context.Background())),
 		guardianSetIndex: 0,
 	}
 
 	v := generateMockVAA(0, append(signers, s.guardianSigner), t)
 
-	gsAddrs = append(gsAddrs, s.guardianAddress)
+	gsAddrs = append(gsAddrs, s.guardianAddress(context.Background()))
 	_, err := s.SignExistingVAA(context.Background(), &nodev1.SignExistingVAARequest{
 		Vaa:                 v,
 		NewGuardianAddrs:    addrsToHexStrings(gsAddrs),
@@ -263,7 +265,7 @@ func TestSignExistingVAA_CantReachQuorum(t *testing.T) {
 
 	v := generateMockVAA(0, signers, t)
 
-	gsAddrs = append(gsAddrs, s.guardianAddress)
+	gsAddrs = append(gsAddrs, s.guardianAddress(context.Background()))
 	_, err := s.SignExistingVAA(context.Background(), &nodev1.SignExistingVAARequest{
 		Vaa:                 v,
 		NewGuardianAddrs:    addrsToHexStrings(append(gsAddrs, common.Address{0, 1}, common.Address{3, 1}, common.Address{8, 1})),
@@ -278,7 +280,7 @@ func TestSignExistingVAA_Valid(t *testing.T) {
 
 	v := generateMockVAA(0, signers, t)
 
-	gsAddrs = append(gsAddrs, s.guardianAddress)
+	gsAddrs = append(gsAddrs, s.guardianAddress(context.Background()))
 	res, err := s.SignExistingVAA(context.Background(), &nodev1.SignExistingVAARequest{
 		Vaa:                 v,
 		NewGuardianAddrs:    addrsToHexStrings(gsAddrs),
@@ -338,15 +340,14 @@ func newNodePrivilegedServiceForGovernorTests() *nodePrivilegedService {
 	gov := governor.NewChainGovernor(zap.NewNop(), &db.MockGovernorDB{}, wh_common.GoTest, false, "")
 
 	return &nodePrivilegedService{
-		db:              nil,
-		injectC:         nil,
-		obsvReqSendC:    nil,
-		logger:          nil,
-		signedInC:       nil,
-		governor:        gov,
-		evmConnector:    nil,
-		guardianSigner:  nil,
-		guardianAddress: common.Address{},
+		db:             nil,
+		injectC:        nil,
+		obsvReqSendC:   nil,
+		logger:         nil,
+		signedInC:      nil,
+		governor:       gov,
+		evmConnector:   nil,
+		guardianSigner: nil,
 	}
 }
 
@@ -414,3 +415,181 @@ func TestChainGovernorResetReleaseTimer(t *testing.T) {
 	}
 
 }
+
+// mockExportStream implements nodev1.NodePrivilegedService_ExportSignedVAAsServer for tests.
+type mockExportStream struct {
+	grpc.ServerStream
+	responses []*nodev1.ExportSignedVAAsResponse
+}
+
+func (m *mockExportStream) Context() context.Context {
+	return context.Background()
+}
+
+func (m *mockExportStream) Send(r *nodev1.ExportSignedVAAsResponse) error {
+	m.responses = append(m.responses, r)
+	return nil
+}
+
+func signedTestVAA(t *testing.T, gsIndex uint32, signers []guardiansigner.GuardianSigner, sequence uint64, timestamp time.Time) []byte {
+	t.Helper()
+	v := &vaa.VAA{
+		Version:          1,
+		GuardianSetIndex: gsIndex,
+		Timestamp:        timestamp,
+		Nonce:            3,
+		Sequence:         sequence,
+		ConsistencyLevel: 1,
+		EmitterChain:     vaa.ChainIDEthereum,
+		EmitterAddress:   vaa.Address{1},
+		Payload:          []byte("test"),
+	}
+	for i, signer := range signers {
+		sig, err := signer.Sign(context.Background(), v.SigningDigest().Bytes())
+		require.NoError(t, err)
+		signature := [ecdsaSignatureLength]byte{}
+		copy(signature[:], sig)
+		v.Signatures = append(v.Signatures, &vaa.Signature{Index: uint8(i), Signature: signature})
+	}
+	b, err := v.Marshal()
+	require.NoError(t, err)
+	return b
+}
+
+func TestExportImportSignedVAAs(t *testing.T) {
+	signers, gsAddrs := generateGuardianSigners(5)
+	start := time.Unix(1700000000, 0)
+
+	source := setupAdminServerForVAASigning(0, gsAddrs)
+	source.db = db.OpenDb(zap.NewNop(), nil)
+	defer source.db.Close()
+
+	for seq := uint64(0); seq < 10; seq++ {
+		v, err := vaa.Unmarshal(signedTestVAA(t, 0, signers, seq, start.Add(time.Duration(seq)*time.Hour)))
+		require.NoError(t, err)
+		require.NoError(t, source.db.StoreSignedVAA(v))
+	}
+
+	// Export a time range.
+	stream := &mockExportStream{}
+	require.NoError(t, source.ExportSignedVAAs(&nodev1.ExportSignedVAAsRequest{
+		StartTime: start.Add(2 * time.Hour).Unix(),
+		EndTime:   start.Add(7 * time.Hour).Unix(),
+	}, stream))
+	exported := [][]byte{}
+	for _, r := range stream.responses {
+		exported = append(exported, r.Vaas...)
+	}
+	require.Len(t, exported, 5)
+
+	target := setupAdminServerForVAASigning(0, gsAddrs)
+	target.db = db.OpenDb(zap.NewNop(), nil)
+	defer target.db.Close()
+
+	// A VAA that doesn't have a quorum of signatures from its guardian set is rejected.
+	invalid := signedTestVAA(t, 0, signers[:2], 100, start)
+
+	resp, err := target.ImportSignedVAAs(context.Background(), &nodev1.ImportSignedVAAsRequest{Vaas: append(exported, invalid, []byte{1, 2, 3})})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(5), resp.Imported)
+	assert.Equal(t, uint32(0), resp.Skipped)
+	require.Len(t, resp.Rejected, 2)
+	assert.Contains(t, resp.Rejected[0], "failed to verify against guardian set 0")
+	assert.Contains(t, resp.Rejected[1], "failed to unmarshal")
+
+	for _, b := range exported {
+		v, err := vaa.Unmarshal(b)
+		require.NoError(t, err)
+		stored, err := target.db.GetSignedVAABytes(*db.VaaIDFromVAA(v))
+		require.NoError(t, err)
+		assert.Equal(t, b, stored)
+	}
+
+	// Importing the same VAAs again skips them.
+	resp, err = target.ImportSignedVAAs(context.Background(), &nodev1.ImportSignedVAAsRequest{Vaas: exported})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0), resp.Imported)
+	assert.Equal(t, uint32(5), resp.Skipped)
+}
+
+func TestExportSignedVAAsInvalidRange(t *testing.T) {
+	s := setupAdminServerForVAASigning(0, nil)
+	err := s.ExportSignedVAAs(&nodev1.ExportSignedVAAsRequest{StartTime: 10, EndTime: 10}, &mockExportStream{})
+	assert.ErrorContains(t, err, "end_time must be after start_time")
+
+	err = s.ExportSignedVAAs(&nodev1.ExportSignedVAAsRequest{EmitterChain: 70000}, &mockExportStream{})
+	assert.ErrorContains(t, err, "invalid emitter chain")
+}
+
+func TestImportSignedVAAsRequiresEthereumConnection(t *testing.T) {
+	s := setupAdminServerForVAASigning(0, nil)
+	s.evmConnector = nil
+	_, err := s.ImportSignedVAAs(context.Background(), &nodev1.ImportSignedVAAsRequest{})
+	assert.ErrorContains(t, err, "Ethereum connection")
+}
+
+func TestGetGuardianKeyStatus(t *testing.T) {
+	ctx := context.Background()
+	s := setupAdminServerForVAASigning(0, nil)
+	s.gst = wh_common.NewGuardianSetState(nil)
+	oldAddress := s.guardianAddress(ctx)
+	s.gst.Set(wh_common.NewGuardianSet([]common.Address{oldAddress}, 3))
+
+	resp, err := s.GetGuardianKeyStatus(ctx, &nodev1.GetGuardianKeyStatusRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, oldAddress.Hex(), resp.ActiveAddress)
+	assert.False(t, resp.Rotating)
+	assert.Equal(t, uint32(3), resp.GuardianSetIndex)
+	assert.True(t, resp.InGuardianSet)
+
+	// During a key rotation, the status shows which key is in use.
+	newSigner, err := guardiansigner.GenerateSignerWithPrivatekeyUnsafe(nil)
+	require.NoError(t, err)
+	newAddress := ethcrypto.PubkeyToAddress(newSigner.PublicKey(ctx))
+	dualKeySigner, err := guardiansigner.NewDualKeySigner(ctx, s.guardianSigner, newSigner)
+	require.NoError(t, err)
+	dualKeySigner.SetGuardianSet(ctx, wh_common.NewGuardianSet([]common.Address{newAddress}, 4))
+	s.guardianSigner = dualKeySigner
+
+	resp, err = s.GetGuardianKeyStatus(ctx, &nodev1.GetGuardianKeyStatusRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, newAddress.Hex(), resp.ActiveAddress)
+	assert.True(t, resp.Rotating)
+	assert.True(t, resp.UsingNewKey)
+	assert.Equal(t, oldAddress.Hex(), resp.OldAddress)
+	assert.Equal(t, newAddress.Hex(), resp.NewAddress)
+	assert.Equal(t, uint32(4), resp.GuardianSetIndex)
+	assert.True(t, resp.InGuardianSet)
+}
+
+type mockBackfiller struct {
+	from, to uint64
+	err      error
+}
+
+func (m *mockBackfiller) Backfill(from uint64, to uint64) error {
+	m.from, m.to = from, to
+	return m.err
+}
+
+func TestBackfill(t *testing.T) {
+	ctx := context.Background()
+	s := setupAdminServerForVAASigning(0, nil)
+	backfiller := &mockBackfiller{}
+	s.backfillers = map[vaa.ChainID]watchers.Backfiller{vaa.ChainIDSolana: backfiller}
+
+	_, err := s.Backfill(ctx, &nodev1.BackfillRequest{ChainId: uint32(vaa.ChainIDSolana), FromBlock: 100, ToBlock: 200})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(100), backfiller.from)
+	assert.Equal(t, uint64(200), backfiller.to)
+
+	backfiller.err = errors.New("another backfill is still queued")
+	_, err = s.Backfill(ctx, &nodev1.BackfillRequest{ChainId: uint32(vaa.ChainIDSolana), FromBlock: 300, ToBlock: 400})
+	assert.ErrorContains(t, err, "another backfill is still queued")
+
+	_, err = s.Backfill(ctx, &nodev1.BackfillRequest{ChainId: uint32(vaa.ChainIDEthereum), FromBlock: 100, ToBlock: 200})
+	assert.ErrorContains(t, err, "no watcher for chain ethereum supports backfills")
+
+	_, err = s.Backfill(ctx, &nodev1.BackfillRequest{ChainId: 70000})
+	assert.ErrorContains(t, err, "invalid chain id")
+}
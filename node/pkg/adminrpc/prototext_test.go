@@ -1139,4 +1139,48 @@ var adminCommandTest = []adminCommandTestEntry{
 					}
 				}`,
 	},
+	// build/bin/guardiand template governor-chain-config-update --chain-id 2 --daily-limit 100000000 --big-transaction-size 5000000
+	{
+		label:   "GovernorChainConfigUpdate success",
+		errText: "",
+		prototext: `
+				current_set_index: 4
+				messages: {
+					sequence: 5295734227318316290
+					nonce: 3813469231
+					governor_chain_config_update: {
+						emitter_chain: 2
+						daily_limit: 100000000
+						big_transaction_size: 5000000
+					}
+				}`,
+	},
+	{
+		label:   "GovernorChainConfigUpdate invalid emitter chain",
+		errText: "invalid emitter_chain",
+		prototext: `
+				current_set_index: 4
+				messages: {
+					sequence: 5295734227318316290
+					nonce: 3813469231
+					governor_chain_config_update: {
+						emitter_chain: 65536
+						daily_limit: 100000000
+					}
+				}`,
+	},
+	{
+		label:   "GovernorChainConfigUpdate big transaction size without daily limit",
+		errText: "big_transaction_size must be zero if daily_limit is zero",
+		prototext: `
+				current_set_index: 4
+				messages: {
+					sequence: 5295734227318316290
+					nonce: 3813469231
+					governor_chain_config_update: {
+						emitter_chain: 2
+						big_transaction_size: 5000000
+					}
+				}`,
+	},
 }
@@ -0,0 +1,110 @@
+package common
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MaxObservationConflicts is the number of most recent observation conflicts kept by ObservationConflicts.
+const MaxObservationConflicts = 100
+
+// ConflictingDigest is one of the digests guardians signed for a message involved in a conflict.
+type ConflictingDigest struct {
+	Digest  string
+	Signers []common.Address
+}
+
+// ObservationConflict describes guardians signing different digests for the same message ID. Since a message ID
+// uniquely identifies a message, this indicates that either some guardians are connected to a compromised or faulty
+// RPC node, or that there is a bug in how observations are made.
+type ObservationConflict struct {
+	MessageID string
+	// FirstDetected is the time the conflict was first detected, LastUpdated the time Digests was last updated.
+	FirstDetected time.Time
+	LastUpdated   time.Time
+	Digests       []ConflictingDigest
+}
+
+// ObservationConflicts keeps the most recent observation conflicts detected by the processor, so that they can
+// be queried by other components. It is safe for concurrent use.
+type ObservationConflicts struct {
+	mu sync.Mutex
+	// conflicts is ordered by FirstDetected, oldest first.
+	conflicts []*ObservationConflict
+}
+
+// NewObservationConflicts returns an empty ObservationConflicts.
+func NewObservationConflicts() *ObservationConflicts {
+	return &ObservationConflicts{}
+}
+
+// Record stores a conflict, replacing the digests of a previously recorded conflict for the same message ID.
+// It returns true if the conflict is new or involves a digest that was not recorded before.
+func (c *ObservationConflicts) Record(messageID string, digests []ConflictingDigest, now time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, existing := range c.conflicts {
+		if existing.MessageID != messageID {
+			continue
+		}
+
+		known := make(map[string]struct{}, len(existing.Digests))
+		for _, d := range existing.Digests {
+			known[d.Digest] = struct{}{}
+		}
+
+		grew := false
+		merged := append([]ConflictingDigest{}, digests...)
+		current := make(map[string]struct{}, len(digests))
+		for _, d := range digests {
+			current[d.Digest] = struct{}{}
+			if _, ok := known[d.Digest]; !ok {
+				grew = true
+			}
+		}
+		// Keep digests that are no longer in the aggregation state, they are still evidence.
+		for _, d := range existing.Digests {
+			if _, ok := current[d.Digest]; !ok {
+				merged = append(merged, d)
+			}
+		}
+
+		existing.Digests = merged
+		existing.LastUpdated = now
+		return grew
+	}
+
+	if len(c.conflicts) >= MaxObservationConflicts {
+		c.conflicts = c.conflicts[1:]
+	}
+	c.conflicts = append(c.conflicts, &ObservationConflict{
+		MessageID:     messageID,
+		FirstDetected: now,
+		LastUpdated:   now,
+		Digests:       digests,
+	})
+	return true
+}
+
+// List returns a copy of the recorded conflicts, oldest first.
+func (c *ObservationConflicts) List() []ObservationConflict {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	out := make([]ObservationConflict, 0, len(c.conflicts))
+	for _, conflict := range c.conflicts {
+		cpy := *conflict
+		cpy.Digests = make([]ConflictingDigest, len(conflict.Digests))
+		for i, d := range conflict.Digests {
+			cpy.Digests[i] = ConflictingDigest{
+				Digest:  d.Digest,
+				Signers: append([]common.Address(nil), d.Signers...),
+			}
+		}
+		out = append(out, cpy)
+	}
+	return out
+}
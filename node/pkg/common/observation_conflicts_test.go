@@ -0,0 +1,50 @@
+package common
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObservationConflictsRecord(t *testing.T) {
+	c := NewObservationConflicts()
+	now := time.Unix(1700000000, 0)
+	a, b := common.Address{1}, common.Address{2}
+
+	assert.True(t, c.Record("2/0001/1", []ConflictingDigest{{Digest: "aa", Signers: []common.Address{a}}, {Digest: "bb", Signers: []common.Address{b}}}, now))
+
+	// Updating signers of known digests is not a new conflict.
+	assert.False(t, c.Record("2/0001/1", []ConflictingDigest{{Digest: "aa", Signers: []common.Address{a, b}}, {Digest: "bb", Signers: []common.Address{b}}}, now.Add(time.Second)))
+
+	// A third digest is.
+	assert.True(t, c.Record("2/0001/1", []ConflictingDigest{{Digest: "aa", Signers: []common.Address{a, b}}, {Digest: "cc", Signers: []common.Address{b}}}, now.Add(2*time.Second)))
+
+	conflicts := c.List()
+	require.Len(t, conflicts, 1)
+	assert.Equal(t, now, conflicts[0].FirstDetected)
+	assert.Equal(t, now.Add(2*time.Second), conflicts[0].LastUpdated)
+	// Digests that are no longer reported are kept.
+	require.Len(t, conflicts[0].Digests, 3)
+	assert.Equal(t, "aa", conflicts[0].Digests[0].Digest)
+	assert.Equal(t, []common.Address{a, b}, conflicts[0].Digests[0].Signers)
+	assert.Equal(t, "cc", conflicts[0].Digests[1].Digest)
+	assert.Equal(t, "bb", conflicts[0].Digests[2].Digest)
+
+	// The returned conflicts are copies.
+	conflicts[0].Digests[0].Signers[0] = common.Address{9}
+	assert.Equal(t, a, c.List()[0].Digests[0].Signers[0])
+}
+
+func TestObservationConflictsBounded(t *testing.T) {
+	c := NewObservationConflicts()
+	for i := 0; i < MaxObservationConflicts+10; i++ {
+		c.Record(fmt.Sprintf("2/0001/%d", i), []ConflictingDigest{{Digest: "aa"}, {Digest: "bb"}}, time.Now())
+	}
+	conflicts := c.List()
+	require.Len(t, conflicts, MaxObservationConflicts)
+	assert.Equal(t, "2/0001/10", conflicts[0].MessageID)
+}
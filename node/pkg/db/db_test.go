@@ -2,8 +2,6 @@ package db
 
 import (
 	"bytes"
-	"crypto/ecdsa"
-	"crypto/rand"
 	"fmt"
 	math_rand "math/rand"
 	"os"
@@ -12,12 +10,11 @@ import (
 	"sync/atomic"
 
 	"github.com/dgraph-io/badger/v3"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa/vaatest"
 	"go.uber.org/zap"
 
 	"testing"
-	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -27,22 +24,16 @@ func getVAA() vaa.VAA {
 	return getVAAWithSeqNum(1)
 }
 
+// testGuardians sign the VAAs stored by the tests.
+var testGuardians = vaatest.NewGuardianSet("db", 1, 1)
+
 func getVAAWithSeqNum(seqNum uint64) vaa.VAA {
-	var payload = []byte{97, 97, 97, 97, 97, 97}
-	var governanceEmitter = vaa.Address{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4}
-
-	return vaa.VAA{
-		Version:          uint8(1),
-		GuardianSetIndex: uint32(1),
-		Signatures:       nil,
-		Timestamp:        time.Unix(0, 0),
-		Nonce:            uint32(1),
-		Sequence:         seqNum,
-		ConsistencyLevel: uint8(32),
-		EmitterChain:     vaa.ChainIDSolana,
-		EmitterAddress:   governanceEmitter,
-		Payload:          payload,
-	}
+	emitter := vaatest.NewGovernanceEmitter()
+	emitter.Chain = vaa.ChainIDSolana
+	emitter.Sequence = seqNum
+	v := emitter.Next([]byte{97, 97, 97, 97, 97, 97})
+	v.GuardianSetIndex = testGuardians.Index
+	return *v
 }
 
 // Testing the expected default behavior of a CreateGovernanceVAA
@@ -107,8 +98,7 @@ func TestStoreSignedVAASigned(t *testing.T) {
 
 	testVaa := getVAA()
 
-	privKey, _ := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
-	testVaa.AddSignature(privKey, 0)
+	testGuardians.Sign(&testVaa)
 
 	err2 := db.StoreSignedVAA(&testVaa)
 	assert.NoError(t, err2)
@@ -120,9 +110,6 @@ func TestStoreSignedVAABatch(t *testing.T) {
 	defer db.Close()
 	defer os.Remove(dbPath)
 
-	privKey, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
-	require.NoError(t, err)
-
 	require.Less(t, int64(0), db.db.MaxBatchCount()) // In testing this was 104857.
 	require.Less(t, int64(0), db.db.MaxBatchSize())  // In testing this was 10066329.
 
@@ -133,12 +120,12 @@ func TestStoreSignedVAABatch(t *testing.T) {
 	vaaBatch := make([]*vaa.VAA, 0, numVAAs)
 	for seqNum := uint64(0); seqNum < numVAAs; seqNum++ {
 		v := getVAAWithSeqNum(seqNum)
-		v.AddSignature(privKey, 0)
+		testGuardians.Sign(&v)
 		vaaBatch = append(vaaBatch, &v)
 	}
 
 	// Store the batch in the database.
-	err = db.StoreSignedVAABatch(vaaBatch)
+	err := db.StoreSignedVAABatch(vaaBatch)
 	require.NoError(t, err)
 
 	// Verify all the VAAs are in the database.
@@ -183,8 +170,7 @@ func TestGetSignedVAABytes(t *testing.T) {
 
 	vaaID := VaaIDFromVAA(&testVaa)
 
-	privKey, _ := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
-	testVaa.AddSignature(privKey, 0)
+	testGuardians.Sign(&testVaa)
 
 	// Store full VAA
 	err2 := db.StoreSignedVAA(&testVaa)
@@ -210,8 +196,7 @@ func TestFindEmitterSequenceGap(t *testing.T) {
 
 	vaaID := VaaIDFromVAA(&testVaa)
 
-	privKey, _ := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
-	testVaa.AddSignature(privKey, 0)
+	testGuardians.Sign(&testVaa)
 
 	// Store full VAA
 	err2 := db.StoreSignedVAA(&testVaa)
@@ -0,0 +1,62 @@
+package db
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// ExportFilter selects the signed VAAs returned by ExportVaas.
+type ExportFilter struct {
+	// EmitterChain restricts the export to a single chain. ChainIDUnset exports all chains.
+	EmitterChain vaa.ChainID
+	// StartTime and EndTime restrict the export to VAAs with a timestamp in [StartTime, EndTime). Zero values are unbounded.
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+// matches returns true if the VAA passes the time range of the filter.
+func (f *ExportFilter) matches(v *vaa.VAA) bool {
+	if !f.StartTime.IsZero() && v.Timestamp.Before(f.StartTime) {
+		return false
+	}
+	if !f.EndTime.IsZero() && !v.Timestamp.Before(f.EndTime) {
+		return false
+	}
+	return true
+}
+
+// ExportVaas calls fn with the bytes of every signed VAA in the database that matches the filter, ordered by
+// emitter chain, emitter address and the string representation of the sequence. The bytes passed to fn are only
+// valid for the duration of the call. If fn returns an error, the export is aborted and the error is returned.
+func (d *Database) ExportVaas(filter ExportFilter, fn func(b []byte) error) error {
+	prefix := []byte("signed/")
+	if filter.EmitterChain != vaa.ChainIDUnset {
+		// Terminate the prefix so that e.g. chain 1 does not match chain 10.
+		prefix = []byte(fmt.Sprintf("signed/%d/", filter.EmitterChain))
+	}
+
+	return d.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			item := it.Item()
+			err := item.Value(func(val []byte) error {
+				v, err := vaa.Unmarshal(val)
+				if err != nil {
+					return fmt.Errorf("failed to unmarshal VAA for %s: %w", string(item.Key()), err)
+				}
+				if !filter.matches(v) {
+					return nil
+				}
+				return fn(val)
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,78 @@
+package db
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+func TestExportVaas(t *testing.T) {
+	db := OpenDb(zap.NewNop(), nil)
+	defer db.Close()
+
+	start := time.Unix(1700000000, 0)
+	emitterAddress := vaa.Address{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2}
+
+	store := func(chainID vaa.ChainID, seq uint64, timestamp time.Time) {
+		require.NoError(t, storeVAA(db, &vaa.VAA{
+			Version:          uint8(1),
+			GuardianSetIndex: uint32(1),
+			Timestamp:        timestamp,
+			Nonce:            uint32(1),
+			Sequence:         seq,
+			ConsistencyLevel: uint8(32),
+			EmitterChain:     chainID,
+			EmitterAddress:   emitterAddress,
+			Payload:          []byte{97, 97, 97, 97, 97, 97},
+		}))
+	}
+
+	for seq := uint64(0); seq < 10; seq++ {
+		store(vaa.ChainIDSolana, seq, start.Add(time.Duration(seq)*time.Hour))
+		store(vaa.ChainIDEthereum, seq, start.Add(time.Duration(seq)*time.Hour))
+		// Chain 10 must not be exported when filtering on chain 1.
+		store(vaa.ChainIDFantom, seq, start.Add(time.Duration(seq)*time.Hour))
+	}
+
+	export := func(filter ExportFilter) []*vaa.VAA {
+		vaas := []*vaa.VAA{}
+		require.NoError(t, db.ExportVaas(filter, func(b []byte) error {
+			v, err := vaa.Unmarshal(b)
+			require.NoError(t, err)
+			vaas = append(vaas, v)
+			return nil
+		}))
+		return vaas
+	}
+
+	assert.Len(t, export(ExportFilter{}), 30)
+
+	vaas := export(ExportFilter{EmitterChain: vaa.ChainIDSolana})
+	require.Len(t, vaas, 10)
+	for _, v := range vaas {
+		assert.Equal(t, vaa.ChainIDSolana, v.EmitterChain)
+	}
+
+	vaas = export(ExportFilter{EmitterChain: vaa.ChainIDEthereum, StartTime: start.Add(2 * time.Hour), EndTime: start.Add(5 * time.Hour)})
+	require.Len(t, vaas, 3)
+	for _, v := range vaas {
+		assert.Equal(t, vaa.ChainIDEthereum, v.EmitterChain)
+		assert.GreaterOrEqual(t, v.Sequence, uint64(2))
+		assert.Less(t, v.Sequence, uint64(5))
+	}
+
+	// Errors returned by the callback abort the export.
+	errStop := errors.New("stop")
+	count := 0
+	err := db.ExportVaas(ExportFilter{}, func(b []byte) error {
+		count++
+		return errStop
+	})
+	assert.ErrorIs(t, err, errStop)
+	assert.Equal(t, 1, count)
+}
@@ -19,6 +19,8 @@ type GovernorDB interface {
 	DeleteTransfer(t *Transfer) error
 	DeletePendingMsg(k *PendingTransfer) error
 	GetChainGovernorData(logger *zap.Logger) (transfers []*Transfer, pending []*PendingTransfer, err error)
+	StoreChainConfig(c *ChainConfig) error
+	GetChainConfigs() ([]*ChainConfig, error)
 }
 
 type MockGovernorDB struct {
@@ -44,6 +46,14 @@ func (d *MockGovernorDB) GetChainGovernorData(logger *zap.Logger) (transfers []*
 	return nil, nil, nil
 }
 
+func (d *MockGovernorDB) StoreChainConfig(c *ChainConfig) error {
+	return nil
+}
+
+func (d *MockGovernorDB) GetChainConfigs() ([]*ChainConfig, error) {
+	return nil, nil
+}
+
 type Transfer struct {
 	// This value is generated by the Governor. It is not read from the blockchain transaction. It represents the
 	// time at which it was observed and evaluated by the Governor.
@@ -280,6 +290,46 @@ func UnmarshalPendingTransfer(data []byte, isOld bool) (*PendingTransfer, error)
 	return p, nil
 }
 
+// ChainConfig holds the limits of a governed chain that were set by a governance VAA. They replace the limits
+// configured in the node on start up.
+type ChainConfig struct {
+	EmitterChain       vaa.ChainID
+	DailyLimit         uint64
+	BigTransactionSize uint64
+	// Timestamp of the governance VAA that set the limits. Only a VAA with a later timestamp may replace them.
+	Timestamp time.Time
+}
+
+const chainConfigLen = 2 + 8 + 8 + 8
+
+func (c *ChainConfig) Marshal() []byte {
+	buf := new(bytes.Buffer)
+	vaa.MustWrite(buf, binary.BigEndian, c.EmitterChain)
+	vaa.MustWrite(buf, binary.BigEndian, c.DailyLimit)
+	vaa.MustWrite(buf, binary.BigEndian, c.BigTransactionSize)
+	vaa.MustWrite(buf, binary.BigEndian, c.Timestamp.Unix())
+	return buf.Bytes()
+}
+
+func UnmarshalChainConfig(data []byte) (*ChainConfig, error) {
+	if len(data) != chainConfigLen {
+		return nil, fmt.Errorf("chain config has an invalid length, should be %d, is %d", chainConfigLen, len(data))
+	}
+
+	return &ChainConfig{
+		EmitterChain:       vaa.ChainID(binary.BigEndian.Uint16(data[0:2])),
+		DailyLimit:         binary.BigEndian.Uint64(data[2:10]),
+		BigTransactionSize: binary.BigEndian.Uint64(data[10:18]),
+		Timestamp:          time.Unix(int64(binary.BigEndian.Uint64(data[18:26])), 0),
+	}, nil
+}
+
+const chainConfig = "GOV:CHAINCFG:"
+
+func ChainConfigID(c *ChainConfig) []byte {
+	return []byte(fmt.Sprintf("%v%d", chainConfig, c.EmitterChain))
+}
+
 const oldTransfer = "GOV:XFER2:"
 const oldTransferLen = len(oldTransfer)
 
@@ -488,3 +538,42 @@ func (d *Database) DeletePendingMsg(pending *PendingTransfer) error {
 
 	return nil
 }
+
+// This is called by the chain governor to persist limits set by a governance VAA.
+func (d *Database) StoreChainConfig(c *ChainConfig) error {
+	err := d.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(ChainConfigID(c), c.Marshal())
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to commit chain config tx: %w", err)
+	}
+
+	return nil
+}
+
+// This is called by the chain governor on start up to reload the limits set by governance VAAs.
+func (d *Database) GetChainConfigs() (configs []*ChainConfig, err error) {
+	err = d.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = []byte(chainConfig)
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		for it.Rewind(); it.Valid(); it.Next() {
+			val, err := it.Item().ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+
+			c, err := UnmarshalChainConfig(val)
+			if err != nil {
+				return err
+			}
+
+			configs = append(configs, c)
+		}
+		return nil
+	})
+
+	return
+}
@@ -910,3 +910,46 @@ func TestOldTransfersUpdatedWhenReloading(t *testing.T) {
 	assert.Equal(t, xfer1, xfers[0])
 	assert.Equal(t, xfer2, xfers[1])
 }
+
+func TestStoreAndReloadChainConfigs(t *testing.T) {
+	dbPath := t.TempDir()
+	db := OpenDb(zap.NewNop(), &dbPath)
+	defer db.Close()
+
+	configs, err := db.GetChainConfigs()
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(configs))
+
+	cfg1 := &ChainConfig{EmitterChain: vaa.ChainIDEthereum, DailyLimit: 100_000_000, BigTransactionSize: 5_000_000, Timestamp: time.Unix(1654516425, 0)}
+	cfg2 := &ChainConfig{EmitterChain: vaa.ChainIDSolana, DailyLimit: 50_000_000, BigTransactionSize: 0, Timestamp: time.Unix(1654516426, 0)}
+	require.NoError(t, db.StoreChainConfig(cfg1))
+	require.NoError(t, db.StoreChainConfig(cfg2))
+
+	// Storing the config of a chain again replaces it.
+	cfg1.DailyLimit = 200_000_000
+	require.NoError(t, db.StoreChainConfig(cfg1))
+
+	configs, err = db.GetChainConfigs()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(configs))
+	sort.Slice(configs, func(i, j int) bool { return configs[i].EmitterChain < configs[j].EmitterChain })
+	assert.Equal(t, cfg2, configs[0])
+	assert.Equal(t, cfg1, configs[1])
+
+	// The chain configs are not mistaken for transfers or pending transfers.
+	transfers, pending, err := db.GetChainGovernorData(zap.NewNop())
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(transfers))
+	assert.Equal(t, 0, len(pending))
+}
+
+func TestUnmarshalChainConfigFailures(t *testing.T) {
+	cfg := &ChainConfig{EmitterChain: vaa.ChainIDEthereum, DailyLimit: 100_000_000, BigTransactionSize: 5_000_000, Timestamp: time.Unix(1654516425, 0)}
+	data := cfg.Marshal()
+
+	_, err := UnmarshalChainConfig(data[:len(data)-1])
+	assert.ErrorContains(t, err, "chain config has an invalid length")
+
+	_, err = UnmarshalChainConfig(append(data, 0))
+	assert.ErrorContains(t, err, "chain config has an invalid length")
+}
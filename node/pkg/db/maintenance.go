@@ -0,0 +1,155 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+var (
+	prunedVaaTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "wormhole_db_pruned_vaas_total",
+			Help: "Total number of VAAs pruned from the database because they exceeded the retention period",
+		})
+	compactionDuration = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "wormhole_db_compaction_duration_seconds",
+			Help:    "Duration of the value log garbage collection runs of the database",
+			Buckets: []float64{1, 5, 15, 60, 300, 900},
+		})
+)
+
+// valueLogGCDiscardRatio is the fraction of a value log file that must be garbage for it to be rewritten.
+const valueLogGCDiscardRatio = 0.5
+
+// PruneResult is the outcome of a PruneVaas call.
+type PruneResult struct {
+	Deleted int
+	Kept    int
+	// Governance VAAs are kept regardless of their age, as they are needed to recover the guardian set history.
+	Governance int
+}
+
+func (r PruneResult) String() string {
+	return fmt.Sprintf("deleted %d VAAs, kept %d VAAs and %d governance VAAs", r.Deleted, r.Kept, r.Governance)
+}
+
+// PruneVaas deletes the VAAs of all chains that are older than the specified time, except governance VAAs. If the
+// logOnly flag is specified, it does not delete anything, just counts up what it would have deleted.
+func (d *Database) PruneVaas(oldestTime time.Time, logOnly bool) (PruneResult, error) {
+	var result PruneResult
+
+	wb := d.db.NewWriteBatch()
+	defer wb.Cancel()
+
+	if err := d.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+		prefix := []byte("signed/")
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			item := it.Item()
+			err := item.Value(func(val []byte) error {
+				v, err := vaa.Unmarshal(val)
+				if err != nil {
+					return fmt.Errorf("failed to unmarshal VAA for %s: %v", string(item.Key()), err)
+				}
+
+				if v.EmitterChain == vaa.GovernanceChain && v.EmitterAddress == vaa.GovernanceEmitter {
+					result.Governance++
+					return nil
+				}
+				if !v.Timestamp.Before(oldestTime) {
+					result.Kept++
+					return nil
+				}
+
+				result.Deleted++
+				if !logOnly {
+					if err := wb.Delete(item.KeyCopy(nil)); err != nil {
+						return fmt.Errorf("failed to delete vaa for key [%v]: %w", item.Key(), err)
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return result, err
+	}
+
+	if logOnly {
+		return result, nil
+	}
+	if err := wb.Flush(); err != nil {
+		return result, fmt.Errorf("failed to delete VAAs: %w", err)
+	}
+	prunedVaaTotal.Add(float64(result.Deleted))
+	return result, nil
+}
+
+// Compact runs the value log garbage collection of the database until there is nothing left to rewrite, so that the
+// disk space of deleted entries is reclaimed. Deleted keys are dropped from the LSM tree by the compactions badger
+// runs by itself. It returns the number of value log files that were rewritten.
+func (d *Database) Compact() (int, error) {
+	start := time.Now()
+	defer func() { compactionDuration.Observe(time.Since(start).Seconds()) }()
+
+	rewritten := 0
+	for {
+		err := d.db.RunValueLogGC(valueLogGCDiscardRatio)
+		// In-memory databases have no value log.
+		if errors.Is(err, badger.ErrNoRewrite) || errors.Is(err, badger.ErrGCInMemoryMode) {
+			return rewritten, nil
+		}
+		if err != nil {
+			return rewritten, err
+		}
+		rewritten++
+	}
+}
+
+// RunMaintenance prunes the VAAs older than the retention period and compacts the database at the specified interval
+// until the context is canceled. A retention of zero disables pruning, the database is still compacted.
+func (d *Database) RunMaintenance(ctx context.Context, logger *zap.Logger, retention time.Duration, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if retention != 0 {
+				result, err := d.PruneVaas(time.Now().Add(-retention), false)
+				if err != nil {
+					// Pruning is retried at the next interval, a failure should not take the node down.
+					logger.Error("failed to prune VAAs", zap.Error(err))
+				} else {
+					logger.Info("pruned VAAs",
+						zap.Int("deleted", result.Deleted),
+						zap.Int("kept", result.Kept),
+						zap.Int("governance", result.Governance),
+					)
+				}
+			}
+
+			rewritten, err := d.Compact()
+			if err != nil {
+				logger.Error("failed to compact database", zap.Error(err))
+			} else {
+				logger.Info("compacted database", zap.Int("rewrittenFiles", rewritten))
+			}
+		}
+	}
+}
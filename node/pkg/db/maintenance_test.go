@@ -0,0 +1,79 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+func TestPruneVaas(t *testing.T) {
+	dbPath := t.TempDir()
+	db := OpenDb(zap.NewNop(), &dbPath)
+	defer db.Close()
+
+	now := time.Now()
+	old := now.Add(-time.Hour * time.Duration(30*24+1))
+	recent := now.Add(-time.Hour * time.Duration(30*24-1))
+	emitterAddress := vaa.Address{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2}
+
+	store := func(chainID vaa.ChainID, addr vaa.Address, seq uint64, timestamp time.Time) {
+		require.NoError(t, storeVAA(db, &vaa.VAA{
+			Version:          uint8(1),
+			GuardianSetIndex: uint32(1),
+			Timestamp:        timestamp,
+			Nonce:            uint32(1),
+			Sequence:         seq,
+			ConsistencyLevel: uint8(32),
+			EmitterChain:     chainID,
+			EmitterAddress:   addr,
+			Payload:          []byte{97, 97, 97, 97, 97, 97},
+		}))
+	}
+
+	for seq := uint64(0); seq < 10; seq++ {
+		store(vaa.ChainIDEthereum, emitterAddress, seq, old)
+		store(vaa.ChainIDSolana, emitterAddress, seq, old)
+		store(vaa.GovernanceChain, vaa.GovernanceEmitter, seq, old)
+	}
+	for seq := uint64(10); seq < 15; seq++ {
+		store(vaa.ChainIDEthereum, emitterAddress, seq, recent)
+	}
+
+	cutoff := now.Add(-time.Hour * 24 * 30)
+
+	result, err := db.PruneVaas(cutoff, true)
+	require.NoError(t, err)
+	assert.Equal(t, PruneResult{Deleted: 20, Kept: 5, Governance: 10}, result)
+
+	// Nothing is deleted in log only mode.
+	ethCount, _, err := countVAAs(db, vaa.ChainIDEthereum)
+	require.NoError(t, err)
+	assert.Equal(t, 15, ethCount)
+
+	result, err = db.PruneVaas(cutoff, false)
+	require.NoError(t, err)
+	assert.Equal(t, PruneResult{Deleted: 20, Kept: 5, Governance: 10}, result)
+
+	ethCount, _, err = countVAAs(db, vaa.ChainIDEthereum)
+	require.NoError(t, err)
+	assert.Equal(t, 5, ethCount)
+
+	// The governance VAAs are the only Solana VAAs left.
+	solCount, _, err := countVAAs(db, vaa.ChainIDSolana)
+	require.NoError(t, err)
+	assert.Equal(t, 10, solCount)
+
+	_, err = db.GetSignedVAABytes(VAAID{EmitterChain: vaa.GovernanceChain, EmitterAddress: vaa.GovernanceEmitter, Sequence: 0})
+	require.NoError(t, err)
+
+	result, err = db.PruneVaas(cutoff, false)
+	require.NoError(t, err)
+	assert.Equal(t, PruneResult{Deleted: 0, Kept: 5, Governance: 10}, result)
+
+	_, err = db.Compact()
+	require.NoError(t, err)
+}
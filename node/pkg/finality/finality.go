@@ -0,0 +1,166 @@
+// Package finality describes how watchers determine that a block of a chain can no longer be reorganized, so that the
+// messages it contains can be observed. Each chain has a default strategy derived from the finality the SDK knows for
+// it, which operators may override per chain.
+package finality
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// Mode is the way a watcher determines finality.
+type Mode uint8
+
+const (
+	// ModeUnknown means no strategy is configured.
+	ModeUnknown Mode = iota
+	// ModeInstant treats every block as final as soon as it is produced.
+	ModeInstant
+	// ModeFinalized waits for blocks to be reported by the "finalized" block tag.
+	ModeFinalized
+	// ModeSafe waits for the "finalized" block tag, and for the "safe" block tag for messages requesting it.
+	ModeSafe
+	// ModeDepth treats a block as final once a number of blocks were produced on top of it.
+	ModeDepth
+	// ModeCheckpoint relies on checkpoints or a finalizer specific to the chain.
+	ModeCheckpoint
+)
+
+func (m Mode) String() string {
+	switch m {
+	case ModeInstant:
+		return "instant"
+	case ModeFinalized:
+		return "finalized"
+	case ModeSafe:
+		return "safe"
+	case ModeDepth:
+		return "depth"
+	case ModeCheckpoint:
+		return "checkpoint"
+	default:
+		return "unknown"
+	}
+}
+
+// Strategy is the finality strategy of a chain.
+type Strategy struct {
+	Mode Mode
+	// Depth is the number of blocks that must be produced on top of a block in ModeDepth.
+	Depth uint64
+}
+
+// String formats the strategy the way Parse accepts it, e.g. "safe" or "depth:12".
+func (s Strategy) String() string {
+	if s.Mode == ModeDepth {
+		return fmt.Sprintf("%s:%d", ModeDepth, s.Depth)
+	}
+	return s.Mode.String()
+}
+
+// IsSet reports whether a strategy is configured.
+func (s Strategy) IsSet() bool {
+	return s.Mode != ModeUnknown
+}
+
+// Parse parses a strategy of the form "instant", "finalized", "safe", "checkpoint" or "depth:<blocks>". The empty
+// string parses to the unset strategy.
+func Parse(value string) (Strategy, error) {
+	switch value {
+	case "":
+		return Strategy{}, nil
+	case "instant":
+		return Strategy{Mode: ModeInstant}, nil
+	case "finalized":
+		return Strategy{Mode: ModeFinalized}, nil
+	case "safe":
+		return Strategy{Mode: ModeSafe}, nil
+	case "checkpoint":
+		return Strategy{Mode: ModeCheckpoint}, nil
+	}
+
+	if depthStr, found := strings.CutPrefix(value, "depth:"); found {
+		depth, err := strconv.ParseUint(depthStr, 10, 64)
+		if err != nil || depth == 0 {
+			return Strategy{}, fmt.Errorf("invalid finality depth %q, must be a positive number of blocks", depthStr)
+		}
+		return Strategy{Mode: ModeDepth, Depth: depth}, nil
+	}
+
+	return Strategy{}, fmt.Errorf("invalid finality %q, must be instant, finalized, safe, checkpoint or depth:<blocks>", value)
+}
+
+// Default returns the strategy of the chain according to the SDK chain registry, see vaa.FinalityForChain. It is
+// unset for chains without a known finality.
+func Default(chainID vaa.ChainID) Strategy {
+	switch vaa.FinalityForChain(chainID) {
+	case vaa.FinalityInstant:
+		return Strategy{Mode: ModeInstant}
+	case vaa.FinalityFinalized:
+		return Strategy{Mode: ModeFinalized}
+	case vaa.FinalitySafe:
+		return Strategy{Mode: ModeSafe}
+	case vaa.FinalityCustom:
+		return Strategy{Mode: ModeCheckpoint}
+	default:
+		return Strategy{}
+	}
+}
+
+// Effective returns the override if it is set, and the default strategy of the chain otherwise.
+func Effective(chainID vaa.ChainID, override Strategy) Strategy {
+	if override.IsSet() {
+		return override
+	}
+	return Default(chainID)
+}
+
+// Check returns an error if the mode of the strategy is not one of the supported ones.
+func (s Strategy) Check(supported ...Mode) error {
+	for _, mode := range supported {
+		if s.Mode == mode {
+			return nil
+		}
+	}
+	names := make([]string, len(supported))
+	for i, mode := range supported {
+		names[i] = mode.String()
+	}
+	return fmt.Errorf("unsupported finality %q, must be one of %s", s, strings.Join(names, ", "))
+}
+
+// ParseOverrides parses finality override flags of the form <chain>=<strategy>, where the chain is a chain name or ID,
+// e.g. "ethereum=finalized" or "30=depth:64".
+func ParseOverrides(values []string) (map[vaa.ChainID]Strategy, error) {
+	overrides := make(map[vaa.ChainID]Strategy, len(values))
+	for _, value := range values {
+		chainStr, strategyStr, found := strings.Cut(value, "=")
+		if !found || chainStr == "" || strategyStr == "" {
+			return nil, fmt.Errorf("invalid finality override %q, expected <chain>=<strategy>", value)
+		}
+		chainID, err := parseChain(chainStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid finality override %q: %w", value, err)
+		}
+		strategy, err := Parse(strategyStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid finality override %q: %w", value, err)
+		}
+		if _, exists := overrides[chainID]; exists {
+			return nil, fmt.Errorf("duplicate finality override for chain %s", chainID)
+		}
+		overrides[chainID] = strategy
+	}
+	return overrides, nil
+}
+
+// parseChain parses a chain name or ID.
+func parseChain(value string) (vaa.ChainID, error) {
+	if n, err := strconv.ParseUint(value, 10, 16); err == nil {
+		return vaa.ChainIDFromNumber(n)
+	}
+	return vaa.ChainIDFromString(value)
+}
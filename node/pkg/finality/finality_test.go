@@ -0,0 +1,80 @@
+package finality
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected Strategy
+	}{
+		{"", Strategy{}},
+		{"instant", Strategy{Mode: ModeInstant}},
+		{"finalized", Strategy{Mode: ModeFinalized}},
+		{"safe", Strategy{Mode: ModeSafe}},
+		{"checkpoint", Strategy{Mode: ModeCheckpoint}},
+		{"depth:12", Strategy{Mode: ModeDepth, Depth: 12}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.value, func(t *testing.T) {
+			s, err := Parse(tc.value)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, s)
+			if tc.value != "" {
+				assert.Equal(t, tc.value, s.String())
+			}
+		})
+	}
+
+	for _, value := range []string{"latest", "custom", "depth", "depth:", "depth:0", "depth:-1", "depth:abc"} {
+		t.Run(value, func(t *testing.T) {
+			_, err := Parse(value)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestDefaultAndEffective(t *testing.T) {
+	assert.Equal(t, Strategy{Mode: ModeSafe}, Default(vaa.ChainIDEthereum))
+	assert.Equal(t, Strategy{Mode: ModeFinalized}, Default(vaa.ChainIDSolana))
+	assert.Equal(t, Strategy{Mode: ModeInstant}, Default(vaa.ChainIDAvalanche))
+	assert.Equal(t, Strategy{Mode: ModeCheckpoint}, Default(vaa.ChainIDCelo))
+	assert.False(t, Default(vaa.ChainIDGnosis).IsSet())
+
+	override := Strategy{Mode: ModeDepth, Depth: 64}
+	assert.Equal(t, override, Effective(vaa.ChainIDEthereum, override))
+	assert.Equal(t, Strategy{Mode: ModeSafe}, Effective(vaa.ChainIDEthereum, Strategy{}))
+}
+
+func TestCheck(t *testing.T) {
+	s := Strategy{Mode: ModeDepth, Depth: 3}
+	assert.NoError(t, s.Check(ModeSafe, ModeDepth))
+	assert.ErrorContains(t, s.Check(ModeSafe, ModeFinalized), `unsupported finality "depth:3", must be one of safe, finalized`)
+}
+
+func TestParseOverrides(t *testing.T) {
+	overrides, err := ParseOverrides([]string{"ethereum=finalized", "30=depth:64"})
+	require.NoError(t, err)
+	assert.Equal(t, map[vaa.ChainID]Strategy{
+		vaa.ChainIDEthereum: {Mode: ModeFinalized},
+		vaa.ChainIDBase:     {Mode: ModeDepth, Depth: 64},
+	}, overrides)
+
+	for _, values := range [][]string{
+		{"ethereum"},
+		{"=safe"},
+		{"ethereum="},
+		{"nochain=safe"},
+		{"70000=safe"},
+		{"ethereum=latest"},
+		{"ethereum=safe", "2=finalized"},
+	} {
+		_, err := ParseOverrides(values)
+		assert.Error(t, err, values)
+	}
+}
@@ -0,0 +1,30 @@
+package governor
+
+import (
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// corridor is a pair of chains between which transfers of flow cancel tokens can 'Flow Cancel'. Corridors are undirected:
+// a transfer in either direction between the two chains reduces the 'daily usage' of the Governor configured for its destination chain.
+// Use newCorridor to create a corridor so that the chains are always stored in the same order.
+type corridor struct {
+	first  vaa.ChainID
+	second vaa.ChainID
+}
+
+// newCorridor creates a corridor between two chains. The chains are ordered so that the same corridor is created regardless of the direction.
+func newCorridor(a vaa.ChainID, b vaa.ChainID) corridor {
+	if a > b {
+		a, b = b, a
+	}
+	return corridor{first: a, second: b}
+}
+
+// FlowCancelCorridors returns the list of corridors for which flow cancelling is enabled in mainnet. Transfers of tokens in
+// the Flow Cancel Token List only flow cancel when they travel along one of these corridors. This allows flow cancelling
+// to be enabled for chain pairs where flows are expected to be balanced, without affecting the limits of any other chain.
+func FlowCancelCorridors() []corridor {
+	return []corridor{
+		newCorridor(vaa.ChainIDEthereum, vaa.ChainIDSui),
+	}
+}
@@ -21,7 +21,8 @@ package governor
 //
 // The set of tokens to be monitored is specified in tokens.go, which can be auto generated using the tool in node/hack/governor. See the README there.
 //
-// The set of chains to be monitored is specified in chains.go, which can be edited by hand.
+// The set of chains to be monitored is specified in chains.go, which can be edited by hand. The limits of a monitored chain
+// can be updated at runtime by a governance VAA, as documented in governor_governance.go.
 //
 // To enable the chain governor, you must specified the --chainGovernorEnabled guardiand command line argument.
 
@@ -52,6 +53,14 @@ const (
 
 const maxEnqueuedTime = time.Hour * 24
 
+// enqueueReason describes why a transfer was enqueued. It is reported by the status queries.
+type enqueueReason string
+
+const (
+	enqueueReasonBigTransaction enqueueReason = "big_transaction"
+	enqueueReasonDailyLimit     enqueueReason = "daily_limit"
+)
+
 type (
 	// Layout of the config data for each token
 	tokenConfigEntry struct {
@@ -94,6 +103,7 @@ type (
 		token  *tokenEntry // Store a reference to the token so we can get the current price to compute the value each interval.
 		amount *big.Int
 		hash   string
+		reason enqueueReason
 		dbData db.PendingTransfer // This info gets persisted in the DB.
 	}
 
@@ -114,6 +124,7 @@ type (
 		dailyLimit              uint64
 		bigTransactionSize      uint64
 		checkForBigTransactions bool
+		configTimestamp         time.Time // Timestamp of the governance VAA that set the limits, zero if they are the configured ones.
 
 		transfers []transfer
 		pending   []*pendingEntry
@@ -201,6 +212,7 @@ type ChainGovernor struct {
 	statusPublishCounter  int64
 	configPublishCounter  int64
 	flowCancelEnabled     bool
+	flowCancelCorridors   map[corridor]struct{} // protected by `mutex`
 	coinGeckoApiKey       string
 }
 
@@ -218,6 +230,7 @@ func NewChainGovernor(
 		tokensByCoinGeckoId: make(map[string][]*tokenEntry),
 		chains:              make(map[vaa.ChainID]*chainEntry),
 		msgsSeen:            make(map[string]bool),
+		flowCancelCorridors: make(map[corridor]struct{}),
 		env:                 env,
 		flowCancelEnabled:   flowCancelEnabled,
 		coinGeckoApiKey:     coinGeckoApiKey,
@@ -248,6 +261,16 @@ func (gov *ChainGovernor) IsFlowCancelEnabled() bool {
 	return gov.flowCancelEnabled
 }
 
+// flowCancelsAlongCorridor returns true if a transfer of the token from the emitter chain to the target chain should flow cancel.
+// This requires that the token can flow cancel and that the two chains form a configured flow cancel corridor.
+func (gov *ChainGovernor) flowCancelsAlongCorridor(token *tokenEntry, emitterChain vaa.ChainID, targetChain vaa.ChainID) bool {
+	if !token.flowCancels {
+		return false
+	}
+	_, exists := gov.flowCancelCorridors[newCorridor(emitterChain, targetChain)]
+	return exists
+}
+
 func (gov *ChainGovernor) initConfig() error {
 	gov.mutex.Lock()
 	defer gov.mutex.Unlock()
@@ -256,15 +279,17 @@ func (gov *ChainGovernor) initConfig() error {
 	configChains := chainList()
 	configTokens := tokenList()
 	flowCancelTokens := []tokenConfigEntry{}
+	flowCancelCorridors := []corridor{}
 
 	if gov.env == common.UnsafeDevNet {
-		configTokens, flowCancelTokens, configChains = gov.initDevnetConfig()
+		configTokens, flowCancelTokens, flowCancelCorridors, configChains = gov.initDevnetConfig()
 	} else if gov.env == common.TestNet {
-		configTokens, flowCancelTokens, configChains = gov.initTestnetConfig()
+		configTokens, flowCancelTokens, flowCancelCorridors, configChains = gov.initTestnetConfig()
 	} else {
 		// mainnet, unit tests, or accountant-mock
 		if gov.flowCancelEnabled {
 			flowCancelTokens = FlowCancelTokenList()
+			flowCancelCorridors = FlowCancelCorridors()
 		}
 	}
 
@@ -349,6 +374,18 @@ func (gov *ChainGovernor) initConfig() error {
 				)
 			}
 		}
+
+		// Flow cancel tokens only flow cancel when they are transferred along one of the configured corridors.
+		for _, c := range flowCancelCorridors {
+			if c.first == c.second {
+				return fmt.Errorf("flow cancel corridor must be between two different chains: %s", c.first)
+			}
+			gov.flowCancelCorridors[newCorridor(c.first, c.second)] = struct{}{}
+
+			if gov.env != common.GoTest {
+				gov.logger.Info("will flow cancel along corridor:", zap.Stringer("first", c.first), zap.Stringer("second", c.second))
+			}
+		}
 	}
 
 	if len(gov.tokens) == 0 {
@@ -516,8 +553,10 @@ func (gov *ChainGovernor) ProcessMsgForTime(msg *common.MessagePublication, now
 
 	enqueueIt := false
 	var releaseTime time.Time
+	var reason enqueueReason
 	if emitterChainEntry.isBigTransfer(value) {
 		enqueueIt = true
+		reason = enqueueReasonBigTransaction
 		releaseTime = now.Add(maxEnqueuedTime)
 		gov.logger.Error("enqueuing vaa because it is a big transaction",
 			zap.Uint64("value", value),
@@ -531,6 +570,7 @@ func (gov *ChainGovernor) ProcessMsgForTime(msg *common.MessagePublication, now
 		)
 	} else if newTotalValue > emitterChainEntry.dailyLimit {
 		enqueueIt = true
+		reason = enqueueReasonDailyLimit
 		releaseTime = now.Add(maxEnqueuedTime)
 		gov.logger.Error("enqueuing vaa because it would exceed the daily limit",
 			zap.Uint64("value", value),
@@ -558,7 +598,7 @@ func (gov *ChainGovernor) ProcessMsgForTime(msg *common.MessagePublication, now
 
 		emitterChainEntry.pending = append(
 			emitterChainEntry.pending,
-			&pendingEntry{token: token, amount: payload.Amount, hash: hash, dbData: dbData},
+			&pendingEntry{token: token, amount: payload.Amount, hash: hash, reason: reason, dbData: dbData},
 		)
 		gov.msgsSeen[hash] = transferEnqueued
 		return false, nil
@@ -617,7 +657,7 @@ func (gov *ChainGovernor) ProcessMsgForTime(msg *common.MessagePublication, now
 	tokenEntry := gov.tokens[key]
 	if tokenEntry != nil {
 		// Mandatory check to ensure that the token should be able to reduce the Governor limit.
-		if tokenEntry.flowCancels {
+		if gov.flowCancelsAlongCorridor(tokenEntry, msg.EmitterChain, payload.TargetChain) {
 			if destinationChainEntry, ok := gov.chains[payload.TargetChain]; ok {
 				if err := destinationChainEntry.addFlowCancelTransferFromDbTransfer(&dbTransfer); err != nil {
 					return false, err
@@ -860,7 +900,7 @@ func (gov *ChainGovernor) CheckPendingForTime(now time.Time) ([]*common.MessageP
 						tokenEntry := gov.tokens[key]
 						if tokenEntry != nil {
 							// Mandatory check to ensure that the token should be able to reduce the Governor limit.
-							if tokenEntry.flowCancels {
+							if gov.flowCancelsAlongCorridor(tokenEntry, dbTransfer.EmitterChain, dbTransfer.TargetChain) {
 								if destinationChainEntry, ok := gov.chains[payload.TargetChain]; ok {
 
 									if err := destinationChainEntry.addFlowCancelTransferFromDbTransfer(&dbTransfer); err != nil {
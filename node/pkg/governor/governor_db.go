@@ -28,6 +28,17 @@ func (gov *ChainGovernor) loadFromDBAlreadyLocked() error {
 		return err
 	}
 
+	configs, err := gov.db.GetChainConfigs()
+	if err != nil {
+		gov.logger.Error("failed to reload chain configs from db", zap.Error(err))
+		return err
+	}
+
+	// The limits have to be in place before the transfers are reloaded.
+	for _, cfg := range configs {
+		gov.reloadChainConfig(cfg)
+	}
+
 	now := time.Now()
 	if len(pending) != 0 {
 		sort.SliceStable(pending, func(i, j int) bool {
@@ -159,7 +170,13 @@ func (gov *ChainGovernor) reloadPendingTransfer(pending *db.PendingTransfer) {
 
 	// Note: no flow cancel added here. We only want to add an inverse, flow-cancel transfer when the transfer is
 	// released from the pending queue, not when it's added.
-	ce.pending = append(ce.pending, &pendingEntry{token: token, amount: payload.Amount, hash: hash, dbData: *pending})
+	// The reason is not persisted, so derive it from the current value of the transfer.
+	reason := enqueueReasonDailyLimit
+	if value, err := computeValue(payload.Amount, token); err == nil && ce.isBigTransfer(value) {
+		reason = enqueueReasonBigTransaction
+	}
+
+	ce.pending = append(ce.pending, &pendingEntry{token: token, amount: payload.Amount, hash: hash, reason: reason, dbData: *pending})
 	gov.msgsSeen[hash] = transferEnqueued
 }
 
@@ -249,7 +266,7 @@ func (gov *ChainGovernor) reloadTransfer(xfer *db.Transfer) error {
 	tokenEntry := gov.tokens[tk]
 	if tokenEntry != nil {
 		// Mandatory check to ensure that the token should be able to reduce the Governor limit.
-		if tokenEntry.flowCancels {
+		if gov.flowCancelsAlongCorridor(tokenEntry, xfer.EmitterChain, xfer.TargetChain) {
 			if destinationChainEntry, ok := gov.chains[xfer.TargetChain]; ok {
 				if err := destinationChainEntry.addFlowCancelTransferFromDbTransfer(xfer); err != nil {
 					gov.logger.Error("could not add flow canceling transfer to destination chain",
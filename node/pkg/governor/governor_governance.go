@@ -0,0 +1,121 @@
+// This file contains the code to update the limits of a governed chain from a governance VAA.
+//
+// The limits in chains.go are only the defaults. Once the guardians have signed a governance VAA for the "Governor" module
+// with the chain config update action, every node applies it when it sees the VAA and persists the new limits, so that
+// they survive a restart. Updates are ordered by the timestamp of the VAA, an older VAA never replaces newer limits.
+
+package governor
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/certusone/wormhole/node/pkg/db"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+
+	"go.uber.org/zap"
+)
+
+// governanceHeaderLen is the length of the module, action and target chain that precede the body of a governance payload.
+const governanceHeaderLen = 32 + 1 + 2
+
+// isChainConfigUpdateVAA returns true if the VAA is a governance VAA that updates the limits of a governed chain.
+func isChainConfigUpdateVAA(v *vaa.VAA) bool {
+	if v.EmitterChain != vaa.GovernanceChain || v.EmitterAddress != vaa.GovernanceEmitter {
+		return false
+	}
+
+	if len(v.Payload) < governanceHeaderLen {
+		return false
+	}
+
+	return bytes.Equal(v.Payload[0:32], vaa.GovernorModule[:]) && vaa.GovernanceAction(v.Payload[32]) == vaa.GovernorActionChainConfigUpdate
+}
+
+// ProcessGovernanceVAA applies a chain config update from a governance VAA. The VAA must have been verified by the caller.
+// VAAs that do not update the governor config are ignored.
+func (gov *ChainGovernor) ProcessGovernanceVAA(v *vaa.VAA) error {
+	if !isChainConfigUpdateVAA(v) {
+		return nil
+	}
+
+	targetChain := vaa.ChainID(binary.BigEndian.Uint16(v.Payload[33:35]))
+	if targetChain != vaa.ChainIDUnset {
+		return fmt.Errorf("governor chain config update has an unexpected target chain: %v", targetChain)
+	}
+
+	var body vaa.BodyGovernorChainConfigUpdate
+	if err := body.Deserialize(v.Payload[governanceHeaderLen:]); err != nil {
+		return fmt.Errorf("failed to deserialize governor chain config update: %w", err)
+	}
+
+	return gov.updateChainConfig(&db.ChainConfig{
+		EmitterChain:       body.EmitterChain,
+		DailyLimit:         body.DailyLimit,
+		BigTransactionSize: body.BigTransactionSize,
+		Timestamp:          v.Timestamp,
+	}, v.MessageID())
+}
+
+// updateChainConfig replaces the limits of a governed chain and persists them. It grabs the lock.
+func (gov *ChainGovernor) updateChainConfig(cfg *db.ChainConfig, msgID string) error {
+	gov.mutex.Lock()
+	defer gov.mutex.Unlock()
+
+	ce, exists := gov.chains[cfg.EmitterChain]
+	if !exists {
+		return fmt.Errorf("governor chain config update is for a chain that is not governed: %v", cfg.EmitterChain)
+	}
+
+	if !cfg.Timestamp.After(ce.configTimestamp) {
+		gov.logger.Info("ignoring governor chain config update that is not newer than the current config",
+			zap.String("msgID", msgID),
+			zap.Stringer("emitterChainId", cfg.EmitterChain),
+			zap.Time("timestamp", cfg.Timestamp),
+			zap.Time("configTimestamp", ce.configTimestamp),
+		)
+		return nil
+	}
+
+	if err := gov.db.StoreChainConfig(cfg); err != nil {
+		return fmt.Errorf("failed to store governor chain config update: %w", err)
+	}
+
+	gov.logger.Info("updating chain config from governance VAA",
+		zap.String("msgID", msgID),
+		zap.Stringer("emitterChainId", cfg.EmitterChain),
+		zap.String("oldDailyLimit", fmt.Sprint(ce.dailyLimit)),
+		zap.String("newDailyLimit", fmt.Sprint(cfg.DailyLimit)),
+		zap.Uint64("oldBigTransactionSize", ce.bigTransactionSize),
+		zap.Uint64("newBigTransactionSize", cfg.BigTransactionSize),
+	)
+
+	ce.applyConfig(cfg)
+	return nil
+}
+
+// reloadChainConfig applies limits that were persisted by a previous governance VAA. It should be called with the lock held.
+func (gov *ChainGovernor) reloadChainConfig(cfg *db.ChainConfig) {
+	ce, exists := gov.chains[cfg.EmitterChain]
+	if !exists {
+		gov.logger.Error("reloaded chain config for unsupported chain, ignoring it", zap.Stringer("emitterChainId", cfg.EmitterChain))
+		return
+	}
+
+	gov.logger.Info("reloaded chain config set by governance VAA",
+		zap.Stringer("emitterChainId", cfg.EmitterChain),
+		zap.String("dailyLimit", fmt.Sprint(cfg.DailyLimit)),
+		zap.Uint64("bigTransactionSize", cfg.BigTransactionSize),
+		zap.Time("timestamp", cfg.Timestamp),
+	)
+
+	ce.applyConfig(cfg)
+}
+
+func (ce *chainEntry) applyConfig(cfg *db.ChainConfig) {
+	ce.dailyLimit = cfg.DailyLimit
+	ce.bigTransactionSize = cfg.BigTransactionSize
+	ce.checkForBigTransactions = cfg.BigTransactionSize != 0
+	ce.configTimestamp = cfg.Timestamp
+}
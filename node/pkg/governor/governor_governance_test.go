@@ -0,0 +1,96 @@
+package governor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func chainConfigUpdateVAA(t *testing.T, timestamp time.Time, body vaa.BodyGovernorChainConfigUpdate) *vaa.VAA {
+	t.Helper()
+	payload, err := body.Serialize()
+	require.NoError(t, err)
+	return vaa.CreateGovernanceVAA(timestamp, 1, 1, 4, payload)
+}
+
+func TestProcessGovernanceVAAUpdatesChainConfig(t *testing.T) {
+	ctx := context.Background()
+	gov, err := newChainGovernorForTest(ctx)
+	require.NoError(t, err)
+
+	tokenBridgeAddrStr := "0x0290fb167208af455bb137780163b7b7a9a10c16" //nolint:gosec
+	err = gov.setChainForTesting(vaa.ChainIDEthereum, tokenBridgeAddrStr, 1000000, 0)
+	require.NoError(t, err)
+
+	ce := gov.chains[vaa.ChainIDEthereum]
+	assert.False(t, ce.checkForBigTransactions)
+
+	now := time.Unix(1654543099, 0)
+	v := chainConfigUpdateVAA(t, now, vaa.BodyGovernorChainConfigUpdate{EmitterChain: vaa.ChainIDEthereum, DailyLimit: 2000000, BigTransactionSize: 100000})
+	require.NoError(t, gov.ProcessGovernanceVAA(v))
+	assert.Equal(t, uint64(2000000), ce.dailyLimit)
+	assert.Equal(t, uint64(100000), ce.bigTransactionSize)
+	assert.True(t, ce.checkForBigTransactions)
+	assert.Equal(t, now, ce.configTimestamp)
+
+	// An update that is not newer than the current config is ignored.
+	v = chainConfigUpdateVAA(t, now, vaa.BodyGovernorChainConfigUpdate{EmitterChain: vaa.ChainIDEthereum, DailyLimit: 3000000})
+	require.NoError(t, gov.ProcessGovernanceVAA(v))
+	v = chainConfigUpdateVAA(t, now.Add(-time.Minute), vaa.BodyGovernorChainConfigUpdate{EmitterChain: vaa.ChainIDEthereum, DailyLimit: 3000000})
+	require.NoError(t, gov.ProcessGovernanceVAA(v))
+	assert.Equal(t, uint64(2000000), ce.dailyLimit)
+
+	// A newer update replaces the config and can disable the big transaction check.
+	v = chainConfigUpdateVAA(t, now.Add(time.Minute), vaa.BodyGovernorChainConfigUpdate{EmitterChain: vaa.ChainIDEthereum, DailyLimit: 3000000})
+	require.NoError(t, gov.ProcessGovernanceVAA(v))
+	assert.Equal(t, uint64(3000000), ce.dailyLimit)
+	assert.Equal(t, uint64(0), ce.bigTransactionSize)
+	assert.False(t, ce.checkForBigTransactions)
+}
+
+func TestProcessGovernanceVAAIgnoresOtherVAAs(t *testing.T) {
+	ctx := context.Background()
+	gov, err := newChainGovernorForTest(ctx)
+	require.NoError(t, err)
+
+	tokenBridgeAddrStr := "0x0290fb167208af455bb137780163b7b7a9a10c16" //nolint:gosec
+	err = gov.setChainForTesting(vaa.ChainIDEthereum, tokenBridgeAddrStr, 1000000, 0)
+	require.NoError(t, err)
+
+	now := time.Unix(1654543099, 0)
+
+	// A chain config update that was not emitted by the governance emitter.
+	v := chainConfigUpdateVAA(t, now, vaa.BodyGovernorChainConfigUpdate{EmitterChain: vaa.ChainIDEthereum, DailyLimit: 2000000})
+	v.EmitterChain = vaa.ChainIDEthereum
+	require.NoError(t, gov.ProcessGovernanceVAA(v))
+
+	// A governance VAA for another module.
+	payload, err := vaa.BodyWormchainChainRateLimitUpdate{EmitterChain: vaa.ChainIDEthereum, Limit: 10, WindowBlocks: 100}.Serialize()
+	require.NoError(t, err)
+	require.NoError(t, gov.ProcessGovernanceVAA(vaa.CreateGovernanceVAA(now, 1, 1, 4, payload)))
+
+	assert.Equal(t, uint64(1000000), gov.chains[vaa.ChainIDEthereum].dailyLimit)
+}
+
+func TestProcessGovernanceVAAFailures(t *testing.T) {
+	ctx := context.Background()
+	gov, err := newChainGovernorForTest(ctx)
+	require.NoError(t, err)
+
+	now := time.Unix(1654543099, 0)
+
+	v := chainConfigUpdateVAA(t, now, vaa.BodyGovernorChainConfigUpdate{EmitterChain: vaa.ChainIDPythNet, DailyLimit: 2000000})
+	assert.ErrorContains(t, gov.ProcessGovernanceVAA(v), "not governed")
+
+	v = chainConfigUpdateVAA(t, now, vaa.BodyGovernorChainConfigUpdate{EmitterChain: vaa.ChainIDEthereum, DailyLimit: 2000000})
+	v.Payload[34] = byte(vaa.ChainIDEthereum)
+	assert.ErrorContains(t, gov.ProcessGovernanceVAA(v), "unexpected target chain")
+
+	v = chainConfigUpdateVAA(t, now, vaa.BodyGovernorChainConfigUpdate{EmitterChain: vaa.ChainIDEthereum, DailyLimit: 2000000})
+	v.Payload = v.Payload[:len(v.Payload)-1]
+	assert.ErrorContains(t, gov.ProcessGovernanceVAA(v), "failed to deserialize")
+}
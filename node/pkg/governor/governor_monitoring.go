@@ -116,8 +116,8 @@ func (gov *ChainGovernor) Status() (resp string) {
 		if len(ce.pending) != 0 {
 			for idx, pe := range ce.pending {
 				value, _ := computeValue(pe.amount, pe.token)
-				s1 := fmt.Sprintf("chain: %v, pending[%v], value: %v, vaa: %v, timeStamp: %v, releaseTime: %v", ce.emitterChainId, idx, value,
-					pe.dbData.Msg.MessageIDString(), pe.dbData.Msg.Timestamp.String(), pe.dbData.ReleaseTime.String())
+				s1 := fmt.Sprintf("chain: %v, pending[%v], value: %v, vaa: %v, timeStamp: %v, releaseTime: %v, reason: %v", ce.emitterChainId, idx, value,
+					pe.dbData.Msg.MessageIDString(), pe.dbData.Msg.Timestamp.String(), pe.dbData.ReleaseTime.String(), pe.reason)
 				gov.logger.Info(s1)
 				resp += "   " + s1 + "\n"
 			}
@@ -382,6 +382,7 @@ func (gov *ChainGovernor) GetEnqueuedVAAs() []*publicrpcv1.GovernorGetEnqueuedVA
 				ReleaseTime:    uint32(pe.dbData.ReleaseTime.Unix()),
 				NotionalValue:  value,
 				TxHash:         pe.dbData.Msg.TxHash.String(),
+				EnqueueReason:  string(pe.reason),
 			})
 		}
 	}
@@ -488,7 +489,7 @@ var (
 		})
 )
 
-func (gov *ChainGovernor) CollectMetrics(ctx context.Context, hb *gossipv1.Heartbeat, sendC chan<- []byte, guardianSigner guardiansigner.GuardianSigner, ourAddr ethCommon.Address) {
+func (gov *ChainGovernor) CollectMetrics(ctx context.Context, hb *gossipv1.Heartbeat, sendC chan<- []byte, gossipSigner *guardiansigner.GossipSigner, ourAddr ethCommon.Address) {
 	gov.mutex.Lock()
 	defer gov.mutex.Unlock()
 
@@ -548,12 +549,12 @@ func (gov *ChainGovernor) CollectMetrics(ctx context.Context, hb *gossipv1.Heart
 	metricTotalEnqueuedVAAs.Set(float64(totalPending))
 
 	if startTime.After(gov.nextConfigPublishTime) {
-		gov.publishConfig(ctx, hb, sendC, guardianSigner, ourAddr)
+		gov.publishConfig(ctx, hb, sendC, gossipSigner, ourAddr)
 		gov.nextConfigPublishTime = startTime.Add(time.Minute * time.Duration(5))
 	}
 
 	if startTime.After(gov.nextStatusPublishTime) {
-		gov.publishStatus(ctx, hb, sendC, startTime, guardianSigner, ourAddr)
+		gov.publishStatus(ctx, hb, sendC, startTime, gossipSigner, ourAddr)
 		gov.nextStatusPublishTime = startTime.Add(time.Minute)
 	}
 }
@@ -561,7 +562,7 @@ func (gov *ChainGovernor) CollectMetrics(ctx context.Context, hb *gossipv1.Heart
 var governorMessagePrefixConfig = []byte("governor_config_000000000000000000|")
 var governorMessagePrefixStatus = []byte("governor_status_000000000000000000|")
 
-func (gov *ChainGovernor) publishConfig(ctx context.Context, hb *gossipv1.Heartbeat, sendC chan<- []byte, guardianSigner guardiansigner.GuardianSigner, ourAddr ethCommon.Address) {
+func (gov *ChainGovernor) publishConfig(ctx context.Context, hb *gossipv1.Heartbeat, sendC chan<- []byte, gossipSigner *guardiansigner.GossipSigner, ourAddr ethCommon.Address) {
 	chains := make([]*gossipv1.ChainGovernorConfig_Chain, 0)
 	// Iterate deterministically by accessing keys from this slice instead of the chainEntry map directly
 	for _, cid := range gov.chainIds {
@@ -601,16 +602,17 @@ func (gov *ChainGovernor) publishConfig(ctx context.Context, hb *gossipv1.Heartb
 
 	digest := ethCrypto.Keccak256Hash(append(governorMessagePrefixConfig, b...))
 
-	sig, err := guardianSigner.Sign(ctx, digest.Bytes())
+	sig, binding, err := gossipSigner.Sign(ctx, digest.Bytes())
 	if err != nil {
 		panic(err)
 	}
 
 	msg := gossipv1.GossipMessage{Message: &gossipv1.GossipMessage_SignedChainGovernorConfig{
 		SignedChainGovernorConfig: &gossipv1.SignedChainGovernorConfig{
-			Config:       b,
-			Signature:    sig,
-			GuardianAddr: ourAddr.Bytes(),
+			Config:           b,
+			Signature:        sig,
+			GuardianAddr:     ourAddr.Bytes(),
+			GossipKeyBinding: binding,
 		}}}
 
 	b, err = proto.Marshal(&msg)
@@ -621,7 +623,7 @@ func (gov *ChainGovernor) publishConfig(ctx context.Context, hb *gossipv1.Heartb
 	sendC <- b
 }
 
-func (gov *ChainGovernor) publishStatus(ctx context.Context, hb *gossipv1.Heartbeat, sendC chan<- []byte, startTime time.Time, guardianSigner guardiansigner.GuardianSigner, ourAddr ethCommon.Address) {
+func (gov *ChainGovernor) publishStatus(ctx context.Context, hb *gossipv1.Heartbeat, sendC chan<- []byte, startTime time.Time, gossipSigner *guardiansigner.GossipSigner, ourAddr ethCommon.Address) {
 	chains := make([]*gossipv1.ChainGovernorStatus_Chain, 0)
 	numEnqueued := 0
 	for chainId, ce := range gov.chains {
@@ -686,16 +688,17 @@ func (gov *ChainGovernor) publishStatus(ctx context.Context, hb *gossipv1.Heartb
 
 	digest := ethCrypto.Keccak256Hash(append(governorMessagePrefixStatus, b...))
 
-	sig, err := guardianSigner.Sign(ctx, digest.Bytes())
+	sig, binding, err := gossipSigner.Sign(ctx, digest.Bytes())
 	if err != nil {
 		panic(err)
 	}
 
 	msg := gossipv1.GossipMessage{Message: &gossipv1.GossipMessage_SignedChainGovernorStatus{
 		SignedChainGovernorStatus: &gossipv1.SignedChainGovernorStatus{
-			Status:       b,
-			Signature:    sig,
-			GuardianAddr: ourAddr.Bytes(),
+			Status:           b,
+			Signature:        sig,
+			GuardianAddr:     ourAddr.Bytes(),
+			GossipKeyBinding: binding,
 		}}}
 
 	b, err = proto.Marshal(&msg)
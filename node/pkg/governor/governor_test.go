@@ -1465,6 +1465,10 @@ func TestTransfersUpToAndOverTheLimit(t *testing.T) {
 	assert.Equal(t, uint64(2218274), valuePending)
 	assert.Equal(t, 3, len(gov.msgsSeen))
 
+	enqueuedVaas := gov.GetEnqueuedVAAs()
+	require.Equal(t, 1, len(enqueuedVaas))
+	assert.Equal(t, "daily_limit", enqueuedVaas[0].EnqueueReason)
+
 	// But a small one should still go through.
 	msg4 := common.MessagePublication{
 		TxHash:           hashFromString("0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4063"),
@@ -2250,6 +2254,7 @@ func TestNumDaysForReleaseTimerReset(t *testing.T) {
 	enqueuedVaas := gov.GetEnqueuedVAAs()
 	assert.Equal(t, len(enqueuedVaas), 1)
 	assert.Equal(t, enqueuedVaas[0].ReleaseTime, expectedReleaseTime)
+	assert.Equal(t, "big_transaction", enqueuedVaas[0].EnqueueReason)
 
 	// the release timer gets reset to 5 days
 	_, err = gov.resetReleaseTimerForTime(msg.MessageIDString(), now, 5)
@@ -3462,3 +3467,75 @@ func TestCheckedAddInt64ReturnsErrorOnUnderflow(t *testing.T) {
 	require.Error(t, err)
 	assert.Equal(t, int64(0), sum)
 }
+
+func TestNewCorridorIsUndirected(t *testing.T) {
+	assert.Equal(t, newCorridor(vaa.ChainIDEthereum, vaa.ChainIDSui), newCorridor(vaa.ChainIDSui, vaa.ChainIDEthereum))
+	assert.Equal(t, vaa.ChainIDEthereum, newCorridor(vaa.ChainIDSui, vaa.ChainIDEthereum).first)
+}
+
+func TestFlowCancelOnlyAlongConfiguredCorridors(t *testing.T) {
+	ctx := context.Background()
+	gov, err := newChainGovernorForTest(ctx)
+	require.NoError(t, err)
+	assert.NotNil(t, gov)
+
+	gov.setDayLengthInMinutes(24 * 60)
+	transferTime := time.Unix(int64(1654543099), 0)
+
+	// NOTE: Replace this Chain:Address pair if the Flow Cancel Token List is modified
+	var flowCancelTokenOriginAddress vaa.Address
+	flowCancelTokenOriginAddress, err = vaa.StringToAddress("c6fa7af3bedbad3a3d65f36aabc97431b1bbe4c2d2f6e0e47ca60203452f5d61")
+	require.NoError(t, err)
+
+	tokenBridgeAddrStrEthereum := "0x0290fb167208af455bb137780163b7b7a9a10c16" //nolint:gosec
+	tokenBridgeAddrEthereum, err := vaa.StringToAddress(tokenBridgeAddrStrEthereum)
+	require.NoError(t, err)
+	tokenBridgeAddrStrPolygon := "0x5a58505a96d1dbf8df91cb21b54419fc36e93fde" //nolint:gosec
+	recipientPolygon := "0x707f9118e33a9b8998bea41dd0d46f38bb963fc8"          //nolint:gosec
+
+	err = gov.setChainForTesting(vaa.ChainIDEthereum, tokenBridgeAddrStrEthereum, 10000, 0)
+	require.NoError(t, err)
+	err = gov.setChainForTesting(vaa.ChainIDPolygon, tokenBridgeAddrStrPolygon, 10000, 0)
+	require.NoError(t, err)
+	err = gov.setTokenForTesting(vaa.ChainIDSolana, flowCancelTokenOriginAddress.String(), "USDC", 1.0, true)
+	require.NoError(t, err)
+
+	// Ethereum to Polygon is not a mainnet corridor.
+	_, exists := gov.flowCancelCorridors[newCorridor(vaa.ChainIDEthereum, vaa.ChainIDPolygon)]
+	require.False(t, exists)
+
+	newMsg := func(sequence uint64) *common.MessagePublication {
+		return &common.MessagePublication{
+			TxHash:           hashFromString(fmt.Sprintf("0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a40%02d", sequence)),
+			Timestamp:        transferTime,
+			Nonce:            uint32(sequence),
+			Sequence:         sequence,
+			EmitterChain:     vaa.ChainIDEthereum,
+			EmitterAddress:   tokenBridgeAddrEthereum,
+			ConsistencyLevel: uint8(32),
+			Payload: buildMockTransferPayloadBytes(1,
+				vaa.ChainIDSolana,
+				flowCancelTokenOriginAddress.String(),
+				vaa.ChainIDPolygon,
+				recipientPolygon,
+				1000,
+			),
+		}
+	}
+
+	// Outside of a corridor, the transfer counts against Ethereum but does not flow cancel on Polygon.
+	result, err := gov.ProcessMsgForTime(newMsg(1), time.Now())
+	require.NoError(t, err)
+	assert.True(t, result)
+	assert.Equal(t, 1, len(gov.chains[vaa.ChainIDEthereum].transfers))
+	assert.Zero(t, len(gov.chains[vaa.ChainIDPolygon].transfers))
+
+	// Once the corridor is configured, the same kind of transfer flow cancels.
+	gov.flowCancelCorridors[newCorridor(vaa.ChainIDPolygon, vaa.ChainIDEthereum)] = struct{}{}
+	result, err = gov.ProcessMsgForTime(newMsg(2), time.Now())
+	require.NoError(t, err)
+	assert.True(t, result)
+	assert.Equal(t, 2, len(gov.chains[vaa.ChainIDEthereum].transfers))
+	require.Equal(t, 1, len(gov.chains[vaa.ChainIDPolygon].transfers))
+	assert.Equal(t, int64(-1000), gov.chains[vaa.ChainIDPolygon].transfers[0].value)
+}
@@ -0,0 +1,174 @@
+package guardiansigner
+
+/*
+	The DualKey signer is a type of signer that holds the guardian key of the
+	current guardian set and the key of the next guardian set at the same time,
+	so that a guardian can rotate its key without downtime. It signs with the
+	key that is part of the active guardian set, switching over as soon as the
+	guardian set update that contains the new key is activated.
+*/
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"sync"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+// GuardianSetAwareSigner is implemented by signers that hold more than one guardian key and select the key
+// to sign with based on the active guardian set.
+type GuardianSetAwareSigner interface {
+	GuardianSigner
+	// SetGuardianSet selects the key to sign with once the guardian set becomes active.
+	SetGuardianSet(ctx context.Context, gs *common.GuardianSet)
+	// KeyStatus returns which key the signer currently signs with.
+	KeyStatus(ctx context.Context) KeyStatus
+}
+
+// KeyStatus describes the keys held by a GuardianSetAwareSigner.
+type KeyStatus struct {
+	// The address of the key the signer currently signs with.
+	ActiveAddress ethcommon.Address
+	// The addresses of the old and the new key.
+	OldAddress ethcommon.Address
+	NewAddress ethcommon.Address
+	// Whether the signer signs with the new key.
+	UsingNewKey bool
+	// The index of the guardian set the key was selected for, and whether the set contains the active key.
+	// GuardianSetKnown is false until the first guardian set was activated.
+	GuardianSetIndex uint32
+	GuardianSetKnown bool
+	InGuardianSet    bool
+}
+
+// AsGuardianSetAware returns the GuardianSetAwareSigner behind the signer, if any. Signers created by
+// NewGuardianSignerFromUri are wrapped in a benchmark signer, which is looked through.
+func AsGuardianSetAware(signer GuardianSigner) (GuardianSetAwareSigner, bool) {
+	if benchmarkSigner, ok := signer.(*BenchmarkSigner); ok {
+		signer = benchmarkSigner.innerSigner
+	}
+	aware, ok := signer.(GuardianSetAwareSigner)
+	return aware, ok
+}
+
+// DualKeySigner is a signer that holds an old and a new guardian key during a guardian key rotation. It
+// signs with the old key until a guardian set containing the new key is activated.
+type DualKeySigner struct {
+	oldSigner  GuardianSigner
+	newSigner  GuardianSigner
+	oldAddress ethcommon.Address
+	newAddress ethcommon.Address
+
+	mu sync.Mutex
+	// useNew is true once a guardian set containing the new key was activated.
+	useNew bool
+	// gs is the guardian set the active key was selected for, or nil if none was activated yet.
+	gs *common.GuardianSet
+}
+
+// NewDualKeySignerFromUris creates the signers for the old and the new guardian key, and wraps the dual
+// key signer in a benchmark signer, like NewGuardianSignerFromUri does.
+func NewDualKeySignerFromUris(ctx context.Context, oldSignerUri string, newSignerUri string, unsafeDevMode bool) (GuardianSigner, error) {
+	oldSigner, err := newGuardianSigner(ctx, oldSignerUri, unsafeDevMode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the signer for the old guardian key: %w", err)
+	}
+
+	newSigner, err := newGuardianSigner(ctx, newSignerUri, unsafeDevMode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the signer for the new guardian key: %w", err)
+	}
+
+	dualKeySigner, err := NewDualKeySigner(ctx, oldSigner, newSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	return BenchmarkWrappedSigner(dualKeySigner), nil
+}
+
+// NewDualKeySigner creates a dual key signer from the signers of the old and the new guardian key.
+func NewDualKeySigner(ctx context.Context, oldSigner GuardianSigner, newSigner GuardianSigner) (*DualKeySigner, error) {
+	oldPubKey := oldSigner.PublicKey(ctx)
+	newPubKey := newSigner.PublicKey(ctx)
+	if oldPubKey.Equal(&newPubKey) {
+		return nil, fmt.Errorf("the old and the new guardian key are the same")
+	}
+
+	return &DualKeySigner{
+		oldSigner:  oldSigner,
+		newSigner:  newSigner,
+		oldAddress: ethcrypto.PubkeyToAddress(oldPubKey),
+		newAddress: ethcrypto.PubkeyToAddress(newPubKey),
+	}, nil
+}
+
+// SetGuardianSet switches to the new key once a guardian set containing it is activated. A guardian set
+// that contains neither key leaves the selection unchanged.
+func (ds *DualKeySigner) SetGuardianSet(ctx context.Context, gs *common.GuardianSet) {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+
+	if _, found := gs.KeyIndex(ds.newAddress); found {
+		ds.useNew = true
+	} else if _, found := gs.KeyIndex(ds.oldAddress); found {
+		ds.useNew = false
+	}
+	ds.gs = gs
+}
+
+// KeyStatus returns which key the signer currently signs with.
+func (ds *DualKeySigner) KeyStatus(ctx context.Context) KeyStatus {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+
+	status := KeyStatus{
+		ActiveAddress: ds.oldAddress,
+		OldAddress:    ds.oldAddress,
+		NewAddress:    ds.newAddress,
+		UsingNewKey:   ds.useNew,
+	}
+	if ds.useNew {
+		status.ActiveAddress = ds.newAddress
+	}
+	if ds.gs != nil {
+		_, status.InGuardianSet = ds.gs.KeyIndex(status.ActiveAddress)
+		status.GuardianSetIndex = ds.gs.Index
+		status.GuardianSetKnown = true
+	}
+	return status
+}
+
+func (ds *DualKeySigner) active() GuardianSigner {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+
+	if ds.useNew {
+		return ds.newSigner
+	}
+	return ds.oldSigner
+}
+
+// Sign signs the hash with the active key.
+func (ds *DualKeySigner) Sign(ctx context.Context, hash []byte) ([]byte, error) {
+	return ds.active().Sign(ctx, hash)
+}
+
+// PublicKey returns the public key of the active key.
+func (ds *DualKeySigner) PublicKey(ctx context.Context) ecdsa.PublicKey {
+	return ds.active().PublicKey(ctx)
+}
+
+// Verify verifies a signature against the active key.
+func (ds *DualKeySigner) Verify(ctx context.Context, sig []byte, hash []byte) (bool, error) {
+	return ds.active().Verify(ctx, sig, hash)
+}
+
+// Return the signer type as "dualkey".
+func (ds *DualKeySigner) TypeAsString() string {
+	return "dualkey"
+}
@@ -0,0 +1,156 @@
+package guardiansigner
+
+/*
+	The Failover signer is a type of signer that wraps several signers holding
+	the same key, e.g. a primary and a standby HSM. Signing requests go to the
+	first signer that is healthy. When a signer fails, requests fail over to
+	the next one, and the failed signer is retried after a while.
+*/
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// How long a signer that failed is skipped before it is tried again.
+	FAILOVER_SIGNER_RETRY_INTERVAL = time.Second * 30
+
+	guardianSignerFailovers = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_guardian_signer_failovers_total",
+			Help: "Total number of times a signing request failed over from one Guardian signer to the next, grouped by the index of the failed signer",
+		}, []string{"signer"})
+)
+
+// FailoverSigner is a signer that signs with the first of its signers that succeeds. The URI is
+// expected to be in the format failover://<signer-uri>,<signer-uri>,... with each signer
+// holding the same key.
+type FailoverSigner struct {
+	signers []GuardianSigner
+
+	mu sync.Mutex
+	// failedUntil holds the time until which each signer is skipped after failing.
+	failedUntil []time.Time
+}
+
+// NewFailoverSigner creates a signer for each comma-separated signer URI and checks that they all
+// hold the same key.
+func NewFailoverSigner(ctx context.Context, unsafeDevMode bool, signerUris string) (*FailoverSigner, error) {
+	uris := strings.Split(signerUris, ",")
+	if len(uris) < 2 {
+		return nil, errors.New("failover signer requires at least two signer URIs")
+	}
+
+	signers := make([]GuardianSigner, 0, len(uris))
+	for i, uri := range uris {
+		signerType, _, err := ParseSignerUri(uri)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signer URI at index %d: %w", i, err)
+		}
+		if signerType == FailoverSignerType {
+			return nil, errors.New("failover signers cannot be nested")
+		}
+
+		signer, err := newGuardianSigner(ctx, uri, unsafeDevMode)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create signer at index %d: %w", i, err)
+		}
+		signers = append(signers, signer)
+	}
+
+	pubKey := signers[0].PublicKey(ctx)
+	for i, signer := range signers[1:] {
+		other := signer.PublicKey(ctx)
+		if !pubKey.Equal(&other) {
+			return nil, fmt.Errorf("signer at index %d holds a different key than the signer at index 0", i+1)
+		}
+	}
+
+	return NewFailoverSignerFromSigners(signers), nil
+}
+
+// NewFailoverSignerFromSigners creates a failover signer from signers that hold the same key.
+func NewFailoverSignerFromSigners(signers []GuardianSigner) *FailoverSigner {
+	return &FailoverSigner{
+		signers:     signers,
+		failedUntil: make([]time.Time, len(signers)),
+	}
+}
+
+// Sign signs the hash with the first healthy signer, failing over to the next signers if it fails.
+// If all signers recently failed, they are all tried anyway.
+func (fs *FailoverSigner) Sign(ctx context.Context, hash []byte) ([]byte, error) {
+	var errs []error
+	for _, i := range fs.order(time.Now()) {
+		sig, err := fs.signers[i].Sign(ctx, hash)
+		if err == nil {
+			fs.markHealthy(i)
+			return sig, nil
+		}
+
+		errs = append(errs, fmt.Errorf("signer %d: %w", i, err))
+		fs.markFailed(i, time.Now())
+		guardianSignerFailovers.WithLabelValues(fmt.Sprint(i)).Inc()
+
+		// Don't fail over if the caller gave up.
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	return nil, fmt.Errorf("all guardian signers failed: %w", errors.Join(errs...))
+}
+
+// order returns the indexes of the signers in the order they should be tried: the healthy ones
+// first, then the ones that recently failed.
+func (fs *FailoverSigner) order(now time.Time) []int {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	healthy := make([]int, 0, len(fs.signers))
+	failed := make([]int, 0)
+	for i := range fs.signers {
+		if now.Before(fs.failedUntil[i]) {
+			failed = append(failed, i)
+		} else {
+			healthy = append(healthy, i)
+		}
+	}
+	return append(healthy, failed...)
+}
+
+func (fs *FailoverSigner) markFailed(i int, now time.Time) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	fs.failedUntil[i] = now.Add(FAILOVER_SIGNER_RETRY_INTERVAL)
+}
+
+func (fs *FailoverSigner) markHealthy(i int) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	fs.failedUntil[i] = time.Time{}
+}
+
+// PublicKey returns the public key shared by the signers.
+func (fs *FailoverSigner) PublicKey(ctx context.Context) ecdsa.PublicKey {
+	return fs.signers[0].PublicKey(ctx)
+}
+
+// Verify verifies a signature using the first signer, since they all hold the same key.
+func (fs *FailoverSigner) Verify(ctx context.Context, sig []byte, hash []byte) (bool, error) {
+	return fs.signers[0].Verify(ctx, sig, hash)
+}
+
+// Return the signer type as "failover".
+func (fs *FailoverSigner) TypeAsString() string {
+	return "failover"
+}
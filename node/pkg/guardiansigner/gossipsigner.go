@@ -0,0 +1,170 @@
+package guardiansigner
+
+/*
+	The gossip signer signs the non-consensus gossip messages of a guardian, i.e. heartbeats and governor
+	messages. By default these are signed with the guardian key, like everything else. If a separate gossip
+	key is configured, the guardian key signs a binding of the gossip key, and the gossip key signs the
+	messages. The binding is attached to every message, so that receivers can verify the messages without
+	any state. A binding is only valid on one P2P network and expires after GossipKeyBindingValidity, which
+	limits what a leaked gossip key can be used for. The guardian key signs a new binding before the old one
+	expires.
+*/
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"google.golang.org/protobuf/proto"
+)
+
+var gossipKeyBindingPrefix = []byte("gossip_key_binding_000000000000000|")
+
+// minGossipKeyBindingLength is the length of the shortest binding that can verify: the guardian and gossip key
+// addresses, each with its field tag and length, and an expiration time of at least one byte with its field tag.
+const minGossipKeyBindingLength = 2*(2+ethcommon.AddressLength) + 2
+
+// GossipKeyBindingValidity is how long a gossip key binding is valid after it was signed.
+const GossipKeyBindingValidity = 24 * time.Hour
+
+// gossipKeyBindingRenewal is the remaining validity below which a new binding is signed. It leaves receivers with
+// some clock skew enough time to see the new binding.
+const gossipKeyBindingRenewal = GossipKeyBindingValidity / 2
+
+// GossipSigner signs non-consensus gossip messages with the gossip key, if one is configured, or the guardian key.
+type GossipSigner struct {
+	guardianSigner  GuardianSigner
+	gossipKeySigner GuardianSigner
+	networkID       string
+
+	mu sync.Mutex
+	// binding is the binding of the gossip key to the guardian key it was last signed with.
+	binding           *gossipv1.SignedGossipKeyBinding
+	bindingAddr       ethcommon.Address
+	bindingExpiration time.Time
+}
+
+// NewGossipSigner creates a GossipSigner for the P2P network with the given ID. If gossipKeySigner is nil, the
+// messages are signed with the guardian key.
+func NewGossipSigner(guardianSigner GuardianSigner, gossipKeySigner GuardianSigner, networkID string) *GossipSigner {
+	return &GossipSigner{
+		guardianSigner:  guardianSigner,
+		gossipKeySigner: gossipKeySigner,
+		networkID:       networkID,
+	}
+}
+
+// GuardianAddress returns the address of the active guardian key, on whose behalf the messages are signed.
+func (s *GossipSigner) GuardianAddress(ctx context.Context) ethcommon.Address {
+	return ethcrypto.PubkeyToAddress(s.guardianSigner.PublicKey(ctx))
+}
+
+// Sign signs the keccak256 hash of a gossip message. It returns the binding that needs to be attached to the message,
+// which is nil if the message was signed with the guardian key.
+func (s *GossipSigner) Sign(ctx context.Context, hash []byte) ([]byte, *gossipv1.SignedGossipKeyBinding, error) {
+	if s.gossipKeySigner == nil {
+		sig, err := s.guardianSigner.Sign(ctx, hash)
+		return sig, nil, err
+	}
+
+	binding, err := s.signedBinding(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sig, err := s.gossipKeySigner.Sign(ctx, hash)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sig, binding, nil
+}
+
+// signedBinding returns the binding of the gossip key to the active guardian key. The binding is only signed again if
+// it is about to expire, or if the active guardian key changed, which happens during a guardian key rotation.
+func (s *GossipSigner) signedBinding(ctx context.Context) (*gossipv1.SignedGossipKeyBinding, error) {
+	guardianAddr := s.GuardianAddress(ctx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.binding != nil && s.bindingAddr == guardianAddr && time.Until(s.bindingExpiration) > gossipKeyBindingRenewal {
+		return s.binding, nil
+	}
+
+	// The expiration time is truncated to seconds, like it is encoded.
+	expiration := time.Unix(time.Now().Add(GossipKeyBindingValidity).Unix(), 0)
+	b, err := proto.Marshal(&gossipv1.GossipKeyBinding{
+		GuardianAddr:   guardianAddr.Bytes(),
+		GossipKeyAddr:  ethcrypto.PubkeyToAddress(s.gossipKeySigner.PublicKey(ctx)).Bytes(),
+		NetworkId:      s.networkID,
+		ExpirationTime: expiration.Unix(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal gossip key binding: %w", err)
+	}
+
+	sig, err := s.guardianSigner.Sign(ctx, gossipKeyBindingDigest(b).Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign gossip key binding: %w", err)
+	}
+
+	s.binding = &gossipv1.SignedGossipKeyBinding{
+		Binding:   b,
+		Signature: sig,
+	}
+	s.bindingAddr = guardianAddr
+	s.bindingExpiration = expiration
+	return s.binding, nil
+}
+
+// VerifyGossipKeyBinding verifies that the binding was signed by the guardian key with the given address for the given
+// P2P network, and that it is valid at the given time. It returns the address of the gossip key it binds.
+func VerifyGossipKeyBinding(s *gossipv1.SignedGossipKeyBinding, guardianAddr ethcommon.Address, networkID string, now time.Time) (ethcommon.Address, error) {
+	// SECURITY: see whitepapers/0009_guardian_key.md
+	if len(s.Binding) < minGossipKeyBindingLength {
+		return ethcommon.Address{}, errors.New("gossip key binding is too short")
+	}
+
+	pubKey, err := ethcrypto.Ecrecover(gossipKeyBindingDigest(s.Binding).Bytes(), s.Signature)
+	if err != nil {
+		return ethcommon.Address{}, errors.New("failed to recover public key of gossip key binding")
+	}
+	signerAddr := ethcommon.BytesToAddress(ethcrypto.Keccak256(pubKey[1:])[12:])
+	if signerAddr != guardianAddr {
+		return ethcommon.Address{}, fmt.Errorf("gossip key binding was signed by %s instead of %s", signerAddr, guardianAddr)
+	}
+
+	var binding gossipv1.GossipKeyBinding
+	if err := proto.Unmarshal(s.Binding, &binding); err != nil {
+		return ethcommon.Address{}, fmt.Errorf("failed to unmarshal gossip key binding: %w", err)
+	}
+	if ethcommon.BytesToAddress(binding.GuardianAddr) != guardianAddr {
+		return ethcommon.Address{}, fmt.Errorf("gossip key binding is for guardian %s", ethcommon.BytesToAddress(binding.GuardianAddr))
+	}
+	if len(binding.GossipKeyAddr) != ethcommon.AddressLength {
+		return ethcommon.Address{}, errors.New("gossip key binding has an invalid gossip key address")
+	}
+	if binding.NetworkId != networkID {
+		return ethcommon.Address{}, fmt.Errorf("gossip key binding is for network %q", binding.NetworkId)
+	}
+
+	// Bindings that are valid for longer than a binding is signed for are rejected as well, so that a leaked gossip key
+	// can't be used for long even if the guardian signed a binding with a far expiration time.
+	expiration := time.Unix(binding.ExpirationTime, 0)
+	if !now.Before(expiration) {
+		return ethcommon.Address{}, fmt.Errorf("gossip key binding expired at %s", expiration.UTC())
+	}
+	if expiration.Sub(now) > GossipKeyBindingValidity {
+		return ethcommon.Address{}, fmt.Errorf("gossip key binding expires too far in the future at %s", expiration.UTC())
+	}
+
+	return ethcommon.BytesToAddress(binding.GossipKeyAddr), nil
+}
+
+func gossipKeyBindingDigest(b []byte) ethcommon.Hash {
+	return ethcrypto.Keccak256Hash(append(gossipKeyBindingPrefix, b...))
+}
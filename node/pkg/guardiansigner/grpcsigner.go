@@ -0,0 +1,166 @@
+package guardiansigner
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	signerv1 "github.com/certusone/wormhole/node/pkg/proto/signer/v1"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// The timeout for remote signer requests. This is necessary to avoid situations where
+	// the signing is blocked indefinitely.
+	GRPC_SIGNER_TIMEOUT = time.Second * 5
+)
+
+// GrpcSigner is a signer that forwards signing requests to a remote signer implementing the
+// RemoteSignerService, e.g. a service in front of an HSM. The URI is expected to be in the
+// format grpc://<address>. Connections to unix sockets and loopback addresses are not
+// encrypted, connections to any other address use TLS.
+type GrpcSigner struct {
+	conn      *grpc.ClientConn
+	client    signerv1.RemoteSignerServiceClient
+	publicKey ecdsa.PublicKey
+}
+
+// NewGrpcSigner connects to the remote signer at the given address and retrieves its public key.
+func NewGrpcSigner(ctx context.Context, unsafeDevMode bool, address string) (*GrpcSigner, error) {
+	if address == "" {
+		return nil, errors.New("no remote signer address specified")
+	}
+
+	creds := credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	if unsafeDevMode || isLocalAddress(address) {
+		creds = insecure.NewCredentials()
+	}
+
+	conn, err := grpc.DialContext(ctx, address, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create remote signer client: %w", err)
+	}
+
+	grpcSigner := &GrpcSigner{
+		conn:   conn,
+		client: signerv1.NewRemoteSignerServiceClient(conn),
+	}
+
+	timeout, cancel := context.WithTimeout(ctx, GRPC_SIGNER_TIMEOUT)
+	defer cancel()
+
+	resp, err := grpcSigner.client.GetPublicKey(timeout, &signerv1.GetPublicKeyRequest{})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to retrieve public key from remote signer: %w", err)
+	}
+
+	pubKey, err := ethcrypto.UnmarshalPubkey(resp.PublicKey)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("remote signer returned an invalid public key: %w", err)
+	}
+
+	grpcSigner.publicKey = *pubKey
+	return grpcSigner, nil
+}
+
+// isLocalAddress reports whether the gRPC target address is a unix socket or a loopback address,
+// which is not encrypted.
+func isLocalAddress(address string) bool {
+	if strings.HasPrefix(address, "unix:") {
+		return true
+	}
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// Sign sends the hash to the remote signer, and checks that the returned signature was made by
+// the expected key.
+func (gs *GrpcSigner) Sign(ctx context.Context, hash []byte) ([]byte, error) {
+	timeout, cancel := context.WithTimeout(ctx, GRPC_SIGNER_TIMEOUT)
+	defer cancel()
+
+	resp, err := gs.client.Sign(timeout, &signerv1.SignRequest{Hash: hash})
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign hash with remote signer: %w", err)
+	}
+
+	// A remote signer that signs with the wrong key would make the guardian produce invalid
+	// observations, so verify the signature before using it.
+	valid, err := gs.Verify(ctx, resp.Signature, hash)
+	if err != nil {
+		return nil, fmt.Errorf("remote signer returned an invalid signature: %w", err)
+	}
+	if !valid {
+		return nil, errors.New("remote signer signed with an unexpected key")
+	}
+
+	return resp.Signature, nil
+}
+
+// PublicKey returns the public key of the signer, which was retrieved when connecting.
+func (gs *GrpcSigner) PublicKey(ctx context.Context) ecdsa.PublicKey {
+	return gs.publicKey
+}
+
+// Verify verifies a signature against a hash locally, using the public key of the remote signer.
+func (gs *GrpcSigner) Verify(ctx context.Context, sig []byte, hash []byte) (bool, error) {
+	recoveredPubKey, err := ethcrypto.SigToPub(hash, sig)
+	if err != nil {
+		return false, err
+	}
+
+	return recoveredPubKey.Equal(&gs.publicKey), nil
+}
+
+// Return the signer type as "grpc".
+func (gs *GrpcSigner) TypeAsString() string {
+	return "grpc"
+}
+
+// RemoteSignerServer implements the RemoteSignerService by signing with another guardian signer.
+// It can be used to run a remote signer in front of a signer the guardian cannot use directly.
+type RemoteSignerServer struct {
+	signerv1.UnsafeRemoteSignerServiceServer
+	signer GuardianSigner
+}
+
+func NewRemoteSignerServer(signer GuardianSigner) *RemoteSignerServer {
+	return &RemoteSignerServer{signer: signer}
+}
+
+func (s *RemoteSignerServer) Sign(ctx context.Context, req *signerv1.SignRequest) (*signerv1.SignResponse, error) {
+	if len(req.Hash) != 32 {
+		return nil, status.Errorf(codes.InvalidArgument, "hash must be 32 bytes, got %d", len(req.Hash))
+	}
+
+	sig, err := s.signer.Sign(ctx, req.Hash)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to sign hash: %v", err)
+	}
+
+	return &signerv1.SignResponse{Signature: sig}, nil
+}
+
+func (s *RemoteSignerServer) GetPublicKey(ctx context.Context, req *signerv1.GetPublicKeyRequest) (*signerv1.GetPublicKeyResponse, error) {
+	pubKey := s.signer.PublicKey(ctx)
+	return &signerv1.GetPublicKeyResponse{PublicKey: ethcrypto.FromECDSAPub(&pubKey)}, nil
+}
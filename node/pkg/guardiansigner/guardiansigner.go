@@ -17,6 +17,10 @@ const (
 	FileSignerType
 	// amazonkms://<arn>
 	AmazonKmsSignerType
+	// grpc://<address>
+	GrpcSignerType
+	// failover://<signer-uri>,<signer-uri>,...
+	FailoverSignerType
 )
 
 // GuardianSigner interface. Each function in the GuardianSigner interface
@@ -45,6 +49,19 @@ type GuardianSigner interface {
 // external services during construction. For example, the Amazon KMS signer validates that
 // the ARN is valid and retrieves the public key from the service.
 func NewGuardianSignerFromUri(ctx context.Context, signerUri string, unsafeDevMode bool) (GuardianSigner, error) {
+	guardianSigner, err := newGuardianSigner(ctx, signerUri, unsafeDevMode)
+	if err != nil {
+		return nil, err
+	}
+
+	// Wrap the guardian signer in a benchmark signer, which will record the
+	// time taken to sign and verify messages.
+	return BenchmarkWrappedSigner(guardianSigner), nil
+}
+
+// newGuardianSigner creates the GuardianSigner for the given URI without wrapping it in a benchmark
+// signer, so that signers wrapping other signers can create them.
+func newGuardianSigner(ctx context.Context, signerUri string, unsafeDevMode bool) (GuardianSigner, error) {
 	// Get the signer type and key configuration. The key configuration
 	// isn't interpreted as anything in particular here, as each signer
 	// implementation requires different configurations; i.e., the file
@@ -65,6 +82,10 @@ func NewGuardianSignerFromUri(ctx context.Context, signerUri string, unsafeDevMo
 		guardianSigner, err = NewFileSigner(ctx, unsafeDevMode, signerKeyConfig)
 	case AmazonKmsSignerType:
 		guardianSigner, err = NewAmazonKmsSigner(ctx, unsafeDevMode, signerKeyConfig)
+	case GrpcSignerType:
+		guardianSigner, err = NewGrpcSigner(ctx, unsafeDevMode, signerKeyConfig)
+	case FailoverSignerType:
+		guardianSigner, err = NewFailoverSigner(ctx, unsafeDevMode, signerKeyConfig)
 	default:
 		return nil, errors.New("unsupported guardian signer type")
 	}
@@ -73,9 +94,7 @@ func NewGuardianSignerFromUri(ctx context.Context, signerUri string, unsafeDevMo
 		return nil, err
 	}
 
-	// Wrap the guardian signer in a benchmark signer, which will record the
-	// time taken to sign and verify messages.
-	return BenchmarkWrappedSigner(guardianSigner), nil
+	return guardianSigner, nil
 }
 
 // Parse the signer URI and return the signer type and key configuration. The signer
@@ -104,6 +123,10 @@ func ParseSignerUri(signerUri string) (signerType SignerType, signerKeyConfig st
 		return FileSignerType, keyConfig, nil
 	case "amazonkms":
 		return AmazonKmsSignerType, keyConfig, nil
+	case "grpc":
+		return GrpcSignerType, keyConfig, nil
+	case "failover":
+		return FailoverSignerType, keyConfig, nil
 	default:
 		return InvalidSignerType, "", fmt.Errorf("unsupported guardian signer type: %s", typeStr)
 	}
@@ -1,14 +1,25 @@
 package guardiansigner
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
+	"errors"
+	"net"
 	"testing"
+	"time"
 
+	"github.com/certusone/wormhole/node/pkg/common"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	signerv1 "github.com/certusone/wormhole/node/pkg/proto/signer/v1"
 )
 
 func TestParseSignerUri(t *testing.T) {
@@ -26,6 +37,9 @@ func TestParseSignerUri(t *testing.T) {
 		{label: "FileUriTraversal", path: "file://../../../file", expectedType: FileSignerType},
 		// Amazon KMS
 		{label: "AmazonKmsURI", path: "amazonkms://some-arn", expectedType: AmazonKmsSignerType},
+		// Remote signers
+		{label: "GrpcURI", path: "grpc://127.0.0.1:9000", expectedType: GrpcSignerType},
+		{label: "FailoverURI", path: "failover://grpc://hsm-1:9000,grpc://hsm-2:9000", expectedType: FailoverSignerType},
 	}
 
 	for _, testcase := range tests {
@@ -144,3 +158,299 @@ func TestAmazonKmsAdjustBufferSize(t *testing.T) {
 		})
 	}
 }
+
+// startRemoteSigner serves a RemoteSignerService signing with the given signer on a loopback address.
+func startRemoteSigner(t *testing.T, signer GuardianSigner) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	signerv1.RegisterRemoteSignerServiceServer(server, NewRemoteSignerServer(signer))
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	return lis.Addr().String()
+}
+
+func TestGrpcSigner(t *testing.T) {
+	ctx := context.Background()
+	key, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+	generated, err := NewGeneratedSigner(key)
+	require.NoError(t, err)
+
+	address := startRemoteSigner(t, generated)
+
+	grpcSigner, err := NewGuardianSignerFromUri(ctx, "grpc://"+address, false)
+	require.NoError(t, err)
+	assert.Equal(t, ethcrypto.PubkeyToAddress(key.PublicKey), ethcrypto.PubkeyToAddress(grpcSigner.PublicKey(ctx)))
+
+	data := crypto.Keccak256Hash([]byte("data"))
+	sig, err := grpcSigner.Sign(ctx, data.Bytes())
+	require.NoError(t, err)
+	valid, err := grpcSigner.Verify(ctx, sig, data.Bytes())
+	require.NoError(t, err)
+	assert.True(t, valid)
+
+	// The remote signer rejects anything but a hash.
+	_, err = grpcSigner.Sign(ctx, []byte("data"))
+	assert.Error(t, err)
+
+	// A remote signer that is not reachable is rejected at startup.
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closedAddress := lis.Addr().String()
+	require.NoError(t, lis.Close())
+	_, err = NewGrpcSigner(ctx, false, closedAddress)
+	assert.Error(t, err)
+}
+
+func TestGrpcSignerLocalAddress(t *testing.T) {
+	assert.True(t, isLocalAddress("127.0.0.1:9000"))
+	assert.True(t, isLocalAddress("[::1]:9000"))
+	assert.True(t, isLocalAddress("localhost:9000"))
+	assert.True(t, isLocalAddress("unix:///run/signer.sock"))
+	assert.False(t, isLocalAddress("hsm.example.com:9000"))
+	assert.False(t, isLocalAddress("10.0.0.1:9000"))
+}
+
+// failingSigner fails every signing request.
+type failingSigner struct {
+	GuardianSigner
+	calls int
+}
+
+func (f *failingSigner) Sign(ctx context.Context, hash []byte) ([]byte, error) {
+	f.calls++
+	return nil, errors.New("signer unavailable")
+}
+
+func TestFailoverSigner(t *testing.T) {
+	ctx := context.Background()
+	key, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+	generated, err := NewGeneratedSigner(key)
+	require.NoError(t, err)
+
+	primary := &failingSigner{GuardianSigner: generated}
+	failover := NewFailoverSignerFromSigners([]GuardianSigner{primary, generated})
+	data := crypto.Keccak256Hash([]byte("data"))
+
+	// The failing primary fails over to the standby.
+	sig, err := failover.Sign(ctx, data.Bytes())
+	require.NoError(t, err)
+	valid, err := failover.Verify(ctx, sig, data.Bytes())
+	require.NoError(t, err)
+	assert.True(t, valid)
+	assert.Equal(t, 1, primary.calls)
+
+	// The failed primary is skipped until the retry interval elapsed.
+	_, err = failover.Sign(ctx, data.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, 1, primary.calls)
+	assert.Equal(t, []int{1, 0}, failover.order(time.Now()))
+	assert.Equal(t, []int{0, 1}, failover.order(time.Now().Add(FAILOVER_SIGNER_RETRY_INTERVAL)))
+
+	// If every signer fails, the error says why.
+	allFailing := NewFailoverSignerFromSigners([]GuardianSigner{&failingSigner{GuardianSigner: generated}, &failingSigner{GuardianSigner: generated}})
+	_, err = allFailing.Sign(ctx, data.Bytes())
+	assert.ErrorContains(t, err, "signer unavailable")
+}
+
+func TestFailoverSignerFromUri(t *testing.T) {
+	ctx := context.Background()
+	key, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+	generated, err := NewGeneratedSigner(key)
+	require.NoError(t, err)
+	otherKey, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+	other, err := NewGeneratedSigner(otherKey)
+	require.NoError(t, err)
+
+	primary := startRemoteSigner(t, generated)
+	standby := startRemoteSigner(t, generated)
+	unrelated := startRemoteSigner(t, other)
+
+	failover, err := NewFailoverSigner(ctx, false, "grpc://"+primary+",grpc://"+standby)
+	require.NoError(t, err)
+	assert.Equal(t, ethcrypto.PubkeyToAddress(key.PublicKey), ethcrypto.PubkeyToAddress(failover.PublicKey(ctx)))
+
+	_, err = NewFailoverSigner(ctx, false, "grpc://"+primary+",grpc://"+unrelated)
+	assert.ErrorContains(t, err, "different key")
+
+	_, err = NewFailoverSigner(ctx, false, "grpc://"+primary)
+	assert.Error(t, err)
+
+	_, err = NewFailoverSigner(ctx, false, "grpc://"+primary+",failover://grpc://"+standby)
+	assert.Error(t, err)
+}
+
+func TestDualKeySigner(t *testing.T) {
+	ctx := context.Background()
+	oldKey, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+	oldSigner, err := NewGeneratedSigner(oldKey)
+	require.NoError(t, err)
+	newKey, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+	newSigner, err := NewGeneratedSigner(newKey)
+	require.NoError(t, err)
+	otherKey, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+
+	oldAddr := ethcrypto.PubkeyToAddress(oldKey.PublicKey)
+	newAddr := ethcrypto.PubkeyToAddress(newKey.PublicKey)
+	otherAddr := ethcrypto.PubkeyToAddress(otherKey.PublicKey)
+
+	_, err = NewDualKeySigner(ctx, oldSigner, oldSigner)
+	assert.Error(t, err)
+
+	dualKeySigner, err := NewDualKeySigner(ctx, oldSigner, newSigner)
+	require.NoError(t, err)
+	signer, ok := AsGuardianSetAware(&BenchmarkSigner{innerSigner: dualKeySigner})
+	require.True(t, ok)
+
+	data := crypto.Keccak256Hash([]byte("data"))
+	signsWith := func(expected ethcommon.Address) {
+		t.Helper()
+		assert.Equal(t, expected, ethcrypto.PubkeyToAddress(signer.PublicKey(ctx)))
+		sig, err := signer.Sign(ctx, data.Bytes())
+		require.NoError(t, err)
+		pubKey, err := ethcrypto.SigToPub(data.Bytes(), sig)
+		require.NoError(t, err)
+		assert.Equal(t, expected, ethcrypto.PubkeyToAddress(*pubKey))
+	}
+
+	// Before a guardian set is known, the old key is used.
+	signsWith(oldAddr)
+	assert.False(t, signer.KeyStatus(ctx).GuardianSetKnown)
+
+	signer.SetGuardianSet(ctx, common.NewGuardianSet([]ethcommon.Address{otherAddr, oldAddr}, 4))
+	signsWith(oldAddr)
+	status := signer.KeyStatus(ctx)
+	assert.Equal(t, KeyStatus{
+		ActiveAddress:    oldAddr,
+		OldAddress:       oldAddr,
+		NewAddress:       newAddr,
+		GuardianSetIndex: 4,
+		GuardianSetKnown: true,
+		InGuardianSet:    true,
+	}, status)
+
+	// The guardian set update that contains the new key switches over.
+	signer.SetGuardianSet(ctx, common.NewGuardianSet([]ethcommon.Address{otherAddr, newAddr}, 5))
+	signsWith(newAddr)
+	status = signer.KeyStatus(ctx)
+	assert.True(t, status.UsingNewKey)
+	assert.True(t, status.InGuardianSet)
+	assert.Equal(t, uint32(5), status.GuardianSetIndex)
+
+	// A guardian set containing neither key leaves the selection unchanged.
+	signer.SetGuardianSet(ctx, common.NewGuardianSet([]ethcommon.Address{otherAddr}, 6))
+	signsWith(newAddr)
+	assert.False(t, signer.KeyStatus(ctx).InGuardianSet)
+
+	// Plain signers are not guardian set aware.
+	_, ok = AsGuardianSetAware(&BenchmarkSigner{innerSigner: oldSigner})
+	assert.False(t, ok)
+}
+
+func TestGossipSigner(t *testing.T) {
+	ctx := context.Background()
+	guardianKey, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+	guardianSigner, err := NewGeneratedSigner(guardianKey)
+	require.NoError(t, err)
+	gossipKey, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+	gossipKeySigner, err := NewGeneratedSigner(gossipKey)
+	require.NoError(t, err)
+
+	guardianAddr := ethcrypto.PubkeyToAddress(guardianKey.PublicKey)
+	gossipAddr := ethcrypto.PubkeyToAddress(gossipKey.PublicKey)
+	networkID := "/wormhole/mainnet/2"
+	data := crypto.Keccak256Hash([]byte("data"))
+	signerOf := func(sig []byte) ethcommon.Address {
+		t.Helper()
+		pubKey, err := ethcrypto.SigToPub(data.Bytes(), sig)
+		require.NoError(t, err)
+		return ethcrypto.PubkeyToAddress(*pubKey)
+	}
+
+	// Without a gossip key, the guardian key signs and there is no binding.
+	sig, binding, err := NewGossipSigner(guardianSigner, nil, networkID).Sign(ctx, data.Bytes())
+	require.NoError(t, err)
+	assert.Nil(t, binding)
+	assert.Equal(t, guardianAddr, signerOf(sig))
+
+	// With a gossip key, the gossip key signs and the binding ties it to the guardian key.
+	gossipSigner := NewGossipSigner(guardianSigner, gossipKeySigner, networkID)
+	sig, binding, err = gossipSigner.Sign(ctx, data.Bytes())
+	require.NoError(t, err)
+	require.NotNil(t, binding)
+	assert.Equal(t, gossipAddr, signerOf(sig))
+
+	now := time.Now()
+	boundAddr, err := VerifyGossipKeyBinding(binding, guardianAddr, networkID, now)
+	require.NoError(t, err)
+	assert.Equal(t, gossipAddr, boundAddr)
+
+	// The binding is only signed once while it is valid for long enough.
+	_, binding2, err := gossipSigner.Sign(ctx, data.Bytes())
+	require.NoError(t, err)
+	assert.Same(t, binding, binding2)
+
+	// The binding is signed again when it is about to expire.
+	gossipSigner.bindingExpiration = now.Add(gossipKeyBindingRenewal - time.Minute)
+	_, binding2, err = gossipSigner.Sign(ctx, data.Bytes())
+	require.NoError(t, err)
+	assert.NotSame(t, binding, binding2)
+
+	// The binding doesn't verify for another guardian or network, after it expired, or if it was tampered with or
+	// truncated.
+	_, err = VerifyGossipKeyBinding(binding, gossipAddr, networkID, now)
+	assert.ErrorContains(t, err, "was signed by")
+
+	_, err = VerifyGossipKeyBinding(binding, guardianAddr, "/wormhole/testnet/2/1", now)
+	assert.ErrorContains(t, err, "is for network")
+
+	_, err = VerifyGossipKeyBinding(binding, guardianAddr, networkID, now.Add(GossipKeyBindingValidity+time.Second))
+	assert.ErrorContains(t, err, "expired")
+
+	tampered := &gossipv1.SignedGossipKeyBinding{Binding: bytes.Clone(binding.Binding), Signature: binding.Signature}
+	tampered.Binding[len(tampered.Binding)-1] ^= 1
+	_, err = VerifyGossipKeyBinding(tampered, guardianAddr, networkID, now)
+	assert.Error(t, err)
+
+	truncated := &gossipv1.SignedGossipKeyBinding{Binding: binding.Binding[:minGossipKeyBindingLength-1], Signature: binding.Signature}
+	_, err = VerifyGossipKeyBinding(truncated, guardianAddr, networkID, now)
+	assert.ErrorContains(t, err, "too short")
+
+	signBinding := func(binding *gossipv1.GossipKeyBinding) *gossipv1.SignedGossipKeyBinding {
+		t.Helper()
+		b, err := proto.Marshal(binding)
+		require.NoError(t, err)
+		sig, err := guardianSigner.Sign(ctx, gossipKeyBindingDigest(b).Bytes())
+		require.NoError(t, err)
+		return &gossipv1.SignedGossipKeyBinding{Binding: b, Signature: sig}
+	}
+
+	// A binding signed by the guardian key for another guardian address is rejected.
+	_, err = VerifyGossipKeyBinding(signBinding(&gossipv1.GossipKeyBinding{
+		GuardianAddr:   gossipAddr.Bytes(),
+		GossipKeyAddr:  gossipAddr.Bytes(),
+		NetworkId:      networkID,
+		ExpirationTime: now.Add(time.Hour).Unix(),
+	}), guardianAddr, networkID, now)
+	assert.ErrorContains(t, err, "is for guardian")
+
+	// A binding that is valid for longer than bindings are signed for is rejected.
+	_, err = VerifyGossipKeyBinding(signBinding(&gossipv1.GossipKeyBinding{
+		GuardianAddr:   guardianAddr.Bytes(),
+		GossipKeyAddr:  gossipAddr.Bytes(),
+		NetworkId:      networkID,
+		ExpirationTime: now.Add(GossipKeyBindingValidity + time.Hour).Unix(),
+	}), guardianAddr, networkID, now)
+	assert.ErrorContains(t, err, "too far in the future")
+}
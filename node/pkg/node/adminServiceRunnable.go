@@ -7,6 +7,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/certusone/wormhole/node/pkg/accountant"
 	"github.com/certusone/wormhole/node/pkg/adminrpc"
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/db"
@@ -17,11 +18,13 @@ import (
 	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
 	"github.com/certusone/wormhole/node/pkg/publicrpc"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/certusone/wormhole/node/pkg/watchers"
 	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors"
 	"go.uber.org/zap"
 
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+
 	ethcommon "github.com/ethereum/go-ethereum/common"
-	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 )
 
 func adminServiceRunnable(
@@ -32,11 +35,14 @@ func adminServiceRunnable(
 	obsvReqSendC chan<- *gossipv1.ObservationRequest,
 	db *db.Database,
 	gst *common.GuardianSetState,
+	conflicts *common.ObservationConflicts,
 	gov *governor.ChainGovernor,
+	acct *accountant.Accountant,
 	guardianSigner guardiansigner.GuardianSigner,
 	ethRpc *string,
 	ethContract *string,
 	rpcMap map[string]string,
+	backfillers map[vaa.ChainID]watchers.Backfiller,
 ) (supervisor.Runnable, error) {
 	// Delete existing UNIX socket, if present.
 	fi, err := os.Stat(socketPath)
@@ -88,13 +94,16 @@ func adminServiceRunnable(
 		logger.Named("adminservice"),
 		signedInC,
 		gov,
+		acct,
 		evmConnector,
 		guardianSigner,
-		ethcrypto.PubkeyToAddress(guardianSigner.PublicKey(ctx)),
 		rpcMap,
+		conflicts,
+		gst,
+		backfillers,
 	)
 
-	publicrpcService := publicrpc.NewPublicrpcServer(logger, db, gst, gov)
+	publicrpcService := publicrpc.NewPublicrpcServer(logger, db, gst, gov, nil)
 
 	grpcServer := common.NewInstrumentedGRPCServer(logger, common.GrpcLogDetailMinimal)
 	nodev1.RegisterNodePrivilegedServiceServer(grpcServer, nodeService)
@@ -13,6 +13,7 @@ import (
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/certusone/wormhole/node/pkg/query"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/certusone/wormhole/node/pkg/watchers"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 
 	"go.uber.org/zap"
@@ -67,13 +68,15 @@ type G struct {
 	guardianSigner guardiansigner.GuardianSigner
 
 	// components
-	db              *db.Database
-	gst             *common.GuardianSetState
-	acct            *accountant.Accountant
-	gov             *governor.ChainGovernor
-	gatewayRelayer  *gwrelayer.GatewayRelayer
-	queryHandler    *query.QueryHandler
-	publicrpcServer *grpc.Server
+	db  *db.Database
+	gst *common.GuardianSetState
+	// observationConflicts is written by the processor and read by the admin service.
+	observationConflicts *common.ObservationConflicts
+	acct                 *accountant.Accountant
+	gov                  *governor.ChainGovernor
+	gatewayRelayer       *gwrelayer.GatewayRelayer
+	queryHandler         *query.QueryHandler
+	publicrpcServer      *grpc.Server
 
 	// runnables
 	runnablesWithScissors map[string]supervisor.Runnable
@@ -106,6 +109,9 @@ type G struct {
 	signedQueryReqC           channelPair[*gossipv1.SignedQueryRequest]
 	queryResponseC            channelPair[*query.PerChainQueryResponseInternal]
 	queryResponsePublicationC channelPair[*query.QueryResponsePublication]
+
+	// Watchers that can backfill a block range, by chain. Populated when the watchers are created.
+	backfillers map[vaa.ChainID]watchers.Backfiller
 }
 
 func NewGuardianNode(
@@ -143,10 +149,12 @@ func (g *G) initializeBasic(rootCtxCancel context.CancelFunc) {
 
 	// Guardian set state managed by processor
 	g.gst = common.NewGuardianSetState(nil)
+	g.observationConflicts = common.NewObservationConflicts()
 
 	// allocate maps
 	g.runnablesWithScissors = make(map[string]supervisor.Runnable)
 	g.runnables = make(map[string]supervisor.Runnable)
+	g.backfillers = make(map[vaa.ChainID]watchers.Backfiller)
 }
 
 // applyOptions applies `options` to the GuardianNode.
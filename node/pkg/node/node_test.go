@@ -26,6 +26,7 @@ import (
 	"github.com/certusone/wormhole/node/pkg/db"
 	"github.com/certusone/wormhole/node/pkg/devnet"
 	"github.com/certusone/wormhole/node/pkg/guardiansigner"
+	"github.com/certusone/wormhole/node/pkg/p2p"
 	"github.com/certusone/wormhole/node/pkg/processor"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
@@ -190,13 +191,13 @@ func mockGuardianRunnable(t testing.TB, gs []*mockGuardian, mockGuardianIndex ui
 			GuardianOptionNoAccountant(), // disable accountant
 			GuardianOptionGovernor(true, false, ""),
 			GuardianOptionGatewayRelayer("", nil), // disable gateway relayer
-			GuardianOptionP2P(gs[mockGuardianIndex].p2pKey, networkID, bootstrapPeers, nodeName, false, false, cfg.p2pPort, "", 0, "", "", func() string { return "" }),
-			GuardianOptionPublicRpcSocket(cfg.publicSocket, publicRpcLogDetail),
-			GuardianOptionPublicrpcTcpService(cfg.publicRpc, publicRpcLogDetail),
+			GuardianOptionP2P(gs[mockGuardianIndex].p2pKey, networkID, bootstrapPeers, nodeName, false, false, cfg.p2pPort, "", 0, "", "", p2p.DefaultTransportConfig(), func() string { return "" }, nil),
+			GuardianOptionPublicRpcSocket(cfg.publicSocket, publicRpcLogDetail, false),
+			GuardianOptionPublicrpcTcpService(cfg.publicRpc, publicRpcLogDetail, false),
 			GuardianOptionPublicWeb(cfg.publicWeb, cfg.publicSocket, "", false, ""),
 			GuardianOptionAdminService(cfg.adminSocket, nil, nil, rpcMap),
 			GuardianOptionStatusServer(fmt.Sprintf("[::]:%d", cfg.statusPort)),
-			GuardianOptionProcessor(networkID),
+			GuardianOptionProcessor(networkID, processor.DefaultObservationBatchConfig),
 		}
 
 		guardianNode := NewGuardianNode(
@@ -917,6 +918,7 @@ func TestGuardianConfigs(t *testing.T) {
 					false, // enforcing
 					nil,   // wormchainConn
 					"",    // nttContract
+					false, // nttEnforcing
 					nil,   // nttWormchainConn
 				),
 			},
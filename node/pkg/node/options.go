@@ -12,6 +12,7 @@ import (
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/db"
 	"github.com/certusone/wormhole/node/pkg/governor"
+	"github.com/certusone/wormhole/node/pkg/guardiansigner"
 	"github.com/certusone/wormhole/node/pkg/gwrelayer"
 	"github.com/certusone/wormhole/node/pkg/p2p"
 	"github.com/certusone/wormhole/node/pkg/processor"
@@ -51,7 +52,9 @@ func GuardianOptionP2P(
 	ccqPort uint,
 	ccqAllowedPeers string,
 	gossipAdvertiseAddress string,
+	transportConfig p2p.TransportConfig,
 	ibcFeaturesFunc func() string,
+	gossipKeySigner guardiansigner.GuardianSigner,
 ) *GuardianOption {
 	return &GuardianOption{
 		name:         "p2p",
@@ -74,6 +77,8 @@ func GuardianOptionP2P(
 			// Add the gossip advertisement address
 			components.GossipAdvertiseAddress = gossipAdvertiseAddress
 
+			components.TransportConfig = transportConfig
+
 			params, err := p2p.NewRunParams(
 				bootstrapPeers,
 				networkId,
@@ -104,6 +109,7 @@ func GuardianOptionP2P(
 					ccqPort,
 					ccqAllowedPeers),
 				p2p.WithProcessorFeaturesFunc(processor.GetFeatures),
+				p2p.WithGossipKeySigner(gossipKeySigner),
 			)
 			if err != nil {
 				return err
@@ -160,6 +166,7 @@ func GuardianOptionAccountant(
 	enforcing bool,
 	wormchainConn *wormconn.ClientConn,
 	nttContract string,
+	nttEnforcing bool,
 	nttWormchainConn *wormconn.ClientConn,
 ) *GuardianOption {
 	return &GuardianOption{
@@ -192,7 +199,11 @@ func GuardianOptionAccountant(
 				if nttWormchainConn == nil {
 					return errors.New("if accountantNttContract is specified, the NTT wormchain sending connection must be enabled")
 				}
-				logger.Info("NTT accountant is enabled", zap.String("component", "gacct"))
+				if nttEnforcing {
+					logger.Info("NTT accountant is enabled and will be enforced", zap.String("component", "gacct"))
+				} else {
+					logger.Info("NTT accountant is enabled but will not be enforced", zap.String("component", "gacct"))
+				}
 			}
 
 			g.acct = accountant.NewAccountant(
@@ -206,6 +217,7 @@ func GuardianOptionAccountant(
 				enforcing,
 				nttContract,
 				nttWormchainConn,
+				nttEnforcing,
 				g.guardianSigner,
 				g.gst,
 				g.acctC.writeC,
@@ -415,10 +427,10 @@ func GuardianOptionWatchers(watcherConfigs []watchers.WatcherConfig, ibcWatcherC
 				}(chainQueryResponseC[chainId], chainId)
 			}
 
-			watchers := make(map[watchers.NetworkID]interfaces.L1Finalizer)
+			l1Finalizers := make(map[watchers.NetworkID]interfaces.L1Finalizer)
 
 			for _, wc := range watcherConfigs {
-				if _, ok := watchers[wc.GetNetworkID()]; ok {
+				if _, ok := l1Finalizers[wc.GetNetworkID()]; ok {
 					return fmt.Errorf("NetworkID already configured: %s", string(wc.GetNetworkID()))
 				}
 
@@ -432,7 +444,7 @@ func GuardianOptionWatchers(watcherConfigs []watchers.WatcherConfig, ibcWatcherC
 				}
 
 				if wc.RequiredL1Finalizer() != "" {
-					l1watcher, ok := watchers[wc.RequiredL1Finalizer()]
+					l1watcher, ok := l1Finalizers[wc.RequiredL1Finalizer()]
 					if !ok || l1watcher == nil {
 						logger.Fatal("L1finalizer does not exist. Please check the order of the watcher configurations in watcherConfigs. The L1 must be configured before this one.",
 							zap.String("ChainID", wc.GetChainID().String()),
@@ -441,14 +453,18 @@ func GuardianOptionWatchers(watcherConfigs []watchers.WatcherConfig, ibcWatcherC
 					wc.SetL1Finalizer(l1watcher)
 				}
 
-				l1finalizer, runnable, err := wc.Create(chainMsgC[wc.GetChainID()], chainObsvReqC[wc.GetChainID()], g.chainQueryReqC[wc.GetChainID()], chainQueryResponseC[wc.GetChainID()], g.setC.writeC, g.env)
+				l1finalizer, watcher, err := wc.Create(chainMsgC[wc.GetChainID()], chainObsvReqC[wc.GetChainID()], g.chainQueryReqC[wc.GetChainID()], chainQueryResponseC[wc.GetChainID()], g.setC.writeC, g.env)
 
 				if err != nil {
 					return fmt.Errorf("error creating watcher: %w", err)
 				}
 
-				g.runnablesWithScissors[watcherName] = runnable
-				watchers[wc.GetNetworkID()] = l1finalizer
+				if backfiller, ok := watcher.(watchers.Backfiller); ok && wc.GetNetworkID() != "solana-confirmed" {
+					g.backfillers[wc.GetChainID()] = backfiller
+				}
+
+				g.runnablesWithScissors[watcherName] = watchers.Supervise(wc.GetNetworkID(), wc.GetChainID(), watcher)
+				l1Finalizers[wc.GetNetworkID()] = l1finalizer
 			}
 
 			if ibcWatcherConfig != nil {
@@ -491,11 +507,11 @@ func GuardianOptionWatchers(watcherConfigs []watchers.WatcherConfig, ibcWatcherC
 }
 
 // GuardianOptionAdminService enables the admin rpc service on a unix socket.
-// Dependencies: db, governor
+// Dependencies: db, governor, accountant
 func GuardianOptionAdminService(socketPath string, ethRpc *string, ethContract *string, rpcMap map[string]string) *GuardianOption {
 	return &GuardianOption{
 		name:         "admin-service",
-		dependencies: []string{"governor", "db"},
+		dependencies: []string{"governor", "accountant", "db"},
 		f: func(ctx context.Context, logger *zap.Logger, g *G) error {
 			adminService, err := adminServiceRunnable(
 				logger,
@@ -505,11 +521,14 @@ func GuardianOptionAdminService(socketPath string, ethRpc *string, ethContract *
 				g.obsvReqSendC.writeC,
 				g.db,
 				g.gst,
+				g.observationConflicts,
 				g.gov,
+				g.acct,
 				g.guardianSigner,
 				ethRpc,
 				ethContract,
 				rpcMap,
+				g.backfillers,
 			)
 			if err != nil {
 				return fmt.Errorf("failed to create admin service: %w", err)
@@ -521,14 +540,19 @@ func GuardianOptionAdminService(socketPath string, ethRpc *string, ethContract *
 }
 
 // GuardianOptionPublicRpcSocket enables the public rpc service on a unix socket
+// If reobservationsEnabled is set, GetSignedVAA may request re-observations of missing VAAs.
 // Dependencies: db, governor
-func GuardianOptionPublicRpcSocket(publicGRPCSocketPath string, publicRpcLogDetail common.GrpcLogDetail) *GuardianOption {
+func GuardianOptionPublicRpcSocket(publicGRPCSocketPath string, publicRpcLogDetail common.GrpcLogDetail, reobservationsEnabled bool) *GuardianOption {
 	return &GuardianOption{
 		name:         "publicrpcsocket",
 		dependencies: []string{"db", "governor"},
 		f: func(ctx context.Context, logger *zap.Logger, g *G) error {
 			// local public grpc service socket
-			publicrpcUnixService, publicrpcServer, err := publicrpcUnixServiceRunnable(logger, publicGRPCSocketPath, publicRpcLogDetail, g.db, g.gst, g.gov)
+			var obsvReqC chan<- *gossipv1.ObservationRequest
+			if reobservationsEnabled {
+				obsvReqC = g.obsvReqC.writeC
+			}
+			publicrpcUnixService, publicrpcServer, err := publicrpcUnixServiceRunnable(logger, publicGRPCSocketPath, publicRpcLogDetail, g.db, g.gst, g.gov, obsvReqC)
 			if err != nil {
 				return fmt.Errorf("failed to create publicrpc service: %w", err)
 			}
@@ -540,13 +564,18 @@ func GuardianOptionPublicRpcSocket(publicGRPCSocketPath string, publicRpcLogDeta
 }
 
 // GuardianOptionPublicrpcTcpService enables the public gRPC service on TCP.
+// If reobservationsEnabled is set, GetSignedVAA may request re-observations of missing VAAs.
 // Dependencies: db, governor, publicrpcsocket
-func GuardianOptionPublicrpcTcpService(publicRpc string, publicRpcLogDetail common.GrpcLogDetail) *GuardianOption {
+func GuardianOptionPublicrpcTcpService(publicRpc string, publicRpcLogDetail common.GrpcLogDetail, reobservationsEnabled bool) *GuardianOption {
 	return &GuardianOption{
 		name:         "publicrpc",
 		dependencies: []string{"db", "governor", "publicrpcsocket"},
 		f: func(ctx context.Context, logger *zap.Logger, g *G) error {
-			publicrpcService := publicrpcTcpServiceRunnable(logger, publicRpc, publicRpcLogDetail, g.db, g.gst, g.gov)
+			var obsvReqC chan<- *gossipv1.ObservationRequest
+			if reobservationsEnabled {
+				obsvReqC = g.obsvReqC.writeC
+			}
+			publicrpcService := publicrpcTcpServiceRunnable(logger, publicRpc, publicRpcLogDetail, g.db, g.gst, g.gov, obsvReqC)
 			g.runnables["publicrpc"] = publicrpcService
 			return nil
 		}}
@@ -577,15 +606,41 @@ func GuardianOptionDatabase(db *db.Database) *GuardianOption {
 		}}
 }
 
+// GuardianOptionDatabaseMaintenance periodically prunes the VAAs older than the retention period from the database and
+// compacts it. A retention of zero keeps all VAAs.
+// Dependencies: db
+func GuardianOptionDatabaseMaintenance(retention time.Duration, interval time.Duration) *GuardianOption {
+	return &GuardianOption{
+		name:         "db-maintenance",
+		dependencies: []string{"db"},
+		f: func(ctx context.Context, logger *zap.Logger, g *G) error {
+			if interval <= 0 {
+				return errors.New("database maintenance interval must be positive")
+			}
+			if retention != 0 {
+				logger.Info("VAAs older than the retention period will be pruned", zap.Duration("retention", retention))
+			}
+
+			g.runnables["db-maintenance"] = func(ctx context.Context) error {
+				supervisor.Signal(ctx, supervisor.SignalHealthy)
+				return g.db.RunMaintenance(ctx, supervisor.Logger(ctx), retention, interval)
+			}
+			return nil
+		}}
+}
+
 // GuardianOptionProcessor enables the default processor, which is required to make consensus on messages.
 // Dependencies: db, governor, accountant
-func GuardianOptionProcessor(networkId string) *GuardianOption {
+func GuardianOptionProcessor(networkId string, batchConfig processor.ObservationBatchConfig) *GuardianOption {
 	return &GuardianOption{
 		name: "processor",
 		// governor and accountant may be set to nil, but that choice needs to be made before the processor is configured
 		dependencies: []string{"db", "governor", "accountant", "gateway-relayer"},
 
 		f: func(ctx context.Context, logger *zap.Logger, g *G) error {
+			if err := batchConfig.Validate(); err != nil {
+				return err
+			}
 
 			g.runnables["processor"] = processor.NewProcessor(ctx,
 				g.db,
@@ -599,11 +654,13 @@ func GuardianOptionProcessor(networkId string) *GuardianOption {
 				g.signedInC.readC,
 				g.guardianSigner,
 				g.gst,
+				g.observationConflicts,
 				g.gov,
 				g.acct,
 				g.acctC.readC,
 				g.gatewayRelayer,
 				networkId,
+				batchConfig,
 			).Run
 
 			return nil
@@ -9,6 +9,7 @@ import (
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/db"
 	"github.com/certusone/wormhole/node/pkg/governor"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
 	"github.com/certusone/wormhole/node/pkg/publicrpc"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
@@ -16,7 +17,7 @@ import (
 	"google.golang.org/grpc"
 )
 
-func publicrpcTcpServiceRunnable(logger *zap.Logger, listenAddr string, publicRpcLogDetail common.GrpcLogDetail, db *db.Database, gst *common.GuardianSetState, gov *governor.ChainGovernor) supervisor.Runnable {
+func publicrpcTcpServiceRunnable(logger *zap.Logger, listenAddr string, publicRpcLogDetail common.GrpcLogDetail, db *db.Database, gst *common.GuardianSetState, gov *governor.ChainGovernor, obsvReqC chan<- *gossipv1.ObservationRequest) supervisor.Runnable {
 	return func(ctx context.Context) error {
 		l, err := net.Listen("tcp", listenAddr)
 
@@ -26,7 +27,7 @@ func publicrpcTcpServiceRunnable(logger *zap.Logger, listenAddr string, publicRp
 
 		logger.Info("publicrpc server listening", zap.String("addr", l.Addr().String()))
 
-		rpcServer := publicrpc.NewPublicrpcServer(logger, db, gst, gov)
+		rpcServer := publicrpc.NewPublicrpcServer(logger, db, gst, gov, obsvReqC)
 		grpcServer := common.NewInstrumentedGRPCServer(logger, publicRpcLogDetail)
 
 		publicrpcv1.RegisterPublicRPCServiceServer(grpcServer, rpcServer)
@@ -40,7 +41,7 @@ func publicrpcTcpServiceRunnable(logger *zap.Logger, listenAddr string, publicRp
 	}
 }
 
-func publicrpcUnixServiceRunnable(logger *zap.Logger, socketPath string, publicRpcLogDetail common.GrpcLogDetail, db *db.Database, gst *common.GuardianSetState, gov *governor.ChainGovernor) (supervisor.Runnable, *grpc.Server, error) {
+func publicrpcUnixServiceRunnable(logger *zap.Logger, socketPath string, publicRpcLogDetail common.GrpcLogDetail, db *db.Database, gst *common.GuardianSetState, gov *governor.ChainGovernor, obsvReqC chan<- *gossipv1.ObservationRequest) (supervisor.Runnable, *grpc.Server, error) {
 	// Delete existing UNIX socket, if present.
 	fi, err := os.Stat(socketPath)
 	if err == nil {
@@ -72,7 +73,7 @@ func publicrpcUnixServiceRunnable(logger *zap.Logger, socketPath string, publicR
 
 	logger.Info("publicrpc (unix socket) server listening on", zap.String("path", socketPath))
 
-	publicrpcService := publicrpc.NewPublicrpcServer(logger, db, gst, gov)
+	publicrpcService := publicrpc.NewPublicrpcServer(logger, db, gst, gov, obsvReqC)
 
 	grpcServer := common.NewInstrumentedGRPCServer(logger, publicRpcLogDetail)
 	publicrpcv1.RegisterPublicRPCServiceServer(grpcServer, publicrpcService)
@@ -0,0 +1,57 @@
+package p2p
+
+import (
+	"sync"
+
+	eth_common "github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/time/rate"
+)
+
+// Guardians request re-observations of the messages that are missing a quorum, so they may send a burst of requests
+// after an outage. A guardian that keeps sending more than this is misbehaving and its requests are dropped, so that it
+// can't make the watchers of the other guardians re-observe transactions endlessly.
+const (
+	obsvReqRateLimit = rate.Limit(5)
+	obsvReqBurst     = 100
+)
+
+var obsvReqRateLimited = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "wormhole_p2p_observation_requests_rate_limited_total",
+		Help: "Total number of signed observation requests dropped because their guardian exceeded the rate limit",
+	}, []string{"guardian"})
+
+// obsvReqRateLimiter limits the rate of the observation requests of each guardian. Requests are only counted once
+// their signature was verified against the guardian set, so there is at most one limiter per guardian.
+type obsvReqRateLimiter struct {
+	mu       sync.Mutex
+	limiters map[eth_common.Address]*rate.Limiter
+	limit    rate.Limit
+	burst    int
+}
+
+func newObsvReqRateLimiter(limit rate.Limit, burst int) *obsvReqRateLimiter {
+	return &obsvReqRateLimiter{
+		limiters: make(map[eth_common.Address]*rate.Limiter),
+		limit:    limit,
+		burst:    burst,
+	}
+}
+
+// allow reports whether an observation request of the guardian may be processed.
+func (l *obsvReqRateLimiter) allow(guardian eth_common.Address) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	limiter, ok := l.limiters[guardian]
+	if !ok {
+		limiter = rate.NewLimiter(l.limit, l.burst)
+		l.limiters[guardian] = limiter
+	}
+	if !limiter.Allow() {
+		obsvReqRateLimited.WithLabelValues(guardian.Hex()).Inc()
+		return false
+	}
+	return true
+}
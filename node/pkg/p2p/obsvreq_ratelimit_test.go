@@ -0,0 +1,24 @@
+package p2p
+
+import (
+	"testing"
+
+	eth_common "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObsvReqRateLimiter(t *testing.T) {
+	// A limit of zero never refills the burst, so the test does not depend on timing.
+	l := newObsvReqRateLimiter(0, 3)
+	g1 := eth_common.HexToAddress("0x01")
+	g2 := eth_common.HexToAddress("0x02")
+
+	for i := 0; i < 3; i++ {
+		assert.True(t, l.allow(g1))
+	}
+	assert.False(t, l.allow(g1))
+
+	// Other guardians have their own limit.
+	assert.True(t, l.allow(g2))
+	assert.False(t, l.allow(g1))
+}
@@ -31,7 +31,6 @@ import (
 	"github.com/libp2p/go-libp2p/core/routing"
 	"github.com/libp2p/go-libp2p/p2p/net/connmgr"
 	libp2ptls "github.com/libp2p/go-libp2p/p2p/security/tls"
-	libp2pquic "github.com/libp2p/go-libp2p/p2p/transport/quic"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"google.golang.org/protobuf/proto"
@@ -80,8 +79,26 @@ var (
 			Name: "wormhole_p2p_drops",
 			Help: "Total number of messages that were dropped by libp2p",
 		})
+	p2pDuplicateMessages = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_p2p_duplicate_messages_total",
+			Help: "Total number of duplicate pubsub messages suppressed by libp2p, grouped by topic",
+		}, []string{"topic"})
+	p2pRejectedMessages = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_p2p_rejected_messages_total",
+			Help: "Total number of pubsub messages rejected by libp2p, grouped by reason",
+		}, []string{"reason"})
+	p2pGuardianPeerScore = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_p2p_guardian_peer_score",
+			Help: "Current gossipsub peer score of the p2p peer protected for each known guardian",
+		}, []string{"guardian_addr"})
 )
 
+// peerScoreInspectInterval is how often the gossipsub peer scores are exported when peer scoring is enabled.
+const peerScoreInspectInterval = 10 * time.Second
+
 var heartbeatMessagePrefix = []byte("heartbeat|")
 
 var signedObservationRequestPrefix = []byte("signed_observation_request|")
@@ -119,6 +136,12 @@ type Components struct {
 	GossipParams pubsub.GossipSubParams
 	// GossipAdvertiseAddress is an override for the external IP advertised via p2p to other peers.
 	GossipAdvertiseAddress string
+	// PeerScoreParams and PeerScoreThresholds enable gossipsub peer scoring when both are set. The resulting
+	// scores of guardian peers are exported as metrics. Peer scoring is disabled by default.
+	PeerScoreParams     *pubsub.PeerScoreParams
+	PeerScoreThresholds *pubsub.PeerScoreThresholds
+	// TransportConfig configures the libp2p transports, their preference order and NAT traversal.
+	TransportConfig TransportConfig
 }
 
 func (f *Components) ListeningAddresses() []string {
@@ -127,6 +150,9 @@ func (f *Components) ListeningAddresses() []string {
 		pattern = cutOverAddressPattern(pattern)
 		la = append(la, fmt.Sprintf(pattern, f.Port))
 	}
+	for _, pattern := range f.TransportConfig.listeningAddressPatterns() {
+		la = append(la, fmt.Sprintf(pattern, f.Port))
+	}
 	return la
 }
 
@@ -149,6 +175,7 @@ func DefaultComponents() *Components {
 		ProtectedHostByGuardianKey: make(map[eth_common.Address]peer.ID),
 		SignedHeartbeatLogLevel:    zapcore.DebugLevel,
 		GossipParams:               pubsub.DefaultGossipSubParams(),
+		TransportConfig:            DefaultTransportConfig(),
 	}
 }
 
@@ -172,9 +199,30 @@ type traceHandler struct {
 // Trace is the interface to the libp2p trace handler. It pegs metrics as appropriate.
 func (*traceHandler) Trace(evt *libp2ppb.TraceEvent) {
 	if evt.Type != nil {
-		if *evt.Type == libp2ppb.TraceEvent_DROP_RPC {
+		switch *evt.Type {
+		case libp2ppb.TraceEvent_DROP_RPC:
 			p2pDrop.Inc()
+		case libp2ppb.TraceEvent_DUPLICATE_MESSAGE:
+			p2pDuplicateMessages.WithLabelValues(evt.GetDuplicateMessage().GetTopic()).Inc()
+		case libp2ppb.TraceEvent_REJECT_MESSAGE:
+			p2pRejectedMessages.WithLabelValues(evt.GetRejectMessage().GetReason()).Inc()
+		}
+	}
+}
+
+// inspectPeerScores is a gossipsub peer score inspector that exports the score of the peer protected for each known guardian.
+// Only guardian peers are exported to avoid a cardinality explosion caused by arbitrary peers.
+func (f *Components) inspectPeerScores(scores map[peer.ID]float64) {
+	f.ProtectedHostByGuardianKeyLock.Lock()
+	defer f.ProtectedHostByGuardianKeyLock.Unlock()
+
+	for guardianAddr, peerId := range f.ProtectedHostByGuardianKey {
+		score, ok := scores[peerId]
+		if !ok {
+			p2pGuardianPeerScore.DeleteLabelValues(guardianAddr.Hex())
+			continue
 		}
+		p2pGuardianPeerScore.WithLabelValues(guardianAddr.Hex()).Set(score)
 	}
 }
 
@@ -263,9 +311,6 @@ func NewHost(logger *zap.Logger, ctx context.Context, networkID string, bootstra
 		// Enable TLS security as the only security protocol.
 		libp2p.Security(libp2ptls.ID, libp2ptls.New),
 
-		// Enable QUIC transport as the only transport.
-		libp2p.Transport(libp2pquic.NewTransport),
-
 		// Let's prevent our peer from having too many
 		// connections by attaching a connection manager.
 		libp2p.ConnectionManager(components.ConnMgr),
@@ -288,6 +333,9 @@ func NewHost(logger *zap.Logger, ctx context.Context, networkID string, bootstra
 		}),
 	}
 
+	// Enable the configured transports (QUIC only by default) and NAT traversal.
+	opts = append(opts, components.TransportConfig.libp2pOptions()...)
+
 	// If the external IP to advertise is known ahead of time, disable address discovery.
 	if gossipAdvertiseAddress != nil {
 		opts = append(opts, libp2p.DisableIdentifyAddressDiscovery())
@@ -316,6 +364,7 @@ func Run(params *RunParams) func(ctx context.Context) error {
 		p2pReceiveChannelOverflow.WithLabelValues("signed_observation_request").Add(0)
 
 		logger := supervisor.Logger(ctx)
+		obsvReqLimiter := newObsvReqRateLimiter(obsvReqRateLimit, obsvReqBurst)
 
 		defer func() {
 			// TODO: Right now we're canceling the root context because it used to be the case that libp2p cannot be cleanly restarted.
@@ -352,13 +401,21 @@ func Run(params *RunParams) func(ctx context.Context) error {
 
 		logger.Info("connecting to pubsub")
 		ourTracer := &traceHandler{}
-		ps, err := pubsub.NewGossipSub(ctx, h,
+		psOpts := []pubsub.Option{
 			pubsub.WithValidateQueueSize(P2P_VALIDATE_QUEUE_SIZE),
 			pubsub.WithGossipSubParams(params.components.GossipParams),
 			pubsub.WithEventTracer(ourTracer),
 			// TODO: Investigate making this change. May need to use LaxSign until everyone has upgraded to that.
 			// pubsub.WithMessageSignaturePolicy(pubsub.StrictNoSign),
-		)
+		}
+		if params.components.PeerScoreParams != nil && params.components.PeerScoreThresholds != nil {
+			logger.Info("enabling gossipsub peer scoring")
+			psOpts = append(psOpts,
+				pubsub.WithPeerScore(params.components.PeerScoreParams, params.components.PeerScoreThresholds),
+				pubsub.WithPeerScoreInspect(pubsub.PeerScoreInspectFn(params.components.inspectPeerScores), peerScoreInspectInterval),
+			)
+		}
+		ps, err := pubsub.NewGossipSub(ctx, h, psOpts...)
 		if err != nil {
 			panic(err)
 		}
@@ -497,8 +554,6 @@ func Run(params *RunParams) func(ctx context.Context) error {
 		// Start up heartbeating if it is enabled.
 		if params.nodeName != "" {
 			go func() {
-				ourAddr := ethcrypto.PubkeyToAddress(params.guardianSigner.PublicKey(ctx))
-
 				ctr := int64(0)
 				// Guardians should send out their first heartbeat immediately to speed up test runs.
 				// But we also want to wait a little bit such that network connections can be established by then.
@@ -514,6 +569,10 @@ func Run(params *RunParams) func(ctx context.Context) error {
 
 						// create a heartbeat
 						b := func() []byte {
+							// The address is derived on every heartbeat, since a dual key signer switches to the new
+							// guardian key once a guardian set containing it is activated.
+							ourAddr := params.gossipSigner.GuardianAddress(ctx)
+
 							DefaultRegistry.mu.Lock()
 							defer DefaultRegistry.mu.Unlock()
 							networks := make([]*gossipv1.Heartbeat_Network, 0, len(DefaultRegistry.networkStats))
@@ -574,12 +633,12 @@ func Run(params *RunParams) func(ctx context.Context) error {
 							collectNodeMetrics(ourAddr, h.ID(), heartbeat)
 
 							if params.gov != nil {
-								params.gov.CollectMetrics(ctx, heartbeat, params.gossipControlSendC, params.guardianSigner, ourAddr)
+								params.gov.CollectMetrics(ctx, heartbeat, params.gossipControlSendC, params.gossipSigner, ourAddr)
 							}
 
 							msg := gossipv1.GossipMessage{
 								Message: &gossipv1.GossipMessage_SignedHeartbeat{
-									SignedHeartbeat: createSignedHeartbeat(ctx, params.guardianSigner, heartbeat),
+									SignedHeartbeat: createSignedHeartbeat(ctx, params.gossipSigner, heartbeat),
 								},
 							}
 
@@ -737,7 +796,7 @@ func Run(params *RunParams) func(ctx context.Context) error {
 							}
 							break
 						}
-						if heartbeat, err := processSignedHeartbeat(envelope.GetFrom(), s, gs, params.gst, params.disableHeartbeatVerify); err != nil {
+						if heartbeat, err := processSignedHeartbeat(envelope.GetFrom(), s, gs, params.gst, params.networkID, params.disableHeartbeatVerify); err != nil {
 							p2pMessagesReceived.WithLabelValues("invalid_heartbeat").Inc()
 							if logger.Level().Enabled(params.components.SignedHeartbeatLogLevel) {
 								logger.Log(params.components.SignedHeartbeatLogLevel, "invalid signed heartbeat received",
@@ -815,6 +874,11 @@ func Run(params *RunParams) func(ctx context.Context) error {
 										zap.Binary("raw", envelope.Data),
 										zap.String("from", envelope.GetFrom().String()))
 								}
+							} else if !obsvReqLimiter.allow(eth_common.BytesToAddress(s.GuardianAddr)) {
+								p2pMessagesReceived.WithLabelValues("rate_limited_signed_observation_request").Inc()
+								if logger.Level().Enabled(zapcore.DebugLevel) {
+									logger.Debug("dropping rate limited signed observation request", zap.Any("value", r), zap.String("from", envelope.GetFrom().String()))
+								}
 							} else {
 								if logger.Level().Enabled(zapcore.DebugLevel) {
 									logger.Debug("valid signed observation request received", zap.Any("value", r), zap.String("from", envelope.GetFrom().String()))
@@ -989,29 +1053,30 @@ func Run(params *RunParams) func(ctx context.Context) error {
 	}
 }
 
-func createSignedHeartbeat(ctx context.Context, guardianSigner guardiansigner.GuardianSigner, heartbeat *gossipv1.Heartbeat) *gossipv1.SignedHeartbeat {
-	ourAddr := ethcrypto.PubkeyToAddress(guardianSigner.PublicKey(ctx))
+func createSignedHeartbeat(ctx context.Context, gossipSigner *guardiansigner.GossipSigner, heartbeat *gossipv1.Heartbeat) *gossipv1.SignedHeartbeat {
+	ourAddr := gossipSigner.GuardianAddress(ctx)
 
 	b, err := proto.Marshal(heartbeat)
 	if err != nil {
 		panic(err)
 	}
 
-	// Sign the heartbeat using our node's guardian signer.
+	// Sign the heartbeat using our node's gossip key, or its guardian key if no gossip key is configured.
 	digest := heartbeatDigest(b)
-	sig, err := guardianSigner.Sign(ctx, digest.Bytes())
+	sig, binding, err := gossipSigner.Sign(ctx, digest.Bytes())
 	if err != nil {
 		panic(err)
 	}
 
 	return &gossipv1.SignedHeartbeat{
-		Heartbeat:    b,
-		Signature:    sig,
-		GuardianAddr: ourAddr.Bytes(),
+		Heartbeat:        b,
+		Signature:        sig,
+		GuardianAddr:     ourAddr.Bytes(),
+		GossipKeyBinding: binding,
 	}
 }
 
-func processSignedHeartbeat(from peer.ID, s *gossipv1.SignedHeartbeat, gs *common.GuardianSet, gst *common.GuardianSetState, disableVerify bool) (*gossipv1.Heartbeat, error) {
+func processSignedHeartbeat(from peer.ID, s *gossipv1.SignedHeartbeat, gs *common.GuardianSet, gst *common.GuardianSetState, networkID string, disableVerify bool) (*gossipv1.Heartbeat, error) {
 	envelopeAddr := eth_common.BytesToAddress(s.GuardianAddr)
 	idx, ok := gs.KeyIndex(envelopeAddr)
 	var pk eth_common.Address
@@ -1036,7 +1101,22 @@ func processSignedHeartbeat(from peer.ID, s *gossipv1.SignedHeartbeat, gs *commo
 	}
 
 	signerAddr := eth_common.BytesToAddress(ethcrypto.Keccak256(pubKey[1:])[12:])
-	if pk != signerAddr && !disableVerify {
+
+	// If the heartbeat was signed with a gossip key, the binding has to be signed by the guardian key for this network,
+	// and it must not have expired.
+	guardianAddr := signerAddr
+	if s.GossipKeyBinding != nil {
+		gossipKeyAddr, err := guardiansigner.VerifyGossipKeyBinding(s.GossipKeyBinding, envelopeAddr, networkID, time.Now())
+		if err != nil {
+			return nil, fmt.Errorf("invalid gossip key binding: %w", err)
+		}
+		if gossipKeyAddr != signerAddr {
+			return nil, fmt.Errorf("invalid signer: %v is not the bound gossip key", signerAddr)
+		}
+		guardianAddr = envelopeAddr
+	}
+
+	if pk != guardianAddr && !disableVerify {
 		return nil, fmt.Errorf("invalid signer: %v", signerAddr)
 	}
 
@@ -1050,7 +1130,7 @@ func processSignedHeartbeat(from peer.ID, s *gossipv1.SignedHeartbeat, gs *commo
 		return nil, fmt.Errorf("heartbeat is too old or too far into the future")
 	}
 
-	if h.GuardianAddr != signerAddr.String() {
+	if h.GuardianAddr != guardianAddr.String() {
 		return nil, fmt.Errorf("GuardianAddr in heartbeat does not match signerAddr")
 	}
 
@@ -1064,11 +1144,11 @@ func processSignedHeartbeat(from peer.ID, s *gossipv1.SignedHeartbeat, gs *commo
 	}
 
 	// Store verified heartbeat in global guardian set state.
-	if err := gst.SetHeartbeat(signerAddr, from, &h); err != nil {
+	if err := gst.SetHeartbeat(guardianAddr, from, &h); err != nil {
 		return nil, fmt.Errorf("failed to store in guardian set state: %w", err)
 	}
 
-	collectNodeMetrics(signerAddr, from, &h)
+	collectNodeMetrics(guardianAddr, from, &h)
 
 	return &h, nil
 }
@@ -1106,7 +1186,5 @@ func processSignedObservationRequest(s *gossipv1.SignedObservationRequest, gs *c
 		return nil, fmt.Errorf("failed to unmarshal observation request: %w", err)
 	}
 
-	// TODO: implement per-guardian rate limiting
-
 	return &h, nil
 }
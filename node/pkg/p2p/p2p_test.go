@@ -6,13 +6,16 @@ import (
 	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	node_common "github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/guardiansigner"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	libp2ppb "github.com/libp2p/go-libp2p-pubsub/pb"
 )
 
 func TestSignedHeartbeat(t *testing.T) {
@@ -20,6 +23,9 @@ func TestSignedHeartbeat(t *testing.T) {
 	type testCase struct {
 		timestamp             int64
 		guardianSigner        guardiansigner.GuardianSigner
+		gossipKeySigner       guardiansigner.GuardianSigner
+		dropBinding           bool
+		bindingNetworkID      string
 		heartbeatGuardianAddr string
 		fromP2pId             peer.ID
 		p2pNodeId             []byte
@@ -44,6 +50,9 @@ func TestSignedHeartbeat(t *testing.T) {
 	p2pNodeId2, err := fromP2pId2.Marshal()
 	assert.NoError(t, err)
 
+	gossipKeySigner, err := guardiansigner.GenerateSignerWithPrivatekeyUnsafe(nil)
+	assert.NoError(t, err)
+
 	tests := []testCase{
 		// happy case
 		{
@@ -90,6 +99,38 @@ func TestSignedHeartbeat(t *testing.T) {
 			p2pNodeId:             p2pNodeId2,
 			expectSuccess:         false,
 		},
+		// heartbeat signed with a bound gossip key
+		{
+			timestamp:             time.Now().UnixNano(),
+			guardianSigner:        guardianSigner,
+			gossipKeySigner:       gossipKeySigner,
+			heartbeatGuardianAddr: gAddr.String(),
+			fromP2pId:             fromP2pId,
+			p2pNodeId:             p2pNodeId,
+			expectSuccess:         true,
+		},
+		// heartbeat signed with a gossip key that is not bound
+		{
+			timestamp:             time.Now().UnixNano(),
+			guardianSigner:        guardianSigner,
+			gossipKeySigner:       gossipKeySigner,
+			dropBinding:           true,
+			heartbeatGuardianAddr: gAddr.String(),
+			fromP2pId:             fromP2pId,
+			p2pNodeId:             p2pNodeId,
+			expectSuccess:         false,
+		},
+		// heartbeat signed with a gossip key that is bound on another network
+		{
+			timestamp:             time.Now().UnixNano(),
+			guardianSigner:        guardianSigner,
+			gossipKeySigner:       gossipKeySigner,
+			bindingNetworkID:      "/wormhole/testnet/2/1",
+			heartbeatGuardianAddr: gAddr.String(),
+			fromP2pId:             fromP2pId,
+			p2pNodeId:             p2pNodeId,
+			expectSuccess:         false,
+		},
 	}
 	// run the tests
 
@@ -109,7 +150,16 @@ func TestSignedHeartbeat(t *testing.T) {
 			P2PNodeId:     tc.p2pNodeId,
 		}
 
-		s := createSignedHeartbeat(context.Background(), guardianSigner, heartbeat)
+		networkID := "/wormhole/mainnet/2"
+		bindingNetworkID := networkID
+		if tc.bindingNetworkID != "" {
+			bindingNetworkID = tc.bindingNetworkID
+		}
+
+		s := createSignedHeartbeat(context.Background(), guardiansigner.NewGossipSigner(tc.guardianSigner, tc.gossipKeySigner, bindingNetworkID), heartbeat)
+		if tc.dropBinding {
+			s.GossipKeyBinding = nil
+		}
 		gs := &node_common.GuardianSet{
 			Keys:  []common.Address{addr},
 			Index: 1,
@@ -117,7 +167,7 @@ func TestSignedHeartbeat(t *testing.T) {
 
 		gst := node_common.NewGuardianSetState(nil)
 
-		heartbeatResult, err := processSignedHeartbeat(tc.fromP2pId, s, gs, gst, false)
+		heartbeatResult, err := processSignedHeartbeat(tc.fromP2pId, s, gs, gst, networkID, false)
 
 		if tc.expectSuccess {
 			assert.NoError(t, err)
@@ -131,3 +181,89 @@ func TestSignedHeartbeat(t *testing.T) {
 		testFunc(t, tc)
 	}
 }
+
+func TestSignedHeartbeatAfterKeyRotation(t *testing.T) {
+	ctx := context.Background()
+	networkID := "/wormhole/mainnet/2"
+
+	oldSigner, err := guardiansigner.GenerateSignerWithPrivatekeyUnsafe(nil)
+	require.NoError(t, err)
+	newSigner, err := guardiansigner.GenerateSignerWithPrivatekeyUnsafe(nil)
+	require.NoError(t, err)
+	dualKeySigner, err := guardiansigner.NewDualKeySigner(ctx, oldSigner, newSigner)
+	require.NoError(t, err)
+	gossipSigner := guardiansigner.NewGossipSigner(dualKeySigner, nil, networkID)
+
+	oldAddr := crypto.PubkeyToAddress(oldSigner.PublicKey(ctx))
+	newAddr := crypto.PubkeyToAddress(newSigner.PublicKey(ctx))
+	fromP2pId, err := peer.Decode("12D3KooWSgMXkhzTbKTeupHYmyG7sFJ5LpVreQcwVnX8RD7LBpy9")
+	require.NoError(t, err)
+	p2pNodeId, err := fromP2pId.Marshal()
+	require.NoError(t, err)
+
+	// heartbeat creates a heartbeat the way the heartbeat loop does, for the given guardian address
+	heartbeat := func(guardianAddr common.Address) *gossipv1.SignedHeartbeat {
+		return createSignedHeartbeat(ctx, gossipSigner, &gossipv1.Heartbeat{
+			NodeName:     "someNode",
+			Timestamp:    time.Now().UnixNano(),
+			GuardianAddr: guardianAddr.String(),
+			P2PNodeId:    p2pNodeId,
+		})
+	}
+	gst := node_common.NewGuardianSetState(nil)
+
+	oldGs := &node_common.GuardianSet{Keys: []common.Address{oldAddr}, Index: 1}
+	dualKeySigner.SetGuardianSet(ctx, oldGs)
+	_, err = processSignedHeartbeat(fromP2pId, heartbeat(gossipSigner.GuardianAddress(ctx)), oldGs, gst, networkID, false)
+	require.NoError(t, err)
+
+	// Once the new key is in the active guardian set, heartbeats have to carry the new address
+	newGs := &node_common.GuardianSet{Keys: []common.Address{newAddr}, Index: 2}
+	dualKeySigner.SetGuardianSet(ctx, newGs)
+	require.Equal(t, newAddr, gossipSigner.GuardianAddress(ctx))
+	_, err = processSignedHeartbeat(fromP2pId, heartbeat(gossipSigner.GuardianAddress(ctx)), newGs, gst, networkID, false)
+	require.NoError(t, err)
+	_, err = processSignedHeartbeat(fromP2pId, heartbeat(oldAddr), newGs, gst, networkID, false)
+	require.Error(t, err)
+}
+
+func TestTraceHandlerPegsGossipMetrics(t *testing.T) {
+	topic := "test-trace-topic"
+	reason := "test-trace-reason"
+	duplicate := libp2ppb.TraceEvent_DUPLICATE_MESSAGE
+	reject := libp2ppb.TraceEvent_REJECT_MESSAGE
+
+	before := testutil.ToFloat64(p2pDuplicateMessages.WithLabelValues(topic))
+	tracer := &traceHandler{}
+	tracer.Trace(&libp2ppb.TraceEvent{Type: &duplicate, DuplicateMessage: &libp2ppb.TraceEvent_DuplicateMessage{Topic: &topic}})
+	tracer.Trace(&libp2ppb.TraceEvent{Type: &duplicate, DuplicateMessage: &libp2ppb.TraceEvent_DuplicateMessage{Topic: &topic}})
+	assert.Equal(t, before+2, testutil.ToFloat64(p2pDuplicateMessages.WithLabelValues(topic)))
+
+	before = testutil.ToFloat64(p2pRejectedMessages.WithLabelValues(reason))
+	tracer.Trace(&libp2ppb.TraceEvent{Type: &reject, RejectMessage: &libp2ppb.TraceEvent_RejectMessage{Reason: &reason}})
+	assert.Equal(t, before+1, testutil.ToFloat64(p2pRejectedMessages.WithLabelValues(reason)))
+
+	// Events without a type should be ignored.
+	tracer.Trace(&libp2ppb.TraceEvent{})
+}
+
+func TestInspectPeerScores(t *testing.T) {
+	peerId, err := peer.Decode("12D3KooWSgMXkhzTbKTeupHYmyG7sFJ5LpVreQcwVnX8RD7LBpy9")
+	require.NoError(t, err)
+	otherPeerId, err := peer.Decode("12D3KooWNgsiB5Ku5xyrKoHnZCSLyMCMzvkzumDFDefaCexfaW5C")
+	require.NoError(t, err)
+
+	gAddr := common.HexToAddress("0x000000000000000000000000000000000000f00d")
+	components := DefaultComponents()
+	components.ProtectedHostByGuardianKey[gAddr] = peerId
+
+	components.inspectPeerScores(map[peer.ID]float64{peerId: 12.5, otherPeerId: -100})
+	assert.Equal(t, 12.5, testutil.ToFloat64(p2pGuardianPeerScore.WithLabelValues(gAddr.Hex())))
+
+	// Only guardian peers should be exported.
+	assert.Equal(t, 1, testutil.CollectAndCount(p2pGuardianPeerScore))
+
+	// A guardian peer that is no longer scored should be removed.
+	components.inspectPeerScores(map[peer.ID]float64{otherPeerId: -100})
+	assert.Equal(t, 0, testutil.CollectAndCount(p2pGuardianPeerScore))
+}
@@ -44,6 +44,11 @@ type (
 		// disableHeartbeatVerify is optional and can be set with `WithDisableHeartbeatVerify` or `WithGuardianOptions`.
 		disableHeartbeatVerify bool
 
+		// gossipKeySigner is optional and can be set with `WithGossipKeySigner`.
+		gossipKeySigner guardiansigner.GuardianSigner
+		// gossipSigner signs heartbeats and governor messages. It is derived from guardianSigner and gossipKeySigner.
+		gossipSigner *guardiansigner.GossipSigner
+
 		// The following options are guardian specific. Set with `WithGuardianOptions`.
 		nodeName               string
 		guardianSigner         guardiansigner.GuardianSigner
@@ -98,6 +103,10 @@ func NewRunParams(
 		return nil, err
 	}
 
+	if p.guardianSigner != nil {
+		p.gossipSigner = guardiansigner.NewGossipSigner(p.guardianSigner, p.gossipKeySigner, p.networkID)
+	}
+
 	return p, nil
 }
 
@@ -173,6 +182,15 @@ func WithDisableHeartbeatVerify(disableHeartbeatVerify bool) RunOpt {
 	}
 }
 
+// WithGossipKeySigner is used to set the signer of the gossip key, which signs heartbeats and governor messages
+// instead of the guardian key. The guardian key signs a binding of the gossip key, which is attached to the messages.
+func WithGossipKeySigner(gossipKeySigner guardiansigner.GuardianSigner) RunOpt {
+	return func(p *RunParams) error {
+		p.gossipKeySigner = gossipKeySigner
+		return nil
+	}
+}
+
 // WithGuardianOptions is used to set options that are only meaningful to the guardian.
 func WithGuardianOptions(
 	nodeName string,
@@ -252,5 +270,10 @@ func (p *RunParams) verify() error {
 			return errors.New("if obsvReqSendC is not nil, vs may not be nil")
 		}
 	}
+	if p.gossipKeySigner != nil {
+		if p.guardianSigner == nil {
+			return errors.New("if gossipKeySigner is not nil, guardianSigner may not be nil")
+		}
+	}
 	return nil
 }
@@ -0,0 +1,157 @@
+package p2p
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/p2p/net/swarm"
+	libp2pquic "github.com/libp2p/go-libp2p/p2p/transport/quic"
+	libp2ptcp "github.com/libp2p/go-libp2p/p2p/transport/tcp"
+	"github.com/multiformats/go-multiaddr"
+)
+
+// Transport identifies a libp2p transport that the guardian may use.
+type Transport string
+
+const (
+	TransportQUIC Transport = "quic"
+	TransportTCP  Transport = "tcp"
+)
+
+// transportPreferenceDelay is how long dialing an address of a less preferred transport is delayed
+// relative to the next more preferred one. This gives the preferred transport a head start without
+// waiting for it to time out before falling back.
+const transportPreferenceDelay = 250 * time.Millisecond
+
+// TransportConfig configures the transports and NAT traversal used by the libp2p host.
+type TransportConfig struct {
+	// Transports lists the enabled transports in order of preference. The first entry is dialed first.
+	Transports []Transport
+	// NATPortMap enables attempting to open a port in the local router using UPnP / NAT-PMP.
+	NATPortMap bool
+	// HolePunching enables the libp2p hole punching service for peers behind a NAT.
+	HolePunching bool
+}
+
+// DefaultTransportConfig returns the default transport configuration, which only uses QUIC.
+func DefaultTransportConfig() TransportConfig {
+	return TransportConfig{
+		Transports: []Transport{TransportQUIC},
+	}
+}
+
+// ParseTransports parses a comma-separated list of transports, in order of preference, such as "quic,tcp".
+// QUIC must always be included since the rest of the network is only reachable over QUIC.
+func ParseTransports(str string) ([]Transport, error) {
+	transports := []Transport{}
+	seen := map[Transport]struct{}{}
+	for _, s := range strings.Split(str, ",") {
+		t := Transport(strings.ToLower(strings.TrimSpace(s)))
+		if t != TransportQUIC && t != TransportTCP {
+			return nil, fmt.Errorf(`invalid transport "%s", must be "%s" or "%s"`, s, TransportQUIC, TransportTCP)
+		}
+		if _, exists := seen[t]; exists {
+			return nil, fmt.Errorf(`transport "%s" specified more than once`, t)
+		}
+		seen[t] = struct{}{}
+		transports = append(transports, t)
+	}
+
+	if _, exists := seen[TransportQUIC]; !exists {
+		return nil, fmt.Errorf(`transport "%s" must be enabled`, TransportQUIC)
+	}
+
+	return transports, nil
+}
+
+// enabled returns true if the specified transport is enabled.
+func (tc *TransportConfig) enabled(t Transport) bool {
+	for _, et := range tc.Transports {
+		if et == t {
+			return true
+		}
+	}
+	return false
+}
+
+// listeningAddressPatterns returns the additional listening address patterns required by the configured transports.
+// The QUIC patterns are part of the default listening addresses, so they are not included here.
+func (tc *TransportConfig) listeningAddressPatterns() []string {
+	if !tc.enabled(TransportTCP) {
+		return nil
+	}
+	return []string{
+		"/ip4/0.0.0.0/tcp/%d",
+		"/ip6/::/tcp/%d",
+	}
+}
+
+// libp2pOptions returns the libp2p options required to enable the configured transports and NAT traversal.
+func (tc *TransportConfig) libp2pOptions() []libp2p.Option {
+	opts := []libp2p.Option{}
+	if len(tc.Transports) == 0 {
+		// Never fall back to the libp2p default transports.
+		return append(opts, libp2p.Transport(libp2pquic.NewTransport))
+	}
+
+	for _, t := range tc.Transports {
+		switch t {
+		case TransportQUIC:
+			opts = append(opts, libp2p.Transport(libp2pquic.NewTransport))
+		case TransportTCP:
+			opts = append(opts, libp2p.Transport(libp2ptcp.NewTCPTransport))
+		}
+	}
+
+	// The libp2p default dial ranker already prefers QUIC, so only override it when there is something to rank.
+	if len(tc.Transports) > 1 {
+		opts = append(opts, libp2p.SwarmOpts(swarm.WithDialRanker(transportDialRanker(tc.Transports))))
+	}
+
+	if tc.NATPortMap {
+		opts = append(opts, libp2p.NATPortMap())
+	}
+
+	if tc.HolePunching {
+		opts = append(opts, libp2p.EnableHolePunching())
+	}
+
+	return opts
+}
+
+// transportDialRanker returns a dial ranker that dials addresses of more preferred transports first.
+// Addresses of transports that are not in the preference list are dialed last.
+func transportDialRanker(preference []Transport) network.DialRanker {
+	return func(addrs []multiaddr.Multiaddr) []network.AddrDelay {
+		res := make([]network.AddrDelay, 0, len(addrs))
+		for _, addr := range addrs {
+			rank := len(preference)
+			for i, t := range preference {
+				if addrUsesTransport(addr, t) {
+					rank = i
+					break
+				}
+			}
+			res = append(res, network.AddrDelay{Addr: addr, Delay: time.Duration(rank) * transportPreferenceDelay})
+		}
+		return res
+	}
+}
+
+// addrUsesTransport returns true if the multiaddr uses the specified transport.
+func addrUsesTransport(addr multiaddr.Multiaddr, t Transport) bool {
+	var code int
+	switch t {
+	case TransportQUIC:
+		code = multiaddr.P_QUIC_V1
+	case TransportTCP:
+		code = multiaddr.P_TCP
+	default:
+		return false
+	}
+	_, err := addr.ValueForProtocol(code)
+	return err == nil
+}
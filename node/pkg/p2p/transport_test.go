@@ -0,0 +1,73 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTransports(t *testing.T) {
+	type test struct {
+		input    string
+		expected []Transport
+		errText  string
+	}
+
+	tests := []test{
+		{input: "quic", expected: []Transport{TransportQUIC}},
+		{input: "quic,tcp", expected: []Transport{TransportQUIC, TransportTCP}},
+		{input: " TCP , QUIC ", expected: []Transport{TransportTCP, TransportQUIC}},
+		{input: "", errText: `invalid transport ""`},
+		{input: "quic,websocket", errText: `invalid transport "websocket"`},
+		{input: "quic,tcp,quic", errText: `transport "quic" specified more than once`},
+		{input: "tcp", errText: `transport "quic" must be enabled`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			transports, err := ParseTransports(tc.input)
+			if tc.errText != "" {
+				require.ErrorContains(t, err, tc.errText)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, transports)
+		})
+	}
+}
+
+func TestListeningAddressesWithTCP(t *testing.T) {
+	components := DefaultComponents()
+	components.Port = 8999
+	assert.Equal(t, []string{"/ip4/0.0.0.0/udp/8999/quic-v1", "/ip6/::/udp/8999/quic-v1"}, components.ListeningAddresses())
+
+	components.TransportConfig.Transports = []Transport{TransportQUIC, TransportTCP}
+	assert.Equal(t, []string{
+		"/ip4/0.0.0.0/udp/8999/quic-v1",
+		"/ip6/::/udp/8999/quic-v1",
+		"/ip4/0.0.0.0/tcp/8999",
+		"/ip6/::/tcp/8999",
+	}, components.ListeningAddresses())
+}
+
+func TestTransportDialRanker(t *testing.T) {
+	quicAddr := multiaddr.StringCast("/ip4/1.2.3.4/udp/8999/quic-v1")
+	tcpAddr := multiaddr.StringCast("/ip4/1.2.3.4/tcp/8999")
+	wsAddr := multiaddr.StringCast("/ip4/1.2.3.4/udp/8999/quic-v1/webtransport")
+	otherAddr := multiaddr.StringCast("/ip4/1.2.3.4/udp/8999/webrtc-direct")
+
+	ranked := transportDialRanker([]Transport{TransportTCP, TransportQUIC})([]multiaddr.Multiaddr{quicAddr, tcpAddr, wsAddr, otherAddr})
+	require.Len(t, ranked, 4)
+
+	delays := map[string]time.Duration{}
+	for _, r := range ranked {
+		delays[r.Addr.String()] = r.Delay
+	}
+	assert.Equal(t, time.Duration(0), delays[tcpAddr.String()])
+	assert.Equal(t, transportPreferenceDelay, delays[quicAddr.String()])
+	assert.Equal(t, transportPreferenceDelay, delays[wsAddr.String()])
+	assert.Equal(t, 2*transportPreferenceDelay, delays[otherAddr.String()])
+}
@@ -0,0 +1,238 @@
+package processor
+
+import (
+	"container/list"
+	"hash/fnv"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+// The aggregation state is split into shards by observation digest. Each shard holds a bounded number of entries in the
+// order they were created, which allows evicting and expiring the oldest entries without scanning the whole state.
+// This keeps memory usage bounded when the node is flooded with observations, for example during a gossip storm.
+
+// aggregationStateShards is the number of shards of the aggregation state.
+const aggregationStateShards = 16
+
+// aggregationStateMaxAge is the maximum time an entry is kept in the aggregation state. The cleanup normally removes
+// entries well before that, so this only catches entries that would otherwise leak.
+const aggregationStateMaxAge = retryLimitOurs
+
+// MaxAggregationStateEntries is the maximum number of entries in the aggregation state, across all shards.
+var MaxAggregationStateEntries = 200_000
+
+var (
+	aggregationStateShardEntries = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_aggregation_state_shard_entries",
+			Help: "Current number of aggregation state entries, grouped by shard",
+		}, []string{"shard"})
+	aggregationStateEvictions = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_aggregation_state_evictions_total",
+			Help: "Total number of aggregation state entries evicted because the shard was full or the entry exceeded the maximum age, grouped by shard and reason",
+		}, []string{"shard", "reason"})
+	aggregationStateQuorumLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "wormhole_aggregation_state_quorum_latency_seconds",
+			Help:    "Time between the first observation of a message and reaching quorum on it, grouped by shard",
+			Buckets: []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 300, 3600},
+		}, []string{"shard"})
+)
+
+type (
+	// aggregationState represents the node's aggregation of guardian signatures. It is only accessed by the processor
+	// goroutine and is therefore not safe for concurrent use.
+	aggregationState struct {
+		logger *zap.Logger
+		shards [aggregationStateShards]*aggregationShard
+		// byMessageID holds the digests of the entries by message ID, which is used to detect conflicting observations.
+		byMessageID map[string]map[string]struct{}
+	}
+
+	// aggregationShard holds the states of the observations whose digest maps to the shard.
+	aggregationShard struct {
+		parent     *aggregationState
+		label      string
+		maxEntries int
+		entries    map[string]*state
+		// order holds the digests of the entries, oldest first.
+		order *list.List
+	}
+)
+
+// newAggregationState creates an aggregation state holding up to maxEntries entries, split evenly across the shards.
+func newAggregationState(logger *zap.Logger, maxEntries int) *aggregationState {
+	perShard := maxEntries / aggregationStateShards
+	if perShard < 1 {
+		perShard = 1
+	}
+
+	a := &aggregationState{
+		logger:      logger,
+		byMessageID: map[string]map[string]struct{}{},
+	}
+	for i := range a.shards {
+		a.shards[i] = &aggregationShard{
+			parent:     a,
+			label:      strconv.Itoa(i),
+			maxEntries: perShard,
+			entries:    map[string]*state{},
+			order:      list.New(),
+		}
+	}
+	return a
+}
+
+// shardFor returns the shard of the specified digest.
+func (a *aggregationState) shardFor(hash string) *aggregationShard {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(hash))
+	return a.shards[h.Sum32()%aggregationStateShards]
+}
+
+// get returns the state for the specified digest, or nil if there is none.
+func (a *aggregationState) get(hash string) *state {
+	return a.shardFor(hash).entries[hash]
+}
+
+// insert adds the state for the specified digest, evicting an older entry if the shard is full. Submitted entries and
+// entries we have not observed ourselves are evicted first. If there are none, the oldest entry is evicted when
+// inserting our own observation. Otherwise the new entry is dropped and false is returned.
+func (a *aggregationState) insert(hash string, s *state, ours bool) bool {
+	shard := a.shardFor(hash)
+	if len(shard.entries) >= shard.maxEntries && !shard.evictOne(a.logger, ours) {
+		return false
+	}
+
+	s.shard = shard
+	s.elem = shard.order.PushBack(hash)
+	shard.entries[hash] = s
+	return true
+}
+
+// delete removes the state for the specified digest, if any.
+func (a *aggregationState) delete(hash string) {
+	a.shardFor(hash).delete(hash)
+}
+
+// setMessageID sets the message ID of the entry with the specified digest and indexes it. Remote guardians could
+// claim an incorrect message ID, so it should be overwritten once we observed the message ourselves.
+func (a *aggregationState) setMessageID(hash string, s *state, messageID string) {
+	if s.messageID == messageID {
+		return
+	}
+	a.unindex(hash, s)
+	s.messageID = messageID
+	if messageID == "" {
+		return
+	}
+	digests, exists := a.byMessageID[messageID]
+	if !exists {
+		digests = map[string]struct{}{}
+		a.byMessageID[messageID] = digests
+	}
+	digests[hash] = struct{}{}
+}
+
+// unindex removes the entry with the specified digest from the message ID index.
+func (a *aggregationState) unindex(hash string, s *state) {
+	digests, exists := a.byMessageID[s.messageID]
+	if !exists {
+		return
+	}
+	delete(digests, hash)
+	if len(digests) == 0 {
+		delete(a.byMessageID, s.messageID)
+	}
+}
+
+// digestsFor returns the digests of the entries with the specified message ID.
+func (a *aggregationState) digestsFor(messageID string) map[string]struct{} {
+	return a.byMessageID[messageID]
+}
+
+// len returns the total number of entries.
+func (a *aggregationState) len() int {
+	n := 0
+	for _, shard := range a.shards {
+		n += len(shard.entries)
+	}
+	return n
+}
+
+// expire removes the entries that were first observed before the specified time and returns the number removed.
+func (a *aggregationState) expire(before time.Time) int {
+	count := 0
+	for _, shard := range a.shards {
+		for e := shard.order.Front(); e != nil; e = shard.order.Front() {
+			hash := e.Value.(string)
+			s := shard.entries[hash]
+			if !s.firstObserved.Before(before) {
+				// Entries are ordered by creation time, so the remaining ones are newer.
+				break
+			}
+			shard.delete(hash)
+			aggregationStateEvictions.WithLabelValues(shard.label, "expired").Inc()
+			count++
+		}
+	}
+	return count
+}
+
+// updateMetrics sets the per shard entry gauges.
+func (a *aggregationState) updateMetrics() {
+	for _, shard := range a.shards {
+		aggregationStateShardEntries.WithLabelValues(shard.label).Set(float64(len(shard.entries)))
+	}
+}
+
+// delete removes the state for the specified digest from the shard, if any.
+func (shard *aggregationShard) delete(hash string) {
+	s, exists := shard.entries[hash]
+	if !exists {
+		return
+	}
+	shard.order.Remove(s.elem)
+	delete(shard.entries, hash)
+	shard.parent.unindex(hash, s)
+}
+
+// evictOne evicts a single entry to make room for a new one, see insert. It returns false if nothing was evicted.
+func (shard *aggregationShard) evictOne(logger *zap.Logger, ours bool) bool {
+	for e := shard.order.Front(); e != nil; e = e.Next() {
+		hash := e.Value.(string)
+		s := shard.entries[hash]
+		switch {
+		case s.submitted:
+			aggregationStateEvictions.WithLabelValues(shard.label, "submitted").Inc()
+		case s.ourObservation == nil:
+			aggregationStateEvictions.WithLabelValues(shard.label, "unobserved").Inc()
+		default:
+			continue
+		}
+		shard.delete(hash)
+		return true
+	}
+
+	if !ours {
+		return false
+	}
+
+	// Every entry is one of our own observations that has not reached quorum yet. Our new observation is at least as
+	// important as the oldest one, which is the least likely to still reach quorum.
+	e := shard.order.Front()
+	hash := e.Value.(string)
+	logger.Warn("aggregation state shard full, evicting oldest pending observation",
+		zap.String("shard", shard.label),
+		zap.String("message_id", shard.entries[hash].LoggingID()),
+		zap.String("digest", hash),
+	)
+	shard.delete(hash)
+	aggregationStateEvictions.WithLabelValues(shard.label, "pending").Inc()
+	return true
+}
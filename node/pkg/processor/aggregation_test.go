@@ -0,0 +1,125 @@
+package processor
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestState(firstObserved time.Time) *state {
+	return &state{
+		firstObserved: firstObserved,
+		signatures:    map[ethCommon.Address][]byte{},
+	}
+}
+
+// hashesInShard returns n distinct digests that map to the same shard.
+func hashesInShard(a *aggregationState, n int) []string {
+	target := a.shardFor("0")
+	hashes := []string{}
+	for i := 0; len(hashes) < n; i++ {
+		hash := fmt.Sprintf("%064x", i)
+		if a.shardFor(hash) == target {
+			hashes = append(hashes, hash)
+		}
+	}
+	return hashes
+}
+
+func TestAggregationStateInsertGetDelete(t *testing.T) {
+	a := newAggregationState(zap.NewNop(), 1000)
+	now := time.Now()
+
+	for i := 0; i < 100; i++ {
+		require.True(t, a.insert(fmt.Sprintf("%064x", i), newTestState(now), false))
+	}
+	assert.Equal(t, 100, a.len())
+
+	// The digests should be spread across the shards.
+	for _, shard := range a.shards {
+		assert.NotEmpty(t, shard.entries)
+	}
+
+	hash := fmt.Sprintf("%064x", 42)
+	s := a.get(hash)
+	require.NotNil(t, s)
+	assert.Equal(t, a.shardFor(hash), s.shard)
+
+	a.delete(hash)
+	assert.Nil(t, a.get(hash))
+	assert.Equal(t, 99, a.len())
+	assert.Equal(t, len(s.shard.entries), s.shard.order.Len())
+
+	// Deleting a missing entry is a no-op.
+	a.delete(hash)
+	assert.Equal(t, 99, a.len())
+}
+
+func TestAggregationStateEviction(t *testing.T) {
+	// Two entries per shard.
+	a := newAggregationState(zap.NewNop(), 2*aggregationStateShards)
+	hashes := hashesInShard(a, 5)
+	shard := a.shardFor(hashes[0])
+	now := time.Now()
+
+	ours := func(firstObserved time.Time) *state {
+		s := newTestState(firstObserved)
+		s.ourObservation = &VAA{}
+		return s
+	}
+
+	// A full shard of our pending observations does not accept observations of others.
+	require.True(t, a.insert(hashes[0], ours(now), true))
+	require.True(t, a.insert(hashes[1], ours(now.Add(time.Second)), true))
+	assert.False(t, a.insert(hashes[2], newTestState(now), false))
+	assert.Nil(t, a.get(hashes[2]))
+
+	// But a new observation of ours evicts the oldest pending one.
+	pending := testutil.ToFloat64(aggregationStateEvictions.WithLabelValues(shard.label, "pending"))
+	require.True(t, a.insert(hashes[2], ours(now.Add(2*time.Second)), true))
+	assert.Nil(t, a.get(hashes[0]))
+	assert.Equal(t, pending+1, testutil.ToFloat64(aggregationStateEvictions.WithLabelValues(shard.label, "pending")))
+
+	// Submitted entries are evicted before pending ones, even if they are newer.
+	a.get(hashes[2]).submitted = true
+	submitted := testutil.ToFloat64(aggregationStateEvictions.WithLabelValues(shard.label, "submitted"))
+	require.True(t, a.insert(hashes[3], newTestState(now), false))
+	assert.Nil(t, a.get(hashes[2]))
+	assert.NotNil(t, a.get(hashes[1]))
+	assert.Equal(t, submitted+1, testutil.ToFloat64(aggregationStateEvictions.WithLabelValues(shard.label, "submitted")))
+
+	// Entries we have not observed ourselves are evicted before pending ones.
+	unobserved := testutil.ToFloat64(aggregationStateEvictions.WithLabelValues(shard.label, "unobserved"))
+	require.True(t, a.insert(hashes[4], newTestState(now), false))
+	assert.Nil(t, a.get(hashes[3]))
+	assert.NotNil(t, a.get(hashes[1]))
+	assert.Equal(t, unobserved+1, testutil.ToFloat64(aggregationStateEvictions.WithLabelValues(shard.label, "unobserved")))
+
+	assert.Len(t, shard.entries, 2)
+	assert.Equal(t, 2, shard.order.Len())
+}
+
+func TestAggregationStateExpire(t *testing.T) {
+	a := newAggregationState(zap.NewNop(), 1000)
+	now := time.Now()
+
+	for i := 0; i < 50; i++ {
+		require.True(t, a.insert(fmt.Sprintf("old%d", i), newTestState(now.Add(-2*time.Hour)), false))
+	}
+	for i := 0; i < 50; i++ {
+		require.True(t, a.insert(fmt.Sprintf("new%d", i), newTestState(now), false))
+	}
+
+	assert.Equal(t, 50, a.expire(now.Add(-time.Hour)))
+	assert.Equal(t, 50, a.len())
+	for i := 0; i < 50; i++ {
+		assert.Nil(t, a.get(fmt.Sprintf("old%d", i)))
+		assert.NotNil(t, a.get(fmt.Sprintf("new%d", i)))
+	}
+}
@@ -4,14 +4,55 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/p2p"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"google.golang.org/protobuf/proto"
 )
 
+// maxObservationBatchDelayLimit is the longest batch delay that can be configured. Longer delays would let the batch
+// publish channel overflow during bursts.
+const maxObservationBatchDelayLimit = 5 * time.Second
+
+var observationBatchSize = promauto.NewHistogram(
+	prometheus.HistogramOpts{
+		Name:    "wormhole_observation_batch_size",
+		Help:    "Histogram of the number of observations in published observation batches",
+		Buckets: []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000, 2000, 4000},
+	})
+
+// ObservationBatchConfig configures when our observations are published as a batch. A batch is published as soon as
+// it holds MaxSize observations, or MaxDelay after the batch processor started collecting it, whichever comes first.
+// Lower values reduce the latency of observations, higher values reduce the number of gossip messages during bursts.
+type ObservationBatchConfig struct {
+	MaxSize  int
+	MaxDelay time.Duration
+}
+
+// DefaultObservationBatchConfig publishes batches of up to p2p.MaxObservationBatchSize observations at least once per
+// p2p.MaxObservationBatchDelay.
+var DefaultObservationBatchConfig = ObservationBatchConfig{
+	MaxSize:  p2p.MaxObservationBatchSize,
+	MaxDelay: p2p.MaxObservationBatchDelay,
+}
+
+// Validate returns an error if the batch thresholds are out of range.
+func (c ObservationBatchConfig) Validate() error {
+	if c.MaxSize < 1 || c.MaxSize > p2p.MaxObservationBatchSize {
+		return fmt.Errorf("observation batch size must be between 1 and %d", p2p.MaxObservationBatchSize)
+	}
+	if c.MaxDelay <= 0 || c.MaxDelay > maxObservationBatchDelayLimit {
+		return fmt.Errorf("observation batch delay must be positive and at most %s", maxObservationBatchDelayLimit)
+	}
+	return nil
+}
+
 // postObservationToBatch posts an individual observation to the batch processor.
 func (p *Processor) postObservationToBatch(obs *gossipv1.Observation) {
 	select {
@@ -39,15 +80,16 @@ func (p *Processor) batchProcessor(ctx context.Context) error {
 // handleBatch reads observations from the channel, either until a timeout occurs or the batch is full.
 // Then it builds a `SendObservationBatch` gossip message and posts it to p2p.
 func (p *Processor) handleBatch(ctx context.Context) error {
-	ctx, cancel := context.WithTimeout(ctx, p2p.MaxObservationBatchDelay)
+	ctx, cancel := context.WithTimeout(ctx, p.batchConfig.MaxDelay)
 	defer cancel()
 
-	observations, err := common.ReadFromChannelWithTimeout(ctx, p.batchObsvPubC, p2p.MaxObservationBatchSize)
+	observations, err := common.ReadFromChannelWithTimeout(ctx, p.batchObsvPubC, p.batchConfig.MaxSize)
 	if err != nil && !errors.Is(err, context.DeadlineExceeded) {
 		return fmt.Errorf("failed to read observations from the internal observation batch channel: %w", err)
 	}
 
 	if len(observations) != 0 {
+		observationBatchSize.Observe(float64(len(observations)))
 		_ = p.publishBatch(observations)
 	}
 
@@ -56,8 +98,11 @@ func (p *Processor) handleBatch(ctx context.Context) error {
 
 // publishBatch formats the set of observations into a gossip message, publishes it, and returns the message bytes.
 func (p *Processor) publishBatch(observations []*gossipv1.Observation) []byte {
+	// The address is taken from the signer rather than p.ourAddr, which the processor loop updates when the
+	// guardian key is rotated.
+	ourAddr := crypto.PubkeyToAddress(p.guardianSigner.PublicKey(context.Background()))
 	batchMsg := gossipv1.SignedObservationBatch{
-		Addr:         p.ourAddr.Bytes(),
+		Addr:         ourAddr.Bytes(),
 		Observations: observations,
 	}
 
@@ -2,10 +2,12 @@ package processor
 
 import (
 	"bytes"
+	"context"
 	"testing"
 	"time"
 
 	"github.com/certusone/wormhole/node/pkg/devnet"
+	"github.com/certusone/wormhole/node/pkg/guardiansigner"
 	"github.com/certusone/wormhole/node/pkg/p2p"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	ethcommon "github.com/ethereum/go-ethereum/common"
@@ -83,3 +85,47 @@ func TestMarshalSignedObservationBatch(t *testing.T) {
 		assert.Equal(t, batch.Observations[idx].MessageId, batch2.Observations[idx].MessageId)
 	}
 }
+
+func TestObservationBatchConfigValidate(t *testing.T) {
+	require.NoError(t, DefaultObservationBatchConfig.Validate())
+	require.NoError(t, ObservationBatchConfig{MaxSize: 1, MaxDelay: time.Millisecond}.Validate())
+
+	assert.ErrorContains(t, ObservationBatchConfig{MaxSize: 0, MaxDelay: time.Second}.Validate(), "batch size")
+	assert.ErrorContains(t, ObservationBatchConfig{MaxSize: p2p.MaxObservationBatchSize + 1, MaxDelay: time.Second}.Validate(), "batch size")
+	assert.ErrorContains(t, ObservationBatchConfig{MaxSize: 100, MaxDelay: 0}.Validate(), "batch delay")
+	assert.ErrorContains(t, ObservationBatchConfig{MaxSize: 100, MaxDelay: time.Minute}.Validate(), "batch delay")
+}
+
+func TestHandleBatchPublishesFullBatches(t *testing.T) {
+	guardianSigner, err := guardiansigner.GenerateSignerWithPrivatekeyUnsafe(nil)
+	require.NoError(t, err)
+
+	gossipAttestationSendC := make(chan []byte, 10)
+	p := &Processor{
+		gossipAttestationSendC: gossipAttestationSendC,
+		guardianSigner:         guardianSigner,
+		batchObsvPubC:          make(chan *gossipv1.Observation, 10),
+		batchConfig:            ObservationBatchConfig{MaxSize: 3, MaxDelay: time.Minute},
+	}
+
+	for seqNo := uint64(1); seqNo <= 5; seqNo++ {
+		v := getUniqueVAA(seqNo)
+		p.postObservationToBatch(&gossipv1.Observation{Hash: v.SigningDigest().Bytes(), MessageId: v.MessageID()})
+	}
+
+	// The first batch is published as soon as it is full, long before the delay expires.
+	require.NoError(t, p.handleBatch(context.Background()))
+	require.Len(t, gossipAttestationSendC, 1)
+
+	var msg gossipv1.GossipMessage
+	require.NoError(t, proto.Unmarshal(<-gossipAttestationSendC, &msg))
+	batch := msg.GetSignedObservationBatch()
+	require.NotNil(t, batch)
+	assert.Len(t, batch.Observations, 3)
+
+	// The remaining observations are published when the delay expires.
+	p.batchConfig.MaxDelay = 10 * time.Millisecond
+	require.NoError(t, p.handleBatch(context.Background()))
+	require.NoError(t, proto.Unmarshal(<-gossipAttestationSendC, &msg))
+	assert.Len(t, msg.GetSignedObservationBatch().Observations, 2)
+}
@@ -178,11 +178,12 @@ func createProcessorForTest(b *testing.B, numVAAs int, ctx context.Context, db *
 		gst:                    gst,
 		db:                     db,
 		logger:                 logger,
-		state:                  &aggregationState{observationMap{}},
+		state:                  newAggregationState(logger, MaxAggregationStateEntries),
 		ourAddr:                crypto.PubkeyToAddress(ourSigner.PublicKey(context.Background())),
 		pythnetVaas:            make(map[string]PythNetVaaEntry),
 		updatedVAAs:            make(map[string]*updateVaaEntry),
 		gatewayRelayer:         gwRelayer,
+		batchConfig:            DefaultObservationBatchConfig,
 	}
 
 	go func() { _ = p.vaaWriter(ctx) }()
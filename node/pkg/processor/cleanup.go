@@ -70,10 +70,30 @@ var (
 
 // handleCleanup handles periodic retransmissions and cleanup of observations
 func (p *Processor) handleCleanup(ctx context.Context) {
-	p.logger.Info("aggregation state summary", zap.Int("cached", len(p.state.signatures)))
-	aggregationStateEntries.Set(float64(len(p.state.signatures)))
+	p.logger.Info("aggregation state summary", zap.Int("cached", p.state.len()))
+	aggregationStateEntries.Set(float64(p.state.len()))
+	p.state.updateMetrics()
 
-	for hash, s := range p.state.signatures {
+	for _, shard := range p.state.shards {
+		p.cleanupShard(shard)
+	}
+
+	if expired := p.state.expire(time.Now().Add(-aggregationStateMaxAge)); expired != 0 {
+		p.logger.Warn("expired aggregation state entries that exceeded the maximum age", zap.Int("count", expired))
+	}
+
+	// Clean up old pythnet VAAs.
+	oldestTime := time.Now().Add(-time.Hour)
+	for key, pe := range p.pythnetVaas {
+		if pe.updateTime.Before(oldestTime) {
+			delete(p.pythnetVaas, key)
+		}
+	}
+}
+
+// cleanupShard handles retransmissions and cleanup of the observations in a single aggregation state shard.
+func (p *Processor) cleanupShard(shard *aggregationShard) {
+	for hash, s := range shard.entries {
 		delta := time.Since(s.firstObserved)
 
 		if !s.submitted && s.ourObservation != nil && delta > settlementTime {
@@ -93,7 +113,7 @@ func (p *Processor) handleCleanup(ctx context.Context) {
 						zap.Duration("delta", delta),
 					)
 					aggregationStateLate.Inc()
-					delete(p.state.signatures, hash)
+					shard.delete(hash)
 					continue
 				}
 			}
@@ -153,7 +173,7 @@ func (p *Processor) handleCleanup(ctx context.Context) {
 					zap.Duration("delta", delta),
 				)
 			}
-			delete(p.state.signatures, hash)
+			shard.delete(hash)
 			aggregationStateExpiration.Inc()
 		case !s.submitted && ((s.ourObs != nil && delta > retryLimitOurs) || (s.ourObs == nil && delta > retryLimitNotOurs)):
 			// Clearly, this horse is dead and continued beatings won't bring it closer to quorum.
@@ -163,7 +183,7 @@ func (p *Processor) handleCleanup(ctx context.Context) {
 				zap.Duration("delta", delta),
 				zap.Bool("weObserved", s.ourObs != nil),
 			)
-			delete(p.state.signatures, hash)
+			shard.delete(hash)
 			aggregationStateTimeout.Inc()
 		case !s.submitted && delta >= FirstRetryMinWait && time.Since(s.nextRetry) >= 0:
 			// Poor observation has been unsubmitted for five minutes - clearly, something went wrong.
@@ -180,7 +200,7 @@ func (p *Processor) handleCleanup(ctx context.Context) {
 						zap.String("digest", hash),
 						zap.Duration("delta", delta),
 					)
-					delete(p.state.signatures, hash)
+					shard.delete(hash)
 					aggregationStateTimeout.Inc()
 					break
 				}
@@ -254,19 +274,11 @@ func (p *Processor) handleCleanup(ctx context.Context) {
 						zap.Bool("quorum", hasSigs >= p.gs.Quorum()),
 					)
 				}
-				delete(p.state.signatures, hash)
+				shard.delete(hash)
 				aggregationStateUnobserved.Inc()
 			}
 		}
 	}
-
-	// Clean up old pythnet VAAs.
-	oldestTime := time.Now().Add(-time.Hour)
-	for key, pe := range p.pythnetVaas {
-		if pe.updateTime.Before(oldestTime) {
-			delete(p.pythnetVaas, key)
-		}
-	}
 }
 
 // signedVaaAlreadyInDB checks if the VAA is already in the DB. If it is, it makes sure the hash matches.
@@ -0,0 +1,71 @@
+package processor
+
+import (
+	"bytes"
+	"sort"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+var (
+	observationConflictsTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "wormhole_observation_conflicts_total",
+			Help: "Total number of times guardians were found to sign a different digest for a message ID than other guardians",
+		})
+	observationsDuplicateTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "wormhole_observations_duplicate_total",
+			Help: "Total number of observations received again from a guardian that already signed the same digest",
+		})
+)
+
+// checkForConflicts checks whether guardians signed different digests for the message ID of the specified aggregation
+// state entry. Each message ID identifies exactly one message, so a conflict means that some guardians observed a
+// different message than others, which indicates a compromised RPC node or a consensus bug. Conflicts are recorded so
+// they can be queried using the admin API, and new ones are logged at error level.
+func (p *Processor) checkForConflicts(s *state) {
+	if p.conflicts == nil || s.messageID == "" {
+		return
+	}
+
+	digests := p.state.digestsFor(s.messageID)
+	if len(digests) < 2 {
+		return
+	}
+
+	conflicting := make([]common.ConflictingDigest, 0, len(digests))
+	for digest := range digests {
+		other := p.state.get(digest)
+		if other == nil {
+			continue
+		}
+		signers := make([]ethCommon.Address, 0, len(other.signatures))
+		for addr := range other.signatures {
+			signers = append(signers, addr)
+		}
+		sort.Slice(signers, func(i, j int) bool { return bytes.Compare(signers[i][:], signers[j][:]) < 0 })
+		conflicting = append(conflicting, common.ConflictingDigest{Digest: digest, Signers: signers})
+	}
+	sort.Slice(conflicting, func(i, j int) bool { return conflicting[i].Digest < conflicting[j].Digest })
+
+	if !p.conflicts.Record(s.messageID, conflicting, time.Now()) {
+		return
+	}
+
+	observationConflictsTotal.Inc()
+	fields := []zap.Field{zap.String("message_id", s.messageID)}
+	for _, d := range conflicting {
+		signers := make([]string, len(d.Signers))
+		for i, addr := range d.Signers {
+			signers[i] = addr.Hex()
+		}
+		fields = append(fields, zap.Strings(d.Digest, signers))
+	}
+	p.logger.Error("CONFLICTING OBSERVATIONS: guardians signed different digests for the same message", fields...)
+}
@@ -0,0 +1,99 @@
+package processor
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestObservationConflictDetection(t *testing.T) {
+	keys := make([]*ecdsa.PrivateKey, 3)
+	addrs := make([]ethcommon.Address, 3)
+	for i := range keys {
+		var err error
+		keys[i], err = ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+		require.NoError(t, err)
+		addrs[i] = crypto.PubkeyToAddress(keys[i].PublicKey)
+	}
+
+	observedZapCore, observedLogs := observer.New(zap.ErrorLevel)
+	p := Processor{
+		logger:    zap.New(observedZapCore),
+		gs:        common.NewGuardianSet(addrs, 0),
+		state:     newAggregationState(zap.NewNop(), MaxAggregationStateEntries),
+		conflicts: common.NewObservationConflicts(),
+	}
+
+	v := getVAA()
+	digest1 := v.SigningDigest()
+	v.Nonce++
+	digest2 := v.SigningDigest()
+	messageID := v.MessageID()
+
+	observe := func(key *ecdsa.PrivateKey, digest ethcommon.Hash) {
+		sig, err := crypto.Sign(digest.Bytes(), key)
+		require.NoError(t, err)
+		p.handleSingleObservation(crypto.PubkeyToAddress(key.PublicKey).Bytes(), &gossipv1.Observation{
+			Hash:      digest.Bytes(),
+			Signature: sig,
+			MessageId: messageID,
+		})
+	}
+
+	conflictsBefore := testutil.ToFloat64(observationConflictsTotal)
+	duplicatesBefore := testutil.ToFloat64(observationsDuplicateTotal)
+
+	observe(keys[0], digest1)
+	observe(keys[1], digest1)
+	observe(keys[1], digest1)
+	assert.Equal(t, duplicatesBefore+1, testutil.ToFloat64(observationsDuplicateTotal))
+	assert.Empty(t, p.conflicts.List())
+
+	// A guardian signing a different digest for the same message is a conflict.
+	observe(keys[2], digest2)
+	assert.Equal(t, conflictsBefore+1, testutil.ToFloat64(observationConflictsTotal))
+	require.Equal(t, 1, observedLogs.Len())
+
+	conflicts := p.conflicts.List()
+	require.Len(t, conflicts, 1)
+	assert.Equal(t, messageID, conflicts[0].MessageID)
+	require.Len(t, conflicts[0].Digests, 2)
+	for _, d := range conflicts[0].Digests {
+		switch d.Digest {
+		case hex.EncodeToString(digest1.Bytes()):
+			assert.ElementsMatch(t, addrs[:2], d.Signers)
+		case hex.EncodeToString(digest2.Bytes()):
+			assert.Equal(t, []ethcommon.Address{addrs[2]}, d.Signers)
+		default:
+			t.Fatalf("unexpected digest %s", d.Digest)
+		}
+	}
+
+	// More signatures for known digests update the conflict without alerting again.
+	observe(keys[0], digest2)
+	assert.Equal(t, conflictsBefore+1, testutil.ToFloat64(observationConflictsTotal))
+	assert.Equal(t, 1, observedLogs.Len())
+	conflicts = p.conflicts.List()
+	require.Len(t, conflicts, 1)
+	for _, d := range conflicts[0].Digests {
+		if d.Digest == hex.EncodeToString(digest2.Bytes()) {
+			assert.Len(t, d.Signers, 2)
+		}
+	}
+
+	// Once the entries are gone, the message ID is no longer indexed.
+	p.state.delete(hex.EncodeToString(digest1.Bytes()))
+	p.state.delete(hex.EncodeToString(digest2.Bytes()))
+	assert.Empty(t, p.state.digestsFor(messageID))
+}
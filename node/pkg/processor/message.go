@@ -100,7 +100,7 @@ func (p *Processor) handleMessage(ctx context.Context, k *common.MessagePublicat
 	observationsReceivedByGuardianAddressTotal.WithLabelValues(p.ourAddr.Hex()).Inc()
 
 	// Get / create our state entry.
-	s := p.state.signatures[hash]
+	s := p.state.get(hash)
 	if s == nil {
 		s = &state{
 			firstObserved: time.Now(),
@@ -109,7 +109,8 @@ func (p *Processor) handleMessage(ctx context.Context, k *common.MessagePublicat
 			source:        "loopback",
 		}
 
-		p.state.signatures[hash] = s
+		// Our own observations always make it into the state, evicting older entries if needed.
+		p.state.insert(hash, s, true)
 	}
 
 	// Update our state.
@@ -120,6 +121,8 @@ func (p *Processor) handleMessage(ctx context.Context, k *common.MessagePublicat
 	s.signatures[p.ourAddr] = signature
 	s.ourObs = ourObs
 	s.ourMsg = msg
+	p.state.setMessageID(hash, s, v.MessageID())
+	p.checkForConflicts(s)
 
 	// Fast path for our own signature.
 	if !s.submitted {
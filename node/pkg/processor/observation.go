@@ -42,6 +42,13 @@ var (
 			Name: "wormhole_observations_unknown_total",
 			Help: "Total number of verified observations we haven't seen ourselves",
 		})
+	observationSignatureLatency = promauto.NewSummaryVec(
+		prometheus.SummaryOpts{
+			Name:       "wormhole_signed_observation_latency_seconds",
+			Help:       "Time between the first observation of a message and receipt of each guardian's signature for it, grouped by guardian address",
+			Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.99: 0.001},
+			MaxAge:     10 * time.Minute,
+		}, []string{"addr"})
 )
 
 // signaturesToVaaFormat converts a map[common.Address][]byte (processor state format) to []*vaa.Signature (VAA format) given a set of keys gsKeys
@@ -99,7 +106,7 @@ func (p *Processor) handleSingleObservation(addr []byte, m *gossipv1.Observation
 
 	their_addr := common.BytesToAddress(addr)
 	hash := hex.EncodeToString(m.Hash)
-	s := p.state.signatures[hash]
+	s := p.state.get(hash)
 	if s != nil && s.submitted {
 		// already submitted; ignoring additional signatures for it.
 		timeToHandleObservation.Observe(float64(time.Since(start).Microseconds()))
@@ -223,10 +230,21 @@ func (p *Processor) handleSingleObservation(addr []byte, m *gossipv1.Observation
 			source:        "unknown",
 		}
 
-		p.state.signatures[hash] = s
+		if !p.state.insert(hash, s, false) {
+			// The shard is full of our own pending observations, which take precedence.
+			observationsFailedTotal.WithLabelValues("aggregation_state_full").Inc()
+			timeToHandleObservation.Observe(float64(time.Since(start).Microseconds()))
+			return
+		}
+		p.state.setMessageID(hash, s, m.MessageId)
+	} else if _, ok := s.signatures[their_addr]; !ok {
+		observationSignatureLatency.WithLabelValues(their_addr.Hex()).Observe(time.Since(s.firstObserved).Seconds())
+	} else {
+		observationsDuplicateTotal.Inc()
 	}
 
 	s.signatures[their_addr] = m.Signature
+	p.checkForConflicts(s)
 
 	if s.ourObservation != nil {
 		p.checkForQuorum(m, s, gs, hash)
@@ -292,6 +310,9 @@ func (p *Processor) checkForQuorum(m *gossipv1.Observation, s *state, gs *node_c
 	start := time.Now()
 	s.ourObservation.HandleQuorum(sigsVaaFormat, hash, p)
 	s.submitted = true
+	if s.shard != nil {
+		aggregationStateQuorumLatency.WithLabelValues(s.shard.label).Observe(time.Since(s.firstObserved).Seconds())
+	}
 	timeToHandleQuorum.Observe(float64(time.Since(start).Microseconds()))
 }
 
@@ -355,4 +376,5 @@ func (p *Processor) handleInboundSignedVAAWithQuorum(m *gossipv1.SignedVAAWithQu
 	}
 
 	p.storeSignedVAA(v)
+	p.handleGovernorConfigVAA(v)
 }
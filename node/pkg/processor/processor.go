@@ -1,6 +1,7 @@
 package processor
 
 import (
+	"container/list"
 	"context"
 	"encoding/hex"
 	"fmt"
@@ -76,13 +77,11 @@ type (
 		txHash []byte
 		// Copy of the guardian set valid at observation/injection time.
 		gs *common.GuardianSet
-	}
-
-	observationMap map[string]*state
-
-	// aggregationState represents the node's aggregation of guardian signatures.
-	aggregationState struct {
-		signatures observationMap
+		// Message ID of the observation. Only trustworthy once we observed it ourselves.
+		messageID string
+		// The aggregation state shard holding this entry and its position in the shard's creation order.
+		shard *aggregationShard
+		elem  *list.Element
 	}
 )
 
@@ -143,6 +142,8 @@ type Processor struct {
 
 	// state is the current runtime VAA view
 	state *aggregationState
+	// conflicts records guardians signing different digests for the same message, for the admin API
+	conflicts *common.ObservationConflicts
 	// gk pk as eth address
 	ourAddr ethcommon.Address
 
@@ -157,6 +158,8 @@ type Processor struct {
 
 	// batchObsvPubC is the internal channel used to publish observations to the batch processor for publishing.
 	batchObsvPubC chan *gossipv1.Observation
+	// batchConfig configures when the batch processor publishes a batch.
+	batchConfig ObservationBatchConfig
 }
 
 // updateVaaEntry is used to queue up a VAA to be written to the database.
@@ -231,13 +234,15 @@ func NewProcessor(
 	signedInC <-chan *gossipv1.SignedVAAWithQuorum,
 	guardianSigner guardiansigner.GuardianSigner,
 	gst *common.GuardianSetState,
+	conflicts *common.ObservationConflicts,
 	g *governor.ChainGovernor,
 	acct *accountant.Accountant,
 	acctReadC <-chan *common.MessagePublication,
 	gatewayRelayer *gwrelayer.GatewayRelayer,
 	networkID string,
+	batchConfig ObservationBatchConfig,
 ) *Processor {
-
+	logger := supervisor.Logger(ctx)
 	return &Processor{
 		msgC:                   msgC,
 		setC:                   setC,
@@ -249,10 +254,11 @@ func NewProcessor(
 		signedInC:              signedInC,
 		guardianSigner:         guardianSigner,
 		gst:                    gst,
+		conflicts:              conflicts,
 		db:                     db,
 
-		logger:         supervisor.Logger(ctx),
-		state:          &aggregationState{observationMap{}},
+		logger:         logger,
+		state:          newAggregationState(logger, MaxAggregationStateEntries),
 		ourAddr:        crypto.PubkeyToAddress(guardianSigner.PublicKey(ctx)),
 		governor:       g,
 		acct:           acct,
@@ -262,6 +268,7 @@ func NewProcessor(
 		batchObsvPubC:  make(chan *gossipv1.Observation, batchObsvPubChanSize),
 		updatedVAAs:    make(map[string]*updateVaaEntry),
 		networkID:      networkID,
+		batchConfig:    batchConfig,
 	}
 }
 
@@ -293,6 +300,12 @@ func (p *Processor) Run(ctx context.Context) error {
 				zap.Int("quorum", p.gs.Quorum()),
 			)
 			p.gst.Set(p.gs)
+			if signer, ok := guardiansigner.AsGuardianSetAware(p.guardianSigner); ok {
+				// During a guardian key rotation, sign with the key that is part of the new guardian set.
+				signer.SetGuardianSet(ctx, p.gs)
+				p.ourAddr = crypto.PubkeyToAddress(p.guardianSigner.PublicKey(ctx))
+				p.logger.Info("guardian key selected for the guardian set", zap.Stringer("address", p.ourAddr), zap.Uint32("index", p.gs.Index))
+			}
 		case k := <-p.msgC:
 			if p.governor != nil {
 				if !p.governor.ProcessMsg(k) {
@@ -376,6 +389,16 @@ func (p *Processor) storeSignedVAA(v *vaa.VAA) {
 	p.updateVAALock.Unlock()
 }
 
+// handleGovernorConfigVAA passes a signed VAA with quorum to the governor, which applies it if it updates its chain config.
+func (p *Processor) handleGovernorConfigVAA(v *vaa.VAA) {
+	if p.governor == nil {
+		return
+	}
+	if err := p.governor.ProcessGovernanceVAA(v); err != nil {
+		p.logger.Error("failed to apply governance VAA to the governor", zap.String("message_id", v.MessageID()), zap.Error(err))
+	}
+}
+
 // haveSignedVAA returns true if we already have a VAA for the given VAAID
 func (p *Processor) haveSignedVAA(id db.VAAID) bool {
 	if id.EmitterChain == vaa.ChainIDPythNet {
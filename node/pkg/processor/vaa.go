@@ -35,6 +35,7 @@ func (v *VAA) HandleQuorum(sigs []*vaa.Signature, hash string, p *Processor) {
 	// Broadcast the VAA and store it in the database.
 	p.broadcastSignedVAA(signed)
 	p.storeSignedVAA(signed)
+	p.handleGovernorConfigVAA(signed)
 }
 
 func (v *VAA) IsReliable() bool {
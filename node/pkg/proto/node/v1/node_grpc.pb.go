@@ -23,6 +23,7 @@ type NodePrivilegedServiceClient interface {
 	//
 	// A consensus majority of nodes on the network will have to inject the VAA within the
 	// VAA timeout window for it to reach consensus.
+	//
 	InjectGovernanceVAA(ctx context.Context, in *InjectGovernanceVAARequest, opts ...grpc.CallOption) (*InjectGovernanceVAAResponse, error)
 	// FindMissingMessages will detect message sequence gaps in the local VAA store for a
 	// specific emitter chain and address. Start and end slots are the lowest and highest
@@ -44,6 +45,12 @@ type NodePrivilegedServiceClient interface {
 	ChainGovernorReleasePendingVAA(ctx context.Context, in *ChainGovernorReleasePendingVAARequest, opts ...grpc.CallOption) (*ChainGovernorReleasePendingVAAResponse, error)
 	// ChainGovernorResetReleaseTimer resets the release timer for a chain governor pending VAA to the configured maximum.
 	ChainGovernorResetReleaseTimer(ctx context.Context, in *ChainGovernorResetReleaseTimerRequest, opts ...grpc.CallOption) (*ChainGovernorResetReleaseTimerResponse, error)
+	// AccountantStatus displays the transfers pending in the accountant, listing token bridge and NTT transfers separately.
+	AccountantStatus(ctx context.Context, in *AccountantStatusRequest, opts ...grpc.CallOption) (*AccountantStatusResponse, error)
+	// AccountantDropPendingTransfer drops a transfer from the accountant pending list without publishing it.
+	AccountantDropPendingTransfer(ctx context.Context, in *AccountantDropPendingTransferRequest, opts ...grpc.CallOption) (*AccountantDropPendingTransferResponse, error)
+	// AccountantReleasePendingTransfer releases a transfer from the accountant pending list, publishing it without waiting for approval.
+	AccountantReleasePendingTransfer(ctx context.Context, in *AccountantReleasePendingTransferRequest, opts ...grpc.CallOption) (*AccountantReleasePendingTransferResponse, error)
 	// PurgePythNetVaas deletes PythNet VAAs from the database that are more than the specified number of days old.
 	PurgePythNetVaas(ctx context.Context, in *PurgePythNetVaasRequest, opts ...grpc.CallOption) (*PurgePythNetVaasResponse, error)
 	// SignExistingVAA signs an existing VAA for a new guardian set using the local guardian key.
@@ -52,6 +59,24 @@ type NodePrivilegedServiceClient interface {
 	DumpRPCs(ctx context.Context, in *DumpRPCsRequest, opts ...grpc.CallOption) (*DumpRPCsResponse, error)
 	// GetMissingVAAs returns the VAAs from a cloud function that need to be reobserved.
 	GetAndObserveMissingVAAs(ctx context.Context, in *GetAndObserveMissingVAAsRequest, opts ...grpc.CallOption) (*GetAndObserveMissingVAAsResponse, error)
+	// PruneVaas deletes the VAAs of all chains that are more than the specified number of days old from the database,
+	// except governance VAAs, and compacts the database afterwards.
+	PruneVaas(ctx context.Context, in *PruneVaasRequest, opts ...grpc.CallOption) (*PruneVaasResponse, error)
+	// ExportSignedVAAs streams the signed VAAs stored in the local database whose timestamp is within the specified
+	// range, so that they can be imported on another guardian node using ImportSignedVAAs.
+	ExportSignedVAAs(ctx context.Context, in *ExportSignedVAAsRequest, opts ...grpc.CallOption) (NodePrivilegedService_ExportSignedVAAsClient, error)
+	// ImportSignedVAAs verifies a batch of signed VAAs against the guardian set they were signed with and stores the
+	// valid ones in the local database. Historical guardian sets are loaded from the Ethereum core contract.
+	ImportSignedVAAs(ctx context.Context, in *ImportSignedVAAsRequest, opts ...grpc.CallOption) (*ImportSignedVAAsResponse, error)
+	// GetObservationConflicts returns the most recent messages for which guardians signed different digests.
+	// This indicates that some guardians are connected to a compromised or faulty RPC node, or a consensus bug.
+	GetObservationConflicts(ctx context.Context, in *GetObservationConflictsRequest, opts ...grpc.CallOption) (*GetObservationConflictsResponse, error)
+	// GetGuardianKeyStatus returns the guardian key the node signs with. During a guardian key rotation, this shows
+	// whether the node switched from the old to the new key.
+	GetGuardianKeyStatus(ctx context.Context, in *GetGuardianKeyStatusRequest, opts ...grpc.CallOption) (*GetGuardianKeyStatusResponse, error)
+	// Backfill queues a scan of a historical block range of a chain for messages its watcher missed, e.g. during an RPC
+	// outage. The messages found are observed as re-observations. For Solana and Pythnet, the blocks are slots.
+	Backfill(ctx context.Context, in *BackfillRequest, opts ...grpc.CallOption) (*BackfillResponse, error)
 }
 
 type nodePrivilegedServiceClient struct {
@@ -134,6 +159,33 @@ func (c *nodePrivilegedServiceClient) ChainGovernorResetReleaseTimer(ctx context
 	return out, nil
 }
 
+func (c *nodePrivilegedServiceClient) AccountantStatus(ctx context.Context, in *AccountantStatusRequest, opts ...grpc.CallOption) (*AccountantStatusResponse, error) {
+	out := new(AccountantStatusResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/AccountantStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) AccountantDropPendingTransfer(ctx context.Context, in *AccountantDropPendingTransferRequest, opts ...grpc.CallOption) (*AccountantDropPendingTransferResponse, error) {
+	out := new(AccountantDropPendingTransferResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/AccountantDropPendingTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) AccountantReleasePendingTransfer(ctx context.Context, in *AccountantReleasePendingTransferRequest, opts ...grpc.CallOption) (*AccountantReleasePendingTransferResponse, error) {
+	out := new(AccountantReleasePendingTransferResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/AccountantReleasePendingTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nodePrivilegedServiceClient) PurgePythNetVaas(ctx context.Context, in *PurgePythNetVaasRequest, opts ...grpc.CallOption) (*PurgePythNetVaasResponse, error) {
 	out := new(PurgePythNetVaasResponse)
 	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/PurgePythNetVaas", in, out, opts...)
@@ -170,6 +222,83 @@ func (c *nodePrivilegedServiceClient) GetAndObserveMissingVAAs(ctx context.Conte
 	return out, nil
 }
 
+func (c *nodePrivilegedServiceClient) PruneVaas(ctx context.Context, in *PruneVaasRequest, opts ...grpc.CallOption) (*PruneVaasResponse, error) {
+	out := new(PruneVaasResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/PruneVaas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) ExportSignedVAAs(ctx context.Context, in *ExportSignedVAAsRequest, opts ...grpc.CallOption) (NodePrivilegedService_ExportSignedVAAsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &NodePrivilegedService_ServiceDesc.Streams[0], "/node.v1.NodePrivilegedService/ExportSignedVAAs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nodePrivilegedServiceExportSignedVAAsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NodePrivilegedService_ExportSignedVAAsClient interface {
+	Recv() (*ExportSignedVAAsResponse, error)
+	grpc.ClientStream
+}
+
+type nodePrivilegedServiceExportSignedVAAsClient struct {
+	grpc.ClientStream
+}
+
+func (x *nodePrivilegedServiceExportSignedVAAsClient) Recv() (*ExportSignedVAAsResponse, error) {
+	m := new(ExportSignedVAAsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *nodePrivilegedServiceClient) ImportSignedVAAs(ctx context.Context, in *ImportSignedVAAsRequest, opts ...grpc.CallOption) (*ImportSignedVAAsResponse, error) {
+	out := new(ImportSignedVAAsResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/ImportSignedVAAs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) GetObservationConflicts(ctx context.Context, in *GetObservationConflictsRequest, opts ...grpc.CallOption) (*GetObservationConflictsResponse, error) {
+	out := new(GetObservationConflictsResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/GetObservationConflicts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) GetGuardianKeyStatus(ctx context.Context, in *GetGuardianKeyStatusRequest, opts ...grpc.CallOption) (*GetGuardianKeyStatusResponse, error) {
+	out := new(GetGuardianKeyStatusResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/GetGuardianKeyStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) Backfill(ctx context.Context, in *BackfillRequest, opts ...grpc.CallOption) (*BackfillResponse, error) {
+	out := new(BackfillResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/Backfill", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NodePrivilegedServiceServer is the server API for NodePrivilegedService service.
 // All implementations must embed UnimplementedNodePrivilegedServiceServer
 // for forward compatibility
@@ -179,6 +308,7 @@ type NodePrivilegedServiceServer interface {
 	//
 	// A consensus majority of nodes on the network will have to inject the VAA within the
 	// VAA timeout window for it to reach consensus.
+	//
 	InjectGovernanceVAA(context.Context, *InjectGovernanceVAARequest) (*InjectGovernanceVAAResponse, error)
 	// FindMissingMessages will detect message sequence gaps in the local VAA store for a
 	// specific emitter chain and address. Start and end slots are the lowest and highest
@@ -200,6 +330,12 @@ type NodePrivilegedServiceServer interface {
 	ChainGovernorReleasePendingVAA(context.Context, *ChainGovernorReleasePendingVAARequest) (*ChainGovernorReleasePendingVAAResponse, error)
 	// ChainGovernorResetReleaseTimer resets the release timer for a chain governor pending VAA to the configured maximum.
 	ChainGovernorResetReleaseTimer(context.Context, *ChainGovernorResetReleaseTimerRequest) (*ChainGovernorResetReleaseTimerResponse, error)
+	// AccountantStatus displays the transfers pending in the accountant, listing token bridge and NTT transfers separately.
+	AccountantStatus(context.Context, *AccountantStatusRequest) (*AccountantStatusResponse, error)
+	// AccountantDropPendingTransfer drops a transfer from the accountant pending list without publishing it.
+	AccountantDropPendingTransfer(context.Context, *AccountantDropPendingTransferRequest) (*AccountantDropPendingTransferResponse, error)
+	// AccountantReleasePendingTransfer releases a transfer from the accountant pending list, publishing it without waiting for approval.
+	AccountantReleasePendingTransfer(context.Context, *AccountantReleasePendingTransferRequest) (*AccountantReleasePendingTransferResponse, error)
 	// PurgePythNetVaas deletes PythNet VAAs from the database that are more than the specified number of days old.
 	PurgePythNetVaas(context.Context, *PurgePythNetVaasRequest) (*PurgePythNetVaasResponse, error)
 	// SignExistingVAA signs an existing VAA for a new guardian set using the local guardian key.
@@ -208,6 +344,24 @@ type NodePrivilegedServiceServer interface {
 	DumpRPCs(context.Context, *DumpRPCsRequest) (*DumpRPCsResponse, error)
 	// GetMissingVAAs returns the VAAs from a cloud function that need to be reobserved.
 	GetAndObserveMissingVAAs(context.Context, *GetAndObserveMissingVAAsRequest) (*GetAndObserveMissingVAAsResponse, error)
+	// PruneVaas deletes the VAAs of all chains that are more than the specified number of days old from the database,
+	// except governance VAAs, and compacts the database afterwards.
+	PruneVaas(context.Context, *PruneVaasRequest) (*PruneVaasResponse, error)
+	// ExportSignedVAAs streams the signed VAAs stored in the local database whose timestamp is within the specified
+	// range, so that they can be imported on another guardian node using ImportSignedVAAs.
+	ExportSignedVAAs(*ExportSignedVAAsRequest, NodePrivilegedService_ExportSignedVAAsServer) error
+	// ImportSignedVAAs verifies a batch of signed VAAs against the guardian set they were signed with and stores the
+	// valid ones in the local database. Historical guardian sets are loaded from the Ethereum core contract.
+	ImportSignedVAAs(context.Context, *ImportSignedVAAsRequest) (*ImportSignedVAAsResponse, error)
+	// GetObservationConflicts returns the most recent messages for which guardians signed different digests.
+	// This indicates that some guardians are connected to a compromised or faulty RPC node, or a consensus bug.
+	GetObservationConflicts(context.Context, *GetObservationConflictsRequest) (*GetObservationConflictsResponse, error)
+	// GetGuardianKeyStatus returns the guardian key the node signs with. During a guardian key rotation, this shows
+	// whether the node switched from the old to the new key.
+	GetGuardianKeyStatus(context.Context, *GetGuardianKeyStatusRequest) (*GetGuardianKeyStatusResponse, error)
+	// Backfill queues a scan of a historical block range of a chain for messages its watcher missed, e.g. during an RPC
+	// outage. The messages found are observed as re-observations. For Solana and Pythnet, the blocks are slots.
+	Backfill(context.Context, *BackfillRequest) (*BackfillResponse, error)
 	mustEmbedUnimplementedNodePrivilegedServiceServer()
 }
 
@@ -239,6 +393,15 @@ func (UnimplementedNodePrivilegedServiceServer) ChainGovernorReleasePendingVAA(c
 func (UnimplementedNodePrivilegedServiceServer) ChainGovernorResetReleaseTimer(context.Context, *ChainGovernorResetReleaseTimerRequest) (*ChainGovernorResetReleaseTimerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChainGovernorResetReleaseTimer not implemented")
 }
+func (UnimplementedNodePrivilegedServiceServer) AccountantStatus(context.Context, *AccountantStatusRequest) (*AccountantStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountantStatus not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) AccountantDropPendingTransfer(context.Context, *AccountantDropPendingTransferRequest) (*AccountantDropPendingTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountantDropPendingTransfer not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) AccountantReleasePendingTransfer(context.Context, *AccountantReleasePendingTransferRequest) (*AccountantReleasePendingTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountantReleasePendingTransfer not implemented")
+}
 func (UnimplementedNodePrivilegedServiceServer) PurgePythNetVaas(context.Context, *PurgePythNetVaasRequest) (*PurgePythNetVaasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PurgePythNetVaas not implemented")
 }
@@ -251,6 +414,24 @@ func (UnimplementedNodePrivilegedServiceServer) DumpRPCs(context.Context, *DumpR
 func (UnimplementedNodePrivilegedServiceServer) GetAndObserveMissingVAAs(context.Context, *GetAndObserveMissingVAAsRequest) (*GetAndObserveMissingVAAsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAndObserveMissingVAAs not implemented")
 }
+func (UnimplementedNodePrivilegedServiceServer) PruneVaas(context.Context, *PruneVaasRequest) (*PruneVaasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneVaas not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) ExportSignedVAAs(*ExportSignedVAAsRequest, NodePrivilegedService_ExportSignedVAAsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportSignedVAAs not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) ImportSignedVAAs(context.Context, *ImportSignedVAAsRequest) (*ImportSignedVAAsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportSignedVAAs not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) GetObservationConflicts(context.Context, *GetObservationConflictsRequest) (*GetObservationConflictsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetObservationConflicts not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) GetGuardianKeyStatus(context.Context, *GetGuardianKeyStatusRequest) (*GetGuardianKeyStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuardianKeyStatus not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) Backfill(context.Context, *BackfillRequest) (*BackfillResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Backfill not implemented")
+}
 func (UnimplementedNodePrivilegedServiceServer) mustEmbedUnimplementedNodePrivilegedServiceServer() {}
 
 // UnsafeNodePrivilegedServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -408,6 +589,60 @@ func _NodePrivilegedService_ChainGovernorResetReleaseTimer_Handler(srv interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NodePrivilegedService_AccountantStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountantStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).AccountantStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/AccountantStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).AccountantStatus(ctx, req.(*AccountantStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_AccountantDropPendingTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountantDropPendingTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).AccountantDropPendingTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/AccountantDropPendingTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).AccountantDropPendingTransfer(ctx, req.(*AccountantDropPendingTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_AccountantReleasePendingTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountantReleasePendingTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).AccountantReleasePendingTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/AccountantReleasePendingTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).AccountantReleasePendingTransfer(ctx, req.(*AccountantReleasePendingTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NodePrivilegedService_PurgePythNetVaas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PurgePythNetVaasRequest)
 	if err := dec(in); err != nil {
@@ -480,6 +715,117 @@ func _NodePrivilegedService_GetAndObserveMissingVAAs_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NodePrivilegedService_PruneVaas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PruneVaasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).PruneVaas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/PruneVaas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).PruneVaas(ctx, req.(*PruneVaasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_ExportSignedVAAs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportSignedVAAsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NodePrivilegedServiceServer).ExportSignedVAAs(m, &nodePrivilegedServiceExportSignedVAAsServer{stream})
+}
+
+type NodePrivilegedService_ExportSignedVAAsServer interface {
+	Send(*ExportSignedVAAsResponse) error
+	grpc.ServerStream
+}
+
+type nodePrivilegedServiceExportSignedVAAsServer struct {
+	grpc.ServerStream
+}
+
+func (x *nodePrivilegedServiceExportSignedVAAsServer) Send(m *ExportSignedVAAsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _NodePrivilegedService_ImportSignedVAAs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportSignedVAAsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).ImportSignedVAAs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/ImportSignedVAAs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).ImportSignedVAAs(ctx, req.(*ImportSignedVAAsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_GetObservationConflicts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetObservationConflictsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).GetObservationConflicts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/GetObservationConflicts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).GetObservationConflicts(ctx, req.(*GetObservationConflictsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_GetGuardianKeyStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuardianKeyStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).GetGuardianKeyStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/GetGuardianKeyStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).GetGuardianKeyStatus(ctx, req.(*GetGuardianKeyStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_Backfill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackfillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).Backfill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/Backfill",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).Backfill(ctx, req.(*BackfillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NodePrivilegedService_ServiceDesc is the grpc.ServiceDesc for NodePrivilegedService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -519,6 +865,18 @@ var NodePrivilegedService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ChainGovernorResetReleaseTimer",
 			Handler:    _NodePrivilegedService_ChainGovernorResetReleaseTimer_Handler,
 		},
+		{
+			MethodName: "AccountantStatus",
+			Handler:    _NodePrivilegedService_AccountantStatus_Handler,
+		},
+		{
+			MethodName: "AccountantDropPendingTransfer",
+			Handler:    _NodePrivilegedService_AccountantDropPendingTransfer_Handler,
+		},
+		{
+			MethodName: "AccountantReleasePendingTransfer",
+			Handler:    _NodePrivilegedService_AccountantReleasePendingTransfer_Handler,
+		},
 		{
 			MethodName: "PurgePythNetVaas",
 			Handler:    _NodePrivilegedService_PurgePythNetVaas_Handler,
@@ -535,7 +893,33 @@ var NodePrivilegedService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAndObserveMissingVAAs",
 			Handler:    _NodePrivilegedService_GetAndObserveMissingVAAs_Handler,
 		},
+		{
+			MethodName: "PruneVaas",
+			Handler:    _NodePrivilegedService_PruneVaas_Handler,
+		},
+		{
+			MethodName: "ImportSignedVAAs",
+			Handler:    _NodePrivilegedService_ImportSignedVAAs_Handler,
+		},
+		{
+			MethodName: "GetObservationConflicts",
+			Handler:    _NodePrivilegedService_GetObservationConflicts_Handler,
+		},
+		{
+			MethodName: "GetGuardianKeyStatus",
+			Handler:    _NodePrivilegedService_GetGuardianKeyStatus_Handler,
+		},
+		{
+			MethodName: "Backfill",
+			Handler:    _NodePrivilegedService_Backfill_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportSignedVAAs",
+			Handler:       _NodePrivilegedService_ExportSignedVAAs_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "node/v1/node.proto",
 }
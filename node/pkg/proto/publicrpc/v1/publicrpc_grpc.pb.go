@@ -22,12 +22,16 @@ type PublicRPCServiceClient interface {
 	// node's active guardian set. Heartbeats received by nodes not in the guardian set are ignored.
 	// The heartbeat value is null if no heartbeat has yet been received.
 	GetLastHeartbeats(ctx context.Context, in *GetLastHeartbeatsRequest, opts ...grpc.CallOption) (*GetLastHeartbeatsResponse, error)
+	// GetSignedVAA returns the signed VAA of a message ID (emitter chain, emitter address and sequence) from the
+	// guardian's database, or requests a re-observation of tx_hash if it is not found, see GetSignedVAARequest.
 	GetSignedVAA(ctx context.Context, in *GetSignedVAARequest, opts ...grpc.CallOption) (*GetSignedVAAResponse, error)
 	GetCurrentGuardianSet(ctx context.Context, in *GetCurrentGuardianSetRequest, opts ...grpc.CallOption) (*GetCurrentGuardianSetResponse, error)
 	GovernorGetAvailableNotionalByChain(ctx context.Context, in *GovernorGetAvailableNotionalByChainRequest, opts ...grpc.CallOption) (*GovernorGetAvailableNotionalByChainResponse, error)
 	GovernorGetEnqueuedVAAs(ctx context.Context, in *GovernorGetEnqueuedVAAsRequest, opts ...grpc.CallOption) (*GovernorGetEnqueuedVAAsResponse, error)
 	GovernorIsVAAEnqueued(ctx context.Context, in *GovernorIsVAAEnqueuedRequest, opts ...grpc.CallOption) (*GovernorIsVAAEnqueuedResponse, error)
 	GovernorGetTokenList(ctx context.Context, in *GovernorGetTokenListRequest, opts ...grpc.CallOption) (*GovernorGetTokenListResponse, error)
+	// GetWatcherHealth returns the health of each chain watcher running on this node.
+	GetWatcherHealth(ctx context.Context, in *GetWatcherHealthRequest, opts ...grpc.CallOption) (*GetWatcherHealthResponse, error)
 }
 
 type publicRPCServiceClient struct {
@@ -101,6 +105,15 @@ func (c *publicRPCServiceClient) GovernorGetTokenList(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *publicRPCServiceClient) GetWatcherHealth(ctx context.Context, in *GetWatcherHealthRequest, opts ...grpc.CallOption) (*GetWatcherHealthResponse, error) {
+	out := new(GetWatcherHealthResponse)
+	err := c.cc.Invoke(ctx, "/publicrpc.v1.PublicRPCService/GetWatcherHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PublicRPCServiceServer is the server API for PublicRPCService service.
 // All implementations must embed UnimplementedPublicRPCServiceServer
 // for forward compatibility
@@ -109,12 +122,16 @@ type PublicRPCServiceServer interface {
 	// node's active guardian set. Heartbeats received by nodes not in the guardian set are ignored.
 	// The heartbeat value is null if no heartbeat has yet been received.
 	GetLastHeartbeats(context.Context, *GetLastHeartbeatsRequest) (*GetLastHeartbeatsResponse, error)
+	// GetSignedVAA returns the signed VAA of a message ID (emitter chain, emitter address and sequence) from the
+	// guardian's database, or requests a re-observation of tx_hash if it is not found, see GetSignedVAARequest.
 	GetSignedVAA(context.Context, *GetSignedVAARequest) (*GetSignedVAAResponse, error)
 	GetCurrentGuardianSet(context.Context, *GetCurrentGuardianSetRequest) (*GetCurrentGuardianSetResponse, error)
 	GovernorGetAvailableNotionalByChain(context.Context, *GovernorGetAvailableNotionalByChainRequest) (*GovernorGetAvailableNotionalByChainResponse, error)
 	GovernorGetEnqueuedVAAs(context.Context, *GovernorGetEnqueuedVAAsRequest) (*GovernorGetEnqueuedVAAsResponse, error)
 	GovernorIsVAAEnqueued(context.Context, *GovernorIsVAAEnqueuedRequest) (*GovernorIsVAAEnqueuedResponse, error)
 	GovernorGetTokenList(context.Context, *GovernorGetTokenListRequest) (*GovernorGetTokenListResponse, error)
+	// GetWatcherHealth returns the health of each chain watcher running on this node.
+	GetWatcherHealth(context.Context, *GetWatcherHealthRequest) (*GetWatcherHealthResponse, error)
 	mustEmbedUnimplementedPublicRPCServiceServer()
 }
 
@@ -143,6 +160,9 @@ func (UnimplementedPublicRPCServiceServer) GovernorIsVAAEnqueued(context.Context
 func (UnimplementedPublicRPCServiceServer) GovernorGetTokenList(context.Context, *GovernorGetTokenListRequest) (*GovernorGetTokenListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GovernorGetTokenList not implemented")
 }
+func (UnimplementedPublicRPCServiceServer) GetWatcherHealth(context.Context, *GetWatcherHealthRequest) (*GetWatcherHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWatcherHealth not implemented")
+}
 func (UnimplementedPublicRPCServiceServer) mustEmbedUnimplementedPublicRPCServiceServer() {}
 
 // UnsafePublicRPCServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -282,6 +302,24 @@ func _PublicRPCService_GovernorGetTokenList_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PublicRPCService_GetWatcherHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWatcherHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicRPCServiceServer).GetWatcherHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/publicrpc.v1.PublicRPCService/GetWatcherHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicRPCServiceServer).GetWatcherHealth(ctx, req.(*GetWatcherHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PublicRPCService_ServiceDesc is the grpc.ServiceDesc for PublicRPCService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -317,6 +355,10 @@ var PublicRPCService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GovernorGetTokenList",
 			Handler:    _PublicRPCService_GovernorGetTokenList_Handler,
 		},
+		{
+			MethodName: "GetWatcherHealth",
+			Handler:    _PublicRPCService_GetWatcherHealth_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "publicrpc/v1/publicrpc.proto",
@@ -0,0 +1,141 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package signerv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// RemoteSignerServiceClient is the client API for RemoteSignerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RemoteSignerServiceClient interface {
+	// Sign signs a keccak256 hash with the guardian key.
+	Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*SignResponse, error)
+	// GetPublicKey returns the public key of the guardian key.
+	GetPublicKey(ctx context.Context, in *GetPublicKeyRequest, opts ...grpc.CallOption) (*GetPublicKeyResponse, error)
+}
+
+type remoteSignerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRemoteSignerServiceClient(cc grpc.ClientConnInterface) RemoteSignerServiceClient {
+	return &remoteSignerServiceClient{cc}
+}
+
+func (c *remoteSignerServiceClient) Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*SignResponse, error) {
+	out := new(SignResponse)
+	err := c.cc.Invoke(ctx, "/signer.v1.RemoteSignerService/Sign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteSignerServiceClient) GetPublicKey(ctx context.Context, in *GetPublicKeyRequest, opts ...grpc.CallOption) (*GetPublicKeyResponse, error) {
+	out := new(GetPublicKeyResponse)
+	err := c.cc.Invoke(ctx, "/signer.v1.RemoteSignerService/GetPublicKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RemoteSignerServiceServer is the server API for RemoteSignerService service.
+// All implementations must embed UnimplementedRemoteSignerServiceServer
+// for forward compatibility
+type RemoteSignerServiceServer interface {
+	// Sign signs a keccak256 hash with the guardian key.
+	Sign(context.Context, *SignRequest) (*SignResponse, error)
+	// GetPublicKey returns the public key of the guardian key.
+	GetPublicKey(context.Context, *GetPublicKeyRequest) (*GetPublicKeyResponse, error)
+	mustEmbedUnimplementedRemoteSignerServiceServer()
+}
+
+// UnimplementedRemoteSignerServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRemoteSignerServiceServer struct {
+}
+
+func (UnimplementedRemoteSignerServiceServer) Sign(context.Context, *SignRequest) (*SignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sign not implemented")
+}
+func (UnimplementedRemoteSignerServiceServer) GetPublicKey(context.Context, *GetPublicKeyRequest) (*GetPublicKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicKey not implemented")
+}
+func (UnimplementedRemoteSignerServiceServer) mustEmbedUnimplementedRemoteSignerServiceServer() {}
+
+// UnsafeRemoteSignerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RemoteSignerServiceServer will
+// result in compilation errors.
+type UnsafeRemoteSignerServiceServer interface {
+	mustEmbedUnimplementedRemoteSignerServiceServer()
+}
+
+func RegisterRemoteSignerServiceServer(s grpc.ServiceRegistrar, srv RemoteSignerServiceServer) {
+	s.RegisterService(&RemoteSignerService_ServiceDesc, srv)
+}
+
+func _RemoteSignerService_Sign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSignerServiceServer).Sign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/signer.v1.RemoteSignerService/Sign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSignerServiceServer).Sign(ctx, req.(*SignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteSignerService_GetPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSignerServiceServer).GetPublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/signer.v1.RemoteSignerService/GetPublicKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSignerServiceServer).GetPublicKey(ctx, req.(*GetPublicKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RemoteSignerService_ServiceDesc is the grpc.ServiceDesc for RemoteSignerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RemoteSignerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "signer.v1.RemoteSignerService",
+	HandlerType: (*RemoteSignerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Sign",
+			Handler:    _RemoteSignerService_Sign_Handler,
+		},
+		{
+			MethodName: "GetPublicKey",
+			Handler:    _RemoteSignerService_GetPublicKey_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "signer/v1/signer.proto",
+}
@@ -204,6 +204,92 @@ func (x *BatchTransactionFilter) GetTxId() []byte {
 	return nil
 }
 
+// A VAAPropertiesFilter matches VAAs that satisfy all of its conditions (AND).
+// Conditions that are left empty are not checked.
+type VAAPropertiesFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Source chains, any of which must match.
+	EmitterChains []v1.ChainID `protobuf:"varint,1,rep,packed,name=emitter_chains,json=emitterChains,proto3,enum=publicrpc.v1.ChainID" json:"emitter_chains,omitempty"`
+	// Hex-encoded (without leading 0x) emitter addresses, any of which must match.
+	EmitterAddresses []string `protobuf:"bytes,2,rep,name=emitter_addresses,json=emitterAddresses,proto3" json:"emitter_addresses,omitempty"`
+	// Minimum sequence number (inclusive).
+	SequenceMin uint64 `protobuf:"varint,3,opt,name=sequence_min,json=sequenceMin,proto3" json:"sequence_min,omitempty"`
+	// Maximum sequence number (inclusive). Zero means unbounded.
+	SequenceMax uint64 `protobuf:"varint,4,opt,name=sequence_max,json=sequenceMax,proto3" json:"sequence_max,omitempty"`
+	// Bytes that the VAA payload must start with.
+	PayloadPrefix []byte `protobuf:"bytes,5,opt,name=payload_prefix,json=payloadPrefix,proto3" json:"payload_prefix,omitempty"`
+}
+
+func (x *VAAPropertiesFilter) Reset() {
+	*x = VAAPropertiesFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spy_v1_spy_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VAAPropertiesFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VAAPropertiesFilter) ProtoMessage() {}
+
+func (x *VAAPropertiesFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_spy_v1_spy_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VAAPropertiesFilter.ProtoReflect.Descriptor instead.
+func (*VAAPropertiesFilter) Descriptor() ([]byte, []int) {
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *VAAPropertiesFilter) GetEmitterChains() []v1.ChainID {
+	if x != nil {
+		return x.EmitterChains
+	}
+	return nil
+}
+
+func (x *VAAPropertiesFilter) GetEmitterAddresses() []string {
+	if x != nil {
+		return x.EmitterAddresses
+	}
+	return nil
+}
+
+func (x *VAAPropertiesFilter) GetSequenceMin() uint64 {
+	if x != nil {
+		return x.SequenceMin
+	}
+	return 0
+}
+
+func (x *VAAPropertiesFilter) GetSequenceMax() uint64 {
+	if x != nil {
+		return x.SequenceMax
+	}
+	return 0
+}
+
+func (x *VAAPropertiesFilter) GetPayloadPrefix() []byte {
+	if x != nil {
+		return x.PayloadPrefix
+	}
+	return nil
+}
+
 type FilterEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -214,13 +300,14 @@ type FilterEntry struct {
 	//	*FilterEntry_EmitterFilter
 	//	*FilterEntry_BatchFilter
 	//	*FilterEntry_BatchTransactionFilter
+	//	*FilterEntry_VaaPropertiesFilter
 	Filter isFilterEntry_Filter `protobuf_oneof:"filter"`
 }
 
 func (x *FilterEntry) Reset() {
 	*x = FilterEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_spy_v1_spy_proto_msgTypes[3]
+		mi := &file_spy_v1_spy_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -233,7 +320,7 @@ func (x *FilterEntry) String() string {
 func (*FilterEntry) ProtoMessage() {}
 
 func (x *FilterEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_spy_v1_spy_proto_msgTypes[3]
+	mi := &file_spy_v1_spy_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -246,7 +333,7 @@ func (x *FilterEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilterEntry.ProtoReflect.Descriptor instead.
 func (*FilterEntry) Descriptor() ([]byte, []int) {
-	return file_spy_v1_spy_proto_rawDescGZIP(), []int{3}
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{4}
 }
 
 func (m *FilterEntry) GetFilter() isFilterEntry_Filter {
@@ -277,6 +364,13 @@ func (x *FilterEntry) GetBatchTransactionFilter() *BatchTransactionFilter {
 	return nil
 }
 
+func (x *FilterEntry) GetVaaPropertiesFilter() *VAAPropertiesFilter {
+	if x, ok := x.GetFilter().(*FilterEntry_VaaPropertiesFilter); ok {
+		return x.VaaPropertiesFilter
+	}
+	return nil
+}
+
 type isFilterEntry_Filter interface {
 	isFilterEntry_Filter()
 }
@@ -293,12 +387,18 @@ type FilterEntry_BatchTransactionFilter struct {
 	BatchTransactionFilter *BatchTransactionFilter `protobuf:"bytes,3,opt,name=batch_transaction_filter,json=batchTransactionFilter,proto3,oneof"`
 }
 
+type FilterEntry_VaaPropertiesFilter struct {
+	VaaPropertiesFilter *VAAPropertiesFilter `protobuf:"bytes,4,opt,name=vaa_properties_filter,json=vaaPropertiesFilter,proto3,oneof"`
+}
+
 func (*FilterEntry_EmitterFilter) isFilterEntry_Filter() {}
 
 func (*FilterEntry_BatchFilter) isFilterEntry_Filter() {}
 
 func (*FilterEntry_BatchTransactionFilter) isFilterEntry_Filter() {}
 
+func (*FilterEntry_VaaPropertiesFilter) isFilterEntry_Filter() {}
+
 type SubscribeSignedVAARequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -307,12 +407,18 @@ type SubscribeSignedVAARequest struct {
 	// List of filters to apply to the stream (OR).
 	// If empty, all messages are streamed.
 	Filters []*FilterEntry `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty"`
+	// Resume token of the last VAA received on a previous stream. If set, VAAs in the replay buffer
+	// that were received after it are streamed before new VAAs. Requires the spy to run with a replay buffer.
+	ResumeToken string `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	// Unix timestamp in seconds. If set, VAAs in the replay buffer that were received at or after this time
+	// are streamed before new VAAs. May not be combined with resume_token. Requires the spy to run with a replay buffer.
+	ResumeFromTimestamp int64 `protobuf:"varint,3,opt,name=resume_from_timestamp,json=resumeFromTimestamp,proto3" json:"resume_from_timestamp,omitempty"`
 }
 
 func (x *SubscribeSignedVAARequest) Reset() {
 	*x = SubscribeSignedVAARequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_spy_v1_spy_proto_msgTypes[4]
+		mi := &file_spy_v1_spy_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -325,7 +431,7 @@ func (x *SubscribeSignedVAARequest) String() string {
 func (*SubscribeSignedVAARequest) ProtoMessage() {}
 
 func (x *SubscribeSignedVAARequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spy_v1_spy_proto_msgTypes[4]
+	mi := &file_spy_v1_spy_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -338,7 +444,7 @@ func (x *SubscribeSignedVAARequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeSignedVAARequest.ProtoReflect.Descriptor instead.
 func (*SubscribeSignedVAARequest) Descriptor() ([]byte, []int) {
-	return file_spy_v1_spy_proto_rawDescGZIP(), []int{4}
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SubscribeSignedVAARequest) GetFilters() []*FilterEntry {
@@ -348,6 +454,20 @@ func (x *SubscribeSignedVAARequest) GetFilters() []*FilterEntry {
 	return nil
 }
 
+func (x *SubscribeSignedVAARequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *SubscribeSignedVAARequest) GetResumeFromTimestamp() int64 {
+	if x != nil {
+		return x.ResumeFromTimestamp
+	}
+	return 0
+}
+
 type SubscribeSignedVAAResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -355,12 +475,14 @@ type SubscribeSignedVAAResponse struct {
 
 	// Raw VAA bytes
 	VaaBytes []byte `protobuf:"bytes,1,opt,name=vaa_bytes,json=vaaBytes,proto3" json:"vaa_bytes,omitempty"`
+	// Token that can be used to resume streaming after this VAA. Only set if the spy runs with a replay buffer.
+	ResumeToken string `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
 }
 
 func (x *SubscribeSignedVAAResponse) Reset() {
 	*x = SubscribeSignedVAAResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_spy_v1_spy_proto_msgTypes[5]
+		mi := &file_spy_v1_spy_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -373,7 +495,7 @@ func (x *SubscribeSignedVAAResponse) String() string {
 func (*SubscribeSignedVAAResponse) ProtoMessage() {}
 
 func (x *SubscribeSignedVAAResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spy_v1_spy_proto_msgTypes[5]
+	mi := &file_spy_v1_spy_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -386,7 +508,7 @@ func (x *SubscribeSignedVAAResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeSignedVAAResponse.ProtoReflect.Descriptor instead.
 func (*SubscribeSignedVAAResponse) Descriptor() ([]byte, []int) {
-	return file_spy_v1_spy_proto_rawDescGZIP(), []int{5}
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SubscribeSignedVAAResponse) GetVaaBytes() []byte {
@@ -396,6 +518,13 @@ func (x *SubscribeSignedVAAResponse) GetVaaBytes() []byte {
 	return nil
 }
 
+func (x *SubscribeSignedVAAResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
 var File_spy_v1_spy_proto protoreflect.FileDescriptor
 
 var file_spy_v1_spy_proto_rawDesc = []byte{
@@ -425,7 +554,22 @@ var file_spy_v1_spy_proto_rawDesc = []byte{
 	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x76,
 	0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e,
 	0x49, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x22, 0xed, 0x01, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x74,
+	0x0c, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x22, 0xed, 0x01, 0x0a, 0x13, 0x56, 0x41, 0x41, 0x50,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x3c, 0x0a, 0x0e, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x0d,
+	0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x2b, 0x0a,
+	0x11, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4d, 0x69, 0x6e, 0x12, 0x21, 0x0a,
+	0x0c, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x78,
+	0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0xc0, 0x02, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x74,
 	0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x3e, 0x0a, 0x0e, 0x65, 0x6d, 0x69, 0x74, 0x74,
 	0x65, 0x72, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x15, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72,
@@ -439,30 +583,43 @@ var file_spy_v1_spy_proto_rawDesc = []byte{
 	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74,
 	0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c,
 	0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x16, 0x62, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x08, 0x0a,
-	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x4a, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x73, 0x22, 0x39, 0x0a, 0x1a, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x61, 0x61, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x76, 0x61, 0x61, 0x42, 0x79, 0x74, 0x65, 0x73, 0x32, 0x94,
-	0x01, 0x0a, 0x0d, 0x53, 0x70, 0x79, 0x52, 0x50, 0x43, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x82, 0x01, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69,
-	0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x12, 0x21, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64,
-	0x56, 0x41, 0x41, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x70, 0x79,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67,
-	0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x3a, 0x73, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x61,
-	0x3a, 0x01, 0x2a, 0x30, 0x01, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x75, 0x73, 0x6f, 0x6e, 0x65, 0x2f, 0x77, 0x6f,
-	0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x70, 0x79, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x70, 0x79,
-	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x51, 0x0a,
+	0x15, 0x76, 0x61, 0x61, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x5f,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73,
+	0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x41, 0x41, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x69, 0x65, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x13, 0x76, 0x61, 0x61,
+	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x42, 0x08, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xa1, 0x01, 0x0a, 0x19, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41,
+	0x41, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x70, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x72, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x5c,
+	0x0a, 0x1a, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x56, 0x41, 0x41, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x76, 0x61, 0x61, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x08, 0x76, 0x61, 0x61, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x32, 0x94, 0x01, 0x0a,
+	0x0d, 0x53, 0x70, 0x79, 0x52, 0x50, 0x43, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x82,
+	0x01, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x56, 0x41, 0x41, 0x12, 0x21, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41,
+	0x41, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x56, 0x41, 0x41, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x3a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x61, 0x3a, 0x01,
+	0x2a, 0x30, 0x01, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x75, 0x73, 0x6f, 0x6e, 0x65, 0x2f, 0x77, 0x6f, 0x72, 0x6d,
+	0x68, 0x6f, 0x6c, 0x65, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x70, 0x79, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x70, 0x79, 0x76, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -477,31 +634,34 @@ func file_spy_v1_spy_proto_rawDescGZIP() []byte {
 	return file_spy_v1_spy_proto_rawDescData
 }
 
-var file_spy_v1_spy_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_spy_v1_spy_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_spy_v1_spy_proto_goTypes = []interface{}{
 	(*EmitterFilter)(nil),              // 0: spy.v1.EmitterFilter
 	(*BatchFilter)(nil),                // 1: spy.v1.BatchFilter
 	(*BatchTransactionFilter)(nil),     // 2: spy.v1.BatchTransactionFilter
-	(*FilterEntry)(nil),                // 3: spy.v1.FilterEntry
-	(*SubscribeSignedVAARequest)(nil),  // 4: spy.v1.SubscribeSignedVAARequest
-	(*SubscribeSignedVAAResponse)(nil), // 5: spy.v1.SubscribeSignedVAAResponse
-	(v1.ChainID)(0),                    // 6: publicrpc.v1.ChainID
+	(*VAAPropertiesFilter)(nil),        // 3: spy.v1.VAAPropertiesFilter
+	(*FilterEntry)(nil),                // 4: spy.v1.FilterEntry
+	(*SubscribeSignedVAARequest)(nil),  // 5: spy.v1.SubscribeSignedVAARequest
+	(*SubscribeSignedVAAResponse)(nil), // 6: spy.v1.SubscribeSignedVAAResponse
+	(v1.ChainID)(0),                    // 7: publicrpc.v1.ChainID
 }
 var file_spy_v1_spy_proto_depIdxs = []int32{
-	6, // 0: spy.v1.EmitterFilter.chain_id:type_name -> publicrpc.v1.ChainID
-	6, // 1: spy.v1.BatchFilter.chain_id:type_name -> publicrpc.v1.ChainID
-	6, // 2: spy.v1.BatchTransactionFilter.chain_id:type_name -> publicrpc.v1.ChainID
-	0, // 3: spy.v1.FilterEntry.emitter_filter:type_name -> spy.v1.EmitterFilter
-	1, // 4: spy.v1.FilterEntry.batch_filter:type_name -> spy.v1.BatchFilter
-	2, // 5: spy.v1.FilterEntry.batch_transaction_filter:type_name -> spy.v1.BatchTransactionFilter
-	3, // 6: spy.v1.SubscribeSignedVAARequest.filters:type_name -> spy.v1.FilterEntry
-	4, // 7: spy.v1.SpyRPCService.SubscribeSignedVAA:input_type -> spy.v1.SubscribeSignedVAARequest
-	5, // 8: spy.v1.SpyRPCService.SubscribeSignedVAA:output_type -> spy.v1.SubscribeSignedVAAResponse
-	8, // [8:9] is the sub-list for method output_type
-	7, // [7:8] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	7,  // 0: spy.v1.EmitterFilter.chain_id:type_name -> publicrpc.v1.ChainID
+	7,  // 1: spy.v1.BatchFilter.chain_id:type_name -> publicrpc.v1.ChainID
+	7,  // 2: spy.v1.BatchTransactionFilter.chain_id:type_name -> publicrpc.v1.ChainID
+	7,  // 3: spy.v1.VAAPropertiesFilter.emitter_chains:type_name -> publicrpc.v1.ChainID
+	0,  // 4: spy.v1.FilterEntry.emitter_filter:type_name -> spy.v1.EmitterFilter
+	1,  // 5: spy.v1.FilterEntry.batch_filter:type_name -> spy.v1.BatchFilter
+	2,  // 6: spy.v1.FilterEntry.batch_transaction_filter:type_name -> spy.v1.BatchTransactionFilter
+	3,  // 7: spy.v1.FilterEntry.vaa_properties_filter:type_name -> spy.v1.VAAPropertiesFilter
+	4,  // 8: spy.v1.SubscribeSignedVAARequest.filters:type_name -> spy.v1.FilterEntry
+	5,  // 9: spy.v1.SpyRPCService.SubscribeSignedVAA:input_type -> spy.v1.SubscribeSignedVAARequest
+	6,  // 10: spy.v1.SpyRPCService.SubscribeSignedVAA:output_type -> spy.v1.SubscribeSignedVAAResponse
+	10, // [10:11] is the sub-list for method output_type
+	9,  // [9:10] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_spy_v1_spy_proto_init() }
@@ -547,7 +707,7 @@ func file_spy_v1_spy_proto_init() {
 			}
 		}
 		file_spy_v1_spy_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FilterEntry); i {
+			switch v := v.(*VAAPropertiesFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -559,7 +719,7 @@ func file_spy_v1_spy_proto_init() {
 			}
 		}
 		file_spy_v1_spy_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeSignedVAARequest); i {
+			switch v := v.(*FilterEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -571,6 +731,18 @@ func file_spy_v1_spy_proto_init() {
 			}
 		}
 		file_spy_v1_spy_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeSignedVAARequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spy_v1_spy_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubscribeSignedVAAResponse); i {
 			case 0:
 				return &v.state
@@ -583,10 +755,11 @@ func file_spy_v1_spy_proto_init() {
 			}
 		}
 	}
-	file_spy_v1_spy_proto_msgTypes[3].OneofWrappers = []interface{}{
+	file_spy_v1_spy_proto_msgTypes[4].OneofWrappers = []interface{}{
 		(*FilterEntry_EmitterFilter)(nil),
 		(*FilterEntry_BatchFilter)(nil),
 		(*FilterEntry_BatchTransactionFilter)(nil),
+		(*FilterEntry_VaaPropertiesFilter)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -594,7 +767,7 @@ func file_spy_v1_spy_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_spy_v1_spy_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -4,37 +4,90 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/db"
 	"github.com/certusone/wormhole/node/pkg/governor"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
+	"github.com/certusone/wormhole/node/pkg/watchers"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
+	"golang.org/x/time/rate"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+const (
+	// reobservationRequestInterval and reobservationRequestBurst limit the rate at which GetSignedVAA requests re-observations
+	// on each emitter chain, to prevent the public RPC from being used to flood the watchers. The rate is limited per chain
+	// rather than per caller, since callers are usually behind the REST gateway or a load balancer, and so that callers of
+	// one chain can't use up the re-observations of the others.
+	reobservationRequestInterval = time.Second
+	reobservationRequestBurst    = 10
+
+	// maxReobservationTxHashLen is the maximum length of a transaction hash accepted by GetSignedVAA.
+	maxReobservationTxHashLen = 64
+)
+
+// reobservationLimiter limits the rate of the re-observation requests of each emitter chain. There is at most one
+// limiter per chain ID.
+type reobservationLimiter struct {
+	mu       sync.Mutex
+	limiters map[vaa.ChainID]*rate.Limiter
+	limit    rate.Limit
+	burst    int
+}
+
+func newReobservationLimiter(limit rate.Limit, burst int) *reobservationLimiter {
+	return &reobservationLimiter{
+		limiters: make(map[vaa.ChainID]*rate.Limiter),
+		limit:    limit,
+		burst:    burst,
+	}
+}
+
+// allow reports whether a re-observation may be requested on the chain.
+func (l *reobservationLimiter) allow(chainID vaa.ChainID) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	limiter, ok := l.limiters[chainID]
+	if !ok {
+		limiter = rate.NewLimiter(l.limit, l.burst)
+		l.limiters[chainID] = limiter
+	}
+	return limiter.Allow()
+}
+
 // PublicrpcServer implements the publicrpc gRPC service.
 type PublicrpcServer struct {
 	publicrpcv1.UnsafePublicRPCServiceServer
-	logger *zap.Logger
-	db     *db.Database
-	gst    *common.GuardianSetState
-	gov    *governor.ChainGovernor
+	logger   *zap.Logger
+	db       *db.Database
+	gst      *common.GuardianSetState
+	gov      *governor.ChainGovernor
+	obsvReqC chan<- *gossipv1.ObservationRequest
+	limiter  *reobservationLimiter
 }
 
+// NewPublicrpcServer creates a new public RPC server. If obsvReqC is not nil, GetSignedVAA requests a local re-observation
+// when a VAA is not found and the caller provided the transaction hash.
 func NewPublicrpcServer(
 	logger *zap.Logger,
 	db *db.Database,
 	gst *common.GuardianSetState,
 	gov *governor.ChainGovernor,
+	obsvReqC chan<- *gossipv1.ObservationRequest,
 ) *PublicrpcServer {
 	return &PublicrpcServer{
-		logger: logger.Named("publicrpcserver"),
-		db:     db,
-		gst:    gst,
-		gov:    gov,
+		logger:   logger.Named("publicrpcserver"),
+		db:       db,
+		gst:      gst,
+		gov:      gov,
+		obsvReqC: obsvReqC,
+		limiter:  newReobservationLimiter(rate.Every(reobservationRequestInterval), reobservationRequestBurst),
 	}
 }
 
@@ -94,6 +147,9 @@ func (s *PublicrpcServer) GetSignedVAA(ctx context.Context, req *publicrpcv1.Get
 
 	if err != nil {
 		if err == db.ErrVAANotFound {
+			if len(req.TxHash) != 0 && s.obsvReqC != nil {
+				return s.requestReobservation(chainID, req.TxHash)
+			}
 			return nil, status.Error(codes.NotFound, err.Error())
 		}
 		s.logger.Error("failed to fetch VAA", zap.Error(err), zap.Any("request", req))
@@ -105,6 +161,37 @@ func (s *PublicrpcServer) GetSignedVAA(ctx context.Context, req *publicrpcv1.Get
 	}, nil
 }
 
+// requestReobservation requests a local re-observation of a transaction whose VAA could not be found.
+func (s *PublicrpcServer) requestReobservation(chainID vaa.ChainID, txHash []byte) (*publicrpcv1.GetSignedVAAResponse, error) {
+	if len(txHash) > maxReobservationTxHashLen {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("tx_hash may not be longer than %d bytes", maxReobservationTxHashLen))
+	}
+
+	if !s.limiter.allow(chainID) {
+		return nil, status.Error(codes.ResourceExhausted, "VAA not found and too many re-observation requests, try again later")
+	}
+
+	req := &gossipv1.ObservationRequest{
+		ChainId: uint32(chainID),
+		TxHash:  txHash,
+	}
+
+	select {
+	case s.obsvReqC <- req:
+	default:
+		return nil, status.Error(codes.ResourceExhausted, "VAA not found and the re-observation queue is full, try again later")
+	}
+
+	s.logger.Info("VAA not found, requested re-observation",
+		zap.Stringer("chain", chainID),
+		zap.String("tx_hash", hex.EncodeToString(txHash)),
+	)
+
+	return &publicrpcv1.GetSignedVAAResponse{
+		ReobservationRequested: true,
+	}, nil
+}
+
 func (s *PublicrpcServer) GetCurrentGuardianSet(ctx context.Context, req *publicrpcv1.GetCurrentGuardianSetRequest) (*publicrpcv1.GetCurrentGuardianSetResponse, error) {
 	gs := s.gst.Get()
 	if gs == nil {
@@ -180,3 +267,24 @@ func (s *PublicrpcServer) GovernorGetTokenList(ctx context.Context, req *publicr
 
 	return resp, nil
 }
+
+func (s *PublicrpcServer) GetWatcherHealth(ctx context.Context, req *publicrpcv1.GetWatcherHealthRequest) (*publicrpcv1.GetWatcherHealthResponse, error) {
+	resp := &publicrpcv1.GetWatcherHealthResponse{
+		Entries: make([]*publicrpcv1.GetWatcherHealthResponse_Entry, 0),
+	}
+
+	for _, h := range watchers.DefaultHealthRegistry.Health() {
+		resp.Entries = append(resp.Entries, &publicrpcv1.GetWatcherHealthResponse_Entry{
+			NetworkId:    string(h.NetworkID),
+			ChainId:      uint32(h.ChainID),
+			Score:        uint32(h.Score),
+			Healthy:      h.Healthy(),
+			Height:       h.Height,
+			LastProgress: h.LastProgress.Unix(),
+			RecentErrors: uint32(h.RecentErrors),
+			Restarts:     h.Restarts,
+		})
+	}
+
+	return resp, nil
+}
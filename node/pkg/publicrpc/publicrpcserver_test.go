@@ -5,9 +5,13 @@ import (
 	"testing"
 
 	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
 	"github.com/certusone/wormhole/node/pkg/governor"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -81,3 +85,69 @@ func TestGovernorIsVAAEnqueuedNoMessage(t *testing.T) {
 		assert.Equal(t, expected_err, err)
 	})
 }
+
+func TestGetSignedVAAReobservation(t *testing.T) {
+	logger := zap.NewNop()
+	database := db.OpenDb(logger, nil)
+	defer database.Close()
+
+	obsvReqC := make(chan *gossipv1.ObservationRequest, 10)
+	server := NewPublicrpcServer(logger, database, nil, nil, obsvReqC)
+	server.limiter = newReobservationLimiter(0, 2)
+
+	msgId := &publicrpcv1.MessageID{
+		EmitterChain:   publicrpcv1.ChainID(vaa.ChainIDEthereum),
+		EmitterAddress: "0000000000000000000000000000000000000000000000000000000000000004",
+		Sequence:       1,
+	}
+	txHash := []byte{1, 2, 3, 4}
+
+	// Without a tx hash, NOT_FOUND is returned as before.
+	_, err := server.GetSignedVAA(context.Background(), &publicrpcv1.GetSignedVAARequest{MessageId: msgId})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Len(t, obsvReqC, 0)
+
+	resp, err := server.GetSignedVAA(context.Background(), &publicrpcv1.GetSignedVAARequest{MessageId: msgId, TxHash: txHash})
+	require.NoError(t, err)
+	assert.True(t, resp.ReobservationRequested)
+	assert.Empty(t, resp.VaaBytes)
+	require.Len(t, obsvReqC, 1)
+	req := <-obsvReqC
+	assert.Equal(t, uint32(vaa.ChainIDEthereum), req.ChainId)
+	assert.Equal(t, txHash, req.TxHash)
+
+	_, err = server.GetSignedVAA(context.Background(), &publicrpcv1.GetSignedVAARequest{MessageId: msgId, TxHash: make([]byte, maxReobservationTxHashLen+1)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = server.GetSignedVAA(context.Background(), &publicrpcv1.GetSignedVAARequest{MessageId: msgId, TxHash: txHash})
+	require.NoError(t, err)
+
+	// The limiter only allows two requests per chain.
+	_, err = server.GetSignedVAA(context.Background(), &publicrpcv1.GetSignedVAARequest{MessageId: msgId, TxHash: txHash})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	otherChainMsgId := &publicrpcv1.MessageID{
+		EmitterChain:   publicrpcv1.ChainID(vaa.ChainIDSolana),
+		EmitterAddress: msgId.EmitterAddress,
+		Sequence:       msgId.Sequence,
+	}
+	resp, err = server.GetSignedVAA(context.Background(), &publicrpcv1.GetSignedVAARequest{MessageId: otherChainMsgId, TxHash: txHash})
+	require.NoError(t, err)
+	assert.True(t, resp.ReobservationRequested)
+}
+
+func TestGetSignedVAAReobservationDisabled(t *testing.T) {
+	logger := zap.NewNop()
+	database := db.OpenDb(logger, nil)
+	defer database.Close()
+
+	server := NewPublicrpcServer(logger, database, nil, nil, nil)
+	msgId := &publicrpcv1.MessageID{
+		EmitterChain:   publicrpcv1.ChainID(vaa.ChainIDEthereum),
+		EmitterAddress: "0000000000000000000000000000000000000000000000000000000000000004",
+		Sequence:       1,
+	}
+
+	_, err := server.GetSignedVAA(context.Background(), &publicrpcv1.GetSignedVAARequest{MessageId: msgId, TxHash: []byte{1, 2, 3, 4}})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -116,6 +116,30 @@ type EthCallData struct {
 
 const EvmContractAddressLength = 20
 
+// EthGetStorageAtQueryRequestType is the type of an EVM eth_get_storage_at query request.
+const EthGetStorageAtQueryRequestType ChainSpecificQueryType = 6
+
+// EthGetStorageAtQueryRequest implements ChainSpecificQuery for an EVM eth_get_storage_at query request.
+type EthGetStorageAtQueryRequest struct {
+	// BlockId identifies the block to be queried. It must be a hex string starting with 0x. It may be a block number or a block hash.
+	BlockId string
+
+	// Slots is an array of storage slots to be read on the specified block, in a single RPC call.
+	Slots []*EthStorageSlot
+}
+
+// EthStorageSlot specifies the parameters to a single EVM eth_getStorageAt request.
+type EthStorageSlot struct {
+	// Address specifies the contract whose storage is to be read.
+	Address []byte
+
+	// Slot is the position in the contract storage to be read.
+	Slot []byte
+}
+
+// EvmStorageSlotLength is the length of an EVM storage slot, and also of the value stored in it.
+const EvmStorageSlotLength = 32
+
 ////////////////////////////////// Solana Queries ////////////////////////////////////////////////
 
 // SolanaAccountQueryRequestType is the type of a Solana sol_account query request.
@@ -428,6 +452,12 @@ func (perChainQuery *PerChainQueryRequest) UnmarshalFromReader(reader *bytes.Rea
 			return fmt.Errorf("failed to unmarshal eth call with finality request: %w", err)
 		}
 		perChainQuery.Query = &q
+	case EthGetStorageAtQueryRequestType:
+		q := EthGetStorageAtQueryRequest{}
+		if err := q.UnmarshalFromReader(reader); err != nil {
+			return fmt.Errorf("failed to unmarshal eth get storage at request: %w", err)
+		}
+		perChainQuery.Query = &q
 	case SolanaAccountQueryRequestType:
 		q := SolanaAccountQueryRequest{}
 		if err := q.UnmarshalFromReader(reader); err != nil {
@@ -471,7 +501,7 @@ func (perChainQuery *PerChainQueryRequest) Validate() error {
 
 func ValidatePerChainQueryRequestType(qt ChainSpecificQueryType) error {
 	if qt != EthCallQueryRequestType && qt != EthCallByTimestampQueryRequestType && qt != EthCallWithFinalityQueryRequestType &&
-		qt != SolanaAccountQueryRequestType && qt != SolanaPdaQueryRequestType {
+		qt != EthGetStorageAtQueryRequestType && qt != SolanaAccountQueryRequestType && qt != SolanaPdaQueryRequestType {
 		return fmt.Errorf("invalid query request type: %d", qt)
 	}
 	return nil
@@ -517,6 +547,13 @@ func (left *PerChainQueryRequest) Equal(right *PerChainQueryRequest) bool {
 		default:
 			panic("unsupported query type on right, must be eth_call_with_finality")
 		}
+	case *EthGetStorageAtQueryRequest:
+		switch rightQuery := right.Query.(type) {
+		case *EthGetStorageAtQueryRequest:
+			return leftQuery.Equal(rightQuery)
+		default:
+			panic("unsupported query type on right, must be eth_get_storage_at")
+		}
 	case *SolanaAccountQueryRequest:
 		switch rightQuery := right.Query.(type) {
 		case *SolanaAccountQueryRequest:
@@ -993,6 +1030,123 @@ func (left *EthCallWithFinalityQueryRequest) Equal(right *EthCallWithFinalityQue
 	return true
 }
 
+//
+// Implementation of EthGetStorageAtQueryRequest, which implements the ChainSpecificQuery interface.
+//
+
+func (e *EthGetStorageAtQueryRequest) Type() ChainSpecificQueryType {
+	return EthGetStorageAtQueryRequestType
+}
+
+// Marshal serializes the binary representation of an EVM eth_get_storage_at request.
+// This method calls Validate() and relies on it to range checks lengths, etc.
+func (esq *EthGetStorageAtQueryRequest) Marshal() ([]byte, error) {
+	if err := esq.Validate(); err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	vaa.MustWrite(buf, binary.BigEndian, uint32(len(esq.BlockId)))
+	buf.Write([]byte(esq.BlockId))
+
+	vaa.MustWrite(buf, binary.BigEndian, uint8(len(esq.Slots)))
+	for _, slot := range esq.Slots {
+		buf.Write(slot.Address)
+		buf.Write(slot.Slot)
+	}
+	return buf.Bytes(), nil
+}
+
+// Unmarshal deserializes an EVM eth_get_storage_at query from a byte array
+func (esq *EthGetStorageAtQueryRequest) Unmarshal(data []byte) error {
+	reader := bytes.NewReader(data[:])
+	return esq.UnmarshalFromReader(reader)
+}
+
+// UnmarshalFromReader  deserializes an EVM eth_get_storage_at query from a byte array
+func (esq *EthGetStorageAtQueryRequest) UnmarshalFromReader(reader *bytes.Reader) error {
+	blockIdLen := uint32(0)
+	if err := binary.Read(reader, binary.BigEndian, &blockIdLen); err != nil {
+		return fmt.Errorf("failed to read block id len: %w", err)
+	}
+
+	blockId := make([]byte, blockIdLen)
+	if n, err := reader.Read(blockId[:]); err != nil || n != int(blockIdLen) {
+		return fmt.Errorf("failed to read block id [%d]: %w", n, err)
+	}
+	esq.BlockId = string(blockId[:])
+
+	numSlots := uint8(0)
+	if err := binary.Read(reader, binary.BigEndian, &numSlots); err != nil {
+		return fmt.Errorf("failed to read number of storage slots: %w", err)
+	}
+
+	for count := 0; count < int(numSlots); count++ {
+		address := [EvmContractAddressLength]byte{}
+		if n, err := reader.Read(address[:]); err != nil || n != EvmContractAddressLength {
+			return fmt.Errorf("failed to read storage address [%d]: %w", n, err)
+		}
+
+		slot := [EvmStorageSlotLength]byte{}
+		if n, err := reader.Read(slot[:]); err != nil || n != EvmStorageSlotLength {
+			return fmt.Errorf("failed to read storage slot [%d]: %w", n, err)
+		}
+
+		esq.Slots = append(esq.Slots, &EthStorageSlot{
+			Address: address[:],
+			Slot:    slot[:],
+		})
+	}
+
+	return nil
+}
+
+// Validate does basic validation on an EVM eth_get_storage_at query.
+func (esq *EthGetStorageAtQueryRequest) Validate() error {
+	if len(esq.BlockId) > math.MaxUint32 {
+		return fmt.Errorf("block id too long")
+	}
+	if !strings.HasPrefix(esq.BlockId, "0x") {
+		return fmt.Errorf("block id must be a hex number or hash starting with 0x")
+	}
+	if len(esq.Slots) <= 0 {
+		return fmt.Errorf("does not contain any storage slots")
+	}
+	if len(esq.Slots) > math.MaxUint8 {
+		return fmt.Errorf("too many storage slots")
+	}
+	for _, slot := range esq.Slots {
+		if len(slot.Address) != EvmContractAddressLength {
+			return fmt.Errorf("invalid length for storage address")
+		}
+		if len(slot.Slot) != EvmStorageSlotLength {
+			return fmt.Errorf("invalid length for storage slot")
+		}
+	}
+
+	return nil
+}
+
+// Equal verifies that two EVM eth_get_storage_at queries are equal.
+func (left *EthGetStorageAtQueryRequest) Equal(right *EthGetStorageAtQueryRequest) bool {
+	if left.BlockId != right.BlockId {
+		return false
+	}
+	if len(left.Slots) != len(right.Slots) {
+		return false
+	}
+	for idx := range left.Slots {
+		if !bytes.Equal(left.Slots[idx].Address, right.Slots[idx].Address) {
+			return false
+		}
+		if !bytes.Equal(left.Slots[idx].Slot, right.Slots[idx].Slot) {
+			return false
+		}
+	}
+
+	return true
+}
+
 //
 // Implementation of SolanaAccountQueryRequest, which implements the ChainSpecificQuery interface.
 //
@@ -726,6 +726,70 @@ func TestMarshalOfEthCallWithFinalityQueryWithSafeShouldSucceed(t *testing.T) {
 	require.NoError(t, err)
 }
 
+///////////// EthGetStorageAt tests ////////////////////////////////////////
+
+func createEthGetStorageAtQueryRequestForTesting(t *testing.T) *QueryRequest {
+	t.Helper()
+
+	address, err := hex.DecodeString("0d500b1d8e8ef31e21c99d1db9a6444d3adf1270")
+	require.NoError(t, err)
+
+	perChainQuery := &PerChainQueryRequest{
+		ChainId: vaa.ChainIDPolygon,
+		Query: &EthGetStorageAtQueryRequest{
+			BlockId: "0x28d9630",
+			Slots: []*EthStorageSlot{
+				{
+					Address: address,
+					Slot:    ethCommon.HexToHash("0x00").Bytes(),
+				},
+				{
+					Address: address,
+					Slot:    ethCommon.HexToHash("0x9999bac44d09a7f69ee7941819b0a19c59ccb1969640cc513be09ef95ed2d8e2").Bytes(),
+				},
+			},
+		},
+	}
+
+	return &QueryRequest{
+		Nonce:           1,
+		PerChainQueries: []*PerChainQueryRequest{perChainQuery},
+	}
+}
+
+func TestEthGetStorageAtQueryRequestMarshalUnmarshal(t *testing.T) {
+	queryRequest := createEthGetStorageAtQueryRequestForTesting(t)
+	queryRequestBytes, err := queryRequest.Marshal()
+	require.NoError(t, err)
+
+	var queryRequest2 QueryRequest
+	err = queryRequest2.Unmarshal(queryRequestBytes)
+	require.NoError(t, err)
+
+	assert.True(t, queryRequest.Equal(&queryRequest2))
+}
+
+func TestMarshalOfEthGetStorageAtQueryWithNoSlotsShouldFail(t *testing.T) {
+	queryRequest := createEthGetStorageAtQueryRequestForTesting(t)
+	queryRequest.PerChainQueries[0].Query.(*EthGetStorageAtQueryRequest).Slots = nil
+	_, err := queryRequest.Marshal()
+	require.Error(t, err)
+}
+
+func TestMarshalOfEthGetStorageAtQueryWithWrongLengthAddressShouldFail(t *testing.T) {
+	queryRequest := createEthGetStorageAtQueryRequestForTesting(t)
+	queryRequest.PerChainQueries[0].Query.(*EthGetStorageAtQueryRequest).Slots[0].Address = []byte("TooShort")
+	_, err := queryRequest.Marshal()
+	require.Error(t, err)
+}
+
+func TestMarshalOfEthGetStorageAtQueryWithWrongLengthSlotShouldFail(t *testing.T) {
+	queryRequest := createEthGetStorageAtQueryRequestForTesting(t)
+	queryRequest.PerChainQueries[0].Query.(*EthGetStorageAtQueryRequest).Slots[1].Slot = []byte{0x01}
+	_, err := queryRequest.Marshal()
+	require.Error(t, err)
+}
+
 ///////////// Solana Account Query tests /////////////////////////////////
 
 func createSolanaAccountQueryRequestForTesting(t *testing.T) *QueryRequest {
@@ -107,6 +107,16 @@ type EthCallWithFinalityQueryResponse struct {
 	Results [][]byte
 }
 
+// EthGetStorageAtQueryResponse implements ChainSpecificResponse for an EVM eth_get_storage_at query response.
+type EthGetStorageAtQueryResponse struct {
+	BlockNumber uint64
+	Hash        common.Hash
+	Time        time.Time
+
+	// Results is the array of storage values matching Slots in EthGetStorageAtQueryRequest. Each value is 32 bytes.
+	Results [][]byte
+}
+
 // SolanaAccountQueryResponse implements ChainSpecificResponse for a Solana sol_account query response.
 type SolanaAccountQueryResponse struct {
 	// SlotNumber is the slot number returned by the sol_account query
@@ -444,6 +454,12 @@ func (perChainResponse *PerChainQueryResponse) UnmarshalFromReader(reader *bytes
 			return fmt.Errorf("failed to unmarshal eth call with finality response: %w", err)
 		}
 		perChainResponse.Response = &r
+	case EthGetStorageAtQueryRequestType:
+		r := EthGetStorageAtQueryResponse{}
+		if err := r.UnmarshalFromReader(reader); err != nil {
+			return fmt.Errorf("failed to unmarshal eth get storage at response: %w", err)
+		}
+		perChainResponse.Response = &r
 	case SolanaAccountQueryRequestType:
 		r := SolanaAccountQueryResponse{}
 		if err := r.UnmarshalFromReader(reader); err != nil {
@@ -525,6 +541,13 @@ func (left *PerChainQueryResponse) Equal(right *PerChainQueryResponse) bool {
 		default:
 			panic("unsupported query type on right") // We checked this above!
 		}
+	case *EthGetStorageAtQueryResponse:
+		switch rightResp := right.Response.(type) {
+		case *EthGetStorageAtQueryResponse:
+			return leftResp.Equal(rightResp)
+		default:
+			panic("unsupported query type on right") // We checked this above!
+		}
 	case *SolanaAccountQueryResponse:
 		switch rightResp := right.Response.(type) {
 		case *SolanaAccountQueryResponse:
@@ -946,6 +969,121 @@ func (left *EthCallWithFinalityQueryResponse) Equal(right *EthCallWithFinalityQu
 	return true
 }
 
+//
+// Implementation of EthGetStorageAtQueryResponse, which implements the ChainSpecificResponse for an EVM eth_get_storage_at query response.
+//
+
+func (e *EthGetStorageAtQueryResponse) Type() ChainSpecificQueryType {
+	return EthGetStorageAtQueryRequestType
+}
+
+// Marshal serializes the binary representation of an EVM eth_get_storage_at response.
+// This method calls Validate() and relies on it to range checks lengths, etc.
+func (esr *EthGetStorageAtQueryResponse) Marshal() ([]byte, error) {
+	if err := esr.Validate(); err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	vaa.MustWrite(buf, binary.BigEndian, esr.BlockNumber)
+	buf.Write(esr.Hash[:])
+	vaa.MustWrite(buf, binary.BigEndian, esr.Time.UnixMicro())
+
+	vaa.MustWrite(buf, binary.BigEndian, uint8(len(esr.Results)))
+	for idx := range esr.Results {
+		buf.Write(esr.Results[idx])
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Unmarshal deserializes an EVM eth_get_storage_at response from a byte array
+func (esr *EthGetStorageAtQueryResponse) Unmarshal(data []byte) error {
+	reader := bytes.NewReader(data[:])
+	return esr.UnmarshalFromReader(reader)
+}
+
+// UnmarshalFromReader  deserializes an EVM eth_get_storage_at response from a byte array
+func (esr *EthGetStorageAtQueryResponse) UnmarshalFromReader(reader *bytes.Reader) error {
+	if err := binary.Read(reader, binary.BigEndian, &esr.BlockNumber); err != nil {
+		return fmt.Errorf("failed to read response number: %w", err)
+	}
+
+	responseHash := common.Hash{}
+	if n, err := reader.Read(responseHash[:]); err != nil || n != 32 {
+		return fmt.Errorf("failed to read response hash [%d]: %w", n, err)
+	}
+	esr.Hash = responseHash
+
+	unixMicros := int64(0)
+	if err := binary.Read(reader, binary.BigEndian, &unixMicros); err != nil {
+		return fmt.Errorf("failed to read response timestamp: %w", err)
+	}
+	esr.Time = time.UnixMicro(unixMicros)
+
+	numResults := uint8(0)
+	if err := binary.Read(reader, binary.BigEndian, &numResults); err != nil {
+		return fmt.Errorf("failed to read number of results: %w", err)
+	}
+
+	for count := 0; count < int(numResults); count++ {
+		result := make([]byte, EvmStorageSlotLength)
+		if n, err := reader.Read(result[:]); err != nil || n != EvmStorageSlotLength {
+			return fmt.Errorf("failed to read result [%d]: %w", n, err)
+		}
+
+		esr.Results = append(esr.Results, result)
+	}
+
+	return nil
+}
+
+// Validate does basic validation on an EVM eth_get_storage_at response.
+func (esr *EthGetStorageAtQueryResponse) Validate() error {
+	if len(esr.Hash) != 32 {
+		return fmt.Errorf("invalid length for block hash")
+	}
+
+	if len(esr.Results) <= 0 {
+		return fmt.Errorf("does not contain any results")
+	}
+	if len(esr.Results) > math.MaxUint8 {
+		return fmt.Errorf("too many results")
+	}
+	for _, result := range esr.Results {
+		if len(result) != EvmStorageSlotLength {
+			return fmt.Errorf("invalid length for result")
+		}
+	}
+	return nil
+}
+
+// Equal verifies that two EVM eth_get_storage_at responses are equal.
+func (left *EthGetStorageAtQueryResponse) Equal(right *EthGetStorageAtQueryResponse) bool {
+	if left.BlockNumber != right.BlockNumber {
+		return false
+	}
+
+	if !bytes.Equal(left.Hash.Bytes(), right.Hash.Bytes()) {
+		return false
+	}
+
+	if left.Time != right.Time {
+		return false
+	}
+
+	if len(left.Results) != len(right.Results) {
+		return false
+	}
+	for idx := range left.Results {
+		if !bytes.Equal(left.Results[idx], right.Results[idx]) {
+			return false
+		}
+	}
+
+	return true
+}
+
 //
 // Implementation of SolanaAccountQueryResponse, which implements the ChainSpecificResponse for a Solana sol_account query response.
 //
@@ -2,6 +2,7 @@ package query
 
 import (
 	"fmt"
+	"math/big"
 	"testing"
 	"time"
 
@@ -263,6 +264,71 @@ func TestMarshalUnmarshalQueryResponseWithTooManyResultsShouldFail(t *testing.T)
 	require.Error(t, err)
 }
 
+///////////// EthGetStorageAt Query tests /////////////////////////////////
+
+func createEthGetStorageAtQueryResponseFromRequest(t *testing.T, queryRequest *QueryRequest) *QueryResponsePublication {
+	queryRequestBytes, err := queryRequest.Marshal()
+	require.NoError(t, err)
+
+	sig := [65]byte{}
+	signedQueryRequest := &gossipv1.SignedQueryRequest{
+		QueryRequest: queryRequestBytes,
+		Signature:    sig[:],
+	}
+
+	perChainResponses := []*PerChainQueryResponse{}
+	for idx, pcr := range queryRequest.PerChainQueries {
+		switch req := pcr.Query.(type) {
+		case *EthGetStorageAtQueryRequest:
+			results := [][]byte{}
+			for idx := range req.Slots {
+				results = append(results, ethCommon.BigToHash(big.NewInt(int64(2000+idx))).Bytes())
+			}
+			perChainResponses = append(perChainResponses, &PerChainQueryResponse{
+				ChainId: pcr.ChainId,
+				Response: &EthGetStorageAtQueryResponse{
+					BlockNumber: uint64(1000 + idx),
+					Hash:        ethCommon.HexToHash("0x9999bac44d09a7f69ee7941819b0a19c59ccb1969640cc513be09ef95ed2d8e2"),
+					Time:        timeForTest(t, time.Now()),
+					Results:     results,
+				},
+			})
+		default:
+			panic("invalid query type!")
+		}
+
+	}
+
+	return &QueryResponsePublication{
+		Request:           signedQueryRequest,
+		PerChainResponses: perChainResponses,
+	}
+}
+
+func TestEthGetStorageAtQueryResponseMarshalUnmarshal(t *testing.T) {
+	queryRequest := createEthGetStorageAtQueryRequestForTesting(t)
+	respPub := createEthGetStorageAtQueryResponseFromRequest(t, queryRequest)
+
+	respPubBytes, err := respPub.Marshal()
+	require.NoError(t, err)
+
+	var respPub2 QueryResponsePublication
+	err = respPub2.Unmarshal(respPubBytes)
+	require.NoError(t, err)
+	require.NotNil(t, respPub2)
+
+	assert.True(t, respPub.Equal(&respPub2))
+}
+
+func TestMarshalOfEthGetStorageAtQueryResponseWithWrongLengthResultShouldFail(t *testing.T) {
+	queryRequest := createEthGetStorageAtQueryRequestForTesting(t)
+	respPub := createEthGetStorageAtQueryResponseFromRequest(t, queryRequest)
+	respPub.PerChainResponses[0].Response.(*EthGetStorageAtQueryResponse).Results[0] = []byte("Not 32 bytes")
+
+	_, err := respPub.Marshal()
+	require.Error(t, err)
+}
+
 ///////////// Solana Account Query tests /////////////////////////////////
 
 func createSolanaAccountQueryResponseFromRequest(t *testing.T, queryRequest *QueryRequest) *QueryResponsePublication {
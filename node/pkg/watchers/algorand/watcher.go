@@ -13,10 +13,10 @@ import (
 	"github.com/algorand/go-algorand-sdk/crypto"
 	"github.com/algorand/go-algorand-sdk/types"
 	"github.com/certusone/wormhole/node/pkg/common"
-	"github.com/certusone/wormhole/node/pkg/p2p"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/certusone/wormhole/node/pkg/readiness"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/certusone/wormhole/node/pkg/watchers"
 	eth_common "github.com/ethereum/go-ethereum/common"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -30,6 +30,8 @@ const MAX_DEPTH = 8
 type (
 	// Watcher is responsible for looking over Algorand blockchain and reporting new transactions to the appid
 	Watcher struct {
+		*watchers.Base
+
 		indexerRPC   string
 		indexerToken string
 		algodRPC     string
@@ -72,9 +74,10 @@ func NewWatcher(
 	algodToken string,
 	appid uint64,
 	msgC chan<- *common.MessagePublication,
-	obsvReqC <-chan *gossipv1.ObservationRequest,
+	obsvReqC chan *gossipv1.ObservationRequest,
 ) *Watcher {
 	return &Watcher{
+		Base:          watchers.NewBase(vaa.ChainIDAlgorand, watchers.VersionAlgorand, msgC, obsvReqC),
 		indexerRPC:    indexerRPC,
 		indexerToken:  indexerToken,
 		algodRPC:      algodRPC,
@@ -186,7 +189,7 @@ func lookAtTxn(e *Watcher, t types.SignedTxnInBlock, b types.Block, logger *zap.
 
 func (e *Watcher) Run(ctx context.Context) error {
 	// an odd thing to broadcast...
-	p2p.DefaultRegistry.SetNetworkStats(vaa.ChainIDAlgorand, &gossipv1.Heartbeat_Network{
+	e.SetNetworkStats(&gossipv1.Heartbeat_Network{
 		ContractAddress: fmt.Sprintf("%d", e.appid),
 	})
 
@@ -210,21 +213,21 @@ func (e *Watcher) Run(ctx context.Context) error {
 	indexerClient, err := indexer.MakeClient(e.indexerRPC, e.indexerToken)
 	if err != nil {
 		logger.Error("indexer make client", zap.Error(err))
-		p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDAlgorand, 1)
+		e.AddErrorCount(1)
 		return err
 	}
 
 	algodClient, err := algod.MakeClient(e.algodRPC, e.algodToken)
 	if err != nil {
 		logger.Error("algod client", zap.Error(err))
-		p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDAlgorand, 1)
+		e.AddErrorCount(1)
 		return err
 	}
 
 	status, err := algodClient.StatusAfterBlock(0).Do(ctx)
 	if err != nil {
 		logger.Error("StatusAfterBlock", zap.Error(err))
-		p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDAlgorand, 1)
+		e.AddErrorCount(1)
 		return err
 	}
 
@@ -248,7 +251,7 @@ func (e *Watcher) Run(ctx context.Context) error {
 			result, err := indexerClient.SearchForTransactions().TXID(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(r.TxHash)).Do(ctx)
 			if err != nil {
 				logger.Error("SearchForTransactions", zap.Error(err))
-				p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDAlgorand, 1)
+				e.AddErrorCount(1)
 				break
 			}
 			for _, t := range result.Transactions {
@@ -257,7 +260,7 @@ func (e *Watcher) Run(ctx context.Context) error {
 				block, err := algodClient.Block(r).Do(ctx)
 				if err != nil {
 					logger.Error("SearchForTransactions", zap.Error(err))
-					p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDAlgorand, 1)
+					e.AddErrorCount(1)
 					break
 				}
 
@@ -270,7 +273,7 @@ func (e *Watcher) Run(ctx context.Context) error {
 			status, err := algodClient.Status().Do(context.Background())
 			if err != nil {
 				logger.Error(fmt.Sprintf("algodClient.Status: %s", err.Error()))
-				p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDAlgorand, 1)
+				e.AddErrorCount(1)
 				continue
 			}
 
@@ -279,7 +282,7 @@ func (e *Watcher) Run(ctx context.Context) error {
 					block, err := algodClient.Block(e.next_round).Do(context.Background())
 					if err != nil {
 						logger.Error(fmt.Sprintf("algodClient.Block %d: %s", e.next_round, err.Error()))
-						p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDAlgorand, 1)
+						e.AddErrorCount(1)
 						break
 					}
 
@@ -299,8 +302,9 @@ func (e *Watcher) Run(ctx context.Context) error {
 			}
 
 			currentAlgorandHeight.Set(float64(status.LastRound))
-			p2p.DefaultRegistry.SetNetworkStats(vaa.ChainIDAlgorand, &gossipv1.Heartbeat_Network{
+			e.SetNetworkStats(&gossipv1.Heartbeat_Network{
 				Height:          int64(status.LastRound),
+				FinalizedHeight: int64(status.LastRound),
 				ContractAddress: fmt.Sprintf("%d", e.appid),
 			})
 
@@ -4,7 +4,6 @@ import (
 	"github.com/certusone/wormhole/node/pkg/common"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/certusone/wormhole/node/pkg/query"
-	"github.com/certusone/wormhole/node/pkg/supervisor"
 	"github.com/certusone/wormhole/node/pkg/watchers"
 	"github.com/certusone/wormhole/node/pkg/watchers/interfaces"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
@@ -36,11 +35,11 @@ func (wc *WatcherConfig) SetL1Finalizer(l1finalizer interfaces.L1Finalizer) {
 
 func (wc *WatcherConfig) Create(
 	msgC chan<- *common.MessagePublication,
-	obsvReqC <-chan *gossipv1.ObservationRequest,
+	obsvReqC chan *gossipv1.ObservationRequest,
 	_ <-chan *query.PerChainQueryInternal,
 	_ chan<- *query.PerChainQueryResponseInternal,
 	_ chan<- *common.GuardianSet,
 	env common.Environment,
-) (interfaces.L1Finalizer, supervisor.Runnable, error) {
-	return nil, NewWatcher(wc.Rpc, wc.Account, wc.Handle, msgC, obsvReqC).Run, nil
+) (interfaces.L1Finalizer, watchers.Watcher, error) {
+	return nil, NewWatcher(wc.Rpc, wc.Account, wc.Handle, msgC, obsvReqC), nil
 }
@@ -10,10 +10,10 @@ import (
 	"time"
 
 	"github.com/certusone/wormhole/node/pkg/common"
-	"github.com/certusone/wormhole/node/pkg/p2p"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/certusone/wormhole/node/pkg/readiness"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/certusone/wormhole/node/pkg/watchers"
 	eth_common "github.com/ethereum/go-ethereum/common"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -25,6 +25,8 @@ import (
 type (
 	// Watcher is responsible for looking over Aptos blockchain and reporting new transactions to the wormhole contract
 	Watcher struct {
+		*watchers.Base
+
 		aptosRPC     string
 		aptosAccount string
 		aptosHandle  string
@@ -46,17 +48,36 @@ var (
 			Name: "wormhole_aptos_current_height",
 			Help: "Current Aptos block height",
 		})
+	currentAptosLedgerVersion = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "wormhole_aptos_current_ledger_version",
+			Help: "Current Aptos ledger version",
+		})
+	aptosEventGaps = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "wormhole_aptos_event_gaps_total",
+			Help: "Total number of gaps in the Aptos event sequence numbers that were backfilled",
+		})
+	aptosEventsMissed = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "wormhole_aptos_events_missed_total",
+			Help: "Total number of Aptos events that could not be backfilled",
+		})
 )
 
+// aptosMaxEventsPageSize is the maximum number of events the events endpoint returns per page
+const aptosMaxEventsPageSize = 100
+
 // NewWatcher creates a new Aptos appid watcher
 func NewWatcher(
 	aptosRPC string,
 	aptosAccount string,
 	aptosHandle string,
 	msgC chan<- *common.MessagePublication,
-	obsvReqC <-chan *gossipv1.ObservationRequest,
+	obsvReqC chan *gossipv1.ObservationRequest,
 ) *Watcher {
 	return &Watcher{
+		Base:          watchers.NewBase(vaa.ChainIDAptos, watchers.VersionAptos, msgC, obsvReqC),
 		aptosRPC:      aptosRPC,
 		aptosAccount:  aptosAccount,
 		aptosHandle:   aptosHandle,
@@ -67,7 +88,7 @@ func NewWatcher(
 }
 
 func (e *Watcher) Run(ctx context.Context) error {
-	p2p.DefaultRegistry.SetNetworkStats(vaa.ChainIDAptos, &gossipv1.Heartbeat_Network{
+	e.SetNetworkStats(&gossipv1.Heartbeat_Network{
 		ContractAddress: e.aptosAccount,
 	})
 
@@ -117,13 +138,13 @@ func (e *Watcher) Run(ctx context.Context) error {
 			body, err := e.retrievePayload(s)
 			if err != nil {
 				logger.Error("retrievePayload", zap.Error(err))
-				p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDAptos, 1)
+				e.AddErrorCount(1)
 				continue
 			}
 
 			if !gjson.Valid(string(body)) {
 				logger.Error("InvalidJson: " + string(body))
-				p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDAptos, 1)
+				e.AddErrorCount(1)
 				break
 
 			}
@@ -165,7 +186,7 @@ func (e *Watcher) Run(ctx context.Context) error {
 			eventsJson, err := e.retrievePayload(s)
 			if err != nil {
 				logger.Error("retrievePayload", zap.Error(err))
-				p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDAptos, 1)
+				e.AddErrorCount(1)
 				continue
 			}
 
@@ -178,42 +199,24 @@ func (e *Watcher) Run(ctx context.Context) error {
 
 			if !gjson.Valid(string(eventsJson)) {
 				logger.Error("InvalidJson: " + string(eventsJson))
-				p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDAptos, 1)
+				e.AddErrorCount(1)
 				continue
 
 			}
 
 			events := gjson.ParseBytes(eventsJson)
-
-			// the endpoint returns an array of events, ordered by sequence
-			// id (ASC)
-			for _, event := range events.Array() {
-				eventSequence := event.Get("sequence_number")
-				if !eventSequence.Exists() {
-					continue
-				}
-
-				// this is interesting in the last iteration, whereby we
-				// find the next sequence that comes after the array
-				nextSequence = eventSequence.Uint() + 1
-
-				data := event.Get("data")
-				if !data.Exists() {
-					continue
-				}
-				e.observeData(logger, data, eventSequence.Uint(), false)
-			}
+			nextSequence = e.observeEvents(logger, eventsEndpoint, events.Array(), nextSequence)
 
 			health, err := e.retrievePayload(aptosHealth)
 			if err != nil {
 				logger.Error("health", zap.Error(err))
-				p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDAptos, 1)
+				e.AddErrorCount(1)
 				continue
 			}
 
 			if !gjson.Valid(string(health)) {
 				logger.Error("Invalid JSON in health response: " + string(health))
-				p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDAptos, 1)
+				e.AddErrorCount(1)
 				continue
 
 			}
@@ -224,11 +227,16 @@ func (e *Watcher) Run(ctx context.Context) error {
 			pHealth := gjson.ParseBytes(health)
 
 			blockHeight := pHealth.Get("block_height")
+			ledgerVersion := pHealth.Get("ledger_version")
 
-			if blockHeight.Exists() {
+			if blockHeight.Exists() && ledgerVersion.Exists() {
 				currentAptosHeight.Set(float64(blockHeight.Uint()))
-				p2p.DefaultRegistry.SetNetworkStats(vaa.ChainIDAptos, &gossipv1.Heartbeat_Network{
-					Height:          int64(blockHeight.Uint()),
+				currentAptosLedgerVersion.Set(float64(ledgerVersion.Uint()))
+				// Events are committed at a ledger version, and committed
+				// transactions are final.
+				e.SetNetworkStats(&gossipv1.Heartbeat_Network{
+					Height:          int64(ledgerVersion.Uint()),
+					FinalizedHeight: int64(ledgerVersion.Uint()),
 					ContractAddress: e.aptosAccount,
 				})
 
@@ -238,6 +246,94 @@ func (e *Watcher) Run(ctx context.Context) error {
 	}
 }
 
+// observeEvents observes a page of events of the events endpoint, ordered by sequence id (ASC), and returns the sequence
+// id to look up next. Events that were skipped since the previous page are backfilled first, so that a gap in the
+// sequence ids doesn't drop messages.
+func (e *Watcher) observeEvents(logger *zap.Logger, eventsEndpoint string, events []gjson.Result, nextSequence uint64) uint64 {
+	for _, event := range events {
+		eventSequence := event.Get("sequence_number")
+		if !eventSequence.Exists() {
+			continue
+		}
+
+		// nextSequence is 0 until the first event was seen
+		if nextSequence != 0 {
+			if eventSequence.Uint() < nextSequence {
+				// already observed
+				continue
+			}
+			if eventSequence.Uint() > nextSequence {
+				e.backfill(logger, eventsEndpoint, nextSequence, eventSequence.Uint())
+			}
+		}
+
+		// this is interesting in the last iteration, whereby we
+		// find the next sequence that comes after the array
+		nextSequence = eventSequence.Uint() + 1
+
+		data := event.Get("data")
+		if !data.Exists() {
+			continue
+		}
+		e.observeData(logger, data, eventSequence.Uint(), false)
+	}
+
+	return nextSequence
+}
+
+// backfill looks up and observes the events with sequence ids in [from, to). Events the endpoint doesn't return are
+// logged and counted as missed, they can still be re-observed.
+func (e *Watcher) backfill(logger *zap.Logger, eventsEndpoint string, from uint64, to uint64) {
+	logger.Warn("gap in the event sequence ids, backfilling", zap.Uint64("from", from), zap.Uint64("to", to))
+	aptosEventGaps.Inc()
+
+	for from < to {
+		limit := min(to-from, aptosMaxEventsPageSize)
+		body, err := e.retrievePayload(fmt.Sprintf(`%s?start=%d&limit=%d`, eventsEndpoint, from, limit))
+		if err != nil {
+			logger.Error("backfill retrievePayload", zap.Error(err))
+			e.AddErrorCount(1)
+			break
+		}
+
+		if !gjson.Valid(string(body)) {
+			logger.Error("InvalidJson: " + string(body))
+			e.AddErrorCount(1)
+			break
+		}
+
+		next := from
+		for _, event := range gjson.ParseBytes(body).Array() {
+			eventSequence := event.Get("sequence_number")
+			if !eventSequence.Exists() || eventSequence.Uint() < next || eventSequence.Uint() >= to {
+				continue
+			}
+			if eventSequence.Uint() > next {
+				logger.Error("events missing from the backfill", zap.Uint64("from", next), zap.Uint64("to", eventSequence.Uint()))
+				aptosEventsMissed.Add(float64(eventSequence.Uint() - next))
+			}
+			next = eventSequence.Uint() + 1
+
+			data := event.Get("data")
+			if !data.Exists() {
+				continue
+			}
+			e.observeData(logger, data, eventSequence.Uint(), false)
+		}
+
+		// no progress, the rest of the gap is missing
+		if next == from {
+			break
+		}
+		from = next
+	}
+
+	if from < to {
+		logger.Error("events missing from the backfill", zap.Uint64("from", from), zap.Uint64("to", to))
+		aptosEventsMissed.Add(float64(to - from))
+	}
+}
+
 func (e *Watcher) retrievePayload(s string) ([]byte, error) {
 	res, err := http.Get(s) // nolint
 	if err != nil {
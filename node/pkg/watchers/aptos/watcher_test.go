@@ -0,0 +1,73 @@
+package aptos
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+)
+
+func testEvent(seq uint64) string {
+	return fmt.Sprintf(`{"sequence_number":"%d","data":{"sender":"1","payload":"0x01","timestamp":"1","nonce":"0","sequence":"%d","consistency_level":"0"}}`, seq, seq)
+}
+
+// newTestEventsServer serves the events with the given sequence ids from the events endpoint
+func newTestEventsServer(t *testing.T, available map[uint64]bool) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start, err := strconv.ParseUint(r.URL.Query().Get("start"), 10, 64)
+		require.NoError(t, err)
+		limit, err := strconv.ParseUint(r.URL.Query().Get("limit"), 10, 64)
+		require.NoError(t, err)
+
+		var events []string
+		for seq := start; seq < start+limit; seq++ {
+			if available[seq] {
+				events = append(events, testEvent(seq))
+			}
+		}
+		fmt.Fprintf(w, "[%s]", strings.Join(events, ","))
+	}))
+}
+
+func observedSequences(msgC chan *common.MessagePublication) []uint64 {
+	var sequences []uint64
+	for len(msgC) > 0 {
+		sequences = append(sequences, (<-msgC).Sequence)
+	}
+	return sequences
+}
+
+func TestObserveEventsBackfillsGaps(t *testing.T) {
+	server := newTestEventsServer(t, map[uint64]bool{5: true, 6: true, 8: true})
+	defer server.Close()
+
+	msgC := make(chan *common.MessagePublication, 10)
+	w := NewWatcher(server.URL, "0x1", "0x1::state::WormholeMessageHandle", msgC, make(chan *gossipv1.ObservationRequest))
+	logger := zap.NewNop()
+
+	page := gjson.Parse(fmt.Sprintf("[%s,%s]", testEvent(9), testEvent(10))).Array()
+
+	// 5 to 8 are backfilled before 9 and 10, 7 is missing
+	next := w.observeEvents(logger, server.URL, page, 5)
+	assert.Equal(t, uint64(11), next)
+	assert.Equal(t, []uint64{5, 6, 8, 9, 10}, observedSequences(msgC))
+
+	// events that were already observed are skipped
+	next = w.observeEvents(logger, server.URL, page, 11)
+	assert.Equal(t, uint64(11), next)
+	assert.Empty(t, observedSequences(msgC))
+
+	// nothing is backfilled before the first event
+	next = w.observeEvents(logger, server.URL, page[1:], 0)
+	assert.Equal(t, uint64(11), next)
+	assert.Equal(t, []uint64{10}, observedSequences(msgC))
+}
@@ -1,10 +1,11 @@
 package cosmwasm
 
 import (
+	"fmt"
+
 	"github.com/certusone/wormhole/node/pkg/common"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/certusone/wormhole/node/pkg/query"
-	"github.com/certusone/wormhole/node/pkg/supervisor"
 	"github.com/certusone/wormhole/node/pkg/watchers"
 	"github.com/certusone/wormhole/node/pkg/watchers/interfaces"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
@@ -16,6 +17,54 @@ type WatcherConfig struct {
 	Websocket string             // Websocket URL
 	Lcd       string             // LCD
 	Contract  string             // hex representation of the contract address
+	Events    *EventMapping      // optional, defaults to DefaultEventMapping of the chain
+}
+
+// EventMapping describes how a chain reports the events of the core contract. It depends on the wasmd and Tendermint
+// versions of the chain.
+type EventMapping struct {
+	// Event attribute the websocket subscription filters on for the core contract address.
+	ContractAddressFilterKey string `json:"contractAddressFilterKey" yaml:"contractAddressFilterKey"`
+	// Attribute of the wasm events that holds the address of the emitting contract.
+	ContractAddressLogKey string `json:"contractAddressLogKey" yaml:"contractAddressLogKey"`
+	// Whether the event attributes are base64 encoded.
+	B64Encoded bool `json:"b64Encoded" yaml:"b64Encoded"`
+	// Optional attribute that holds the address of the emitting contract in re-observed transactions before
+	// LegacyHeight, for chains that renamed it in an upgrade.
+	LegacyContractAddressLogKey string `json:"legacyContractAddressLogKey,omitempty" yaml:"legacyContractAddressLogKey,omitempty"`
+	LegacyHeight                int64  `json:"legacyHeight,omitempty" yaml:"legacyHeight,omitempty"`
+}
+
+// DefaultEventMapping returns the event mapping of the CosmWasm chains the node knows.
+func DefaultEventMapping(chainID vaa.ChainID, env common.Environment) EventMapping {
+	events := EventMapping{
+		// CosmWasm 1.0.0
+		ContractAddressFilterKey: "execute._contract_address",
+		ContractAddressLogKey:    "_contract_address",
+		// Injective does not base64 encode parameters (as of release v1.11.2).
+		// Terra does not base64 encode parameters (as of v3.0.1 software upgrade)
+		// Terra2 no longer base64 encodes parameters.
+		B64Encoded: env == common.UnsafeDevNet || (chainID != vaa.ChainIDInjective && chainID != vaa.ChainIDTerra2 && chainID != vaa.ChainIDTerra),
+	}
+	if chainID == vaa.ChainIDTerra {
+		// Terra Classic upgraded WASM versions starting at block 13215800.
+		events.LegacyContractAddressLogKey = "contract_address"
+		events.LegacyHeight = 13215800
+	}
+	return events
+}
+
+func (m *EventMapping) validate() error {
+	if m.ContractAddressFilterKey == "" {
+		return fmt.Errorf("contractAddressFilterKey is required")
+	}
+	if m.ContractAddressLogKey == "" {
+		return fmt.Errorf("contractAddressLogKey is required")
+	}
+	if (m.LegacyContractAddressLogKey == "") != (m.LegacyHeight == 0) {
+		return fmt.Errorf("legacyContractAddressLogKey and legacyHeight must be set together")
+	}
+	return nil
 }
 
 func (wc *WatcherConfig) GetNetworkID() watchers.NetworkID {
@@ -36,11 +85,16 @@ func (wc *WatcherConfig) SetL1Finalizer(l1finalizer interfaces.L1Finalizer) {
 
 func (wc *WatcherConfig) Create(
 	msgC chan<- *common.MessagePublication,
-	obsvReqC <-chan *gossipv1.ObservationRequest,
+	obsvReqC chan *gossipv1.ObservationRequest,
 	_ <-chan *query.PerChainQueryInternal,
 	_ chan<- *query.PerChainQueryResponseInternal,
 	_ chan<- *common.GuardianSet,
 	env common.Environment,
-) (interfaces.L1Finalizer, supervisor.Runnable, error) {
-	return nil, NewWatcher(wc.Websocket, wc.Lcd, wc.Contract, msgC, obsvReqC, wc.ChainID, env).Run, nil
+) (interfaces.L1Finalizer, watchers.Watcher, error) {
+	events := DefaultEventMapping(wc.ChainID, env)
+	if wc.Events != nil {
+		events = *wc.Events
+	}
+
+	return nil, NewWatcher(wc.Websocket, wc.Lcd, wc.Contract, msgC, obsvReqC, wc.ChainID, events), nil
 }
@@ -0,0 +1,118 @@
+package cosmwasm
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/watchers"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"gopkg.in/yaml.v3"
+)
+
+// ChainRegistryEntry declares a CosmWasm chain to watch in the chain registry file.
+type ChainRegistryEntry struct {
+	// Human readable name of the watcher, e.g. "terra2". Must be unique.
+	NetworkID string `json:"networkId" yaml:"networkId"`
+	// Wormhole chain ID of the chain.
+	ChainID uint16 `json:"chainId" yaml:"chainId"`
+	// Tendermint RPC websocket URL.
+	Websocket string `json:"websocket" yaml:"websocket"`
+	// LCD URL.
+	Lcd string `json:"lcd" yaml:"lcd"`
+	// Bech32 address of the core contract.
+	Contract string `json:"contract" yaml:"contract"`
+	// Optional event mapping of the chain. Defaults to DefaultEventMapping of the chain.
+	Events *EventMapping `json:"events,omitempty" yaml:"events,omitempty"`
+}
+
+// ChainRegistry is a file of CosmWasm chain declarations. It lets operators add CosmWasm chains the SDK already knows,
+// and the way they report events, without adding flags and watcher setup code to the node. The file is JSON, or YAML
+// if its name ends in .yaml or .yml.
+type ChainRegistry struct {
+	entries []ChainRegistryEntry
+}
+
+// LoadChainRegistry reads and validates a chain registry file.
+func LoadChainRegistry(path string) (*ChainRegistry, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read chain registry: %w", err)
+	}
+
+	var entries []ChainRegistryEntry
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(bz, &entries)
+	default:
+		err = json.Unmarshal(bz, &entries)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse chain registry: %w", err)
+	}
+
+	networkIDs := make(map[string]struct{})
+	chainIDs := make(map[uint16]struct{})
+	for _, entry := range entries {
+		if err := entry.validate(); err != nil {
+			return nil, fmt.Errorf("invalid chain registry entry %q: %w", entry.NetworkID, err)
+		}
+		if _, exists := networkIDs[entry.NetworkID]; exists {
+			return nil, fmt.Errorf("duplicate network ID in chain registry: %s", entry.NetworkID)
+		}
+		if _, exists := chainIDs[entry.ChainID]; exists {
+			return nil, fmt.Errorf("duplicate chain ID in chain registry: %d", entry.ChainID)
+		}
+		networkIDs[entry.NetworkID] = struct{}{}
+		chainIDs[entry.ChainID] = struct{}{}
+	}
+
+	return &ChainRegistry{entries: entries}, nil
+}
+
+func (e *ChainRegistryEntry) validate() error {
+	if e.NetworkID == "" {
+		return fmt.Errorf("networkId is required")
+	}
+	chainID := vaa.ChainID(e.ChainID)
+	if _, err := common.ConvertChainIdToReadinessSyncing(chainID); err != nil {
+		return fmt.Errorf("chain %d is not known to the SDK", e.ChainID)
+	}
+	if vaa.IsEVMChain(chainID) || chainID == vaa.ChainIDWormchain {
+		return fmt.Errorf("chain %d is not a CosmWasm chain", e.ChainID)
+	}
+	if e.Websocket == "" {
+		return fmt.Errorf("websocket is required")
+	}
+	if e.Lcd == "" {
+		return fmt.Errorf("lcd is required")
+	}
+	if e.Contract == "" {
+		return fmt.Errorf("contract is required")
+	}
+	if e.Events != nil {
+		if err := e.Events.validate(); err != nil {
+			return fmt.Errorf("invalid events: %w", err)
+		}
+	}
+	return nil
+}
+
+// WatcherConfigs returns the watcher configs of the chains in the registry.
+func (r *ChainRegistry) WatcherConfigs() []watchers.WatcherConfig {
+	wcs := make([]watchers.WatcherConfig, 0, len(r.entries))
+	for _, entry := range r.entries {
+		wcs = append(wcs, &WatcherConfig{
+			NetworkID: watchers.NetworkID(entry.NetworkID),
+			ChainID:   vaa.ChainID(entry.ChainID),
+			Websocket: entry.Websocket,
+			Lcd:       entry.Lcd,
+			Contract:  entry.Contract,
+			Events:    entry.Events,
+		})
+	}
+	return wcs
+}
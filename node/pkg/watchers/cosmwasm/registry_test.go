@@ -0,0 +1,115 @@
+package cosmwasm
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/watchers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+const testRegistryJSON = `[
+	{"networkId": "xpla", "chainId": 28, "websocket": "ws://xpla:26657/websocket", "lcd": "http://xpla:1317", "contract": "xpla1core"},
+	{"networkId": "injective", "chainId": 19, "websocket": "ws://injective:26657/websocket", "lcd": "http://injective:10337", "contract": "inj1core",
+		"events": {"contractAddressFilterKey": "wasm._contract_address", "contractAddressLogKey": "contract_address", "b64Encoded": false}}
+]`
+
+const testRegistryYAML = `
+- networkId: xpla
+  chainId: 28
+  websocket: ws://xpla:26657/websocket
+  lcd: http://xpla:1317
+  contract: xpla1core
+- networkId: injective
+  chainId: 19
+  websocket: ws://injective:26657/websocket
+  lcd: http://injective:10337
+  contract: inj1core
+  events:
+    contractAddressFilterKey: wasm._contract_address
+    contractAddressLogKey: contract_address
+    b64Encoded: false
+`
+
+func writeRegistry(t *testing.T, name string, contents string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0600))
+	return path
+}
+
+func TestLoadChainRegistry(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		contents string
+	}{
+		{"registry.json", testRegistryJSON},
+		{"registry.yaml", testRegistryYAML},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			registry, err := LoadChainRegistry(writeRegistry(t, tc.name, tc.contents))
+			require.NoError(t, err)
+
+			wcs := registry.WatcherConfigs()
+			require.Len(t, wcs, 2)
+			xpla := wcs[0].(*WatcherConfig)
+			assert.Equal(t, watchers.NetworkID("xpla"), xpla.NetworkID)
+			assert.Equal(t, vaa.ChainIDXpla, xpla.ChainID)
+			assert.Equal(t, "ws://xpla:26657/websocket", xpla.Websocket)
+			assert.Equal(t, "http://xpla:1317", xpla.Lcd)
+			assert.Equal(t, "xpla1core", xpla.Contract)
+			assert.Nil(t, xpla.Events)
+
+			injective := wcs[1].(*WatcherConfig)
+			assert.Equal(t, vaa.ChainIDInjective, injective.ChainID)
+			assert.Equal(t, &EventMapping{
+				ContractAddressFilterKey: "wasm._contract_address",
+				ContractAddressLogKey:    "contract_address",
+				B64Encoded:               false,
+			}, injective.Events)
+		})
+	}
+}
+
+func TestLoadChainRegistryInvalid(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		contents string
+	}{
+		{"missing network ID", `[{"chainId": 28, "websocket": "ws://xpla", "lcd": "http://xpla", "contract": "xpla1core"}]`},
+		{"unknown chain", `[{"networkId": "x", "chainId": 65000, "websocket": "ws://xpla", "lcd": "http://xpla", "contract": "xpla1core"}]`},
+		{"EVM chain", `[{"networkId": "eth", "chainId": 2, "websocket": "ws://eth", "lcd": "http://eth", "contract": "0x1"}]`},
+		{"missing lcd", `[{"networkId": "xpla", "chainId": 28, "websocket": "ws://xpla", "contract": "xpla1core"}]`},
+		{"missing contract address key", `[{"networkId": "xpla", "chainId": 28, "websocket": "ws://xpla", "lcd": "http://xpla", "contract": "xpla1core",
+			"events": {"contractAddressFilterKey": "execute._contract_address"}}]`},
+		{"legacy key without height", `[{"networkId": "xpla", "chainId": 28, "websocket": "ws://xpla", "lcd": "http://xpla", "contract": "xpla1core",
+			"events": {"contractAddressFilterKey": "execute._contract_address", "contractAddressLogKey": "_contract_address", "legacyContractAddressLogKey": "contract_address"}}]`},
+		{"duplicate chain", `[{"networkId": "xpla", "chainId": 28, "websocket": "ws://xpla", "lcd": "http://xpla", "contract": "xpla1core"},
+			{"networkId": "xpla2", "chainId": 28, "websocket": "ws://xpla", "lcd": "http://xpla", "contract": "xpla1core"}]`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := LoadChainRegistry(writeRegistry(t, "registry.json", tc.contents))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestDefaultEventMapping(t *testing.T) {
+	terra := DefaultEventMapping(vaa.ChainIDTerra, common.MainNet)
+	assert.False(t, terra.B64Encoded)
+	assert.Equal(t, "contract_address", terra.LegacyContractAddressLogKey)
+	assert.Equal(t, int64(13215800), terra.LegacyHeight)
+	require.NoError(t, terra.validate())
+
+	xpla := DefaultEventMapping(vaa.ChainIDXpla, common.MainNet)
+	assert.True(t, xpla.B64Encoded)
+	assert.Equal(t, "execute._contract_address", xpla.ContractAddressFilterKey)
+	assert.Equal(t, "_contract_address", xpla.ContractAddressLogKey)
+	assert.Zero(t, xpla.LegacyHeight)
+
+	// the devnet base64 encodes the attributes on every chain
+	assert.True(t, DefaultEventMapping(vaa.ChainIDInjective, common.UnsafeDevNet).B64Encoded)
+}
@@ -10,7 +10,6 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/certusone/wormhole/node/pkg/p2p"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 
@@ -21,6 +20,7 @@ import (
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/readiness"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/certusone/wormhole/node/pkg/watchers"
 
 	"github.com/tidwall/gjson"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
@@ -37,6 +37,8 @@ const ReadLimitSize = 524288
 type (
 	// Watcher is responsible for looking over a cosmwasm blockchain and reporting new transactions to the contract
 	Watcher struct {
+		*watchers.Base
+
 		urlWS    string
 		urlLCD   string
 		contract string
@@ -51,16 +53,11 @@ type (
 		readinessSync readiness.Component
 		// VAA ChainID of the network we're connecting to.
 		chainID vaa.ChainID
-		// Key for contract address in the wasm logs
-		contractAddressFilterKey string
-		// Key for contract address in the wasm logs
-		contractAddressLogKey string
+		// How the chain reports the events of the core contract
+		events EventMapping
 
 		// URL to get the latest block info from
 		latestBlockURL string
-
-		// b64Encoded indicates if transactions are base 64 encoded.
-		b64Encoded bool
 	}
 )
 
@@ -104,42 +101,32 @@ func NewWatcher(
 	urlLCD string,
 	contract string,
 	msgC chan<- *common.MessagePublication,
-	obsvReqC <-chan *gossipv1.ObservationRequest,
+	obsvReqC chan *gossipv1.ObservationRequest,
 	chainID vaa.ChainID,
-	env common.Environment,
+	events EventMapping,
 ) *Watcher {
 
-	// CosmWasm 1.0.0
-	contractAddressFilterKey := "execute._contract_address"
-	contractAddressLogKey := "_contract_address"
-
 	// Do not add a leading slash
 	latestBlockURL := "cosmos/base/tendermint/v1beta1/blocks/latest"
 
-	// Injective does not base64 encode parameters (as of release v1.11.2).
-	// Terra does not base64 encode parameters (as of v3.0.1 software upgrade)
-	// Terra2 no longer base64 encodes parameters.
-	b64Encoded := env == common.UnsafeDevNet || (chainID != vaa.ChainIDInjective && chainID != vaa.ChainIDTerra2 && chainID != vaa.ChainIDTerra)
-
 	return &Watcher{
-		urlWS:                    urlWS,
-		urlLCD:                   urlLCD,
-		contract:                 contract,
-		msgC:                     msgC,
-		obsvReqC:                 obsvReqC,
-		readinessSync:            common.MustConvertChainIdToReadinessSyncing(chainID),
-		chainID:                  chainID,
-		contractAddressFilterKey: contractAddressFilterKey,
-		contractAddressLogKey:    contractAddressLogKey,
-		latestBlockURL:           latestBlockURL,
-		b64Encoded:               b64Encoded,
+		Base:           watchers.NewBase(chainID, watchers.VersionCosmwasm, msgC, obsvReqC),
+		urlWS:          urlWS,
+		urlLCD:         urlLCD,
+		contract:       contract,
+		msgC:           msgC,
+		obsvReqC:       obsvReqC,
+		readinessSync:  common.MustConvertChainIdToReadinessSyncing(chainID),
+		chainID:        chainID,
+		events:         events,
+		latestBlockURL: latestBlockURL,
 	}
 }
 
 func (e *Watcher) Run(ctx context.Context) error {
 	networkName := e.chainID.String()
 
-	p2p.DefaultRegistry.SetNetworkStats(e.chainID, &gossipv1.Heartbeat_Network{
+	e.SetNetworkStats(&gossipv1.Heartbeat_Network{
 		ContractAddress: e.contract,
 	})
 
@@ -158,7 +145,7 @@ func (e *Watcher) Run(ctx context.Context) error {
 
 	c, _, err := websocket.Dial(ctx, e.urlWS, nil)
 	if err != nil {
-		p2p.DefaultRegistry.AddErrorCount(e.chainID, 1)
+		e.AddErrorCount(1)
 		connectionErrors.WithLabelValues(networkName, "websocket_dial_error").Inc()
 		return fmt.Errorf("websocket dial failed: %w", err)
 	}
@@ -167,7 +154,7 @@ func (e *Watcher) Run(ctx context.Context) error {
 	c.SetReadLimit(ReadLimitSize)
 
 	// Subscribe to smart contract transactions
-	params := [...]string{fmt.Sprintf("tm.event='Tx' AND %s='%s'", e.contractAddressFilterKey, e.contract)}
+	params := [...]string{fmt.Sprintf("tm.event='Tx' AND %s='%s'", e.events.ContractAddressFilterKey, e.contract)}
 	command := &clientRequest{
 		JSONRPC: "2.0",
 		Method:  "subscribe",
@@ -176,7 +163,7 @@ func (e *Watcher) Run(ctx context.Context) error {
 	}
 	err = wsjson.Write(ctx, c, command)
 	if err != nil {
-		p2p.DefaultRegistry.AddErrorCount(e.chainID, 1)
+		e.AddErrorCount(1)
 		connectionErrors.WithLabelValues(networkName, "websocket_subscription_error").Inc()
 		return fmt.Errorf("websocket subscription failed: %w", err)
 	}
@@ -184,7 +171,7 @@ func (e *Watcher) Run(ctx context.Context) error {
 	// Wait for the success response
 	_, _, err = c.Read(ctx)
 	if err != nil {
-		p2p.DefaultRegistry.AddErrorCount(e.chainID, 1)
+		e.AddErrorCount(1)
 		connectionErrors.WithLabelValues(networkName, "event_subscription_error").Inc()
 		return fmt.Errorf("event subscription failed: %w", err)
 	}
@@ -226,8 +213,9 @@ func (e *Watcher) Run(ctx context.Context) error {
 				latestBlock := gjson.Get(blockJSON, "block.header.height")
 				logger.Debug("current height", zap.String("network", networkName), zap.Int64("block", latestBlock.Int()))
 				currentSlotHeight.WithLabelValues(networkName).Set(float64(latestBlock.Int()))
-				p2p.DefaultRegistry.SetNetworkStats(e.chainID, &gossipv1.Heartbeat_Network{
+				e.SetNetworkStats(&gossipv1.Heartbeat_Network{
 					Height:          latestBlock.Int(),
+					FinalizedHeight: latestBlock.Int(),
 					ContractAddress: e.contract,
 				})
 
@@ -283,22 +271,22 @@ func (e *Watcher) Run(ctx context.Context) error {
 					continue
 				}
 
-				contractAddressLogKey := e.contractAddressLogKey
-				if e.chainID == vaa.ChainIDTerra {
-					// Terra Classic upgraded WASM versions starting at block 13215800. If this transaction is from before that, we need to use the old contract address format.
+				contractAddressLogKey := e.events.ContractAddressLogKey
+				if e.events.LegacyHeight != 0 {
+					// If this transaction is from before the chain renamed the contract address attribute, we need to use the old one.
 					blockHeightStr := gjson.Get(txJSON, "tx_response.height")
 					if !blockHeightStr.Exists() {
 						logger.Error("failed to look up block height on old reobserved tx", zap.String("network", networkName), zap.String("txHash", txHash), zap.String("payload", txJSON))
 						continue
 					}
 					blockHeight := blockHeightStr.Int()
-					if blockHeight < 13215800 {
+					if blockHeight < e.events.LegacyHeight {
 						logger.Info("doing look up of old tx", zap.String("network", networkName), zap.String("txHash", txHash), zap.Int64("blockHeight", blockHeight))
-						contractAddressLogKey = "contract_address"
+						contractAddressLogKey = e.events.LegacyContractAddressLogKey
 					}
 				}
 
-				msgs := EventsToMessagePublications(e.contract, txHash, events.Array(), logger, e.chainID, contractAddressLogKey, e.b64Encoded)
+				msgs := EventsToMessagePublications(e.contract, txHash, events.Array(), logger, e.chainID, contractAddressLogKey, e.events.B64Encoded)
 				for _, msg := range msgs {
 					msg.IsReobservation = true
 					e.msgC <- msg
@@ -316,7 +304,7 @@ func (e *Watcher) Run(ctx context.Context) error {
 			default:
 				_, message, err := c.Read(ctx)
 				if err != nil {
-					p2p.DefaultRegistry.AddErrorCount(e.chainID, 1)
+					e.AddErrorCount(1)
 					connectionErrors.WithLabelValues(networkName, "channel_read_error").Inc()
 					logger.Error("error reading channel", zap.String("network", networkName), zap.Error(err))
 					errC <- err
@@ -339,7 +327,7 @@ func (e *Watcher) Run(ctx context.Context) error {
 					continue
 				}
 
-				msgs := EventsToMessagePublications(e.contract, txHash, events.Array(), logger, e.chainID, e.contractAddressLogKey, e.b64Encoded)
+				msgs := EventsToMessagePublications(e.contract, txHash, events.Array(), logger, e.chainID, e.events.ContractAddressLogKey, e.events.B64Encoded)
 				for _, msg := range msgs {
 					e.msgC <- msg
 					messagesConfirmed.WithLabelValues(networkName).Inc()
@@ -57,6 +57,8 @@ func (w *Watcher) QueryHandler(ctx context.Context, queryRequest *query.PerChain
 		w.ccqHandleEthCallByTimestampQueryRequest(ctx, queryRequest, req)
 	case *query.EthCallWithFinalityQueryRequest:
 		w.ccqHandleEthCallWithFinalityQueryRequest(ctx, queryRequest, req)
+	case *query.EthGetStorageAtQueryRequest:
+		w.ccqHandleEthGetStorageAtQueryRequest(ctx, queryRequest, req)
 	default:
 		w.ccqLogger.Warn("received unsupported request type",
 			zap.Uint8("payload", uint8(queryRequest.Request.Query.Type())),
@@ -627,6 +629,119 @@ func (w *Watcher) ccqHandleEthCallWithFinalityQueryRequest(ctx context.Context,
 	w.ccqSendQueryResponse(queryRequest, query.QuerySuccess, &resp)
 }
 
+// ccqHandleEthGetStorageAtQueryRequest is the query handler for an eth_get_storage_at request.
+func (w *Watcher) ccqHandleEthGetStorageAtQueryRequest(ctx context.Context, queryRequest *query.PerChainQueryInternal, req *query.EthGetStorageAtQueryRequest) {
+	requestId := "eth_get_storage_at:" + queryRequest.ID()
+	block := req.BlockId
+	w.ccqLogger.Info("received eth_get_storage_at query request",
+		zap.String("requestId", requestId),
+		zap.String("block", block),
+		zap.Int("numRequests", len(req.Slots)),
+	)
+
+	// Create the block query args.
+	blockMethod, callBlockArg, err := ccqCreateBlockRequest(block)
+	if err != nil {
+		w.ccqLogger.Info("invalid block id in eth_get_storage_at query request",
+			zap.String("requestId", requestId),
+			zap.String("block", block),
+			zap.Error(err),
+		)
+		w.ccqSendQueryResponse(queryRequest, query.QueryFatalError, nil)
+		return
+	}
+
+	// Create the batch of requested storage reads for the specified block.
+	batch := []rpc.BatchElem{}
+	storageResults := make([]eth_common.Hash, len(req.Slots))
+	for idx, slot := range req.Slots {
+		batch = append(batch, rpc.BatchElem{
+			Method: "eth_getStorageAt",
+			Args: []interface{}{
+				eth_common.BytesToAddress(slot.Address),
+				eth_common.BytesToHash(slot.Slot),
+				callBlockArg,
+			},
+			Result: &storageResults[idx],
+		})
+	}
+
+	// Add the block query to the batch.
+	var blockResult connectors.BlockMarshaller
+	batch = append(batch, rpc.BatchElem{
+		Method: blockMethod,
+		Args: []interface{}{
+			block,
+			false, // no full transaction details
+		},
+		Result: &blockResult,
+	})
+
+	// Query the RPC.
+	start := time.Now()
+	timeout, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	err = w.ethConn.RawBatchCallContext(timeout, batch)
+	if err != nil {
+		w.ccqLogger.Info("failed to process eth_get_storage_at query request",
+			zap.String("requestId", requestId),
+			zap.String("block", block),
+			zap.Any("batch", batch),
+			zap.Error(err),
+		)
+		w.ccqSendQueryResponse(queryRequest, query.QueryRetryNeeded, nil)
+		return
+	}
+
+	// Verify that the block read was successful.
+	if err := w.ccqVerifyBlockResult(batch[len(batch)-1].Error, blockResult); err != nil {
+		w.ccqLogger.Debug("failed to verify block for eth_get_storage_at query",
+			zap.String("requestId", requestId),
+			zap.String("block", block),
+			zap.Any("batch", batch),
+			zap.Error(err),
+		)
+		w.ccqSendQueryResponse(queryRequest, query.QueryRetryNeeded, nil)
+		return
+	}
+
+	// Verify all the storage reads and build the batch of results.
+	results := [][]byte{}
+	for idx := range req.Slots {
+		if batch[idx].Error != nil {
+			w.ccqLogger.Info("failed to process eth_get_storage_at query storage request",
+				zap.String("requestId", requestId),
+				zap.String("block", block),
+				zap.Int("idx", idx),
+				zap.Error(batch[idx].Error),
+			)
+			w.ccqSendQueryResponse(queryRequest, query.QueryRetryNeeded, nil)
+			return
+		}
+
+		results = append(results, storageResults[idx].Bytes())
+	}
+
+	w.ccqLogger.Info("query complete for eth_get_storage_at",
+		zap.String("requestId", requestId),
+		zap.String("block", block),
+		zap.String("blockNumber", blockResult.Number.String()),
+		zap.String("blockHash", blockResult.Hash.Hex()),
+		zap.String("blockTime", blockResult.Time.String()),
+		zap.Int64("duration", time.Since(start).Milliseconds()),
+	)
+
+	// Finally, build the response and publish it.
+	resp := query.EthGetStorageAtQueryResponse{
+		BlockNumber: blockResult.Number.ToInt().Uint64(),
+		Hash:        blockResult.Hash,
+		Time:        time.Unix(int64(blockResult.Time), 0),
+		Results:     results,
+	}
+
+	w.ccqSendQueryResponse(queryRequest, query.QuerySuccess, &resp)
+}
+
 // ccqCreateBlockRequest creates a block query. It parses the block string, allowing for both a block number or a block hash. Note that for now, strings like "latest", "finalized" or "safe"
 // are not supported, and the block must be a hex string starting with 0x. The determination of whether it is a block number or a block hash is based on the overall length of the string,
 // since a hash is 32 bytes (64 hex digits).
@@ -1,10 +1,15 @@
 package evm
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/finality"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/certusone/wormhole/node/pkg/query"
-	"github.com/certusone/wormhole/node/pkg/supervisor"
 	"github.com/certusone/wormhole/node/pkg/watchers"
 	"github.com/certusone/wormhole/node/pkg/watchers/interfaces"
 	eth_common "github.com/ethereum/go-ethereum/common"
@@ -15,11 +20,19 @@ type WatcherConfig struct {
 	NetworkID              watchers.NetworkID // human readable name
 	ChainID                vaa.ChainID        // ChainID
 	Rpc                    string             // RPC URL
+	PollRpc                string             // (optional) HTTP RPC URL polled while the websocket RPC is unavailable
+	BackupRpcs             []string           // (optional) RPC URLs to fail over to, also checked for consistent finalized blocks
 	Contract               string             // hex representation of the contract address
 	GuardianSetUpdateChain bool               // if `true`, we will retrieve the GuardianSet from this chain and watch this chain for GuardianSet updates
 	L1FinalizerRequired    watchers.NetworkID // (optional)
 	l1Finalizer            interfaces.L1Finalizer
 	CcqBackfillCache       bool
+	Finality               finality.Strategy // (optional) overrides the default finality strategy of the chain
+	PollInterval           time.Duration     // (optional) interval to poll for finalized and safe blocks
+	TransferVerifier       bool              // (optional) if `true`, token bridge transfers are verified against the deposits in their transaction
+
+	// reloadC delivers new connection settings from the chain registry. It is nil for watchers configured by flags.
+	reloadC chan *WatcherConfig
 }
 
 func (wc *WatcherConfig) GetNetworkID() watchers.NetworkID {
@@ -40,12 +53,12 @@ func (wc *WatcherConfig) SetL1Finalizer(l1finalizer interfaces.L1Finalizer) {
 
 func (wc *WatcherConfig) Create(
 	msgC chan<- *common.MessagePublication,
-	obsvReqC <-chan *gossipv1.ObservationRequest,
+	obsvReqC chan *gossipv1.ObservationRequest,
 	queryReqC <-chan *query.PerChainQueryInternal,
 	queryResponseC chan<- *query.PerChainQueryResponseInternal,
 	setC chan<- *common.GuardianSet,
 	env common.Environment,
-) (interfaces.L1Finalizer, supervisor.Runnable, error) {
+) (interfaces.L1Finalizer, watchers.Watcher, error) {
 
 	// only actually use the guardian set channel if wc.GuardianSetUpdateChain == true
 	var setWriteC chan<- *common.GuardianSet = nil
@@ -57,5 +70,50 @@ func (wc *WatcherConfig) Create(
 
 	watcher := NewEthWatcher(wc.Rpc, eth_common.HexToAddress(wc.Contract), string(wc.NetworkID), wc.ChainID, msgC, setWriteC, obsvReqC, queryReqC, queryResponseC, devMode, wc.CcqBackfillCache)
 	watcher.SetL1Finalizer(wc.l1Finalizer)
-	return watcher, watcher.Run, nil
+	watcher.finalityOverride = wc.Finality
+	watcher.setEndpoints(wc.Rpc, wc.PollRpc, wc.BackupRpcs)
+	if wc.PollInterval != 0 {
+		watcher.SetPollInterval(wc.PollInterval)
+	}
+	watcher.reloadC = wc.reloadC
+	if wc.TransferVerifier {
+		tv, err := newTransferVerifier(wc.ChainID, eth_common.HexToAddress(wc.Contract), env)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create transfer verifier: %w", err)
+		}
+		watcher.transferVerifier = tv
+	}
+	return watcher, watcher, nil
+}
+
+// ParseTransferVerifierChains parses the chain names or IDs for which transfer verification is enabled.
+func ParseTransferVerifierChains(values []string) (map[vaa.ChainID]struct{}, error) {
+	chains := make(map[vaa.ChainID]struct{}, len(values))
+	for _, value := range values {
+		var chainID vaa.ChainID
+		var err error
+		if n, parseErr := strconv.ParseUint(value, 10, 16); parseErr == nil {
+			chainID, err = vaa.ChainIDFromNumber(n)
+		} else {
+			chainID, err = vaa.ChainIDFromString(value)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid transfer verifier chain %q: %w", value, err)
+		}
+		chains[chainID] = struct{}{}
+	}
+	return chains, nil
+}
+
+// ParseBackupRpcs parses backup RPC flags of the form <networkId>=<url> into the backup RPC URLs of each network.
+func ParseBackupRpcs(values []string) (map[watchers.NetworkID][]string, error) {
+	backupRpcs := make(map[watchers.NetworkID][]string)
+	for _, value := range values {
+		networkID, rpcUrl, found := strings.Cut(value, "=")
+		if !found || networkID == "" || rpcUrl == "" {
+			return nil, fmt.Errorf("invalid backup RPC %q, expected <networkId>=<url>", value)
+		}
+		backupRpcs[watchers.NetworkID(networkID)] = append(backupRpcs[watchers.NetworkID(networkID)], rpcUrl)
+	}
+	return backupRpcs, nil
 }
@@ -0,0 +1,99 @@
+package connectors
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"go.uber.org/zap"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// DepthFinalityConnector is used for chains that consider a block final once a fixed number of blocks were produced on
+// top of it. It uses the standard geth head sink to read blocks and publishes each block as latest. Once a block is
+// depth blocks deep, it publishes it as safe and finalized.
+type DepthFinalityConnector struct {
+	Connector
+	logger *zap.Logger
+	depth  uint64
+}
+
+func NewDepthFinalityConnector(baseConnector Connector, logger *zap.Logger, depth uint64) (*DepthFinalityConnector, error) {
+	if depth == 0 {
+		return nil, fmt.Errorf("finality depth must be positive")
+	}
+	connector := &DepthFinalityConnector{
+		Connector: baseConnector,
+		logger:    logger,
+		depth:     depth,
+	}
+	return connector, nil
+}
+
+func (c *DepthFinalityConnector) SubscribeForBlocks(ctx context.Context, errC chan error, sink chan<- *NewBlock) (ethereum.Subscription, error) {
+	headSink := make(chan *ethTypes.Header, 2)
+	headerSubscription, err := c.Connector.SubscribeNewHead(ctx, headSink)
+	if err != nil {
+		return nil, err
+	}
+
+	common.RunWithScissors(ctx, errC, "eth_depth_connector_subscribe_for_block", func(ctx context.Context) error {
+		var lastFinalized *big.Int
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case ev := <-headSink:
+				if ev == nil {
+					c.logger.Error("new header event is nil")
+					continue
+				}
+				if ev.Number == nil {
+					c.logger.Error("new header block number is nil")
+					continue
+				}
+				sink <- &NewBlock{
+					Number:   ev.Number,
+					Time:     ev.Time,
+					Hash:     ev.Hash(),
+					Finality: Latest,
+				}
+
+				if ev.Number.Uint64() < c.depth {
+					continue
+				}
+				number := new(big.Int).Sub(ev.Number, new(big.Int).SetUint64(c.depth))
+				// Blocks may be skipped or repeated after a reorg, only ever move the finalized block forward.
+				if lastFinalized != nil && number.Cmp(lastFinalized) <= 0 {
+					continue
+				}
+
+				var m BlockMarshaller
+				timeout, cancel := context.WithTimeout(ctx, 15*time.Second)
+				err := c.Connector.RawCallContext(timeout, &m, "eth_getBlockByNumber", hexutil.EncodeBig(number), false)
+				cancel()
+				if err != nil || m.Number == nil {
+					c.logger.Error("failed to read the finalized block", zap.Stringer("number", number), zap.Error(err))
+					continue
+				}
+				lastFinalized = number
+
+				block := &NewBlock{
+					Number:   m.Number.ToInt(),
+					Time:     uint64(m.Time),
+					Hash:     m.Hash,
+					Finality: Finalized,
+				}
+				sink <- block
+				sink <- block.Copy(Safe)
+			}
+		}
+	})
+
+	return headerSubscription, err
+}
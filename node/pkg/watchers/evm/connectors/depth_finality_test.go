@@ -0,0 +1,94 @@
+package connectors
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// mockConnectorForDepth serves the blocks requested by number from the batch poller mock.
+type mockConnectorForDepth struct {
+	mockConnectorForBatchPoller
+}
+
+func (e *mockConnectorForDepth) RawCallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	if method != "eth_getBlockByNumber" {
+		return fmt.Errorf("method %s not implemented by mockConnectorForDepth", method)
+	}
+	number, err := hexutil.DecodeBig(args[0].(string))
+	if err != nil {
+		return err
+	}
+	m := result.(*BlockMarshaller)
+	m.Number = (*hexutil.Big)(number)
+	m.Hash = ethCommon.BigToHash(number)
+	m.Time = hexutil.Uint64(number.Uint64())
+	return nil
+}
+
+func TestDepthFinalityConnector(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	baseConnector := &mockConnectorForDepth{}
+	connector, err := NewDepthFinalityConnector(baseConnector, zap.NewNop(), 5)
+	require.NoError(t, err)
+
+	sink := make(chan *NewBlock, 10)
+	errC := make(chan error, 1)
+	_, err = connector.SubscribeForBlocks(ctx, errC, sink)
+	require.NoError(t, err)
+
+	head := func(number int64) {
+		baseConnector.headSink <- &ethTypes.Header{Number: big.NewInt(number)}
+	}
+	next := func() *NewBlock {
+		select {
+		case block := <-sink:
+			return block
+		case <-time.After(time.Second):
+			require.FailNow(t, "timed out waiting for a block")
+			return nil
+		}
+	}
+
+	// Blocks that are not deep enough are only published as latest.
+	head(3)
+	block := next()
+	assert.Equal(t, Latest, block.Finality)
+	assert.Equal(t, uint64(3), block.Number.Uint64())
+
+	head(10)
+	assert.Equal(t, Latest, next().Finality)
+	block = next()
+	assert.Equal(t, Finalized, block.Finality)
+	assert.Equal(t, uint64(5), block.Number.Uint64())
+	assert.Equal(t, ethCommon.BigToHash(big.NewInt(5)), block.Hash)
+	block = next()
+	assert.Equal(t, Safe, block.Finality)
+	assert.Equal(t, uint64(5), block.Number.Uint64())
+
+	// The finalized block never moves backwards.
+	head(9)
+	assert.Equal(t, Latest, next().Finality)
+	head(11)
+	assert.Equal(t, Latest, next().Finality)
+	block = next()
+	assert.Equal(t, Finalized, block.Finality)
+	assert.Equal(t, uint64(6), block.Number.Uint64())
+	assert.Equal(t, Safe, next().Finality)
+	assert.Empty(t, sink)
+
+	_, err = NewDepthFinalityConnector(baseConnector, zap.NewNop(), 0)
+	assert.Error(t, err)
+}
@@ -0,0 +1,227 @@
+package connectors
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ethAbi "github.com/certusone/wormhole/node/pkg/watchers/evm/connectors/ethabi"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	ethEvent "github.com/ethereum/go-ethereum/event"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxLogRange is the maximum number of blocks requested in a single eth_getLogs call.
+	maxLogRange = 100
+	// maxLogBackfill is the maximum number of blocks before the latest one that are searched for missed messages
+	// when polling starts. Older messages have to be reobserved.
+	maxLogBackfill = 1000
+	// maxPollErrors is the number of consecutive polling errors after which the subscription fails.
+	maxPollErrors = 3
+)
+
+// HttpPollConnector replaces the websocket subscriptions of the underlying connector with polling, so that it can be
+// used over HTTP. New heads are read with eth_getBlockByNumber and message publications with eth_getLogs over bounded
+// block ranges. It is used when the websocket endpoint of a chain is unavailable.
+type HttpPollConnector struct {
+	Connector
+	logger *zap.Logger
+	Delay  time.Duration
+	// fromBlock is the first block searched for message publications. If it is zero, polling starts at the latest block.
+	fromBlock uint64
+}
+
+func NewHttpPollConnector(logger *zap.Logger, baseConnector Connector, delay time.Duration, fromBlock uint64) *HttpPollConnector {
+	return &HttpPollConnector{
+		Connector: baseConnector,
+		logger:    logger.With(zap.String("component", "http_poller")),
+		Delay:     delay,
+		fromBlock: fromBlock,
+	}
+}
+
+// SubscribeNewHead polls for the latest block and publishes its header whenever it changes.
+func (p *HttpPollConnector) SubscribeNewHead(ctx context.Context, ch chan<- *ethTypes.Header) (ethereum.Subscription, error) {
+	prev, err := p.latestHeader(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest block: %w", err)
+	}
+
+	sub := NewPollSubscription()
+	common.RunWithScissors(ctx, sub.err, "http_poll_subscribe_new_head", func(ctx context.Context) error {
+		// Unsubscribe waits for this, also when polling already stopped.
+		defer func() { sub.unsubDone <- struct{}{} }()
+		select {
+		case <-ctx.Done():
+			return nil
+		case ch <- prev:
+		}
+
+		timer := time.NewTimer(p.Delay)
+		defer timer.Stop()
+		errCount := 0
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-sub.quit:
+				return nil
+			case <-timer.C:
+				header, err := p.latestHeader(ctx)
+				if err != nil {
+					errCount++
+					p.logger.Error("failed to poll for the latest block", zap.Int("errCount", errCount), zap.Error(err))
+					if errCount >= maxPollErrors {
+						sub.err <- fmt.Errorf("polling for the latest block encountered too many errors: %w", err)
+						return nil
+					}
+				} else {
+					errCount = 0
+					if header.Hash() != prev.Hash() {
+						select {
+						case <-ctx.Done():
+							return nil
+						case ch <- header:
+						}
+						prev = header
+					}
+				}
+				timer.Reset(p.Delay)
+			}
+		}
+	})
+
+	return sub, nil
+}
+
+// WatchLogMessagePublished polls for message publications of the core contract, at most maxLogRange blocks at a time.
+func (p *HttpPollConnector) WatchLogMessagePublished(ctx context.Context, errC chan error, sink chan<- *ethAbi.AbiLogMessagePublished) (ethEvent.Subscription, error) {
+	latest, err := p.blockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest block number: %w", err)
+	}
+	next := latest
+	if p.fromBlock != 0 && p.fromBlock < next {
+		next = p.fromBlock
+		if latest-next > maxLogBackfill {
+			p.logger.Warn("too many blocks to search for missed messages, they have to be reobserved",
+				zap.Uint64("fromBlock", p.fromBlock),
+				zap.Uint64("latestBlock", latest),
+			)
+			next = latest - maxLogBackfill
+		}
+	}
+	p.logger.Info("polling for message publications", zap.Uint64("fromBlock", next))
+
+	sub := NewPollSubscription()
+	common.RunWithScissors(ctx, errC, "http_poll_watch_log", func(ctx context.Context) error {
+		defer func() { sub.unsubDone <- struct{}{} }()
+		timer := time.NewTimer(0)
+		defer timer.Stop()
+		errCount := 0
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-sub.quit:
+				return nil
+			case <-timer.C:
+				var err error
+				next, err = p.pollLogs(ctx, sink, next)
+				if err != nil {
+					errCount++
+					p.logger.Error("failed to poll for message publications", zap.Int("errCount", errCount), zap.Error(err))
+					if errCount >= maxPollErrors {
+						sub.err <- fmt.Errorf("polling for message publications encountered too many errors: %w", err)
+						return nil
+					}
+				} else {
+					errCount = 0
+				}
+				timer.Reset(p.Delay)
+			}
+		}
+	})
+
+	return sub, nil
+}
+
+// pollLogs publishes the messages in the blocks from next up to the latest one. It returns the next block to search.
+func (p *HttpPollConnector) pollLogs(ctx context.Context, sink chan<- *ethAbi.AbiLogMessagePublished, next uint64) (uint64, error) {
+	latest, err := p.blockNumber(ctx)
+	if err != nil {
+		return next, err
+	}
+
+	for next <= latest {
+		to := next + maxLogRange - 1
+		if to > latest {
+			to = latest
+		}
+
+		logs, err := p.getLogs(ctx, next, to)
+		if err != nil {
+			return next, fmt.Errorf("failed to get logs for blocks %d to %d: %w", next, to, err)
+		}
+		for _, log := range logs {
+			if len(log.Topics) == 0 || log.Topics[0] != logsLogMessageTopic {
+				continue
+			}
+			ev, err := p.ParseLogMessagePublished(log)
+			if err != nil {
+				p.logger.Error("failed to parse log entry", zap.Stringer("txHash", log.TxHash), zap.Error(err))
+				continue
+			}
+			select {
+			case <-ctx.Done():
+				return next, ctx.Err()
+			case sink <- ev:
+			}
+		}
+		next = to + 1
+	}
+
+	return next, nil
+}
+
+func (p *HttpPollConnector) latestHeader(ctx context.Context) (*ethTypes.Header, error) {
+	timeout, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+	var header *ethTypes.Header
+	if err := p.RawCallContext(timeout, &header, "eth_getBlockByNumber", "latest", false); err != nil {
+		return nil, err
+	}
+	if header == nil || header.Number == nil {
+		return nil, fmt.Errorf("latest block not found")
+	}
+	return header, nil
+}
+
+func (p *HttpPollConnector) blockNumber(ctx context.Context) (uint64, error) {
+	timeout, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+	var num hexutil.Uint64
+	if err := p.RawCallContext(timeout, &num, "eth_blockNumber"); err != nil {
+		return 0, err
+	}
+	return uint64(num), nil
+}
+
+func (p *HttpPollConnector) getLogs(ctx context.Context, from, to uint64) ([]ethTypes.Log, error) {
+	timeout, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+	var logs []ethTypes.Log
+	err := p.RawCallContext(timeout, &logs, "eth_getLogs", map[string]interface{}{
+		"address":   p.ContractAddress(),
+		"topics":    [][]interface{}{{logsLogMessageTopic}},
+		"fromBlock": hexutil.EncodeUint64(from),
+		"toBlock":   hexutil.EncodeUint64(to),
+	})
+	return logs, err
+}
@@ -0,0 +1,152 @@
+package connectors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	ethAbi "github.com/certusone/wormhole/node/pkg/watchers/evm/connectors/ethabi"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// mockConnectorForHttpPoller serves the RPC calls of the HttpPollConnector from a list of logs.
+type mockConnectorForHttpPoller struct {
+	Connector
+	mutex       sync.Mutex
+	latest      uint64
+	logs        []ethTypes.Log
+	logRequests [][2]uint64
+	err         error
+}
+
+func (m *mockConnectorForHttpPoller) setLatest(latest uint64) {
+	m.mutex.Lock()
+	m.latest = latest
+	m.mutex.Unlock()
+}
+
+func (m *mockConnectorForHttpPoller) ContractAddress() ethCommon.Address {
+	return ethCommon.HexToAddress("0x98f3c9e6E3fAce36bAAd05FE09d375Ef1464288B")
+}
+
+func (m *mockConnectorForHttpPoller) ParseLogMessagePublished(log ethTypes.Log) (*ethAbi.AbiLogMessagePublished, error) {
+	return &ethAbi.AbiLogMessagePublished{Raw: log}, nil
+}
+
+func (m *mockConnectorForHttpPoller) RawCallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.err != nil {
+		return m.err
+	}
+
+	var resp interface{}
+	switch method {
+	case "eth_blockNumber":
+		resp = hexutil.Uint64(m.latest)
+	case "eth_getBlockByNumber":
+		resp = &ethTypes.Header{Number: new(big.Int).SetUint64(m.latest), Difficulty: big.NewInt(0)}
+	case "eth_getLogs":
+		filter := args[0].(map[string]interface{})
+		from, _ := hexutil.DecodeUint64(filter["fromBlock"].(string))
+		to, _ := hexutil.DecodeUint64(filter["toBlock"].(string))
+		m.logRequests = append(m.logRequests, [2]uint64{from, to})
+		logs := []ethTypes.Log{}
+		for _, log := range m.logs {
+			if log.BlockNumber >= from && log.BlockNumber <= to {
+				logs = append(logs, log)
+			}
+		}
+		resp = logs
+	default:
+		return fmt.Errorf("method %s not implemented", method)
+	}
+
+	bz, err := json.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bz, result)
+}
+
+func messageLog(blockNumber uint64) ethTypes.Log {
+	return ethTypes.Log{
+		Topics:      []ethCommon.Hash{logsLogMessageTopic},
+		BlockNumber: blockNumber,
+		TxHash:      ethCommon.BigToHash(new(big.Int).SetUint64(blockNumber)),
+	}
+}
+
+func TestHttpPollConnectorWatchLogMessagePublished(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	otherLog := messageLog(1150)
+	otherLog.Topics = []ethCommon.Hash{{1}}
+	mock := &mockConnectorForHttpPoller{
+		latest: 1200,
+		logs:   []ethTypes.Log{messageLog(150), messageLog(250), messageLog(1150), otherLog, messageLog(1210)},
+	}
+	// Polling starts at most maxLogBackfill blocks before the latest one.
+	poller := NewHttpPollConnector(zap.NewNop(), mock, 10*time.Millisecond, 100)
+
+	errC := make(chan error)
+	sink := make(chan *ethAbi.AbiLogMessagePublished, 10)
+	sub, err := poller.WatchLogMessagePublished(ctx, errC, sink)
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	assert.Equal(t, uint64(250), (<-sink).Raw.BlockNumber)
+	assert.Equal(t, uint64(1150), (<-sink).Raw.BlockNumber)
+
+	mock.setLatest(1210)
+	assert.Equal(t, uint64(1210), (<-sink).Raw.BlockNumber)
+	assert.Empty(t, sink)
+
+	mock.mutex.Lock()
+	defer mock.mutex.Unlock()
+	require.GreaterOrEqual(t, len(mock.logRequests), 12)
+	for i, r := range mock.logRequests[:11] {
+		assert.Equal(t, [2]uint64{200 + uint64(i)*maxLogRange, min(299+uint64(i)*maxLogRange, 1200)}, r)
+	}
+	assert.Equal(t, [2]uint64{1201, 1210}, mock.logRequests[11])
+}
+
+func TestHttpPollConnectorSubscribeNewHead(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mock := &mockConnectorForHttpPoller{latest: 10}
+	poller := NewHttpPollConnector(zap.NewNop(), mock, 10*time.Millisecond, 0)
+
+	headSink := make(chan *ethTypes.Header, 2)
+	sub, err := poller.SubscribeNewHead(ctx, headSink)
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	assert.Equal(t, uint64(10), (<-headSink).Number.Uint64())
+	mock.setLatest(12)
+	assert.Equal(t, uint64(12), (<-headSink).Number.Uint64())
+
+	// The subscription fails after repeated polling errors.
+	mock.mutex.Lock()
+	mock.err = fmt.Errorf("connection refused")
+	mock.mutex.Unlock()
+	select {
+	case err := <-sub.Err():
+		assert.ErrorContains(t, err, "connection refused")
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscription did not fail")
+	}
+}
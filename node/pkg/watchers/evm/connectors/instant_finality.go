@@ -27,7 +27,7 @@ func NewInstantFinalityConnector(baseConnector Connector, logger *zap.Logger) (*
 
 func (c *InstantFinalityConnector) SubscribeForBlocks(ctx context.Context, errC chan error, sink chan<- *NewBlock) (ethereum.Subscription, error) {
 	headSink := make(chan *ethTypes.Header, 2)
-	headerSubscription, err := c.Connector.Client().SubscribeNewHead(ctx, headSink)
+	headerSubscription, err := c.Connector.SubscribeNewHead(ctx, headSink)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,205 @@
+package evm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync/atomic"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors"
+	eth_common "github.com/ethereum/go-ethereum/common"
+	eth_hexutil "github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+var (
+	ethRpcLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "wormhole_eth_rpc_latency_seconds",
+			Help:    "Latency of the finalized block requests the watcher sends to each of its RPC endpoints",
+			Buckets: []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+		}, []string{"eth_network", "endpoint"})
+	ethRpcErrors = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_eth_rpc_errors_total",
+			Help: "Total number of errors of each RPC endpoint of the watcher",
+		}, []string{"eth_network", "endpoint"})
+	ethRpcDivergences = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_eth_rpc_divergences_total",
+			Help: "Total number of finalized blocks on which an RPC endpoint disagreed with the active one",
+		}, []string{"eth_network", "endpoint"})
+	ethRpcFailovers = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_eth_rpc_failovers_total",
+			Help: "Total number of times the watcher failed over to the next RPC endpoint",
+		}, []string{"eth_network", "reason"})
+	ethRpcActiveEndpoint = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_eth_rpc_active_endpoint",
+			Help: "Index of the RPC endpoint the watcher uses, 0 being the primary one",
+		}, []string{"eth_network"})
+)
+
+// consistencyCheckInterval is how often the finalized blocks of the RPC endpoints of a chain are compared.
+const consistencyCheckInterval = 30 * time.Second
+
+// errEndpointDiverged is returned when the active RPC endpoint disagrees with most of the other ones on a finalized block.
+var errEndpointDiverged = errors.New("RPC endpoint diverged from the other endpoints")
+
+// rpcEndpoint is one of the RPC endpoints of a chain.
+type rpcEndpoint struct {
+	url string
+	// HTTP URL to poll while the websocket endpoint is unavailable. Empty disables the fallback.
+	pollUrl string
+}
+
+// endpointLabel returns the host of the URL, so that API keys in its path or query don't end up in metrics and logs.
+func endpointLabel(rawUrl string) string {
+	u, err := url.Parse(rawUrl)
+	if err != nil || u.Host == "" {
+		return "invalid"
+	}
+	return u.Host
+}
+
+// setEndpoints sets the RPC endpoints of the watcher and makes the primary one active.
+func (w *Watcher) setEndpoints(rpcUrl string, pollRpc string, backupRpcs []string) {
+	w.endpoints = []rpcEndpoint{{url: rpcUrl, pollUrl: pollUrl(w.chainID, rpcUrl, pollRpc)}}
+	for _, backup := range backupRpcs {
+		w.endpoints = append(w.endpoints, rpcEndpoint{url: backup, pollUrl: pollUrl(w.chainID, backup, "")})
+	}
+	w.activeEndpoint = 0
+	w.url = w.endpoints[0].url
+	w.pollUrl = w.endpoints[0].pollUrl
+	w.pollingUntil = time.Time{}
+	ethRpcActiveEndpoint.WithLabelValues(w.networkName).Set(0)
+}
+
+// failOver makes the next RPC endpoint active after the active one failed. It returns false if the primary endpoint
+// became active again, or if there is no other endpoint.
+func (w *Watcher) failOver(logger *zap.Logger, err error) bool {
+	if len(w.endpoints) < 2 {
+		return false
+	}
+
+	reason := "error"
+	if errors.Is(err, errEndpointDiverged) {
+		reason = "divergence"
+	}
+	from := w.url
+	w.activeEndpoint = (w.activeEndpoint + 1) % len(w.endpoints)
+	w.url = w.endpoints[w.activeEndpoint].url
+	w.pollUrl = w.endpoints[w.activeEndpoint].pollUrl
+	w.pollingUntil = time.Time{}
+
+	ethRpcFailovers.WithLabelValues(w.networkName, reason).Inc()
+	ethRpcActiveEndpoint.WithLabelValues(w.networkName).Set(float64(w.activeEndpoint))
+	logger.Warn("failing over to the next RPC endpoint",
+		zap.String("from", endpointLabel(from)),
+		zap.String("to", endpointLabel(w.url)),
+		zap.Int("endpoint", w.activeEndpoint),
+		zap.Error(err),
+	)
+	return w.activeEndpoint != 0
+}
+
+// endpointClient is a connection to an RPC endpoint that is not the active one.
+type endpointClient struct {
+	label  string
+	client *rpc.Client
+}
+
+// dialOtherEndpoints connects to the RPC endpoints that are not active, to check the active one against them. Their
+// HTTP URLs are preferred because they are only sent requests. Endpoints that can't be reached are skipped.
+func (w *Watcher) dialOtherEndpoints(ctx context.Context, logger *zap.Logger) []*endpointClient {
+	clients := make([]*endpointClient, 0, len(w.endpoints)-1)
+	for i, e := range w.endpoints {
+		if i == w.activeEndpoint {
+			continue
+		}
+		rpcUrl := e.url
+		if e.pollUrl != "" {
+			rpcUrl = e.pollUrl
+		}
+		label := endpointLabel(e.url)
+		timeout, cancel := context.WithTimeout(ctx, 15*time.Second)
+		client, err := rpc.DialContext(timeout, rpcUrl)
+		cancel()
+		if err != nil {
+			ethRpcErrors.WithLabelValues(w.networkName, label).Inc()
+			logger.Warn("failed to connect to RPC endpoint, it will not be checked", zap.String("endpoint", label), zap.Error(err))
+			continue
+		}
+		clients = append(clients, &endpointClient{label: label, client: client})
+	}
+	return clients
+}
+
+// blockHash returns the hash of a block from an RPC endpoint, recording the latency and errors of the request. It
+// returns false if the endpoint failed or does not have the block yet.
+func (w *Watcher) blockHash(ctx context.Context, label string, call func(ctx context.Context, result interface{}, method string, args ...interface{}) error, blockNum uint64) (eth_common.Hash, bool) {
+	timeout, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+
+	var m connectors.BlockMarshaller
+	start := time.Now()
+	err := call(timeout, &m, "eth_getBlockByNumber", eth_hexutil.EncodeUint64(blockNum), false)
+	ethRpcLatency.WithLabelValues(w.networkName, label).Observe(time.Since(start).Seconds())
+	if err != nil {
+		ethRpcErrors.WithLabelValues(w.networkName, label).Inc()
+		return eth_common.Hash{}, false
+	}
+	// Endpoints that lag behind return null for blocks they don't have.
+	if m.Number == nil {
+		return eth_common.Hash{}, false
+	}
+	return m.Hash, true
+}
+
+// checkConsistency compares the latest finalized block of the active RPC endpoint with the other endpoints. It returns
+// errEndpointDiverged if more of them disagree with the active endpoint than agree with it, counting the active one.
+// With a single other endpoint, a divergence is only reported in the metrics, as it is unclear which one is wrong.
+func (w *Watcher) checkConsistency(ctx context.Context, logger *zap.Logger, others []*endpointClient) error {
+	blockNum := atomic.LoadUint64(&w.latestFinalizedBlockNumber)
+	if blockNum == 0 {
+		return nil
+	}
+
+	activeLabel := endpointLabel(w.url)
+	expected, ok := w.blockHash(ctx, activeLabel, w.ethConn.RawCallContext, blockNum)
+	if !ok {
+		return nil
+	}
+
+	agree, disagree := 1, 0
+	for _, other := range others {
+		hash, ok := w.blockHash(ctx, other.label, other.client.CallContext, blockNum)
+		if !ok {
+			continue
+		}
+		if hash == expected {
+			agree++
+			continue
+		}
+		disagree++
+		ethRpcDivergences.WithLabelValues(w.networkName, other.label).Inc()
+		logger.Warn("RPC endpoints disagree on a finalized block",
+			zap.Uint64("block", blockNum),
+			zap.String("active", activeLabel),
+			zap.Stringer("activeHash", expected),
+			zap.String("other", other.label),
+			zap.Stringer("otherHash", hash),
+		)
+	}
+
+	if disagree > agree {
+		return fmt.Errorf("%w: block %d has hash %s on %s, %d of %d other endpoints disagree", errEndpointDiverged, blockNum, expected, activeLabel, disagree, agree-1+disagree)
+	}
+	return nil
+}
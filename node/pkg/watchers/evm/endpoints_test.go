@@ -0,0 +1,122 @@
+package evm
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors"
+	eth_common "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// blockService serves eth_getBlockByNumber with a fixed block hash.
+type blockService struct {
+	hash eth_common.Hash
+}
+
+func (s *blockService) GetBlockByNumber(number string, full bool) map[string]interface{} {
+	return map[string]interface{}{"number": number, "hash": s.hash}
+}
+
+func newBlockClient(t *testing.T, hash eth_common.Hash) *rpc.Client {
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", &blockService{hash: hash}))
+	client := rpc.DialInProc(server)
+	t.Cleanup(func() {
+		client.Close()
+		server.Stop()
+	})
+	return client
+}
+
+// mockEndpointConn is the connector of the active endpoint.
+type mockEndpointConn struct {
+	connectors.Connector
+	client *rpc.Client
+}
+
+func (c *mockEndpointConn) RawCallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	return c.client.CallContext(ctx, result, method, args...)
+}
+
+func TestCheckConsistency(t *testing.T) {
+	good := eth_common.HexToHash("0x01")
+	bad := eth_common.HexToHash("0x02")
+
+	for _, tc := range []struct {
+		name     string
+		others   []eth_common.Hash
+		diverged bool
+	}{
+		{"agree", []eth_common.Hash{good, good}, false},
+		{"one disagrees", []eth_common.Hash{good, bad}, false},
+		{"single other disagrees", []eth_common.Hash{bad}, false},
+		{"active disagrees with the others", []eth_common.Hash{bad, bad}, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			w := NewEthWatcher("ws://active:8545", eth_common.Address{}, "test", vaa.ChainIDEthereum, nil, nil, nil, nil, nil, false, false)
+			w.ethConn = &mockEndpointConn{client: newBlockClient(t, good)}
+			w.latestFinalizedBlockNumber = 100
+
+			others := make([]*endpointClient, 0, len(tc.others))
+			for _, hash := range tc.others {
+				others = append(others, &endpointClient{label: "other", client: newBlockClient(t, hash)})
+			}
+
+			err := w.checkConsistency(context.Background(), zap.NewNop(), others)
+			if tc.diverged {
+				assert.ErrorIs(t, err, errEndpointDiverged)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFailOver(t *testing.T) {
+	w := NewEthWatcher("", eth_common.Address{}, "test", vaa.ChainIDEthereum, nil, nil, nil, nil, nil, false, false)
+	err := errors.New("connection reset")
+
+	// A single endpoint can't fail over.
+	w.setEndpoints("wss://primary.example.com/key", "", nil)
+	assert.False(t, w.failOver(zap.NewNop(), err))
+	assert.Equal(t, "wss://primary.example.com/key", w.url)
+
+	w.setEndpoints("wss://primary.example.com/key", "https://primary-http.example.com", []string{"wss://backup1.example.com", "ws://backup2:8545"})
+	assert.Equal(t, "https://primary-http.example.com", w.pollUrl)
+
+	assert.True(t, w.failOver(zap.NewNop(), err))
+	assert.Equal(t, "wss://backup1.example.com", w.url)
+	assert.Equal(t, "https://backup1.example.com", w.pollUrl)
+
+	assert.True(t, w.failOver(zap.NewNop(), err))
+	assert.Equal(t, "ws://backup2:8545", w.url)
+
+	// Once all endpoints failed, the primary one is active again.
+	assert.False(t, w.failOver(zap.NewNop(), err))
+	assert.Equal(t, "wss://primary.example.com/key", w.url)
+	assert.Equal(t, "https://primary-http.example.com", w.pollUrl)
+}
+
+func TestEndpointLabel(t *testing.T) {
+	assert.Equal(t, "mainnet.infura.io", endpointLabel("wss://mainnet.infura.io/ws/v3/secret"))
+	assert.Equal(t, "eth:8545", endpointLabel("ws://eth:8545"))
+	assert.Equal(t, "invalid", endpointLabel("eth"))
+}
+
+func TestParseBackupRpcs(t *testing.T) {
+	backupRpcs, err := ParseBackupRpcs([]string{"eth=wss://eth-1.example.com", "base=ws://base:8545", "eth=wss://eth-2.example.com?key=a=b"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"wss://eth-1.example.com", "wss://eth-2.example.com?key=a=b"}, backupRpcs["eth"])
+	assert.Equal(t, []string{"ws://base:8545"}, backupRpcs["base"])
+
+	for _, value := range []string{"eth", "=ws://eth:8545", "eth="} {
+		_, err := ParseBackupRpcs([]string{value})
+		assert.Error(t, err, value)
+	}
+}
@@ -0,0 +1,271 @@
+package evm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/finality"
+	"github.com/certusone/wormhole/node/pkg/watchers"
+	eth_common "github.com/ethereum/go-ethereum/common"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+)
+
+// ChainRegistryEntry declares an EVM chain to watch in the chain registry file.
+type ChainRegistryEntry struct {
+	// Human readable name of the watcher, e.g. "ethereum". Must be unique.
+	NetworkID string `json:"networkId" yaml:"networkId"`
+	// Wormhole chain ID of the chain.
+	ChainID uint16 `json:"chainId" yaml:"chainId"`
+	// RPC URL, usually a websocket.
+	Rpc string `json:"rpc" yaml:"rpc"`
+	// Optional HTTP RPC URL polled while the websocket endpoint is unavailable. Defaults to the rpc URL with an http(s) scheme.
+	PollRpc string `json:"pollRpc,omitempty" yaml:"pollRpc,omitempty"`
+	// Optional RPC URLs to fail over to. The finalized blocks of the active endpoint are checked against them.
+	BackupRpcs []string `json:"backupRpcs,omitempty" yaml:"backupRpcs,omitempty"`
+	// Hex address of the core contract.
+	Contract string `json:"contract" yaml:"contract"`
+	// Optional finality strategy: "instant", "finalized", "safe" or "depth:<blocks>". Defaults to the default strategy of
+	// the chain, see finality.Default.
+	Finality string `json:"finality,omitempty" yaml:"finality,omitempty"`
+	// Optional block time, e.g. "2s". The watcher polls for finalized and safe blocks at this interval.
+	BlockTime string `json:"blockTime,omitempty" yaml:"blockTime,omitempty"`
+	// Optional network ID of the watcher that finalizes this chain.
+	L1Finalizer string `json:"l1Finalizer,omitempty" yaml:"l1Finalizer,omitempty"`
+	// Watch the chain for guardian set updates.
+	GuardianSetUpdateChain bool `json:"guardianSetUpdateChain,omitempty" yaml:"guardianSetUpdateChain,omitempty"`
+}
+
+// ChainRegistry is a file of EVM chain declarations. It lets operators add EVM chains the SDK already knows without
+// adding flags and watcher setup code to the node. The file is JSON, or YAML if its name ends in .yaml or .yml.
+type ChainRegistry struct {
+	path    string
+	entries []ChainRegistryEntry
+	configs map[string]*WatcherConfig
+}
+
+// LoadChainRegistry reads and validates a chain registry file.
+func LoadChainRegistry(path string) (*ChainRegistry, error) {
+	entries, err := readChainRegistry(path)
+	if err != nil {
+		return nil, err
+	}
+	return &ChainRegistry{path: path, entries: entries, configs: make(map[string]*WatcherConfig)}, nil
+}
+
+func readChainRegistry(path string) ([]ChainRegistryEntry, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read chain registry: %w", err)
+	}
+
+	var entries []ChainRegistryEntry
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(bz, &entries)
+	default:
+		err = json.Unmarshal(bz, &entries)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse chain registry: %w", err)
+	}
+
+	networkIDs := make(map[string]struct{})
+	chainIDs := make(map[uint16]struct{})
+	for _, entry := range entries {
+		if err := entry.validate(); err != nil {
+			return nil, fmt.Errorf("invalid chain registry entry %q: %w", entry.NetworkID, err)
+		}
+		if _, exists := networkIDs[entry.NetworkID]; exists {
+			return nil, fmt.Errorf("duplicate network ID in chain registry: %s", entry.NetworkID)
+		}
+		if _, exists := chainIDs[entry.ChainID]; exists {
+			return nil, fmt.Errorf("duplicate chain ID in chain registry: %d", entry.ChainID)
+		}
+		networkIDs[entry.NetworkID] = struct{}{}
+		chainIDs[entry.ChainID] = struct{}{}
+	}
+
+	return entries, nil
+}
+
+func (e *ChainRegistryEntry) validate() error {
+	if e.NetworkID == "" {
+		return fmt.Errorf("networkId is required")
+	}
+	chainID := vaa.ChainID(e.ChainID)
+	if !vaa.IsEVMChain(chainID) {
+		return fmt.Errorf("chain %d is not an EVM chain known to the SDK", e.ChainID)
+	}
+	if e.Rpc == "" {
+		return fmt.Errorf("rpc is required")
+	}
+	for _, backup := range e.BackupRpcs {
+		if backup == "" || backup == e.Rpc {
+			return fmt.Errorf("invalid backup rpc %q", backup)
+		}
+	}
+	if !eth_common.IsHexAddress(e.Contract) {
+		return fmt.Errorf("invalid contract address: %q", e.Contract)
+	}
+	if _, err := e.finality(); err != nil {
+		return err
+	}
+	if _, err := e.pollInterval(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// finality returns the finality override of the entry, which is unset if the default strategy should be used.
+func (e *ChainRegistryEntry) finality() (finality.Strategy, error) {
+	strategy, err := finality.Parse(e.Finality)
+	if err != nil || !strategy.IsSet() {
+		return strategy, err
+	}
+	return strategy, strategy.Check(finality.ModeInstant, finality.ModeFinalized, finality.ModeSafe, finality.ModeDepth)
+}
+
+// pollInterval returns the block time of the entry, or zero if the default poll interval should be used.
+func (e *ChainRegistryEntry) pollInterval() (time.Duration, error) {
+	if e.BlockTime == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(e.BlockTime)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid block time %q", e.BlockTime)
+	}
+	return d, nil
+}
+
+// reloadable reports whether the entry can replace other without restarting the node. Only the connection settings can
+// change at runtime; the chain and the channels wired to its watcher are fixed at startup.
+func (e *ChainRegistryEntry) reloadable(other *ChainRegistryEntry) bool {
+	return e.NetworkID == other.NetworkID &&
+		e.ChainID == other.ChainID &&
+		e.L1Finalizer == other.L1Finalizer &&
+		e.GuardianSetUpdateChain == other.GuardianSetUpdateChain
+}
+
+// WatcherConfigs returns the watcher configs of the chains in the registry. A watcher's L1 finalizer must be configured
+// before it, so these configs should be appended after the flag based ones.
+func (r *ChainRegistry) WatcherConfigs(ccqBackfillCache bool) []watchers.WatcherConfig {
+	wcs := make([]watchers.WatcherConfig, 0, len(r.entries))
+	for i := range r.entries {
+		entry := &r.entries[i]
+		// The errors were checked when the registry was loaded.
+		finalityOverride, _ := entry.finality()
+		pollInterval, _ := entry.pollInterval()
+		wc := &WatcherConfig{
+			NetworkID:              watchers.NetworkID(entry.NetworkID),
+			ChainID:                vaa.ChainID(entry.ChainID),
+			Rpc:                    entry.Rpc,
+			PollRpc:                entry.PollRpc,
+			BackupRpcs:             entry.BackupRpcs,
+			Contract:               entry.Contract,
+			GuardianSetUpdateChain: entry.GuardianSetUpdateChain,
+			L1FinalizerRequired:    watchers.NetworkID(entry.L1Finalizer),
+			CcqBackfillCache:       ccqBackfillCache,
+			Finality:               finalityOverride,
+			PollInterval:           pollInterval,
+			reloadC:                make(chan *WatcherConfig, 1),
+		}
+		r.configs[entry.NetworkID] = wc
+		wcs = append(wcs, wc)
+	}
+	return wcs
+}
+
+// Reload re-reads the registry file and reconfigures the watchers whose connection settings changed. Chains that were
+// added or removed, or whose other settings changed, are only logged: they take effect when the node restarts.
+func (r *ChainRegistry) Reload(logger *zap.Logger) error {
+	entries, err := readChainRegistry(r.path)
+	if err != nil {
+		return err
+	}
+
+	current := make(map[string]*ChainRegistryEntry, len(r.entries))
+	for i := range r.entries {
+		current[r.entries[i].NetworkID] = &r.entries[i]
+	}
+
+	// applied are the entries the watchers run with after the reload.
+	applied := make([]ChainRegistryEntry, 0, len(entries))
+	for i := range entries {
+		entry := &entries[i]
+		old, exists := current[entry.NetworkID]
+		if !exists {
+			logger.Warn("chain added to the chain registry, it will be watched after a restart", zap.String("networkId", entry.NetworkID))
+			continue
+		}
+		delete(current, entry.NetworkID)
+		if reflect.DeepEqual(entry, old) {
+			applied = append(applied, *old)
+			continue
+		}
+		if !old.reloadable(entry) {
+			logger.Warn("chain registry entry changed settings that require a restart, ignoring the change", zap.String("networkId", entry.NetworkID))
+			applied = append(applied, *old)
+			continue
+		}
+		applied = append(applied, *entry)
+
+		wc, ok := r.configs[entry.NetworkID]
+		if !ok {
+			continue
+		}
+		finalityOverride, _ := entry.finality()
+		pollInterval, _ := entry.pollInterval()
+		updated := *wc
+		updated.Rpc = entry.Rpc
+		updated.PollRpc = entry.PollRpc
+		updated.BackupRpcs = entry.BackupRpcs
+		updated.Contract = entry.Contract
+		updated.Finality = finalityOverride
+		updated.PollInterval = pollInterval
+
+		// Replace a reconfiguration the watcher has not picked up yet.
+		select {
+		case <-wc.reloadC:
+		default:
+		}
+		wc.reloadC <- &updated
+		logger.Info("reconfiguring watcher from the chain registry", zap.String("networkId", entry.NetworkID), zap.String("rpc", entry.Rpc), zap.String("contract", entry.Contract))
+	}
+
+	for networkID, old := range current {
+		logger.Warn("chain removed from the chain registry, it will be watched until a restart", zap.String("networkId", networkID))
+		applied = append(applied, *old)
+	}
+
+	r.entries = applied
+	return nil
+}
+
+// RunReloadOnSIGHUP reloads the registry whenever the node receives SIGHUP. Invalid files are logged and ignored.
+func (r *ChainRegistry) RunReloadOnSIGHUP(ctx context.Context, logger *zap.Logger) error {
+	sighup := make(chan os.Signal, 1)
+	signal.Notify(sighup, syscall.SIGHUP)
+	defer signal.Stop(sighup)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sighup:
+			logger.Info("received SIGHUP, reloading the chain registry", zap.String("path", r.path))
+			if err := r.Reload(logger); err != nil {
+				logger.Error("failed to reload the chain registry", zap.Error(err))
+			}
+		}
+	}
+}
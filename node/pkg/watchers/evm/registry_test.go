@@ -0,0 +1,127 @@
+package evm
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/finality"
+	"github.com/certusone/wormhole/node/pkg/watchers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+const testRegistryJSON = `[
+	{"networkId": "eth", "chainId": 2, "rpc": "ws://eth:8545", "contract": "0xC89Ce4735882C9F0f0FE26686c53074E09B0D550", "guardianSetUpdateChain": true},
+	{"networkId": "base", "chainId": 30, "rpc": "ws://base:8545", "contract": "0xC89Ce4735882C9F0f0FE26686c53074E09B0D550", "finality": "finalized", "blockTime": "2s"}
+]`
+
+const testRegistryYAML = `
+- networkId: eth
+  chainId: 2
+  rpc: ws://eth:8545
+  contract: "0xC89Ce4735882C9F0f0FE26686c53074E09B0D550"
+  guardianSetUpdateChain: true
+- networkId: base
+  chainId: 30
+  rpc: ws://base:8545
+  contract: "0xC89Ce4735882C9F0f0FE26686c53074E09B0D550"
+  finality: finalized
+  blockTime: 2s
+`
+
+func writeRegistry(t *testing.T, name string, contents string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0600))
+	return path
+}
+
+func TestLoadChainRegistry(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		contents string
+	}{
+		{"registry.json", testRegistryJSON},
+		{"registry.yaml", testRegistryYAML},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			registry, err := LoadChainRegistry(writeRegistry(t, tc.name, tc.contents))
+			require.NoError(t, err)
+
+			wcs := registry.WatcherConfigs(true)
+			require.Len(t, wcs, 2)
+			eth := wcs[0].(*WatcherConfig)
+			assert.Equal(t, watchers.NetworkID("eth"), eth.NetworkID)
+			assert.Equal(t, vaa.ChainIDEthereum, eth.ChainID)
+			assert.True(t, eth.GuardianSetUpdateChain)
+			assert.False(t, eth.Finality.IsSet())
+			base := wcs[1].(*WatcherConfig)
+			assert.Equal(t, vaa.ChainIDBase, base.ChainID)
+			assert.Equal(t, finality.Strategy{Mode: finality.ModeFinalized}, base.Finality)
+			assert.Equal(t, 2*time.Second, base.PollInterval)
+			assert.True(t, base.CcqBackfillCache)
+		})
+	}
+}
+
+func TestLoadChainRegistryInvalid(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		contents string
+	}{
+		{"not an EVM chain", `[{"networkId": "solana", "chainId": 1, "rpc": "ws://solana", "contract": "0xC89Ce4735882C9F0f0FE26686c53074E09B0D550"}]`},
+		{"missing rpc", `[{"networkId": "eth", "chainId": 2, "contract": "0xC89Ce4735882C9F0f0FE26686c53074E09B0D550"}]`},
+		{"backup rpc is the rpc", `[{"networkId": "eth", "chainId": 2, "rpc": "ws://eth:8545", "backupRpcs": ["ws://eth:8545"], "contract": "0xC89Ce4735882C9F0f0FE26686c53074E09B0D550"}]`},
+		{"invalid contract", `[{"networkId": "eth", "chainId": 2, "rpc": "ws://eth:8545", "contract": "eth"}]`},
+		{"invalid finality", `[{"networkId": "eth", "chainId": 2, "rpc": "ws://eth:8545", "contract": "0xC89Ce4735882C9F0f0FE26686c53074E09B0D550", "finality": "custom"}]`},
+		{"unsupported finality", `[{"networkId": "eth", "chainId": 2, "rpc": "ws://eth:8545", "contract": "0xC89Ce4735882C9F0f0FE26686c53074E09B0D550", "finality": "checkpoint"}]`},
+		{"invalid block time", `[{"networkId": "eth", "chainId": 2, "rpc": "ws://eth:8545", "contract": "0xC89Ce4735882C9F0f0FE26686c53074E09B0D550", "blockTime": "-1s"}]`},
+		{"duplicate chain", `[
+			{"networkId": "eth", "chainId": 2, "rpc": "ws://eth:8545", "contract": "0xC89Ce4735882C9F0f0FE26686c53074E09B0D550"},
+			{"networkId": "eth2", "chainId": 2, "rpc": "ws://eth:8545", "contract": "0xC89Ce4735882C9F0f0FE26686c53074E09B0D550"}
+		]`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := LoadChainRegistry(writeRegistry(t, "registry.json", tc.contents))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestChainRegistryReload(t *testing.T) {
+	path := writeRegistry(t, "registry.json", testRegistryJSON)
+	registry, err := LoadChainRegistry(path)
+	require.NoError(t, err)
+	wcs := registry.WatcherConfigs(false)
+	eth := wcs[0].(*WatcherConfig)
+	base := wcs[1].(*WatcherConfig)
+
+	// A new RPC URL reconfigures the watcher, other changes and new chains need a restart.
+	require.NoError(t, os.WriteFile(path, []byte(`[
+		{"networkId": "eth", "chainId": 2, "rpc": "ws://eth:8545", "contract": "0xC89Ce4735882C9F0f0FE26686c53074E09B0D550"},
+		{"networkId": "base", "chainId": 30, "rpc": "ws://base-backup:8545", "backupRpcs": ["ws://base:8545"], "contract": "0xC89Ce4735882C9F0f0FE26686c53074E09B0D550", "finality": "safe"},
+		{"networkId": "optimism", "chainId": 24, "rpc": "ws://optimism:8545", "contract": "0xC89Ce4735882C9F0f0FE26686c53074E09B0D550"}
+	]`), 0600))
+	require.NoError(t, registry.Reload(zap.NewNop()))
+
+	select {
+	case updated := <-base.reloadC:
+		assert.Equal(t, "ws://base-backup:8545", updated.Rpc)
+		assert.Equal(t, []string{"ws://base:8545"}, updated.BackupRpcs)
+		assert.Equal(t, finality.Strategy{Mode: finality.ModeSafe}, updated.Finality)
+		assert.Equal(t, time.Duration(0), updated.PollInterval)
+	default:
+		t.Fatal("base watcher was not reconfigured")
+	}
+	assert.Empty(t, eth.reloadC)
+	require.Len(t, registry.entries, 2)
+	assert.True(t, registry.entries[0].GuardianSetUpdateChain)
+
+	// Invalid files leave the running configuration alone.
+	require.NoError(t, os.WriteFile(path, []byte(`[{"networkId": "eth"}]`), 0600))
+	assert.Error(t, registry.Reload(zap.NewNop()))
+	assert.Len(t, registry.entries, 2)
+}
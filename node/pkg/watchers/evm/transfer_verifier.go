@@ -0,0 +1,295 @@
+package evm
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors"
+	"github.com/wormhole-foundation/wormhole/sdk"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+
+	eth_common "github.com/ethereum/go-ethereum/common"
+	eth_hexutil "github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	// errTransferNotBacked is returned when the token bridge transfers published by a transaction are not backed by deposits into the token bridge.
+	// Observations failing with this error should be dropped. Any other error returned by the transfer verifier is transient.
+	errTransferNotBacked = errors.New("transfer is not backed by a deposit into the token bridge")
+
+	// erc20TransferTopic is the topic of the ERC-20 Transfer(address,address,uint256) event.
+	erc20TransferTopic = eth_common.BytesToHash(ethCrypto.Keccak256([]byte("Transfer(address,address,uint256)")))
+
+	// wethDepositTopic is the topic of the WETH Deposit(address,uint256) event, emitted when the token bridge wraps native tokens.
+	wethDepositTopic = eth_common.BytesToHash(ethCrypto.Keccak256([]byte("Deposit(address,uint256)")))
+
+	// decimalsSelector is the selector of the ERC-20 decimals() function.
+	decimalsSelector = ethCrypto.Keccak256([]byte("decimals()"))[:4]
+
+	// wrappedAssetSelector is the selector of the token bridge wrappedAsset(uint16,bytes32) function.
+	wrappedAssetSelector = ethCrypto.Keccak256([]byte("wrappedAsset(uint16,bytes32)"))[:4]
+)
+
+// transferPayloadMinLength is the length of the token bridge transfer payload up to and including the token chain.
+const transferPayloadMinLength = 67
+
+type (
+	// transferVerifier verifies that the token bridge transfers published by a transaction are backed by deposits into the token bridge, based
+	// on the logs in the transaction receipt. For each token, the net increase of the token bridge balance (tokens transferred to the token bridge
+	// or wrapped by it, minus tokens transferred out of it other than burns) must cover the amounts of all transfers of that token in the transaction.
+	transferVerifier struct {
+		chainID     vaa.ChainID
+		contract    eth_common.Address
+		tokenBridge eth_common.Address
+
+		// The token details do not change, so they are cached. They are protected by cacheLock since both the main watcher loop and
+		// re-observation requests verify transfers.
+		cacheLock     sync.Mutex
+		decimals      map[eth_common.Address]uint8
+		wrappedAssets map[wrappedAssetKey]eth_common.Address
+	}
+
+	// wrappedAssetKey is the key to the map of wrapped asset addresses.
+	wrappedAssetKey struct {
+		tokenChain   vaa.ChainID
+		tokenAddress vaa.Address
+	}
+)
+
+// newTransferVerifier creates a transfer verifier for the token bridge of the specified chain in the specified environment.
+func newTransferVerifier(chainID vaa.ChainID, contract eth_common.Address, env common.Environment) (*transferVerifier, error) {
+	emitters := sdk.KnownDevnetTokenbridgeEmitters
+	if env == common.MainNet {
+		emitters = sdk.KnownTokenbridgeEmitters
+	} else if env == common.TestNet {
+		emitters = sdk.KnownTestnetTokenbridgeEmitters
+	}
+
+	emitter, exists := emitters[chainID]
+	if !exists {
+		return nil, fmt.Errorf("there is no known token bridge for chain %s", chainID)
+	}
+
+	return &transferVerifier{
+		chainID:       chainID,
+		contract:      contract,
+		tokenBridge:   eth_common.BytesToAddress(emitter),
+		decimals:      make(map[eth_common.Address]uint8),
+		wrappedAssets: make(map[wrappedAssetKey]eth_common.Address),
+	}, nil
+}
+
+// isTokenBridgeTransfer returns true if the message is a token bridge transfer that should be verified.
+func (tv *transferVerifier) isTokenBridgeTransfer(msg *common.MessagePublication) bool {
+	return msg.EmitterAddress == PadAddress(tv.tokenBridge) && vaa.IsTransfer(msg.Payload) && len(msg.Payload) >= transferPayloadMinLength
+}
+
+// verifyTransaction fetches the receipt of the transaction that published the message and verifies it.
+func (tv *transferVerifier) verifyTransaction(ctx context.Context, ethConn connectors.Connector, msg *common.MessagePublication) error {
+	if !tv.isTokenBridgeTransfer(msg) {
+		return nil
+	}
+
+	receipt, err := ethConn.TransactionReceipt(ctx, msg.TxHash)
+	if err != nil {
+		return fmt.Errorf("failed to get transaction receipt: %w", err)
+	}
+
+	return tv.verifyReceipt(ctx, ethConn, receipt, msg)
+}
+
+// verifyReceipt verifies that the token bridge transfers in the receipt of the transaction that published the message are backed by deposits.
+// It returns nil if the message is not a token bridge transfer.
+func (tv *transferVerifier) verifyReceipt(ctx context.Context, ethConn connectors.Connector, receipt *types.Receipt, msg *common.MessagePublication) error {
+	if !tv.isTokenBridgeTransfer(msg) {
+		return nil
+	}
+
+	requested, err := tv.requestedAmounts(ctx, ethConn, receipt)
+	if err != nil {
+		return err
+	}
+
+	deposited := tv.depositedAmounts(receipt)
+	for token, amount := range requested {
+		deposit, exists := deposited[token]
+		if !exists {
+			return fmt.Errorf("%w: no deposit of token %s for a transfer of %s", errTransferNotBacked, token, amount)
+		}
+		if deposit.Cmp(amount) < 0 {
+			return fmt.Errorf("%w: deposit of %s of token %s is less than the transferred amount of %s", errTransferNotBacked, deposit, token, amount)
+		}
+	}
+
+	return nil
+}
+
+// requestedAmounts returns the total amount of each token transferred by the token bridge messages published in the receipt, in the units of the token on this chain.
+func (tv *transferVerifier) requestedAmounts(ctx context.Context, ethConn connectors.Connector, receipt *types.Receipt) (map[eth_common.Address]*big.Int, error) {
+	requested := make(map[eth_common.Address]*big.Int)
+	for _, l := range receipt.Logs {
+		// SECURITY: Only consider messages published by our core contract on behalf of the token bridge.
+		if l == nil || l.Address != tv.contract || len(l.Topics) == 0 || l.Topics[0] != logMessagePublishedTopic {
+			continue
+		}
+
+		ev, err := ethConn.ParseLogMessagePublished(*l)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse log: %w", err)
+		}
+
+		if ev.Sender != tv.tokenBridge || !vaa.IsTransfer(ev.Payload) {
+			continue
+		}
+
+		if len(ev.Payload) < transferPayloadMinLength {
+			return nil, fmt.Errorf("%w: transfer payload is too short: %d", errTransferNotBacked, len(ev.Payload))
+		}
+
+		amount := new(big.Int).SetBytes(ev.Payload[1:33])
+		tokenAddress := vaa.Address(ev.Payload[33:65])
+		tokenChain := vaa.ChainID(binary.BigEndian.Uint16(ev.Payload[65:67]))
+
+		var token eth_common.Address
+		if tokenChain == tv.chainID {
+			// Native tokens are locked in the token bridge, which normalizes amounts to at most eight decimals.
+			token = eth_common.BytesToAddress(tokenAddress.Bytes())
+			decimals, err := tv.tokenDecimals(ctx, ethConn, token)
+			if err != nil {
+				return nil, err
+			}
+			if decimals > 8 {
+				amount.Mul(amount, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals-8)), nil))
+			}
+		} else {
+			// Wrapped tokens are transferred to the token bridge and burned. They never have more than eight decimals.
+			token, err = tv.wrappedAsset(ctx, ethConn, tokenChain, tokenAddress)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if total, exists := requested[token]; exists {
+			total.Add(total, amount)
+		} else {
+			requested[token] = amount
+		}
+	}
+
+	return requested, nil
+}
+
+// depositedAmounts returns the net increase of the token bridge balance of each token in the receipt. Burns of wrapped tokens by the token bridge
+// are not counted as outflows, since the tokens were transferred to the token bridge as part of the same transfer.
+func (tv *transferVerifier) depositedAmounts(receipt *types.Receipt) map[eth_common.Address]*big.Int {
+	deposited := make(map[eth_common.Address]*big.Int)
+	add := func(token eth_common.Address, amount *big.Int) {
+		if total, exists := deposited[token]; exists {
+			total.Add(total, amount)
+		} else {
+			deposited[token] = amount
+		}
+	}
+
+	for _, l := range receipt.Logs {
+		if l == nil || len(l.Topics) == 0 || len(l.Data) != 32 {
+			continue
+		}
+
+		amount := new(big.Int).SetBytes(l.Data)
+		switch {
+		case l.Topics[0] == erc20TransferTopic && len(l.Topics) == 3:
+			from := eth_common.BytesToAddress(l.Topics[1].Bytes())
+			to := eth_common.BytesToAddress(l.Topics[2].Bytes())
+			if to == tv.tokenBridge && from != tv.tokenBridge {
+				add(l.Address, amount)
+			} else if from == tv.tokenBridge && to != tv.tokenBridge && to != (eth_common.Address{}) {
+				add(l.Address, amount.Neg(amount))
+			}
+		case l.Topics[0] == wethDepositTopic && len(l.Topics) == 2:
+			if eth_common.BytesToAddress(l.Topics[1].Bytes()) == tv.tokenBridge {
+				add(l.Address, amount)
+			}
+		}
+	}
+
+	return deposited
+}
+
+// tokenDecimals returns the number of decimals of a token, querying the token contract the first time.
+func (tv *transferVerifier) tokenDecimals(ctx context.Context, ethConn connectors.Connector, token eth_common.Address) (uint8, error) {
+	tv.cacheLock.Lock()
+	decimals, exists := tv.decimals[token]
+	tv.cacheLock.Unlock()
+	if exists {
+		return decimals, nil
+	}
+
+	result, err := tv.call(ctx, ethConn, token, decimalsSelector)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get decimals of token %s: %w", token, err)
+	}
+	if len(result) != 32 {
+		return 0, fmt.Errorf("unexpected result length for decimals of token %s: %d", token, len(result))
+	}
+
+	decimals = result[31]
+	tv.cacheLock.Lock()
+	tv.decimals[token] = decimals
+	tv.cacheLock.Unlock()
+	return decimals, nil
+}
+
+// wrappedAsset returns the address of the wrapped asset for a foreign token, querying the token bridge the first time.
+func (tv *transferVerifier) wrappedAsset(ctx context.Context, ethConn connectors.Connector, tokenChain vaa.ChainID, tokenAddress vaa.Address) (eth_common.Address, error) {
+	key := wrappedAssetKey{tokenChain: tokenChain, tokenAddress: tokenAddress}
+	tv.cacheLock.Lock()
+	token, exists := tv.wrappedAssets[key]
+	tv.cacheLock.Unlock()
+	if exists {
+		return token, nil
+	}
+
+	data := make([]byte, 0, len(wrappedAssetSelector)+64)
+	data = append(data, wrappedAssetSelector...)
+	data = append(data, eth_common.LeftPadBytes(binary.BigEndian.AppendUint16(nil, uint16(tokenChain)), 32)...)
+	data = append(data, tokenAddress.Bytes()...)
+
+	result, err := tv.call(ctx, ethConn, tv.tokenBridge, data)
+	if err != nil {
+		return eth_common.Address{}, fmt.Errorf("failed to get wrapped asset for %s:%s: %w", tokenChain, tokenAddress, err)
+	}
+	if len(result) != 32 {
+		return eth_common.Address{}, fmt.Errorf("unexpected result length for wrapped asset for %s:%s: %d", tokenChain, tokenAddress, len(result))
+	}
+
+	token = eth_common.BytesToAddress(result)
+	if token == (eth_common.Address{}) {
+		return eth_common.Address{}, fmt.Errorf("%w: there is no wrapped asset for %s:%s", errTransferNotBacked, tokenChain, tokenAddress)
+	}
+
+	tv.cacheLock.Lock()
+	tv.wrappedAssets[key] = token
+	tv.cacheLock.Unlock()
+	return token, nil
+}
+
+// call makes an eth_call to a contract at the latest block.
+func (tv *transferVerifier) call(ctx context.Context, ethConn connectors.Connector, to eth_common.Address, data []byte) ([]byte, error) {
+	var result eth_hexutil.Bytes
+	callTransactionArg := map[string]interface{}{
+		"to":   to,
+		"data": eth_hexutil.Encode(data),
+	}
+	if err := ethConn.RawCallContext(ctx, &result, "eth_call", callTransactionArg, "latest"); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,231 @@
+package evm
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors"
+	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors/ethabi"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	eth_common "github.com/ethereum/go-ethereum/common"
+	eth_hexutil "github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	tvCoreContract = eth_common.HexToAddress("0xC89Ce4735882C9F0f0FE26686c53074E09B0D550")
+	tvSender       = eth_common.HexToAddress("0x90F8bf6A479f320ead074411a4B0e7944Ea8c9C1")
+	tvToken        = eth_common.HexToAddress("0x2D8BE6BF0baA74e0A907016679CaE9190e80dD0A")
+	tvWrappedToken = eth_common.HexToAddress("0xf19A2A01B70519f67ADb309a994Ec8c69A967E8b")
+	tvSolanaToken  = vaa.Address{0x3b, 0x44, 0x2c, 0xb3, 0x91, 0x21, 0x57, 0xf1}
+)
+
+// mockTransferVerifierConn answers the eth_calls made by the transfer verifier.
+type mockTransferVerifierConn struct {
+	connectors.Connector
+	filterer      *ethabi.AbiFilterer
+	decimals      map[eth_common.Address]uint8
+	wrappedAssets map[vaa.Address]eth_common.Address
+	numCalls      int
+}
+
+func newMockTransferVerifierConn(t *testing.T) *mockTransferVerifierConn {
+	filterer, err := ethabi.NewAbiFilterer(tvCoreContract, nil)
+	require.NoError(t, err)
+	return &mockTransferVerifierConn{
+		filterer:      filterer,
+		decimals:      map[eth_common.Address]uint8{tvToken: 18},
+		wrappedAssets: map[vaa.Address]eth_common.Address{tvSolanaToken: tvWrappedToken},
+	}
+}
+
+func (c *mockTransferVerifierConn) ParseLogMessagePublished(log types.Log) (*ethabi.AbiLogMessagePublished, error) {
+	return c.filterer.ParseLogMessagePublished(log)
+}
+
+func (c *mockTransferVerifierConn) RawCallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	c.numCalls++
+	callArg := args[0].(map[string]interface{})
+	data, err := eth_hexutil.Decode(callArg["data"].(string))
+	if err != nil {
+		return err
+	}
+
+	var ret []byte
+	switch {
+	case bytes.Equal(data[:4], decimalsSelector):
+		ret = eth_common.LeftPadBytes([]byte{c.decimals[callArg["to"].(eth_common.Address)]}, 32)
+	case bytes.Equal(data[:4], wrappedAssetSelector):
+		ret = eth_common.LeftPadBytes(c.wrappedAssets[vaa.Address(data[36:68])].Bytes(), 32)
+	default:
+		return errors.New("unexpected call")
+	}
+
+	*result.(*eth_hexutil.Bytes) = ret
+	return nil
+}
+
+func newTransferVerifierForTest(t *testing.T) *transferVerifier {
+	tv, err := newTransferVerifier(vaa.ChainIDEthereum, tvCoreContract, common.GoTest)
+	require.NoError(t, err)
+	return tv
+}
+
+// transferPayload builds a token bridge transfer payload with the fields checked by the transfer verifier.
+func transferPayload(amount int64, tokenAddress vaa.Address, tokenChain vaa.ChainID) []byte {
+	payload := []byte{1}
+	payload = append(payload, eth_common.LeftPadBytes(big.NewInt(amount).Bytes(), 32)...)
+	payload = append(payload, tokenAddress.Bytes()...)
+	payload = binary.BigEndian.AppendUint16(payload, uint16(tokenChain))
+	return append(payload, make([]byte, 32+2+32)...) // to, toChain and fee
+}
+
+// messagePublishedLog builds a LogMessagePublished log emitted by the core contract.
+func messagePublishedLog(t *testing.T, sender eth_common.Address, payload []byte) *types.Log {
+	parsed, err := abi.JSON(strings.NewReader(ethabi.AbiABI))
+	require.NoError(t, err)
+	event := parsed.Events["LogMessagePublished"]
+	data, err := event.Inputs.NonIndexed().Pack(uint64(1), uint32(0), payload, uint8(1))
+	require.NoError(t, err)
+	return &types.Log{
+		Address: tvCoreContract,
+		Topics:  []eth_common.Hash{event.ID, eth_common.BytesToHash(sender.Bytes())},
+		Data:    data,
+	}
+}
+
+// erc20TransferLog builds an ERC-20 Transfer log.
+func erc20TransferLog(token eth_common.Address, from eth_common.Address, to eth_common.Address, amount *big.Int) *types.Log {
+	return &types.Log{
+		Address: token,
+		Topics:  []eth_common.Hash{erc20TransferTopic, eth_common.BytesToHash(from.Bytes()), eth_common.BytesToHash(to.Bytes())},
+		Data:    eth_common.LeftPadBytes(amount.Bytes(), 32),
+	}
+}
+
+func tokenBridgeMsg(tv *transferVerifier, payload []byte) *common.MessagePublication {
+	return &common.MessagePublication{
+		EmitterChain:   vaa.ChainIDEthereum,
+		EmitterAddress: PadAddress(tv.tokenBridge),
+		Payload:        payload,
+	}
+}
+
+func TestTransferVerifierNativeToken(t *testing.T) {
+	tv := newTransferVerifierForTest(t)
+	payload := transferPayload(100, PadAddress(tvToken), vaa.ChainIDEthereum)
+	msg := tokenBridgeMsg(tv, payload)
+
+	// The amount is normalized to eight decimals, so 100 is 100 * 10^10 of an 18 decimal token.
+	deposit := big.NewInt(1_000_000_000_000)
+	tooSmall := big.NewInt(999_999_999_999)
+
+	tests := []struct {
+		name   string
+		logs   []*types.Log
+		backed bool
+	}{
+		{"deposit matches", []*types.Log{erc20TransferLog(tvToken, tvSender, tv.tokenBridge, deposit), messagePublishedLog(t, tv.tokenBridge, payload)}, true},
+		{"deposit too small", []*types.Log{erc20TransferLog(tvToken, tvSender, tv.tokenBridge, tooSmall), messagePublishedLog(t, tv.tokenBridge, payload)}, false},
+		{"no deposit", []*types.Log{messagePublishedLog(t, tv.tokenBridge, payload)}, false},
+		{"deposit of another token", []*types.Log{erc20TransferLog(tvWrappedToken, tvSender, tv.tokenBridge, deposit), messagePublishedLog(t, tv.tokenBridge, payload)}, false},
+		{"deposit transferred back out", []*types.Log{
+			erc20TransferLog(tvToken, tvSender, tv.tokenBridge, deposit),
+			erc20TransferLog(tvToken, tv.tokenBridge, tvSender, deposit),
+			messagePublishedLog(t, tv.tokenBridge, payload),
+		}, false},
+		{"one deposit for two transfers", []*types.Log{
+			erc20TransferLog(tvToken, tvSender, tv.tokenBridge, deposit),
+			messagePublishedLog(t, tv.tokenBridge, payload),
+			messagePublishedLog(t, tv.tokenBridge, payload),
+		}, false},
+		{"message from another emitter is ignored", []*types.Log{
+			erc20TransferLog(tvToken, tvSender, tv.tokenBridge, deposit),
+			messagePublishedLog(t, tv.tokenBridge, payload),
+			messagePublishedLog(t, tvSender, payload),
+		}, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tv.verifyReceipt(context.Background(), newMockTransferVerifierConn(t), &types.Receipt{Logs: tc.logs}, msg)
+			if tc.backed {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, errTransferNotBacked)
+			}
+		})
+	}
+}
+
+func TestTransferVerifierWrappedNativeDeposit(t *testing.T) {
+	tv := newTransferVerifierForTest(t)
+	conn := newMockTransferVerifierConn(t)
+	payload := transferPayload(100, PadAddress(tvToken), vaa.ChainIDEthereum)
+
+	deposit := &types.Log{
+		Address: tvToken,
+		Topics:  []eth_common.Hash{wethDepositTopic, eth_common.BytesToHash(tv.tokenBridge.Bytes())},
+		Data:    eth_common.LeftPadBytes(big.NewInt(1_000_000_000_000).Bytes(), 32),
+	}
+
+	receipt := &types.Receipt{Logs: []*types.Log{deposit, messagePublishedLog(t, tv.tokenBridge, payload)}}
+	require.NoError(t, tv.verifyReceipt(context.Background(), conn, receipt, tokenBridgeMsg(tv, payload)))
+
+	// The decimals of the token are cached.
+	require.NoError(t, tv.verifyReceipt(context.Background(), conn, receipt, tokenBridgeMsg(tv, payload)))
+	assert.Equal(t, 1, conn.numCalls)
+}
+
+func TestTransferVerifierWrappedToken(t *testing.T) {
+	tv := newTransferVerifierForTest(t)
+	payload := transferPayload(100, tvSolanaToken, vaa.ChainIDSolana)
+	amount := big.NewInt(100)
+
+	// Wrapped tokens are transferred to the token bridge and then burned.
+	receipt := &types.Receipt{Logs: []*types.Log{
+		erc20TransferLog(tvWrappedToken, tvSender, tv.tokenBridge, amount),
+		erc20TransferLog(tvWrappedToken, tv.tokenBridge, eth_common.Address{}, amount),
+		messagePublishedLog(t, tv.tokenBridge, payload),
+	}}
+	require.NoError(t, tv.verifyReceipt(context.Background(), newMockTransferVerifierConn(t), receipt, tokenBridgeMsg(tv, payload)))
+
+	// A transfer of a token that has no wrapped asset cannot be backed.
+	unknown := transferPayload(100, vaa.Address{0x01}, vaa.ChainIDSolana)
+	receipt = &types.Receipt{Logs: []*types.Log{messagePublishedLog(t, tv.tokenBridge, unknown)}}
+	err := tv.verifyReceipt(context.Background(), newMockTransferVerifierConn(t), receipt, tokenBridgeMsg(tv, unknown))
+	assert.ErrorIs(t, err, errTransferNotBacked)
+}
+
+func TestTransferVerifierIgnoresOtherMessages(t *testing.T) {
+	tv := newTransferVerifierForTest(t)
+	conn := newMockTransferVerifierConn(t)
+
+	// Messages from other emitters and token bridge messages that are not transfers are not verified.
+	payload := transferPayload(100, PadAddress(tvToken), vaa.ChainIDEthereum)
+	other := &common.MessagePublication{EmitterChain: vaa.ChainIDEthereum, EmitterAddress: PadAddress(tvSender), Payload: payload}
+	assert.NoError(t, tv.verifyReceipt(context.Background(), conn, &types.Receipt{}, other))
+
+	attestation := tokenBridgeMsg(tv, append([]byte{2}, payload[1:]...))
+	assert.NoError(t, tv.verifyReceipt(context.Background(), conn, &types.Receipt{}, attestation))
+	assert.Equal(t, 0, conn.numCalls)
+}
+
+func TestParseTransferVerifierChains(t *testing.T) {
+	chains, err := ParseTransferVerifierChains([]string{"ethereum", "30"})
+	require.NoError(t, err)
+	assert.Equal(t, map[vaa.ChainID]struct{}{vaa.ChainIDEthereum: {}, vaa.ChainIDBase: {}}, chains)
+
+	_, err = ParseTransferVerifierChains([]string{"notachain"})
+	assert.Error(t, err)
+}
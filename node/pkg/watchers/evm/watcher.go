@@ -2,9 +2,11 @@ package evm
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -13,7 +15,6 @@ import (
 	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors/ethabi"
 	"github.com/certusone/wormhole/node/pkg/watchers/interfaces"
 
-	"github.com/certusone/wormhole/node/pkg/p2p"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -22,12 +23,15 @@ import (
 
 	eth_common "github.com/ethereum/go-ethereum/common"
 	eth_hexutil "github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"go.uber.org/zap"
 
 	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/finality"
 	"github.com/certusone/wormhole/node/pkg/query"
 	"github.com/certusone/wormhole/node/pkg/readiness"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/certusone/wormhole/node/pkg/watchers"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
 
@@ -38,6 +42,17 @@ var (
 			Help: "Total number of Ethereum connection errors (either during initial connection or while watching)",
 		}, []string{"eth_network", "reason"})
 
+	ethRpcModeSwitches = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_eth_rpc_mode_switches_total",
+			Help: "Total number of switches between websocket subscriptions and HTTP polling",
+		}, []string{"eth_network", "mode"})
+	ethRpcPolling = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_eth_rpc_polling",
+			Help: "Whether the watcher polls over HTTP because its websocket endpoint is unavailable",
+		}, []string{"eth_network"})
+
 	ethMessagesObserved = promauto.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "wormhole_eth_messages_observed_total",
@@ -68,6 +83,11 @@ var (
 			Name: "wormhole_eth_current_finalized_height",
 			Help: "Current Ethereum finalized block height",
 		}, []string{"eth_network"})
+	ethTransfersRejected = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_eth_transfers_rejected_total",
+			Help: "Total number of Eth token bridge transfers dropped because they failed transfer verification",
+		}, []string{"eth_network"})
 	queryLatency = promauto.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Name: "wormhole_eth_query_latency",
@@ -77,6 +97,8 @@ var (
 
 type (
 	Watcher struct {
+		*watchers.Base
+
 		// Ethereum RPC url
 		url string
 		// Address of the Eth contract
@@ -128,6 +150,23 @@ type (
 		latestFinalizedBlockNumber uint64
 		l1Finalizer                interfaces.L1Finalizer
 
+		// Verifies token bridge transfers against the deposits in their transaction before publishing them. Nil if disabled for this chain.
+		transferVerifier *transferVerifier
+
+		// Overrides the default finality strategy of the chain, unless it is unset.
+		finalityOverride finality.Strategy
+		// Interval to poll for finalized and safe blocks.
+		pollInterval time.Duration
+		// New connection settings from the chain registry, nil for watchers configured by flags.
+		reloadC <-chan *WatcherConfig
+		// RPC endpoints of the chain, the first one being the primary one. url and pollUrl are those of the active one.
+		endpoints      []rpcEndpoint
+		activeEndpoint int
+		// HTTP URL to poll while the websocket endpoint is unavailable. Empty disables the fallback.
+		pollUrl string
+		// The watcher polls pollUrl instead of using websocket subscriptions until this time.
+		pollingUntil time.Time
+
 		ccqConfig          query.PerChainConfig
 		ccqMaxBlockNumber  *big.Int
 		ccqTimestampCache  *BlocksByTimestamp
@@ -153,6 +192,12 @@ type (
 // MaxWaitConfirmations is the maximum number of confirmations to wait before declaring a transaction abandoned.
 const MaxWaitConfirmations = 60
 
+// wsRetryInterval is how long the watcher polls over HTTP after its websocket endpoint failed, before trying it again.
+const wsRetryInterval = 5 * time.Minute
+
+// defaultPollInterval is the interval to poll for finalized and safe blocks unless the chain registry sets a block time.
+const defaultPollInterval = 1000 * time.Millisecond
+
 func NewEthWatcher(
 	url string,
 	contract eth_common.Address,
@@ -160,13 +205,14 @@ func NewEthWatcher(
 	chainID vaa.ChainID,
 	msgC chan<- *common.MessagePublication,
 	setC chan<- *common.GuardianSet,
-	obsvReqC <-chan *gossipv1.ObservationRequest,
+	obsvReqC chan *gossipv1.ObservationRequest,
 	queryReqC <-chan *query.PerChainQueryInternal,
 	queryResponseC chan<- *query.PerChainQueryResponseInternal,
 	unsafeDevMode bool,
 	ccqBackfillCache bool,
 ) *Watcher {
 	return &Watcher{
+		Base:               watchers.NewBase(chainID, watchers.VersionEVM, msgC, obsvReqC),
 		url:                url,
 		contract:           contract,
 		networkName:        networkName,
@@ -179,6 +225,7 @@ func NewEthWatcher(
 		queryResponseC:     queryResponseC,
 		pending:            map[pendingKey]*pendingMessage{},
 		unsafeDevMode:      unsafeDevMode,
+		pollInterval:       defaultPollInterval,
 		ccqConfig:          query.GetPerChainConfig(chainID),
 		ccqMaxBlockNumber:  big.NewInt(0).SetUint64(math.MaxUint64),
 		ccqBackfillCache:   ccqBackfillCache,
@@ -186,14 +233,83 @@ func NewEthWatcher(
 	}
 }
 
-func (w *Watcher) Run(parentCtx context.Context) error {
+// Run watches the chain. Watchers loaded from the chain registry restart with new connection settings when it is reloaded.
+func (w *Watcher) Run(ctx context.Context) error {
+	if w.reloadC != nil {
+		return w.runReloadable(ctx)
+	}
+	return w.run(ctx)
+}
+
+// run watches the chain using websocket subscriptions. When they fail, it fails over to the next RPC endpoint. Once
+// all of them failed, it falls back to polling the primary endpoint over HTTP for wsRetryInterval, so that messages
+// keep being observed while the websocket endpoints are unavailable.
+func (w *Watcher) run(ctx context.Context) error {
+	logger := supervisor.Logger(ctx)
+
+	if !isWebsocketUrl(w.url) {
+		// Endpoints without websocket support are always polled.
+		err := w.watch(ctx, w.url, true)
+		if err != nil && ctx.Err() == nil {
+			ethRpcErrors.WithLabelValues(w.networkName, endpointLabel(w.url)).Inc()
+			w.failOver(logger, err)
+		}
+		return err
+	}
+
+	if time.Now().Before(w.pollingUntil) {
+		pollCtx, cancel := context.WithDeadline(ctx, w.pollingUntil)
+		defer cancel()
+		err := w.watch(pollCtx, w.pollUrl, true)
+		if ctx.Err() != nil {
+			return err
+		}
+		// Whether polling failed or it is time to retry, go back to the websocket endpoint.
+		w.pollingUntil = time.Time{}
+		logger.Info("switching back to the websocket endpoint", zap.String("url", w.url), zap.Error(err))
+		ethRpcModeSwitches.WithLabelValues(w.networkName, "websocket").Inc()
+		ethRpcPolling.WithLabelValues(w.networkName).Set(0)
+		if errors.Is(err, errEndpointDiverged) {
+			w.failOver(logger, err)
+		}
+		if err == nil || errors.Is(err, context.DeadlineExceeded) {
+			err = errors.New("retrying the websocket endpoint")
+		}
+		return err
+	}
+
+	err := w.watch(ctx, w.url, false)
+	if err == nil || ctx.Err() != nil {
+		return err
+	}
+	ethRpcErrors.WithLabelValues(w.networkName, endpointLabel(w.url)).Inc()
+	// Polling an endpoint that diverged from the others would not help.
+	if w.failOver(logger, err) || errors.Is(err, errEndpointDiverged) || w.pollUrl == "" {
+		return err
+	}
+
+	w.pollingUntil = time.Now().Add(wsRetryInterval)
+	logger.Warn("websocket endpoint failed, polling over HTTP",
+		zap.String("pollUrl", w.pollUrl),
+		zap.Duration("retryWebsocketIn", wsRetryInterval),
+		zap.Error(err),
+	)
+	ethRpcModeSwitches.WithLabelValues(w.networkName, "http").Inc()
+	ethRpcPolling.WithLabelValues(w.networkName).Set(1)
+	return err
+}
+
+// watch watches the chain over rpcUrl. If polling is set, it polls for new blocks and messages instead of
+// subscribing to them.
+func (w *Watcher) watch(parentCtx context.Context, rpcUrl string, polling bool) error {
 	var err error
 	logger := supervisor.Logger(parentCtx)
 	w.ccqLogger = logger.With(zap.String("component", "ccqevm"))
 
 	logger.Info("Starting watcher",
 		zap.String("watcher_name", "evm"),
-		zap.String("url", w.url),
+		zap.String("url", rpcUrl),
+		zap.Bool("polling", polling),
 		zap.String("contract", w.contract.String()),
 		zap.String("networkName", w.networkName),
 		zap.String("chainID", w.chainID.String()),
@@ -207,53 +323,71 @@ func (w *Watcher) Run(parentCtx context.Context) error {
 	defer watcherContextCancelFunc()
 
 	// Initialize gossip metrics (we want to broadcast the address even if we're not yet syncing)
-	p2p.DefaultRegistry.SetNetworkStats(w.chainID, &gossipv1.Heartbeat_Network{
+	w.SetNetworkStats(&gossipv1.Heartbeat_Network{
 		ContractAddress: w.contract.Hex(),
 	})
 
 	timeout, cancel := context.WithTimeout(ctx, 15*time.Second)
 	defer cancel()
 
-	finalizedPollingSupported, safePollingSupported, err := w.getFinality(ctx)
+	strategy, err := w.getFinality(ctx, rpcUrl)
 	if err != nil {
 		return fmt.Errorf("failed to determine finality: %w", err)
 	}
+	w.SetFinality(strategy)
 
-	if finalizedPollingSupported {
+	switch strategy.Mode {
+	case finality.ModeFinalized, finality.ModeSafe:
+		safePollingSupported := strategy.Mode == finality.ModeSafe
 		if safePollingSupported {
 			logger.Info("polling for finalized and safe blocks")
 		} else {
 			logger.Info("polling for finalized blocks, will generate safe blocks")
 		}
-		baseConnector, err := connectors.NewEthereumBaseConnector(timeout, w.networkName, w.url, w.contract, logger)
+		baseConnector, err := connectors.NewEthereumBaseConnector(timeout, w.networkName, rpcUrl, w.contract, logger)
 		if err != nil {
 			ethConnectionErrors.WithLabelValues(w.networkName, "dial_error").Inc()
-			p2p.DefaultRegistry.AddErrorCount(w.chainID, 1)
+			w.AddErrorCount(1)
 			return fmt.Errorf("dialing eth client failed: %w", err)
 		}
-		w.ethConn = connectors.NewBatchPollConnector(ctx, logger, baseConnector, safePollingSupported, 1000*time.Millisecond)
-	} else if w.chainID == vaa.ChainIDCelo {
+		w.ethConn = connectors.NewBatchPollConnector(ctx, logger, w.withPolling(logger, baseConnector, polling), safePollingSupported, w.pollInterval)
+	case finality.ModeCheckpoint:
+		// Celo is the only EVM chain with checkpoint finality, see getFinality.
 		// When we are running in mainnet or testnet, we need to use the Celo ethereum library rather than go-ethereum.
 		// However, in devnet, we currently run the standard ETH node for Celo, so we need to use the standard go-ethereum.
-		w.ethConn, err = connectors.NewCeloConnector(timeout, w.networkName, w.url, w.contract, logger)
+		if polling {
+			return fmt.Errorf("polling over HTTP is not supported on Celo")
+		}
+		w.ethConn, err = connectors.NewCeloConnector(timeout, w.networkName, rpcUrl, w.contract, logger)
 		if err != nil {
 			ethConnectionErrors.WithLabelValues(w.networkName, "dial_error").Inc()
-			p2p.DefaultRegistry.AddErrorCount(w.chainID, 1)
+			w.AddErrorCount(1)
 			return fmt.Errorf("dialing eth client failed: %w", err)
 		}
-	} else {
-		// Everything else is instant finality.
+	case finality.ModeDepth:
+		logger.Info("waiting for a fixed number of blocks for finality", zap.Uint64("depth", strategy.Depth))
+		baseConnector, err := connectors.NewEthereumBaseConnector(timeout, w.networkName, rpcUrl, w.contract, logger)
+		if err != nil {
+			ethConnectionErrors.WithLabelValues(w.networkName, "dial_error").Inc()
+			w.AddErrorCount(1)
+			return fmt.Errorf("dialing eth client failed: %w", err)
+		}
+		w.ethConn, err = connectors.NewDepthFinalityConnector(w.withPolling(logger, baseConnector, polling), logger, strategy.Depth)
+		if err != nil {
+			return fmt.Errorf("failed to create the depth finality connector: %w", err)
+		}
+	default:
 		logger.Info("assuming instant finality")
-		baseConnector, err := connectors.NewEthereumBaseConnector(timeout, w.networkName, w.url, w.contract, logger)
+		baseConnector, err := connectors.NewEthereumBaseConnector(timeout, w.networkName, rpcUrl, w.contract, logger)
 		if err != nil {
 			ethConnectionErrors.WithLabelValues(w.networkName, "dial_error").Inc()
-			p2p.DefaultRegistry.AddErrorCount(w.chainID, 1)
+			w.AddErrorCount(1)
 			return fmt.Errorf("dialing eth client failed: %w", err)
 		}
-		w.ethConn, err = connectors.NewInstantFinalityConnector(baseConnector, logger)
+		w.ethConn, err = connectors.NewInstantFinalityConnector(w.withPolling(logger, baseConnector, polling), logger)
 		if err != nil {
 			ethConnectionErrors.WithLabelValues(w.networkName, "dial_error").Inc()
-			p2p.DefaultRegistry.AddErrorCount(w.chainID, 1)
+			w.AddErrorCount(1)
 			return fmt.Errorf("failed to connect to instant finality chain: %w", err)
 		}
 	}
@@ -270,7 +404,7 @@ func (w *Watcher) Run(parentCtx context.Context) error {
 	messageSub, err := w.ethConn.WatchLogMessagePublished(ctx, errC, messageC)
 	if err != nil {
 		ethConnectionErrors.WithLabelValues(w.networkName, "subscribe_error").Inc()
-		p2p.DefaultRegistry.AddErrorCount(w.chainID, 1)
+		w.AddErrorCount(1)
 		return fmt.Errorf("failed to subscribe to message publication events: %w", err)
 	}
 	defer messageSub.Unsubscribe()
@@ -333,6 +467,10 @@ func (w *Watcher) Run(parentCtx context.Context) error {
 
 				for _, msg := range msgs {
 					msg.IsReobservation = true
+					if err := w.verifyTransfer(ctx, logger, msg, nil); err != nil {
+						continue
+					}
+
 					if msg.ConsistencyLevel == vaa.ConsistencyLevelPublishImmediately {
 						logger.Info("re-observed message publication transaction, publishing it immediately",
 							zap.String("msgId", msg.MessageIDString()),
@@ -423,7 +561,7 @@ func (w *Watcher) Run(parentCtx context.Context) error {
 			case err := <-messageSub.Err():
 				ethConnectionErrors.WithLabelValues(w.networkName, "subscription_error").Inc()
 				errC <- fmt.Errorf("error while processing message publication subscription: %w", err)
-				p2p.DefaultRegistry.AddErrorCount(w.chainID, 1)
+				w.AddErrorCount(1)
 				return nil
 			case ev := <-messageC:
 				blockTime, err := w.getBlockTime(ctx, ev.Raw.BlockHash)
@@ -433,12 +571,12 @@ func (w *Watcher) Run(parentCtx context.Context) error {
 						go w.waitForBlockTime(ctx, logger, errC, ev)
 						continue
 					}
-					p2p.DefaultRegistry.AddErrorCount(w.chainID, 1)
+					w.AddErrorCount(1)
 					errC <- fmt.Errorf("failed to request timestamp for block %d, hash %s: %w", ev.Raw.BlockNumber, ev.Raw.BlockHash.String(), err)
 					return nil
 				}
 
-				w.postMessage(logger, ev, blockTime)
+				w.postMessage(ctx, logger, ev, blockTime)
 			}
 		}
 	})
@@ -448,7 +586,7 @@ func (w *Watcher) Run(parentCtx context.Context) error {
 	headerSubscription, err := w.ethConn.SubscribeForBlocks(ctx, errC, headSink)
 	if err != nil {
 		ethConnectionErrors.WithLabelValues(w.networkName, "header_subscribe_error").Inc()
-		p2p.DefaultRegistry.AddErrorCount(w.chainID, 1)
+		w.AddErrorCount(1)
 		return fmt.Errorf("failed to subscribe to header events: %w", err)
 	}
 	defer headerSubscription.Unsubscribe()
@@ -463,7 +601,7 @@ func (w *Watcher) Run(parentCtx context.Context) error {
 				logger.Error("error while processing header subscription", zap.Error(err))
 				ethConnectionErrors.WithLabelValues(w.networkName, "header_subscription_error").Inc()
 				errC <- fmt.Errorf("error while processing header subscription: %w", err)
-				p2p.DefaultRegistry.AddErrorCount(w.chainID, 1)
+				w.AddErrorCount(1)
 				return nil
 			case ev := <-headSink:
 				// These two pointers should have been checked before the event was placed on the channel, but just being safe.
@@ -613,6 +751,15 @@ func (w *Watcher) Run(parentCtx context.Context) error {
 							continue
 						}
 
+						if err := w.verifyTransfer(ctx, logger, pLock.message, tx); err != nil {
+							// Transfers that are not backed by a deposit are dropped. Other errors are retried on the next block, until the observation times out.
+							if errors.Is(err, errTransferNotBacked) || pLock.height+MaxWaitConfirmations <= blockNumberU {
+								delete(w.pending, key)
+								ethMessagesOrphaned.WithLabelValues(w.networkName, "transfer_verification_failed").Inc()
+							}
+							continue
+						}
+
 						logger.Info("observation confirmed",
 							zap.String("msgId", pLock.message.MessageIDString()),
 							zap.Stringer("txHash", pLock.message.TxHash),
@@ -639,6 +786,31 @@ func (w *Watcher) Run(parentCtx context.Context) error {
 		}
 	})
 
+	// Check the finalized blocks of the active endpoint against the other ones.
+	if len(w.endpoints) > 1 {
+		others := w.dialOtherEndpoints(ctx, logger)
+		defer func() {
+			for _, other := range others {
+				other.client.Close()
+			}
+		}()
+		common.RunWithScissors(ctx, errC, "evm_check_endpoints", func(ctx context.Context) error {
+			t := time.NewTicker(consistencyCheckInterval)
+			defer t.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-t.C:
+					if err := w.checkConsistency(ctx, logger, others); err != nil {
+						errC <- err
+						return nil
+					}
+				}
+			}
+		})
+	}
+
 	// Now that the init is complete, peg readiness. That will also happen when we process a new head, but chains
 	// that wait for finality may take a while to receive the first block and we don't want to hold up the init.
 	readiness.SetReady(w.readinessSync)
@@ -663,7 +835,7 @@ func (w *Watcher) fetchAndUpdateGuardianSet(
 	idx, gs, err := fetchCurrentGuardianSet(timeout, ethConn)
 	if err != nil {
 		ethConnectionErrors.WithLabelValues(w.networkName, "guardian_set_fetch_error").Inc()
-		p2p.DefaultRegistry.AddErrorCount(w.chainID, 1)
+		w.AddErrorCount(1)
 		return err
 	}
 
@@ -699,82 +871,36 @@ func fetchCurrentGuardianSet(ctx context.Context, ethConn connectors.Connector)
 	return currentIndex, &gs, nil
 }
 
-// getFinality determines if the chain supports "finalized" and "safe". This is hard coded so it requires thought to change something. However, it also reads the RPC
-// to make sure the node actually supports the expected values, and returns an error if it doesn't. Note that we do not support using safe mode but not finalized mode.
-func (w *Watcher) getFinality(ctx context.Context) (bool, bool, error) {
-	finalized := false
-	safe := false
+// getFinality returns the finality strategy of the chain: the override if one is configured, and the default strategy
+// of the chain otherwise, see finality.Default. The default is hard coded in the SDK chain registry so it requires
+// thought to change something. If the strategy relies on the "finalized" or "safe" block tags, it also reads the RPC to
+// make sure the node actually supports them, and returns an error if it doesn't.
+func (w *Watcher) getFinality(ctx context.Context, rpcUrl string) (finality.Strategy, error) {
+	if !w.unsafeDevMode && !vaa.IsEVMChain(w.chainID) {
+		return finality.Strategy{}, fmt.Errorf("unsupported chain: %s", w.chainID.String())
+	}
 
+	strategy := finality.Effective(w.chainID, w.finalityOverride)
 	// Tilt supports polling for both finalized and safe.
-	if w.unsafeDevMode {
-		finalized = true
-		safe = true
-
-		// The following chains support polling for both finalized and safe.
-	} else if w.chainID == vaa.ChainIDAcala ||
-		w.chainID == vaa.ChainIDArbitrum ||
-		w.chainID == vaa.ChainIDArbitrumSepolia ||
-		w.chainID == vaa.ChainIDBase ||
-		w.chainID == vaa.ChainIDBaseSepolia ||
-		w.chainID == vaa.ChainIDBlast ||
-		w.chainID == vaa.ChainIDBSC ||
-		w.chainID == vaa.ChainIDEthereum ||
-		w.chainID == vaa.ChainIDHolesky ||
-		w.chainID == vaa.ChainIDInk ||
-		w.chainID == vaa.ChainIDKarura ||
-		w.chainID == vaa.ChainIDMantle ||
-		w.chainID == vaa.ChainIDMonadDevnet ||
-		w.chainID == vaa.ChainIDMoonbeam ||
-		w.chainID == vaa.ChainIDOptimism ||
-		w.chainID == vaa.ChainIDOptimismSepolia ||
-		w.chainID == vaa.ChainIDSepolia ||
-		w.chainID == vaa.ChainIDSnaxchain ||
-		w.chainID == vaa.ChainIDUnichain ||
-		w.chainID == vaa.ChainIDWorldchain ||
-		w.chainID == vaa.ChainIDXLayer {
-		finalized = true
-		safe = true
-
-		// The following chains have their own specialized finalizers.
-	} else if w.chainID == vaa.ChainIDCelo {
-		return false, false, nil
-
-		// Polygon now supports polling for finalized but not safe.
-		// https://forum.polygon.technology/t/optimizing-decentralized-apps-ux-with-milestones-a-significantly-accelerated-finality-solution/13154
-	} else if w.chainID == vaa.ChainIDPolygon ||
-		w.chainID == vaa.ChainIDPolygonSepolia {
-		finalized = true
-
-		// As of 11/10/2023 Scroll supports polling for finalized but not safe.
-	} else if w.chainID == vaa.ChainIDScroll {
-		finalized = true
-
-		// As of 9/06/2024 Linea supports polling for finalized but not safe.
-	} else if w.chainID == vaa.ChainIDLinea {
-		finalized = true
-
-		// The following chains support instant finality.
-	} else if w.chainID == vaa.ChainIDAvalanche ||
-		w.chainID == vaa.ChainIDBerachain || // Berachain supports instant finality: https://docs.berachain.com/faq/
-		w.chainID == vaa.ChainIDOasis ||
-		w.chainID == vaa.ChainIDAurora ||
-		w.chainID == vaa.ChainIDFantom ||
-		w.chainID == vaa.ChainIDKlaytn {
-		return false, false, nil
-
-		// Anything else is undefined / not supported.
-	} else {
-		return false, false, fmt.Errorf("unsupported chain: %s", w.chainID.String())
+	if w.unsafeDevMode && !w.finalityOverride.IsSet() {
+		strategy = finality.Strategy{Mode: finality.ModeSafe}
+	}
+	// Chains with instant finality, a fixed depth or their own specialized finalizers don't poll for finalized or safe.
+	if err := strategy.Check(finality.ModeInstant, finality.ModeFinalized, finality.ModeSafe, finality.ModeDepth, finality.ModeCheckpoint); err != nil {
+		return finality.Strategy{}, fmt.Errorf("chain %s: %w", w.chainID.String(), err)
+	}
+	if strategy.Mode == finality.ModeCheckpoint && w.chainID != vaa.ChainIDCelo {
+		return finality.Strategy{}, fmt.Errorf("checkpoint finality is not supported on chain %s", w.chainID.String())
 	}
 
 	// If finalized / safe should be supported, read the RPC to make sure they actually are.
-	if finalized {
+	if strategy.Mode == finality.ModeFinalized || strategy.Mode == finality.ModeSafe {
 		timeout, cancel := context.WithTimeout(ctx, 15*time.Second)
 		defer cancel()
 
-		c, err := rpc.DialContext(timeout, w.url)
+		c, err := rpc.DialContext(timeout, rpcUrl)
 		if err != nil {
-			return false, false, fmt.Errorf("failed to connect to endpoint: %w", err)
+			return finality.Strategy{}, fmt.Errorf("failed to connect to endpoint: %w", err)
 		}
 
 		type Marshaller struct {
@@ -784,18 +910,85 @@ func (w *Watcher) getFinality(ctx context.Context) (bool, bool, error) {
 
 		err = c.CallContext(ctx, &m, "eth_getBlockByNumber", "finalized", false)
 		if err != nil || m.Number == nil {
-			return false, false, fmt.Errorf("finalized not supported by the node when it should be")
+			return finality.Strategy{}, fmt.Errorf("finalized not supported by the node when it should be")
 		}
 
-		if safe {
+		if strategy.Mode == finality.ModeSafe {
 			err = c.CallContext(ctx, &m, "eth_getBlockByNumber", "safe", false)
 			if err != nil || m.Number == nil {
-				return false, false, fmt.Errorf("safe not supported by the node when it should be")
+				return finality.Strategy{}, fmt.Errorf("safe not supported by the node when it should be")
 			}
 		}
 	}
 
-	return finalized, safe, nil
+	return strategy, nil
+}
+
+// SetPollInterval sets the interval to poll for finalized and safe blocks.
+func (w *Watcher) SetPollInterval(pollInterval time.Duration) {
+	w.pollInterval = pollInterval
+}
+
+// withPolling wraps the connector to poll over HTTP instead of using websocket subscriptions if polling is set.
+// Polling starts at the latest block the watcher has seen, to pick up the messages it may have missed when the
+// websocket endpoint failed.
+func (w *Watcher) withPolling(logger *zap.Logger, baseConnector connectors.Connector, polling bool) connectors.Connector {
+	if !polling {
+		return baseConnector
+	}
+	return connectors.NewHttpPollConnector(logger, baseConnector, w.pollInterval, atomic.LoadUint64(&w.latestBlockNumber))
+}
+
+// isWebsocketUrl returns whether the URL has a websocket scheme.
+func isWebsocketUrl(rawUrl string) bool {
+	return strings.HasPrefix(rawUrl, "ws://") || strings.HasPrefix(rawUrl, "wss://")
+}
+
+// pollUrl returns the URL to poll while the websocket endpoint rpcUrl is unavailable: the configured one, or else rpcUrl with
+// an http(s) scheme. The fallback is disabled on Celo, which does not support polling.
+func pollUrl(chainID vaa.ChainID, rpcUrl string, configured string) string {
+	if chainID == vaa.ChainIDCelo {
+		return ""
+	}
+	if configured != "" {
+		return configured
+	}
+	if strings.HasPrefix(rpcUrl, "ws://") {
+		return "http://" + strings.TrimPrefix(rpcUrl, "ws://")
+	}
+	if strings.HasPrefix(rpcUrl, "wss://") {
+		return "https://" + strings.TrimPrefix(rpcUrl, "wss://")
+	}
+	return ""
+}
+
+// runReloadable runs the watcher until new connection settings arrive on reloadC. It then stops the watcher, applies
+// the settings and returns an error so the supervisor restarts it with them.
+func (w *Watcher) runReloadable(ctx context.Context) error {
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errC := make(chan error, 1)
+	go func() {
+		errC <- common.WrapWithScissors(w.run, "evm_watcher_"+w.networkName)(runCtx)
+	}()
+
+	select {
+	case err := <-errC:
+		return err
+	case wc := <-w.reloadC:
+		cancel()
+		<-errC
+		w.setEndpoints(wc.Rpc, wc.PollRpc, wc.BackupRpcs)
+		ethRpcPolling.WithLabelValues(w.networkName).Set(0)
+		w.contract = eth_common.HexToAddress(wc.Contract)
+		w.finalityOverride = wc.Finality
+		w.pollInterval = defaultPollInterval
+		if wc.PollInterval != 0 {
+			w.pollInterval = wc.PollInterval
+		}
+		return fmt.Errorf("watcher reconfigured from the chain registry")
+	}
 }
 
 // SetL1Finalizer is used to set the layer one finalizer.
@@ -815,7 +1008,7 @@ func (w *Watcher) getLatestSafeBlockNumber() uint64 {
 }
 
 func (w *Watcher) updateNetworkStats(stats *gossipv1.Heartbeat_Network) {
-	p2p.DefaultRegistry.SetNetworkStats(w.chainID, &gossipv1.Heartbeat_Network{
+	w.SetNetworkStats(&gossipv1.Heartbeat_Network{
 		Height:          stats.Height,
 		SafeHeight:      stats.SafeHeight,
 		FinalizedHeight: stats.FinalizedHeight,
@@ -834,7 +1027,7 @@ func (w *Watcher) getBlockTime(ctx context.Context, blockHash eth_common.Hash) (
 }
 
 // postMessage creates a message object from a log event and adds it to the pending list for processing.
-func (w *Watcher) postMessage(logger *zap.Logger, ev *ethabi.AbiLogMessagePublished, blockTime uint64) {
+func (w *Watcher) postMessage(ctx context.Context, logger *zap.Logger, ev *ethabi.AbiLogMessagePublished, blockTime uint64) {
 	message := &common.MessagePublication{
 		TxHash:           ev.Raw.TxHash,
 		Timestamp:        time.Unix(int64(blockTime), 0),
@@ -860,6 +1053,10 @@ func (w *Watcher) postMessage(logger *zap.Logger, ev *ethabi.AbiLogMessagePublis
 			zap.Uint8("ConsistencyLevel", ev.ConsistencyLevel),
 		)
 
+		if err := w.verifyTransfer(ctx, logger, message, nil); err != nil {
+			return
+		}
+
 		w.msgC <- message
 		ethMessagesConfirmed.WithLabelValues(w.networkName).Inc()
 		return
@@ -891,6 +1088,41 @@ func (w *Watcher) postMessage(logger *zap.Logger, ev *ethabi.AbiLogMessagePublis
 	w.pendingMu.Unlock()
 }
 
+// verifyTransfer verifies that a token bridge transfer is backed by a deposit into the token bridge, if transfer verification is enabled for this chain.
+// If the receipt is nil, it is fetched. It logs the failure and returns an error if the transfer should not be published.
+func (w *Watcher) verifyTransfer(ctx context.Context, logger *zap.Logger, msg *common.MessagePublication, receipt *types.Receipt) error {
+	if w.transferVerifier == nil || !w.transferVerifier.isTokenBridgeTransfer(msg) {
+		return nil
+	}
+
+	timeout, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var err error
+	if receipt == nil {
+		err = w.transferVerifier.verifyTransaction(timeout, w.ethConn, msg)
+	} else {
+		err = w.transferVerifier.verifyReceipt(timeout, w.ethConn, receipt, msg)
+	}
+
+	if errors.Is(err, errTransferNotBacked) {
+		logger.Error("dropping token bridge transfer because it failed transfer verification",
+			zap.String("msgId", msg.MessageIDString()),
+			zap.Stringer("txHash", msg.TxHash),
+			zap.Error(err),
+		)
+		ethTransfersRejected.WithLabelValues(w.networkName).Inc()
+	} else if err != nil {
+		logger.Warn("failed to verify token bridge transfer",
+			zap.String("msgId", msg.MessageIDString()),
+			zap.Stringer("txHash", msg.TxHash),
+			zap.Error(err),
+		)
+	}
+
+	return err
+}
+
 // blockNotFoundErrors is used by `canRetryGetBlockTime`. It is a map of the error returns from `getBlockTime` that can trigger a retry.
 var blockNotFoundErrors = map[string]struct{}{
 	"not found":                     {},
@@ -942,13 +1174,13 @@ func (w *Watcher) waitForBlockTime(ctx context.Context, logger *zap.Logger, errC
 					zap.Int("retries", retries),
 				)
 
-				w.postMessage(logger, ev, blockTime)
+				w.postMessage(ctx, logger, ev, blockTime)
 				return
 			}
 
 			ethConnectionErrors.WithLabelValues(w.networkName, "block_by_number_error").Inc()
 			if !canRetryGetBlockTime(err) {
-				p2p.DefaultRegistry.AddErrorCount(w.chainID, 1)
+				w.AddErrorCount(1)
 				errC <- fmt.Errorf("failed to request timestamp for block %d, hash %s: %w", ev.Raw.BlockNumber, ev.Raw.BlockHash.String(), err)
 				return
 			}
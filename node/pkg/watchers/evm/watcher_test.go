@@ -50,3 +50,11 @@ func Test_canRetryGetBlockTime(t *testing.T) {
 	assert.True(t, canRetryGetBlockTime(errors.New("cannot query unfinalized data")))
 	assert.False(t, canRetryGetBlockTime(errors.New("Hello, World!")))
 }
+
+func TestPollUrl(t *testing.T) {
+	assert.Equal(t, "http://eth:8545", pollUrl(vaa.ChainIDEthereum, "ws://eth:8545", ""))
+	assert.Equal(t, "https://eth.example.com/v1/key", pollUrl(vaa.ChainIDEthereum, "wss://eth.example.com/v1/key", ""))
+	assert.Equal(t, "https://eth-http.example.com", pollUrl(vaa.ChainIDEthereum, "wss://eth.example.com", "https://eth-http.example.com"))
+	assert.Equal(t, "", pollUrl(vaa.ChainIDEthereum, "http://eth:8545", ""))
+	assert.Equal(t, "", pollUrl(vaa.ChainIDCelo, "ws://celo:8545", ""))
+}
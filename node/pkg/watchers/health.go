@@ -0,0 +1,185 @@
+package watchers
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/finality"
+	"github.com/certusone/wormhole/node/pkg/p2p"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+const (
+	// StaleAfter is how long a watcher may go without the height of its chain increasing before it is unhealthy.
+	StaleAfter = 5 * time.Minute
+
+	// UnhealthyScore is the health score below which a watcher is restarted.
+	UnhealthyScore = 50
+
+	// Penalties subtracted from the health score of 100.
+	lagPenalty      = 25 // no new block for half of StaleAfter
+	errorPenalty    = 5  // per error reported in the last errorWindow
+	maxErrorPenalty = 50 // errors alone never make a watcher unhealthy
+
+	errorWindow = 5 * time.Minute
+)
+
+// HealthStatus is a snapshot of how well a watcher keeps up with its chain.
+type HealthStatus struct {
+	// Score between 0 and 100, see UnhealthyScore.
+	Score int
+	// Latest height of the chain reported by the watcher.
+	Height int64
+	// Time the height last increased, or the time the watcher was created if it never did.
+	LastProgress time.Time
+	// Number of errors reported in the last five minutes.
+	RecentErrors int
+}
+
+func (s HealthStatus) Healthy() bool {
+	return s.Score >= UnhealthyScore
+}
+
+// Base implements the parts of the Watcher interface that are the same for every watcher. Watchers embed it, report
+// their network stats and errors through it instead of p2p.DefaultRegistry, and implement Run.
+type Base struct {
+	chainID  vaa.ChainID
+	version  string
+	msgC     chan<- *common.MessagePublication
+	obsvReqC chan<- *gossipv1.ObservationRequest
+
+	mu           sync.Mutex
+	finality     finality.Strategy
+	height       int64
+	lastProgress time.Time
+	errors       []time.Time
+}
+
+// NewBase creates the Base of a watcher. version identifies the implementation of the watcher in heartbeats, e.g.
+// "evm/1". obsvReqC may be nil if the watcher does not handle re-observation requests.
+func NewBase(chainID vaa.ChainID, version string, msgC chan<- *common.MessagePublication, obsvReqC chan<- *gossipv1.ObservationRequest) *Base {
+	return &Base{
+		chainID:      chainID,
+		version:      version,
+		msgC:         msgC,
+		obsvReqC:     obsvReqC,
+		finality:     finality.Default(chainID),
+		lastProgress: time.Now(),
+	}
+}
+
+func (b *Base) ObservationChannel() chan<- *common.MessagePublication {
+	return b.msgC
+}
+
+// Reobserve queues a re-observation request for the watcher. It does not block: an error is returned if the watcher
+// does not handle re-observation requests or its queue is full.
+func (b *Base) Reobserve(req *gossipv1.ObservationRequest) error {
+	if vaa.ChainID(req.ChainId) != b.chainID {
+		return fmt.Errorf("re-observation request for chain %d sent to the watcher of chain %s", req.ChainId, b.chainID)
+	}
+	if b.obsvReqC == nil {
+		return fmt.Errorf("the watcher of chain %s does not handle re-observation requests", b.chainID)
+	}
+	select {
+	case b.obsvReqC <- req:
+		return nil
+	default:
+		return fmt.Errorf("the re-observation queue of chain %s is full", b.chainID)
+	}
+}
+
+// SetFinality sets the finality strategy the watcher uses, which is published in heartbeats. It defaults to the
+// default strategy of the chain, see finality.Default.
+func (b *Base) SetFinality(strategy finality.Strategy) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.finality = strategy
+}
+
+// Finality returns the finality strategy the watcher uses.
+func (b *Base) Finality() finality.Strategy {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.finality
+}
+
+// SetNetworkStats publishes the network stats of the chain, the version and the finality strategy of the watcher in
+// heartbeats and records the progress of the watcher.
+func (b *Base) SetNetworkStats(stats *gossipv1.Heartbeat_Network) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	stats.WatcherVersion = b.version
+	if b.finality.IsSet() {
+		stats.Finality = b.finality.String()
+	}
+	p2p.DefaultRegistry.SetNetworkStats(b.chainID, stats)
+
+	if stats.Height > b.height {
+		b.height = stats.Height
+		b.lastProgress = time.Now()
+	}
+}
+
+// AddErrorCount counts errors of the watcher in heartbeats and in its health score.
+func (b *Base) AddErrorCount(delta uint64) {
+	p2p.DefaultRegistry.AddErrorCount(b.chainID, delta)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := time.Now()
+	b.errors = append(b.pruneErrors(now), now)
+}
+
+// pruneErrors drops the errors that no longer count towards the health score. b.mu must be held.
+func (b *Base) pruneErrors(now time.Time) []time.Time {
+	for len(b.errors) > 0 && now.Sub(b.errors[0]) > errorWindow {
+		b.errors = b.errors[1:]
+	}
+	// Only keep as many errors as can be penalized.
+	if limit := maxErrorPenalty / errorPenalty; len(b.errors) >= limit {
+		b.errors = b.errors[len(b.errors)-limit+1:]
+	}
+	return b.errors
+}
+
+func (b *Base) HealthStatus() HealthStatus {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := time.Now()
+	recentErrors := 0
+	for _, t := range b.errors {
+		if now.Sub(t) <= errorWindow {
+			recentErrors++
+		}
+	}
+
+	status := HealthStatus{
+		Height:       b.height,
+		LastProgress: b.lastProgress,
+		RecentErrors: recentErrors,
+	}
+	status.Score = healthScore(now.Sub(b.lastProgress), recentErrors)
+	return status
+}
+
+// healthScore scores a watcher whose chain height last increased sinceProgress ago. A stalled watcher scores 0, a
+// lagging one loses lagPenalty and every recent error costs errorPenalty.
+func healthScore(sinceProgress time.Duration, recentErrors int) int {
+	if sinceProgress >= StaleAfter {
+		return 0
+	}
+	score := 100
+	if sinceProgress >= StaleAfter/2 {
+		score -= lagPenalty
+	}
+	penalty := errorPenalty * recentErrors
+	if penalty > maxErrorPenalty {
+		penalty = maxErrorPenalty
+	}
+	return score - penalty
+}
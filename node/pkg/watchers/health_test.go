@@ -0,0 +1,101 @@
+package watchers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/finality"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestHealthScore(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		sinceProgress time.Duration
+		recentErrors  int
+		score         int
+	}{
+		{"healthy", time.Second, 0, 100},
+		{"errors", time.Second, 3, 85},
+		{"many errors", time.Second, 100, 50},
+		{"lagging", StaleAfter / 2, 0, 75},
+		{"lagging with errors", StaleAfter / 2, 10, 25},
+		{"stale", StaleAfter, 0, 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.score, healthScore(tc.sinceProgress, tc.recentErrors))
+		})
+	}
+}
+
+func TestBaseFinality(t *testing.T) {
+	b := NewBase(vaa.ChainIDEthereum, "test/1", make(chan *common.MessagePublication), nil)
+	assert.Equal(t, finality.Strategy{Mode: finality.ModeSafe}, b.Finality())
+
+	b.SetFinality(finality.Strategy{Mode: finality.ModeDepth, Depth: 12})
+	stats := &gossipv1.Heartbeat_Network{}
+	b.SetNetworkStats(stats)
+	assert.Equal(t, "depth:12", stats.Finality)
+
+	// Chains without a known finality do not publish one.
+	b = NewBase(vaa.ChainIDGnosis, "test/1", make(chan *common.MessagePublication), nil)
+	stats = &gossipv1.Heartbeat_Network{}
+	b.SetNetworkStats(stats)
+	assert.Empty(t, stats.Finality)
+}
+
+func TestBaseHealthStatus(t *testing.T) {
+	b := NewBase(vaa.ChainIDEthereum, "test/1", make(chan *common.MessagePublication), nil)
+
+	stats := &gossipv1.Heartbeat_Network{Height: 10}
+	b.SetNetworkStats(stats)
+	assert.Equal(t, "test/1", stats.WatcherVersion)
+	assert.Equal(t, "safe", stats.Finality)
+	status := b.HealthStatus()
+	assert.Equal(t, int64(10), status.Height)
+	assert.Equal(t, 100, status.Score)
+	assert.True(t, status.Healthy())
+
+	for i := 0; i < 3; i++ {
+		b.AddErrorCount(1)
+	}
+	status = b.HealthStatus()
+	assert.Equal(t, 3, status.RecentErrors)
+	assert.Equal(t, 85, status.Score)
+
+	// Only the errors that can still be penalized are kept.
+	for i := 0; i < 20; i++ {
+		b.AddErrorCount(1)
+	}
+	assert.Len(t, b.errors, maxErrorPenalty/errorPenalty)
+	assert.True(t, b.HealthStatus().Healthy())
+
+	// A watcher whose height does not increase goes stale.
+	b.lastProgress = time.Now().Add(-StaleAfter)
+	b.SetNetworkStats(&gossipv1.Heartbeat_Network{Height: 10})
+	assert.False(t, b.HealthStatus().Healthy())
+	b.SetNetworkStats(&gossipv1.Heartbeat_Network{Height: 11})
+	assert.True(t, b.HealthStatus().Healthy())
+}
+
+func TestBaseReobserve(t *testing.T) {
+	obsvReqC := make(chan *gossipv1.ObservationRequest, 1)
+	b := NewBase(vaa.ChainIDEthereum, "test/1", make(chan *common.MessagePublication), obsvReqC)
+
+	req := &gossipv1.ObservationRequest{ChainId: uint32(vaa.ChainIDEthereum), TxHash: []byte{1}}
+	assert.NoError(t, b.Reobserve(req))
+	assert.Equal(t, req, <-obsvReqC)
+
+	// The request is not queued if the queue is full or the chain does not match.
+	obsvReqC <- req
+	assert.Error(t, b.Reobserve(req))
+	<-obsvReqC
+	assert.Error(t, b.Reobserve(&gossipv1.ObservationRequest{ChainId: uint32(vaa.ChainIDSolana)}))
+	assert.Empty(t, obsvReqC)
+
+	b = NewBase(vaa.ChainIDEthereum, "test/1", make(chan *common.MessagePublication), nil)
+	assert.Error(t, b.Reobserve(req))
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/certusone/wormhole/node/pkg/p2p"
 	"github.com/certusone/wormhole/node/pkg/readiness"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/certusone/wormhole/node/pkg/watchers"
 	"github.com/certusone/wormhole/node/pkg/watchers/cosmwasm"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 
@@ -242,7 +243,10 @@ func (w *Watcher) Run(ctx context.Context) error {
 
 	for _, ce := range w.chainMap {
 		w.logger.Info("will monitor chain over IBC", zap.String("chain", ce.chainName))
-		p2p.DefaultRegistry.SetNetworkStats(ce.chainID, &gossipv1.Heartbeat_Network{ContractAddress: w.contractAddress})
+		p2p.DefaultRegistry.SetNetworkStats(ce.chainID, &gossipv1.Heartbeat_Network{
+			ContractAddress: w.contractAddress,
+			WatcherVersion:  watchers.VersionIBC,
+		})
 	}
 
 	c, _, err := websocket.Dial(ctx, w.wsUrl, nil)
@@ -416,9 +420,12 @@ func (w *Watcher) handleQueryBlockHeight(ctx context.Context, queryUrl string) e
 
 			for _, ce := range w.chainMap {
 				currentSlotHeight.WithLabelValues(ce.chainName).Set(float64(blockHeight))
+				// Wormchain has instant finality.
 				p2p.DefaultRegistry.SetNetworkStats(ce.chainID, &gossipv1.Heartbeat_Network{
 					Height:          blockHeight,
+					FinalizedHeight: blockHeight,
 					ContractAddress: w.contractAddress,
+					WatcherVersion:  watchers.VersionIBC,
 				})
 
 				readiness.SetReady(ce.readiness)
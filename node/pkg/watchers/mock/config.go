@@ -4,7 +4,6 @@ import (
 	"github.com/certusone/wormhole/node/pkg/common"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/certusone/wormhole/node/pkg/query"
-	"github.com/certusone/wormhole/node/pkg/supervisor"
 	"github.com/certusone/wormhole/node/pkg/watchers"
 	"github.com/certusone/wormhole/node/pkg/watchers/interfaces"
 	eth_common "github.com/ethereum/go-ethereum/common"
@@ -42,11 +41,11 @@ func (wc *WatcherConfig) SetL1Finalizer(l1finalizer interfaces.L1Finalizer) {
 
 func (wc *WatcherConfig) Create(
 	msgC chan<- *common.MessagePublication,
-	obsvReqC <-chan *gossipv1.ObservationRequest,
+	obsvReqC chan *gossipv1.ObservationRequest,
 	_ <-chan *query.PerChainQueryInternal,
 	_ chan<- *query.PerChainQueryResponseInternal,
 	setC chan<- *common.GuardianSet,
 	env common.Environment,
-) (interfaces.L1Finalizer, supervisor.Runnable, error) {
-	return MockL1Finalizer{}, NewWatcherRunnable(msgC, obsvReqC, setC, wc), nil
+) (interfaces.L1Finalizer, watchers.Watcher, error) {
+	return MockL1Finalizer{}, NewWatcher(msgC, obsvReqC, setC, wc), nil
 }
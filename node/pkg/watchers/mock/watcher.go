@@ -2,54 +2,79 @@ package mock
 
 import (
 	"context"
+	"time"
 
 	"github.com/certusone/wormhole/node/pkg/common"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/certusone/wormhole/node/pkg/watchers"
 	eth_common "github.com/ethereum/go-ethereum/common"
 	"go.uber.org/zap"
 )
 
-func NewWatcherRunnable(
+type Watcher struct {
+	*watchers.Base
+
+	msgC     chan<- *common.MessagePublication
+	obsvReqC <-chan *gossipv1.ObservationRequest
+	setC     chan<- *common.GuardianSet
+	c        *WatcherConfig
+}
+
+func NewWatcher(
 	msgC chan<- *common.MessagePublication,
-	obsvReqC <-chan *gossipv1.ObservationRequest,
+	obsvReqC chan *gossipv1.ObservationRequest,
 	setC chan<- *common.GuardianSet,
 	c *WatcherConfig,
-) supervisor.Runnable {
-	return func(ctx context.Context) error {
-		logger := supervisor.Logger(ctx)
-		supervisor.Signal(ctx, supervisor.SignalHealthy)
+) *Watcher {
+	return &Watcher{
+		Base:     watchers.NewBase(c.ChainID, watchers.VersionMock, msgC, obsvReqC),
+		msgC:     msgC,
+		obsvReqC: obsvReqC,
+		setC:     setC,
+		c:        c,
+	}
+}
 
-		if c.L1FinalizerRequired != "" && c.l1Finalizer == nil {
-			logger.Fatal("Mock watcher: L1FinalizerRequired but not set.")
-		}
+func (w *Watcher) Run(ctx context.Context) error {
+	c := w.c
+	logger := supervisor.Logger(ctx)
+	supervisor.Signal(ctx, supervisor.SignalHealthy)
 
-		logger.Info("Mock Watcher running.")
-
-		for {
-			select {
-			case <-ctx.Done():
-				logger.Info("Mock Watcher shutting down.")
-				return nil
-			case observation := <-c.MockObservationC:
-				logger.Info("message observed", observation.ZapFields(zap.String("digest", observation.CreateDigest()))...)
-				msgC <- observation
-			case gs := <-c.MockSetC:
-				setC <- gs
-			case o := <-obsvReqC:
-				hash := eth_common.BytesToHash(o.TxHash)
-				logger.Info("Received obsv request", zap.String("log_msg_type", "obsv_req_received"), zap.String("tx_hash", hash.Hex()))
-				msg, ok := c.ObservationDb[hash]
-				if ok {
-					msg2 := *msg
-					msg2.IsReobservation = true
-					msgC <- &msg2
-				}
+	if c.L1FinalizerRequired != "" && c.l1Finalizer == nil {
+		logger.Fatal("Mock watcher: L1FinalizerRequired but not set.")
+	}
+
+	logger.Info("Mock Watcher running.")
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("Mock Watcher shutting down.")
+			return nil
+		case observation := <-c.MockObservationC:
+			logger.Info("message observed", observation.ZapFields(zap.String("digest", observation.CreateDigest()))...)
+			w.msgC <- observation
+		case gs := <-c.MockSetC:
+			w.setC <- gs
+		case o := <-w.obsvReqC:
+			hash := eth_common.BytesToHash(o.TxHash)
+			logger.Info("Received obsv request", zap.String("log_msg_type", "obsv_req_received"), zap.String("tx_hash", hash.Hex()))
+			msg, ok := c.ObservationDb[hash]
+			if ok {
+				msg2 := *msg
+				msg2.IsReobservation = true
+				w.msgC <- &msg2
 			}
 		}
 	}
 }
 
+// HealthStatus always reports the mock watcher as healthy because it does not follow a chain.
+func (w *Watcher) HealthStatus() watchers.HealthStatus {
+	return watchers.HealthStatus{Score: 100, LastProgress: time.Now()}
+}
+
 type MockL1Finalizer struct{}
 
 func (f MockL1Finalizer) GetLatestFinalizedBlockNumber() uint64 {
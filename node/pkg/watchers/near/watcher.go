@@ -7,10 +7,10 @@ import (
 	"time"
 
 	"github.com/certusone/wormhole/node/pkg/common"
-	"github.com/certusone/wormhole/node/pkg/p2p"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/certusone/wormhole/node/pkg/readiness"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/certusone/wormhole/node/pkg/watchers"
 	"github.com/certusone/wormhole/node/pkg/watchers/near/nearapi"
 	"github.com/mr-tron/base58"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
@@ -63,6 +63,8 @@ type (
 	}
 
 	Watcher struct {
+		*watchers.Base
+
 		mainnet         bool
 		wormholeAccount string // name of the Wormhole Account on the NEAR blockchain
 		nearRPC         string
@@ -95,10 +97,11 @@ func NewWatcher(
 	nearRPC string,
 	wormholeContract string,
 	msgC chan<- *common.MessagePublication,
-	obsvReqC <-chan *gossipv1.ObservationRequest,
+	obsvReqC chan *gossipv1.ObservationRequest,
 	mainnet bool,
 ) *Watcher {
 	return &Watcher{
+		Base:                         watchers.NewBase(vaa.ChainIDNear, watchers.VersionNear, msgC, obsvReqC),
 		mainnet:                      mainnet,
 		wormholeAccount:              wormholeContract,
 		nearRPC:                      nearRPC,
@@ -149,8 +152,9 @@ func (e *Watcher) runBlockPoll(ctx context.Context) error {
 				logger.Warn("NEAR poll error", zap.String("log_msg_type", "block_poll_error"), zap.String("error", err.Error()))
 			}
 
-			p2p.DefaultRegistry.SetNetworkStats(vaa.ChainIDNear, &gossipv1.Heartbeat_Network{
+			e.SetNetworkStats(&gossipv1.Heartbeat_Network{
 				Height:          int64(highestFinalBlockHeightObserved),
+				FinalizedHeight: int64(highestFinalBlockHeightObserved),
 				ContractAddress: e.wormholeAccount,
 			})
 			readiness.SetReady(e.readinessSync)
@@ -172,7 +176,7 @@ func (e *Watcher) runChunkFetcher(ctx context.Context) error {
 			newJobs, err := e.fetchAndParseChunk(logger, ctx, chunkHeader)
 			if err != nil {
 				logger.Warn("near.processChunk failed", zap.String("log_msg_type", "chunk_processing_failed"), zap.String("error", err.Error()))
-				p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDNear, 1)
+				e.AddErrorCount(1)
 				continue
 			}
 			for _, job := range newJobs {
@@ -251,7 +255,7 @@ func (e *Watcher) runTxProcessor(ctx context.Context) error {
 						zap.String("tx_hash", job.txHash),
 						zap.String("error", err.Error()),
 					)
-					p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDNear, 1)
+					e.AddErrorCount(1)
 				}
 			}
 
@@ -279,7 +283,7 @@ func (e *Watcher) Run(ctx context.Context) error {
 	e.nearAPI = nearapi.NewNearApiImpl(nearapi.NewHttpNearRpc(e.nearRPC))
 	e.finalizer = newFinalizer(e.eventChan, e.nearAPI, e.mainnet)
 
-	p2p.DefaultRegistry.SetNetworkStats(vaa.ChainIDNear, &gossipv1.Heartbeat_Network{
+	e.SetNetworkStats(&gossipv1.Heartbeat_Network{
 		ContractAddress: e.wormholeAccount,
 	})
 
@@ -314,7 +318,7 @@ func (e *Watcher) Run(ctx context.Context) error {
 // is cancelled before delay has passed and the job is picked up by a worker.
 func (e *Watcher) schedule(ctx context.Context, job *transactionProcessingJob, delay time.Duration) error {
 	if int(e.transactionProcessingQueueCounter.Load())+len(e.transactionProcessingQueue) > queueSize {
-		p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDNear, 1)
+		e.AddErrorCount(1)
 		return fmt.Errorf("NEAR transactionProcessingQueue exceeds max queue size. Skipping transaction.")
 	}
 
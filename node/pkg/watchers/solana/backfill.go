@@ -0,0 +1,99 @@
+package solana
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/watchers"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+)
+
+// maxBackfillSlots is the maximum number of slots a single backfill scans, about 11 hours of slots.
+const maxBackfillSlots = 100_000
+
+// backfillSlotsPerSecond limits the rate at which a backfill requests blocks, so it doesn't exhaust the rate limit of
+// the RPC node the watcher depends on for new blocks.
+const backfillSlotsPerSecond = 10
+
+var solanaBackfilledSlots = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "wormhole_solana_backfilled_slots_total",
+		Help: "Total number of Solana slots scanned by backfills",
+	}, []string{"solana_network", "result"})
+
+var _ watchers.Backfiller = (*SolanaWatcher)(nil)
+
+type backfillRange struct {
+	from uint64
+	to   uint64
+}
+
+// Backfill implements watchers.Backfiller. The watcher scans the slots in the background at backfillSlotsPerSecond,
+// and observes the messages of the core contract it finds as re-observations.
+func (s *SolanaWatcher) Backfill(from uint64, to uint64) error {
+	if from > to {
+		return fmt.Errorf("invalid slot range [%d, %d]", from, to)
+	}
+	if to-from >= maxBackfillSlots {
+		return fmt.Errorf("slot range [%d, %d] exceeds the maximum of %d slots", from, to, maxBackfillSlots)
+	}
+	if latest := s.GetLatestFinalizedBlockNumber(); latest != 0 && to > latest {
+		return fmt.Errorf("slot %d was not processed by the watcher yet, the latest is %d", to, latest)
+	}
+
+	select {
+	case s.backfillC <- backfillRange{from: from, to: to}:
+		return nil
+	default:
+		return errors.New("another backfill is still queued")
+	}
+}
+
+// runBackfills scans the queued slot ranges, one at a time.
+func (s *SolanaWatcher) runBackfills(ctx context.Context, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case r := <-s.backfillC:
+			s.backfillSlots(ctx, logger, r.from, r.to)
+		}
+	}
+}
+
+func (s *SolanaWatcher) backfillSlots(ctx context.Context, logger *zap.Logger, from uint64, to uint64) {
+	logger.Info("backfilling slots", zap.Uint64("from", from), zap.Uint64("to", to), zap.String("commitment", string(s.commitment)))
+	start := time.Now()
+
+	limiter := rate.NewLimiter(backfillSlotsPerSecond, 1)
+	var failed uint64
+	for slot := from; slot <= to; slot++ {
+		ok := false
+		for retry := 0; !ok && retry <= maxRetries; retry++ {
+			if err := limiter.Wait(ctx); err != nil {
+				logger.Info("backfill canceled", zap.Uint64("slot", slot))
+				return
+			}
+			ok = s.fetchBlock(ctx, logger, slot, 0, true)
+		}
+
+		if !ok {
+			failed++
+			logger.Error("failed to backfill slot", zap.Uint64("slot", slot))
+			solanaBackfilledSlots.WithLabelValues(s.networkName, "failed").Inc()
+			continue
+		}
+		solanaBackfilledSlots.WithLabelValues(s.networkName, "scanned").Inc()
+	}
+
+	logger.Info("finished backfilling slots",
+		zap.Uint64("from", from),
+		zap.Uint64("to", to),
+		zap.Uint64("failed", failed),
+		zap.Duration("took", time.Since(start)))
+}
@@ -0,0 +1,29 @@
+package solana
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackfillValidatesRange(t *testing.T) {
+	s := &SolanaWatcher{backfillC: make(chan backfillRange, 1)}
+	s.updateLatestBlock(1_000_000)
+
+	assert.ErrorContains(t, s.Backfill(200, 100), "invalid slot range")
+	assert.ErrorContains(t, s.Backfill(100, 100+maxBackfillSlots), "exceeds the maximum")
+	assert.ErrorContains(t, s.Backfill(999_990, 1_000_001), "was not processed by the watcher yet")
+	assert.Empty(t, s.backfillC)
+
+	require.NoError(t, s.Backfill(100, 100+maxBackfillSlots-1))
+	assert.Equal(t, backfillRange{from: 100, to: 100 + maxBackfillSlots - 1}, <-s.backfillC)
+}
+
+func TestBackfillRejectsSecondQueuedRange(t *testing.T) {
+	s := &SolanaWatcher{backfillC: make(chan backfillRange, 1)}
+
+	require.NoError(t, s.Backfill(100, 200))
+	assert.ErrorContains(t, s.Backfill(300, 400), "another backfill is still queued")
+	assert.Equal(t, backfillRange{from: 100, to: 200}, <-s.backfillC)
+}
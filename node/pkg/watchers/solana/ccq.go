@@ -128,8 +128,14 @@ func (w *SolanaWatcher) ccqBaseHandleSolanaAccountQueryRequest(
 		}
 	}
 
-	// Read the accounts.
-	info, err := w.getMultipleAccountsWithOpts(rCtx, accounts, &params)
+	// Read the accounts. A single account is read using getAccountInfo, and the result is normalized to look like getMultipleAccounts.
+	var info *rpc.GetMultipleAccountsResult
+	var err error
+	if len(accounts) == 1 {
+		info, err = w.getAccountInfoWithOpts(rCtx, accounts[0], &params)
+	} else {
+		info, err = w.getMultipleAccountsWithOpts(rCtx, accounts, &params)
+	}
 	if err != nil {
 		if w.ccqCheckForMinSlotContext(ctx, queryRequest, req, requestId, err, giveUpTime, !isRetry, tag, publisher, numFastRetries) {
 			// Return without posting a response because a go routine was created to handle it.
@@ -146,6 +152,18 @@ func (w *SolanaWatcher) ccqBaseHandleSolanaAccountQueryRequest(
 		return
 	}
 
+	// Extract the results.
+	results, err := ccqExtractAccountResults(info, len(req.Accounts))
+	if err != nil {
+		w.ccqLogger.Error(fmt.Sprintf("read for %s query request returned invalid results", tag),
+			zap.String("requestId", requestId),
+			zap.Any("accounts", accounts),
+			zap.Error(err),
+		)
+		w.ccqSendErrorResponse(queryRequest, query.QueryFatalError)
+		return
+	}
+
 	// Read the block for this slot to get the block time.
 	var block *rpc.GetBlockResult
 	var numBlockReadAttempts int
@@ -187,51 +205,15 @@ func (w *SolanaWatcher) ccqBaseHandleSolanaAccountQueryRequest(
 		time.Sleep(CCQ_BLOCK_RETRY_DELAY)
 	}
 
-	if info == nil {
-		w.ccqLogger.Error(fmt.Sprintf("read for %s query request returned nil info", tag), zap.String("requestId", requestId))
-		w.ccqSendErrorResponse(queryRequest, query.QueryFatalError)
-		return
-	}
-
-	if info.Value == nil {
-		w.ccqLogger.Error(fmt.Sprintf("read for %s query request returned nil value", tag), zap.String("requestId", requestId))
-		w.ccqSendErrorResponse(queryRequest, query.QueryFatalError)
-		return
-	}
-
-	if len(info.Value) != len(req.Accounts) {
-		w.ccqLogger.Error(fmt.Sprintf("read for %s query request returned unexpected number of results", tag),
+	if block.BlockTime == nil {
+		w.ccqLogger.Error(fmt.Sprintf("block for %s query request does not have a block time", tag),
 			zap.String("requestId", requestId),
-			zap.Int("numAccounts", len(req.Accounts)),
-			zap.Int("numValues", len(info.Value)),
+			zap.Uint64("slotNumber", info.Context.Slot),
 		)
-
-		w.ccqSendErrorResponse(queryRequest, query.QueryFatalError)
+		w.ccqSendErrorResponse(queryRequest, query.QueryRetryNeeded)
 		return
 	}
 
-	// Extract the results.
-	results := make([]query.SolanaAccountResult, 0, len(req.Accounts))
-	for idx, val := range info.Value {
-		if val == nil { // This can happen for an invalid account.
-			w.ccqLogger.Error(fmt.Sprintf("read of account for %s query request failed, val is nil", tag), zap.String("requestId", requestId), zap.Any("account", req.Accounts[idx]))
-			w.ccqSendErrorResponse(queryRequest, query.QueryFatalError)
-			return
-		}
-		if val.Data == nil {
-			w.ccqLogger.Error(fmt.Sprintf("read of account for %s query request failed, data is nil", tag), zap.String("requestId", requestId), zap.Any("account", req.Accounts[idx]))
-			w.ccqSendErrorResponse(queryRequest, query.QueryFatalError)
-			return
-		}
-		results = append(results, query.SolanaAccountResult{
-			Lamports:   val.Lamports,
-			RentEpoch:  val.RentEpoch,
-			Executable: val.Executable,
-			Owner:      val.Owner,
-			Data:       val.Data.GetBinary(),
-		})
-	}
-
 	// Finally, build the response and publish it.
 	resp := &query.SolanaAccountQueryResponse{
 		SlotNumber: info.Context.Slot,
@@ -245,7 +227,7 @@ func (w *SolanaWatcher) ccqBaseHandleSolanaAccountQueryRequest(
 		zap.Uint64("slotNumber", info.Context.Slot),
 		zap.Uint64("blockTime", uint64(*block.BlockTime)),
 		zap.String("blockHash", hex.EncodeToString(block.Blockhash[:])),
-		zap.Uint64("blockHeight", *block.BlockHeight),
+		zap.Any("blockHeight", block.BlockHeight),
 		zap.Int("numFastRetries", numFastRetries),
 	)
 
@@ -253,6 +235,40 @@ func (w *SolanaWatcher) ccqBaseHandleSolanaAccountQueryRequest(
 	publisher.publish(query.CreatePerChainQueryResponseInternal(queryRequest.RequestID, queryRequest.RequestIdx, queryRequest.Request.ChainId, query.QuerySuccess, resp), resp)
 }
 
+// ccqExtractAccountResults verifies the result of an account read and converts it to the per account query results.
+func ccqExtractAccountResults(info *rpc.GetMultipleAccountsResult, numAccounts int) ([]query.SolanaAccountResult, error) {
+	if info == nil {
+		return nil, errors.New("info is nil")
+	}
+
+	if info.Value == nil {
+		return nil, errors.New("value is nil")
+	}
+
+	if len(info.Value) != numAccounts {
+		return nil, fmt.Errorf("unexpected number of results, expected %d, received %d", numAccounts, len(info.Value))
+	}
+
+	results := make([]query.SolanaAccountResult, 0, numAccounts)
+	for idx, val := range info.Value {
+		if val == nil { // This can happen for an invalid account.
+			return nil, fmt.Errorf("read of account %d failed, val is nil", idx)
+		}
+		if val.Data == nil {
+			return nil, fmt.Errorf("read of account %d failed, data is nil", idx)
+		}
+		results = append(results, query.SolanaAccountResult{
+			Lamports:   val.Lamports,
+			RentEpoch:  val.RentEpoch,
+			Executable: val.Executable,
+			Owner:      val.Owner,
+			Data:       val.Data.GetBinary(),
+		})
+	}
+
+	return results, nil
+}
+
 // ccqCheckForMinSlotContext checks to see if the returned error was due to the min context slot not being reached.
 // If so, and the estimated time in the future is not too great, it kicks off a go routine to sleep and do a retry.
 // In that case, it returns true, telling the caller that it is handling the request so it should not post a response.
@@ -532,6 +548,51 @@ func (w *SolanaWatcher) getMultipleAccountsWithOpts(
 	accounts []solana.PublicKey,
 	opts *rpc.GetMultipleAccountsOpts,
 ) (out *rpc.GetMultipleAccountsResult, err error) {
+	params, err := ccqBuildAccountParams(accounts, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	err = w.rpcClient.RPCCallForInto(ctx, &out, "getMultipleAccounts", params)
+	if err != nil {
+		return nil, err
+	}
+	if out.Value == nil {
+		return nil, rpc.ErrNotFound
+	}
+	return
+}
+
+// getAccountInfoWithOpts reads a single account using getAccountInfo, honoring MinContextSlot like getMultipleAccountsWithOpts.
+// The result is normalized to a getMultipleAccounts result containing a single value, so both reads can be handled the same way.
+func (w *SolanaWatcher) getAccountInfoWithOpts(
+	ctx context.Context,
+	account solana.PublicKey,
+	opts *rpc.GetMultipleAccountsOpts,
+) (*rpc.GetMultipleAccountsResult, error) {
+	params, err := ccqBuildAccountParams(account, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var out *rpc.GetAccountInfoResult
+	err = w.rpcClient.RPCCallForInto(ctx, &out, "getAccountInfo", params)
+	if err != nil {
+		return nil, err
+	}
+	if out == nil {
+		return nil, rpc.ErrNotFound
+	}
+
+	// A nil value means the account does not exist. That is reported when the results are extracted.
+	return &rpc.GetMultipleAccountsResult{
+		RPCContext: out.RPCContext,
+		Value:      []*rpc.Account{out.Value},
+	}, nil
+}
+
+// ccqBuildAccountParams builds the parameters for getAccountInfo and getMultipleAccounts, which take the same options.
+func ccqBuildAccountParams(accounts interface{}, opts *rpc.GetMultipleAccountsOpts) ([]interface{}, error) {
 	params := []interface{}{accounts}
 
 	if opts != nil {
@@ -559,14 +620,7 @@ func (w *SolanaWatcher) getMultipleAccountsWithOpts(
 		}
 	}
 
-	err = w.rpcClient.RPCCallForInto(ctx, &out, "getMultipleAccounts", params)
-	if err != nil {
-		return nil, err
-	}
-	if out.Value == nil {
-		return nil, rpc.ErrNotFound
-	}
-	return
+	return params, nil
 }
 
 // ccqIsBlockNotAvailable parses an error to see if it is a "Block not available for slot" error.
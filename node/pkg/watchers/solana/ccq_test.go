@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/certusone/wormhole/node/pkg/query"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
 	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
 
 	"github.com/stretchr/testify/assert"
@@ -135,3 +137,59 @@ func TestCcqIsBlockNotAvailableWrongTextFailure(t *testing.T) {
 
 	assert.False(t, ccqIsBlockNotAvailable(error(myErr)))
 }
+
+func TestCcqExtractAccountResultsSuccess(t *testing.T) {
+	owner := solana.MustPublicKeyFromBase58("Bridge1p5gheXUvJ6jGWGeCsgPKgnE3YgdGKRVCMY9o")
+	info := &rpc.GetMultipleAccountsResult{
+		RPCContext: rpc.RPCContext{Context: rpc.Context{Slot: 13526}},
+		Value: []*rpc.Account{
+			{
+				Lamports:   1000,
+				Owner:      owner,
+				Data:       rpc.DataBytesOrJSONFromBytes([]byte("Account data")),
+				Executable: true,
+				RentEpoch:  42,
+			},
+		},
+	}
+
+	results, err := ccqExtractAccountResults(info, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(results))
+	assert.Equal(t, uint64(1000), results[0].Lamports)
+	assert.Equal(t, uint64(42), results[0].RentEpoch)
+	assert.True(t, results[0].Executable)
+	assert.Equal(t, [32]byte(owner), results[0].Owner)
+	assert.Equal(t, []byte("Account data"), results[0].Data)
+}
+
+func TestCcqExtractAccountResultsNilInfoFailure(t *testing.T) {
+	_, err := ccqExtractAccountResults(nil, 1)
+	assert.EqualError(t, err, "info is nil")
+}
+
+func TestCcqExtractAccountResultsWrongNumberOfResultsFailure(t *testing.T) {
+	info := &rpc.GetMultipleAccountsResult{Value: []*rpc.Account{}}
+	_, err := ccqExtractAccountResults(info, 1)
+	assert.EqualError(t, err, "unexpected number of results, expected 1, received 0")
+}
+
+func TestCcqExtractAccountResultsMissingAccountFailure(t *testing.T) {
+	// This is what getAccountInfo gets normalized to for an account that does not exist.
+	info := &rpc.GetMultipleAccountsResult{Value: []*rpc.Account{nil}}
+	_, err := ccqExtractAccountResults(info, 1)
+	assert.EqualError(t, err, "read of account 0 failed, val is nil")
+}
+
+func TestCcqBuildAccountParams(t *testing.T) {
+	minContextSlot := uint64(13526)
+	params, err := ccqBuildAccountParams(solana.SystemProgramID, &rpc.GetMultipleAccountsOpts{
+		Encoding:       solana.EncodingBase64,
+		Commitment:     rpc.CommitmentFinalized,
+		MinContextSlot: &minContextSlot,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(params))
+	assert.Equal(t, solana.SystemProgramID, params[0])
+	assert.Equal(t, M{"encoding": solana.EncodingBase64, "commitment": rpc.CommitmentFinalized, "minContextSlot": minContextSlot}, params[1])
+}
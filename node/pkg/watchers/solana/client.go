@@ -13,11 +13,11 @@ import (
 	"encoding/json"
 
 	"github.com/certusone/wormhole/node/pkg/common"
-	"github.com/certusone/wormhole/node/pkg/p2p"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/certusone/wormhole/node/pkg/query"
 	"github.com/certusone/wormhole/node/pkg/readiness"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/certusone/wormhole/node/pkg/watchers"
 	eth_common "github.com/ethereum/go-ethereum/common"
 	"github.com/gagliardetto/solana-go"
 	lookup "github.com/gagliardetto/solana-go/programs/address-lookup-table"
@@ -36,6 +36,8 @@ import (
 
 type (
 	SolanaWatcher struct {
+		*watchers.Base
+
 		contract    solana.PublicKey
 		rawContract string
 		rpcUrl      string
@@ -76,6 +78,9 @@ type (
 
 		ccqConfig query.PerChainConfig
 		ccqLogger *zap.Logger
+
+		// Slot ranges queued by Backfill
+		backfillC chan backfillRange
 	}
 
 	EventSubscriptionError struct {
@@ -216,7 +221,7 @@ func NewSolanaWatcher(
 	contractAddress solana.PublicKey,
 	rawContract string,
 	msgC chan<- *common.MessagePublication,
-	obsvReqC <-chan *gossipv1.ObservationRequest,
+	obsvReqC chan *gossipv1.ObservationRequest,
 	commitment rpc.CommitmentType,
 	chainID vaa.ChainID,
 	queryReqC <-chan *query.PerChainQueryInternal,
@@ -227,6 +232,7 @@ func NewSolanaWatcher(
 		msgObservedLogLevel = zapcore.DebugLevel
 	}
 	return &SolanaWatcher{
+		Base:                watchers.NewBase(chainID, watchers.VersionSolana, msgC, obsvReqC),
 		rpcUrl:              rpcUrl,
 		wsUrl:               wsUrl,
 		contract:            contractAddress,
@@ -243,6 +249,7 @@ func NewSolanaWatcher(
 		queryReqC:           queryReqC,
 		queryResponseC:      queryResponseC,
 		ccqConfig:           query.GetPerChainConfig(chainID),
+		backfillC:           make(chan backfillRange, 1),
 	}
 }
 
@@ -316,7 +323,7 @@ func (s *SolanaWatcher) readWebSocketWithTimeout(ctx context.Context, ws *websoc
 func (s *SolanaWatcher) Run(ctx context.Context) error {
 	// Initialize gossip metrics (we want to broadcast the address even if we're not yet syncing)
 	contractAddr := base58.Encode(s.contract[:])
-	p2p.DefaultRegistry.SetNetworkStats(s.chainID, &gossipv1.Heartbeat_Network{
+	s.SetNetworkStats(&gossipv1.Heartbeat_Network{
 		ContractAddress: contractAddr,
 	})
 
@@ -361,7 +368,7 @@ func (s *SolanaWatcher) Run(ctx context.Context) error {
 			case msg := <-s.pumpData:
 				err := s.processAccountSubscriptionData(ctx, logger, msg, false)
 				if err != nil {
-					p2p.DefaultRegistry.AddErrorCount(s.chainID, 1)
+					s.AddErrorCount(1)
 					solanaConnectionErrors.WithLabelValues(s.networkName, string(s.commitment), "account_subscription_data").Inc()
 					s.errC <- err
 					return err
@@ -385,7 +392,7 @@ func (s *SolanaWatcher) Run(ctx context.Context) error {
 				cancel()
 				queryLatency.WithLabelValues(s.networkName, "get_slot", string(s.commitment)).Observe(time.Since(start).Seconds())
 				if err != nil {
-					p2p.DefaultRegistry.AddErrorCount(s.chainID, 1)
+					s.AddErrorCount(1)
 					solanaConnectionErrors.WithLabelValues(s.networkName, string(s.commitment), "get_slot_error").Inc()
 					s.errC <- err
 					return err
@@ -397,7 +404,7 @@ func (s *SolanaWatcher) Run(ctx context.Context) error {
 				}
 				currentSolanaHeight.WithLabelValues(s.networkName, string(s.commitment)).Set(float64(slot))
 				readiness.SetReady(s.readinessSync)
-				p2p.DefaultRegistry.SetNetworkStats(s.chainID, &gossipv1.Heartbeat_Network{
+				s.SetNetworkStats(&gossipv1.Heartbeat_Network{
 					Height:          int64(slot),
 					ContractAddress: contractAddr,
 				})
@@ -431,6 +438,10 @@ func (s *SolanaWatcher) Run(ctx context.Context) error {
 		}
 	})
 
+	common.RunWithScissors(ctx, s.errC, "SolanaWatcherBackfill", func(ctx context.Context) error {
+		return s.runBackfills(ctx, logger)
+	})
+
 	if s.commitment == rpc.CommitmentType("finalized") && s.ccqConfig.QueriesSupported() {
 		s.ccqStart(ctx)
 	}
@@ -525,7 +536,7 @@ func (s *SolanaWatcher) fetchBlock(ctx context.Context, logger *zap.Logger, slot
 				logger.Debug("failed to request block", zap.Error(err), zap.Uint64("slot", slot),
 					zap.String("commitment", string(s.commitment)))
 			}
-			p2p.DefaultRegistry.AddErrorCount(s.chainID, 1)
+			s.AddErrorCount(1)
 			solanaConnectionErrors.WithLabelValues(s.networkName, string(s.commitment), "get_confirmed_block_error").Inc()
 		}
 		return false
@@ -756,7 +767,7 @@ func (s *SolanaWatcher) fetchMessageAccount(ctx context.Context, logger *zap.Log
 	})
 	queryLatency.WithLabelValues(s.networkName, "get_account_info", string(s.commitment)).Observe(time.Since(start).Seconds())
 	if err != nil {
-		p2p.DefaultRegistry.AddErrorCount(s.chainID, 1)
+		s.AddErrorCount(1)
 		solanaConnectionErrors.WithLabelValues(s.networkName, string(s.commitment), "get_account_info_error").Inc()
 		logger.Error("failed to request account",
 			zap.Error(err),
@@ -767,7 +778,7 @@ func (s *SolanaWatcher) fetchMessageAccount(ctx context.Context, logger *zap.Log
 	}
 
 	if !info.Value.Owner.Equals(s.contract) {
-		p2p.DefaultRegistry.AddErrorCount(s.chainID, 1)
+		s.AddErrorCount(1)
 		solanaConnectionErrors.WithLabelValues(s.networkName, string(s.commitment), "account_owner_mismatch").Inc()
 		logger.Error("account has invalid owner",
 			zap.Uint64("slot", slot),
@@ -779,7 +790,7 @@ func (s *SolanaWatcher) fetchMessageAccount(ctx context.Context, logger *zap.Log
 
 	data := info.Value.Data.GetBinary()
 	if string(data[:3]) != accountPrefixReliable && string(data[:3]) != accountPrefixUnreliable {
-		p2p.DefaultRegistry.AddErrorCount(s.chainID, 1)
+		s.AddErrorCount(1)
 		solanaConnectionErrors.WithLabelValues(s.networkName, string(s.commitment), "bad_account_data").Inc()
 		logger.Error("account is not a message account",
 			zap.Uint64("slot", slot),
@@ -806,7 +817,7 @@ func (s *SolanaWatcher) processAccountSubscriptionData(_ context.Context, logger
 	err := json.Unmarshal(data, &e)
 	if err != nil {
 		logger.Error(*s.wsUrl, zap.Error(err))
-		p2p.DefaultRegistry.AddErrorCount(s.chainID, 1)
+		s.AddErrorCount(1)
 		return err
 	}
 
@@ -818,7 +829,7 @@ func (s *SolanaWatcher) processAccountSubscriptionData(_ context.Context, logger
 	err = json.Unmarshal(data, &res)
 	if err != nil {
 		logger.Error(*s.wsUrl, zap.Error(err))
-		p2p.DefaultRegistry.AddErrorCount(s.chainID, 1)
+		s.AddErrorCount(1)
 		return err
 	}
 
@@ -837,7 +848,7 @@ func (s *SolanaWatcher) processAccountSubscriptionData(_ context.Context, logger
 	data, err = base64.StdEncoding.DecodeString(value.Account.Data[0])
 	if err != nil {
 		logger.Error(*s.wsUrl, zap.Error(err))
-		p2p.DefaultRegistry.AddErrorCount(s.chainID, 1)
+		s.AddErrorCount(1)
 		return err
 	}
 
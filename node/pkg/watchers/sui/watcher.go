@@ -13,10 +13,10 @@ import (
 	"encoding/json"
 
 	"github.com/certusone/wormhole/node/pkg/common"
-	"github.com/certusone/wormhole/node/pkg/p2p"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/certusone/wormhole/node/pkg/readiness"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/certusone/wormhole/node/pkg/watchers"
 
 	eth_common "github.com/ethereum/go-ethereum/common"
 	"github.com/prometheus/client_golang/prometheus"
@@ -30,6 +30,8 @@ import (
 type (
 	// Watcher is responsible for looking over Sui blockchain and reporting new transactions to the wormhole contract
 	Watcher struct {
+		*watchers.Base
+
 		suiRPC           string
 		suiMoveEventType string
 
@@ -165,19 +167,29 @@ var (
 			Name: "wormhole_sui_current_height",
 			Help: "Current Sui block height",
 		})
+	suiProcessedCheckpoint = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "wormhole_sui_processed_checkpoint",
+			Help: "Latest Sui checkpoint whose Wormhole messages were observed",
+		})
 )
 
+// errTxNotCheckpointed is returned for a transaction that is not part of a
+// checkpoint yet. Sui transactions are only final once they are checkpointed.
+var errTxNotCheckpointed = errors.New("transaction is not checkpointed yet")
+
 // NewWatcher creates a new Sui appid watcher
 func NewWatcher(
 	suiRPC string,
 	suiMoveEventType string,
 	unsafeDevMode bool,
 	messageEvents chan<- *common.MessagePublication,
-	obsvReqC <-chan *gossipv1.ObservationRequest,
+	obsvReqC chan *gossipv1.ObservationRequest,
 ) *Watcher {
 	maxBatchSize := 10
 	descOrder := true
 	return &Watcher{
+		Base:                      watchers.NewBase(vaa.ChainIDSui, watchers.VersionSui, messageEvents, obsvReqC),
 		suiRPC:                    suiRPC,
 		suiMoveEventType:          suiMoveEventType,
 		unsafeDevMode:             unsafeDevMode,
@@ -218,7 +230,7 @@ func (e *Watcher) inspectBody(logger *zap.Logger, body SuiResult, isReobservatio
 	err := json.Unmarshal(*body.Fields, &fields)
 	if err != nil {
 		logger.Error("failed to unmarshal FieldsData", zap.String("SuiResult.Fields", string(*body.Fields)), zap.Error(err))
-		p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDSui, 1)
+		e.AddErrorCount(1)
 		return fmt.Errorf("inspectBody failed to unmarshal FieldsData: %w", err)
 	}
 
@@ -292,7 +304,7 @@ func (e *Watcher) inspectBody(logger *zap.Logger, body SuiResult, isReobservatio
 }
 
 func (e *Watcher) Run(ctx context.Context) error {
-	p2p.DefaultRegistry.SetNetworkStats(vaa.ChainIDSui, &gossipv1.Heartbeat_Network{
+	e.SetNetworkStats(&gossipv1.Heartbeat_Network{
 		ContractAddress: e.suiMoveEventType,
 	})
 
@@ -311,6 +323,7 @@ func (e *Watcher) Run(ctx context.Context) error {
 		return fmt.Errorf("failed to get latest checkpoint sequence number: %w", err)
 	}
 	e.latestProcessedCheckpoint = latest
+	suiProcessedCheckpoint.Set(float64(latest))
 
 	timer := time.NewTicker(time.Second * 5)
 	defer timer.Stop()
@@ -346,6 +359,7 @@ func (e *Watcher) Run(ctx context.Context) error {
 						}
 						if event.checkpoint > e.latestProcessedCheckpoint {
 							e.latestProcessedCheckpoint = event.checkpoint
+							suiProcessedCheckpoint.Set(float64(event.checkpoint))
 						}
 					}
 				}
@@ -369,8 +383,9 @@ func (e *Watcher) Run(ctx context.Context) error {
 					currentSuiHeight.Set(float64(height))
 					logger.Debug("sui_getLatestCheckpointSequenceNumber", zap.Int64("result", height))
 
-					p2p.DefaultRegistry.SetNetworkStats(vaa.ChainIDSui, &gossipv1.Heartbeat_Network{
+					e.SetNetworkStats(&gossipv1.Heartbeat_Network{
 						Height:          height,
+						FinalizedHeight: height,
 						ContractAddress: e.suiMoveEventType,
 					})
 				}
@@ -393,12 +408,19 @@ func (e *Watcher) Run(ctx context.Context) error {
 
 				tx58 := base58.Encode(r.TxHash)
 
+				// Only re-observe transactions that are final.
+				if _, err := e.getCheckpointForDigest(tx58); err != nil {
+					logger.Error("sui_fetch_obvs_req failed to get the checkpoint of the transaction", zap.String("txhash", tx58), zap.Error(err))
+					e.AddErrorCount(1)
+					continue
+				}
+
 				payload := fmt.Sprintf(`{"jsonrpc":"2.0", "id": 1, "method": "sui_getEvents", "params": ["%s"]}`, tx58)
 
 				body, err := e.createAndExecReq(payload)
 				if err != nil {
 					logger.Error("sui_fetch_obvs_req failed", zap.Error(err))
-					p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDSui, 1)
+					e.AddErrorCount(1)
 					return fmt.Errorf("sui_fetch_obvs_req failed to create and execute request: %w", err)
 				}
 
@@ -409,13 +431,13 @@ func (e *Watcher) Run(ctx context.Context) error {
 				err = json.Unmarshal(body, &err_res)
 				if err != nil {
 					logger.Error("sui_fetch_obvs_req failed to unmarshal event error message", zap.String("Result", string(body)))
-					p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDSui, 1)
+					e.AddErrorCount(1)
 					return err
 				}
 
 				if err_res.Error.Message != nil {
 					logger.Error("sui_fetch_obvs_req failed to get events for re-observation request, detected error", zap.String("Result", string(body)))
-					p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDSui, 1)
+					e.AddErrorCount(1)
 					// Don't need to kill the watcher on this error. So, just continue.
 					continue
 				}
@@ -423,7 +445,7 @@ func (e *Watcher) Run(ctx context.Context) error {
 				err = json.Unmarshal(body, &res)
 				if err != nil {
 					logger.Error("failed to unmarshal event message", zap.String("body", string(body)), zap.Error(err))
-					p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDSui, 1)
+					e.AddErrorCount(1)
 					return fmt.Errorf("sui_fetch_obvs_req failed to unmarshal: %w", err)
 
 				}
@@ -481,11 +503,12 @@ func (w *Watcher) getEvents() ([]SuiResultInfo, error) {
 			return retVal, errors.New("getEvents was unable to get any events")
 		}
 		// Get and check the checkpoint for the last event against the lastProcessedHeight to see if we are done.
+		// A transaction that is not checkpointed yet is newer than the last processed checkpoint.
 		height, hErr := w.getCheckpointForDigest(txs[len(txs)-1])
-		if hErr != nil {
+		if hErr != nil && !errors.Is(hErr, errTxNotCheckpointed) {
 			return retVal, hErr
 		}
-		if height <= w.latestProcessedCheckpoint || !res.Result.HasNextPage {
+		if (hErr == nil && height <= w.latestProcessedCheckpoint) || !res.Result.HasNextPage {
 			break
 		}
 		nextCursor.TxDigest = res.Result.NextCursor.TxDigest
@@ -501,12 +524,24 @@ func (w *Watcher) getEvents() ([]SuiResultInfo, error) {
 	if (len(mbRes) == 0) || (len(mbRes) != len(txs)) {
 		return retVal, errors.New("getEvents error getting multiple blocks")
 	}
-	for idx, block := range mbRes {
+
+	return checkpointedEvents(txs, results, mbRes, w.latestProcessedCheckpoint)
+}
+
+// checkpointedEvents returns the events of the transactions that were checkpointed after latestProcessedCheckpoint,
+// in the descending order of the blocks. The events of transactions that are not checkpointed yet are left for a
+// later poll, since they are not final.
+func checkpointedEvents(txs []string, results []SuiResult, blocks []TxBlockResult, latestProcessedCheckpoint int64) ([]SuiResultInfo, error) {
+	var retVal []SuiResultInfo
+	for idx, block := range blocks {
+		if block.Checkpoint == "" {
+			continue
+		}
 		cp, err := strconv.ParseInt(block.Checkpoint, 10, 64)
 		if err != nil {
 			return retVal, fmt.Errorf("getEvents failed to ParseInt: %w", err)
 		}
-		if cp > w.latestProcessedCheckpoint {
+		if cp > latestProcessedCheckpoint {
 			// Double check the digest here
 			if txs[idx] != block.Digest {
 				return retVal, fmt.Errorf("getEvents digest mismatch: [%s] [%s]", txs[idx], block.Digest)
@@ -557,7 +592,7 @@ func (e *Watcher) getLatestCheckpointSN(logger *zap.Logger) (int64, error) {
 	body, err := e.createAndExecReq(payload)
 	if err != nil {
 		logger.Error("sui_getLatestCheckpointSequenceNumber failed", zap.Error(err))
-		p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDSui, 1)
+		e.AddErrorCount(1)
 		return 0, fmt.Errorf("sui_getLatestCheckpointSequenceNumber failed to create and execute request: %w", err)
 	}
 
@@ -565,14 +600,14 @@ func (e *Watcher) getLatestCheckpointSN(logger *zap.Logger) (int64, error) {
 	err = json.Unmarshal(body, &res)
 	if err != nil {
 		logger.Error("unmarshal failed into uint64", zap.String("body", string(body)), zap.Error(err))
-		p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDSui, 1)
+		e.AddErrorCount(1)
 		return 0, fmt.Errorf("sui_getLatestCheckpointSequenceNumber failed to unmarshal body: %s, error: %w", string(body), err)
 	}
 
 	height, pErr := strconv.ParseInt(res.Result, 0, 64)
 	if pErr != nil {
 		logger.Error("Failed to ParseInt")
-		p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDSui, 1)
+		e.AddErrorCount(1)
 		return 0, fmt.Errorf("sui_getLatestCheckpointSequenceNumber failed to ParseInt, error: %w", err)
 	}
 	return height, nil
@@ -592,6 +627,9 @@ func (e *Watcher) getCheckpointForDigest(tx string) (int64, error) {
 	if err != nil {
 		return retVal, fmt.Errorf("getCheckpointForDigest failed to unmarshal body: %s, error: %w", string(body), err)
 	}
+	if res.Result.Checkpoint == "" {
+		return retVal, fmt.Errorf("getCheckpointForDigest %s: %w", tx, errTxNotCheckpointed)
+	}
 	retVal, err = strconv.ParseInt(res.Result.Checkpoint, 10, 64)
 	if err != nil {
 		return retVal, fmt.Errorf("getCheckpointForDigest failed to ParseInt: %w", err)
@@ -60,3 +60,36 @@ func Test_JSONParseMultipleMsgs(t *testing.T) {
 		assert.Equal(t, "1693091880", *fields.Timestamp)
 	}
 }
+
+func Test_checkpointedEvents(t *testing.T) {
+	txs := []string{"tx3", "tx2", "tx1", "tx0"}
+	results := make([]SuiResult, len(txs))
+	for i := range txs {
+		results[i].ID.TxDigest = &txs[i]
+	}
+
+	blocks := []TxBlockResult{
+		// the newest transaction is not checkpointed yet
+		{Digest: "tx3", Checkpoint: ""},
+		{Digest: "tx2", Checkpoint: "12"},
+		{Digest: "tx1", Checkpoint: "11"},
+		{Digest: "tx0", Checkpoint: "10"},
+	}
+
+	events, err := checkpointedEvents(txs, results, blocks, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, "tx2", *events[0].result.ID.TxDigest)
+	assert.Equal(t, int64(12), events[0].checkpoint)
+	assert.Equal(t, "tx1", *events[1].result.ID.TxDigest)
+	assert.Equal(t, int64(11), events[1].checkpoint)
+
+	// nothing is returned before the transactions are checkpointed
+	events, err = checkpointedEvents(txs[:1], results[:1], blocks[:1], 10)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+
+	blocks[1].Digest = "other"
+	_, err = checkpointedEvents(txs, results, blocks, 10)
+	assert.ErrorContains(t, err, "digest mismatch")
+}
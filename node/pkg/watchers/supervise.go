@@ -0,0 +1,183 @@
+package watchers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+var (
+	watcherHealthScore = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_watcher_health_score",
+			Help: "Health score of the watcher, between 0 and 100",
+		}, []string{"network_id"})
+	watcherUnhealthyRestarts = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_watcher_unhealthy_restarts_total",
+			Help: "Total number of times the watcher was restarted because it was unhealthy",
+		}, []string{"network_id"})
+)
+
+// healthCheckInterval is how often Supervise checks the health of a watcher.
+const healthCheckInterval = 30 * time.Second
+
+// WatcherHealth is the health of a supervised watcher.
+type WatcherHealth struct {
+	NetworkID NetworkID
+	ChainID   vaa.ChainID
+	HealthStatus
+	// Number of times the watcher was restarted because it was unhealthy.
+	Restarts uint32
+}
+
+type healthRegistry struct {
+	mu       sync.Mutex
+	watchers map[NetworkID]*watcherSupervisor
+}
+
+// DefaultHealthRegistry holds the watchers started with Supervise, for the public RPC.
+var DefaultHealthRegistry = &healthRegistry{watchers: map[NetworkID]*watcherSupervisor{}}
+
+// Health returns the health of the supervised watchers, sorted by network ID.
+func (r *healthRegistry) Health() []WatcherHealth {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	health := make([]WatcherHealth, 0, len(r.watchers))
+	for _, s := range r.watchers {
+		health = append(health, s.health())
+	}
+	sort.Slice(health, func(i, j int) bool { return health[i].NetworkID < health[j].NetworkID })
+	return health
+}
+
+type watcherSupervisor struct {
+	networkID NetworkID
+	chainID   vaa.ChainID
+	watcher   Watcher
+
+	// checkInterval is how often the health of the watcher is checked.
+	checkInterval time.Duration
+	// grace is how long a restarted watcher has to become healthy.
+	grace time.Duration
+
+	mu       sync.Mutex
+	restarts uint32
+	// bo spaces out restarts of a watcher that stays unhealthy. It is reset once the watcher is healthy again.
+	bo *backoff.ExponentialBackOff
+	// delay is how long to wait before the next start of the watcher.
+	delay time.Duration
+}
+
+// Supervise returns a runnable that runs the watcher and restarts it when its health score drops below UnhealthyScore.
+// The supervisor already restarts watchers that fail, but a watcher that hangs or stops following its chain never does.
+// Restarts of a watcher that stays unhealthy are spaced out with an exponential backoff.
+func Supervise(networkID NetworkID, chainID vaa.ChainID, watcher Watcher) supervisor.Runnable {
+	bo := backoff.NewExponentialBackOff()
+	bo.InitialInterval = time.Minute
+	bo.MaxInterval = 30 * time.Minute
+	bo.MaxElapsedTime = 0
+
+	s := &watcherSupervisor{
+		networkID:     networkID,
+		chainID:       chainID,
+		watcher:       watcher,
+		checkInterval: healthCheckInterval,
+		grace:         StaleAfter,
+		bo:            bo,
+	}
+	DefaultHealthRegistry.mu.Lock()
+	DefaultHealthRegistry.watchers[networkID] = s
+	DefaultHealthRegistry.mu.Unlock()
+	return s.run
+}
+
+func (s *watcherSupervisor) health() WatcherHealth {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return WatcherHealth{
+		NetworkID:    s.networkID,
+		ChainID:      s.chainID,
+		HealthStatus: s.watcher.HealthStatus(),
+		Restarts:     s.restarts,
+	}
+}
+
+func (s *watcherSupervisor) run(ctx context.Context) error {
+	logger := supervisor.Logger(ctx)
+
+	s.mu.Lock()
+	delay := s.delay
+	s.delay = 0
+	s.mu.Unlock()
+	if delay > 0 {
+		logger.Info("waiting before restarting unhealthy watcher", zap.String("network_id", string(s.networkID)), zap.Duration("backoff", delay))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+
+	started := time.Now()
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errC := make(chan error, 1)
+	go func() {
+		errC <- common.WrapWithScissors(s.watcher.Run, string(s.networkID))(runCtx)
+	}()
+
+	ticker := time.NewTicker(s.checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			<-errC
+			return ctx.Err()
+		case err := <-errC:
+			return err
+		case <-ticker.C:
+			status := s.watcher.HealthStatus()
+			watcherHealthScore.WithLabelValues(string(s.networkID)).Set(float64(status.Score))
+
+			if status.Healthy() {
+				s.mu.Lock()
+				s.bo.Reset()
+				s.mu.Unlock()
+				continue
+			}
+			// The height may not have increased since before the last restart, give the watcher time to catch up.
+			if time.Since(started) < s.grace {
+				continue
+			}
+
+			s.mu.Lock()
+			s.restarts++
+			s.delay = s.bo.NextBackOff()
+			s.mu.Unlock()
+			watcherUnhealthyRestarts.WithLabelValues(string(s.networkID)).Inc()
+			logger.Warn("watcher is unhealthy, restarting it",
+				zap.String("network_id", string(s.networkID)),
+				zap.Int("score", status.Score),
+				zap.Int64("height", status.Height),
+				zap.Time("last_progress", status.LastProgress),
+				zap.Int("recent_errors", status.RecentErrors),
+			)
+			cancel()
+			<-errC
+			return fmt.Errorf("watcher %s is unhealthy (score %d)", s.networkID, status.Score)
+		}
+	}
+}
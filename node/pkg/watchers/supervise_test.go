@@ -0,0 +1,75 @@
+package watchers
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// testWatcher is a watcher whose health is set by the test.
+type testWatcher struct {
+	*Base
+	runs  atomic.Int32
+	score atomic.Int32
+}
+
+func (w *testWatcher) Run(ctx context.Context) error {
+	w.runs.Add(1)
+	supervisor.Signal(ctx, supervisor.SignalHealthy)
+	<-ctx.Done()
+	return nil
+}
+
+func (w *testWatcher) HealthStatus() HealthStatus {
+	return HealthStatus{Score: int(w.score.Load())}
+}
+
+func TestSuperviseRestartsUnhealthyWatcher(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	w := &testWatcher{Base: NewBase(vaa.ChainIDEthereum, "test/1", make(chan *common.MessagePublication), make(chan *gossipv1.ObservationRequest))}
+	w.score.Store(100)
+
+	run := Supervise("test", vaa.ChainIDEthereum, w)
+	s := DefaultHealthRegistry.watchers["test"]
+	s.checkInterval = 10 * time.Millisecond
+	s.grace = 0
+	s.bo.InitialInterval = 10 * time.Millisecond
+	s.bo.Reset()
+
+	supervisor.New(ctx, zap.NewNop(), func(ctx context.Context) error {
+		if err := supervisor.Run(ctx, "test_watch", run); err != nil {
+			return err
+		}
+		supervisor.Signal(ctx, supervisor.SignalHealthy)
+		<-ctx.Done()
+		return nil
+	})
+
+	require.Eventually(t, func() bool { return w.runs.Load() == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	// An unhealthy watcher is restarted until it is healthy again.
+	w.score.Store(UnhealthyScore - 1)
+	require.Eventually(t, func() bool { return w.runs.Load() >= 3 }, 10*time.Second, 10*time.Millisecond)
+
+	w.score.Store(100)
+	restarts := s.health().Restarts
+	assert.GreaterOrEqual(t, restarts, uint32(2))
+	time.Sleep(100 * time.Millisecond)
+	assert.LessOrEqual(t, s.health().Restarts, restarts+1)
+
+	health := DefaultHealthRegistry.Health()
+	require.Len(t, health, 1)
+	assert.Equal(t, NetworkID("test"), health[0].NetworkID)
+	assert.True(t, health[0].Healthy())
+}
@@ -1,10 +1,11 @@
 package watchers
 
 import (
+	"context"
+
 	"github.com/certusone/wormhole/node/pkg/common"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/certusone/wormhole/node/pkg/query"
-	"github.com/certusone/wormhole/node/pkg/supervisor"
 	"github.com/certusone/wormhole/node/pkg/watchers/interfaces"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
@@ -13,6 +14,41 @@ import (
 // This is different from vaa.ChainID because there could be multiple watchers for a single chain (e.g. solana-confirmed and solana-finalized)
 type NetworkID string
 
+// Versions of the watcher implementations, reported in heartbeats so that fleet monitoring can tell which guardians
+// run which implementation. Bump the version of a watcher when a change affects what it observes.
+const (
+	VersionAlgorand  = "algorand/1"
+	VersionAptos     = "aptos/2"
+	VersionCosmwasm  = "cosmwasm/1"
+	VersionEVM       = "evm/1"
+	VersionIBC       = "ibc/1"
+	VersionMock      = "mock/1"
+	VersionNear      = "near/1"
+	VersionSolana    = "solana/1"
+	VersionSui       = "sui/1"
+	VersionWormchain = "wormchain/1"
+)
+
+// Backfiller is implemented by watchers that can scan a historical range of blocks for messages they missed, e.g. during
+// an RPC outage. It is triggered through the admin service.
+type Backfiller interface {
+	// Backfill queues a scan of the blocks from from to to, inclusive. It returns an error if the range is invalid or
+	// another backfill is still queued.
+	Backfill(from uint64, to uint64) error
+}
+
+// Watcher is implemented by every chain watcher. Most of it is provided by embedding Base.
+type Watcher interface {
+	// Run watches the chain until the context is canceled. It is run under the supervisor, see Supervise.
+	Run(ctx context.Context) error
+	// ObservationChannel returns the channel the watcher publishes its observations on.
+	ObservationChannel() chan<- *common.MessagePublication
+	// Reobserve asks the watcher to observe the messages of a transaction again.
+	Reobserve(req *gossipv1.ObservationRequest) error
+	// HealthStatus reports how well the watcher keeps up with its chain.
+	HealthStatus() HealthStatus
+}
+
 type WatcherConfig interface {
 	GetNetworkID() NetworkID
 	GetChainID() vaa.ChainID
@@ -20,10 +56,10 @@ type WatcherConfig interface {
 	SetL1Finalizer(l1finalizer interfaces.L1Finalizer)
 	Create(
 		msgC chan<- *common.MessagePublication,
-		obsvReqC <-chan *gossipv1.ObservationRequest,
+		obsvReqC chan *gossipv1.ObservationRequest,
 		queryReqC <-chan *query.PerChainQueryInternal,
 		queryResponseC chan<- *query.PerChainQueryResponseInternal,
 		setC chan<- *common.GuardianSet,
 		env common.Environment,
-	) (interfaces.L1Finalizer, supervisor.Runnable, error)
+	) (interfaces.L1Finalizer, Watcher, error)
 }
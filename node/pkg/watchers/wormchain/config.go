@@ -0,0 +1,44 @@
+package wormchain
+
+import (
+	"github.com/certusone/wormhole/node/pkg/common"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/certusone/wormhole/node/pkg/query"
+	"github.com/certusone/wormhole/node/pkg/watchers"
+	"github.com/certusone/wormhole/node/pkg/watchers/interfaces"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+type WatcherConfig struct {
+	NetworkID watchers.NetworkID // human readable name
+	Websocket string             // Tendermint websocket URL
+	Lcd       string             // LCD URL
+	Contract  string             // bech32 address of the wasm core contract
+}
+
+func (wc *WatcherConfig) GetNetworkID() watchers.NetworkID {
+	return wc.NetworkID
+}
+
+func (wc *WatcherConfig) GetChainID() vaa.ChainID {
+	return vaa.ChainIDWormchain
+}
+
+func (wc *WatcherConfig) RequiredL1Finalizer() watchers.NetworkID {
+	return ""
+}
+
+func (wc *WatcherConfig) SetL1Finalizer(l1finalizer interfaces.L1Finalizer) {
+	// empty
+}
+
+func (wc *WatcherConfig) Create(
+	msgC chan<- *common.MessagePublication,
+	obsvReqC chan *gossipv1.ObservationRequest,
+	_ <-chan *query.PerChainQueryInternal,
+	_ chan<- *query.PerChainQueryResponseInternal,
+	_ chan<- *common.GuardianSet,
+	_ common.Environment,
+) (interfaces.L1Finalizer, watchers.Watcher, error) {
+	return nil, NewWatcher(wc.Websocket, wc.Lcd, wc.Contract, msgC, obsvReqC), nil
+}
@@ -0,0 +1,472 @@
+package wormchain
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/certusone/wormhole/node/pkg/readiness"
+	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/certusone/wormhole/node/pkg/watchers"
+	"github.com/certusone/wormhole/node/pkg/watchers/cosmwasm"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/tidwall/gjson"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+	"nhooyr.io/websocket"
+	"nhooyr.io/websocket/wsjson"
+)
+
+// Messages are published on wormchain in two ways: by the wasm core contract, like on other cosmwasm chains, and by the
+// x/wormhole module itself, which emits an EventPostedMessage typed event, e.g. for governance messages. The watcher
+// subscribes to the transactions containing either, and tells them apart by the ID of the subscription.
+
+const (
+	// postedMessageEventType is the type of the typed event x/wormhole emits when a message is posted.
+	postedMessageEventType = "wormhole_foundation.wormchain.wormhole.EventPostedMessage"
+
+	// contractAddressKey is the attribute holding the contract address in wasm events.
+	contractAddressKey = "_contract_address"
+
+	// Tendermint 0.34, which wormchain runs, base64 encodes the keys and values of event attributes.
+	b64Encoded = true
+
+	// JSON-RPC IDs of the subscriptions.
+	contractSubscriptionID = 1
+	moduleSubscriptionID   = 2
+
+	// Do not add a leading slash
+	latestBlockURL = "cosmos/base/tendermint/v1beta1/blocks/latest"
+
+	// Message sources, used as metric labels.
+	sourceContract = "contract"
+	sourceModule   = "module"
+)
+
+type (
+	// Watcher is responsible for observing the messages published on wormchain by the wasm core contract and the
+	// x/wormhole module.
+	Watcher struct {
+		*watchers.Base
+
+		urlWS    string
+		urlLCD   string
+		contract string
+
+		msgC chan<- *common.MessagePublication
+
+		// Incoming re-observation requests from the network. Pre-filtered to only
+		// include requests for our chainID.
+		obsvReqC <-chan *gossipv1.ObservationRequest
+
+		readinessSync readiness.Component
+	}
+)
+
+var (
+	connectionErrors = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_wormchain_connection_errors_total",
+			Help: "Total number of connection errors on wormchain",
+		}, []string{"reason"})
+	messagesConfirmed = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_wormchain_messages_confirmed_total",
+			Help: "Total number of verified messages found on wormchain, grouped by whether the core contract or the module published them",
+		}, []string{"source"})
+	currentHeight = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "wormhole_wormchain_current_height",
+			Help: "Current block height on wormchain",
+		})
+	queryLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "wormhole_wormchain_query_latency",
+			Help: "Latency histogram for LCD calls on wormchain",
+		}, []string{"operation"})
+)
+
+type clientRequest struct {
+	JSONRPC string    `json:"jsonrpc"`
+	Method  string    `json:"method"`
+	Params  [1]string `json:"params"`
+	ID      uint64    `json:"id"`
+}
+
+// NewWatcher creates a new wormchain watcher
+func NewWatcher(
+	urlWS string,
+	urlLCD string,
+	contract string,
+	msgC chan<- *common.MessagePublication,
+	obsvReqC chan *gossipv1.ObservationRequest,
+) *Watcher {
+	return &Watcher{
+		Base:          watchers.NewBase(vaa.ChainIDWormchain, watchers.VersionWormchain, msgC, obsvReqC),
+		urlWS:         urlWS,
+		urlLCD:        urlLCD,
+		contract:      contract,
+		msgC:          msgC,
+		obsvReqC:      obsvReqC,
+		readinessSync: common.MustConvertChainIdToReadinessSyncing(vaa.ChainIDWormchain),
+	}
+}
+
+func (e *Watcher) Run(ctx context.Context) error {
+	e.SetNetworkStats(&gossipv1.Heartbeat_Network{
+		ContractAddress: e.contract,
+	})
+
+	errC := make(chan error)
+	logger := supervisor.Logger(ctx)
+
+	logger.Info("Starting watcher",
+		zap.String("watcher_name", "wormchain"),
+		zap.String("urlWS", e.urlWS),
+		zap.String("urlLCD", e.urlLCD),
+		zap.String("contract", e.contract),
+	)
+
+	c, _, err := websocket.Dial(ctx, e.urlWS, nil)
+	if err != nil {
+		e.AddErrorCount(1)
+		connectionErrors.WithLabelValues("websocket_dial_error").Inc()
+		return fmt.Errorf("websocket dial failed: %w", err)
+	}
+	defer c.Close(websocket.StatusNormalClosure, "")
+
+	c.SetReadLimit(cosmwasm.ReadLimitSize)
+
+	subscriptions := []struct {
+		id    uint64
+		query string
+	}{
+		{contractSubscriptionID, fmt.Sprintf("tm.event='Tx' AND execute.%s='%s'", contractAddressKey, e.contract)},
+		{moduleSubscriptionID, fmt.Sprintf("tm.event='Tx' AND %s.sequence EXISTS", postedMessageEventType)},
+	}
+	for _, sub := range subscriptions {
+		if err := e.subscribe(ctx, c, sub.id, sub.query); err != nil {
+			e.AddErrorCount(1)
+			connectionErrors.WithLabelValues("websocket_subscription_error").Inc()
+			return err
+		}
+	}
+	logger.Info("subscribed to new transaction events")
+
+	readiness.SetReady(e.readinessSync)
+
+	common.RunWithScissors(ctx, errC, "wormchain_block_height", func(ctx context.Context) error {
+		t := time.NewTicker(5 * time.Second)
+		defer t.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-t.C:
+				msm := time.Now()
+				blockJSON, err := e.queryLCD(ctx, latestBlockURL)
+				if err != nil {
+					logger.Error("query latest block error", zap.Error(err))
+					continue
+				}
+				queryLatency.WithLabelValues("block_latest").Observe(time.Since(msm).Seconds())
+
+				latestBlock := gjson.Get(blockJSON, "block.header.height")
+				logger.Debug("current height", zap.Int64("block", latestBlock.Int()))
+				currentHeight.Set(float64(latestBlock.Int()))
+				e.SetNetworkStats(&gossipv1.Heartbeat_Network{
+					Height:          latestBlock.Int(),
+					FinalizedHeight: latestBlock.Int(),
+					ContractAddress: e.contract,
+				})
+
+				readiness.SetReady(e.readinessSync)
+			}
+		}
+	})
+
+	common.RunWithScissors(ctx, errC, "wormchain_objs_req", func(ctx context.Context) error {
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case r := <-e.obsvReqC:
+				if vaa.ChainID(r.ChainId) != vaa.ChainIDWormchain {
+					panic("invalid chain ID")
+				}
+
+				tx := hex.EncodeToString(r.TxHash)
+				logger.Info("received observation request", zap.String("tx_hash", tx))
+
+				msm := time.Now()
+				txJSON, err := e.queryLCD(ctx, "cosmos/tx/v1beta1/txs/"+tx)
+				if err != nil {
+					logger.Error("query tx error", zap.String("tx_hash", tx), zap.Error(err))
+					continue
+				}
+				queryLatency.WithLabelValues("get_tx").Observe(time.Since(msm).Seconds())
+
+				txHash := gjson.Get(txJSON, "tx_response.txhash")
+				if !txHash.Exists() {
+					logger.Error("tx does not have tx hash", zap.String("payload", txJSON))
+					continue
+				}
+				events := gjson.Get(txJSON, "tx_response.events")
+				if !events.Exists() {
+					logger.Error("tx has no events", zap.String("payload", txJSON))
+					continue
+				}
+
+				// A re-observation request does not say who published the message, so look for both kinds.
+				e.publish(sourceContract, cosmwasm.EventsToMessagePublications(e.contract, txHash.String(), events.Array(), logger, vaa.ChainIDWormchain, contractAddressKey, b64Encoded), true)
+				e.publish(sourceModule, PostedMessagesFromEvents(txHash.String(), events.Array(), logger), true)
+			}
+		}
+	})
+
+	common.RunWithScissors(ctx, errC, "wormchain_data_pump", func(ctx context.Context) error {
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			default:
+				_, message, err := c.Read(ctx)
+				if err != nil {
+					e.AddErrorCount(1)
+					connectionErrors.WithLabelValues("channel_read_error").Inc()
+					logger.Error("error reading channel", zap.Error(err))
+					errC <- err
+					return nil
+				}
+
+				json := string(message)
+
+				txHashRaw := gjson.Get(json, "result.events.tx\\.hash.0")
+				if !txHashRaw.Exists() {
+					logger.Warn("message does not have tx hash", zap.String("payload", json))
+					continue
+				}
+				txHash := txHashRaw.String()
+
+				events := gjson.Get(json, "result.data.value.TxResult.result.events")
+				if !events.Exists() {
+					logger.Warn("message has no events", zap.String("payload", json))
+					continue
+				}
+
+				// A transaction matching both subscriptions is delivered twice, so only look for the messages the
+				// subscription is about.
+				switch id := gjson.Get(json, "id").Uint(); id {
+				case contractSubscriptionID:
+					e.publish(sourceContract, cosmwasm.EventsToMessagePublications(e.contract, txHash, events.Array(), logger, vaa.ChainIDWormchain, contractAddressKey, b64Encoded), false)
+				case moduleSubscriptionID:
+					e.publish(sourceModule, PostedMessagesFromEvents(txHash, events.Array(), logger), false)
+				default:
+					logger.Warn("message for an unknown subscription", zap.Uint64("id", id), zap.String("tx_hash", txHash))
+				}
+			}
+		}
+	})
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-errC:
+		return err
+	}
+}
+
+// subscribe subscribes to the transactions matching the query and waits for the subscription to be confirmed.
+func (e *Watcher) subscribe(ctx context.Context, c *websocket.Conn, id uint64, query string) error {
+	command := &clientRequest{
+		JSONRPC: "2.0",
+		Method:  "subscribe",
+		Params:  [1]string{query},
+		ID:      id,
+	}
+	if err := wsjson.Write(ctx, c, command); err != nil {
+		return fmt.Errorf("websocket subscription failed: %w", err)
+	}
+
+	_, response, err := c.Read(ctx)
+	if err != nil {
+		return fmt.Errorf("event subscription failed: %w", err)
+	}
+	if rpcErr := gjson.GetBytes(response, "error"); rpcErr.Exists() {
+		return fmt.Errorf("event subscription %q failed: %s", query, rpcErr.String())
+	}
+	return nil
+}
+
+// queryLCD queries the path of the LCD and returns the response body.
+func (e *Watcher) queryLCD(ctx context.Context, path string) (string, error) {
+	timeout, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(timeout, http.MethodGet, fmt.Sprintf("%s/%s", e.urlLCD, path), nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %s: %s", resp.Status, body)
+	}
+	return string(body), nil
+}
+
+// publish sends the messages to the processor.
+func (e *Watcher) publish(source string, msgs []*common.MessagePublication, reobservation bool) {
+	for _, msg := range msgs {
+		msg.IsReobservation = reobservation
+		e.msgC <- msg
+		messagesConfirmed.WithLabelValues(source).Inc()
+	}
+}
+
+// PostedMessagesFromEvents returns the messages posted by the x/wormhole module in the events of a transaction.
+func PostedMessagesFromEvents(txHash string, events []gjson.Result, logger *zap.Logger) []*common.MessagePublication {
+	msgs := make([]*common.MessagePublication, 0)
+	for _, event := range events {
+		if gjson.Get(event.String(), "type").String() != postedMessageEventType {
+			continue
+		}
+
+		attributes, err := decodeAttributes(gjson.Get(event.String(), "attributes"))
+		if err != nil {
+			logger.Error("posted message event has invalid attributes", zap.String("tx_hash", txHash), zap.String("event", event.String()), zap.Error(err))
+			continue
+		}
+
+		msg, err := postedMessageFromAttributes(txHash, attributes)
+		if err != nil {
+			logger.Error("invalid posted message event", zap.String("tx_hash", txHash), zap.String("event", event.String()), zap.Error(err))
+			continue
+		}
+		logger.Info("new message posted by the wormhole module",
+			zap.String("txHash", txHash),
+			zap.Stringer("emitter", msg.EmitterAddress),
+			zap.Uint64("sequence", msg.Sequence),
+			zap.Uint32("nonce", msg.Nonce),
+		)
+		msgs = append(msgs, msg)
+	}
+	return msgs
+}
+
+// decodeAttributes maps the keys of event attributes to their values.
+func decodeAttributes(attributes gjson.Result) (map[string]string, error) {
+	if !attributes.IsArray() {
+		return nil, fmt.Errorf("attributes are not an array")
+	}
+	mapped := map[string]string{}
+	for _, attribute := range attributes.Array() {
+		key := gjson.Get(attribute.String(), "key").String()
+		value := gjson.Get(attribute.String(), "value").String()
+		if b64Encoded {
+			k, err := base64.StdEncoding.DecodeString(key)
+			if err != nil {
+				return nil, fmt.Errorf("invalid attribute key %q: %w", key, err)
+			}
+			v, err := base64.StdEncoding.DecodeString(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value of attribute %q: %w", k, err)
+			}
+			key, value = string(k), string(v)
+		}
+		if _, exists := mapped[key]; exists {
+			return nil, fmt.Errorf("duplicate attribute %q", key)
+		}
+		mapped[key] = value
+	}
+	return mapped, nil
+}
+
+// postedMessageFromAttributes converts the attributes of an EventPostedMessage into a message publication. The values
+// of typed event attributes are JSON encoded, with 64 bit integers as strings and bytes in base64.
+func postedMessageFromAttributes(txHash string, attributes map[string]string) (*common.MessagePublication, error) {
+	var emitter, payload []byte
+	if err := unmarshalAttribute(attributes, "emitter", &emitter); err != nil {
+		return nil, err
+	}
+	var emitterAddress vaa.Address
+	if len(emitter) != len(emitterAddress) {
+		return nil, fmt.Errorf("emitter must be %d bytes long, is %d", len(emitterAddress), len(emitter))
+	}
+	copy(emitterAddress[:], emitter)
+	if err := unmarshalAttribute(attributes, "payload", &payload); err != nil {
+		return nil, err
+	}
+	sequence, err := uintAttribute(attributes, "sequence", 64)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := uintAttribute(attributes, "nonce", 32)
+	if err != nil {
+		return nil, err
+	}
+	blockTime, err := uintAttribute(attributes, "time", 63)
+	if err != nil {
+		return nil, err
+	}
+
+	txHashValue, err := cosmwasm.StringToHash(txHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tx hash %q: %w", txHash, err)
+	}
+
+	return &common.MessagePublication{
+		TxHash:           txHashValue,
+		Timestamp:        time.Unix(int64(blockTime), 0),
+		Nonce:            uint32(nonce),
+		Sequence:         sequence,
+		EmitterChain:     vaa.ChainIDWormchain,
+		EmitterAddress:   emitterAddress,
+		Payload:          payload,
+		ConsistencyLevel: 0, // Instant finality
+	}, nil
+}
+
+// unmarshalAttribute JSON decodes the value of an attribute.
+func unmarshalAttribute(attributes map[string]string, key string, v interface{}) error {
+	value, exists := attributes[key]
+	if !exists {
+		return fmt.Errorf("missing attribute %q", key)
+	}
+	if err := json.Unmarshal([]byte(value), v); err != nil {
+		return fmt.Errorf("invalid value of attribute %q: %w", key, err)
+	}
+	return nil
+}
+
+// uintAttribute parses an unsigned integer attribute, which is quoted if it is a 64 bit integer.
+func uintAttribute(attributes map[string]string, key string, bitSize int) (uint64, error) {
+	value, exists := attributes[key]
+	if !exists {
+		return 0, fmt.Errorf("missing attribute %q", key)
+	}
+	n, err := strconv.ParseUint(strings.Trim(value, `"`), 10, bitSize)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value of attribute %q: %w", key, err)
+	}
+	return n, nil
+}
@@ -0,0 +1,116 @@
+package wormchain
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+const testTxHash = "0A1B2C3D4E5F60718293A4B5C6D7E8F90A1B2C3D4E5F60718293A4B5C6D7E8F9"
+
+// testEvents returns the events of a transaction in the format Tendermint 0.34 delivers them, with base64 encoded
+// attributes.
+func testEvents(events ...map[string]interface{}) []gjson.Result {
+	encoded := make([]string, 0, len(events))
+	for _, event := range events {
+		attributes := []string{}
+		for _, attr := range event["attributes"].([][2]string) {
+			attributes = append(attributes, fmt.Sprintf(`{"key":"%s","value":"%s","index":true}`,
+				base64.StdEncoding.EncodeToString([]byte(attr[0])), base64.StdEncoding.EncodeToString([]byte(attr[1]))))
+		}
+		encoded = append(encoded, fmt.Sprintf(`{"type":"%s","attributes":[%s]}`, event["type"], strings.Join(attributes, ",")))
+	}
+	return gjson.Parse("[" + strings.Join(encoded, ",") + "]").Array()
+}
+
+func postedMessageEvent(emitter []byte, sequence uint64, nonce uint32, blockTime uint64, payload []byte) map[string]interface{} {
+	return map[string]interface{}{
+		"type": postedMessageEventType,
+		"attributes": [][2]string{
+			{"emitter", fmt.Sprintf(`"%s"`, base64.StdEncoding.EncodeToString(emitter))},
+			{"sequence", fmt.Sprintf(`"%d"`, sequence)},
+			{"nonce", fmt.Sprintf("%d", nonce)},
+			{"time", fmt.Sprintf(`"%d"`, blockTime)},
+			{"payload", fmt.Sprintf(`"%s"`, base64.StdEncoding.EncodeToString(payload))},
+		},
+	}
+}
+
+func TestPostedMessagesFromEvents(t *testing.T) {
+	emitter := make([]byte, 32)
+	emitter[31] = 4
+	payload := []byte{1, 2, 3}
+
+	events := testEvents(
+		map[string]interface{}{"type": "message", "attributes": [][2]string{{"action", "/wormhole_foundation.wormchain.wormhole.MsgExecuteGovernanceVAA"}}},
+		postedMessageEvent(emitter, 7, 42, 1700000000, payload),
+		postedMessageEvent(emitter, 8, 0, 1700000000, nil),
+	)
+
+	msgs := PostedMessagesFromEvents(testTxHash, events, zap.NewNop())
+	require.Len(t, msgs, 2)
+
+	txHash, err := hex.DecodeString(testTxHash)
+	require.NoError(t, err)
+	msg := msgs[0]
+	assert.Equal(t, txHash, msg.TxHash.Bytes())
+	assert.Equal(t, vaa.ChainIDWormchain, msg.EmitterChain)
+	assert.Equal(t, emitter, msg.EmitterAddress.Bytes())
+	assert.Equal(t, uint64(7), msg.Sequence)
+	assert.Equal(t, uint32(42), msg.Nonce)
+	assert.Equal(t, time.Unix(1700000000, 0), msg.Timestamp)
+	assert.Equal(t, payload, msg.Payload)
+	assert.Equal(t, uint8(0), msg.ConsistencyLevel)
+
+	assert.Equal(t, uint64(8), msgs[1].Sequence)
+	assert.Empty(t, msgs[1].Payload)
+}
+
+func TestPostedMessagesFromEventsInvalid(t *testing.T) {
+	emitter := make([]byte, 32)
+	valid := postedMessageEvent(emitter, 1, 0, 1700000000, []byte{1})
+
+	withAttribute := func(key string, value string) map[string]interface{} {
+		attributes := [][2]string{}
+		for _, attr := range valid["attributes"].([][2]string) {
+			if attr[0] == key {
+				if value == "" {
+					continue
+				}
+				attr[1] = value
+			}
+			attributes = append(attributes, attr)
+		}
+		return map[string]interface{}{"type": postedMessageEventType, "attributes": attributes}
+	}
+
+	for _, tc := range []struct {
+		name  string
+		event map[string]interface{}
+	}{
+		{"short emitter", postedMessageEvent(emitter[:20], 1, 0, 1700000000, nil)},
+		{"missing emitter", withAttribute("emitter", "")},
+		{"missing sequence", withAttribute("sequence", "")},
+		{"invalid sequence", withAttribute("sequence", `"abc"`)},
+		{"nonce out of range", withAttribute("nonce", "4294967296")},
+		{"invalid payload", withAttribute("payload", `"!!"`)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msgs := PostedMessagesFromEvents(testTxHash, testEvents(tc.event), zap.NewNop())
+			assert.Empty(t, msgs)
+		})
+	}
+
+	// Attributes that are not base64 encoded are rejected.
+	events := gjson.Parse(fmt.Sprintf(`[{"type":"%s","attributes":[{"key":"emitter!","value":"x"}]}]`, postedMessageEventType)).Array()
+	assert.Empty(t, PostedMessagesFromEvents(testTxHash, events, zap.NewNop()))
+}
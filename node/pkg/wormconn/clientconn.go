@@ -31,6 +31,12 @@ type ClientConn struct {
 	senderAddress string
 	chainId       string
 	mutex         sync.Mutex // Protects the account / sequence number
+
+	// The account number and the sequence number of the next transaction, cached so that the account does not have to be
+	// queried for every transaction. Only valid if haveAccount is set.
+	haveAccount   bool
+	accountNumber uint64
+	sequence      uint64
 }
 
 // NewConn creates a new connection to the wormhole-chain instance at `target`.
@@ -6,6 +6,7 @@ import (
 
 	txclient "github.com/cosmos/cosmos-sdk/client/tx"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
@@ -17,18 +18,10 @@ func (c *ClientConn) SignAndBroadcastTx(ctx context.Context, msg sdktypes.Msg) (
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
-	authClient := auth.NewQueryClient(c.c)
-	accountQuery := &auth.QueryAccountRequest{
-		Address: c.senderAddress,
-	}
-	resp, err := authClient.Account(ctx, accountQuery)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch account: %w", err)
-	}
-
-	var account auth.AccountI
-	if err := c.encCfg.InterfaceRegistry.UnpackAny(resp.Account, &account); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal account info: %w", err)
+	if !c.haveAccount {
+		if err := c.fetchAccount(ctx); err != nil {
+			return nil, err
+		}
 	}
 
 	builder := c.encCfg.TxConfig.NewTxBuilder()
@@ -39,7 +32,7 @@ func (c *ClientConn) SignAndBroadcastTx(ctx context.Context, msg sdktypes.Msg) (
 
 	// The tx needs to be signed in 2 passes: first we populate the SignerInfo
 	// inside the TxBuilder and then sign the payload.
-	sequence := account.GetSequence()
+	sequence := c.sequence
 	sig := signing.SignatureV2{
 		PubKey: c.privateKey.PubKey(),
 		Data: &signing.SingleSignatureData{
@@ -54,11 +47,11 @@ func (c *ClientConn) SignAndBroadcastTx(ctx context.Context, msg sdktypes.Msg) (
 
 	signerData := authsigning.SignerData{
 		ChainID:       c.chainId,
-		AccountNumber: account.GetAccountNumber(),
+		AccountNumber: c.accountNumber,
 		Sequence:      sequence,
 	}
 
-	sig, err = txclient.SignWithPrivKey(
+	sig, err := txclient.SignWithPrivKey(
 		c.encCfg.TxConfig.SignModeHandler().DefaultMode(),
 		signerData,
 		builder,
@@ -89,8 +82,57 @@ func (c *ClientConn) SignAndBroadcastTx(ctx context.Context, msg sdktypes.Msg) (
 		},
 	)
 	if err != nil {
+		// The tx may or may not have made it into the mempool, so the sequence number is unknown.
+		c.haveAccount = false
 		return nil, fmt.Errorf("failed to broadcast tx: %w", err)
 	}
 
+	c.updateSequence(txResp)
 	return txResp, nil
 }
+
+// fetchAccount queries the account number and the current sequence number of the sender.
+func (c *ClientConn) fetchAccount(ctx context.Context) error {
+	authClient := auth.NewQueryClient(c.c)
+	accountQuery := &auth.QueryAccountRequest{
+		Address: c.senderAddress,
+	}
+	resp, err := authClient.Account(ctx, accountQuery)
+	if err != nil {
+		return fmt.Errorf("failed to fetch account: %w", err)
+	}
+
+	var account auth.AccountI
+	if err := c.encCfg.InterfaceRegistry.UnpackAny(resp.Account, &account); err != nil {
+		return fmt.Errorf("failed to unmarshal account info: %w", err)
+	}
+
+	c.accountNumber = account.GetAccountNumber()
+	c.sequence = account.GetSequence()
+	c.haveAccount = true
+	return nil
+}
+
+// updateSequence advances the cached sequence number after a broadcast. A tx that was included in a block used up its
+// sequence number, even if it failed. A tx that was rejected before that did not, unless it was rejected because the
+// cached sequence number is wrong, in which case the account is queried again for the next tx.
+func (c *ClientConn) updateSequence(txResp *sdktx.BroadcastTxResponse) {
+	if txResp == nil || txResp.TxResponse == nil {
+		c.haveAccount = false
+		return
+	}
+	if isSequenceMismatch(txResp) {
+		c.haveAccount = false
+		return
+	}
+	if txResp.TxResponse.Height != 0 || txResp.TxResponse.Code == 0 {
+		c.sequence++
+	}
+}
+
+// isSequenceMismatch returns true if the tx was rejected because it was signed with the wrong sequence number.
+func isSequenceMismatch(txResp *sdktx.BroadcastTxResponse) bool {
+	return txResp != nil && txResp.TxResponse != nil &&
+		txResp.TxResponse.Codespace == sdkerrors.ErrWrongSequence.Codespace() &&
+		txResp.TxResponse.Code == sdkerrors.ErrWrongSequence.ABCICode()
+}
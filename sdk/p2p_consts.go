@@ -6,4 +6,5 @@ var (
 	SignedObservationRequestPrefix_old = []byte("signed_observation_request|")
 	SignedObservationRequestPrefix     = []byte("signed_observation_request_000000|")
 	SignedWormchainAddressPrefix       = []byte("signed_wormchain_address_00000000|")
+	SignedWormchainRegistrationPrefix  = []byte("signed_wormchain_registration_000|")
 )
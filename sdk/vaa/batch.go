@@ -0,0 +1,321 @@
+package vaa
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// BatchVAA is a verifiable action approval of multiple observations, signed once by the guardian set. The
+// guardians sign the hashes of all observations in the batch, so each observation can be verified individually
+// without the others, as long as the full list of hashes is available.
+type BatchVAA struct {
+	// Version of the VAA schema
+	Version uint8
+	// GuardianSetIndex is the index of the guardian set that signed this VAA
+	GuardianSetIndex uint32
+	// SignatureData is the signature of the guardian set
+	Signatures []*Signature
+
+	// Hashes of all observations in the batch, in batch order. These are the v1 signing digests of the observations.
+	Hashes []common.Hash
+	// Observations included in this VAA. This may be a subset of the batch, each observation is placed at the
+	// position of its hash by its index.
+	Observations []*Observation
+}
+
+const (
+	BatchVAAVersion = 0x02
+
+	// Minimum batch VAA size is the header without signatures (6 bytes), plus the lengths of the hash (1 byte) and
+	// observation (1 byte) arrays.
+	minBatchVAALength = 8
+)
+
+var (
+	_ Attestation = (*VAA)(nil)
+	_ Attestation = (*BatchVAA)(nil)
+)
+
+// NewBatchVAA creates an unsigned batch VAA of the given observations. Only the body of the observations is
+// included, their signatures are ignored.
+func NewBatchVAA(guardianSetIndex uint32, observations []*VAA) (*BatchVAA, error) {
+	if len(observations) == 0 {
+		return nil, errors.New("batch has no observations")
+	}
+	if len(observations) > 255 {
+		return nil, fmt.Errorf("batch has too many observations: %d", len(observations))
+	}
+
+	v := &BatchVAA{
+		Version:          BatchVAAVersion,
+		GuardianSetIndex: guardianSetIndex,
+		Signatures:       []*Signature{},
+		Hashes:           make([]common.Hash, len(observations)),
+		Observations:     make([]*Observation, len(observations)),
+	}
+	for i, obsv := range observations {
+		if obsv.EmitterChain != observations[0].EmitterChain {
+			return nil, errors.New("batch observations were emitted on different chains")
+		}
+		v.Hashes[i] = obsv.SigningDigest()
+		v.Observations[i] = &Observation{Index: uint8(i), Observation: obsv}
+	}
+
+	return v, nil
+}
+
+// UnmarshalBatch deserializes the binary representation of a batch VAA. It checks that every observation matches
+// the hash at its index.
+func UnmarshalBatch(data []byte) (*BatchVAA, error) {
+	if len(data) < minBatchVAALength {
+		return nil, fmt.Errorf("batch VAA is too short")
+	}
+	v := &BatchVAA{}
+
+	v.Version = data[0]
+	if v.Version != BatchVAAVersion {
+		return nil, fmt.Errorf("unsupported batch VAA version: %d", v.Version)
+	}
+
+	reader := bytes.NewReader(data[1:])
+
+	if err := binary.Read(reader, binary.BigEndian, &v.GuardianSetIndex); err != nil {
+		return nil, fmt.Errorf("failed to read guardian set index: %w", err)
+	}
+
+	lenSignatures, er := reader.ReadByte()
+	if er != nil {
+		return nil, fmt.Errorf("failed to read signature length")
+	}
+
+	v.Signatures = make([]*Signature, lenSignatures)
+	for i := 0; i < int(lenSignatures); i++ {
+		index, err := reader.ReadByte()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read validator index [%d]", i)
+		}
+
+		signature := [65]byte{}
+		if n, err := reader.Read(signature[:]); err != nil || n != 65 {
+			return nil, fmt.Errorf("failed to read signature [%d]: %w", i, err)
+		}
+
+		v.Signatures[i] = &Signature{
+			Index:     index,
+			Signature: signature,
+		}
+	}
+
+	lenHashes, er := reader.ReadByte()
+	if er != nil {
+		return nil, fmt.Errorf("failed to read hashes length")
+	}
+	if lenHashes == 0 {
+		return nil, fmt.Errorf("batch has no hashes")
+	}
+
+	v.Hashes = make([]common.Hash, lenHashes)
+	for i := 0; i < int(lenHashes); i++ {
+		if n, err := reader.Read(v.Hashes[i][:]); err != nil || n != 32 {
+			return nil, fmt.Errorf("failed to read hash [%d]: %w", i, err)
+		}
+	}
+
+	lenObservations, er := reader.ReadByte()
+	if er != nil {
+		return nil, fmt.Errorf("failed to read observations length")
+	}
+
+	v.Observations = make([]*Observation, lenObservations)
+	for i := 0; i < int(lenObservations); i++ {
+		index, err := reader.ReadByte()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read observation index [%d]", i)
+		}
+
+		var lenObservation uint32
+		if err := binary.Read(reader, binary.BigEndian, &lenObservation); err != nil {
+			return nil, fmt.Errorf("failed to read observation length [%d]: %w", i, err)
+		}
+		if lenObservation < minHeadlessVAALength || int64(lenObservation) > int64(reader.Len()) {
+			return nil, fmt.Errorf("invalid observation length [%d]: %d", i, lenObservation)
+		}
+
+		obsvBytes := make([]byte, lenObservation)
+		if _, err := io.ReadFull(reader, obsvBytes); err != nil {
+			return nil, fmt.Errorf("failed to read observation [%d]: %w", i, err)
+		}
+
+		obsv, err := UnmarshalBody(obsvBytes, bytes.NewReader(obsvBytes), &VAA{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read observation [%d]: %w", i, err)
+		}
+
+		v.Observations[i] = &Observation{
+			Index:       index,
+			Observation: obsv,
+		}
+	}
+
+	if reader.Len() != 0 {
+		return nil, fmt.Errorf("batch VAA has %d trailing bytes", reader.Len())
+	}
+
+	if err := v.verifyObservations(); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// verifyObservations checks that the observations are ordered by index, match the hash at their index and were
+// emitted on the same chain.
+func (v *BatchVAA) verifyObservations() error {
+	lastIndex := -1
+	for _, obsv := range v.Observations {
+		if obsv.Observation.EmitterChain != v.GetEmitterChain() {
+			return fmt.Errorf("observation %d was emitted on a different chain", obsv.Index)
+		}
+		if int(obsv.Index) <= lastIndex {
+			return fmt.Errorf("observation indexes are not increasing")
+		}
+		lastIndex = int(obsv.Index)
+
+		if int(obsv.Index) >= len(v.Hashes) {
+			return fmt.Errorf("observation index %d is out of range", obsv.Index)
+		}
+		if obsv.Observation.SigningDigest() != v.Hashes[obsv.Index] {
+			return fmt.Errorf("observation %d does not match its hash", obsv.Index)
+		}
+	}
+	return nil
+}
+
+// signingBody returns the binary representation of the data that is relevant for signing and verifying the VAA,
+// which is the concatenation of the observation hashes.
+func (v *BatchVAA) signingBody() []byte {
+	buf := new(bytes.Buffer)
+	for _, hash := range v.Hashes {
+		buf.Write(hash[:])
+	}
+	return buf.Bytes()
+}
+
+// SigningDigest returns the batch digest to be signed directly.
+// This is used for signature generation and verification
+func (v *BatchVAA) SigningDigest() common.Hash {
+	return doubleKeccak(v.signingBody())
+}
+
+// VerifySignatures verifies the signature of the batch VAA given the signer addresses.
+// Returns true if the signatures were verified successfully.
+func (v *BatchVAA) VerifySignatures(addresses []common.Address) bool {
+	return verifySignatures(v.SigningDigest().Bytes(), v.Signatures, addresses)
+}
+
+// Verify checks the batch VAA like VAA.Verify. It also checks that every observation matches the hash at its
+// index, so that the signatures cover the observations.
+func (v *BatchVAA) Verify(addresses []common.Address) error {
+	if err := v.verifyObservations(); err != nil {
+		return err
+	}
+
+	return verifyQuorum(v.SigningDigest().Bytes(), v.Signatures, addresses)
+}
+
+// Marshal returns the binary representation of the batch VAA
+func (v *BatchVAA) Marshal() ([]byte, error) {
+	if len(v.Hashes) > 255 || len(v.Observations) > 255 {
+		return nil, fmt.Errorf("batch has too many observations")
+	}
+
+	buf := new(bytes.Buffer)
+	MustWrite(buf, binary.BigEndian, v.Version)
+	MustWrite(buf, binary.BigEndian, v.GuardianSetIndex)
+
+	// Write signatures
+	MustWrite(buf, binary.BigEndian, uint8(len(v.Signatures)))
+	for _, sig := range v.Signatures {
+		MustWrite(buf, binary.BigEndian, sig.Index)
+		buf.Write(sig.Signature[:])
+	}
+
+	// Write Body
+	buf.Write(v.serializeBody())
+
+	return buf.Bytes(), nil
+}
+
+// implement encoding.BinaryMarshaler interface for the BatchVAA struct
+func (v BatchVAA) MarshalBinary() ([]byte, error) {
+	return v.Marshal()
+}
+
+// implement encoding.BinaryUnmarshaler interface for the BatchVAA struct
+func (v *BatchVAA) UnmarshalBinary(data []byte) error {
+	vaa, err := UnmarshalBatch(data)
+	if err != nil {
+		return err
+	}
+
+	*v = *vaa
+	return nil
+}
+
+// serializeBody returns the hashes and observations of the batch
+func (v *BatchVAA) serializeBody() []byte {
+	buf := new(bytes.Buffer)
+	MustWrite(buf, binary.BigEndian, uint8(len(v.Hashes)))
+	buf.Write(v.signingBody())
+
+	MustWrite(buf, binary.BigEndian, uint8(len(v.Observations)))
+	for _, obsv := range v.Observations {
+		body := obsv.Observation.serializeBody()
+		MustWrite(buf, binary.BigEndian, obsv.Index)
+		MustWrite(buf, binary.BigEndian, uint32(len(body)))
+		buf.Write(body)
+	}
+
+	return buf.Bytes()
+}
+
+// UniqueID returns the hex digest that uniquely identifies the batch
+func (v *BatchVAA) UniqueID() string {
+	return v.HexDigest()
+}
+
+// HexDigest returns the hex-encoded batch digest.
+func (v *BatchVAA) HexDigest() string {
+	return hex.EncodeToString(v.SigningDigest().Bytes())
+}
+
+func (v *BatchVAA) AddSignature(key *ecdsa.PrivateKey, index uint8) {
+	sig, err := crypto.Sign(v.SigningDigest().Bytes(), key)
+	if err != nil {
+		panic(err)
+	}
+	sigData := [65]byte{}
+	copy(sigData[:], sig)
+
+	v.Signatures = append(v.Signatures, &Signature{
+		Index:     index,
+		Signature: sigData,
+	})
+}
+
+// GetEmitterChain returns the emitter chain of the observations in the batch. Batches are observed on a single
+// chain, so this is the emitter chain of the first observation, or ChainIDUnset if the VAA has no observations.
+func (v *BatchVAA) GetEmitterChain() ChainID {
+	if len(v.Observations) == 0 {
+		return ChainIDUnset
+	}
+	return v.Observations[0].Observation.EmitterChain
+}
@@ -0,0 +1,166 @@
+package vaa
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getBatchObservations() []*VAA {
+	observations := make([]*VAA, 3)
+	for i := range observations {
+		observations[i] = &VAA{
+			Timestamp:        time.Unix(1000, 0),
+			Nonce:            uint32(7),
+			Sequence:         uint64(i),
+			ConsistencyLevel: uint8(32),
+			EmitterChain:     ChainIDEthereum,
+			EmitterAddress:   Address{31: byte(i)},
+			Payload:          []byte{97, 97, byte(i)},
+		}
+	}
+	return observations
+}
+
+func signBatch(t *testing.T, v *BatchVAA, n int) []common.Address {
+	addrs := make([]common.Address, n)
+	for i := 0; i < n; i++ {
+		key, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+		require.NoError(t, err)
+		addrs[i] = crypto.PubkeyToAddress(key.PublicKey)
+		v.AddSignature(key, uint8(i))
+	}
+	return addrs
+}
+
+func TestNewBatchVAA(t *testing.T) {
+	observations := getBatchObservations()
+	v, err := NewBatchVAA(1, observations)
+	require.NoError(t, err)
+
+	assert.Equal(t, uint8(BatchVAAVersion), v.Version)
+	assert.Equal(t, ChainIDEthereum, v.GetEmitterChain())
+	require.Len(t, v.Hashes, 3)
+	for i, obsv := range observations {
+		assert.Equal(t, obsv.SigningDigest(), v.Hashes[i])
+		assert.Equal(t, uint8(i), v.Observations[i].Index)
+	}
+
+	// The batch digest is the double keccak of the concatenated observation hashes
+	hashes := append(append(v.Hashes[0].Bytes(), v.Hashes[1].Bytes()...), v.Hashes[2].Bytes()...)
+	assert.Equal(t, crypto.Keccak256Hash(crypto.Keccak256(hashes)), v.SigningDigest())
+	assert.Equal(t, v.HexDigest(), v.UniqueID())
+
+	_, err = NewBatchVAA(1, nil)
+	assert.Error(t, err)
+
+	observations[1].EmitterChain = ChainIDSolana
+	_, err = NewBatchVAA(1, observations)
+	assert.Error(t, err)
+}
+
+func TestBatchVAAMarshalRoundTrip(t *testing.T) {
+	v, err := NewBatchVAA(1, getBatchObservations())
+	require.NoError(t, err)
+	addrs := signBatch(t, v, 4)
+
+	bz, err := v.Marshal()
+	require.NoError(t, err)
+
+	decoded, err := UnmarshalBatch(bz)
+	require.NoError(t, err)
+	assert.Equal(t, v, decoded)
+	require.NoError(t, decoded.Verify(addrs))
+
+	var fromBinary BatchVAA
+	require.NoError(t, fromBinary.UnmarshalBinary(bz))
+	assert.Equal(t, v, &fromBinary)
+
+	// v1 VAAs are not batch VAAs and vice versa
+	_, err = Unmarshal(bz)
+	assert.Error(t, err)
+	single := getVaa()
+	singleBz, err := single.Marshal()
+	require.NoError(t, err)
+	_, err = UnmarshalBatch(singleBz)
+	assert.Error(t, err)
+}
+
+func TestBatchVAAPartialObservations(t *testing.T) {
+	v, err := NewBatchVAA(1, getBatchObservations())
+	require.NoError(t, err)
+	addrs := signBatch(t, v, 4)
+
+	// A VAA with only some of the observations still verifies, since the signatures cover the hashes
+	v.Observations = v.Observations[1:2]
+	bz, err := v.Marshal()
+	require.NoError(t, err)
+
+	decoded, err := UnmarshalBatch(bz)
+	require.NoError(t, err)
+	require.Len(t, decoded.Observations, 1)
+	assert.Equal(t, uint8(1), decoded.Observations[0].Index)
+	assert.NoError(t, decoded.Verify(addrs))
+}
+
+func TestBatchVAAVerify(t *testing.T) {
+	v, err := NewBatchVAA(1, getBatchObservations())
+	require.NoError(t, err)
+	addrs := signBatch(t, v, 4)
+	require.NoError(t, v.Verify(addrs))
+
+	// Not enough signatures for quorum
+	sigs := v.Signatures
+	v.Signatures = sigs[:2]
+	assert.Error(t, v.Verify(addrs))
+	v.Signatures = sigs
+
+	// Observations must match the signed hashes
+	v.Observations[0].Observation.Payload = []byte{98}
+	assert.Error(t, v.Verify(addrs))
+	assert.True(t, v.VerifySignatures(addrs))
+}
+
+func TestUnmarshalBatchInvalid(t *testing.T) {
+	v, err := NewBatchVAA(1, getBatchObservations())
+	require.NoError(t, err)
+	signBatch(t, v, 1)
+	bz, err := v.Marshal()
+	require.NoError(t, err)
+
+	// header (6) + signature (66) + hashes (1 + 3 * 32) + observations length (1)
+	firstObservation := 6 + 66 + 1 + 3*32 + 1
+
+	tests := []struct {
+		label  string
+		mutate func(bz []byte) []byte
+	}{
+		{label: "TooShort", mutate: func(bz []byte) []byte { return bz[:minBatchVAALength-1] }},
+		{label: "Truncated", mutate: func(bz []byte) []byte { return bz[:len(bz)-1] }},
+		{label: "TrailingBytes", mutate: func(bz []byte) []byte { return append(bz, 0) }},
+		{label: "WrongVersion", mutate: func(bz []byte) []byte { bz[0] = SupportedVAAVersion; return bz }},
+		{label: "NoHashes", mutate: func(bz []byte) []byte { return append(bz[:72:72], 0, 0) }},
+		{label: "IndexOutOfRange", mutate: func(bz []byte) []byte { bz[firstObservation] = 3; return bz }},
+		{label: "IndexNotIncreasing", mutate: func(bz []byte) []byte { bz[firstObservation] = 1; return bz }},
+		{label: "ObservationTooLong", mutate: func(bz []byte) []byte {
+			binary.BigEndian.PutUint32(bz[firstObservation+1:], uint32(len(bz)))
+			return bz
+		}},
+		{label: "TamperedPayload", mutate: func(bz []byte) []byte { bz[len(bz)-1]++; return bz }},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.label, func(t *testing.T) {
+			data := tc.mutate(append([]byte{}, bz...))
+			_, err := UnmarshalBatch(data)
+			assert.Error(t, err)
+		})
+	}
+}
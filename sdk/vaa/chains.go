@@ -0,0 +1,210 @@
+package vaa
+
+import (
+	"fmt"
+	"math"
+)
+
+// Finality describes how the guardians determine that a block of a chain can no longer be reorganized.
+type Finality uint8
+
+const (
+	// FinalityUnknown is the finality of chains without a known finality model.
+	FinalityUnknown Finality = iota
+	// FinalityInstant chains finalize blocks as soon as they are produced.
+	FinalityInstant
+	// FinalityFinalized chains report finalized blocks, but not safe blocks.
+	FinalityFinalized
+	// FinalitySafe chains report both finalized and safe blocks.
+	FinalitySafe
+	// FinalityCustom chains require a chain specific finalizer.
+	FinalityCustom
+)
+
+func (f Finality) String() string {
+	switch f {
+	case FinalityInstant:
+		return "instant"
+	case FinalityFinalized:
+		return "finalized"
+	case FinalitySafe:
+		return "safe"
+	case FinalityCustom:
+		return "custom"
+	default:
+		return "unknown"
+	}
+}
+
+// AddressFormat is the native format of the account and contract addresses of a chain.
+type AddressFormat uint8
+
+const (
+	// AddressFormatUnknown is the address format of chains without a known address format.
+	AddressFormatUnknown AddressFormat = iota
+	// AddressFormatEVM addresses are 20 bytes, written as hex and left padded to 32 bytes in VAAs.
+	AddressFormatEVM
+	// AddressFormatBase58 addresses are 32 byte public keys or program derived addresses written as base58.
+	AddressFormatBase58
+	// AddressFormatBech32 addresses are cosmos-sdk accounts and contracts written as bech32.
+	AddressFormatBech32
+	// AddressFormatHex32 addresses are 32 bytes written as hex.
+	AddressFormatHex32
+	// AddressFormatAlgorand addresses are 32 byte public keys or application IDs written as base32 with a checksum.
+	AddressFormatAlgorand
+	// AddressFormatNear addresses are account IDs, which are hashed to 32 bytes in VAAs.
+	AddressFormatNear
+)
+
+func (a AddressFormat) String() string {
+	switch a {
+	case AddressFormatEVM:
+		return "evm"
+	case AddressFormatBase58:
+		return "base58"
+	case AddressFormatBech32:
+		return "bech32"
+	case AddressFormatHex32:
+		return "hex32"
+	case AddressFormatAlgorand:
+		return "algorand"
+	case AddressFormatNear:
+		return "near"
+	default:
+		return "unknown"
+	}
+}
+
+// ChainInfo is the metadata of a chain known to the SDK.
+type ChainInfo struct {
+	ID ChainID
+	// Name is the human readable name of the chain, as returned by ChainID.String.
+	Name          string
+	Finality      Finality
+	AddressFormat AddressFormat
+	// EVMChainID is the EIP-155 chain ID of an EVM chain, or 0 for other chains. Chains with a mainnet and a testnet
+	// deployment under the same wormhole chain ID use the mainnet value.
+	EVMChainID uint64
+}
+
+// IsEVM returns true if the chain runs the EVM.
+func (i ChainInfo) IsEVM() bool {
+	return i.AddressFormat == AddressFormatEVM
+}
+
+// chainRegistry holds the metadata of the chains known to the SDK. The name of a chain is taken from ChainID.String
+// when it is looked up.
+var chainRegistry = map[ChainID]ChainInfo{
+	ChainIDSolana:   {Finality: FinalityFinalized, AddressFormat: AddressFormatBase58},
+	ChainIDEthereum: {Finality: FinalitySafe, AddressFormat: AddressFormatEVM, EVMChainID: 1},
+	ChainIDTerra:    {Finality: FinalityInstant, AddressFormat: AddressFormatBech32},
+	ChainIDBSC:      {Finality: FinalitySafe, AddressFormat: AddressFormatEVM, EVMChainID: 56},
+	// Polygon now supports polling for finalized but not safe.
+	// https://forum.polygon.technology/t/optimizing-decentralized-apps-ux-with-milestones-a-significantly-accelerated-finality-solution/13154
+	ChainIDPolygon:   {Finality: FinalityFinalized, AddressFormat: AddressFormatEVM, EVMChainID: 137},
+	ChainIDAvalanche: {Finality: FinalityInstant, AddressFormat: AddressFormatEVM, EVMChainID: 43114},
+	ChainIDOasis:     {Finality: FinalityInstant, AddressFormat: AddressFormatEVM, EVMChainID: 42262},
+	ChainIDAlgorand:  {Finality: FinalityInstant, AddressFormat: AddressFormatAlgorand},
+	ChainIDAurora:    {Finality: FinalityInstant, AddressFormat: AddressFormatEVM, EVMChainID: 1313161554},
+	ChainIDFantom:    {Finality: FinalityInstant, AddressFormat: AddressFormatEVM, EVMChainID: 250},
+	ChainIDKarura:    {Finality: FinalitySafe, AddressFormat: AddressFormatEVM, EVMChainID: 686},
+	ChainIDAcala:     {Finality: FinalitySafe, AddressFormat: AddressFormatEVM, EVMChainID: 787},
+	ChainIDKlaytn:    {Finality: FinalityInstant, AddressFormat: AddressFormatEVM, EVMChainID: 8217},
+	// Celo has its own specialized finalizer.
+	ChainIDCelo:      {Finality: FinalityCustom, AddressFormat: AddressFormatEVM, EVMChainID: 42220},
+	ChainIDNear:      {Finality: FinalityCustom, AddressFormat: AddressFormatNear},
+	ChainIDMoonbeam:  {Finality: FinalitySafe, AddressFormat: AddressFormatEVM, EVMChainID: 1284},
+	ChainIDTerra2:    {Finality: FinalityInstant, AddressFormat: AddressFormatBech32},
+	ChainIDInjective: {Finality: FinalityInstant, AddressFormat: AddressFormatBech32},
+	ChainIDOsmosis:   {Finality: FinalityInstant, AddressFormat: AddressFormatBech32},
+	ChainIDSui:       {Finality: FinalityInstant, AddressFormat: AddressFormatHex32},
+	ChainIDAptos:     {Finality: FinalityInstant, AddressFormat: AddressFormatHex32},
+	ChainIDArbitrum:  {Finality: FinalitySafe, AddressFormat: AddressFormatEVM, EVMChainID: 42161},
+	ChainIDOptimism:  {Finality: FinalitySafe, AddressFormat: AddressFormatEVM, EVMChainID: 10},
+	ChainIDGnosis:    {Finality: FinalityUnknown, AddressFormat: AddressFormatEVM, EVMChainID: 100},
+	ChainIDPythNet:   {Finality: FinalityFinalized, AddressFormat: AddressFormatBase58},
+	ChainIDXpla:      {Finality: FinalityInstant, AddressFormat: AddressFormatBech32},
+	ChainIDBtc:       {Finality: FinalityUnknown, AddressFormat: AddressFormatUnknown},
+	ChainIDBase:      {Finality: FinalitySafe, AddressFormat: AddressFormatEVM, EVMChainID: 8453},
+	ChainIDSei:       {Finality: FinalityInstant, AddressFormat: AddressFormatBech32},
+	ChainIDRootstock: {Finality: FinalityUnknown, AddressFormat: AddressFormatEVM, EVMChainID: 30},
+	// As of 11/10/2023 Scroll supports polling for finalized but not safe.
+	ChainIDScroll: {Finality: FinalityFinalized, AddressFormat: AddressFormatEVM, EVMChainID: 534352},
+	ChainIDMantle: {Finality: FinalitySafe, AddressFormat: AddressFormatEVM, EVMChainID: 5000},
+	ChainIDBlast:  {Finality: FinalitySafe, AddressFormat: AddressFormatEVM, EVMChainID: 81457},
+	ChainIDXLayer: {Finality: FinalitySafe, AddressFormat: AddressFormatEVM, EVMChainID: 196},
+	// As of 9/06/2024 Linea supports polling for finalized but not safe.
+	ChainIDLinea: {Finality: FinalityFinalized, AddressFormat: AddressFormatEVM, EVMChainID: 59144},
+	// Berachain supports instant finality: https://docs.berachain.com/faq/
+	ChainIDBerachain:       {Finality: FinalityInstant, AddressFormat: AddressFormatEVM, EVMChainID: 80094},
+	ChainIDSeiEVM:          {Finality: FinalityUnknown, AddressFormat: AddressFormatEVM, EVMChainID: 1329},
+	ChainIDSnaxchain:       {Finality: FinalitySafe, AddressFormat: AddressFormatEVM, EVMChainID: 2192},
+	ChainIDUnichain:        {Finality: FinalitySafe, AddressFormat: AddressFormatEVM, EVMChainID: 130},
+	ChainIDWorldchain:      {Finality: FinalitySafe, AddressFormat: AddressFormatEVM, EVMChainID: 480},
+	ChainIDInk:             {Finality: FinalitySafe, AddressFormat: AddressFormatEVM, EVMChainID: 57073},
+	ChainIDWormchain:       {Finality: FinalityInstant, AddressFormat: AddressFormatBech32},
+	ChainIDCosmoshub:       {Finality: FinalityInstant, AddressFormat: AddressFormatBech32},
+	ChainIDEvmos:           {Finality: FinalityInstant, AddressFormat: AddressFormatBech32},
+	ChainIDKujira:          {Finality: FinalityInstant, AddressFormat: AddressFormatBech32},
+	ChainIDNeutron:         {Finality: FinalityInstant, AddressFormat: AddressFormatBech32},
+	ChainIDCelestia:        {Finality: FinalityInstant, AddressFormat: AddressFormatBech32},
+	ChainIDStargaze:        {Finality: FinalityInstant, AddressFormat: AddressFormatBech32},
+	ChainIDSeda:            {Finality: FinalityInstant, AddressFormat: AddressFormatBech32},
+	ChainIDDymension:       {Finality: FinalityInstant, AddressFormat: AddressFormatBech32},
+	ChainIDProvenance:      {Finality: FinalityInstant, AddressFormat: AddressFormatBech32},
+	ChainIDSepolia:         {Finality: FinalitySafe, AddressFormat: AddressFormatEVM, EVMChainID: 11155111},
+	ChainIDArbitrumSepolia: {Finality: FinalitySafe, AddressFormat: AddressFormatEVM, EVMChainID: 421614},
+	ChainIDBaseSepolia:     {Finality: FinalitySafe, AddressFormat: AddressFormatEVM, EVMChainID: 84532},
+	ChainIDOptimismSepolia: {Finality: FinalitySafe, AddressFormat: AddressFormatEVM, EVMChainID: 11155420},
+	ChainIDHolesky:         {Finality: FinalitySafe, AddressFormat: AddressFormatEVM, EVMChainID: 17000},
+	ChainIDPolygonSepolia:  {Finality: FinalityFinalized, AddressFormat: AddressFormatEVM, EVMChainID: 80002},
+	ChainIDMonadDevnet:     {Finality: FinalitySafe, AddressFormat: AddressFormatEVM},
+}
+
+// number is the set of integer types chain IDs are carried in, e.g. in protobuf messages.
+type number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// ChainIDFromNumber converts an integer into a ChainID. It fails if the integer does not fit into a uint16, but
+// accepts chain IDs that are not known to the SDK yet.
+func ChainIDFromNumber[N number](n N) (ChainID, error) {
+	if n < 0 || uint64(n) > math.MaxUint16 {
+		return ChainIDUnset, fmt.Errorf("chain id %d is out of range", n)
+	}
+	return ChainID(n), nil
+}
+
+// GetChainInfo returns the metadata of a chain. It returns false if the chain is not known to the SDK.
+func GetChainInfo(c ChainID) (ChainInfo, bool) {
+	info, ok := chainRegistry[c]
+	if !ok {
+		return ChainInfo{}, false
+	}
+	info.ID = c
+	info.Name = c.String()
+	return info, true
+}
+
+// ChainIDFromEVMChainID returns the ChainID of the EVM chain with the given EIP-155 chain ID.
+func ChainIDFromEVMChainID(evmChainID uint64) (ChainID, bool) {
+	if evmChainID == 0 {
+		return ChainIDUnset, false
+	}
+	for c, info := range chainRegistry {
+		if info.EVMChainID == evmChainID {
+			return c, true
+		}
+	}
+	return ChainIDUnset, false
+}
+
+// IsEVMChain returns true if the chain is known to run the EVM.
+func IsEVMChain(c ChainID) bool {
+	return chainRegistry[c].IsEVM()
+}
+
+// FinalityForChain returns the finality model of a chain, or FinalityUnknown if the chain is not known.
+func FinalityForChain(c ChainID) Finality {
+	return chainRegistry[c].Finality
+}
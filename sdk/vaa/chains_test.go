@@ -0,0 +1,106 @@
+package vaa
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainRegistryCoversAllNetworkIDs(t *testing.T) {
+	for _, c := range GetAllNetworkIDs() {
+		info, ok := GetChainInfo(c)
+		require.True(t, ok, "chain %s is missing from the registry", c)
+		assert.Equal(t, c, info.ID)
+		assert.Equal(t, c.String(), info.Name)
+
+		fromName, err := ChainIDFromString(info.Name)
+		require.NoError(t, err)
+		assert.Equal(t, c, fromName)
+	}
+
+	for c := range chainRegistry {
+		_, err := ChainIDFromString(c.String())
+		assert.NoError(t, err, "chain %d has no name", c)
+	}
+}
+
+func TestChainRegistryEVMChainIDs(t *testing.T) {
+	seen := make(map[uint64]ChainID)
+	for c, info := range chainRegistry {
+		if !info.IsEVM() {
+			assert.Zero(t, info.EVMChainID, "non EVM chain %s has an EVM chain id", c)
+			continue
+		}
+		if info.EVMChainID == 0 {
+			continue
+		}
+		other, dup := seen[info.EVMChainID]
+		assert.False(t, dup, "EVM chain id %d is used by %s and %s", info.EVMChainID, c, other)
+		seen[info.EVMChainID] = c
+
+		fromEVM, ok := ChainIDFromEVMChainID(info.EVMChainID)
+		require.True(t, ok)
+		assert.Equal(t, c, fromEVM)
+	}
+
+	_, ok := ChainIDFromEVMChainID(0)
+	assert.False(t, ok)
+	_, ok = ChainIDFromEVMChainID(math.MaxUint64)
+	assert.False(t, ok)
+}
+
+func TestGetChainInfo(t *testing.T) {
+	info, ok := GetChainInfo(ChainIDEthereum)
+	require.True(t, ok)
+	assert.Equal(t, ChainInfo{
+		ID:            ChainIDEthereum,
+		Name:          "ethereum",
+		Finality:      FinalitySafe,
+		AddressFormat: AddressFormatEVM,
+		EVMChainID:    1,
+	}, info)
+
+	_, ok = GetChainInfo(ChainIDUnset)
+	assert.False(t, ok)
+	_, ok = GetChainInfo(ChainID(math.MaxUint16))
+	assert.False(t, ok)
+}
+
+func TestIsEVMChain(t *testing.T) {
+	assert.True(t, IsEVMChain(ChainIDEthereum))
+	assert.True(t, IsEVMChain(ChainIDCelo))
+	assert.True(t, IsEVMChain(ChainIDSepolia))
+	assert.False(t, IsEVMChain(ChainIDSolana))
+	assert.False(t, IsEVMChain(ChainIDWormchain))
+	assert.False(t, IsEVMChain(ChainIDEvmos))
+	assert.False(t, IsEVMChain(ChainIDUnset))
+}
+
+func TestFinalityForChain(t *testing.T) {
+	assert.Equal(t, FinalitySafe, FinalityForChain(ChainIDEthereum))
+	assert.Equal(t, FinalityFinalized, FinalityForChain(ChainIDPolygon))
+	assert.Equal(t, FinalityInstant, FinalityForChain(ChainIDAvalanche))
+	assert.Equal(t, FinalityCustom, FinalityForChain(ChainIDCelo))
+	assert.Equal(t, FinalityUnknown, FinalityForChain(ChainIDUnset))
+	assert.Equal(t, "safe", FinalitySafe.String())
+}
+
+func TestChainIDFromNumber(t *testing.T) {
+	c, err := ChainIDFromNumber(uint32(2))
+	require.NoError(t, err)
+	assert.Equal(t, ChainIDEthereum, c)
+
+	// Chains that are not known yet are accepted
+	c, err = ChainIDFromNumber(math.MaxUint16)
+	require.NoError(t, err)
+	assert.Equal(t, ChainID(math.MaxUint16), c)
+
+	_, err = ChainIDFromNumber(math.MaxUint16 + 1)
+	assert.Error(t, err)
+	_, err = ChainIDFromNumber(-1)
+	assert.Error(t, err)
+	_, err = ChainIDFromNumber(uint64(math.MaxUint64))
+	assert.Error(t, err)
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"time"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/holiman/uint256"
@@ -13,6 +14,7 @@ import (
 
 // CoreModule is the identifier of the Core module (which is used for governance messages)
 var CoreModule = []byte{00, 00, 00, 00, 00, 00, 00, 00, 00, 00, 00, 00, 00, 00, 00, 00, 00, 00, 00, 00, 00, 00, 00, 00, 00, 00, 00, 00, 0x43, 0x6f, 0x72, 0x65}
+var CoreModuleStr = string(CoreModule)
 
 // WasmdModule is the identifier of the Wormchain Wasmd module (which is used for governance messages)
 var WasmdModule = [32]byte{00, 00, 00, 00, 00, 00, 00, 00, 00, 00, 00, 00, 00, 00, 00, 00, 00, 00, 00, 00, 00, 0x57, 0x61, 0x73, 0x6D, 0x64, 0x4D, 0x6F, 0x64, 0x75, 0x6C, 0x65}
@@ -64,6 +66,14 @@ var GeneralPurposeGovernanceModule = [32]byte{
 }
 var GeneralPurposeGovernanceModuleStr = string(GeneralPurposeGovernanceModule[:])
 
+// GovernorModule is the identifier of the guardian node chain governor (which is used for governance messages).
+// It is the hex representation of "Governor" left padded with zeroes.
+var GovernorModule = [32]byte{
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x6f, 0x72,
+}
+var GovernorModuleStr = string(GovernorModule[:])
+
 type GovernanceAction uint8
 
 var (
@@ -75,6 +85,49 @@ var (
 	ActionCoreTransferFees   GovernanceAction = 4
 	ActionCoreRecoverChainId GovernanceAction = 5
 
+	// Wormchain core governance actions
+	ActionUpdateGovernanceEmitter GovernanceAction = 6
+	ActionPruneGuardianSets       GovernanceAction = 7
+	ActionConsensusParamsUpdate   GovernanceAction = 8
+	// ActionScheduledGuardianSetUpdate registers a new guardian set that only
+	// becomes the wormchain consensus set once its activation point is reached.
+	ActionScheduledGuardianSetUpdate GovernanceAction = 9
+	ActionFeeParamsUpdate            GovernanceAction = 10
+	ActionSignatureGasUpdate         GovernanceAction = 11
+	ActionRegisterEmitter            GovernanceAction = 12
+	ActionQuorumThresholdUpdate      GovernanceAction = 13
+	// ActionGovernanceSubmitterUpdate adds or removes an account from the
+	// allowlist of accounts that can submit governance VAAs on wormchain.
+	ActionGovernanceSubmitterUpdate GovernanceAction = 14
+	// ActionVAAArchiveRetentionUpdate sets how many blocks verified VAAs are
+	// kept in the wormchain VAA archive.
+	ActionVAAArchiveRetentionUpdate GovernanceAction = 15
+	// ActionGuardianSetWeightsUpdate sets the weights of the guardians of a
+	// guardian set when wormchain tallies observations.
+	ActionGuardianSetWeightsUpdate GovernanceAction = 16
+	// ActionPauseBridge and ActionResumeBridge toggle the wormchain circuit
+	// breaker that rejects token bridge executions routed through the gateway.
+	ActionPauseBridge  GovernanceAction = 17
+	ActionResumeBridge GovernanceAction = 18
+	// ActionChainRateLimitUpdate sets the number of observations from an
+	// emitter chain that wormchain finalizes within a window of blocks.
+	ActionChainRateLimitUpdate GovernanceAction = 19
+	// ActionMsgShutdownUpdate shuts down or recovers the handler of a
+	// wormchain wormhole module message type. Its payload is versioned.
+	ActionMsgShutdownUpdate GovernanceAction = 20
+	// ActionForwardFeeUpdate sets the fee deducted from the transfers the
+	// packet forward middleware forwards through wormchain. Its payload is
+	// versioned.
+	ActionForwardFeeUpdate GovernanceAction = 21
+	// ActionMaintenanceWindowUpdate declares a window of blocks during which
+	// wasm executions sent directly to a set of wormchain contracts are
+	// rejected. Its payload is versioned.
+	ActionMaintenanceWindowUpdate GovernanceAction = 22
+	// ActionValidatorTransitionWindowUpdate sets how many blocks the
+	// validators of guardians removed from the wormchain consensus guardian
+	// set stay bonded after the switch. Its payload is versioned.
+	ActionValidatorTransitionWindowUpdate GovernanceAction = 23
+
 	// Wormchain cosmwasm/middleware governance actions
 	ActionStoreCode                      GovernanceAction = 1
 	ActionInstantiateContract            GovernanceAction = 2
@@ -86,6 +139,22 @@ var (
 	ActionScheduleUpgrade               GovernanceAction = 1
 	ActionCancelUpgrade                 GovernanceAction = 2
 	ActionSetIbcComposabilityMwContract GovernanceAction = 3
+	ActionSlashingParamsUpdate          GovernanceAction = 4
+	ActionStakingParamsUpdate           GovernanceAction = 5
+	// ActionIcaHostAllowlistUpdate allows or disallows a message type to be
+	// executed on Gateway by the interchain accounts of an IBC connection.
+	ActionIcaHostAllowlistUpdate GovernanceAction = 6
+	// ActionTokenFactoryAdminUpdate and ActionTokenFactoryMetadataUpdate
+	// repair the admin and the bank metadata of the tokenfactory denoms of
+	// wrapped assets on Gateway.
+	ActionTokenFactoryAdminUpdate    GovernanceAction = 7
+	ActionTokenFactoryMetadataUpdate GovernanceAction = 8
+	// ActionSetCoreContract sets the core contract on Gateway whose published
+	// messages are reported to the message posted hooks of the wormhole module.
+	ActionSetCoreContract GovernanceAction = 9
+	// ActionApprovedCodeHashUpdate pins the code hash that wasm instantiations
+	// and migrations of a code ID on Gateway must match, or revokes it.
+	ActionApprovedCodeHashUpdate GovernanceAction = 10
 
 	// Accountant governance actions
 	ActionModifyBalance GovernanceAction = 1
@@ -112,6 +181,11 @@ var (
 	// General purpose governance
 	GeneralPurposeGovernanceEvmAction    GovernanceAction = 1
 	GeneralPurposeGovernanceSolanaAction GovernanceAction = 2
+
+	// Guardian node chain governor governance actions
+	// GovernorActionChainConfigUpdate sets the daily notional limit and the
+	// big transaction size the governor enforces for an emitter chain.
+	GovernorActionChainConfigUpdate GovernanceAction = 1
 )
 
 type (
@@ -127,6 +201,120 @@ type (
 		NewIndex uint32
 	}
 
+	// BodyScheduledGuardianSetUpdate is a governance message to set a new guardian set on wormchain
+	// whose switch to the consensus set is delayed until ActivationHeight and ActivationTime (unix
+	// seconds) are reached. A zero value leaves that condition unconstrained.
+	BodyScheduledGuardianSetUpdate struct {
+		Keys             []ethcommon.Address
+		NewIndex         uint32
+		ActivationHeight uint64
+		ActivationTime   uint64
+	}
+
+	// BodyWormchainUpdateGovernanceEmitter is a governance message to change the governance emitter trusted by wormchain
+	BodyWormchainUpdateGovernanceEmitter struct {
+		GovernanceChain   ChainID
+		GovernanceEmitter Address
+	}
+
+	// BodyWormchainPruneGuardianSets is a governance message to delete the wormchain guardian sets older than KeepFromIndex
+	BodyWormchainPruneGuardianSets struct {
+		KeepFromIndex uint32
+	}
+
+	// BodyWormchainConsensusParamsUpdate is a governance message to update the tendermint consensus parameters of wormchain
+	BodyWormchainConsensusParamsUpdate struct {
+		BlockMaxBytes           int64
+		BlockMaxGas             int64
+		EvidenceMaxAgeNumBlocks int64
+		EvidenceMaxAgeDuration  time.Duration
+		EvidenceMaxBytes        int64
+	}
+
+	// BodyWormchainFeeParamsUpdate is a governance message to update the wormchain message and gateway transfer fees.
+	// The fees are encoded as uint256 but wormchain only accepts values that fit into a uint64.
+	BodyWormchainFeeParamsUpdate struct {
+		MessageFee         uint64
+		GatewayTransferFee uint64
+	}
+
+	// BodyWormchainSignatureGasUpdate is a governance message to set the gas charged per verified guardian signature on wormchain
+	BodyWormchainSignatureGasUpdate struct {
+		GasPerSignature uint64
+	}
+
+	// BodyWormchainRegisterEmitter is a governance message to register the emitter of a module on a chain with wormchain.
+	// The module name is left padded to 32 bytes.
+	BodyWormchainRegisterEmitter struct {
+		EmitterChain   ChainID
+		EmitterAddress Address
+		Module         string
+	}
+
+	// BodyWormchainQuorumThresholdUpdate is a governance message to set the fraction of guardians wormchain requires for quorum
+	BodyWormchainQuorumThresholdUpdate struct {
+		Numerator   uint32
+		Denominator uint32
+	}
+
+	// BodyWormchainGovernanceSubmitterUpdate is a governance message to add (Allowed) or remove an account from the
+	// allowlist of accounts that can submit governance VAAs on wormchain. The address is 20 or 32 bytes long.
+	BodyWormchainGovernanceSubmitterUpdate struct {
+		Allowed bool
+		Address []byte
+	}
+
+	// BodyWormchainVAAArchiveRetentionUpdate is a governance message to set the number of blocks verified VAAs are kept
+	// in the wormchain VAA archive. Zero disables the archive.
+	BodyWormchainVAAArchiveRetentionUpdate struct {
+		RetentionBlocks uint64
+	}
+
+	// BodyWormchainValidatorTransitionWindowUpdate is a governance message to set the number of blocks the validators of
+	// guardians removed from the wormchain consensus guardian set stay bonded after the switch. Zero unbonds them at the
+	// switch. It is encoded as version 1 of the versioned ActionValidatorTransitionWindowUpdate payload.
+	BodyWormchainValidatorTransitionWindowUpdate struct {
+		TransitionBlocks uint64
+	}
+
+	// BodyWormchainGuardianSetWeightsUpdate is a governance message to set the weights of the guardians of a guardian
+	// set on wormchain, in the order of the guardian set keys
+	BodyWormchainGuardianSetWeightsUpdate struct {
+		GuardianSetIndex uint32
+		Weights          []uint64
+	}
+
+	// BodyWormchainChainRateLimitUpdate is a governance message to set the number of observations from an emitter chain
+	// that wormchain finalizes within a window of blocks
+	BodyWormchainChainRateLimitUpdate struct {
+		EmitterChain ChainID
+		Limit        uint64
+		WindowBlocks uint64
+	}
+
+	// BodyWormchainMsgShutdownUpdate is a governance message to shut down or recover the handler of a wormchain
+	// wormhole module message type. It is encoded as version 1 of the versioned ActionMsgShutdownUpdate payload.
+	BodyWormchainMsgShutdownUpdate struct {
+		Shutdown   bool
+		MsgTypeURL string
+	}
+
+	// BodyWormchainForwardFeeUpdate is a governance message to set the fee, in basis points, deducted from the transfers
+	// the packet forward middleware forwards through wormchain. It is encoded as version 1 of the versioned
+	// ActionForwardFeeUpdate payload.
+	BodyWormchainForwardFeeUpdate struct {
+		FeeBps uint16
+	}
+
+	// BodyWormchainMaintenanceWindowUpdate is a governance message to declare the blocks, inclusive, during which wasm
+	// executions sent directly to the listed wormchain contracts are rejected. An empty list of contracts ends the
+	// maintenance window. It is encoded as version 1 of the versioned ActionMaintenanceWindowUpdate payload.
+	BodyWormchainMaintenanceWindowUpdate struct {
+		StartHeight uint64
+		EndHeight   uint64
+		Contracts   []Address
+	}
+
 	// BodyTokenBridgeRegisterChain is a governance message to register a chain on the token bridge
 	BodyTokenBridgeRegisterChain struct {
 		Module         string
@@ -193,6 +381,61 @@ type (
 		ContractAddr [32]byte
 	}
 
+	// BodyGatewaySlashingParamsUpdate is a governance message to update the slashing parameters on Gateway.
+	// The decimal parameters are carried as the raw 32-byte big-endian mantissas of cosmos-sdk's Dec, which
+	// has 18 decimal places, i.e. 0.5 is encoded as 500000000000000000. DowntimeJailDuration is in nanoseconds.
+	BodyGatewaySlashingParamsUpdate struct {
+		SignedBlocksWindow      uint64
+		MinSignedPerWindow      *uint256.Int
+		DowntimeJailDuration    uint64
+		SlashFractionDoubleSign *uint256.Int
+		SlashFractionDowntime   *uint256.Int
+	}
+
+	// BodyGatewayStakingParamsUpdate is a governance message to update the staking parameters on Gateway.
+	// UnbondingTime is in nanoseconds. The bond denom takes up the rest of the payload.
+	BodyGatewayStakingParamsUpdate struct {
+		UnbondingTime uint64
+		MaxValidators uint32
+		MaxEntries    uint32
+		BondDenom     string
+	}
+
+	// BodyGatewayIcaHostAllowlistUpdate is a governance message to allow or disallow a message type to be executed on
+	// Gateway by the interchain accounts of an IBC connection. The connection ID is length prefixed, the message
+	// type URL takes up the rest of the payload.
+	BodyGatewayIcaHostAllowlistUpdate struct {
+		Allowed      bool
+		ConnectionID string
+		MsgTypeURL   string
+	}
+
+	// BodyGatewayTokenFactoryAdminUpdate is a governance message to set the admin of a tokenfactory denom on Gateway.
+	// The denom is length prefixed, the new admin takes up the rest of the payload and is empty to leave the denom
+	// without an admin.
+	BodyGatewayTokenFactoryAdminUpdate struct {
+		Denom    string
+		NewAdmin string
+	}
+
+	// BodyGatewayTokenFactoryMetadataUpdate is a governance message to set the bank metadata of a tokenfactory denom on
+	// Gateway. The metadata is the JSON encoded cosmos.bank.v1beta1.Metadata, whose base is the denom.
+	BodyGatewayTokenFactoryMetadataUpdate struct {
+		Metadata []byte
+	}
+
+	// BodyGatewayCoreContract is a governance message to set the core contract on Gateway
+	BodyGatewayCoreContract struct {
+		ContractAddr [32]byte
+	}
+
+	// BodyGatewayApprovedCodeHashUpdate is a governance message to approve the code hash of a wasm code ID on Gateway.
+	// A zero code hash revokes the approval.
+	BodyGatewayApprovedCodeHashUpdate struct {
+		CodeID   uint64
+		CodeHash [32]byte
+	}
+
 	// BodyCircleIntegrationUpdateWormholeFinality is a governance message to update the wormhole finality for Circle Integration.
 	BodyCircleIntegrationUpdateWormholeFinality struct {
 		TargetChainID ChainID
@@ -230,6 +473,14 @@ type (
 		NewDefaultDeliveryProviderAddress Address
 	}
 
+	// BodyGovernorChainConfigUpdate is a governance message to update the limits the chain governor enforces for an
+	// emitter chain. It is addressed to all guardians rather than to a contract.
+	BodyGovernorChainConfigUpdate struct {
+		EmitterChain       ChainID
+		DailyLimit         uint64
+		BigTransactionSize uint64
+	}
+
 	// BodyGeneralPurposeGovernanceEvm is a general purpose governance message for EVM chains
 	BodyGeneralPurposeGovernanceEvm struct {
 		ChainID            ChainID
@@ -264,6 +515,15 @@ func (b BodyContractUpgrade) Serialize() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+func (b *BodyContractUpgrade) Deserialize(bz []byte) error {
+	if len(bz) != 32 {
+		return fmt.Errorf("incorrect payload length, should be 32, is %d", len(bz))
+	}
+
+	copy(b.NewContract[:], bz)
+	return nil
+}
+
 func (b BodyGuardianSetUpdate) Serialize() ([]byte, error) {
 	buf := new(bytes.Buffer)
 
@@ -283,6 +543,76 @@ func (b BodyGuardianSetUpdate) Serialize() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+func (b *BodyGuardianSetUpdate) Deserialize(bz []byte) error {
+	newIndex, keys, rest, err := deserializeGuardianSet(bz)
+	if err != nil {
+		return err
+	}
+	if len(rest) != 0 {
+		return fmt.Errorf("incorrect payload length, has %d trailing bytes", len(rest))
+	}
+
+	b.NewIndex = newIndex
+	b.Keys = keys
+	return nil
+}
+
+func (b BodyScheduledGuardianSetUpdate) Serialize() ([]byte, error) {
+	buf := new(bytes.Buffer)
+
+	// Module
+	buf.Write(CoreModule)
+	// Action
+	MustWrite(buf, binary.BigEndian, ActionScheduledGuardianSetUpdate)
+	// ChainID
+	MustWrite(buf, binary.BigEndian, ChainIDWormchain)
+
+	MustWrite(buf, binary.BigEndian, b.NewIndex)
+	MustWrite(buf, binary.BigEndian, uint8(len(b.Keys)))
+	for _, k := range b.Keys {
+		buf.Write(k[:])
+	}
+	MustWrite(buf, binary.BigEndian, b.ActivationHeight)
+	MustWrite(buf, binary.BigEndian, b.ActivationTime)
+
+	return buf.Bytes(), nil
+}
+
+func (b *BodyScheduledGuardianSetUpdate) Deserialize(bz []byte) error {
+	newIndex, keys, rest, err := deserializeGuardianSet(bz)
+	if err != nil {
+		return err
+	}
+	if len(rest) != 16 {
+		return fmt.Errorf("incorrect activation length, should be 16, is %d", len(rest))
+	}
+
+	b.NewIndex = newIndex
+	b.Keys = keys
+	b.ActivationHeight = binary.BigEndian.Uint64(rest[0:8])
+	b.ActivationTime = binary.BigEndian.Uint64(rest[8:16])
+	return nil
+}
+
+// deserializeGuardianSet decodes [uint32 new_index][uint8 num_keys][20-byte key]*num_keys and returns the trailing bytes
+func deserializeGuardianSet(bz []byte) (uint32, []ethcommon.Address, []byte, error) {
+	if len(bz) < 5 {
+		return 0, nil, nil, fmt.Errorf("incorrect payload length, should be at least 5, is %d", len(bz))
+	}
+
+	newIndex := binary.BigEndian.Uint32(bz[0:4])
+	numKeys := int(bz[4])
+	if len(bz) < 5+20*numKeys {
+		return 0, nil, nil, fmt.Errorf("incorrect payload length, should be at least %d, is %d", 5+20*numKeys, len(bz))
+	}
+
+	keys := make([]ethcommon.Address, numKeys)
+	for i := range keys {
+		copy(keys[i][:], bz[5+20*i:5+20*(i+1)])
+	}
+	return newIndex, keys, bz[5+20*numKeys:], nil
+}
+
 func (r BodyTokenBridgeRegisterChain) Serialize() ([]byte, error) {
 	payload := &bytes.Buffer{}
 	MustWrite(payload, binary.BigEndian, r.ChainID)
@@ -291,6 +621,16 @@ func (r BodyTokenBridgeRegisterChain) Serialize() ([]byte, error) {
 	return serializeBridgeGovernanceVaa(r.Module, ActionRegisterChain, 0, payload.Bytes())
 }
 
+func (r *BodyTokenBridgeRegisterChain) Deserialize(bz []byte) error {
+	if len(bz) != 34 {
+		return fmt.Errorf("incorrect payload length, should be 34, is %d", len(bz))
+	}
+
+	r.ChainID = ChainID(binary.BigEndian.Uint16(bz[0:2]))
+	copy(r.EmitterAddress[:], bz[2:34])
+	return nil
+}
+
 func (r BodyTokenBridgeUpgradeContract) Serialize() ([]byte, error) {
 	return serializeBridgeGovernanceVaa(r.Module, ActionUpgradeTokenBridge, r.TargetChainID, r.NewContract[:])
 }
@@ -353,6 +693,365 @@ func (r BodyWormchainMigrateContract) Serialize() ([]byte, error) {
 	return serializeBridgeGovernanceVaa(WasmdModuleStr, ActionMigrateContract, ChainIDWormchain, r.MigrationParamsHash[:])
 }
 
+func (r *BodyWormchainStoreCode) Deserialize(bz []byte) error {
+	return deserializeHash(r.WasmHash[:], bz)
+}
+
+func (r *BodyWormchainInstantiateContract) Deserialize(bz []byte) error {
+	return deserializeHash(r.InstantiationParamsHash[:], bz)
+}
+
+func (r *BodyWormchainMigrateContract) Deserialize(bz []byte) error {
+	return deserializeHash(r.MigrationParamsHash[:], bz)
+}
+
+func deserializeHash(hash []byte, bz []byte) error {
+	if len(bz) != 32 {
+		return fmt.Errorf("incorrect payload length, should be 32, is %d", len(bz))
+	}
+
+	copy(hash, bz)
+	return nil
+}
+
+func (r BodyWormchainUpdateGovernanceEmitter) Serialize() ([]byte, error) {
+	payload := &bytes.Buffer{}
+	MustWrite(payload, binary.BigEndian, r.GovernanceChain)
+	payload.Write(r.GovernanceEmitter[:])
+	return serializeBridgeGovernanceVaa(CoreModuleStr, ActionUpdateGovernanceEmitter, ChainIDWormchain, payload.Bytes())
+}
+
+func (r *BodyWormchainUpdateGovernanceEmitter) Deserialize(bz []byte) error {
+	if len(bz) != 34 {
+		return fmt.Errorf("incorrect payload length, should be 34, is %d", len(bz))
+	}
+
+	r.GovernanceChain = ChainID(binary.BigEndian.Uint16(bz[0:2]))
+	copy(r.GovernanceEmitter[:], bz[2:34])
+	return nil
+}
+
+func (r BodyWormchainPruneGuardianSets) Serialize() ([]byte, error) {
+	payload := &bytes.Buffer{}
+	MustWrite(payload, binary.BigEndian, r.KeepFromIndex)
+	return serializeBridgeGovernanceVaa(CoreModuleStr, ActionPruneGuardianSets, ChainIDWormchain, payload.Bytes())
+}
+
+func (r *BodyWormchainPruneGuardianSets) Deserialize(bz []byte) error {
+	if len(bz) != 4 {
+		return fmt.Errorf("incorrect payload length, should be 4, is %d", len(bz))
+	}
+
+	r.KeepFromIndex = binary.BigEndian.Uint32(bz)
+	return nil
+}
+
+func (r BodyWormchainConsensusParamsUpdate) Serialize() ([]byte, error) {
+	payload := &bytes.Buffer{}
+	MustWrite(payload, binary.BigEndian, r.BlockMaxBytes)
+	MustWrite(payload, binary.BigEndian, r.BlockMaxGas)
+	MustWrite(payload, binary.BigEndian, r.EvidenceMaxAgeNumBlocks)
+	MustWrite(payload, binary.BigEndian, int64(r.EvidenceMaxAgeDuration))
+	MustWrite(payload, binary.BigEndian, r.EvidenceMaxBytes)
+	return serializeBridgeGovernanceVaa(CoreModuleStr, ActionConsensusParamsUpdate, ChainIDWormchain, payload.Bytes())
+}
+
+func (r *BodyWormchainConsensusParamsUpdate) Deserialize(bz []byte) error {
+	if len(bz) != 40 {
+		return fmt.Errorf("incorrect payload length, should be 40, is %d", len(bz))
+	}
+
+	r.BlockMaxBytes = int64(binary.BigEndian.Uint64(bz[0:8]))
+	r.BlockMaxGas = int64(binary.BigEndian.Uint64(bz[8:16]))
+	r.EvidenceMaxAgeNumBlocks = int64(binary.BigEndian.Uint64(bz[16:24]))
+	r.EvidenceMaxAgeDuration = time.Duration(binary.BigEndian.Uint64(bz[24:32]))
+	r.EvidenceMaxBytes = int64(binary.BigEndian.Uint64(bz[32:40]))
+	return nil
+}
+
+func (r BodyWormchainFeeParamsUpdate) Serialize() ([]byte, error) {
+	payload := &bytes.Buffer{}
+	MustWrite(payload, binary.BigEndian, uint256.NewInt(r.MessageFee).Bytes32())
+	MustWrite(payload, binary.BigEndian, uint256.NewInt(r.GatewayTransferFee).Bytes32())
+	return serializeBridgeGovernanceVaa(CoreModuleStr, ActionFeeParamsUpdate, ChainIDWormchain, payload.Bytes())
+}
+
+func (r *BodyWormchainFeeParamsUpdate) Deserialize(bz []byte) error {
+	if len(bz) != 64 {
+		return fmt.Errorf("incorrect payload length, should be 64, is %d", len(bz))
+	}
+
+	messageFee := new(uint256.Int).SetBytes32(bz[0:32])
+	gatewayTransferFee := new(uint256.Int).SetBytes32(bz[32:64])
+	if !messageFee.IsUint64() || !gatewayTransferFee.IsUint64() {
+		return errors.New("fee does not fit into a uint64")
+	}
+
+	r.MessageFee = messageFee.Uint64()
+	r.GatewayTransferFee = gatewayTransferFee.Uint64()
+	return nil
+}
+
+func (r BodyWormchainSignatureGasUpdate) Serialize() ([]byte, error) {
+	payload := &bytes.Buffer{}
+	MustWrite(payload, binary.BigEndian, r.GasPerSignature)
+	return serializeBridgeGovernanceVaa(CoreModuleStr, ActionSignatureGasUpdate, ChainIDWormchain, payload.Bytes())
+}
+
+func (r *BodyWormchainSignatureGasUpdate) Deserialize(bz []byte) error {
+	if len(bz) != 8 {
+		return fmt.Errorf("incorrect payload length, should be 8, is %d", len(bz))
+	}
+
+	r.GasPerSignature = binary.BigEndian.Uint64(bz)
+	return nil
+}
+
+func (r BodyWormchainRegisterEmitter) Serialize() ([]byte, error) {
+	module, err := LeftPadBytes(r.Module, 32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to left pad emitter module: %w", err)
+	}
+	payload := &bytes.Buffer{}
+	MustWrite(payload, binary.BigEndian, r.EmitterChain)
+	payload.Write(r.EmitterAddress[:])
+	payload.Write(module.Bytes())
+	return serializeBridgeGovernanceVaa(CoreModuleStr, ActionRegisterEmitter, ChainIDWormchain, payload.Bytes())
+}
+
+func (r *BodyWormchainRegisterEmitter) Deserialize(bz []byte) error {
+	if len(bz) != 66 {
+		return fmt.Errorf("incorrect payload length, should be 66, is %d", len(bz))
+	}
+
+	r.EmitterChain = ChainID(binary.BigEndian.Uint16(bz[0:2]))
+	copy(r.EmitterAddress[:], bz[2:34])
+	r.Module = string(bytes.TrimLeft(bz[34:66], "\x00"))
+	return nil
+}
+
+func (r BodyWormchainQuorumThresholdUpdate) Serialize() ([]byte, error) {
+	payload := &bytes.Buffer{}
+	MustWrite(payload, binary.BigEndian, r.Numerator)
+	MustWrite(payload, binary.BigEndian, r.Denominator)
+	return serializeBridgeGovernanceVaa(CoreModuleStr, ActionQuorumThresholdUpdate, ChainIDWormchain, payload.Bytes())
+}
+
+func (r *BodyWormchainQuorumThresholdUpdate) Deserialize(bz []byte) error {
+	if len(bz) != 8 {
+		return fmt.Errorf("incorrect payload length, should be 8, is %d", len(bz))
+	}
+
+	r.Numerator = binary.BigEndian.Uint32(bz[0:4])
+	r.Denominator = binary.BigEndian.Uint32(bz[4:8])
+	return nil
+}
+
+func (r BodyWormchainGovernanceSubmitterUpdate) Serialize() ([]byte, error) {
+	if len(r.Address) != 20 && len(r.Address) != 32 {
+		return nil, fmt.Errorf("address must be 20 or 32 bytes long, is %d", len(r.Address))
+	}
+	payload := &bytes.Buffer{}
+	MustWrite(payload, binary.BigEndian, r.Allowed)
+	payload.Write(r.Address)
+	return serializeBridgeGovernanceVaa(CoreModuleStr, ActionGovernanceSubmitterUpdate, ChainIDWormchain, payload.Bytes())
+}
+
+func (r *BodyWormchainGovernanceSubmitterUpdate) Deserialize(bz []byte) error {
+	if len(bz) != 1+20 && len(bz) != 1+32 {
+		return fmt.Errorf("incorrect payload length, should be 21 or 33, is %d", len(bz))
+	}
+	if bz[0] > 1 {
+		return fmt.Errorf("invalid allowed flag %d", bz[0])
+	}
+
+	r.Allowed = bz[0] == 1
+	r.Address = append([]byte{}, bz[1:]...)
+	return nil
+}
+
+func (r BodyWormchainVAAArchiveRetentionUpdate) Serialize() ([]byte, error) {
+	payload := &bytes.Buffer{}
+	MustWrite(payload, binary.BigEndian, r.RetentionBlocks)
+	return serializeBridgeGovernanceVaa(CoreModuleStr, ActionVAAArchiveRetentionUpdate, ChainIDWormchain, payload.Bytes())
+}
+
+func (r *BodyWormchainVAAArchiveRetentionUpdate) Deserialize(bz []byte) error {
+	if len(bz) != 8 {
+		return fmt.Errorf("incorrect payload length, should be 8, is %d", len(bz))
+	}
+
+	r.RetentionBlocks = binary.BigEndian.Uint64(bz)
+	return nil
+}
+
+func (r BodyWormchainGuardianSetWeightsUpdate) Serialize() ([]byte, error) {
+	if len(r.Weights) > math.MaxUint8 {
+		return nil, fmt.Errorf("too many weights; expected at most %d", math.MaxUint8)
+	}
+	payload := &bytes.Buffer{}
+	MustWrite(payload, binary.BigEndian, r.GuardianSetIndex)
+	MustWrite(payload, binary.BigEndian, uint8(len(r.Weights)))
+	for _, weight := range r.Weights {
+		MustWrite(payload, binary.BigEndian, weight)
+	}
+	return serializeBridgeGovernanceVaa(CoreModuleStr, ActionGuardianSetWeightsUpdate, ChainIDWormchain, payload.Bytes())
+}
+
+func (r *BodyWormchainGuardianSetWeightsUpdate) Deserialize(bz []byte) error {
+	if len(bz) < 5 {
+		return fmt.Errorf("incorrect payload length, should be at least 5, is %d", len(bz))
+	}
+	numWeights := int(bz[4])
+	if len(bz) != 5+8*numWeights {
+		return fmt.Errorf("incorrect payload length, should be %d, is %d", 5+8*numWeights, len(bz))
+	}
+
+	r.GuardianSetIndex = binary.BigEndian.Uint32(bz[0:4])
+	r.Weights = make([]uint64, numWeights)
+	for i := range r.Weights {
+		r.Weights[i] = binary.BigEndian.Uint64(bz[5+8*i:])
+	}
+	return nil
+}
+
+func (r BodyWormchainChainRateLimitUpdate) Serialize() ([]byte, error) {
+	payload := &bytes.Buffer{}
+	MustWrite(payload, binary.BigEndian, r.EmitterChain)
+	MustWrite(payload, binary.BigEndian, r.Limit)
+	MustWrite(payload, binary.BigEndian, r.WindowBlocks)
+	return serializeBridgeGovernanceVaa(CoreModuleStr, ActionChainRateLimitUpdate, ChainIDWormchain, payload.Bytes())
+}
+
+func (r *BodyWormchainChainRateLimitUpdate) Deserialize(bz []byte) error {
+	if len(bz) != 18 {
+		return fmt.Errorf("incorrect payload length, should be 18, is %d", len(bz))
+	}
+
+	r.EmitterChain = ChainID(binary.BigEndian.Uint16(bz[0:2]))
+	r.Limit = binary.BigEndian.Uint64(bz[2:10])
+	r.WindowBlocks = binary.BigEndian.Uint64(bz[10:18])
+	return nil
+}
+
+// msgShutdownUpdatePayloadVersion is the version of the ActionMsgShutdownUpdate payload encoded by BodyWormchainMsgShutdownUpdate
+const msgShutdownUpdatePayloadVersion uint8 = 1
+
+func (r BodyWormchainMsgShutdownUpdate) Serialize() ([]byte, error) {
+	if len(r.MsgTypeURL) == 0 {
+		return nil, errors.New("message type URL must not be empty")
+	}
+	payload := &bytes.Buffer{}
+	MustWrite(payload, binary.BigEndian, msgShutdownUpdatePayloadVersion)
+	MustWrite(payload, binary.BigEndian, r.Shutdown)
+	payload.WriteString(r.MsgTypeURL)
+	return serializeBridgeGovernanceVaa(CoreModuleStr, ActionMsgShutdownUpdate, ChainIDWormchain, payload.Bytes())
+}
+
+func (r *BodyWormchainMsgShutdownUpdate) Deserialize(bz []byte) error {
+	if len(bz) < 3 {
+		return fmt.Errorf("incorrect payload length, should be at least 3, is %d", len(bz))
+	}
+	if bz[0] != msgShutdownUpdatePayloadVersion {
+		return fmt.Errorf("unsupported payload version %d", bz[0])
+	}
+	if bz[1] > 1 {
+		return fmt.Errorf("invalid shutdown flag %d", bz[1])
+	}
+
+	r.Shutdown = bz[1] == 1
+	r.MsgTypeURL = string(bz[2:])
+	return nil
+}
+
+// forwardFeeUpdatePayloadVersion is the version of the ActionForwardFeeUpdate payload encoded by BodyWormchainForwardFeeUpdate
+const forwardFeeUpdatePayloadVersion uint8 = 1
+
+func (r BodyWormchainForwardFeeUpdate) Serialize() ([]byte, error) {
+	payload := &bytes.Buffer{}
+	MustWrite(payload, binary.BigEndian, forwardFeeUpdatePayloadVersion)
+	MustWrite(payload, binary.BigEndian, r.FeeBps)
+	return serializeBridgeGovernanceVaa(CoreModuleStr, ActionForwardFeeUpdate, ChainIDWormchain, payload.Bytes())
+}
+
+func (r *BodyWormchainForwardFeeUpdate) Deserialize(bz []byte) error {
+	if len(bz) != 3 {
+		return fmt.Errorf("incorrect payload length, should be 3, is %d", len(bz))
+	}
+	if bz[0] != forwardFeeUpdatePayloadVersion {
+		return fmt.Errorf("unsupported payload version %d", bz[0])
+	}
+
+	r.FeeBps = binary.BigEndian.Uint16(bz[1:])
+	return nil
+}
+
+// maintenanceWindowUpdatePayloadVersion is the version of the ActionMaintenanceWindowUpdate payload encoded by
+// BodyWormchainMaintenanceWindowUpdate
+const maintenanceWindowUpdatePayloadVersion uint8 = 1
+
+func (r BodyWormchainMaintenanceWindowUpdate) Serialize() ([]byte, error) {
+	if r.EndHeight < r.StartHeight {
+		return nil, fmt.Errorf("end height %d is before start height %d", r.EndHeight, r.StartHeight)
+	}
+	if len(r.Contracts) > math.MaxUint8 {
+		return nil, fmt.Errorf("too many contracts: %d", len(r.Contracts))
+	}
+	payload := &bytes.Buffer{}
+	MustWrite(payload, binary.BigEndian, maintenanceWindowUpdatePayloadVersion)
+	MustWrite(payload, binary.BigEndian, r.StartHeight)
+	MustWrite(payload, binary.BigEndian, r.EndHeight)
+	MustWrite(payload, binary.BigEndian, uint8(len(r.Contracts)))
+	for _, contract := range r.Contracts {
+		payload.Write(contract[:])
+	}
+	return serializeBridgeGovernanceVaa(CoreModuleStr, ActionMaintenanceWindowUpdate, ChainIDWormchain, payload.Bytes())
+}
+
+func (r *BodyWormchainMaintenanceWindowUpdate) Deserialize(bz []byte) error {
+	if len(bz) < 18 {
+		return fmt.Errorf("incorrect payload length, should be at least 18, is %d", len(bz))
+	}
+	if bz[0] != maintenanceWindowUpdatePayloadVersion {
+		return fmt.Errorf("unsupported payload version %d", bz[0])
+	}
+	numContracts := int(bz[17])
+	if len(bz) != 18+32*numContracts {
+		return fmt.Errorf("incorrect payload length, should be %d, is %d", 18+32*numContracts, len(bz))
+	}
+
+	r.StartHeight = binary.BigEndian.Uint64(bz[1:9])
+	r.EndHeight = binary.BigEndian.Uint64(bz[9:17])
+	r.Contracts = make([]Address, numContracts)
+	for i := range r.Contracts {
+		copy(r.Contracts[i][:], bz[18+32*i:])
+	}
+	return nil
+}
+
+// validatorTransitionWindowUpdatePayloadVersion is the version of the ActionValidatorTransitionWindowUpdate payload
+// encoded by BodyWormchainValidatorTransitionWindowUpdate
+const validatorTransitionWindowUpdatePayloadVersion uint8 = 1
+
+func (r BodyWormchainValidatorTransitionWindowUpdate) Serialize() ([]byte, error) {
+	payload := &bytes.Buffer{}
+	MustWrite(payload, binary.BigEndian, validatorTransitionWindowUpdatePayloadVersion)
+	MustWrite(payload, binary.BigEndian, r.TransitionBlocks)
+	return serializeBridgeGovernanceVaa(CoreModuleStr, ActionValidatorTransitionWindowUpdate, ChainIDWormchain, payload.Bytes())
+}
+
+func (r *BodyWormchainValidatorTransitionWindowUpdate) Deserialize(bz []byte) error {
+	if len(bz) != 9 {
+		return fmt.Errorf("incorrect payload length, should be 9, is %d", len(bz))
+	}
+	if bz[0] != validatorTransitionWindowUpdatePayloadVersion {
+		return fmt.Errorf("unsupported payload version %d", bz[0])
+	}
+
+	r.TransitionBlocks = binary.BigEndian.Uint64(bz[1:])
+	return nil
+}
+
 func (r BodyWormchainWasmAllowlistInstantiate) Serialize(action GovernanceAction) ([]byte, error) {
 	payload := &bytes.Buffer{}
 	payload.Write(r.ContractAddr[:])
@@ -406,6 +1105,160 @@ func (r *BodyGatewayScheduleUpgrade) Deserialize(bz []byte) error {
 	return nil
 }
 
+func (r BodyGatewaySlashingParamsUpdate) Serialize() ([]byte, error) {
+	if r.MinSignedPerWindow == nil || r.SlashFractionDoubleSign == nil || r.SlashFractionDowntime == nil {
+		return nil, errors.New("decimal slashing params must be set")
+	}
+	payload := &bytes.Buffer{}
+	MustWrite(payload, binary.BigEndian, r.SignedBlocksWindow)
+	MustWrite(payload, binary.BigEndian, r.MinSignedPerWindow.Bytes32())
+	MustWrite(payload, binary.BigEndian, r.DowntimeJailDuration)
+	MustWrite(payload, binary.BigEndian, r.SlashFractionDoubleSign.Bytes32())
+	MustWrite(payload, binary.BigEndian, r.SlashFractionDowntime.Bytes32())
+	return serializeBridgeGovernanceVaa(GatewayModuleStr, ActionSlashingParamsUpdate, ChainIDWormchain, payload.Bytes())
+}
+
+func (r *BodyGatewaySlashingParamsUpdate) Deserialize(bz []byte) error {
+	if len(bz) != 112 {
+		return fmt.Errorf("incorrect payload length, should be 112, is %d", len(bz))
+	}
+
+	r.SignedBlocksWindow = binary.BigEndian.Uint64(bz[0:8])
+	r.MinSignedPerWindow = new(uint256.Int).SetBytes32(bz[8:40])
+	r.DowntimeJailDuration = binary.BigEndian.Uint64(bz[40:48])
+	r.SlashFractionDoubleSign = new(uint256.Int).SetBytes32(bz[48:80])
+	r.SlashFractionDowntime = new(uint256.Int).SetBytes32(bz[80:112])
+	return nil
+}
+
+func (r BodyGatewayStakingParamsUpdate) Serialize() ([]byte, error) {
+	if len(r.BondDenom) == 0 {
+		return nil, errors.New("bond denom must not be empty")
+	}
+	payload := &bytes.Buffer{}
+	MustWrite(payload, binary.BigEndian, r.UnbondingTime)
+	MustWrite(payload, binary.BigEndian, r.MaxValidators)
+	MustWrite(payload, binary.BigEndian, r.MaxEntries)
+	payload.WriteString(r.BondDenom)
+	return serializeBridgeGovernanceVaa(GatewayModuleStr, ActionStakingParamsUpdate, ChainIDWormchain, payload.Bytes())
+}
+
+func (r *BodyGatewayStakingParamsUpdate) Deserialize(bz []byte) error {
+	if len(bz) <= 16 {
+		return fmt.Errorf("incorrect payload length, should be more than 16, is %d", len(bz))
+	}
+
+	r.UnbondingTime = binary.BigEndian.Uint64(bz[0:8])
+	r.MaxValidators = binary.BigEndian.Uint32(bz[8:12])
+	r.MaxEntries = binary.BigEndian.Uint32(bz[12:16])
+	r.BondDenom = string(bz[16:])
+	return nil
+}
+
+func (r BodyGatewayIcaHostAllowlistUpdate) Serialize() ([]byte, error) {
+	if len(r.ConnectionID) == 0 || len(r.ConnectionID) > math.MaxUint8 {
+		return nil, fmt.Errorf("connection ID length must be between 1 and %d, is %d", math.MaxUint8, len(r.ConnectionID))
+	}
+	if len(r.MsgTypeURL) == 0 {
+		return nil, errors.New("message type URL must not be empty")
+	}
+	payload := &bytes.Buffer{}
+	MustWrite(payload, binary.BigEndian, r.Allowed)
+	MustWrite(payload, binary.BigEndian, uint8(len(r.ConnectionID)))
+	payload.WriteString(r.ConnectionID)
+	payload.WriteString(r.MsgTypeURL)
+	return serializeBridgeGovernanceVaa(GatewayModuleStr, ActionIcaHostAllowlistUpdate, ChainIDWormchain, payload.Bytes())
+}
+
+func (r *BodyGatewayIcaHostAllowlistUpdate) Deserialize(bz []byte) error {
+	if len(bz) < 2 {
+		return fmt.Errorf("incorrect payload length, should be at least 2, is %d", len(bz))
+	}
+	if bz[0] > 1 {
+		return fmt.Errorf("invalid allowed flag %d", bz[0])
+	}
+	connectionIDLen := int(bz[1])
+	if connectionIDLen == 0 || len(bz) <= 2+connectionIDLen {
+		return fmt.Errorf("incorrect payload length, should be more than %d, is %d", 2+connectionIDLen, len(bz))
+	}
+
+	r.Allowed = bz[0] == 1
+	r.ConnectionID = string(bz[2 : 2+connectionIDLen])
+	r.MsgTypeURL = string(bz[2+connectionIDLen:])
+	return nil
+}
+
+func (r BodyGatewayTokenFactoryAdminUpdate) Serialize() ([]byte, error) {
+	if len(r.Denom) == 0 || len(r.Denom) > math.MaxUint8 {
+		return nil, fmt.Errorf("denom length must be between 1 and %d, is %d", math.MaxUint8, len(r.Denom))
+	}
+	payload := &bytes.Buffer{}
+	MustWrite(payload, binary.BigEndian, uint8(len(r.Denom)))
+	payload.WriteString(r.Denom)
+	payload.WriteString(r.NewAdmin)
+	return serializeBridgeGovernanceVaa(GatewayModuleStr, ActionTokenFactoryAdminUpdate, ChainIDWormchain, payload.Bytes())
+}
+
+func (r *BodyGatewayTokenFactoryAdminUpdate) Deserialize(bz []byte) error {
+	if len(bz) < 2 {
+		return fmt.Errorf("incorrect payload length, should be at least 2, is %d", len(bz))
+	}
+	denomLen := int(bz[0])
+	if denomLen == 0 || len(bz) < 1+denomLen {
+		return fmt.Errorf("incorrect payload length, should be at least %d, is %d", 1+denomLen, len(bz))
+	}
+
+	r.Denom = string(bz[1 : 1+denomLen])
+	r.NewAdmin = string(bz[1+denomLen:])
+	return nil
+}
+
+func (r BodyGatewayTokenFactoryMetadataUpdate) Serialize() ([]byte, error) {
+	if len(r.Metadata) == 0 {
+		return nil, errors.New("metadata must not be empty")
+	}
+	return serializeBridgeGovernanceVaa(GatewayModuleStr, ActionTokenFactoryMetadataUpdate, ChainIDWormchain, r.Metadata)
+}
+
+func (r *BodyGatewayTokenFactoryMetadataUpdate) Deserialize(bz []byte) error {
+	if len(bz) == 0 {
+		return errors.New("incorrect payload length, should be at least 1, is 0")
+	}
+
+	r.Metadata = append([]byte{}, bz...)
+	return nil
+}
+
+func (r BodyGatewayCoreContract) Serialize() ([]byte, error) {
+	return serializeBridgeGovernanceVaa(GatewayModuleStr, ActionSetCoreContract, ChainIDWormchain, r.ContractAddr[:])
+}
+
+func (r *BodyGatewayCoreContract) Deserialize(bz []byte) error {
+	if len(bz) != 32 {
+		return fmt.Errorf("incorrect payload length, should be 32, is %d", len(bz))
+	}
+
+	copy(r.ContractAddr[:], bz)
+	return nil
+}
+
+func (r BodyGatewayApprovedCodeHashUpdate) Serialize() ([]byte, error) {
+	payload := &bytes.Buffer{}
+	MustWrite(payload, binary.BigEndian, r.CodeID)
+	payload.Write(r.CodeHash[:])
+	return serializeBridgeGovernanceVaa(GatewayModuleStr, ActionApprovedCodeHashUpdate, ChainIDWormchain, payload.Bytes())
+}
+
+func (r *BodyGatewayApprovedCodeHashUpdate) Deserialize(bz []byte) error {
+	if len(bz) != 40 {
+		return fmt.Errorf("incorrect payload length, should be 40, is %d", len(bz))
+	}
+
+	r.CodeID = binary.BigEndian.Uint64(bz[:8])
+	copy(r.CodeHash[:], bz[8:])
+	return nil
+}
+
 func (r BodyCircleIntegrationUpdateWormholeFinality) Serialize() ([]byte, error) {
 	return serializeBridgeGovernanceVaa(CircleIntegrationModuleStr, CircleIntegrationActionUpdateWormholeFinality, r.TargetChainID, []byte{r.Finality})
 }
@@ -466,6 +1319,25 @@ func (r BodyGeneralPurposeGovernanceSolana) Serialize() ([]byte, error) {
 	return serializeBridgeGovernanceVaa(GeneralPurposeGovernanceModuleStr, GeneralPurposeGovernanceSolanaAction, r.ChainID, payload.Bytes())
 }
 
+func (r BodyGovernorChainConfigUpdate) Serialize() ([]byte, error) {
+	payload := &bytes.Buffer{}
+	MustWrite(payload, binary.BigEndian, r.EmitterChain)
+	MustWrite(payload, binary.BigEndian, r.DailyLimit)
+	MustWrite(payload, binary.BigEndian, r.BigTransactionSize)
+	return serializeBridgeGovernanceVaa(GovernorModuleStr, GovernorActionChainConfigUpdate, ChainIDUnset, payload.Bytes())
+}
+
+func (r *BodyGovernorChainConfigUpdate) Deserialize(bz []byte) error {
+	if len(bz) != 18 {
+		return fmt.Errorf("incorrect payload length, should be 18, is %d", len(bz))
+	}
+
+	r.EmitterChain = ChainID(binary.BigEndian.Uint16(bz[0:2]))
+	r.DailyLimit = binary.BigEndian.Uint64(bz[2:10])
+	r.BigTransactionSize = binary.BigEndian.Uint64(bz[10:18])
+	return nil
+}
+
 func EmptyPayloadVaa(module string, actionId GovernanceAction, chainId ChainID) ([]byte, error) {
 	return serializeBridgeGovernanceVaa(module, actionId, chainId, []byte{})
 }
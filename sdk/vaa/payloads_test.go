@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"encoding/hex"
 	"errors"
+	"math"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/holiman/uint256"
@@ -73,6 +75,18 @@ func TestBodyGuardianSetUpdateSerialize(t *testing.T) {
 	assert.Equal(t, expected, hex.EncodeToString(serializedBodyGuardianSetUpdate))
 }
 
+func TestBodyScheduledGuardianSetUpdateSerialize(t *testing.T) {
+	keys := []common.Address{
+		common.HexToAddress("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"),
+		common.HexToAddress("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaee"),
+	}
+	body := BodyScheduledGuardianSetUpdate{Keys: keys, NewIndex: uint32(1), ActivationHeight: 1000, ActivationTime: 1700000000}
+	expected := "00000000000000000000000000000000000000000000000000000000436f7265090c2000000001025aaeb6053f3e94c9b9a09f33669435e7ef1beaed5aaeb6053f3e94c9b9a09f33669435e7ef1beaee00000000000003e8000000006553f100"
+	serialized, err := body.Serialize()
+	require.NoError(t, err)
+	assert.Equal(t, expected, hex.EncodeToString(serialized))
+}
+
 func TestBodyTokenBridgeRegisterChainSerialize(t *testing.T) {
 	module := "test"
 	tests := []struct {
@@ -363,6 +377,99 @@ func TestBodyGatewayIbcComposabilityMwContractDeserializeFailureTooLong(t *testi
 	require.ErrorContains(t, err, "incorrect payload length, should be 32, is 33")
 }
 
+func TestBodyGatewaySlashingParamsUpdateSerialize(t *testing.T) {
+	expected := "00000000000000000000000000000000000000476174657761794d6f64756c65040c20000000000000271000000000000000000000000000000000000000000000000001b69b4ba630f34e000000000000025800000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000056bc75e2d63100001"
+	bodyGatewaySlashingParamsUpdate := BodyGatewaySlashingParamsUpdate{
+		SignedBlocksWindow:      10000,
+		MinSignedPerWindow:      uint256.NewInt(123456789012345678),
+		DowntimeJailDuration:    600,
+		SlashFractionDoubleSign: uint256.NewInt(0),
+		// 100.000000000000000001 does not fit into 64 bits
+		SlashFractionDowntime: new(uint256.Int).AddUint64(new(uint256.Int).Exp(uint256.NewInt(10), uint256.NewInt(20)), 1),
+	}
+	buf, err := bodyGatewaySlashingParamsUpdate.Serialize()
+	require.NoError(t, err)
+	assert.Equal(t, expected, hex.EncodeToString(buf))
+
+	bodyGatewaySlashingParamsUpdate.SlashFractionDoubleSign = nil
+	_, err = bodyGatewaySlashingParamsUpdate.Serialize()
+	require.Error(t, err)
+}
+
+func TestBodyGatewaySlashingParamsUpdateDeserialize(t *testing.T) {
+	expected := BodyGatewaySlashingParamsUpdate{
+		SignedBlocksWindow:      10000,
+		MinSignedPerWindow:      uint256.NewInt(123456789012345678),
+		DowntimeJailDuration:    600,
+		SlashFractionDoubleSign: uint256.NewInt(0),
+		SlashFractionDowntime:   new(uint256.Int).AddUint64(new(uint256.Int).Exp(uint256.NewInt(10), uint256.NewInt(20)), 1),
+	}
+	buf, err := hex.DecodeString("000000000000271000000000000000000000000000000000000000000000000001b69b4ba630f34e000000000000025800000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000056bc75e2d63100001")
+	require.NoError(t, err)
+
+	var actual BodyGatewaySlashingParamsUpdate
+	err = actual.Deserialize(buf)
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+
+	err = actual.Deserialize(buf[1:])
+	require.ErrorContains(t, err, "incorrect payload length, should be 112, is 111")
+}
+
+func TestBodyGatewaySlashingParamsUpdateRoundTrip(t *testing.T) {
+	max := new(uint256.Int).SetAllOne()
+	expected := BodyGatewaySlashingParamsUpdate{
+		SignedBlocksWindow:      math.MaxUint64,
+		MinSignedPerWindow:      max,
+		DowntimeJailDuration:    math.MaxUint64,
+		SlashFractionDoubleSign: uint256.NewInt(1),
+		SlashFractionDowntime:   max,
+	}
+	buf, err := expected.Serialize()
+	require.NoError(t, err)
+
+	// Strip the governance header
+	var actual BodyGatewaySlashingParamsUpdate
+	require.NoError(t, actual.Deserialize(buf[35:]))
+	assert.Equal(t, expected, actual)
+}
+
+func TestBodyGatewayStakingParamsUpdateSerialize(t *testing.T) {
+	expected := "00000000000000000000000000000000000000476174657761794d6f64756c65050c200006722feb7b0000000000640000000775776f726d"
+	bodyGatewayStakingParamsUpdate := BodyGatewayStakingParamsUpdate{
+		UnbondingTime: 1814400000000000,
+		MaxValidators: 100,
+		MaxEntries:    7,
+		BondDenom:     "uworm",
+	}
+	buf, err := bodyGatewayStakingParamsUpdate.Serialize()
+	require.NoError(t, err)
+	assert.Equal(t, expected, hex.EncodeToString(buf))
+
+	bodyGatewayStakingParamsUpdate.BondDenom = ""
+	_, err = bodyGatewayStakingParamsUpdate.Serialize()
+	require.Error(t, err)
+}
+
+func TestBodyGatewayStakingParamsUpdateDeserialize(t *testing.T) {
+	expected := BodyGatewayStakingParamsUpdate{
+		UnbondingTime: 1814400000000000,
+		MaxValidators: 100,
+		MaxEntries:    7,
+		BondDenom:     "uworm",
+	}
+	buf, err := hex.DecodeString("0006722feb7b0000000000640000000775776f726d")
+	require.NoError(t, err)
+
+	var actual BodyGatewayStakingParamsUpdate
+	err = actual.Deserialize(buf)
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+
+	err = actual.Deserialize(buf[:16])
+	require.ErrorContains(t, err, "incorrect payload length, should be more than 16, is 16")
+}
+
 func TestBodyCoreRecoverChainIdSerialize(t *testing.T) {
 	expected := "00000000000000000000000000000000000000000000000000000000436f72650500000000000000000000000000000000000000000000000000000000000000010fa0"
 	BodyRecoverChainId := BodyRecoverChainId{
@@ -397,3 +504,147 @@ func TestBodyRecoverChainIdModuleTooLong(t *testing.T) {
 	require.ErrorContains(t, err, "failed to left pad module: payload longer than 32 bytes")
 	assert.Nil(t, buf)
 }
+
+func TestBodyWormchainPruneGuardianSetsSerialize(t *testing.T) {
+	expected := "00000000000000000000000000000000000000000000000000000000436f7265070c2000000003"
+	buf, err := BodyWormchainPruneGuardianSets{KeepFromIndex: 3}.Serialize()
+	require.NoError(t, err)
+	assert.Equal(t, expected, hex.EncodeToString(buf))
+}
+
+func TestBodyGatewayIcaHostAllowlistUpdateSerialize(t *testing.T) {
+	expected := "00000000000000000000000000000000000000476174657761794d6f64756c65060c20010c636f6e6e656374696f6e2d302f612e42"
+	buf, err := BodyGatewayIcaHostAllowlistUpdate{Allowed: true, ConnectionID: "connection-0", MsgTypeURL: "/a.B"}.Serialize()
+	require.NoError(t, err)
+	assert.Equal(t, expected, hex.EncodeToString(buf))
+}
+
+func TestBodyWormchainMsgShutdownUpdateSerialize(t *testing.T) {
+	expected := "00000000000000000000000000000000000000000000000000000000436f7265140c2001012f612e42"
+	buf, err := BodyWormchainMsgShutdownUpdate{Shutdown: true, MsgTypeURL: "/a.B"}.Serialize()
+	require.NoError(t, err)
+	assert.Equal(t, expected, hex.EncodeToString(buf))
+
+	_, err = BodyWormchainMsgShutdownUpdate{Shutdown: true}.Serialize()
+	require.Error(t, err)
+}
+
+// governancePayloadBody is a governance body that can be decoded from its action payload
+type governancePayloadBody interface {
+	Serialize() ([]byte, error)
+	Deserialize(bz []byte) error
+}
+
+func TestGovernanceBodyRoundTrip(t *testing.T) {
+	keys := []common.Address{
+		common.HexToAddress("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"),
+		common.HexToAddress("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaee"),
+	}
+	tests := []struct {
+		name   string
+		action GovernanceAction
+		chain  ChainID
+		body   governancePayloadBody
+		empty  governancePayloadBody
+	}{
+		{"ContractUpgrade", ActionContractUpgrade, ChainIDWormchain, &BodyContractUpgrade{ChainID: ChainIDWormchain, NewContract: addr}, &BodyContractUpgrade{ChainID: ChainIDWormchain}},
+		{"GuardianSetUpdate", ActionGuardianSetUpdate, ChainIDUnset, &BodyGuardianSetUpdate{Keys: keys, NewIndex: 1}, &BodyGuardianSetUpdate{}},
+		{"ScheduledGuardianSetUpdate", ActionScheduledGuardianSetUpdate, ChainIDWormchain, &BodyScheduledGuardianSetUpdate{Keys: keys, NewIndex: 1, ActivationHeight: 1000, ActivationTime: math.MaxUint64}, &BodyScheduledGuardianSetUpdate{}},
+		{"TokenBridgeRegisterChain", ActionRegisterChain, ChainIDUnset, &BodyTokenBridgeRegisterChain{Module: "TokenBridge", ChainID: ChainIDEthereum, EmitterAddress: addr}, &BodyTokenBridgeRegisterChain{Module: "TokenBridge"}},
+		{"WormchainStoreCode", ActionStoreCode, ChainIDWormchain, &BodyWormchainStoreCode{WasmHash: dummyBytes}, &BodyWormchainStoreCode{}},
+		{"WormchainInstantiateContract", ActionInstantiateContract, ChainIDWormchain, &BodyWormchainInstantiateContract{InstantiationParamsHash: dummyBytes}, &BodyWormchainInstantiateContract{}},
+		{"WormchainMigrateContract", ActionMigrateContract, ChainIDWormchain, &BodyWormchainMigrateContract{MigrationParamsHash: dummyBytes}, &BodyWormchainMigrateContract{}},
+		{"UpdateGovernanceEmitter", ActionUpdateGovernanceEmitter, ChainIDWormchain, &BodyWormchainUpdateGovernanceEmitter{GovernanceChain: ChainIDSolana, GovernanceEmitter: addr}, &BodyWormchainUpdateGovernanceEmitter{}},
+		{"PruneGuardianSets", ActionPruneGuardianSets, ChainIDWormchain, &BodyWormchainPruneGuardianSets{KeepFromIndex: 3}, &BodyWormchainPruneGuardianSets{}},
+		{"ConsensusParamsUpdate", ActionConsensusParamsUpdate, ChainIDWormchain, &BodyWormchainConsensusParamsUpdate{BlockMaxBytes: 1000000, BlockMaxGas: -1, EvidenceMaxAgeNumBlocks: 1000, EvidenceMaxAgeDuration: time.Hour, EvidenceMaxBytes: 100000}, &BodyWormchainConsensusParamsUpdate{}},
+		{"FeeParamsUpdate", ActionFeeParamsUpdate, ChainIDWormchain, &BodyWormchainFeeParamsUpdate{MessageFee: 100, GatewayTransferFee: math.MaxUint64}, &BodyWormchainFeeParamsUpdate{}},
+		{"SignatureGasUpdate", ActionSignatureGasUpdate, ChainIDWormchain, &BodyWormchainSignatureGasUpdate{GasPerSignature: 3000}, &BodyWormchainSignatureGasUpdate{}},
+		{"RegisterEmitter", ActionRegisterEmitter, ChainIDWormchain, &BodyWormchainRegisterEmitter{EmitterChain: ChainIDEthereum, EmitterAddress: addr, Module: "TokenBridge"}, &BodyWormchainRegisterEmitter{}},
+		{"QuorumThresholdUpdate", ActionQuorumThresholdUpdate, ChainIDWormchain, &BodyWormchainQuorumThresholdUpdate{Numerator: 2, Denominator: 3}, &BodyWormchainQuorumThresholdUpdate{}},
+		{"GovernanceSubmitterUpdate", ActionGovernanceSubmitterUpdate, ChainIDWormchain, &BodyWormchainGovernanceSubmitterUpdate{Allowed: true, Address: bytes.Repeat([]byte{1}, 20)}, &BodyWormchainGovernanceSubmitterUpdate{}},
+		{"VAAArchiveRetentionUpdate", ActionVAAArchiveRetentionUpdate, ChainIDWormchain, &BodyWormchainVAAArchiveRetentionUpdate{RetentionBlocks: 100}, &BodyWormchainVAAArchiveRetentionUpdate{}},
+		{"GuardianSetWeightsUpdate", ActionGuardianSetWeightsUpdate, ChainIDWormchain, &BodyWormchainGuardianSetWeightsUpdate{GuardianSetIndex: 1, Weights: []uint64{1, 2, 3}}, &BodyWormchainGuardianSetWeightsUpdate{}},
+		{"ChainRateLimitUpdate", ActionChainRateLimitUpdate, ChainIDWormchain, &BodyWormchainChainRateLimitUpdate{EmitterChain: ChainIDEthereum, Limit: 10, WindowBlocks: 100}, &BodyWormchainChainRateLimitUpdate{}},
+		{"MsgShutdownUpdate", ActionMsgShutdownUpdate, ChainIDWormchain, &BodyWormchainMsgShutdownUpdate{Shutdown: true, MsgTypeURL: "/wormchain.wormhole.MsgCreateAllowlistEntryRequest"}, &BodyWormchainMsgShutdownUpdate{}},
+		{"ForwardFeeUpdate", ActionForwardFeeUpdate, ChainIDWormchain, &BodyWormchainForwardFeeUpdate{FeeBps: 25}, &BodyWormchainForwardFeeUpdate{}},
+		{"MaintenanceWindowUpdate", ActionMaintenanceWindowUpdate, ChainIDWormchain, &BodyWormchainMaintenanceWindowUpdate{StartHeight: 100, EndHeight: 200, Contracts: []Address{{1}, {2}}}, &BodyWormchainMaintenanceWindowUpdate{}},
+		{"ValidatorTransitionWindowUpdate", ActionValidatorTransitionWindowUpdate, ChainIDWormchain, &BodyWormchainValidatorTransitionWindowUpdate{TransitionBlocks: 600}, &BodyWormchainValidatorTransitionWindowUpdate{}},
+		{"TokenFactoryAdminUpdate", ActionTokenFactoryAdminUpdate, ChainIDWormchain, &BodyGatewayTokenFactoryAdminUpdate{Denom: "factory/wormhole1creator/subdenom", NewAdmin: "wormhole1admin"}, &BodyGatewayTokenFactoryAdminUpdate{}},
+		{"TokenFactoryMetadataUpdate", ActionTokenFactoryMetadataUpdate, ChainIDWormchain, &BodyGatewayTokenFactoryMetadataUpdate{Metadata: []byte(`{"base":"factory/wormhole1creator/subdenom"}`)}, &BodyGatewayTokenFactoryMetadataUpdate{}},
+		{"SetCoreContract", ActionSetCoreContract, ChainIDWormchain, &BodyGatewayCoreContract{ContractAddr: addr}, &BodyGatewayCoreContract{}},
+		{"ApprovedCodeHashUpdate", ActionApprovedCodeHashUpdate, ChainIDWormchain, &BodyGatewayApprovedCodeHashUpdate{CodeID: 7, CodeHash: addr}, &BodyGatewayApprovedCodeHashUpdate{}},
+		{"GovernorChainConfigUpdate", GovernorActionChainConfigUpdate, ChainIDUnset, &BodyGovernorChainConfigUpdate{EmitterChain: ChainIDEthereum, DailyLimit: 100_000_000, BigTransactionSize: 5_000_000}, &BodyGovernorChainConfigUpdate{}},
+		{"IcaHostAllowlistUpdate", ActionIcaHostAllowlistUpdate, ChainIDWormchain, &BodyGatewayIcaHostAllowlistUpdate{Allowed: true, ConnectionID: "connection-0", MsgTypeURL: "/cosmos.bank.v1beta1.MsgSend"}, &BodyGatewayIcaHostAllowlistUpdate{}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			buf, err := tc.body.Serialize()
+			require.NoError(t, err)
+			require.Greater(t, len(buf), 35)
+			assert.Equal(t, tc.action, GovernanceAction(buf[32]))
+			assert.Equal(t, tc.chain, ChainID(uint16(buf[33])<<8|uint16(buf[34])))
+
+			// The target chain and module are part of the governance header, so they are kept from the empty body
+			require.NoError(t, tc.empty.Deserialize(buf[35:]))
+			assert.Equal(t, tc.body, tc.empty)
+
+			// The message type URL, admin or metadata takes up the rest of the variable length payloads, every other payload has a fixed length
+			switch tc.body.(type) {
+			case *BodyWormchainMsgShutdownUpdate, *BodyGatewayIcaHostAllowlistUpdate, *BodyGatewayTokenFactoryAdminUpdate, *BodyGatewayTokenFactoryMetadataUpdate:
+			default:
+				assert.Error(t, tc.empty.Deserialize(buf[35:len(buf)-1]))
+			}
+		})
+	}
+}
+
+func TestGovernanceBodyDeserializeFailures(t *testing.T) {
+	var fees BodyWormchainFeeParamsUpdate
+	overflow := make([]byte, 64)
+	overflow[23] = 1
+	require.ErrorContains(t, fees.Deserialize(overflow), "fee does not fit into a uint64")
+
+	var submitter BodyWormchainGovernanceSubmitterUpdate
+	require.ErrorContains(t, submitter.Deserialize(append([]byte{2}, make([]byte, 20)...)), "invalid allowed flag 2")
+	_, err := BodyWormchainGovernanceSubmitterUpdate{Address: make([]byte, 21)}.Serialize()
+	require.Error(t, err)
+
+	var shutdown BodyWormchainMsgShutdownUpdate
+	require.ErrorContains(t, shutdown.Deserialize([]byte{2, 1, 'a'}), "unsupported payload version 2")
+	require.ErrorContains(t, shutdown.Deserialize([]byte{1, 2, 'a'}), "invalid shutdown flag 2")
+
+	var icaHostAllowlist BodyGatewayIcaHostAllowlistUpdate
+	require.ErrorContains(t, icaHostAllowlist.Deserialize([]byte{2, 1, 'c', '/'}), "invalid allowed flag 2")
+	require.ErrorContains(t, icaHostAllowlist.Deserialize([]byte{1, 2, 'c', '/'}), "incorrect payload length, should be more than 4, is 4")
+	require.ErrorContains(t, icaHostAllowlist.Deserialize([]byte{1, 0, '/'}), "incorrect payload length")
+	_, err = BodyGatewayIcaHostAllowlistUpdate{ConnectionID: "connection-0"}.Serialize()
+	require.Error(t, err)
+	_, err = BodyGatewayIcaHostAllowlistUpdate{ConnectionID: string(make([]byte, 256)), MsgTypeURL: "/a.B"}.Serialize()
+	require.Error(t, err)
+
+	var tokenFactoryAdmin BodyGatewayTokenFactoryAdminUpdate
+	require.ErrorContains(t, tokenFactoryAdmin.Deserialize([]byte{3, 'd'}), "incorrect payload length, should be at least 4, is 2")
+	require.ErrorContains(t, tokenFactoryAdmin.Deserialize([]byte{0, 'a'}), "incorrect payload length")
+	// The admin may be empty
+	require.NoError(t, tokenFactoryAdmin.Deserialize([]byte{1, 'd'}))
+	require.Equal(t, BodyGatewayTokenFactoryAdminUpdate{Denom: "d"}, tokenFactoryAdmin)
+	_, err = BodyGatewayTokenFactoryAdminUpdate{NewAdmin: "wormhole1admin"}.Serialize()
+	require.Error(t, err)
+
+	var tokenFactoryMetadata BodyGatewayTokenFactoryMetadataUpdate
+	require.Error(t, tokenFactoryMetadata.Deserialize(nil))
+	_, err = BodyGatewayTokenFactoryMetadataUpdate{}.Serialize()
+	require.Error(t, err)
+
+	var weights BodyWormchainGuardianSetWeightsUpdate
+	require.ErrorContains(t, weights.Deserialize([]byte{0, 0, 0, 1, 1}), "incorrect payload length, should be 13, is 5")
+	_, err = BodyWormchainGuardianSetWeightsUpdate{Weights: make([]uint64, 256)}.Serialize()
+	require.Error(t, err)
+
+	var guardianSet BodyGuardianSetUpdate
+	require.ErrorContains(t, guardianSet.Deserialize([]byte{0, 0, 0, 1, 0, 0}), "incorrect payload length, has 1 trailing bytes")
+
+	_, err = BodyWormchainRegisterEmitter{Module: "ModuleNameIsMoreThanThirtyTwoCharacters"}.Serialize()
+	require.ErrorContains(t, err, "failed to left pad emitter module")
+}
@@ -20,3 +20,111 @@ func CalculateQuorum(numGuardians int) int {
 	//
 	return ((numGuardians * 2) / 3) + 1
 }
+
+// QuorumScheme decides how many guardians of a guardian set need to sign a VAA. Guardians can carry different weights,
+// and a VAA reaches quorum once the total weight of its signers reaches the quorum weight.
+type QuorumScheme interface {
+	// Weight returns the weight of the guardian with the given index.
+	Weight(guardianIndex uint8) uint64
+	// Quorum returns the minimum total weight of the signers of a VAA for a guardian set of numGuardians guardians.
+	Quorum(numGuardians int) uint64
+}
+
+// QuorumConfig is the metadata of a guardian set that selects its quorum scheme. The zero value selects the
+// supermajority of the contracts.
+type QuorumConfig struct {
+	// Numerator and Denominator of the share of the total weight that needs to be exceeded. A zero denominator
+	// selects 2/3.
+	Numerator   uint64
+	Denominator uint64
+	// Weights of the guardians, or nil if every guardian has a weight of 1.
+	Weights []uint64
+}
+
+// Scheme returns the quorum scheme selected by the config.
+func (c QuorumConfig) Scheme() QuorumScheme {
+	switch {
+	case c.Weights != nil:
+		return Weighted{Weights: c.Weights, Numerator: c.Numerator, Denominator: c.Denominator}
+	case c.Denominator != 0:
+		return FixedThreshold{Numerator: c.Numerator, Denominator: c.Denominator}
+	default:
+		return Supermajority{}
+	}
+}
+
+// Supermajority is the quorum scheme of the contracts, where more than 2/3 of the guardians need to sign.
+type Supermajority struct{}
+
+func (Supermajority) Weight(uint8) uint64 {
+	return 1
+}
+
+func (Supermajority) Quorum(numGuardians int) uint64 {
+	return uint64(CalculateQuorum(numGuardians))
+}
+
+// FixedThreshold is a quorum scheme where more than Numerator/Denominator of the guardians need to sign.
+type FixedThreshold struct {
+	Numerator   uint64
+	Denominator uint64
+}
+
+func (FixedThreshold) Weight(uint8) uint64 {
+	return 1
+}
+
+func (s FixedThreshold) Quorum(numGuardians int) uint64 {
+	if numGuardians < 0 {
+		panic("Invalid numGuardians is less than zero")
+	}
+	return QuorumWeight(uint64(numGuardians), s.Numerator, s.Denominator)
+}
+
+// Weighted is a quorum scheme where the signers need more than Numerator/Denominator of the total weight of the
+// guardians. Guardians without a weight have a weight of 0.
+type Weighted struct {
+	Weights     []uint64
+	Numerator   uint64
+	Denominator uint64
+}
+
+func (s Weighted) Weight(guardianIndex uint8) uint64 {
+	if int(guardianIndex) >= len(s.Weights) {
+		return 0
+	}
+	return s.Weights[guardianIndex]
+}
+
+// Quorum ignores numGuardians, the weights determine the guardian set.
+func (s Weighted) Quorum(int) uint64 {
+	var total uint64
+	for _, weight := range s.Weights {
+		total += weight
+	}
+	return QuorumWeight(total, s.Numerator, s.Denominator)
+}
+
+// QuorumWeight returns the minimum weight that exceeds numerator/denominator of totalWeight. A zero denominator
+// selects 2/3, where QuorumWeight agrees with CalculateQuorum.
+func QuorumWeight(totalWeight uint64, numerator uint64, denominator uint64) uint64 {
+	if denominator == 0 {
+		numerator, denominator = 2, 3
+	}
+	return totalWeight*numerator/denominator + 1
+}
+
+// HasQuorum returns true if the signers of the signatures reach the quorum of a guardian set of numGuardians
+// guardians. It only counts the signers, the signatures still need to be verified.
+func HasQuorum(scheme QuorumScheme, numGuardians int, signatures []*Signature) bool {
+	var weight uint64
+	signed := make(map[uint8]bool, len(signatures))
+	for _, sig := range signatures {
+		if signed[sig.Index] {
+			continue
+		}
+		signed[sig.Index] = true
+		weight += scheme.Weight(sig.Index)
+	}
+	return weight >= scheme.Quorum(numGuardians)
+}
@@ -91,3 +91,55 @@ func FuzzCalculateQuorum(f *testing.F) {
 		assert.Greater(t, actualFloat, floorFloat, "fuzz violation: quorum has dropped below 2/3rds threshold")
 	})
 }
+
+func TestQuorumConfigScheme(t *testing.T) {
+	assert.Equal(t, Supermajority{}, QuorumConfig{}.Scheme())
+	assert.Equal(t, FixedThreshold{Numerator: 3, Denominator: 4}, QuorumConfig{Numerator: 3, Denominator: 4}.Scheme())
+	assert.Equal(t, Weighted{Weights: []uint64{1, 2}}, QuorumConfig{Weights: []uint64{1, 2}}.Scheme())
+}
+
+func TestQuorumSchemes(t *testing.T) {
+	// Every scheme agrees with the contracts when all weights are 1 and the threshold is 2/3
+	for n := 0; n <= 100; n++ {
+		weights := make([]uint64, n)
+		for i := range weights {
+			weights[i] = 1
+		}
+		for _, scheme := range []QuorumScheme{
+			Supermajority{},
+			FixedThreshold{Numerator: 2, Denominator: 3},
+			FixedThreshold{},
+			Weighted{Weights: weights},
+		} {
+			assert.Equal(t, uint64(CalculateQuorum(n)), scheme.Quorum(n), "%T with %d guardians", scheme, n)
+		}
+	}
+
+	assert.Equal(t, uint64(16), FixedThreshold{Numerator: 3, Denominator: 4}.Quorum(20))
+	assert.Panics(t, func() { FixedThreshold{}.Quorum(-1) })
+
+	weighted := Weighted{Weights: []uint64{70, 10, 10, 10}}
+	assert.Equal(t, uint64(67), weighted.Quorum(4))
+	assert.Equal(t, uint64(70), weighted.Weight(0))
+	assert.Equal(t, uint64(0), weighted.Weight(4))
+}
+
+func TestHasQuorum(t *testing.T) {
+	sigs := func(indexes ...uint8) []*Signature {
+		out := make([]*Signature, len(indexes))
+		for i, index := range indexes {
+			out[i] = &Signature{Index: index}
+		}
+		return out
+	}
+
+	assert.True(t, HasQuorum(Supermajority{}, 19, sigs(0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12)))
+	assert.False(t, HasQuorum(Supermajority{}, 19, sigs(0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11)))
+
+	weighted := Weighted{Weights: []uint64{70, 10, 10, 10}}
+	assert.True(t, HasQuorum(weighted, 4, sigs(0)))
+	assert.False(t, HasQuorum(weighted, 4, sigs(1, 2, 3)))
+
+	// Signing twice does not count twice
+	assert.False(t, HasQuorum(Supermajority{}, 4, sigs(0, 0, 1)))
+}
@@ -0,0 +1,106 @@
+package vaa
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"golang.org/x/crypto/sha3"
+)
+
+// Reader parses a VAA from a stream without buffering its payload. The header, signatures and body fields are
+// parsed by NewReader, and the payload is then read from the Reader itself. The signing digest is computed while
+// the payload is read, so it is only available once the payload has been read to EOF.
+type Reader struct {
+	header  VAA
+	payload io.Reader
+	hasher  hash.Hash
+	eof     bool
+}
+
+var _ io.Reader = (*Reader)(nil)
+
+// NewReader parses everything but the payload of a VAA from r.
+func NewReader(r io.Reader) (*Reader, error) {
+	vr := &Reader{hasher: sha3.NewLegacyKeccak256()}
+	v := &vr.header
+
+	header := make([]byte, 6)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+
+	v.Version = header[0]
+	if v.Version != SupportedVAAVersion {
+		return nil, fmt.Errorf("unsupported VAA version: %d", v.Version)
+	}
+	v.GuardianSetIndex = binary.BigEndian.Uint32(header[1:5])
+
+	lenSignatures := int(header[5])
+	v.Signatures = make([]*Signature, lenSignatures)
+	for i := 0; i < lenSignatures; i++ {
+		sig := make([]byte, 66)
+		if _, err := io.ReadFull(r, sig); err != nil {
+			return nil, fmt.Errorf("failed to read signature [%d]: %w", i, err)
+		}
+
+		v.Signatures[i] = &Signature{Index: sig[0]}
+		copy(v.Signatures[i].Signature[:], sig[1:])
+	}
+
+	// The body is hashed as it is read to compute the signing digest
+	body := io.TeeReader(r, vr.hasher)
+
+	fields := make([]byte, minHeadlessVAALength)
+	if _, err := io.ReadFull(body, fields); err != nil {
+		return nil, fmt.Errorf("failed to read body: %w", err)
+	}
+
+	v.Timestamp = time.Unix(int64(binary.BigEndian.Uint32(fields[0:4])), 0)
+	v.Nonce = binary.BigEndian.Uint32(fields[4:8])
+	v.EmitterChain = ChainID(binary.BigEndian.Uint16(fields[8:10]))
+	copy(v.EmitterAddress[:], fields[10:42])
+	v.Sequence = binary.BigEndian.Uint64(fields[42:50])
+	v.ConsistencyLevel = fields[50]
+
+	vr.payload = body
+	return vr, nil
+}
+
+// Header returns the parsed fields of the VAA. The payload of the returned VAA is nil.
+func (r *Reader) Header() *VAA {
+	v := r.header
+	return &v
+}
+
+// Read reads the payload of the VAA.
+func (r *Reader) Read(p []byte) (int, error) {
+	n, err := r.payload.Read(p)
+	if errors.Is(err, io.EOF) {
+		r.eof = true
+	}
+	return n, err
+}
+
+// SigningDigest returns the hash of the VAA to be signed, like VAA.SigningDigest. It fails if the payload was not
+// read to EOF.
+func (r *Reader) SigningDigest() (common.Hash, error) {
+	if !r.eof {
+		return common.Hash{}, errors.New("payload was not fully read")
+	}
+	return crypto.Keccak256Hash(r.hasher.Sum(nil)), nil
+}
+
+// Verify checks the VAA like VAA.Verify. It fails if the payload was not read to EOF.
+func (r *Reader) Verify(addresses []common.Address) error {
+	digest, err := r.SigningDigest()
+	if err != nil {
+		return err
+	}
+	return verifyQuorum(digest.Bytes(), r.header.Signatures, addresses)
+}
@@ -0,0 +1,95 @@
+package vaa
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"io"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReader(t *testing.T) {
+	v := getVaa()
+	v.Payload = bytes.Repeat([]byte{0xab}, 1<<20)
+
+	addrs := make([]common.Address, 3)
+	for i := range addrs {
+		key, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+		require.NoError(t, err)
+		addrs[i] = crypto.PubkeyToAddress(key.PublicKey)
+		v.AddSignature(key, uint8(i))
+	}
+	bz, err := v.Marshal()
+	require.NoError(t, err)
+
+	r, err := NewReader(bytes.NewReader(bz))
+	require.NoError(t, err)
+
+	header := v
+	header.Payload = nil
+	assert.Equal(t, &header, r.Header())
+
+	// The digest is only available once the payload was read
+	_, err = r.SigningDigest()
+	assert.Error(t, err)
+	assert.Error(t, r.Verify(addrs))
+
+	payload, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, v.Payload, payload)
+
+	digest, err := r.SigningDigest()
+	require.NoError(t, err)
+	assert.Equal(t, v.SigningDigest(), digest)
+	assert.NoError(t, r.Verify(addrs))
+	assert.Error(t, r.Verify(addrs[:2]))
+}
+
+func TestReaderEmptyPayload(t *testing.T) {
+	v := getEmptyPayloadVaa()
+	bz, err := v.Marshal()
+	require.NoError(t, err)
+
+	r, err := NewReader(bytes.NewReader(bz))
+	require.NoError(t, err)
+
+	payload, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Empty(t, payload)
+
+	digest, err := r.SigningDigest()
+	require.NoError(t, err)
+	assert.Equal(t, v.SigningDigest(), digest)
+}
+
+func TestReaderInvalid(t *testing.T) {
+	v := getVaa()
+	key, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	v.AddSignature(key, 0)
+	bz, err := v.Marshal()
+	require.NoError(t, err)
+
+	tests := []struct {
+		label string
+		data  []byte
+	}{
+		{label: "Empty", data: []byte{}},
+		{label: "TruncatedHeader", data: bz[:5]},
+		{label: "TruncatedSignature", data: bz[:6+65]},
+		{label: "TruncatedBody", data: bz[:6+66+minHeadlessVAALength-1]},
+		{label: "WrongVersion", data: append([]byte{BatchVAAVersion}, bz[1:]...)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.label, func(t *testing.T) {
+			_, err := NewReader(bytes.NewReader(tc.data))
+			assert.Error(t, err)
+		})
+	}
+}
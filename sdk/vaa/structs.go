@@ -6,11 +6,15 @@ import (
 	"encoding"
 	"encoding/binary"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"math/big"
+	"runtime"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -82,9 +86,9 @@ type (
 	Attestation interface {
 		encoding.BinaryMarshaler
 		encoding.BinaryUnmarshaler
-		serializeBody()
+		serializeBody() []byte
 		signingBody() []byte
-		SigningMsg() common.Hash
+		SigningDigest() common.Hash
 		VerifySignatures(addrs []common.Address) bool
 		UniqueID() string
 		HexDigest() string
@@ -125,6 +129,28 @@ func (a SignatureData) MarshalJSON() ([]byte, error) {
 	return []byte(fmt.Sprintf(`"%s"`, a)), nil
 }
 
+// UnmarshalJSON accepts the hex string written by MarshalJSON as well as the array of bytes of the standard encoding,
+// which the wasm contracts send.
+func (a *SignatureData) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		return json.Unmarshal(data, (*[65]byte)(a))
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	bz, err := hex.DecodeString(str)
+	if err != nil {
+		return err
+	}
+	if len(bz) != len(a) {
+		return fmt.Errorf("invalid signature length: %d", len(bz))
+	}
+	copy(a[:], bz)
+	return nil
+}
+
 func (a SignatureData) String() string {
 	return hex.EncodeToString(a[:])
 }
@@ -737,15 +763,31 @@ func verifySignature(vaa_digest []byte, signature *Signature, address common.Add
 	return addr == address
 }
 
+// minConcurrentSignatures is the number of signatures from which verifySignatures recovers the signers concurrently.
+// Below that, starting the workers costs more than it saves.
+const minConcurrentSignatures = 4
+
 // Digest should be the output of SigningMsg(data).Bytes()
 // Should not be public as other message types should be verified using a message prefix.
 func verifySignatures(vaa_digest []byte, signatures []*Signature, addresses []common.Address) bool {
+	if !checkSignerIndexes(signatures, addresses) {
+		return false
+	}
+	if len(signatures) < minConcurrentSignatures || runtime.GOMAXPROCS(0) == 1 {
+		return verifySignaturesSerial(vaa_digest, signatures, addresses)
+	}
+	return verifySignaturesConcurrent(vaa_digest, signatures, addresses)
+}
+
+// checkSignerIndexes checks that the signature indexes are strictly increasing and refer to distinct guardians, which
+// is cheap compared to recovering the signers.
+func checkSignerIndexes(signatures []*Signature, addresses []common.Address) bool {
 	if len(addresses) < len(signatures) {
 		return false
 	}
 
 	last_index := -1
-	signing_addresses := []common.Address{}
+	signing_addresses := make(map[common.Address]struct{}, len(signatures))
 
 	for _, sig := range signatures {
 		if int(sig.Index) >= len(addresses) {
@@ -758,25 +800,61 @@ func verifySignatures(vaa_digest []byte, signatures []*Signature, addresses []co
 		}
 		last_index = int(sig.Index)
 
-		// verify this signature
+		// Ensure we never see the same signer twice
 		addr := addresses[sig.Index]
-		ok := verifySignature(vaa_digest, sig, addr)
-		if !ok {
+		if _, ok := signing_addresses[addr]; ok {
 			return false
 		}
+		signing_addresses[addr] = struct{}{}
+	}
 
-		// Ensure we never see the same signer twice
-		for _, signing_address := range signing_addresses {
-			if signing_address == addr {
-				return false
-			}
+	return true
+}
+
+// verifySignaturesSerial verifies the signatures one after another. The signer indexes must have been checked.
+func verifySignaturesSerial(vaa_digest []byte, signatures []*Signature, addresses []common.Address) bool {
+	for _, sig := range signatures {
+		if !verifySignature(vaa_digest, sig, addresses[sig.Index]) {
+			return false
 		}
-		signing_addresses = append(signing_addresses, addr)
 	}
-
 	return true
 }
 
+// verifySignaturesConcurrent verifies the signatures with at most GOMAXPROCS workers. Since every signature has to be
+// valid, the workers stop as soon as one signature fails. The signer indexes must have been checked.
+func verifySignaturesConcurrent(vaa_digest []byte, signatures []*Signature, addresses []common.Address) bool {
+	workers := runtime.GOMAXPROCS(0)
+	if workers > len(signatures) {
+		workers = len(signatures)
+	}
+
+	var (
+		next   atomic.Int64
+		failed atomic.Bool
+		wg     sync.WaitGroup
+	)
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for !failed.Load() {
+				i := int(next.Add(1) - 1)
+				if i >= len(signatures) {
+					return
+				}
+				sig := signatures[i]
+				if !verifySignature(vaa_digest, sig, addresses[sig.Index]) {
+					failed.Store(true)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	return !failed.Load()
+}
+
 // Operating on bytes directly is error prone.  We should use `vaa.VerifyingSignatures()` whenever possible.
 // This function will be removed in a subsequent release.
 func DeprecatedVerifySignatures(vaaBody []byte, signatures []*Signature, addresses []common.Address) bool {
@@ -795,6 +873,12 @@ func VerifyMessageSignature(prefix []byte, messageBody []byte, signatures *Signa
 	return verifySignature(msgDigest[:], signatures, addresses)
 }
 
+// VerifyDigestSignature verifies a single signature of a signing digest given the address of its signer. It lets callers
+// that verify the signatures of a VAA one at a time hash the VAA body only once. It does not check the signer index.
+func VerifyDigestSignature(digest common.Hash, signature *Signature, address common.Address) bool {
+	return verifySignature(digest.Bytes(), signature, address)
+}
+
 // VerifySignatures verifies the signature of the VAA given the signer addresses.
 // Returns true if the signatures were verified successfully.
 func (v *VAA) VerifySignatures(addresses []common.Address) bool {
@@ -809,23 +893,28 @@ func (v *VAA) VerifySignatures(addresses []common.Address) bool {
 // - Quorum is calculated on the guardian set passed in and checks if the VAA has enough signatures.
 // - The signatures in the VAA is verified against the guardian set keys.
 func (v *VAA) Verify(addresses []common.Address) error {
+	return verifyQuorum(v.SigningDigest().Bytes(), v.Signatures, addresses)
+}
+
+// verifyQuorum implements the checks of Verify for any VAA type given its signing digest.
+func verifyQuorum(vaa_digest []byte, signatures []*Signature, addresses []common.Address) error {
 	if addresses == nil {
 		return errors.New("no addresses were provided")
 	}
 
 	// Check if VAA doesn't have any signatures
-	if len(v.Signatures) == 0 {
+	if len(signatures) == 0 {
 		return errors.New("VAA was not signed")
 	}
 
 	// Verify VAA has enough signatures for quorum
 	quorum := CalculateQuorum(len(addresses))
-	if len(v.Signatures) < quorum {
+	if len(signatures) < quorum {
 		return errors.New("VAA did not have a quorum")
 	}
 
 	// Verify VAA signatures to prevent a DoS attack on our local store.
-	if !v.VerifySignatures(addresses) {
+	if !verifySignatures(vaa_digest, signatures, addresses) {
 		return errors.New("VAA had bad signatures")
 	}
 
@@ -868,6 +957,75 @@ func (v *VAA) UnmarshalBinary(data []byte) error {
 	return nil
 }
 
+// vaaJSON is the JSON representation of a VAA, matching the parsed VAAs of the explorer. The sequence is a string
+// because it does not fit into a JavaScript number.
+type vaaJSON struct {
+	Version          uint8           `json:"version"`
+	GuardianSetIndex uint32          `json:"guardianSetIndex"`
+	Signatures       []signatureJSON `json:"signatures"`
+	Timestamp        string          `json:"timestamp"`
+	Nonce            uint32          `json:"nonce"`
+	Sequence         uint64          `json:"sequence,string"`
+	ConsistencyLevel uint8           `json:"consistencyLevel"`
+	EmitterChain     ChainID         `json:"emitterChain"`
+	EmitterAddress   Address         `json:"emitterAddress"`
+	Payload          []byte          `json:"payload"`
+}
+
+type signatureJSON struct {
+	Index     uint8         `json:"index"`
+	Signature SignatureData `json:"signature"`
+}
+
+// MarshalJSON encodes the VAA with hex signatures and emitter address, a base64 payload and an RFC3339 timestamp.
+func (v VAA) MarshalJSON() ([]byte, error) {
+	out := vaaJSON{
+		Version:          v.Version,
+		GuardianSetIndex: v.GuardianSetIndex,
+		Signatures:       make([]signatureJSON, len(v.Signatures)),
+		Timestamp:        v.Timestamp.UTC().Format(time.RFC3339),
+		Nonce:            v.Nonce,
+		Sequence:         v.Sequence,
+		ConsistencyLevel: v.ConsistencyLevel,
+		EmitterChain:     v.EmitterChain,
+		EmitterAddress:   v.EmitterAddress,
+		Payload:          v.Payload,
+	}
+	for i, sig := range v.Signatures {
+		out.Signatures[i] = signatureJSON{Index: sig.Index, Signature: sig.Signature}
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes a VAA encoded by MarshalJSON.
+func (v *VAA) UnmarshalJSON(data []byte) error {
+	var in vaaJSON
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	timestamp, err := time.Parse(time.RFC3339, in.Timestamp)
+	if err != nil {
+		return fmt.Errorf("invalid timestamp: %w", err)
+	}
+
+	*v = VAA{
+		Version:          in.Version,
+		GuardianSetIndex: in.GuardianSetIndex,
+		Timestamp:        timestamp,
+		Nonce:            in.Nonce,
+		Sequence:         in.Sequence,
+		ConsistencyLevel: in.ConsistencyLevel,
+		EmitterChain:     in.EmitterChain,
+		EmitterAddress:   in.EmitterAddress,
+		Signatures:       make([]*Signature, len(in.Signatures)),
+		Payload:          in.Payload,
+	}
+	for i, sig := range in.Signatures {
+		v.Signatures[i] = &Signature{Index: sig.Index, Signature: sig.Signature}
+	}
+	return nil
+}
+
 // MessageID returns a human-readable emitter_chain/emitter_address/sequence tuple.
 func (v *VAA) MessageID() string {
 	return fmt.Sprintf("%d/%s/%d", v.EmitterChain, v.EmitterAddress, v.Sequence)
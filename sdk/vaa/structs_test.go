@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"math/big"
 	"reflect"
 	"testing"
@@ -447,6 +448,60 @@ func TestUnmarshal(t *testing.T) {
 	assert.Equal(t, &vaa1, vaa2)
 }
 
+func TestVAA_JSON(t *testing.T) {
+	v := getVaa()
+	v.Sequence = math.MaxUint64
+	key, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	v.AddSignature(key, 0)
+
+	bz, err := json.Marshal(v)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"version": 1,
+		"guardianSetIndex": 1,
+		"signatures": [{"index": 0, "signature": "`+v.Signatures[0].Signature.String()+`"}],
+		"timestamp": "1970-01-01T00:00:00Z",
+		"nonce": 1,
+		"sequence": "18446744073709551615",
+		"consistencyLevel": 32,
+		"emitterChain": 1,
+		"emitterAddress": "0000000000000000000000000000000000000000000000000000000000000004",
+		"payload": "YWFhYWFh"
+	}`, string(bz))
+
+	// Pointers encode the same way
+	ptrBz, err := json.Marshal(&v)
+	require.NoError(t, err)
+	assert.Equal(t, bz, ptrBz)
+
+	var decoded VAA
+	require.NoError(t, json.Unmarshal(bz, &decoded))
+	assert.True(t, v.Timestamp.Equal(decoded.Timestamp))
+	expected, err := v.Marshal()
+	require.NoError(t, err)
+	actual, err := decoded.Marshal()
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+
+	// Signatures can also be written as arrays of bytes
+	var sig SignatureData
+	arrayBz, err := json.Marshal([65]byte(v.Signatures[0].Signature))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(arrayBz, &sig))
+	assert.Equal(t, v.Signatures[0].Signature, sig)
+
+	invalid := []string{
+		`{"timestamp": "yesterday"}`,
+		`{"timestamp": "1970-01-01T00:00:00Z", "sequence": 1}`,
+		`{"timestamp": "1970-01-01T00:00:00Z", "signatures": [{"index": 0, "signature": "abcd"}]}`,
+		`{"timestamp": "1970-01-01T00:00:00Z", "emitterAddress": "not hex"}`,
+	}
+	for _, data := range invalid {
+		assert.Error(t, json.Unmarshal([]byte(data), &decoded), data)
+	}
+}
+
 func TestUnmarshalNoPayload(t *testing.T) {
 	vaaBytes := []byte{0x1, 0x0, 0x0, 0x0, 0x1, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1, 0x0, 0x1, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x4, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1, 0x20}
 	vaa1 := getEmptyPayloadVaa()
@@ -615,6 +670,21 @@ func TestVerifySignatures(t *testing.T) {
 	}
 }
 
+func TestVerifyDigestSignature(t *testing.T) {
+	privKey1, _ := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	privKey2, _ := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	addr1 := crypto.PubkeyToAddress(privKey1.PublicKey)
+
+	vaa := getVaa()
+	vaa.AddSignature(privKey1, 0)
+	vaa.AddSignature(privKey2, 1)
+	digest := vaa.SigningDigest()
+
+	assert.True(t, VerifyDigestSignature(digest, vaa.Signatures[0], addr1))
+	assert.False(t, VerifyDigestSignature(digest, vaa.Signatures[1], addr1))
+	assert.False(t, VerifyDigestSignature(common.Hash{}, vaa.Signatures[0], addr1))
+}
+
 func TestVerifySignaturesFuzz(t *testing.T) {
 	// Generate some random trusted private keys to sign with
 	privKey1, _ := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
@@ -775,6 +845,59 @@ func TestVerifySignaturesFuzz(t *testing.T) {
 	}
 }
 
+// signedByGuardians returns a VAA signed by n random guardians and the addresses of the guardians.
+func signedByGuardians(t testing.TB, n int) (VAA, []common.Address) {
+	v := getVaa()
+	addrs := make([]common.Address, n)
+	for i := range addrs {
+		key, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+		require.NoError(t, err)
+		addrs[i] = crypto.PubkeyToAddress(key.PublicKey)
+		v.AddSignature(key, uint8(i))
+	}
+	return v, addrs
+}
+
+func TestVerifySignaturesConcurrent(t *testing.T) {
+	v, addrs := signedByGuardians(t, 19)
+	assert.True(t, v.VerifySignatures(addrs))
+	assert.True(t, verifySignaturesConcurrent(v.SigningDigest().Bytes(), v.Signatures, addrs))
+
+	// Any bad signature fails the VAA, wherever it is
+	for _, i := range []int{0, 9, 18} {
+		bad := v
+		bad.Signatures = append([]*Signature{}, v.Signatures...)
+		bad.Signatures[i] = &Signature{Index: v.Signatures[i].Index, Signature: v.Signatures[(i+1)%19].Signature}
+		assert.False(t, bad.VerifySignatures(addrs), "signature %d", i)
+		assert.False(t, verifySignaturesConcurrent(bad.SigningDigest().Bytes(), bad.Signatures, addrs), "signature %d", i)
+	}
+
+	// A guardian listed twice must not sign twice
+	dup := append([]common.Address{}, addrs...)
+	dup[5] = dup[4]
+	assert.False(t, v.VerifySignatures(dup))
+}
+
+func BenchmarkVerifySignatures(b *testing.B) {
+	v, addrs := signedByGuardians(b, 19)
+	digest := v.SigningDigest().Bytes()
+
+	b.Run("Serial", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if !verifySignaturesSerial(digest, v.Signatures, addrs) {
+				b.Fatal("verification failed")
+			}
+		}
+	})
+	b.Run("Concurrent", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if !verifySignaturesConcurrent(digest, v.Signatures, addrs) {
+				b.Fatal("verification failed")
+			}
+		}
+	})
+}
+
 func TestStringToAddress(t *testing.T) {
 
 	type Test struct {
@@ -0,0 +1,242 @@
+package vaa
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// Token bridge payload IDs
+const (
+	TokenBridgePayloadTransfer            uint8 = 1
+	TokenBridgePayloadAssetMeta           uint8 = 2
+	TokenBridgePayloadTransferWithPayload uint8 = 3
+)
+
+const (
+	transferPayloadLength        = 133
+	assetMetaPayloadLength       = 100
+	minTransferWithPayloadLength = 133
+)
+
+type (
+	// TransferPayload is a token bridge transfer (payload 1)
+	TransferPayload struct {
+		// Amount being transferred, truncated to 8 decimals
+		Amount *big.Int
+		// OriginAddress of the token on its origin chain
+		OriginAddress Address
+		// OriginChain of the token
+		OriginChain ChainID
+		// TargetAddress of the recipient
+		TargetAddress Address
+		// TargetChain of the recipient
+		TargetChain ChainID
+		// Fee paid to the relayer of the transfer, truncated to 8 decimals
+		Fee *big.Int
+	}
+
+	// AssetMetaPayload attests the metadata of a token (payload 2)
+	AssetMetaPayload struct {
+		// TokenAddress of the token on its origin chain
+		TokenAddress Address
+		// TokenChain is the origin chain of the token
+		TokenChain ChainID
+		// Decimals of the token
+		Decimals uint8
+		// Symbol of the token, at most 32 bytes
+		Symbol string
+		// Name of the token, at most 32 bytes
+		Name string
+	}
+
+	// TransferWithPayload is a token bridge transfer with an arbitrary payload for the recipient (payload 3)
+	TransferWithPayload struct {
+		// Amount being transferred, truncated to 8 decimals
+		Amount *big.Int
+		// OriginAddress of the token on its origin chain
+		OriginAddress Address
+		// OriginChain of the token
+		OriginChain ChainID
+		// TargetAddress of the recipient
+		TargetAddress Address
+		// TargetChain of the recipient
+		TargetChain ChainID
+		// FromAddress of the sender of the transfer
+		FromAddress Address
+		// Payload for the recipient
+		Payload []byte
+	}
+)
+
+// Validate checks that the transfer can be encoded
+func (p *TransferPayload) Validate() error {
+	if err := validateUint256("amount", p.Amount); err != nil {
+		return err
+	}
+	if err := validateUint256("fee", p.Fee); err != nil {
+		return err
+	}
+	if p.OriginChain == ChainIDUnset || p.TargetChain == ChainIDUnset {
+		return errors.New("chain is unset")
+	}
+	return nil
+}
+
+// Marshal returns the binary representation of the transfer
+func (p *TransferPayload) Marshal() ([]byte, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	MustWrite(buf, binary.BigEndian, TokenBridgePayloadTransfer)
+	buf.Write(uint256Bytes(p.Amount))
+	buf.Write(p.OriginAddress[:])
+	MustWrite(buf, binary.BigEndian, p.OriginChain)
+	buf.Write(p.TargetAddress[:])
+	MustWrite(buf, binary.BigEndian, p.TargetChain)
+	buf.Write(uint256Bytes(p.Fee))
+
+	return buf.Bytes(), nil
+}
+
+// Unmarshal deserializes the binary representation of a transfer
+func (p *TransferPayload) Unmarshal(data []byte) error {
+	if len(data) != transferPayloadLength {
+		return fmt.Errorf("invalid transfer payload length: %d", len(data))
+	}
+	if data[0] != TokenBridgePayloadTransfer {
+		return fmt.Errorf("invalid payload id: %d", data[0])
+	}
+
+	p.Amount = new(big.Int).SetBytes(data[1:33])
+	copy(p.OriginAddress[:], data[33:65])
+	p.OriginChain = ChainID(binary.BigEndian.Uint16(data[65:67]))
+	copy(p.TargetAddress[:], data[67:99])
+	p.TargetChain = ChainID(binary.BigEndian.Uint16(data[99:101]))
+	p.Fee = new(big.Int).SetBytes(data[101:133])
+
+	return p.Validate()
+}
+
+// Validate checks that the asset meta can be encoded
+func (p *AssetMetaPayload) Validate() error {
+	if p.TokenChain == ChainIDUnset {
+		return errors.New("chain is unset")
+	}
+	if len(p.Symbol) > 32 {
+		return fmt.Errorf("symbol is longer than 32 bytes")
+	}
+	if len(p.Name) > 32 {
+		return fmt.Errorf("name is longer than 32 bytes")
+	}
+	return nil
+}
+
+// Marshal returns the binary representation of the asset meta. The symbol and name are right padded with zeroes.
+func (p *AssetMetaPayload) Marshal() ([]byte, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	MustWrite(buf, binary.BigEndian, TokenBridgePayloadAssetMeta)
+	buf.Write(p.TokenAddress[:])
+	MustWrite(buf, binary.BigEndian, p.TokenChain)
+	MustWrite(buf, binary.BigEndian, p.Decimals)
+
+	var symbol, name [32]byte
+	copy(symbol[:], p.Symbol)
+	copy(name[:], p.Name)
+	buf.Write(symbol[:])
+	buf.Write(name[:])
+
+	return buf.Bytes(), nil
+}
+
+// Unmarshal deserializes the binary representation of an asset meta. Trailing zeroes of the symbol and name are
+// removed.
+func (p *AssetMetaPayload) Unmarshal(data []byte) error {
+	if len(data) != assetMetaPayloadLength {
+		return fmt.Errorf("invalid asset meta payload length: %d", len(data))
+	}
+	if data[0] != TokenBridgePayloadAssetMeta {
+		return fmt.Errorf("invalid payload id: %d", data[0])
+	}
+
+	copy(p.TokenAddress[:], data[1:33])
+	p.TokenChain = ChainID(binary.BigEndian.Uint16(data[33:35]))
+	p.Decimals = data[35]
+	p.Symbol = string(bytes.TrimRight(data[36:68], "\x00"))
+	p.Name = string(bytes.TrimRight(data[68:100], "\x00"))
+
+	return p.Validate()
+}
+
+// Validate checks that the transfer can be encoded
+func (p *TransferWithPayload) Validate() error {
+	if err := validateUint256("amount", p.Amount); err != nil {
+		return err
+	}
+	if p.OriginChain == ChainIDUnset || p.TargetChain == ChainIDUnset {
+		return errors.New("chain is unset")
+	}
+	return nil
+}
+
+// Marshal returns the binary representation of the transfer
+func (p *TransferWithPayload) Marshal() ([]byte, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	MustWrite(buf, binary.BigEndian, TokenBridgePayloadTransferWithPayload)
+	buf.Write(uint256Bytes(p.Amount))
+	buf.Write(p.OriginAddress[:])
+	MustWrite(buf, binary.BigEndian, p.OriginChain)
+	buf.Write(p.TargetAddress[:])
+	MustWrite(buf, binary.BigEndian, p.TargetChain)
+	buf.Write(p.FromAddress[:])
+	buf.Write(p.Payload)
+
+	return buf.Bytes(), nil
+}
+
+// Unmarshal deserializes the binary representation of a transfer with payload
+func (p *TransferWithPayload) Unmarshal(data []byte) error {
+	if len(data) < minTransferWithPayloadLength {
+		return fmt.Errorf("invalid transfer with payload length: %d", len(data))
+	}
+	if data[0] != TokenBridgePayloadTransferWithPayload {
+		return fmt.Errorf("invalid payload id: %d", data[0])
+	}
+
+	p.Amount = new(big.Int).SetBytes(data[1:33])
+	copy(p.OriginAddress[:], data[33:65])
+	p.OriginChain = ChainID(binary.BigEndian.Uint16(data[65:67]))
+	copy(p.TargetAddress[:], data[67:99])
+	p.TargetChain = ChainID(binary.BigEndian.Uint16(data[99:101]))
+	copy(p.FromAddress[:], data[101:133])
+	p.Payload = append([]byte{}, data[133:]...)
+
+	return p.Validate()
+}
+
+func validateUint256(name string, v *big.Int) error {
+	if v == nil {
+		return fmt.Errorf("%s is unset", name)
+	}
+	if v.Sign() < 0 || v.BitLen() > 256 {
+		return fmt.Errorf("%s is not a uint256", name)
+	}
+	return nil
+}
+
+// uint256Bytes returns the big endian 32 byte representation of v, which must be a uint256
+func uint256Bytes(v *big.Int) []byte {
+	return v.FillBytes(make([]byte, 32))
+}
@@ -0,0 +1,171 @@
+package vaa
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Token bridge payloads of VAAs used across the repo's tests and tools
+var (
+	knownTransferPayloads = []string{
+		// 100 WAVAX from Avalanche to Terra
+		"010000000000000000000000000000000000000000000000000000000000000064000000000000000000000000b31f66aa3c1e785363f0875a1b74e27b85fd66c700060000000000000000000000003d5a258ef48d3b468f0f13973f91b2a9a5cc53d800030000000000000000000000000000000000000000000000000000000000000000",
+		// Native LUNA returning to Terra
+		"010000000000000000000000000000000000000000000000000000000000002710010000000000000000000000000000000000000000000000000000756c756e6100030000000000000000000000003d5a258ef48d3b468f0f13973f91b2a9a5cc53d800030000000000000000000000000000000000000000000000000000000000000000",
+		// NEAR to Aptos
+		"0100000000000000000000000000000000000000000000000000000000000f42400000000000000000000000000000000000000000000000000000000000000000000f0108bc32f7de18a5f6e1e7d6ee7aff9f5fc858d0d87ac0da94dd8d2a5d267d6b00160000000000000000000000000000000000000000000000000000000000000000",
+		"0100000000000000000000000000000000000000000000000000000002540be400000000000000000000000000ddb64fe46a91d46ee29420539fc25fd07c5fea3e000200000000000000000000000090f8bf6a479f320ead074411a4b0e7944ea8c9c100040000000000000000000000000000000000000000000000000000000000000000",
+	}
+	knownAssetMetaPayloads = []string{
+		// WAVAX
+		"02000000000000000000000000b31f66aa3c1e785363f0875a1b74e27b85fd66c700061257415641580000000000000000000000000000000000000000000000000000005772617070656420415641580000000000000000000000000000000000000000",
+		// WFTM
+		"0200000000000000000000000021be370d5312f44cb42ce377bc9b8a0cef1a4c83000a125746544d00000000000000000000000000000000000000000000000000000000577261707065642046616e746f6d000000000000000000000000000000000000",
+	}
+	knownTransferWithPayloadPayloads = []string{
+		// Avalanche to the gateway with a cosmos recipient
+		"0300000000000000000000000000000000000000000000000000000000000000640000000000000000000000005425890298aed601595a70ab815c96711a31bc650006ade4a5f5803a439835c636395a8d648dee57b2fc90d98dc17fa887159b69638b0c20000000000000000000000000e6990c7e206d418d62b9e50c8e61f59dc360183b7b2262617369635f726563697069656e74223a7b22726563697069656e74223a22633256704d57786c656d3179636d31336348687865575679626e6c344d33706a595768735a4756715958686e4f485a364f484e774d32526f227d7d",
+	}
+)
+
+func decodeHex(t testing.TB, s string) []byte {
+	bz, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return bz
+}
+
+func TestTransferPayload(t *testing.T) {
+	var p TransferPayload
+	require.NoError(t, p.Unmarshal(decodeHex(t, knownTransferPayloads[0])))
+
+	token, _ := StringToAddress("b31f66aa3c1e785363f0875a1b74e27b85fd66c7")
+	recipient, _ := StringToAddress("3d5a258ef48d3b468f0f13973f91b2a9a5cc53d8")
+	assert.Equal(t, TransferPayload{
+		Amount:        big.NewInt(100),
+		OriginAddress: token,
+		OriginChain:   ChainIDAvalanche,
+		TargetAddress: recipient,
+		TargetChain:   ChainIDTerra,
+		Fee:           p.Fee,
+	}, p)
+	assert.Zero(t, p.Fee.Sign())
+
+	bz, err := p.Marshal()
+	require.NoError(t, err)
+	assert.Equal(t, knownTransferPayloads[0], hex.EncodeToString(bz))
+
+	// The header decoder agrees with the payload decoder
+	hdr, err := DecodeTransferPayloadHdr(bz)
+	require.NoError(t, err)
+	assert.Equal(t, p.Amount, hdr.Amount)
+	assert.Equal(t, p.TargetChain, hdr.TargetChain)
+
+	p.Amount = nil
+	_, err = p.Marshal()
+	assert.Error(t, err)
+	p.Amount = new(big.Int).Lsh(big.NewInt(1), 256)
+	_, err = p.Marshal()
+	assert.Error(t, err)
+	p.Amount = big.NewInt(-1)
+	_, err = p.Marshal()
+	assert.Error(t, err)
+
+	assert.Error(t, p.Unmarshal(bz[:len(bz)-1]))
+	assert.Error(t, p.Unmarshal(append(bz, 0)))
+	assert.Error(t, p.Unmarshal(decodeHex(t, knownAssetMetaPayloads[0])))
+}
+
+func TestAssetMetaPayload(t *testing.T) {
+	var p AssetMetaPayload
+	require.NoError(t, p.Unmarshal(decodeHex(t, knownAssetMetaPayloads[0])))
+
+	token, _ := StringToAddress("b31f66aa3c1e785363f0875a1b74e27b85fd66c7")
+	assert.Equal(t, AssetMetaPayload{
+		TokenAddress: token,
+		TokenChain:   ChainIDAvalanche,
+		Decimals:     18,
+		Symbol:       "WAVAX",
+		Name:         "Wrapped AVAX",
+	}, p)
+
+	bz, err := p.Marshal()
+	require.NoError(t, err)
+	assert.Equal(t, knownAssetMetaPayloads[0], hex.EncodeToString(bz))
+
+	p.Name = "A name that is longer than 32 bytes"
+	_, err = p.Marshal()
+	assert.Error(t, err)
+
+	assert.Error(t, p.Unmarshal(bz[:len(bz)-1]))
+	assert.Error(t, p.Unmarshal(decodeHex(t, knownTransferPayloads[0])))
+}
+
+func TestTransferWithPayload(t *testing.T) {
+	var p TransferWithPayload
+	require.NoError(t, p.Unmarshal(decodeHex(t, knownTransferWithPayloadPayloads[0])))
+
+	assert.Equal(t, big.NewInt(100), p.Amount)
+	assert.Equal(t, ChainIDAvalanche, p.OriginChain)
+	assert.Equal(t, ChainIDWormchain, p.TargetChain)
+	assert.Equal(t, `{"basic_recipient":{"recipient":"c2VpMWxlem1ycm13cHhxeWVybnl4M3pjYWhsZGVqYXhnOHZ6OHNwM2Ro"}}`, string(p.Payload))
+
+	bz, err := p.Marshal()
+	require.NoError(t, err)
+	assert.Equal(t, knownTransferWithPayloadPayloads[0], hex.EncodeToString(bz))
+
+	// The payload for the recipient may be empty
+	require.NoError(t, p.Unmarshal(bz[:minTransferWithPayloadLength]))
+	assert.Empty(t, p.Payload)
+	assert.Error(t, p.Unmarshal(bz[:minTransferWithPayloadLength-1]))
+}
+
+// The fuzz tests check that decoding never panics and that every decoded payload encodes back to the same bytes.
+
+func FuzzTransferPayload(f *testing.F) {
+	for _, s := range knownTransferPayloads {
+		f.Add(decodeHex(f, s))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var p TransferPayload
+		if err := p.Unmarshal(data); err != nil {
+			return
+		}
+		bz, err := p.Marshal()
+		require.NoError(t, err)
+		assert.Equal(t, data, bz)
+	})
+}
+
+func FuzzAssetMetaPayload(f *testing.F) {
+	for _, s := range knownAssetMetaPayloads {
+		f.Add(decodeHex(f, s))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var p AssetMetaPayload
+		if err := p.Unmarshal(data); err != nil {
+			return
+		}
+		bz, err := p.Marshal()
+		require.NoError(t, err)
+		assert.Equal(t, data, bz)
+	})
+}
+
+func FuzzTransferWithPayload(f *testing.F) {
+	for _, s := range knownTransferWithPayloadPayloads {
+		f.Add(decodeHex(f, s))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var p TransferWithPayload
+		if err := p.Unmarshal(data); err != nil {
+			return
+		}
+		bz, err := p.Marshal()
+		require.NoError(t, err)
+		assert.Equal(t, data, bz)
+	})
+}
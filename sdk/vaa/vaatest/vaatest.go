@@ -0,0 +1,112 @@
+// Package vaatest deterministically generates guardian keys and signed VAAs for tests. The same seed always yields
+// the same keys, and since signatures are deterministic the same VAAs always have the same signatures.
+package vaatest
+
+import (
+	"crypto/ecdsa"
+	"encoding/binary"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// GuardianKeys derives n guardian keys from seed.
+func GuardianKeys(seed string, n int) []*ecdsa.PrivateKey {
+	keys := make([]*ecdsa.PrivateKey, n)
+	for i := range keys {
+		// A hash is not a valid key with negligible probability, in which case the next counter is tried
+		for counter := uint32(0); keys[i] == nil; counter++ {
+			material := binary.BigEndian.AppendUint32([]byte(seed), uint32(i))
+			material = binary.BigEndian.AppendUint32(material, counter)
+			keys[i], _ = crypto.ToECDSA(crypto.Keccak256(material))
+		}
+	}
+	return keys
+}
+
+// GuardianSet is a guardian set whose keys sign VAAs. Guardian i signs with signature index i.
+type GuardianSet struct {
+	Index uint32
+	Keys  []*ecdsa.PrivateKey
+}
+
+// NewGuardianSet returns the guardian set with the given index and n guardians derived from seed.
+func NewGuardianSet(seed string, index uint32, n int) *GuardianSet {
+	return &GuardianSet{Index: index, Keys: GuardianKeys(seed, n)}
+}
+
+// Addresses returns the addresses of the guardians, in the order of their signature indexes.
+func (s *GuardianSet) Addresses() []common.Address {
+	addrs := make([]common.Address, len(s.Keys))
+	for i, key := range s.Keys {
+		addrs[i] = crypto.PubkeyToAddress(key.PublicKey)
+	}
+	return addrs
+}
+
+// Sign sets the guardian set index of v and replaces its signatures by the signatures of all guardians.
+func (s *GuardianSet) Sign(v *vaa.VAA) {
+	v.GuardianSetIndex = s.Index
+	SignVAA(v, s.Keys)
+}
+
+// SignVAA replaces the signatures of v by the signatures of keys, where keys[i] signs with signature index i.
+func SignVAA(v *vaa.VAA, keys []*ecdsa.PrivateKey) {
+	v.Signatures = nil
+	for i, key := range keys {
+		v.AddSignature(key, uint8(i))
+	}
+}
+
+// Emitter generates the VAAs of an emitter with consecutive sequences. The fields are used for the next VAA and can
+// be changed between VAAs.
+type Emitter struct {
+	Chain            vaa.ChainID
+	Address          vaa.Address
+	Sequence         uint64
+	Timestamp        time.Time
+	Nonce            uint32
+	ConsistencyLevel uint8
+}
+
+// NewEmitter returns an emitter whose first VAA has sequence 1.
+func NewEmitter(chain vaa.ChainID, address vaa.Address) *Emitter {
+	return &Emitter{
+		Chain:            chain,
+		Address:          address,
+		Sequence:         1,
+		Timestamp:        time.Unix(0, 0),
+		Nonce:            1,
+		ConsistencyLevel: 32,
+	}
+}
+
+// NewGovernanceEmitter returns an emitter of governance VAAs.
+func NewGovernanceEmitter() *Emitter {
+	return NewEmitter(vaa.GovernanceChain, vaa.GovernanceEmitter)
+}
+
+// Next returns the next unsigned VAA of the emitter with the given payload.
+func (e *Emitter) Next(payload []byte) *vaa.VAA {
+	v := &vaa.VAA{
+		Version:          vaa.SupportedVAAVersion,
+		Timestamp:        e.Timestamp,
+		Nonce:            e.Nonce,
+		Sequence:         e.Sequence,
+		ConsistencyLevel: e.ConsistencyLevel,
+		EmitterChain:     e.Chain,
+		EmitterAddress:   e.Address,
+		Payload:          payload,
+	}
+	e.Sequence++
+	return v
+}
+
+// NextSigned returns the next VAA of the emitter with the given payload, signed by all guardians of set.
+func (e *Emitter) NextSigned(set *GuardianSet, payload []byte) *vaa.VAA {
+	v := e.Next(payload)
+	set.Sign(v)
+	return v
+}
@@ -0,0 +1,47 @@
+package vaatest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestGuardianKeysDeterministic(t *testing.T) {
+	keys := GuardianKeys("seed", 3)
+	require.Len(t, keys, 3)
+	assert.Equal(t, keys, GuardianKeys("seed", 3))
+	assert.Equal(t, keys[:2], GuardianKeys("seed", 2))
+	assert.NotEqual(t, keys[0], keys[1])
+	assert.NotEqual(t, keys[0], GuardianKeys("other", 1)[0])
+}
+
+func TestEmitterNextSigned(t *testing.T) {
+	set := NewGuardianSet("seed", 2, 3)
+	emitter := NewGovernanceEmitter()
+
+	first := emitter.NextSigned(set, []byte{1})
+	second := emitter.NextSigned(set, []byte{2})
+	assert.Equal(t, uint64(1), first.Sequence)
+	assert.Equal(t, uint64(2), second.Sequence)
+	assert.Equal(t, vaa.GovernanceChain, first.EmitterChain)
+	assert.Equal(t, vaa.GovernanceEmitter, first.EmitterAddress)
+	assert.Equal(t, uint32(2), first.GuardianSetIndex)
+	require.Len(t, first.Signatures, 3)
+	assert.NoError(t, first.Verify(set.Addresses()))
+	assert.NoError(t, second.Verify(set.Addresses()))
+
+	// Generating the same VAA again yields the same bytes
+	again := NewGovernanceEmitter().NextSigned(NewGuardianSet("seed", 2, 3), []byte{1})
+	bz, err := first.Marshal()
+	require.NoError(t, err)
+	againBz, err := again.Marshal()
+	require.NoError(t, err)
+	assert.Equal(t, bz, againBz)
+
+	// Resigning replaces the signatures
+	SignVAA(first, set.Keys[:1])
+	assert.Len(t, first.Signatures, 1)
+	assert.Error(t, first.Verify(set.Addresses()))
+}
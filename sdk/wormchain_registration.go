@@ -0,0 +1,18 @@
+package sdk
+
+import (
+	"encoding/binary"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// SignedWormchainRegistrationDigest returns the digest a guardian signs with its guardian key to register a wormchain
+// validator address, as a proof of possession of the key. It commits to the cosmos chain id of the wormchain network,
+// so the signature can't be replayed on another network:
+// [SignedWormchainRegistrationPrefix][uint16 len(chain_id)][chain_id][address]
+func SignedWormchainRegistrationDigest(chainID string, address []byte) common.Hash {
+	chainIDLen := make([]byte, 2)
+	binary.BigEndian.PutUint16(chainIDLen, uint16(len(chainID)))
+	return crypto.Keccak256Hash(SignedWormchainRegistrationPrefix, chainIDLen, []byte(chainID), address)
+}
@@ -0,0 +1,42 @@
+package app
+
+import (
+	store "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	icahosttypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+)
+
+var V2_24_0_Upgrade = Upgrade{
+	UpgradeName:          "v2.24.0",
+	CreateUpgradeHandler: CreateV2_24_0_UpgradeHandler,
+	StoreUpgrades: store.StoreUpgrades{
+		Added: []string{
+			icahosttypes.StoreKey,
+		},
+	},
+}
+
+func CreateV2_24_0_UpgradeHandler(
+	mm *module.Manager,
+	cfg module.Configurator,
+	app *App,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		logger := ctx.Logger().With("upgrade", "v2.24.0")
+
+		// Initializes the interchain accounts module with its default genesis
+		vm, err := mm.RunMigrations(ctx, cfg, vm)
+		if err != nil {
+			return nil, err
+		}
+
+		// Interchain accounts host: the message types are gated per connection
+		// by the wormhole module ICA host allowlist, which starts out empty.
+		app.ICAHostKeeper.SetParams(ctx, icahosttypes.NewParams(true, []string{"*"}))
+		logger.Info("set interchain accounts host params")
+
+		return vm, nil
+	}
+}
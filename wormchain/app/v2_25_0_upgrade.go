@@ -0,0 +1,26 @@
+package app
+
+import (
+	store "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+var V2_25_0_Upgrade = Upgrade{
+	UpgradeName:          "v2.25.0",
+	CreateUpgradeHandler: CreateV2_25_0_UpgradeHandler,
+	StoreUpgrades:        store.StoreUpgrades{},
+}
+
+func CreateV2_25_0_UpgradeHandler(
+	mm *module.Manager,
+	cfg module.Configurator,
+	_ *App,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		// Runs the wormhole module store migrations, which re-key the
+		// guardian sets under length prefixed keys
+		return mm.RunMigrations(ctx, cfg, vm)
+	}
+}
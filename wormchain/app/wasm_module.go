@@ -0,0 +1,36 @@
+package app
+
+import (
+	"github.com/CosmWasm/wasmd/x/wasm"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	wormholemodulekeeper "github.com/wormhole-foundation/wormchain/x/wormhole/keeper"
+)
+
+// wasmAppModule is the x/wasm module with its msg server wrapped so the
+// messages published by the core contract are reported to the message posted
+// hooks of the wormhole module.
+type wasmAppModule struct {
+	wasm.AppModule
+	keeper         *wasmkeeper.Keeper
+	wormholeKeeper wormholemodulekeeper.Keeper
+}
+
+func newWasmAppModule(am wasm.AppModule, keeper *wasmkeeper.Keeper, wormholeKeeper wormholemodulekeeper.Keeper) wasmAppModule {
+	return wasmAppModule{AppModule: am, keeper: keeper, wormholeKeeper: wormholeKeeper}
+}
+
+// RegisterServices mirrors wasm.AppModule.RegisterServices, apart from the
+// wrapped msg server.
+func (am wasmAppModule) RegisterServices(cfg module.Configurator) {
+	msgServer := wasmkeeper.NewMsgServerImpl(wasmkeeper.NewDefaultPermissionKeeper(am.keeper))
+	wasmtypes.RegisterMsgServer(cfg.MsgServer(), wormholemodulekeeper.NewMessagePostedMsgServer(am.wormholeKeeper, msgServer))
+	wasmtypes.RegisterQueryServer(cfg.QueryServer(), wasm.NewQuerier(am.keeper))
+
+	m := wasmkeeper.NewMigrator(*am.keeper)
+	err := cfg.RegisterMigration(wasmtypes.ModuleName, 1, m.Migrate1to2)
+	if err != nil {
+		panic(err)
+	}
+}
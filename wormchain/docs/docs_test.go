@@ -0,0 +1,29 @@
+package docs_test
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormchain/docs"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+// TestOpenAPIWormholeQueries checks that every query of the wormhole module is documented, so the spec is
+// regenerated whenever a query is added.
+func TestOpenAPIWormholeQueries(t *testing.T) {
+	spec, err := docs.Docs.ReadFile("static/openapi.yml")
+	require.NoError(t, err)
+
+	operations := map[string]bool{}
+	for _, m := range regexp.MustCompile(`operationId: (\S+)`).FindAllSubmatch(spec, -1) {
+		operations[string(m[1])] = true
+	}
+
+	queries := reflect.TypeOf((*types.QueryServer)(nil)).Elem()
+	for i := 0; i < queries.NumMethod(); i++ {
+		name := queries.Method(i).Name
+		require.True(t, operations["WormholeFoundationWormchainWormhole"+name], "query %s is missing from the OpenAPI spec", name)
+	}
+}
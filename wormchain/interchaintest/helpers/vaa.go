@@ -1,46 +1,38 @@
 package helpers
 
 import (
-	"time"
+	"crypto/ecdsa"
 
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa/vaatest"
 
 	"github.com/wormhole-foundation/wormchain/interchaintest/guardians"
 )
 
-var latestSequence = 1
+// latestSequence numbers the VAAs of all emitters, so that VAAs with the same payload never collide.
+var latestSequence = uint64(1)
 
 func signVaa(vaaToSign vaa.VAA, signers *guardians.ValSet) vaa.VAA {
-	for i, key := range signers.Vals {
-		vaaToSign.AddSignature(key.Priv, uint8(i))
+	keys := make([]*ecdsa.PrivateKey, len(signers.Vals))
+	for i, val := range signers.Vals {
+		keys[i] = val.Priv
 	}
+	vaatest.SignVAA(&vaaToSign, keys)
 	return vaaToSign
 }
 
 func generateVaa(index uint32, signers *guardians.ValSet, emitterChain vaa.ChainID, emitterAddr vaa.Address, payload []byte) vaa.VAA {
-	v := vaa.VAA{
-		Version:          uint8(1),
-		GuardianSetIndex: index,
-		Signatures:       nil,
-		Timestamp:        time.Unix(0, 0),
-		Nonce:            uint32(1),
-		Sequence:         uint64(latestSequence),
-		ConsistencyLevel: uint8(32),
-		EmitterChain:     emitterChain,
-		EmitterAddress:   emitterAddr,
-		Payload:          payload,
-	}
-	latestSequence = latestSequence + 1
-	return signVaa(v, signers)
+	emitter := vaatest.NewEmitter(emitterChain, emitterAddr)
+	emitter.Sequence = latestSequence
+	v := emitter.Next(payload)
+	latestSequence = emitter.Sequence
+	v.GuardianSetIndex = index
+	return signVaa(*v, signers)
 }
 
 func GenerateGovernanceVaa(index uint32,
 	signers *guardians.ValSet,
 	payload []byte) vaa.VAA {
 
-	v := vaa.CreateGovernanceVAA(time.Unix(0, 0),
-		uint32(1), uint64(latestSequence), index, payload)
-
-	latestSequence = latestSequence + 1
-	return signVaa(*v, signers)
+	return generateVaa(index, signers, vaa.GovernanceChain, vaa.GovernanceEmitter, payload)
 }
@@ -14,10 +14,18 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/wormhole-foundation/wormchain/app"
 	"github.com/wormhole-foundation/wormchain/app/wasm_handlers"
+	ibccomposabilitymwkeeper "github.com/wormhole-foundation/wormchain/x/ibc-composability-mw/keeper"
+	ibccomposabilitymwtypes "github.com/wormhole-foundation/wormchain/x/ibc-composability-mw/types"
 	"github.com/wormhole-foundation/wormchain/x/wormhole/keeper"
 	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
 
@@ -31,29 +39,85 @@ import (
 )
 
 func WormholeKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
-	k, _, _, ctx := WormholeKeeperAndWasmd(t)
-	return k, ctx
+	keepers, ctx := wormholeKeepers(t)
+	return keepers.wormhole, ctx
 }
 
+// WormholeKeeperAndWasmd also sets the wasm view keeper of the wormhole keeper,
+// which verifies the code hashes of instantiations and migrations.
 func WormholeKeeperAndWasmd(t testing.TB) (*keeper.Keeper, wasmkeeper.Keeper, *wasmkeeper.PermissionedKeeper, sdk.Context) {
+	keepers, ctx := wormholeKeepers(t)
+	keepers.wormhole.SetWasmViewKeeper(keepers.wasm)
+	return keepers.wormhole, keepers.wasm, keepers.permissionedWasm, ctx
+}
+
+func WormholeKeeperAndBank(t testing.TB) (*keeper.Keeper, bankkeeper.BaseKeeper, sdk.Context) {
+	keepers, ctx := wormholeKeepers(t)
+	return keepers.wormhole, keepers.bank, ctx
+}
+
+func WormholeKeeperAndSlashing(t testing.TB) (*keeper.Keeper, slashingkeeper.Keeper, sdk.Context) {
+	keepers, ctx := wormholeKeepers(t)
+	return keepers.wormhole, keepers.slashing, ctx
+}
+
+func WormholeKeeperAndStaking(t testing.TB) (*keeper.Keeper, stakingkeeper.Keeper, sdk.Context) {
+	keepers, ctx := wormholeKeepers(t)
+	return keepers.wormhole, keepers.staking, ctx
+}
+
+func IbcComposabilityMwKeeper(t testing.TB) (*ibccomposabilitymwkeeper.Keeper, *keeper.Keeper, sdk.Context) {
+	keepers, ctx := wormholeKeepers(t)
+	return keepers.composabilityMw, keepers.wormhole, ctx
+}
+
+func WormholeKeeperAndConsensusParams(t testing.TB) (*keeper.Keeper, types.ConsensusParamsKeeper, sdk.Context) {
+	keepers, ctx := wormholeKeepers(t)
+	return keepers.wormhole, keepers.consensusParams, ctx
+}
+
+// testKeepers holds the wormhole keeper along with the keepers it was wired to.
+type testKeepers struct {
+	wormhole         *keeper.Keeper
+	wasm             wasmkeeper.Keeper
+	permissionedWasm *wasmkeeper.PermissionedKeeper
+	bank             bankkeeper.BaseKeeper
+	slashing         slashingkeeper.Keeper
+	staking          stakingkeeper.Keeper
+	consensusParams  types.ConsensusParamsKeeper
+	composabilityMw  *ibccomposabilitymwkeeper.Keeper
+}
+
+func wormholeKeepers(t testing.TB) (testKeepers, sdk.Context) {
 	keys := sdk.NewKVStoreKeys(
 		authtypes.StoreKey,
+		banktypes.StoreKey,
 		paramstypes.StoreKey,
 		capabilitytypes.StoreKey,
 		types.StoreKey,
 		wasmtypes.StoreKey,
+		slashingtypes.StoreKey,
+		stakingtypes.StoreKey,
+		ibccomposabilitymwtypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey, types.MemStoreKey)
-	maccPerms := map[string][]string{}
+	maccPerms := map[string][]string{
+		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
+	}
 
 	db := tmdb.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db)
 	stateStore.MountStoreWithDB(keys[authtypes.StoreKey], sdk.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(keys[banktypes.StoreKey], sdk.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(keys[paramstypes.StoreKey], sdk.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(keys[capabilitytypes.StoreKey], sdk.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(keys[types.StoreKey], sdk.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(keys[wasmtypes.StoreKey], sdk.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(keys[slashingtypes.StoreKey], sdk.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(keys[stakingtypes.StoreKey], sdk.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(keys[ibccomposabilitymwtypes.StoreKey], sdk.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(memKeys[types.MemStoreKey], sdk.StoreTypeMemory, nil)
 	stateStore.MountStoreWithDB(tkeys[paramstypes.TStoreKey], sdk.StoreTypeTransient, nil)
 	require.NoError(t, stateStore.LoadLatestVersion())
@@ -65,19 +129,28 @@ func WormholeKeeperAndWasmd(t testing.TB) (*keeper.Keeper, wasmkeeper.Keeper, *w
 	paramsKeeper := paramskeeper.NewKeeper(appCodec, amino, keys[paramstypes.StoreKey], tkeys[paramstypes.TStoreKey])
 	paramsKeeper.Subspace(types.ModuleName)
 	paramsKeeper.Subspace(wasm.ModuleName)
+	paramsKeeper.Subspace(slashingtypes.ModuleName)
+	paramsKeeper.Subspace(stakingtypes.ModuleName)
 
 	paramsKeeper.Subspace(authtypes.ModuleName)
 	subspace_auth, _ := paramsKeeper.GetSubspace(authtypes.ModuleName)
 	accountKeeper := authkeeper.NewAccountKeeper(
 		appCodec, keys[authtypes.StoreKey], subspace_auth, authtypes.ProtoBaseAccount, maccPerms,
 	)
+	paramsKeeper.Subspace(banktypes.ModuleName)
+	subspaceBank, _ := paramsKeeper.GetSubspace(banktypes.ModuleName)
+	bankKeeper := bankkeeper.NewBaseKeeper(appCodec, keys[banktypes.StoreKey], accountKeeper, subspaceBank, nil)
 	// this line is used by starport scaffolding # stargate/app/paramSubspace
 
 	subspaceWasmd, _ := paramsKeeper.GetSubspace(wasmtypes.ModuleName)
+	subspaceSlashing, _ := paramsKeeper.GetSubspace(slashingtypes.ModuleName)
+	slashingKeeper := slashingkeeper.NewKeeper(appCodec, keys[slashingtypes.StoreKey], nil, subspaceSlashing)
+	subspaceStaking, _ := paramsKeeper.GetSubspace(stakingtypes.ModuleName)
 
 	bApp := baseapp.NewBaseApp("wormchain", log.NewNopLogger(), db, encodingConfig.TxConfig.TxDecoder())
 	bApp.SetVersion(version.Version)
 	bApp.SetInterfaceRegistry(encodingConfig.InterfaceRegistry)
+	bApp.SetParamStore(paramsKeeper.Subspace(baseapp.Paramspace).WithKeyTable(paramskeeper.ConsensusParamsKeyTable()))
 
 	appapp := &app.App{
 		BaseApp: bApp,
@@ -88,7 +161,7 @@ func WormholeKeeperAndWasmd(t testing.TB) (*keeper.Keeper, wasmkeeper.Keeper, *w
 		keys[types.StoreKey],
 		memKeys[types.MemStoreKey],
 		accountKeeper,
-		nil,
+		bankKeeper,
 	)
 
 	supportedFeatures := "iterator,staking,stargate,wormhole"
@@ -135,6 +208,29 @@ func WormholeKeeperAndWasmd(t testing.TB) (*keeper.Keeper, wasmkeeper.Keeper, *w
 	permissionedWasmKeeper := wasmkeeper.NewDefaultPermissionKeeper(wasmKeeper)
 	appapp.WormholeKeeper.SetWasmdKeeper(permissionedWasmKeeper)
 	k.SetWasmdKeeper(permissionedWasmKeeper)
+	k.SetSlashingKeeper(slashingKeeper)
+	stakingKeeper := stakingkeeper.NewKeeper(appCodec, keys[stakingtypes.StoreKey], accountKeeper, nil, k, subspaceStaking)
+	stakingKeeper.SetParams(ctx, stakingtypes.DefaultParams())
+	k.SetStakingKeeper(stakingKeeper)
+	k.SetConsensusParamsKeeper(bApp)
+
+	composabilityMwKeeper := ibccomposabilitymwkeeper.NewKeeper(
+		appCodec,
+		keys[ibccomposabilitymwtypes.StoreKey],
+		&wasmKeeper,
+		k,
+		0,
+		time.Hour,
+	)
 
-	return k, wasmKeeper, permissionedWasmKeeper, ctx
+	return testKeepers{
+		wormhole:         k,
+		wasm:             wasmKeeper,
+		permissionedWasm: permissionedWasmKeeper,
+		bank:             bankKeeper,
+		slashing:         slashingKeeper,
+		staking:          stakingKeeper,
+		consensusParams:  bApp,
+		composabilityMw:  composabilityMwKeeper,
+	}, ctx
 }
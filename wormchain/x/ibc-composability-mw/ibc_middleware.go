@@ -101,19 +101,33 @@ func (im IBCMiddleware) OnRecvPacket(
 	return im.app.OnRecvPacket(ctx, packet, relayer)
 }
 
-// OnAcknowledgementPacket implements the IBCModule interface.
+// OnAcknowledgementPacket implements the IBCModule interface. Once the underlying application returned
+// the funds of a failed forwarded transfer to the ibc translator contract, they are sent back to the sender
+// of the token bridge transfer.
 func (im IBCMiddleware) OnAcknowledgementPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	acknowledgement []byte,
 	relayer sdk.AccAddress,
 ) error {
-	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+	if err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	im.keeper.OnAcknowledgementPacket(ctx, packet, acknowledgement)
+	return nil
 }
 
-// OnTimeoutPacket implements the IBCModule interface.
+// OnTimeoutPacket implements the IBCModule interface. Once the underlying application returned the funds of
+// a timed out forwarded transfer to the ibc translator contract, they are sent back to the sender of the
+// token bridge transfer.
 func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
-	return im.app.OnTimeoutPacket(ctx, packet, relayer)
+	if err := im.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	im.keeper.OnTimeoutPacket(ctx, packet)
+	return nil
 }
 
 // SendPacket implements the ICS4 Wrapper interface.
@@ -28,7 +28,13 @@ func NewICS4Middleware(channel porttypes.ICS4Wrapper, keeper *keeper.Keeper) ICS
 
 func (i ICS4Middleware) SendPacket(ctx sdk.Context, channelCap *capabilitytypes.Capability, packet ibcexported.PacketI) error {
 	err := i.channel.SendPacket(ctx, channelCap, packet)
-	return err
+	if err != nil {
+		return err
+	}
+
+	// Track transfers sent by the ibc translator contract, so that they can be refunded if they fail
+	i.keeper.TrackForwardedTransfer(ctx, packet)
+	return nil
 }
 
 func (i ICS4Middleware) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, ack ibcexported.Acknowledgement) error {
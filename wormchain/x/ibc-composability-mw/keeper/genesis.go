@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"bytes"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/wormhole-foundation/wormchain/x/ibc-composability-mw/types"
 )
@@ -11,6 +13,12 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 	for key, value := range state.TransposedDataInFlight {
 		store.Set([]byte(key), value)
 	}
+	for _, transfer := range state.ForwardedTransfers {
+		k.SetForwardedTransfer(ctx, transfer)
+	}
+	for _, refund := range state.PendingRefunds {
+		k.SetPendingRefund(ctx, refund)
+	}
 }
 
 // ExportGenesis
@@ -20,8 +28,18 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	transposedDataInFlight := make(map[string][]byte)
 
 	itr := store.Iterator(nil, nil)
+	defer itr.Close()
 	for ; itr.Valid(); itr.Next() {
+		// Forwarded transfers and pending refunds are exported separately
+		if bytes.HasPrefix(itr.Key(), types.KeyPrefix(types.ForwardedTransferKeyPrefix)) ||
+			bytes.HasPrefix(itr.Key(), types.KeyPrefix(types.PendingRefundKeyPrefix)) {
+			continue
+		}
 		transposedDataInFlight[string(itr.Key())] = itr.Value()
 	}
-	return &types.GenesisState{TransposedDataInFlight: transposedDataInFlight}
+	return &types.GenesisState{
+		TransposedDataInFlight: transposedDataInFlight,
+		ForwardedTransfers:     k.GetAllForwardedTransfers(ctx),
+		PendingRefunds:         k.GetAllPendingRefunds(ctx),
+	}
 }
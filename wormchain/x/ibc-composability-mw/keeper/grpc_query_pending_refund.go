@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/wormhole-foundation/wormchain/x/ibc-composability-mw/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) PendingRefundAll(c context.Context, req *types.QueryAllPendingRefundRequest) (*types.QueryAllPendingRefundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var pendingRefunds []types.PendingRefund
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	pendingRefundStore := prefix.NewStore(store, types.KeyPrefix(types.PendingRefundKeyPrefix))
+
+	pageRes, err := query.Paginate(pendingRefundStore, req.Pagination, func(key []byte, value []byte) error {
+		var pendingRefund types.PendingRefund
+		if err := k.cdc.Unmarshal(value, &pendingRefund); err != nil {
+			return err
+		}
+
+		pendingRefunds = append(pendingRefunds, pendingRefund)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllPendingRefundResponse{PendingRefund: pendingRefunds, Pagination: pageRes}, nil
+}
+
+func (k Keeper) PendingRefund(c context.Context, req *types.QueryGetPendingRefundRequest) (*types.QueryGetPendingRefundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetPendingRefund(
+		ctx,
+		req.PortId,
+		req.ChannelId,
+		req.Sequence,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetPendingRefundResponse{PendingRefund: val}, nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/wormhole-foundation/wormchain/x/ibc-composability-mw/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -17,6 +18,7 @@ import (
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wormholekeeper "github.com/wormhole-foundation/wormchain/x/wormhole/keeper"
+	wormholetypes "github.com/wormhole-foundation/wormchain/x/wormhole/types"
 )
 
 type Keeper struct {
@@ -51,6 +53,10 @@ func (k *Keeper) SetWasmKeeper(wasmkeeper *wasmkeeper.Keeper) {
 	k.wasmKeeper = wasmkeeper
 }
 
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
 // OnRecvPacket checks the memo field on this packet and if the memo indicates this packet
 // should be handled by the ibc composability middleware, it updates the memo according to the payload
 func (k Keeper) OnRecvPacket(
@@ -74,6 +80,11 @@ func (k Keeper) OnRecvPacket(
 		return packet, channeltypes.NewErrorAcknowledgement(fmt.Errorf("ibc-composability-mw: must be a valid memo for gateway"))
 	}
 
+	// Reject gateway token bridge payloads while the bridge is paused
+	if k.wormholeKeeper.IsBridgePaused(ctx) {
+		return packet, channeltypes.NewErrorAcknowledgement(wormholetypes.ErrBridgePaused)
+	}
+
 	parsedPayload, err := types.VerifyAndParseGatewayPayload(data.Memo)
 	if err != nil {
 		return packet, channeltypes.NewErrorAcknowledgement(err)
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/wormhole-foundation/wormchain/x/ibc-composability-mw/types"
+)
+
+type msgServer struct {
+	*Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (k msgServer) RetryRefund(goCtx context.Context, msg *types.MsgRetryRefund) (*types.MsgRetryRefundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.RetryRefund(ctx, msg.PortId, msg.ChannelId, msg.Sequence); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRetryRefundResponse{}, nil
+}
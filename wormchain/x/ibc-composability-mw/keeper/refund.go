@@ -0,0 +1,269 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+
+	"github.com/wormhole-foundation/wormchain/x/ibc-composability-mw/types"
+	wormholetypes "github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+// SetForwardedTransfer stores a forwarded transfer until its packet is acknowledged.
+func (k Keeper) SetForwardedTransfer(ctx sdk.Context, transfer types.ForwardedTransfer) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ForwardedTransferKeyPrefix))
+	b := k.cdc.MustMarshal(&transfer)
+	store.Set(types.PacketKey(transfer.PortId, transfer.ChannelId, transfer.Sequence), b)
+}
+
+// GetForwardedTransfer returns the forwarded transfer sent with the packet.
+func (k Keeper) GetForwardedTransfer(ctx sdk.Context, portID string, channelID string, sequence uint64) (val types.ForwardedTransfer, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ForwardedTransferKeyPrefix))
+	b := store.Get(types.PacketKey(portID, channelID, sequence))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveForwardedTransfer removes the forwarded transfer sent with the packet.
+func (k Keeper) RemoveForwardedTransfer(ctx sdk.Context, portID string, channelID string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ForwardedTransferKeyPrefix))
+	store.Delete(types.PacketKey(portID, channelID, sequence))
+}
+
+// GetAllForwardedTransfers returns all forwarded transfers whose packets were not acknowledged yet.
+func (k Keeper) GetAllForwardedTransfers(ctx sdk.Context) (list []types.ForwardedTransfer) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ForwardedTransferKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ForwardedTransfer
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetPendingRefund stores the refund of a failed forwarded transfer that could not be executed.
+func (k Keeper) SetPendingRefund(ctx sdk.Context, refund types.PendingRefund) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingRefundKeyPrefix))
+	b := k.cdc.MustMarshal(&refund)
+	store.Set(types.PacketKey(refund.Transfer.PortId, refund.Transfer.ChannelId, refund.Transfer.Sequence), b)
+}
+
+// GetPendingRefund returns the pending refund of the failed forwarded transfer sent with the packet.
+func (k Keeper) GetPendingRefund(ctx sdk.Context, portID string, channelID string, sequence uint64) (val types.PendingRefund, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingRefundKeyPrefix))
+	b := store.Get(types.PacketKey(portID, channelID, sequence))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemovePendingRefund removes the pending refund of the failed forwarded transfer sent with the packet.
+func (k Keeper) RemovePendingRefund(ctx sdk.Context, portID string, channelID string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingRefundKeyPrefix))
+	store.Delete(types.PacketKey(portID, channelID, sequence))
+}
+
+// GetAllPendingRefunds returns all pending refunds.
+func (k Keeper) GetAllPendingRefunds(ctx sdk.Context) (list []types.PendingRefund) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingRefundKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PendingRefund
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// TrackForwardedTransfer records the transfers the ibc translator contract sends to cosmos chains, along with
+// the sender of the token bridge transfer they were completed from, so that the funds can be returned to the
+// sender if the packet fails. Packets sent by anyone else are ignored.
+func (k Keeper) TrackForwardedTransfer(ctx sdk.Context, packet ibcexported.PacketI) {
+	if k.wasmKeeper == nil {
+		return
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return
+	}
+
+	ibcTranslatorContract := k.wormholeKeeper.GetIbcComposabilityMwContract(ctx)
+	if ibcTranslatorContract.ContractAddress == "" || data.Sender != ibcTranslatorContract.ContractAddress {
+		return
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return
+	}
+
+	ibcTranslatorAddr, err := sdk.AccAddressFromBech32(ibcTranslatorContract.ContractAddress)
+	if err != nil {
+		return
+	}
+
+	reqBz, err := json.Marshal(types.IbcTranslatorLastTransferSenderQueryMsg{})
+	if err != nil {
+		return
+	}
+
+	resp, err := k.wasmKeeper.QuerySmart(ctx, ibcTranslatorAddr, reqBz)
+	if err != nil {
+		// The contract predates sender tracking, the transfer can't be refunded
+		k.Logger(ctx).Error("failed to query the sender of a forwarded transfer", "error", err)
+		return
+	}
+
+	var sender types.IbcTranslatorTransferSender
+	if err := json.Unmarshal(resp, &sender); err != nil {
+		k.Logger(ctx).Error("failed to parse the sender of a forwarded transfer", "error", err)
+		return
+	}
+
+	k.SetForwardedTransfer(ctx, types.ForwardedTransfer{
+		PortId:      packet.GetSourcePort(),
+		ChannelId:   packet.GetSourceChannel(),
+		Sequence:    packet.GetSequence(),
+		Amount:      sdk.NewCoin(data.Denom, amount),
+		Receiver:    data.Receiver,
+		SenderChain: uint32(sender.Chain),
+		Sender:      sender.Sender,
+	})
+}
+
+// OnAcknowledgementPacket stops tracking the forwarded transfer sent with the packet, and returns its funds
+// to the sender if the destination chain acknowledged it with an error.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) {
+	transfer, found := k.GetForwardedTransfer(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return
+	}
+	k.RemoveForwardedTransfer(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil || ack.Success() {
+		return
+	}
+
+	k.refundOrDefer(ctx, transfer)
+}
+
+// OnTimeoutPacket stops tracking the forwarded transfer sent with the packet and returns its funds to the sender.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) {
+	transfer, found := k.GetForwardedTransfer(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return
+	}
+	k.RemoveForwardedTransfer(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
+	k.refundOrDefer(ctx, transfer)
+}
+
+// RetryRefund retries a pending refund and removes it once the funds were returned.
+func (k Keeper) RetryRefund(ctx sdk.Context, portID string, channelID string, sequence uint64) error {
+	pendingRefund, found := k.GetPendingRefund(ctx, portID, channelID, sequence)
+	if !found {
+		return types.ErrPendingRefundNotFound
+	}
+
+	if err := k.refund(ctx, pendingRefund.Transfer); err != nil {
+		return sdkerrors.Wrap(types.ErrRefundFailed, err.Error())
+	}
+
+	k.RemovePendingRefund(ctx, portID, channelID, sequence)
+	return nil
+}
+
+// refundOrDefer returns the funds of a failed forwarded transfer to the sender. The transfer module has
+// already returned the funds to the ibc translator contract at this point, so a refund that fails must not
+// fail the acknowledgement or timeout; it's stored as a pending refund instead.
+func (k Keeper) refundOrDefer(ctx sdk.Context, transfer types.ForwardedTransfer) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+
+	if err := k.refund(cacheCtx, transfer); err != nil {
+		k.Logger(ctx).Error("failed to refund forwarded transfer", "port", transfer.PortId, "channel", transfer.ChannelId,
+			"sequence", transfer.Sequence, "error", err)
+		k.SetPendingRefund(ctx, types.PendingRefund{Transfer: transfer, Error: err.Error()})
+		ctx.EventManager().EmitEvent(refundEvent(types.EventTypeRefundFailed, transfer).
+			AppendAttributes(sdk.NewAttribute(types.AttributeKeyError, err.Error())))
+		return
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
+
+// refund returns the funds of the transfer to the sender through the ibc translator contract, which converts
+// them back into their cw20 tokens and sends them through the token bridge.
+func (k Keeper) refund(ctx sdk.Context, transfer types.ForwardedTransfer) error {
+	if k.wasmKeeper == nil {
+		return fmt.Errorf("wasm keeper not set")
+	}
+	if k.wormholeKeeper.IsBridgePaused(ctx) {
+		return wormholetypes.ErrBridgePaused
+	}
+
+	ibcTranslatorContract := k.wormholeKeeper.GetIbcComposabilityMwContract(ctx)
+	ibcTranslatorAddr, err := sdk.AccAddressFromBech32(ibcTranslatorContract.ContractAddress)
+	if err != nil {
+		return err
+	}
+
+	executeMsg, err := json.Marshal(wormholetypes.GatewayExecuteMsg{
+		GatewayConvertAndTransfer: &wormholetypes.GatewayConvertAndTransfer{
+			Recipient: transfer.Sender,
+			Chain:     uint16(transfer.SenderChain),
+			Fee:       "0",
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// The funds were returned to the contract, which sends them on to the token bridge
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(k.wasmKeeper)
+	if _, err := contractKeeper.Execute(ctx, ibcTranslatorAddr, ibcTranslatorAddr, executeMsg, sdk.NewCoins(transfer.Amount)); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(refundEvent(types.EventTypeRefund, transfer))
+	return nil
+}
+
+func refundEvent(eventType string, transfer types.ForwardedTransfer) sdk.Event {
+	return sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(types.AttributeKeyPortID, transfer.PortId),
+		sdk.NewAttribute(types.AttributeKeyChannelID, transfer.ChannelId),
+		sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprint(transfer.Sequence)),
+		sdk.NewAttribute(types.AttributeKeyAmount, transfer.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeySenderChain, fmt.Sprint(transfer.SenderChain)),
+		sdk.NewAttribute(types.AttributeKeySender, hex.EncodeToString(transfer.Sender)),
+	)
+}
@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormchain/testutil/keeper"
+	"github.com/wormhole-foundation/wormchain/x/ibc-composability-mw/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func forwardedTransfer(sequence uint64) types.ForwardedTransfer {
+	return types.ForwardedTransfer{
+		PortId:      "transfer",
+		ChannelId:   "channel-0",
+		Sequence:    sequence,
+		Amount:      sdk.NewInt64Coin("factory/wormhole14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9srrg465/8sYgCzLRJC3J7qPn2bNbx6PiGcarhyx8rBhVaNnfvHCA", 1000),
+		Receiver:    "osmo1sender",
+		SenderChain: 2,
+		Sender:      bytes.Repeat([]byte{1}, 32),
+	}
+}
+
+func forwardedPacket(transfer types.ForwardedTransfer) channeltypes.Packet {
+	return channeltypes.Packet{
+		Sequence:           transfer.Sequence,
+		SourcePort:         transfer.PortId,
+		SourceChannel:      transfer.ChannelId,
+		DestinationPort:    "transfer",
+		DestinationChannel: "channel-1",
+	}
+}
+
+func TestForwardedTransferAcknowledged(t *testing.T) {
+	k, _, ctx := keeper.IbcComposabilityMwKeeper(t)
+
+	transfer := forwardedTransfer(1)
+	k.SetForwardedTransfer(ctx, transfer)
+
+	ack := channeltypes.NewResultAcknowledgement([]byte{1})
+	k.OnAcknowledgementPacket(ctx, forwardedPacket(transfer), transfertypes.ModuleCdc.MustMarshalJSON(&ack))
+
+	_, found := k.GetForwardedTransfer(ctx, transfer.PortId, transfer.ChannelId, transfer.Sequence)
+	require.False(t, found)
+	require.Empty(t, k.GetAllPendingRefunds(ctx))
+}
+
+func TestForwardedTransferFailed(t *testing.T) {
+	k, _, ctx := keeper.IbcComposabilityMwKeeper(t)
+
+	// The ibc translator contract isn't set, so the refunds can't be executed and are kept as pending refunds
+	errorAck := channeltypes.NewErrorAcknowledgement(errors.New("invalid receiver"))
+	timedOut := forwardedTransfer(1)
+	failed := forwardedTransfer(2)
+	k.SetForwardedTransfer(ctx, timedOut)
+	k.SetForwardedTransfer(ctx, failed)
+
+	k.OnTimeoutPacket(ctx, forwardedPacket(timedOut))
+	k.OnAcknowledgementPacket(ctx, forwardedPacket(failed), transfertypes.ModuleCdc.MustMarshalJSON(&errorAck))
+
+	require.Empty(t, k.GetAllForwardedTransfers(ctx))
+	for _, transfer := range []types.ForwardedTransfer{timedOut, failed} {
+		pendingRefund, found := k.GetPendingRefund(ctx, transfer.PortId, transfer.ChannelId, transfer.Sequence)
+		require.True(t, found)
+		require.Equal(t, transfer, pendingRefund.Transfer)
+		require.NotEmpty(t, pendingRefund.Error)
+	}
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 2)
+	require.Equal(t, types.EventTypeRefundFailed, events[0].Type)
+
+	// Retrying fails the same way and leaves the pending refund in place
+	err := k.RetryRefund(ctx, timedOut.PortId, timedOut.ChannelId, timedOut.Sequence)
+	require.ErrorIs(t, err, types.ErrRefundFailed)
+	_, found := k.GetPendingRefund(ctx, timedOut.PortId, timedOut.ChannelId, timedOut.Sequence)
+	require.True(t, found)
+
+	err = k.RetryRefund(ctx, timedOut.PortId, timedOut.ChannelId, 3)
+	require.ErrorIs(t, err, types.ErrPendingRefundNotFound)
+}
+
+func TestUntrackedPacket(t *testing.T) {
+	k, _, ctx := keeper.IbcComposabilityMwKeeper(t)
+
+	k.OnTimeoutPacket(ctx, forwardedPacket(forwardedTransfer(1)))
+
+	require.Empty(t, k.GetAllPendingRefunds(ctx))
+	require.Empty(t, ctx.EventManager().Events())
+}
+
+func TestPendingRefundQuery(t *testing.T) {
+	k, _, ctx := keeper.IbcComposabilityMwKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	var pendingRefunds []types.PendingRefund
+	for i := uint64(1); i <= 5; i++ {
+		pendingRefund := types.PendingRefund{Transfer: forwardedTransfer(i), Error: "bridge is paused"}
+		k.SetPendingRefund(ctx, pendingRefund)
+		pendingRefunds = append(pendingRefunds, pendingRefund)
+	}
+
+	res, err := k.PendingRefund(wctx, &types.QueryGetPendingRefundRequest{PortId: "transfer", ChannelId: "channel-0", Sequence: 3})
+	require.NoError(t, err)
+	require.Equal(t, pendingRefunds[2], res.PendingRefund)
+
+	_, err = k.PendingRefund(wctx, &types.QueryGetPendingRefundRequest{PortId: "transfer", ChannelId: "channel-0", Sequence: 6})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	var all []types.PendingRefund
+	var next []byte
+	for {
+		res, err := k.PendingRefundAll(wctx, &types.QueryAllPendingRefundRequest{Pagination: &query.PageRequest{Key: next, Limit: 2}})
+		require.NoError(t, err)
+		all = append(all, res.PendingRefund...)
+		next = res.Pagination.NextKey
+		if next == nil {
+			break
+		}
+	}
+	require.Equal(t, pendingRefunds, all)
+
+	_, err = k.PendingRefundAll(wctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGenesis(t *testing.T) {
+	k, _, ctx := keeper.IbcComposabilityMwKeeper(t)
+
+	genesisState := types.GenesisState{
+		TransposedDataInFlight: map[string][]byte{
+			string(types.TransposedDataKey("channel-1", "transfer", 7)): []byte("packet data"),
+		},
+		ForwardedTransfers: []types.ForwardedTransfer{forwardedTransfer(1), forwardedTransfer(2)},
+		PendingRefunds:     []types.PendingRefund{{Transfer: forwardedTransfer(3), Error: "bridge is paused"}},
+	}
+
+	k.InitGenesis(ctx, genesisState)
+	require.Equal(t, genesisState, *k.ExportGenesis(ctx))
+}
@@ -0,0 +1,8 @@
+package types
+
+import sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+var (
+	ErrPendingRefundNotFound = sdkerrors.Register(ModuleName, 2, "pending refund not found")
+	ErrRefundFailed          = sdkerrors.Register(ModuleName, 3, "failed to refund the forwarded transfer")
+)
@@ -0,0 +1,14 @@
+package types
+
+const (
+	EventTypeRefund       = "gateway_refund"
+	EventTypeRefundFailed = "gateway_refund_failed"
+
+	AttributeKeyPortID      = "port_id"
+	AttributeKeyChannelID   = "channel_id"
+	AttributeKeySequence    = "sequence"
+	AttributeKeyAmount      = "amount"
+	AttributeKeySenderChain = "sender_chain"
+	AttributeKeySender      = "sender"
+	AttributeKeyError       = "error"
+)
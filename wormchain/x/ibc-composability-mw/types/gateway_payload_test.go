@@ -0,0 +1,98 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/wormhole-foundation/wormchain/x/ibc-composability-mw/types"
+)
+
+const ibcTranslatorContract = "wormhole14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9srrg465"
+
+func TestVerifyAndParseGatewayPayload(t *testing.T) {
+	for _, tc := range []struct {
+		desc   string
+		memo   string
+		parsed types.ParsedPayload
+		valid  bool
+	}{
+		{
+			desc: "transfer",
+			memo: `{"gateway_ibc_token_bridge_payload":{"gateway_transfer":{"chain":2,"recipient":"AQID","fee":"10","nonce":7}}}`,
+			parsed: types.ParsedPayload{
+				NoPayload: true,
+				ChainId:   2,
+				Recipient: []byte{1, 2, 3},
+				Fee:       "10",
+				Nonce:     7,
+			},
+			valid: true,
+		},
+		{
+			desc: "transfer with payload",
+			memo: `{"gateway_ibc_token_bridge_payload":{"gateway_transfer_with_payload":{"chain":4,"contract":"BAU=","payload":"Bgc=","nonce":8}}}`,
+			parsed: types.ParsedPayload{
+				NoPayload: false,
+				ChainId:   4,
+				Recipient: []byte{4, 5},
+				Nonce:     8,
+				Payload:   []byte{6, 7},
+			},
+			valid: true,
+		},
+		{
+			desc:  "missing transfer",
+			memo:  `{"gateway_ibc_token_bridge_payload":{}}`,
+			valid: false,
+		},
+		{
+			desc:  "invalid json",
+			memo:  `{"gateway_ibc_token_bridge_payload":`,
+			valid: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			parsed, err := types.VerifyAndParseGatewayPayload(tc.memo)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.parsed, parsed)
+		})
+	}
+}
+
+func TestFormatIbcHooksMemo(t *testing.T) {
+	transfer := types.ParsedPayload{NoPayload: true, ChainId: 2, Recipient: []byte{1, 2, 3}, Fee: "10", Nonce: 7}
+	memo, err := types.FormatIbcHooksMemo(transfer, ibcTranslatorContract)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"wasm":{"contract":"`+ibcTranslatorContract+`","msg":{"gateway_convert_and_transfer":{"chain":2,"recipient":"AQID","fee":"10","nonce":7}}}}`, memo)
+
+	withPayload := types.ParsedPayload{ChainId: 4, Recipient: []byte{4, 5}, Nonce: 8, Payload: []byte{6, 7}}
+	memo, err = types.FormatIbcHooksMemo(withPayload, ibcTranslatorContract)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"wasm":{"contract":"`+ibcTranslatorContract+`","msg":{"gateway_convert_and_transfer_with_payload":{"chain":4,"contract":"BAU=","payload":"Bgc=","nonce":8}}}}`, memo)
+}
+
+func TestFormatPfmMemo(t *testing.T) {
+	resp, err := json.Marshal(types.IbcTranslatorQueryRsp{Channel: "channel-3"})
+	require.NoError(t, err)
+
+	transfer := types.ParsedPayload{NoPayload: true, ChainId: 3104, Recipient: []byte("osmo1recipient")}
+	memo, err := types.FormatPfmMemo(transfer, resp, time.Minute, 2)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"forward":{"receiver":"osmo1recipient","port":"transfer","channel":"channel-3","timeout":60000000000,"retries":2}}`, memo)
+
+	// The payload of a transfer with payload is passed on to the next hop
+	withPayload := types.ParsedPayload{ChainId: 3104, Recipient: []byte("osmo1contract"), Payload: []byte(`{"wasm":{}}`)}
+	memo, err = types.FormatPfmMemo(withPayload, resp, time.Minute, 2)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"forward":{"receiver":"osmo1contract","port":"transfer","channel":"channel-3","timeout":60000000000,"retries":2,"next":"{\"wasm\":{}}"}}`, memo)
+
+	_, err = types.FormatPfmMemo(transfer, []byte("invalid"), time.Minute, 2)
+	require.Error(t, err)
+}
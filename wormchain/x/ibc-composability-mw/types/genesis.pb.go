@@ -28,7 +28,9 @@ type GenesisState struct {
 	// key - information about modified packet: src_channel
 	// (parsedReceiver.Channel), src_port (parsedReceiver.Port), sequence value -
 	// bytes are the packet data bytes as they came in
-	TransposedDataInFlight map[string][]byte `protobuf:"bytes,1,rep,name=transposed_data_in_flight,json=transposedDataInFlight,proto3" json:"transposed_data_in_flight" yaml:"transposed_data_in_flight" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	TransposedDataInFlight map[string][]byte   `protobuf:"bytes,1,rep,name=transposed_data_in_flight,json=transposedDataInFlight,proto3" json:"transposed_data_in_flight" yaml:"transposed_data_in_flight" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ForwardedTransfers     []ForwardedTransfer `protobuf:"bytes,2,rep,name=forwarded_transfers,json=forwardedTransfers,proto3" json:"forwarded_transfers"`
+	PendingRefunds         []PendingRefund     `protobuf:"bytes,3,rep,name=pending_refunds,json=pendingRefunds,proto3" json:"pending_refunds"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -71,6 +73,20 @@ func (m *GenesisState) GetTransposedDataInFlight() map[string][]byte {
 	return nil
 }
 
+func (m *GenesisState) GetForwardedTransfers() []ForwardedTransfer {
+	if m != nil {
+		return m.ForwardedTransfers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingRefunds() []PendingRefund {
+	if m != nil {
+		return m.PendingRefunds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "wormhole_foundation.wormchain.ibc_composability_mw.v1.GenesisState")
 	proto.RegisterMapType((map[string][]byte)(nil), "wormhole_foundation.wormchain.ibc_composability_mw.v1.GenesisState.TransposedDataInFlightEntry")
@@ -81,28 +97,33 @@ func init() {
 }
 
 var fileDescriptor_17a5790dbeee9b79 = []byte{
-	// 326 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xc1, 0x4e, 0xf2, 0x40,
-	0x14, 0x85, 0x3b, 0x90, 0xff, 0x4f, 0xac, 0x2c, 0x4c, 0x43, 0x0c, 0x62, 0x52, 0x9a, 0xae, 0xba,
-	0x61, 0x1a, 0x35, 0x26, 0x86, 0x25, 0x11, 0x0d, 0x5b, 0x74, 0xe5, 0x66, 0x32, 0x2d, 0x43, 0x3b,
-	0xb1, 0x9d, 0xdb, 0x74, 0x2e, 0x60, 0xdf, 0xc2, 0x47, 0xf0, 0x61, 0x5c, 0xb0, 0x64, 0xe9, 0x8a,
-	0x18, 0x78, 0x03, 0x9f, 0xc0, 0x40, 0x23, 0x6a, 0x82, 0x2e, 0xdc, 0xdd, 0x99, 0xc5, 0xf7, 0x9d,
-	0x9c, 0x63, 0xba, 0x32, 0x08, 0xdb, 0x21, 0xa4, 0x19, 0x68, 0x1e, 0xc8, 0x44, 0x62, 0xd1, 0x4e,
-	0xa7, 0x7e, 0x24, 0x94, 0xd0, 0x52, 0xd3, 0x2c, 0x07, 0x04, 0xeb, 0x7c, 0x0a, 0x79, 0x1a, 0x43,
-	0x22, 0xd8, 0x08, 0xc6, 0x6a, 0xc8, 0x51, 0x82, 0xa2, 0xeb, 0xbf, 0x30, 0xe6, 0x52, 0x51, 0x19,
-	0x84, 0xec, 0x1b, 0x81, 0xa5, 0x53, 0x3a, 0x39, 0x69, 0xd6, 0x23, 0x88, 0x60, 0x43, 0xf0, 0xd7,
-	0x57, 0x09, 0x73, 0x9f, 0x2a, 0x66, 0xed, 0xba, 0xc4, 0xdf, 0x20, 0x47, 0x61, 0x3d, 0x13, 0xf3,
-	0x08, 0x73, 0xae, 0x74, 0x06, 0x5a, 0x0c, 0xd9, 0x90, 0x23, 0x67, 0x52, 0xb1, 0x51, 0x22, 0xa3,
-	0x18, 0x1b, 0xc4, 0xa9, 0x7a, 0xfb, 0xa7, 0x8c, 0xfe, 0x29, 0x02, 0xfd, 0x2a, 0xa2, 0xb7, 0x5b,
-	0xc9, 0x25, 0x47, 0xde, 0x57, 0x57, 0x1b, 0x43, 0x4f, 0x61, 0x5e, 0x74, 0xbd, 0xd9, 0xa2, 0x65,
-	0xbc, 0x2d, 0x5a, 0x4e, 0xc1, 0xd3, 0xa4, 0xe3, 0xfe, 0x98, 0xc7, 0x1d, 0x1c, 0xe2, 0x4e, 0x4c,
-	0xb3, 0x6f, 0x1e, 0xff, 0x22, 0xb0, 0x0e, 0xcc, 0xea, 0xbd, 0x28, 0x1a, 0xc4, 0x21, 0xde, 0xde,
-	0x60, 0x7d, 0x5a, 0x75, 0xf3, 0xdf, 0x84, 0x27, 0x63, 0xd1, 0xa8, 0x38, 0xc4, 0xab, 0x0d, 0xca,
-	0x47, 0xa7, 0x72, 0x41, 0xba, 0x6c, 0xb6, 0xb4, 0xc9, 0x7c, 0x69, 0x93, 0xd7, 0xa5, 0x4d, 0x1e,
-	0x57, 0xb6, 0x31, 0x5f, 0xd9, 0xc6, 0xcb, 0xca, 0x36, 0xee, 0x7a, 0x91, 0xc4, 0x78, 0x1c, 0xd0,
-	0x10, 0x52, 0xff, 0xa3, 0x91, 0xf6, 0x67, 0x23, 0xfe, 0xb6, 0x11, 0xff, 0xc1, 0xdf, 0x39, 0x2c,
-	0x16, 0x99, 0xd0, 0xc1, 0xff, 0xcd, 0x14, 0x67, 0xef, 0x01, 0x00, 0x00, 0xff, 0xff, 0x99, 0x45,
-	0x35, 0x0c, 0xfd, 0x01, 0x00, 0x00,
+	// 406 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x31, 0x8f, 0xd3, 0x30,
+	0x14, 0xc7, 0xe3, 0xf6, 0x40, 0xc2, 0x9c, 0x00, 0x85, 0x13, 0x0a, 0x45, 0xca, 0x85, 0x4c, 0x59,
+	0xea, 0x08, 0x10, 0x12, 0xba, 0xb1, 0xba, 0x3b, 0xb8, 0x0d, 0x85, 0x4e, 0x2c, 0x96, 0x93, 0x38,
+	0x89, 0x45, 0x62, 0x47, 0xb6, 0xd3, 0x90, 0x89, 0xaf, 0xc0, 0xc4, 0x27, 0x62, 0xe8, 0xd8, 0x91,
+	0xa9, 0x42, 0xed, 0x37, 0xe0, 0x13, 0xa0, 0x24, 0x6d, 0x29, 0xa8, 0xdc, 0xd0, 0xcd, 0x79, 0x79,
+	0xfa, 0xfd, 0xfe, 0xef, 0xe9, 0x41, 0x97, 0x85, 0xd1, 0x38, 0x12, 0x45, 0x29, 0x14, 0x09, 0x59,
+	0xce, 0x74, 0x33, 0x2e, 0x6a, 0x3f, 0xa5, 0x9c, 0x2a, 0xa6, 0x50, 0x29, 0x85, 0x16, 0xe6, 0xeb,
+	0x5a, 0xc8, 0x22, 0x13, 0x39, 0xc5, 0x89, 0xa8, 0x78, 0x4c, 0x34, 0x13, 0x1c, 0xb5, 0xb5, 0x28,
+	0x23, 0x8c, 0x23, 0x16, 0x46, 0xf8, 0x2f, 0x02, 0x2e, 0x6a, 0x34, 0x7b, 0x31, 0x3a, 0x4b, 0x45,
+	0x2a, 0x3a, 0x82, 0xdf, 0xbe, 0x7a, 0xd8, 0xe8, 0xf9, 0x41, 0xa1, 0xa4, 0x49, 0xc5, 0xe3, 0xbe,
+	0xc5, 0xfd, 0x76, 0x02, 0x4f, 0xdf, 0xf6, 0x09, 0x3e, 0x68, 0xa2, 0xa9, 0xf9, 0x1d, 0xc0, 0xa7,
+	0x5a, 0x12, 0xae, 0x4a, 0xa1, 0x68, 0x8c, 0x63, 0xa2, 0x09, 0x66, 0x1c, 0x27, 0x39, 0x4b, 0x33,
+	0x6d, 0x01, 0x67, 0xe8, 0xdd, 0x7f, 0x89, 0xd1, 0x51, 0x29, 0xd1, 0xbe, 0x08, 0x4d, 0x77, 0x92,
+	0x4b, 0xa2, 0xc9, 0x0d, 0xbf, 0xee, 0x0c, 0x57, 0x5c, 0xcb, 0x66, 0xe2, 0xcd, 0x97, 0xe7, 0xc6,
+	0xaf, 0xe5, 0xb9, 0xd3, 0x90, 0x22, 0xbf, 0x70, 0xff, 0x9b, 0xc7, 0x0d, 0x9e, 0xe8, 0x83, 0x18,
+	0xf3, 0x0b, 0x7c, 0x9c, 0x08, 0x59, 0x13, 0x19, 0xd3, 0x18, 0x77, 0x3d, 0x09, 0x95, 0xca, 0x1a,
+	0x74, 0xf9, 0xdf, 0x1d, 0x99, 0xff, 0x7a, 0x4b, 0x9c, 0x6e, 0x80, 0x93, 0x93, 0x36, 0x68, 0x60,
+	0x26, 0xff, 0xfe, 0x50, 0xa6, 0x82, 0x0f, 0x4b, 0xca, 0x63, 0xc6, 0x53, 0xdc, 0x2f, 0x5c, 0x59,
+	0xc3, 0x4e, 0x7e, 0x79, 0xa4, 0xfc, 0x7d, 0x4f, 0x0b, 0x3a, 0xd8, 0x46, 0xfc, 0xa0, 0xdc, 0x2f,
+	0xaa, 0xd1, 0x0d, 0x7c, 0x76, 0xcb, 0x5a, 0xcd, 0x47, 0x70, 0xf8, 0x89, 0x36, 0x16, 0x70, 0x80,
+	0x77, 0x2f, 0x68, 0x9f, 0xe6, 0x19, 0xbc, 0x33, 0x23, 0x79, 0x45, 0xad, 0x81, 0x03, 0xbc, 0xd3,
+	0xa0, 0xff, 0xb8, 0x18, 0xbc, 0x01, 0x13, 0x3c, 0x5f, 0xd9, 0x60, 0xb1, 0xb2, 0xc1, 0xcf, 0x95,
+	0x0d, 0xbe, 0xae, 0x6d, 0x63, 0xb1, 0xb6, 0x8d, 0x1f, 0x6b, 0xdb, 0xf8, 0x78, 0x95, 0x32, 0x9d,
+	0x55, 0x21, 0x8a, 0x44, 0xe1, 0x6f, 0x47, 0x19, 0xff, 0x19, 0xc5, 0xdf, 0x8d, 0xe2, 0x7f, 0xf6,
+	0x0f, 0x1e, 0xa0, 0x6e, 0x4a, 0xaa, 0xc2, 0xbb, 0xdd, 0x01, 0xbe, 0xfa, 0x3d, 0x00, 0xfc, 0xd8,
+	0x57, 0x9f, 0x16, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -125,6 +146,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingRefunds) > 0 {
+		for iNdEx := len(m.PendingRefunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRefunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ForwardedTransfers) > 0 {
+		for iNdEx := len(m.ForwardedTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForwardedTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.TransposedDataInFlight) > 0 {
 		for k := range m.TransposedDataInFlight {
 			v := m.TransposedDataInFlight[k]
@@ -178,6 +227,18 @@ func (m *GenesisState) Size() (n int) {
 			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
 		}
 	}
+	if len(m.ForwardedTransfers) > 0 {
+		for _, e := range m.ForwardedTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingRefunds) > 0 {
+		for _, e := range m.PendingRefunds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -344,6 +405,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.TransposedDataInFlight[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardedTransfers = append(m.ForwardedTransfers, ForwardedTransfer{})
+			if err := m.ForwardedTransfers[len(m.ForwardedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRefunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRefunds = append(m.PendingRefunds, PendingRefund{})
+			if err := m.PendingRefunds[len(m.PendingRefunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
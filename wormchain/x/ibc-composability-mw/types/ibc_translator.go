@@ -11,3 +11,15 @@ type QueryIbcChannel struct {
 type IbcTranslatorQueryRsp struct {
 	Channel string `json:"channel,omitempty"`
 }
+
+type IbcTranslatorLastTransferSenderQueryMsg struct {
+	LastTransferSender QueryLastTransferSender `json:"last_transfer_sender"`
+}
+
+type QueryLastTransferSender struct{}
+
+// IbcTranslatorTransferSender is the sender of the most recent token bridge transfer completed by the ibc translator.
+type IbcTranslatorTransferSender struct {
+	Chain  uint16 `json:"chain"`
+	Sender []byte `json:"sender"`
+}
@@ -1,6 +1,10 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	// ModuleName defines the ibc composability middleware name
@@ -8,8 +12,27 @@ const (
 	ModuleName = "composability-mw"
 
 	StoreKey = ModuleName
+
+	// RouterKey is the message route for the ibc composability middleware
+	RouterKey = ModuleName
+
+	// ForwardedTransferKeyPrefix is the prefix to retrieve all ForwardedTransfer
+	ForwardedTransferKeyPrefix = "ForwardedTransfer/value/"
+
+	// PendingRefundKeyPrefix is the prefix to retrieve all PendingRefund
+	PendingRefundKeyPrefix = "PendingRefund/value/"
 )
 
 func TransposedDataKey(channelID, portID string, sequence uint64) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%d", channelID, portID, sequence))
 }
+
+func KeyPrefix(p string) []byte {
+	return []byte(p)
+}
+
+// PacketKey returns the key of a forwarded transfer or pending refund, relative to its prefix.
+func PacketKey(portID, channelID string, sequence uint64) []byte {
+	key := []byte(fmt.Sprintf("%s/%s/", portID, channelID))
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
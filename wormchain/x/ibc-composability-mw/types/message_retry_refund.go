@@ -0,0 +1,58 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+)
+
+var _ sdk.Msg = &MsgRetryRefund{}
+
+func NewMsgRetryRefund(signer string, portID string, channelID string, sequence uint64) *MsgRetryRefund {
+	return &MsgRetryRefund{
+		Signer:    signer,
+		PortId:    portID,
+		ChannelId: channelID,
+		Sequence:  sequence,
+	}
+}
+
+func (msg *MsgRetryRefund) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRetryRefund) Type() string {
+	return "MsgRetryRefund"
+}
+
+func (msg *MsgRetryRefund) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg *MsgRetryRefund) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRetryRefund) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if msg.Sequence == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "sequence must not be zero")
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormchain/testutil/sample"
+	"github.com/wormhole-foundation/wormchain/x/ibc-composability-mw/types"
+)
+
+func TestMsgRetryRefundValidateBasic(t *testing.T) {
+	signer := sample.AccAddress()
+
+	tests := []struct {
+		name  string
+		msg   *types.MsgRetryRefund
+		valid bool
+	}{
+		{"valid", types.NewMsgRetryRefund(signer, "transfer", "channel-0", 1), true},
+		{"invalid signer", types.NewMsgRetryRefund("invalid", "transfer", "channel-0", 1), false},
+		{"invalid port", types.NewMsgRetryRefund(signer, "", "channel-0", 1), false},
+		{"invalid channel", types.NewMsgRetryRefund(signer, "transfer", "channel 0", 1), false},
+		{"zero sequence", types.NewMsgRetryRefund(signer, "transfer", "channel-0", 0), false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
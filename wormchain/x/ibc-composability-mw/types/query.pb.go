@@ -0,0 +1,1139 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc-composability-mw/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type QueryGetPendingRefundRequest struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence  uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *QueryGetPendingRefundRequest) Reset()         { *m = QueryGetPendingRefundRequest{} }
+func (m *QueryGetPendingRefundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPendingRefundRequest) ProtoMessage()    {}
+func (*QueryGetPendingRefundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f19cc35f4f4d632b, []int{0}
+}
+func (m *QueryGetPendingRefundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPendingRefundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPendingRefundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPendingRefundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPendingRefundRequest.Merge(m, src)
+}
+func (m *QueryGetPendingRefundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPendingRefundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPendingRefundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPendingRefundRequest proto.InternalMessageInfo
+
+func (m *QueryGetPendingRefundRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryGetPendingRefundRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryGetPendingRefundRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+type QueryGetPendingRefundResponse struct {
+	PendingRefund PendingRefund `protobuf:"bytes,1,opt,name=pending_refund,json=pendingRefund,proto3" json:"pending_refund"`
+}
+
+func (m *QueryGetPendingRefundResponse) Reset()         { *m = QueryGetPendingRefundResponse{} }
+func (m *QueryGetPendingRefundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPendingRefundResponse) ProtoMessage()    {}
+func (*QueryGetPendingRefundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f19cc35f4f4d632b, []int{1}
+}
+func (m *QueryGetPendingRefundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPendingRefundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPendingRefundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPendingRefundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPendingRefundResponse.Merge(m, src)
+}
+func (m *QueryGetPendingRefundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPendingRefundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPendingRefundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPendingRefundResponse proto.InternalMessageInfo
+
+func (m *QueryGetPendingRefundResponse) GetPendingRefund() PendingRefund {
+	if m != nil {
+		return m.PendingRefund
+	}
+	return PendingRefund{}
+}
+
+type QueryAllPendingRefundRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllPendingRefundRequest) Reset()         { *m = QueryAllPendingRefundRequest{} }
+func (m *QueryAllPendingRefundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPendingRefundRequest) ProtoMessage()    {}
+func (*QueryAllPendingRefundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f19cc35f4f4d632b, []int{2}
+}
+func (m *QueryAllPendingRefundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPendingRefundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPendingRefundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllPendingRefundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPendingRefundRequest.Merge(m, src)
+}
+func (m *QueryAllPendingRefundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPendingRefundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPendingRefundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllPendingRefundRequest proto.InternalMessageInfo
+
+func (m *QueryAllPendingRefundRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllPendingRefundResponse struct {
+	PendingRefund []PendingRefund     `protobuf:"bytes,1,rep,name=pending_refund,json=pendingRefund,proto3" json:"pending_refund"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllPendingRefundResponse) Reset()         { *m = QueryAllPendingRefundResponse{} }
+func (m *QueryAllPendingRefundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPendingRefundResponse) ProtoMessage()    {}
+func (*QueryAllPendingRefundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f19cc35f4f4d632b, []int{3}
+}
+func (m *QueryAllPendingRefundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPendingRefundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPendingRefundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllPendingRefundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPendingRefundResponse.Merge(m, src)
+}
+func (m *QueryAllPendingRefundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPendingRefundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPendingRefundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllPendingRefundResponse proto.InternalMessageInfo
+
+func (m *QueryAllPendingRefundResponse) GetPendingRefund() []PendingRefund {
+	if m != nil {
+		return m.PendingRefund
+	}
+	return nil
+}
+
+func (m *QueryAllPendingRefundResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryGetPendingRefundRequest)(nil), "wormhole_foundation.wormchain.ibc_composability_mw.v1.QueryGetPendingRefundRequest")
+	proto.RegisterType((*QueryGetPendingRefundResponse)(nil), "wormhole_foundation.wormchain.ibc_composability_mw.v1.QueryGetPendingRefundResponse")
+	proto.RegisterType((*QueryAllPendingRefundRequest)(nil), "wormhole_foundation.wormchain.ibc_composability_mw.v1.QueryAllPendingRefundRequest")
+	proto.RegisterType((*QueryAllPendingRefundResponse)(nil), "wormhole_foundation.wormchain.ibc_composability_mw.v1.QueryAllPendingRefundResponse")
+}
+
+func init() { proto.RegisterFile("ibc-composability-mw/query.proto", fileDescriptor_f19cc35f4f4d632b) }
+
+var fileDescriptor_f19cc35f4f4d632b = []byte{
+	// 466 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcf, 0x6a, 0xd4, 0x40,
+	0x18, 0xdf, 0xe9, 0xd6, 0x6a, 0x47, 0x2a, 0x32, 0x08, 0x96, 0x60, 0xe3, 0xba, 0x07, 0x2d, 0xc2,
+	0xce, 0xb0, 0x15, 0x1f, 0xa0, 0x45, 0x2d, 0xbd, 0xd5, 0xd8, 0x93, 0x97, 0x30, 0x99, 0xcc, 0x26,
+	0x03, 0xc9, 0xcc, 0x6c, 0x66, 0xd2, 0x75, 0xef, 0x3e, 0x80, 0xf8, 0x38, 0x82, 0xf7, 0x1e, 0x7b,
+	0x14, 0x04, 0x91, 0xdd, 0x17, 0x91, 0x64, 0xd2, 0x76, 0x83, 0xdb, 0x0a, 0xb5, 0xbd, 0x25, 0xdf,
+	0x37, 0xdf, 0xef, 0xcf, 0xf7, 0x1b, 0x06, 0xf6, 0x44, 0xc4, 0x06, 0x4c, 0xe5, 0x5a, 0x19, 0x1a,
+	0x89, 0x4c, 0xd8, 0xe9, 0x20, 0x9f, 0x90, 0x71, 0xc9, 0x8b, 0x29, 0xd6, 0x85, 0xb2, 0x0a, 0xbd,
+	0x9e, 0xa8, 0x22, 0x4f, 0x55, 0xc6, 0xc3, 0x91, 0x2a, 0x65, 0x4c, 0xad, 0x50, 0x12, 0x57, 0x35,
+	0x96, 0x52, 0x21, 0xb1, 0x88, 0x58, 0xd8, 0x9a, 0x0f, 0xf3, 0x09, 0x3e, 0x1e, 0x7a, 0x8f, 0x12,
+	0x95, 0xa8, 0x1a, 0x81, 0x54, 0x5f, 0x0e, 0xcc, 0x7b, 0xc9, 0x94, 0xc9, 0x95, 0x21, 0x11, 0x35,
+	0xdc, 0xb1, 0x90, 0xe3, 0x61, 0xc4, 0x2d, 0x1d, 0x12, 0x4d, 0x13, 0x21, 0x1d, 0xba, 0x3b, 0xfb,
+	0x6c, 0xa9, 0xb4, 0x82, 0x8f, 0x4a, 0x19, 0xbb, 0x23, 0xfd, 0x02, 0x3e, 0x79, 0x5f, 0x81, 0xec,
+	0x73, 0x7b, 0xc8, 0x65, 0x2c, 0x64, 0x12, 0xd4, 0xed, 0x80, 0x8f, 0x4b, 0x6e, 0x2c, 0x7a, 0x0c,
+	0xef, 0x6a, 0x55, 0xd8, 0x50, 0xc4, 0x9b, 0xa0, 0x07, 0xb6, 0xd7, 0x83, 0xb5, 0xea, 0xf7, 0x20,
+	0x46, 0x5b, 0x10, 0xb2, 0x94, 0x4a, 0xc9, 0xb3, 0xaa, 0xb7, 0x52, 0xf7, 0xd6, 0x9b, 0xca, 0x41,
+	0x8c, 0x3c, 0x78, 0xcf, 0x54, 0x10, 0x92, 0xf1, 0xcd, 0x6e, 0x0f, 0x6c, 0xaf, 0x06, 0xe7, 0xff,
+	0xfd, 0xaf, 0x00, 0x6e, 0x5d, 0x42, 0x6a, 0xb4, 0x92, 0x86, 0xa3, 0x31, 0x7c, 0xa0, 0x5d, 0x23,
+	0x74, 0x6a, 0x6b, 0xf2, 0xfb, 0x3b, 0x6f, 0xf0, 0xb5, 0x56, 0x89, 0x5b, 0x2c, 0x7b, 0xab, 0x27,
+	0xbf, 0x9e, 0x76, 0x82, 0x0d, 0xbd, 0x58, 0xec, 0x8f, 0x9a, 0x45, 0xec, 0x66, 0xd9, 0xd2, 0x45,
+	0xbc, 0x83, 0xf0, 0x62, 0xbf, 0x8d, 0x9c, 0xe7, 0xd8, 0x85, 0x81, 0xab, 0x30, 0xb0, 0x8b, 0xbc,
+	0x09, 0x03, 0x1f, 0xd2, 0x84, 0x37, 0xb3, 0xc1, 0xc2, 0x64, 0xff, 0xe7, 0x99, 0xf9, 0xbf, 0x89,
+	0xae, 0x30, 0xdf, 0xbd, 0x55, 0xf3, 0x68, 0xbf, 0x65, 0x6e, 0xa5, 0x36, 0xf7, 0xe2, 0x9f, 0xe6,
+	0x9c, 0xde, 0x45, 0x77, 0x3b, 0x9f, 0xbb, 0xf0, 0x4e, 0xed, 0x0e, 0x7d, 0x03, 0x70, 0xa3, 0xc5,
+	0x8c, 0x3e, 0x5c, 0x53, 0xff, 0x55, 0xf7, 0xd3, 0x3b, 0xba, 0x59, 0xd0, 0x26, 0x82, 0xef, 0x00,
+	0x3e, 0x6c, 0x75, 0x76, 0xb3, 0xec, 0xff, 0xf4, 0x5f, 0x72, 0xad, 0xbc, 0xa3, 0x9b, 0x05, 0x75,
+	0xfa, 0xf7, 0xc2, 0x93, 0x99, 0x0f, 0x4e, 0x67, 0x3e, 0xf8, 0x3d, 0xf3, 0xc1, 0x97, 0xb9, 0xdf,
+	0x39, 0x9d, 0xfb, 0x9d, 0x1f, 0x73, 0xbf, 0xf3, 0xf1, 0x6d, 0x22, 0x6c, 0x5a, 0x46, 0x98, 0xa9,
+	0x9c, 0x9c, 0x31, 0x0f, 0x2e, 0x98, 0xc9, 0x39, 0x33, 0xf9, 0x44, 0x96, 0xbe, 0x1e, 0x76, 0xaa,
+	0xb9, 0x89, 0xd6, 0xea, 0xd7, 0xe3, 0xd5, 0x9f, 0x01, 0x00, 0x32, 0x6e, 0x17, 0xa2, 0xfd, 0x04,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Queries the pending refund of a failed forwarded transfer.
+	PendingRefund(ctx context.Context, in *QueryGetPendingRefundRequest, opts ...grpc.CallOption) (*QueryGetPendingRefundResponse, error)
+	// Queries a list of pending refunds.
+	PendingRefundAll(ctx context.Context, in *QueryAllPendingRefundRequest, opts ...grpc.CallOption) (*QueryAllPendingRefundResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) PendingRefund(ctx context.Context, in *QueryGetPendingRefundRequest, opts ...grpc.CallOption) (*QueryGetPendingRefundResponse, error) {
+	out := new(QueryGetPendingRefundResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.ibc_composability_mw.v1.Query/PendingRefund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingRefundAll(ctx context.Context, in *QueryAllPendingRefundRequest, opts ...grpc.CallOption) (*QueryAllPendingRefundResponse, error) {
+	out := new(QueryAllPendingRefundResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.ibc_composability_mw.v1.Query/PendingRefundAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Queries the pending refund of a failed forwarded transfer.
+	PendingRefund(context.Context, *QueryGetPendingRefundRequest) (*QueryGetPendingRefundResponse, error)
+	// Queries a list of pending refunds.
+	PendingRefundAll(context.Context, *QueryAllPendingRefundRequest) (*QueryAllPendingRefundResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) PendingRefund(ctx context.Context, req *QueryGetPendingRefundRequest) (*QueryGetPendingRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingRefund not implemented")
+}
+func (*UnimplementedQueryServer) PendingRefundAll(ctx context.Context, req *QueryAllPendingRefundRequest) (*QueryAllPendingRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingRefundAll not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_PendingRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPendingRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.ibc_composability_mw.v1.Query/PendingRefund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingRefund(ctx, req.(*QueryGetPendingRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingRefundAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPendingRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingRefundAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.ibc_composability_mw.v1.Query/PendingRefundAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingRefundAll(ctx, req.(*QueryAllPendingRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "wormhole_foundation.wormchain.ibc_composability_mw.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PendingRefund",
+			Handler:    _Query_PendingRefund_Handler,
+		},
+		{
+			MethodName: "PendingRefundAll",
+			Handler:    _Query_PendingRefundAll_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc-composability-mw/query.proto",
+}
+
+func (m *QueryGetPendingRefundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPendingRefundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPendingRefundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPendingRefundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPendingRefundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPendingRefundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PendingRefund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPendingRefundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPendingRefundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPendingRefundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPendingRefundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPendingRefundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPendingRefundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingRefund) > 0 {
+		for iNdEx := len(m.PendingRefund) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRefund[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGetPendingRefundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryGetPendingRefundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PendingRefund.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllPendingRefundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPendingRefundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingRefund) > 0 {
+		for _, e := range m.PendingRefund {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGetPendingRefundRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPendingRefundRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPendingRefundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPendingRefundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPendingRefundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPendingRefundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRefund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingRefund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPendingRefundRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPendingRefundRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPendingRefundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPendingRefundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPendingRefundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPendingRefundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRefund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRefund = append(m.PendingRefund, PendingRefund{})
+			if err := m.PendingRefund[len(m.PendingRefund)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
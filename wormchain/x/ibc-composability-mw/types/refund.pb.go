@@ -0,0 +1,843 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc-composability-mw/refund.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ForwardedTransfer is an ibc transfer sent by the ibc translator contract to
+// a cosmos chain on behalf of a token bridge transfer. It is tracked until the
+// packet is acknowledged, so that the funds can be returned to the sender of
+// the token bridge transfer if the packet times out or fails on the
+// destination chain.
+type ForwardedTransfer struct {
+	PortId    string     `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string     `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence  uint64     `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Amount    types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	Receiver  string     `protobuf:"bytes,5,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// the wormhole chain id and the 32 byte address of the sender of the token
+	// bridge transfer
+	SenderChain uint32 `protobuf:"varint,6,opt,name=sender_chain,json=senderChain,proto3" json:"sender_chain,omitempty"`
+	Sender      []byte `protobuf:"bytes,7,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *ForwardedTransfer) Reset()         { *m = ForwardedTransfer{} }
+func (m *ForwardedTransfer) String() string { return proto.CompactTextString(m) }
+func (*ForwardedTransfer) ProtoMessage()    {}
+func (*ForwardedTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f92d95a5f77f2bb2, []int{0}
+}
+func (m *ForwardedTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardedTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardedTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForwardedTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardedTransfer.Merge(m, src)
+}
+func (m *ForwardedTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardedTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardedTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardedTransfer proto.InternalMessageInfo
+
+func (m *ForwardedTransfer) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ForwardedTransfer) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ForwardedTransfer) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *ForwardedTransfer) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *ForwardedTransfer) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *ForwardedTransfer) GetSenderChain() uint32 {
+	if m != nil {
+		return m.SenderChain
+	}
+	return 0
+}
+
+func (m *ForwardedTransfer) GetSender() []byte {
+	if m != nil {
+		return m.Sender
+	}
+	return nil
+}
+
+// PendingRefund is a forwarded transfer that failed and whose funds could not
+// be returned through the token bridge yet.
+type PendingRefund struct {
+	Transfer ForwardedTransfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer"`
+	// the reason the last refund attempt failed
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *PendingRefund) Reset()         { *m = PendingRefund{} }
+func (m *PendingRefund) String() string { return proto.CompactTextString(m) }
+func (*PendingRefund) ProtoMessage()    {}
+func (*PendingRefund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f92d95a5f77f2bb2, []int{1}
+}
+func (m *PendingRefund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingRefund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingRefund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingRefund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingRefund.Merge(m, src)
+}
+func (m *PendingRefund) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingRefund) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingRefund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingRefund proto.InternalMessageInfo
+
+func (m *PendingRefund) GetTransfer() ForwardedTransfer {
+	if m != nil {
+		return m.Transfer
+	}
+	return ForwardedTransfer{}
+}
+
+func (m *PendingRefund) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ForwardedTransfer)(nil), "wormhole_foundation.wormchain.ibc_composability_mw.v1.ForwardedTransfer")
+	proto.RegisterType((*PendingRefund)(nil), "wormhole_foundation.wormchain.ibc_composability_mw.v1.PendingRefund")
+}
+
+func init() { proto.RegisterFile("ibc-composability-mw/refund.proto", fileDescriptor_f92d95a5f77f2bb2) }
+
+var fileDescriptor_f92d95a5f77f2bb2 = []byte{
+	// 413 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0x4d, 0x6f, 0x13, 0x31,
+	0x14, 0x8c, 0x21, 0xdd, 0xb6, 0x4e, 0x7b, 0xc0, 0xaa, 0x60, 0x89, 0xc4, 0xb2, 0xed, 0x69, 0x2f,
+	0xb1, 0x95, 0x22, 0xc4, 0xbd, 0x15, 0x88, 0xde, 0xd0, 0x8a, 0x13, 0x97, 0x95, 0xd7, 0x7e, 0x49,
+	0x8c, 0xb2, 0x7e, 0xc1, 0xeb, 0x4d, 0xe8, 0xbf, 0x80, 0x7f, 0xd5, 0x63, 0x8e, 0x9c, 0x10, 0x4a,
+	0x7e, 0x04, 0x57, 0xb4, 0x1f, 0x2c, 0x42, 0x70, 0xea, 0xcd, 0x33, 0x63, 0x8d, 0xe7, 0xcd, 0x33,
+	0x3d, 0x37, 0xb9, 0x9a, 0x28, 0x2c, 0x56, 0x58, 0xca, 0xdc, 0x2c, 0x8d, 0xbf, 0x9d, 0x14, 0x1b,
+	0xe1, 0x60, 0x56, 0x59, 0xcd, 0x57, 0x0e, 0x3d, 0xb2, 0x97, 0x1b, 0x74, 0xc5, 0x02, 0x97, 0x90,
+	0xcd, 0xb0, 0xb2, 0x5a, 0x7a, 0x83, 0x96, 0xd7, 0x9c, 0x5a, 0x48, 0x63, 0xb9, 0xc9, 0x55, 0xf6,
+	0x97, 0x41, 0x56, 0x6c, 0xf8, 0x7a, 0x3a, 0x3e, 0x9b, 0xe3, 0x1c, 0x1b, 0x07, 0x51, 0x9f, 0x5a,
+	0xb3, 0x71, 0xa4, 0xb0, 0x2c, 0xb0, 0x14, 0xb9, 0x2c, 0x41, 0xac, 0xa7, 0x39, 0x78, 0x39, 0x15,
+	0x0a, 0x8d, 0x6d, 0xf5, 0x8b, 0x9f, 0x84, 0x3e, 0x7a, 0x83, 0x6e, 0x23, 0x9d, 0x06, 0xfd, 0xde,
+	0x49, 0x5b, 0xce, 0xc0, 0xb1, 0x27, 0xf4, 0x70, 0x85, 0xce, 0x67, 0x46, 0x87, 0x24, 0x26, 0xc9,
+	0x71, 0x1a, 0xd4, 0xf0, 0x46, 0xb3, 0x67, 0x94, 0xaa, 0x85, 0xb4, 0x16, 0x96, 0xb5, 0xf6, 0xa0,
+	0xd1, 0x8e, 0x3b, 0xe6, 0x46, 0xb3, 0x31, 0x3d, 0x2a, 0xe1, 0x53, 0x05, 0x56, 0x41, 0xf8, 0x30,
+	0x26, 0xc9, 0x30, 0xed, 0x31, 0x7b, 0x45, 0x03, 0x59, 0x60, 0x65, 0x7d, 0x38, 0x8c, 0x49, 0x32,
+	0xba, 0x7c, 0xca, 0xdb, 0x68, 0xbc, 0x8e, 0xc6, 0xbb, 0x68, 0xfc, 0x1a, 0x8d, 0xbd, 0x1a, 0xde,
+	0x7d, 0x7f, 0x3e, 0x48, 0xbb, 0xeb, 0xb5, 0xa9, 0x03, 0x05, 0x66, 0x0d, 0x2e, 0x3c, 0x68, 0x5e,
+	0xec, 0x31, 0x3b, 0xa7, 0x27, 0x25, 0x58, 0x0d, 0x2e, 0x6b, 0xca, 0x09, 0x83, 0x98, 0x24, 0xa7,
+	0xe9, 0xa8, 0xe5, 0xae, 0x6b, 0x8a, 0x3d, 0xa6, 0x41, 0x0b, 0xc3, 0xc3, 0x98, 0x24, 0x27, 0x69,
+	0x87, 0x2e, 0xbe, 0x12, 0x7a, 0xfa, 0x0e, 0xac, 0x36, 0x76, 0x9e, 0x36, 0xf5, 0xb3, 0x8f, 0xf4,
+	0xc8, 0x77, 0x0d, 0x34, 0x63, 0x8f, 0x2e, 0xdf, 0xf2, 0x7b, 0xed, 0x82, 0xff, 0xd3, 0x68, 0x37,
+	0x52, 0xef, 0xcf, 0xce, 0xe8, 0x01, 0x38, 0x87, 0xae, 0xeb, 0xb0, 0x05, 0x57, 0xd9, 0xdd, 0x2e,
+	0x22, 0xdb, 0x5d, 0x44, 0x7e, 0xec, 0x22, 0xf2, 0x65, 0x1f, 0x0d, 0xb6, 0xfb, 0x68, 0xf0, 0x6d,
+	0x1f, 0x0d, 0x3e, 0xbc, 0x9e, 0x1b, 0xbf, 0xa8, 0x72, 0xae, 0xb0, 0x10, 0xbf, 0x33, 0x4d, 0xfe,
+	0x64, 0x12, 0x7d, 0x26, 0xf1, 0x59, 0xfc, 0xf7, 0x8b, 0xf9, 0xdb, 0x15, 0x94, 0x79, 0xd0, 0x6c,
+	0xfd, 0xc5, 0xaf, 0x01, 0x00, 0x88, 0xab, 0x7c, 0xb0, 0x87, 0x02, 0x00, 0x00,
+}
+
+func (m *ForwardedTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardedTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardedTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintRefund(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.SenderChain != 0 {
+		i = encodeVarintRefund(dAtA, i, uint64(m.SenderChain))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintRefund(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRefund(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Sequence != 0 {
+		i = encodeVarintRefund(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintRefund(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintRefund(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingRefund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingRefund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingRefund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintRefund(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Transfer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRefund(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRefund(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRefund(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ForwardedTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovRefund(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovRefund(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovRefund(uint64(m.Sequence))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovRefund(uint64(l))
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovRefund(uint64(l))
+	}
+	if m.SenderChain != 0 {
+		n += 1 + sovRefund(uint64(m.SenderChain))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovRefund(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingRefund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Transfer.Size()
+	n += 1 + l + sovRefund(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovRefund(uint64(l))
+	}
+	return n
+}
+
+func sovRefund(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRefund(x uint64) (n int) {
+	return sovRefund(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ForwardedTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRefund
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardedTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardedTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRefund
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRefund
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRefund
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRefund
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRefund
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRefund
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRefund
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRefund
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRefund
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRefund
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRefund
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRefund
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRefund
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderChain", wireType)
+			}
+			m.SenderChain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRefund
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SenderChain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRefund
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRefund
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRefund
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRefund(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRefund
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingRefund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRefund
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingRefund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingRefund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRefund
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRefund
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRefund
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Transfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRefund
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRefund
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRefund
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRefund(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRefund
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRefund(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRefund
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRefund
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRefund
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRefund
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRefund
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRefund
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRefund        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRefund          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRefund = fmt.Errorf("proto: unexpected end of group")
+)
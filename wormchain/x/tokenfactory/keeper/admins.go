@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/gogo/protobuf/proto"
 
 	"github.com/wormhole-foundation/wormchain/x/tokenfactory/types"
@@ -47,3 +48,29 @@ func (k Keeper) setAdmin(ctx sdk.Context, denom string, admin string) error {
 
 	return k.setAuthorityMetadata(ctx, denom, metadata)
 }
+
+// ForceChangeAdmin sets the admin of an existing denom without the consent of
+// its current admin. It is used by wormhole guardian governance to repair the
+// denoms of wrapped assets. An empty admin leaves the denom without an admin.
+func (k Keeper) ForceChangeAdmin(ctx sdk.Context, denom string, newAdmin string) error {
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); !found {
+		return types.ErrDenomDoesNotExist.Wrapf("denom: %s", denom)
+	}
+
+	return k.setAdmin(ctx, denom, newAdmin)
+}
+
+// ForceSetDenomMetadata sets the bank metadata of an existing denom without
+// the consent of its admin. It is used by wormhole guardian governance to
+// repair the denoms of wrapped assets.
+func (k Keeper) ForceSetDenomMetadata(ctx sdk.Context, metadata banktypes.Metadata) error {
+	if err := metadata.Validate(); err != nil {
+		return err
+	}
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, metadata.Base); !found {
+		return types.ErrDenomDoesNotExist.Wrapf("denom: %s", metadata.Base)
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+	return nil
+}
@@ -4,7 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	//banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/wormhole-foundation/wormchain/x/tokenfactory/types"
 )
@@ -523,3 +523,51 @@ func (suite *KeeperTestSuite) TestChangeAdminDenom() {
 		})
 	}
 }*/
+
+func (suite *KeeperTestSuite) TestForceChangeAdmin() {
+	suite.CreateDefaultDenom()
+	keeper := suite.App.TokenFactoryKeeper
+
+	suite.Require().NoError(keeper.ForceChangeAdmin(suite.Ctx, suite.defaultDenom, suite.TestAccs[1].String()))
+	metadata, err := keeper.GetAuthorityMetadata(suite.Ctx, suite.defaultDenom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.TestAccs[1].String(), metadata.Admin)
+
+	// The new admin can mint, the previous one can not
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(suite.TestAccs[1].String(), sdk.NewInt64Coin(suite.defaultDenom, 5)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(suite.TestAccs[0].String(), sdk.NewInt64Coin(suite.defaultDenom, 5)))
+	suite.Require().Error(err)
+
+	suite.Require().ErrorIs(keeper.ForceChangeAdmin(suite.Ctx, suite.defaultDenom+"x", suite.TestAccs[1].String()), types.ErrDenomDoesNotExist)
+	suite.Require().Error(keeper.ForceChangeAdmin(suite.Ctx, suite.defaultDenom, "invalid"))
+}
+
+func (suite *KeeperTestSuite) TestForceSetDenomMetadata() {
+	suite.CreateDefaultDenom()
+	keeper := suite.App.TokenFactoryKeeper
+
+	metadata := banktypes.Metadata{
+		Description: "wrapped bitcoin",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: suite.defaultDenom, Exponent: 0},
+			{Denom: "wbtc", Exponent: 8},
+		},
+		Base:    suite.defaultDenom,
+		Display: "wbtc",
+		Name:    "Wrapped Bitcoin",
+		Symbol:  "WBTC",
+	}
+	suite.Require().NoError(keeper.ForceSetDenomMetadata(suite.Ctx, metadata))
+	stored, found := suite.App.BankKeeper.GetDenomMetaData(suite.Ctx, suite.defaultDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(metadata, stored)
+
+	metadata.Display = "unknown"
+	suite.Require().Error(keeper.ForceSetDenomMetadata(suite.Ctx, metadata))
+
+	metadata.Base = "uosmo"
+	metadata.DenomUnits = []*banktypes.DenomUnit{{Denom: "uosmo", Exponent: 0}}
+	metadata.Display = "uosmo"
+	suite.Require().ErrorIs(keeper.ForceSetDenomMetadata(suite.Ctx, metadata), types.ErrDenomDoesNotExist)
+}
@@ -1,9 +1,11 @@
 package ante
 
 import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/wormhole-foundation/wormchain/x/wormhole/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
 )
 
 // Reject all messages if we're expecting a software update.
@@ -36,8 +38,8 @@ func (wh WormholeAllowlistDecorator) AnteHandle(request sdk.Request, tx sdk.Tx,
 			// check for an allowlist
 			if wh.k.HasValidatorAllowedAddress(request, addr) {
 				allowed_entry := wh.k.GetValidatorAllowedAddress(request, addr)
-				// authenticate that the validator that made the allowlist is still valid
-				if wh.k.IsAddressValidatorOrFutureValidator(request, allowed_entry.ValidatorAddress) {
+				// authenticate that the entry has not expired and that the validator that made the allowlist is still valid
+				if !allowed_entry.Expired(request.BlockHeight()) && wh.k.IsAddressValidatorOrFutureValidator(request, allowed_entry.ValidatorAddress) {
 					// ok
 					return next(request, tx, simulate)
 				}
@@ -55,3 +57,79 @@ func (wh WormholeAllowlistDecorator) AnteHandle(request sdk.Request, tx sdk.Tx,
 	// Not authorized!
 	return request, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "signer must be current validator or allowlisted by current validator")
 }
+
+// Reject guardian-only messages from accounts that are not on the governance
+// submitter allowlist. The allowlist is only enforced once it has an entry.
+type WormholeGovernanceSubmitterDecorator struct {
+	k keeper.Keeper
+}
+
+func NewWormholeGovernanceSubmitterDecorator(k keeper.Keeper) WormholeGovernanceSubmitterDecorator {
+	return WormholeGovernanceSubmitterDecorator{
+		k: k,
+	}
+}
+
+// isGuardianOnlyMsg returns whether a message may only be submitted by
+// allowlisted governance submitters.
+func isGuardianOnlyMsg(msg sdk.Msg) bool {
+	switch msg.(type) {
+	case *types.MsgExecuteGovernanceVAA,
+		*types.MsgExecuteGovernanceVAABatch,
+		*types.MsgExecuteGatewayGovernanceVaa,
+		*types.MsgStoreCode,
+		*types.MsgInstantiateContract,
+		*types.MsgMigrateContract:
+		return true
+	default:
+		return false
+	}
+}
+
+func (wh WormholeGovernanceSubmitterDecorator) AnteHandle(request sdk.Request, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Request, err error) {
+	if request.IsReCheckTx() {
+		return next(request, tx, simulate)
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		if !isGuardianOnlyMsg(msg) {
+			continue
+		}
+		for _, signer := range msg.GetSigners() {
+			if !wh.k.IsGovernanceSubmitterAllowed(request, signer.String()) {
+				return request, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowlisted to submit governance VAAs", signer)
+			}
+		}
+	}
+
+	return next(request, tx, simulate)
+}
+
+// Reject wasm executions sent directly to contracts that governance put under
+// maintenance for the current block. Executions routed through the module,
+// such as gateway transfers, do not pass through the ante handler and are
+// still allowed. Executions by interchain accounts are rejected by the
+// IcaHostAllowlistModule instead.
+type WormholeMaintenanceWindowDecorator struct {
+	k keeper.Keeper
+}
+
+func NewWormholeMaintenanceWindowDecorator(k keeper.Keeper) WormholeMaintenanceWindowDecorator {
+	return WormholeMaintenanceWindowDecorator{
+		k: k,
+	}
+}
+
+func (wh WormholeMaintenanceWindowDecorator) AnteHandle(request sdk.Request, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Request, err error) {
+	for _, msg := range tx.GetMsgs() {
+		execute, ok := msg.(*wasmtypes.MsgExecuteContract)
+		if !ok {
+			continue
+		}
+		if wh.k.IsContractUnderMaintenance(request, execute.Contract) {
+			return request, sdkerrors.Wrap(types.ErrContractUnderMaintenance, execute.Contract)
+		}
+	}
+
+	return next(request, tx, simulate)
+}
@@ -408,7 +408,7 @@ func CmdGenerateGuardianSetUpdatea() *cobra.Command {
 func CmdTestSignAddress() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "test-sign-address",
-		Short: "Test method sign the validator address to use for registering as a guardian.  Use guardiand for production, not this method.  Read guardian key as hex in $GUARDIAN_KEY env variable. use --from to indicate address to sign.",
+		Short: "Test method sign the validator address to use for registering as a guardian.  Use guardiand for production, not this method.  Read guardian key as hex in $GUARDIAN_KEY env variable. use --from to indicate address to sign and --chain-id to indicate the chain it is registered on.",
 		Args:  cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -425,8 +425,11 @@ func CmdTestSignAddress() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if clientCtx.ChainID == "" {
+				return fmt.Errorf("--%s is required", flags.FlagChainID)
+			}
 			addr := info.GetAddress()
-			addrHash := crypto.Keccak256Hash(wormholesdk.SignedWormchainAddressPrefix, addr)
+			addrHash := wormholesdk.SignedWormchainRegistrationDigest(clientCtx.ChainID, addr)
 			sig, err := crypto.Sign(addrHash[:], key)
 			if err != nil {
 				return err
@@ -26,19 +26,53 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(CmdListGuardianSet())
 	cmd.AddCommand(CmdShowGuardianSet())
+	cmd.AddCommand(CmdGuardianSetHistory())
 	cmd.AddCommand(CmdShowConfig())
 	cmd.AddCommand(CmdListReplayProtection())
 	cmd.AddCommand(CmdShowReplayProtection())
+	cmd.AddCommand(CmdListExecutedGovernanceVAA())
 	cmd.AddCommand(CmdListSequenceCounter())
 	cmd.AddCommand(CmdShowSequenceCounter())
 	cmd.AddCommand(CmdShowConsensusGuardianSetIndex())
+	cmd.AddCommand(CmdShowConsensusGuardianSetValidators())
 	cmd.AddCommand(CmdListGuardianValidator())
 	cmd.AddCommand(CmdShowGuardianValidator())
 	cmd.AddCommand(CmdLatestGuardianSetIndex())
 	cmd.AddCommand(CmdListAllowlists())
 	cmd.AddCommand(CmdShowAllowlist())
 	cmd.AddCommand(CmdShowIbcComposabilityMwContract())
+	cmd.AddCommand(CmdShowCoreContract())
 	cmd.AddCommand(CmdListWasmInstantiateAllowlist())
+	cmd.AddCommand(CmdListRegisteredEmitter())
+	cmd.AddCommand(CmdShowRegisteredEmitter())
+	cmd.AddCommand(CmdListGovernanceSubmitter())
+	cmd.AddCommand(CmdShowGovernanceSubmitter())
+	cmd.AddCommand(CmdVerifyVAA())
+	cmd.AddCommand(CmdListArchivedVAA())
+	cmd.AddCommand(CmdListObservationTally())
+	cmd.AddCommand(CmdShowObservationTally())
+	cmd.AddCommand(CmdShowGuardianSetWeights())
+	cmd.AddCommand(CmdShowBridgePaused())
+	cmd.AddCommand(CmdListChainRateLimit())
+	cmd.AddCommand(CmdShowChainRateLimit())
+	cmd.AddCommand(CmdListQueuedObservation())
+	cmd.AddCommand(CmdListMsgShutdown())
+	cmd.AddCommand(CmdListIcaHostAllowlist())
+	cmd.AddCommand(CmdListForwardVolume())
+	cmd.AddCommand(CmdShowMaintenanceWindow())
+	cmd.AddCommand(CmdListGuardianHeartbeat())
+	cmd.AddCommand(CmdShowGuardianHeartbeat())
+	cmd.AddCommand(CmdShowAccountantBalance())
+	cmd.AddCommand(CmdListAccountantAccounts())
+	cmd.AddCommand(CmdListAccountantTransfers())
+	cmd.AddCommand(CmdListAccountantPendingTransfers())
+	cmd.AddCommand(CmdShowAccountantTransferStatus())
+	cmd.AddCommand(CmdShowNextSequence())
+	cmd.AddCommand(CmdListSequenceReservation())
+	cmd.AddCommand(CmdListApprovedCodeHash())
+	cmd.AddCommand(CmdShowApprovedCodeHash())
+	cmd.AddCommand(CmdShowWrappedAssetSupply())
+	cmd.AddCommand(CmdSimulateGovernanceVAA())
 
 	// this line is used by starport scaffolding # 1
 
@@ -0,0 +1,212 @@
+package cli
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+func CmdShowAccountantBalance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-accountant-balance [contract] [chain-id] [token-chain] [token-address]",
+		Short: "shows the balance of a token on a chain in a global accountant contract",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			key, err := parseAccountantAccountKey(args[1], args[2], args[3])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AccountantBalance(context.Background(), &types.QueryAccountantBalanceRequest{
+				Contract: args[0],
+				Key:      key,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListAccountantAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-accountant-accounts [contract]",
+		Short: "list the accounts of a global accountant contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			limit, err := cmd.Flags().GetUint32(flags.FlagLimit)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccountantAccountAll(context.Background(), &types.QueryAllAccountantAccountRequest{
+				Contract: args[0],
+				Limit:    limit,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint32(flags.FlagLimit, 0, "maximum number of accounts to list, the contract's default if 0")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListAccountantTransfers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-accountant-transfers [contract]",
+		Short: "list the transfers committed by a global accountant contract, with their digests",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			limit, err := cmd.Flags().GetUint32(flags.FlagLimit)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccountantTransferAll(context.Background(), &types.QueryAllAccountantTransferRequest{
+				Contract: args[0],
+				Limit:    limit,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint32(flags.FlagLimit, 0, "maximum number of transfers to list, the contract's default if 0")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListAccountantPendingTransfers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-accountant-pending-transfers [contract]",
+		Short: "list the transfers a global accountant contract has not committed yet",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			limit, err := cmd.Flags().GetUint32(flags.FlagLimit)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccountantPendingTransferAll(context.Background(), &types.QueryAllAccountantPendingTransferRequest{
+				Contract: args[0],
+				Limit:    limit,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint32(flags.FlagLimit, 0, "maximum number of pending transfers to list, the contract's default if 0")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowAccountantTransferStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-accountant-transfer-status [contract] [emitter-chain] [emitter-address] [sequence]",
+		Short: "shows whether a transfer is committed or pending in a global accountant contract",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			emitterChain, err := strconv.ParseUint(args[1], 10, 16)
+			if err != nil {
+				return err
+			}
+			emitterAddress, err := hex.DecodeString(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid emitter address: %w", err)
+			}
+			sequence, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccountantTransferStatus(context.Background(), &types.QueryAccountantTransferStatusRequest{
+				Contract: args[0],
+				Key: types.AccountantTransferKey{
+					EmitterChain:   uint32(emitterChain),
+					EmitterAddress: emitterAddress,
+					Sequence:       sequence,
+				},
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseAccountantAccountKey parses an account key given as decimal chain ids
+// and a hex encoded token address.
+func parseAccountantAccountKey(chainId string, tokenChain string, tokenAddress string) (types.AccountantAccountKey, error) {
+	chain, err := strconv.ParseUint(chainId, 10, 16)
+	if err != nil {
+		return types.AccountantAccountKey{}, err
+	}
+	token, err := strconv.ParseUint(tokenChain, 10, 16)
+	if err != nil {
+		return types.AccountantAccountKey{}, err
+	}
+	address, err := hex.DecodeString(tokenAddress)
+	if err != nil {
+		return types.AccountantAccountKey{}, fmt.Errorf("invalid token address: %w", err)
+	}
+
+	return types.AccountantAccountKey{
+		ChainId:      uint32(chain),
+		TokenChain:   uint32(token),
+		TokenAddress: address,
+	}, nil
+}
@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+func CmdShowConsensusGuardianSetValidators() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-consensus-guardian-set-validators",
+		Short: "shows the consensus guardian set with the validators registered by its guardians",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryConsensusGuardianSetValidatorsRequest{}
+
+			res, err := queryClient.ConsensusGuardianSetValidators(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+func CmdShowCoreContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-core-contract",
+		Short: "show the core contract whose published messages are reported to the message posted hooks",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryCoreContractRequest{}
+
+			res, err := queryClient.CoreContract(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
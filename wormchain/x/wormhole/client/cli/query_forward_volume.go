@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+func CmdListForwardVolume() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-forward-volume [channel-id]",
+		Short: "list the volume forwarded through wormchain, optionally over a single channel",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllForwardVolumeRequest{
+				Pagination: pageReq,
+			}
+			if len(args) > 0 {
+				params.ChannelId = args[0]
+			}
+
+			res, err := queryClient.ForwardVolumeAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
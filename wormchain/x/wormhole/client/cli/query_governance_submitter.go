@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+func CmdListGovernanceSubmitter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-governance-submitter",
+		Short: "list all accounts allowlisted to submit governance VAAs",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllGovernanceSubmitterRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.GovernanceSubmitterAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowGovernanceSubmitter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-governance-submitter [address]",
+		Short: "shows whether an account is allowlisted to submit governance VAAs",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetGovernanceSubmitterRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.GovernanceSubmitter(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
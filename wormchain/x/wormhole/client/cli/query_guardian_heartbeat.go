@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+func CmdListGuardianHeartbeat() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-guardian-heartbeat",
+		Short: "list the GuardianHeartbeat of all guardians that did not expire",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllGuardianHeartbeatRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.GuardianHeartbeatAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowGuardianHeartbeat() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-guardian-heartbeat [guardian-key-hex]",
+		Short: "shows the latest GuardianHeartbeat of a guardian",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			guardianKey, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid guardian key hex: %w", err)
+			}
+
+			params := &types.QueryGetGuardianHeartbeatRequest{
+				GuardianKey: guardianKey,
+			}
+
+			res, err := queryClient.GuardianHeartbeat(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
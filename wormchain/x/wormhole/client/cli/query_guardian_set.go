@@ -43,6 +43,39 @@ func CmdListGuardianSet() *cobra.Command {
 	return cmd
 }
 
+func CmdGuardianSetHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "guardian-set-history",
+		Short: "list all GuardianSet with their activation height and validity",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGuardianSetHistoryRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.GuardianSetHistory(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdShowGuardianSet() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "show-guardian-set [id]",
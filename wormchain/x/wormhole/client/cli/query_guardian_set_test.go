@@ -23,11 +23,17 @@ func networkWithGuardianSetObjects(t *testing.T, n int) (*network.Network, []typ
 	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
 
 	for i := 0; i < n; i++ {
-		state.GuardianSetList = append(state.GuardianSetList, types.GuardianSet{
+		guardianSet := types.GuardianSet{
 			Index: uint32(i),
 			Keys:  [][]byte{},
-		})
+		}
+		// every guardian set but the latest one has been replaced
+		if i < n-1 {
+			guardianSet.ExpirationTime = 1
+		}
+		state.GuardianSetList = append(state.GuardianSetList, guardianSet)
 	}
+	state.ConsensusGuardianSetIndex = &types.ConsensusGuardianSetIndex{Index: uint32(n - 1)}
 	buf, err := cfg.Codec.MarshalJSON(&state)
 	require.NoError(t, err)
 	cfg.GenesisState[types.ModuleName] = buf
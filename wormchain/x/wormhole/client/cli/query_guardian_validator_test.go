@@ -35,6 +35,15 @@ func networkWithGuardianValidatorObjects(t *testing.T, n int) (*network.Network,
 		}
 		state.GuardianValidatorList = append(state.GuardianValidatorList, guardianValidator)
 	}
+	// guardian validators have to belong to a known guardian. They are
+	// added to a pending guardian set so the empty consensus set keeps the
+	// network validator bonded.
+	guardianSet := types.GuardianSet{Index: 1}
+	for _, guardianValidator := range state.GuardianValidatorList {
+		guardianSet.Keys = append(guardianSet.Keys, guardianValidator.GuardianKey)
+	}
+	state.GuardianSetList = append(state.GuardianSetList, types.GuardianSet{Index: 0, Keys: [][]byte{}, ExpirationTime: 1}, guardianSet)
+	state.ConsensusGuardianSetIndex = &types.ConsensusGuardianSetIndex{Index: 0}
 	buf, err := cfg.Codec.MarshalJSON(&state)
 	require.NoError(t, err)
 	cfg.GenesisState[types.ModuleName] = buf
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+func CmdListIcaHostAllowlist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-ica-host-allowlist [connection-id]",
+		Short: "list the message types interchain accounts may execute, optionally of a single IBC connection",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllIcaHostAllowlistRequest{
+				Pagination: pageReq,
+			}
+			if len(args) > 0 {
+				params.ConnectionId = args[0]
+			}
+
+			res, err := queryClient.IcaHostAllowlistAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+func CmdListObservationTally() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-observation-tally",
+		Short: "list all ObservationTally",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllObservationTallyRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ObservationTallyAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowObservationTally() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-observation-tally [digest-hex]",
+		Short: "shows the ObservationTally of a VAA digest",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			digest, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid digest hex: %w", err)
+			}
+
+			params := &types.QueryGetObservationTallyRequest{
+				Digest: digest,
+			}
+
+			res, err := queryClient.ObservationTally(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowGuardianSetWeights() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-guardian-set-weights [guardian-set-index]",
+		Short: "shows the observation weights of the guardians of a guardian set",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			guardianSetIndex, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetGuardianSetWeightsRequest{
+				GuardianSetIndex: uint32(guardianSetIndex),
+			}
+
+			res, err := queryClient.GuardianSetWeights(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+func CmdListChainRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-chain-rate-limit",
+		Short: "list all ChainRateLimit",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllChainRateLimitRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ChainRateLimitAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowChainRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-chain-rate-limit [chain-id]",
+		Short: "shows the rate limit of an emitter chain and its current flow",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			chainId, err := strconv.ParseUint(args[0], 10, 16)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetChainRateLimitRequest{
+				ChainId: uint32(chainId),
+			}
+
+			res, err := queryClient.ChainRateLimit(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListQueuedObservation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-queued-observation",
+		Short: "list the observations queued by the emitter chain rate limits",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllQueuedObservationRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.QueuedObservationAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
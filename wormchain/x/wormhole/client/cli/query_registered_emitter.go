@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+func CmdListRegisteredEmitter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-registered-emitter",
+		Short: "list all RegisteredEmitter",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllRegisteredEmitterRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.RegisteredEmitterAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowRegisteredEmitter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-registered-emitter [module] [chain-id]",
+		Short: "shows the RegisteredEmitter of a module on a chain",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argModule := args[0]
+			argChainID, err := strconv.ParseUint(args[1], 10, 16)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetRegisteredEmitterRequest{
+				Module:  argModule,
+				ChainId: uint32(argChainID),
+			}
+
+			res, err := queryClient.RegisteredEmitter(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
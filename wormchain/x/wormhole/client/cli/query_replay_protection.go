@@ -72,3 +72,36 @@ func CmdShowReplayProtection() *cobra.Command {
 
 	return cmd
 }
+
+func CmdListExecutedGovernanceVAA() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-executed-governance-vaa",
+		Short: "list the digests of all executed governance VAAs",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllExecutedGovernanceVAARequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ExecutedGovernanceVAAAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
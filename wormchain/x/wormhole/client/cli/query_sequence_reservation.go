@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+func CmdShowNextSequence() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-next-sequence [emitter] [contract]",
+		Short: "show the next sequence of a hex encoded emitter of the wormhole module or, if given, of a core contract",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryNextSequenceRequest{
+				Emitter: args[0],
+			}
+			if len(args) > 1 {
+				params.Contract = args[1]
+			}
+
+			res, err := queryClient.NextSequence(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListSequenceReservation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-sequence-reservation [emitter]",
+		Short: "list the outstanding sequence reservations, optionally of a single hex encoded emitter",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllSequenceReservationRequest{
+				Pagination: pageReq,
+			}
+			if len(args) > 0 {
+				params.Emitter = args[0]
+			}
+
+			res, err := queryClient.SequenceReservationAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+const FLAG_SEQUENCE_START = "sequence-start"
+const FLAG_SEQUENCE_END = "sequence-end"
+
+func CmdListArchivedVAA() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-archived-vaa [emitter-chain] [emitter-address-hex]",
+		Short: "list archived VAAs, optionally of a single emitter chain or emitter",
+		Args:  cobra.RangeArgs(0, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryAllArchivedVAARequest{
+				Pagination: pageReq,
+			}
+			if len(args) > 0 {
+				emitterChain, err := strconv.ParseUint(args[0], 10, 16)
+				if err != nil {
+					return err
+				}
+				params.EmitterChain = uint32(emitterChain)
+			}
+			if len(args) > 1 {
+				emitterAddress, err := hex.DecodeString(args[1])
+				if err != nil {
+					return err
+				}
+				if len(emitterAddress) != 32 {
+					return fmt.Errorf("emitter address must be 32 bytes, got %d", len(emitterAddress))
+				}
+				params.EmitterAddress = emitterAddress
+			}
+			if params.SequenceStart, err = cmd.Flags().GetUint64(FLAG_SEQUENCE_START); err != nil {
+				return err
+			}
+			if params.SequenceEnd, err = cmd.Flags().GetUint64(FLAG_SEQUENCE_END); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ArchivedVAAAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FLAG_SEQUENCE_START, 0, "only list VAAs with at least this sequence, requires an emitter address")
+	cmd.Flags().Uint64(FLAG_SEQUENCE_END, 0, "only list VAAs with at most this sequence, requires an emitter address")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+func CmdVerifyVAA() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-vaa [vaa-hex]",
+		Short: "verifies the guardian signatures of a VAA and shows its body",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			vaaBz, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryVerifyVAARequest{
+				Vaa: vaaBz,
+			}
+
+			res, err := queryClient.VerifyVaa(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+func CmdShowWrappedAssetSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-wrapped-asset-supply [chain] [token-address]",
+		Short: "shows the denom, total supply and holders of the wrapped asset of a token, given its origin chain and hex encoded 32 byte address",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			chain, err := strconv.ParseUint(args[0], 10, 16)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryWrappedAssetSupplyRequest{
+				Chain:      uint32(chain),
+				Address:    args[1],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.WrappedAssetSupply(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -30,6 +30,13 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdAddWasmInstantiateAllowlist())
 	cmd.AddCommand(CmdDeleteWasmInstantiateAllowlist())
 	cmd.AddCommand(CmdExecuteGatewayGovernanceVaa())
+	cmd.AddCommand(CmdExecuteGovernanceVAABatch())
+	cmd.AddCommand(CmdSubmitObservation())
+	cmd.AddCommand(CmdGuardianHeartbeat())
+	cmd.AddCommand(CmdExecuteGatewayTransfer())
+	cmd.AddCommand(CmdExecuteGatewayTransferWithPayload())
+	cmd.AddCommand(CmdReserveSequenceBlock())
+	cmd.AddCommand(CmdBuildGovernance())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -12,25 +12,35 @@ import (
 
 var _ = strconv.Itoa(0)
 
+const FLAG_EXPIRATION_HEIGHT = "expiration-height"
+
 // StoreCodeCmd will upload code to be reused.
 func CmdCreateAllowedAddress() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "create-allowed-address [wormchain-address] [human-readable-name-of-key]",
 		Short:   "Allowlist an address to be able to submit tx to wormchain. Must be submitted by a validator account.",
 		Aliases: []string{"allowlist", "allow"},
-		Args:    cobra.ExactArgs(2),
+		Args:    cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 			address := args[0]
-			name := args[1]
+			var name string
+			if len(args) > 1 {
+				name = args[1]
+			}
+			expirationHeight, err := cmd.Flags().GetUint64(FLAG_EXPIRATION_HEIGHT)
+			if err != nil {
+				return err
+			}
 
 			msg := types.MsgCreateAllowlistEntryRequest{
-				Signer:  clientCtx.GetFromAddress().String(),
-				Address: address,
-				Name:    name,
+				Signer:           clientCtx.GetFromAddress().String(),
+				Address:          address,
+				Name:             name,
+				ExpirationHeight: expirationHeight,
 			}
 
 			if err = msg.ValidateBasic(); err != nil {
@@ -40,6 +50,7 @@ func CmdCreateAllowedAddress() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(FLAG_EXPIRATION_HEIGHT, 0, "block height at which the entry expires, 0 if it never expires")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
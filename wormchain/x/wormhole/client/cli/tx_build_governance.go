@@ -0,0 +1,677 @@
+package cli
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/holiman/uint256"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"golang.org/x/crypto/sha3"
+)
+
+const FlagDryRun = "dry-run"
+const FlagSignedBlocksWindow = "signed-blocks-window"
+const FlagMinSignedPerWindow = "min-signed-per-window"
+const FlagDowntimeJailDuration = "downtime-jail-duration"
+const FlagSlashFractionDoubleSign = "slash-fraction-double-sign"
+const FlagSlashFractionDowntime = "slash-fraction-downtime"
+const FlagUnbondingTime = "unbonding-time"
+const FlagMaxValidators = "max-validators"
+const FlagMaxEntries = "max-entries"
+const FlagBondDenom = "bond-denom"
+const FlagDisallow = "disallow"
+
+// CmdBuildGovernance groups the commands that build unsigned governance
+// messages. They print the hex encoded VAA payload, which still has to be
+// signed by the guardians before it can be executed.
+func CmdBuildGovernance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "build-governance",
+		Short:                      "Build unsigned governance VAA payloads",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdBuildGuardianSetUpdate())
+	cmd.AddCommand(CmdBuildSlashingParamsUpdate())
+	cmd.AddCommand(CmdBuildStakingParamsUpdate())
+	cmd.AddCommand(CmdBuildForwardFeeUpdate())
+	cmd.AddCommand(CmdBuildMaintenanceWindowUpdate())
+	cmd.AddCommand(CmdBuildValidatorTransitionWindowUpdate())
+	cmd.AddCommand(CmdBuildIcaHostAllowlistUpdate())
+	cmd.AddCommand(CmdBuildTokenFactoryAdminUpdate())
+	cmd.AddCommand(CmdBuildTokenFactoryMetadataUpdate())
+	cmd.AddCommand(CmdBuildSetCoreContract())
+	cmd.AddCommand(CmdBuildApprovedCodeHashUpdate())
+	cmd.AddCommand(CmdBuildStoreCode())
+	cmd.AddCommand(CmdBuildInstantiateContract())
+	cmd.AddCommand(CmdBuildMigrateContract())
+
+	return cmd
+}
+
+func CmdBuildGuardianSetUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "guardian-set-update [flags]",
+		Short: "Build a guardian set update governance message",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			keyStrings, err := cmd.Flags().GetStringArray(FlagGuardianSetKeys)
+			if err != nil {
+				return err
+			}
+
+			newIndex, err := cmd.Flags().GetUint32(FlagGuardianSetIndex)
+			if err != nil {
+				return err
+			}
+
+			keys := make([]ethcommon.Address, len(keyStrings))
+			for i, keyString := range keyStrings {
+				keyBytes, err := hex.DecodeString(keyString)
+				if err != nil {
+					return err
+				}
+				if len(keyBytes) != ethcommon.AddressLength {
+					return fmt.Errorf("guardian key %s should be %d bytes", keyString, ethcommon.AddressLength)
+				}
+				keys[i] = ethcommon.BytesToAddress(keyBytes)
+			}
+
+			payload, err := vaa.BodyGuardianSetUpdate{Keys: keys, NewIndex: newIndex}.Serialize()
+			if err != nil {
+				return err
+			}
+
+			return printGovernancePayload(cmd, payload, vaa.CoreModule, func(clientCtx client.Context, actionPayload []byte) error {
+				res, err := types.NewQueryClient(clientCtx).LatestGuardianSetIndex(context.Background(), &types.QueryLatestGuardianSetIndexRequest{})
+				if err != nil {
+					return err
+				}
+				if newIndex != res.LatestGuardianSetIndex+1 {
+					return fmt.Errorf("%w: latest guardian set is %d, new index is %d", types.ErrGuardianSetNotSequential, res.LatestGuardianSetIndex, newIndex)
+				}
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().StringArray(FlagGuardianSetKeys, []string{}, "list of guardian keys (hex encoded without 0x)")
+	cmd.Flags().Uint32(FlagGuardianSetIndex, 0, "index of the new guardian set")
+	cmd.MarkFlagRequired(FlagGuardianSetKeys)
+	cmd.MarkFlagRequired(FlagGuardianSetIndex)
+	addBuildGovernanceFlags(cmd)
+
+	return cmd
+}
+
+func CmdBuildSlashingParamsUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slashing-params [flags]",
+		Short: "Build a gateway slashing params update governance message",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			signedBlocksWindow, err := cmd.Flags().GetUint64(FlagSignedBlocksWindow)
+			if err != nil {
+				return err
+			}
+			minSignedPerWindow, err := getDecFlag(cmd, FlagMinSignedPerWindow)
+			if err != nil {
+				return err
+			}
+			downtimeJailDuration, err := cmd.Flags().GetDuration(FlagDowntimeJailDuration)
+			if err != nil {
+				return err
+			}
+			slashFractionDoubleSign, err := getDecFlag(cmd, FlagSlashFractionDoubleSign)
+			if err != nil {
+				return err
+			}
+			slashFractionDowntime, err := getDecFlag(cmd, FlagSlashFractionDowntime)
+			if err != nil {
+				return err
+			}
+
+			payload, err := vaa.BodyGatewaySlashingParamsUpdate{
+				SignedBlocksWindow:      signedBlocksWindow,
+				MinSignedPerWindow:      minSignedPerWindow,
+				DowntimeJailDuration:    uint64(downtimeJailDuration),
+				SlashFractionDoubleSign: slashFractionDoubleSign,
+				SlashFractionDowntime:   slashFractionDowntime,
+			}.Serialize()
+			if err != nil {
+				return err
+			}
+
+			return printGovernancePayload(cmd, payload, vaa.GatewayModule[:], func(_ client.Context, actionPayload []byte) error {
+				var body vaa.BodyGatewaySlashingParamsUpdate
+				return body.Deserialize(actionPayload)
+			})
+		},
+	}
+
+	cmd.Flags().Uint64(FlagSignedBlocksWindow, 0, "number of blocks in the liveness window")
+	cmd.Flags().String(FlagMinSignedPerWindow, "", "minimum fraction of the window a validator has to sign, e.g. 0.05")
+	cmd.Flags().Duration(FlagDowntimeJailDuration, 0, "how long a validator is jailed for downtime, e.g. 10m")
+	cmd.Flags().String(FlagSlashFractionDoubleSign, "", "fraction slashed for double signing, e.g. 0.05")
+	cmd.Flags().String(FlagSlashFractionDowntime, "", "fraction slashed for downtime, e.g. 0.0001")
+	cmd.MarkFlagRequired(FlagSignedBlocksWindow)
+	cmd.MarkFlagRequired(FlagMinSignedPerWindow)
+	cmd.MarkFlagRequired(FlagDowntimeJailDuration)
+	cmd.MarkFlagRequired(FlagSlashFractionDoubleSign)
+	cmd.MarkFlagRequired(FlagSlashFractionDowntime)
+	addBuildGovernanceFlags(cmd)
+
+	return cmd
+}
+
+func CmdBuildStakingParamsUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "staking-params [flags]",
+		Short: "Build a gateway staking params update governance message",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			unbondingTime, err := cmd.Flags().GetDuration(FlagUnbondingTime)
+			if err != nil {
+				return err
+			}
+			maxValidators, err := cmd.Flags().GetUint32(FlagMaxValidators)
+			if err != nil {
+				return err
+			}
+			maxEntries, err := cmd.Flags().GetUint32(FlagMaxEntries)
+			if err != nil {
+				return err
+			}
+			bondDenom, err := cmd.Flags().GetString(FlagBondDenom)
+			if err != nil {
+				return err
+			}
+
+			payload, err := vaa.BodyGatewayStakingParamsUpdate{
+				UnbondingTime: uint64(unbondingTime),
+				MaxValidators: maxValidators,
+				MaxEntries:    maxEntries,
+				BondDenom:     bondDenom,
+			}.Serialize()
+			if err != nil {
+				return err
+			}
+
+			return printGovernancePayload(cmd, payload, vaa.GatewayModule[:], func(_ client.Context, actionPayload []byte) error {
+				var body vaa.BodyGatewayStakingParamsUpdate
+				return body.Deserialize(actionPayload)
+			})
+		},
+	}
+
+	cmd.Flags().Duration(FlagUnbondingTime, 0, "how long unbonding takes, e.g. 504h")
+	cmd.Flags().Uint32(FlagMaxValidators, 0, "maximum number of bonded validators")
+	cmd.Flags().Uint32(FlagMaxEntries, 0, "maximum number of unbonding or redelegation entries per pair")
+	cmd.Flags().String(FlagBondDenom, "", "denom that is bonded by validators")
+	cmd.MarkFlagRequired(FlagUnbondingTime)
+	cmd.MarkFlagRequired(FlagMaxValidators)
+	cmd.MarkFlagRequired(FlagMaxEntries)
+	cmd.MarkFlagRequired(FlagBondDenom)
+	addBuildGovernanceFlags(cmd)
+
+	return cmd
+}
+
+func CmdBuildForwardFeeUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "forward-fee [fee-bps] [flags]",
+		Short: "Build a governance message setting the fee in basis points deducted from transfers forwarded through wormchain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			feeBps, err := strconv.ParseUint(args[0], 10, 16)
+			if err != nil {
+				return err
+			}
+			if feeBps > types.MaxForwardFeeBps {
+				return fmt.Errorf("forward fee of %d bps exceeds %d bps", feeBps, types.MaxForwardFeeBps)
+			}
+
+			payload, err := vaa.BodyWormchainForwardFeeUpdate{FeeBps: uint16(feeBps)}.Serialize()
+			if err != nil {
+				return err
+			}
+
+			return printGovernancePayload(cmd, payload, vaa.CoreModule, func(_ client.Context, actionPayload []byte) error {
+				var body vaa.BodyWormchainForwardFeeUpdate
+				return body.Deserialize(actionPayload)
+			})
+		},
+	}
+
+	addBuildGovernanceFlags(cmd)
+
+	return cmd
+}
+
+func CmdBuildMaintenanceWindowUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "maintenance-window [start-height] [end-height] [contract]... [flags]",
+		Short: "Build a governance message rejecting direct executions of contracts between two block heights, inclusive. Without contracts, it ends the maintenance window",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			startHeight, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			endHeight, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			var contracts []vaa.Address
+			for _, arg := range args[2:] {
+				contract, err := sdk.AccAddressFromBech32(arg)
+				if err != nil {
+					return fmt.Errorf("invalid contract address: %w", err)
+				}
+				if len(contract) != 32 {
+					return fmt.Errorf("invalid contract address %s: should be 32 bytes, is %d", arg, len(contract))
+				}
+				var address vaa.Address
+				copy(address[:], contract)
+				contracts = append(contracts, address)
+			}
+
+			payload, err := vaa.BodyWormchainMaintenanceWindowUpdate{
+				StartHeight: startHeight,
+				EndHeight:   endHeight,
+				Contracts:   contracts,
+			}.Serialize()
+			if err != nil {
+				return err
+			}
+
+			return printGovernancePayload(cmd, payload, vaa.CoreModule, func(_ client.Context, actionPayload []byte) error {
+				var body vaa.BodyWormchainMaintenanceWindowUpdate
+				return body.Deserialize(actionPayload)
+			})
+		},
+	}
+
+	addBuildGovernanceFlags(cmd)
+
+	return cmd
+}
+
+func CmdBuildValidatorTransitionWindowUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-transition-window [blocks] [flags]",
+		Short: "Build a governance message setting the number of blocks the validators of removed consensus guardians stay bonded. Zero unbonds them at the switch",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			blocks, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			payload, err := vaa.BodyWormchainValidatorTransitionWindowUpdate{TransitionBlocks: blocks}.Serialize()
+			if err != nil {
+				return err
+			}
+
+			return printGovernancePayload(cmd, payload, vaa.CoreModule, func(_ client.Context, actionPayload []byte) error {
+				var body vaa.BodyWormchainValidatorTransitionWindowUpdate
+				return body.Deserialize(actionPayload)
+			})
+		},
+	}
+
+	addBuildGovernanceFlags(cmd)
+
+	return cmd
+}
+
+func CmdBuildIcaHostAllowlistUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ica-host-allowlist [connection-id] [msg-type-url] [flags]",
+		Short: "Build a governance message allowing the interchain accounts of an IBC connection to execute a message type",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			disallow, err := cmd.Flags().GetBool(FlagDisallow)
+			if err != nil {
+				return err
+			}
+
+			entry := types.IcaHostAllowlistEntry{
+				ConnectionId: args[0],
+				MsgTypeUrl:   args[1],
+			}
+			if err := entry.Validate(); err != nil {
+				return err
+			}
+
+			payload, err := vaa.BodyGatewayIcaHostAllowlistUpdate{
+				Allowed:      !disallow,
+				ConnectionID: entry.ConnectionId,
+				MsgTypeURL:   entry.MsgTypeUrl,
+			}.Serialize()
+			if err != nil {
+				return err
+			}
+
+			return printGovernancePayload(cmd, payload, vaa.GatewayModule[:], func(_ client.Context, actionPayload []byte) error {
+				var body vaa.BodyGatewayIcaHostAllowlistUpdate
+				return body.Deserialize(actionPayload)
+			})
+		},
+	}
+
+	cmd.Flags().Bool(FlagDisallow, false, "remove the message type from the allowlist of the connection instead")
+	addBuildGovernanceFlags(cmd)
+
+	return cmd
+}
+
+func CmdBuildTokenFactoryAdminUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokenfactory-admin [denom] [new-admin] [flags]",
+		Short: "Build a governance message setting the admin of a wrapped asset tokenfactory denom",
+		Long:  "Build a governance message setting the admin of a wrapped asset tokenfactory denom. An empty new admin leaves the denom without an admin.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if args[1] != "" {
+				if _, err := sdk.AccAddressFromBech32(args[1]); err != nil {
+					return err
+				}
+			}
+
+			payload, err := vaa.BodyGatewayTokenFactoryAdminUpdate{
+				Denom:    args[0],
+				NewAdmin: args[1],
+			}.Serialize()
+			if err != nil {
+				return err
+			}
+
+			return printGovernancePayload(cmd, payload, vaa.GatewayModule[:], func(_ client.Context, actionPayload []byte) error {
+				var body vaa.BodyGatewayTokenFactoryAdminUpdate
+				return body.Deserialize(actionPayload)
+			})
+		},
+	}
+
+	addBuildGovernanceFlags(cmd)
+
+	return cmd
+}
+
+func CmdBuildTokenFactoryMetadataUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokenfactory-metadata [metadata-json-file] [flags]",
+		Short: "Build a governance message setting the bank metadata of a wrapped asset tokenfactory denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			metadataJson, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var metadata banktypes.Metadata
+			if err := types.ModuleCdc.UnmarshalJSON(metadataJson, &metadata); err != nil {
+				return err
+			}
+			if err := metadata.Validate(); err != nil {
+				return err
+			}
+			// Re-encode the metadata so the payload does not depend on the formatting of the file
+			metadataJson, err = types.ModuleCdc.MarshalJSON(&metadata)
+			if err != nil {
+				return err
+			}
+
+			payload, err := vaa.BodyGatewayTokenFactoryMetadataUpdate{Metadata: metadataJson}.Serialize()
+			if err != nil {
+				return err
+			}
+
+			return printGovernancePayload(cmd, payload, vaa.GatewayModule[:], func(_ client.Context, actionPayload []byte) error {
+				var body vaa.BodyGatewayTokenFactoryMetadataUpdate
+				return body.Deserialize(actionPayload)
+			})
+		},
+	}
+
+	addBuildGovernanceFlags(cmd)
+
+	return cmd
+}
+
+func CmdBuildSetCoreContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-core-contract [contract] [flags]",
+		Short: "Build a governance message setting the core contract whose published messages are reported to the message posted hooks",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			contract, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid contract address: %w", err)
+			}
+			if len(contract) != 32 {
+				return fmt.Errorf("invalid contract address %s: should be 32 bytes, is %d", args[0], len(contract))
+			}
+
+			var body vaa.BodyGatewayCoreContract
+			copy(body.ContractAddr[:], contract)
+			payload, err := body.Serialize()
+			if err != nil {
+				return err
+			}
+
+			return printGovernancePayload(cmd, payload, vaa.GatewayModule[:], func(_ client.Context, actionPayload []byte) error {
+				var body vaa.BodyGatewayCoreContract
+				return body.Deserialize(actionPayload)
+			})
+		},
+	}
+
+	addBuildGovernanceFlags(cmd)
+
+	return cmd
+}
+
+func CmdBuildApprovedCodeHashUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approved-code-hash-update [code-id] [code-hash] [flags]",
+		Short: "Build a governance message approving the hex encoded wasm code hash of a code id, or revoking its approval with a zero hash",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid code id: %w", err)
+			}
+			codeHash, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid code hash: %w", err)
+			}
+			if len(codeHash) != 32 {
+				return fmt.Errorf("invalid code hash %s: should be 32 bytes, is %d", args[1], len(codeHash))
+			}
+
+			body := vaa.BodyGatewayApprovedCodeHashUpdate{CodeID: codeID}
+			copy(body.CodeHash[:], codeHash)
+			payload, err := body.Serialize()
+			if err != nil {
+				return err
+			}
+
+			return printGovernancePayload(cmd, payload, vaa.GatewayModule[:], func(_ client.Context, actionPayload []byte) error {
+				var body vaa.BodyGatewayApprovedCodeHashUpdate
+				return body.Deserialize(actionPayload)
+			})
+		},
+	}
+
+	addBuildGovernanceFlags(cmd)
+
+	return cmd
+}
+
+func CmdBuildStoreCode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store-code [wasm file]",
+		Short: "Build a governance message approving the upload of a wasm binary",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			wasm, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var wasmHash [32]byte
+			keccak := sha3.NewLegacyKeccak256()
+			keccak.Write(wasm)
+			keccak.Sum(wasmHash[:0])
+
+			payload, err := vaa.BodyWormchainStoreCode{WasmHash: wasmHash}.Serialize()
+			if err != nil {
+				return err
+			}
+
+			return printGovernancePayload(cmd, payload, vaa.WasmdModule[:], func(client.Context, []byte) error {
+				return nil
+			})
+		},
+	}
+
+	addBuildGovernanceFlags(cmd)
+
+	return cmd
+}
+
+func CmdBuildInstantiateContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "instantiate-contract [label] [code-id] [json-encoded-init-args]",
+		Short: "Build a governance message approving the instantiation of a wasm contract",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			codeID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			hash := vaa.CreateInstatiateCosmwasmContractHash(codeID, args[0], []byte(args[2]))
+			payload, err := vaa.BodyWormchainInstantiateContract{InstantiationParamsHash: hash}.Serialize()
+			if err != nil {
+				return err
+			}
+
+			return printGovernancePayload(cmd, payload, vaa.WasmdModule[:], func(client.Context, []byte) error {
+				return nil
+			})
+		},
+	}
+
+	addBuildGovernanceFlags(cmd)
+
+	return cmd
+}
+
+func CmdBuildMigrateContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-contract [contract] [code-id] [json-encoded-migrate-args]",
+		Short: "Build a governance message approving the migration of a wasm contract",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return fmt.Errorf("invalid contract address: %w", err)
+			}
+			codeID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			hash := vaa.CreateMigrateCosmwasmContractHash(codeID, args[0], []byte(args[2]))
+			payload, err := vaa.BodyWormchainMigrateContract{MigrationParamsHash: hash}.Serialize()
+			if err != nil {
+				return err
+			}
+
+			return printGovernancePayload(cmd, payload, vaa.WasmdModule[:], func(client.Context, []byte) error {
+				return nil
+			})
+		},
+	}
+
+	addBuildGovernanceFlags(cmd)
+
+	return cmd
+}
+
+func addBuildGovernanceFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(FlagDryRun, false, "check the message against the governance config of the node before printing it")
+	flags.AddQueryFlagsToCmd(cmd)
+}
+
+// getDecFlag parses a decimal flag into the raw Dec mantissa used by
+// governance payloads.
+func getDecFlag(cmd *cobra.Command, flag string) (*uint256.Int, error) {
+	str, err := cmd.Flags().GetString(flag)
+	if err != nil {
+		return nil, err
+	}
+	dec, err := sdk.NewDecFromStr(str)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s: %w", flag, err)
+	}
+	if dec.IsNegative() {
+		return nil, fmt.Errorf("invalid --%s: %s is out of range", flag, str)
+	}
+	mantissa, overflow := uint256.FromBig(dec.BigInt())
+	if overflow {
+		return nil, fmt.Errorf("invalid --%s: %s is out of range", flag, str)
+	}
+	return mantissa, nil
+}
+
+// printGovernancePayload prints the hex encoded governance payload. With
+// --dry-run it first runs the governance header checks of the chain against
+// the config of the node, followed by the action specific check.
+func printGovernancePayload(cmd *cobra.Command, payload []byte, module []byte, check func(client.Context, []byte) error) error {
+	dryRun, err := cmd.Flags().GetBool(FlagDryRun)
+	if err != nil {
+		return err
+	}
+
+	if dryRun {
+		clientCtx, err := client.GetClientQueryContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		res, err := types.NewQueryClient(clientCtx).Config(context.Background(), &types.QueryGetConfigRequest{})
+		if err != nil {
+			return err
+		}
+
+		var expectedModule [32]byte
+		copy(expectedModule[:], module)
+		_, actionPayload, err := types.ParseGovernancePayload(payload, expectedModule, uint16(res.Config.ChainId))
+		if err != nil {
+			return err
+		}
+		if err := check(clientCtx, actionPayload); err != nil {
+			return err
+		}
+	}
+
+	_, err = fmt.Fprintln(cmd.OutOrStdout(), hex.EncodeToString(payload))
+	return err
+}
@@ -0,0 +1,108 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+const FlagNonce = "nonce"
+
+// CmdExecuteGatewayTransfer sends tokens through the gateway to another chain.
+func CmdExecuteGatewayTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gateway-transfer [amount] [recipient-chain] [recipient-hex] [fee]",
+		Short: "Transfer tokenfactory tokens through the Wormhole Gateway to another chain",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, recipientChain, recipient, err := parseGatewayTransferArgs(args[0], args[1], args[2])
+			if err != nil {
+				return err
+			}
+			fee, ok := sdk.NewIntFromString(args[3])
+			if !ok {
+				return fmt.Errorf("invalid fee %q", args[3])
+			}
+			nonce, err := cmd.Flags().GetUint32(FlagNonce)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExecuteGatewayTransfer(clientCtx.GetFromAddress().String(), amount, recipientChain, recipient, fee, nonce)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint32(FlagNonce, 0, "nonce of the wormhole message")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdExecuteGatewayTransferWithPayload sends tokens and a payload through the gateway to a contract on another chain.
+func CmdExecuteGatewayTransferWithPayload() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gateway-transfer-with-payload [amount] [recipient-chain] [contract-hex] [payload-hex]",
+		Short: "Transfer tokenfactory tokens and a payload through the Wormhole Gateway to a contract on another chain",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, recipientChain, contract, err := parseGatewayTransferArgs(args[0], args[1], args[2])
+			if err != nil {
+				return err
+			}
+			payload, err := hex.DecodeString(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid payload: %w", err)
+			}
+			nonce, err := cmd.Flags().GetUint32(FlagNonce)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExecuteGatewayTransferWithPayload(clientCtx.GetFromAddress().String(), amount, recipientChain, contract, payload, nonce)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint32(FlagNonce, 0, "nonce of the wormhole message")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseGatewayTransferArgs(amountArg string, chainArg string, recipientArg string) (sdk.Coin, uint32, []byte, error) {
+	amount, err := sdk.ParseCoinNormalized(amountArg)
+	if err != nil {
+		return sdk.Coin{}, 0, nil, fmt.Errorf("invalid amount: %w", err)
+	}
+	recipientChain, err := strconv.ParseUint(chainArg, 10, 16)
+	if err != nil {
+		return sdk.Coin{}, 0, nil, fmt.Errorf("invalid recipient chain: %w", err)
+	}
+	recipient, err := hex.DecodeString(recipientArg)
+	if err != nil {
+		return sdk.Coin{}, 0, nil, fmt.Errorf("invalid recipient: %w", err)
+	}
+	return amount, uint32(recipientChain), recipient, nil
+}
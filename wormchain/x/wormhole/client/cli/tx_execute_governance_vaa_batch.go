@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+// CmdExecuteGovernanceVAABatch will submit several governance VAAs to be executed atomically.
+func CmdExecuteGovernanceVAABatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execute-governance-vaa-batch [vaa] [vaa]...",
+		Short: "Broadcast message ExecuteGovernanceVAABatch",
+		Long:  "Execute the provided governance VAAs in order. If any of them fails, none of them take effect.",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			vaas := make([][]byte, 0, len(args))
+			for i, argVaa := range args {
+				vaaBytes, err := hex.DecodeString(argVaa)
+				if err != nil {
+					return fmt.Errorf("invalid vaa hex at position %d: %w", i, err)
+				}
+				vaas = append(vaas, vaaBytes)
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExecuteGovernanceVAABatch(
+				vaas,
+				clientCtx.GetFromAddress().String(),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+const FlagHeights = "heights"
+const FlagFeatures = "features"
+
+func CmdGuardianHeartbeat() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "guardian-heartbeat [node-version]",
+		Short: "Broadcast message GuardianHeartbeat",
+		Long:  "Records the status of the node of a guardian. Must be signed by the validator of a guardian in the current or a future guardian set.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			heightsArg, err := cmd.Flags().GetStringSlice(FlagHeights)
+			if err != nil {
+				return err
+			}
+			heights, err := parseChainHeights(heightsArg)
+			if err != nil {
+				return err
+			}
+			features, err := cmd.Flags().GetStringSlice(FlagFeatures)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGuardianHeartbeat(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				heights,
+				features,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagHeights, nil, "latest observed block heights as chain:height pairs")
+	cmd.Flags().StringSlice(FlagFeatures, nil, "feature flags enabled on the node")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseChainHeights(args []string) ([]types.ChainHeight, error) {
+	heights := make([]types.ChainHeight, 0, len(args))
+	for _, arg := range args {
+		parts := strings.Split(arg, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid chain height %q, expected chain:height", arg)
+		}
+		chainId, err := strconv.ParseUint(parts[0], 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid chain id %q: %w", parts[0], err)
+		}
+		height, err := strconv.ParseUint(parts[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid height %q: %w", parts[1], err)
+		}
+		heights = append(heights, types.ChainHeight{ChainId: uint32(chainId), Height: height})
+	}
+	return heights, nil
+}
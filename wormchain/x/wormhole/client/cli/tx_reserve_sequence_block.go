@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+func CmdReserveSequenceBlock() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reserve-sequence-block [count]",
+		Short: "Broadcast message ReserveSequenceBlock",
+		Long:  "Pre-allocates a contiguous range of sequences for the emitter of the signer, which is the signer padded to 32 bytes.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			count, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgReserveSequenceBlock(
+				clientCtx.GetFromAddress().String(),
+				count,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,252 @@
+package wormhole
+
+import (
+	"context"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+	packetforwardtypes "github.com/strangelove-ventures/packet-forward-middleware/v4/router/types"
+
+	"github.com/wormhole-foundation/wormchain/x/wormhole/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+// TransferKeeper defines the transfer keeper the packet forward middleware
+// forwards transfers with.
+type TransferKeeper interface {
+	Transfer(goCtx context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error)
+	DenomPathFromHash(ctx sdk.Context, denom string) (string, error)
+}
+
+// BankKeeper defines the bank keeper used to escrow, collect and refund the
+// forward fees.
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+}
+
+// ForwardFeeTransferKeeper wraps the transfer keeper of the packet forward
+// middleware. It deducts the forward fee from every forwarded transfer and
+// holds it in escrow until the forwarded packet is acknowledged, see
+// ForwardFeeModule.
+type ForwardFeeTransferKeeper struct {
+	TransferKeeper
+	keeper     keeper.Keeper
+	bankKeeper BankKeeper
+}
+
+// NewForwardFeeTransferKeeper creates a new ForwardFeeTransferKeeper given
+// the transfer keeper it wraps.
+func NewForwardFeeTransferKeeper(
+	transferKeeper TransferKeeper,
+	keeper keeper.Keeper,
+	bankKeeper BankKeeper,
+) ForwardFeeTransferKeeper {
+	return ForwardFeeTransferKeeper{
+		TransferKeeper: transferKeeper,
+		keeper:         keeper,
+		bankKeeper:     bankKeeper,
+	}
+}
+
+// Transfer deducts the forward fee from the transferred token, sends the rest
+// with the wrapped transfer keeper and moves the fee to the forward fee
+// escrow. The fee is paid by the sender, which is the account the packet
+// forward middleware received the transfer on. Transfers the packet forward
+// middleware retries after a timeout already paid the fee and are sent as is.
+func (k ForwardFeeTransferKeeper) Transfer(goCtx context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if retry, found := k.keeper.GetForwardFeeMarker(ctx, types.ForwardFeeRetryKey); found {
+		k.keeper.RemoveForwardFeeMarker(ctx, types.ForwardFeeRetryKey)
+		res, err := k.TransferKeeper.Transfer(goCtx, msg)
+		if err != nil {
+			return nil, err
+		}
+		retry.PortId = msg.SourcePort
+		retry.ChannelId = msg.SourceChannel
+		retry.Sequence = res.Sequence
+		k.keeper.SetPendingForwardFee(ctx, retry)
+		return res, nil
+	}
+
+	fee := k.keeper.ForwardFee(ctx, msg.Token.Amount)
+	forwarded := *msg
+	forwarded.Token = sdk.NewCoin(msg.Token.Denom, msg.Token.Amount.Sub(fee))
+	res, err := k.TransferKeeper.Transfer(goCtx, &forwarded)
+	if err != nil {
+		return nil, err
+	}
+
+	if fee.IsPositive() {
+		sender, err := sdk.AccAddressFromBech32(msg.Sender)
+		if err != nil {
+			return nil, err
+		}
+		feeCoins := sdk.NewCoins(sdk.NewCoin(msg.Token.Denom, fee))
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ForwardFeeEscrowName, feeCoins); err != nil {
+			return nil, err
+		}
+	}
+
+	// The packet forward middleware only forwards while ForwardFeeModule
+	// receives a packet. Without the marker the channel to refund the source
+	// chain over is unknown, so the fee is kept when the forward fails.
+	pending, found := k.keeper.GetForwardFeeMarker(ctx, types.ForwardFeeReceiveKey)
+	if !found {
+		pending.Nonrefundable = true
+	}
+	if nonrefundable, ok := ctx.Context().Value(packetforwardtypes.NonrefundableKey{}).(bool); ok && nonrefundable {
+		pending.Nonrefundable = true
+	}
+	pending.PortId = msg.SourcePort
+	pending.ChannelId = msg.SourceChannel
+	pending.Sequence = res.Sequence
+	pending.Denom = msg.Token.Denom
+	pending.Amount = forwarded.Token.Amount
+	pending.Fee = fee
+	k.keeper.SetPendingForwardFee(ctx, pending)
+
+	return res, nil
+}
+
+// settleForwardFee settles the escrowed fee of a forwarded packet. If the
+// forward succeeded the fee is paid to the forward fee collector and the
+// transfer is added to the forwarded volume. Otherwise the fee is refunded
+// along with the forwarded amount: the source chain refunds the full
+// transferred amount, so the fee is burned if it is a voucher of the source
+// chain, or returned to the escrow of the channel it was received on.
+func (k ForwardFeeTransferKeeper) settleForwardFee(ctx sdk.Context, pending types.PendingForwardFee, success bool) error {
+	feeCoins := sdk.NewCoins(sdk.NewCoin(pending.Denom, pending.Fee))
+
+	if success || pending.Nonrefundable {
+		if !feeCoins.IsZero() {
+			if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ForwardFeeEscrowName, types.ForwardFeeCollectorName, feeCoins); err != nil {
+				return err
+			}
+		}
+		k.keeper.RecordForward(ctx, pending.ChannelId, pending.Denom, pending.Amount, pending.Fee)
+		return nil
+	}
+
+	if feeCoins.IsZero() {
+		return nil
+	}
+
+	fullDenomPath := pending.Denom
+	if strings.HasPrefix(pending.Denom, "ibc/") {
+		var err error
+		fullDenomPath, err = k.DenomPathFromHash(ctx, pending.Denom)
+		if err != nil {
+			return err
+		}
+	}
+
+	if transfertypes.SenderChainIsSource(pending.RefundPortId, pending.RefundChannelId, fullDenomPath) {
+		escrowAddress := transfertypes.GetEscrowAddress(pending.RefundPortId, pending.RefundChannelId)
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ForwardFeeEscrowName, escrowAddress, feeCoins)
+	}
+	return k.bankKeeper.BurnCoins(ctx, types.ForwardFeeEscrowName, feeCoins)
+}
+
+var _ porttypes.IBCModule = ForwardFeeModule{}
+
+// ForwardFeeModule wraps the packet forward middleware and settles the forward
+// fees ForwardFeeTransferKeeper escrowed once the forwarded packets are
+// acknowledged or time out. All other callbacks are passed through to the
+// packet forward middleware.
+type ForwardFeeModule struct {
+	porttypes.IBCModule
+	transferKeeper ForwardFeeTransferKeeper
+}
+
+// NewForwardFeeModule creates a new ForwardFeeModule given the packet forward
+// middleware it wraps and the transfer keeper the middleware forwards with.
+func NewForwardFeeModule(app porttypes.IBCModule, transferKeeper ForwardFeeTransferKeeper) ForwardFeeModule {
+	return ForwardFeeModule{
+		IBCModule:      app,
+		transferKeeper: transferKeeper,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface. It records the port and
+// channel the packet was received on while the packet forward middleware
+// forwards it, so that a failed forward can refund the fee over them.
+func (im ForwardFeeModule) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	k := im.transferKeeper.keeper
+	k.SetForwardFeeMarker(ctx, types.ForwardFeeReceiveKey, types.PendingForwardFee{
+		Amount:          sdk.ZeroInt(),
+		Fee:             sdk.ZeroInt(),
+		RefundPortId:    packet.DestinationPort,
+		RefundChannelId: packet.DestinationChannel,
+	})
+	defer k.RemoveForwardFeeMarker(ctx, types.ForwardFeeReceiveKey)
+
+	return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. The escrowed fee
+// of a forwarded packet is collected if the packet was acknowledged
+// successfully and refunded otherwise.
+func (im ForwardFeeModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	k := im.transferKeeper.keeper
+	pending, found := k.GetPendingForwardFee(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
+	if err := im.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+	if !found {
+		return nil
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return err
+	}
+	k.RemovePendingForwardFee(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	return im.transferKeeper.settleForwardFee(ctx, pending, ack.Success())
+}
+
+// OnTimeoutPacket implements the IBCModule interface. If the packet forward
+// middleware retries a forwarded packet, the escrowed fee moves to the retried
+// packet without charging the fee again. Otherwise the forward failed and the
+// fee is refunded.
+func (im ForwardFeeModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	k := im.transferKeeper.keeper
+	pending, found := k.GetPendingForwardFee(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return im.IBCModule.OnTimeoutPacket(ctx, packet, relayer)
+	}
+
+	k.RemovePendingForwardFee(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	k.SetForwardFeeMarker(ctx, types.ForwardFeeRetryKey, pending)
+	if err := im.IBCModule.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	// The transfer keeper removes the marker when the packet is retried
+	if _, found := k.GetForwardFeeMarker(ctx, types.ForwardFeeRetryKey); !found {
+		return nil
+	}
+	k.RemoveForwardFeeMarker(ctx, types.ForwardFeeRetryKey)
+	return im.transferKeeper.settleForwardFee(ctx, pending, false)
+}
@@ -0,0 +1,241 @@
+package wormhole_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/wormhole-foundation/wormchain/testutil/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+// mockTransferKeeper records the transfers it is asked to send
+type mockTransferKeeper struct {
+	wormhole.TransferKeeper
+	transfers []transfertypes.MsgTransfer
+	denomPath string
+	err       error
+}
+
+func (m *mockTransferKeeper) Transfer(goCtx context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	m.transfers = append(m.transfers, *msg)
+	return &transfertypes.MsgTransferResponse{Sequence: uint64(len(m.transfers))}, nil
+}
+
+func (m *mockTransferKeeper) DenomPathFromHash(ctx sdk.Context, denom string) (string, error) {
+	return m.denomPath, nil
+}
+
+// mockBankKeeper records the balances of module accounts and accounts and the
+// coins burned
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+	burned   sdk.Coins
+}
+
+func (m *mockBankKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	m.balances[recipientModule] = m.balances[recipientModule].Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	m.balances[senderModule] = m.balances[senderModule].Sub(amt)
+	m.balances[recipientModule] = m.balances[recipientModule].Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	m.balances[senderModule] = m.balances[senderModule].Sub(amt)
+	m.balances[recipientAddr.String()] = m.balances[recipientAddr.String()].Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+	m.balances[moduleName] = m.balances[moduleName].Sub(amt)
+	m.burned = m.burned.Add(amt...)
+	return nil
+}
+
+// mockForwardMiddleware forwards the token of every packet it receives with
+// the transfer keeper, and resends the forwarded token when a packet times out
+// if retry is set
+type mockForwardMiddleware struct {
+	porttypes.IBCModule
+	transferKeeper wormhole.ForwardFeeTransferKeeper
+	token          sdk.Coin
+	retry          bool
+}
+
+func (m *mockForwardMiddleware) transfer(ctx sdk.Context, token sdk.Coin) error {
+	_, err := m.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), &transfertypes.MsgTransfer{
+		SourcePort:    "transfer",
+		SourceChannel: "channel-0",
+		Token:         token,
+		Sender:        sdk.AccAddress(make([]byte, 20)).String(),
+		Receiver:      "osmo1receiver",
+	})
+	return err
+}
+
+func (m *mockForwardMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	if err := m.transfer(ctx, m.token); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	return channeltypes.NewResultAcknowledgement([]byte{1})
+}
+
+func (m *mockForwardMiddleware) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+	return nil
+}
+
+func (m *mockForwardMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	if !m.retry {
+		return nil
+	}
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return err
+	}
+	amount, _ := sdk.NewIntFromString(data.Amount)
+	return m.transfer(ctx, sdk.NewCoin(m.token.Denom, amount))
+}
+
+func TestForwardFeeTransferKeeper(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	transferKeeper := &mockTransferKeeper{}
+	bankKeeper := &mockBankKeeper{balances: map[string]sdk.Coins{}}
+	forwardKeeper := wormhole.NewForwardFeeTransferKeeper(transferKeeper, *k, bankKeeper)
+
+	transfer := func(amount int64) error {
+		_, err := forwardKeeper.Transfer(sdk.WrapSDKContext(ctx), &transfertypes.MsgTransfer{
+			SourcePort:    "transfer",
+			SourceChannel: "channel-0",
+			Token:         sdk.NewInt64Coin("uworm", amount),
+			Sender:        sdk.AccAddress(make([]byte, 20)).String(),
+			Receiver:      "osmo1receiver",
+		})
+		return err
+	}
+
+	// No fee is charged by default
+	require.NoError(t, transfer(1000))
+	require.Equal(t, sdk.NewInt64Coin("uworm", 1000), transferKeeper.transfers[0].Token)
+	require.Empty(t, bankKeeper.balances)
+
+	// The fee is held in escrow until the packet is acknowledged
+	k.SetParams(ctx, types.Params{ForwardFeeBps: 100})
+	require.NoError(t, transfer(1000))
+	require.Equal(t, sdk.NewInt64Coin("uworm", 990), transferKeeper.transfers[1].Token)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uworm", 10)), bankKeeper.balances[types.ForwardFeeEscrowName])
+	require.Empty(t, bankKeeper.balances[types.ForwardFeeCollectorName])
+
+	pending, found := k.GetPendingForwardFee(ctx, "transfer", "channel-0", 2)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(990), pending.Amount)
+	require.Equal(t, sdk.NewInt(10), pending.Fee)
+
+	// Failed transfers are not charged
+	transferKeeper.err = errors.New("transfer failed")
+	require.Error(t, transfer(1000))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uworm", 10)), bankKeeper.balances[types.ForwardFeeEscrowName])
+	require.Len(t, k.GetAllPendingForwardFee(ctx), 2)
+
+	// Nothing is recorded before the packets are acknowledged
+	_, found = k.GetForwardVolume(ctx, "channel-0", "uworm")
+	require.False(t, found)
+}
+
+func TestForwardFeeModule(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	k.SetParams(ctx, types.Params{ForwardFeeBps: 100})
+	transferKeeper := &mockTransferKeeper{}
+	bankKeeper := &mockBankKeeper{balances: map[string]sdk.Coins{}}
+	forwardKeeper := wormhole.NewForwardFeeTransferKeeper(transferKeeper, *k, bankKeeper)
+	middleware := &mockForwardMiddleware{transferKeeper: forwardKeeper, token: sdk.NewInt64Coin("uworm", 1000)}
+	module := wormhole.NewForwardFeeModule(middleware, forwardKeeper)
+
+	// The packet is received from the source chain on channel-1 and forwarded
+	// over channel-0
+	receive := func() {
+		ack := module.OnRecvPacket(ctx, channeltypes.Packet{DestinationPort: "transfer", DestinationChannel: "channel-1"}, nil)
+		require.True(t, ack.Success())
+		_, found := k.GetForwardFeeMarker(ctx, types.ForwardFeeReceiveKey)
+		require.False(t, found)
+	}
+	forwarded := func(sequence uint64) channeltypes.Packet {
+		data := transfertypes.NewFungibleTokenPacketData("uworm", "990", "sender", "osmo1receiver")
+		return channeltypes.Packet{SourcePort: "transfer", SourceChannel: "channel-0", Sequence: sequence, Data: data.GetBytes()}
+	}
+	successAck := channeltypes.NewResultAcknowledgement([]byte{1}).Acknowledgement()
+	errorAck := channeltypes.NewErrorAcknowledgement(errors.New("forward failed")).Acknowledgement()
+	refundEscrow := transfertypes.GetEscrowAddress("transfer", "channel-1").String()
+	escrowed := func() sdk.Coins { return bankKeeper.balances[types.ForwardFeeEscrowName] }
+
+	// The fee is collected when the forward succeeds
+	receive()
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uworm", 10)), escrowed())
+	pending, found := k.GetPendingForwardFee(ctx, "transfer", "channel-0", 1)
+	require.True(t, found)
+	require.Equal(t, "channel-1", pending.RefundChannelId)
+	require.NoError(t, module.OnAcknowledgementPacket(ctx, forwarded(1), successAck, nil))
+	require.True(t, escrowed().IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uworm", 10)), bankKeeper.balances[types.ForwardFeeCollectorName])
+	_, found = k.GetPendingForwardFee(ctx, "transfer", "channel-0", 1)
+	require.False(t, found)
+	volume, found := k.GetForwardVolume(ctx, "channel-0", "uworm")
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(990), volume.Amount)
+	require.Equal(t, sdk.NewInt(10), volume.Fees)
+
+	// The fee of a native denom is returned to the escrow of the channel it
+	// was received on when the forward fails
+	receive()
+	require.NoError(t, module.OnAcknowledgementPacket(ctx, forwarded(2), errorAck, nil))
+	require.True(t, escrowed().IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uworm", 10)), bankKeeper.balances[refundEscrow])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uworm", 10)), bankKeeper.balances[types.ForwardFeeCollectorName])
+
+	// Retried packets are not charged again, the fee moves to the retried
+	// packet and is refunded when the forward times out for good
+	receive()
+	middleware.retry = true
+	require.NoError(t, module.OnTimeoutPacket(ctx, forwarded(3), nil))
+	require.Len(t, transferKeeper.transfers, 4)
+	require.Equal(t, sdk.NewInt64Coin("uworm", 990), transferKeeper.transfers[3].Token)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uworm", 10)), escrowed())
+	_, found = k.GetPendingForwardFee(ctx, "transfer", "channel-0", 3)
+	require.False(t, found)
+	_, found = k.GetPendingForwardFee(ctx, "transfer", "channel-0", 4)
+	require.True(t, found)
+
+	middleware.retry = false
+	require.NoError(t, module.OnTimeoutPacket(ctx, forwarded(4), nil))
+	require.True(t, escrowed().IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uworm", 20)), bankKeeper.balances[refundEscrow])
+	require.Empty(t, k.GetAllPendingForwardFee(ctx))
+
+	// The fee of a voucher of the source chain is burned when the forward
+	// fails
+	voucher := transfertypes.ParseDenomTrace("transfer/channel-1/uosmo").IBCDenom()
+	transferKeeper.denomPath = "transfer/channel-1/uosmo"
+	middleware.token = sdk.NewInt64Coin(voucher, 1000)
+	receive()
+	require.NoError(t, module.OnAcknowledgementPacket(ctx, forwarded(5), errorAck, nil))
+	require.True(t, escrowed().IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(voucher, 10)), bankKeeper.burned)
+
+	// Only the successful forward was recorded
+	volume, _ = k.GetForwardVolume(ctx, "channel-0", "uworm")
+	require.Equal(t, uint64(1), volume.Transfers)
+}
@@ -11,11 +11,20 @@ import (
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	// Set all the guardianSet
 	for _, elem := range genState.GuardianSetList {
+		// Older guardian sets may have been pruned before the export
+		if k.GetGuardianSetCount(ctx) < elem.Index {
+			k.SetGuardianSetCount(ctx, elem.Index)
+		}
 		if _, err := k.AppendGuardianSet(ctx, elem); err != nil {
 			panic(err)
 		}
 	}
 
+	// Restore the heights the guardian sets were originally added at
+	for _, elem := range genState.GuardianSetActivationHeightList {
+		k.SetGuardianSetActivationHeight(ctx, elem.Index, elem.Height)
+	}
+
 	// Set if defined
 	if genState.Config != nil {
 		k.SetConfig(ctx, *genState.Config)
@@ -24,6 +33,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.ReplayProtectionList {
 		k.SetReplayProtection(ctx, elem)
 	}
+	// Set all the executedGovernanceVAA
+	for _, elem := range genState.ExecutedGovernanceVaaList {
+		k.SetExecutedGovernanceVAA(ctx, elem)
+	}
 	// Set all the sequenceCounter
 	for _, elem := range genState.SequenceCounterList {
 		k.SetSequenceCounter(ctx, elem)
@@ -31,6 +44,17 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	// Set if defined
 	if genState.ConsensusGuardianSetIndex != nil {
 		k.SetConsensusGuardianSetIndex(ctx, *genState.ConsensusGuardianSetIndex)
+		// The validators are always reconciled with the exported consensus
+		// guardian set
+		k.SetBondedGuardianSetIndex(ctx, genState.ConsensusGuardianSetIndex.Index)
+	}
+	// Set if defined
+	if genState.Params != nil {
+		k.SetParams(ctx, *genState.Params)
+	}
+	// Set if defined
+	if genState.GuardianSetActivation != nil {
+		k.SetGuardianSetActivation(ctx, *genState.GuardianSetActivation)
 	}
 	// Set all the guardianValidator
 	for _, elem := range genState.GuardianValidatorList {
@@ -44,6 +68,78 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetWasmInstantiateAllowlist(ctx, elem)
 	}
 	k.StoreIbcComposabilityMwContract(ctx, genState.IbcComposabilityMwContract)
+	k.StoreCoreContract(ctx, genState.CoreContract)
+	// Set all the approved wasm code hashes
+	for _, elem := range genState.ApprovedCodeHashList {
+		k.SetApprovedCodeHash(ctx, elem)
+	}
+	// Set all the registeredEmitter
+	for _, elem := range genState.RegisteredEmitterList {
+		k.SetRegisteredEmitter(ctx, elem)
+	}
+	// Set all the governanceSubmitter
+	for _, elem := range genState.GovernanceSubmitterList {
+		k.SetGovernanceSubmitter(ctx, elem)
+	}
+	// Set all the guardianSetWeights
+	for _, elem := range genState.GuardianSetWeightsList {
+		k.SetGuardianSetWeights(ctx, elem)
+	}
+	// Set all the observationTally
+	for _, elem := range genState.ObservationTallyList {
+		k.SetObservationTally(ctx, elem)
+	}
+	// Set all the finalizedObservation
+	for _, elem := range genState.FinalizedObservationList {
+		k.SetFinalizedObservation(ctx, elem)
+	}
+	// Set all the archivedVAA, this rebuilds the height index used for pruning
+	for _, elem := range genState.ArchivedVaaList {
+		k.SetArchivedVAA(ctx, elem)
+	}
+	k.SetBridgePaused(ctx, genState.BridgePaused)
+	// Set all the chainRateLimit
+	for _, elem := range genState.ChainRateLimitList {
+		k.SetChainRateLimit(ctx, elem)
+	}
+	// Set all the rateLimitFlow
+	for _, elem := range genState.RateLimitFlowList {
+		k.SetRateLimitFlow(ctx, elem)
+	}
+	// Set all the queuedObservation
+	for _, elem := range genState.QueuedObservationList {
+		k.SetQueuedObservation(ctx, elem)
+	}
+	// Set all the msgShutdown
+	for _, elem := range genState.MsgShutdownList {
+		k.SetMsgShutdown(ctx, elem, true)
+	}
+	// Set all the guardianHeartbeat
+	for _, elem := range genState.GuardianHeartbeatList {
+		k.SetGuardianHeartbeat(ctx, elem)
+	}
+	// Set all the icaHostAllowlist
+	for _, elem := range genState.IcaHostAllowlist {
+		k.SetIcaHostAllowlistEntry(ctx, elem, true)
+	}
+	// Set all the forwardVolume
+	for _, elem := range genState.ForwardVolumeList {
+		k.SetForwardVolume(ctx, elem)
+	}
+	// Set all the pendingForwardFee
+	for _, elem := range genState.PendingForwardFeeList {
+		k.SetPendingForwardFee(ctx, elem)
+	}
+	if genState.MaintenanceWindow != nil {
+		k.SetMaintenanceWindow(ctx, *genState.MaintenanceWindow)
+	}
+	for _, elem := range genState.SequenceReservationList {
+		k.SetSequenceReservation(ctx, elem)
+	}
+	// Set all the guardianValidatorTransition
+	for _, elem := range genState.GuardianValidatorTransitionList {
+		k.SetGuardianValidatorTransition(ctx, elem)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 }
 
@@ -52,6 +148,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 
 	genesis.GuardianSetList = k.GetAllGuardianSet(ctx)
+	genesis.GuardianSetActivationHeightList = k.GetAllGuardianSetActivationHeight(ctx)
 
 	// Get all config
 	config, found := k.GetConfig(ctx)
@@ -59,16 +156,46 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		genesis.Config = &config
 	}
 	genesis.ReplayProtectionList = k.GetAllReplayProtection(ctx)
+	genesis.ExecutedGovernanceVaaList = k.GetAllExecutedGovernanceVAA(ctx)
 	genesis.SequenceCounterList = k.GetAllSequenceCounter(ctx)
 	// Get all consensusGuardianSetIndex
 	consensusGuardianSetIndex, found := k.GetConsensusGuardianSetIndex(ctx)
 	if found {
 		genesis.ConsensusGuardianSetIndex = &consensusGuardianSetIndex
 	}
+	params := k.GetParams(ctx)
+	genesis.Params = &params
+	guardianSetActivation, found := k.GetGuardianSetActivation(ctx)
+	if found {
+		genesis.GuardianSetActivation = &guardianSetActivation
+	}
 	genesis.GuardianValidatorList = k.GetAllGuardianValidator(ctx)
 	genesis.AllowedAddresses = k.GetAllAllowedAddresses(ctx)
 	genesis.WasmInstantiateAllowlist = k.GetAllWasmInstiateAllowedAddresses(ctx)
 	genesis.IbcComposabilityMwContract = k.GetIbcComposabilityMwContract(ctx)
+	genesis.CoreContract = k.GetCoreContract(ctx)
+	genesis.ApprovedCodeHashList = k.GetAllApprovedCodeHash(ctx)
+	genesis.RegisteredEmitterList = k.GetAllRegisteredEmitter(ctx)
+	genesis.GovernanceSubmitterList = k.GetAllGovernanceSubmitter(ctx)
+	genesis.ArchivedVaaList = k.GetAllArchivedVAA(ctx)
+	genesis.GuardianSetWeightsList = k.GetAllGuardianSetWeights(ctx)
+	genesis.ObservationTallyList = k.GetAllObservationTally(ctx)
+	genesis.FinalizedObservationList = k.GetAllFinalizedObservation(ctx)
+	genesis.BridgePaused = k.IsBridgePaused(ctx)
+	genesis.ChainRateLimitList = k.GetAllChainRateLimit(ctx)
+	genesis.RateLimitFlowList = k.GetAllRateLimitFlow(ctx)
+	genesis.QueuedObservationList = k.GetAllQueuedObservation(ctx)
+	genesis.MsgShutdownList = k.GetAllMsgShutdown(ctx)
+	genesis.GuardianHeartbeatList = k.GetAllGuardianHeartbeat(ctx)
+	genesis.IcaHostAllowlist = k.GetAllIcaHostAllowlist(ctx)
+	genesis.ForwardVolumeList = k.GetAllForwardVolume(ctx)
+	genesis.PendingForwardFeeList = k.GetAllPendingForwardFee(ctx)
+	maintenanceWindow, found := k.GetMaintenanceWindow(ctx)
+	if found {
+		genesis.MaintenanceWindow = &maintenanceWindow
+	}
+	genesis.SequenceReservationList = k.GetAllSequenceReservation(ctx)
+	genesis.GuardianValidatorTransitionList = k.GetAllGuardianValidatorTransition(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -1,8 +1,13 @@
 package wormhole_test
 
 import (
+	"bytes"
+	"encoding/hex"
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	keepertest "github.com/wormhole-foundation/wormchain/testutil/keeper"
 	"github.com/wormhole-foundation/wormchain/x/wormhole"
@@ -17,6 +22,7 @@ func TestGenesis(t *testing.T) {
 			},
 			{
 				Index: 1,
+				Keys:  [][]byte{bytes.Repeat([]byte{7}, 20), bytes.Repeat([]byte{8}, 20)},
 			},
 		},
 		Config: &types.Config{},
@@ -47,6 +53,153 @@ func TestGenesis(t *testing.T) {
 				GuardianKey: []byte{1},
 			},
 		},
+		GuardianSetActivation: &types.GuardianSetActivation{
+			Index:            1,
+			ActivationHeight: 100,
+		},
+		Params: &types.Params{
+			MessageFee:                1,
+			GatewayTransferFee:        2,
+			VaaArchiveRetentionBlocks: 10,
+		},
+		RegisteredEmitterList: []types.RegisteredEmitter{
+			{
+				Module:         "TokenBridge",
+				ChainId:        2,
+				EmitterAddress: []byte{2},
+			},
+			{
+				Module:         "NFTBridge",
+				ChainId:        2,
+				EmitterAddress: []byte{3},
+			},
+		},
+		ExecutedGovernanceVaaList: []types.ExecutedGovernanceVAA{
+			{
+				Digest: bytes.Repeat([]byte{1}, 32),
+				Height: 10,
+			},
+			{
+				Digest: bytes.Repeat([]byte{2}, 32),
+				Height: 20,
+			},
+		},
+		GuardianSetActivationHeightList: []types.GuardianSetActivationHeight{
+			{
+				Index:  0,
+				Height: 1,
+			},
+			{
+				Index:  1,
+				Height: 50,
+			},
+		},
+		AllowedAddresses: []types.ValidatorAllowedAddress{
+			{
+				ValidatorAddress: "wormhole1validator",
+				AllowedAddress:   "wormhole1allowed",
+				Name:             "allowed",
+			},
+		},
+		WasmInstantiateAllowlist: []types.WasmInstantiateAllowedContractCodeId{
+			{
+				ContractAddress: "wormhole1contract",
+				CodeId:          1,
+			},
+		},
+		IbcComposabilityMwContract: types.IbcComposabilityMwContract{
+			ContractAddress: sdk.AccAddress(bytes.Repeat([]byte{3}, 32)).String(),
+		},
+		CoreContract: types.CoreContract{
+			ContractAddress: sdk.AccAddress(bytes.Repeat([]byte{5}, 32)).String(),
+		},
+		ApprovedCodeHashList: []types.ApprovedCodeHash{
+			{CodeId: 1, CodeHash: bytes.Repeat([]byte{6}, 32)},
+			{CodeId: 300, CodeHash: bytes.Repeat([]byte{7}, 32)},
+		},
+		GovernanceSubmitterList: []types.GovernanceSubmitter{
+			{
+				Address: sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String(),
+			},
+		},
+		ArchivedVaaList: []types.ArchivedVAA{
+			{
+				Digest:         bytes.Repeat([]byte{4}, 32),
+				EmitterChain:   2,
+				EmitterAddress: bytes.Repeat([]byte{5}, 32),
+				Sequence:       7,
+				Height:         1,
+			},
+			{
+				Digest:         bytes.Repeat([]byte{6}, 32),
+				EmitterChain:   2,
+				EmitterAddress: bytes.Repeat([]byte{5}, 32),
+				Sequence:       8,
+				Height:         20,
+			},
+		},
+		GuardianSetWeightsList: []types.GuardianSetWeights{
+			{
+				GuardianSetIndex: 1,
+				Weights:          []uint64{3, 1},
+			},
+		},
+		ObservationTallyList: []types.ObservationTally{
+			{
+				Digest:           bytes.Repeat([]byte{9}, 32),
+				GuardianSetIndex: 1,
+				Signers:          []byte{1},
+				Weight:           3,
+				Finalized:        true,
+			},
+		},
+		FinalizedObservationList: []types.FinalizedObservation{
+			{
+				Digest: bytes.Repeat([]byte{10}, 32),
+				Height: 5,
+			},
+		},
+		PendingForwardFeeList: []types.PendingForwardFee{
+			{
+				PortId:          "transfer",
+				ChannelId:       "channel-0",
+				Sequence:        3,
+				Denom:           "uworm",
+				Amount:          sdk.NewInt(990),
+				Fee:             sdk.NewInt(10),
+				RefundPortId:    "transfer",
+				RefundChannelId: "channel-1",
+			},
+		},
+		BridgePaused: true,
+		ChainRateLimitList: []types.ChainRateLimit{
+			{
+				ChainId:      2,
+				Limit:        10,
+				WindowBlocks: 100,
+			},
+		},
+		RateLimitFlowList: []types.RateLimitFlow{
+			{
+				ChainId: 2,
+				Height:  5,
+				Count:   10,
+			},
+		},
+		QueuedObservationList: []types.QueuedObservation{
+			{
+				Digest:       bytes.Repeat([]byte{9}, 32),
+				EmitterChain: 2,
+				QueuedHeight: 5,
+			},
+		},
+		GuardianValidatorTransitionList: []types.GuardianValidatorTransition{
+			{
+				ValidatorAddr: []byte{1, 2, 3},
+				GuardianKey:   bytes.Repeat([]byte{4}, 20),
+				UnbondHeight:  50,
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -66,5 +219,91 @@ func TestGenesis(t *testing.T) {
 	require.Subset(t, genesisState.SequenceCounterList, got.SequenceCounterList)
 	require.Equal(t, genesisState.ConsensusGuardianSetIndex, got.ConsensusGuardianSetIndex)
 	require.ElementsMatch(t, genesisState.GuardianValidatorList, got.GuardianValidatorList)
+	require.Equal(t, genesisState.GuardianSetActivation, got.GuardianSetActivation)
+	require.Equal(t, genesisState.Params, got.Params)
+	require.ElementsMatch(t, genesisState.RegisteredEmitterList, got.RegisteredEmitterList)
+	require.ElementsMatch(t, genesisState.ExecutedGovernanceVaaList, got.ExecutedGovernanceVaaList)
+	require.ElementsMatch(t, genesisState.GuardianSetActivationHeightList, got.GuardianSetActivationHeightList)
+	require.ElementsMatch(t, genesisState.AllowedAddresses, got.AllowedAddresses)
+	require.ElementsMatch(t, genesisState.WasmInstantiateAllowlist, got.WasmInstantiateAllowlist)
+	require.Equal(t, genesisState.IbcComposabilityMwContract, got.IbcComposabilityMwContract)
+	require.Equal(t, genesisState.CoreContract, got.CoreContract)
+	require.Equal(t, genesisState.ApprovedCodeHashList, got.ApprovedCodeHashList)
+	require.ElementsMatch(t, genesisState.GovernanceSubmitterList, got.GovernanceSubmitterList)
+	require.ElementsMatch(t, genesisState.ArchivedVaaList, got.ArchivedVaaList)
+	require.ElementsMatch(t, genesisState.GuardianSetWeightsList, got.GuardianSetWeightsList)
+	require.ElementsMatch(t, genesisState.ObservationTallyList, got.ObservationTallyList)
+	require.ElementsMatch(t, genesisState.FinalizedObservationList, got.FinalizedObservationList)
+	require.ElementsMatch(t, genesisState.PendingForwardFeeList, got.PendingForwardFeeList)
+	require.Equal(t, genesisState.BridgePaused, got.BridgePaused)
+	require.ElementsMatch(t, genesisState.ChainRateLimitList, got.ChainRateLimitList)
+	require.ElementsMatch(t, genesisState.RateLimitFlowList, got.RateLimitFlowList)
+	require.ElementsMatch(t, genesisState.QueuedObservationList, got.QueuedObservationList)
+	require.ElementsMatch(t, genesisState.GuardianValidatorTransitionList, got.GuardianValidatorTransitionList)
+
+	// The height index of the archive is rebuilt, so imported VAAs are pruned
+	k.PruneVAAArchive(ctx.WithBlockHeight(15))
+	require.Equal(t, genesisState.ArchivedVaaList[1:], k.GetAllArchivedVAA(ctx))
 	// this line is used by starport scaffolding # genesis/test/assert
 }
+
+func TestGenesisPrunedGuardianSets(t *testing.T) {
+	genesisState := types.GenesisState{
+		GuardianSetList: []types.GuardianSet{
+			{
+				Index: 3,
+			},
+			{
+				Index: 4,
+			},
+		},
+		ConsensusGuardianSetIndex: &types.ConsensusGuardianSetIndex{
+			Index: 4,
+		},
+	}
+
+	k, ctx := keepertest.WormholeKeeper(t)
+	wormhole.InitGenesis(ctx, *k, genesisState)
+	require.Equal(t, uint32(4), k.GetLatestGuardianSetIndex(ctx))
+
+	got := wormhole.ExportGenesis(ctx, *k)
+	require.ElementsMatch(t, genesisState.GuardianSetList, got.GuardianSetList)
+}
+
+// TestGenesisMigration imports a genesis exported before executed governance
+// VAAs and guardian set activation heights were part of the genesis state.
+func TestGenesisMigration(t *testing.T) {
+	legacyGovernanceDigest := bytes.Repeat([]byte{7}, 32)
+	legacyGenesis := `{
+		"guardianSetList": [{"index": 0, "keys": [], "expirationTime": "0"}],
+		"replayProtectionList": [{"index": "` + hex.EncodeToString(legacyGovernanceDigest) + `"}],
+		"sequenceCounterList": [{"index": "emitter", "sequence": "5"}],
+		"consensusGuardianSetIndex": {"index": 0},
+		"allowedAddresses": [{"validator_address": "wormhole1validator", "allowed_address": "wormhole1allowed", "name": "allowed"}]
+	}`
+
+	k, ctx := keepertest.WormholeKeeper(t)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	var genesisState types.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON([]byte(legacyGenesis), &genesisState))
+	require.NoError(t, genesisState.Validate())
+
+	ctx = ctx.WithBlockHeight(42)
+	wormhole.InitGenesis(ctx, *k, genesisState)
+
+	// Governance VAAs executed before the dedicated store existed stay consumed
+	require.True(t, k.IsGovernanceVAAExecuted(ctx, legacyGovernanceDigest))
+	require.Empty(t, k.GetAllExecutedGovernanceVAA(ctx))
+
+	got := wormhole.ExportGenesis(ctx, *k)
+	require.Equal(t, genesisState.ReplayProtectionList, got.ReplayProtectionList)
+	require.Equal(t, genesisState.SequenceCounterList, got.SequenceCounterList)
+	require.Equal(t, genesisState.AllowedAddresses, got.AllowedAddresses)
+	// Without a recorded height the guardian set counts as added at import
+	require.Equal(t, []types.GuardianSetActivationHeight{{Index: 0, Height: 42}}, got.GuardianSetActivationHeightList)
+
+	// A second round trip preserves the migrated state unchanged
+	k2, ctx2 := keepertest.WormholeKeeper(t)
+	wormhole.InitGenesis(ctx2.WithBlockHeight(100), *k2, *got)
+	require.Equal(t, got, wormhole.ExportGenesis(ctx2, *k2))
+}
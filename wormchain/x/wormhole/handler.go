@@ -47,6 +47,24 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgExecuteGatewayGovernanceVaa:
 			res, err := msgServer.ExecuteGatewayGovernanceVaa(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgExecuteGovernanceVAABatch:
+			res, err := msgServer.ExecuteGovernanceVAABatch(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSubmitObservation:
+			res, err := msgServer.SubmitObservation(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgGuardianHeartbeat:
+			res, err := msgServer.GuardianHeartbeat(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgExecuteGatewayTransfer:
+			res, err := msgServer.ExecuteGatewayTransfer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgExecuteGatewayTransferWithPayload:
+			res, err := msgServer.ExecuteGatewayTransferWithPayload(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgReserveSequenceBlock:
+			res, err := msgServer.ReserveSequenceBlock(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 			// this line is used by starport scaffolding # 1
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
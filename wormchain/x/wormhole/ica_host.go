@@ -0,0 +1,108 @@
+package wormhole
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	icahostkeeper "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/keeper"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+
+	"github.com/wormhole-foundation/wormchain/x/wormhole/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+// ChannelKeeper defines the channel keeper used to look up the connection
+// an interchain accounts packet was received on.
+type ChannelKeeper interface {
+	GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
+}
+
+var _ porttypes.IBCModule = IcaHostAllowlistModule{}
+
+// IcaHostAllowlistModule wraps the interchain accounts host module and only
+// lets the interchain accounts of a connection execute the message types
+// guardian governance allowed for that connection. Interchain accounts can not
+// execute contracts that are under maintenance either, since their messages do
+// not pass through the ante handler. All other callbacks are passed through to
+// the host module.
+type IcaHostAllowlistModule struct {
+	porttypes.IBCModule
+	keeper        keeper.Keeper
+	channelKeeper ChannelKeeper
+	cdc           codec.BinaryCodec
+}
+
+// NewIcaHostAllowlistModule creates a new IcaHostAllowlistModule given the
+// interchain accounts host module it wraps. The codec must be able to decode
+// every message type the allowlist can name.
+func NewIcaHostAllowlistModule(
+	app porttypes.IBCModule,
+	keeper keeper.Keeper,
+	channelKeeper ChannelKeeper,
+	cdc codec.BinaryCodec,
+) IcaHostAllowlistModule {
+	return IcaHostAllowlistModule{
+		IBCModule:     app,
+		keeper:        keeper,
+		channelKeeper: channelKeeper,
+		cdc:           cdc,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface. Transactions containing a
+// message type that is not allowed for the connection, or executing a contract
+// under maintenance, are rejected with an error acknowledgement before they
+// reach the host module.
+func (im IcaHostAllowlistModule) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	if err := im.checkAllowlist(ctx, packet); err != nil {
+		ack := channeltypes.NewErrorAcknowledgement(err)
+		icahostkeeper.EmitAcknowledgementEvent(ctx, packet, ack, err)
+		return ack
+	}
+
+	return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+}
+
+// checkAllowlist returns an error if the packet executes a message type that
+// is not allowed for the connection it was received on, or a contract that is
+// under maintenance. Packets the host module can not decode are left for it to
+// reject.
+func (im IcaHostAllowlistModule) checkAllowlist(ctx sdk.Context, packet channeltypes.Packet) error {
+	var data icatypes.InterchainAccountPacketData
+	if err := icatypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return nil
+	}
+	if data.Type != icatypes.EXECUTE_TX {
+		return nil
+	}
+	msgs, err := icatypes.DeserializeCosmosTx(im.cdc, data.Data)
+	if err != nil {
+		return nil
+	}
+
+	channel, found := im.channelKeeper.GetChannel(ctx, packet.DestinationPort, packet.DestinationChannel)
+	if !found {
+		return channeltypes.ErrChannelNotFound
+	}
+	connectionID := channel.ConnectionHops[0]
+
+	for _, msg := range msgs {
+		msgTypeURL := sdk.MsgTypeURL(msg)
+		if !im.keeper.IsIcaHostMsgAllowed(ctx, connectionID, msgTypeURL) {
+			return sdkerrors.Wrapf(types.ErrIcaHostMsgNotAllowed, "%s on %s", msgTypeURL, connectionID)
+		}
+		if execute, ok := msg.(*wasmtypes.MsgExecuteContract); ok && im.keeper.IsContractUnderMaintenance(ctx, execute.Contract) {
+			return sdkerrors.Wrap(types.ErrContractUnderMaintenance, execute.Contract)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package wormhole_test
+
+import (
+	"bytes"
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/wormhole-foundation/wormchain/testutil/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+type mockChannelKeeper struct{}
+
+func (mockChannelKeeper) GetChannel(ctx sdk.Context, srcPort, srcChan string) (channeltypes.Channel, bool) {
+	if srcChan != "channel-0" {
+		return channeltypes.Channel{}, false
+	}
+	return channeltypes.Channel{ConnectionHops: []string{"connection-0"}}, true
+}
+
+// mockIcaHost acknowledges every packet it receives successfully
+type mockIcaHost struct {
+	porttypes.IBCModule
+	received int
+}
+
+func (m *mockIcaHost) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	m.received++
+	return channeltypes.NewResultAcknowledgement([]byte{1})
+}
+
+func TestIcaHostAllowlistModule(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+
+	registry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(registry)
+	stakingtypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	host := &mockIcaHost{}
+	module := wormhole.NewIcaHostAllowlistModule(host, *k, mockChannelKeeper{}, cdc)
+
+	packet := func(channel string, msgs ...sdk.Msg) channeltypes.Packet {
+		data, err := icatypes.SerializeCosmosTx(cdc, msgs)
+		require.NoError(t, err)
+		packetData := icatypes.InterchainAccountPacketData{
+			Type: icatypes.EXECUTE_TX,
+			Data: data,
+		}
+		return channeltypes.Packet{
+			DestinationPort:    "icahost",
+			DestinationChannel: channel,
+			Data:               packetData.GetBytes(),
+		}
+	}
+	send := &banktypes.MsgSend{}
+	delegate := &stakingtypes.MsgDelegate{}
+
+	// Nothing is allowed by default
+	ack := module.OnRecvPacket(ctx, packet("channel-0", send), nil)
+	require.False(t, ack.Success())
+	require.Equal(t, 0, host.received)
+
+	k.SetIcaHostAllowlistEntry(ctx, types.IcaHostAllowlistEntry{ConnectionId: "connection-0", MsgTypeUrl: sdk.MsgTypeURL(send)}, true)
+	ack = module.OnRecvPacket(ctx, packet("channel-0", send), nil)
+	require.True(t, ack.Success())
+	require.Equal(t, 1, host.received)
+
+	// Every message of the transaction must be allowed
+	ack = module.OnRecvPacket(ctx, packet("channel-0", send, delegate), nil)
+	require.False(t, ack.Success())
+	require.Equal(t, 1, host.received)
+
+	// Unknown channel
+	ack = module.OnRecvPacket(ctx, packet("channel-1", send), nil)
+	require.False(t, ack.Success())
+	require.Equal(t, 1, host.received)
+
+	// Packets that can not be decoded are left for the host module to reject
+	ack = module.OnRecvPacket(ctx, channeltypes.Packet{DestinationChannel: "channel-0", Data: []byte("invalid")}, nil)
+	require.True(t, ack.Success())
+	require.Equal(t, 2, host.received)
+}
+
+func TestIcaHostAllowlistModuleMaintenanceWindow(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+
+	registry := codectypes.NewInterfaceRegistry()
+	wasmtypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	host := &mockIcaHost{}
+	module := wormhole.NewIcaHostAllowlistModule(host, *k, mockChannelKeeper{}, cdc)
+
+	core := sdk.AccAddress(bytes.Repeat([]byte{1}, 32)).String()
+	other := sdk.AccAddress(bytes.Repeat([]byte{2}, 32)).String()
+	packet := func(contract string) channeltypes.Packet {
+		data, err := icatypes.SerializeCosmosTx(cdc, []sdk.Msg{&wasmtypes.MsgExecuteContract{Contract: contract}})
+		require.NoError(t, err)
+		packetData := icatypes.InterchainAccountPacketData{
+			Type: icatypes.EXECUTE_TX,
+			Data: data,
+		}
+		return channeltypes.Packet{
+			DestinationPort:    "icahost",
+			DestinationChannel: "channel-0",
+			Data:               packetData.GetBytes(),
+		}
+	}
+
+	k.SetIcaHostAllowlistEntry(ctx, types.IcaHostAllowlistEntry{ConnectionId: "connection-0", MsgTypeUrl: sdk.MsgTypeURL(&wasmtypes.MsgExecuteContract{})}, true)
+	k.SetMaintenanceWindow(ctx, types.MaintenanceWindow{StartHeight: 100, EndHeight: 200, Contracts: []string{core}})
+
+	// Contracts under maintenance can only be executed outside of the window
+	for _, tc := range []struct {
+		height   int64
+		contract string
+		success  bool
+	}{
+		{99, core, true},
+		{100, core, false},
+		{200, core, false},
+		{150, other, true},
+		{201, core, true},
+	} {
+		ack := module.OnRecvPacket(ctx.WithBlockHeight(tc.height), packet(tc.contract), nil)
+		require.Equal(t, tc.success, ack.Success(), "height %d", tc.height)
+	}
+	require.Equal(t, 3, host.received)
+}
@@ -2,17 +2,36 @@ package keeper
 
 import (
 	"bytes"
+	"encoding/binary"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
 )
 
+// MaxAllowlistPrunePerBlock bounds the number of expired allowlist entries
+// removed in a single EndBlock.
+const MaxAllowlistPrunePerBlock = 1000
+
 // SetSequenceCounter set a specific sequenceCounter in the store from its index
 func (k Keeper) SetValidatorAllowedAddress(ctx sdk.Context, address types.ValidatorAllowedAddress) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ValidatorAllowlistKey))
+	expirationStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ValidatorAllowlistExpirationKey))
+
+	// an overwritten entry may have expired at a different height
+	if b := store.Get([]byte(address.AllowedAddress)); b != nil {
+		var old types.ValidatorAllowedAddress
+		k.cdc.MustUnmarshal(b, &old)
+		if old.ExpirationHeight != 0 {
+			expirationStore.Delete(types.ValidatorAllowlistExpirationIndexKey(old.ExpirationHeight, old.AllowedAddress))
+		}
+	}
+
 	b := k.cdc.MustMarshal(&address)
 	store.Set([]byte(address.AllowedAddress), b)
+	if address.ExpirationHeight != 0 {
+		expirationStore.Set(types.ValidatorAllowlistExpirationIndexKey(address.ExpirationHeight, address.AllowedAddress), []byte{})
+	}
 }
 
 func (k Keeper) GetValidatorAllowedAddress(ctx sdk.Context, address string) types.ValidatorAllowedAddress {
@@ -31,9 +50,50 @@ func (k Keeper) HasValidatorAllowedAddress(ctx sdk.Context, address string) bool
 // RemoveSequenceCounter removes a sequenceCounter from the store
 func (k Keeper) RemoveValidatorAllowedAddress(ctx sdk.Context, address string) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ValidatorAllowlistKey))
+	b := store.Get([]byte(address))
+	if b == nil {
+		return
+	}
+	var allowed types.ValidatorAllowedAddress
+	k.cdc.MustUnmarshal(b, &allowed)
+	if allowed.ExpirationHeight != 0 {
+		expirationStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ValidatorAllowlistExpirationKey))
+		expirationStore.Delete(types.ValidatorAllowlistExpirationIndexKey(allowed.ExpirationHeight, address))
+	}
 	store.Delete([]byte(address))
 }
 
+// PruneExpiredAllowlistEntries removes the allowlist entries that expired at or
+// before the current block height.
+func (k Keeper) PruneExpiredAllowlistEntries(ctx sdk.Context) error {
+	if ctx.BlockHeight() < 0 {
+		return nil
+	}
+	expirationStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ValidatorAllowlistExpirationKey))
+
+	end := binary.BigEndian.AppendUint64(nil, uint64(ctx.BlockHeight())+1)
+	iterator := expirationStore.Iterator(nil, end)
+	var pruned []string
+	for ; iterator.Valid() && len(pruned) < MaxAllowlistPrunePerBlock; iterator.Next() {
+		pruned = append(pruned, string(iterator.Key()[8:]))
+	}
+	iterator.Close()
+
+	for _, address := range pruned {
+		allowed := k.GetValidatorAllowedAddress(ctx, address)
+		k.RemoveValidatorAllowedAddress(ctx, address)
+		err := ctx.EventManager().EmitTypedEvent(&types.EventAllowlistEntryExpired{
+			ValidatorAddress: allowed.ValidatorAddress,
+			AllowedAddress:   allowed.AllowedAddress,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (k Keeper) GetAllAllowedAddresses(ctx sdk.Context) (list []types.ValidatorAllowedAddress) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ValidatorAllowlistKey))
 	iterator := sdk.KVStorePrefixIterator(store, []byte{})
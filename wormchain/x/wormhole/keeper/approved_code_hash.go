@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+// SetApprovedCodeHash set a specific approvedCodeHash in the store from its code id
+func (k Keeper) SetApprovedCodeHash(ctx sdk.Context, approved types.ApprovedCodeHash) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ApprovedCodeHashKeyPrefix))
+	b := k.cdc.MustMarshal(&approved)
+	store.Set(types.ApprovedCodeHashKey(approved.CodeId), b)
+}
+
+// GetApprovedCodeHash returns an approvedCodeHash from its code id
+func (k Keeper) GetApprovedCodeHash(ctx sdk.Context, codeID uint64) (val types.ApprovedCodeHash, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ApprovedCodeHashKeyPrefix))
+
+	b := store.Get(types.ApprovedCodeHashKey(codeID))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveApprovedCodeHash removes an approvedCodeHash from the store
+func (k Keeper) RemoveApprovedCodeHash(ctx sdk.Context, codeID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ApprovedCodeHashKeyPrefix))
+	store.Delete(types.ApprovedCodeHashKey(codeID))
+}
+
+// GetAllApprovedCodeHash returns all approvedCodeHash
+func (k Keeper) GetAllApprovedCodeHash(ctx sdk.Context) (list []types.ApprovedCodeHash) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ApprovedCodeHashKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ApprovedCodeHash
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// VerifyApprovedCode checks that the code stored under a code id is the code
+// governance approved for it. This prevents a governance VAA that names a code
+// id from instantiating or migrating to code that was substituted under that id.
+func (k Keeper) VerifyApprovedCode(ctx sdk.Context, codeID uint64) error {
+	if !k.setWasmView {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "x/wasmd not set")
+	}
+
+	approved, found := k.GetApprovedCodeHash(ctx, codeID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrCodeNotApproved, "code id %d", codeID)
+	}
+
+	codeInfo := k.wasmViewKeeper.GetCodeInfo(ctx, codeID)
+	if codeInfo == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "code id %d", codeID)
+	}
+	if !bytes.Equal(codeInfo.CodeHash, approved.CodeHash) {
+		return sdkerrors.Wrapf(types.ErrCodeHashMismatch, "code id %d", codeID)
+	}
+
+	return nil
+}
+
+// approveCodeHash pins the code hash of a code id and emits the update.
+func (k Keeper) approveCodeHash(ctx sdk.Context, codeID uint64, codeHash []byte) error {
+	k.SetApprovedCodeHash(ctx, types.ApprovedCodeHash{CodeId: codeID, CodeHash: codeHash})
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventApprovedCodeHashUpdate{
+		CodeId:   codeID,
+		CodeHash: codeHash,
+	})
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+// SetBridgePaused sets or clears the chain-wide pause flag. While the bridge
+// is paused, token bridge executions routed through the gateway are rejected.
+func (k Keeper) SetBridgePaused(ctx sdk.Context, paused bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BridgePausedKey))
+	if paused {
+		store.Set([]byte{0}, []byte{1})
+	} else {
+		store.Delete([]byte{0})
+	}
+}
+
+// IsBridgePaused returns whether the bridge is paused
+func (k Keeper) IsBridgePaused(ctx sdk.Context) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BridgePausedKey))
+	return store.Has([]byte{0})
+}
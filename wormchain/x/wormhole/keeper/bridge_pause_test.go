@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/wormhole-foundation/wormchain/testutil/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestExecuteGovernanceVAAPauseBridge(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 10)
+	k.SetConfig(ctx, types.Config{
+		GovernanceEmitter:     vaa.GovernanceEmitter[:],
+		GovernanceChain:       uint32(vaa.GovernanceChain),
+		ChainId:               uint32(vaa.ChainIDWormchain),
+		GuardianSetExpiration: 86400,
+	})
+	signer := sdk.AccAddress(make([]byte, 20))
+
+	set := createNewGuardianSet(k, ctx, guardians)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: set.Index})
+
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	execute := func(action vaa.GovernanceAction, payload []byte) error {
+		module := [32]byte{}
+		copy(module[:], vaa.CoreModule)
+		gov_msg := types.NewGovernanceMessage(module, byte(action), uint16(vaa.ChainIDWormchain), payload)
+
+		v := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), gov_msg.MarshalBinary())
+		vBz, _ := v.Marshal()
+		_, err := msgServer.ExecuteGovernanceVAA(sdk.WrapSDKContext(ctx), &types.MsgExecuteGovernanceVAA{
+			Signer: signer.String(),
+			Vaa:    vBz,
+		})
+		return err
+	}
+	// An unknown gateway action passes verification and fails on execution
+	executeGateway := func() error {
+		gov_msg := types.NewGovernanceMessage(vaa.GatewayModule, 0xff, uint16(vaa.ChainIDWormchain), nil)
+
+		v := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), gov_msg.MarshalBinary())
+		vBz, _ := v.Marshal()
+		_, err := msgServer.ExecuteGatewayGovernanceVaa(sdk.WrapSDKContext(ctx), &types.MsgExecuteGatewayGovernanceVaa{
+			Signer: signer.String(),
+			Vaa:    vBz,
+		})
+		return err
+	}
+	events := func() (paused, resumed int) {
+		for _, abciEvent := range ctx.EventManager().ABCIEvents() {
+			msg, err := sdk.ParseTypedEvent(abciEvent)
+			if err != nil {
+				continue
+			}
+			switch msg.(type) {
+			case *types.EventBridgePaused:
+				paused++
+			case *types.EventBridgeResumed:
+				resumed++
+			}
+		}
+		return
+	}
+
+	assert.False(t, k.IsBridgePaused(ctx))
+	assert.ErrorIs(t, executeGateway(), types.ErrUnknownGovernanceAction)
+
+	assert.ErrorIs(t, execute(vaa.ActionPauseBridge, []byte{1}), types.ErrInvalidGovernancePayloadLength)
+
+	require.NoError(t, execute(vaa.ActionPauseBridge, nil))
+	assert.True(t, k.IsBridgePaused(ctx))
+	res, err := k.BridgePaused(sdk.WrapSDKContext(ctx), &types.QueryBridgePausedRequest{})
+	require.NoError(t, err)
+	assert.True(t, res.Paused)
+
+	// Gateway governance is rejected while paused
+	assert.ErrorIs(t, executeGateway(), types.ErrBridgePaused)
+
+	// Pausing again is a no-op
+	require.NoError(t, execute(vaa.ActionPauseBridge, nil))
+	paused, resumed := events()
+	assert.Equal(t, 1, paused)
+	assert.Equal(t, 0, resumed)
+
+	require.NoError(t, execute(vaa.ActionResumeBridge, nil))
+	assert.False(t, k.IsBridgePaused(ctx))
+	paused, resumed = events()
+	assert.Equal(t, 1, paused)
+	assert.Equal(t, 1, resumed)
+
+	assert.ErrorIs(t, executeGateway(), types.ErrUnknownGovernanceAction)
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+func (k Keeper) StoreCoreContract(ctx sdk.Context, entry types.CoreContract) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CoreContractKey))
+	b := k.cdc.MustMarshal(&entry)
+	store.Set([]byte{0}, b)
+}
+
+func (k Keeper) GetCoreContract(ctx sdk.Context) types.CoreContract {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CoreContractKey))
+	entry := store.Get([]byte{0})
+
+	var val types.CoreContract
+	k.cdc.MustUnmarshal(entry, &val)
+
+	return val
+}
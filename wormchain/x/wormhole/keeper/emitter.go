@@ -17,12 +17,37 @@ func (k Keeper) PostMessage(ctx sdk.Context, emitter types.EmitterAddress, nonce
 		}
 	}
 
+	if err := k.emitPostedMessage(ctx, emitter, sequence.Sequence, nonce, data); err != nil {
+		return err
+	}
+
+	// Increment sequence counter
+	sequence.Sequence++
+	k.SetSequenceCounter(ctx, sequence)
+
+	return nil
+}
+
+// PostMessageWithReservedSequence posts a message with a sequence that was
+// pre-allocated by ReserveSequenceBlock. The sequences of a reservation must be
+// used in order.
+func (k Keeper) PostMessageWithReservedSequence(ctx sdk.Context, emitter types.EmitterAddress, sequence uint64, nonce uint32, data []byte) error {
+	if err := k.useReservedSequence(ctx, hex.EncodeToString(emitter.Bytes()), sequence); err != nil {
+		return err
+	}
+
+	return k.emitPostedMessage(ctx, emitter, sequence, nonce, data)
+}
+
+// emitPostedMessage emits the event of a posted message and notifies the
+// message posted hooks.
+func (k Keeper) emitPostedMessage(ctx sdk.Context, emitter types.EmitterAddress, sequence uint64, nonce uint32, data []byte) error {
 	// Retrieve the number of seconds since the unix epoch from the block header
 	time := ctx.BlockTime().Unix()
 
 	err := ctx.EventManager().EmitTypedEvent(&types.EventPostedMessage{
 		Emitter:  emitter.Bytes(),
-		Sequence: sequence.Sequence,
+		Sequence: sequence,
 		Nonce:    nonce,
 		Time:     uint64(time),
 		Payload:  data,
@@ -31,9 +56,11 @@ func (k Keeper) PostMessage(ctx sdk.Context, emitter types.EmitterAddress, nonce
 		panic(err)
 	}
 
-	// Increment sequence counter
-	sequence.Sequence++
-	k.SetSequenceCounter(ctx, sequence)
-
-	return nil
+	return k.afterMessagePosted(ctx, types.PostedMessage{
+		Emitter:  emitter.Bytes(),
+		Sequence: sequence,
+		Nonce:    nonce,
+		Time:     uint64(time),
+		Payload:  data,
+	})
 }
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+// SetForwardVolume set a specific forwardVolume in the store from its index
+func (k Keeper) SetForwardVolume(ctx sdk.Context, forwardVolume types.ForwardVolume) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ForwardVolumeKey))
+	b := k.cdc.MustMarshal(&forwardVolume)
+	store.Set(types.ForwardVolumeEntryKey(forwardVolume.ChannelId, forwardVolume.Denom), b)
+}
+
+// GetForwardVolume returns a forwardVolume from its index
+func (k Keeper) GetForwardVolume(ctx sdk.Context, channelID string, denom string) (val types.ForwardVolume, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ForwardVolumeKey))
+
+	b := store.Get(types.ForwardVolumeEntryKey(channelID, denom))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllForwardVolume returns all forwardVolume
+func (k Keeper) GetAllForwardVolume(ctx sdk.Context) (list []types.ForwardVolume) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ForwardVolumeKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ForwardVolume
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// ForwardFee returns the forward fee deducted from an amount. The fee is
+// rounded down.
+func (k Keeper) ForwardFee(ctx sdk.Context, amount sdk.Int) sdk.Int {
+	feeBps := k.GetParams(ctx).ForwardFeeBps
+	return amount.MulRaw(int64(feeBps)).QuoRaw(types.MaxForwardFeeBps)
+}
+
+// RecordForward adds a transfer forwarded over a channel to its volume. The
+// amount is the amount forwarded after the fee was deducted.
+func (k Keeper) RecordForward(ctx sdk.Context, channelID string, denom string, amount sdk.Int, fee sdk.Int) {
+	volume, found := k.GetForwardVolume(ctx, channelID, denom)
+	if !found {
+		volume = types.ForwardVolume{
+			ChannelId: channelID,
+			Denom:     denom,
+			Amount:    sdk.ZeroInt(),
+			Fees:      sdk.ZeroInt(),
+		}
+	}
+
+	volume.Amount = volume.Amount.Add(amount)
+	volume.Fees = volume.Fees.Add(fee)
+	volume.Transfers++
+	k.SetForwardVolume(ctx, volume)
+}
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/wormhole-foundation/wormchain/testutil/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestExecuteGovernanceVAAForwardFeeUpdate(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 10)
+	k.SetConfig(ctx, types.Config{
+		GovernanceEmitter:     vaa.GovernanceEmitter[:],
+		GovernanceChain:       uint32(vaa.GovernanceChain),
+		ChainId:               uint32(vaa.ChainIDWormchain),
+		GuardianSetExpiration: 86400,
+	})
+	signer := sdk.AccAddress(make([]byte, 20))
+
+	set := createNewGuardianSet(k, ctx, guardians)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: set.Index})
+
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	execute := func(ctx sdk.Context, payload []byte) error {
+		v := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), payload)
+		vBz, _ := v.Marshal()
+		_, err := msgServer.ExecuteGovernanceVAA(sdk.WrapSDKContext(ctx), &types.MsgExecuteGovernanceVAA{
+			Signer: signer.String(),
+			Vaa:    vBz,
+		})
+		return err
+	}
+
+	payload, err := vaa.BodyWormchainForwardFeeUpdate{FeeBps: 25}.Serialize()
+	require.NoError(t, err)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, execute(ctx, payload))
+	assert.Equal(t, uint32(25), k.GetParams(ctx).ForwardFeeBps)
+
+	var event *types.EventForwardFeeUpdate
+	for _, abciEvent := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(abciEvent)
+		if err != nil {
+			continue
+		}
+		if e, ok := msg.(*types.EventForwardFeeUpdate); ok {
+			event = e
+		}
+	}
+	require.NotNil(t, event)
+	assert.Equal(t, types.EventForwardFeeUpdate{OldFeeBps: 0, NewFeeBps: 25}, *event)
+
+	// The fee can not exceed the forwarded amount
+	payload, err = vaa.BodyWormchainForwardFeeUpdate{FeeBps: types.MaxForwardFeeBps + 1}.Serialize()
+	require.NoError(t, err)
+	assert.ErrorIs(t, execute(ctx, payload), types.ErrInvalidFeeParams)
+	assert.Equal(t, uint32(25), k.GetParams(ctx).ForwardFeeBps)
+
+	// Unknown payload version
+	payload[35] = 2
+	assert.ErrorIs(t, execute(ctx, payload), types.ErrUnknownGovernancePayloadVersion)
+}
+
+func TestRecordForward(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+
+	assert.True(t, k.ForwardFee(ctx, sdk.NewInt(1000)).IsZero())
+
+	k.SetParams(ctx, types.Params{ForwardFeeBps: 25})
+	assert.Equal(t, sdk.NewInt(2), k.ForwardFee(ctx, sdk.NewInt(1000)))
+	// Fees are rounded down
+	assert.True(t, k.ForwardFee(ctx, sdk.NewInt(399)).IsZero())
+
+	k.RecordForward(ctx, "channel-0", "uworm", sdk.NewInt(998), sdk.NewInt(2))
+	k.RecordForward(ctx, "channel-0", "uworm", sdk.NewInt(399), sdk.ZeroInt())
+	k.RecordForward(ctx, "channel-0", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", sdk.NewInt(1), sdk.ZeroInt())
+	k.RecordForward(ctx, "channel-1", "uworm", sdk.NewInt(5), sdk.ZeroInt())
+
+	volume, found := k.GetForwardVolume(ctx, "channel-0", "uworm")
+	require.True(t, found)
+	assert.Equal(t, types.ForwardVolume{
+		ChannelId: "channel-0",
+		Denom:     "uworm",
+		Amount:    sdk.NewInt(1397),
+		Fees:      sdk.NewInt(2),
+		Transfers: 2,
+	}, volume)
+
+	res, err := k.ForwardVolumeAll(sdk.WrapSDKContext(ctx), &types.QueryAllForwardVolumeRequest{ChannelId: "channel-0"})
+	require.NoError(t, err)
+	assert.Len(t, res.ForwardVolume, 2)
+
+	res, err = k.ForwardVolumeAll(sdk.WrapSDKContext(ctx), &types.QueryAllForwardVolumeRequest{})
+	require.NoError(t, err)
+	assert.Len(t, res.ForwardVolume, 3)
+}
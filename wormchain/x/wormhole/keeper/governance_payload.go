@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// coreGovernanceActionHandler executes a core governance action with its
+// payload decoded by types.CoreGovernancePayloads, in the format of the latest
+// version of the action.
+type coreGovernanceActionHandler func(k Keeper, ctx sdk.Context, payload []byte) error
+
+// coreGovernanceActionHandlers are the handlers of the actions registered in
+// types.CoreGovernancePayloads.
+var coreGovernanceActionHandlers = map[vaa.GovernanceAction]coreGovernanceActionHandler{
+	vaa.ActionGuardianSetUpdate:               Keeper.executeGuardianSetUpdate,
+	vaa.ActionUpdateGovernanceEmitter:         Keeper.updateGovernanceEmitter,
+	vaa.ActionPruneGuardianSets:               Keeper.pruneGuardianSets,
+	vaa.ActionConsensusParamsUpdate:           Keeper.updateConsensusParams,
+	vaa.ActionScheduledGuardianSetUpdate:      Keeper.executeScheduledGuardianSetUpdate,
+	vaa.ActionFeeParamsUpdate:                 Keeper.updateFeeParams,
+	vaa.ActionSignatureGasUpdate:              Keeper.updateSignatureVerificationGas,
+	vaa.ActionRegisterEmitter:                 Keeper.registerEmitter,
+	vaa.ActionQuorumThresholdUpdate:           Keeper.updateQuorumThreshold,
+	vaa.ActionGovernanceSubmitterUpdate:       Keeper.updateGovernanceSubmitter,
+	vaa.ActionVAAArchiveRetentionUpdate:       Keeper.updateVAAArchiveRetention,
+	vaa.ActionGuardianSetWeightsUpdate:        Keeper.updateGuardianSetWeights,
+	vaa.ActionPauseBridge:                     Keeper.pauseBridge,
+	vaa.ActionResumeBridge:                    Keeper.resumeBridge,
+	vaa.ActionChainRateLimitUpdate:            Keeper.updateChainRateLimit,
+	vaa.ActionMsgShutdownUpdate:               Keeper.updateMsgShutdown,
+	vaa.ActionForwardFeeUpdate:                Keeper.updateForwardFee,
+	vaa.ActionMaintenanceWindowUpdate:         Keeper.updateMaintenanceWindow,
+	vaa.ActionValidatorTransitionWindowUpdate: Keeper.updateValidatorTransitionWindow,
+}
@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"encoding/binary"
+	"math"
+	"sync"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/wormhole-foundation/wormchain/testutil/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// The core registry is global, so the new version is only registered once per
+// test binary, and numbered apart from the versions other tests expect to be
+// unknown.
+var registerForwardFeeUpdateVersion sync.Once
+
+const forwardFeeUpdateTestVersion = math.MaxUint8
+
+func TestExecuteGovernanceVAANewPayloadVersion(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 10)
+	k.SetConfig(ctx, types.Config{
+		GovernanceEmitter:     vaa.GovernanceEmitter[:],
+		GovernanceChain:       uint32(vaa.GovernanceChain),
+		ChainId:               uint32(vaa.ChainIDWormchain),
+		GuardianSetExpiration: 86400,
+	})
+	signer := sdk.AccAddress(make([]byte, 20))
+
+	set := createNewGuardianSet(k, ctx, guardians)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: set.Index})
+
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	var coreModule [32]byte
+	copy(coreModule[:], vaa.CoreModule)
+	execute := func(payload []byte) error {
+		gov_msg := types.NewGovernanceMessage(coreModule, byte(vaa.ActionForwardFeeUpdate), uint16(vaa.ChainIDWormchain), payload)
+		v := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), gov_msg.MarshalBinary())
+		vBz, _ := v.Marshal()
+		_, err := msgServer.ExecuteGovernanceVAA(sdk.WrapSDKContext(ctx), &types.MsgExecuteGovernanceVAA{
+			Signer: signer.String(),
+			Vaa:    vBz,
+		})
+		return err
+	}
+
+	// The new version carries the fee as a uint32 and decodes into the
+	// version 1 format, the handler of the action is unchanged
+	registerForwardFeeUpdateVersion.Do(func() {
+		types.CoreGovernancePayloads.Register(coreModule, byte(vaa.ActionForwardFeeUpdate), forwardFeeUpdateTestVersion, func(payload []byte) ([]byte, error) {
+			if len(payload) != 4 {
+				return nil, types.ErrInvalidGovernancePayloadLength
+			}
+			feeBps := binary.BigEndian.Uint32(payload)
+			if feeBps > math.MaxUint16 {
+				return nil, types.ErrInvalidFeeParams
+			}
+			return binary.BigEndian.AppendUint16(nil, uint16(feeBps)), nil
+		})
+	})
+
+	require.NoError(t, execute(append([]byte{forwardFeeUpdateTestVersion}, binary.BigEndian.AppendUint32(nil, 30)...)))
+	assert.Equal(t, uint32(30), k.GetParams(ctx).ForwardFeeBps)
+
+	// Version 1 keeps executing
+	require.NoError(t, execute(append([]byte{1}, binary.BigEndian.AppendUint16(nil, 20)...)))
+	assert.Equal(t, uint32(20), k.GetParams(ctx).ForwardFeeBps)
+
+	// The handler validates the decoded payload of every version
+	err := execute(append([]byte{forwardFeeUpdateTestVersion}, binary.BigEndian.AppendUint32(nil, types.MaxForwardFeeBps+1)...))
+	assert.ErrorIs(t, err, types.ErrInvalidFeeParams)
+	assert.Equal(t, uint32(20), k.GetParams(ctx).ForwardFeeBps)
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+// SetGovernanceSubmitter allowlists an account to submit governance VAAs
+func (k Keeper) SetGovernanceSubmitter(ctx sdk.Context, governanceSubmitter types.GovernanceSubmitter) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GovernanceSubmitterKey))
+	b := k.cdc.MustMarshal(&governanceSubmitter)
+	store.Set([]byte(governanceSubmitter.Address), b)
+}
+
+// GetGovernanceSubmitter returns the allowlist entry of an account
+func (k Keeper) GetGovernanceSubmitter(ctx sdk.Context, address string) (val types.GovernanceSubmitter, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GovernanceSubmitterKey))
+
+	b := store.Get([]byte(address))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveGovernanceSubmitter removes an account from the governance submitter allowlist
+func (k Keeper) RemoveGovernanceSubmitter(ctx sdk.Context, address string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GovernanceSubmitterKey))
+	store.Delete([]byte(address))
+}
+
+// GetAllGovernanceSubmitter returns all allowlisted governance submitters
+func (k Keeper) GetAllGovernanceSubmitter(ctx sdk.Context) (list []types.GovernanceSubmitter) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GovernanceSubmitterKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.GovernanceSubmitter
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// IsGovernanceSubmitterAllowed returns whether an account can submit
+// governance VAAs. The allowlist is only enforced once it has an entry.
+func (k Keeper) IsGovernanceSubmitterAllowed(ctx sdk.Context, address string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GovernanceSubmitterKey))
+	if store.Has([]byte(address)) {
+		return true
+	}
+
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	return !iterator.Valid()
+}
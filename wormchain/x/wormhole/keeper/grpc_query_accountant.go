@@ -0,0 +1,332 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// The types below mirror the JSON encoding of the global accountant contract's
+// query messages and responses. Addresses are hex encoded and amounts are
+// decimal strings.
+
+type accountantAccountKey struct {
+	ChainId      uint16 `json:"chain_id"`
+	TokenChain   uint16 `json:"token_chain"`
+	TokenAddress string `json:"token_address"`
+}
+
+type accountantAccount struct {
+	Key     accountantAccountKey `json:"key"`
+	Balance sdk.Int              `json:"balance"`
+}
+
+type accountantTransferKey struct {
+	EmitterChain   uint16 `json:"emitter_chain"`
+	EmitterAddress string `json:"emitter_address"`
+	Sequence       uint64 `json:"sequence"`
+}
+
+type accountantTransferData struct {
+	Amount         sdk.Int `json:"amount"`
+	TokenChain     uint16  `json:"token_chain"`
+	TokenAddress   string  `json:"token_address"`
+	RecipientChain uint16  `json:"recipient_chain"`
+}
+
+type accountantTransfer struct {
+	Key  accountantTransferKey  `json:"key"`
+	Data accountantTransferData `json:"data"`
+}
+
+type accountantObservation struct {
+	Digest           []byte      `json:"digest"`
+	TxHash           []byte      `json:"tx_hash"`
+	Signatures       json.Number `json:"signatures"`
+	GuardianSetIndex uint32      `json:"guardian_set_index"`
+	EmitterChain     uint16      `json:"emitter_chain"`
+}
+
+type accountantPendingTransfer struct {
+	Key  accountantTransferKey   `json:"key"`
+	Data []accountantObservation `json:"data"`
+}
+
+type accountantPageRequest struct {
+	StartAfter interface{} `json:"start_after,omitempty"`
+	Limit      uint32      `json:"limit,omitempty"`
+}
+
+func (k Keeper) AccountantBalance(c context.Context, req *types.QueryAccountantBalanceRequest) (*types.QueryAccountantBalanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	key, err := toAccountantAccountKey(req.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	var balance sdk.Int
+	if err := k.queryAccountant(sdk.UnwrapSDKContext(c), req.Contract, map[string]interface{}{"balance": key}, &balance); err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccountantBalanceResponse{Account: types.AccountantAccount{Key: req.Key, Balance: balance}}, nil
+}
+
+func (k Keeper) AccountantAccountAll(c context.Context, req *types.QueryAllAccountantAccountRequest) (*types.QueryAllAccountantAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	page := accountantPageRequest{Limit: req.Limit}
+	if req.StartAfter != nil {
+		key, err := toAccountantAccountKey(*req.StartAfter)
+		if err != nil {
+			return nil, err
+		}
+		page.StartAfter = key
+	}
+
+	var res struct {
+		Accounts []accountantAccount `json:"accounts"`
+	}
+	if err := k.queryAccountant(sdk.UnwrapSDKContext(c), req.Contract, map[string]interface{}{"all_accounts": page}, &res); err != nil {
+		return nil, err
+	}
+
+	accounts := make([]types.AccountantAccount, 0, len(res.Accounts))
+	for _, account := range res.Accounts {
+		key, err := fromAccountantAccountKey(account.Key)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, types.AccountantAccount{Key: key, Balance: account.Balance})
+	}
+
+	return &types.QueryAllAccountantAccountResponse{Accounts: accounts}, nil
+}
+
+func (k Keeper) AccountantTransferAll(c context.Context, req *types.QueryAllAccountantTransferRequest) (*types.QueryAllAccountantTransferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	page := accountantPageRequest{Limit: req.Limit}
+	if req.StartAfter != nil {
+		key, err := toAccountantTransferKey(*req.StartAfter)
+		if err != nil {
+			return nil, err
+		}
+		page.StartAfter = key
+	}
+
+	// The contract returns each transfer as a (transfer, digest) tuple.
+	var res struct {
+		Transfers [][2]json.RawMessage `json:"transfers"`
+	}
+	if err := k.queryAccountant(sdk.UnwrapSDKContext(c), req.Contract, map[string]interface{}{"all_transfers": page}, &res); err != nil {
+		return nil, err
+	}
+
+	transfers := make([]types.AccountantTransfer, 0, len(res.Transfers))
+	for _, tuple := range res.Transfers {
+		var transfer accountantTransfer
+		var digest []byte
+		if err := json.Unmarshal(tuple[0], &transfer); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if err := json.Unmarshal(tuple[1], &digest); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		t, err := fromAccountantTransfer(transfer.Key, transfer.Data, digest)
+		if err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, t)
+	}
+
+	return &types.QueryAllAccountantTransferResponse{Transfers: transfers}, nil
+}
+
+func (k Keeper) AccountantPendingTransferAll(c context.Context, req *types.QueryAllAccountantPendingTransferRequest) (*types.QueryAllAccountantPendingTransferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	page := accountantPageRequest{Limit: req.Limit}
+	if req.StartAfter != nil {
+		key, err := toAccountantTransferKey(*req.StartAfter)
+		if err != nil {
+			return nil, err
+		}
+		page.StartAfter = key
+	}
+
+	var res struct {
+		Pending []accountantPendingTransfer `json:"pending"`
+	}
+	if err := k.queryAccountant(sdk.UnwrapSDKContext(c), req.Contract, map[string]interface{}{"all_pending_transfers": page}, &res); err != nil {
+		return nil, err
+	}
+
+	pending := make([]types.AccountantPendingTransfer, 0, len(res.Pending))
+	for _, p := range res.Pending {
+		key, err := fromAccountantTransferKey(p.Key)
+		if err != nil {
+			return nil, err
+		}
+		pending = append(pending, types.AccountantPendingTransfer{
+			Key:          key,
+			Observations: fromAccountantObservations(p.Data),
+		})
+	}
+
+	return &types.QueryAllAccountantPendingTransferResponse{Pending: pending}, nil
+}
+
+func (k Keeper) AccountantTransferStatus(c context.Context, req *types.QueryAccountantTransferStatusRequest) (*types.QueryAccountantTransferStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	key, err := toAccountantTransferKey(req.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Committed *struct {
+			Data   accountantTransferData `json:"data"`
+			Digest []byte                 `json:"digest"`
+		} `json:"committed"`
+		Pending []accountantObservation `json:"pending"`
+	}
+	if err := k.queryAccountant(sdk.UnwrapSDKContext(c), req.Contract, map[string]interface{}{"transfer_status": key}, &res); err != nil {
+		return nil, err
+	}
+
+	if res.Committed != nil {
+		committed, err := fromAccountantTransfer(key, res.Committed.Data, res.Committed.Digest)
+		if err != nil {
+			return nil, err
+		}
+		return &types.QueryAccountantTransferStatusResponse{Committed: &committed}, nil
+	}
+
+	return &types.QueryAccountantTransferStatusResponse{Pending: fromAccountantObservations(res.Pending)}, nil
+}
+
+// queryAccountant sends a smart query to a global accountant contract and
+// decodes its JSON response into res.
+func (k Keeper) queryAccountant(ctx sdk.Context, contract string, query interface{}, res interface{}) error {
+	if !k.setWasmView {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "x/wasmd not set")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(contract)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	queryBz, err := json.Marshal(query)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	resBz, err := k.wasmViewKeeper.QuerySmart(ctx, contractAddr, queryBz)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(resBz, res); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
+
+func toAccountantAccountKey(key types.AccountantAccountKey) (accountantAccountKey, error) {
+	if key.ChainId > math.MaxUint16 || key.TokenChain > math.MaxUint16 {
+		return accountantAccountKey{}, status.Error(codes.InvalidArgument, "chain ids must fit in 16 bits")
+	}
+	if len(key.TokenAddress) != 32 {
+		return accountantAccountKey{}, status.Error(codes.InvalidArgument, "token address must be 32 bytes")
+	}
+	return accountantAccountKey{
+		ChainId:      uint16(key.ChainId),
+		TokenChain:   uint16(key.TokenChain),
+		TokenAddress: hex.EncodeToString(key.TokenAddress),
+	}, nil
+}
+
+func fromAccountantAccountKey(key accountantAccountKey) (types.AccountantAccountKey, error) {
+	tokenAddress, err := hex.DecodeString(key.TokenAddress)
+	if err != nil {
+		return types.AccountantAccountKey{}, status.Error(codes.Internal, err.Error())
+	}
+	return types.AccountantAccountKey{
+		ChainId:      uint32(key.ChainId),
+		TokenChain:   uint32(key.TokenChain),
+		TokenAddress: tokenAddress,
+	}, nil
+}
+
+func toAccountantTransferKey(key types.AccountantTransferKey) (accountantTransferKey, error) {
+	if key.EmitterChain > math.MaxUint16 {
+		return accountantTransferKey{}, status.Error(codes.InvalidArgument, "emitter chain must fit in 16 bits")
+	}
+	if len(key.EmitterAddress) != 32 {
+		return accountantTransferKey{}, status.Error(codes.InvalidArgument, "emitter address must be 32 bytes")
+	}
+	return accountantTransferKey{
+		EmitterChain:   uint16(key.EmitterChain),
+		EmitterAddress: hex.EncodeToString(key.EmitterAddress),
+		Sequence:       key.Sequence,
+	}, nil
+}
+
+func fromAccountantTransferKey(key accountantTransferKey) (types.AccountantTransferKey, error) {
+	emitterAddress, err := hex.DecodeString(key.EmitterAddress)
+	if err != nil {
+		return types.AccountantTransferKey{}, status.Error(codes.Internal, err.Error())
+	}
+	return types.AccountantTransferKey{
+		EmitterChain:   uint32(key.EmitterChain),
+		EmitterAddress: emitterAddress,
+		Sequence:       key.Sequence,
+	}, nil
+}
+
+func fromAccountantTransfer(key accountantTransferKey, data accountantTransferData, digest []byte) (types.AccountantTransfer, error) {
+	transferKey, err := fromAccountantTransferKey(key)
+	if err != nil {
+		return types.AccountantTransfer{}, err
+	}
+	tokenAddress, err := hex.DecodeString(data.TokenAddress)
+	if err != nil {
+		return types.AccountantTransfer{}, status.Error(codes.Internal, err.Error())
+	}
+	return types.AccountantTransfer{
+		Key:            transferKey,
+		Amount:         data.Amount,
+		TokenChain:     uint32(data.TokenChain),
+		TokenAddress:   tokenAddress,
+		RecipientChain: uint32(data.RecipientChain),
+		Digest:         digest,
+	}, nil
+}
+
+func fromAccountantObservations(data []accountantObservation) []types.AccountantObservation {
+	observations := make([]types.AccountantObservation, 0, len(data))
+	for _, o := range data {
+		observations = append(observations, types.AccountantObservation{
+			Digest:           o.Digest,
+			TxHash:           o.TxHash,
+			Signatures:       o.Signatures.String(),
+			GuardianSetIndex: o.GuardianSetIndex,
+			EmitterChain:     uint32(o.EmitterChain),
+		})
+	}
+	return observations
+}
@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/wormhole-foundation/wormchain/testutil/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+// mockAccountant answers smart queries with canned JSON responses keyed by
+// the name of the query.
+type mockAccountant struct {
+	responses map[string]string
+	queries   map[string]json.RawMessage
+}
+
+func (m *mockAccountant) QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
+	var query map[string]json.RawMessage
+	if err := json.Unmarshal(req, &query); err != nil {
+		return nil, err
+	}
+	for name, args := range query {
+		m.queries[name] = args
+		if res, ok := m.responses[name]; ok {
+			return []byte(res), nil
+		}
+	}
+	return nil, errors.New("unknown query")
+}
+
+func (m *mockAccountant) QueryRaw(ctx sdk.Context, contractAddress sdk.AccAddress, key []byte) []byte {
+	return nil
+}
+
+func (m *mockAccountant) GetCodeInfo(ctx sdk.Context, codeID uint64) *wasmtypes.CodeInfo {
+	return nil
+}
+
+func TestAccountantQueries(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	contract := sdk.AccAddress(bytes.Repeat([]byte{1}, 32)).String()
+	tokenAddress := bytes.Repeat([]byte{0xab}, 32)
+	emitterAddress := bytes.Repeat([]byte{0xcd}, 32)
+	tokenHex := "abababababababababababababababababababababababababababababababab"
+	emitterHex := "cdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcd"
+
+	accountKey := types.AccountantAccountKey{ChainId: 2, TokenChain: 2, TokenAddress: tokenAddress}
+	transferKey := types.AccountantTransferKey{EmitterChain: 2, EmitterAddress: emitterAddress, Sequence: 7}
+
+	// The wasm keeper must be set
+	_, err := k.AccountantBalance(wctx, &types.QueryAccountantBalanceRequest{Contract: contract, Key: accountKey})
+	assert.ErrorIs(t, err, sdkerrors.ErrNotSupported)
+
+	accountant := &mockAccountant{
+		queries: map[string]json.RawMessage{},
+		responses: map[string]string{
+			"balance":      `"1000"`,
+			"all_accounts": `{"accounts":[{"key":{"chain_id":2,"token_chain":2,"token_address":"` + tokenHex + `"},"balance":"1000"}]}`,
+			"all_transfers": `{"transfers":[[{"key":{"emitter_chain":2,"emitter_address":"` + emitterHex + `","sequence":7},` +
+				`"data":{"amount":"500","token_chain":2,"token_address":"` + tokenHex + `","recipient_chain":4}},"AQID"]]}`,
+			"all_pending_transfers": `{"pending":[{"key":{"emitter_chain":2,"emitter_address":"` + emitterHex + `","sequence":8},` +
+				`"data":[{"digest":"BAUG","tx_hash":"BwgJ","signatures":"5","guardian_set_index":4,"emitter_chain":2}]}]}`,
+			"transfer_status": `{"committed":{"data":{"amount":"500","token_chain":2,"token_address":"` + tokenHex + `","recipient_chain":4},"digest":"AQID"}}`,
+		},
+	}
+	k.SetWasmViewKeeper(accountant)
+
+	balance, err := k.AccountantBalance(wctx, &types.QueryAccountantBalanceRequest{Contract: contract, Key: accountKey})
+	require.NoError(t, err)
+	assert.Equal(t, types.AccountantAccount{Key: accountKey, Balance: sdk.NewInt(1000)}, balance.Account)
+	assert.JSONEq(t, `{"chain_id":2,"token_chain":2,"token_address":"`+tokenHex+`"}`, string(accountant.queries["balance"]))
+
+	accounts, err := k.AccountantAccountAll(wctx, &types.QueryAllAccountantAccountRequest{Contract: contract, Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, []types.AccountantAccount{{Key: accountKey, Balance: sdk.NewInt(1000)}}, accounts.Accounts)
+	assert.JSONEq(t, `{"limit":10}`, string(accountant.queries["all_accounts"]))
+
+	transfer := types.AccountantTransfer{
+		Key:            transferKey,
+		Amount:         sdk.NewInt(500),
+		TokenChain:     2,
+		TokenAddress:   tokenAddress,
+		RecipientChain: 4,
+		Digest:         []byte{1, 2, 3},
+	}
+	transfers, err := k.AccountantTransferAll(wctx, &types.QueryAllAccountantTransferRequest{Contract: contract, StartAfter: &transferKey})
+	require.NoError(t, err)
+	assert.Equal(t, []types.AccountantTransfer{transfer}, transfers.Transfers)
+	assert.JSONEq(t, `{"start_after":{"emitter_chain":2,"emitter_address":"`+emitterHex+`","sequence":7}}`, string(accountant.queries["all_transfers"]))
+
+	pending, err := k.AccountantPendingTransferAll(wctx, &types.QueryAllAccountantPendingTransferRequest{Contract: contract})
+	require.NoError(t, err)
+	assert.Equal(t, []types.AccountantPendingTransfer{{
+		Key: types.AccountantTransferKey{EmitterChain: 2, EmitterAddress: emitterAddress, Sequence: 8},
+		Observations: []types.AccountantObservation{{
+			Digest:           []byte{4, 5, 6},
+			TxHash:           []byte{7, 8, 9},
+			Signatures:       "5",
+			GuardianSetIndex: 4,
+			EmitterChain:     2,
+		}},
+	}}, pending.Pending)
+
+	transferStatus, err := k.AccountantTransferStatus(wctx, &types.QueryAccountantTransferStatusRequest{Contract: contract, Key: transferKey})
+	require.NoError(t, err)
+	assert.Equal(t, &transfer, transferStatus.Committed)
+	assert.Empty(t, transferStatus.Pending)
+
+	accountant.responses["transfer_status"] = `{"pending":[{"digest":"BAUG","tx_hash":"BwgJ","signatures":"5","guardian_set_index":4,"emitter_chain":2}]}`
+	transferStatus, err = k.AccountantTransferStatus(wctx, &types.QueryAccountantTransferStatusRequest{Contract: contract, Key: transferKey})
+	require.NoError(t, err)
+	assert.Nil(t, transferStatus.Committed)
+	assert.Len(t, transferStatus.Pending, 1)
+
+	// Invalid requests
+	_, err = k.AccountantBalance(wctx, &types.QueryAccountantBalanceRequest{Contract: "not a contract", Key: accountKey})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = k.AccountantBalance(wctx, &types.QueryAccountantBalanceRequest{Contract: contract, Key: types.AccountantAccountKey{ChainId: 2, TokenChain: 2}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = k.AccountantTransferStatus(wctx, &types.QueryAccountantTransferStatusRequest{
+		Contract: contract,
+		Key:      types.AccountantTransferKey{EmitterChain: 1 << 16, EmitterAddress: emitterAddress},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
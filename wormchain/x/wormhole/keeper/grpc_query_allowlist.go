@@ -44,15 +44,30 @@ func (k Keeper) Allowlist(c context.Context, req *types.QueryValidatorAllowlist)
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
+	allowlist := []*types.ValidatorAllowedAddress{}
 	ctx := sdk.UnwrapSDKContext(c)
 
-	allowedAddresses := k.GetAllAllowedAddresses(ctx)
-	allowlist := []*types.ValidatorAllowedAddress{}
-	for _, allowed := range allowedAddresses {
-		if allowed.ValidatorAddress == req.ValidatorAddress {
-			allowlist = append(allowlist, &allowed)
+	store := ctx.KVStore(k.storeKey)
+	allowedStore := prefix.NewStore(store, types.KeyPrefix(types.ValidatorAllowlistKey))
+
+	pageRes, err := query.FilteredPaginate(allowedStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var allowedAddress types.ValidatorAllowedAddress
+		if err := k.cdc.Unmarshal(value, &allowedAddress); err != nil {
+			return false, err
 		}
+		if allowedAddress.ValidatorAddress != req.ValidatorAddress {
+			return false, nil
+		}
+
+		if accumulate {
+			allowlist = append(allowlist, &allowedAddress)
+		}
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &types.QueryValidatorAllowlistResponse{Allowlist: allowlist, ValidatorAddress: req.ValidatorAddress}, nil
+	return &types.QueryValidatorAllowlistResponse{Allowlist: allowlist, ValidatorAddress: req.ValidatorAddress, Pagination: pageRes}, nil
 }
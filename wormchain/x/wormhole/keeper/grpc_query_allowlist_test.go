@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/wormhole-foundation/wormchain/testutil/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+func TestAllowlistQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.WormholeKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	validator := getRandomAddress()
+	var msgs []*types.ValidatorAllowedAddress
+	for i := 0; i < 5; i++ {
+		allowed := types.ValidatorAllowedAddress{
+			ValidatorAddress: validator,
+			AllowedAddress:   getRandomAddress(),
+			ExpirationHeight: uint64(i),
+		}
+		keeper.SetValidatorAllowedAddress(ctx, allowed)
+		msgs = append(msgs, &allowed)
+
+		// entries of other validators are filtered out
+		keeper.SetValidatorAllowedAddress(ctx, types.ValidatorAllowedAddress{
+			ValidatorAddress: getRandomAddress(),
+			AllowedAddress:   getRandomAddress(),
+		})
+	}
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryValidatorAllowlist {
+		return &types.QueryValidatorAllowlist{
+			ValidatorAddress: validator,
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.Allowlist(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Allowlist), step)
+			require.Subset(t, msgs, resp.Allowlist)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		var found []*types.ValidatorAllowedAddress
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.Allowlist(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Allowlist), step)
+			found = append(found, resp.Allowlist...)
+			next = resp.Pagination.NextKey
+		}
+		require.ElementsMatch(t, msgs, found)
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.Allowlist(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t, msgs, resp.Allowlist)
+		require.Equal(t, validator, resp.ValidatorAddress)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.Allowlist(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
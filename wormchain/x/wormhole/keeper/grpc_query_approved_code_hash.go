@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ApprovedCodeHashAll(c context.Context, req *types.QueryAllApprovedCodeHashRequest) (*types.QueryAllApprovedCodeHashResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var approvedCodeHashes []types.ApprovedCodeHash
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	approvedCodeHashStore := prefix.NewStore(store, types.KeyPrefix(types.ApprovedCodeHashKeyPrefix))
+
+	pageRes, err := query.Paginate(approvedCodeHashStore, req.Pagination, func(key []byte, value []byte) error {
+		var approvedCodeHash types.ApprovedCodeHash
+		if err := k.cdc.Unmarshal(value, &approvedCodeHash); err != nil {
+			return err
+		}
+
+		approvedCodeHashes = append(approvedCodeHashes, approvedCodeHash)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllApprovedCodeHashResponse{ApprovedCodeHash: approvedCodeHashes, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ApprovedCodeHash(c context.Context, req *types.QueryGetApprovedCodeHashRequest) (*types.QueryGetApprovedCodeHashResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	approvedCodeHash, found := k.GetApprovedCodeHash(ctx, req.CodeId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryGetApprovedCodeHashResponse{ApprovedCodeHash: approvedCodeHash}, nil
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ConsensusGuardianSetValidators(c context.Context, req *types.QueryConsensusGuardianSetValidatorsRequest) (*types.QueryConsensusGuardianSetValidatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	consensusIndex, found := k.GetConsensusGuardianSetIndex(ctx)
+	if !found {
+		return nil, status.Error(codes.InvalidArgument, "not found")
+	}
+	guardianSet, found := k.GetGuardianSet(ctx, consensusIndex.Index)
+	if !found {
+		return nil, status.Error(codes.InvalidArgument, "not found")
+	}
+
+	res := &types.QueryConsensusGuardianSetValidatorsResponse{
+		GuardianSetIndex: guardianSet.Index,
+	}
+	for i, key := range guardianSet.Keys {
+		guardian := types.ConsensusGuardianValidator{
+			Index:       uint32(i),
+			GuardianKey: key,
+		}
+		if validator, found := k.GetGuardianValidator(ctx, key); found {
+			guardian.ValidatorAddress = sdk.ValAddress(validator.ValidatorAddr).String()
+			guardian.Registered = true
+		} else {
+			res.UnregisteredCount++
+		}
+		res.Guardians = append(res.Guardians, guardian)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/wormhole-foundation/wormchain/testutil/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+func TestConsensusGuardianSetValidatorsQuery(t *testing.T) {
+	keeper, ctx := keepertest.WormholeKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	_, err := keeper.ConsensusGuardianSetValidators(wctx, &types.QueryConsensusGuardianSetValidatorsRequest{})
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "not found"))
+	_, err = keeper.ConsensusGuardianSetValidators(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+
+	guardians, _ := createNGuardianValidator(keeper, ctx, 3)
+	// the last guardian of the set has not registered a validator
+	unregistered := types.GuardianValidator{GuardianKey: make([]byte, 20)}
+	set := createNewGuardianSet(keeper, ctx, append(guardians, unregistered))
+	keeper.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: set.Index})
+
+	response, err := keeper.ConsensusGuardianSetValidators(wctx, &types.QueryConsensusGuardianSetValidatorsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, set.Index, response.GuardianSetIndex)
+	require.Equal(t, uint32(1), response.UnregisteredCount)
+	require.Len(t, response.Guardians, 4)
+	for i, guardian := range guardians {
+		require.Equal(t, types.ConsensusGuardianValidator{
+			Index:            uint32(i),
+			GuardianKey:      guardian.GuardianKey,
+			ValidatorAddress: sdk.ValAddress(guardian.ValidatorAddr).String(),
+			Registered:       true,
+		}, response.Guardians[i])
+	}
+	require.Equal(t, types.ConsensusGuardianValidator{
+		Index:       3,
+		GuardianKey: unregistered.GuardianKey,
+	}, response.Guardians[3])
+}
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) CoreContract(c context.Context, req *types.QueryCoreContractRequest) (*types.QueryCoreContractResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	coreContract := k.GetCoreContract(ctx)
+
+	return &types.QueryCoreContractResponse{ContractAddress: coreContract.ContractAddress}, nil
+}
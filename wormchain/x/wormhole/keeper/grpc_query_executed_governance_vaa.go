@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ExecutedGovernanceVAAAll(c context.Context, req *types.QueryAllExecutedGovernanceVAARequest) (*types.QueryAllExecutedGovernanceVAAResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var executedVAAs []types.ExecutedGovernanceVAA
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	executedStore := prefix.NewStore(store, types.KeyPrefix(types.ExecutedGovernanceVAAKeyPrefix))
+
+	pageRes, err := query.Paginate(executedStore, req.Pagination, func(key []byte, value []byte) error {
+		var executed types.ExecutedGovernanceVAA
+		if err := k.cdc.Unmarshal(value, &executed); err != nil {
+			return err
+		}
+
+		executedVAAs = append(executedVAAs, executed)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllExecutedGovernanceVAAResponse{ExecutedGovernanceVAA: executedVAAs, Pagination: pageRes}, nil
+}
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/wormhole-foundation/wormchain/testutil/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+func createNExecutedGovernanceVAA(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.ExecutedGovernanceVAA {
+	items := make([]types.ExecutedGovernanceVAA, n)
+	for i := range items {
+		digest := [32]byte{}
+		digest[0] = byte(i)
+		items[i].Digest = digest[:]
+		items[i].Height = int64(i)
+
+		keeper.SetExecutedGovernanceVAA(ctx, items[i])
+	}
+	return items
+}
+
+func TestExecutedGovernanceVAAQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.WormholeKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNExecutedGovernanceVAA(keeper, ctx, 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllExecutedGovernanceVAARequest {
+		return &types.QueryAllExecutedGovernanceVAARequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.ExecutedGovernanceVAAAll(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.ExecutedGovernanceVAA), step)
+			require.Subset(t, msgs, resp.ExecutedGovernanceVAA)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.ExecutedGovernanceVAAAll(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.ExecutedGovernanceVAA), step)
+			require.Subset(t, msgs, resp.ExecutedGovernanceVAA)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.ExecutedGovernanceVAAAll(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.ExecutedGovernanceVAAAll(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
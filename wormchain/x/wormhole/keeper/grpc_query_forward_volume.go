@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ForwardVolumeAll(c context.Context, req *types.QueryAllForwardVolumeRequest) (*types.QueryAllForwardVolumeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var forwardVolumes []types.ForwardVolume
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	forwardVolumeStore := prefix.NewStore(store, types.KeyPrefix(types.ForwardVolumeKey))
+	if req.ChannelId != "" {
+		forwardVolumeStore = prefix.NewStore(forwardVolumeStore, types.ForwardVolumeChannelKey(req.ChannelId))
+	}
+
+	pageRes, err := query.Paginate(forwardVolumeStore, req.Pagination, func(key []byte, value []byte) error {
+		var forwardVolume types.ForwardVolume
+		if err := k.cdc.Unmarshal(value, &forwardVolume); err != nil {
+			return err
+		}
+
+		forwardVolumes = append(forwardVolumes, forwardVolume)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllForwardVolumeResponse{ForwardVolume: forwardVolumes, Pagination: pageRes}, nil
+}
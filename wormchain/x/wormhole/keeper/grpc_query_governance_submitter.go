@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) GovernanceSubmitterAll(c context.Context, req *types.QueryAllGovernanceSubmitterRequest) (*types.QueryAllGovernanceSubmitterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var governanceSubmitters []types.GovernanceSubmitter
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	governanceSubmitterStore := prefix.NewStore(store, types.KeyPrefix(types.GovernanceSubmitterKey))
+
+	pageRes, err := query.Paginate(governanceSubmitterStore, req.Pagination, func(key []byte, value []byte) error {
+		var governanceSubmitter types.GovernanceSubmitter
+		if err := k.cdc.Unmarshal(value, &governanceSubmitter); err != nil {
+			return err
+		}
+
+		governanceSubmitters = append(governanceSubmitters, governanceSubmitter)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllGovernanceSubmitterResponse{GovernanceSubmitter: governanceSubmitters, Pagination: pageRes}, nil
+}
+
+func (k Keeper) GovernanceSubmitter(c context.Context, req *types.QueryGetGovernanceSubmitterRequest) (*types.QueryGetGovernanceSubmitterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetGovernanceSubmitter(ctx, req.Address)
+	if !found {
+		return nil, status.Error(codes.InvalidArgument, "not found")
+	}
+
+	return &types.QueryGetGovernanceSubmitterResponse{GovernanceSubmitter: val}, nil
+}
@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/wormhole-foundation/wormchain/testutil/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+func createNGovernanceSubmitter(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.GovernanceSubmitter {
+	items := make([]types.GovernanceSubmitter, n)
+	for i := range items {
+		items[i].Address = getRandomAddress()
+
+		keeper.SetGovernanceSubmitter(ctx, items[i])
+	}
+	return items
+}
+
+func TestGovernanceSubmitterQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.WormholeKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNGovernanceSubmitter(keeper, ctx, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetGovernanceSubmitterRequest
+		response *types.QueryGetGovernanceSubmitterResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetGovernanceSubmitterRequest{Address: msgs[0].Address},
+			response: &types.QueryGetGovernanceSubmitterResponse{GovernanceSubmitter: msgs[0]},
+		},
+		{
+			desc:     "Second",
+			request:  &types.QueryGetGovernanceSubmitterRequest{Address: msgs[1].Address},
+			response: &types.QueryGetGovernanceSubmitterResponse{GovernanceSubmitter: msgs[1]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetGovernanceSubmitterRequest{Address: getRandomAddress()},
+			err:     status.Error(codes.InvalidArgument, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.GovernanceSubmitter(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
+
+func TestGovernanceSubmitterQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.WormholeKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNGovernanceSubmitter(keeper, ctx, 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllGovernanceSubmitterRequest {
+		return &types.QueryAllGovernanceSubmitterRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.GovernanceSubmitterAll(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.GovernanceSubmitter), step)
+			require.Subset(t, msgs, resp.GovernanceSubmitter)
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.GovernanceSubmitterAll(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.GovernanceSubmitterAll(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) GuardianHeartbeatAll(c context.Context, req *types.QueryAllGuardianHeartbeatRequest) (*types.QueryAllGuardianHeartbeatResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var guardianHeartbeats []types.GuardianHeartbeat
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	guardianHeartbeatStore := prefix.NewStore(store, types.KeyPrefix(types.GuardianHeartbeatKey))
+
+	// Expired heartbeats are only pruned at the end of the block, so skip them
+	pageRes, err := query.FilteredPaginate(guardianHeartbeatStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var guardianHeartbeat types.GuardianHeartbeat
+		if err := k.cdc.Unmarshal(value, &guardianHeartbeat); err != nil {
+			return false, err
+		}
+		if guardianHeartbeat.Expired(ctx.BlockTime()) {
+			return false, nil
+		}
+
+		if accumulate {
+			guardianHeartbeats = append(guardianHeartbeats, guardianHeartbeat)
+		}
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllGuardianHeartbeatResponse{GuardianHeartbeat: guardianHeartbeats, Pagination: pageRes}, nil
+}
+
+func (k Keeper) GuardianHeartbeat(c context.Context, req *types.QueryGetGuardianHeartbeatRequest) (*types.QueryGetGuardianHeartbeatResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetGuardianHeartbeat(ctx, req.GuardianKey)
+	if !found || val.Expired(ctx.BlockTime()) {
+		return nil, status.Error(codes.InvalidArgument, "not found")
+	}
+
+	return &types.QueryGetGuardianHeartbeatResponse{GuardianHeartbeat: val}, nil
+}
@@ -53,3 +53,36 @@ func (k Keeper) GuardianSet(c context.Context, req *types.QueryGetGuardianSetReq
 
 	return &types.QueryGetGuardianSetResponse{GuardianSet: guardianSet}, nil
 }
+
+func (k Keeper) GuardianSetHistory(c context.Context, req *types.QueryGuardianSetHistoryRequest) (*types.QueryGuardianSetHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var entries []types.GuardianSetHistoryEntry
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	guardianSetStore := prefix.NewStore(store, types.KeyPrefix(types.GuardianSetKey))
+
+	pageRes, err := query.Paginate(guardianSetStore, req.Pagination, func(key []byte, value []byte) error {
+		var guardianSet types.GuardianSet
+		if err := k.cdc.Unmarshal(value, &guardianSet); err != nil {
+			return err
+		}
+
+		entries = append(entries, types.GuardianSetHistoryEntry{
+			GuardianSet:      guardianSet,
+			ActivationHeight: k.GetGuardianSetActivationHeight(ctx, guardianSet.Index),
+			ExpirationTime:   guardianSet.ExpirationTime,
+			Valid:            k.IsGuardianSetValid(ctx, guardianSet),
+		})
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGuardianSetHistoryResponse{GuardianSets: entries, Pagination: pageRes}, nil
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -101,3 +102,43 @@ func TestGuardianSetQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestGuardianSetHistoryQuery(t *testing.T) {
+	keeper, ctx := keepertest.WormholeKeeper(t)
+	ctx = ctx.WithBlockHeight(5).WithBlockTime(time.Unix(1000, 0))
+
+	// expired, still within its expiration window, no expiry and latest
+	sets := []types.GuardianSet{
+		{Index: 0, ExpirationTime: 999},
+		{Index: 1, ExpirationTime: 1000},
+		{Index: 2},
+		{Index: 3},
+	}
+	for i, set := range sets {
+		_, err := keeper.AppendGuardianSet(ctx.WithBlockHeight(int64(10*i)), set)
+		require.NoError(t, err)
+	}
+	wctx := sdk.WrapSDKContext(ctx)
+
+	resp, err := keeper.GuardianSetHistory(wctx, &types.QueryGuardianSetHistoryRequest{
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(sets)), resp.Pagination.Total)
+	require.Equal(t, []types.GuardianSetHistoryEntry{
+		{GuardianSet: sets[0], ActivationHeight: 0, ExpirationTime: 999, Valid: false},
+		{GuardianSet: sets[1], ActivationHeight: 10, ExpirationTime: 1000, Valid: true},
+		{GuardianSet: sets[2], ActivationHeight: 20, ExpirationTime: 0, Valid: false},
+		{GuardianSet: sets[3], ActivationHeight: 30, ExpirationTime: 0, Valid: true},
+	}, resp.GuardianSets)
+
+	resp, err = keeper.GuardianSetHistory(wctx, &types.QueryGuardianSetHistoryRequest{
+		Pagination: &query.PageRequest{Offset: 1, Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GuardianSets, 2)
+	require.Equal(t, sets[1], resp.GuardianSets[0].GuardianSet)
+
+	_, err = keeper.GuardianSetHistory(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
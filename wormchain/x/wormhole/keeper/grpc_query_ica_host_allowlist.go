@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) IcaHostAllowlistAll(c context.Context, req *types.QueryAllIcaHostAllowlistRequest) (*types.QueryAllIcaHostAllowlistResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var allowlist []types.IcaHostAllowlistEntry
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	allowlistStore := prefix.NewStore(store, types.KeyPrefix(types.IcaHostAllowlistKey))
+	if req.ConnectionId != "" {
+		allowlistStore = prefix.NewStore(allowlistStore, types.IcaHostAllowlistConnectionKey(req.ConnectionId))
+	}
+
+	pageRes, err := query.Paginate(allowlistStore, req.Pagination, func(key []byte, value []byte) error {
+		var entry types.IcaHostAllowlistEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+
+		allowlist = append(allowlist, entry)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllIcaHostAllowlistResponse{Allowlist: allowlist, Pagination: pageRes}, nil
+}
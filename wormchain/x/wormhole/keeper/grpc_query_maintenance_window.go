@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) MaintenanceWindow(c context.Context, req *types.QueryMaintenanceWindowRequest) (*types.QueryMaintenanceWindowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	window, found := k.GetMaintenanceWindow(ctx)
+	if !found {
+		return &types.QueryMaintenanceWindowResponse{}, nil
+	}
+
+	return &types.QueryMaintenanceWindowResponse{Window: &window, Active: window.IsActive(ctx.BlockHeight())}, nil
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) MsgShutdownAll(c context.Context, req *types.QueryAllMsgShutdownRequest) (*types.QueryAllMsgShutdownResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var msgTypeURLs []string
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	msgShutdownStore := prefix.NewStore(store, types.KeyPrefix(types.MsgShutdownKey))
+
+	pageRes, err := query.Paginate(msgShutdownStore, req.Pagination, func(key []byte, value []byte) error {
+		msgTypeURLs = append(msgTypeURLs, string(key))
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllMsgShutdownResponse{MsgTypeUrls: msgTypeURLs, Pagination: pageRes}, nil
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ObservationTallyAll(c context.Context, req *types.QueryAllObservationTallyRequest) (*types.QueryAllObservationTallyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var observationTallies []types.ObservationTally
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	observationTallyStore := prefix.NewStore(store, types.KeyPrefix(types.ObservationTallyKey))
+
+	pageRes, err := query.Paginate(observationTallyStore, req.Pagination, func(key []byte, value []byte) error {
+		var observationTally types.ObservationTally
+		if err := k.cdc.Unmarshal(value, &observationTally); err != nil {
+			return err
+		}
+
+		observationTallies = append(observationTallies, observationTally)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllObservationTallyResponse{ObservationTally: observationTallies, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ObservationTally(c context.Context, req *types.QueryGetObservationTallyRequest) (*types.QueryGetObservationTallyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetObservationTally(ctx, req.Digest)
+	if !found {
+		return nil, status.Error(codes.InvalidArgument, "not found")
+	}
+
+	var quorumWeight uint64
+	if guardianSet, found := k.GetGuardianSet(ctx, val.GuardianSetIndex); found {
+		quorumWeight = k.ObservationQuorumScheme(ctx, guardianSet).Quorum(len(guardianSet.Keys))
+	}
+
+	return &types.QueryGetObservationTallyResponse{ObservationTally: val, QuorumWeight: quorumWeight}, nil
+}
+
+func (k Keeper) GuardianSetWeights(c context.Context, req *types.QueryGetGuardianSetWeightsRequest) (*types.QueryGetGuardianSetWeightsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	guardianSet, found := k.GetGuardianSet(ctx, req.GuardianSetIndex)
+	if !found {
+		return nil, status.Error(codes.InvalidArgument, "not found")
+	}
+
+	weights := k.GetGuardianWeights(ctx, guardianSet)
+	totalWeight := totalGuardianWeight(weights)
+
+	return &types.QueryGetGuardianSetWeightsResponse{
+		GuardianSetWeights: types.GuardianSetWeights{
+			GuardianSetIndex: guardianSet.Index,
+			Weights:          weights,
+		},
+		TotalWeight:  totalWeight,
+		QuorumWeight: k.QuorumWeight(ctx, totalWeight),
+	}, nil
+}
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ChainRateLimitAll(c context.Context, req *types.QueryAllChainRateLimitRequest) (*types.QueryAllChainRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var chainRateLimits []types.ChainRateLimit
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	chainRateLimitStore := prefix.NewStore(store, types.KeyPrefix(types.ChainRateLimitKeyPrefix))
+
+	pageRes, err := query.Paginate(chainRateLimitStore, req.Pagination, func(key []byte, value []byte) error {
+		var chainRateLimit types.ChainRateLimit
+		if err := k.cdc.Unmarshal(value, &chainRateLimit); err != nil {
+			return err
+		}
+
+		chainRateLimits = append(chainRateLimits, chainRateLimit)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllChainRateLimitResponse{ChainRateLimit: chainRateLimits, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ChainRateLimit(c context.Context, req *types.QueryGetChainRateLimitRequest) (*types.QueryGetChainRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := vaa.ChainIDFromNumber(req.ChainId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetChainRateLimit(ctx, uint16(req.ChainId))
+	if !found {
+		return nil, status.Error(codes.InvalidArgument, "not found")
+	}
+
+	flow := k.GetChainFlow(ctx, val)
+	var available uint64
+	if flow < val.Limit {
+		available = val.Limit - flow
+	}
+
+	return &types.QueryGetChainRateLimitResponse{ChainRateLimit: val, Flow: flow, Available: available}, nil
+}
+
+func (k Keeper) QueuedObservationAll(c context.Context, req *types.QueryAllQueuedObservationRequest) (*types.QueryAllQueuedObservationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var queuedObservations []types.QueuedObservation
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	queuedObservationStore := prefix.NewStore(store, types.KeyPrefix(types.QueuedObservationKeyPrefix))
+
+	pageRes, err := query.Paginate(queuedObservationStore, req.Pagination, func(key []byte, value []byte) error {
+		var queuedObservation types.QueuedObservation
+		if err := k.cdc.Unmarshal(value, &queuedObservation); err != nil {
+			return err
+		}
+
+		queuedObservations = append(queuedObservations, queuedObservation)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllQueuedObservationResponse{QueuedObservation: queuedObservations, Pagination: pageRes}, nil
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) RegisteredEmitterAll(c context.Context, req *types.QueryAllRegisteredEmitterRequest) (*types.QueryAllRegisteredEmitterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var registeredEmitters []types.RegisteredEmitter
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	registeredEmitterStore := prefix.NewStore(store, types.KeyPrefix(types.RegisteredEmitterKeyPrefix))
+
+	pageRes, err := query.Paginate(registeredEmitterStore, req.Pagination, func(key []byte, value []byte) error {
+		var registeredEmitter types.RegisteredEmitter
+		if err := k.cdc.Unmarshal(value, &registeredEmitter); err != nil {
+			return err
+		}
+
+		registeredEmitters = append(registeredEmitters, registeredEmitter)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllRegisteredEmitterResponse{RegisteredEmitter: registeredEmitters, Pagination: pageRes}, nil
+}
+
+func (k Keeper) RegisteredEmitter(c context.Context, req *types.QueryGetRegisteredEmitterRequest) (*types.QueryGetRegisteredEmitterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := vaa.ChainIDFromNumber(req.ChainId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetRegisteredEmitter(
+		ctx,
+		req.Module,
+		uint16(req.ChainId),
+	)
+	if !found {
+		return nil, status.Error(codes.InvalidArgument, "not found")
+	}
+
+	return &types.QueryGetRegisteredEmitterResponse{RegisteredEmitter: val}, nil
+}
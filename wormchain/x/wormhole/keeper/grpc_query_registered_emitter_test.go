@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/wormhole-foundation/wormchain/testutil/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+func createNRegisteredEmitter(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.RegisteredEmitter {
+	items := make([]types.RegisteredEmitter, n)
+	for i := range items {
+		emitter := [32]byte{}
+		emitter[31] = byte(i + 1)
+		items[i].Module = "TokenBridge"
+		items[i].ChainId = uint32(i + 1)
+		items[i].EmitterAddress = emitter[:]
+
+		keeper.SetRegisteredEmitter(ctx, items[i])
+	}
+	return items
+}
+
+func TestRegisteredEmitterQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.WormholeKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNRegisteredEmitter(keeper, ctx, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetRegisteredEmitterRequest
+		response *types.QueryGetRegisteredEmitterResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetRegisteredEmitterRequest{Module: msgs[0].Module, ChainId: msgs[0].ChainId},
+			response: &types.QueryGetRegisteredEmitterResponse{RegisteredEmitter: msgs[0]},
+		},
+		{
+			desc:     "Second",
+			request:  &types.QueryGetRegisteredEmitterRequest{Module: msgs[1].Module, ChainId: msgs[1].ChainId},
+			response: &types.QueryGetRegisteredEmitterResponse{RegisteredEmitter: msgs[1]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetRegisteredEmitterRequest{Module: "NFTBridge", ChainId: msgs[0].ChainId},
+			err:     status.Error(codes.InvalidArgument, "not found"),
+		},
+		{
+			desc:    "InvalidChainId",
+			request: &types.QueryGetRegisteredEmitterRequest{Module: msgs[0].Module, ChainId: 1 << 16},
+			err:     status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.RegisteredEmitter(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
+
+func TestRegisteredEmitterQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.WormholeKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNRegisteredEmitter(keeper, ctx, 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllRegisteredEmitterRequest {
+		return &types.QueryAllRegisteredEmitterRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.RegisteredEmitterAll(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.RegisteredEmitter), step)
+			require.Subset(t, msgs, resp.RegisteredEmitter)
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.RegisteredEmitterAll(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.RegisteredEmitterAll(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
+
+func TestRegisteredEmitterWasmQuery(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	msgs := createNRegisteredEmitter(k, ctx, 2)
+	querier := keeper.WormholeQuerier(*k)
+
+	res, err := querier(ctx, []byte(`{"registered_emitter":{"module":"TokenBridge","chain_id":2}}`))
+	require.NoError(t, err)
+	var response struct {
+		EmitterAddress []byte `json:"emitter_address"`
+	}
+	require.NoError(t, json.Unmarshal(res, &response))
+	require.Equal(t, msgs[1].EmitterAddress, response.EmitterAddress)
+
+	_, err = querier(ctx, []byte(`{"registered_emitter":{"module":"NFTBridge","chain_id":2}}`))
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+}
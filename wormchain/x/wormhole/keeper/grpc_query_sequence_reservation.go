@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) NextSequence(c context.Context, req *types.QueryNextSequenceRequest) (*types.QueryNextSequenceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	emitterBytes, err := hex.DecodeString(req.Emitter)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	emitter, err := types.EmitterAddressFromBytes32(emitterBytes)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.Contract == "" {
+		return &types.QueryNextSequenceResponse{Sequence: k.GetNextSequence(ctx, emitter)}, nil
+	}
+
+	contract, err := sdk.AccAddressFromBech32(req.Contract)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	sequence, err := k.GetContractNextSequence(ctx, contract, emitter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryNextSequenceResponse{Sequence: sequence}, nil
+}
+
+func (k Keeper) SequenceReservationAll(c context.Context, req *types.QueryAllSequenceReservationRequest) (*types.QueryAllSequenceReservationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var sequenceReservations []types.SequenceReservation
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	sequenceReservationStore := prefix.NewStore(store, types.KeyPrefix(types.SequenceReservationKeyPrefix))
+	if req.Emitter != "" {
+		sequenceReservationStore = prefix.NewStore(sequenceReservationStore, types.SequenceReservationEmitterPrefix(req.Emitter))
+	}
+
+	pageRes, err := query.Paginate(sequenceReservationStore, req.Pagination, func(key []byte, value []byte) error {
+		var sequenceReservation types.SequenceReservation
+		if err := k.cdc.Unmarshal(value, &sequenceReservation); err != nil {
+			return err
+		}
+
+		sequenceReservations = append(sequenceReservations, sequenceReservation)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllSequenceReservationResponse{SequenceReservation: sequenceReservations, Pagination: pageRes}, nil
+}
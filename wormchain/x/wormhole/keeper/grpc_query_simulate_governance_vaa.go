@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SimulateGovernanceVAA executes a governance VAA the way MsgExecuteGovernanceVAA
+// and MsgExecuteGatewayGovernanceVaa would, on a cache of the state that is
+// discarded afterwards. Wasmd governance VAAs only authorize the wasmd messages
+// that carry them, so they are verified but not executed. VAAs that would fail
+// are reported through the valid, error, codespace and code fields, so callers
+// still get the decoded action.
+func (k Keeper) SimulateGovernanceVAA(c context.Context, req *types.QuerySimulateGovernanceVAARequest) (*types.QuerySimulateGovernanceVAAResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	v, err := ParseVAA(req.Vaa)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(v.Payload) < 35 {
+		return nil, status.Error(codes.InvalidArgument, types.ErrGovernanceHeaderTooShort.Error())
+	}
+
+	// [32-byte module][uint8 action][uint16 target_chain]
+	var module [32]byte
+	copy(module[:], v.Payload[:32])
+	action := v.Payload[32]
+
+	res := &types.QuerySimulateGovernanceVAAResponse{
+		Valid:       true,
+		Digest:      v.SigningDigest().Bytes(),
+		Module:      types.GovernanceModuleName(module),
+		Action:      uint32(action),
+		ActionName:  types.GovernanceActionName(module, action),
+		TargetChain: uint32(binary.BigEndian.Uint16(v.Payload[33:35])),
+	}
+	// The execution reports a payload that can't be decoded.
+	if fields, err := types.DecodeGovernancePayload(module, action, v.Payload[35:]); err == nil {
+		res.PayloadFields = fields
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.
+		WithEventManager(sdk.NewEventManager()).
+		WithValue(simulationKey{}, true)
+	msgServer := NewMsgServerImpl(k)
+	signer := authtypes.NewModuleAddress(types.ModuleName).String()
+
+	var coreModule [32]byte
+	copy(coreModule[:], vaa.CoreModule)
+	switch module {
+	case coreModule:
+		_, err = msgServer.ExecuteGovernanceVAA(sdk.WrapSDKContext(cacheCtx), &types.MsgExecuteGovernanceVAA{
+			Vaa:    req.Vaa,
+			Signer: signer,
+		})
+	case vaa.GatewayModule:
+		_, err = msgServer.ExecuteGatewayGovernanceVaa(sdk.WrapSDKContext(cacheCtx), &types.MsgExecuteGatewayGovernanceVaa{
+			Vaa:    req.Vaa,
+			Signer: signer,
+		})
+	case vaa.WasmdModule:
+		_, _, err = k.VerifyGovernanceVAA(cacheCtx, v, vaa.WasmdModule)
+	default:
+		err = sdkerrors.Wrapf(types.ErrInvalidGovernanceModule, "module %x is not executed on wormchain", module)
+	}
+	if err != nil {
+		res.Valid = false
+		res.Error = err.Error()
+		res.Codespace, res.Code, _ = sdkerrors.ABCIInfo(err, false)
+		return res, nil
+	}
+
+	res.Events = sdk.StringifyEvents(cacheCtx.EventManager().ABCIEvents())
+
+	return res, nil
+}
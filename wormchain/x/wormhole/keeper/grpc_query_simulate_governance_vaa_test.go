@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/wormhole-foundation/wormchain/testutil/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestSimulateGovernanceVAAQuery(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	k.SetConfig(ctx, types.Config{
+		GovernanceEmitter:     vaa.GovernanceEmitter[:],
+		GovernanceChain:       uint32(vaa.GovernanceChain),
+		ChainId:               uint32(vaa.ChainIDWormchain),
+		GuardianSetExpiration: 86400,
+	})
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 10)
+	set := createNewGuardianSet(k, ctx, guardians)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: set.Index})
+	signer := sdk.AccAddress(make([]byte, 20))
+
+	payload, err := vaa.BodyWormchainSignatureGasUpdate{GasPerSignature: 42}.Serialize()
+	require.NoError(t, err)
+	v := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), payload)
+	vBz, err := v.Marshal()
+	require.NoError(t, err)
+
+	res, err := k.SimulateGovernanceVAA(wctx, &types.QuerySimulateGovernanceVAARequest{Vaa: vBz})
+	require.NoError(t, err)
+	assert.True(t, res.Valid, res.Error)
+	assert.Equal(t, v.SigningDigest().Bytes(), res.Digest)
+	assert.Equal(t, "Core", res.Module)
+	assert.Equal(t, uint32(vaa.ActionSignatureGasUpdate), res.Action)
+	assert.Equal(t, "SignatureGasUpdate", res.ActionName)
+	assert.Equal(t, uint32(vaa.ChainIDWormchain), res.TargetChain)
+	assert.Equal(t, []types.GovernancePayloadField{{Name: "GasPerSignature", Value: "42"}}, res.PayloadFields)
+	var eventTypes []string
+	for _, event := range res.Events {
+		eventTypes = append(eventTypes, event.Type)
+	}
+	assert.Contains(t, eventTypes, "wormhole_foundation.wormchain.wormhole.EventSignatureVerificationGasUpdate")
+
+	// Nothing is committed, so the VAA can still be executed
+	assert.NotEqual(t, uint64(42), k.GetSignatureVerificationGas(ctx))
+	assert.False(t, k.IsGovernanceVAAExecuted(ctx, v.SigningDigest().Bytes()))
+	_, err = keeper.NewMsgServerImpl(*k).ExecuteGovernanceVAA(wctx, &types.MsgExecuteGovernanceVAA{
+		Signer: signer.String(),
+		Vaa:    vBz,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), k.GetSignatureVerificationGas(ctx))
+
+	// Failures are reported along with the decoded action
+	res, err = k.SimulateGovernanceVAA(wctx, &types.QuerySimulateGovernanceVAARequest{Vaa: vBz})
+	require.NoError(t, err)
+	assert.False(t, res.Valid)
+	assert.Contains(t, res.Error, types.ErrGovernanceVaaAlreadyExecuted.Error())
+	assert.Equal(t, types.ModuleName, res.Codespace)
+	assert.Equal(t, types.ErrGovernanceVaaAlreadyExecuted.ABCICode(), res.Code)
+	assert.Equal(t, "SignatureGasUpdate", res.ActionName)
+	assert.Len(t, res.PayloadFields, 1)
+	assert.Empty(t, res.Events)
+
+	// Gateway actions
+	contract := [32]byte{1, 2, 3}
+	payload, err = vaa.BodyGatewayCoreContract{ContractAddr: contract}.Serialize()
+	require.NoError(t, err)
+	v = generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), payload)
+	vBz, err = v.Marshal()
+	require.NoError(t, err)
+	res, err = k.SimulateGovernanceVAA(wctx, &types.QuerySimulateGovernanceVAARequest{Vaa: vBz})
+	require.NoError(t, err)
+	assert.True(t, res.Valid, res.Error)
+	assert.Equal(t, "Gateway", res.Module)
+	assert.Equal(t, "SetCoreContract", res.ActionName)
+	assert.Equal(t, []types.GovernancePayloadField{{Name: "ContractAddr", Value: hex.EncodeToString(contract[:])}}, res.PayloadFields)
+	assert.Empty(t, k.GetCoreContract(ctx).ContractAddress)
+
+	// Wasmd actions are only verified
+	wasmHash := [32]byte{4, 5, 6}
+	payload, err = vaa.BodyWormchainStoreCode{WasmHash: wasmHash}.Serialize()
+	require.NoError(t, err)
+	v = generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), payload)
+	vBz, err = v.Marshal()
+	require.NoError(t, err)
+	res, err = k.SimulateGovernanceVAA(wctx, &types.QuerySimulateGovernanceVAARequest{Vaa: vBz})
+	require.NoError(t, err)
+	assert.True(t, res.Valid, res.Error)
+	assert.Equal(t, "WasmdModule", res.Module)
+	assert.Equal(t, "StoreCode", res.ActionName)
+	assert.Equal(t, []types.GovernancePayloadField{{Name: "WasmHash", Value: hex.EncodeToString(wasmHash[:])}}, res.PayloadFields)
+
+	// Without quorum the VAA is still decoded but not valid
+	payload, err = vaa.BodyWormchainPruneGuardianSets{KeepFromIndex: 7}.Serialize()
+	require.NoError(t, err)
+	v = generateVaa(set.Index, privateKeys[:6], vaa.ChainID(vaa.GovernanceChain), payload)
+	vBz, err = v.Marshal()
+	require.NoError(t, err)
+	res, err = k.SimulateGovernanceVAA(wctx, &types.QuerySimulateGovernanceVAARequest{Vaa: vBz})
+	require.NoError(t, err)
+	assert.False(t, res.Valid)
+	assert.Contains(t, res.Error, types.ErrNoQuorum.Error())
+	assert.Equal(t, "PruneGuardianSets", res.ActionName)
+	assert.Equal(t, []types.GovernancePayloadField{{Name: "KeepFromIndex", Value: "7"}}, res.PayloadFields)
+
+	// Modules that aren't executed on wormchain are not valid
+	payload, err = vaa.BodyTokenBridgeRegisterChain{Module: "TokenBridge", ChainID: vaa.ChainIDEthereum}.Serialize()
+	require.NoError(t, err)
+	v = generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), payload)
+	vBz, err = v.Marshal()
+	require.NoError(t, err)
+	res, err = k.SimulateGovernanceVAA(wctx, &types.QuerySimulateGovernanceVAARequest{Vaa: vBz})
+	require.NoError(t, err)
+	assert.False(t, res.Valid)
+	assert.Contains(t, res.Error, types.ErrInvalidGovernanceModule.Error())
+	assert.Empty(t, res.ActionName)
+
+	// Unparseable VAAs are rejected
+	_, err = k.SimulateGovernanceVAA(wctx, &types.QuerySimulateGovernanceVAARequest{Vaa: []byte{1}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = k.SimulateGovernanceVAA(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ArchivedVAAAll(c context.Context, req *types.QueryAllArchivedVAARequest) (*types.QueryAllArchivedVAAResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := vaa.ChainIDFromNumber(req.EmitterChain); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if len(req.EmitterAddress) != 0 && (req.EmitterChain == 0 || len(req.EmitterAddress) != 32) {
+		return nil, status.Error(codes.InvalidArgument, "emitter address must be 32 bytes and requires an emitter chain")
+	}
+	hasSequenceRange := req.SequenceStart != 0 || req.SequenceEnd != 0
+	if hasSequenceRange && len(req.EmitterAddress) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "sequence range requires an emitter address")
+	}
+	if req.SequenceEnd != 0 && req.SequenceEnd < req.SequenceStart {
+		return nil, status.Error(codes.InvalidArgument, "sequence end is below sequence start")
+	}
+
+	var archivedVAAs []types.ArchivedVAA
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	archivedVAAStore := prefix.NewStore(store, types.KeyPrefix(types.ArchivedVAAKeyPrefix))
+
+	var keyPrefix []byte
+	if req.EmitterChain != 0 {
+		keyPrefix = binary.BigEndian.AppendUint16(nil, uint16(req.EmitterChain))
+		keyPrefix = append(keyPrefix, req.EmitterAddress...)
+	}
+	archivedVAAStore = prefix.NewStore(archivedVAAStore, keyPrefix)
+
+	pageRes, err := query.FilteredPaginate(archivedVAAStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		if hasSequenceRange {
+			// keys are relative to the emitter, so they are just the sequence
+			sequence := binary.BigEndian.Uint64(key)
+			if sequence < req.SequenceStart || (req.SequenceEnd != 0 && sequence > req.SequenceEnd) {
+				return false, nil
+			}
+		}
+
+		if accumulate {
+			var archivedVAA types.ArchivedVAA
+			if err := k.cdc.Unmarshal(value, &archivedVAA); err != nil {
+				return false, err
+			}
+			archivedVAAs = append(archivedVAAs, archivedVAA)
+		}
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllArchivedVAAResponse{ArchivedVaa: archivedVAAs, Pagination: pageRes}, nil
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// VerifyVaa runs the same guardian set and quorum checks as VerifyVAA. VAAs
+// that parse but fail verification are reported through the valid and error
+// fields, so callers still get the parsed body. The codespace and code of the
+// error let callers tell the failures apart without matching the message.
+func (k Keeper) VerifyVaa(c context.Context, req *types.QueryVerifyVAARequest) (*types.QueryVerifyVAAResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	v, err := ParseVAA(req.Vaa)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res := &types.QueryVerifyVAAResponse{
+		Valid:            true,
+		Digest:           v.SigningDigest().Bytes(),
+		Version:          uint32(v.Version),
+		GuardianSetIndex: v.GuardianSetIndex,
+		NumSignatures:    uint32(len(v.Signatures)),
+		Timestamp:        uint32(v.Timestamp.Unix()),
+		Nonce:            v.Nonce,
+		EmitterChain:     uint32(v.EmitterChain),
+		EmitterAddress:   v.EmitterAddress.Bytes(),
+		Sequence:         v.Sequence,
+		ConsistencyLevel: uint32(v.ConsistencyLevel),
+		Payload:          v.Payload,
+	}
+
+	if err := k.VerifyVAA(ctx, v); err != nil {
+		res.Valid = false
+		res.Error = err.Error()
+		res.Codespace, res.Code, _ = sdkerrors.ABCIInfo(err, false)
+	}
+
+	return res, nil
+}
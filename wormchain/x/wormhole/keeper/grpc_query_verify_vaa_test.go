@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/wormhole-foundation/wormchain/testutil/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestVerifyVaaQuery(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 10)
+	set := createNewGuardianSet(k, ctx, guardians)
+
+	payload := []byte{1, 2, 3}
+	v := generateVaa(set.Index, privateKeys, vaa.ChainIDEthereum, payload)
+	vBz, err := v.Marshal()
+	require.NoError(t, err)
+
+	res, err := k.VerifyVaa(wctx, &types.QueryVerifyVAARequest{Vaa: vBz})
+	require.NoError(t, err)
+	assert.True(t, res.Valid)
+	assert.Empty(t, res.Error)
+	assert.Zero(t, res.Code)
+	assert.Equal(t, v.SigningDigest().Bytes(), res.Digest)
+	assert.Equal(t, set.Index, res.GuardianSetIndex)
+	assert.Equal(t, uint32(len(privateKeys)), res.NumSignatures)
+	assert.Equal(t, uint32(v.EmitterChain), res.EmitterChain)
+	assert.Equal(t, v.EmitterAddress.Bytes(), res.EmitterAddress)
+	assert.Equal(t, v.Sequence, res.Sequence)
+	assert.Equal(t, payload, res.Payload)
+
+	// Without quorum the VAA is still parsed but not valid
+	v = generateVaa(set.Index, privateKeys[:6], vaa.ChainIDEthereum, payload)
+	vBz, err = v.Marshal()
+	require.NoError(t, err)
+	res, err = k.VerifyVaa(wctx, &types.QueryVerifyVAARequest{Vaa: vBz})
+	require.NoError(t, err)
+	assert.False(t, res.Valid)
+	assert.Contains(t, res.Error, types.ErrNoQuorum.Error())
+	assert.Equal(t, types.ModuleName, res.Codespace)
+	assert.Equal(t, types.ErrNoQuorum.ABCICode(), res.Code)
+	assert.Equal(t, payload, res.Payload)
+
+	// Each verification failure has its own error code
+	for _, tc := range []struct {
+		label  string
+		modify func(v *vaa.VAA)
+		err    *sdkerrors.Error
+	}{
+		{"unknown guardian set", func(v *vaa.VAA) { v.GuardianSetIndex = set.Index + 1 }, types.ErrGuardianSetNotFound},
+		{"signer index out of bounds", func(v *vaa.VAA) { v.Signatures[9].Index = 10 }, types.ErrGuardianIndexOutOfBounds},
+		{"repeated signer index", func(v *vaa.VAA) { v.Signatures[1].Index = v.Signatures[0].Index }, types.ErrInvalidSignerIndexes},
+		{"invalid signature", func(v *vaa.VAA) { v.Signatures[3].Signature[0] ^= 0xff }, types.ErrSignaturesInvalid},
+	} {
+		t.Run(tc.label, func(t *testing.T) {
+			v := generateVaa(set.Index, privateKeys, vaa.ChainIDEthereum, payload)
+			tc.modify(&v)
+			vBz, err := v.Marshal()
+			require.NoError(t, err)
+			res, err := k.VerifyVaa(wctx, &types.QueryVerifyVAARequest{Vaa: vBz})
+			require.NoError(t, err)
+			assert.False(t, res.Valid)
+			assert.Equal(t, types.ModuleName, res.Codespace)
+			assert.Equal(t, tc.err.ABCICode(), res.Code)
+		})
+	}
+
+	// Unparseable VAAs are rejected
+	_, err = k.VerifyVaa(wctx, &types.QueryVerifyVAARequest{Vaa: []byte{1}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = k.VerifyVaa(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
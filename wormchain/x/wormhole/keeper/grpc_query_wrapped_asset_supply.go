@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	dbm "github.com/tendermint/tm-db"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) WrappedAssetSupply(c context.Context, req *types.QueryWrappedAssetSupplyRequest) (*types.QueryWrappedAssetSupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	chain, err := vaa.ChainIDFromNumber(req.Chain)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	addressBytes, err := hex.DecodeString(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(addressBytes) != 32 {
+		return nil, status.Error(codes.InvalidArgument, "token address must be 32 bytes")
+	}
+	var address [32]byte
+	copy(address[:], addressBytes)
+
+	denom, cw20, err := k.GetWrappedAssetDenom(ctx, chain, address)
+	if err != nil {
+		return nil, err
+	}
+
+	// The bank module has no index of the holders of a denom, so the holders
+	// are collected from all balances, in the order of their addresses, and
+	// paginated from memory.
+	holders := k.GetWrappedAssetHolders(ctx, denom)
+	holderStore := dbadapter.Store{DB: dbm.NewMemDB()}
+	for i := range holders {
+		holderStore.Set(binary.BigEndian.AppendUint64(nil, uint64(i)), k.cdc.MustMarshal(&holders[i]))
+	}
+
+	var page []types.WrappedAssetHolder
+	pageRes, err := query.Paginate(holderStore, req.Pagination, func(key []byte, value []byte) error {
+		var holder types.WrappedAssetHolder
+		if err := k.cdc.Unmarshal(value, &holder); err != nil {
+			return err
+		}
+
+		page = append(page, holder)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryWrappedAssetSupplyResponse{
+		Denom:        denom,
+		Cw20Contract: cw20.String(),
+		TotalSupply:  k.bankKeeper.GetSupply(ctx, denom),
+		HolderCount:  uint64(len(holders)),
+		Holders:      page,
+		Pagination:   pageRes,
+	}, nil
+}
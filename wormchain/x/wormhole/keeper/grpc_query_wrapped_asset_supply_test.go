@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/wormhole-foundation/wormchain/testutil/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+// mockTranslator serves the state of an ibc translator contract and the
+// wrapped registry of its token bridge contract.
+type mockTranslator struct {
+	state   map[string][]byte
+	wrapped map[string]string
+}
+
+func (m *mockTranslator) QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
+	var query struct {
+		WrappedRegistry struct {
+			Chain   uint16 `json:"chain"`
+			Address []byte `json:"address"`
+		} `json:"wrapped_registry"`
+	}
+	if err := json.Unmarshal(req, &query); err != nil {
+		return nil, err
+	}
+	address, ok := m.wrapped[hex.EncodeToString(query.WrappedRegistry.Address)]
+	if !ok || query.WrappedRegistry.Chain != 2 {
+		return nil, sdkerrors.ErrNotFound
+	}
+	return json.Marshal(map[string]string{"address": address})
+}
+
+func (m *mockTranslator) QueryRaw(ctx sdk.Context, contractAddress sdk.AccAddress, key []byte) []byte {
+	return m.state[string(key)]
+}
+
+func (m *mockTranslator) GetCodeInfo(ctx sdk.Context, codeID uint64) *wasmtypes.CodeInfo {
+	return nil
+}
+
+func TestWrappedAssetSupply(t *testing.T) {
+	k, bank, ctx := keepertest.WormholeKeeperAndBank(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	translator := sdk.AccAddress(bytes.Repeat([]byte{1}, 32)).String()
+	tokenBridge := sdk.AccAddress(bytes.Repeat([]byte{2}, 32)).String()
+	cw20 := sdk.AccAddress(bytes.Repeat([]byte{3}, 32)).String()
+	token := hex.EncodeToString(bytes.Repeat([]byte{4}, 32))
+	denom := "factory/" + translator + "/wrapped"
+
+	req := &types.QueryWrappedAssetSupplyRequest{Chain: 2, Address: token}
+
+	// The wasm keeper and the translator contract must be set
+	_, err := k.WrappedAssetSupply(wctx, req)
+	assert.ErrorIs(t, err, sdkerrors.ErrNotSupported)
+
+	denomsKey := binary.BigEndian.AppendUint16(nil, uint16(len("cw_denoms")))
+	denomsKey = append(append(denomsKey, "cw_denoms"...), cw20...)
+	k.SetWasmViewKeeper(&mockTranslator{
+		state: map[string][]byte{
+			"token_bridge_contract": []byte(`"` + tokenBridge + `"`),
+			string(denomsKey):       []byte(`"` + denom + `"`),
+		},
+		wrapped: map[string]string{token: cw20},
+	})
+	_, err = k.WrappedAssetSupply(wctx, req)
+	assert.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	k.StoreIbcComposabilityMwContract(ctx, types.IbcComposabilityMwContract{ContractAddress: translator})
+
+	holders := []sdk.AccAddress{
+		sdk.AccAddress(bytes.Repeat([]byte{5}, 20)),
+		sdk.AccAddress(bytes.Repeat([]byte{6}, 20)),
+		sdk.AccAddress(bytes.Repeat([]byte{7}, 20)),
+	}
+	bank.InitGenesis(ctx, &banktypes.GenesisState{
+		Params: banktypes.DefaultParams(),
+		Balances: []banktypes.Balance{
+			{Address: holders[0].String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(denom, 10), sdk.NewInt64Coin("uworm", 1))},
+			{Address: holders[1].String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(denom, 20))},
+			{Address: holders[2].String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(denom, 30))},
+			{Address: sdk.AccAddress(bytes.Repeat([]byte{8}, 20)).String(), Coins: sdk.NewCoins(sdk.NewInt64Coin("uworm", 5))},
+		},
+	})
+
+	req.Pagination = &query.PageRequest{Limit: 2, CountTotal: true}
+	res, err := k.WrappedAssetSupply(wctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, denom, res.Denom)
+	assert.Equal(t, cw20, res.Cw20Contract)
+	assert.Equal(t, sdk.NewInt64Coin(denom, 60), res.TotalSupply)
+	assert.Equal(t, uint64(3), res.HolderCount)
+	assert.Equal(t, uint64(3), res.Pagination.Total)
+	assert.Equal(t, []types.WrappedAssetHolder{
+		{Address: holders[0].String(), Amount: sdk.NewInt(10)},
+		{Address: holders[1].String(), Amount: sdk.NewInt(20)},
+	}, res.Holders)
+
+	req.Pagination = &query.PageRequest{Key: res.Pagination.NextKey}
+	res, err = k.WrappedAssetSupply(wctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, []types.WrappedAssetHolder{
+		{Address: holders[2].String(), Amount: sdk.NewInt(30)},
+	}, res.Holders)
+
+	// Tokens without a wrapped asset
+	_, err = k.WrappedAssetSupply(wctx, &types.QueryWrappedAssetSupplyRequest{Chain: 2, Address: hex.EncodeToString(make([]byte, 32))})
+	assert.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	_, err = k.WrappedAssetSupply(wctx, &types.QueryWrappedAssetSupplyRequest{Chain: 2, Address: "abcd"})
+	assert.Error(t, err)
+}
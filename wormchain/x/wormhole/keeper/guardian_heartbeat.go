@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+// MaxGuardianHeartbeatPrunePerBlock bounds the number of heartbeats removed in
+// a single EndBlock. Anything left over is pruned in the following blocks.
+const MaxGuardianHeartbeatPrunePerBlock = 1000
+
+// SetGuardianHeartbeat stores the latest heartbeat of a guardian, replacing
+// the previous one in the expiry index
+func (k Keeper) SetGuardianHeartbeat(ctx sdk.Context, heartbeat types.GuardianHeartbeat) {
+	k.RemoveGuardianHeartbeat(ctx, heartbeat.GuardianKey)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GuardianHeartbeatKey))
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GuardianHeartbeatExpiryKeyPrefix))
+	b := k.cdc.MustMarshal(&heartbeat)
+	store.Set(heartbeat.GuardianKey, b)
+	expiryStore.Set(types.GuardianHeartbeatExpiryKey(heartbeat.Expiry(), heartbeat.GuardianKey), []byte{})
+}
+
+// GetGuardianHeartbeat returns the latest heartbeat of a guardian, including
+// expired heartbeats that were not pruned yet
+func (k Keeper) GetGuardianHeartbeat(ctx sdk.Context, guardianKey []byte) (val types.GuardianHeartbeat, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GuardianHeartbeatKey))
+
+	b := store.Get(guardianKey)
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveGuardianHeartbeat removes the heartbeat of a guardian from the store
+func (k Keeper) RemoveGuardianHeartbeat(ctx sdk.Context, guardianKey []byte) {
+	heartbeat, found := k.GetGuardianHeartbeat(ctx, guardianKey)
+	if !found {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GuardianHeartbeatKey))
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GuardianHeartbeatExpiryKeyPrefix))
+	store.Delete(guardianKey)
+	expiryStore.Delete(types.GuardianHeartbeatExpiryKey(heartbeat.Expiry(), guardianKey))
+}
+
+// GetAllGuardianHeartbeat returns all guardianHeartbeat
+func (k Keeper) GetAllGuardianHeartbeat(ctx sdk.Context) (list []types.GuardianHeartbeat) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GuardianHeartbeatKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.GuardianHeartbeat
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// PruneGuardianHeartbeats removes the heartbeats that expired at the current
+// block time. Only the expired range of the expiry index is visited, and at
+// most MaxGuardianHeartbeatPrunePerBlock heartbeats are removed per call.
+func (k Keeper) PruneGuardianHeartbeats(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GuardianHeartbeatKey))
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GuardianHeartbeatExpiryKeyPrefix))
+
+	// heartbeats expire once their expiry is before the block time
+	now := ctx.BlockTime()
+	end := now.Unix()
+	if now.Nanosecond() > 0 {
+		end++
+	}
+	if end <= 0 {
+		return
+	}
+
+	iterator := expiryStore.Iterator(nil, binary.BigEndian.AppendUint64(nil, uint64(end)))
+	var pruned [][]byte
+	for ; iterator.Valid() && len(pruned) < MaxGuardianHeartbeatPrunePerBlock; iterator.Next() {
+		pruned = append(pruned, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, expiryKey := range pruned {
+		expiryStore.Delete(expiryKey)
+		store.Delete(expiryKey[8:])
+	}
+}
@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/wormhole-foundation/wormchain/testutil/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+func TestGuardianHeartbeat(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, _ := createNGuardianValidator(k, ctx, 3)
+	set := createNewGuardianSet(k, ctx, guardians[:2])
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: set.Index})
+
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1_700_000_000, 0))
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	heartbeat := func(ctx sdk.Context, validator types.GuardianValidator, nodeVersion string) error {
+		_, err := msgServer.GuardianHeartbeat(sdk.WrapSDKContext(ctx), &types.MsgGuardianHeartbeat{
+			Signer:      sdk.AccAddress(validator.ValidatorAddr).String(),
+			NodeVersion: nodeVersion,
+			Heights:     []types.ChainHeight{{ChainId: 2, Height: 100}},
+			Features:    []string{"ccq"},
+		})
+		return err
+	}
+
+	// Only the validators of guardians in the current or a future set can submit heartbeats
+	require.NoError(t, heartbeat(ctx, guardians[0], "v2.23.0"))
+	assert.ErrorIs(t, heartbeat(ctx, guardians[2], "v2.23.0"), types.ErrNotGuardianValidator)
+	assert.ErrorIs(t, heartbeat(ctx, types.GuardianValidator{ValidatorAddr: make([]byte, 20)}, "v2.23.0"), types.ErrNotGuardianValidator)
+
+	res, err := k.GuardianHeartbeat(sdk.WrapSDKContext(ctx), &types.QueryGetGuardianHeartbeatRequest{GuardianKey: guardians[0].GuardianKey})
+	require.NoError(t, err)
+	assert.Equal(t, types.GuardianHeartbeat{
+		GuardianKey:      guardians[0].GuardianKey,
+		ValidatorAddress: sdk.AccAddress(guardians[0].ValidatorAddr).String(),
+		NodeVersion:      "v2.23.0",
+		Heights:          []types.ChainHeight{{ChainId: 2, Height: 100}},
+		Features:         []string{"ccq"},
+		Height:           10,
+		Timestamp:        1_700_000_000,
+	}, res.GuardianHeartbeat)
+
+	// A later heartbeat replaces the previous one
+	later := ctx.WithBlockHeight(20).WithBlockTime(ctx.BlockTime().Add(30 * time.Minute))
+	require.NoError(t, heartbeat(later, guardians[1], "v2.23.0"))
+	require.NoError(t, heartbeat(later, guardians[0], "v2.24.0"))
+	val, found := k.GetGuardianHeartbeat(later, guardians[0].GuardianKey)
+	require.True(t, found)
+	assert.Equal(t, "v2.24.0", val.NodeVersion)
+	assert.Equal(t, int64(20), val.Height)
+
+	// Heartbeats expire after the TTL. Queries skip them until they are pruned
+	k.SetGuardianHeartbeat(ctx, types.GuardianHeartbeat{GuardianKey: guardians[2].GuardianKey, Timestamp: ctx.BlockTime().Unix()})
+	expired := ctx.WithBlockTime(ctx.BlockTime().Add(types.GuardianHeartbeatTTL + time.Minute))
+	_, err = k.GuardianHeartbeat(sdk.WrapSDKContext(expired), &types.QueryGetGuardianHeartbeatRequest{GuardianKey: guardians[2].GuardianKey})
+	assert.Error(t, err)
+	all, err := k.GuardianHeartbeatAll(sdk.WrapSDKContext(expired), &types.QueryAllGuardianHeartbeatRequest{Pagination: &query.PageRequest{CountTotal: true}})
+	require.NoError(t, err)
+	assert.Len(t, all.GuardianHeartbeat, 2)
+	assert.Equal(t, uint64(2), all.Pagination.Total)
+	assert.Len(t, k.GetAllGuardianHeartbeat(expired), 3)
+
+	// The replaced first heartbeat of guardian 0 expired as well, but its
+	// later heartbeat is kept
+	k.PruneGuardianHeartbeats(expired)
+	assert.Len(t, k.GetAllGuardianHeartbeat(expired), 2)
+	_, found = k.GetGuardianHeartbeat(expired, guardians[2].GuardianKey)
+	assert.False(t, found)
+
+	// The remaining heartbeats are pruned once they expire
+	k.PruneGuardianHeartbeats(later.WithBlockTime(later.BlockTime().Add(types.GuardianHeartbeatTTL)))
+	assert.Len(t, k.GetAllGuardianHeartbeat(expired), 2)
+	k.PruneGuardianHeartbeats(later.WithBlockTime(later.BlockTime().Add(types.GuardianHeartbeatTTL + time.Nanosecond)))
+	assert.Empty(t, k.GetAllGuardianHeartbeat(expired))
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
 )
 
@@ -14,6 +15,69 @@ func (k Keeper) GetLatestGuardianSetIndex(ctx sdk.Context) uint32 {
 }
 
 func (k Keeper) UpdateGuardianSet(ctx sdk.Context, newGuardianSet types.GuardianSet) error {
+	if err := k.appendNewGuardianSet(ctx, newGuardianSet); err != nil {
+		return err
+	}
+
+	return k.TrySwitchToNewConsensusGuardianSet(ctx)
+}
+
+// ScheduleGuardianSetUpdate registers a new guardian set like UpdateGuardianSet,
+// but the set only becomes the consensus guardian set once the block height and
+// block time reach activationHeight and activationTime (unix seconds). Until
+// then guardians can register their validators against the new set. The switch
+// itself happens in EndBlock.
+func (k Keeper) ScheduleGuardianSetUpdate(ctx sdk.Context, newGuardianSet types.GuardianSet, activationHeight uint64, activationTime uint64) error {
+	if activationHeight == 0 && activationTime == 0 {
+		return sdkerrors.Wrap(types.ErrInvalidGuardianSetActivation, "activation height and time cannot both be zero")
+	}
+
+	if err := k.appendNewGuardianSet(ctx, newGuardianSet); err != nil {
+		return err
+	}
+
+	k.SetGuardianSetActivation(ctx, types.GuardianSetActivation{
+		Index:            newGuardianSet.Index,
+		ActivationHeight: activationHeight,
+		ActivationTime:   activationTime,
+	})
+
+	return nil
+}
+
+// ActivateScheduledGuardianSet switches the consensus guardian set to the
+// scheduled guardian set once its activation point has been reached. It is
+// called at the end of every block.
+func (k Keeper) ActivateScheduledGuardianSet(ctx sdk.Context) error {
+	activation, found := k.GetGuardianSetActivation(ctx)
+	if !found {
+		return nil
+	}
+
+	// A later guardian set update supersedes the schedule
+	if activation.Index != k.GetLatestGuardianSetIndex(ctx) {
+		k.RemoveGuardianSetActivation(ctx)
+		return nil
+	}
+
+	if !isGuardianSetActivationReached(ctx, activation) {
+		return nil
+	}
+
+	// From here on the set behaves like any other new guardian set, so if
+	// some guardians have not registered yet the switch happens once they do.
+	k.RemoveGuardianSetActivation(ctx)
+	return k.TrySwitchToNewConsensusGuardianSet(ctx)
+}
+
+func isGuardianSetActivationReached(ctx sdk.Context, activation types.GuardianSetActivation) bool {
+	return uint64(ctx.BlockHeight()) >= activation.ActivationHeight &&
+		uint64(ctx.BlockTime().Unix()) >= activation.ActivationTime
+}
+
+// appendNewGuardianSet appends newGuardianSet as the latest guardian set and
+// starts the expiration of the previous one.
+func (k Keeper) appendNewGuardianSet(ctx sdk.Context, newGuardianSet types.GuardianSet) error {
 	config, ok := k.GetConfig(ctx)
 	if !ok {
 		return types.ErrNoConfig
@@ -43,15 +107,50 @@ func (k Keeper) UpdateGuardianSet(ctx sdk.Context, newGuardianSet types.Guardian
 	k.setGuardianSet(ctx, oldSet)
 
 	// Emit event
+	added, removed := guardianKeyDiff(oldSet.Keys, newGuardianSet.Keys)
 	err = ctx.EventManager().EmitTypedEvent(&types.EventGuardianSetUpdate{
-		OldIndex: oldSet.Index,
-		NewIndex: oldSet.Index + 1,
+		OldIndex:    oldSet.Index,
+		NewIndex:    oldSet.Index + 1,
+		AddedKeys:   added,
+		RemovedKeys: removed,
 	})
-	if err != nil {
-		return err
+	return err
+}
+
+// isKnownGuardianKey returns whether key is part of any of guardianSets.
+func isKnownGuardianKey(guardianSets []types.GuardianSet, key []byte) bool {
+	for _, guardianSet := range guardianSets {
+		for _, guardianKey := range guardianSet.Keys {
+			if bytes.Equal(guardianKey, key) {
+				return true
+			}
+		}
 	}
+	return false
+}
 
-	return k.TrySwitchToNewConsensusGuardianSet(ctx)
+// guardianKeyDiff returns the keys of newKeys missing from oldKeys and the keys
+// of oldKeys missing from newKeys, each in the order of its source set.
+func guardianKeyDiff(oldKeys, newKeys [][]byte) (added, removed [][]byte) {
+	contains := func(keys [][]byte, key []byte) bool {
+		for _, k := range keys {
+			if bytes.Equal(k, key) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, key := range newKeys {
+		if !contains(oldKeys, key) {
+			added = append(added, key)
+		}
+	}
+	for _, key := range oldKeys {
+		if !contains(newKeys, key) {
+			removed = append(removed, key)
+		}
+	}
+	return
 }
 
 func (k Keeper) TrySwitchToNewConsensusGuardianSet(ctx sdk.Context) error {
@@ -66,6 +165,13 @@ func (k Keeper) TrySwitchToNewConsensusGuardianSet(ctx sdk.Context) error {
 		return nil
 	}
 
+	// a scheduled guardian set waits for its activation point
+	if activation, found := k.GetGuardianSetActivation(ctx); found && activation.Index == latestGuardianSetIndex {
+		if !isGuardianSetActivationReached(ctx, activation) {
+			return nil
+		}
+	}
+
 	latestGuardianSet, found := k.GetGuardianSet(ctx, latestGuardianSetIndex)
 	if !found {
 		return types.ErrGuardianSetNotFound
@@ -111,8 +217,8 @@ func (k Keeper) GetGuardianSetCount(ctx sdk.Context) uint32 {
 	return binary.BigEndian.Uint32(bz)
 }
 
-// setGuardianSetCount set the total number of guardianSet
-func (k Keeper) setGuardianSetCount(ctx sdk.Context, count uint32) {
+// SetGuardianSetCount set the total number of guardianSet
+func (k Keeper) SetGuardianSetCount(ctx sdk.Context, count uint32) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
 	byteKey := types.KeyPrefix(types.GuardianSetCountKey)
 	bz := make([]byte, 8)
@@ -133,7 +239,8 @@ func (k Keeper) AppendGuardianSet(
 	}
 
 	k.setGuardianSet(ctx, guardianSet)
-	k.setGuardianSetCount(ctx, count+1)
+	k.SetGuardianSetCount(ctx, count+1)
+	k.SetGuardianSetActivationHeight(ctx, guardianSet.Index, ctx.BlockHeight())
 
 	return count, nil
 }
@@ -142,13 +249,13 @@ func (k Keeper) AppendGuardianSet(
 func (k Keeper) setGuardianSet(ctx sdk.Context, guardianSet types.GuardianSet) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GuardianSetKey))
 	b := k.cdc.MustMarshal(&guardianSet)
-	store.Set(GetGuardianSetIDBytes(guardianSet.Index), b)
+	store.Set(types.GuardianSetIndexKey(guardianSet.Index), b)
 }
 
 // GetGuardianSet returns a guardianSet from its id
 func (k Keeper) GetGuardianSet(ctx sdk.Context, id uint32) (val types.GuardianSet, found bool) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GuardianSetKey))
-	b := store.Get(GetGuardianSetIDBytes(id))
+	b := store.Get(types.GuardianSetIndexKey(id))
 	if b == nil {
 		return val, false
 	}
@@ -156,6 +263,105 @@ func (k Keeper) GetGuardianSet(ctx sdk.Context, id uint32) (val types.GuardianSe
 	return val, true
 }
 
+// RemoveGuardianSet removes a guardianSet from the store. The guardian set
+// count is left untouched, so indices are never reused.
+func (k Keeper) RemoveGuardianSet(ctx sdk.Context, id uint32) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GuardianSetKey))
+	store.Delete(types.GuardianSetIndexKey(id))
+
+	heightStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GuardianSetActivationHeightKey))
+	heightStore.Delete(GetGuardianSetIDBytes(id))
+}
+
+// SetGuardianSetActivationHeight sets the block height at which a guardian set
+// was added
+func (k Keeper) SetGuardianSetActivationHeight(ctx sdk.Context, id uint32, height int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GuardianSetActivationHeightKey))
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	store.Set(GetGuardianSetIDBytes(id), bz)
+}
+
+// GetGuardianSetActivationHeight returns the block height at which a guardian
+// set was added. Guardian sets added before heights were tracked return 0.
+func (k Keeper) GetGuardianSetActivationHeight(ctx sdk.Context, id uint32) int64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GuardianSetActivationHeightKey))
+	bz := store.Get(GetGuardianSetIDBytes(id))
+	if bz == nil {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+// GetAllGuardianSetActivationHeight returns the activation heights of all
+// guardian sets
+func (k Keeper) GetAllGuardianSetActivationHeight(ctx sdk.Context) (list []types.GuardianSetActivationHeight) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GuardianSetActivationHeightKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, types.GuardianSetActivationHeight{
+			Index:  GetGuardianSetIDFromBytes(iterator.Key()),
+			Height: int64(binary.BigEndian.Uint64(iterator.Value())),
+		})
+	}
+
+	return
+}
+
+// IsGuardianSetValid returns whether VAAs signed by the guardian set are still
+// accepted: the latest guardian set never expires, older ones are valid until
+// their expiration time.
+func (k Keeper) IsGuardianSetValid(ctx sdk.Context, guardianSet types.GuardianSet) bool {
+	return guardianSet.Index == k.GetLatestGuardianSetIndex(ctx) ||
+		guardianSet.ExpirationTime >= uint64(ctx.BlockTime().Unix())
+}
+
+// PruneGuardianSets removes all guardian sets below keepFromIndex. Every one
+// of them must have expired, and neither the consensus guardian set nor any
+// set after it can be pruned. Sets that were already pruned are skipped.
+// Guardian validator registrations of guardians that are not in any of the
+// remaining sets are removed as well.
+func (k Keeper) PruneGuardianSets(ctx sdk.Context, keepFromIndex uint32) error {
+	consensusGuardianSetIndex, found := k.GetConsensusGuardianSetIndex(ctx)
+	if !found {
+		return types.ErrConsensusSetUndefined
+	}
+	if keepFromIndex > consensusGuardianSetIndex.Index {
+		return types.ErrConsensusGuardianSetNotPrunable
+	}
+
+	// Check all the sets before deleting any of them
+	now := uint64(ctx.BlockTime().Unix())
+	var prunable []uint32
+	for index := uint32(0); index < keepFromIndex; index++ {
+		guardianSet, found := k.GetGuardianSet(ctx, index)
+		if !found {
+			continue
+		}
+		if guardianSet.ExpirationTime == 0 || guardianSet.ExpirationTime >= now {
+			return sdkerrors.Wrapf(types.ErrGuardianSetNotExpired, "guardian set %d", index)
+		}
+		prunable = append(prunable, index)
+	}
+
+	for _, index := range prunable {
+		k.RemoveGuardianSet(ctx, index)
+		k.RemoveGuardianSetWeights(ctx, index)
+	}
+
+	remaining := k.GetAllGuardianSet(ctx)
+	for _, guardianValidator := range k.GetAllGuardianValidator(ctx) {
+		if !isKnownGuardianKey(remaining, guardianValidator.GuardianKey) {
+			k.RemoveGuardianValidator(ctx, guardianValidator.GuardianKey)
+		}
+	}
+
+	return nil
+}
+
 // Returns true when the given validator address is registered as a guardian and
 // that guardian is a member of the consensus guardian set.
 //
@@ -200,6 +406,11 @@ func (k Keeper) IsConsensusGuardian(ctx sdk.Context, addr sdk.ValAddress) (bool,
 		}
 	}
 
+	// Validators of removed guardians stay bonded during the transition window
+	if !isConsensusGuardian {
+		isConsensusGuardian = k.isTransitioningGuardianValidator(ctx, addr)
+	}
+
 	return isConsensusGuardian, nil
 }
 
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+// SetGuardianSetActivation set the scheduled guardian set activation in the store
+func (k Keeper) SetGuardianSetActivation(ctx sdk.Context, activation types.GuardianSetActivation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GuardianSetActivationKey))
+	b := k.cdc.MustMarshal(&activation)
+	store.Set([]byte{0}, b)
+}
+
+// GetGuardianSetActivation returns the scheduled guardian set activation
+func (k Keeper) GetGuardianSetActivation(ctx sdk.Context) (val types.GuardianSetActivation, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GuardianSetActivationKey))
+
+	b := store.Get([]byte{0})
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveGuardianSetActivation removes the scheduled guardian set activation from the store
+func (k Keeper) RemoveGuardianSetActivation(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GuardianSetActivationKey))
+	store.Delete([]byte{0})
+}
@@ -44,3 +44,32 @@ func TestGuardianSetCount(t *testing.T) {
 	count := uint32(len(items))
 	require.Equal(t, count, keeper.GetGuardianSetCount(ctx))
 }
+
+func TestUpdateGuardianSetEvent(t *testing.T) {
+	keeper, ctx := keepertest.WormholeKeeper(t)
+	keeper.SetConfig(ctx, types.Config{GuardianSetExpiration: 86400})
+
+	oldSet := types.GuardianSet{Index: 0, Keys: [][]byte{{1}, {2}, {3}}}
+	_, err := keeper.AppendGuardianSet(ctx, oldSet)
+	require.NoError(t, err)
+	keeper.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: 0})
+
+	newSet := types.GuardianSet{Index: 1, Keys: [][]byte{{4}, {2}, {5}}}
+	require.NoError(t, keeper.UpdateGuardianSet(ctx, newSet))
+
+	var event *types.EventGuardianSetUpdate
+	for _, abciEvent := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(abciEvent)
+		if err != nil {
+			continue
+		}
+		if e, ok := msg.(*types.EventGuardianSetUpdate); ok {
+			event = e
+		}
+	}
+	require.NotNil(t, event)
+	require.Equal(t, uint32(0), event.OldIndex)
+	require.Equal(t, uint32(1), event.NewIndex)
+	require.Equal(t, [][]byte{{4}, {5}}, event.AddedKeys)
+	require.Equal(t, [][]byte{{1}, {3}}, event.RemovedKeys)
+}
@@ -0,0 +1,283 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+// MaxGuardianValidatorTransitionsPerBlock bounds the number of guardian
+// validator transitions completed in a single EndBlock. Anything left over is
+// completed in the following blocks.
+const MaxGuardianValidatorTransitionsPerBlock = 1000
+
+// SetGuardianValidatorTransition set a specific guardianValidatorTransition in the store from its validator address
+func (k Keeper) SetGuardianValidatorTransition(ctx sdk.Context, transition types.GuardianValidatorTransition) {
+	k.RemoveGuardianValidatorTransition(ctx, transition.ValidatorAddr)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GuardianValidatorTransitionKey))
+	b := k.cdc.MustMarshal(&transition)
+	store.Set(transition.ValidatorAddr, b)
+
+	heightStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GuardianValidatorTransitionHeightKeyPrefix))
+	heightStore.Set(types.GuardianValidatorTransitionHeightKey(transition.UnbondHeight, transition.ValidatorAddr), []byte{})
+}
+
+// GetGuardianValidatorTransition returns a guardianValidatorTransition from its validator address
+func (k Keeper) GetGuardianValidatorTransition(ctx sdk.Context, validatorAddr []byte) (val types.GuardianValidatorTransition, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GuardianValidatorTransitionKey))
+
+	b := store.Get(validatorAddr)
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveGuardianValidatorTransition removes a guardianValidatorTransition from the store
+func (k Keeper) RemoveGuardianValidatorTransition(ctx sdk.Context, validatorAddr []byte) {
+	transition, found := k.GetGuardianValidatorTransition(ctx, validatorAddr)
+	if !found {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GuardianValidatorTransitionKey))
+	store.Delete(validatorAddr)
+
+	heightStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GuardianValidatorTransitionHeightKeyPrefix))
+	heightStore.Delete(types.GuardianValidatorTransitionHeightKey(transition.UnbondHeight, validatorAddr))
+}
+
+// GetAllGuardianValidatorTransition returns all guardianValidatorTransition
+func (k Keeper) GetAllGuardianValidatorTransition(ctx sdk.Context) (list []types.GuardianValidatorTransition) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GuardianValidatorTransitionKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.GuardianValidatorTransition
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetBondedGuardianSetIndex sets the index of the consensus guardian set whose
+// validators were last reconciled.
+func (k Keeper) SetBondedGuardianSetIndex(ctx sdk.Context, index uint32) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BondedGuardianSetIndexKey))
+	b := k.cdc.MustMarshal(&types.ConsensusGuardianSetIndex{Index: index})
+	store.Set([]byte{0}, b)
+}
+
+// GetBondedGuardianSetIndex returns the index of the consensus guardian set
+// whose validators were last reconciled.
+func (k Keeper) GetBondedGuardianSetIndex(ctx sdk.Context) (index uint32, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BondedGuardianSetIndexKey))
+
+	b := store.Get([]byte{0})
+	if b == nil {
+		return 0, false
+	}
+
+	var val types.ConsensusGuardianSetIndex
+	k.cdc.MustUnmarshal(b, &val)
+	return val.Index, true
+}
+
+// isTransitioningGuardianValidator returns whether a validator that is not
+// part of the consensus guardian set is kept bonded by the transition window
+// of its removed guardian. This includes a switch of the consensus guardian
+// set that EndBlock has not reconciled yet, since x/staking updates the
+// validator set before the wormhole module's EndBlock.
+func (k Keeper) isTransitioningGuardianValidator(ctx sdk.Context, addr sdk.ValAddress) bool {
+	if transition, found := k.GetGuardianValidatorTransition(ctx, addr); found {
+		return ctx.BlockHeight() < transition.UnbondHeight
+	}
+
+	if k.GetParams(ctx).ValidatorTransitionBlocks == 0 {
+		return false
+	}
+	bondedIndex, found := k.GetBondedGuardianSetIndex(ctx)
+	if !found {
+		return false
+	}
+	consensusIndex, found := k.GetConsensusGuardianSetIndex(ctx)
+	if !found || consensusIndex.Index == bondedIndex {
+		return false
+	}
+	_, found = k.guardianSetValidators(ctx, bondedIndex)[string(addr)]
+	return found
+}
+
+// guardianSetValidators returns the guardian keys of a guardian set by the
+// address of their registered validator.
+func (k Keeper) guardianSetValidators(ctx sdk.Context, index uint32) map[string][]byte {
+	validators := map[string][]byte{}
+	guardianSet, found := k.GetGuardianSet(ctx, index)
+	if !found {
+		return validators
+	}
+	for _, key := range guardianSet.Keys {
+		if validator, found := k.GetGuardianValidator(ctx, key); found {
+			validators[string(validator.ValidatorAddr)] = key
+		}
+	}
+	return validators
+}
+
+// ReconcileGuardianValidators reconciles the validator set with a switch of the
+// consensus guardian set. x/staking bonds the validators of the consensus
+// guardians, all with the same power. The validators of added guardians are
+// unjailed so they can be bonded, unless they were tombstoned. The validators
+// of removed guardians stay bonded for the governable transition window, and
+// are unbonded once it ends.
+func (k Keeper) ReconcileGuardianValidators(ctx sdk.Context) error {
+	consensusIndex, found := k.GetConsensusGuardianSetIndex(ctx)
+	if !found {
+		return nil
+	}
+
+	bondedIndex, found := k.GetBondedGuardianSetIndex(ctx)
+	// nothing to reconcile against before the first switch
+	if !found {
+		k.SetBondedGuardianSetIndex(ctx, consensusIndex.Index)
+	} else if bondedIndex != consensusIndex.Index {
+		if err := k.switchGuardianValidators(ctx, bondedIndex, consensusIndex.Index); err != nil {
+			return err
+		}
+		k.SetBondedGuardianSetIndex(ctx, consensusIndex.Index)
+	}
+
+	return k.completeGuardianValidatorTransitions(ctx)
+}
+
+func (k Keeper) switchGuardianValidators(ctx sdk.Context, oldIndex uint32, newIndex uint32) error {
+	oldValidators := k.guardianSetValidators(ctx, oldIndex)
+	newValidators := k.guardianSetValidators(ctx, newIndex)
+
+	// iterate the guardian sets rather than the maps, so the events are in
+	// guardian set order
+	newSet, _ := k.GetGuardianSet(ctx, newIndex)
+	for _, key := range newSet.Keys {
+		validator, found := k.GetGuardianValidator(ctx, key)
+		if !found {
+			continue
+		}
+		if _, found := oldValidators[string(validator.ValidatorAddr)]; found {
+			continue
+		}
+
+		// a guardian that is added back ends its transition
+		k.RemoveGuardianValidatorTransition(ctx, validator.ValidatorAddr)
+		k.unjailGuardianValidator(ctx, sdk.ValAddress(validator.ValidatorAddr))
+
+		err := ctx.EventManager().EmitTypedEvent(&types.EventGuardianValidatorBonded{
+			GuardianKey:      key,
+			ValidatorAddr:    validator.ValidatorAddr,
+			GuardianSetIndex: newIndex,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	window := k.GetParams(ctx).ValidatorTransitionBlocks
+	oldSet, _ := k.GetGuardianSet(ctx, oldIndex)
+	for _, key := range oldSet.Keys {
+		validator, found := k.GetGuardianValidator(ctx, key)
+		if !found {
+			continue
+		}
+		if _, found := newValidators[string(validator.ValidatorAddr)]; found {
+			continue
+		}
+
+		if window == 0 {
+			err := ctx.EventManager().EmitTypedEvent(&types.EventGuardianValidatorUnbonded{
+				GuardianKey:   key,
+				ValidatorAddr: validator.ValidatorAddr,
+			})
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		transition := types.GuardianValidatorTransition{
+			ValidatorAddr: validator.ValidatorAddr,
+			GuardianKey:   key,
+			UnbondHeight:  ctx.BlockHeight() + int64(window),
+		}
+		k.SetGuardianValidatorTransition(ctx, transition)
+
+		err := ctx.EventManager().EmitTypedEvent(&types.EventGuardianValidatorUnbonding{
+			GuardianKey:   key,
+			ValidatorAddr: validator.ValidatorAddr,
+			UnbondHeight:  transition.UnbondHeight,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// unjailGuardianValidator unjails the validator of a guardian so x/staking
+// bonds it. Tombstoned validators stay jailed.
+func (k Keeper) unjailGuardianValidator(ctx sdk.Context, addr sdk.ValAddress) {
+	if !k.setStaking || !k.setSlashing {
+		return
+	}
+	validator, found := k.stakingKeeper.GetValidator(ctx, addr)
+	if !found || !validator.IsJailed() {
+		return
+	}
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		k.Logger(ctx).Error("failed to get the consensus address of a guardian validator", "validator", addr.String(), "error", err)
+		return
+	}
+	if k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+		return
+	}
+	k.stakingKeeper.Unjail(ctx, consAddr)
+}
+
+// completeGuardianValidatorTransitions removes the transitions that ended. The
+// validators were unbonded by x/staking earlier in the block.
+func (k Keeper) completeGuardianValidatorTransitions(ctx sdk.Context) error {
+	if ctx.BlockHeight() < 0 {
+		return nil
+	}
+	heightStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GuardianValidatorTransitionHeightKeyPrefix))
+
+	end := binary.BigEndian.AppendUint64(nil, uint64(ctx.BlockHeight())+1)
+	iterator := heightStore.Iterator(nil, end)
+	var completed [][]byte
+	for ; iterator.Valid() && len(completed) < MaxGuardianValidatorTransitionsPerBlock; iterator.Next() {
+		completed = append(completed, iterator.Key()[8:])
+	}
+	iterator.Close()
+
+	for _, validatorAddr := range completed {
+		transition, _ := k.GetGuardianValidatorTransition(ctx, validatorAddr)
+		k.RemoveGuardianValidatorTransition(ctx, validatorAddr)
+
+		err := ctx.EventManager().EmitTypedEvent(&types.EventGuardianValidatorUnbonded{
+			GuardianKey:   transition.GuardianKey,
+			ValidatorAddr: transition.ValidatorAddr,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
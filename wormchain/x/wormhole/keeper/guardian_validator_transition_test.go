@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/wormhole-foundation/wormchain/testutil/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func reconcileGuardianValidators(t *testing.T, k *keeper.Keeper, ctx sdk.Context) []string {
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.ReconcileGuardianValidators(ctx))
+	var eventTypes []string
+	for _, event := range ctx.EventManager().Events() {
+		eventTypes = append(eventTypes, event.Type)
+	}
+	return eventTypes
+}
+
+func requireConsensusGuardian(t *testing.T, k *keeper.Keeper, ctx sdk.Context, validator types.GuardianValidator, expected bool) {
+	isConsensusGuardian, err := k.IsConsensusGuardian(ctx, validator.ValidatorAddr)
+	require.NoError(t, err)
+	require.Equal(t, expected, isConsensusGuardian)
+}
+
+func TestReconcileGuardianValidators(t *testing.T) {
+	const (
+		bonded    = "wormhole_foundation.wormchain.wormhole.EventGuardianValidatorBonded"
+		unbonding = "wormhole_foundation.wormchain.wormhole.EventGuardianValidatorUnbonding"
+		unbonded  = "wormhole_foundation.wormchain.wormhole.EventGuardianValidatorUnbonded"
+	)
+
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, _ := createNGuardianValidator(k, ctx, 4)
+	oldSet := createNewGuardianSet(k, ctx, guardians[:3])
+	newSet := createNewGuardianSet(k, ctx, guardians[1:])
+
+	// The first reconciliation only records the consensus guardian set
+	ctx = ctx.WithBlockHeight(10)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: oldSet.Index})
+	require.Empty(t, reconcileGuardianValidators(t, k, ctx))
+	bondedIndex, found := k.GetBondedGuardianSetIndex(ctx)
+	require.True(t, found)
+	require.Equal(t, oldSet.Index, bondedIndex)
+	requireConsensusGuardian(t, k, ctx, guardians[0], true)
+	requireConsensusGuardian(t, k, ctx, guardians[3], false)
+
+	// Removed guardians stay bonded through the transition window, even
+	// before EndBlock reconciles the switch
+	params := k.GetParams(ctx)
+	params.ValidatorTransitionBlocks = 5
+	k.SetParams(ctx, params)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: newSet.Index})
+	requireConsensusGuardian(t, k, ctx, guardians[0], true)
+	requireConsensusGuardian(t, k, ctx, guardians[3], true)
+
+	require.Equal(t, []string{bonded, unbonding}, reconcileGuardianValidators(t, k, ctx))
+	transition, found := k.GetGuardianValidatorTransition(ctx, guardians[0].ValidatorAddr)
+	require.True(t, found)
+	require.Equal(t, types.GuardianValidatorTransition{
+		ValidatorAddr: guardians[0].ValidatorAddr,
+		GuardianKey:   guardians[0].GuardianKey,
+		UnbondHeight:  15,
+	}, transition)
+
+	ctx = ctx.WithBlockHeight(14)
+	requireConsensusGuardian(t, k, ctx, guardians[0], true)
+	require.Empty(t, reconcileGuardianValidators(t, k, ctx))
+
+	// The validator is unbonded once the window ends
+	ctx = ctx.WithBlockHeight(15)
+	requireConsensusGuardian(t, k, ctx, guardians[0], false)
+	require.Equal(t, []string{unbonded}, reconcileGuardianValidators(t, k, ctx))
+	require.Empty(t, k.GetAllGuardianValidatorTransition(ctx))
+
+	// Without a transition window, removed guardians are unbonded at the switch
+	params.ValidatorTransitionBlocks = 0
+	k.SetParams(ctx, params)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: oldSet.Index})
+	requireConsensusGuardian(t, k, ctx, guardians[3], false)
+	require.Equal(t, []string{bonded, unbonded}, reconcileGuardianValidators(t, k, ctx))
+	require.Empty(t, k.GetAllGuardianValidatorTransition(ctx))
+
+	// A guardian added back ends its transition
+	params.ValidatorTransitionBlocks = 5
+	k.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(20)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: newSet.Index})
+	require.Equal(t, []string{bonded, unbonding}, reconcileGuardianValidators(t, k, ctx))
+	ctx = ctx.WithBlockHeight(21)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: oldSet.Index})
+	require.Equal(t, []string{bonded, unbonding}, reconcileGuardianValidators(t, k, ctx))
+	transitions := k.GetAllGuardianValidatorTransition(ctx)
+	require.Len(t, transitions, 1)
+	require.Equal(t, guardians[3].ValidatorAddr, transitions[0].ValidatorAddr)
+	require.Equal(t, int64(26), transitions[0].UnbondHeight)
+}
+
+func TestExecuteGovernanceVAAValidatorTransitionWindowUpdate(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 10)
+	k.SetConfig(ctx, types.Config{
+		GovernanceEmitter:     vaa.GovernanceEmitter[:],
+		GovernanceChain:       uint32(vaa.GovernanceChain),
+		ChainId:               uint32(vaa.ChainIDWormchain),
+		GuardianSetExpiration: 86400,
+	})
+	signer := sdk.AccAddress(make([]byte, 20))
+
+	set := createNewGuardianSet(k, ctx, guardians)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: set.Index})
+
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	execute := func(ctx sdk.Context, payload []byte) error {
+		v := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), payload)
+		vBz, _ := v.Marshal()
+		_, err := msgServer.ExecuteGovernanceVAA(sdk.WrapSDKContext(ctx), &types.MsgExecuteGovernanceVAA{
+			Signer: signer.String(),
+			Vaa:    vBz,
+		})
+		return err
+	}
+
+	payload, err := vaa.BodyWormchainValidatorTransitionWindowUpdate{TransitionBlocks: 600}.Serialize()
+	require.NoError(t, err)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, execute(ctx, payload))
+	assert.Equal(t, uint64(600), k.GetParams(ctx).ValidatorTransitionBlocks)
+
+	var event *types.EventValidatorTransitionWindowUpdate
+	for _, abciEvent := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(abciEvent)
+		if err != nil {
+			continue
+		}
+		if e, ok := msg.(*types.EventValidatorTransitionWindowUpdate); ok {
+			event = e
+		}
+	}
+	require.NotNil(t, event)
+	assert.Equal(t, types.EventValidatorTransitionWindowUpdate{OldTransitionBlocks: 0, NewTransitionBlocks: 600}, *event)
+
+	// Unknown payload version
+	payload, err = vaa.BodyWormchainValidatorTransitionWindowUpdate{TransitionBlocks: 0}.Serialize()
+	require.NoError(t, err)
+	payload[35] = 2
+	assert.ErrorIs(t, execute(ctx, payload), types.ErrUnknownGovernancePayloadVersion)
+	assert.Equal(t, uint64(600), k.GetParams(ctx).ValidatorTransitionBlocks)
+}
+
+func TestCompleteGuardianValidatorTransitionsBounded(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: 0})
+	k.SetBondedGuardianSetIndex(ctx, 0)
+
+	for i := 0; i < keeper.MaxGuardianValidatorTransitionsPerBlock+2; i++ {
+		k.SetGuardianValidatorTransition(ctx, types.GuardianValidatorTransition{
+			ValidatorAddr: []byte{byte(i >> 8), byte(i)},
+			GuardianKey:   []byte{byte(i >> 8), byte(i)},
+			UnbondHeight:  10,
+		})
+	}
+	// A transition that is set again completes at its new height only
+	k.SetGuardianValidatorTransition(ctx, types.GuardianValidatorTransition{
+		ValidatorAddr: []byte{0, 0},
+		GuardianKey:   []byte{0, 0},
+		UnbondHeight:  20,
+	})
+
+	// The transitions left over are completed in the following blocks
+	ctx = ctx.WithBlockHeight(10)
+	require.NoError(t, k.ReconcileGuardianValidators(ctx))
+	require.Len(t, k.GetAllGuardianValidatorTransition(ctx), 2)
+	require.NoError(t, k.ReconcileGuardianValidators(ctx.WithBlockHeight(11)))
+	require.Len(t, k.GetAllGuardianValidatorTransition(ctx), 1)
+	_, found := k.GetGuardianValidatorTransition(ctx, []byte{0, 0})
+	require.True(t, found)
+
+	require.NoError(t, k.ReconcileGuardianValidators(ctx.WithBlockHeight(20)))
+	require.Empty(t, k.GetAllGuardianValidatorTransition(ctx))
+}
@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"strconv"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+// AddMessagePostedHook registers a hook that is notified of the messages
+// published by the core contract and the wormhole module. The hooks are shared
+// by all copies of the keeper, so modules that were handed the keeper before
+// the hook was registered notify it too.
+func (k Keeper) AddMessagePostedHook(hook types.MessagePostedHook) {
+	*k.messagePostedHooks = append(*k.messagePostedHooks, hook)
+}
+
+func (k Keeper) afterMessagePosted(ctx sdk.Context, msg types.PostedMessage) error {
+	if k.messagePostedHooks == nil {
+		return nil
+	}
+	return k.messagePostedHooks.AfterMessagePosted(ctx, msg)
+}
+
+// ParseCoreContractMessages returns the messages published by the core
+// contract in the wasm events of its executions.
+func ParseCoreContractMessages(contract string, events sdk.Events) ([]types.PostedMessage, error) {
+	var msgs []types.PostedMessage
+	for _, event := range events {
+		if event.Type != wasmtypes.WasmModuleEventType {
+			continue
+		}
+		attributes := make(map[string]string, len(event.Attributes))
+		for _, attribute := range event.Attributes {
+			attributes[string(attribute.Key)] = string(attribute.Value)
+		}
+		if attributes[wasmtypes.AttributeKeyContractAddr] != contract {
+			continue
+		}
+		if _, ok := attributes["message.sequence"]; !ok {
+			continue
+		}
+
+		msg, err := parseCoreContractMessage(contract, attributes)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "message published by core contract %s: %v", contract, err)
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
+func parseCoreContractMessage(contract string, attributes map[string]string) (types.PostedMessage, error) {
+	emitter, err := hex.DecodeString(attributes["message.sender"])
+	if err != nil {
+		return types.PostedMessage{}, err
+	}
+	if _, err := types.EmitterAddressFromBytes32(emitter); err != nil {
+		return types.PostedMessage{}, err
+	}
+	payload, err := hex.DecodeString(attributes["message.message"])
+	if err != nil {
+		return types.PostedMessage{}, err
+	}
+	sequence, err := strconv.ParseUint(attributes["message.sequence"], 10, 64)
+	if err != nil {
+		return types.PostedMessage{}, err
+	}
+	nonce, err := strconv.ParseUint(attributes["message.nonce"], 10, 32)
+	if err != nil {
+		return types.PostedMessage{}, err
+	}
+	time, err := strconv.ParseUint(attributes["message.block_time"], 10, 64)
+	if err != nil {
+		return types.PostedMessage{}, err
+	}
+
+	return types.PostedMessage{
+		Contract: contract,
+		Emitter:  emitter,
+		Sequence: sequence,
+		Nonce:    uint32(nonce),
+		Time:     time,
+		Payload:  payload,
+	}, nil
+}
+
+type messagePostedMsgServer struct {
+	wasmtypes.MsgServer
+	k Keeper
+}
+
+// NewMessagePostedMsgServer wraps the msg server of x/wasm to notify the
+// message posted hooks of the messages published by executions of the core
+// contract. Contracts dispatch their executions through the msg server as
+// well, so messages published on behalf of other contracts are included.
+func NewMessagePostedMsgServer(k Keeper, msgServer wasmtypes.MsgServer) wasmtypes.MsgServer {
+	return &messagePostedMsgServer{MsgServer: msgServer, k: k}
+}
+
+func (m *messagePostedMsgServer) ExecuteContract(goCtx context.Context, msg *wasmtypes.MsgExecuteContract) (*wasmtypes.MsgExecuteContractResponse, error) {
+	res, err := m.MsgServer.ExecuteContract(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	coreContract := m.k.GetCoreContract(ctx).ContractAddress
+	if coreContract == "" || msg.Contract != coreContract {
+		return res, nil
+	}
+
+	// The msg service router gives every message its own event manager, so
+	// these are only the events of this execution.
+	msgs, err := ParseCoreContractMessages(coreContract, ctx.EventManager().Events())
+	if err != nil {
+		return nil, err
+	}
+	for _, posted := range msgs {
+		if err := m.k.afterMessagePosted(ctx, posted); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
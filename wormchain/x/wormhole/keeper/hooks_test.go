@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/wormhole-foundation/wormchain/testutil/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+// recordingHook records the messages it is notified of.
+type recordingHook struct {
+	msgs []types.PostedMessage
+	err  error
+}
+
+func (h *recordingHook) AfterMessagePosted(ctx sdk.Context, msg types.PostedMessage) error {
+	h.msgs = append(h.msgs, msg)
+	return h.err
+}
+
+// mockWasmMsgServer publishes a message from the executed contract the way
+// the core contract does.
+type mockWasmMsgServer struct {
+	wasmtypes.MsgServer
+	emitter []byte
+}
+
+func (m *mockWasmMsgServer) ExecuteContract(goCtx context.Context, msg *wasmtypes.MsgExecuteContract) (*wasmtypes.MsgExecuteContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		wasmtypes.WasmModuleEventType,
+		sdk.NewAttribute(wasmtypes.AttributeKeyContractAddr, msg.Contract),
+		sdk.NewAttribute("message.message", hex.EncodeToString(msg.Msg)),
+		sdk.NewAttribute("message.sender", hex.EncodeToString(m.emitter)),
+		sdk.NewAttribute("message.chain_id", "3104"),
+		sdk.NewAttribute("message.nonce", "7"),
+		sdk.NewAttribute("message.sequence", "3"),
+		sdk.NewAttribute("message.block_time", "1700000000"),
+	))
+	return &wasmtypes.MsgExecuteContractResponse{}, nil
+}
+
+func TestMessagePostedHookPostMessage(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	hook := &recordingHook{}
+	k.AddMessagePostedHook(hook)
+
+	emitter := types.EmitterAddressFromAccAddress(sdk.AccAddress(bytes.Repeat([]byte{1}, 20)))
+	require.NoError(t, k.PostMessage(ctx, emitter, 5, []byte{1, 2}))
+	require.NoError(t, k.PostMessage(ctx, emitter, 6, []byte{3}))
+
+	assert.Equal(t, []types.PostedMessage{
+		{Emitter: emitter.Bytes(), Sequence: 0, Nonce: 5, Time: uint64(ctx.BlockTime().Unix()), Payload: []byte{1, 2}},
+		{Emitter: emitter.Bytes(), Sequence: 1, Nonce: 6, Time: uint64(ctx.BlockTime().Unix()), Payload: []byte{3}},
+	}, hook.msgs)
+
+	// A failing hook fails the publication
+	hook.err = errors.New("rejected")
+	assert.ErrorIs(t, k.PostMessage(ctx, emitter, 0, []byte{4}), hook.err)
+	assert.Equal(t, uint64(2), k.GetNextSequence(ctx, emitter))
+}
+
+func TestMessagePostedHookCoreContract(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	hook := &recordingHook{}
+	k.AddMessagePostedHook(hook)
+
+	emitter := bytes.Repeat([]byte{0xab}, 32)
+	msgServer := keeper.NewMessagePostedMsgServer(*k, &mockWasmMsgServer{emitter: emitter})
+	core := sdk.AccAddress(bytes.Repeat([]byte{2}, 32)).String()
+	other := sdk.AccAddress(bytes.Repeat([]byte{3}, 32)).String()
+
+	// Like the msg service router, every execution gets its own event manager
+	execute := func(contract string) error {
+		wctx := sdk.WrapSDKContext(ctx.WithEventManager(sdk.NewEventManager()))
+		_, err := msgServer.ExecuteContract(wctx, &wasmtypes.MsgExecuteContract{Contract: contract, Msg: []byte{9}})
+		return err
+	}
+
+	// Nothing is reported until the core contract is set
+	require.NoError(t, execute(core))
+	assert.Empty(t, hook.msgs)
+
+	k.StoreCoreContract(ctx, types.CoreContract{ContractAddress: core})
+	require.NoError(t, execute(other))
+	assert.Empty(t, hook.msgs)
+
+	require.NoError(t, execute(core))
+	assert.Equal(t, []types.PostedMessage{
+		{Contract: core, Emitter: emitter, Sequence: 3, Nonce: 7, Time: 1700000000, Payload: []byte{9}},
+	}, hook.msgs)
+
+	hook.err = errors.New("rejected")
+	assert.ErrorIs(t, execute(core), hook.err)
+}
+
+func TestParseCoreContractMessages(t *testing.T) {
+	core := sdk.AccAddress(bytes.Repeat([]byte{2}, 32)).String()
+	event := func(attributes ...sdk.Attribute) sdk.Event {
+		return sdk.NewEvent(wasmtypes.WasmModuleEventType, append([]sdk.Attribute{
+			sdk.NewAttribute(wasmtypes.AttributeKeyContractAddr, core),
+		}, attributes...)...)
+	}
+
+	// Events without a published message are ignored
+	msgs, err := keeper.ParseCoreContractMessages(core, sdk.Events{event(sdk.NewAttribute("action", "submit_vaa"))})
+	require.NoError(t, err)
+	assert.Empty(t, msgs)
+
+	_, err = keeper.ParseCoreContractMessages(core, sdk.Events{event(
+		sdk.NewAttribute("message.sender", "abcd"),
+		sdk.NewAttribute("message.sequence", "1"),
+	)})
+	assert.ErrorIs(t, err, sdkerrors.ErrInvalidType)
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+// SetIcaHostAllowlistEntry allows or disallows the interchain accounts of a
+// connection to execute a message type
+func (k Keeper) SetIcaHostAllowlistEntry(ctx sdk.Context, entry types.IcaHostAllowlistEntry, allowed bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IcaHostAllowlistKey))
+	key := types.IcaHostAllowlistEntryKey(entry.ConnectionId, entry.MsgTypeUrl)
+	if allowed {
+		store.Set(key, k.cdc.MustMarshal(&entry))
+	} else {
+		store.Delete(key)
+	}
+}
+
+// IsIcaHostMsgAllowed returns whether the interchain accounts of a connection
+// may execute a message type
+func (k Keeper) IsIcaHostMsgAllowed(ctx sdk.Context, connectionID string, msgTypeURL string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IcaHostAllowlistKey))
+	return store.Has(types.IcaHostAllowlistEntryKey(connectionID, msgTypeURL))
+}
+
+// GetAllIcaHostAllowlist returns all interchain accounts host allowlist entries
+func (k Keeper) GetAllIcaHostAllowlist(ctx sdk.Context) (list []types.IcaHostAllowlistEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IcaHostAllowlistKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.IcaHostAllowlistEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+// RegisterInvariants registers all wormhole invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "consensus-guardian-set", ConsensusGuardianSetInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "guardian-set-index", GuardianSetIndexInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "guardian-validator", GuardianValidatorInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "guardian-set-expiration", GuardianSetExpirationInvariant(k))
+}
+
+// AllInvariants runs all invariants of the wormhole module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			ConsensusGuardianSetInvariant(k),
+			GuardianSetIndexInvariant(k),
+			GuardianValidatorInvariant(k),
+			GuardianSetExpirationInvariant(k),
+		} {
+			res, stop := inv(ctx)
+			if stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// ConsensusGuardianSetInvariant checks that the consensus guardian set index
+// points to an existing guardian set.
+func ConsensusGuardianSetInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		// Nothing to point to before the first guardian set is added
+		if k.GetGuardianSetCount(ctx) == 0 {
+			return sdk.FormatInvariant(types.ModuleName, "consensus-guardian-set", "no guardian sets"), false
+		}
+
+		consensusGuardianSetIndex, found := k.GetConsensusGuardianSetIndex(ctx)
+		if !found {
+			return sdk.FormatInvariant(types.ModuleName, "consensus-guardian-set", "consensus guardian set index is not set"), true
+		}
+		if _, found := k.GetGuardianSet(ctx, consensusGuardianSetIndex.Index); !found {
+			return sdk.FormatInvariant(types.ModuleName, "consensus-guardian-set",
+				fmt.Sprintf("consensus guardian set %d does not exist", consensusGuardianSetIndex.Index)), true
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "consensus-guardian-set",
+			fmt.Sprintf("consensus guardian set %d exists", consensusGuardianSetIndex.Index)), false
+	}
+}
+
+// GuardianSetIndexInvariant checks that every guardian set is stored under its
+// own index, so no two sets can share an index, and that every index is below
+// the guardian set count.
+func GuardianSetIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		count := k.GetGuardianSetCount(ctx)
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GuardianSetKey))
+		iterator := sdk.KVStorePrefixIterator(store, []byte{})
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			var guardianSet types.GuardianSet
+			k.cdc.MustUnmarshal(iterator.Value(), &guardianSet)
+
+			storedIndex, err := types.GuardianSetIndexFromKey(iterator.Key())
+			if err != nil {
+				broken = true
+				msg += fmt.Sprintf("\tguardian set %d is stored under an %s\n", guardianSet.Index, err)
+			} else if storedIndex != guardianSet.Index {
+				broken = true
+				msg += fmt.Sprintf("\tguardian set %d is stored under index %d\n", guardianSet.Index, storedIndex)
+			}
+
+			if guardianSet.Index >= count {
+				broken = true
+				msg += fmt.Sprintf("\tguardian set %d is not below the guardian set count %d\n", guardianSet.Index, count)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "guardian-set-index",
+			fmt.Sprintf("found invalid guardian set indices\n%s", msg)), broken
+	}
+}
+
+// GuardianValidatorInvariant checks that every guardian validator registration
+// belongs to a key of a known guardian set.
+func GuardianValidatorInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		guardianSets := k.GetAllGuardianSet(ctx)
+		for _, guardianValidator := range k.GetAllGuardianValidator(ctx) {
+			if !isKnownGuardianKey(guardianSets, guardianValidator.GuardianKey) {
+				broken = true
+				msg += fmt.Sprintf("\tguardian %x is not in any guardian set\n", guardianValidator.GuardianKey)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "guardian-validator",
+			fmt.Sprintf("found guardian validators of unknown guardians\n%s", msg)), broken
+	}
+}
+
+// GuardianSetExpirationInvariant checks that every guardian set other than the
+// latest one has been given an expiration time.
+func GuardianSetExpirationInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		if k.GetGuardianSetCount(ctx) == 0 {
+			return sdk.FormatInvariant(types.ModuleName, "guardian-set-expiration", "no guardian sets"), false
+		}
+
+		latestGuardianSetIndex := k.GetLatestGuardianSetIndex(ctx)
+		for _, guardianSet := range k.GetAllGuardianSet(ctx) {
+			if guardianSet.Index < latestGuardianSetIndex && guardianSet.ExpirationTime == 0 {
+				broken = true
+				msg += fmt.Sprintf("\tguardian set %d was replaced but has no expiration time\n", guardianSet.Index)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "guardian-set-expiration",
+			fmt.Sprintf("found replaced guardian sets without expiration\n%s", msg)), broken
+	}
+}
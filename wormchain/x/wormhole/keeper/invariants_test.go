@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/wormhole-foundation/wormchain/testutil/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+func TestInvariants(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+
+	// An empty store is consistent
+	_, broken := keeper.AllInvariants(*k)(ctx)
+	require.False(t, broken)
+
+	guardianKey := make([]byte, 20)
+	guardianKey[19] = 1
+	_, err := k.AppendGuardianSet(ctx, types.GuardianSet{Index: 0, Keys: [][]byte{guardianKey}, ExpirationTime: 100})
+	require.NoError(t, err)
+	_, err = k.AppendGuardianSet(ctx, types.GuardianSet{Index: 1, Keys: [][]byte{guardianKey}})
+	require.NoError(t, err)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: 1})
+	k.SetGuardianValidator(ctx, types.GuardianValidator{GuardianKey: guardianKey, ValidatorAddr: []byte{1}})
+
+	_, broken = keeper.AllInvariants(*k)(ctx)
+	require.False(t, broken)
+
+	// The consensus guardian set has to exist
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: 5})
+	_, broken = keeper.ConsensusGuardianSetInvariant(*k)(ctx)
+	require.True(t, broken)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: 1})
+
+	// Guardian validators have to belong to a known guardian
+	k.SetGuardianValidator(ctx, types.GuardianValidator{GuardianKey: make([]byte, 20), ValidatorAddr: []byte{2}})
+	_, broken = keeper.GuardianValidatorInvariant(*k)(ctx)
+	require.True(t, broken)
+	k.RemoveGuardianValidator(ctx, make([]byte, 20))
+
+	// A guardian set index has to be below the guardian set count
+	k.SetGuardianSetCount(ctx, 1)
+	_, broken = keeper.GuardianSetIndexInvariant(*k)(ctx)
+	require.True(t, broken)
+	k.SetGuardianSetCount(ctx, 2)
+
+	// Replaced guardian sets have to expire
+	_, err = k.AppendGuardianSet(ctx, types.GuardianSet{Index: 2, Keys: [][]byte{guardianKey}})
+	require.NoError(t, err)
+	_, broken = keeper.GuardianSetExpirationInvariant(*k)(ctx)
+	require.True(t, broken)
+}
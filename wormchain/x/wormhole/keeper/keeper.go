@@ -7,6 +7,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
 )
@@ -17,13 +19,26 @@ type (
 		storeKey sdk.StoreKey
 		memKey   sdk.StoreKey
 
-		accountKeeper types.AccountKeeper
-		bankKeeper    types.BankKeeper
-		wasmdKeeper   types.WasmdKeeper
-		upgradeKeeper upgradekeeper.Keeper
+		accountKeeper   types.AccountKeeper
+		bankKeeper      types.BankKeeper
+		wasmdKeeper     types.WasmdKeeper
+		wasmViewKeeper  types.WasmViewKeeper
+		upgradeKeeper   upgradekeeper.Keeper
+		slashingKeeper  slashingkeeper.Keeper
+		stakingKeeper   stakingkeeper.Keeper
+		consensusKeeper types.ConsensusParamsKeeper
+		tokenFactory    types.TokenFactoryKeeper
 
-		setWasmd   bool
-		setUpgrade bool
+		// shared by all copies of the keeper, see AddMessagePostedHook
+		messagePostedHooks *types.MessagePostedHooks
+
+		setWasmd        bool
+		setWasmView     bool
+		setUpgrade      bool
+		setSlashing     bool
+		setStaking      bool
+		setConsensus    bool
+		setTokenFactory bool
 	}
 )
 
@@ -40,6 +55,8 @@ func NewKeeper(
 		memKey:   memKey,
 
 		accountKeeper: accountKeeper, bankKeeper: bankKeeper,
+
+		messagePostedHooks: &types.MessagePostedHooks{},
 	}
 }
 
@@ -54,11 +71,42 @@ func (k *Keeper) SetWasmdKeeper(keeper types.WasmdKeeper) {
 	k.setWasmd = true
 }
 
+// The wasmd keeper set above is permissioned and can not query contracts, so
+// the keeper used to query them is set separately.
+func (k *Keeper) SetWasmViewKeeper(keeper types.WasmViewKeeper) {
+	k.wasmViewKeeper = keeper
+	k.setWasmView = true
+}
+
 func (k *Keeper) SetUpgradeKeeper(keeper upgradekeeper.Keeper) {
 	k.upgradeKeeper = keeper
 	k.setUpgrade = true
 }
 
+func (k *Keeper) SetSlashingKeeper(keeper slashingkeeper.Keeper) {
+	k.slashingKeeper = keeper
+	k.setSlashing = true
+}
+
+// x/staking depends on x/wormhole, so the staking keeper is set once it exists.
+func (k *Keeper) SetStakingKeeper(keeper stakingkeeper.Keeper) {
+	k.stakingKeeper = keeper
+	k.setStaking = true
+}
+
+// The consensus params live in the baseapp param store, so this is set to the
+// BaseApp itself once the app is constructed.
+func (k *Keeper) SetConsensusParamsKeeper(keeper types.ConsensusParamsKeeper) {
+	k.consensusKeeper = keeper
+	k.setConsensus = true
+}
+
+// x/tokenfactory is created after x/wormhole, so its keeper is set once it exists.
+func (k *Keeper) SetTokenFactoryKeeper(keeper types.TokenFactoryKeeper) {
+	k.tokenFactory = keeper
+	k.setTokenFactory = true
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+// SetMaintenanceWindow replaces the maintenance window declared by governance
+func (k Keeper) SetMaintenanceWindow(ctx sdk.Context, window types.MaintenanceWindow) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MaintenanceWindowKey))
+	b := k.cdc.MustMarshal(&window)
+	store.Set([]byte{0}, b)
+}
+
+// GetMaintenanceWindow returns the maintenance window declared by governance
+func (k Keeper) GetMaintenanceWindow(ctx sdk.Context) (val types.MaintenanceWindow, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MaintenanceWindowKey))
+
+	b := store.Get([]byte{0})
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveMaintenanceWindow ends the maintenance window declared by governance
+func (k Keeper) RemoveMaintenanceWindow(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MaintenanceWindowKey))
+	store.Delete([]byte{0})
+}
+
+// IsContractUnderMaintenance returns whether the contract is listed in a
+// maintenance window that covers the current block.
+func (k Keeper) IsContractUnderMaintenance(ctx sdk.Context, contract string) bool {
+	window, found := k.GetMaintenanceWindow(ctx)
+	return found && window.IsActive(ctx.BlockHeight()) && window.HasContract(contract)
+}
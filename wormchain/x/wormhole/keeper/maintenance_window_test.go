@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/wormhole-foundation/wormchain/testutil/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/ante"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestExecuteGovernanceVAAMaintenanceWindow(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 10)
+	k.SetConfig(ctx, types.Config{
+		GovernanceEmitter:     vaa.GovernanceEmitter[:],
+		GovernanceChain:       uint32(vaa.GovernanceChain),
+		ChainId:               uint32(vaa.ChainIDWormchain),
+		GuardianSetExpiration: 86400,
+	})
+	signer := sdk.AccAddress(make([]byte, 20))
+
+	set := createNewGuardianSet(k, ctx, guardians)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: set.Index})
+
+	msgServer := keeper.NewMsgServerImpl(*k)
+	anteHandler := ante.NewWormholeMaintenanceWindowDecorator(*k)
+
+	execute := func(payload []byte) error {
+		module := [32]byte{}
+		copy(module[:], vaa.CoreModule)
+		gov_msg := types.NewGovernanceMessage(module, byte(vaa.ActionMaintenanceWindowUpdate), uint16(vaa.ChainIDWormchain), payload)
+
+		v := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), gov_msg.MarshalBinary())
+		vBz, _ := v.Marshal()
+		_, err := msgServer.ExecuteGovernanceVAA(sdk.WrapSDKContext(ctx), &types.MsgExecuteGovernanceVAA{
+			Signer: signer.String(),
+			Vaa:    vBz,
+		})
+		return err
+	}
+	maintenancePayload := func(start uint64, end uint64, contracts ...[]byte) []byte {
+		payload := []byte{1}
+		payload = binary.BigEndian.AppendUint64(payload, start)
+		payload = binary.BigEndian.AppendUint64(payload, end)
+		payload = append(payload, byte(len(contracts)))
+		for _, contract := range contracts {
+			payload = append(payload, contract...)
+		}
+		return payload
+	}
+	executeContract := func(height int64, contract sdk.AccAddress) error {
+		tx := &MockTx{Msgs: []sdk.Msg{&wasmtypes.MsgExecuteContract{Sender: signer.String(), Contract: contract.String()}}}
+		_, err := anteHandler.AnteHandle(ctx.WithBlockHeight(height), tx, false, MockNext)
+		return err
+	}
+
+	core := sdk.AccAddress(bytes.Repeat([]byte{1}, 32))
+	tokenBridge := sdk.AccAddress(bytes.Repeat([]byte{2}, 32))
+	other := sdk.AccAddress(bytes.Repeat([]byte{3}, 32))
+
+	// Nothing is under maintenance before a window is declared
+	require.NoError(t, executeContract(100, core))
+
+	assert.ErrorIs(t, execute(maintenancePayload(100, 200, core)[:40]), types.ErrInvalidGovernancePayloadLength)
+	assert.ErrorIs(t, execute(maintenancePayload(200, 100, core)), types.ErrInvalidMaintenanceWindow)
+	assert.ErrorIs(t, execute(maintenancePayload(100, 200, core, core)), types.ErrInvalidMaintenanceWindow)
+
+	require.NoError(t, execute(maintenancePayload(100, 200, core, tokenBridge)))
+	window, found := k.GetMaintenanceWindow(ctx)
+	require.True(t, found)
+	assert.Equal(t, types.MaintenanceWindow{StartHeight: 100, EndHeight: 200, Contracts: []string{core.String(), tokenBridge.String()}}, window)
+
+	// Direct executions of the listed contracts are rejected within the window, bounds included
+	require.NoError(t, executeContract(99, core))
+	assert.ErrorIs(t, executeContract(100, core), types.ErrContractUnderMaintenance)
+	assert.ErrorIs(t, executeContract(200, tokenBridge), types.ErrContractUnderMaintenance)
+	require.NoError(t, executeContract(201, tokenBridge))
+	require.NoError(t, executeContract(150, other))
+
+	// Other messages are not restricted
+	_, err := anteHandler.AnteHandle(ctx.WithBlockHeight(150), getTxWithSigner(signer.String()), false, MockNext)
+	require.NoError(t, err)
+
+	res, err := k.MaintenanceWindow(sdk.WrapSDKContext(ctx.WithBlockHeight(150)), &types.QueryMaintenanceWindowRequest{})
+	require.NoError(t, err)
+	assert.True(t, res.Active)
+	assert.Equal(t, &window, res.Window)
+
+	// A window without contracts ends the maintenance
+	require.NoError(t, execute(maintenancePayload(0, 0)))
+	_, found = k.GetMaintenanceWindow(ctx)
+	assert.False(t, found)
+	require.NoError(t, executeContract(150, core))
+}
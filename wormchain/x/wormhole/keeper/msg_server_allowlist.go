@@ -10,15 +10,22 @@ import (
 
 func (k msgServer) CreateAllowlistEntry(goCtx context.Context, msg *types.MsgCreateAllowlistEntryRequest) (*types.MsgAllowlistResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.assertMsgNotShutdown(ctx, msg); err != nil {
+		return nil, err
+	}
 	validator_address := msg.Signer
 	if !k.IsAddressValidatorOrFutureValidator(ctx, validator_address) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "must be a current or future validator")
 	}
 
+	if msg.ExpirationHeight != 0 && msg.ExpirationHeight <= uint64(ctx.BlockHeight()) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "expiration height %d is not in the future", msg.ExpirationHeight)
+	}
+
 	// is this already in an active allowlist?
 	if k.HasValidatorAllowedAddress(ctx, msg.Address) {
 		allowed := k.GetValidatorAllowedAddress(ctx, msg.Address)
-		if k.IsAddressValidatorOrFutureValidator(ctx, allowed.ValidatorAddress) {
+		if !allowed.Expired(ctx.BlockHeight()) && k.IsAddressValidatorOrFutureValidator(ctx, allowed.ValidatorAddress) {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "address is already whitelisted")
 		}
 	}
@@ -27,6 +34,7 @@ func (k msgServer) CreateAllowlistEntry(goCtx context.Context, msg *types.MsgCre
 		ValidatorAddress: validator_address,
 		AllowedAddress:   msg.Address,
 		Name:             msg.Name,
+		ExpirationHeight: msg.ExpirationHeight,
 	})
 
 	return &types.MsgAllowlistResponse{}, nil
@@ -34,6 +42,9 @@ func (k msgServer) CreateAllowlistEntry(goCtx context.Context, msg *types.MsgCre
 
 func (k msgServer) DeleteAllowlistEntry(goCtx context.Context, msg *types.MsgDeleteAllowlistEntryRequest) (*types.MsgAllowlistResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.assertMsgNotShutdown(ctx, msg); err != nil {
+		return nil, err
+	}
 	validator_address := msg.Signer
 	if !k.IsAddressValidatorOrFutureValidator(ctx, validator_address) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "must be a current or future validator")
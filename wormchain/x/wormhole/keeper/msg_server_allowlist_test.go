@@ -254,3 +254,94 @@ func TestAllowlistEntryAnteHandler(t *testing.T) {
 	_, err = anteHandler.AnteHandle(ctx, getTxWithSigner(new_address), false, MockNext)
 	assert.Error(t, err)
 }
+
+func TestAllowlistEntryExpiration(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, _ := createNGuardianValidator(k, ctx, 2)
+	createNewGuardianSet(k, ctx, guardians)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{
+		Index: 0,
+	})
+
+	ctx = ctx.WithBlockHeight(10)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	anteHandler := ante.NewWormholeAllowlistDecorator(*k)
+
+	// Expiration heights must be in the future
+	for _, height := range []uint64{5, 10} {
+		_, err := msgServer.CreateAllowlistEntry(sdk.WrapSDKContext(ctx), &types.MsgCreateAllowlistEntryRequest{
+			Signer:           getSigner(&guardians[0]),
+			Address:          getRandomAddress(),
+			ExpirationHeight: height,
+		})
+		assert.Error(t, err)
+	}
+
+	temporary := getRandomAddress()
+	permanent := getRandomAddress()
+	_, err := msgServer.CreateAllowlistEntry(sdk.WrapSDKContext(ctx), &types.MsgCreateAllowlistEntryRequest{
+		Signer:           getSigner(&guardians[0]),
+		Address:          temporary,
+		Name:             "relayer",
+		ExpirationHeight: 12,
+	})
+	assert.NoError(t, err)
+	_, err = msgServer.CreateAllowlistEntry(sdk.WrapSDKContext(ctx), &types.MsgCreateAllowlistEntryRequest{
+		Signer:  getSigner(&guardians[0]),
+		Address: permanent,
+	})
+	assert.NoError(t, err)
+
+	allowed := k.GetValidatorAllowedAddress(ctx, temporary)
+	assert.Equal(t, "relayer", allowed.Name)
+	assert.Equal(t, uint64(12), allowed.ExpirationHeight)
+
+	// Not pruned before the expiration height
+	assert.NoError(t, k.PruneExpiredAllowlistEntries(ctx.WithBlockHeight(11)))
+	assert.True(t, k.HasValidatorAllowedAddress(ctx, temporary))
+	_, err = anteHandler.AnteHandle(ctx.WithBlockHeight(11), getTxWithSigner(temporary), false, MockNext)
+	assert.NoError(t, err)
+
+	// Rejected by the ante handler once expired, even before pruning
+	_, err = anteHandler.AnteHandle(ctx.WithBlockHeight(12), getTxWithSigner(temporary), false, MockNext)
+	assert.Error(t, err)
+
+	// An expired entry can be taken over by another validator
+	_, err = msgServer.CreateAllowlistEntry(sdk.WrapSDKContext(ctx.WithBlockHeight(12)), &types.MsgCreateAllowlistEntryRequest{
+		Signer:           getSigner(&guardians[1]),
+		Address:          temporary,
+		ExpirationHeight: 20,
+	})
+	assert.NoError(t, err)
+
+	// The entry was extended, so the old expiration no longer prunes it
+	pruneCtx := ctx.WithBlockHeight(12).WithEventManager(sdk.NewEventManager())
+	assert.NoError(t, k.PruneExpiredAllowlistEntries(pruneCtx))
+	assert.True(t, k.HasValidatorAllowedAddress(ctx, temporary))
+	assert.Empty(t, pruneCtx.EventManager().Events())
+
+	pruneCtx = ctx.WithBlockHeight(25).WithEventManager(sdk.NewEventManager())
+	assert.NoError(t, k.PruneExpiredAllowlistEntries(pruneCtx))
+	assert.False(t, k.HasValidatorAllowedAddress(ctx, temporary))
+	assert.True(t, k.HasValidatorAllowedAddress(ctx, permanent))
+	events := pruneCtx.EventManager().Events()
+	assert.Len(t, events, 1)
+	assert.Equal(t, "wormhole_foundation.wormchain.wormhole.EventAllowlistEntryExpired", events[0].Type)
+
+	// Deleted entries are removed from the expiration index
+	expiring := getRandomAddress()
+	_, err = msgServer.CreateAllowlistEntry(sdk.WrapSDKContext(ctx), &types.MsgCreateAllowlistEntryRequest{
+		Signer:           getSigner(&guardians[0]),
+		Address:          expiring,
+		ExpirationHeight: 30,
+	})
+	assert.NoError(t, err)
+	_, err = msgServer.DeleteAllowlistEntry(sdk.WrapSDKContext(ctx), &types.MsgDeleteAllowlistEntryRequest{
+		Signer:  getSigner(&guardians[0]),
+		Address: expiring,
+	})
+	assert.NoError(t, err)
+	pruneCtx = ctx.WithBlockHeight(30).WithEventManager(sdk.NewEventManager())
+	assert.NoError(t, k.PruneExpiredAllowlistEntries(pruneCtx))
+	assert.Empty(t, pruneCtx.EventManager().Events())
+}
@@ -1,12 +1,19 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"reflect"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/holiman/uint256"
 
+	tokenfactorytypes "github.com/wormhole-foundation/wormchain/x/tokenfactory/types"
 	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
@@ -16,6 +23,9 @@ func (k msgServer) ExecuteGatewayGovernanceVaa(
 	msg *types.MsgExecuteGatewayGovernanceVaa,
 ) (*types.EmptyResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.assertMsgNotShutdown(ctx, msg); err != nil {
+		return nil, err
+	}
 
 	// Validate signer
 	_, err := sdk.AccAddressFromBech32(msg.Signer)
@@ -28,6 +38,12 @@ func (k msgServer) ExecuteGatewayGovernanceVaa(
 		sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
 	))
 
+	// Gateway governance is suspended along with the bridge; resuming it is a
+	// core governance action.
+	if k.IsBridgePaused(ctx) {
+		return nil, types.ErrBridgePaused
+	}
+
 	// Parse VAA
 	v, err := ParseVAA(msg.Vaa)
 	if err != nil {
@@ -41,16 +57,38 @@ func (k msgServer) ExecuteGatewayGovernanceVaa(
 	}
 
 	// Execute action
+	var res *types.EmptyResponse
 	switch vaa.GovernanceAction(action) {
 	case vaa.ActionScheduleUpgrade:
-		return k.scheduleUpgrade(ctx, payload)
+		res, err = k.scheduleUpgrade(ctx, payload)
 	case vaa.ActionCancelUpgrade:
-		return k.cancelUpgrade(ctx)
+		res, err = k.cancelUpgrade(ctx)
 	case vaa.ActionSetIbcComposabilityMwContract:
-		return k.setIbcComposabilityMwContract(ctx, payload)
+		res, err = k.setIbcComposabilityMwContract(ctx, payload)
+	case vaa.ActionSlashingParamsUpdate:
+		res, err = k.setSlashingParams(ctx, payload)
+	case vaa.ActionStakingParamsUpdate:
+		res, err = k.setStakingParams(ctx, payload)
+	case vaa.ActionIcaHostAllowlistUpdate:
+		res, err = k.updateIcaHostAllowlist(ctx, payload)
+	case vaa.ActionTokenFactoryAdminUpdate:
+		res, err = k.setTokenFactoryAdmin(ctx, payload)
+	case vaa.ActionTokenFactoryMetadataUpdate:
+		res, err = k.setTokenFactoryMetadata(ctx, payload)
+	case vaa.ActionSetCoreContract:
+		res, err = k.setCoreContract(ctx, payload)
+	case vaa.ActionApprovedCodeHashUpdate:
+		res, err = k.updateApprovedCodeHash(ctx, payload)
 	default:
 		return nil, types.ErrUnknownGovernanceAction
 	}
+	if err != nil {
+		return nil, err
+	}
+
+	telemetryGovernanceVAAExecuted(ctx, metricModuleGateway, action)
+
+	return res, nil
 }
 
 func (k msgServer) scheduleUpgrade(
@@ -81,7 +119,13 @@ func (k msgServer) setIbcComposabilityMwContract(
 ) (*types.EmptyResponse, error) {
 	// validate the contractAddress in the VAA payload match the ones in the message
 	var payloadBody vaa.BodyGatewayIbcComposabilityMwContract
-	payloadBody.Deserialize(payload)
+	if err := payloadBody.Deserialize(payload); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidGovernancePayloadLength, err.Error())
+	}
+	// a zero address would silently disable the middleware
+	if payloadBody.ContractAddr == [32]byte{} {
+		return nil, sdkerrors.Wrap(types.ErrInvalidIbcComposabilityMwContractAddr, "contract address cannot be zero")
+	}
 
 	// convert bytes to bech32 address
 	contractAddr, err := sdk.Bech32ifyAddressBytes(
@@ -96,7 +140,282 @@ func (k msgServer) setIbcComposabilityMwContract(
 		ContractAddress: contractAddr,
 	}
 
+	oldContract := k.GetIbcComposabilityMwContract(ctx)
 	k.StoreIbcComposabilityMwContract(ctx, newContract)
 
+	err = ctx.EventManager().EmitTypedEvent(&types.EventIbcComposabilityMwContractUpdate{
+		OldContractAddress: oldContract.ContractAddress,
+		NewContractAddress: newContract.ContractAddress,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+func (k msgServer) setCoreContract(
+	ctx sdk.Context,
+	payload []byte,
+) (*types.EmptyResponse, error) {
+	var payloadBody vaa.BodyGatewayCoreContract
+	if err := payloadBody.Deserialize(payload); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidGovernancePayloadLength, err.Error())
+	}
+	// a zero address would silently stop reporting the published messages
+	if payloadBody.ContractAddr == [32]byte{} {
+		return nil, sdkerrors.Wrap(types.ErrInvalidCoreContractAddr, "contract address cannot be zero")
+	}
+
+	newContract := types.CoreContract{
+		ContractAddress: sdk.AccAddress(payloadBody.ContractAddr[:]).String(),
+	}
+
+	oldContract := k.GetCoreContract(ctx)
+	k.StoreCoreContract(ctx, newContract)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventCoreContractUpdate{
+		OldContractAddress: oldContract.ContractAddress,
+		NewContractAddress: newContract.ContractAddress,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// SlashingParamsDecPrecision is the number of decimal places of the mantissas
+// used for the decimal slashing parameters in the governance payload. It
+// matches the precision of sdk.Dec, so a parameter is encoded as dec.BigInt()
+// and decodes back to the exact same value.
+const SlashingParamsDecPrecision = sdk.Precision
+
+func decodeSlashingParamsDec(v *uint256.Int) sdk.Dec {
+	return sdk.NewDecFromBigIntWithPrec(v.ToBig(), SlashingParamsDecPrecision)
+}
+
+func (k msgServer) setSlashingParams(
+	ctx sdk.Context,
+	payload []byte,
+) (*types.EmptyResponse, error) {
+	if !k.setSlashing {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "x/slashing not set")
+	}
+
+	var payloadBody vaa.BodyGatewaySlashingParamsUpdate
+	if err := payloadBody.Deserialize(payload); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidGovernancePayloadLength, err.Error())
+	}
+
+	params := slashingtypes.NewParams(
+		int64(payloadBody.SignedBlocksWindow),
+		decodeSlashingParamsDec(payloadBody.MinSignedPerWindow),
+		time.Duration(payloadBody.DowntimeJailDuration),
+		decodeSlashingParamsDec(payloadBody.SlashFractionDoubleSign),
+		decodeSlashingParamsDec(payloadBody.SlashFractionDowntime),
+	)
+
+	// The param store panics on invalid values, so validate them up front.
+	for _, pair := range params.ParamSetPairs() {
+		if err := pair.ValidatorFn(reflect.Indirect(reflect.ValueOf(pair.Value)).Interface()); err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidSlashingParams, "%s: %s", pair.Key, err)
+		}
+	}
+
+	k.slashingKeeper.SetParams(ctx, params)
+
+	return &types.EmptyResponse{}, nil
+}
+
+// setStakingParams updates the unbonding time, max validators, max entries and
+// bond denom of x/staking. The other staking params are left unchanged.
+func (k msgServer) setStakingParams(
+	ctx sdk.Context,
+	payload []byte,
+) (*types.EmptyResponse, error) {
+	if !k.setStaking {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "x/staking not set")
+	}
+
+	var payloadBody vaa.BodyGatewayStakingParamsUpdate
+	if err := payloadBody.Deserialize(payload); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidGovernancePayloadLength, err.Error())
+	}
+
+	params := k.stakingKeeper.GetParams(ctx)
+	params.UnbondingTime = time.Duration(payloadBody.UnbondingTime)
+	params.MaxValidators = payloadBody.MaxValidators
+	params.MaxEntries = payloadBody.MaxEntries
+	params.BondDenom = payloadBody.BondDenom
+
+	// The param store panics on invalid values, so validate them up front.
+	if err := params.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidStakingParams, err.Error())
+	}
+
+	k.stakingKeeper.SetParams(ctx, params)
+
+	return &types.EmptyResponse{}, nil
+}
+
+// updateIcaHostAllowlist allows or disallows a message type to be executed by
+// the interchain accounts of an IBC connection.
+func (k msgServer) updateIcaHostAllowlist(
+	ctx sdk.Context,
+	payload []byte,
+) (*types.EmptyResponse, error) {
+	var payloadBody vaa.BodyGatewayIcaHostAllowlistUpdate
+	if err := payloadBody.Deserialize(payload); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidGovernancePayloadLength, err.Error())
+	}
+
+	entry := types.IcaHostAllowlistEntry{
+		ConnectionId: payloadBody.ConnectionID,
+		MsgTypeUrl:   payloadBody.MsgTypeURL,
+	}
+	if err := entry.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidIcaHostAllowlistEntry, err.Error())
+	}
+
+	k.SetIcaHostAllowlistEntry(ctx, entry, payloadBody.Allowed)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventIcaHostAllowlistUpdate{
+		ConnectionId: entry.ConnectionId,
+		MsgTypeUrl:   entry.MsgTypeUrl,
+		Allowed:      payloadBody.Allowed,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// assertWrappedAssetDenom returns an error unless the denom is a tokenfactory
+// denom created by the ibc composability mw contract, which creates the denoms
+// of the wrapped assets on Gateway.
+func (k msgServer) assertWrappedAssetDenom(ctx sdk.Context, denom string) error {
+	creator, _, err := tokenfactorytypes.DeconstructDenom(denom)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidTokenFactoryDenom, err.Error())
+	}
+	contract := k.GetIbcComposabilityMwContract(ctx).ContractAddress
+	if contract == "" || creator != contract {
+		return sdkerrors.Wrapf(types.ErrInvalidTokenFactoryDenom, "denom %s", denom)
+	}
+	return nil
+}
+
+// setTokenFactoryAdmin sets the admin of a wrapped asset denom.
+func (k msgServer) setTokenFactoryAdmin(
+	ctx sdk.Context,
+	payload []byte,
+) (*types.EmptyResponse, error) {
+	if !k.setTokenFactory {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "x/tokenfactory not set")
+	}
+
+	var payloadBody vaa.BodyGatewayTokenFactoryAdminUpdate
+	if err := payloadBody.Deserialize(payload); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidGovernancePayloadLength, err.Error())
+	}
+	if err := k.assertWrappedAssetDenom(ctx, payloadBody.Denom); err != nil {
+		return nil, err
+	}
+	if payloadBody.NewAdmin != "" {
+		if _, err := sdk.AccAddressFromBech32(payloadBody.NewAdmin); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+	}
+
+	if err := k.tokenFactory.ForceChangeAdmin(ctx, payloadBody.Denom, payloadBody.NewAdmin); err != nil {
+		return nil, err
+	}
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventTokenFactoryAdminUpdate{
+		Denom:    payloadBody.Denom,
+		NewAdmin: payloadBody.NewAdmin,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// setTokenFactoryMetadata sets the bank metadata of a wrapped asset denom.
+func (k msgServer) setTokenFactoryMetadata(
+	ctx sdk.Context,
+	payload []byte,
+) (*types.EmptyResponse, error) {
+	if !k.setTokenFactory {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "x/tokenfactory not set")
+	}
+
+	var payloadBody vaa.BodyGatewayTokenFactoryMetadataUpdate
+	if err := payloadBody.Deserialize(payload); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidGovernancePayloadLength, err.Error())
+	}
+	var metadata banktypes.Metadata
+	if err := types.ModuleCdc.UnmarshalJSON(payloadBody.Metadata, &metadata); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if err := k.assertWrappedAssetDenom(ctx, metadata.Base); err != nil {
+		return nil, err
+	}
+
+	if err := k.tokenFactory.ForceSetDenomMetadata(ctx, metadata); err != nil {
+		return nil, err
+	}
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventTokenFactoryMetadataUpdate{
+		Denom: metadata.Base,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+func (k msgServer) updateApprovedCodeHash(
+	ctx sdk.Context,
+	payload []byte,
+) (*types.EmptyResponse, error) {
+	var payloadBody vaa.BodyGatewayApprovedCodeHashUpdate
+	if err := payloadBody.Deserialize(payload); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidGovernancePayloadLength, err.Error())
+	}
+
+	// a zero code hash revokes the approval
+	if payloadBody.CodeHash == [32]byte{} {
+		k.RemoveApprovedCodeHash(ctx, payloadBody.CodeID)
+		err := ctx.EventManager().EmitTypedEvent(&types.EventApprovedCodeHashUpdate{
+			CodeId: payloadBody.CodeID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &types.EmptyResponse{}, nil
+	}
+
+	// Only code that is already stored can be approved, and only with its own
+	// hash, so the approval can't be taken over by code uploaded later.
+	if !k.setWasmView {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "x/wasmd not set")
+	}
+	codeInfo := k.wasmViewKeeper.GetCodeInfo(ctx, payloadBody.CodeID)
+	if codeInfo == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "code id %d", payloadBody.CodeID)
+	}
+	if !bytes.Equal(codeInfo.CodeHash, payloadBody.CodeHash[:]) {
+		return nil, sdkerrors.Wrapf(types.ErrCodeHashMismatch, "code id %d", payloadBody.CodeID)
+	}
+
+	if err := k.approveCodeHash(ctx, payloadBody.CodeID, payloadBody.CodeHash[:]); err != nil {
+		return nil, err
+	}
+
 	return &types.EmptyResponse{}, nil
 }
@@ -0,0 +1,426 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/wormhole-foundation/wormchain/testutil/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func decMantissa(dec sdk.Dec) *uint256.Int {
+	mantissa, _ := uint256.FromBig(dec.BigInt())
+	return mantissa
+}
+
+func TestExecuteGatewayGovernanceVaaSlashingParams(t *testing.T) {
+	k, slashingKeeper, ctx := keepertest.WormholeKeeperAndSlashing(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 10)
+	k.SetConfig(ctx, types.Config{
+		GovernanceEmitter:     vaa.GovernanceEmitter[:],
+		GovernanceChain:       uint32(vaa.GovernanceChain),
+		ChainId:               uint32(vaa.ChainIDWormchain),
+		GuardianSetExpiration: 86400,
+	})
+	signer_bz := [20]byte{}
+	signer := sdk.AccAddress(signer_bz[:])
+
+	set := createNewGuardianSet(k, ctx, guardians)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: set.Index})
+
+	context := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	minSignedPerWindow := sdk.NewDecWithPrec(5, 1)
+	slashFractionDoubleSign := sdk.NewDecWithPrec(5, 2)
+	slashFractionDowntime := sdk.NewDecWithPrec(1, 2)
+	body := vaa.BodyGatewaySlashingParamsUpdate{
+		SignedBlocksWindow:      200,
+		MinSignedPerWindow:      decMantissa(minSignedPerWindow),
+		DowntimeJailDuration:    uint64(10 * time.Minute),
+		SlashFractionDoubleSign: decMantissa(slashFractionDoubleSign),
+		SlashFractionDowntime:   decMantissa(slashFractionDowntime),
+	}
+	payload, err := body.Serialize()
+	require.NoError(t, err)
+
+	v := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), payload)
+	vBz, _ := v.Marshal()
+	_, err = msgServer.ExecuteGatewayGovernanceVaa(context, &types.MsgExecuteGatewayGovernanceVaa{
+		Signer: signer.String(),
+		Vaa:    vBz,
+	})
+	require.NoError(t, err)
+
+	params := slashingKeeper.GetParams(ctx)
+	assert.Equal(t, int64(200), params.SignedBlocksWindow)
+	assert.True(t, minSignedPerWindow.Equal(params.MinSignedPerWindow), params.MinSignedPerWindow.String())
+	assert.Equal(t, 10*time.Minute, params.DowntimeJailDuration)
+	assert.True(t, slashFractionDoubleSign.Equal(params.SlashFractionDoubleSign), params.SlashFractionDoubleSign.String())
+	assert.True(t, slashFractionDowntime.Equal(params.SlashFractionDowntime), params.SlashFractionDowntime.String())
+
+	// Fractions keep the full precision of sdk.Dec
+	minSignedPerWindow = sdk.MustNewDecFromStr("0.123456789012345678")
+	body.MinSignedPerWindow = decMantissa(minSignedPerWindow)
+	payload, err = body.Serialize()
+	require.NoError(t, err)
+	v = generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), payload)
+	vBz, _ = v.Marshal()
+	_, err = msgServer.ExecuteGatewayGovernanceVaa(context, &types.MsgExecuteGatewayGovernanceVaa{
+		Signer: signer.String(),
+		Vaa:    vBz,
+	})
+	require.NoError(t, err)
+	params = slashingKeeper.GetParams(ctx)
+	assert.True(t, minSignedPerWindow.Equal(params.MinSignedPerWindow), params.MinSignedPerWindow.String())
+
+	// A fraction above 1 is rejected, even if it does not fit into 64 bits
+	body.SlashFractionDowntime = decMantissa(sdk.NewDec(100))
+	payload, err = body.Serialize()
+	require.NoError(t, err)
+	v = generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), payload)
+	vBz, _ = v.Marshal()
+	_, err = msgServer.ExecuteGatewayGovernanceVaa(context, &types.MsgExecuteGatewayGovernanceVaa{
+		Signer: signer.String(),
+		Vaa:    vBz,
+	})
+	assert.ErrorIs(t, err, types.ErrInvalidSlashingParams)
+
+	// Invalid length
+	v = generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), payload[:len(payload)-1])
+	vBz, _ = v.Marshal()
+	_, err = msgServer.ExecuteGatewayGovernanceVaa(context, &types.MsgExecuteGatewayGovernanceVaa{
+		Signer: signer.String(),
+		Vaa:    vBz,
+	})
+	assert.ErrorIs(t, err, types.ErrInvalidGovernancePayloadLength)
+}
+
+func TestExecuteGatewayGovernanceVaaStakingParams(t *testing.T) {
+	k, stakingKeeper, ctx := keepertest.WormholeKeeperAndStaking(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 10)
+	k.SetConfig(ctx, types.Config{
+		GovernanceEmitter:     vaa.GovernanceEmitter[:],
+		GovernanceChain:       uint32(vaa.GovernanceChain),
+		ChainId:               uint32(vaa.ChainIDWormchain),
+		GuardianSetExpiration: 86400,
+	})
+	signer_bz := [20]byte{}
+	signer := sdk.AccAddress(signer_bz[:])
+
+	set := createNewGuardianSet(k, ctx, guardians)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: set.Index})
+
+	context := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	execute := func(payload []byte) error {
+		v := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), payload)
+		vBz, _ := v.Marshal()
+		_, err := msgServer.ExecuteGatewayGovernanceVaa(context, &types.MsgExecuteGatewayGovernanceVaa{
+			Signer: signer.String(),
+			Vaa:    vBz,
+		})
+		return err
+	}
+
+	before := stakingKeeper.GetParams(ctx)
+	body := vaa.BodyGatewayStakingParamsUpdate{
+		UnbondingTime: uint64(14 * 24 * time.Hour),
+		MaxValidators: 19,
+		MaxEntries:    3,
+		BondDenom:     "uworm",
+	}
+	payload, err := body.Serialize()
+	require.NoError(t, err)
+	require.NoError(t, execute(payload))
+
+	params := stakingKeeper.GetParams(ctx)
+	assert.Equal(t, 14*24*time.Hour, params.UnbondingTime)
+	assert.Equal(t, uint32(19), params.MaxValidators)
+	assert.Equal(t, uint32(3), params.MaxEntries)
+	assert.Equal(t, "uworm", params.BondDenom)
+	// The params that are not in the payload are left unchanged
+	assert.Equal(t, before.HistoricalEntries, params.HistoricalEntries)
+
+	// Zero max validators is rejected
+	body.MaxValidators = 0
+	payload, err = body.Serialize()
+	require.NoError(t, err)
+	assert.ErrorIs(t, execute(payload), types.ErrInvalidStakingParams)
+
+	// Invalid bond denom
+	body.MaxValidators = 19
+	body.BondDenom = "!"
+	payload, err = body.Serialize()
+	require.NoError(t, err)
+	assert.ErrorIs(t, execute(payload), types.ErrInvalidStakingParams)
+	assert.Equal(t, params, stakingKeeper.GetParams(ctx))
+
+	// Invalid length
+	assert.ErrorIs(t, execute(payload[:len(payload)-1]), types.ErrInvalidGovernancePayloadLength)
+}
+
+func TestExecuteGatewayGovernanceVaaIbcComposabilityMwContract(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 10)
+	k.SetConfig(ctx, types.Config{
+		GovernanceEmitter:     vaa.GovernanceEmitter[:],
+		GovernanceChain:       uint32(vaa.GovernanceChain),
+		ChainId:               uint32(vaa.ChainIDWormchain),
+		GuardianSetExpiration: 86400,
+	})
+	signer_bz := [20]byte{}
+	signer := sdk.AccAddress(signer_bz[:])
+
+	set := createNewGuardianSet(k, ctx, guardians)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: set.Index})
+
+	context := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	execute := func(payload []byte) error {
+		v := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), payload)
+		vBz, _ := v.Marshal()
+		_, err := msgServer.ExecuteGatewayGovernanceVaa(context, &types.MsgExecuteGatewayGovernanceVaa{
+			Signer: signer.String(),
+			Vaa:    vBz,
+		})
+		return err
+	}
+
+	contractAddr := [32]byte{}
+	contractAddr[31] = 1
+	payload, err := vaa.BodyGatewayIbcComposabilityMwContract{ContractAddr: contractAddr}.Serialize()
+	require.NoError(t, err)
+	require.NoError(t, execute(payload))
+
+	expected := sdk.AccAddress(contractAddr[:]).String()
+	assert.Equal(t, expected, k.GetIbcComposabilityMwContract(ctx).ContractAddress)
+	res, err := k.IbcComposabilityMwContract(context, &types.QueryIbcComposabilityMwContractRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, expected, res.ContractAddress)
+
+	// Invalid length
+	assert.ErrorIs(t, execute(payload[:len(payload)-1]), types.ErrInvalidGovernancePayloadLength)
+
+	// Zero address
+	payload, err = vaa.BodyGatewayIbcComposabilityMwContract{}.Serialize()
+	require.NoError(t, err)
+	assert.ErrorIs(t, execute(payload), types.ErrInvalidIbcComposabilityMwContractAddr)
+	assert.Equal(t, expected, k.GetIbcComposabilityMwContract(ctx).ContractAddress)
+}
+
+func TestExecuteGatewayGovernanceVaaCoreContract(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 10)
+	k.SetConfig(ctx, types.Config{
+		GovernanceEmitter:     vaa.GovernanceEmitter[:],
+		GovernanceChain:       uint32(vaa.GovernanceChain),
+		ChainId:               uint32(vaa.ChainIDWormchain),
+		GuardianSetExpiration: 86400,
+	})
+	signer := sdk.AccAddress(make([]byte, 20))
+
+	set := createNewGuardianSet(k, ctx, guardians)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: set.Index})
+
+	context := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	execute := func(payload []byte) error {
+		v := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), payload)
+		vBz, _ := v.Marshal()
+		_, err := msgServer.ExecuteGatewayGovernanceVaa(context, &types.MsgExecuteGatewayGovernanceVaa{
+			Signer: signer.String(),
+			Vaa:    vBz,
+		})
+		return err
+	}
+
+	contractAddr := [32]byte{}
+	contractAddr[31] = 2
+	payload, err := vaa.BodyGatewayCoreContract{ContractAddr: contractAddr}.Serialize()
+	require.NoError(t, err)
+	require.NoError(t, execute(payload))
+
+	expected := sdk.AccAddress(contractAddr[:]).String()
+	assert.Equal(t, expected, k.GetCoreContract(ctx).ContractAddress)
+	res, err := k.CoreContract(context, &types.QueryCoreContractRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, expected, res.ContractAddress)
+
+	// Invalid length
+	assert.ErrorIs(t, execute(payload[:len(payload)-1]), types.ErrInvalidGovernancePayloadLength)
+
+	// Zero address
+	payload, err = vaa.BodyGatewayCoreContract{}.Serialize()
+	require.NoError(t, err)
+	assert.ErrorIs(t, execute(payload), types.ErrInvalidCoreContractAddr)
+	assert.Equal(t, expected, k.GetCoreContract(ctx).ContractAddress)
+}
+
+func TestExecuteGatewayGovernanceVaaIcaHostAllowlist(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 10)
+	k.SetConfig(ctx, types.Config{
+		GovernanceEmitter:     vaa.GovernanceEmitter[:],
+		GovernanceChain:       uint32(vaa.GovernanceChain),
+		ChainId:               uint32(vaa.ChainIDWormchain),
+		GuardianSetExpiration: 86400,
+	})
+	signer_bz := [20]byte{}
+	signer := sdk.AccAddress(signer_bz[:])
+
+	set := createNewGuardianSet(k, ctx, guardians)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: set.Index})
+
+	context := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	execute := func(body vaa.BodyGatewayIcaHostAllowlistUpdate) error {
+		payload, err := body.Serialize()
+		require.NoError(t, err)
+		v := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), payload)
+		vBz, _ := v.Marshal()
+		_, err = msgServer.ExecuteGatewayGovernanceVaa(context, &types.MsgExecuteGatewayGovernanceVaa{
+			Signer: signer.String(),
+			Vaa:    vBz,
+		})
+		return err
+	}
+
+	const msgSend = "/cosmos.bank.v1beta1.MsgSend"
+	const msgDelegate = "/cosmos.staking.v1beta1.MsgDelegate"
+	require.NoError(t, execute(vaa.BodyGatewayIcaHostAllowlistUpdate{Allowed: true, ConnectionID: "connection-0", MsgTypeURL: msgSend}))
+	require.NoError(t, execute(vaa.BodyGatewayIcaHostAllowlistUpdate{Allowed: true, ConnectionID: "connection-0", MsgTypeURL: msgDelegate}))
+	require.NoError(t, execute(vaa.BodyGatewayIcaHostAllowlistUpdate{Allowed: true, ConnectionID: "connection-1", MsgTypeURL: msgSend}))
+
+	// Entries apply to a single connection
+	assert.True(t, k.IsIcaHostMsgAllowed(ctx, "connection-0", msgSend))
+	assert.True(t, k.IsIcaHostMsgAllowed(ctx, "connection-1", msgSend))
+	assert.False(t, k.IsIcaHostMsgAllowed(ctx, "connection-1", msgDelegate))
+	assert.False(t, k.IsIcaHostMsgAllowed(ctx, "connection-10", msgSend))
+
+	res, err := k.IcaHostAllowlistAll(context, &types.QueryAllIcaHostAllowlistRequest{ConnectionId: "connection-0"})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []types.IcaHostAllowlistEntry{
+		{ConnectionId: "connection-0", MsgTypeUrl: msgSend},
+		{ConnectionId: "connection-0", MsgTypeUrl: msgDelegate},
+	}, res.Allowlist)
+	res, err = k.IcaHostAllowlistAll(context, &types.QueryAllIcaHostAllowlistRequest{})
+	require.NoError(t, err)
+	assert.Len(t, res.Allowlist, 3)
+
+	// Disallowing removes the entry
+	require.NoError(t, execute(vaa.BodyGatewayIcaHostAllowlistUpdate{Allowed: false, ConnectionID: "connection-0", MsgTypeURL: msgSend}))
+	assert.False(t, k.IsIcaHostMsgAllowed(ctx, "connection-0", msgSend))
+	assert.True(t, k.IsIcaHostMsgAllowed(ctx, "connection-0", msgDelegate))
+	assert.Len(t, k.GetAllIcaHostAllowlist(ctx), 2)
+
+	// Invalid connection ID and message type URL
+	assert.ErrorIs(t, execute(vaa.BodyGatewayIcaHostAllowlistUpdate{Allowed: true, ConnectionID: "channel/0", MsgTypeURL: msgSend}), types.ErrInvalidIcaHostAllowlistEntry)
+	assert.ErrorIs(t, execute(vaa.BodyGatewayIcaHostAllowlistUpdate{Allowed: true, ConnectionID: "connection-0", MsgTypeURL: "cosmos.bank.v1beta1.MsgSend"}), types.ErrInvalidIcaHostAllowlistEntry)
+	assert.Len(t, k.GetAllIcaHostAllowlist(ctx), 2)
+}
+
+// mockTokenFactoryKeeper records the admins and metadata set by governance
+type mockTokenFactoryKeeper struct {
+	admins   map[string]string
+	metadata map[string]banktypes.Metadata
+}
+
+func (m *mockTokenFactoryKeeper) ForceChangeAdmin(ctx sdk.Context, denom string, newAdmin string) error {
+	m.admins[denom] = newAdmin
+	return nil
+}
+
+func (m *mockTokenFactoryKeeper) ForceSetDenomMetadata(ctx sdk.Context, metadata banktypes.Metadata) error {
+	m.metadata[metadata.Base] = metadata
+	return nil
+}
+
+func TestExecuteGatewayGovernanceVaaTokenFactory(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 10)
+	k.SetConfig(ctx, types.Config{
+		GovernanceEmitter:     vaa.GovernanceEmitter[:],
+		GovernanceChain:       uint32(vaa.GovernanceChain),
+		ChainId:               uint32(vaa.ChainIDWormchain),
+		GuardianSetExpiration: 86400,
+	})
+	signer_bz := [20]byte{}
+	signer := sdk.AccAddress(signer_bz[:])
+
+	set := createNewGuardianSet(k, ctx, guardians)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: set.Index})
+
+	context := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	execute := func(body interface{ Serialize() ([]byte, error) }) error {
+		payload, err := body.Serialize()
+		require.NoError(t, err)
+		v := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), payload)
+		vBz, _ := v.Marshal()
+		_, err = msgServer.ExecuteGatewayGovernanceVaa(context, &types.MsgExecuteGatewayGovernanceVaa{
+			Signer: signer.String(),
+			Vaa:    vBz,
+		})
+		return err
+	}
+
+	contract := sdk.AccAddress(bytes.Repeat([]byte{1}, 32)).String()
+	admin := sdk.AccAddress(bytes.Repeat([]byte{2}, 20)).String()
+	wrapped := "factory/" + contract + "/wrapped"
+	other := "factory/" + admin + "/other"
+	metadata := banktypes.Metadata{
+		Base:        wrapped,
+		Display:     "wrapped",
+		Name:        "Wrapped",
+		Symbol:      "WRAPPED",
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: wrapped, Aliases: []string{}}, {Denom: "wrapped", Exponent: 8, Aliases: []string{}}},
+		Description: "wrapped asset",
+	}
+	metadataJson, err := types.ModuleCdc.MarshalJSON(&metadata)
+	require.NoError(t, err)
+
+	// The tokenfactory keeper must be set
+	assert.ErrorIs(t, execute(vaa.BodyGatewayTokenFactoryAdminUpdate{Denom: wrapped, NewAdmin: admin}), sdkerrors.ErrNotSupported)
+
+	tokenFactory := &mockTokenFactoryKeeper{admins: map[string]string{}, metadata: map[string]banktypes.Metadata{}}
+	k.SetTokenFactoryKeeper(tokenFactory)
+	msgServer = keeper.NewMsgServerImpl(*k)
+
+	// Only denoms created by the ibc composability mw contract can be updated
+	assert.ErrorIs(t, execute(vaa.BodyGatewayTokenFactoryAdminUpdate{Denom: wrapped, NewAdmin: admin}), types.ErrInvalidTokenFactoryDenom)
+	k.StoreIbcComposabilityMwContract(ctx, types.IbcComposabilityMwContract{ContractAddress: contract})
+	assert.ErrorIs(t, execute(vaa.BodyGatewayTokenFactoryAdminUpdate{Denom: other, NewAdmin: admin}), types.ErrInvalidTokenFactoryDenom)
+	assert.ErrorIs(t, execute(vaa.BodyGatewayTokenFactoryAdminUpdate{Denom: "uworm", NewAdmin: admin}), types.ErrInvalidTokenFactoryDenom)
+	assert.ErrorIs(t, execute(vaa.BodyGatewayTokenFactoryAdminUpdate{Denom: wrapped, NewAdmin: "not an address"}), sdkerrors.ErrInvalidAddress)
+	assert.Empty(t, tokenFactory.admins)
+
+	require.NoError(t, execute(vaa.BodyGatewayTokenFactoryAdminUpdate{Denom: wrapped, NewAdmin: admin}))
+	assert.Equal(t, admin, tokenFactory.admins[wrapped])
+	// An empty admin removes the admin
+	require.NoError(t, execute(vaa.BodyGatewayTokenFactoryAdminUpdate{Denom: wrapped}))
+	assert.Equal(t, "", tokenFactory.admins[wrapped])
+
+	require.NoError(t, execute(vaa.BodyGatewayTokenFactoryMetadataUpdate{Metadata: metadataJson}))
+	assert.Equal(t, metadata, tokenFactory.metadata[wrapped])
+
+	metadata.Base = other
+	metadataJson, err = types.ModuleCdc.MarshalJSON(&metadata)
+	require.NoError(t, err)
+	assert.ErrorIs(t, execute(vaa.BodyGatewayTokenFactoryMetadataUpdate{Metadata: metadataJson}), types.ErrInvalidTokenFactoryDenom)
+	assert.ErrorIs(t, execute(vaa.BodyGatewayTokenFactoryMetadataUpdate{Metadata: []byte("{")}), sdkerrors.ErrJSONUnmarshal)
+}
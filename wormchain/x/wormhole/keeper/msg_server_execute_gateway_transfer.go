@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+// ExecuteGatewayTransfer sends tokens through the gateway by executing the gateway contract on behalf of the
+// signer, so that wallets don't need to construct the wasm execute message themselves.
+func (k msgServer) ExecuteGatewayTransfer(goCtx context.Context, msg *types.MsgExecuteGatewayTransfer) (*types.MsgExecuteGatewayTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	executeMsg, err := msg.GatewayExecuteMsg()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := k.executeGatewayTransfer(ctx, msg, msg.Signer, msg.Amount, executeMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventGatewayTransfer{
+		Sender:         msg.Signer,
+		Amount:         msg.Amount.String(),
+		RecipientChain: msg.RecipientChain,
+		Recipient:      msg.Recipient,
+		Fee:            msg.Fee.String(),
+		Nonce:          msg.Nonce,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExecuteGatewayTransferResponse{Data: data}, nil
+}
+
+// ExecuteGatewayTransferWithPayload sends tokens and a payload through the gateway to a contract on another
+// chain by executing the gateway contract on behalf of the signer.
+func (k msgServer) ExecuteGatewayTransferWithPayload(goCtx context.Context, msg *types.MsgExecuteGatewayTransferWithPayload) (*types.MsgExecuteGatewayTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	executeMsg, err := msg.GatewayExecuteMsg()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := k.executeGatewayTransfer(ctx, msg, msg.Signer, msg.Amount, executeMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventGatewayTransfer{
+		Sender:         msg.Signer,
+		Amount:         msg.Amount.String(),
+		RecipientChain: msg.RecipientChain,
+		Recipient:      msg.Contract,
+		Nonce:          msg.Nonce,
+		WithPayload:    true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExecuteGatewayTransferResponse{Data: data}, nil
+}
+
+// executeGatewayTransfer executes the gateway contract with the signer as the caller, sending the amount along.
+func (k msgServer) executeGatewayTransfer(ctx sdk.Context, msg sdk.Msg, signer string, amount sdk.Coin, executeMsg []byte) ([]byte, error) {
+	if !k.setWasmd {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "x/wasmd not set")
+	}
+	if err := k.assertMsgNotShutdown(ctx, msg); err != nil {
+		return nil, err
+	}
+	if k.IsBridgePaused(ctx) {
+		return nil, types.ErrBridgePaused
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(signer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "signer")
+	}
+
+	gatewayContract := k.GetIbcComposabilityMwContract(ctx)
+	if gatewayContract.ContractAddress == "" {
+		return nil, types.ErrGatewayContractNotSet
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(gatewayContract.ContractAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "gateway contract")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, signer),
+	))
+
+	return k.wasmdKeeper.Execute(ctx, contractAddr, senderAddr, executeMsg, sdk.NewCoins(amount))
+}
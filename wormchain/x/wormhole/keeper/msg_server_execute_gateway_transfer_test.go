@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/wormhole-foundation/wormchain/testutil/keeper"
+	"github.com/wormhole-foundation/wormchain/testutil/sample"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+// mockWasmdKeeper records the contract executions of the wormhole module.
+type mockWasmdKeeper struct {
+	types.WasmdKeeper
+	contract sdk.AccAddress
+	caller   sdk.AccAddress
+	msg      []byte
+	coins    sdk.Coins
+}
+
+func (m *mockWasmdKeeper) Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error) {
+	m.contract = contractAddress
+	m.caller = caller
+	m.msg = msg
+	m.coins = coins
+	return []byte("executed"), nil
+}
+
+func TestExecuteGatewayTransfer(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	wasmd := &mockWasmdKeeper{}
+	k.SetWasmdKeeper(wasmd)
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	recipient := make([]byte, 32)
+	recipient[31] = 1
+	signer := sample.AccAddress()
+	amount := sdk.NewInt64Coin("factory/wormhole1contract/token", 1000)
+	msg := types.NewMsgExecuteGatewayTransfer(signer, amount, 2, recipient, sdk.NewInt(10), 42)
+	require.NoError(t, msg.ValidateBasic())
+
+	// The gateway contract must be set
+	_, err := msgServer.ExecuteGatewayTransfer(sdk.WrapSDKContext(ctx), msg)
+	assert.ErrorIs(t, err, types.ErrGatewayContractNotSet)
+
+	gatewayContract := sample.AccAddress()
+	k.StoreIbcComposabilityMwContract(ctx, types.IbcComposabilityMwContract{ContractAddress: gatewayContract})
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	resp, err := msgServer.ExecuteGatewayTransfer(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("executed"), resp.Data)
+	assert.Equal(t, gatewayContract, wasmd.contract.String())
+	assert.Equal(t, signer, wasmd.caller.String())
+	assert.Equal(t, sdk.NewCoins(amount), wasmd.coins)
+
+	var executeMsg map[string]map[string]interface{}
+	require.NoError(t, json.Unmarshal(wasmd.msg, &executeMsg))
+	assert.Equal(t, map[string]interface{}{
+		"recipient": "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAE=",
+		"chain":     float64(2),
+		"fee":       "10",
+		"nonce":     float64(42),
+	}, executeMsg["gateway_convert_and_transfer"])
+
+	var event *types.EventGatewayTransfer
+	for _, abciEvent := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(abciEvent)
+		if err != nil {
+			continue
+		}
+		if e, ok := msg.(*types.EventGatewayTransfer); ok {
+			event = e
+		}
+	}
+	require.NotNil(t, event)
+	assert.Equal(t, types.EventGatewayTransfer{
+		Sender:         signer,
+		Amount:         amount.String(),
+		RecipientChain: 2,
+		Recipient:      recipient,
+		Fee:            "10",
+		Nonce:          42,
+	}, *event)
+
+	// Transfers are rejected while the bridge is paused
+	k.SetBridgePaused(ctx, true)
+	_, err = msgServer.ExecuteGatewayTransfer(sdk.WrapSDKContext(ctx), msg)
+	assert.ErrorIs(t, err, types.ErrBridgePaused)
+}
+
+func TestExecuteGatewayTransferWithPayload(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	wasmd := &mockWasmdKeeper{}
+	k.SetWasmdKeeper(wasmd)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	k.StoreIbcComposabilityMwContract(ctx, types.IbcComposabilityMwContract{ContractAddress: sample.AccAddress()})
+
+	contract := make([]byte, 32)
+	contract[0] = 1
+	amount := sdk.NewInt64Coin("factory/wormhole1contract/token", 1000)
+	msg := types.NewMsgExecuteGatewayTransferWithPayload(sample.AccAddress(), amount, 2, contract, []byte{0xab}, 7)
+	require.NoError(t, msg.ValidateBasic())
+
+	_, err := msgServer.ExecuteGatewayTransferWithPayload(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	var executeMsg map[string]map[string]interface{}
+	require.NoError(t, json.Unmarshal(wasmd.msg, &executeMsg))
+	assert.Equal(t, map[string]interface{}{
+		"contract": "AQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=",
+		"chain":    float64(2),
+		"payload":  "qw==",
+		"nonce":    float64(7),
+	}, executeMsg["gateway_convert_and_transfer_with_payload"])
+
+	// Gateway transfers can be shut down by governance
+	k.SetMsgShutdown(ctx, sdk.MsgTypeURL(msg), true)
+	_, err = msgServer.ExecuteGatewayTransferWithPayload(sdk.WrapSDKContext(ctx), msg)
+	assert.ErrorIs(t, err, types.ErrMsgShutdown)
+}
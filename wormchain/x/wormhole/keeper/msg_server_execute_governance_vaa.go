@@ -1,10 +1,19 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
@@ -26,43 +35,488 @@ func (k msgServer) ExecuteGovernanceVAA(goCtx context.Context, msg *types.MsgExe
 		return nil, err
 	}
 
+	// Decode the payload of its version into the format of the handler
+	_, payload, err = types.CoreGovernancePayloads.Decode(coreModule, action, payload)
+	if err != nil {
+		return nil, err
+	}
+	handler, found := coreGovernanceActionHandlers[vaa.GovernanceAction(action)]
+	if !found {
+		return nil, types.ErrUnknownGovernanceAction
+	}
+
 	// Execute action
-	switch vaa.GovernanceAction(action) {
-	case vaa.ActionGuardianSetUpdate:
-		if len(payload) < 5 {
-			return nil, types.ErrInvalidGovernancePayloadLength
+	if err := handler(k.Keeper, ctx, payload); err != nil {
+		return nil, err
+	}
+
+	telemetryGovernanceVAAExecuted(ctx, metricModuleCore, action)
+
+	return &types.MsgExecuteGovernanceVAAResponse{}, nil
+}
+
+// executeGuardianSetUpdate replaces the guardian set. The payload is a
+// guardian set update, see parseGuardianSetUpdate.
+func (k Keeper) executeGuardianSetUpdate(ctx sdk.Context, payload []byte) error {
+	newGuardianSet, _, err := parseGuardianSetUpdate(payload)
+	if err != nil {
+		return err
+	}
+
+	return k.UpdateGuardianSet(ctx, newGuardianSet)
+}
+
+// executeScheduledGuardianSetUpdate schedules a guardian set update. The
+// payload is
+// [guardian set update][uint64 activation_height][uint64 activation_time]
+func (k Keeper) executeScheduledGuardianSetUpdate(ctx sdk.Context, payload []byte) error {
+	newGuardianSet, rest, err := parseGuardianSetUpdate(payload)
+	if err != nil {
+		return err
+	}
+	activationHeight := binary.BigEndian.Uint64(rest[:8])
+	activationTime := binary.BigEndian.Uint64(rest[8:16])
+
+	return k.ScheduleGuardianSetUpdate(ctx, newGuardianSet, activationHeight, activationTime)
+}
+
+// updateGovernanceEmitter sets the emitter governance VAAs are accepted from.
+// The payload is
+// [uint16 new_governance_chain][32-byte new_governance_emitter]
+func (k Keeper) updateGovernanceEmitter(ctx sdk.Context, payload []byte) error {
+	newChain := binary.BigEndian.Uint16(payload[:2])
+	newEmitter := payload[2:34]
+
+	// A zero emitter can never sign anything, so accepting it would
+	// permanently lock governance.
+	if bytes.Equal(newEmitter, make([]byte, 32)) {
+		return sdkerrors.Wrap(types.ErrInvalidGovernanceEmitter, "new governance emitter cannot be zero")
+	}
+
+	config, ok := k.GetConfig(ctx)
+	if !ok {
+		return types.ErrNoConfig
+	}
+	config.GovernanceChain = uint32(newChain)
+	config.GovernanceEmitter = newEmitter
+	k.SetConfig(ctx, config)
+	return nil
+}
+
+// pruneGuardianSets removes the guardian sets before an index. The payload is
+// [uint32 keep_from_index]
+func (k Keeper) pruneGuardianSets(ctx sdk.Context, payload []byte) error {
+	keepFromIndex := binary.BigEndian.Uint32(payload)
+
+	return k.PruneGuardianSets(ctx, keepFromIndex)
+}
+
+// updateSignatureVerificationGas sets the gas charged per verified guardian
+// signature. The payload is [uint64 gas_per_signature].
+func (k Keeper) updateSignatureVerificationGas(ctx sdk.Context, payload []byte) error {
+	gas := binary.BigEndian.Uint64(payload)
+	// Zero would silently fall back to the default
+	if gas == 0 {
+		return sdkerrors.Wrap(types.ErrInvalidSignatureVerificationGas, "gas per signature must be positive")
+	}
+
+	params := k.GetParams(ctx)
+	oldGas := k.GetSignatureVerificationGas(ctx)
+	params.SignatureVerificationGas = gas
+	k.SetParams(ctx, params)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventSignatureVerificationGasUpdate{
+		OldGas: oldGas,
+		NewGas: gas,
+	})
+}
+
+// parseGuardianSetUpdate decodes a guardian set update payload
+// [uint32 new_index][uint8 num_guardians][20-byte key]*num_guardians
+// and returns the new guardian set along with any trailing bytes.
+func parseGuardianSetUpdate(payload []byte) (types.GuardianSet, []byte, error) {
+	if len(payload) < 5 {
+		return types.GuardianSet{}, nil, types.ErrInvalidGovernancePayloadLength
+	}
+	newIndex := binary.BigEndian.Uint32(payload[:4])
+	numGuardians := int(payload[4])
+
+	if len(payload) < 5+20*numGuardians {
+		return types.GuardianSet{}, nil, types.ErrInvalidGovernancePayloadLength
+	}
+
+	added := make(map[string]bool)
+	var keys [][]byte
+	for i := 0; i < numGuardians; i++ {
+		k := payload[5+i*20 : 5+i*20+20]
+		sk := string(k)
+		if _, found := added[sk]; found {
+			return types.GuardianSet{}, nil, types.ErrDuplicateGuardianAddress
 		}
-		// Update guardian set
-		newIndex := binary.BigEndian.Uint32(payload[:4])
-		numGuardians := int(payload[4])
+		keys = append(keys, k)
+		added[sk] = true
+	}
+
+	return types.GuardianSet{
+		Keys:  keys,
+		Index: newIndex,
+	}, payload[5+20*numGuardians:], nil
+}
+
+// updateGuardianSetWeights sets the weights of the guardians of a guardian set
+// when tallying observations. The payload is
+// [uint32 guardian_set_index][uint8 num_guardians][uint64 weight]*num_guardians
+// with one weight per guardian of the set, in guardian index order.
+func (k Keeper) updateGuardianSetWeights(ctx sdk.Context, payload []byte) error {
+	guardianSetIndex := binary.BigEndian.Uint32(payload[0:4])
+	numGuardians := int(payload[4])
 
-		if len(payload) != 5+20*numGuardians {
-			return nil, types.ErrInvalidGovernancePayloadLength
+	guardianSet, found := k.GetGuardianSet(ctx, guardianSetIndex)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrGuardianSetNotFound, "guardian set %d", guardianSetIndex)
+	}
+
+	weights := types.GuardianSetWeights{GuardianSetIndex: guardianSetIndex}
+	for i := 0; i < numGuardians; i++ {
+		weights.Weights = append(weights.Weights, binary.BigEndian.Uint64(payload[5+8*i:]))
+	}
+	if err := weights.Validate(len(guardianSet.Keys)); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidGuardianWeights, err.Error())
+	}
+
+	k.SetGuardianSetWeights(ctx, weights)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventGuardianSetWeightsUpdate{
+		GuardianSetIndex: weights.GuardianSetIndex,
+		Weights:          weights.Weights,
+	})
+}
+
+// updateMsgShutdown shuts down or recovers the handler of a message type of
+// the module. The version 1 payload is
+// [uint8 shutdown][message type URL]
+// where shutdown is 1 to shut the handler down and 0 to recover it.
+func (k Keeper) updateMsgShutdown(ctx sdk.Context, payload []byte) error {
+	shutdown := payload[0] == 1
+	msgTypeURL := string(payload[1:])
+	if err := types.ValidateShutdownMsgTypeURL(msgTypeURL); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidMsgShutdown, err.Error())
+	}
+
+	k.SetMsgShutdown(ctx, msgTypeURL, shutdown)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventMsgShutdownUpdate{
+		MsgTypeUrl: msgTypeURL,
+		Shutdown:   shutdown,
+	})
+}
+
+// updateForwardFee sets the fee deducted from the transfers the packet forward
+// middleware forwards. The version 1 payload is
+// [uint16 fee_bps]
+// with the fee in basis points of the forwarded amount.
+func (k Keeper) updateForwardFee(ctx sdk.Context, payload []byte) error {
+	feeBps := uint32(binary.BigEndian.Uint16(payload))
+	if feeBps > types.MaxForwardFeeBps {
+		return sdkerrors.Wrapf(types.ErrInvalidFeeParams, "forward fee of %d bps exceeds %d bps", feeBps, types.MaxForwardFeeBps)
+	}
+
+	params := k.GetParams(ctx)
+	oldFeeBps := params.ForwardFeeBps
+	params.ForwardFeeBps = feeBps
+	k.SetParams(ctx, params)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventForwardFeeUpdate{
+		OldFeeBps: oldFeeBps,
+		NewFeeBps: feeBps,
+	})
+}
+
+// updateMaintenanceWindow declares the window of blocks during which wasm
+// executions sent directly to the listed contracts are rejected. The version 1
+// payload is
+// [uint64 start_height][uint64 end_height][uint8 num_contracts][[32]byte contract]...
+// where a payload without contracts ends the maintenance window.
+func (k Keeper) updateMaintenanceWindow(ctx sdk.Context, payload []byte) error {
+	window := types.MaintenanceWindow{
+		StartHeight: binary.BigEndian.Uint64(payload[0:8]),
+		EndHeight:   binary.BigEndian.Uint64(payload[8:16]),
+	}
+	for i := 0; i < int(payload[16]); i++ {
+		contract := payload[17+32*i : 17+32*(i+1)]
+		window.Contracts = append(window.Contracts, sdk.AccAddress(contract).String())
+	}
+
+	if len(window.Contracts) == 0 {
+		k.RemoveMaintenanceWindow(ctx)
+	} else {
+		if err := window.Validate(); err != nil {
+			return sdkerrors.Wrap(types.ErrInvalidMaintenanceWindow, err.Error())
 		}
+		k.SetMaintenanceWindow(ctx, window)
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventMaintenanceWindowUpdate{
+		StartHeight: window.StartHeight,
+		EndHeight:   window.EndHeight,
+		Contracts:   window.Contracts,
+	})
+}
 
-		added := make(map[string]bool)
-		var keys [][]byte
-		for i := 0; i < numGuardians; i++ {
-			k := payload[5+i*20 : 5+i*20+20]
-			sk := string(k)
-			if _, found := added[sk]; found {
-				return nil, types.ErrDuplicateGuardianAddress
-			}
-			keys = append(keys, k)
-			added[sk] = true
+// updateValidatorTransitionWindow sets the number of blocks the validators of
+// guardians removed from the consensus guardian set stay bonded. The version 1
+// payload is [uint64 transition_blocks], 0 unbonds them at the switch. Pending
+// transitions keep their unbond height.
+func (k Keeper) updateValidatorTransitionWindow(ctx sdk.Context, payload []byte) error {
+	transitionBlocks := binary.BigEndian.Uint64(payload)
+
+	params := k.GetParams(ctx)
+	oldTransitionBlocks := params.ValidatorTransitionBlocks
+	params.ValidatorTransitionBlocks = transitionBlocks
+	k.SetParams(ctx, params)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventValidatorTransitionWindowUpdate{
+		OldTransitionBlocks: oldTransitionBlocks,
+		NewTransitionBlocks: transitionBlocks,
+	})
+}
+
+// updateChainRateLimit sets the rate limit of an emitter chain. The payload is
+// [uint16 chain_id][uint64 limit][uint64 window_blocks]
+// where a limit of 0 removes the rate limit of the chain.
+func (k Keeper) updateChainRateLimit(ctx sdk.Context, payload []byte) error {
+	rateLimit := types.ChainRateLimit{
+		ChainId:      uint32(binary.BigEndian.Uint16(payload[0:2])),
+		Limit:        binary.BigEndian.Uint64(payload[2:10]),
+		WindowBlocks: binary.BigEndian.Uint64(payload[10:18]),
+	}
+
+	if rateLimit.Limit == 0 {
+		k.RemoveChainRateLimit(ctx, uint16(rateLimit.ChainId))
+	} else {
+		if err := rateLimit.Validate(); err != nil {
+			return sdkerrors.Wrap(types.ErrInvalidChainRateLimit, err.Error())
 		}
+		k.SetChainRateLimit(ctx, rateLimit)
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventChainRateLimitUpdate{
+		ChainId:      rateLimit.ChainId,
+		Limit:        rateLimit.Limit,
+		WindowBlocks: rateLimit.WindowBlocks,
+	})
+}
 
-		err := k.UpdateGuardianSet(ctx, types.GuardianSet{
-			Keys:  keys,
-			Index: newIndex,
-		})
-		if err != nil {
-			return nil, err
+// pauseBridge pauses the bridge, see setBridgePaused.
+func (k Keeper) pauseBridge(ctx sdk.Context, payload []byte) error {
+	return k.setBridgePaused(ctx, payload, true)
+}
+
+// resumeBridge resumes the bridge, see setBridgePaused.
+func (k Keeper) resumeBridge(ctx sdk.Context, payload []byte) error {
+	return k.setBridgePaused(ctx, payload, false)
+}
+
+// setBridgePaused pauses or resumes the bridge. The payload is empty. Pausing
+// a paused bridge or resuming a running one is a no-op and emits no event.
+func (k Keeper) setBridgePaused(ctx sdk.Context, payload []byte, paused bool) error {
+	if k.IsBridgePaused(ctx) == paused {
+		return nil
+	}
+
+	k.SetBridgePaused(ctx, paused)
+
+	if paused {
+		return ctx.EventManager().EmitTypedEvent(&types.EventBridgePaused{})
+	}
+	return ctx.EventManager().EmitTypedEvent(&types.EventBridgeResumed{})
+}
+
+// updateVAAArchiveRetention sets the number of blocks verified VAAs are kept
+// in the VAA archive. The payload is [uint64 retention_blocks], 0 disables the
+// archive and clears it over the following blocks.
+func (k Keeper) updateVAAArchiveRetention(ctx sdk.Context, payload []byte) error {
+	retention := binary.BigEndian.Uint64(payload)
+
+	params := k.GetParams(ctx)
+	oldRetention := params.VaaArchiveRetentionBlocks
+	params.VaaArchiveRetentionBlocks = retention
+	k.SetParams(ctx, params)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventVAAArchiveRetentionUpdate{
+		OldRetentionBlocks: oldRetention,
+		NewRetentionBlocks: retention,
+	})
+}
+
+// updateGovernanceSubmitter adds an account to or removes it from the
+// governance submitter allowlist. The payload is
+// [uint8 allowed][address]
+// where allowed is 1 to add and 0 to remove the account, and the address is
+// the 20 or 32 byte account address.
+func (k Keeper) updateGovernanceSubmitter(ctx sdk.Context, payload []byte) error {
+	if payload[0] > 1 {
+		return sdkerrors.Wrapf(types.ErrInvalidGovernanceSubmitter, "invalid allowed flag %d", payload[0])
+	}
+	allowed := payload[0] == 1
+	address := sdk.AccAddress(payload[1:]).String()
+
+	if allowed {
+		k.SetGovernanceSubmitter(ctx, types.GovernanceSubmitter{Address: address})
+	} else {
+		if _, found := k.GetGovernanceSubmitter(ctx, address); !found {
+			return sdkerrors.Wrapf(types.ErrInvalidGovernanceSubmitter, "%s is not allowlisted", address)
 		}
-	default:
-		return nil, types.ErrUnknownGovernanceAction
+		k.RemoveGovernanceSubmitter(ctx, address)
+	}
 
+	return ctx.EventManager().EmitTypedEvent(&types.EventGovernanceSubmitterUpdate{
+		Address: address,
+		Allowed: allowed,
+	})
+}
+
+// updateQuorumThreshold overrides the fraction of guardians that need to sign
+// a VAA. The payload is
+// [uint32 numerator][uint32 denominator]
+// The threshold can only be raised above the default 2/3, and must stay below
+// 1 so that a quorum never needs more signatures than there are guardians.
+// 0/0 restores the default.
+func (k Keeper) updateQuorumThreshold(ctx sdk.Context, payload []byte) error {
+	numerator := binary.BigEndian.Uint32(payload[0:4])
+	denominator := binary.BigEndian.Uint32(payload[4:8])
+
+	if numerator != 0 || denominator != 0 {
+		if denominator == 0 || numerator >= denominator {
+			return sdkerrors.Wrapf(types.ErrInvalidQuorumThreshold, "%d/%d must be below 1", numerator, denominator)
+		}
+		// numerator/denominator >= 2/3
+		if 3*uint64(numerator) < 2*uint64(denominator) {
+			return sdkerrors.Wrapf(types.ErrInvalidQuorumThreshold, "%d/%d is below 2/3", numerator, denominator)
+		}
 	}
 
-	return &types.MsgExecuteGovernanceVAAResponse{}, nil
+	params := k.GetParams(ctx)
+	params.QuorumNumerator = numerator
+	params.QuorumDenominator = denominator
+	k.SetParams(ctx, params)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventQuorumThresholdUpdate{
+		Numerator:   numerator,
+		Denominator: denominator,
+	})
+}
+
+// registerEmitter registers the emitter a module trusts on a foreign chain,
+// replacing any previous registration. The payload is
+// [uint16 chain_id][32-byte emitter_address][32-byte module]
+// where the module is left padded with zeros like in the governance header.
+func (k Keeper) registerEmitter(ctx sdk.Context, payload []byte) error {
+	chainID := binary.BigEndian.Uint16(payload[0:2])
+	emitterAddress := payload[2:34]
+	module := string(bytes.TrimLeft(payload[34:66], "\x00"))
+
+	if chainID == 0 {
+		return sdkerrors.Wrap(types.ErrInvalidEmitterRegistration, "chain id cannot be zero")
+	}
+	if bytes.Equal(emitterAddress, make([]byte, 32)) {
+		return sdkerrors.Wrap(types.ErrInvalidEmitterRegistration, "emitter address cannot be zero")
+	}
+	if module == "" {
+		return sdkerrors.Wrap(types.ErrInvalidEmitterRegistration, "module cannot be empty")
+	}
+
+	registeredEmitter := types.RegisteredEmitter{
+		Module:         module,
+		ChainId:        uint32(chainID),
+		EmitterAddress: emitterAddress,
+	}
+	k.SetRegisteredEmitter(ctx, registeredEmitter)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventEmitterRegistered{
+		Module:         registeredEmitter.Module,
+		ChainId:        registeredEmitter.ChainId,
+		EmitterAddress: registeredEmitter.EmitterAddress,
+	})
+}
+
+// updateFeeParams sets the message and gateway transfer fees. The payload is
+// [uint256 message_fee][uint256 gateway_transfer_fee]
+// with both fees in uworm.
+func (k Keeper) updateFeeParams(ctx sdk.Context, payload []byte) error {
+
+	messageFee, err := decodeFee(payload[0:32])
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidFeeParams, "message fee: %s", err)
+	}
+	gatewayTransferFee, err := decodeFee(payload[32:64])
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidFeeParams, "gateway transfer fee: %s", err)
+	}
+
+	params := k.GetParams(ctx)
+	old := params
+	params.MessageFee = messageFee
+	params.GatewayTransferFee = gatewayTransferFee
+	k.SetParams(ctx, params)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventFeeParamsUpdate{
+		OldMessageFee:         old.MessageFee,
+		NewMessageFee:         params.MessageFee,
+		OldGatewayTransferFee: old.GatewayTransferFee,
+		NewGatewayTransferFee: params.GatewayTransferFee,
+	})
+}
+
+// decodeFee decodes a big endian uint256 fee, which must fit in a uint64.
+func decodeFee(bz []byte) (uint64, error) {
+	if !bytes.Equal(bz[:24], make([]byte, 24)) {
+		return 0, fmt.Errorf("%x does not fit in 64 bits", bz)
+	}
+	return binary.BigEndian.Uint64(bz[24:]), nil
+}
+
+// updateConsensusParams replaces the block and evidence consensus params. The
+// payload is
+// [int64 block_max_bytes][int64 block_max_gas]
+// [int64 evidence_max_age_num_blocks][int64 evidence_max_age_duration_ns][int64 evidence_max_bytes]
+// The validator params are left untouched.
+func (k Keeper) updateConsensusParams(ctx sdk.Context, payload []byte) error {
+	if !k.setConsensus {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "consensus params keeper not set")
+	}
+
+	block := abci.BlockParams{
+		MaxBytes: int64(binary.BigEndian.Uint64(payload[0:8])),
+		MaxGas:   int64(binary.BigEndian.Uint64(payload[8:16])),
+	}
+	evidence := tmproto.EvidenceParams{
+		MaxAgeNumBlocks: int64(binary.BigEndian.Uint64(payload[16:24])),
+		MaxAgeDuration:  time.Duration(binary.BigEndian.Uint64(payload[24:32])),
+		MaxBytes:        int64(binary.BigEndian.Uint64(payload[32:40])),
+	}
+
+	if err := baseapp.ValidateBlockParams(block); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidConsensusParams, err.Error())
+	}
+	if err := baseapp.ValidateEvidenceParams(evidence); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidConsensusParams, err.Error())
+	}
+	// Tendermint rejects these on its side, which would halt the chain
+	if block.MaxBytes > tmtypes.MaxBlockSizeBytes {
+		return sdkerrors.Wrapf(types.ErrInvalidConsensusParams, "block maximum bytes must not exceed %d", tmtypes.MaxBlockSizeBytes)
+	}
+	if evidence.MaxBytes > block.MaxBytes {
+		return sdkerrors.Wrap(types.ErrInvalidConsensusParams, "evidence maximum bytes must not exceed block maximum bytes")
+	}
+
+	cp := k.consensusKeeper.GetConsensusParams(ctx)
+	if cp == nil || cp.Validator == nil {
+		return sdkerrors.Wrap(types.ErrInvalidConsensusParams, "consensus params not initialized")
+	}
+	cp.Block = &block
+	cp.Evidence = &evidence
+	k.consensusKeeper.StoreConsensusParams(ctx, cp)
+
+	return nil
 }
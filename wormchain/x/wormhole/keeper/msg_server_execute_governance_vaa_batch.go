@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+func (k msgServer) ExecuteGovernanceVAABatch(goCtx context.Context, msg *types.MsgExecuteGovernanceVAABatch) (*types.MsgExecuteGovernanceVAABatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.assertMsgNotShutdown(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// Execute the VAAs against a branch of the state, which is only written
+	// back once every one of them succeeded. The branch has its own event
+	// manager, so its events are emitted along with the writes.
+	cacheCtx, writeCache := ctx.CacheContext()
+	for i, vaaBz := range msg.Vaas {
+		_, err := k.ExecuteGovernanceVAA(sdk.WrapSDKContext(cacheCtx), &types.MsgExecuteGovernanceVAA{
+			Vaa:    vaaBz,
+			Signer: msg.Signer,
+		})
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "VAA %d of the batch", i)
+		}
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return &types.MsgExecuteGovernanceVAABatchResponse{}, nil
+}
@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/wormhole-foundation/wormchain/testutil/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestExecuteGovernanceVAABatch(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 10)
+	k.SetConfig(ctx, types.Config{
+		GovernanceEmitter:     vaa.GovernanceEmitter[:],
+		GovernanceChain:       uint32(vaa.GovernanceChain),
+		ChainId:               uint32(vaa.ChainIDWormchain),
+		GuardianSetExpiration: 86400,
+	})
+	signer_bz := [20]byte{}
+	signer := sdk.AccAddress(signer_bz[:])
+
+	set := createNewGuardianSet(k, ctx, guardians)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: set.Index})
+
+	context := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	newEmitter := vaa.Address{}
+	newEmitter[31] = 0x05
+	emitterPayload := createUpdateGovernanceEmitterPayload(vaa.ChainIDEthereum, newEmitter)
+	emitterVaa := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), emitterPayload)
+	emitterVaaBz, _ := emitterVaa.Marshal()
+
+	guardianSetPayload, _ := createExecuteGovernanceVaaPayload(k, ctx, 11)
+	guardianSetVaa := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), guardianSetPayload)
+	guardianSetVaaBz, _ := guardianSetVaa.Marshal()
+
+	badVaa := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), guardianSetPayload[:len(guardianSetPayload)-1])
+	badVaaBz, _ := badVaa.Marshal()
+
+	// A failing VAA rolls back the ones before it
+	_, err := msgServer.ExecuteGovernanceVAABatch(context, &types.MsgExecuteGovernanceVAABatch{
+		Signer: signer.String(),
+		Vaas:   [][]byte{guardianSetVaaBz, badVaaBz},
+	})
+	assert.ErrorIs(t, err, types.ErrInvalidGovernancePayloadLength)
+	assert.Equal(t, set.Index, k.GetLatestGuardianSetIndex(ctx))
+	_, found := k.GetReplayProtection(ctx, guardianSetVaa.HexDigest())
+	assert.False(t, found)
+
+	// The same VAA twice in a batch is rejected
+	_, err = msgServer.ExecuteGovernanceVAABatch(context, &types.MsgExecuteGovernanceVAABatch{
+		Signer: signer.String(),
+		Vaas:   [][]byte{guardianSetVaaBz, guardianSetVaaBz},
+	})
+	assert.ErrorIs(t, err, types.ErrGovernanceVaaAlreadyExecuted)
+	assert.Equal(t, set.Index, k.GetLatestGuardianSetIndex(ctx))
+
+	// All VAAs are executed, in order
+	_, err = msgServer.ExecuteGovernanceVAABatch(context, &types.MsgExecuteGovernanceVAABatch{
+		Signer: signer.String(),
+		Vaas:   [][]byte{guardianSetVaaBz, emitterVaaBz},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, set.Index+1, k.GetLatestGuardianSetIndex(ctx))
+	config, _ := k.GetConfig(ctx)
+	assert.Equal(t, newEmitter[:], config.GovernanceEmitter)
+
+	// The governance emitter changed mid-batch, so later VAAs from the old one fail
+	_, err = msgServer.ExecuteGovernanceVAABatch(context, &types.MsgExecuteGovernanceVAABatch{
+		Signer: signer.String(),
+		Vaas:   [][]byte{badVaaBz},
+	})
+	assert.ErrorIs(t, err, types.ErrInvalidGovernanceEmitter)
+}
+
+func TestExecuteGovernanceVAABatchEvents(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 10)
+	k.SetConfig(ctx, types.Config{
+		GovernanceEmitter:     vaa.GovernanceEmitter[:],
+		GovernanceChain:       uint32(vaa.GovernanceChain),
+		ChainId:               uint32(vaa.ChainIDWormchain),
+		GuardianSetExpiration: 86400,
+	})
+	signer := sdk.AccAddress(make([]byte, 20))
+
+	set := createNewGuardianSet(k, ctx, guardians)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: set.Index})
+
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	guardianSetPayload, _ := createExecuteGovernanceVaaPayload(k, ctx, 11)
+	guardianSetVaa := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), guardianSetPayload)
+	guardianSetVaaBz, _ := guardianSetVaa.Marshal()
+
+	module := [32]byte{}
+	copy(module[:], vaa.CoreModule)
+	pausePayload := types.NewGovernanceMessage(module, byte(vaa.ActionPauseBridge), uint16(vaa.ChainIDWormchain), nil)
+	pauseVaa := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), pausePayload.MarshalBinary())
+	pauseVaaBz, _ := pauseVaa.Marshal()
+
+	_, err := msgServer.ExecuteGovernanceVAABatch(sdk.WrapSDKContext(ctx), &types.MsgExecuteGovernanceVAABatch{
+		Signer: signer.String(),
+		Vaas:   [][]byte{guardianSetVaaBz, pauseVaaBz},
+	})
+	require.NoError(t, err)
+
+	// The events of both actions are emitted on the context of the batch
+	var guardianSetUpdates, bridgePauses int
+	for _, abciEvent := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(abciEvent)
+		if err != nil {
+			continue
+		}
+		switch e := msg.(type) {
+		case *types.EventGuardianSetUpdate:
+			guardianSetUpdates++
+			assert.Equal(t, set.Index+1, e.NewIndex)
+		case *types.EventBridgePaused:
+			bridgePauses++
+		}
+	}
+	assert.Equal(t, 1, guardianSetUpdates)
+	assert.Equal(t, 1, bridgePauses)
+}
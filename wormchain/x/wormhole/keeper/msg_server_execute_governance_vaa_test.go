@@ -1,13 +1,20 @@
 package keeper_test
 
 import (
+	"bytes"
 	"crypto/ecdsa"
-	"encoding/binary"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	keepertest "github.com/wormhole-foundation/wormchain/testutil/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/ante"
 	"github.com/wormhole-foundation/wormchain/x/wormhole/keeper"
 	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
@@ -15,20 +22,13 @@ import (
 
 func createExecuteGovernanceVaaPayload(k *keeper.Keeper, ctx sdk.Context, num_guardians byte) ([]byte, []*ecdsa.PrivateKey) {
 	guardians, privateKeys := createNGuardianValidator(k, ctx, int(num_guardians))
-	next_index := k.GetGuardianSetCount(ctx)
-	set_update := make([]byte, 4)
-	binary.BigEndian.PutUint32(set_update, next_index)
-	set_update = append(set_update, num_guardians)
-	// Add keys to set_update
-	for _, guardian := range guardians {
-		set_update = append(set_update, guardian.GuardianKey...)
+	keys := make([]common.Address, len(guardians))
+	for i, guardian := range guardians {
+		keys[i] = common.BytesToAddress(guardian.GuardianKey)
 	}
-	// governance message with sha3 of wasmBytes as the payload
-	module := [32]byte{}
-	copy(module[:], vaa.CoreModule)
-	gov_msg := types.NewGovernanceMessage(module, byte(vaa.ActionGuardianSetUpdate), uint16(vaa.ChainIDWormchain), set_update)
+	payload, _ := vaa.BodyGuardianSetUpdate{Keys: keys, NewIndex: k.GetGuardianSetCount(ctx)}.Serialize()
 
-	return gov_msg.MarshalBinary(), privateKeys
+	return payload, privateKeys
 }
 
 func TestExecuteGovernanceVAA(t *testing.T) {
@@ -107,3 +107,669 @@ func TestExecuteGovernanceVAA(t *testing.T) {
 	new_index2 := k.GetLatestGuardianSetIndex(ctx)
 	assert.Equal(t, new_set.Index+1, new_index2)
 }
+
+func createUpdateGovernanceEmitterPayload(chain vaa.ChainID, emitter vaa.Address) []byte {
+	payload, _ := vaa.BodyWormchainUpdateGovernanceEmitter{GovernanceChain: chain, GovernanceEmitter: emitter}.Serialize()
+	return payload
+}
+
+func TestExecuteGovernanceVAAUpdateGovernanceEmitter(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 10)
+	k.SetConfig(ctx, types.Config{
+		GovernanceEmitter:     vaa.GovernanceEmitter[:],
+		GovernanceChain:       uint32(vaa.GovernanceChain),
+		ChainId:               uint32(vaa.ChainIDWormchain),
+		GuardianSetExpiration: 86400,
+	})
+	signer_bz := [20]byte{}
+	signer := sdk.AccAddress(signer_bz[:])
+
+	set := createNewGuardianSet(k, ctx, guardians)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: set.Index})
+
+	context := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	newEmitter := vaa.Address{}
+	newEmitter[31] = 0x05
+
+	// Invalid length
+	payload := createUpdateGovernanceEmitterPayload(vaa.ChainIDEthereum, newEmitter)
+	v := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), payload[:len(payload)-1])
+	vBz, _ := v.Marshal()
+	_, err := msgServer.ExecuteGovernanceVAA(context, &types.MsgExecuteGovernanceVAA{
+		Signer: signer.String(),
+		Vaa:    vBz,
+	})
+	assert.ErrorIs(t, err, types.ErrInvalidGovernancePayloadLength)
+
+	// Zero emitter is rejected
+	payload = createUpdateGovernanceEmitterPayload(vaa.ChainIDEthereum, vaa.Address{})
+	v = generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), payload)
+	vBz, _ = v.Marshal()
+	_, err = msgServer.ExecuteGovernanceVAA(context, &types.MsgExecuteGovernanceVAA{
+		Signer: signer.String(),
+		Vaa:    vBz,
+	})
+	assert.ErrorIs(t, err, types.ErrInvalidGovernanceEmitter)
+
+	// Rotate the governance emitter
+	payload = createUpdateGovernanceEmitterPayload(vaa.ChainIDEthereum, newEmitter)
+	v = generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), payload)
+	vBz, _ = v.Marshal()
+	_, err = msgServer.ExecuteGovernanceVAA(context, &types.MsgExecuteGovernanceVAA{
+		Signer: signer.String(),
+		Vaa:    vBz,
+	})
+	assert.NoError(t, err)
+
+	config, found := k.GetConfig(ctx)
+	assert.True(t, found)
+	assert.Equal(t, uint32(vaa.ChainIDEthereum), config.GovernanceChain)
+	assert.Equal(t, newEmitter[:], config.GovernanceEmitter)
+
+	// A VAA from the old emitter no longer verifies
+	guardianSetPayload, _ := createExecuteGovernanceVaaPayload(k, ctx, 11)
+	v = generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), guardianSetPayload)
+	vBz, _ = v.Marshal()
+	_, err = msgServer.ExecuteGovernanceVAA(context, &types.MsgExecuteGovernanceVAA{
+		Signer: signer.String(),
+		Vaa:    vBz,
+	})
+	assert.ErrorIs(t, err, types.ErrInvalidGovernanceEmitter)
+
+	// A VAA from the new emitter does
+	v = generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), guardianSetPayload)
+	v.EmitterChain = vaa.ChainIDEthereum
+	v.EmitterAddress = newEmitter
+	v = resignVaa(v, privateKeys)
+	vBz, _ = v.Marshal()
+	_, err = msgServer.ExecuteGovernanceVAA(context, &types.MsgExecuteGovernanceVAA{
+		Signer: signer.String(),
+		Vaa:    vBz,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, set.Index+1, k.GetLatestGuardianSetIndex(ctx))
+}
+
+func TestExecuteGovernanceVAAInvalidModule(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 10)
+	k.SetConfig(ctx, types.Config{
+		GovernanceEmitter:     vaa.GovernanceEmitter[:],
+		GovernanceChain:       uint32(vaa.GovernanceChain),
+		ChainId:               uint32(vaa.ChainIDWormchain),
+		GuardianSetExpiration: 86400,
+	})
+	signer_bz := [20]byte{}
+	signer := sdk.AccAddress(signer_bz[:])
+
+	set := createNewGuardianSet(k, ctx, guardians)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: set.Index})
+
+	context := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	// "Core" right-padded instead of left-padded
+	misalignedCore := [32]byte{}
+	copy(misalignedCore[:], "Core")
+	// "Core" with a non-zero padding byte
+	paddedCore := [32]byte{}
+	copy(paddedCore[:], vaa.CoreModule)
+	paddedCore[0] = 0x01
+	bogusModules := [][32]byte{misalignedCore, paddedCore, vaa.GatewayModule}
+
+	coreActions := []vaa.GovernanceAction{
+		vaa.ActionGuardianSetUpdate,
+		vaa.ActionUpdateGovernanceEmitter,
+	}
+	for _, module := range bogusModules {
+		for _, action := range coreActions {
+			gov_msg := types.NewGovernanceMessage(module, byte(action), uint16(vaa.ChainIDWormchain), []byte{})
+			v := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), gov_msg.MarshalBinary())
+			vBz, _ := v.Marshal()
+			_, err := msgServer.ExecuteGovernanceVAA(context, &types.MsgExecuteGovernanceVAA{
+				Signer: signer.String(),
+				Vaa:    vBz,
+			})
+			assert.ErrorIs(t, err, types.ErrInvalidGovernanceModule)
+		}
+	}
+
+	gatewayActions := []vaa.GovernanceAction{
+		vaa.ActionScheduleUpgrade,
+		vaa.ActionCancelUpgrade,
+		vaa.ActionSetIbcComposabilityMwContract,
+	}
+	coreModule := [32]byte{}
+	copy(coreModule[:], vaa.CoreModule)
+	for _, action := range gatewayActions {
+		gov_msg := types.NewGovernanceMessage(coreModule, byte(action), uint16(vaa.ChainIDWormchain), []byte{})
+		v := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), gov_msg.MarshalBinary())
+		vBz, _ := v.Marshal()
+		_, err := msgServer.ExecuteGatewayGovernanceVaa(context, &types.MsgExecuteGatewayGovernanceVaa{
+			Signer: signer.String(),
+			Vaa:    vBz,
+		})
+		assert.ErrorIs(t, err, types.ErrInvalidGovernanceModule)
+	}
+}
+
+func TestExecuteGovernanceVAAPruneGuardianSets(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	k.SetConfig(ctx, types.Config{
+		GovernanceEmitter:     vaa.GovernanceEmitter[:],
+		GovernanceChain:       uint32(vaa.GovernanceChain),
+		ChainId:               uint32(vaa.ChainIDWormchain),
+		GuardianSetExpiration: 86400,
+	})
+	signer_bz := [20]byte{}
+	signer := sdk.AccAddress(signer_bz[:])
+
+	// set 0 has expired, set 1 is still within its expiration window and set 2 is the consensus set
+	_, err := k.AppendGuardianSet(ctx, types.GuardianSet{Index: 0, ExpirationTime: uint64(ctx.BlockTime().Unix()) - 1})
+	require.NoError(t, err)
+	_, err = k.AppendGuardianSet(ctx, types.GuardianSet{Index: 1, ExpirationTime: uint64(ctx.BlockTime().Unix()) + 3600})
+	require.NoError(t, err)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 10)
+	set := createNewGuardianSet(k, ctx, guardians)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: set.Index})
+
+	context := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	prune := func(keepFromIndex uint32) error {
+		payload, err := vaa.BodyWormchainPruneGuardianSets{KeepFromIndex: keepFromIndex}.Serialize()
+		require.NoError(t, err)
+
+		v := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), payload)
+		vBz, _ := v.Marshal()
+		_, err = msgServer.ExecuteGovernanceVAA(context, &types.MsgExecuteGovernanceVAA{
+			Signer: signer.String(),
+			Vaa:    vBz,
+		})
+		return err
+	}
+
+	// The consensus set can't be pruned
+	err = prune(set.Index + 1)
+	assert.ErrorIs(t, err, types.ErrConsensusGuardianSetNotPrunable)
+
+	// Set 1 hasn't expired yet, so nothing is pruned
+	err = prune(set.Index)
+	assert.ErrorIs(t, err, types.ErrGuardianSetNotExpired)
+	_, found := k.GetGuardianSet(ctx, 0)
+	assert.True(t, found)
+
+	// Pruning only the expired set works
+	err = prune(1)
+	assert.NoError(t, err)
+	_, found = k.GetGuardianSet(ctx, 0)
+	assert.False(t, found)
+	_, found = k.GetGuardianSet(ctx, 1)
+	assert.True(t, found)
+	assert.Equal(t, set.Index, k.GetLatestGuardianSetIndex(ctx))
+}
+
+func createConsensusParamsUpdatePayload(blockMaxBytes, blockMaxGas, evidenceMaxAgeNumBlocks int64, evidenceMaxAgeDuration time.Duration, evidenceMaxBytes int64) []byte {
+	payload, _ := vaa.BodyWormchainConsensusParamsUpdate{
+		BlockMaxBytes:           blockMaxBytes,
+		BlockMaxGas:             blockMaxGas,
+		EvidenceMaxAgeNumBlocks: evidenceMaxAgeNumBlocks,
+		EvidenceMaxAgeDuration:  evidenceMaxAgeDuration,
+		EvidenceMaxBytes:        evidenceMaxBytes,
+	}.Serialize()
+	return payload
+}
+
+func TestExecuteGovernanceVAAConsensusParamsUpdate(t *testing.T) {
+	k, consensusParamsKeeper, ctx := keepertest.WormholeKeeperAndConsensusParams(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 10)
+	k.SetConfig(ctx, types.Config{
+		GovernanceEmitter:     vaa.GovernanceEmitter[:],
+		GovernanceChain:       uint32(vaa.GovernanceChain),
+		ChainId:               uint32(vaa.ChainIDWormchain),
+		GuardianSetExpiration: 86400,
+	})
+	signer_bz := [20]byte{}
+	signer := sdk.AccAddress(signer_bz[:])
+
+	set := createNewGuardianSet(k, ctx, guardians)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: set.Index})
+
+	validatorParams := tmproto.ValidatorParams{PubKeyTypes: []string{"ed25519"}}
+	consensusParamsKeeper.StoreConsensusParams(ctx, &abci.ConsensusParams{
+		Block:     &abci.BlockParams{MaxBytes: 22020096, MaxGas: -1},
+		Evidence:  &tmproto.EvidenceParams{MaxAgeNumBlocks: 100000, MaxAgeDuration: 48 * time.Hour, MaxBytes: 1048576},
+		Validator: &validatorParams,
+	})
+
+	context := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	execute := func(payload []byte) error {
+		v := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), payload)
+		vBz, _ := v.Marshal()
+		_, err := msgServer.ExecuteGovernanceVAA(context, &types.MsgExecuteGovernanceVAA{
+			Signer: signer.String(),
+			Vaa:    vBz,
+		})
+		return err
+	}
+
+	// Invalid length
+	payload := createConsensusParamsUpdatePayload(1000000, 50000000, 1000, time.Hour, 100000)
+	err := execute(payload[:len(payload)-1])
+	assert.ErrorIs(t, err, types.ErrInvalidGovernancePayloadLength)
+
+	// Invalid params
+	err = execute(createConsensusParamsUpdatePayload(0, 50000000, 1000, time.Hour, 100000))
+	assert.ErrorIs(t, err, types.ErrInvalidConsensusParams)
+	err = execute(createConsensusParamsUpdatePayload(1000000, -2, 1000, time.Hour, 100000))
+	assert.ErrorIs(t, err, types.ErrInvalidConsensusParams)
+	err = execute(createConsensusParamsUpdatePayload(1000000, 50000000, 1000, time.Hour, 2000000))
+	assert.ErrorIs(t, err, types.ErrInvalidConsensusParams)
+
+	// Valid update
+	err = execute(createConsensusParamsUpdatePayload(1000000, 50000000, 1000, time.Hour, 100000))
+	assert.NoError(t, err)
+
+	cp := consensusParamsKeeper.GetConsensusParams(ctx)
+	assert.Equal(t, abci.BlockParams{MaxBytes: 1000000, MaxGas: 50000000}, *cp.Block)
+	assert.Equal(t, tmproto.EvidenceParams{MaxAgeNumBlocks: 1000, MaxAgeDuration: time.Hour, MaxBytes: 100000}, *cp.Evidence)
+	assert.Equal(t, validatorParams, *cp.Validator)
+}
+
+func TestExecuteGovernanceVAAScheduledGuardianSetUpdate(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 10)
+	k.SetConfig(ctx, types.Config{
+		GovernanceEmitter:     vaa.GovernanceEmitter[:],
+		GovernanceChain:       uint32(vaa.GovernanceChain),
+		ChainId:               uint32(vaa.ChainIDWormchain),
+		GuardianSetExpiration: 86400,
+	})
+	signer_bz := [20]byte{}
+	signer := sdk.AccAddress(signer_bz[:])
+
+	set := createNewGuardianSet(k, ctx, guardians)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: set.Index})
+
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	createScheduledPayload := func(activationHeight, activationTime uint64) []byte {
+		guardians, _ := createNGuardianValidator(k, ctx, 11)
+		keys := make([]common.Address, len(guardians))
+		for i, guardian := range guardians {
+			keys[i] = common.BytesToAddress(guardian.GuardianKey)
+		}
+		payload, err := vaa.BodyScheduledGuardianSetUpdate{
+			Keys:             keys,
+			NewIndex:         k.GetGuardianSetCount(ctx),
+			ActivationHeight: activationHeight,
+			ActivationTime:   activationTime,
+		}.Serialize()
+		require.NoError(t, err)
+		return payload
+	}
+	execute := func(payload []byte) error {
+		v := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), payload)
+		vBz, _ := v.Marshal()
+		_, err := msgServer.ExecuteGovernanceVAA(sdk.WrapSDKContext(ctx), &types.MsgExecuteGovernanceVAA{
+			Signer: signer.String(),
+			Vaa:    vBz,
+		})
+		return err
+	}
+
+	// An activation without any condition is rejected
+	err := execute(createScheduledPayload(0, 0))
+	assert.ErrorIs(t, err, types.ErrInvalidGuardianSetActivation)
+
+	// Missing activation time
+	payload := createScheduledPayload(20, 2000)
+	err = execute(payload[:len(payload)-8])
+	assert.ErrorIs(t, err, types.ErrInvalidGovernancePayloadLength)
+
+	err = execute(createScheduledPayload(20, 2000))
+	require.NoError(t, err)
+
+	// The new set is registered but the consensus set does not change yet,
+	// even though all new guardians have registered validators
+	assert.Equal(t, set.Index+1, k.GetLatestGuardianSetIndex(ctx))
+	consensusIndex, _ := k.GetConsensusGuardianSetIndex(ctx)
+	assert.Equal(t, set.Index, consensusIndex.Index)
+	activation, found := k.GetGuardianSetActivation(ctx)
+	require.True(t, found)
+	assert.Equal(t, types.GuardianSetActivation{Index: set.Index + 1, ActivationHeight: 20, ActivationTime: 2000}, activation)
+
+	// Registering a guardian does not bypass the schedule
+	require.NoError(t, k.TrySwitchToNewConsensusGuardianSet(ctx))
+	consensusIndex, _ = k.GetConsensusGuardianSetIndex(ctx)
+	assert.Equal(t, set.Index, consensusIndex.Index)
+
+	// Only the height is reached
+	ctx = ctx.WithBlockHeight(20)
+	require.NoError(t, k.ActivateScheduledGuardianSet(ctx))
+	consensusIndex, _ = k.GetConsensusGuardianSetIndex(ctx)
+	assert.Equal(t, set.Index, consensusIndex.Index)
+
+	// Both the height and the time are reached
+	ctx = ctx.WithBlockTime(time.Unix(2000, 0))
+	require.NoError(t, k.ActivateScheduledGuardianSet(ctx))
+	consensusIndex, _ = k.GetConsensusGuardianSetIndex(ctx)
+	assert.Equal(t, set.Index+1, consensusIndex.Index)
+	_, found = k.GetGuardianSetActivation(ctx)
+	assert.False(t, found)
+}
+
+func createFeeParamsUpdatePayload(messageFee, gatewayTransferFee uint64) []byte {
+	payload, _ := vaa.BodyWormchainFeeParamsUpdate{MessageFee: messageFee, GatewayTransferFee: gatewayTransferFee}.Serialize()
+	return payload
+}
+
+func TestExecuteGovernanceVAAFeeParamsUpdate(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 10)
+	k.SetConfig(ctx, types.Config{
+		GovernanceEmitter:     vaa.GovernanceEmitter[:],
+		GovernanceChain:       uint32(vaa.GovernanceChain),
+		ChainId:               uint32(vaa.ChainIDWormchain),
+		GuardianSetExpiration: 86400,
+	})
+	signer_bz := [20]byte{}
+	signer := sdk.AccAddress(signer_bz[:])
+
+	set := createNewGuardianSet(k, ctx, guardians)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: set.Index})
+
+	context := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	execute := func(payload []byte) error {
+		v := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), payload)
+		vBz, _ := v.Marshal()
+		_, err := msgServer.ExecuteGovernanceVAA(context, &types.MsgExecuteGovernanceVAA{
+			Signer: signer.String(),
+			Vaa:    vBz,
+		})
+		return err
+	}
+
+	err := execute(createFeeParamsUpdatePayload(100, 2500))
+	require.NoError(t, err)
+	assert.Equal(t, types.Params{MessageFee: 100, GatewayTransferFee: 2500}, k.GetParams(ctx))
+
+	var event *types.EventFeeParamsUpdate
+	for _, abciEvent := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(abciEvent)
+		if err != nil {
+			continue
+		}
+		if e, ok := msg.(*types.EventFeeParamsUpdate); ok {
+			event = e
+		}
+	}
+	require.NotNil(t, event)
+	assert.Equal(t, types.EventFeeParamsUpdate{NewMessageFee: 100, NewGatewayTransferFee: 2500}, *event)
+
+	// Fees that don't fit in 64 bits are rejected
+	payload := createFeeParamsUpdatePayload(1, 1)
+	payload[35+23] = 1
+	err = execute(payload)
+	assert.ErrorIs(t, err, types.ErrInvalidFeeParams)
+
+	// Invalid length
+	payload = createFeeParamsUpdatePayload(1, 1)
+	err = execute(payload[:len(payload)-1])
+	assert.ErrorIs(t, err, types.ErrInvalidGovernancePayloadLength)
+
+	assert.Equal(t, types.Params{MessageFee: 100, GatewayTransferFee: 2500}, k.GetParams(ctx))
+}
+
+func TestExecuteGovernanceVAASignatureGasUpdate(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 10)
+	k.SetConfig(ctx, types.Config{
+		GovernanceEmitter:     vaa.GovernanceEmitter[:],
+		GovernanceChain:       uint32(vaa.GovernanceChain),
+		ChainId:               uint32(vaa.ChainIDWormchain),
+		GuardianSetExpiration: 86400,
+	})
+	signer_bz := [20]byte{}
+	signer := sdk.AccAddress(signer_bz[:])
+
+	set := createNewGuardianSet(k, ctx, guardians)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: set.Index})
+	k.SetParams(ctx, types.Params{MessageFee: 7})
+
+	context := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	execute := func(gas uint64) error {
+		payload, err := vaa.BodyWormchainSignatureGasUpdate{GasPerSignature: gas}.Serialize()
+		require.NoError(t, err)
+
+		v := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), payload)
+		vBz, _ := v.Marshal()
+		_, err = msgServer.ExecuteGovernanceVAA(context, &types.MsgExecuteGovernanceVAA{
+			Signer: signer.String(),
+			Vaa:    vBz,
+		})
+		return err
+	}
+
+	err := execute(0)
+	assert.ErrorIs(t, err, types.ErrInvalidSignatureVerificationGas)
+
+	err = execute(3000)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3000), k.GetSignatureVerificationGas(ctx))
+	// other params are kept
+	assert.Equal(t, uint64(7), k.GetParams(ctx).MessageFee)
+}
+
+func createRegisterEmitterPayload(chain vaa.ChainID, emitter vaa.Address, emitterModule string) []byte {
+	payload, _ := vaa.BodyWormchainRegisterEmitter{EmitterChain: chain, EmitterAddress: emitter, Module: emitterModule}.Serialize()
+	return payload
+}
+
+func TestExecuteGovernanceVAARegisterEmitter(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 10)
+	k.SetConfig(ctx, types.Config{
+		GovernanceEmitter:     vaa.GovernanceEmitter[:],
+		GovernanceChain:       uint32(vaa.GovernanceChain),
+		ChainId:               uint32(vaa.ChainIDWormchain),
+		GuardianSetExpiration: 86400,
+	})
+	signer_bz := [20]byte{}
+	signer := sdk.AccAddress(signer_bz[:])
+
+	set := createNewGuardianSet(k, ctx, guardians)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: set.Index})
+
+	context := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	execute := func(payload []byte) error {
+		v := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), payload)
+		vBz, _ := v.Marshal()
+		_, err := msgServer.ExecuteGovernanceVAA(context, &types.MsgExecuteGovernanceVAA{
+			Signer: signer.String(),
+			Vaa:    vBz,
+		})
+		return err
+	}
+
+	emitter := vaa.Address{}
+	emitter[31] = 0x01
+	err := execute(createRegisterEmitterPayload(vaa.ChainIDEthereum, emitter, "TokenBridge"))
+	require.NoError(t, err)
+	registered, found := k.GetRegisteredEmitter(ctx, "TokenBridge", uint16(vaa.ChainIDEthereum))
+	require.True(t, found)
+	assert.Equal(t, types.RegisteredEmitter{
+		Module:         "TokenBridge",
+		ChainId:        uint32(vaa.ChainIDEthereum),
+		EmitterAddress: emitter[:],
+	}, registered)
+
+	// Registrations are per module
+	_, found = k.GetRegisteredEmitter(ctx, "NFTBridge", uint16(vaa.ChainIDEthereum))
+	assert.False(t, found)
+
+	// A new registration replaces the previous one
+	emitter[31] = 0x02
+	err = execute(createRegisterEmitterPayload(vaa.ChainIDEthereum, emitter, "TokenBridge"))
+	require.NoError(t, err)
+	registered, _ = k.GetRegisteredEmitter(ctx, "TokenBridge", uint16(vaa.ChainIDEthereum))
+	assert.Equal(t, emitter[:], registered.EmitterAddress)
+	assert.Len(t, k.GetAllRegisteredEmitter(ctx), 1)
+
+	err = execute(createRegisterEmitterPayload(vaa.ChainIDEthereum, vaa.Address{}, "TokenBridge"))
+	assert.ErrorIs(t, err, types.ErrInvalidEmitterRegistration)
+	err = execute(createRegisterEmitterPayload(vaa.ChainIDUnset, emitter, "TokenBridge"))
+	assert.ErrorIs(t, err, types.ErrInvalidEmitterRegistration)
+	err = execute(createRegisterEmitterPayload(vaa.ChainIDEthereum, emitter, ""))
+	assert.ErrorIs(t, err, types.ErrInvalidEmitterRegistration)
+	payload := createRegisterEmitterPayload(vaa.ChainIDEthereum, emitter, "TokenBridge")
+	err = execute(payload[:len(payload)-1])
+	assert.ErrorIs(t, err, types.ErrInvalidGovernancePayloadLength)
+}
+
+func TestExecuteGovernanceVAAQuorumThresholdUpdate(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 10)
+	k.SetConfig(ctx, types.Config{
+		GovernanceEmitter:     vaa.GovernanceEmitter[:],
+		GovernanceChain:       uint32(vaa.GovernanceChain),
+		ChainId:               uint32(vaa.ChainIDWormchain),
+		GuardianSetExpiration: 86400,
+	})
+	signer_bz := [20]byte{}
+	signer := sdk.AccAddress(signer_bz[:])
+
+	set := createNewGuardianSet(k, ctx, guardians)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: set.Index})
+
+	context := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	execute := func(numerator, denominator uint32) error {
+		payload, err := vaa.BodyWormchainQuorumThresholdUpdate{Numerator: numerator, Denominator: denominator}.Serialize()
+		require.NoError(t, err)
+
+		v := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), payload)
+		vBz, _ := v.Marshal()
+		_, err = msgServer.ExecuteGovernanceVAA(context, &types.MsgExecuteGovernanceVAA{
+			Signer: signer.String(),
+			Vaa:    vBz,
+		})
+		return err
+	}
+
+	quorum, _, err := k.CalculateQuorum(ctx, set.Index)
+	require.NoError(t, err)
+	assert.Equal(t, 7, quorum)
+
+	// 1/2 is looser than the default, n/n can never be reached, x/0 is undefined
+	assert.ErrorIs(t, execute(1, 2), types.ErrInvalidQuorumThreshold)
+	assert.ErrorIs(t, execute(4, 4), types.ErrInvalidQuorumThreshold)
+	assert.ErrorIs(t, execute(1, 0), types.ErrInvalidQuorumThreshold)
+
+	require.NoError(t, execute(3, 4))
+	quorum, _, err = k.CalculateQuorum(ctx, set.Index)
+	require.NoError(t, err)
+	assert.Equal(t, 8, quorum)
+
+	// 7 signatures are no longer enough
+	v := generateVaa(set.Index, privateKeys[:7], vaa.ChainID(vaa.GovernanceChain), []byte{1})
+	assert.ErrorIs(t, k.VerifyVAA(ctx, &v), types.ErrNoQuorum)
+	v = generateVaa(set.Index, privateKeys[:8], vaa.ChainID(vaa.GovernanceChain), []byte{1})
+	assert.NoError(t, k.VerifyVAA(ctx, &v))
+
+	// Restore the default
+	require.NoError(t, execute(0, 0))
+	quorum, _, err = k.CalculateQuorum(ctx, set.Index)
+	require.NoError(t, err)
+	assert.Equal(t, 7, quorum)
+
+	// An explicit 2/3 matches the default for every guardian set size
+	k.SetParams(ctx, types.Params{QuorumNumerator: 2, QuorumDenominator: 3})
+	for n := 1; n <= 19; n++ {
+		g, _ := createNGuardianValidator(k, ctx, n)
+		s := createNewGuardianSet(k, ctx, g)
+		quorum, _, err := k.CalculateQuorum(ctx, s.Index)
+		require.NoError(t, err)
+		assert.Equal(t, keeper.CalculateQuorum(n), quorum)
+	}
+}
+
+func TestExecuteGovernanceVAAGovernanceSubmitterUpdate(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 10)
+	k.SetConfig(ctx, types.Config{
+		GovernanceEmitter:     vaa.GovernanceEmitter[:],
+		GovernanceChain:       uint32(vaa.GovernanceChain),
+		ChainId:               uint32(vaa.ChainIDWormchain),
+		GuardianSetExpiration: 86400,
+	})
+	signer_bz := [20]byte{1}
+	signer := sdk.AccAddress(signer_bz[:])
+	other := sdk.AccAddress(bytes.Repeat([]byte{2}, 20))
+
+	set := createNewGuardianSet(k, ctx, guardians)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: set.Index})
+
+	context := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	anteHandler := ante.NewWormholeGovernanceSubmitterDecorator(*k)
+
+	execute := func(payload []byte) error {
+		module := [32]byte{}
+		copy(module[:], vaa.CoreModule)
+		gov_msg := types.NewGovernanceMessage(module, byte(vaa.ActionGovernanceSubmitterUpdate), uint16(vaa.ChainIDWormchain), payload)
+
+		v := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), gov_msg.MarshalBinary())
+		vBz, _ := v.Marshal()
+		_, err := msgServer.ExecuteGovernanceVAA(context, &types.MsgExecuteGovernanceVAA{
+			Signer: signer.String(),
+			Vaa:    vBz,
+		})
+		return err
+	}
+
+	// Anyone can submit governance VAAs while the allowlist is empty
+	_, err := anteHandler.AnteHandle(ctx, getTxWithSigner(other.String()), false, MockNext)
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, execute(append([]byte{1}, signer_bz[:10]...)), types.ErrInvalidGovernancePayloadLength)
+	assert.ErrorIs(t, execute(append([]byte{2}, signer_bz[:]...)), types.ErrInvalidGovernanceSubmitter)
+	assert.ErrorIs(t, execute(append([]byte{0}, signer_bz[:]...)), types.ErrInvalidGovernanceSubmitter)
+
+	require.NoError(t, execute(append([]byte{1}, signer...)))
+	submitter, found := k.GetGovernanceSubmitter(ctx, signer.String())
+	require.True(t, found)
+	assert.Equal(t, signer.String(), submitter.Address)
+
+	// Only allowlisted accounts can submit governance VAAs now
+	_, err = anteHandler.AnteHandle(ctx, getTxWithSigner(signer.String()), false, MockNext)
+	assert.NoError(t, err)
+	_, err = anteHandler.AnteHandle(ctx, getTxWithSigner(other.String()), false, MockNext)
+	assert.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = anteHandler.AnteHandle(ctx, &MockTx{Msgs: []sdk.Msg{&types.MsgStoreCode{Signer: other.String()}}}, false, MockNext)
+	assert.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = anteHandler.AnteHandle(ctx, &MockTx{Msgs: []sdk.Msg{&types.MsgMigrateContract{Signer: other.String()}}}, false, MockNext)
+	assert.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	// Other messages are not restricted
+	_, err = anteHandler.AnteHandle(ctx, &MockTx{Msgs: []sdk.Msg{&types.MsgRegisterAccountAsGuardian{Signer: other.String()}}}, false, MockNext)
+	assert.NoError(t, err)
+
+	require.NoError(t, execute(append([]byte{0}, signer...)))
+	_, found = k.GetGovernanceSubmitter(ctx, signer.String())
+	assert.False(t, found)
+	_, err = anteHandler.AnteHandle(ctx, getTxWithSigner(other.String()), false, MockNext)
+	assert.NoError(t, err)
+}
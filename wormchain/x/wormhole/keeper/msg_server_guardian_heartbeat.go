@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+// GuardianHeartbeat records the status reported by the validator of a
+// guardian in the current or a future guardian set, replacing its previous
+// heartbeat.
+func (k msgServer) GuardianHeartbeat(goCtx context.Context, msg *types.MsgGuardianHeartbeat) (*types.MsgGuardianHeartbeatResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.assertMsgNotShutdown(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if !k.IsAddressValidatorOrFutureValidator(ctx, msg.Signer) {
+		return nil, sdkerrors.Wrap(types.ErrNotGuardianValidator, msg.Signer)
+	}
+	validator, found := k.GetGuardianValidatorByValidatorAddress(ctx, msg.Signer)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrNotGuardianValidator, msg.Signer)
+	}
+
+	k.SetGuardianHeartbeat(ctx, types.GuardianHeartbeat{
+		GuardianKey:      validator.GuardianKey,
+		ValidatorAddress: msg.Signer,
+		NodeVersion:      msg.NodeVersion,
+		Heights:          msg.Heights,
+		Features:         msg.Features,
+		Height:           ctx.BlockHeight(),
+		Timestamp:        ctx.BlockTime().Unix(),
+	})
+
+	return &types.MsgGuardianHeartbeatResponse{}, nil
+}
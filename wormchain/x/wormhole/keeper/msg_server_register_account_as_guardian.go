@@ -8,6 +8,7 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
 	wormholesdk "github.com/wormhole-foundation/wormhole/sdk"
 )
@@ -16,21 +17,25 @@ import (
 // It creates a 1:1 association between a Guardian addresss and a Wormchain validator address.
 // There is also a special case -- when the size of the Guardian set is 1, the Guardian is allowed to "hot-swap" their validator address in the mapping.
 // We include the special case to make it easier to shuffle things in testnets and local devnets.
-// 1. Guardian signs their validator address -- SIGNATURE=$(guardiand admin sign-wormchain-address <wormhole...>)
+// 1. Guardian signs their validator address and the Wormchain chain id, as a proof of possession of the guardian key -- SIGNATURE=$(guardiand admin sign-wormchain-address <signer-uri> <wormhole...> <chain-id>)
 // 2. Guardian submits $SIGNATURE to Wormchain via this handler, using their new validator address as the signer of the Wormchain tx.
 func (k msgServer) RegisterAccountAsGuardian(goCtx context.Context, msg *types.MsgRegisterAccountAsGuardian) (*types.MsgRegisterAccountAsGuardianResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.assertMsgNotShutdown(ctx, msg); err != nil {
+		return nil, err
+	}
 
 	signer, err := sdk.AccAddressFromBech32(msg.Signer)
 	if err != nil {
 		return nil, err
 	}
-	// recover guardian key from signature
-	signerHash := crypto.Keccak256Hash(wormholesdk.SignedWormchainAddressPrefix, signer)
+	// recover guardian key from signature. The signature commits to the
+	// chain id, so a signature for another network recovers a different key.
+	signerHash := wormholesdk.SignedWormchainRegistrationDigest(ctx.ChainID(), signer)
 	guardianKey, err := crypto.Ecrecover(signerHash.Bytes(), msg.Signature)
 
 	if err != nil {
-		return nil, err
+		return nil, sdkerrors.Wrap(types.ErrInvalidProofOfPossession, err.Error())
 	}
 
 	// ecrecover gave us a 65-byte public key, which we first need to
@@ -36,7 +36,7 @@ func TestRegisterAccountAsGuardianHotSwap(t *testing.T) {
 	msgServer := keeper.NewMsgServerImpl(*k)
 
 	// sign the new validator address as the new validator address
-	addrHash := crypto.Keccak256Hash(wormholesdk.SignedWormchainAddressPrefix, newValAddr)
+	addrHash := wormholesdk.SignedWormchainRegistrationDigest(ctx.ChainID(), newValAddr)
 	sig, err := crypto.Sign(addrHash[:], privateKeys[0])
 	require.NoErrorf(t, err, "failed to sign wormchain address: %v", err)
 
@@ -75,7 +75,7 @@ func TestRegisterAccountAsGuardianBlockHotSwap(t *testing.T) {
 	msgServer := keeper.NewMsgServerImpl(*k)
 
 	// sign the new validator address as the new validator address
-	addrHash := crypto.Keccak256Hash(wormholesdk.SignedWormchainAddressPrefix, newValAddr)
+	addrHash := wormholesdk.SignedWormchainRegistrationDigest(ctx.ChainID(), newValAddr)
 	sig, err := crypto.Sign(addrHash[:], privateKeys[0])
 	require.NoErrorf(t, err, "failed to sign wormchain address: %v", err)
 
@@ -86,3 +86,60 @@ func TestRegisterAccountAsGuardianBlockHotSwap(t *testing.T) {
 	})
 	assert.Error(t, types.ErrConsensusSetNotUpdatable, err)
 }
+
+// the signature must be a proof of possession of the guardian key for the
+// validator address on this chain
+func TestRegisterAccountAsGuardianProofOfPossession(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	ctx = ctx.WithChainID("wormchain")
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 1)
+	k.SetConfig(ctx, types.Config{
+		GovernanceEmitter:     vaa.GovernanceEmitter[:],
+		GovernanceChain:       uint32(vaa.GovernanceChain),
+		ChainId:               uint32(vaa.ChainIDWormchain),
+		GuardianSetExpiration: 86400,
+	})
+	newValAddr_bz := [20]byte{1}
+	newValAddr := sdk.AccAddress(newValAddr_bz[:])
+
+	set := createNewGuardianSet(k, ctx, guardians)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: set.Index})
+
+	context := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	register := func(digest []byte) error {
+		sig, err := crypto.Sign(digest, privateKeys[0])
+		require.NoError(t, err)
+		_, err = msgServer.RegisterAccountAsGuardian(context, &types.MsgRegisterAccountAsGuardian{
+			Signer:    newValAddr.String(),
+			Signature: sig,
+		})
+		return err
+	}
+
+	// A signature for another network recovers another key
+	err := register(wormholesdk.SignedWormchainRegistrationDigest("wormchain-testnet", newValAddr).Bytes())
+	assert.ErrorIs(t, err, types.ErrGuardianNotFound)
+
+	// Signatures without the chain id are not accepted
+	err = register(crypto.Keccak256Hash(wormholesdk.SignedWormchainAddressPrefix, newValAddr).Bytes())
+	assert.ErrorIs(t, err, types.ErrGuardianNotFound)
+
+	// A signature of another address recovers another key
+	otherAddr := sdk.AccAddress(make([]byte, 20))
+	err = register(wormholesdk.SignedWormchainRegistrationDigest(ctx.ChainID(), otherAddr).Bytes())
+	assert.ErrorIs(t, err, types.ErrGuardianNotFound)
+
+	_, err = msgServer.RegisterAccountAsGuardian(context, &types.MsgRegisterAccountAsGuardian{
+		Signer:    newValAddr.String(),
+		Signature: make([]byte, 65),
+	})
+	assert.ErrorIs(t, err, types.ErrInvalidProofOfPossession)
+
+	err = register(wormholesdk.SignedWormchainRegistrationDigest(ctx.ChainID(), newValAddr).Bytes())
+	require.NoError(t, err)
+	guardian, found := k.GetGuardianValidator(ctx, guardians[0].GuardianKey)
+	require.True(t, found)
+	assert.Equal(t, newValAddr.Bytes(), guardian.ValidatorAddr)
+}
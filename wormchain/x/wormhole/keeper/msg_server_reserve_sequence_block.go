@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+// ReserveSequenceBlock pre-allocates a contiguous range of sequences for the
+// emitter of the signer, which is the signer padded to 32 bytes.
+func (k msgServer) ReserveSequenceBlock(goCtx context.Context, msg *types.MsgReserveSequenceBlock) (*types.MsgReserveSequenceBlockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.assertMsgNotShutdown(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	start, err := k.Keeper.ReserveSequenceBlock(ctx, types.EmitterAddressFromAccAddress(signer), msg.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReserveSequenceBlockResponse{Start: start, Count: msg.Count}, nil
+}
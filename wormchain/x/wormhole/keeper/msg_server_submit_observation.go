@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+// SubmitObservation tallies the guardian signatures on an observed VAA. Anyone
+// can relay observations, since the tally only counts guardian signatures.
+func (k msgServer) SubmitObservation(goCtx context.Context, msg *types.MsgSubmitObservation) (*types.MsgSubmitObservationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.assertMsgNotShutdown(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	v, err := ParseVAA(msg.Vaa)
+	if err != nil {
+		return nil, err
+	}
+
+	tally, err := k.TallyObservation(ctx, v)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitObservationResponse{Finalized: tally.Finalized, Queued: tally.Queued}, nil
+}
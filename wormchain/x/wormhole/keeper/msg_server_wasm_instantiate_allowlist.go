@@ -11,10 +11,16 @@ import (
 )
 
 func (k msgServer) AddWasmInstantiateAllowlist(goCtx context.Context, msg *types.MsgAddWasmInstantiateAllowlist) (*types.MsgWasmInstantiateAllowlistResponse, error) {
+	if err := k.assertMsgNotShutdown(sdk.UnwrapSDKContext(goCtx), msg); err != nil {
+		return nil, err
+	}
 	return k.ExecuteWasmInstantiateAllowlistAction(goCtx, msg.Vaa, msg.Signer, msg.CodeId, msg.Address, vaa.ActionAddWasmInstantiateAllowlist)
 }
 
 func (k msgServer) DeleteWasmInstantiateAllowlist(goCtx context.Context, msg *types.MsgDeleteWasmInstantiateAllowlist) (*types.MsgWasmInstantiateAllowlistResponse, error) {
+	if err := k.assertMsgNotShutdown(sdk.UnwrapSDKContext(goCtx), msg); err != nil {
+		return nil, err
+	}
 	return k.ExecuteWasmInstantiateAllowlistAction(goCtx, msg.Vaa, msg.Signer, msg.CodeId, msg.Address, vaa.ActionDeleteWasmInstantiateAllowlist)
 }
 
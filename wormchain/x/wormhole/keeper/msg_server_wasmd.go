@@ -20,6 +20,9 @@ func (k msgServer) StoreCode(goCtx context.Context, msg *types.MsgStoreCode) (*t
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "x/wasmd not set")
 	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.assertMsgNotShutdown(ctx, msg); err != nil {
+		return nil, err
+	}
 
 	// Parse VAA
 	v, err := ParseVAA(msg.Vaa)
@@ -58,6 +61,10 @@ func (k msgServer) StoreCode(goCtx context.Context, msg *types.MsgStoreCode) (*t
 	if err != nil {
 		return nil, err
 	}
+	// The VAA approved this code, so pin its hash to the new code id
+	if err := k.approveCodeHash(ctx, codeID, chksum); err != nil {
+		return nil, err
+	}
 	return &types.MsgStoreCodeResponse{
 		CodeID:   codeID,
 		Checksum: chksum,
@@ -70,6 +77,9 @@ func (k msgServer) InstantiateContract(goCtx context.Context, msg *types.MsgInst
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "x/wasmd not set")
 	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.assertMsgNotShutdown(ctx, msg); err != nil {
+		return nil, err
+	}
 
 	// Parse VAA
 	v, err := ParseVAA(msg.Vaa)
@@ -94,6 +104,11 @@ func (k msgServer) InstantiateContract(goCtx context.Context, msg *types.MsgInst
 		return nil, types.ErrInvalidHash
 	}
 
+	// The code id must still hold the code governance approved
+	if err := k.VerifyApprovedCode(ctx, msg.CodeID); err != nil {
+		return nil, err
+	}
+
 	// Execute Instantiate normally
 	senderAddr, err := sdk.AccAddressFromBech32(msg.Signer)
 	if err != nil {
@@ -114,8 +129,15 @@ func (k msgServer) InstantiateContract(goCtx context.Context, msg *types.MsgInst
 	}, nil
 }
 
+// Simple wrapper of x/wasmd MigrateContract that requires a VAA
 func (k msgServer) MigrateContract(goCtx context.Context, msg *types.MsgMigrateContract) (*types.MsgMigrateContractResponse, error) {
+	if !k.setWasmd {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "x/wasmd not set")
+	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.assertMsgNotShutdown(ctx, msg); err != nil {
+		return nil, err
+	}
 
 	// Parse VAA
 	v, err := ParseVAA(msg.Vaa)
@@ -149,6 +171,11 @@ func (k msgServer) MigrateContract(goCtx context.Context, msg *types.MsgMigrateC
 		return nil, sdkerrors.Wrap(err, "contract")
 	}
 
+	// The code id must still hold the code governance approved
+	if err := k.VerifyApprovedCode(ctx, msg.CodeID); err != nil {
+		return nil, err
+	}
+
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		sdk.EventTypeMessage,
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
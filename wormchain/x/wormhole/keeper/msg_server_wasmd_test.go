@@ -13,6 +13,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/assert"
@@ -132,7 +133,7 @@ func setupAccountantAndGuardianSet(t *testing.T, ctx sdk.Context, k *keeper.Keep
 }
 
 func TestWasmdStoreCode(t *testing.T) {
-	k, ctx := keepertest.WormholeKeeper(t)
+	k, _, _, ctx := keepertest.WormholeKeeperAndWasmd(t)
 	guardians, privateKeys := createNGuardianValidator(k, ctx, 10)
 	_ = privateKeys
 	k.SetConfig(ctx, types.Config{
@@ -170,7 +171,7 @@ func TestWasmdStoreCode(t *testing.T) {
 		WASMByteCode: keepertest.ACCOUNTANT_WASM_B64_GZIP,
 		Vaa:          vBz,
 	})
-	assert.ErrorIs(t, err, types.ErrVAAAlreadyExecuted)
+	assert.ErrorIs(t, err, types.ErrGovernanceVaaAlreadyExecuted)
 
 	// modified wasm byte code does not verify
 	bad_wasm := make([]byte, len(keepertest.ACCOUNTANT_WASM_B64_GZIP))
@@ -197,11 +198,11 @@ func TestWasmdStoreCode(t *testing.T) {
 		WASMByteCode: bad_wasm,
 		Vaa:          vBz,
 	})
-	assert.ErrorIs(t, err, types.ErrUnknownGovernanceModule)
+	assert.ErrorIs(t, err, types.ErrInvalidGovernanceModule)
 }
 
 func TestWasmdInstantiateContract(t *testing.T) {
-	k, ctx := keepertest.WormholeKeeper(t)
+	k, _, _, ctx := keepertest.WormholeKeeperAndWasmd(t)
 	guardians, privateKeys := createNGuardianValidator(k, ctx, 10)
 	_ = privateKeys
 	k.SetConfig(ctx, types.Config{
@@ -309,7 +310,7 @@ func TestWasmdInstantiateContract(t *testing.T) {
 		Msg:    []byte("{\"arg\":\"bad\"}"),
 		Vaa:    vBz,
 	})
-	assert.ErrorIs(t, err, types.ErrUnknownGovernanceModule)
+	assert.ErrorIs(t, err, types.ErrInvalidGovernanceModule)
 
 	// test action byte is checked by sending a valid migrate vaa
 	payload = createWasmMigratePayload(code_id, "btc", "{}")
@@ -326,7 +327,7 @@ func TestWasmdInstantiateContract(t *testing.T) {
 }
 
 func TestWasmdMigrateContract(t *testing.T) {
-	k, ctx := keepertest.WormholeKeeper(t)
+	k, _, _, ctx := keepertest.WormholeKeeperAndWasmd(t)
 	tb := setupAccountantAndGuardianSet(t, ctx, k)
 
 	// First we need to (1) upload some codes and (2) instantiate.
@@ -376,7 +377,7 @@ func TestWasmdMigrateContract(t *testing.T) {
 		})
 		require.NoError(t, err)
 
-		// replaying the same message should return ErrVAAAlreadyExecuted
+		// replaying the same message should return ErrGovernanceVaaAlreadyExecuted
 		_, err = tb.msgServer.MigrateContract(tb.context, &types.MsgMigrateContract{
 			Signer:   tb.signer.String(),
 			CodeID:   code_id,
@@ -384,7 +385,7 @@ func TestWasmdMigrateContract(t *testing.T) {
 			Msg:      []byte("{}"),
 			Vaa:      vBz,
 		})
-		require.ErrorIs(t, err, types.ErrVAAAlreadyExecuted)
+		require.ErrorIs(t, err, types.ErrGovernanceVaaAlreadyExecuted)
 	}
 
 	// Test failure using the wrong codeid
@@ -456,7 +457,7 @@ func TestWasmdMigrateContract(t *testing.T) {
 		Msg:      []byte(`{}`),
 		Vaa:      vBz,
 	})
-	assert.ErrorIs(t, err, types.ErrUnknownGovernanceModule)
+	assert.ErrorIs(t, err, types.ErrInvalidGovernanceModule)
 
 	// test action byte is checked by sending a valid instantiate vaa
 	payload = createWasmInstantiatePayload(code_ids[0], "btc", "{}")
@@ -472,6 +473,21 @@ func TestWasmdMigrateContract(t *testing.T) {
 	assert.ErrorIs(t, err, types.ErrUnknownGovernanceAction)
 }
 
+func TestWasmdMigrateContractWithoutWasmd(t *testing.T) {
+	_, ctx := keepertest.WormholeKeeper(t)
+
+	// The wasmd keeper is set late during app init, until then migrations are
+	// not supported
+	msgServer := keeper.NewMsgServerImpl(*keeper.NewKeeper(nil, nil, nil, nil, nil))
+	_, err := msgServer.MigrateContract(sdk.WrapSDKContext(ctx), &types.MsgMigrateContract{
+		Signer:   sdk.AccAddress(make([]byte, 20)).String(),
+		CodeID:   1,
+		Contract: sdk.AccAddress(make([]byte, 32)).String(),
+		Msg:      []byte("{}"),
+	})
+	assert.ErrorIs(t, err, sdkerrors.ErrNotSupported)
+}
+
 // This specifically tests the modify vaa in accountant
 // This also tests that the path to verify VAAs through the accountant contract to the wormhole querier interface is working.
 func TestWasmdAccountantContractModify(t *testing.T) {
@@ -492,7 +508,7 @@ func TestWasmdAccountantContractModify(t *testing.T) {
 	modify_msg := vaa.BodyAccountantModifyBalance{
 		Module:        "GlobalAccountant",
 		TargetChainID: vaa.ChainIDWormchain,
-		Sequence:      uint64(lastestSequence),
+		Sequence:      governanceEmitter.Sequence,
 		ChainId:       vaa.ChainIDSolana,
 		TokenChain:    vaa.ChainIDSolana,
 		TokenAddress:  token_address,
@@ -609,3 +625,84 @@ func TestWasmdAccountantContractSubmitObservation(t *testing.T) {
 	_, err = permissionedWasmd.Execute(ctx, contract_addr, tb.signer, []byte(execute_msg), []sdk.Coin{})
 	require.Error(t, err)
 }
+
+func TestWasmdApprovedCodeHash(t *testing.T) {
+	k, _, permissionedWasmd, ctx := keepertest.WormholeKeeperAndWasmd(t)
+	tb := setupAccountantAndGuardianSet(t, ctx, k)
+
+	// Code stored through governance is approved with its checksum
+	approved, found := k.GetApprovedCodeHash(ctx, tb.codeId)
+	require.True(t, found)
+	require.Len(t, approved.CodeHash, 32)
+	res, err := k.ApprovedCodeHash(tb.context, &types.QueryGetApprovedCodeHashRequest{CodeId: tb.codeId})
+	require.NoError(t, err)
+	assert.Equal(t, approved, res.ApprovedCodeHash)
+
+	executeGateway := func(body vaa.BodyGatewayApprovedCodeHashUpdate) error {
+		payload, err := body.Serialize()
+		require.NoError(t, err)
+		v := generateVaa(tb.set.Index, tb.privateKeys, vaa.ChainID(vaa.GovernanceChain), payload)
+		vBz, _ := v.Marshal()
+		_, err = tb.msgServer.ExecuteGatewayGovernanceVaa(tb.context, &types.MsgExecuteGatewayGovernanceVaa{
+			Signer: tb.signer.String(),
+			Vaa:    vBz,
+		})
+		return err
+	}
+	instantiate := func(codeID uint64) error {
+		payload := createWasmInstantiatePayload(codeID, "btc", "{}")
+		v := generateVaa(tb.set.Index, tb.privateKeys, vaa.ChainID(vaa.GovernanceChain), payload)
+		vBz, _ := v.Marshal()
+		_, err := tb.msgServer.InstantiateContract(tb.context, &types.MsgInstantiateContract{
+			Signer: tb.signer.String(),
+			CodeID: codeID,
+			Label:  "btc",
+			Msg:    []byte("{}"),
+			Vaa:    vBz,
+		})
+		return err
+	}
+	migrate := func(codeID uint64) error {
+		payload := createWasmMigratePayload(codeID, tb.contractAddress, "{}")
+		v := generateVaa(tb.set.Index, tb.privateKeys, vaa.ChainID(vaa.GovernanceChain), payload)
+		vBz, _ := v.Marshal()
+		_, err := tb.msgServer.MigrateContract(tb.context, &types.MsgMigrateContract{
+			Signer:   tb.signer.String(),
+			CodeID:   codeID,
+			Contract: tb.contractAddress,
+			Msg:      []byte("{}"),
+			Vaa:      vBz,
+		})
+		return err
+	}
+
+	// Code uploaded outside of governance can't be instantiated or migrated to
+	codeID, checksum, err := permissionedWasmd.Create(ctx, tb.signer, keepertest.ACCOUNTANT_WASM_B64_GZIP, nil)
+	require.NoError(t, err)
+	assert.ErrorIs(t, instantiate(codeID), types.ErrCodeNotApproved)
+	assert.ErrorIs(t, migrate(codeID), types.ErrCodeNotApproved)
+
+	// Governance can only approve the hash of the stored code
+	var codeHash [32]byte
+	copy(codeHash[:], checksum)
+	wrongHash := codeHash
+	wrongHash[0] ^= 1
+	assert.ErrorIs(t, executeGateway(vaa.BodyGatewayApprovedCodeHashUpdate{CodeID: codeID, CodeHash: wrongHash}), types.ErrCodeHashMismatch)
+	assert.Error(t, executeGateway(vaa.BodyGatewayApprovedCodeHashUpdate{CodeID: codeID + 1, CodeHash: codeHash}))
+
+	require.NoError(t, executeGateway(vaa.BodyGatewayApprovedCodeHashUpdate{CodeID: codeID, CodeHash: codeHash}))
+	require.NoError(t, instantiate(codeID))
+	require.NoError(t, migrate(codeID))
+
+	// A substituted code hash is rejected
+	k.SetApprovedCodeHash(ctx, types.ApprovedCodeHash{CodeId: codeID, CodeHash: wrongHash[:]})
+	assert.ErrorIs(t, instantiate(codeID), types.ErrCodeHashMismatch)
+	assert.ErrorIs(t, migrate(codeID), types.ErrCodeHashMismatch)
+
+	// A zero code hash revokes the approval
+	require.NoError(t, executeGateway(vaa.BodyGatewayApprovedCodeHashUpdate{CodeID: tb.codeId}))
+	_, found = k.GetApprovedCodeHash(ctx, tb.codeId)
+	assert.False(t, found)
+	assert.ErrorIs(t, instantiate(tb.codeId), types.ErrCodeNotApproved)
+	assert.ErrorIs(t, migrate(tb.codeId), types.ErrCodeNotApproved)
+}
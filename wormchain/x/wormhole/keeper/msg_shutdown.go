@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+// SetMsgShutdown shuts down or recovers the handler of a message type
+func (k Keeper) SetMsgShutdown(ctx sdk.Context, msgTypeURL string, shutdown bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MsgShutdownKey))
+	if shutdown {
+		store.Set([]byte(msgTypeURL), []byte{})
+	} else {
+		store.Delete([]byte(msgTypeURL))
+	}
+}
+
+// IsMsgShutdown returns whether the handler of a message type is shut down
+func (k Keeper) IsMsgShutdown(ctx sdk.Context, msgTypeURL string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MsgShutdownKey))
+	return store.Has([]byte(msgTypeURL))
+}
+
+// GetAllMsgShutdown returns the type URLs of all shut down messages
+func (k Keeper) GetAllMsgShutdown(ctx sdk.Context) (list []string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MsgShutdownKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, string(iterator.Key()))
+	}
+
+	return
+}
+
+// assertMsgNotShutdown rejects messages whose handler was shut down by
+// governance.
+func (k Keeper) assertMsgNotShutdown(ctx sdk.Context, msg sdk.Msg) error {
+	if msgTypeURL := sdk.MsgTypeURL(msg); k.IsMsgShutdown(ctx, msgTypeURL) {
+		return sdkerrors.Wrap(types.ErrMsgShutdown, msgTypeURL)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/wormhole-foundation/wormchain/testutil/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestExecuteGovernanceVAAMsgShutdown(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 10)
+	k.SetConfig(ctx, types.Config{
+		GovernanceEmitter:     vaa.GovernanceEmitter[:],
+		GovernanceChain:       uint32(vaa.GovernanceChain),
+		ChainId:               uint32(vaa.ChainIDWormchain),
+		GuardianSetExpiration: 86400,
+	})
+	signer := sdk.AccAddress(make([]byte, 20))
+
+	set := createNewGuardianSet(k, ctx, guardians)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: set.Index})
+
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	execute := func(payload []byte) error {
+		module := [32]byte{}
+		copy(module[:], vaa.CoreModule)
+		gov_msg := types.NewGovernanceMessage(module, byte(vaa.ActionMsgShutdownUpdate), uint16(vaa.ChainIDWormchain), payload)
+
+		v := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), gov_msg.MarshalBinary())
+		vBz, _ := v.Marshal()
+		_, err := msgServer.ExecuteGovernanceVAA(sdk.WrapSDKContext(ctx), &types.MsgExecuteGovernanceVAA{
+			Signer: signer.String(),
+			Vaa:    vBz,
+		})
+		return err
+	}
+	shutdownPayload := func(shutdown byte, msgTypeURL string) []byte {
+		return append([]byte{1, shutdown}, msgTypeURL...)
+	}
+	createAllowlistEntry := func() error {
+		_, err := msgServer.CreateAllowlistEntry(sdk.WrapSDKContext(ctx), &types.MsgCreateAllowlistEntryRequest{
+			Signer: signer.String(),
+		})
+		return err
+	}
+
+	msgTypeURL := sdk.MsgTypeURL(&types.MsgCreateAllowlistEntryRequest{})
+	err := createAllowlistEntry()
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, types.ErrMsgShutdown)
+
+	// Payloads without a version or with an unknown version are rejected
+	assert.ErrorIs(t, execute(nil), types.ErrInvalidGovernancePayloadLength)
+	assert.ErrorIs(t, execute(append([]byte{2, 1}, msgTypeURL...)), types.ErrUnknownGovernancePayloadVersion)
+	assert.ErrorIs(t, execute([]byte{1, 1}), types.ErrInvalidGovernancePayloadLength)
+	assert.ErrorIs(t, execute(shutdownPayload(2, msgTypeURL)), types.ErrInvalidMsgShutdown)
+
+	// Only messages of the module can be shut down, except for governance VAAs
+	assert.ErrorIs(t, execute(shutdownPayload(1, "/cosmos.bank.v1beta1.MsgSend")), types.ErrInvalidMsgShutdown)
+	assert.ErrorIs(t, execute(shutdownPayload(1, sdk.MsgTypeURL(&types.MsgExecuteGovernanceVAA{}))), types.ErrInvalidMsgShutdown)
+	assert.Empty(t, k.GetAllMsgShutdown(ctx))
+
+	require.NoError(t, execute(shutdownPayload(1, msgTypeURL)))
+	assert.True(t, k.IsMsgShutdown(ctx, msgTypeURL))
+	assert.ErrorIs(t, createAllowlistEntry(), types.ErrMsgShutdown)
+
+	res, err := k.MsgShutdownAll(sdk.WrapSDKContext(ctx), &types.QueryAllMsgShutdownRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{msgTypeURL}, res.MsgTypeUrls)
+
+	require.NoError(t, execute(shutdownPayload(0, msgTypeURL)))
+	assert.False(t, k.IsMsgShutdown(ctx, msgTypeURL))
+	err = createAllowlistEntry()
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, types.ErrMsgShutdown)
+
+	var updates []*types.EventMsgShutdownUpdate
+	for _, abciEvent := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(abciEvent)
+		if err != nil {
+			continue
+		}
+		if update, ok := msg.(*types.EventMsgShutdownUpdate); ok {
+			updates = append(updates, update)
+		}
+	}
+	require.Len(t, updates, 2)
+	assert.True(t, updates[0].Shutdown)
+	assert.False(t, updates[1].Shutdown)
+	assert.Equal(t, msgTypeURL, updates[1].MsgTypeUrl)
+}
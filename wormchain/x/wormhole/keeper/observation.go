@@ -0,0 +1,334 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// ObservationTallyRetentionBlocks is the number of blocks after which an
+// observation tally that did not reach quorum is pruned, which is about a day.
+// The records of finalized observations are never pruned.
+const ObservationTallyRetentionBlocks = 14400
+
+// MaxObservationTallyPrunePerBlock bounds the number of observation tallies
+// removed in a single EndBlock. Anything left over is pruned in the following
+// blocks.
+const MaxObservationTallyPrunePerBlock = 1000
+
+// SetGuardianSetWeights sets the guardian weights of a guardian set
+func (k Keeper) SetGuardianSetWeights(ctx sdk.Context, guardianSetWeights types.GuardianSetWeights) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GuardianSetWeightsKey))
+	b := k.cdc.MustMarshal(&guardianSetWeights)
+	store.Set(GetGuardianSetIDBytes(guardianSetWeights.GuardianSetIndex), b)
+}
+
+// GetGuardianSetWeights returns the guardian weights that were set for a guardian set
+func (k Keeper) GetGuardianSetWeights(ctx sdk.Context, guardianSetIndex uint32) (val types.GuardianSetWeights, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GuardianSetWeightsKey))
+
+	b := store.Get(GetGuardianSetIDBytes(guardianSetIndex))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveGuardianSetWeights removes the guardian weights of a guardian set
+func (k Keeper) RemoveGuardianSetWeights(ctx sdk.Context, guardianSetIndex uint32) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GuardianSetWeightsKey))
+	store.Delete(GetGuardianSetIDBytes(guardianSetIndex))
+}
+
+// GetAllGuardianSetWeights returns the guardian weights of all guardian sets that have them
+func (k Keeper) GetAllGuardianSetWeights(ctx sdk.Context) (list []types.GuardianSetWeights) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GuardianSetWeightsKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.GuardianSetWeights
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetGuardianWeights returns the weight of each guardian of the guardian set.
+// Every guardian has a weight of 1 unless governance set weights for the set.
+func (k Keeper) GetGuardianWeights(ctx sdk.Context, guardianSet types.GuardianSet) []uint64 {
+	if weights, found := k.GetGuardianSetWeights(ctx, guardianSet.Index); found {
+		return weights.Weights
+	}
+
+	weights := make([]uint64, len(guardianSet.Keys))
+	for i := range weights {
+		weights[i] = 1
+	}
+	return weights
+}
+
+// QuorumConfig returns the quorum config of the guardian sets from the quorum
+// threshold params. It carries no guardian weights.
+func (k Keeper) QuorumConfig(ctx sdk.Context) vaa.QuorumConfig {
+	params := k.GetParams(ctx)
+	return vaa.QuorumConfig{
+		Numerator:   uint64(params.QuorumNumerator),
+		Denominator: uint64(params.QuorumDenominator),
+	}
+}
+
+// ObservationQuorumScheme returns the quorum scheme that observations signed
+// by the guardian set are tallied with, which applies the guardian weights.
+func (k Keeper) ObservationQuorumScheme(ctx sdk.Context, guardianSet types.GuardianSet) vaa.QuorumScheme {
+	config := k.QuorumConfig(ctx)
+	config.Weights = k.GetGuardianWeights(ctx, guardianSet)
+	return config.Scheme()
+}
+
+// QuorumWeight returns the minimum weight of guardians that need to sign an
+// observation out of totalWeight. With all weights at 1 this is the same as
+// the number of signatures a VAA needs.
+func (k Keeper) QuorumWeight(ctx sdk.Context, totalWeight uint64) uint64 {
+	config := k.QuorumConfig(ctx)
+	return vaa.QuorumWeight(totalWeight, config.Numerator, config.Denominator)
+}
+
+func totalGuardianWeight(weights []uint64) (total uint64) {
+	for _, weight := range weights {
+		total += weight
+	}
+	return
+}
+
+// SetObservationTally sets an observation tally in the store. Tallies are
+// indexed by their height to be pruned, except for queued tallies which stay
+// until they are released.
+func (k Keeper) SetObservationTally(ctx sdk.Context, observationTally types.ObservationTally) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObservationTallyKey))
+	heightStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObservationTallyHeightKeyPrefix))
+	b := k.cdc.MustMarshal(&observationTally)
+	store.Set(observationTally.Digest, b)
+
+	heightKey := types.ObservationTallyHeightKey(observationTally.Height, observationTally.Digest)
+	if observationTally.Queued {
+		heightStore.Delete(heightKey)
+	} else {
+		heightStore.Set(heightKey, []byte{})
+	}
+}
+
+// RemoveObservationTally removes the tally of the observation with the given
+// digest
+func (k Keeper) RemoveObservationTally(ctx sdk.Context, digest []byte) {
+	tally, found := k.GetObservationTally(ctx, digest)
+	if !found {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObservationTallyKey))
+	heightStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObservationTallyHeightKeyPrefix))
+	store.Delete(digest)
+	heightStore.Delete(types.ObservationTallyHeightKey(tally.Height, digest))
+}
+
+// GetObservationTally returns the tally of the observation with the given digest
+func (k Keeper) GetObservationTally(ctx sdk.Context, digest []byte) (val types.ObservationTally, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObservationTallyKey))
+
+	b := store.Get(digest)
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllObservationTally returns all observation tallies
+func (k Keeper) GetAllObservationTally(ctx sdk.Context) (list []types.ObservationTally) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObservationTallyKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ObservationTally
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetFinalizedObservation records that an observation was finalized. Like the
+// replay protection of governance VAAs, the record is kept forever.
+func (k Keeper) SetFinalizedObservation(ctx sdk.Context, finalizedObservation types.FinalizedObservation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FinalizedObservationKeyPrefix))
+	b := k.cdc.MustMarshal(&finalizedObservation)
+	store.Set(finalizedObservation.Digest, b)
+}
+
+// IsObservationFinalized returns whether the observation with the given digest
+// was finalized
+func (k Keeper) IsObservationFinalized(ctx sdk.Context, digest []byte) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FinalizedObservationKeyPrefix))
+	return store.Has(digest)
+}
+
+// GetAllFinalizedObservation returns all finalized observations
+func (k Keeper) GetAllFinalizedObservation(ctx sdk.Context) (list []types.FinalizedObservation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FinalizedObservationKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FinalizedObservation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// PruneObservationTallies removes the observation tallies that were started
+// ObservationTallyRetentionBlocks or more blocks ago without being finalized.
+// Queued tallies are kept until they are released. At most
+// MaxObservationTallyPrunePerBlock tallies are removed per call.
+func (k Keeper) PruneObservationTallies(ctx sdk.Context) {
+	cutoff := ctx.BlockHeight() - ObservationTallyRetentionBlocks
+	if cutoff < 0 {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObservationTallyKey))
+	heightStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObservationTallyHeightKeyPrefix))
+	end := binary.BigEndian.AppendUint64(nil, uint64(cutoff)+1)
+
+	iterator := heightStore.Iterator(nil, end)
+	var pruned [][]byte
+	for ; iterator.Valid() && len(pruned) < MaxObservationTallyPrunePerBlock; iterator.Next() {
+		pruned = append(pruned, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, heightKey := range pruned {
+		heightStore.Delete(heightKey)
+		store.Delete(heightKey[8:])
+	}
+}
+
+// TallyObservation verifies the guardian signatures on an observed VAA and
+// adds the weight of every guardian that had not signed the observation yet.
+// The observation is finalized once the tallied weight reaches the quorum
+// weight of its guardian set, unless its emitter chain is at its rate limit in
+// which case it is queued until ReleaseQueuedObservations finalizes it.
+// Finalized tallies are replaced by a FinalizedObservation, and signatures on
+// an observation that was finalized are rejected, so that an observation is
+// only finalized once.
+func (k Keeper) TallyObservation(ctx sdk.Context, v *vaa.VAA) (types.ObservationTally, error) {
+	// Retrieve the guardian set, this also checks that it has not expired
+	_, guardianSet, err := k.CalculateQuorum(ctx, v.GuardianSetIndex)
+	if err != nil {
+		return types.ObservationTally{}, err
+	}
+
+	signingDigest := v.SigningDigest()
+	digest := signingDigest.Bytes()
+	if k.IsObservationFinalized(ctx, digest) {
+		return types.ObservationTally{}, sdkerrors.Wrapf(types.ErrObservationFinalized, "observation %s", v.HexDigest())
+	}
+	tally, found := k.GetObservationTally(ctx, digest)
+	if !found {
+		tally = types.ObservationTally{
+			Digest:           digest,
+			GuardianSetIndex: v.GuardianSetIndex,
+			Height:           ctx.BlockHeight(),
+		}
+	} else if tally.GuardianSetIndex != v.GuardianSetIndex {
+		// the guardian indices in the tally are only meaningful for one set
+		return types.ObservationTally{}, sdkerrors.Wrapf(types.ErrSignaturesInvalid, "observation %s is tallied for guardian set %d, got guardian set %d", v.HexDigest(), tally.GuardianSetIndex, v.GuardianSetIndex)
+	}
+
+	k.consumeSignatureVerificationGas(ctx, len(v.Signatures))
+	addresses := guardianSet.KeysAsAddresses()
+	if err := checkSignerIndexes(v.Signatures, len(addresses)); err != nil {
+		return types.ObservationTally{}, err
+	}
+	scheme := k.ObservationQuorumScheme(ctx, *guardianSet)
+
+	tallied := false
+	for _, signature := range v.Signatures {
+		if !vaa.VerifyDigestSignature(signingDigest, signature, addresses[signature.Index]) {
+			return types.ObservationTally{}, sdkerrors.Wrapf(types.ErrSignaturesInvalid, "guardian %d", signature.Index)
+		}
+		if tally.HasSigned(signature.Index) {
+			continue
+		}
+		tally.SetSigned(signature.Index)
+		tally.Weight += scheme.Weight(signature.Index)
+		tallied = true
+	}
+	if !tallied {
+		return types.ObservationTally{}, sdkerrors.Wrapf(types.ErrObservationAlreadySigned, "observation %s", v.HexDigest())
+	}
+
+	if !tally.Finalized && !tally.Queued && tally.Weight >= scheme.Quorum(len(addresses)) {
+		// the observation is a verified VAA once it reaches quorum, even if
+		// its release is held back by the rate limit
+		k.ArchiveVAA(ctx, v)
+		if k.admitObservation(ctx, uint16(v.EmitterChain)) {
+			err = k.finalizeObservation(ctx, &tally)
+		} else {
+			err = k.queueObservation(ctx, &tally, uint16(v.EmitterChain))
+		}
+		if err != nil {
+			return types.ObservationTally{}, err
+		}
+	}
+
+	if !tally.Finalized {
+		k.SetObservationTally(ctx, tally)
+	}
+	return tally, nil
+}
+
+// finalizeObservation marks the tally as finalized and replaces it with a
+// FinalizedObservation.
+func (k Keeper) finalizeObservation(ctx sdk.Context, tally *types.ObservationTally) error {
+	tally.Finalized = true
+	k.RemoveObservationTally(ctx, tally.Digest)
+	k.SetFinalizedObservation(ctx, types.FinalizedObservation{
+		Digest: tally.Digest,
+		Height: ctx.BlockHeight(),
+	})
+	return ctx.EventManager().EmitTypedEvent(&types.EventObservationFinalized{
+		Digest:           tally.Digest,
+		GuardianSetIndex: tally.GuardianSetIndex,
+		Weight:           tally.Weight,
+	})
+}
+
+// queueObservation holds back an observation that reached quorum while its
+// emitter chain is at its rate limit, see ReleaseQueuedObservations.
+func (k Keeper) queueObservation(ctx sdk.Context, tally *types.ObservationTally, emitterChain uint16) error {
+	tally.Queued = true
+	k.SetQueuedObservation(ctx, types.QueuedObservation{
+		Digest:       tally.Digest,
+		EmitterChain: uint32(emitterChain),
+		QueuedHeight: ctx.BlockHeight(),
+	})
+	return ctx.EventManager().EmitTypedEvent(&types.EventObservationQueued{
+		Digest:       tally.Digest,
+		EmitterChain: uint32(emitterChain),
+	})
+}
@@ -0,0 +1,303 @@
+package keeper_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/wormhole-foundation/wormchain/testutil/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestSubmitObservation(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 10)
+	set := createNewGuardianSet(k, ctx, guardians)
+	signer := sdk.AccAddress(make([]byte, 20))
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	observation := generateVaa(set.Index, nil, vaa.ChainIDEthereum, []byte{1, 2, 3})
+	submit := func(guardianIndices ...int) (*types.MsgSubmitObservationResponse, error) {
+		v := observation
+		v.Signatures = nil
+		for _, i := range guardianIndices {
+			v.AddSignature(privateKeys[i], uint8(i))
+		}
+		vBz, err := v.Marshal()
+		require.NoError(t, err)
+		return msgServer.SubmitObservation(sdk.WrapSDKContext(ctx), &types.MsgSubmitObservation{
+			Signer: signer.String(),
+			Vaa:    vBz,
+		})
+	}
+
+	// 7 of 10 guardians with equal weights are needed
+	res, err := submit(0, 1, 2)
+	require.NoError(t, err)
+	assert.False(t, res.Finalized)
+
+	res, err = submit(3, 4, 5)
+	require.NoError(t, err)
+	assert.False(t, res.Finalized)
+
+	// Signatures that were already tallied do not count twice
+	_, err = submit(0, 5)
+	assert.ErrorIs(t, err, types.ErrObservationAlreadySigned)
+
+	// Signatures must be from the guardian at the given index
+	v := observation
+	v.Signatures = nil
+	v.AddSignature(privateKeys[1], 8)
+	vBz, _ := v.Marshal()
+	_, err = msgServer.SubmitObservation(sdk.WrapSDKContext(ctx), &types.MsgSubmitObservation{Signer: signer.String(), Vaa: vBz})
+	assert.ErrorIs(t, err, types.ErrSignaturesInvalid)
+
+	// Signer indexes must be strictly increasing, a guardian listed twice
+	// does not count twice
+	_, err = submit(7, 7)
+	assert.ErrorIs(t, err, types.ErrInvalidSignerIndexes)
+	_, err = submit(8, 7)
+	assert.ErrorIs(t, err, types.ErrInvalidSignerIndexes)
+
+	tally, found := k.GetObservationTally(ctx, observation.SigningDigest().Bytes())
+	require.True(t, found)
+	assert.Equal(t, uint64(6), tally.Weight)
+	assert.Equal(t, []byte{0x3f}, tally.Signers)
+	for i := uint8(0); i < 10; i++ {
+		assert.Equal(t, i < 6, tally.HasSigned(i))
+	}
+
+	res, err = submit(5, 6)
+	require.NoError(t, err)
+	assert.True(t, res.Finalized)
+
+	// The tally is removed once the observation is finalized
+	_, found = k.GetObservationTally(ctx, observation.SigningDigest().Bytes())
+	assert.False(t, found)
+
+	finalized := 0
+	for _, abciEvent := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(abciEvent)
+		if err != nil {
+			continue
+		}
+		if e, ok := msg.(*types.EventObservationFinalized); ok {
+			assert.Equal(t, types.EventObservationFinalized{Digest: observation.SigningDigest().Bytes(), GuardianSetIndex: set.Index, Weight: 7}, *e)
+			finalized++
+		}
+	}
+	assert.Equal(t, 1, finalized)
+
+	// Finalized observations are only finalized once, neither late signatures
+	// nor the original signers resubmitting start a new tally
+	_, err = submit(9)
+	assert.ErrorIs(t, err, types.ErrObservationFinalized)
+	_, err = submit(0, 1, 2, 3, 4, 5, 6)
+	assert.ErrorIs(t, err, types.ErrObservationFinalized)
+	_, found = k.GetObservationTally(ctx, observation.SigningDigest().Bytes())
+	assert.False(t, found)
+	assert.Equal(t, []types.FinalizedObservation{{Digest: observation.SigningDigest().Bytes(), Height: ctx.BlockHeight()}}, k.GetAllFinalizedObservation(ctx))
+
+	// Finalized observations are kept past the retention window of tallies
+	height := ctx.BlockHeight()
+	ctx = ctx.WithBlockHeight(height + keeper.ObservationTallyRetentionBlocks + 1)
+	k.PruneObservationTallies(ctx)
+	_, err = submit(0, 1, 2, 3, 4, 5, 6)
+	assert.ErrorIs(t, err, types.ErrObservationFinalized)
+	assert.Equal(t, []types.FinalizedObservation{{Digest: observation.SigningDigest().Bytes(), Height: height}}, k.GetAllFinalizedObservation(ctx))
+}
+
+func TestSubmitObservationWeighted(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 4)
+	set := createNewGuardianSet(k, ctx, guardians)
+
+	k.SetGuardianSetWeights(ctx, types.GuardianSetWeights{
+		GuardianSetIndex: set.Index,
+		Weights:          []uint64{70, 10, 10, 10},
+	})
+
+	// More than 2/3 of the total weight of 100 is needed
+	assert.Equal(t, uint64(67), k.QuorumWeight(ctx, 100))
+
+	observation := generateVaa(set.Index, nil, vaa.ChainIDEthereum, []byte{1})
+	for i := 1; i < 4; i++ {
+		observation.AddSignature(privateKeys[i], uint8(i))
+	}
+	tally, err := k.TallyObservation(ctx, &observation)
+	require.NoError(t, err)
+	assert.False(t, tally.Finalized)
+	assert.Equal(t, uint64(30), tally.Weight)
+
+	observation.Signatures = nil
+	observation.AddSignature(privateKeys[0], 0)
+	tally, err = k.TallyObservation(ctx, &observation)
+	require.NoError(t, err)
+	assert.True(t, tally.Finalized)
+	assert.Equal(t, uint64(100), tally.Weight)
+
+	// The quorum threshold param applies to the weights as well
+	k.SetParams(ctx, types.Params{QuorumNumerator: 3, QuorumDenominator: 4})
+	assert.Equal(t, uint64(76), k.QuorumWeight(ctx, 100))
+
+	other := generateVaa(set.Index, privateKeys[:1], vaa.ChainIDEthereum, []byte{2})
+	tally, err = k.TallyObservation(ctx, &other)
+	require.NoError(t, err)
+	assert.False(t, tally.Finalized)
+
+	// Observations are tallied against a single guardian set
+	newSet := createNewGuardianSet(k, ctx, guardians)
+	other.GuardianSetIndex = newSet.Index
+	other = resignVaa(other, privateKeys[:1])
+	_, err = k.TallyObservation(ctx, &other)
+	assert.ErrorIs(t, err, types.ErrSignaturesInvalid)
+}
+
+func TestExecuteGovernanceVAAGuardianSetWeightsUpdate(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 4)
+	k.SetConfig(ctx, types.Config{
+		GovernanceEmitter:     vaa.GovernanceEmitter[:],
+		GovernanceChain:       uint32(vaa.GovernanceChain),
+		ChainId:               uint32(vaa.ChainIDWormchain),
+		GuardianSetExpiration: 86400,
+	})
+	signer := sdk.AccAddress(make([]byte, 20))
+
+	set := createNewGuardianSet(k, ctx, guardians)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: set.Index})
+
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	execute := func(payload []byte) error {
+		module := [32]byte{}
+		copy(module[:], vaa.CoreModule)
+		gov_msg := types.NewGovernanceMessage(module, byte(vaa.ActionGuardianSetWeightsUpdate), uint16(vaa.ChainIDWormchain), payload)
+
+		v := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), gov_msg.MarshalBinary())
+		vBz, _ := v.Marshal()
+		_, err := msgServer.ExecuteGovernanceVAA(sdk.WrapSDKContext(ctx), &types.MsgExecuteGovernanceVAA{
+			Signer: signer.String(),
+			Vaa:    vBz,
+		})
+		return err
+	}
+	payload := func(guardianSetIndex uint32, weights ...uint64) []byte {
+		b := binary.BigEndian.AppendUint32(nil, guardianSetIndex)
+		b = append(b, uint8(len(weights)))
+		for _, weight := range weights {
+			b = binary.BigEndian.AppendUint64(b, weight)
+		}
+		return b
+	}
+
+	res, err := k.GuardianSetWeights(sdk.WrapSDKContext(ctx), &types.QueryGetGuardianSetWeightsRequest{GuardianSetIndex: set.Index})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1, 1, 1, 1}, res.GuardianSetWeights.Weights)
+	assert.Equal(t, uint64(4), res.TotalWeight)
+	assert.Equal(t, uint64(3), res.QuorumWeight)
+
+	assert.ErrorIs(t, execute(payload(set.Index, 1, 2, 3, 4)[:10]), types.ErrInvalidGovernancePayloadLength)
+	assert.ErrorIs(t, execute(payload(set.Index+1, 1, 2, 3, 4)), types.ErrGuardianSetNotFound)
+	assert.ErrorIs(t, execute(payload(set.Index, 1, 2, 3)), types.ErrInvalidGuardianWeights)
+	assert.ErrorIs(t, execute(payload(set.Index, 0, 0, 0, 0)), types.ErrInvalidGuardianWeights)
+	assert.ErrorIs(t, execute(payload(set.Index, 1<<32, 0, 0, 0)), types.ErrInvalidGuardianWeights)
+
+	require.NoError(t, execute(payload(set.Index, 4, 3, 2, 1)))
+	res, err = k.GuardianSetWeights(sdk.WrapSDKContext(ctx), &types.QueryGetGuardianSetWeightsRequest{GuardianSetIndex: set.Index})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{4, 3, 2, 1}, res.GuardianSetWeights.Weights)
+	assert.Equal(t, uint64(10), res.TotalWeight)
+	assert.Equal(t, uint64(7), res.QuorumWeight)
+
+	var event *types.EventGuardianSetWeightsUpdate
+	for _, abciEvent := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(abciEvent)
+		if err != nil {
+			continue
+		}
+		if e, ok := msg.(*types.EventGuardianSetWeightsUpdate); ok {
+			event = e
+		}
+	}
+	require.NotNil(t, event)
+	assert.Equal(t, types.EventGuardianSetWeightsUpdate{GuardianSetIndex: set.Index, Weights: []uint64{4, 3, 2, 1}}, *event)
+}
+
+func TestObservationTallyQuery(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 4)
+	set := createNewGuardianSet(k, ctx, guardians)
+
+	observation := generateVaa(set.Index, privateKeys[:2], vaa.ChainIDEthereum, []byte{1})
+	tally, err := k.TallyObservation(ctx, &observation)
+	require.NoError(t, err)
+
+	res, err := k.ObservationTally(wctx, &types.QueryGetObservationTallyRequest{Digest: tally.Digest})
+	require.NoError(t, err)
+	assert.Equal(t, tally, res.ObservationTally)
+	assert.Equal(t, uint64(3), res.QuorumWeight)
+
+	all, err := k.ObservationTallyAll(wctx, &types.QueryAllObservationTallyRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []types.ObservationTally{tally}, all.ObservationTally)
+
+	_, err = k.ObservationTally(wctx, &types.QueryGetObservationTallyRequest{Digest: make([]byte, 32)})
+	assert.Error(t, err)
+}
+
+func TestPruneObservationTallies(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 4)
+	set := createNewGuardianSet(k, ctx, guardians)
+
+	digests := func() (list [][]byte) {
+		for _, tally := range k.GetAllObservationTally(ctx) {
+			list = append(list, tally.Digest)
+		}
+		return
+	}
+
+	early := generateVaa(set.Index, privateKeys[:1], vaa.ChainIDEthereum, []byte{1})
+	_, err := k.TallyObservation(ctx.WithBlockHeight(1), &early)
+	require.NoError(t, err)
+	late := generateVaa(set.Index, privateKeys[:1], vaa.ChainIDEthereum, []byte{2})
+	_, err = k.TallyObservation(ctx.WithBlockHeight(5), &late)
+	require.NoError(t, err)
+
+	// Later signatures do not move a tally to a later height
+	early.Signatures = nil
+	early.AddSignature(privateKeys[1], 1)
+	_, err = k.TallyObservation(ctx.WithBlockHeight(5), &early)
+	require.NoError(t, err)
+
+	// Queued tallies are kept until they are released
+	k.SetChainRateLimit(ctx, types.ChainRateLimit{ChainId: uint32(vaa.ChainIDSolana), Limit: 1, WindowBlocks: 100})
+	for i := 0; i < 2; i++ {
+		v := generateVaa(set.Index, privateKeys, vaa.ChainIDSolana, []byte{byte(i)})
+		_, err = k.TallyObservation(ctx.WithBlockHeight(1), &v)
+		require.NoError(t, err)
+	}
+	queued := k.GetAllQueuedObservation(ctx)
+	require.Len(t, queued, 1)
+	assert.Len(t, digests(), 3)
+	finalized := k.GetAllFinalizedObservation(ctx)
+	require.Len(t, finalized, 1)
+
+	k.PruneObservationTallies(ctx.WithBlockHeight(keeper.ObservationTallyRetentionBlocks))
+	assert.Len(t, digests(), 3)
+
+	// Tallies started at or before height 1 are pruned, finalized observations are kept
+	k.PruneObservationTallies(ctx.WithBlockHeight(1 + keeper.ObservationTallyRetentionBlocks))
+	assert.ElementsMatch(t, [][]byte{late.SigningDigest().Bytes(), queued[0].Digest}, digests())
+	assert.Equal(t, finalized, k.GetAllFinalizedObservation(ctx))
+
+	k.PruneObservationTallies(ctx.WithBlockHeight(5 + keeper.ObservationTallyRetentionBlocks))
+	assert.Equal(t, [][]byte{queued[0].Digest}, digests())
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+// DefaultSignatureVerificationGas is the gas consumed per guardian signature
+// when the signature_verification_gas param is not set. It matches the cost of
+// verifying a secp256k1 transaction signature in x/auth.
+const DefaultSignatureVerificationGas uint64 = 1000
+
+// SetParams set params in the store
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ParamsKey))
+	b := k.cdc.MustMarshal(&params)
+	store.Set([]byte{0}, b)
+}
+
+// GetParams returns params. Params that were never set are all zero.
+func (k Keeper) GetParams(ctx sdk.Context) (val types.Params) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ParamsKey))
+
+	b := store.Get([]byte{0})
+	if b == nil {
+		return val
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val
+}
+
+// GetSignatureVerificationGas returns the gas consumed per guardian signature
+// when verifying a VAA.
+func (k Keeper) GetSignatureVerificationGas(ctx sdk.Context) uint64 {
+	gas := k.GetParams(ctx).SignatureVerificationGas
+	if gas == 0 {
+		return DefaultSignatureVerificationGas
+	}
+	return gas
+}
+
+// consumeSignatureVerificationGas charges the gas for verifying numSignatures
+// guardian signatures. It is charged before verifying, so VAAs that fail
+// verification still pay for the work.
+func (k Keeper) consumeSignatureVerificationGas(ctx sdk.Context, numSignatures int) {
+	ctx.GasMeter().ConsumeGas(k.GetSignatureVerificationGas(ctx)*uint64(numSignatures), "wormhole guardian signature verification")
+	telemetrySignatureVerifications(numSignatures)
+}
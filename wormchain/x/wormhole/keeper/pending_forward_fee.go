@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+// SetPendingForwardFee set a specific pendingForwardFee in the store from its index
+func (k Keeper) SetPendingForwardFee(ctx sdk.Context, pendingForwardFee types.PendingForwardFee) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingForwardFeeKey))
+	b := k.cdc.MustMarshal(&pendingForwardFee)
+	store.Set(types.PendingForwardFeeEntryKey(pendingForwardFee.PortId, pendingForwardFee.ChannelId, pendingForwardFee.Sequence), b)
+}
+
+// GetPendingForwardFee returns a pendingForwardFee from its index
+func (k Keeper) GetPendingForwardFee(ctx sdk.Context, portID string, channelID string, sequence uint64) (val types.PendingForwardFee, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingForwardFeeKey))
+
+	b := store.Get(types.PendingForwardFeeEntryKey(portID, channelID, sequence))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemovePendingForwardFee removes a pendingForwardFee from the store
+func (k Keeper) RemovePendingForwardFee(ctx sdk.Context, portID string, channelID string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingForwardFeeKey))
+	store.Delete(types.PendingForwardFeeEntryKey(portID, channelID, sequence))
+}
+
+// GetAllPendingForwardFee returns all pendingForwardFee
+func (k Keeper) GetAllPendingForwardFee(ctx sdk.Context) (list []types.PendingForwardFee) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingForwardFeeKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PendingForwardFee
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetForwardFeeMarker stores a pending fee under one of the marker keys,
+// types.ForwardFeeReceiveKey or types.ForwardFeeRetryKey. Markers pass the
+// packet being handled by the forward fee middleware to the transfer keeper
+// the packet forward middleware forwards with, and are removed before the
+// middleware returns.
+func (k Keeper) SetForwardFeeMarker(ctx sdk.Context, key string, marker types.PendingForwardFee) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefix(key), k.cdc.MustMarshal(&marker))
+}
+
+// GetForwardFeeMarker returns the pending fee stored under a marker key
+func (k Keeper) GetForwardFeeMarker(ctx sdk.Context, key string) (val types.PendingForwardFee, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.KeyPrefix(key))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveForwardFeeMarker removes the pending fee stored under a marker key
+func (k Keeper) RemoveForwardFeeMarker(ctx sdk.Context, key string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyPrefix(key))
+}
@@ -31,6 +31,9 @@ type WormholeQuery struct {
 
 	// Calculate the minimum number of participants required in quorum for the latest guardian set.
 	CalculateQuorum *calculateQuorumParams `json:"calculate_quorum,omitempty"`
+
+	// Look up the emitter registered through governance for a module on a foreign chain.
+	RegisteredEmitter *registeredEmitterParams `json:"registered_emitter,omitempty"`
 }
 
 // deprecated
@@ -55,6 +58,15 @@ type calculateQuorumParams struct {
 	GuardianSetIndex uint32 `json:"guardian_set_index"`
 }
 
+type registeredEmitterParams struct {
+	Module  string `json:"module"`
+	ChainID uint16 `json:"chain_id"`
+}
+
+type registeredEmitterResponse struct {
+	EmitterAddress []byte `json:"emitter_address"`
+}
+
 func WormholeQuerier(keeper Keeper) func(ctx sdk.Context, data json.RawMessage) ([]byte, error) {
 	return func(ctx sdk.Context, data json.RawMessage) ([]byte, error) {
 		var wormholeQuery WormholeQuery
@@ -73,7 +85,7 @@ func WormholeQuerier(keeper Keeper) func(ctx sdk.Context, data json.RawMessage)
 		}
 		if wormholeQuery.VerifyVaa != nil {
 			// verify vaa using recommended method
-			v, err := vaa.Unmarshal(wormholeQuery.VerifyVaa.Vaa)
+			v, err := ParseVAA(wormholeQuery.VerifyVaa.Vaa)
 			if err != nil {
 				return nil, err
 			}
@@ -107,6 +119,15 @@ func WormholeQuerier(keeper Keeper) func(ctx sdk.Context, data json.RawMessage)
 			return json.Marshal(quorum)
 		}
 
+		if wormholeQuery.RegisteredEmitter != nil {
+			registered, found := keeper.GetRegisteredEmitter(ctx, wormholeQuery.RegisteredEmitter.Module, wormholeQuery.RegisteredEmitter.ChainID)
+			if !found {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no %s emitter registered for chain %d", wormholeQuery.RegisteredEmitter.Module, wormholeQuery.RegisteredEmitter.ChainID)
+			}
+
+			return json.Marshal(registeredEmitterResponse{EmitterAddress: registered.EmitterAddress})
+		}
+
 		// else we have an unrecognized request
 		return nil, wasmvmtypes.UnsupportedRequest{Kind: "custom"}
 	}
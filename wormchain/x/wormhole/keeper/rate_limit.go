@@ -0,0 +1,269 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+// MaxRateLimitFlowPrunePerBlock bounds the number of rate limit flows removed
+// in a single EndBlock. Anything left over is pruned in the following blocks.
+const MaxRateLimitFlowPrunePerBlock = 1000
+
+// MaxQueuedObservationReleasePerBlock bounds the number of queued observations
+// visited in a single EndBlock. Anything left over is released in the
+// following blocks.
+const MaxQueuedObservationReleasePerBlock = 1000
+
+// SetChainRateLimit sets the rate limit of an emitter chain
+func (k Keeper) SetChainRateLimit(ctx sdk.Context, rateLimit types.ChainRateLimit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainRateLimitKeyPrefix))
+	b := k.cdc.MustMarshal(&rateLimit)
+	store.Set(types.ChainRateLimitKey(uint16(rateLimit.ChainId)), b)
+}
+
+// GetChainRateLimit returns the rate limit of an emitter chain
+func (k Keeper) GetChainRateLimit(ctx sdk.Context, chainId uint16) (val types.ChainRateLimit, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainRateLimitKeyPrefix))
+	b := store.Get(types.ChainRateLimitKey(chainId))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveChainRateLimit removes the rate limit of an emitter chain along with
+// its flows. Observations queued for the chain are released in the next
+// EndBlock.
+func (k Keeper) RemoveChainRateLimit(ctx sdk.Context, chainId uint16) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainRateLimitKeyPrefix))
+	store.Delete(types.ChainRateLimitKey(chainId))
+	k.removeChainRateLimitFlows(ctx, chainId)
+}
+
+// GetAllChainRateLimit returns all emitter chain rate limits
+func (k Keeper) GetAllChainRateLimit(ctx sdk.Context) (list []types.ChainRateLimit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainRateLimitKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ChainRateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetRateLimitFlow sets the number of observations of an emitter chain
+// finalized at a block height
+func (k Keeper) SetRateLimitFlow(ctx sdk.Context, flow types.RateLimitFlow) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimitFlowKeyPrefix))
+	store.Set(types.RateLimitFlowKey(uint16(flow.ChainId), flow.Height), binary.BigEndian.AppendUint64(nil, flow.Count))
+}
+
+// GetAllRateLimitFlow returns all recorded rate limit flows
+func (k Keeper) GetAllRateLimitFlow(ctx sdk.Context) (list []types.RateLimitFlow) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimitFlowKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		list = append(list, types.RateLimitFlow{
+			ChainId: uint32(binary.BigEndian.Uint16(key)),
+			Height:  int64(binary.BigEndian.Uint64(key[2:])),
+			Count:   binary.BigEndian.Uint64(iterator.Value()),
+		})
+	}
+
+	return
+}
+
+// GetChainFlow returns the number of observations of the emitter chain of a
+// rate limit that were finalized within its current window, i.e. in the last
+// window_blocks blocks including the current one.
+func (k Keeper) GetChainFlow(ctx sdk.Context, rateLimit types.ChainRateLimit) (flow uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimitFlowKeyPrefix))
+	chainId := uint16(rateLimit.ChainId)
+
+	start := ctx.BlockHeight() - int64(rateLimit.WindowBlocks) + 1
+	if start < 0 {
+		start = 0
+	}
+	iterator := store.Iterator(types.RateLimitFlowKey(chainId, start), types.RateLimitFlowKey(chainId, ctx.BlockHeight()+1))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		flow += binary.BigEndian.Uint64(iterator.Value())
+	}
+
+	return
+}
+
+// admitObservation records an observation from an emitter chain in the flow of
+// the current block if the chain has capacity left within its window. It
+// returns false if the observation has to be queued instead.
+func (k Keeper) admitObservation(ctx sdk.Context, emitterChain uint16) bool {
+	rateLimit, found := k.GetChainRateLimit(ctx, emitterChain)
+	if !found {
+		return true
+	}
+	if k.GetChainFlow(ctx, rateLimit) >= rateLimit.Limit {
+		return false
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimitFlowKeyPrefix))
+	key := types.RateLimitFlowKey(emitterChain, ctx.BlockHeight())
+	var count uint64
+	if b := store.Get(key); b != nil {
+		count = binary.BigEndian.Uint64(b)
+	}
+	store.Set(key, binary.BigEndian.AppendUint64(nil, count+1))
+
+	return true
+}
+
+// SetQueuedObservation adds an observation to the queue of its emitter chain
+func (k Keeper) SetQueuedObservation(ctx sdk.Context, queued types.QueuedObservation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.QueuedObservationKeyPrefix))
+	b := k.cdc.MustMarshal(&queued)
+	store.Set(types.QueuedObservationKey(uint16(queued.EmitterChain), queued.QueuedHeight, queued.Digest), b)
+}
+
+// GetAllQueuedObservation returns all queued observations, in queue order per
+// emitter chain
+func (k Keeper) GetAllQueuedObservation(ctx sdk.Context) (list []types.QueuedObservation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.QueuedObservationKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.QueuedObservation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// PruneRateLimitFlows removes the flows that fell out of the window of their
+// emitter chain. Flows are keyed by chain and height, so only the flows below
+// the window of each rate limited chain are visited. The flows of chains that
+// are no longer rate limited are removed along with their rate limit. At most
+// MaxRateLimitFlowPrunePerBlock flows are removed per call.
+func (k Keeper) PruneRateLimitFlows(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimitFlowKeyPrefix))
+
+	var pruned [][]byte
+	for _, rateLimit := range k.GetAllChainRateLimit(ctx) {
+		if len(pruned) >= MaxRateLimitFlowPrunePerBlock {
+			break
+		}
+		cutoff := ctx.BlockHeight() - int64(rateLimit.WindowBlocks)
+		if cutoff < 0 {
+			continue
+		}
+		chainId := uint16(rateLimit.ChainId)
+		iterator := store.Iterator(types.RateLimitFlowKey(chainId, 0), types.RateLimitFlowKey(chainId, cutoff+1))
+		for ; iterator.Valid() && len(pruned) < MaxRateLimitFlowPrunePerBlock; iterator.Next() {
+			pruned = append(pruned, iterator.Key())
+		}
+		iterator.Close()
+	}
+
+	for _, key := range pruned {
+		store.Delete(key)
+	}
+}
+
+// removeChainRateLimitFlows removes all flows of an emitter chain.
+func (k Keeper) removeChainRateLimitFlows(ctx sdk.Context, chainId uint16) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimitFlowKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.ChainRateLimitKey(chainId))
+
+	var pruned [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		pruned = append(pruned, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range pruned {
+		store.Delete(key)
+	}
+}
+
+// ReleaseQueuedObservations finalizes queued observations, in queue order per
+// emitter chain, for as long as their chain has capacity left in its window.
+// Queue entries whose tally is gone are dropped without using up capacity. At
+// most MaxQueuedObservationReleasePerBlock entries are visited per call. The
+// caller runs it on a branch of the state that is discarded if it fails.
+func (k Keeper) ReleaseQueuedObservations(ctx sdk.Context) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.QueuedObservationKeyPrefix))
+
+	visited := 0
+	var start []byte
+	for visited < MaxQueuedObservationReleasePerBlock {
+		// seek to the queue of the next emitter chain
+		iterator := store.Iterator(start, nil)
+		if !iterator.Valid() {
+			iterator.Close()
+			return nil
+		}
+		emitterChain := binary.BigEndian.Uint16(iterator.Key())
+		iterator.Close()
+
+		queue := k.chainQueuedObservations(ctx, emitterChain, MaxQueuedObservationReleasePerBlock-visited)
+		for _, queued := range queue {
+			visited++
+			tally, found := k.GetObservationTally(ctx, queued.Digest)
+			if !found {
+				store.Delete(types.QueuedObservationKey(emitterChain, queued.QueuedHeight, queued.Digest))
+				continue
+			}
+			if !k.admitObservation(ctx, emitterChain) {
+				break
+			}
+
+			store.Delete(types.QueuedObservationKey(emitterChain, queued.QueuedHeight, queued.Digest))
+			tally.Queued = false
+			if err := k.finalizeObservation(ctx, &tally); err != nil {
+				return err
+			}
+		}
+
+		if emitterChain == math.MaxUint16 {
+			return nil
+		}
+		start = types.ChainRateLimitKey(emitterChain + 1)
+	}
+
+	return nil
+}
+
+// chainQueuedObservations returns up to limit observations from the head of
+// the queue of an emitter chain.
+func (k Keeper) chainQueuedObservations(ctx sdk.Context, emitterChain uint16, limit int) (list []types.QueuedObservation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.QueuedObservationKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.ChainRateLimitKey(emitterChain))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid() && len(list) < limit; iterator.Next() {
+		var val types.QueuedObservation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,232 @@
+package keeper_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/wormhole-foundation/wormchain/testutil/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestChainRateLimitQueue(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 4)
+	set := createNewGuardianSet(k, ctx, guardians)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	signer := sdk.AccAddress(make([]byte, 20))
+
+	// generateVaa always emits from solana
+	k.SetChainRateLimit(ctx, types.ChainRateLimit{ChainId: uint32(vaa.ChainIDSolana), Limit: 2, WindowBlocks: 10})
+
+	ctx = ctx.WithBlockHeight(10)
+	var observations []vaa.VAA
+	for i := 0; i < 3; i++ {
+		observations = append(observations, generateVaa(set.Index, privateKeys[:3], vaa.ChainIDSolana, []byte{byte(i)}))
+	}
+	submit := func(ctx sdk.Context, v vaa.VAA) *types.MsgSubmitObservationResponse {
+		vBz, err := v.Marshal()
+		require.NoError(t, err)
+		res, err := msgServer.SubmitObservation(sdk.WrapSDKContext(ctx), &types.MsgSubmitObservation{
+			Signer: signer.String(),
+			Vaa:    vBz,
+		})
+		require.NoError(t, err)
+		return res
+	}
+
+	assert.Equal(t, &types.MsgSubmitObservationResponse{Finalized: true}, submit(ctx, observations[0]))
+	assert.Equal(t, &types.MsgSubmitObservationResponse{Finalized: true}, submit(ctx, observations[1]))
+	// The third observation exceeds the limit of the window
+	assert.Equal(t, &types.MsgSubmitObservationResponse{Queued: true}, submit(ctx, observations[2]))
+
+	queuedDigest := observations[2].SigningDigest().Bytes()
+	assert.Equal(t, []types.QueuedObservation{{
+		Digest:       queuedDigest,
+		EmitterChain: uint32(vaa.ChainIDSolana),
+		QueuedHeight: 10,
+	}}, k.GetAllQueuedObservation(ctx))
+
+	var queuedEvent *types.EventObservationQueued
+	for _, abciEvent := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(abciEvent)
+		if err != nil {
+			continue
+		}
+		if e, ok := msg.(*types.EventObservationQueued); ok {
+			queuedEvent = e
+		}
+	}
+	require.NotNil(t, queuedEvent)
+	assert.Equal(t, types.EventObservationQueued{Digest: queuedDigest, EmitterChain: uint32(vaa.ChainIDSolana)}, *queuedEvent)
+
+	res, err := k.ChainRateLimit(sdk.WrapSDKContext(ctx), &types.QueryGetChainRateLimitRequest{ChainId: uint32(vaa.ChainIDSolana)})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), res.Flow)
+	assert.Equal(t, uint64(0), res.Available)
+
+	// More signatures do not queue the observation again
+	late := observations[2]
+	late.Signatures = nil
+	late.AddSignature(privateKeys[3], 3)
+	assert.Equal(t, &types.MsgSubmitObservationResponse{Queued: true}, submit(ctx, late))
+	assert.Len(t, k.GetAllQueuedObservation(ctx), 1)
+
+	// The observation stays queued while the window is full
+	ctx = ctx.WithBlockHeight(19).WithEventManager(sdk.NewEventManager())
+	k.PruneRateLimitFlows(ctx)
+	require.NoError(t, k.ReleaseQueuedObservations(ctx))
+	assert.Len(t, k.GetAllQueuedObservation(ctx), 1)
+
+	// and is released once the flows of height 10 leave the window
+	ctx = ctx.WithBlockHeight(20)
+	k.PruneRateLimitFlows(ctx)
+	assert.Empty(t, k.GetAllRateLimitFlow(ctx))
+	require.NoError(t, k.ReleaseQueuedObservations(ctx))
+	assert.Empty(t, k.GetAllQueuedObservation(ctx))
+
+	// released observations are finalized and their tally is removed
+	_, found := k.GetObservationTally(ctx, queuedDigest)
+	assert.False(t, found)
+	assert.True(t, k.IsObservationFinalized(ctx, queuedDigest))
+	assert.Equal(t, []types.RateLimitFlow{{ChainId: uint32(vaa.ChainIDSolana), Height: 20, Count: 1}}, k.GetAllRateLimitFlow(ctx))
+
+	finalized := 0
+	for _, abciEvent := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(abciEvent)
+		if err != nil {
+			continue
+		}
+		if _, ok := msg.(*types.EventObservationFinalized); ok {
+			finalized++
+		}
+	}
+	assert.Equal(t, 1, finalized)
+}
+
+func TestExecuteGovernanceVAAChainRateLimitUpdate(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 4)
+	k.SetConfig(ctx, types.Config{
+		GovernanceEmitter:     vaa.GovernanceEmitter[:],
+		GovernanceChain:       uint32(vaa.GovernanceChain),
+		ChainId:               uint32(vaa.ChainIDWormchain),
+		GuardianSetExpiration: 86400,
+	})
+	signer := sdk.AccAddress(make([]byte, 20))
+
+	set := createNewGuardianSet(k, ctx, guardians)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: set.Index})
+
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	execute := func(payload []byte) error {
+		module := [32]byte{}
+		copy(module[:], vaa.CoreModule)
+		gov_msg := types.NewGovernanceMessage(module, byte(vaa.ActionChainRateLimitUpdate), uint16(vaa.ChainIDWormchain), payload)
+
+		v := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), gov_msg.MarshalBinary())
+		vBz, _ := v.Marshal()
+		_, err := msgServer.ExecuteGovernanceVAA(sdk.WrapSDKContext(ctx), &types.MsgExecuteGovernanceVAA{
+			Signer: signer.String(),
+			Vaa:    vBz,
+		})
+		return err
+	}
+	payload := func(chainId vaa.ChainID, limit uint64, windowBlocks uint64) []byte {
+		b := binary.BigEndian.AppendUint16(nil, uint16(chainId))
+		b = binary.BigEndian.AppendUint64(b, limit)
+		return binary.BigEndian.AppendUint64(b, windowBlocks)
+	}
+
+	assert.ErrorIs(t, execute(payload(vaa.ChainIDEthereum, 1, 1)[:17]), types.ErrInvalidGovernancePayloadLength)
+	assert.ErrorIs(t, execute(payload(vaa.ChainIDEthereum, 1, 0)), types.ErrInvalidChainRateLimit)
+
+	require.NoError(t, execute(payload(vaa.ChainIDEthereum, 100, 50)))
+	rateLimit, found := k.GetChainRateLimit(ctx, uint16(vaa.ChainIDEthereum))
+	require.True(t, found)
+	assert.Equal(t, types.ChainRateLimit{ChainId: uint32(vaa.ChainIDEthereum), Limit: 100, WindowBlocks: 50}, rateLimit)
+
+	all, err := k.ChainRateLimitAll(sdk.WrapSDKContext(ctx), &types.QueryAllChainRateLimitRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []types.ChainRateLimit{rateLimit}, all.ChainRateLimit)
+
+	var event *types.EventChainRateLimitUpdate
+	for _, abciEvent := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(abciEvent)
+		if err != nil {
+			continue
+		}
+		if e, ok := msg.(*types.EventChainRateLimitUpdate); ok {
+			event = e
+		}
+	}
+	require.NotNil(t, event)
+	assert.Equal(t, types.EventChainRateLimitUpdate{ChainId: uint32(vaa.ChainIDEthereum), Limit: 100, WindowBlocks: 50}, *event)
+
+	// A limit of 0 removes the rate limit
+	require.NoError(t, execute(payload(vaa.ChainIDEthereum, 0, 0)))
+	_, found = k.GetChainRateLimit(ctx, uint16(vaa.ChainIDEthereum))
+	assert.False(t, found)
+	_, err = k.ChainRateLimit(sdk.WrapSDKContext(ctx), &types.QueryGetChainRateLimitRequest{ChainId: uint32(vaa.ChainIDEthereum)})
+	assert.Error(t, err)
+}
+
+func TestRemovedChainRateLimitReleasesQueue(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 4)
+	set := createNewGuardianSet(k, ctx, guardians)
+
+	k.SetChainRateLimit(ctx, types.ChainRateLimit{ChainId: uint32(vaa.ChainIDSolana), Limit: 1, WindowBlocks: 100})
+	for i := 0; i < 3; i++ {
+		v := generateVaa(set.Index, privateKeys, vaa.ChainIDSolana, []byte{byte(i)})
+		_, err := k.TallyObservation(ctx, &v)
+		require.NoError(t, err)
+	}
+	assert.Len(t, k.GetAllQueuedObservation(ctx), 2)
+
+	k.RemoveChainRateLimit(ctx, uint16(vaa.ChainIDSolana))
+	k.PruneRateLimitFlows(ctx)
+	assert.Empty(t, k.GetAllRateLimitFlow(ctx))
+	require.NoError(t, k.ReleaseQueuedObservations(ctx))
+	assert.Empty(t, k.GetAllQueuedObservation(ctx))
+	assert.Empty(t, k.GetAllObservationTally(ctx))
+}
+
+func TestReleaseQueuedObservationsWithoutTally(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 4)
+	set := createNewGuardianSet(k, ctx, guardians)
+
+	k.SetChainRateLimit(ctx, types.ChainRateLimit{ChainId: uint32(vaa.ChainIDSolana), Limit: 1, WindowBlocks: 10})
+	ctx = ctx.WithBlockHeight(10)
+	for i := 0; i < 2; i++ {
+		v := generateVaa(set.Index, privateKeys, vaa.ChainIDSolana, []byte{byte(i)})
+		_, err := k.TallyObservation(ctx, &v)
+		require.NoError(t, err)
+	}
+	queued := k.GetAllQueuedObservation(ctx)
+	require.Len(t, queued, 1)
+
+	// A queue entry whose tally is gone, ahead of the queued observation
+	stale := types.QueuedObservation{Digest: []byte{1}, EmitterChain: uint32(vaa.ChainIDSolana), QueuedHeight: 5}
+	k.SetQueuedObservation(ctx, stale)
+
+	ctx = ctx.WithBlockHeight(20)
+	k.PruneRateLimitFlows(ctx)
+	require.NoError(t, k.ReleaseQueuedObservations(ctx))
+
+	// The stale entry is dropped without using up the capacity of the window
+	assert.Empty(t, k.GetAllQueuedObservation(ctx))
+	assert.True(t, k.IsObservationFinalized(ctx, queued[0].Digest))
+	assert.Equal(t, []types.RateLimitFlow{{ChainId: uint32(vaa.ChainIDSolana), Height: 20, Count: 1}}, k.GetAllRateLimitFlow(ctx))
+
+	// Removing the rate limit removes the flows of the chain
+	k.RemoveChainRateLimit(ctx, uint16(vaa.ChainIDSolana))
+	assert.Empty(t, k.GetAllRateLimitFlow(ctx))
+}
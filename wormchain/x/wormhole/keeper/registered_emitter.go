@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+// SetRegisteredEmitter set a specific registeredEmitter in the store from its index
+func (k Keeper) SetRegisteredEmitter(ctx sdk.Context, registeredEmitter types.RegisteredEmitter) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RegisteredEmitterKeyPrefix))
+	b := k.cdc.MustMarshal(&registeredEmitter)
+	store.Set(types.RegisteredEmitterKey(
+		registeredEmitter.Module,
+		uint16(registeredEmitter.ChainId),
+	), b)
+}
+
+// GetRegisteredEmitter returns the emitter registered for a module on a chain
+func (k Keeper) GetRegisteredEmitter(
+	ctx sdk.Context,
+	module string,
+	chainID uint16,
+) (val types.RegisteredEmitter, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RegisteredEmitterKeyPrefix))
+
+	b := store.Get(types.RegisteredEmitterKey(
+		module,
+		chainID,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllRegisteredEmitter returns all registeredEmitter
+func (k Keeper) GetAllRegisteredEmitter(ctx sdk.Context) (list []types.RegisteredEmitter) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RegisteredEmitterKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RegisteredEmitter
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"encoding/hex"
+
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
@@ -61,3 +63,58 @@ func (k Keeper) GetAllReplayProtection(ctx sdk.Context) (list []types.ReplayProt
 
 	return
 }
+
+// SetExecutedGovernanceVAA records a governance VAA digest as executed
+func (k Keeper) SetExecutedGovernanceVAA(ctx sdk.Context, executed types.ExecutedGovernanceVAA) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ExecutedGovernanceVAAKeyPrefix))
+	b := k.cdc.MustMarshal(&executed)
+	store.Set(types.ExecutedGovernanceVAAKey(
+		executed.Digest,
+	), b)
+}
+
+// GetExecutedGovernanceVAA returns an executed governance VAA from its digest
+func (k Keeper) GetExecutedGovernanceVAA(
+	ctx sdk.Context,
+	digest []byte,
+) (val types.ExecutedGovernanceVAA, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ExecutedGovernanceVAAKeyPrefix))
+
+	b := store.Get(types.ExecutedGovernanceVAAKey(
+		digest,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllExecutedGovernanceVAA returns all executed governance VAAs
+func (k Keeper) GetAllExecutedGovernanceVAA(ctx sdk.Context) (list []types.ExecutedGovernanceVAA) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ExecutedGovernanceVAAKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ExecutedGovernanceVAA
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// IsGovernanceVAAExecuted returns whether a governance VAA with the given digest
+// has already been executed. Governance VAAs executed before the dedicated store
+// was introduced are only tracked in the generic replay protection store, so that
+// is checked as well.
+func (k Keeper) IsGovernanceVAAExecuted(ctx sdk.Context, digest []byte) bool {
+	if _, found := k.GetExecutedGovernanceVAA(ctx, digest); found {
+		return true
+	}
+	_, found := k.GetReplayProtection(ctx, hex.EncodeToString(digest))
+	return found
+}
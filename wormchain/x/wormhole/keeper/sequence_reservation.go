@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+// contractSequenceNamespace is the namespace of the bucket in which the core
+// contract stores the next sequence of each emitter.
+const contractSequenceNamespace = "sequence"
+
+// SetSequenceReservation set a specific sequenceReservation in the store from
+// its emitter and first sequence
+func (k Keeper) SetSequenceReservation(ctx sdk.Context, reservation types.SequenceReservation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SequenceReservationKeyPrefix))
+	b := k.cdc.MustMarshal(&reservation)
+	store.Set(types.SequenceReservationKey(reservation.Emitter, reservation.Start), b)
+}
+
+// RemoveSequenceReservation removes a sequenceReservation from the store
+func (k Keeper) RemoveSequenceReservation(ctx sdk.Context, emitter string, start uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SequenceReservationKeyPrefix))
+	store.Delete(types.SequenceReservationKey(emitter, start))
+}
+
+// GetSequenceReservations returns the outstanding sequenceReservations of an
+// emitter, ordered by their first sequence
+func (k Keeper) GetSequenceReservations(ctx sdk.Context, emitter string) (list []types.SequenceReservation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SequenceReservationKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.SequenceReservationEmitterPrefix(emitter))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.SequenceReservation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllSequenceReservation returns all sequenceReservation
+func (k Keeper) GetAllSequenceReservation(ctx sdk.Context) (list []types.SequenceReservation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SequenceReservationKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.SequenceReservation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetNextSequence returns the sequence of the next message the wormhole
+// module posts for an emitter. Sequences of outstanding reservations are
+// already accounted for.
+func (k Keeper) GetNextSequence(ctx sdk.Context, emitter types.EmitterAddress) uint64 {
+	counter, _ := k.GetSequenceCounter(ctx, hex.EncodeToString(emitter.Bytes()))
+	return counter.Sequence
+}
+
+// GetContractNextSequence returns the sequence of the next message a core
+// contract publishes for an emitter, read directly from the state of the
+// contract.
+func (k Keeper) GetContractNextSequence(ctx sdk.Context, contract sdk.AccAddress, emitter types.EmitterAddress) (uint64, error) {
+	if !k.setWasmView {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "x/wasmd not set")
+	}
+
+	// The core contract stores the sequences in a cosmwasm-storage bucket,
+	// whose keys are the length prefixed namespace followed by the emitter.
+	key := binary.BigEndian.AppendUint16(nil, uint16(len(contractSequenceNamespace)))
+	key = append(key, contractSequenceNamespace...)
+	key = append(key, emitter.Bytes()...)
+
+	value := k.wasmViewKeeper.QueryRaw(ctx, contract, key)
+	if value == nil {
+		return 0, nil
+	}
+	sequence, err := strconv.ParseUint(string(value), 10, 64)
+	if err != nil {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "invalid sequence %q stored by contract %s", value, contract)
+	}
+	return sequence, nil
+}
+
+// ReserveSequenceBlock pre-allocates count contiguous sequences for an
+// emitter, so its messages can be posted with PostMessageWithReservedSequence
+// without contending for the sequence counter. It returns the first sequence
+// of the reservation.
+func (k Keeper) ReserveSequenceBlock(ctx sdk.Context, emitter types.EmitterAddress, count uint64) (uint64, error) {
+	if err := types.ValidateSequenceReservationCount(count); err != nil {
+		return 0, sdkerrors.Wrap(types.ErrInvalidSequenceReservation, err.Error())
+	}
+
+	emitterHex := hex.EncodeToString(emitter.Bytes())
+	counter, found := k.GetSequenceCounter(ctx, emitterHex)
+	if !found {
+		counter = types.SequenceCounter{
+			Index:    emitterHex,
+			Sequence: 0,
+		}
+	}
+	start := counter.Sequence
+	if start+count < start {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidSequenceReservation, "reservation of %d sequences starting at %d overflows", count, start)
+	}
+
+	counter.Sequence += count
+	k.SetSequenceCounter(ctx, counter)
+	k.SetSequenceReservation(ctx, types.SequenceReservation{
+		Emitter: emitterHex,
+		Start:   start,
+		Count:   count,
+	})
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventSequenceBlockReserved{
+		Emitter: emitter.Bytes(),
+		Start:   start,
+		Count:   count,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return start, nil
+}
+
+// useReservedSequence marks the sequence as used if it is the next unused
+// sequence of one of the reservations of the emitter. A reservation is
+// removed once all its sequences were used.
+func (k Keeper) useReservedSequence(ctx sdk.Context, emitterHex string, sequence uint64) error {
+	for _, reservation := range k.GetSequenceReservations(ctx, emitterHex) {
+		if sequence < reservation.Start || sequence >= reservation.End() {
+			continue
+		}
+		if sequence != reservation.NextSequence() {
+			return sdkerrors.Wrapf(types.ErrSequenceNotReserved, "sequence %d of emitter %s is out of order, expected %d", sequence, emitterHex, reservation.NextSequence())
+		}
+
+		reservation.Used++
+		if reservation.Used == reservation.Count {
+			k.RemoveSequenceReservation(ctx, emitterHex, reservation.Start)
+		} else {
+			k.SetSequenceReservation(ctx, reservation)
+		}
+		return nil
+	}
+
+	return sdkerrors.Wrapf(types.ErrSequenceNotReserved, "sequence %d of emitter %s", sequence, emitterHex)
+}
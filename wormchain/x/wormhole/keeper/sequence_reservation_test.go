@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/wormhole-foundation/wormchain/testutil/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+// mockCoreContract answers raw queries from a canned contract state.
+type mockCoreContract struct {
+	state map[string][]byte
+}
+
+func (m *mockCoreContract) QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
+	return nil, sdkerrors.ErrNotSupported
+}
+
+func (m *mockCoreContract) QueryRaw(ctx sdk.Context, contractAddress sdk.AccAddress, key []byte) []byte {
+	return m.state[string(key)]
+}
+
+func (m *mockCoreContract) GetCodeInfo(ctx sdk.Context, codeID uint64) *wasmtypes.CodeInfo {
+	return nil
+}
+
+func TestReserveSequenceBlock(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	signer := sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+	emitter := types.EmitterAddressFromAccAddress(signer)
+	emitterHex := hex.EncodeToString(emitter.Bytes())
+
+	require.NoError(t, k.PostMessage(ctx, emitter, 0, []byte{1}))
+
+	res, err := msgServer.ReserveSequenceBlock(wctx, &types.MsgReserveSequenceBlock{Signer: signer.String(), Count: 3})
+	require.NoError(t, err)
+	assert.Equal(t, &types.MsgReserveSequenceBlockResponse{Start: 1, Count: 3}, res)
+
+	// Messages posted without a reservation skip the reserved range
+	next, err := k.NextSequence(wctx, &types.QueryNextSequenceRequest{Emitter: emitterHex})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), next.Sequence)
+	require.NoError(t, k.PostMessage(ctx, emitter, 0, []byte{2}))
+	assert.Equal(t, uint64(5), k.GetNextSequence(ctx, emitter))
+
+	_, err = msgServer.ReserveSequenceBlock(wctx, &types.MsgReserveSequenceBlock{Signer: signer.String(), Count: 2})
+	require.NoError(t, err)
+
+	all, err := k.SequenceReservationAll(wctx, &types.QueryAllSequenceReservationRequest{Emitter: emitterHex})
+	require.NoError(t, err)
+	assert.Equal(t, []types.SequenceReservation{
+		{Emitter: emitterHex, Start: 1, Count: 3},
+		{Emitter: emitterHex, Start: 5, Count: 2},
+	}, all.SequenceReservation)
+
+	// Reserved sequences must be used in order
+	err = k.PostMessageWithReservedSequence(ctx, emitter, 2, 0, []byte{3})
+	assert.ErrorIs(t, err, types.ErrSequenceNotReserved)
+	err = k.PostMessageWithReservedSequence(ctx, emitter, 4, 0, []byte{3})
+	assert.ErrorIs(t, err, types.ErrSequenceNotReserved)
+
+	for _, sequence := range []uint64{1, 5, 2, 3} {
+		require.NoError(t, k.PostMessageWithReservedSequence(ctx, emitter, sequence, 0, []byte{3}))
+	}
+	err = k.PostMessageWithReservedSequence(ctx, emitter, 3, 0, []byte{3})
+	assert.ErrorIs(t, err, types.ErrSequenceNotReserved)
+
+	// Exhausted reservations are removed
+	assert.Equal(t, []types.SequenceReservation{
+		{Emitter: emitterHex, Start: 5, Count: 2, Used: 1},
+	}, k.GetAllSequenceReservation(ctx))
+
+	_, err = msgServer.ReserveSequenceBlock(wctx, &types.MsgReserveSequenceBlock{Signer: signer.String(), Count: types.MaxSequenceReservationCount + 1})
+	assert.ErrorIs(t, err, types.ErrInvalidSequenceReservation)
+}
+
+func TestContractNextSequence(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	contract := sdk.AccAddress(bytes.Repeat([]byte{2}, 32)).String()
+	emitter := bytes.Repeat([]byte{0xab}, 32)
+	emitterHex := hex.EncodeToString(emitter)
+
+	// The wasm keeper must be set
+	_, err := k.NextSequence(wctx, &types.QueryNextSequenceRequest{Emitter: emitterHex, Contract: contract})
+	assert.ErrorIs(t, err, sdkerrors.ErrNotSupported)
+
+	key := append([]byte("\x00\x08sequence"), emitter...)
+	k.SetWasmViewKeeper(&mockCoreContract{state: map[string][]byte{string(key): []byte("42")}})
+
+	res, err := k.NextSequence(wctx, &types.QueryNextSequenceRequest{Emitter: emitterHex, Contract: contract})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), res.Sequence)
+
+	// Emitters that never published start at sequence zero
+	res, err = k.NextSequence(wctx, &types.QueryNextSequenceRequest{Emitter: hex.EncodeToString(make([]byte, 32)), Contract: contract})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), res.Sequence)
+
+	_, err = k.NextSequence(wctx, &types.QueryNextSequenceRequest{Emitter: "abcd", Contract: contract})
+	assert.Error(t, err)
+}
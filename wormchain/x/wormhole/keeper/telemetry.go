@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+// Metric keys of the module. They are exported with the module name as prefix
+// through the telemetry of the node, i.e. its Prometheus endpoint when
+// telemetry is enabled in app.toml.
+const (
+	MetricGovernanceVAAExecuted     = "governance_vaa_executed"
+	MetricSignatureVerifications    = "signature_verifications"
+	MetricQuorumFailures            = "quorum_failures"
+	MetricVAARejected               = "vaa_rejected"
+	MetricGuardianSetIndex          = "guardian_set_index"
+	MetricConsensusGuardianSetIndex = "consensus_guardian_set_index"
+)
+
+// governance modules as labelled in the metrics
+const (
+	metricModuleCore    = "core"
+	metricModuleGateway = "gateway"
+)
+
+// simulationKey marks the context of a simulated governance VAA execution,
+// which is not counted as executed.
+type simulationKey struct{}
+
+func telemetryGovernanceVAAExecuted(ctx sdk.Context, module string, action byte) {
+	if ctx.Value(simulationKey{}) != nil {
+		return
+	}
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, MetricGovernanceVAAExecuted},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel("module", module),
+			telemetry.NewLabel("action", strconv.Itoa(int(action))),
+		},
+	)
+}
+
+func telemetrySignatureVerifications(numSignatures int) {
+	telemetry.IncrCounter(float32(numSignatures), types.ModuleName, MetricSignatureVerifications)
+}
+
+func telemetryQuorumFailure() {
+	telemetry.IncrCounter(1, types.ModuleName, MetricQuorumFailures)
+}
+
+// vaaRejectionReason returns the label of the reason a VAA was rejected for
+func vaaRejectionReason(err error) string {
+	switch {
+	case errors.Is(err, types.ErrGuardianSetNotFound):
+		return "guardian_set_not_found"
+	case errors.Is(err, types.ErrGuardianSetExpired):
+		return "guardian_set_expired"
+	case errors.Is(err, types.ErrNoQuorum):
+		return "no_quorum"
+	case errors.Is(err, types.ErrSignaturesInvalid), errors.Is(err, types.ErrGuardianIndexOutOfBounds), errors.Is(err, types.ErrInvalidSignerIndexes):
+		return "invalid_signatures"
+	case errors.Is(err, types.ErrInsufficientVerificationGas):
+		return "insufficient_gas"
+	case errors.Is(err, types.ErrGovernanceVaaAlreadyExecuted):
+		return "already_executed"
+	case errors.Is(err, types.ErrInvalidGovernanceEmitter):
+		return "invalid_emitter"
+	default:
+		return "invalid_payload"
+	}
+}
+
+func telemetryVAARejected(err error) {
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, MetricVAARejected},
+		1,
+		[]metrics.Label{telemetry.NewLabel("reason", vaaRejectionReason(err))},
+	)
+}
+
+// EmitGuardianSetMetrics sets the gauges of the latest and the consensus
+// guardian set index. It is called at the end of every block, so the gauges
+// only reflect committed state.
+func (k Keeper) EmitGuardianSetMetrics(ctx sdk.Context) {
+	telemetry.SetGauge(float32(k.GetLatestGuardianSetIndex(ctx)), types.ModuleName, MetricGuardianSetIndex)
+	if consensusIndex, found := k.GetConsensusGuardianSetIndex(ctx); found {
+		telemetry.SetGauge(float32(consensusIndex.Index), types.ModuleName, MetricConsensusGuardianSetIndex)
+	}
+}
@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/wormhole-foundation/wormchain/testutil/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestTelemetry(t *testing.T) {
+	metrics, err := telemetry.New(telemetry.Config{
+		ServiceName:             "wormchain",
+		Enabled:                 true,
+		PrometheusRetentionTime: 60,
+	})
+	require.NoError(t, err)
+
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 10)
+	k.SetConfig(ctx, types.Config{
+		GovernanceEmitter:     vaa.GovernanceEmitter[:],
+		GovernanceChain:       uint32(vaa.GovernanceChain),
+		ChainId:               uint32(vaa.ChainIDWormchain),
+		GuardianSetExpiration: 86400,
+	})
+	signer := sdk.AccAddress(make([]byte, 20))
+
+	set := createNewGuardianSet(k, ctx, guardians)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: set.Index})
+
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	module := [32]byte{}
+	copy(module[:], vaa.CoreModule)
+	gov_msg := types.NewGovernanceMessage(module, byte(vaa.ActionPauseBridge), uint16(vaa.ChainIDWormchain), nil)
+
+	// Without quorum
+	v := generateVaa(set.Index, privateKeys[:1], vaa.ChainID(vaa.GovernanceChain), gov_msg.MarshalBinary())
+	vBz, _ := v.Marshal()
+	_, err = msgServer.ExecuteGovernanceVAA(sdk.WrapSDKContext(ctx), &types.MsgExecuteGovernanceVAA{
+		Signer: signer.String(),
+		Vaa:    vBz,
+	})
+	assert.ErrorIs(t, err, types.ErrNoQuorum)
+
+	v = generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), gov_msg.MarshalBinary())
+	vBz, _ = v.Marshal()
+	_, err = msgServer.ExecuteGovernanceVAA(sdk.WrapSDKContext(ctx), &types.MsgExecuteGovernanceVAA{
+		Signer: signer.String(),
+		Vaa:    vBz,
+	})
+	require.NoError(t, err)
+
+	// Replay
+	_, err = msgServer.ExecuteGovernanceVAA(sdk.WrapSDKContext(ctx), &types.MsgExecuteGovernanceVAA{
+		Signer: signer.String(),
+		Vaa:    vBz,
+	})
+	assert.ErrorIs(t, err, types.ErrGovernanceVaaAlreadyExecuted)
+
+	k.EmitGuardianSetMetrics(ctx)
+
+	res, err := metrics.Gather(telemetry.FormatPrometheus)
+	require.NoError(t, err)
+	output := string(res.Metrics)
+	assert.Contains(t, output, `wormchain_wormhole_governance_vaa_executed{action="17",module="core"} 1`)
+	assert.Contains(t, output, `wormchain_wormhole_quorum_failures 1`)
+	assert.Contains(t, output, `wormchain_wormhole_vaa_rejected{reason="no_quorum"} 1`)
+	assert.Contains(t, output, `wormchain_wormhole_vaa_rejected{reason="already_executed"} 1`)
+	// The replay is rejected before its signatures are verified
+	assert.Contains(t, output, `wormchain_wormhole_signature_verifications 10`)
+	assert.Contains(t, output, `wormchain_wormhole_guardian_set_index 0`)
+	assert.Contains(t, output, `wormchain_wormhole_consensus_guardian_set_index 0`)
+}
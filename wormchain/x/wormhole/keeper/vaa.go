@@ -2,9 +2,9 @@ package keeper
 
 import (
 	"bytes"
-	"encoding/binary"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
@@ -12,7 +12,7 @@ import (
 func ParseVAA(data []byte) (*vaa.VAA, error) {
 	v, err := vaa.Unmarshal(data)
 	if err != nil {
-		return nil, err
+		return nil, sdkerrors.Wrap(types.ErrMalformedVAA, err.Error())
 	}
 
 	return v, nil
@@ -23,7 +23,7 @@ func ParseVAA(data []byte) (*vaa.VAA, error) {
 // The canonical source is the calculation in the contracts (solana/bridge/src/processor.rs and
 // ethereum/contracts/Wormhole.sol), and this needs to match the implementation in the contracts.
 func CalculateQuorum(numGuardians int) int {
-	return (numGuardians*2)/3 + 1
+	return vaa.CalculateQuorum(numGuardians)
 }
 
 // Calculate Quorum retrieves the guardian set for the given index, verifies that it is a valid set, and then calculates the needed quorum.
@@ -53,14 +53,34 @@ func (k Keeper) CalculateQuorum(ctx sdk.Context, guardianSetIndex uint32) (int,
 		}
 	} else {
 		// new
-		latestGuardianSetIndex := k.GetLatestGuardianSetIndex(ctx)
-
-		if guardianSet.Index != latestGuardianSetIndex && guardianSet.ExpirationTime < uint64(ctx.BlockTime().Unix()) {
-			return 0, nil, types.ErrGuardianSetExpired
+		// A superseded guardian set is accepted for the configured
+		// GuardianSetExpiration after it was replaced.
+		if !k.IsGuardianSetValid(ctx, guardianSet) {
+			return 0, nil, sdkerrors.Wrapf(types.ErrGuardianSetExpired, "guardian set %d expired at %d", guardianSet.Index, guardianSet.ExpirationTime)
 		}
 	}
 
-	return CalculateQuorum(len(guardianSet.Keys)), &guardianSet, nil
+	// VAAs are signed by a number of guardians, the guardian weights only apply to observations
+	quorum := k.QuorumConfig(ctx).Scheme().Quorum(len(guardianSet.Keys))
+	return int(quorum), &guardianSet, nil
+}
+
+// checkSignerIndexes checks that the signer indexes are within the guardian set
+// and strictly increasing, so that the signatures can't fail verification for
+// a reason that is cheap to detect. It returns ErrGuardianIndexOutOfBounds or
+// ErrInvalidSignerIndexes.
+func checkSignerIndexes(signatures []*vaa.Signature, numGuardians int) error {
+	lastIndex := -1
+	for _, sig := range signatures {
+		if int(sig.Index) >= numGuardians {
+			return sdkerrors.Wrapf(types.ErrGuardianIndexOutOfBounds, "signer index %d, guardian set size %d", sig.Index, numGuardians)
+		}
+		if int(sig.Index) <= lastIndex {
+			return sdkerrors.Wrapf(types.ErrInvalidSignerIndexes, "signer index %d after %d", sig.Index, lastIndex)
+		}
+		lastIndex = int(sig.Index)
+	}
+	return nil
 }
 
 func (k Keeper) VerifyMessageSignature(ctx sdk.Context, prefix []byte, data []byte, guardianSetIndex uint32, signature *vaa.Signature) error {
@@ -71,14 +91,15 @@ func (k Keeper) VerifyMessageSignature(ctx sdk.Context, prefix []byte, data []by
 	}
 
 	// verify signature
+	k.consumeSignatureVerificationGas(ctx, 1)
 	addresses := guardianSet.KeysAsAddresses()
-	if int(signature.Index) >= len(addresses) {
-		return types.ErrGuardianIndexOutOfBounds
+	if err := checkSignerIndexes([]*vaa.Signature{signature}, len(addresses)); err != nil {
+		return err
 	}
 
 	ok := vaa.VerifyMessageSignature(prefix, data, signature, addresses[signature.Index])
 	if !ok {
-		return types.ErrSignaturesInvalid
+		return sdkerrors.Wrapf(types.ErrSignaturesInvalid, "invalid signature of guardian %d", signature.Index)
 	}
 
 	return nil
@@ -91,10 +112,15 @@ func (k Keeper) DeprecatedVerifyVaa(ctx sdk.Context, vaaBody []byte, guardianSet
 		return err
 	}
 	if len(signatures) < quorum {
-		return types.ErrNoQuorum
+		telemetryQuorumFailure()
+		return sdkerrors.Wrapf(types.ErrNoQuorum, "got %d signatures, need %d", len(signatures), quorum)
+	}
+	if err := checkSignerIndexes(signatures, len(guardianSet.Keys)); err != nil {
+		return err
 	}
 
 	// Verify signatures
+	k.consumeSignatureVerificationGas(ctx, len(signatures))
 	ok := vaa.DeprecatedVerifySignatures(vaaBody, signatures, guardianSet.KeysAsAddresses())
 	if !ok {
 		return types.ErrSignaturesInvalid
@@ -103,17 +129,28 @@ func (k Keeper) DeprecatedVerifyVaa(ctx sdk.Context, vaaBody []byte, guardianSet
 	return nil
 }
 
-func (k Keeper) VerifyVAA(ctx sdk.Context, v *vaa.VAA) error {
+func (k Keeper) VerifyVAA(ctx sdk.Context, v *vaa.VAA) (err error) {
+	defer func() {
+		if err != nil {
+			telemetryVAARejected(err)
+		}
+	}()
+
 	// Calculate quorum and retrieve guardian set
 	quorum, guardianSet, err := k.CalculateQuorum(ctx, v.GuardianSetIndex)
 	if err != nil {
 		return err
 	}
 	if len(v.Signatures) < quorum {
-		return types.ErrNoQuorum
+		telemetryQuorumFailure()
+		return sdkerrors.Wrapf(types.ErrNoQuorum, "got %d signatures, need %d", len(v.Signatures), quorum)
+	}
+	if err := checkSignerIndexes(v.Signatures, len(guardianSet.Keys)); err != nil {
+		return err
 	}
 
 	// Verify signatures
+	k.consumeSignatureVerificationGas(ctx, len(v.Signatures))
 	ok := v.VerifySignatures(guardianSet.KeysAsAddresses())
 	if !ok {
 		return types.ErrSignaturesInvalid
@@ -122,23 +159,67 @@ func (k Keeper) VerifyVAA(ctx sdk.Context, v *vaa.VAA) error {
 	return nil
 }
 
-// Verify a governance VAA:
-// - Check signatures
-// - Replay protection
-// - Check the source chain and address is governance
-// - Check the governance payload is for wormchain and the specified module
-// - return the parsed action and governance payload
-func (k Keeper) VerifyGovernanceVAA(ctx sdk.Context, v *vaa.VAA, module [32]byte) (action byte, payload []byte, err error) {
-	if err = k.VerifyVAA(ctx, v); err != nil {
-		return
+// verifyVAASignaturesMetered verifies the signatures of a VAA one at a time
+// against a gas budget. Before any signature is recovered, it checks the
+// quorum and the signer indexes, and that the gas meter can pay for every
+// signature, so VAAs that can never verify are rejected without running
+// ecrecover. The gas of all signatures is consumed up front. If a signature is
+// invalid, the gas of the signatures after it, which are never verified, is
+// refunded, so the gas used by a failed verification only depends on the VAA.
+func (k Keeper) verifyVAASignaturesMetered(ctx sdk.Context, v *vaa.VAA) error {
+	quorum, guardianSet, err := k.CalculateQuorum(ctx, v.GuardianSetIndex)
+	if err != nil {
+		return err
 	}
-	_, known := k.GetReplayProtection(ctx, v.HexDigest())
-	if known {
-		err = types.ErrVAAAlreadyExecuted
-		return
+	if len(v.Signatures) < quorum {
+		telemetryQuorumFailure()
+		return sdkerrors.Wrapf(types.ErrNoQuorum, "got %d signatures, need %d", len(v.Signatures), quorum)
+	}
+
+	addresses := guardianSet.KeysAsAddresses()
+	if err := checkSignerIndexes(v.Signatures, len(addresses)); err != nil {
+		return err
+	}
+
+	gasPerSignature := k.GetSignatureVerificationGas(ctx)
+	cost := gasPerSignature * uint64(len(v.Signatures))
+	if limit := ctx.GasMeter().Limit(); limit > 0 && limit-ctx.GasMeter().GasConsumedToLimit() < cost {
+		return sdkerrors.Wrapf(types.ErrInsufficientVerificationGas, "need %d gas for %d signatures, have %d", cost, len(v.Signatures), limit-ctx.GasMeter().GasConsumedToLimit())
+	}
+	k.consumeSignatureVerificationGas(ctx, len(v.Signatures))
+
+	digest := v.SigningDigest()
+	for i, sig := range v.Signatures {
+		if !vaa.VerifyDigestSignature(digest, sig, addresses[sig.Index]) {
+			ctx.GasMeter().RefundGas(gasPerSignature*uint64(len(v.Signatures)-i-1), "wormhole guardian signature verification refund")
+			return sdkerrors.Wrapf(types.ErrSignaturesInvalid, "invalid signature of guardian %d", sig.Index)
+		}
 	}
-	// Prevent replay
-	k.SetReplayProtection(ctx, types.ReplayProtection{Index: v.HexDigest()})
+
+	return nil
+}
+
+// Verify a governance VAA in three stages, so that spam is cheap to reject:
+//  1. Check the governance emitter, the governance header of the payload and
+//     replay protection. These only read the state.
+//  2. Check the signatures with verifyVAASignaturesMetered.
+//  3. Mark the VAA as executed and archive it.
+//
+// Return the parsed action and governance payload.
+//
+// Malformed headers are returned as ErrNoConfig, ErrInvalidGovernanceEmitter
+// for the wrong emitter chain or address, or one of the governance header
+// errors, replays as ErrGovernanceVaaAlreadyExecuted and signature failures as
+// ErrGuardianSetNotFound, ErrGuardianSetExpired, ErrNoQuorum,
+// ErrGuardianIndexOutOfBounds, ErrInvalidSignerIndexes,
+// ErrInsufficientVerificationGas or ErrSignaturesInvalid, each wrapped with
+// the details of the offending VAA.
+func (k Keeper) VerifyGovernanceVAA(ctx sdk.Context, v *vaa.VAA, module [32]byte) (action byte, payload []byte, err error) {
+	defer func() {
+		if err != nil {
+			telemetryVAARejected(err)
+		}
+	}()
 
 	config, ok := k.GetConfig(ctx)
 	if !ok {
@@ -147,33 +228,31 @@ func (k Keeper) VerifyGovernanceVAA(ctx sdk.Context, v *vaa.VAA, module [32]byte
 	}
 
 	if !bytes.Equal(v.EmitterAddress[:], config.GovernanceEmitter) {
-		err = types.ErrInvalidGovernanceEmitter
+		err = sdkerrors.Wrapf(types.ErrInvalidGovernanceEmitter, "expected emitter %x, got %s", config.GovernanceEmitter, v.EmitterAddress)
 		return
 	}
 	if v.EmitterChain != vaa.ChainID(config.GovernanceChain) {
-		err = types.ErrInvalidGovernanceEmitter
+		err = sdkerrors.Wrapf(types.ErrInvalidGovernanceEmitter, "expected emitter chain %d, got %d", config.GovernanceChain, v.EmitterChain)
 		return
 	}
-	if len(v.Payload) < 35 {
-		err = types.ErrGovernanceHeaderTooShort
+	action, payload, err = types.ParseGovernancePayload(v.Payload, module, uint16(config.ChainId))
+	if err != nil {
 		return
 	}
 
-	// Check governance header
-	if !bytes.Equal(v.Payload[:32], module[:]) {
-		err = types.ErrUnknownGovernanceModule
+	digest := v.SigningDigest()
+	if k.IsGovernanceVAAExecuted(ctx, digest.Bytes()) {
+		err = sdkerrors.Wrapf(types.ErrGovernanceVaaAlreadyExecuted, "governance VAA %s", v.HexDigest())
 		return
 	}
 
-	// Decode header
-	action = v.Payload[32]
-	chain := binary.BigEndian.Uint16(v.Payload[33:35])
-	payload = v.Payload[35:]
-
-	if chain != 0 && chain != uint16(config.ChainId) {
-		err = types.ErrInvalidGovernanceTargetChain
+	if err = k.verifyVAASignaturesMetered(ctx, v); err != nil {
+		err = sdkerrors.Wrapf(err, "governance VAA %s (guardian set %d)", v.HexDigest(), v.GuardianSetIndex)
 		return
 	}
 
+	// Prevent replay
+	k.SetExecutedGovernanceVAA(ctx, types.ExecutedGovernanceVAA{Digest: digest.Bytes(), Height: ctx.BlockHeight()})
+	k.ArchiveVAA(ctx, v)
 	return
 }
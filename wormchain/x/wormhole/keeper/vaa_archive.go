@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// MaxVAAArchivePrunePerBlock bounds the number of archived VAAs removed in a
+// single EndBlock, so shortening the retention window does not stall a block.
+// Anything left over is pruned in the following blocks.
+const MaxVAAArchivePrunePerBlock = 1000
+
+// ArchiveVAA records the metadata of a verified VAA in the VAA archive. It does
+// nothing while the archive is disabled. Every message that accepts a VAA
+// archives it: governance VAAs in VerifyGovernanceVAA and observations in
+// TallyObservation once they reach quorum.
+func (k Keeper) ArchiveVAA(ctx sdk.Context, v *vaa.VAA) {
+	if k.GetParams(ctx).VaaArchiveRetentionBlocks == 0 {
+		return
+	}
+
+	k.SetArchivedVAA(ctx, types.ArchivedVAA{
+		Digest:           v.SigningDigest().Bytes(),
+		EmitterChain:     uint32(v.EmitterChain),
+		EmitterAddress:   v.EmitterAddress.Bytes(),
+		Sequence:         v.Sequence,
+		GuardianSetIndex: v.GuardianSetIndex,
+		Timestamp:        uint32(v.Timestamp.Unix()),
+		Height:           ctx.BlockHeight(),
+	})
+}
+
+// SetArchivedVAA sets an archived VAA in the store, replacing any VAA archived
+// for the same emitter and sequence.
+func (k Keeper) SetArchivedVAA(ctx sdk.Context, archivedVAA types.ArchivedVAA) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ArchivedVAAKeyPrefix))
+	heightStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ArchivedVAAHeightKeyPrefix))
+	key := types.ArchivedVAAKey(uint16(archivedVAA.EmitterChain), archivedVAA.EmitterAddress, archivedVAA.Sequence)
+
+	if old, found := k.getArchivedVAA(store, key); found {
+		heightStore.Delete(types.ArchivedVAAHeightKey(old.Height, key))
+	}
+
+	b := k.cdc.MustMarshal(&archivedVAA)
+	store.Set(key, b)
+	heightStore.Set(types.ArchivedVAAHeightKey(archivedVAA.Height, key), []byte{})
+}
+
+// GetArchivedVAA returns the archived VAA of an emitter with the given sequence
+func (k Keeper) GetArchivedVAA(
+	ctx sdk.Context,
+	emitterChain uint16,
+	emitterAddress []byte,
+	sequence uint64,
+) (val types.ArchivedVAA, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ArchivedVAAKeyPrefix))
+	return k.getArchivedVAA(store, types.ArchivedVAAKey(emitterChain, emitterAddress, sequence))
+}
+
+func (k Keeper) getArchivedVAA(store prefix.Store, key []byte) (val types.ArchivedVAA, found bool) {
+	b := store.Get(key)
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllArchivedVAA returns all archived VAAs
+func (k Keeper) GetAllArchivedVAA(ctx sdk.Context) (list []types.ArchivedVAA) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ArchivedVAAKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ArchivedVAA
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// PruneVAAArchive removes the archived VAAs that were verified
+// vaa_archive_retention_blocks or more blocks ago. When the archive is disabled
+// all archived VAAs are removed. At most MaxVAAArchivePrunePerBlock VAAs are
+// removed per call.
+func (k Keeper) PruneVAAArchive(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ArchivedVAAKeyPrefix))
+	heightStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ArchivedVAAHeightKeyPrefix))
+
+	var end []byte
+	if retention := k.GetParams(ctx).VaaArchiveRetentionBlocks; retention != 0 {
+		cutoff := ctx.BlockHeight() - int64(retention)
+		if cutoff < 0 {
+			return
+		}
+		// prune everything verified at or below the cutoff height
+		end = binary.BigEndian.AppendUint64(nil, uint64(cutoff)+1)
+	}
+
+	iterator := heightStore.Iterator(nil, end)
+	var pruned [][]byte
+	for ; iterator.Valid() && len(pruned) < MaxVAAArchivePrunePerBlock; iterator.Next() {
+		pruned = append(pruned, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, heightKey := range pruned {
+		heightStore.Delete(heightKey)
+		store.Delete(heightKey[8:])
+	}
+}
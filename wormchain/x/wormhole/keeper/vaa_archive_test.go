@@ -0,0 +1,259 @@
+package keeper_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/wormhole-foundation/wormchain/testutil/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestExecuteGovernanceVAAArchiveRetentionUpdate(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 10)
+	k.SetConfig(ctx, types.Config{
+		GovernanceEmitter:     vaa.GovernanceEmitter[:],
+		GovernanceChain:       uint32(vaa.GovernanceChain),
+		ChainId:               uint32(vaa.ChainIDWormchain),
+		GuardianSetExpiration: 86400,
+	})
+	signer := sdk.AccAddress(make([]byte, 20))
+
+	set := createNewGuardianSet(k, ctx, guardians)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: set.Index})
+
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	execute := func(ctx sdk.Context, payload []byte) (vaa.VAA, error) {
+		module := [32]byte{}
+		copy(module[:], vaa.CoreModule)
+		gov_msg := types.NewGovernanceMessage(module, byte(vaa.ActionVAAArchiveRetentionUpdate), uint16(vaa.ChainIDWormchain), payload)
+
+		v := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), gov_msg.MarshalBinary())
+		vBz, _ := v.Marshal()
+		_, err := msgServer.ExecuteGovernanceVAA(sdk.WrapSDKContext(ctx), &types.MsgExecuteGovernanceVAA{
+			Signer: signer.String(),
+			Vaa:    vBz,
+		})
+		return v, err
+	}
+
+	_, err := execute(ctx, []byte{1})
+	assert.ErrorIs(t, err, types.ErrInvalidGovernancePayloadLength)
+
+	// The archive starts out disabled, so the VAA enabling it is not archived
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	_, err = execute(ctx, binary.BigEndian.AppendUint64(nil, 5))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), k.GetParams(ctx).VaaArchiveRetentionBlocks)
+	assert.Empty(t, k.GetAllArchivedVAA(ctx))
+
+	var event *types.EventVAAArchiveRetentionUpdate
+	for _, abciEvent := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(abciEvent)
+		if err != nil {
+			continue
+		}
+		if e, ok := msg.(*types.EventVAAArchiveRetentionUpdate); ok {
+			event = e
+		}
+	}
+	require.NotNil(t, event)
+	assert.Equal(t, types.EventVAAArchiveRetentionUpdate{OldRetentionBlocks: 0, NewRetentionBlocks: 5}, *event)
+
+	v, err := execute(ctx, binary.BigEndian.AppendUint64(nil, 5))
+	require.NoError(t, err)
+	archived, found := k.GetArchivedVAA(ctx, uint16(v.EmitterChain), v.EmitterAddress.Bytes(), v.Sequence)
+	require.True(t, found)
+	assert.Equal(t, types.ArchivedVAA{
+		Digest:           v.SigningDigest().Bytes(),
+		EmitterChain:     uint32(v.EmitterChain),
+		EmitterAddress:   v.EmitterAddress.Bytes(),
+		Sequence:         v.Sequence,
+		GuardianSetIndex: set.Index,
+		Timestamp:        uint32(v.Timestamp.Unix()),
+		Height:           10,
+	}, archived)
+
+	// VAAs that fail verification are not archived
+	module := [32]byte{}
+	copy(module[:], vaa.CoreModule)
+	gov_msg := types.NewGovernanceMessage(module, byte(vaa.ActionVAAArchiveRetentionUpdate), uint16(vaa.ChainIDWormchain), binary.BigEndian.AppendUint64(nil, 5))
+	v = generateVaa(set.Index, privateKeys[:6], vaa.ChainID(vaa.GovernanceChain), gov_msg.MarshalBinary())
+	vBz, _ := v.Marshal()
+	_, err = msgServer.ExecuteGovernanceVAA(sdk.WrapSDKContext(ctx), &types.MsgExecuteGovernanceVAA{
+		Signer: signer.String(),
+		Vaa:    vBz,
+	})
+	require.ErrorIs(t, err, types.ErrNoQuorum)
+	assert.Len(t, k.GetAllArchivedVAA(ctx), 1)
+
+	// Disabling the archive clears it
+	_, err = execute(ctx, binary.BigEndian.AppendUint64(nil, 0))
+	require.NoError(t, err)
+	k.PruneVAAArchive(ctx)
+	assert.Empty(t, k.GetAllArchivedVAA(ctx))
+}
+
+func TestSubmitObservationArchive(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 10)
+	set := createNewGuardianSet(k, ctx, guardians)
+	k.SetParams(ctx, types.Params{VaaArchiveRetentionBlocks: 10})
+	signer := sdk.AccAddress(make([]byte, 20))
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	observation := generateVaa(set.Index, nil, vaa.ChainIDEthereum, []byte{1, 2, 3})
+	submit := func(guardianIndices ...int) *types.MsgSubmitObservationResponse {
+		v := observation
+		v.Signatures = nil
+		for _, i := range guardianIndices {
+			v.AddSignature(privateKeys[i], uint8(i))
+		}
+		vBz, err := v.Marshal()
+		require.NoError(t, err)
+		res, err := msgServer.SubmitObservation(sdk.WrapSDKContext(ctx), &types.MsgSubmitObservation{
+			Signer: signer.String(),
+			Vaa:    vBz,
+		})
+		require.NoError(t, err)
+		return res
+	}
+
+	// Observations are archived once they reach quorum
+	ctx = ctx.WithBlockHeight(3)
+	assert.False(t, submit(0, 1, 2, 3, 4, 5).Finalized)
+	assert.Empty(t, k.GetAllArchivedVAA(ctx))
+
+	assert.True(t, submit(6).Finalized)
+	archived, found := k.GetArchivedVAA(ctx, uint16(observation.EmitterChain), observation.EmitterAddress.Bytes(), observation.Sequence)
+	require.True(t, found)
+	assert.Equal(t, types.ArchivedVAA{
+		Digest:           observation.SigningDigest().Bytes(),
+		EmitterChain:     uint32(observation.EmitterChain),
+		EmitterAddress:   observation.EmitterAddress.Bytes(),
+		Sequence:         observation.Sequence,
+		GuardianSetIndex: set.Index,
+		Timestamp:        uint32(observation.Timestamp.Unix()),
+		Height:           3,
+	}, archived)
+}
+
+func TestPruneVAAArchive(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	k.SetParams(ctx, types.Params{VaaArchiveRetentionBlocks: 10})
+
+	emitter := vaa.Address{1}
+	for height := int64(1); height <= 5; height++ {
+		k.ArchiveVAA(ctx.WithBlockHeight(height), &vaa.VAA{
+			EmitterChain:   vaa.ChainIDEthereum,
+			EmitterAddress: emitter,
+			Sequence:       uint64(height),
+		})
+	}
+	// Re-archiving a VAA moves it to the new height
+	k.ArchiveVAA(ctx.WithBlockHeight(9), &vaa.VAA{
+		EmitterChain:   vaa.ChainIDEthereum,
+		EmitterAddress: emitter,
+		Sequence:       1,
+	})
+
+	sequences := func() (list []uint64) {
+		for _, archived := range k.GetAllArchivedVAA(ctx) {
+			list = append(list, archived.Sequence)
+		}
+		return
+	}
+
+	k.PruneVAAArchive(ctx.WithBlockHeight(10))
+	assert.Equal(t, []uint64{1, 2, 3, 4, 5}, sequences())
+
+	// Everything verified at or before height 13 - 10 is pruned
+	k.PruneVAAArchive(ctx.WithBlockHeight(13))
+	assert.Equal(t, []uint64{1, 4, 5}, sequences())
+
+	k.PruneVAAArchive(ctx.WithBlockHeight(19))
+	assert.Empty(t, sequences())
+}
+
+func TestArchivedVAAQuery(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	k.SetParams(ctx, types.Params{VaaArchiveRetentionBlocks: 10})
+
+	emitterA := vaa.Address{1}
+	emitterB := vaa.Address{2}
+	for sequence := uint64(0); sequence < 5; sequence++ {
+		k.ArchiveVAA(ctx, &vaa.VAA{EmitterChain: vaa.ChainIDEthereum, EmitterAddress: emitterA, Sequence: sequence})
+		k.ArchiveVAA(ctx, &vaa.VAA{EmitterChain: vaa.ChainIDEthereum, EmitterAddress: emitterB, Sequence: sequence})
+		k.ArchiveVAA(ctx, &vaa.VAA{EmitterChain: vaa.ChainIDSolana, EmitterAddress: emitterA, Sequence: sequence})
+	}
+
+	sequences := func(res *types.QueryAllArchivedVAAResponse) (list []uint64) {
+		for _, archived := range res.ArchivedVaa {
+			list = append(list, archived.Sequence)
+		}
+		return
+	}
+
+	res, err := k.ArchivedVAAAll(wctx, &types.QueryAllArchivedVAARequest{})
+	require.NoError(t, err)
+	assert.Len(t, res.ArchivedVaa, 15)
+
+	res, err = k.ArchivedVAAAll(wctx, &types.QueryAllArchivedVAARequest{EmitterChain: uint32(vaa.ChainIDEthereum)})
+	require.NoError(t, err)
+	assert.Len(t, res.ArchivedVaa, 10)
+
+	res, err = k.ArchivedVAAAll(wctx, &types.QueryAllArchivedVAARequest{
+		EmitterChain:   uint32(vaa.ChainIDEthereum),
+		EmitterAddress: emitterB.Bytes(),
+		SequenceStart:  1,
+		SequenceEnd:    3,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1, 2, 3}, sequences(res))
+	for _, archived := range res.ArchivedVaa {
+		assert.Equal(t, emitterB.Bytes(), archived.EmitterAddress)
+	}
+
+	// Open ended ranges are paginated in sequence order
+	res, err = k.ArchivedVAAAll(wctx, &types.QueryAllArchivedVAARequest{
+		EmitterChain:   uint32(vaa.ChainIDSolana),
+		EmitterAddress: emitterA.Bytes(),
+		SequenceStart:  2,
+		Pagination:     &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{2, 3}, sequences(res))
+	assert.Equal(t, uint64(3), res.Pagination.Total)
+
+	res, err = k.ArchivedVAAAll(wctx, &types.QueryAllArchivedVAARequest{
+		EmitterChain:   uint32(vaa.ChainIDSolana),
+		EmitterAddress: emitterA.Bytes(),
+		SequenceStart:  2,
+		Pagination:     &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{4}, sequences(res))
+
+	for _, req := range []*types.QueryAllArchivedVAARequest{
+		nil,
+		{EmitterChain: 1 << 16},
+		{EmitterAddress: emitterA.Bytes()},
+		{EmitterChain: uint32(vaa.ChainIDEthereum), EmitterAddress: []byte{1}},
+		{EmitterChain: uint32(vaa.ChainIDEthereum), SequenceStart: 1},
+		{EmitterChain: uint32(vaa.ChainIDEthereum), EmitterAddress: emitterA.Bytes(), SequenceStart: 3, SequenceEnd: 2},
+	} {
+		_, err = k.ArchivedVAAAll(wctx, req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
@@ -16,6 +17,7 @@ import (
 	"github.com/wormhole-foundation/wormchain/x/wormhole/keeper"
 	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa/vaatest"
 )
 
 func TestCalculateQuorum(t *testing.T) {
@@ -92,8 +94,7 @@ func TestKeeperCalculateQuorum(t *testing.T) {
 			quorum, _, err := keeper.CalculateQuorum(ctx, tc.guardianSetIndex)
 
 			if tc.willError == true {
-				assert.NotNil(t, err)
-				assert.Equal(t, err, tc.err)
+				assert.ErrorIs(t, err, tc.err)
 			} else {
 				assert.Nil(t, err)
 				assert.Equal(t, quorum, tc.quorum)
@@ -172,8 +173,7 @@ func TestVerifyMessageSignature(t *testing.T) {
 			err := keeper.VerifyMessageSignature(ctx, prefix[:], payload, tc.guardianSet.Index, signature)
 
 			if tc.willError == true {
-				assert.NotNil(t, err)
-				assert.Equal(t, err, tc.err)
+				assert.ErrorIs(t, err, tc.err)
 			} else {
 				assert.NoError(t, err)
 			}
@@ -219,8 +219,7 @@ func TestVerifyVaaSignature(t *testing.T) {
 			setSigIndex: true,
 			sigIndex:    1,
 			willError:   true,
-			// this out of bounds issue will trigger invalid signature from sdk.
-			err: types.ErrSignaturesInvalid},
+			err:         types.ErrGuardianIndexOutOfBounds},
 		{label: "InvalidSigner",
 			guardianSet: types.GuardianSet{Index: 0, Keys: addrsBytes, ExpirationTime: 0},
 			signer:      privKey2,
@@ -238,14 +237,13 @@ func TestVerifyVaaSignature(t *testing.T) {
 			if tc.setSigIndex {
 				signature.Index = tc.sigIndex
 			}
-			v.Signatures = append(v.Signatures, signature)
+			v.Signatures = []*vaa.Signature{signature}
 
 			// verify the signature
 			err := keeper.VerifyVAA(ctx, v)
 
 			if tc.willError == true {
-				assert.NotNil(t, err)
-				assert.Equal(t, err, tc.err)
+				assert.ErrorIs(t, err, tc.err)
 			} else {
 				assert.NoError(t, err)
 			}
@@ -253,35 +251,23 @@ func TestVerifyVaaSignature(t *testing.T) {
 	}
 }
 
-var lastestSequence = 1
+// governanceEmitter numbers the VAAs of all keeper tests. Test VAAs are always emitted by the governance emitter on
+// solana, whatever emitter chain the caller asks for.
+var governanceEmitter = vaatest.NewEmitter(vaa.ChainIDSolana, vaa.GovernanceEmitter)
 
 func signVaa(vaaToSign vaa.VAA, signers []*ecdsa.PrivateKey) vaa.VAA {
-	for i, key := range signers {
-		vaaToSign.AddSignature(key, uint8(i))
-	}
+	signatures := vaaToSign.Signatures
+	vaatest.SignVAA(&vaaToSign, signers)
+	vaaToSign.Signatures = append(signatures, vaaToSign.Signatures...)
 	return vaaToSign
 }
 func generateVaa(index uint32, signers []*ecdsa.PrivateKey, emitterChain vaa.ChainID, payload []byte) vaa.VAA {
-	v := vaa.VAA{
-		Version:          uint8(1),
-		GuardianSetIndex: index,
-		Signatures:       nil,
-		Timestamp:        time.Unix(0, 0),
-		Nonce:            uint32(1),
-		Sequence:         uint64(lastestSequence),
-		ConsistencyLevel: uint8(32),
-		EmitterChain:     vaa.ChainIDSolana,
-		EmitterAddress:   vaa.Address(vaa.GovernanceEmitter),
-		Payload:          payload,
-	}
-	lastestSequence = lastestSequence + 1
-	return signVaa(v, signers)
+	v := governanceEmitter.Next(payload)
+	(&vaatest.GuardianSet{Index: index, Keys: signers}).Sign(v)
+	return *v
 }
 func resignVaa(v vaa.VAA, signers []*ecdsa.PrivateKey) vaa.VAA {
-	v.Signatures = []*vaa.Signature{}
-	for i, key := range signers {
-		v.AddSignature(key, uint8(i))
-	}
+	vaatest.SignVAA(&v, signers)
 	return v
 }
 
@@ -392,14 +378,23 @@ func TestVerifyVAAGovernance(t *testing.T) {
 
 	// verifying a second time will return error because of replay protection
 	_, _, err = keeper.VerifyGovernanceVAA(ctx, &v, our_module)
-	assert.ErrorIs(t, err, types.ErrVAAAlreadyExecuted)
+	assert.ErrorIs(t, err, types.ErrGovernanceVaaAlreadyExecuted)
+	executed, found := keeper.GetExecutedGovernanceVAA(ctx, v.SigningDigest().Bytes())
+	assert.True(t, found)
+	assert.Equal(t, ctx.BlockHeight(), executed.Height)
+
+	// governance VAAs recorded in the legacy replay protection store are rejected as well
+	legacy := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), payload)
+	keeper.SetReplayProtection(ctx, types.ReplayProtection{Index: legacy.HexDigest()})
+	_, _, err = keeper.VerifyGovernanceVAA(ctx, &legacy, our_module)
+	assert.ErrorIs(t, err, types.ErrGovernanceVaaAlreadyExecuted)
 
 	// Expect error if module-id is different
 	v = generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), payload)
 	bad_module := [32]byte{}
 	bad_module[31] = 0xff
 	_, _, err = keeper.VerifyGovernanceVAA(ctx, &v, bad_module)
-	assert.ErrorIs(t, err, types.ErrUnknownGovernanceModule)
+	assert.ErrorIs(t, err, types.ErrInvalidGovernanceModule)
 
 	// Expect error if we're not using the right governance emitter address
 	v = generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), payload)
@@ -427,3 +422,183 @@ func TestVerifyVAAGovernance(t *testing.T) {
 	_, _, err = keeper.VerifyGovernanceVAA(ctx, &v, our_module)
 	assert.ErrorIs(t, err, types.ErrInvalidGovernanceTargetChain)
 }
+
+func TestVerifyGovernanceVAAErrors(t *testing.T) {
+	keeper, ctx := keepertest.WormholeKeeper(t)
+	keeper.SetConfig(ctx, types.Config{
+		GovernanceEmitter:     vaa.GovernanceEmitter[:],
+		GovernanceChain:       uint32(vaa.GovernanceChain),
+		ChainId:               uint32(vaa.ChainIDWormchain),
+		GuardianSetExpiration: 86400,
+	})
+
+	// guardian set 0 expired an hour ago, guardian set 1 is the latest
+	expiredGuardians, expiredPrivateKeys := createNGuardianValidator(keeper, ctx, 4)
+	expiredSet := types.GuardianSet{
+		Index:          0,
+		ExpirationTime: uint64(ctx.BlockTime().Add(-time.Hour).Unix()),
+	}
+	for _, guardian := range expiredGuardians {
+		expiredSet.Keys = append(expiredSet.Keys, guardian.GuardianKey)
+	}
+	_, err := keeper.AppendGuardianSet(ctx, expiredSet)
+	require.NoError(t, err)
+	guardians, privateKeys := createNGuardianValidator(keeper, ctx, 4)
+	set := createNewGuardianSet(keeper, ctx, guardians)
+
+	module := [32]byte{}
+	copy(module[:], vaa.CoreModule)
+	govMsg := types.NewGovernanceMessage(module, byte(vaa.ActionGuardianSetUpdate), uint16(vaa.ChainIDWormchain), []byte{})
+	payload := govMsg.MarshalBinary()
+
+	// too few signatures
+	v := generateVaa(set.Index, privateKeys[:2], vaa.ChainID(vaa.GovernanceChain), payload)
+	_, _, err = keeper.VerifyGovernanceVAA(ctx, &v, module)
+	assert.ErrorIs(t, err, types.ErrNoQuorum)
+
+	// signed by an expired guardian set
+	v = generateVaa(expiredSet.Index, expiredPrivateKeys, vaa.ChainID(vaa.GovernanceChain), payload)
+	_, _, err = keeper.VerifyGovernanceVAA(ctx, &v, module)
+	assert.ErrorIs(t, err, types.ErrGuardianSetExpired)
+
+	// mismatched emitter
+	v = generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), payload)
+	v.EmitterAddress = vaa.Address{0xff}
+	v = resignVaa(v, privateKeys)
+	_, _, err = keeper.VerifyGovernanceVAA(ctx, &v, module)
+	assert.ErrorIs(t, err, types.ErrInvalidGovernanceEmitter)
+}
+
+func TestVerifyVAAGuardianSetExpiration(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	replacedAt := time.Unix(1_700_000_000, 0)
+	ctx = ctx.WithBlockTime(replacedAt)
+	k.SetConfig(ctx, types.Config{
+		GovernanceEmitter:     vaa.GovernanceEmitter[:],
+		GovernanceChain:       uint32(vaa.GovernanceChain),
+		ChainId:               uint32(vaa.ChainIDWormchain),
+		GuardianSetExpiration: 3600,
+	})
+	oldGuardians, oldPrivateKeys := createNGuardianValidator(k, ctx, 4)
+	oldSet := createNewGuardianSet(k, ctx, oldGuardians)
+	k.SetConsensusGuardianSetIndex(ctx, types.ConsensusGuardianSetIndex{Index: oldSet.Index})
+
+	newGuardians, newPrivateKeys := createNGuardianValidator(k, ctx, 4)
+	newSet := types.GuardianSet{Index: oldSet.Index + 1}
+	for _, guardian := range newGuardians {
+		newSet.Keys = append(newSet.Keys, guardian.GuardianKey)
+	}
+	require.NoError(t, k.UpdateGuardianSet(ctx, newSet))
+
+	// The replaced set expires GuardianSetExpiration seconds after the update
+	expiration := uint64(replacedAt.Unix()) + 3600
+	replaced, found := k.GetGuardianSet(ctx, oldSet.Index)
+	require.True(t, found)
+	assert.Equal(t, expiration, replaced.ExpirationTime)
+
+	oldVaa := generateVaa(oldSet.Index, oldPrivateKeys, vaa.ChainID(vaa.GovernanceChain), []byte{1})
+	newVaa := generateVaa(newSet.Index, newPrivateKeys, vaa.ChainID(vaa.GovernanceChain), []byte{1})
+
+	// Accepted up to and including the expiration time
+	inWindow := ctx.WithBlockTime(time.Unix(int64(expiration), 0))
+	assert.NoError(t, k.VerifyVAA(inWindow, &oldVaa))
+
+	// Rejected afterwards, while the latest set never expires
+	expired := ctx.WithBlockTime(time.Unix(int64(expiration)+1, 0))
+	err := k.VerifyVAA(expired, &oldVaa)
+	assert.ErrorIs(t, err, types.ErrGuardianSetExpired)
+	assert.ErrorContains(t, err, fmt.Sprintf("guardian set %d expired at %d", oldSet.Index, expiration))
+	assert.NoError(t, k.VerifyVAA(expired, &newVaa))
+
+	// The history query reports the expiry of each set
+	res, err := k.GuardianSetHistory(sdk.WrapSDKContext(expired), &types.QueryGuardianSetHistoryRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.GuardianSets, 2)
+	assert.Equal(t, expiration, res.GuardianSets[0].ExpirationTime)
+	assert.False(t, res.GuardianSets[0].Valid)
+	assert.Equal(t, uint64(0), res.GuardianSets[1].ExpirationTime)
+	assert.True(t, res.GuardianSets[1].Valid)
+}
+
+func TestVerifyGovernanceVAAGas(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	k.SetConfig(ctx, types.Config{
+		GovernanceEmitter:     vaa.GovernanceEmitter[:],
+		GovernanceChain:       uint32(vaa.GovernanceChain),
+		ChainId:               uint32(vaa.ChainIDWormchain),
+		GuardianSetExpiration: 86400,
+	})
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 4)
+	set := createNewGuardianSet(k, ctx, guardians)
+
+	// The signature gas dwarfs the gas of the store reads
+	const gasPerSignature = 1_000_000
+	k.SetParams(ctx, types.Params{SignatureVerificationGas: gasPerSignature})
+
+	module := [32]byte{}
+	copy(module[:], vaa.CoreModule)
+	govMsg := types.NewGovernanceMessage(module, byte(vaa.ActionGuardianSetUpdate), uint16(vaa.ChainIDWormchain), []byte{})
+	payload := govMsg.MarshalBinary()
+
+	verify := func(v vaa.VAA, gasLimit uint64) (uint64, error) {
+		meteredCtx := ctx.WithGasMeter(sdk.NewGasMeter(gasLimit))
+		_, _, err := k.VerifyGovernanceVAA(meteredCtx, &v, module)
+		return meteredCtx.GasMeter().GasConsumed(), err
+	}
+
+	_, err := keeper.ParseVAA([]byte{1, 2, 3})
+	assert.ErrorIs(t, err, types.ErrMalformedVAA)
+
+	// Malformed governance headers are rejected before any signature is verified
+	v := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), payload[:34])
+	gas, err := verify(v, 10*gasPerSignature)
+	assert.ErrorIs(t, err, types.ErrGovernanceHeaderTooShort)
+	assert.Less(t, gas, uint64(gasPerSignature))
+
+	// So are signatures that are out of order
+	v = generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), payload)
+	v.Signatures[0], v.Signatures[1] = v.Signatures[1], v.Signatures[0]
+	gas, err = verify(v, 10*gasPerSignature)
+	assert.ErrorIs(t, err, types.ErrInvalidSignerIndexes)
+	assert.Less(t, gas, uint64(gasPerSignature))
+
+	// and VAAs whose signatures the transaction can not pay for
+	v = generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), payload)
+	gas, err = verify(v, 3*gasPerSignature)
+	assert.ErrorIs(t, err, types.ErrInsufficientVerificationGas)
+	assert.Less(t, gas, uint64(gasPerSignature))
+
+	// Only the gas of the signatures up to the first invalid one is kept
+	v.Signatures[1].Signature[0] ^= 0xff
+	gas, err = verify(v, 10*gasPerSignature)
+	assert.ErrorIs(t, err, types.ErrSignaturesInvalid)
+	assert.GreaterOrEqual(t, gas, uint64(2*gasPerSignature))
+	assert.Less(t, gas, uint64(3*gasPerSignature))
+	_, found := k.GetExecutedGovernanceVAA(ctx, v.SigningDigest().Bytes())
+	assert.False(t, found)
+
+	v = generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), payload)
+	gas, err = verify(v, 10*gasPerSignature)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, gas, uint64(4*gasPerSignature))
+}
+
+func TestVerifyVAAGas(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	guardians, privateKeys := createNGuardianValidator(k, ctx, 7)
+	set := createNewGuardianSet(k, ctx, guardians)
+	v := generateVaa(set.Index, privateKeys, vaa.ChainID(vaa.GovernanceChain), []byte{1})
+
+	gasUsed := func(gasPerSignature uint64) uint64 {
+		k.SetParams(ctx, types.Params{SignatureVerificationGas: gasPerSignature})
+		meteredCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		require.NoError(t, k.VerifyVAA(meteredCtx, &v))
+		return meteredCtx.GasMeter().GasConsumed()
+	}
+
+	// Only the per signature cost changes between the two runs
+	assert.Equal(t, uint64(len(v.Signatures))*1000, gasUsed(2000)-gasUsed(1000))
+
+	// Unset params fall back to the default
+	assert.Equal(t, keeper.DefaultSignatureVerificationGas, k.GetSignatureVerificationGas(ctx.WithGasMeter(sdk.NewInfiniteGasMeter())))
+}
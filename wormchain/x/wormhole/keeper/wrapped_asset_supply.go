@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+const (
+	// translatorTokenBridgeKey is the key of the item in which the ibc
+	// translator contract stores the address of the token bridge contract.
+	translatorTokenBridgeKey = "token_bridge_contract"
+	// translatorDenomsNamespace is the namespace of the map in which the ibc
+	// translator contract stores the tokenfactory denom of each cw20 contract.
+	translatorDenomsNamespace = "cw_denoms"
+)
+
+// GetWrappedAssetDenom returns the tokenfactory denom of the wrapped asset of
+// a token from another chain, and the cw20 contract of the token bridge it is
+// bridged from. The denoms are created by the ibc translator contract, which is
+// the ibc composability mw contract.
+func (k Keeper) GetWrappedAssetDenom(ctx sdk.Context, chain vaa.ChainID, address [32]byte) (string, sdk.AccAddress, error) {
+	if !k.setWasmView {
+		return "", nil, sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "x/wasmd not set")
+	}
+	translator, err := sdk.AccAddressFromBech32(k.GetIbcComposabilityMwContract(ctx).ContractAddress)
+	if err != nil {
+		return "", nil, sdkerrors.Wrap(sdkerrors.ErrNotFound, "ibc composability mw contract not set")
+	}
+
+	var tokenBridge string
+	if err := k.queryRawJSON(ctx, translator, []byte(translatorTokenBridgeKey), &tokenBridge); err != nil {
+		return "", nil, err
+	}
+	tokenBridgeAddr, err := sdk.AccAddressFromBech32(tokenBridge)
+	if err != nil {
+		return "", nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "token bridge contract %q stored by contract %s", tokenBridge, translator)
+	}
+
+	query := map[string]interface{}{
+		"wrapped_registry": map[string]interface{}{
+			"chain":   uint16(chain),
+			"address": address[:],
+		},
+	}
+	queryBz, err := json.Marshal(query)
+	if err != nil {
+		return "", nil, err
+	}
+	resBz, err := k.wasmViewKeeper.QuerySmart(ctx, tokenBridgeAddr, queryBz)
+	if err != nil {
+		return "", nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no wrapped asset for token %x of chain %d: %v", address, chain, err)
+	}
+	var res struct {
+		Address string `json:"address"`
+	}
+	if err := json.Unmarshal(resBz, &res); err != nil {
+		return "", nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	cw20, err := sdk.AccAddressFromBech32(res.Address)
+	if err != nil {
+		return "", nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "wrapped asset contract %q", res.Address)
+	}
+
+	// The denoms are stored in a cw-storage-plus map, whose keys are the
+	// length prefixed namespace followed by the cw20 address.
+	key := binary.BigEndian.AppendUint16(nil, uint16(len(translatorDenomsNamespace)))
+	key = append(key, translatorDenomsNamespace...)
+	key = append(key, res.Address...)
+
+	var denom string
+	if err := k.queryRawJSON(ctx, translator, key, &denom); err != nil {
+		return "", nil, err
+	}
+
+	return denom, cw20, nil
+}
+
+// GetWrappedAssetHolders returns the accounts with a positive balance of a
+// denom, ordered by address. This iterates all balances.
+func (k Keeper) GetWrappedAssetHolders(ctx sdk.Context, denom string) (list []types.WrappedAssetHolder) {
+	k.bankKeeper.IterateAllBalances(ctx, func(address sdk.AccAddress, coin sdk.Coin) bool {
+		if coin.Denom == denom && coin.Amount.IsPositive() {
+			list = append(list, types.WrappedAssetHolder{Address: address.String(), Amount: coin.Amount})
+		}
+		return false
+	})
+
+	return
+}
+
+// queryRawJSON decodes the JSON value stored by a contract under a raw key.
+func (k Keeper) queryRawJSON(ctx sdk.Context, contract sdk.AccAddress, key []byte, value interface{}) error {
+	bz := k.wasmViewKeeper.QueryRaw(ctx, contract, key)
+	if bz == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "key %q of contract %s", key, contract)
+	}
+	if err := json.Unmarshal(bz, value); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "key %q of contract %s: %v", key, contract, err)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package v3
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+// legacyGuardianSetIndexLen is the length of the big endian guardian set
+// index that v2 used as the guardian set key.
+const legacyGuardianSetIndexLen = 4
+
+// MigrateStore performs the in-place store migration from version 2 to 3. The
+// guardian sets are re-keyed from their big endian index to the length
+// prefixed key of types.GuardianSetIndexKey. Keys that are already length
+// prefixed are left untouched, so the migration can be rerun safely.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey) error {
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.GuardianSetKey))
+
+	// collect the legacy entries first, since the store cannot be written
+	// while it is iterated
+	var legacyKeys, values [][]byte
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		if len(iterator.Key()) != legacyGuardianSetIndexLen {
+			continue
+		}
+		legacyKeys = append(legacyKeys, iterator.Key())
+		values = append(values, iterator.Value())
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for i, legacyKey := range legacyKeys {
+		index := binary.BigEndian.Uint32(legacyKey)
+		store.Set(types.GuardianSetIndexKey(index), values[i])
+		store.Delete(legacyKey)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	v3 "github.com/wormhole-foundation/wormchain/x/wormhole/migrations/v3"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.GuardianSetKey))
+
+	// v2 keyed the guardian sets by their big endian index
+	store.Set([]byte{0, 0, 0, 0}, []byte("set 0"))
+	store.Set([]byte{0, 0, 1, 2}, []byte("set 258"))
+	// a key that is already length prefixed is left untouched
+	store.Set(types.GuardianSetIndexKey(5), []byte("set 5"))
+
+	require.NoError(t, v3.MigrateStore(ctx, storeKey))
+
+	var keys [][]byte
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	require.NoError(t, iterator.Close())
+	require.Equal(t, [][]byte{
+		types.GuardianSetIndexKey(0),
+		types.GuardianSetIndexKey(5),
+		types.GuardianSetIndexKey(258),
+	}, keys)
+
+	require.Equal(t, []byte("set 0"), store.Get(types.GuardianSetIndexKey(0)))
+	require.Equal(t, []byte("set 258"), store.Get(types.GuardianSetIndexKey(258)))
+	require.Equal(t, []byte("set 5"), store.Get(types.GuardianSetIndexKey(5)))
+
+	// the migration can be rerun
+	require.NoError(t, v3.MigrateStore(ctx, storeKey))
+	require.Equal(t, []byte("set 258"), store.Get(types.GuardianSetIndexKey(258)))
+}
@@ -98,13 +98,17 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 type AppModule struct {
 	AppModuleBasic
 
-	keeper keeper.Keeper
+	keeper        keeper.Keeper
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
 }
 
-func NewAppModule(cdc codec.Codec, keeper keeper.Keeper) AppModule {
+func NewAppModule(cdc codec.Codec, keeper keeper.Keeper, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper) AppModule {
 	return AppModule{
 		AppModuleBasic: NewAppModuleBasic(cdc),
 		keeper:         keeper,
+		accountKeeper:  accountKeeper,
+		bankKeeper:     bankKeeper,
 	}
 }
 
@@ -130,10 +134,17 @@ func (am AppModule) LegacyQuerierHandler(legacyQuerierCdc *codec.LegacyAmino) sd
 // module-specific GRPC queries.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers the capability module's invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // InitGenesis performs the capability module's genesis initialization It returns
 // no validator updates.
@@ -154,13 +165,35 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 2 }
+func (AppModule) ConsensusVersion() uint64 { return 3 }
 
 // BeginBlock executes all ABCI BeginBlock logic respective to the capability module.
 func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
 // EndBlock executes all ABCI EndBlock logic respective to the capability module. It
 // returns no validator updates.
-func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.runEndBlockStep(ctx, "activate scheduled guardian set", am.keeper.ActivateScheduledGuardianSet)
+	am.runEndBlockStep(ctx, "reconcile guardian validators", am.keeper.ReconcileGuardianValidators)
+	am.keeper.PruneVAAArchive(ctx)
+	am.keeper.PruneRateLimitFlows(ctx)
+	am.keeper.PruneGuardianHeartbeats(ctx)
+	am.runEndBlockStep(ctx, "prune expired allowlist entries", am.keeper.PruneExpiredAllowlistEntries)
+	am.runEndBlockStep(ctx, "release queued observations", am.keeper.ReleaseQueuedObservations)
+	am.keeper.PruneObservationTallies(ctx)
+	am.keeper.EmitGuardianSetMetrics(ctx)
 	return []abci.ValidatorUpdate{}
 }
+
+// runEndBlockStep runs a step of EndBlock on a branch of the state, which is
+// only written back, along with the events of the step, if the step succeeds.
+// A failing step is logged and leaves no partial writes behind.
+func (am AppModule) runEndBlockStep(ctx sdk.Context, name string, step func(ctx sdk.Context) error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := step(cacheCtx); err != nil {
+		am.keeper.Logger(ctx).Error("failed to "+name, "error", err)
+		return
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
@@ -3,44 +3,30 @@ package wormhole
 import (
 	"math/rand"
 
-	"github.com/cosmos/cosmos-sdk/baseapp"
-	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
-	"github.com/wormhole-foundation/wormchain/testutil/sample"
 	wormholesimulation "github.com/wormhole-foundation/wormchain/x/wormhole/simulation"
-	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
-)
-
-// avoid unused import issue
-var (
-	_ = sample.AccAddress
-	_ = wormholesimulation.FindAccount
-	_ = simappparams.StakePerAccount
-	_ = simulation.MsgEntryKind
-	_ = baseapp.Paramspace
 )
 
 const (
-	opWeightMsgRegisterAccountAsGuardian = "op_weight_msg_create_chain"
+	opWeightMsgRegisterAccountAsGuardian = "op_weight_msg_register_account_as_guardian"
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgRegisterAccountAsGuardian int = 100
 
+	opWeightMsgExecuteGovernanceVAA          = "op_weight_msg_execute_governance_vaa"
+	defaultWeightMsgExecuteGovernanceVAA int = 20
+
+	opWeightMsgSubmitObservation          = "op_weight_msg_submit_observation"
+	defaultWeightMsgSubmitObservation int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
 // GenerateGenesisState creates a randomized GenState of the module
 func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
-	accs := make([]string, len(simState.Accounts))
-	for i, acc := range simState.Accounts {
-		accs[i] = acc.Address.String()
-	}
-	wormholeGenesis := types.GenesisState{
-		// this line is used by starport scaffolding # simapp/module/genesisState
-	}
-	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&wormholeGenesis)
+	wormholesimulation.RandomizedGenState(simState)
 }
 
 // ProposalContents doesn't return any content functions for governance proposals
@@ -69,7 +55,29 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 	)
 	operations = append(operations, simulation.NewWeightedOperation(
 		weightMsgRegisterAccountAsGuardian,
-		wormholesimulation.SimulateMsgRegisterAccountAsGuardian(am.keeper),
+		wormholesimulation.SimulateMsgRegisterAccountAsGuardian(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgExecuteGovernanceVAA int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgExecuteGovernanceVAA, &weightMsgExecuteGovernanceVAA, nil,
+		func(_ *rand.Rand) {
+			weightMsgExecuteGovernanceVAA = defaultWeightMsgExecuteGovernanceVAA
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgExecuteGovernanceVAA,
+		wormholesimulation.SimulateMsgExecuteGovernanceVAA(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgSubmitObservation int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgSubmitObservation, &weightMsgSubmitObservation, nil,
+		func(_ *rand.Rand) {
+			weightMsgSubmitObservation = defaultWeightMsgSubmitObservation
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSubmitObservation,
+		wormholesimulation.SimulateMsgSubmitObservation(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
 	// this line is used by starport scaffolding # simapp/module/operation
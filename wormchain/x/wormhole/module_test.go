@@ -0,0 +1,32 @@
+package wormhole_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	keepertest "github.com/wormhole-foundation/wormchain/testutil/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+)
+
+func TestEndBlockActivateScheduledGuardianSetFailure(t *testing.T) {
+	k, ctx := keepertest.WormholeKeeper(t)
+	am := wormhole.NewAppModule(nil, *k, nil, nil)
+
+	for i := uint32(0); i < 2; i++ {
+		_, err := k.AppendGuardianSet(ctx, types.GuardianSet{Index: i, Keys: [][]byte{{byte(i)}}})
+		require.NoError(t, err)
+	}
+	activation := types.GuardianSetActivation{Index: 1, ActivationHeight: 1}
+	k.SetGuardianSetActivation(ctx, activation)
+
+	// Without a consensus guardian set the switch fails after the schedule
+	// was removed, which must not be committed
+	am.EndBlock(ctx.WithBlockHeight(1), abci.RequestEndBlock{})
+	stored, found := k.GetGuardianSetActivation(ctx)
+	require.True(t, found)
+	require.Equal(t, activation, stored)
+	require.Empty(t, ctx.EventManager().Events())
+}
@@ -0,0 +1,79 @@
+package simulation
+
+import (
+	"encoding/binary"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// randomGovernanceAction returns a random core governance action and its
+// payload. Only actions that leave the other simulated messages executable
+// are generated, e.g. no guardian set updates or message shutdowns.
+func randomGovernanceAction(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (vaa.GovernanceAction, []byte) {
+	switch r.Intn(2) {
+	case 0:
+		if k.IsBridgePaused(ctx) {
+			return vaa.ActionResumeBridge, nil
+		}
+		return vaa.ActionPauseBridge, nil
+	default:
+		// [uint16 chain][uint64 limit][uint64 window blocks], a zero limit
+		// removes the rate limit of the chain
+		payload := binary.BigEndian.AppendUint16(nil, uint16(1+r.Intn(30)))
+		payload = binary.BigEndian.AppendUint64(payload, uint64(r.Intn(10)))
+		payload = binary.BigEndian.AppendUint64(payload, uint64(1+r.Intn(20)))
+		return vaa.ActionChainRateLimitUpdate, payload
+	}
+}
+
+// SimulateMsgExecuteGovernanceVAA executes a random core governance action,
+// signed by all guardians of the latest guardian set.
+func SimulateMsgExecuteGovernanceVAA(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := (&types.MsgExecuteGovernanceVAA{}).Type()
+
+		config, found := k.GetConfig(ctx)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no config"), nil, nil
+		}
+		guardianSet, keys, ok := latestGuardianSetKeys(ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "guardian set is not simulated"), nil, nil
+		}
+
+		var module [32]byte
+		copy(module[:], vaa.CoreModule)
+		action, payload := randomGovernanceAction(r, ctx, k)
+		govMsg := types.NewGovernanceMessage(module, byte(action), uint16(config.ChainId), payload)
+
+		signers := make([]int, len(keys))
+		for i := range signers {
+			signers[i] = i
+		}
+		var governanceEmitter vaa.Address
+		copy(governanceEmitter[:], config.GovernanceEmitter)
+		vBz, err := randomVAA(r, ctx, guardianSet, keys, signers, vaa.ChainID(config.GovernanceChain), governanceEmitter, govMsg.MarshalBinary())
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to marshal VAA"), nil, err
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgExecuteGovernanceVAA{
+			Signer: simAccount.Address.String(),
+			Vaa:    vBz,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(operationInput(r, app, ctx, msg, simAccount, ak, bk))
+	}
+}
@@ -0,0 +1,117 @@
+package simulation
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// MaxGuardians is the number of deterministic guardian keys available to the
+// simulation.
+const MaxGuardians = 19
+
+// guardianKeys are the private keys of the simulated guardians. They are
+// derived deterministically, so that operations can sign the VAAs of the
+// guardian sets generated at genesis.
+var guardianKeys = func() (keys []*ecdsa.PrivateKey) {
+	for i := 0; i < MaxGuardians; i++ {
+		key, err := crypto.ToECDSA(crypto.Keccak256([]byte(fmt.Sprintf("wormchain simulation guardian %d", i))))
+		if err != nil {
+			panic(err)
+		}
+		keys = append(keys, key)
+	}
+	return
+}()
+
+// GuardianKey returns the private key of a simulated guardian, or nil if the
+// address is not one of the simulated guardians.
+func GuardianKey(address common.Address) *ecdsa.PrivateKey {
+	for _, key := range guardianKeys {
+		if crypto.PubkeyToAddress(key.PublicKey) == address {
+			return key
+		}
+	}
+	return nil
+}
+
+func guardianAddresses(n int) (addresses [][]byte) {
+	for _, key := range guardianKeys[:n] {
+		addresses = append(addresses, crypto.PubkeyToAddress(key.PublicKey).Bytes())
+	}
+	return
+}
+
+// RandomizedGenState generates a random genesis state of the module.
+//
+// Guardian set 0 is the consensus set, each of its guardians is registered to
+// one of the bonded simulation validators. If there are enough validators, a
+// larger guardian set 1 extends set 0 with guardians that have yet to register
+// a validator, so that the switch to a new consensus set is simulated too. All
+// other accounts are allowlisted, as the ante handler rejects transactions of
+// accounts that are neither validators nor allowlisted.
+func RandomizedGenState(simState *module.SimulationState) {
+	numBonded := int(simState.NumBonded)
+	if numBonded > len(simState.Accounts) {
+		numBonded = len(simState.Accounts)
+	}
+	if numBonded > MaxGuardians {
+		numBonded = MaxGuardians
+	}
+	if numBonded < 1 {
+		panic("wormhole simulation requires at least one bonded validator")
+	}
+
+	guardianSetExpiration := uint64(86400 + simState.Rand.Intn(86400))
+	consensusSize := 1 + simState.Rand.Intn(numBonded)
+	guardianSets := []types.GuardianSet{
+		{
+			Index: 0,
+			Keys:  guardianAddresses(consensusSize),
+		},
+	}
+	if consensusSize < MaxGuardians && simState.Rand.Intn(2) == 0 {
+		// a replaced guardian set expires
+		guardianSets[0].ExpirationTime = uint64(simState.GenTimestamp.Unix()) + guardianSetExpiration
+		guardianSets = append(guardianSets, types.GuardianSet{
+			Index: 1,
+			Keys:  guardianAddresses(consensusSize + 1 + simState.Rand.Intn(MaxGuardians-consensusSize)),
+		})
+	}
+
+	var guardianValidators []types.GuardianValidator
+	for i, key := range guardianSets[0].Keys {
+		guardianValidators = append(guardianValidators, types.GuardianValidator{
+			GuardianKey:   key,
+			ValidatorAddr: simState.Accounts[i].Address,
+		})
+	}
+
+	var allowedAddresses []types.ValidatorAllowedAddress
+	for i, acc := range simState.Accounts[consensusSize:] {
+		allowedAddresses = append(allowedAddresses, types.ValidatorAllowedAddress{
+			ValidatorAddress: simState.Accounts[0].Address.String(),
+			AllowedAddress:   acc.Address.String(),
+			Name:             fmt.Sprintf("simulation account %d", i),
+		})
+	}
+
+	wormholeGenesis := types.DefaultGenesis()
+	wormholeGenesis.GuardianSetList = guardianSets
+	wormholeGenesis.Config = &types.Config{
+		GuardianSetExpiration: guardianSetExpiration,
+		GovernanceEmitter:     vaa.GovernanceEmitter[:],
+		GovernanceChain:       uint32(vaa.GovernanceChain),
+		ChainId:               uint32(vaa.ChainIDWormchain),
+	}
+	wormholeGenesis.ConsensusGuardianSetIndex = &types.ConsensusGuardianSetIndex{Index: 0}
+	wormholeGenesis.GuardianValidatorList = guardianValidators
+	wormholeGenesis.AllowedAddresses = allowedAddresses
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(wormholeGenesis)
+}
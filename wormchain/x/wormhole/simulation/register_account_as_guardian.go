@@ -6,22 +6,72 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/wormhole-foundation/wormchain/x/wormhole/keeper"
 	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	wormholesdk "github.com/wormhole-foundation/wormhole/sdk"
 )
 
+// SimulateMsgRegisterAccountAsGuardian registers a random account as the
+// validator of a guardian of the latest guardian set that has not registered
+// one yet. Registration is only simulated while a new guardian set is being
+// onboarded, since hot-swapping the validator of a single guardian set would
+// take away the voting power of the only simulated validator.
 func SimulateMsgRegisterAccountAsGuardian(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
 	k keeper.Keeper,
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgRegisterAccountAsGuardian{
-			Signer: simAccount.Address.String(),
+		msgType := (&types.MsgRegisterAccountAsGuardian{}).Type()
+
+		consensusIndex, found := k.GetConsensusGuardianSetIndex(ctx)
+		if !found || consensusIndex.Index == k.GetLatestGuardianSetIndex(ctx) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no guardian set to onboard"), nil, nil
+		}
+
+		guardianSet, keys, ok := latestGuardianSetKeys(ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "guardian set is not simulated"), nil, nil
+		}
+
+		var unregistered []int
+		for i, key := range guardianSet.Keys {
+			if _, found := k.GetGuardianValidator(ctx, key); !found {
+				unregistered = append(unregistered, i)
+			}
+		}
+		if len(unregistered) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "all guardians are registered"), nil, nil
 		}
+		guardianKey := keys[unregistered[r.Intn(len(unregistered))]]
 
-		// TODO: Handling the RegisterAccountAsGuardian simulation
+		registered := make(map[string]bool)
+		for _, gv := range k.GetAllGuardianValidator(ctx) {
+			registered[sdk.AccAddress(gv.ValidatorAddr).String()] = true
+		}
+		var candidates []simtypes.Account
+		for _, acc := range accs {
+			if !registered[acc.Address.String()] {
+				candidates = append(candidates, acc)
+			}
+		}
+		if len(candidates) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "all accounts are registered"), nil, nil
+		}
+		simAccount := candidates[r.Intn(len(candidates))]
 
-		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "RegisterAccountAsGuardian simulation not implemented"), nil, nil
+		signature, err := crypto.Sign(wormholesdk.SignedWormchainRegistrationDigest(ctx.ChainID(), simAccount.Address).Bytes(), guardianKey)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to sign validator address"), nil, err
+		}
+
+		msg := &types.MsgRegisterAccountAsGuardian{
+			Signer:    simAccount.Address.String(),
+			Signature: signature,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(operationInput(r, app, ctx, msg, simAccount, ak, bk))
 	}
 }
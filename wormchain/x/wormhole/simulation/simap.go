@@ -1,8 +1,18 @@
 package simulation
 
 import (
+	"crypto/ecdsa"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
 
 // FindAccount find a specific address from an account list
@@ -13,3 +23,80 @@ func FindAccount(accs []simtypes.Account, address string) (simtypes.Account, boo
 	}
 	return simtypes.FindAccount(accs, creator)
 }
+
+// operationInput builds the input to deliver a message signed by a simulation
+// account with random fees
+func operationInput(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	msg interface {
+		sdk.Msg
+		Type() string
+	},
+	simAccount simtypes.Account,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+) simulation.OperationInput {
+	return simulation.OperationInput{
+		R:             r,
+		App:           app,
+		TxGen:         simappparams.MakeTestEncodingConfig().TxConfig,
+		Msg:           msg,
+		MsgType:       msg.Type(),
+		Context:       ctx,
+		SimAccount:    simAccount,
+		AccountKeeper: ak,
+		Bankkeeper:    bk,
+		ModuleName:    types.ModuleName,
+	}
+}
+
+// latestGuardianSetKeys returns the latest guardian set and the private keys
+// of its guardians. It returns false if the set is not made of simulated
+// guardians.
+func latestGuardianSetKeys(ctx sdk.Context, k keeper.Keeper) (types.GuardianSet, []*ecdsa.PrivateKey, bool) {
+	guardianSet, found := k.GetGuardianSet(ctx, k.GetLatestGuardianSetIndex(ctx))
+	if !found {
+		return guardianSet, nil, false
+	}
+
+	var keys []*ecdsa.PrivateKey
+	for _, address := range guardianSet.Keys {
+		key := GuardianKey(common.BytesToAddress(address))
+		if key == nil {
+			return guardianSet, nil, false
+		}
+		keys = append(keys, key)
+	}
+	return guardianSet, keys, true
+}
+
+// randomVAA builds a VAA with a random nonce and sequence, signed by the
+// guardians of a guardian set whose indices are given.
+func randomVAA(
+	r *rand.Rand,
+	ctx sdk.Context,
+	guardianSet types.GuardianSet,
+	keys []*ecdsa.PrivateKey,
+	signers []int,
+	emitterChain vaa.ChainID,
+	emitterAddress vaa.Address,
+	payload []byte,
+) ([]byte, error) {
+	v := vaa.VAA{
+		Version:          vaa.SupportedVAAVersion,
+		GuardianSetIndex: guardianSet.Index,
+		Timestamp:        ctx.BlockTime(),
+		Nonce:            r.Uint32(),
+		Sequence:         r.Uint64(),
+		ConsistencyLevel: 32,
+		EmitterChain:     emitterChain,
+		EmitterAddress:   emitterAddress,
+		Payload:          payload,
+	}
+	for _, i := range signers {
+		v.AddSignature(keys[i], uint8(i))
+	}
+	return v.Marshal()
+}
@@ -0,0 +1,59 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/keeper"
+	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// SimulateMsgSubmitObservation submits an observation of a random message,
+// signed by a random subset of the guardians of the latest guardian set, so
+// that it is either left pending, finalized or queued by a rate limit.
+func SimulateMsgSubmitObservation(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := (&types.MsgSubmitObservation{}).Type()
+
+		guardianSet, keys, ok := latestGuardianSetKeys(ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "guardian set is not simulated"), nil, nil
+		}
+
+		// signatures have to be ordered by guardian index
+		var signers []int
+		for i := range keys {
+			if r.Intn(2) == 0 {
+				signers = append(signers, i)
+			}
+		}
+		if len(signers) == 0 {
+			signers = append(signers, r.Intn(len(keys)))
+		}
+
+		var emitterAddress vaa.Address
+		r.Read(emitterAddress[:])
+		payload := make([]byte, 1+r.Intn(64))
+		r.Read(payload)
+		vBz, err := randomVAA(r, ctx, guardianSet, keys, signers, vaa.ChainID(1+r.Intn(30)), emitterAddress, payload)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to marshal VAA"), nil, err
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgSubmitObservation{
+			Signer: simAccount.Address.String(),
+			Vaa:    vBz,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(operationInput(r, app, ctx, msg, simAccount, ak, bk))
+	}
+}
@@ -0,0 +1,1867 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: wormhole/accountant.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AccountantAccountKey identifies an account of the global accountant
+// contract: the balance of a token on a chain.
+type AccountantAccountKey struct {
+	// chain the account belongs to
+	ChainId uint32 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// native chain of the token
+	TokenChain uint32 `protobuf:"varint,2,opt,name=token_chain,json=tokenChain,proto3" json:"token_chain,omitempty"`
+	// address of the token on its native chain
+	TokenAddress []byte `protobuf:"bytes,3,opt,name=token_address,json=tokenAddress,proto3" json:"token_address,omitempty"`
+}
+
+func (m *AccountantAccountKey) Reset()         { *m = AccountantAccountKey{} }
+func (m *AccountantAccountKey) String() string { return proto.CompactTextString(m) }
+func (*AccountantAccountKey) ProtoMessage()    {}
+func (*AccountantAccountKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4090bb560839b8d, []int{0}
+}
+func (m *AccountantAccountKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountantAccountKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountantAccountKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountantAccountKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountantAccountKey.Merge(m, src)
+}
+func (m *AccountantAccountKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountantAccountKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountantAccountKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountantAccountKey proto.InternalMessageInfo
+
+func (m *AccountantAccountKey) GetChainId() uint32 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *AccountantAccountKey) GetTokenChain() uint32 {
+	if m != nil {
+		return m.TokenChain
+	}
+	return 0
+}
+
+func (m *AccountantAccountKey) GetTokenAddress() []byte {
+	if m != nil {
+		return m.TokenAddress
+	}
+	return nil
+}
+
+// AccountantAccount is an account of the global accountant contract.
+type AccountantAccount struct {
+	Key     AccountantAccountKey                   `protobuf:"bytes,1,opt,name=key,proto3" json:"key"`
+	Balance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"balance"`
+}
+
+func (m *AccountantAccount) Reset()         { *m = AccountantAccount{} }
+func (m *AccountantAccount) String() string { return proto.CompactTextString(m) }
+func (*AccountantAccount) ProtoMessage()    {}
+func (*AccountantAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4090bb560839b8d, []int{1}
+}
+func (m *AccountantAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountantAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountantAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountantAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountantAccount.Merge(m, src)
+}
+func (m *AccountantAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountantAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountantAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountantAccount proto.InternalMessageInfo
+
+func (m *AccountantAccount) GetKey() AccountantAccountKey {
+	if m != nil {
+		return m.Key
+	}
+	return AccountantAccountKey{}
+}
+
+// AccountantTransferKey identifies a transfer observed by the global
+// accountant contract.
+type AccountantTransferKey struct {
+	EmitterChain   uint32 `protobuf:"varint,1,opt,name=emitter_chain,json=emitterChain,proto3" json:"emitter_chain,omitempty"`
+	EmitterAddress []byte `protobuf:"bytes,2,opt,name=emitter_address,json=emitterAddress,proto3" json:"emitter_address,omitempty"`
+	Sequence       uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *AccountantTransferKey) Reset()         { *m = AccountantTransferKey{} }
+func (m *AccountantTransferKey) String() string { return proto.CompactTextString(m) }
+func (*AccountantTransferKey) ProtoMessage()    {}
+func (*AccountantTransferKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4090bb560839b8d, []int{2}
+}
+func (m *AccountantTransferKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountantTransferKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountantTransferKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountantTransferKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountantTransferKey.Merge(m, src)
+}
+func (m *AccountantTransferKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountantTransferKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountantTransferKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountantTransferKey proto.InternalMessageInfo
+
+func (m *AccountantTransferKey) GetEmitterChain() uint32 {
+	if m != nil {
+		return m.EmitterChain
+	}
+	return 0
+}
+
+func (m *AccountantTransferKey) GetEmitterAddress() []byte {
+	if m != nil {
+		return m.EmitterAddress
+	}
+	return nil
+}
+
+func (m *AccountantTransferKey) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// AccountantTransfer is a transfer committed by the global accountant
+// contract.
+type AccountantTransfer struct {
+	Key            AccountantTransferKey                  `protobuf:"bytes,1,opt,name=key,proto3" json:"key"`
+	Amount         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	TokenChain     uint32                                 `protobuf:"varint,3,opt,name=token_chain,json=tokenChain,proto3" json:"token_chain,omitempty"`
+	TokenAddress   []byte                                 `protobuf:"bytes,4,opt,name=token_address,json=tokenAddress,proto3" json:"token_address,omitempty"`
+	RecipientChain uint32                                 `protobuf:"varint,5,opt,name=recipient_chain,json=recipientChain,proto3" json:"recipient_chain,omitempty"`
+	// digest of the transfer's VAA
+	Digest []byte `protobuf:"bytes,6,opt,name=digest,proto3" json:"digest,omitempty"`
+}
+
+func (m *AccountantTransfer) Reset()         { *m = AccountantTransfer{} }
+func (m *AccountantTransfer) String() string { return proto.CompactTextString(m) }
+func (*AccountantTransfer) ProtoMessage()    {}
+func (*AccountantTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4090bb560839b8d, []int{3}
+}
+func (m *AccountantTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountantTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountantTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountantTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountantTransfer.Merge(m, src)
+}
+func (m *AccountantTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountantTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountantTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountantTransfer proto.InternalMessageInfo
+
+func (m *AccountantTransfer) GetKey() AccountantTransferKey {
+	if m != nil {
+		return m.Key
+	}
+	return AccountantTransferKey{}
+}
+
+func (m *AccountantTransfer) GetTokenChain() uint32 {
+	if m != nil {
+		return m.TokenChain
+	}
+	return 0
+}
+
+func (m *AccountantTransfer) GetTokenAddress() []byte {
+	if m != nil {
+		return m.TokenAddress
+	}
+	return nil
+}
+
+func (m *AccountantTransfer) GetRecipientChain() uint32 {
+	if m != nil {
+		return m.RecipientChain
+	}
+	return 0
+}
+
+func (m *AccountantTransfer) GetDigest() []byte {
+	if m != nil {
+		return m.Digest
+	}
+	return nil
+}
+
+// AccountantObservation is an observation of a transfer the global
+// accountant contract has not committed yet.
+type AccountantObservation struct {
+	// digest of the observed VAA
+	Digest []byte `protobuf:"bytes,1,opt,name=digest,proto3" json:"digest,omitempty"`
+	TxHash []byte `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// bitset of the guardians that signed the observation, as a decimal string
+	Signatures       string `protobuf:"bytes,3,opt,name=signatures,proto3" json:"signatures,omitempty"`
+	GuardianSetIndex uint32 `protobuf:"varint,4,opt,name=guardian_set_index,json=guardianSetIndex,proto3" json:"guardian_set_index,omitempty"`
+	EmitterChain     uint32 `protobuf:"varint,5,opt,name=emitter_chain,json=emitterChain,proto3" json:"emitter_chain,omitempty"`
+}
+
+func (m *AccountantObservation) Reset()         { *m = AccountantObservation{} }
+func (m *AccountantObservation) String() string { return proto.CompactTextString(m) }
+func (*AccountantObservation) ProtoMessage()    {}
+func (*AccountantObservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4090bb560839b8d, []int{4}
+}
+func (m *AccountantObservation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountantObservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountantObservation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountantObservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountantObservation.Merge(m, src)
+}
+func (m *AccountantObservation) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountantObservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountantObservation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountantObservation proto.InternalMessageInfo
+
+func (m *AccountantObservation) GetDigest() []byte {
+	if m != nil {
+		return m.Digest
+	}
+	return nil
+}
+
+func (m *AccountantObservation) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *AccountantObservation) GetSignatures() string {
+	if m != nil {
+		return m.Signatures
+	}
+	return ""
+}
+
+func (m *AccountantObservation) GetGuardianSetIndex() uint32 {
+	if m != nil {
+		return m.GuardianSetIndex
+	}
+	return 0
+}
+
+func (m *AccountantObservation) GetEmitterChain() uint32 {
+	if m != nil {
+		return m.EmitterChain
+	}
+	return 0
+}
+
+// AccountantPendingTransfer is a transfer the global accountant contract has
+// received observations of, but not enough to commit it.
+type AccountantPendingTransfer struct {
+	Key          AccountantTransferKey   `protobuf:"bytes,1,opt,name=key,proto3" json:"key"`
+	Observations []AccountantObservation `protobuf:"bytes,2,rep,name=observations,proto3" json:"observations"`
+}
+
+func (m *AccountantPendingTransfer) Reset()         { *m = AccountantPendingTransfer{} }
+func (m *AccountantPendingTransfer) String() string { return proto.CompactTextString(m) }
+func (*AccountantPendingTransfer) ProtoMessage()    {}
+func (*AccountantPendingTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4090bb560839b8d, []int{5}
+}
+func (m *AccountantPendingTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountantPendingTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountantPendingTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountantPendingTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountantPendingTransfer.Merge(m, src)
+}
+func (m *AccountantPendingTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountantPendingTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountantPendingTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountantPendingTransfer proto.InternalMessageInfo
+
+func (m *AccountantPendingTransfer) GetKey() AccountantTransferKey {
+	if m != nil {
+		return m.Key
+	}
+	return AccountantTransferKey{}
+}
+
+func (m *AccountantPendingTransfer) GetObservations() []AccountantObservation {
+	if m != nil {
+		return m.Observations
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AccountantAccountKey)(nil), "wormhole_foundation.wormchain.wormhole.AccountantAccountKey")
+	proto.RegisterType((*AccountantAccount)(nil), "wormhole_foundation.wormchain.wormhole.AccountantAccount")
+	proto.RegisterType((*AccountantTransferKey)(nil), "wormhole_foundation.wormchain.wormhole.AccountantTransferKey")
+	proto.RegisterType((*AccountantTransfer)(nil), "wormhole_foundation.wormchain.wormhole.AccountantTransfer")
+	proto.RegisterType((*AccountantObservation)(nil), "wormhole_foundation.wormchain.wormhole.AccountantObservation")
+	proto.RegisterType((*AccountantPendingTransfer)(nil), "wormhole_foundation.wormchain.wormhole.AccountantPendingTransfer")
+}
+
+func init() { proto.RegisterFile("wormhole/accountant.proto", fileDescriptor_c4090bb560839b8d) }
+
+var fileDescriptor_c4090bb560839b8d = []byte{
+	// 567 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x9b, 0x75, 0xeb, 0xb6, 0x6f, 0xed, 0x00, 0x6b, 0x40, 0xbb, 0x43, 0x3a, 0x65, 0xd2,
+	0xd8, 0x81, 0x25, 0x12, 0x9c, 0x90, 0xe0, 0xb0, 0x22, 0xa1, 0x55, 0x1c, 0x40, 0xd9, 0xb8, 0x70,
+	0x89, 0xdc, 0xc4, 0x4b, 0xac, 0xae, 0x76, 0x89, 0x1d, 0xd6, 0x1e, 0x79, 0x03, 0xde, 0x85, 0x1b,
+	0xbc, 0xc0, 0x8e, 0x3b, 0x22, 0x90, 0x26, 0xd4, 0xbe, 0x08, 0xb2, 0xe3, 0xb4, 0x19, 0x45, 0x02,
+	0x26, 0x71, 0x6a, 0xfc, 0xff, 0xfc, 0xfd, 0x3f, 0xfb, 0xe7, 0xbf, 0x0a, 0xad, 0x73, 0x9e, 0x0e,
+	0x12, 0x7e, 0x46, 0x3c, 0x1c, 0x86, 0x3c, 0x63, 0x12, 0x33, 0xe9, 0x0e, 0x53, 0x2e, 0x39, 0xda,
+	0x2b, 0x4a, 0xc1, 0x29, 0xcf, 0x58, 0x84, 0x25, 0xe5, 0xcc, 0x55, 0x5a, 0x98, 0x60, 0xca, 0xdc,
+	0xa2, 0xba, 0xbd, 0x15, 0xf3, 0x98, 0xeb, 0x16, 0x4f, 0x7d, 0xe5, 0xdd, 0xce, 0x39, 0x6c, 0x1d,
+	0xce, 0x1c, 0xcd, 0xd7, 0x4b, 0x32, 0x46, 0x2d, 0x58, 0xd3, 0xfd, 0x01, 0x8d, 0x9a, 0xd6, 0x8e,
+	0xb5, 0xdf, 0xf0, 0x57, 0xf5, 0xba, 0x1b, 0xa1, 0x36, 0x6c, 0x48, 0xde, 0x27, 0x2c, 0xd0, 0x42,
+	0x73, 0x49, 0x57, 0x41, 0x4b, 0xcf, 0x95, 0x82, 0x76, 0xa1, 0x91, 0x6f, 0xc0, 0x51, 0x94, 0x12,
+	0x21, 0x9a, 0xd5, 0x1d, 0x6b, 0xbf, 0xee, 0xd7, 0xb5, 0x78, 0x98, 0x6b, 0xce, 0x27, 0x0b, 0xee,
+	0x2c, 0x4c, 0x46, 0x27, 0x50, 0xed, 0x93, 0xb1, 0x9e, 0xb8, 0xf1, 0xe8, 0xa9, 0xfb, 0x77, 0x57,
+	0x73, 0x7f, 0x77, 0x83, 0xce, 0xf2, 0xc5, 0x55, 0xbb, 0xe2, 0x2b, 0x3b, 0x74, 0x04, 0xab, 0x3d,
+	0x7c, 0x86, 0x59, 0x48, 0xf4, 0x69, 0xd7, 0x3b, 0xae, 0xaa, 0x7d, 0xbb, 0x6a, 0xef, 0xc5, 0x54,
+	0x26, 0x59, 0xcf, 0x0d, 0xf9, 0xc0, 0x0b, 0xb9, 0x18, 0x70, 0x61, 0x7e, 0x0e, 0x44, 0xd4, 0xf7,
+	0xe4, 0x78, 0x48, 0x84, 0xdb, 0x65, 0xd2, 0x2f, 0xda, 0x9d, 0x0f, 0x16, 0xdc, 0x9d, 0x4f, 0x3b,
+	0x49, 0x31, 0x13, 0xa7, 0x24, 0x55, 0xc0, 0x76, 0xa1, 0x41, 0x06, 0x54, 0x4a, 0x92, 0x1a, 0x2e,
+	0x39, 0xb5, 0xba, 0x11, 0x73, 0x32, 0x0f, 0xe0, 0x56, 0xb1, 0xa9, 0x60, 0xb3, 0xa4, 0xd9, 0x6c,
+	0x1a, 0xd9, 0xd0, 0x41, 0xdb, 0xb0, 0x26, 0xc8, 0xbb, 0x8c, 0xa8, 0x23, 0x2b, 0x7a, 0xcb, 0xfe,
+	0x6c, 0xed, 0x7c, 0x5e, 0x02, 0xb4, 0x78, 0x06, 0xf4, 0xa6, 0x8c, 0xee, 0xd9, 0xbf, 0xa3, 0x2b,
+	0x5d, 0xa6, 0xcc, 0xee, 0x05, 0xd4, 0xf0, 0x40, 0x6d, 0xb9, 0x21, 0x3a, 0xd3, 0xfd, 0x6b, 0x6a,
+	0xaa, 0x7f, 0x4e, 0xcd, 0xf2, 0x62, 0x6a, 0x14, 0xc0, 0x94, 0x84, 0x74, 0x48, 0x09, 0x93, 0xc6,
+	0x69, 0x45, 0x3b, 0x6d, 0xce, 0xe4, 0xdc, 0xed, 0x1e, 0xd4, 0x22, 0x1a, 0x13, 0x21, 0x9b, 0x35,
+	0x6d, 0x63, 0x56, 0xce, 0x97, 0x6b, 0x0f, 0xf8, 0xaa, 0x27, 0x48, 0xfa, 0x5e, 0xc3, 0x29, 0x75,
+	0x58, 0xe5, 0x0e, 0x74, 0x1f, 0x56, 0xe5, 0x28, 0x48, 0xb0, 0x48, 0xcc, 0x5b, 0xd5, 0xe4, 0xe8,
+	0x08, 0x8b, 0x04, 0xd9, 0x00, 0x82, 0xc6, 0x0c, 0xcb, 0x2c, 0x25, 0x79, 0xc6, 0xd7, 0xfd, 0x92,
+	0x82, 0x1e, 0x02, 0x8a, 0x33, 0x9c, 0x46, 0x14, 0xb3, 0x40, 0x10, 0x19, 0x50, 0x16, 0x91, 0x91,
+	0xbe, 0x55, 0xc3, 0xbf, 0x5d, 0x54, 0x8e, 0x89, 0xec, 0x2a, 0x7d, 0x31, 0x3f, 0x2b, 0x8b, 0xf9,
+	0x71, 0xbe, 0x5b, 0xd0, 0x9a, 0x9f, 0xfe, 0x35, 0x61, 0x11, 0x65, 0xf1, 0xff, 0x4e, 0x40, 0x0c,
+	0x75, 0x3e, 0xe7, 0xa4, 0x12, 0x5b, 0xbd, 0x99, 0x7f, 0x89, 0xb6, 0xf1, 0xbf, 0x66, 0xdc, 0x39,
+	0xbe, 0x98, 0xd8, 0xd6, 0xe5, 0xc4, 0xb6, 0x7e, 0x4c, 0x6c, 0xeb, 0xe3, 0xd4, 0xae, 0x5c, 0x4e,
+	0xed, 0xca, 0xd7, 0xa9, 0x5d, 0x79, 0xfb, 0xa4, 0x14, 0xb6, 0xc2, 0xf8, 0x60, 0x3e, 0xd6, 0x9b,
+	0x8d, 0xf5, 0x46, 0xb3, 0x7a, 0x9e, 0xc1, 0x5e, 0x4d, 0xff, 0xcf, 0x3d, 0xfe, 0x39, 0x00, 0xdf,
+	0xe2, 0xc8, 0x2c, 0x42, 0x05, 0x00, 0x00,
+}
+
+func (m *AccountantAccountKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountantAccountKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountantAccountKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenAddress) > 0 {
+		i -= len(m.TokenAddress)
+		copy(dAtA[i:], m.TokenAddress)
+		i = encodeVarintAccountant(dAtA, i, uint64(len(m.TokenAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TokenChain != 0 {
+		i = encodeVarintAccountant(dAtA, i, uint64(m.TokenChain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintAccountant(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountantAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountantAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountantAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAccountant(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAccountant(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountantTransferKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountantTransferKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountantTransferKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintAccountant(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.EmitterAddress) > 0 {
+		i -= len(m.EmitterAddress)
+		copy(dAtA[i:], m.EmitterAddress)
+		i = encodeVarintAccountant(dAtA, i, uint64(len(m.EmitterAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EmitterChain != 0 {
+		i = encodeVarintAccountant(dAtA, i, uint64(m.EmitterChain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountantTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountantTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountantTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarintAccountant(dAtA, i, uint64(len(m.Digest)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.RecipientChain != 0 {
+		i = encodeVarintAccountant(dAtA, i, uint64(m.RecipientChain))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TokenAddress) > 0 {
+		i -= len(m.TokenAddress)
+		copy(dAtA[i:], m.TokenAddress)
+		i = encodeVarintAccountant(dAtA, i, uint64(len(m.TokenAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TokenChain != 0 {
+		i = encodeVarintAccountant(dAtA, i, uint64(m.TokenChain))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAccountant(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAccountant(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountantObservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountantObservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountantObservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EmitterChain != 0 {
+		i = encodeVarintAccountant(dAtA, i, uint64(m.EmitterChain))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GuardianSetIndex != 0 {
+		i = encodeVarintAccountant(dAtA, i, uint64(m.GuardianSetIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Signatures) > 0 {
+		i -= len(m.Signatures)
+		copy(dAtA[i:], m.Signatures)
+		i = encodeVarintAccountant(dAtA, i, uint64(len(m.Signatures)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintAccountant(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarintAccountant(dAtA, i, uint64(len(m.Digest)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountantPendingTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountantPendingTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountantPendingTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Observations) > 0 {
+		for iNdEx := len(m.Observations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Observations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAccountant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAccountant(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAccountant(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAccountant(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AccountantAccountKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovAccountant(uint64(m.ChainId))
+	}
+	if m.TokenChain != 0 {
+		n += 1 + sovAccountant(uint64(m.TokenChain))
+	}
+	l = len(m.TokenAddress)
+	if l > 0 {
+		n += 1 + l + sovAccountant(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountantAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Key.Size()
+	n += 1 + l + sovAccountant(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovAccountant(uint64(l))
+	return n
+}
+
+func (m *AccountantTransferKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EmitterChain != 0 {
+		n += 1 + sovAccountant(uint64(m.EmitterChain))
+	}
+	l = len(m.EmitterAddress)
+	if l > 0 {
+		n += 1 + l + sovAccountant(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovAccountant(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *AccountantTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Key.Size()
+	n += 1 + l + sovAccountant(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovAccountant(uint64(l))
+	if m.TokenChain != 0 {
+		n += 1 + sovAccountant(uint64(m.TokenChain))
+	}
+	l = len(m.TokenAddress)
+	if l > 0 {
+		n += 1 + l + sovAccountant(uint64(l))
+	}
+	if m.RecipientChain != 0 {
+		n += 1 + sovAccountant(uint64(m.RecipientChain))
+	}
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sovAccountant(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountantObservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sovAccountant(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovAccountant(uint64(l))
+	}
+	l = len(m.Signatures)
+	if l > 0 {
+		n += 1 + l + sovAccountant(uint64(l))
+	}
+	if m.GuardianSetIndex != 0 {
+		n += 1 + sovAccountant(uint64(m.GuardianSetIndex))
+	}
+	if m.EmitterChain != 0 {
+		n += 1 + sovAccountant(uint64(m.EmitterChain))
+	}
+	return n
+}
+
+func (m *AccountantPendingTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Key.Size()
+	n += 1 + l + sovAccountant(uint64(l))
+	if len(m.Observations) > 0 {
+		for _, e := range m.Observations {
+			l = e.Size()
+			n += 1 + l + sovAccountant(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAccountant(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAccountant(x uint64) (n int) {
+	return sovAccountant(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AccountantAccountKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccountant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountantAccountKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountantAccountKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenChain", wireType)
+			}
+			m.TokenChain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenChain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccountant
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenAddress = append(m.TokenAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.TokenAddress == nil {
+				m.TokenAddress = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccountant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccountant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountantAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccountant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountantAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountantAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccountant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccountant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccountant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccountant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountantTransferKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccountant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountantTransferKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountantTransferKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmitterChain", wireType)
+			}
+			m.EmitterChain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EmitterChain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmitterAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccountant
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmitterAddress = append(m.EmitterAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.EmitterAddress == nil {
+				m.EmitterAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccountant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccountant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountantTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccountant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountantTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountantTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccountant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccountant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenChain", wireType)
+			}
+			m.TokenChain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenChain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccountant
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenAddress = append(m.TokenAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.TokenAddress == nil {
+				m.TokenAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientChain", wireType)
+			}
+			m.RecipientChain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecipientChain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccountant
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = append(m.Digest[:0], dAtA[iNdEx:postIndex]...)
+			if m.Digest == nil {
+				m.Digest = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccountant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccountant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountantObservation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccountant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountantObservation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountantObservation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccountant
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = append(m.Digest[:0], dAtA[iNdEx:postIndex]...)
+			if m.Digest == nil {
+				m.Digest = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccountant
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccountant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianSetIndex", wireType)
+			}
+			m.GuardianSetIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GuardianSetIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmitterChain", wireType)
+			}
+			m.EmitterChain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EmitterChain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccountant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccountant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountantPendingTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccountant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountantPendingTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountantPendingTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccountant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Observations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccountant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Observations = append(m.Observations, AccountantObservation{})
+			if err := m.Observations[len(m.Observations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccountant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccountant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAccountant(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAccountant
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccountant
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccountant
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAccountant
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAccountant
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAccountant
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAccountant        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAccountant          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAccountant = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,7 @@
+package types
+
+// Expired returns whether the allowlist entry has expired at the given block
+// height. Entries without an expiration height never expire.
+func (a ValidatorAllowedAddress) Expired(height int64) bool {
+	return a.ExpirationHeight != 0 && height >= 0 && uint64(height) >= a.ExpirationHeight
+}
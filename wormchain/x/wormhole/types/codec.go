@@ -19,6 +19,12 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAddWasmInstantiateAllowlist{}, "wormhole/AddWasmInstantiateAllowlist", nil)
 	cdc.RegisterConcrete(&MsgDeleteWasmInstantiateAllowlist{}, "wormhole/DeleteWasmInstantiateAllowlist", nil)
 	cdc.RegisterConcrete(&MsgExecuteGatewayGovernanceVaa{}, "wormhole/ExecuteGatewayGovernanceVaa", nil)
+	cdc.RegisterConcrete(&MsgExecuteGovernanceVAABatch{}, "wormhole/ExecuteGovernanceVAABatch", nil)
+	cdc.RegisterConcrete(&MsgSubmitObservation{}, "wormhole/SubmitObservation", nil)
+	cdc.RegisterConcrete(&MsgGuardianHeartbeat{}, "wormhole/GuardianHeartbeat", nil)
+	cdc.RegisterConcrete(&MsgReserveSequenceBlock{}, "wormhole/ReserveSequenceBlock", nil)
+	cdc.RegisterConcrete(&MsgExecuteGatewayTransfer{}, "wormhole/ExecuteGatewayTransfer", nil)
+	cdc.RegisterConcrete(&MsgExecuteGatewayTransferWithPayload{}, "wormhole/ExecuteGatewayTransferWithPayload", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -31,6 +37,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCreateAllowlistEntryRequest{},
 		&MsgDeleteAllowlistEntryRequest{},
 		&MsgExecuteGatewayGovernanceVaa{},
+		&MsgExecuteGovernanceVAABatch{},
+		&MsgSubmitObservation{},
+		&MsgGuardianHeartbeat{},
+		&MsgReserveSequenceBlock{},
+		&MsgExecuteGatewayTransfer{},
+		&MsgExecuteGatewayTransferWithPayload{},
 	)
 	registry.RegisterImplementations((*gov.Content)(nil),
 		&GovernanceWormholeMessageProposal{},
@@ -66,8 +66,72 @@ func (m *ConsensusGuardianSetIndex) GetIndex() uint32 {
 	return 0
 }
 
+// GuardianSetActivation delays the switch of the consensus guardian set to the
+// guardian set at index until both activation_height and activation_time (unix
+// seconds) are reached. A zero value leaves that condition unconstrained.
+type GuardianSetActivation struct {
+	Index            uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	ActivationHeight uint64 `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	ActivationTime   uint64 `protobuf:"varint,3,opt,name=activation_time,json=activationTime,proto3" json:"activation_time,omitempty"`
+}
+
+func (m *GuardianSetActivation) Reset()         { *m = GuardianSetActivation{} }
+func (m *GuardianSetActivation) String() string { return proto.CompactTextString(m) }
+func (*GuardianSetActivation) ProtoMessage()    {}
+func (*GuardianSetActivation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18e45d0c16ad5fce, []int{1}
+}
+func (m *GuardianSetActivation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GuardianSetActivation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GuardianSetActivation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GuardianSetActivation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GuardianSetActivation.Merge(m, src)
+}
+func (m *GuardianSetActivation) XXX_Size() int {
+	return m.Size()
+}
+func (m *GuardianSetActivation) XXX_DiscardUnknown() {
+	xxx_messageInfo_GuardianSetActivation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GuardianSetActivation proto.InternalMessageInfo
+
+func (m *GuardianSetActivation) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *GuardianSetActivation) GetActivationHeight() uint64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *GuardianSetActivation) GetActivationTime() uint64 {
+	if m != nil {
+		return m.ActivationTime
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ConsensusGuardianSetIndex)(nil), "wormhole_foundation.wormchain.wormhole.ConsensusGuardianSetIndex")
+	proto.RegisterType((*GuardianSetActivation)(nil), "wormhole_foundation.wormchain.wormhole.GuardianSetActivation")
 }
 
 func init() {
@@ -75,19 +139,23 @@ func init() {
 }
 
 var fileDescriptor_18e45d0c16ad5fce = []byte{
-	// 190 bytes of a gzipped FileDescriptorProto
+	// 252 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x2e, 0xcf, 0x2f, 0xca,
 	0xcd, 0xc8, 0xcf, 0x49, 0xd5, 0x4f, 0xce, 0xcf, 0x2b, 0x4e, 0xcd, 0x2b, 0x2e, 0x2d, 0x8e, 0x4f,
 	0x2f, 0x4d, 0x2c, 0x4a, 0xc9, 0x4c, 0xcc, 0x8b, 0x2f, 0x4e, 0x2d, 0x89, 0xcf, 0xcc, 0x4b, 0x49,
 	0xad, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x52, 0x83, 0x29, 0x8e, 0x4f, 0xcb, 0x2f, 0xcd,
-	0x4b, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x03, 0x89, 0x25, 0x67, 0x24, 0x66, 0x42, 0x58, 0x20,
-	0x59, 0x25, 0x43, 0x2e, 0x49, 0x67, 0x98, 0x69, 0xee, 0x50, 0xc3, 0x82, 0x53, 0x4b, 0x3c, 0x41,
-	0x46, 0x09, 0x89, 0x70, 0xb1, 0x82, 0xcd, 0x94, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0d, 0x82, 0x70,
-	0x9c, 0x82, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09,
-	0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x32, 0x3d, 0xb3,
-	0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x66, 0x83, 0x2e, 0xc2, 0x7e, 0x7d, 0xb8,
-	0xfd, 0xfa, 0x15, 0x70, 0x79, 0xfd, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0xb3, 0x8d,
-	0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x90, 0x7c, 0x1c, 0x4e, 0xe5, 0x00, 0x00, 0x00,
+	0x4b, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x03, 0x89, 0x25, 0x67, 0x24, 0x66, 0xe6, 0xe9, 0xc1,
+	0x64, 0x95, 0x0c, 0xb9, 0x24, 0x9d, 0x61, 0xa6, 0xb9, 0x43, 0x0d, 0x0b, 0x4e, 0x2d, 0xf1, 0x04,
+	0x19, 0x25, 0x24, 0xc2, 0xc5, 0x0a, 0x36, 0x53, 0x82, 0x51, 0x81, 0x51, 0x83, 0x37, 0x08, 0xc2,
+	0x51, 0x6a, 0x66, 0xe4, 0x12, 0x45, 0x52, 0xea, 0x98, 0x5c, 0x92, 0x59, 0x06, 0x36, 0x1f, 0xbb,
+	0x7a, 0x21, 0x6d, 0x2e, 0xc1, 0x44, 0xb8, 0x9a, 0xf8, 0x8c, 0xd4, 0xcc, 0xf4, 0x8c, 0x12, 0x09,
+	0x26, 0x05, 0x46, 0x0d, 0x96, 0x20, 0x01, 0x84, 0x84, 0x07, 0x58, 0x5c, 0x48, 0x9d, 0x8b, 0x1f,
+	0x49, 0x71, 0x49, 0x66, 0x6e, 0xaa, 0x04, 0x33, 0x58, 0x29, 0x1f, 0x42, 0x38, 0x24, 0x33, 0x37,
+	0xd5, 0x29, 0xf8, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c,
+	0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x2c, 0xd3, 0x33,
+	0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x61, 0xfe, 0xd4, 0x45, 0x84, 0x82, 0x3e,
+	0x3c, 0x14, 0xf4, 0x2b, 0xe0, 0xf2, 0xfa, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0xc0,
+	0x33, 0x06, 0x0c, 0x00, 0xf1, 0xe4, 0x1f, 0xa3, 0x6b, 0x01, 0x00, 0x00,
 }
 
 func (m *ConsensusGuardianSetIndex) Marshal() (dAtA []byte, err error) {
@@ -118,6 +186,44 @@ func (m *ConsensusGuardianSetIndex) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *GuardianSetActivation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GuardianSetActivation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GuardianSetActivation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationTime != 0 {
+		i = encodeVarintConsensusGuardianSetIndex(dAtA, i, uint64(m.ActivationTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ActivationHeight != 0 {
+		i = encodeVarintConsensusGuardianSetIndex(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Index != 0 {
+		i = encodeVarintConsensusGuardianSetIndex(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintConsensusGuardianSetIndex(dAtA []byte, offset int, v uint64) int {
 	offset -= sovConsensusGuardianSetIndex(v)
 	base := offset
@@ -141,6 +247,24 @@ func (m *ConsensusGuardianSetIndex) Size() (n int) {
 	return n
 }
 
+func (m *GuardianSetActivation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovConsensusGuardianSetIndex(uint64(m.Index))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovConsensusGuardianSetIndex(uint64(m.ActivationHeight))
+	}
+	if m.ActivationTime != 0 {
+		n += 1 + sovConsensusGuardianSetIndex(uint64(m.ActivationTime))
+	}
+	return n
+}
+
 func sovConsensusGuardianSetIndex(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -216,6 +340,113 @@ func (m *ConsensusGuardianSetIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GuardianSetActivation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensusGuardianSetIndex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GuardianSetActivation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GuardianSetActivation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusGuardianSetIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusGuardianSetIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationTime", wireType)
+			}
+			m.ActivationTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensusGuardianSetIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensusGuardianSetIndex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsensusGuardianSetIndex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipConsensusGuardianSetIndex(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
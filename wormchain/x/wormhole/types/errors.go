@@ -11,7 +11,7 @@ var (
 	ErrGuardianSetNotFound                   = sdkerrors.Register(ModuleName, 1101, "guardian set not found")
 	ErrSignaturesInvalid                     = sdkerrors.Register(ModuleName, 1102, "invalid signatures on VAA")
 	ErrNoQuorum                              = sdkerrors.Register(ModuleName, 1103, "no quorum on VAA")
-	ErrUnknownGovernanceModule               = sdkerrors.Register(ModuleName, 1105, "invalid governance module")
+	ErrInvalidGovernanceModule               = sdkerrors.Register(ModuleName, 1105, "invalid governance module")
 	ErrNoConfig                              = sdkerrors.Register(ModuleName, 1106, "config not set")
 	ErrInvalidGovernanceEmitter              = sdkerrors.Register(ModuleName, 1107, "invalid governance emitter")
 	ErrUnknownGovernanceAction               = sdkerrors.Register(ModuleName, 1108, "unknown governance action")
@@ -34,4 +34,42 @@ var (
 	ErrInvalidAllowlistContractAddr          = sdkerrors.Register(ModuleName, 1125, "contract addresses in the wasm allowlist msg and vaa do not match")
 	ErrInvalidAllowlistCodeId                = sdkerrors.Register(ModuleName, 1126, "code ids in the wasm allowlist msg and vaa do not match")
 	ErrInvalidIbcComposabilityMwContractAddr = sdkerrors.Register(ModuleName, 1127, "contract addresses in the set ibc composability mw contract and vaa do not match")
+	ErrInvalidSlashingParams                 = sdkerrors.Register(ModuleName, 1128, "invalid slashing params")
+	ErrGuardianSetNotExpired                 = sdkerrors.Register(ModuleName, 1129, "guardian set has not expired")
+	ErrConsensusGuardianSetNotPrunable       = sdkerrors.Register(ModuleName, 1130, "cannot prune the consensus guardian set or any later set")
+	ErrInvalidConsensusParams                = sdkerrors.Register(ModuleName, 1131, "invalid consensus params")
+	ErrGovernanceVaaAlreadyExecuted          = sdkerrors.Register(ModuleName, 1132, "governance VAA was already executed")
+	ErrInvalidGuardianSetActivation          = sdkerrors.Register(ModuleName, 1133, "invalid guardian set activation")
+	ErrInvalidFeeParams                      = sdkerrors.Register(ModuleName, 1134, "invalid fee params")
+	ErrInvalidSignatureVerificationGas       = sdkerrors.Register(ModuleName, 1135, "invalid signature verification gas")
+	ErrInvalidEmitterRegistration            = sdkerrors.Register(ModuleName, 1136, "invalid emitter registration")
+	ErrInvalidQuorumThreshold                = sdkerrors.Register(ModuleName, 1137, "invalid quorum threshold")
+	ErrInvalidGovernanceSubmitter            = sdkerrors.Register(ModuleName, 1138, "invalid governance submitter")
+	ErrObservationAlreadySigned              = sdkerrors.Register(ModuleName, 1139, "observation was already signed by the guardians")
+	ErrInvalidGuardianWeights                = sdkerrors.Register(ModuleName, 1140, "invalid guardian weights")
+	ErrInvalidStakingParams                  = sdkerrors.Register(ModuleName, 1141, "invalid staking params")
+	ErrBridgePaused                          = sdkerrors.Register(ModuleName, 1142, "bridge is paused")
+	ErrInvalidChainRateLimit                 = sdkerrors.Register(ModuleName, 1143, "invalid chain rate limit")
+	ErrUnknownGovernancePayloadVersion       = sdkerrors.Register(ModuleName, 1144, "unknown governance payload version")
+	ErrMsgShutdown                           = sdkerrors.Register(ModuleName, 1145, "message type is shut down")
+	ErrInvalidMsgShutdown                    = sdkerrors.Register(ModuleName, 1146, "invalid message shutdown")
+	ErrNotGuardianValidator                  = sdkerrors.Register(ModuleName, 1147, "signer is not the validator of a guardian in the current or a future guardian set")
+	ErrInvalidGuardianHeartbeat              = sdkerrors.Register(ModuleName, 1148, "invalid guardian heartbeat")
+	ErrInvalidIcaHostAllowlistEntry          = sdkerrors.Register(ModuleName, 1149, "invalid interchain accounts host allowlist entry")
+	ErrIcaHostMsgNotAllowed                  = sdkerrors.Register(ModuleName, 1150, "message type is not allowed for interchain accounts of the connection")
+	ErrInvalidTokenFactoryDenom              = sdkerrors.Register(ModuleName, 1151, "tokenfactory denom was not created by the ibc composability mw contract")
+	ErrInvalidGatewayTransfer                = sdkerrors.Register(ModuleName, 1152, "invalid gateway transfer")
+	ErrGatewayContractNotSet                 = sdkerrors.Register(ModuleName, 1153, "gateway contract is not set")
+	ErrInvalidMaintenanceWindow              = sdkerrors.Register(ModuleName, 1154, "invalid maintenance window")
+	ErrContractUnderMaintenance              = sdkerrors.Register(ModuleName, 1155, "contract is under maintenance")
+	ErrMalformedVAA                          = sdkerrors.Register(ModuleName, 1156, "malformed VAA")
+	ErrInvalidSignerIndexes                  = sdkerrors.Register(ModuleName, 1157, "signer indexes of the VAA are not strictly increasing")
+	ErrInsufficientVerificationGas           = sdkerrors.Register(ModuleName, 1158, "not enough gas left to verify the VAA signatures")
+	ErrInvalidSequenceReservation            = sdkerrors.Register(ModuleName, 1159, "invalid sequence reservation")
+	ErrSequenceNotReserved                   = sdkerrors.Register(ModuleName, 1160, "sequence is not reserved")
+	ErrInvalidCoreContractAddr               = sdkerrors.Register(ModuleName, 1161, "invalid core contract address")
+	ErrCodeNotApproved                       = sdkerrors.Register(ModuleName, 1162, "wasm code is not approved by governance")
+	ErrCodeHashMismatch                      = sdkerrors.Register(ModuleName, 1163, "wasm code hash does not match the approved code hash")
+	ErrInvalidProofOfPossession              = sdkerrors.Register(ModuleName, 1164, "invalid proof of possession of the guardian key")
+	ErrObservationFinalized                  = sdkerrors.Register(ModuleName, 1166, "observation was already finalized")
 )
@@ -25,6 +25,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type EventGuardianSetUpdate struct {
 	OldIndex uint32 `protobuf:"varint,1,opt,name=old_index,json=oldIndex,proto3" json:"old_index,omitempty"`
 	NewIndex uint32 `protobuf:"varint,2,opt,name=new_index,json=newIndex,proto3" json:"new_index,omitempty"`
+	// keys present in the new guardian set but not in the old one
+	AddedKeys [][]byte `protobuf:"bytes,3,rep,name=added_keys,json=addedKeys,proto3" json:"added_keys,omitempty"`
+	// keys present in the old guardian set but not in the new one
+	RemovedKeys [][]byte `protobuf:"bytes,4,rep,name=removed_keys,json=removedKeys,proto3" json:"removed_keys,omitempty"`
 }
 
 func (m *EventGuardianSetUpdate) Reset()         { *m = EventGuardianSetUpdate{} }
@@ -74,6 +78,20 @@ func (m *EventGuardianSetUpdate) GetNewIndex() uint32 {
 	return 0
 }
 
+func (m *EventGuardianSetUpdate) GetAddedKeys() [][]byte {
+	if m != nil {
+		return m.AddedKeys
+	}
+	return nil
+}
+
+func (m *EventGuardianSetUpdate) GetRemovedKeys() [][]byte {
+	if m != nil {
+		return m.RemovedKeys
+	}
+	return nil
+}
+
 type EventPostedMessage struct {
 	Emitter  []byte `protobuf:"bytes,1,opt,name=emitter,proto3" json:"emitter,omitempty"`
 	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
@@ -254,288 +272,6598 @@ func (m *EventConsensusSetUpdate) GetNewIndex() uint32 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*EventGuardianSetUpdate)(nil), "wormhole_foundation.wormchain.wormhole.EventGuardianSetUpdate")
-	proto.RegisterType((*EventPostedMessage)(nil), "wormhole_foundation.wormchain.wormhole.EventPostedMessage")
-	proto.RegisterType((*EventGuardianRegistered)(nil), "wormhole_foundation.wormchain.wormhole.EventGuardianRegistered")
-	proto.RegisterType((*EventConsensusSetUpdate)(nil), "wormhole_foundation.wormchain.wormhole.EventConsensusSetUpdate")
+type EventFeeParamsUpdate struct {
+	OldMessageFee         uint64 `protobuf:"varint,1,opt,name=old_message_fee,json=oldMessageFee,proto3" json:"old_message_fee,omitempty"`
+	NewMessageFee         uint64 `protobuf:"varint,2,opt,name=new_message_fee,json=newMessageFee,proto3" json:"new_message_fee,omitempty"`
+	OldGatewayTransferFee uint64 `protobuf:"varint,3,opt,name=old_gateway_transfer_fee,json=oldGatewayTransferFee,proto3" json:"old_gateway_transfer_fee,omitempty"`
+	NewGatewayTransferFee uint64 `protobuf:"varint,4,opt,name=new_gateway_transfer_fee,json=newGatewayTransferFee,proto3" json:"new_gateway_transfer_fee,omitempty"`
 }
 
-func init() { proto.RegisterFile("wormhole/events.proto", fileDescriptor_486bfc4df1202b88) }
-
-var fileDescriptor_486bfc4df1202b88 = []byte{
-	// 353 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xbf, 0x4e, 0xc3, 0x30,
-	0x10, 0xc6, 0x9b, 0xd2, 0x42, 0x31, 0xed, 0x62, 0xf1, 0x27, 0x02, 0x29, 0x82, 0x20, 0x21, 0x16,
-	0x9a, 0x81, 0x89, 0x15, 0x84, 0x10, 0xaa, 0x90, 0x50, 0x2a, 0x16, 0x96, 0xca, 0xad, 0x8f, 0xd4,
-	0x22, 0xf1, 0x85, 0xd8, 0x69, 0x9b, 0x97, 0x40, 0x3c, 0x16, 0x63, 0x47, 0x46, 0xd4, 0xbe, 0x08,
-	0x8a, 0xf3, 0x07, 0xb1, 0xb3, 0xdd, 0xf7, 0xfd, 0x2e, 0xdf, 0xc5, 0x3e, 0x93, 0xbd, 0x39, 0x26,
-	0xd1, 0x14, 0x43, 0xf0, 0x60, 0x06, 0x52, 0xab, 0x7e, 0x9c, 0xa0, 0x46, 0x7a, 0x56, 0xd9, 0xa3,
-	0x17, 0x4c, 0x25, 0x67, 0x5a, 0xa0, 0xec, 0xe7, 0xde, 0x64, 0xca, 0x44, 0x51, 0xe5, 0xd4, 0xf5,
-	0xc9, 0xfe, 0x6d, 0xfe, 0xdd, 0x5d, 0xca, 0x12, 0x2e, 0x98, 0x1c, 0x82, 0x7e, 0x8a, 0x39, 0xd3,
-	0x40, 0x8f, 0xc8, 0x36, 0x86, 0x7c, 0x24, 0x24, 0x87, 0x85, 0x6d, 0x1d, 0x5b, 0xe7, 0x3d, 0xbf,
-	0x83, 0x21, 0xbf, 0xcf, 0x75, 0x0e, 0x25, 0xcc, 0x4b, 0xd8, 0x2c, 0xa0, 0x84, 0xb9, 0x81, 0xee,
-	0xbb, 0x45, 0xa8, 0x09, 0x7d, 0x44, 0xa5, 0x81, 0x3f, 0x80, 0x52, 0x2c, 0x00, 0x6a, 0x93, 0x2d,
-	0x88, 0x84, 0xd6, 0x90, 0x98, 0xb8, 0xae, 0x5f, 0x49, 0x7a, 0x48, 0x3a, 0x0a, 0xde, 0x52, 0x90,
-	0x13, 0x30, 0x61, 0x2d, 0xbf, 0xd6, 0x74, 0x97, 0xb4, 0x25, 0xe6, 0x60, 0xc3, 0x4c, 0x29, 0x04,
-	0xa5, 0xa4, 0xa5, 0x45, 0x04, 0x76, 0xcb, 0x74, 0x9b, 0x3a, 0xcf, 0x8f, 0x59, 0x16, 0x22, 0xe3,
-	0x76, 0xbb, 0xc8, 0x2f, 0xa5, 0xcb, 0xc8, 0xc1, 0x9f, 0x43, 0xfa, 0x10, 0x08, 0xa5, 0x21, 0x01,
-	0x4e, 0x4f, 0x48, 0x37, 0x28, 0xdd, 0xd1, 0x2b, 0x64, 0xe5, 0x9f, 0xed, 0x54, 0xde, 0x00, 0x32,
-	0x7a, 0x4a, 0x7a, 0x33, 0x16, 0x0a, 0xce, 0x34, 0x26, 0xa6, 0xa7, 0x69, 0x7a, 0xba, 0xb5, 0x39,
-	0x80, 0xcc, 0x1d, 0x96, 0x23, 0x6e, 0x50, 0x2a, 0x90, 0x2a, 0x55, 0xff, 0x70, 0x91, 0xd7, 0xc3,
-	0xcf, 0x95, 0x63, 0x2d, 0x57, 0x8e, 0xf5, 0xbd, 0x72, 0xac, 0x8f, 0xb5, 0xd3, 0x58, 0xae, 0x9d,
-	0xc6, 0xd7, 0xda, 0x69, 0x3c, 0x5f, 0x05, 0x42, 0x4f, 0xd3, 0x71, 0x7f, 0x82, 0x91, 0x57, 0xed,
-	0xf2, 0xe2, 0x77, 0xd3, 0x5e, 0xbd, 0x69, 0x6f, 0x51, 0x73, 0x4f, 0x67, 0x31, 0xa8, 0xf1, 0xa6,
-	0x79, 0x20, 0x97, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc1, 0xe4, 0xc3, 0xee, 0x39, 0x02, 0x00,
-	0x00,
+func (m *EventFeeParamsUpdate) Reset()         { *m = EventFeeParamsUpdate{} }
+func (m *EventFeeParamsUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventFeeParamsUpdate) ProtoMessage()    {}
+func (*EventFeeParamsUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_486bfc4df1202b88, []int{4}
 }
-
-func (m *EventGuardianSetUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventFeeParamsUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFeeParamsUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFeeParamsUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *EventFeeParamsUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeeParamsUpdate.Merge(m, src)
+}
+func (m *EventFeeParamsUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFeeParamsUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeeParamsUpdate.DiscardUnknown(m)
 }
 
-func (m *EventGuardianSetUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_EventFeeParamsUpdate proto.InternalMessageInfo
+
+func (m *EventFeeParamsUpdate) GetOldMessageFee() uint64 {
+	if m != nil {
+		return m.OldMessageFee
+	}
+	return 0
 }
 
-func (m *EventGuardianSetUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NewIndex != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.NewIndex))
-		i--
-		dAtA[i] = 0x10
+func (m *EventFeeParamsUpdate) GetNewMessageFee() uint64 {
+	if m != nil {
+		return m.NewMessageFee
 	}
-	if m.OldIndex != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.OldIndex))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *EventFeeParamsUpdate) GetOldGatewayTransferFee() uint64 {
+	if m != nil {
+		return m.OldGatewayTransferFee
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventPostedMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventFeeParamsUpdate) GetNewGatewayTransferFee() uint64 {
+	if m != nil {
+		return m.NewGatewayTransferFee
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventPostedMessage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventSignatureVerificationGasUpdate struct {
+	OldGas uint64 `protobuf:"varint,1,opt,name=old_gas,json=oldGas,proto3" json:"old_gas,omitempty"`
+	NewGas uint64 `protobuf:"varint,2,opt,name=new_gas,json=newGas,proto3" json:"new_gas,omitempty"`
 }
 
-func (m *EventPostedMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Payload)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Time != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Time))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Nonce != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Sequence != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x10
+func (m *EventSignatureVerificationGasUpdate) Reset()         { *m = EventSignatureVerificationGasUpdate{} }
+func (m *EventSignatureVerificationGasUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventSignatureVerificationGasUpdate) ProtoMessage()    {}
+func (*EventSignatureVerificationGasUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_486bfc4df1202b88, []int{5}
+}
+func (m *EventSignatureVerificationGasUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSignatureVerificationGasUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSignatureVerificationGasUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Emitter) > 0 {
-		i -= len(m.Emitter)
-		copy(dAtA[i:], m.Emitter)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Emitter)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventSignatureVerificationGasUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSignatureVerificationGasUpdate.Merge(m, src)
+}
+func (m *EventSignatureVerificationGasUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSignatureVerificationGasUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSignatureVerificationGasUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSignatureVerificationGasUpdate proto.InternalMessageInfo
+
+func (m *EventSignatureVerificationGasUpdate) GetOldGas() uint64 {
+	if m != nil {
+		return m.OldGas
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventGuardianRegistered) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventSignatureVerificationGasUpdate) GetNewGas() uint64 {
+	if m != nil {
+		return m.NewGas
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventGuardianRegistered) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventEmitterRegistered struct {
+	Module         string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	ChainId        uint32 `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	EmitterAddress []byte `protobuf:"bytes,3,opt,name=emitter_address,json=emitterAddress,proto3" json:"emitter_address,omitempty"`
 }
 
-func (m *EventGuardianRegistered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorKey) > 0 {
-		i -= len(m.ValidatorKey)
-		copy(dAtA[i:], m.ValidatorKey)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ValidatorKey)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.GuardianKey) > 0 {
-		i -= len(m.GuardianKey)
-		copy(dAtA[i:], m.GuardianKey)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.GuardianKey)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventEmitterRegistered) Reset()         { *m = EventEmitterRegistered{} }
+func (m *EventEmitterRegistered) String() string { return proto.CompactTextString(m) }
+func (*EventEmitterRegistered) ProtoMessage()    {}
+func (*EventEmitterRegistered) Descriptor() ([]byte, []int) {
+	return fileDescriptor_486bfc4df1202b88, []int{6}
+}
+func (m *EventEmitterRegistered) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventEmitterRegistered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventEmitterRegistered.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventEmitterRegistered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventEmitterRegistered.Merge(m, src)
+}
+func (m *EventEmitterRegistered) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventEmitterRegistered) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventEmitterRegistered.DiscardUnknown(m)
 }
 
-func (m *EventConsensusSetUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventEmitterRegistered proto.InternalMessageInfo
+
+func (m *EventEmitterRegistered) GetModule() string {
+	if m != nil {
+		return m.Module
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventConsensusSetUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventEmitterRegistered) GetChainId() uint32 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
 }
 
-func (m *EventConsensusSetUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NewIndex != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.NewIndex))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.OldIndex != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.OldIndex))
-		i--
-		dAtA[i] = 0x8
+func (m *EventEmitterRegistered) GetEmitterAddress() []byte {
+	if m != nil {
+		return m.EmitterAddress
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+type EventQuorumThresholdUpdate struct {
+	Numerator   uint32 `protobuf:"varint,1,opt,name=numerator,proto3" json:"numerator,omitempty"`
+	Denominator uint32 `protobuf:"varint,2,opt,name=denominator,proto3" json:"denominator,omitempty"`
 }
-func (m *EventGuardianSetUpdate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OldIndex != 0 {
-		n += 1 + sovEvents(uint64(m.OldIndex))
+
+func (m *EventQuorumThresholdUpdate) Reset()         { *m = EventQuorumThresholdUpdate{} }
+func (m *EventQuorumThresholdUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventQuorumThresholdUpdate) ProtoMessage()    {}
+func (*EventQuorumThresholdUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_486bfc4df1202b88, []int{7}
+}
+func (m *EventQuorumThresholdUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventQuorumThresholdUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventQuorumThresholdUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.NewIndex != 0 {
-		n += 1 + sovEvents(uint64(m.NewIndex))
+}
+func (m *EventQuorumThresholdUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventQuorumThresholdUpdate.Merge(m, src)
+}
+func (m *EventQuorumThresholdUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventQuorumThresholdUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventQuorumThresholdUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventQuorumThresholdUpdate proto.InternalMessageInfo
+
+func (m *EventQuorumThresholdUpdate) GetNumerator() uint32 {
+	if m != nil {
+		return m.Numerator
 	}
-	return n
+	return 0
 }
 
-func (m *EventPostedMessage) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventQuorumThresholdUpdate) GetDenominator() uint32 {
+	if m != nil {
+		return m.Denominator
 	}
-	var l int
-	_ = l
-	l = len(m.Emitter)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return 0
+}
+
+type EventVAAArchiveRetentionUpdate struct {
+	OldRetentionBlocks uint64 `protobuf:"varint,1,opt,name=old_retention_blocks,json=oldRetentionBlocks,proto3" json:"old_retention_blocks,omitempty"`
+	NewRetentionBlocks uint64 `protobuf:"varint,2,opt,name=new_retention_blocks,json=newRetentionBlocks,proto3" json:"new_retention_blocks,omitempty"`
+}
+
+func (m *EventVAAArchiveRetentionUpdate) Reset()         { *m = EventVAAArchiveRetentionUpdate{} }
+func (m *EventVAAArchiveRetentionUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventVAAArchiveRetentionUpdate) ProtoMessage()    {}
+func (*EventVAAArchiveRetentionUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_486bfc4df1202b88, []int{8}
+}
+func (m *EventVAAArchiveRetentionUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventVAAArchiveRetentionUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventVAAArchiveRetentionUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Sequence != 0 {
-		n += 1 + sovEvents(uint64(m.Sequence))
+}
+func (m *EventVAAArchiveRetentionUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventVAAArchiveRetentionUpdate.Merge(m, src)
+}
+func (m *EventVAAArchiveRetentionUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventVAAArchiveRetentionUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventVAAArchiveRetentionUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventVAAArchiveRetentionUpdate proto.InternalMessageInfo
+
+func (m *EventVAAArchiveRetentionUpdate) GetOldRetentionBlocks() uint64 {
+	if m != nil {
+		return m.OldRetentionBlocks
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovEvents(uint64(m.Nonce))
+	return 0
+}
+
+func (m *EventVAAArchiveRetentionUpdate) GetNewRetentionBlocks() uint64 {
+	if m != nil {
+		return m.NewRetentionBlocks
 	}
-	if m.Time != 0 {
-		n += 1 + sovEvents(uint64(m.Time))
+	return 0
+}
+
+type EventIbcComposabilityMwContractUpdate struct {
+	OldContractAddress string `protobuf:"bytes,1,opt,name=old_contract_address,json=oldContractAddress,proto3" json:"old_contract_address,omitempty"`
+	NewContractAddress string `protobuf:"bytes,2,opt,name=new_contract_address,json=newContractAddress,proto3" json:"new_contract_address,omitempty"`
+}
+
+func (m *EventIbcComposabilityMwContractUpdate) Reset()         { *m = EventIbcComposabilityMwContractUpdate{} }
+func (m *EventIbcComposabilityMwContractUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventIbcComposabilityMwContractUpdate) ProtoMessage()    {}
+func (*EventIbcComposabilityMwContractUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_486bfc4df1202b88, []int{9}
+}
+func (m *EventIbcComposabilityMwContractUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventIbcComposabilityMwContractUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventIbcComposabilityMwContractUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+}
+func (m *EventIbcComposabilityMwContractUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventIbcComposabilityMwContractUpdate.Merge(m, src)
+}
+func (m *EventIbcComposabilityMwContractUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventIbcComposabilityMwContractUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventIbcComposabilityMwContractUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventIbcComposabilityMwContractUpdate proto.InternalMessageInfo
+
+func (m *EventIbcComposabilityMwContractUpdate) GetOldContractAddress() string {
+	if m != nil {
+		return m.OldContractAddress
 	}
-	return n
+	return ""
 }
 
-func (m *EventGuardianRegistered) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventIbcComposabilityMwContractUpdate) GetNewContractAddress() string {
+	if m != nil {
+		return m.NewContractAddress
 	}
-	var l int
-	_ = l
-	l = len(m.GuardianKey)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return ""
+}
+
+type EventCoreContractUpdate struct {
+	OldContractAddress string `protobuf:"bytes,1,opt,name=old_contract_address,json=oldContractAddress,proto3" json:"old_contract_address,omitempty"`
+	NewContractAddress string `protobuf:"bytes,2,opt,name=new_contract_address,json=newContractAddress,proto3" json:"new_contract_address,omitempty"`
+}
+
+func (m *EventCoreContractUpdate) Reset()         { *m = EventCoreContractUpdate{} }
+func (m *EventCoreContractUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventCoreContractUpdate) ProtoMessage()    {}
+func (*EventCoreContractUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_486bfc4df1202b88, []int{10}
+}
+func (m *EventCoreContractUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCoreContractUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCoreContractUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.ValidatorKey)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+}
+func (m *EventCoreContractUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCoreContractUpdate.Merge(m, src)
+}
+func (m *EventCoreContractUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCoreContractUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCoreContractUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCoreContractUpdate proto.InternalMessageInfo
+
+func (m *EventCoreContractUpdate) GetOldContractAddress() string {
+	if m != nil {
+		return m.OldContractAddress
+	}
+	return ""
+}
+
+func (m *EventCoreContractUpdate) GetNewContractAddress() string {
+	if m != nil {
+		return m.NewContractAddress
+	}
+	return ""
+}
+
+type EventGuardianSetWeightsUpdate struct {
+	GuardianSetIndex uint32   `protobuf:"varint,1,opt,name=guardian_set_index,json=guardianSetIndex,proto3" json:"guardian_set_index,omitempty"`
+	Weights          []uint64 `protobuf:"varint,2,rep,packed,name=weights,proto3" json:"weights,omitempty"`
+}
+
+func (m *EventGuardianSetWeightsUpdate) Reset()         { *m = EventGuardianSetWeightsUpdate{} }
+func (m *EventGuardianSetWeightsUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventGuardianSetWeightsUpdate) ProtoMessage()    {}
+func (*EventGuardianSetWeightsUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_486bfc4df1202b88, []int{11}
+}
+func (m *EventGuardianSetWeightsUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventGuardianSetWeightsUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventGuardianSetWeightsUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventGuardianSetWeightsUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventGuardianSetWeightsUpdate.Merge(m, src)
+}
+func (m *EventGuardianSetWeightsUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventGuardianSetWeightsUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventGuardianSetWeightsUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventGuardianSetWeightsUpdate proto.InternalMessageInfo
+
+func (m *EventGuardianSetWeightsUpdate) GetGuardianSetIndex() uint32 {
+	if m != nil {
+		return m.GuardianSetIndex
+	}
+	return 0
+}
+
+func (m *EventGuardianSetWeightsUpdate) GetWeights() []uint64 {
+	if m != nil {
+		return m.Weights
+	}
+	return nil
+}
+
+type EventObservationFinalized struct {
+	Digest           []byte `protobuf:"bytes,1,opt,name=digest,proto3" json:"digest,omitempty"`
+	GuardianSetIndex uint32 `protobuf:"varint,2,opt,name=guardian_set_index,json=guardianSetIndex,proto3" json:"guardian_set_index,omitempty"`
+	Weight           uint64 `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *EventObservationFinalized) Reset()         { *m = EventObservationFinalized{} }
+func (m *EventObservationFinalized) String() string { return proto.CompactTextString(m) }
+func (*EventObservationFinalized) ProtoMessage()    {}
+func (*EventObservationFinalized) Descriptor() ([]byte, []int) {
+	return fileDescriptor_486bfc4df1202b88, []int{12}
+}
+func (m *EventObservationFinalized) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventObservationFinalized) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventObservationFinalized.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventObservationFinalized) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventObservationFinalized.Merge(m, src)
+}
+func (m *EventObservationFinalized) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventObservationFinalized) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventObservationFinalized.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventObservationFinalized proto.InternalMessageInfo
+
+func (m *EventObservationFinalized) GetDigest() []byte {
+	if m != nil {
+		return m.Digest
+	}
+	return nil
+}
+
+func (m *EventObservationFinalized) GetGuardianSetIndex() uint32 {
+	if m != nil {
+		return m.GuardianSetIndex
+	}
+	return 0
+}
+
+func (m *EventObservationFinalized) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+type EventGovernanceSubmitterUpdate struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Allowed bool   `protobuf:"varint,2,opt,name=allowed,proto3" json:"allowed,omitempty"`
+}
+
+func (m *EventGovernanceSubmitterUpdate) Reset()         { *m = EventGovernanceSubmitterUpdate{} }
+func (m *EventGovernanceSubmitterUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventGovernanceSubmitterUpdate) ProtoMessage()    {}
+func (*EventGovernanceSubmitterUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_486bfc4df1202b88, []int{13}
+}
+func (m *EventGovernanceSubmitterUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventGovernanceSubmitterUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventGovernanceSubmitterUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventGovernanceSubmitterUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventGovernanceSubmitterUpdate.Merge(m, src)
+}
+func (m *EventGovernanceSubmitterUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventGovernanceSubmitterUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventGovernanceSubmitterUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventGovernanceSubmitterUpdate proto.InternalMessageInfo
+
+func (m *EventGovernanceSubmitterUpdate) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventGovernanceSubmitterUpdate) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+type EventChainRateLimitUpdate struct {
+	ChainId      uint32 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Limit        uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	WindowBlocks uint64 `protobuf:"varint,3,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+}
+
+func (m *EventChainRateLimitUpdate) Reset()         { *m = EventChainRateLimitUpdate{} }
+func (m *EventChainRateLimitUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventChainRateLimitUpdate) ProtoMessage()    {}
+func (*EventChainRateLimitUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_486bfc4df1202b88, []int{14}
+}
+func (m *EventChainRateLimitUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventChainRateLimitUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventChainRateLimitUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventChainRateLimitUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventChainRateLimitUpdate.Merge(m, src)
+}
+func (m *EventChainRateLimitUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventChainRateLimitUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventChainRateLimitUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventChainRateLimitUpdate proto.InternalMessageInfo
+
+func (m *EventChainRateLimitUpdate) GetChainId() uint32 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *EventChainRateLimitUpdate) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *EventChainRateLimitUpdate) GetWindowBlocks() uint64 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
+}
+
+type EventObservationQueued struct {
+	Digest       []byte `protobuf:"bytes,1,opt,name=digest,proto3" json:"digest,omitempty"`
+	EmitterChain uint32 `protobuf:"varint,2,opt,name=emitter_chain,json=emitterChain,proto3" json:"emitter_chain,omitempty"`
+}
+
+func (m *EventObservationQueued) Reset()         { *m = EventObservationQueued{} }
+func (m *EventObservationQueued) String() string { return proto.CompactTextString(m) }
+func (*EventObservationQueued) ProtoMessage()    {}
+func (*EventObservationQueued) Descriptor() ([]byte, []int) {
+	return fileDescriptor_486bfc4df1202b88, []int{15}
+}
+func (m *EventObservationQueued) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventObservationQueued) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventObservationQueued.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventObservationQueued) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventObservationQueued.Merge(m, src)
+}
+func (m *EventObservationQueued) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventObservationQueued) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventObservationQueued.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventObservationQueued proto.InternalMessageInfo
+
+func (m *EventObservationQueued) GetDigest() []byte {
+	if m != nil {
+		return m.Digest
+	}
+	return nil
+}
+
+func (m *EventObservationQueued) GetEmitterChain() uint32 {
+	if m != nil {
+		return m.EmitterChain
+	}
+	return 0
+}
+
+type EventMsgShutdownUpdate struct {
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	Shutdown   bool   `protobuf:"varint,2,opt,name=shutdown,proto3" json:"shutdown,omitempty"`
+}
+
+func (m *EventMsgShutdownUpdate) Reset()         { *m = EventMsgShutdownUpdate{} }
+func (m *EventMsgShutdownUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventMsgShutdownUpdate) ProtoMessage()    {}
+func (*EventMsgShutdownUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_486bfc4df1202b88, []int{16}
+}
+func (m *EventMsgShutdownUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMsgShutdownUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMsgShutdownUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMsgShutdownUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMsgShutdownUpdate.Merge(m, src)
+}
+func (m *EventMsgShutdownUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMsgShutdownUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMsgShutdownUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMsgShutdownUpdate proto.InternalMessageInfo
+
+func (m *EventMsgShutdownUpdate) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *EventMsgShutdownUpdate) GetShutdown() bool {
+	if m != nil {
+		return m.Shutdown
+	}
+	return false
+}
+
+type EventBridgePaused struct {
+}
+
+func (m *EventBridgePaused) Reset()         { *m = EventBridgePaused{} }
+func (m *EventBridgePaused) String() string { return proto.CompactTextString(m) }
+func (*EventBridgePaused) ProtoMessage()    {}
+func (*EventBridgePaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_486bfc4df1202b88, []int{17}
+}
+func (m *EventBridgePaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBridgePaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBridgePaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBridgePaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBridgePaused.Merge(m, src)
+}
+func (m *EventBridgePaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBridgePaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBridgePaused.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBridgePaused proto.InternalMessageInfo
+
+type EventBridgeResumed struct {
+}
+
+func (m *EventBridgeResumed) Reset()         { *m = EventBridgeResumed{} }
+func (m *EventBridgeResumed) String() string { return proto.CompactTextString(m) }
+func (*EventBridgeResumed) ProtoMessage()    {}
+func (*EventBridgeResumed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_486bfc4df1202b88, []int{18}
+}
+func (m *EventBridgeResumed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBridgeResumed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBridgeResumed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBridgeResumed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBridgeResumed.Merge(m, src)
+}
+func (m *EventBridgeResumed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBridgeResumed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBridgeResumed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBridgeResumed proto.InternalMessageInfo
+
+type EventAllowlistEntryExpired struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	AllowedAddress   string `protobuf:"bytes,2,opt,name=allowed_address,json=allowedAddress,proto3" json:"allowed_address,omitempty"`
+}
+
+func (m *EventAllowlistEntryExpired) Reset()         { *m = EventAllowlistEntryExpired{} }
+func (m *EventAllowlistEntryExpired) String() string { return proto.CompactTextString(m) }
+func (*EventAllowlistEntryExpired) ProtoMessage()    {}
+func (*EventAllowlistEntryExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_486bfc4df1202b88, []int{19}
+}
+func (m *EventAllowlistEntryExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAllowlistEntryExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAllowlistEntryExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAllowlistEntryExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAllowlistEntryExpired.Merge(m, src)
+}
+func (m *EventAllowlistEntryExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAllowlistEntryExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAllowlistEntryExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAllowlistEntryExpired proto.InternalMessageInfo
+
+func (m *EventAllowlistEntryExpired) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *EventAllowlistEntryExpired) GetAllowedAddress() string {
+	if m != nil {
+		return m.AllowedAddress
+	}
+	return ""
+}
+
+type EventIcaHostAllowlistUpdate struct {
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	MsgTypeUrl   string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	Allowed      bool   `protobuf:"varint,3,opt,name=allowed,proto3" json:"allowed,omitempty"`
+}
+
+func (m *EventIcaHostAllowlistUpdate) Reset()         { *m = EventIcaHostAllowlistUpdate{} }
+func (m *EventIcaHostAllowlistUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventIcaHostAllowlistUpdate) ProtoMessage()    {}
+func (*EventIcaHostAllowlistUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_486bfc4df1202b88, []int{20}
+}
+func (m *EventIcaHostAllowlistUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventIcaHostAllowlistUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventIcaHostAllowlistUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventIcaHostAllowlistUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventIcaHostAllowlistUpdate.Merge(m, src)
+}
+func (m *EventIcaHostAllowlistUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventIcaHostAllowlistUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventIcaHostAllowlistUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventIcaHostAllowlistUpdate proto.InternalMessageInfo
+
+func (m *EventIcaHostAllowlistUpdate) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *EventIcaHostAllowlistUpdate) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *EventIcaHostAllowlistUpdate) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+type EventForwardFeeUpdate struct {
+	OldFeeBps uint32 `protobuf:"varint,1,opt,name=old_fee_bps,json=oldFeeBps,proto3" json:"old_fee_bps,omitempty"`
+	NewFeeBps uint32 `protobuf:"varint,2,opt,name=new_fee_bps,json=newFeeBps,proto3" json:"new_fee_bps,omitempty"`
+}
+
+func (m *EventForwardFeeUpdate) Reset()         { *m = EventForwardFeeUpdate{} }
+func (m *EventForwardFeeUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventForwardFeeUpdate) ProtoMessage()    {}
+func (*EventForwardFeeUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_486bfc4df1202b88, []int{21}
+}
+func (m *EventForwardFeeUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventForwardFeeUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventForwardFeeUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventForwardFeeUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventForwardFeeUpdate.Merge(m, src)
+}
+func (m *EventForwardFeeUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventForwardFeeUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventForwardFeeUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventForwardFeeUpdate proto.InternalMessageInfo
+
+func (m *EventForwardFeeUpdate) GetOldFeeBps() uint32 {
+	if m != nil {
+		return m.OldFeeBps
+	}
+	return 0
+}
+
+func (m *EventForwardFeeUpdate) GetNewFeeBps() uint32 {
+	if m != nil {
+		return m.NewFeeBps
+	}
+	return 0
+}
+
+type EventMaintenanceWindowUpdate struct {
+	StartHeight uint64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   uint64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// empty when the maintenance window was ended
+	Contracts []string `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts,omitempty"`
+}
+
+func (m *EventMaintenanceWindowUpdate) Reset()         { *m = EventMaintenanceWindowUpdate{} }
+func (m *EventMaintenanceWindowUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventMaintenanceWindowUpdate) ProtoMessage()    {}
+func (*EventMaintenanceWindowUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_486bfc4df1202b88, []int{22}
+}
+func (m *EventMaintenanceWindowUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMaintenanceWindowUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMaintenanceWindowUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMaintenanceWindowUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMaintenanceWindowUpdate.Merge(m, src)
+}
+func (m *EventMaintenanceWindowUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMaintenanceWindowUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMaintenanceWindowUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMaintenanceWindowUpdate proto.InternalMessageInfo
+
+func (m *EventMaintenanceWindowUpdate) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *EventMaintenanceWindowUpdate) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *EventMaintenanceWindowUpdate) GetContracts() []string {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
+type EventSequenceBlockReserved struct {
+	Emitter []byte `protobuf:"bytes,1,opt,name=emitter,proto3" json:"emitter,omitempty"`
+	Start   uint64 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Count   uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *EventSequenceBlockReserved) Reset()         { *m = EventSequenceBlockReserved{} }
+func (m *EventSequenceBlockReserved) String() string { return proto.CompactTextString(m) }
+func (*EventSequenceBlockReserved) ProtoMessage()    {}
+func (*EventSequenceBlockReserved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_486bfc4df1202b88, []int{23}
+}
+func (m *EventSequenceBlockReserved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSequenceBlockReserved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSequenceBlockReserved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSequenceBlockReserved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSequenceBlockReserved.Merge(m, src)
+}
+func (m *EventSequenceBlockReserved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSequenceBlockReserved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSequenceBlockReserved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSequenceBlockReserved proto.InternalMessageInfo
+
+func (m *EventSequenceBlockReserved) GetEmitter() []byte {
+	if m != nil {
+		return m.Emitter
+	}
+	return nil
+}
+
+func (m *EventSequenceBlockReserved) GetStart() uint64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *EventSequenceBlockReserved) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type EventTokenFactoryAdminUpdate struct {
+	Denom    string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	NewAdmin string `protobuf:"bytes,2,opt,name=new_admin,json=newAdmin,proto3" json:"new_admin,omitempty"`
+}
+
+func (m *EventTokenFactoryAdminUpdate) Reset()         { *m = EventTokenFactoryAdminUpdate{} }
+func (m *EventTokenFactoryAdminUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventTokenFactoryAdminUpdate) ProtoMessage()    {}
+func (*EventTokenFactoryAdminUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_486bfc4df1202b88, []int{24}
+}
+func (m *EventTokenFactoryAdminUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTokenFactoryAdminUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTokenFactoryAdminUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTokenFactoryAdminUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTokenFactoryAdminUpdate.Merge(m, src)
+}
+func (m *EventTokenFactoryAdminUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTokenFactoryAdminUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTokenFactoryAdminUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTokenFactoryAdminUpdate proto.InternalMessageInfo
+
+func (m *EventTokenFactoryAdminUpdate) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventTokenFactoryAdminUpdate) GetNewAdmin() string {
+	if m != nil {
+		return m.NewAdmin
+	}
+	return ""
+}
+
+type EventTokenFactoryMetadataUpdate struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *EventTokenFactoryMetadataUpdate) Reset()         { *m = EventTokenFactoryMetadataUpdate{} }
+func (m *EventTokenFactoryMetadataUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventTokenFactoryMetadataUpdate) ProtoMessage()    {}
+func (*EventTokenFactoryMetadataUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_486bfc4df1202b88, []int{25}
+}
+func (m *EventTokenFactoryMetadataUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTokenFactoryMetadataUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTokenFactoryMetadataUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTokenFactoryMetadataUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTokenFactoryMetadataUpdate.Merge(m, src)
+}
+func (m *EventTokenFactoryMetadataUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTokenFactoryMetadataUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTokenFactoryMetadataUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTokenFactoryMetadataUpdate proto.InternalMessageInfo
+
+func (m *EventTokenFactoryMetadataUpdate) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type EventGatewayTransfer struct {
+	Sender         string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Amount         string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	RecipientChain uint32 `protobuf:"varint,3,opt,name=recipient_chain,json=recipientChain,proto3" json:"recipient_chain,omitempty"`
+	Recipient      []byte `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Fee            string `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	Nonce          uint32 `protobuf:"varint,6,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// whether the transfer carries a payload for a recipient contract
+	WithPayload bool `protobuf:"varint,7,opt,name=with_payload,json=withPayload,proto3" json:"with_payload,omitempty"`
+}
+
+func (m *EventGatewayTransfer) Reset()         { *m = EventGatewayTransfer{} }
+func (m *EventGatewayTransfer) String() string { return proto.CompactTextString(m) }
+func (*EventGatewayTransfer) ProtoMessage()    {}
+func (*EventGatewayTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_486bfc4df1202b88, []int{26}
+}
+func (m *EventGatewayTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventGatewayTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventGatewayTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventGatewayTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventGatewayTransfer.Merge(m, src)
+}
+func (m *EventGatewayTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventGatewayTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventGatewayTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventGatewayTransfer proto.InternalMessageInfo
+
+func (m *EventGatewayTransfer) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventGatewayTransfer) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventGatewayTransfer) GetRecipientChain() uint32 {
+	if m != nil {
+		return m.RecipientChain
+	}
+	return 0
+}
+
+func (m *EventGatewayTransfer) GetRecipient() []byte {
+	if m != nil {
+		return m.Recipient
+	}
+	return nil
+}
+
+func (m *EventGatewayTransfer) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+func (m *EventGatewayTransfer) GetNonce() uint32 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *EventGatewayTransfer) GetWithPayload() bool {
+	if m != nil {
+		return m.WithPayload
+	}
+	return false
+}
+
+type EventApprovedCodeHashUpdate struct {
+	CodeId uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// empty if the approval was revoked
+	CodeHash []byte `protobuf:"bytes,2,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+}
+
+func (m *EventApprovedCodeHashUpdate) Reset()         { *m = EventApprovedCodeHashUpdate{} }
+func (m *EventApprovedCodeHashUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventApprovedCodeHashUpdate) ProtoMessage()    {}
+func (*EventApprovedCodeHashUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_486bfc4df1202b88, []int{27}
+}
+func (m *EventApprovedCodeHashUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventApprovedCodeHashUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventApprovedCodeHashUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventApprovedCodeHashUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventApprovedCodeHashUpdate.Merge(m, src)
+}
+func (m *EventApprovedCodeHashUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventApprovedCodeHashUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventApprovedCodeHashUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventApprovedCodeHashUpdate proto.InternalMessageInfo
+
+func (m *EventApprovedCodeHashUpdate) GetCodeId() uint64 {
+	if m != nil {
+		return m.CodeId
+	}
+	return 0
+}
+
+func (m *EventApprovedCodeHashUpdate) GetCodeHash() []byte {
+	if m != nil {
+		return m.CodeHash
+	}
+	return nil
+}
+
+type EventValidatorTransitionWindowUpdate struct {
+	OldTransitionBlocks uint64 `protobuf:"varint,1,opt,name=old_transition_blocks,json=oldTransitionBlocks,proto3" json:"old_transition_blocks,omitempty"`
+	NewTransitionBlocks uint64 `protobuf:"varint,2,opt,name=new_transition_blocks,json=newTransitionBlocks,proto3" json:"new_transition_blocks,omitempty"`
+}
+
+func (m *EventValidatorTransitionWindowUpdate) Reset()         { *m = EventValidatorTransitionWindowUpdate{} }
+func (m *EventValidatorTransitionWindowUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventValidatorTransitionWindowUpdate) ProtoMessage()    {}
+func (*EventValidatorTransitionWindowUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_486bfc4df1202b88, []int{28}
+}
+func (m *EventValidatorTransitionWindowUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventValidatorTransitionWindowUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventValidatorTransitionWindowUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventValidatorTransitionWindowUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventValidatorTransitionWindowUpdate.Merge(m, src)
+}
+func (m *EventValidatorTransitionWindowUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventValidatorTransitionWindowUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventValidatorTransitionWindowUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventValidatorTransitionWindowUpdate proto.InternalMessageInfo
+
+func (m *EventValidatorTransitionWindowUpdate) GetOldTransitionBlocks() uint64 {
+	if m != nil {
+		return m.OldTransitionBlocks
+	}
+	return 0
+}
+
+func (m *EventValidatorTransitionWindowUpdate) GetNewTransitionBlocks() uint64 {
+	if m != nil {
+		return m.NewTransitionBlocks
+	}
+	return 0
+}
+
+// EventGuardianValidatorBonded is emitted for the validator of a guardian
+// added to the consensus guardian set.
+type EventGuardianValidatorBonded struct {
+	GuardianKey      []byte `protobuf:"bytes,1,opt,name=guardian_key,json=guardianKey,proto3" json:"guardian_key,omitempty"`
+	ValidatorAddr    []byte `protobuf:"bytes,2,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	GuardianSetIndex uint32 `protobuf:"varint,3,opt,name=guardian_set_index,json=guardianSetIndex,proto3" json:"guardian_set_index,omitempty"`
+}
+
+func (m *EventGuardianValidatorBonded) Reset()         { *m = EventGuardianValidatorBonded{} }
+func (m *EventGuardianValidatorBonded) String() string { return proto.CompactTextString(m) }
+func (*EventGuardianValidatorBonded) ProtoMessage()    {}
+func (*EventGuardianValidatorBonded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_486bfc4df1202b88, []int{29}
+}
+func (m *EventGuardianValidatorBonded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventGuardianValidatorBonded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventGuardianValidatorBonded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventGuardianValidatorBonded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventGuardianValidatorBonded.Merge(m, src)
+}
+func (m *EventGuardianValidatorBonded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventGuardianValidatorBonded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventGuardianValidatorBonded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventGuardianValidatorBonded proto.InternalMessageInfo
+
+func (m *EventGuardianValidatorBonded) GetGuardianKey() []byte {
+	if m != nil {
+		return m.GuardianKey
+	}
+	return nil
+}
+
+func (m *EventGuardianValidatorBonded) GetValidatorAddr() []byte {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return nil
+}
+
+func (m *EventGuardianValidatorBonded) GetGuardianSetIndex() uint32 {
+	if m != nil {
+		return m.GuardianSetIndex
+	}
+	return 0
+}
+
+// EventGuardianValidatorUnbonding is emitted for the validator of a guardian
+// removed from the consensus guardian set, which stays bonded until the
+// unbond height.
+type EventGuardianValidatorUnbonding struct {
+	GuardianKey   []byte `protobuf:"bytes,1,opt,name=guardian_key,json=guardianKey,proto3" json:"guardian_key,omitempty"`
+	ValidatorAddr []byte `protobuf:"bytes,2,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	UnbondHeight  int64  `protobuf:"varint,3,opt,name=unbond_height,json=unbondHeight,proto3" json:"unbond_height,omitempty"`
+}
+
+func (m *EventGuardianValidatorUnbonding) Reset()         { *m = EventGuardianValidatorUnbonding{} }
+func (m *EventGuardianValidatorUnbonding) String() string { return proto.CompactTextString(m) }
+func (*EventGuardianValidatorUnbonding) ProtoMessage()    {}
+func (*EventGuardianValidatorUnbonding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_486bfc4df1202b88, []int{30}
+}
+func (m *EventGuardianValidatorUnbonding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventGuardianValidatorUnbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventGuardianValidatorUnbonding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventGuardianValidatorUnbonding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventGuardianValidatorUnbonding.Merge(m, src)
+}
+func (m *EventGuardianValidatorUnbonding) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventGuardianValidatorUnbonding) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventGuardianValidatorUnbonding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventGuardianValidatorUnbonding proto.InternalMessageInfo
+
+func (m *EventGuardianValidatorUnbonding) GetGuardianKey() []byte {
+	if m != nil {
+		return m.GuardianKey
+	}
+	return nil
+}
+
+func (m *EventGuardianValidatorUnbonding) GetValidatorAddr() []byte {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return nil
+}
+
+func (m *EventGuardianValidatorUnbonding) GetUnbondHeight() int64 {
+	if m != nil {
+		return m.UnbondHeight
+	}
+	return 0
+}
+
+// EventGuardianValidatorUnbonded is emitted at the end of the transition
+// window of the validator of a removed guardian.
+type EventGuardianValidatorUnbonded struct {
+	GuardianKey   []byte `protobuf:"bytes,1,opt,name=guardian_key,json=guardianKey,proto3" json:"guardian_key,omitempty"`
+	ValidatorAddr []byte `protobuf:"bytes,2,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+}
+
+func (m *EventGuardianValidatorUnbonded) Reset()         { *m = EventGuardianValidatorUnbonded{} }
+func (m *EventGuardianValidatorUnbonded) String() string { return proto.CompactTextString(m) }
+func (*EventGuardianValidatorUnbonded) ProtoMessage()    {}
+func (*EventGuardianValidatorUnbonded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_486bfc4df1202b88, []int{31}
+}
+func (m *EventGuardianValidatorUnbonded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventGuardianValidatorUnbonded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventGuardianValidatorUnbonded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventGuardianValidatorUnbonded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventGuardianValidatorUnbonded.Merge(m, src)
+}
+func (m *EventGuardianValidatorUnbonded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventGuardianValidatorUnbonded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventGuardianValidatorUnbonded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventGuardianValidatorUnbonded proto.InternalMessageInfo
+
+func (m *EventGuardianValidatorUnbonded) GetGuardianKey() []byte {
+	if m != nil {
+		return m.GuardianKey
+	}
+	return nil
+}
+
+func (m *EventGuardianValidatorUnbonded) GetValidatorAddr() []byte {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*EventGuardianSetUpdate)(nil), "wormhole_foundation.wormchain.wormhole.EventGuardianSetUpdate")
+	proto.RegisterType((*EventPostedMessage)(nil), "wormhole_foundation.wormchain.wormhole.EventPostedMessage")
+	proto.RegisterType((*EventGuardianRegistered)(nil), "wormhole_foundation.wormchain.wormhole.EventGuardianRegistered")
+	proto.RegisterType((*EventConsensusSetUpdate)(nil), "wormhole_foundation.wormchain.wormhole.EventConsensusSetUpdate")
+	proto.RegisterType((*EventFeeParamsUpdate)(nil), "wormhole_foundation.wormchain.wormhole.EventFeeParamsUpdate")
+	proto.RegisterType((*EventSignatureVerificationGasUpdate)(nil), "wormhole_foundation.wormchain.wormhole.EventSignatureVerificationGasUpdate")
+	proto.RegisterType((*EventEmitterRegistered)(nil), "wormhole_foundation.wormchain.wormhole.EventEmitterRegistered")
+	proto.RegisterType((*EventQuorumThresholdUpdate)(nil), "wormhole_foundation.wormchain.wormhole.EventQuorumThresholdUpdate")
+	proto.RegisterType((*EventVAAArchiveRetentionUpdate)(nil), "wormhole_foundation.wormchain.wormhole.EventVAAArchiveRetentionUpdate")
+	proto.RegisterType((*EventIbcComposabilityMwContractUpdate)(nil), "wormhole_foundation.wormchain.wormhole.EventIbcComposabilityMwContractUpdate")
+	proto.RegisterType((*EventCoreContractUpdate)(nil), "wormhole_foundation.wormchain.wormhole.EventCoreContractUpdate")
+	proto.RegisterType((*EventGuardianSetWeightsUpdate)(nil), "wormhole_foundation.wormchain.wormhole.EventGuardianSetWeightsUpdate")
+	proto.RegisterType((*EventObservationFinalized)(nil), "wormhole_foundation.wormchain.wormhole.EventObservationFinalized")
+	proto.RegisterType((*EventGovernanceSubmitterUpdate)(nil), "wormhole_foundation.wormchain.wormhole.EventGovernanceSubmitterUpdate")
+	proto.RegisterType((*EventChainRateLimitUpdate)(nil), "wormhole_foundation.wormchain.wormhole.EventChainRateLimitUpdate")
+	proto.RegisterType((*EventObservationQueued)(nil), "wormhole_foundation.wormchain.wormhole.EventObservationQueued")
+	proto.RegisterType((*EventMsgShutdownUpdate)(nil), "wormhole_foundation.wormchain.wormhole.EventMsgShutdownUpdate")
+	proto.RegisterType((*EventBridgePaused)(nil), "wormhole_foundation.wormchain.wormhole.EventBridgePaused")
+	proto.RegisterType((*EventBridgeResumed)(nil), "wormhole_foundation.wormchain.wormhole.EventBridgeResumed")
+	proto.RegisterType((*EventAllowlistEntryExpired)(nil), "wormhole_foundation.wormchain.wormhole.EventAllowlistEntryExpired")
+	proto.RegisterType((*EventIcaHostAllowlistUpdate)(nil), "wormhole_foundation.wormchain.wormhole.EventIcaHostAllowlistUpdate")
+	proto.RegisterType((*EventForwardFeeUpdate)(nil), "wormhole_foundation.wormchain.wormhole.EventForwardFeeUpdate")
+	proto.RegisterType((*EventMaintenanceWindowUpdate)(nil), "wormhole_foundation.wormchain.wormhole.EventMaintenanceWindowUpdate")
+	proto.RegisterType((*EventSequenceBlockReserved)(nil), "wormhole_foundation.wormchain.wormhole.EventSequenceBlockReserved")
+	proto.RegisterType((*EventTokenFactoryAdminUpdate)(nil), "wormhole_foundation.wormchain.wormhole.EventTokenFactoryAdminUpdate")
+	proto.RegisterType((*EventTokenFactoryMetadataUpdate)(nil), "wormhole_foundation.wormchain.wormhole.EventTokenFactoryMetadataUpdate")
+	proto.RegisterType((*EventGatewayTransfer)(nil), "wormhole_foundation.wormchain.wormhole.EventGatewayTransfer")
+	proto.RegisterType((*EventApprovedCodeHashUpdate)(nil), "wormhole_foundation.wormchain.wormhole.EventApprovedCodeHashUpdate")
+	proto.RegisterType((*EventValidatorTransitionWindowUpdate)(nil), "wormhole_foundation.wormchain.wormhole.EventValidatorTransitionWindowUpdate")
+	proto.RegisterType((*EventGuardianValidatorBonded)(nil), "wormhole_foundation.wormchain.wormhole.EventGuardianValidatorBonded")
+	proto.RegisterType((*EventGuardianValidatorUnbonding)(nil), "wormhole_foundation.wormchain.wormhole.EventGuardianValidatorUnbonding")
+	proto.RegisterType((*EventGuardianValidatorUnbonded)(nil), "wormhole_foundation.wormchain.wormhole.EventGuardianValidatorUnbonded")
+}
+
+func init() { proto.RegisterFile("wormhole/events.proto", fileDescriptor_486bfc4df1202b88) }
+
+var fileDescriptor_486bfc4df1202b88 = []byte{
+	// 1514 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x6f, 0x13, 0x47,
+	0x14, 0xc7, 0x71, 0xbe, 0x3c, 0xb1, 0x03, 0x2c, 0x49, 0x30, 0x10, 0x4c, 0xd8, 0x94, 0x0f, 0xa9,
+	0x6d, 0x52, 0xd1, 0x03, 0xea, 0x31, 0x89, 0x92, 0x10, 0xd1, 0xa8, 0x61, 0x9d, 0x10, 0xa9, 0xaa,
+	0x64, 0x8d, 0x77, 0x5e, 0xd6, 0x53, 0x76, 0x67, 0xcc, 0xcc, 0x6c, 0x16, 0x57, 0x2a, 0xa7, 0xde,
+	0x2a, 0x55, 0x1c, 0xaa, 0xfe, 0x4d, 0x3d, 0x72, 0xe4, 0x58, 0xc1, 0x3f, 0x52, 0xcd, 0xd7, 0xda,
+	0x4e, 0x02, 0xea, 0x01, 0xa9, 0xb7, 0x7d, 0xbf, 0x37, 0xef, 0x73, 0xde, 0x7b, 0xf3, 0x16, 0x2d,
+	0x16, 0x5c, 0x64, 0x3d, 0x9e, 0xc2, 0x3a, 0x9c, 0x02, 0x53, 0x72, 0xad, 0x2f, 0xb8, 0xe2, 0xc1,
+	0x7d, 0x0f, 0x77, 0x4e, 0x78, 0xce, 0x08, 0x56, 0x94, 0xb3, 0x35, 0x8d, 0xc5, 0x3d, 0x4c, 0xd9,
+	0x9a, 0xe7, 0x86, 0x7f, 0x56, 0xd0, 0xd2, 0xb6, 0x16, 0xdc, 0xcd, 0xb1, 0x20, 0x14, 0xb3, 0x36,
+	0xa8, 0xa3, 0x3e, 0xc1, 0x0a, 0x82, 0x5b, 0xa8, 0xc6, 0x53, 0xd2, 0xa1, 0x8c, 0xc0, 0xab, 0x66,
+	0x65, 0xa5, 0xf2, 0xb0, 0x11, 0xcd, 0xf2, 0x94, 0xec, 0x69, 0x5a, 0x33, 0x19, 0x14, 0x8e, 0x39,
+	0x61, 0x99, 0x0c, 0x0a, 0xcb, 0xbc, 0x8d, 0x10, 0x26, 0x04, 0x48, 0xe7, 0x05, 0x0c, 0x64, 0xb3,
+	0xba, 0x52, 0x7d, 0x58, 0x8f, 0x6a, 0x06, 0x79, 0x0a, 0x03, 0x19, 0xdc, 0x45, 0x75, 0x01, 0x19,
+	0x3f, 0xf5, 0x07, 0x26, 0xcd, 0x81, 0x39, 0x87, 0xe9, 0x23, 0xe1, 0x1f, 0x15, 0x14, 0x18, 0xb7,
+	0x0e, 0xb8, 0x54, 0x40, 0xf6, 0x41, 0x4a, 0x9c, 0x40, 0xd0, 0x44, 0x33, 0x90, 0x51, 0xa5, 0x40,
+	0x18, 0x87, 0xea, 0x91, 0x27, 0x83, 0x9b, 0x68, 0x56, 0xc2, 0xcb, 0x1c, 0x58, 0x0c, 0xc6, 0x9d,
+	0xc9, 0xa8, 0xa4, 0x83, 0x05, 0x34, 0xc5, 0xb8, 0x66, 0x54, 0x8d, 0x9f, 0x96, 0x08, 0x02, 0x34,
+	0xa9, 0x68, 0x06, 0xcd, 0x49, 0x73, 0xda, 0x7c, 0x6b, 0xfd, 0x7d, 0x3c, 0x48, 0x39, 0x26, 0xcd,
+	0x29, 0xab, 0xdf, 0x91, 0x21, 0x46, 0xd7, 0xc7, 0xd2, 0x14, 0x41, 0x42, 0xa5, 0x02, 0x01, 0x44,
+	0x87, 0x93, 0x38, 0x54, 0xc7, 0xe3, 0x3c, 0x9b, 0xf3, 0xd8, 0x53, 0x18, 0x04, 0xab, 0xa8, 0x71,
+	0x8a, 0x53, 0x4a, 0xb0, 0xe2, 0xc2, 0x9c, 0x99, 0x30, 0x67, 0xea, 0x25, 0xf8, 0x14, 0x06, 0x61,
+	0xdb, 0x99, 0xd8, 0xe2, 0x4c, 0x02, 0x93, 0xb9, 0xfc, 0x0c, 0x57, 0x11, 0xbe, 0xab, 0xa0, 0x05,
+	0xa3, 0x75, 0x07, 0xe0, 0x00, 0x0b, 0x9c, 0x49, 0xa7, 0xf2, 0x3e, 0xba, 0xac, 0x55, 0x66, 0x36,
+	0xb3, 0x9d, 0x13, 0x00, 0xa3, 0x78, 0x32, 0x6a, 0xf0, 0xd4, 0xe7, 0x7b, 0x07, 0xcc, 0x39, 0xad,
+	0x7d, 0xf4, 0x9c, 0xcd, 0x6f, 0x83, 0x41, 0x31, 0x72, 0xee, 0x31, 0x6a, 0x6a, 0x7d, 0x09, 0x56,
+	0x50, 0xe0, 0x41, 0x47, 0x09, 0xcc, 0xe4, 0x09, 0x08, 0x23, 0x50, 0x35, 0x02, 0x8b, 0x3c, 0x25,
+	0xbb, 0x96, 0x7d, 0xe8, 0xb8, 0x4e, 0x50, 0x1b, 0xb8, 0x50, 0xd0, 0xde, 0xcd, 0x22, 0x83, 0xe2,
+	0xbc, 0x60, 0x78, 0x8c, 0x56, 0x4d, 0x64, 0x6d, 0x9a, 0x30, 0xac, 0x72, 0x01, 0xcf, 0x41, 0xd0,
+	0x13, 0x1a, 0x9b, 0x5a, 0xdf, 0xc5, 0x3e, 0xd0, 0xeb, 0x68, 0xc6, 0x3a, 0x26, 0x5d, 0x80, 0xd3,
+	0xc6, 0x0f, 0xa9, 0x19, 0xd6, 0xb0, 0x74, 0x11, 0x4d, 0x1b, 0x3b, 0x32, 0x54, 0xae, 0x25, 0xb6,
+	0x6d, 0x6d, 0x8d, 0x5c, 0xf5, 0x12, 0x9a, 0xce, 0x38, 0xc9, 0x53, 0x9b, 0xab, 0x5a, 0xe4, 0xa8,
+	0xe0, 0x06, 0x9a, 0x35, 0x7d, 0xd5, 0xa1, 0xc4, 0xdd, 0xc0, 0x8c, 0xa1, 0xf7, 0x48, 0xf0, 0x00,
+	0x5d, 0x76, 0x35, 0xda, 0xc1, 0x84, 0x08, 0x90, 0xd2, 0xa4, 0xa3, 0x1e, 0xcd, 0x3b, 0x78, 0xc3,
+	0xa2, 0xe1, 0x4f, 0xe8, 0xa6, 0xb1, 0xfa, 0x2c, 0xe7, 0x22, 0xcf, 0x0e, 0x7b, 0x02, 0x64, 0x8f,
+	0xa7, 0xc4, 0x45, 0xb1, 0x8c, 0x6a, 0x2c, 0xcf, 0x40, 0xe8, 0x62, 0x71, 0x15, 0x30, 0x04, 0x82,
+	0x15, 0x34, 0x47, 0x80, 0xf1, 0x8c, 0x32, 0xc3, 0xb7, 0x2e, 0x8c, 0x42, 0xe1, 0x6f, 0x15, 0xd4,
+	0x32, 0xea, 0x9f, 0x6f, 0x6c, 0x6c, 0x88, 0xb8, 0x47, 0x4f, 0x21, 0x02, 0x05, 0x4c, 0xe7, 0xca,
+	0x99, 0xf8, 0x06, 0x2d, 0xe8, 0x44, 0x09, 0x0f, 0x77, 0xba, 0x29, 0x8f, 0x5f, 0xf8, 0xac, 0x05,
+	0x3c, 0x25, 0xa5, 0xc4, 0xa6, 0xe1, 0x68, 0x09, 0x9d, 0xc1, 0x73, 0x12, 0x36, 0x9d, 0x01, 0x83,
+	0xe2, 0x8c, 0x44, 0xf8, 0x7b, 0x05, 0xdd, 0x33, 0x6e, 0xec, 0x75, 0xe3, 0x2d, 0x9e, 0xf5, 0xb9,
+	0xc4, 0x5d, 0x9a, 0x52, 0x35, 0xd8, 0x2f, 0xb6, 0x38, 0x53, 0x02, 0xc7, 0x6a, 0xdc, 0x9b, 0xd8,
+	0xa1, 0x65, 0xf2, 0x6c, 0xe2, 0xb5, 0x37, 0x5e, 0xc0, 0x25, 0xd0, 0x7b, 0x73, 0x4e, 0x62, 0xc2,
+	0x4a, 0x30, 0x28, 0xce, 0x48, 0x84, 0xbf, 0x96, 0x1d, 0x27, 0xe0, 0x7f, 0x30, 0x9f, 0xa0, 0xdb,
+	0x67, 0x47, 0xef, 0x31, 0xd0, 0xa4, 0xa7, 0x7c, 0xe9, 0x7e, 0x85, 0x82, 0x72, 0xb2, 0x48, 0x50,
+	0x63, 0xfd, 0x7f, 0x25, 0x19, 0x4a, 0xd9, 0x39, 0xd0, 0x44, 0x33, 0x85, 0x15, 0x6f, 0x4e, 0xac,
+	0x54, 0x1f, 0x4e, 0x46, 0x9e, 0x0c, 0x07, 0xe8, 0x86, 0x31, 0xf4, 0x43, 0x57, 0x82, 0x38, 0x35,
+	0xfd, 0xb1, 0x43, 0x19, 0x4e, 0xe9, 0x2f, 0xb6, 0xa6, 0x09, 0x4d, 0x40, 0x2a, 0x37, 0xb8, 0x1c,
+	0xf5, 0x11, 0xe3, 0x13, 0x1f, 0x31, 0xbe, 0x84, 0xa6, 0xad, 0x35, 0xd7, 0xec, 0x8e, 0x0a, 0x0f,
+	0x5d, 0xd9, 0xed, 0xf2, 0x53, 0x10, 0x0c, 0xb3, 0x18, 0xda, 0x79, 0xd7, 0x16, 0xbe, 0x0b, 0xb2,
+	0x89, 0x66, 0xc6, 0x93, 0xeb, 0x49, 0xc3, 0x49, 0x53, 0x5e, 0x80, 0x6d, 0xaa, 0xd9, 0xc8, 0x93,
+	0xe1, 0x4b, 0x17, 0xd0, 0x96, 0x6e, 0xb2, 0x08, 0x2b, 0xf8, 0x9e, 0x66, 0xd4, 0x5f, 0xdd, 0x68,
+	0x33, 0x56, 0xc6, 0x9b, 0x71, 0x01, 0x4d, 0xa5, 0xfa, 0xa4, 0xab, 0x50, 0x4b, 0xe8, 0xe9, 0x5c,
+	0x50, 0x46, 0x78, 0xe1, 0xeb, 0xd7, 0x86, 0x50, 0xb7, 0xa0, 0xab, 0xdc, 0x23, 0xb4, 0x74, 0x36,
+	0x87, 0xcf, 0x72, 0xc8, 0x3f, 0x91, 0xc0, 0x55, 0xd4, 0xf0, 0x9d, 0x6f, 0xec, 0xbb, 0xdc, 0xd5,
+	0x1d, 0x68, 0x7c, 0x0f, 0x9f, 0x3b, 0xb5, 0xfb, 0x32, 0x69, 0xf7, 0x72, 0x45, 0x78, 0xe1, 0xdb,
+	0x71, 0x05, 0xd5, 0x33, 0x99, 0x74, 0xd4, 0xa0, 0x0f, 0x9d, 0x5c, 0xa4, 0x2e, 0x39, 0x28, 0x93,
+	0xc9, 0xe1, 0xa0, 0x0f, 0x47, 0x22, 0x35, 0x6f, 0x9e, 0x93, 0x71, 0x09, 0x2a, 0xe9, 0xf0, 0x1a,
+	0xba, 0x6a, 0xf4, 0x6e, 0x0a, 0x4a, 0x12, 0x38, 0xc0, 0xb9, 0x04, 0x12, 0x2e, 0xa0, 0x60, 0x04,
+	0x8c, 0x40, 0xe6, 0x19, 0x90, 0x50, 0xb8, 0xc1, 0xb3, 0xa1, 0x93, 0x9b, 0x52, 0xa9, 0xb6, 0x99,
+	0x12, 0x83, 0xed, 0x57, 0x7d, 0xaa, 0x47, 0xde, 0x97, 0xe8, 0xea, 0xf0, 0xe9, 0x1a, 0xbf, 0xa8,
+	0x2b, 0x25, 0xc3, 0xf7, 0xc0, 0x03, 0x74, 0xd9, 0x5d, 0xd1, 0x99, 0xf2, 0x9f, 0x77, 0xb0, 0x2f,
+	0xfd, 0xd7, 0xe8, 0x96, 0x1d, 0x03, 0x31, 0x7e, 0xc2, 0xe5, 0xd0, 0xb4, 0x8b, 0x7d, 0x15, 0x35,
+	0x62, 0xce, 0x18, 0xc4, 0x66, 0xaa, 0xb8, 0x7b, 0xac, 0x45, 0xf5, 0x21, 0xb8, 0x47, 0xce, 0x25,
+	0x68, 0xe2, 0x5c, 0x82, 0x46, 0x0a, 0xa8, 0x3a, 0x5e, 0x40, 0xc7, 0x68, 0xd1, 0xbe, 0x8a, 0x5c,
+	0x14, 0x58, 0x90, 0x1d, 0x00, 0x67, 0xb9, 0x85, 0xe6, 0x74, 0xdf, 0x9f, 0x00, 0x74, 0xba, 0x7d,
+	0xe9, 0x27, 0x2d, 0x4f, 0xf5, 0x91, 0xcd, 0xbe, 0xd4, 0x7c, 0xdd, 0xe5, 0x9e, 0x6f, 0xaf, 0x54,
+	0xbf, 0xbf, 0x96, 0x1f, 0xbe, 0x46, 0xcb, 0xf6, 0x3e, 0x31, 0x65, 0x0a, 0x4c, 0xc1, 0x1f, 0x9b,
+	0x32, 0x72, 0xfa, 0xef, 0xa2, 0xba, 0x54, 0x58, 0xa8, 0x4e, 0xcf, 0x76, 0x8b, 0x1d, 0xae, 0x73,
+	0x06, 0x7b, 0x62, 0x20, 0xbd, 0x3d, 0x01, 0x23, 0xfe, 0x80, 0xad, 0xd4, 0x1a, 0x30, 0xe2, 0xd8,
+	0xcb, 0xa8, 0xe6, 0x67, 0x8c, 0xdd, 0xad, 0x6a, 0xd1, 0x10, 0x08, 0xbb, 0xee, 0x32, 0xdb, 0x6e,
+	0xf9, 0x31, 0xd5, 0x1b, 0x81, 0xae, 0x59, 0x20, 0x9f, 0xd8, 0x9f, 0x16, 0xd0, 0x94, 0xf1, 0xc1,
+	0x77, 0x86, 0x21, 0x34, 0x1a, 0xf3, 0x9c, 0xf9, 0xa6, 0xb6, 0x44, 0xf8, 0xcc, 0xc5, 0x78, 0xc8,
+	0x5f, 0x00, 0xdb, 0xc1, 0xb1, 0xe2, 0x62, 0xb0, 0x41, 0x32, 0xea, 0x2b, 0x77, 0x01, 0x4d, 0x99,
+	0xa7, 0xc7, 0xdd, 0x9a, 0x25, 0xfc, 0x9a, 0x82, 0xf5, 0x41, 0x77, 0x57, 0x7a, 0x4d, 0x31, 0x82,
+	0xe1, 0x63, 0x74, 0xe7, 0x9c, 0xca, 0x7d, 0x50, 0x98, 0x60, 0x85, 0x3f, 0xa5, 0x75, 0xb8, 0xdf,
+	0x9c, 0x59, 0x10, 0x74, 0x57, 0x4a, 0x60, 0xc4, 0x45, 0x5a, 0x8b, 0x1c, 0xa5, 0x71, 0x9c, 0x99,
+	0x98, 0xac, 0x0f, 0x8e, 0xd2, 0xa5, 0x2b, 0x20, 0xa6, 0x7d, 0x0a, 0x4c, 0xb9, 0x7e, 0xb5, 0xeb,
+	0xe2, 0x7c, 0x09, 0x9b, 0x8e, 0xd5, 0xf9, 0x2f, 0x11, 0xb3, 0xa0, 0xd4, 0xa3, 0x21, 0x10, 0x5c,
+	0x41, 0x55, 0xbd, 0xb8, 0x4c, 0x19, 0xdd, 0xfa, 0x73, 0xb8, 0x7d, 0x4e, 0x8f, 0x6e, 0x9f, 0x77,
+	0x51, 0xbd, 0xa0, 0xaa, 0xd7, 0xf1, 0xeb, 0xe6, 0x8c, 0xa9, 0xcf, 0x39, 0x8d, 0x1d, 0x58, 0x28,
+	0x6c, 0xbb, 0x1e, 0xd9, 0xe8, 0xf7, 0x85, 0x5e, 0x8c, 0xb7, 0x38, 0x81, 0x27, 0x58, 0xf6, 0x86,
+	0x7b, 0x4d, 0xcc, 0x09, 0xf8, 0xee, 0x98, 0x8c, 0xa6, 0x35, 0xb9, 0x47, 0x74, 0xa2, 0x0d, 0xa3,
+	0x87, 0x65, 0xcf, 0x2d, 0x9a, 0xb3, 0xb1, 0x93, 0xd5, 0x8b, 0xf5, 0x17, 0x76, 0x0f, 0xf0, 0xbd,
+	0x6b, 0x32, 0x46, 0x75, 0x4f, 0x8d, 0x15, 0xea, 0x23, 0xa4, 0xf7, 0xb5, 0x8e, 0x2a, 0xb9, 0xe3,
+	0xeb, 0xc0, 0x35, 0x9e, 0x92, 0xa1, 0xa4, 0xdb, 0x07, 0x1e, 0x21, 0xbd, 0xaa, 0x5d, 0x20, 0x63,
+	0x8b, 0xea, 0x1a, 0x83, 0xe2, 0xac, 0x4c, 0xf8, 0x57, 0x05, 0x2d, 0x8f, 0xbd, 0x82, 0xa5, 0x63,
+	0x9b, 0x9c, 0x91, 0xff, 0xb6, 0x5e, 0xdf, 0x43, 0xf3, 0xe3, 0x33, 0xca, 0x85, 0xdd, 0x18, 0x1b,
+	0x50, 0x1f, 0x79, 0xd1, 0xaa, 0x17, 0xbf, 0x68, 0xe1, 0x9b, 0x0a, 0xba, 0x73, 0xb1, 0x63, 0x47,
+	0xac, 0xcb, 0x19, 0xa1, 0x2c, 0xf9, 0x8c, 0xbe, 0xad, 0xa2, 0x46, 0x6e, 0xd4, 0xfa, 0xbe, 0xd7,
+	0x6e, 0x55, 0xa3, 0xba, 0x05, 0x6d, 0xeb, 0x87, 0x3f, 0xfb, 0xc7, 0xf4, 0x62, 0x8f, 0x3e, 0x67,
+	0xb2, 0x36, 0xdb, 0x7f, 0xbf, 0x6f, 0x55, 0xde, 0xbe, 0x6f, 0x55, 0xfe, 0x79, 0xdf, 0xaa, 0xbc,
+	0xf9, 0xd0, 0xba, 0xf4, 0xf6, 0x43, 0xeb, 0xd2, 0xbb, 0x0f, 0xad, 0x4b, 0x3f, 0x7e, 0x97, 0x50,
+	0xd5, 0xcb, 0xbb, 0x6b, 0x31, 0xcf, 0xd6, 0xfd, 0x7f, 0xe4, 0xd7, 0xc3, 0xbf, 0xcc, 0xf5, 0xf2,
+	0x2f, 0x73, 0xfd, 0x55, 0xc9, 0x5f, 0xd7, 0x53, 0x5a, 0x76, 0xa7, 0xcd, 0xcf, 0xe9, 0xb7, 0xff,
+	0x0e, 0x00, 0xbf, 0x17, 0xf6, 0x9d, 0xb5, 0x0e, 0x00, 0x00,
+}
+
+func (m *EventGuardianSetUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventGuardianSetUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventGuardianSetUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemovedKeys) > 0 {
+		for iNdEx := len(m.RemovedKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovedKeys[iNdEx])
+			copy(dAtA[i:], m.RemovedKeys[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.RemovedKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AddedKeys) > 0 {
+		for iNdEx := len(m.AddedKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddedKeys[iNdEx])
+			copy(dAtA[i:], m.AddedKeys[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.AddedKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.NewIndex != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NewIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OldIndex != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OldIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPostedMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPostedMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPostedMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Time != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Emitter) > 0 {
+		i -= len(m.Emitter)
+		copy(dAtA[i:], m.Emitter)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Emitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventGuardianRegistered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventGuardianRegistered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventGuardianRegistered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorKey) > 0 {
+		i -= len(m.ValidatorKey)
+		copy(dAtA[i:], m.ValidatorKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ValidatorKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GuardianKey) > 0 {
+		i -= len(m.GuardianKey)
+		copy(dAtA[i:], m.GuardianKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.GuardianKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventConsensusSetUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventConsensusSetUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventConsensusSetUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewIndex != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NewIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OldIndex != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OldIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFeeParamsUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFeeParamsUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFeeParamsUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewGatewayTransferFee != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NewGatewayTransferFee))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.OldGatewayTransferFee != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OldGatewayTransferFee))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NewMessageFee != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NewMessageFee))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OldMessageFee != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OldMessageFee))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSignatureVerificationGasUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSignatureVerificationGasUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSignatureVerificationGasUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewGas != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NewGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OldGas != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OldGas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventEmitterRegistered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventEmitterRegistered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventEmitterRegistered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EmitterAddress) > 0 {
+		i -= len(m.EmitterAddress)
+		copy(dAtA[i:], m.EmitterAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.EmitterAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventQuorumThresholdUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventQuorumThresholdUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventQuorumThresholdUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Denominator != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Denominator))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Numerator != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Numerator))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventVAAArchiveRetentionUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventVAAArchiveRetentionUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventVAAArchiveRetentionUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewRetentionBlocks != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NewRetentionBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OldRetentionBlocks != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OldRetentionBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventIbcComposabilityMwContractUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventIbcComposabilityMwContractUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventIbcComposabilityMwContractUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewContractAddress) > 0 {
+		i -= len(m.NewContractAddress)
+		copy(dAtA[i:], m.NewContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OldContractAddress) > 0 {
+		i -= len(m.OldContractAddress)
+		copy(dAtA[i:], m.OldContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OldContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCoreContractUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCoreContractUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCoreContractUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewContractAddress) > 0 {
+		i -= len(m.NewContractAddress)
+		copy(dAtA[i:], m.NewContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OldContractAddress) > 0 {
+		i -= len(m.OldContractAddress)
+		copy(dAtA[i:], m.OldContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OldContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventGuardianSetWeightsUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventGuardianSetWeightsUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventGuardianSetWeightsUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weights) > 0 {
+		dAtA2 := make([]byte, len(m.Weights)*10)
+		var j1 int
+		for _, num := range m.Weights {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintEvents(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GuardianSetIndex != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GuardianSetIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventObservationFinalized) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventObservationFinalized) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventObservationFinalized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GuardianSetIndex != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GuardianSetIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Digest)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventGovernanceSubmitterUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventGovernanceSubmitterUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventGovernanceSubmitterUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Allowed {
+		i--
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventChainRateLimitUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventChainRateLimitUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventChainRateLimitUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowBlocks != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Limit != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventObservationQueued) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventObservationQueued) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventObservationQueued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EmitterChain != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EmitterChain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Digest)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMsgShutdownUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMsgShutdownUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMsgShutdownUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Shutdown {
+		i--
+		if m.Shutdown {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBridgePaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBridgePaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBridgePaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBridgeResumed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBridgeResumed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBridgeResumed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAllowlistEntryExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAllowlistEntryExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAllowlistEntryExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedAddress) > 0 {
+		i -= len(m.AllowedAddress)
+		copy(dAtA[i:], m.AllowedAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.AllowedAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventIcaHostAllowlistUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventIcaHostAllowlistUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventIcaHostAllowlistUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Allowed {
+		i--
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventForwardFeeUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventForwardFeeUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventForwardFeeUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewFeeBps != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NewFeeBps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OldFeeBps != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OldFeeBps))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMaintenanceWindowUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMaintenanceWindowUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMaintenanceWindowUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Contracts[iNdEx])
+			copy(dAtA[i:], m.Contracts[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Contracts[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSequenceBlockReserved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSequenceBlockReserved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSequenceBlockReserved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Start != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Emitter) > 0 {
+		i -= len(m.Emitter)
+		copy(dAtA[i:], m.Emitter)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Emitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTokenFactoryAdminUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTokenFactoryAdminUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTokenFactoryAdminUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewAdmin) > 0 {
+		i -= len(m.NewAdmin)
+		copy(dAtA[i:], m.NewAdmin)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewAdmin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTokenFactoryMetadataUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTokenFactoryMetadataUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTokenFactoryMetadataUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventGatewayTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventGatewayTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventGatewayTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WithPayload {
+		i--
+		if m.WithPayload {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RecipientChain != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RecipientChain))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventApprovedCodeHashUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventApprovedCodeHashUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventApprovedCodeHashUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CodeId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CodeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventValidatorTransitionWindowUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventValidatorTransitionWindowUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventValidatorTransitionWindowUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewTransitionBlocks != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NewTransitionBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OldTransitionBlocks != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OldTransitionBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventGuardianValidatorBonded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventGuardianValidatorBonded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventGuardianValidatorBonded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GuardianSetIndex != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GuardianSetIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GuardianKey) > 0 {
+		i -= len(m.GuardianKey)
+		copy(dAtA[i:], m.GuardianKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.GuardianKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventGuardianValidatorUnbonding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventGuardianValidatorUnbonding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventGuardianValidatorUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnbondHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.UnbondHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GuardianKey) > 0 {
+		i -= len(m.GuardianKey)
+		copy(dAtA[i:], m.GuardianKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.GuardianKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventGuardianValidatorUnbonded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventGuardianValidatorUnbonded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventGuardianValidatorUnbonded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GuardianKey) > 0 {
+		i -= len(m.GuardianKey)
+		copy(dAtA[i:], m.GuardianKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.GuardianKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventGuardianSetUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OldIndex != 0 {
+		n += 1 + sovEvents(uint64(m.OldIndex))
+	}
+	if m.NewIndex != 0 {
+		n += 1 + sovEvents(uint64(m.NewIndex))
+	}
+	if len(m.AddedKeys) > 0 {
+		for _, b := range m.AddedKeys {
+			l = len(b)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.RemovedKeys) > 0 {
+		for _, b := range m.RemovedKeys {
+			l = len(b)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventPostedMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Emitter)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEvents(uint64(m.Sequence))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovEvents(uint64(m.Nonce))
+	}
+	if m.Time != 0 {
+		n += 1 + sovEvents(uint64(m.Time))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventGuardianRegistered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GuardianKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ValidatorKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventConsensusSetUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OldIndex != 0 {
+		n += 1 + sovEvents(uint64(m.OldIndex))
+	}
+	if m.NewIndex != 0 {
+		n += 1 + sovEvents(uint64(m.NewIndex))
+	}
+	return n
+}
+
+func (m *EventFeeParamsUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OldMessageFee != 0 {
+		n += 1 + sovEvents(uint64(m.OldMessageFee))
+	}
+	if m.NewMessageFee != 0 {
+		n += 1 + sovEvents(uint64(m.NewMessageFee))
+	}
+	if m.OldGatewayTransferFee != 0 {
+		n += 1 + sovEvents(uint64(m.OldGatewayTransferFee))
+	}
+	if m.NewGatewayTransferFee != 0 {
+		n += 1 + sovEvents(uint64(m.NewGatewayTransferFee))
+	}
+	return n
+}
+
+func (m *EventSignatureVerificationGasUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OldGas != 0 {
+		n += 1 + sovEvents(uint64(m.OldGas))
+	}
+	if m.NewGas != 0 {
+		n += 1 + sovEvents(uint64(m.NewGas))
+	}
+	return n
+}
+
+func (m *EventEmitterRegistered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovEvents(uint64(m.ChainId))
+	}
+	l = len(m.EmitterAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventQuorumThresholdUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Numerator != 0 {
+		n += 1 + sovEvents(uint64(m.Numerator))
+	}
+	if m.Denominator != 0 {
+		n += 1 + sovEvents(uint64(m.Denominator))
+	}
+	return n
+}
+
+func (m *EventVAAArchiveRetentionUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OldRetentionBlocks != 0 {
+		n += 1 + sovEvents(uint64(m.OldRetentionBlocks))
+	}
+	if m.NewRetentionBlocks != 0 {
+		n += 1 + sovEvents(uint64(m.NewRetentionBlocks))
+	}
+	return n
+}
+
+func (m *EventIbcComposabilityMwContractUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OldContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCoreContractUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OldContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventGuardianSetWeightsUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GuardianSetIndex != 0 {
+		n += 1 + sovEvents(uint64(m.GuardianSetIndex))
+	}
+	if len(m.Weights) > 0 {
+		l = 0
+		for _, e := range m.Weights {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *EventObservationFinalized) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.GuardianSetIndex != 0 {
+		n += 1 + sovEvents(uint64(m.GuardianSetIndex))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovEvents(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *EventGovernanceSubmitterUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Allowed {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventChainRateLimitUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovEvents(uint64(m.ChainId))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovEvents(uint64(m.Limit))
+	}
+	if m.WindowBlocks != 0 {
+		n += 1 + sovEvents(uint64(m.WindowBlocks))
+	}
+	return n
+}
+
+func (m *EventObservationQueued) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.EmitterChain != 0 {
+		n += 1 + sovEvents(uint64(m.EmitterChain))
+	}
+	return n
+}
+
+func (m *EventMsgShutdownUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Shutdown {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventBridgePaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EventBridgeResumed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EventAllowlistEntryExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.AllowedAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventIcaHostAllowlistUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Allowed {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventForwardFeeUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OldFeeBps != 0 {
+		n += 1 + sovEvents(uint64(m.OldFeeBps))
+	}
+	if m.NewFeeBps != 0 {
+		n += 1 + sovEvents(uint64(m.NewFeeBps))
+	}
+	return n
+}
+
+func (m *EventMaintenanceWindowUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovEvents(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovEvents(uint64(m.EndHeight))
+	}
+	if len(m.Contracts) > 0 {
+		for _, s := range m.Contracts {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventSequenceBlockReserved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Emitter)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Start != 0 {
+		n += 1 + sovEvents(uint64(m.Start))
+	}
+	if m.Count != 0 {
+		n += 1 + sovEvents(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *EventTokenFactoryAdminUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewAdmin)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventTokenFactoryMetadataUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventGatewayTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.RecipientChain != 0 {
+		n += 1 + sovEvents(uint64(m.RecipientChain))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovEvents(uint64(m.Nonce))
+	}
+	if m.WithPayload {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventApprovedCodeHashUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeId != 0 {
+		n += 1 + sovEvents(uint64(m.CodeId))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventValidatorTransitionWindowUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OldTransitionBlocks != 0 {
+		n += 1 + sovEvents(uint64(m.OldTransitionBlocks))
+	}
+	if m.NewTransitionBlocks != 0 {
+		n += 1 + sovEvents(uint64(m.NewTransitionBlocks))
+	}
+	return n
+}
+
+func (m *EventGuardianValidatorBonded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GuardianKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.GuardianSetIndex != 0 {
+		n += 1 + sovEvents(uint64(m.GuardianSetIndex))
+	}
+	return n
+}
+
+func (m *EventGuardianValidatorUnbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GuardianKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.UnbondHeight != 0 {
+		n += 1 + sovEvents(uint64(m.UnbondHeight))
+	}
+	return n
+}
+
+func (m *EventGuardianValidatorUnbonded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GuardianKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventGuardianSetUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventGuardianSetUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventGuardianSetUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldIndex", wireType)
+			}
+			m.OldIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewIndex", wireType)
+			}
+			m.NewIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddedKeys = append(m.AddedKeys, make([]byte, postIndex-iNdEx))
+			copy(m.AddedKeys[len(m.AddedKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedKeys = append(m.RemovedKeys, make([]byte, postIndex-iNdEx))
+			copy(m.RemovedKeys[len(m.RemovedKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPostedMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPostedMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPostedMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Emitter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Emitter = append(m.Emitter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Emitter == nil {
+				m.Emitter = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventGuardianRegistered) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventGuardianRegistered: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventGuardianRegistered: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GuardianKey = append(m.GuardianKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.GuardianKey == nil {
+				m.GuardianKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorKey = append(m.ValidatorKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorKey == nil {
+				m.ValidatorKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventConsensusSetUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventConsensusSetUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventConsensusSetUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldIndex", wireType)
+			}
+			m.OldIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewIndex", wireType)
+			}
+			m.NewIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFeeParamsUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFeeParamsUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFeeParamsUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldMessageFee", wireType)
+			}
+			m.OldMessageFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldMessageFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewMessageFee", wireType)
+			}
+			m.NewMessageFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewMessageFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldGatewayTransferFee", wireType)
+			}
+			m.OldGatewayTransferFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldGatewayTransferFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewGatewayTransferFee", wireType)
+			}
+			m.NewGatewayTransferFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewGatewayTransferFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSignatureVerificationGasUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSignatureVerificationGasUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSignatureVerificationGasUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldGas", wireType)
+			}
+			m.OldGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewGas", wireType)
+			}
+			m.NewGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventEmitterRegistered) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventEmitterRegistered: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventEmitterRegistered: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmitterAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmitterAddress = append(m.EmitterAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.EmitterAddress == nil {
+				m.EmitterAddress = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventQuorumThresholdUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventQuorumThresholdUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventQuorumThresholdUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Numerator", wireType)
+			}
+			m.Numerator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Numerator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denominator", wireType)
+			}
+			m.Denominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Denominator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventVAAArchiveRetentionUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventVAAArchiveRetentionUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventVAAArchiveRetentionUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldRetentionBlocks", wireType)
+			}
+			m.OldRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRetentionBlocks", wireType)
+			}
+			m.NewRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventIbcComposabilityMwContractUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventIbcComposabilityMwContractUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventIbcComposabilityMwContractUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCoreContractUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCoreContractUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCoreContractUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventGuardianSetWeightsUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventGuardianSetWeightsUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventGuardianSetWeightsUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianSetIndex", wireType)
+			}
+			m.GuardianSetIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GuardianSetIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Weights = append(m.Weights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Weights) == 0 {
+					m.Weights = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Weights = append(m.Weights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventObservationFinalized) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventObservationFinalized: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventObservationFinalized: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = append(m.Digest[:0], dAtA[iNdEx:postIndex]...)
+			if m.Digest == nil {
+				m.Digest = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianSetIndex", wireType)
+			}
+			m.GuardianSetIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GuardianSetIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventGovernanceSubmitterUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventGovernanceSubmitterUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventGovernanceSubmitterUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventChainRateLimitUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventChainRateLimitUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventChainRateLimitUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventObservationQueued) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventObservationQueued: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventObservationQueued: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = append(m.Digest[:0], dAtA[iNdEx:postIndex]...)
+			if m.Digest == nil {
+				m.Digest = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmitterChain", wireType)
+			}
+			m.EmitterChain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EmitterChain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMsgShutdownUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMsgShutdownUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMsgShutdownUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shutdown", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Shutdown = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBridgePaused) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBridgePaused: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBridgePaused: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBridgeResumed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBridgeResumed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBridgeResumed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAllowlistEntryExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAllowlistEntryExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAllowlistEntryExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventIcaHostAllowlistUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventIcaHostAllowlistUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventIcaHostAllowlistUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventForwardFeeUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventForwardFeeUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventForwardFeeUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldFeeBps", wireType)
+			}
+			m.OldFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFeeBps", wireType)
+			}
+			m.NewFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMaintenanceWindowUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMaintenanceWindowUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMaintenanceWindowUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSequenceBlockReserved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSequenceBlockReserved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSequenceBlockReserved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Emitter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Emitter = append(m.Emitter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Emitter == nil {
+				m.Emitter = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTokenFactoryAdminUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTokenFactoryAdminUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTokenFactoryAdminUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTokenFactoryMetadataUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTokenFactoryMetadataUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTokenFactoryMetadataUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventGatewayTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventGatewayTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventGatewayTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientChain", wireType)
+			}
+			m.RecipientChain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecipientChain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = append(m.Recipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.Recipient == nil {
+				m.Recipient = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithPayload", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithPayload = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *EventConsensusSetUpdate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OldIndex != 0 {
-		n += 1 + sovEvents(uint64(m.OldIndex))
-	}
-	if m.NewIndex != 0 {
-		n += 1 + sovEvents(uint64(m.NewIndex))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventGuardianSetUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventApprovedCodeHashUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -558,17 +6886,17 @@ func (m *EventGuardianSetUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventGuardianSetUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventApprovedCodeHashUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventGuardianSetUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventApprovedCodeHashUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
 			}
-			m.OldIndex = 0
+			m.CodeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -578,16 +6906,16 @@ func (m *EventGuardianSetUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OldIndex |= uint32(b&0x7F) << shift
+				m.CodeId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
 			}
-			m.NewIndex = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -597,11 +6925,26 @@ func (m *EventGuardianSetUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NewIndex |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeHash == nil {
+				m.CodeHash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -623,7 +6966,7 @@ func (m *EventGuardianSetUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventPostedMessage) Unmarshal(dAtA []byte) error {
+func (m *EventValidatorTransitionWindowUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -646,17 +6989,17 @@ func (m *EventPostedMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventPostedMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventValidatorTransitionWindowUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPostedMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventValidatorTransitionWindowUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Emitter", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldTransitionBlocks", wireType)
 			}
-			var byteLen int
+			m.OldTransitionBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -666,31 +7009,16 @@ func (m *EventPostedMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.OldTransitionBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Emitter = append(m.Emitter[:0], dAtA[iNdEx:postIndex]...)
-			if m.Emitter == nil {
-				m.Emitter = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewTransitionBlocks", wireType)
 			}
-			m.Sequence = 0
+			m.NewTransitionBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -700,35 +7028,66 @@ func (m *EventPostedMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				m.NewTransitionBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Time = 0
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventGuardianValidatorBonded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventGuardianValidatorBonded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventGuardianValidatorBonded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianKey", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -738,14 +7097,29 @@ func (m *EventPostedMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Time |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GuardianKey = append(m.GuardianKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.GuardianKey == nil {
+				m.GuardianKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -772,11 +7146,30 @@ func (m *EventPostedMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
+			m.ValidatorAddr = append(m.ValidatorAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddr == nil {
+				m.ValidatorAddr = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianSetIndex", wireType)
+			}
+			m.GuardianSetIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GuardianSetIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -798,7 +7191,7 @@ func (m *EventPostedMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventGuardianRegistered) Unmarshal(dAtA []byte) error {
+func (m *EventGuardianValidatorUnbonding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -821,10 +7214,10 @@ func (m *EventGuardianRegistered) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventGuardianRegistered: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventGuardianValidatorUnbonding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventGuardianRegistered: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventGuardianValidatorUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -863,7 +7256,7 @@ func (m *EventGuardianRegistered) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -890,11 +7283,30 @@ func (m *EventGuardianRegistered) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorKey = append(m.ValidatorKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.ValidatorKey == nil {
-				m.ValidatorKey = []byte{}
+			m.ValidatorAddr = append(m.ValidatorAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddr == nil {
+				m.ValidatorAddr = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondHeight", wireType)
+			}
+			m.UnbondHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -916,7 +7328,7 @@ func (m *EventGuardianRegistered) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventConsensusSetUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventGuardianValidatorUnbonded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -939,17 +7351,17 @@ func (m *EventConsensusSetUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventConsensusSetUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventGuardianValidatorUnbonded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventConsensusSetUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventGuardianValidatorUnbonded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianKey", wireType)
 			}
-			m.OldIndex = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -959,16 +7371,31 @@ func (m *EventConsensusSetUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OldIndex |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GuardianKey = append(m.GuardianKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.GuardianKey == nil {
+				m.GuardianKey = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
 			}
-			m.NewIndex = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -978,11 +7405,26 @@ func (m *EventConsensusSetUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NewIndex |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = append(m.ValidatorAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddr == nil {
+				m.ValidatorAddr = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -3,14 +3,20 @@ package types
 import (
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 type AccountKeeper interface {
-	// Methods imported from account should be defined here
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
 }
 
 type BankKeeper interface {
-	// Methods imported from bank should be defined here
+	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	// For the supply and holders of wrapped assets
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
+	IterateAllBalances(ctx sdk.Context, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool))
 }
 
 type WasmdKeeper interface {
@@ -18,4 +24,24 @@ type WasmdKeeper interface {
 	Create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, instantiateAccess *wasmtypes.AccessConfig) (codeID uint64, checksum []byte, err error)
 	Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins) (sdk.AccAddress, []byte, error)
 	Migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte) ([]byte, error)
+	// For gateway transfers
+	Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error)
+}
+
+type WasmViewKeeper interface {
+	QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error)
+	// For reading the emitter sequences of core contracts
+	QueryRaw(ctx sdk.Context, contractAddress sdk.AccAddress, key []byte) []byte
+	// For verifying the code hashes of instantiations and migrations
+	GetCodeInfo(ctx sdk.Context, codeID uint64) *wasmtypes.CodeInfo
+}
+
+type TokenFactoryKeeper interface {
+	ForceChangeAdmin(ctx sdk.Context, denom string, newAdmin string) error
+	ForceSetDenomMetadata(ctx sdk.Context, metadata banktypes.Metadata) error
+}
+
+type ConsensusParamsKeeper interface {
+	GetConsensusParams(ctx sdk.Context) *abci.ConsensusParams
+	StoreConsensusParams(ctx sdk.Context, cp *abci.ConsensusParams)
 }
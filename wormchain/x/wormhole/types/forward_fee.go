@@ -0,0 +1,81 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+)
+
+// MaxForwardFeeBps is the largest forward fee, a fee of 100%.
+const MaxForwardFeeBps = 10000
+
+// ForwardVolumeEntryKey returns the store key of the volume of a denom
+// forwarded over a channel. Channel IDs can not contain a slash, so the keys
+// of a channel share the prefix ForwardVolumeChannelKey(channelID).
+func ForwardVolumeEntryKey(channelID string, denom string) []byte {
+	return append(ForwardVolumeChannelKey(channelID), denom...)
+}
+
+// ForwardVolumeChannelKey returns the key prefix of the volumes forwarded
+// over a channel.
+func ForwardVolumeChannelKey(channelID string) []byte {
+	return []byte(channelID + "/")
+}
+
+// Validate checks that the volume names a valid channel and denom and that
+// its amounts are not negative.
+func (v ForwardVolume) Validate() error {
+	if err := host.ChannelIdentifierValidator(v.ChannelId); err != nil {
+		return fmt.Errorf("invalid channel id: %w", err)
+	}
+	if err := sdk.ValidateDenom(v.Denom); err != nil {
+		return err
+	}
+	if v.Amount.IsNil() || v.Amount.IsNegative() {
+		return fmt.Errorf("invalid amount %s", v.Amount)
+	}
+	if v.Fees.IsNil() || v.Fees.IsNegative() {
+		return fmt.Errorf("invalid fees %s", v.Fees)
+	}
+	return nil
+}
+
+// PendingForwardFeeEntryKey returns the store key of the pending forward fee
+// of the packet sent with a sequence over a port and channel.
+func PendingForwardFeeEntryKey(portID string, channelID string, sequence uint64) []byte {
+	key := []byte(portID + "/" + channelID + "/")
+	return binary.BigEndian.AppendUint64(key, sequence)
+}
+
+// Validate checks that the pending fee names a valid packet and denom, that
+// its amounts are not negative and, unless it is nonrefundable, that it names
+// the channel the source chain is refunded over.
+func (f PendingForwardFee) Validate() error {
+	if err := host.PortIdentifierValidator(f.PortId); err != nil {
+		return fmt.Errorf("invalid port id: %w", err)
+	}
+	if err := host.ChannelIdentifierValidator(f.ChannelId); err != nil {
+		return fmt.Errorf("invalid channel id: %w", err)
+	}
+	if err := sdk.ValidateDenom(f.Denom); err != nil {
+		return err
+	}
+	if f.Amount.IsNil() || f.Amount.IsNegative() {
+		return fmt.Errorf("invalid amount %s", f.Amount)
+	}
+	if f.Fee.IsNil() || f.Fee.IsNegative() {
+		return fmt.Errorf("invalid fee %s", f.Fee)
+	}
+	if f.Nonrefundable {
+		return nil
+	}
+	if err := host.PortIdentifierValidator(f.RefundPortId); err != nil {
+		return fmt.Errorf("invalid refund port id: %w", err)
+	}
+	if err := host.ChannelIdentifierValidator(f.RefundChannelId); err != nil {
+		return fmt.Errorf("invalid refund channel id: %w", err)
+	}
+	return nil
+}
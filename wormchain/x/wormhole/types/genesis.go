@@ -2,6 +2,9 @@ package types
 
 import (
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
 
 // DefaultIndex is the default capability global index
@@ -33,6 +36,20 @@ func (gs GenesisState) Validate() error {
 		}
 		guardianSetIdMap[elem.Index] = true
 	}
+	// Check that activation heights belong to a unique guardianSet
+	guardianSetActivationHeightIdMap := make(map[uint32]bool)
+	for _, elem := range gs.GuardianSetActivationHeightList {
+		if !guardianSetIdMap[elem.Index] {
+			return fmt.Errorf("activation height for unknown guardianSet %d", elem.Index)
+		}
+		if guardianSetActivationHeightIdMap[elem.Index] {
+			return fmt.Errorf("duplicated id for guardianSetActivationHeight")
+		}
+		if elem.Height < 0 {
+			return fmt.Errorf("negative activation height for guardianSet %d", elem.Index)
+		}
+		guardianSetActivationHeightIdMap[elem.Index] = true
+	}
 	// Check for duplicated index in replayProtection
 	replayProtectionIndexMap := make(map[string]struct{})
 
@@ -43,6 +60,19 @@ func (gs GenesisState) Validate() error {
 		}
 		replayProtectionIndexMap[index] = struct{}{}
 	}
+	// Check for duplicated digest in executedGovernanceVAA
+	executedGovernanceVAAIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ExecutedGovernanceVaaList {
+		if len(elem.Digest) != 32 {
+			return fmt.Errorf("invalid digest length %d for executedGovernanceVAA", len(elem.Digest))
+		}
+		index := string(ExecutedGovernanceVAAKey(elem.Digest))
+		if _, ok := executedGovernanceVAAIndexMap[index]; ok {
+			return fmt.Errorf("duplicated digest for executedGovernanceVAA")
+		}
+		executedGovernanceVAAIndexMap[index] = struct{}{}
+	}
 	// Check for duplicated index in sequenceCounter
 	sequenceCounterIndexMap := make(map[string]struct{})
 
@@ -63,6 +93,258 @@ func (gs GenesisState) Validate() error {
 		}
 		guardianValidatorIndexMap[index] = struct{}{}
 	}
+	// Check for duplicated index in registeredEmitter
+	registeredEmitterIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.RegisteredEmitterList {
+		if _, err := vaa.ChainIDFromNumber(elem.ChainId); err != nil {
+			return fmt.Errorf("invalid chain id %d for registeredEmitter", elem.ChainId)
+		}
+		index := string(RegisteredEmitterKey(elem.Module, uint16(elem.ChainId)))
+		if _, ok := registeredEmitterIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for registeredEmitter")
+		}
+		registeredEmitterIndexMap[index] = struct{}{}
+	}
+	// Check the ibcComposabilityMwContract address if it is set
+	if gs.IbcComposabilityMwContract.ContractAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(gs.IbcComposabilityMwContract.ContractAddress); err != nil {
+			return fmt.Errorf("invalid address %s for ibcComposabilityMwContract: %w", gs.IbcComposabilityMwContract.ContractAddress, err)
+		}
+	}
+	// Check the coreContract address if it is set
+	if gs.CoreContract.ContractAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(gs.CoreContract.ContractAddress); err != nil {
+			return fmt.Errorf("invalid address %s for coreContract: %w", gs.CoreContract.ContractAddress, err)
+		}
+	}
+	// Check for duplicated code id or invalid hash in approvedCodeHash
+	approvedCodeHashIndexMap := make(map[uint64]struct{})
+
+	for _, elem := range gs.ApprovedCodeHashList {
+		if len(elem.CodeHash) != 32 {
+			return fmt.Errorf("invalid code hash length %d for approvedCodeHash of code id %d", len(elem.CodeHash), elem.CodeId)
+		}
+		if _, ok := approvedCodeHashIndexMap[elem.CodeId]; ok {
+			return fmt.Errorf("duplicated index for approvedCodeHash")
+		}
+		approvedCodeHashIndexMap[elem.CodeId] = struct{}{}
+	}
+	// Check for duplicated or invalid address in governanceSubmitter
+	governanceSubmitterIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.GovernanceSubmitterList {
+		if _, err := sdk.AccAddressFromBech32(elem.Address); err != nil {
+			return fmt.Errorf("invalid address %s for governanceSubmitter: %w", elem.Address, err)
+		}
+		if _, ok := governanceSubmitterIndexMap[elem.Address]; ok {
+			return fmt.Errorf("duplicated address for governanceSubmitter")
+		}
+		governanceSubmitterIndexMap[elem.Address] = struct{}{}
+	}
+
+	// Check for duplicated or invalid archivedVAA
+	archivedVAAIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ArchivedVaaList {
+		if _, err := vaa.ChainIDFromNumber(elem.EmitterChain); err != nil || len(elem.EmitterAddress) != 32 {
+			return fmt.Errorf("invalid emitter %d/%x for archivedVAA", elem.EmitterChain, elem.EmitterAddress)
+		}
+		if len(elem.Digest) != 32 {
+			return fmt.Errorf("invalid digest %x for archivedVAA", elem.Digest)
+		}
+		if elem.Height < 0 {
+			return fmt.Errorf("negative height for archivedVAA")
+		}
+		index := string(ArchivedVAAKey(uint16(elem.EmitterChain), elem.EmitterAddress, elem.Sequence))
+		if _, ok := archivedVAAIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for archivedVAA")
+		}
+		archivedVAAIndexMap[index] = struct{}{}
+	}
+	// Check that guardianSetWeights belong to a unique guardianSet and match its size
+	guardianSetSizes := make(map[uint32]int)
+	for _, elem := range gs.GuardianSetList {
+		guardianSetSizes[elem.Index] = len(elem.Keys)
+	}
+	guardianSetWeightsIdMap := make(map[uint32]bool)
+	for _, elem := range gs.GuardianSetWeightsList {
+		size, ok := guardianSetSizes[elem.GuardianSetIndex]
+		if !ok {
+			return fmt.Errorf("weights for unknown guardianSet %d", elem.GuardianSetIndex)
+		}
+		if guardianSetWeightsIdMap[elem.GuardianSetIndex] {
+			return fmt.Errorf("duplicated id for guardianSetWeights")
+		}
+		if err := elem.Validate(size); err != nil {
+			return fmt.Errorf("invalid weights for guardianSet %d: %w", elem.GuardianSetIndex, err)
+		}
+		guardianSetWeightsIdMap[elem.GuardianSetIndex] = true
+	}
+	// Check for duplicated or invalid digest in observationTally
+	observationTallyIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ObservationTallyList {
+		if len(elem.Digest) != 32 {
+			return fmt.Errorf("invalid digest length %d for observationTally", len(elem.Digest))
+		}
+		if _, ok := observationTallyIndexMap[string(elem.Digest)]; ok {
+			return fmt.Errorf("duplicated digest for observationTally")
+		}
+		observationTallyIndexMap[string(elem.Digest)] = struct{}{}
+	}
+	// Check for duplicated or invalid digest in finalizedObservation
+	finalizedObservationIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.FinalizedObservationList {
+		if len(elem.Digest) != 32 {
+			return fmt.Errorf("invalid digest length %d for finalizedObservation", len(elem.Digest))
+		}
+		if _, ok := finalizedObservationIndexMap[string(elem.Digest)]; ok {
+			return fmt.Errorf("duplicated digest for finalizedObservation")
+		}
+		finalizedObservationIndexMap[string(elem.Digest)] = struct{}{}
+	}
+	// Check for duplicated or invalid chainRateLimit
+	chainRateLimitIdMap := make(map[uint32]bool)
+	for _, elem := range gs.ChainRateLimitList {
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid chainRateLimit: %w", err)
+		}
+		if chainRateLimitIdMap[elem.ChainId] {
+			return fmt.Errorf("duplicated id for chainRateLimit")
+		}
+		chainRateLimitIdMap[elem.ChainId] = true
+	}
+	// Check for duplicated or invalid rateLimitFlow
+	rateLimitFlowIndexMap := make(map[string]struct{})
+	for _, elem := range gs.RateLimitFlowList {
+		if _, err := vaa.ChainIDFromNumber(elem.ChainId); err != nil {
+			return fmt.Errorf("invalid chain id %d for rateLimitFlow", elem.ChainId)
+		}
+		index := string(RateLimitFlowKey(uint16(elem.ChainId), elem.Height))
+		if _, ok := rateLimitFlowIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for rateLimitFlow")
+		}
+		rateLimitFlowIndexMap[index] = struct{}{}
+	}
+	// Check that queuedObservations are unique and have a queued tally
+	queuedObservationIndexMap := make(map[string]struct{})
+	for _, elem := range gs.QueuedObservationList {
+		if _, err := vaa.ChainIDFromNumber(elem.EmitterChain); err != nil {
+			return fmt.Errorf("invalid emitter chain %d for queuedObservation", elem.EmitterChain)
+		}
+		if _, ok := observationTallyIndexMap[string(elem.Digest)]; !ok {
+			return fmt.Errorf("queuedObservation without observationTally")
+		}
+		if _, ok := queuedObservationIndexMap[string(elem.Digest)]; ok {
+			return fmt.Errorf("duplicated digest for queuedObservation")
+		}
+		queuedObservationIndexMap[string(elem.Digest)] = struct{}{}
+	}
+	// Check for duplicated or invalid msgShutdown
+	msgShutdownIndexMap := make(map[string]struct{})
+	for _, elem := range gs.MsgShutdownList {
+		if err := ValidateShutdownMsgTypeURL(elem); err != nil {
+			return fmt.Errorf("invalid msgShutdown: %w", err)
+		}
+		if _, ok := msgShutdownIndexMap[elem]; ok {
+			return fmt.Errorf("duplicated msgShutdown")
+		}
+		msgShutdownIndexMap[elem] = struct{}{}
+	}
+	// Check for duplicated or invalid guardianHeartbeat
+	guardianHeartbeatIndexMap := make(map[string]struct{})
+	for _, elem := range gs.GuardianHeartbeatList {
+		if len(elem.GuardianKey) != 20 {
+			return fmt.Errorf("invalid guardian key length %d for guardianHeartbeat", len(elem.GuardianKey))
+		}
+		if err := ValidateHeartbeatStatus(elem.NodeVersion, elem.Heights, elem.Features); err != nil {
+			return fmt.Errorf("invalid guardianHeartbeat: %w", err)
+		}
+		if _, ok := guardianHeartbeatIndexMap[string(elem.GuardianKey)]; ok {
+			return fmt.Errorf("duplicated guardian key for guardianHeartbeat")
+		}
+		guardianHeartbeatIndexMap[string(elem.GuardianKey)] = struct{}{}
+	}
+	// Check for duplicated or invalid icaHostAllowlist
+	icaHostAllowlistIndexMap := make(map[string]struct{})
+	for _, elem := range gs.IcaHostAllowlist {
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid icaHostAllowlist: %w", err)
+		}
+		index := string(IcaHostAllowlistEntryKey(elem.ConnectionId, elem.MsgTypeUrl))
+		if _, ok := icaHostAllowlistIndexMap[index]; ok {
+			return fmt.Errorf("duplicated icaHostAllowlist")
+		}
+		icaHostAllowlistIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated or invalid forwardVolume
+	forwardVolumeIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ForwardVolumeList {
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid forwardVolume: %w", err)
+		}
+		index := string(ForwardVolumeEntryKey(elem.ChannelId, elem.Denom))
+		if _, ok := forwardVolumeIndexMap[index]; ok {
+			return fmt.Errorf("duplicated forwardVolume")
+		}
+		forwardVolumeIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated or invalid pendingForwardFee
+	pendingForwardFeeIndexMap := make(map[string]struct{})
+	for _, elem := range gs.PendingForwardFeeList {
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid pendingForwardFee: %w", err)
+		}
+		index := string(PendingForwardFeeEntryKey(elem.PortId, elem.ChannelId, elem.Sequence))
+		if _, ok := pendingForwardFeeIndexMap[index]; ok {
+			return fmt.Errorf("duplicated pendingForwardFee")
+		}
+		pendingForwardFeeIndexMap[index] = struct{}{}
+	}
+	if gs.Params != nil && gs.Params.ForwardFeeBps > MaxForwardFeeBps {
+		return fmt.Errorf("forward fee of %d bps exceeds %d bps", gs.Params.ForwardFeeBps, MaxForwardFeeBps)
+	}
+	if gs.MaintenanceWindow != nil {
+		if err := gs.MaintenanceWindow.Validate(); err != nil {
+			return fmt.Errorf("invalid maintenanceWindow: %w", err)
+		}
+	}
+	// Check for duplicated or invalid sequenceReservation and that the
+	// reserved sequences were taken from the sequence counter of the emitter
+	sequenceCounters := make(map[string]uint64)
+	for _, elem := range gs.SequenceCounterList {
+		sequenceCounters[elem.Index] = elem.Sequence
+	}
+	sequenceReservationIndexMap := make(map[string]struct{})
+	for _, elem := range gs.SequenceReservationList {
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid sequenceReservation: %w", err)
+		}
+		if elem.End() > sequenceCounters[elem.Emitter] {
+			return fmt.Errorf("sequenceReservation of %s ends after the sequence counter of its emitter", elem.Emitter)
+		}
+		index := string(SequenceReservationKey(elem.Emitter, elem.Start))
+		if _, ok := sequenceReservationIndexMap[index]; ok {
+			return fmt.Errorf("duplicated sequenceReservation")
+		}
+		sequenceReservationIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated or invalid guardianValidatorTransition
+	guardianValidatorTransitionIndexMap := make(map[string]struct{})
+	for _, elem := range gs.GuardianValidatorTransitionList {
+		if len(elem.ValidatorAddr) == 0 {
+			return fmt.Errorf("empty validator address for guardianValidatorTransition")
+		}
+		if len(elem.GuardianKey) != 20 {
+			return fmt.Errorf("invalid guardian key length %d for guardianValidatorTransition", len(elem.GuardianKey))
+		}
+		if _, ok := guardianValidatorTransitionIndexMap[string(elem.ValidatorAddr)]; ok {
+			return fmt.Errorf("duplicated validator address for guardianValidatorTransition")
+		}
+		guardianValidatorTransitionIndexMap[string(elem.ValidatorAddr)] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return nil
@@ -25,15 +25,41 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the wormhole module's genesis state.
 type GenesisState struct {
-	GuardianSetList            []GuardianSet                          `protobuf:"bytes,1,rep,name=guardianSetList,proto3" json:"guardianSetList"`
-	Config                     *Config                                `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
-	ReplayProtectionList       []ReplayProtection                     `protobuf:"bytes,3,rep,name=replayProtectionList,proto3" json:"replayProtectionList"`
-	SequenceCounterList        []SequenceCounter                      `protobuf:"bytes,4,rep,name=sequenceCounterList,proto3" json:"sequenceCounterList"`
-	ConsensusGuardianSetIndex  *ConsensusGuardianSetIndex             `protobuf:"bytes,5,opt,name=consensusGuardianSetIndex,proto3" json:"consensusGuardianSetIndex,omitempty"`
-	GuardianValidatorList      []GuardianValidator                    `protobuf:"bytes,6,rep,name=guardianValidatorList,proto3" json:"guardianValidatorList"`
-	AllowedAddresses           []ValidatorAllowedAddress              `protobuf:"bytes,7,rep,name=allowedAddresses,proto3" json:"allowedAddresses"`
-	WasmInstantiateAllowlist   []WasmInstantiateAllowedContractCodeId `protobuf:"bytes,8,rep,name=wasmInstantiateAllowlist,proto3" json:"wasmInstantiateAllowlist"`
-	IbcComposabilityMwContract IbcComposabilityMwContract             `protobuf:"bytes,9,opt,name=ibcComposabilityMwContract,proto3" json:"ibcComposabilityMwContract"`
+	GuardianSetList                 []GuardianSet                          `protobuf:"bytes,1,rep,name=guardianSetList,proto3" json:"guardianSetList"`
+	Config                          *Config                                `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	ReplayProtectionList            []ReplayProtection                     `protobuf:"bytes,3,rep,name=replayProtectionList,proto3" json:"replayProtectionList"`
+	SequenceCounterList             []SequenceCounter                      `protobuf:"bytes,4,rep,name=sequenceCounterList,proto3" json:"sequenceCounterList"`
+	ConsensusGuardianSetIndex       *ConsensusGuardianSetIndex             `protobuf:"bytes,5,opt,name=consensusGuardianSetIndex,proto3" json:"consensusGuardianSetIndex,omitempty"`
+	GuardianValidatorList           []GuardianValidator                    `protobuf:"bytes,6,rep,name=guardianValidatorList,proto3" json:"guardianValidatorList"`
+	AllowedAddresses                []ValidatorAllowedAddress              `protobuf:"bytes,7,rep,name=allowedAddresses,proto3" json:"allowedAddresses"`
+	WasmInstantiateAllowlist        []WasmInstantiateAllowedContractCodeId `protobuf:"bytes,8,rep,name=wasmInstantiateAllowlist,proto3" json:"wasmInstantiateAllowlist"`
+	IbcComposabilityMwContract      IbcComposabilityMwContract             `protobuf:"bytes,9,opt,name=ibcComposabilityMwContract,proto3" json:"ibcComposabilityMwContract"`
+	GuardianSetActivation           *GuardianSetActivation                 `protobuf:"bytes,10,opt,name=guardianSetActivation,proto3" json:"guardianSetActivation,omitempty"`
+	Params                          *Params                                `protobuf:"bytes,11,opt,name=params,proto3" json:"params,omitempty"`
+	RegisteredEmitterList           []RegisteredEmitter                    `protobuf:"bytes,12,rep,name=registeredEmitterList,proto3" json:"registeredEmitterList"`
+	ExecutedGovernanceVaaList       []ExecutedGovernanceVAA                `protobuf:"bytes,13,rep,name=executedGovernanceVaaList,proto3" json:"executedGovernanceVaaList"`
+	GuardianSetActivationHeightList []GuardianSetActivationHeight          `protobuf:"bytes,14,rep,name=guardianSetActivationHeightList,proto3" json:"guardianSetActivationHeightList"`
+	GovernanceSubmitterList         []GovernanceSubmitter                  `protobuf:"bytes,15,rep,name=governanceSubmitterList,proto3" json:"governanceSubmitterList"`
+	ArchivedVaaList                 []ArchivedVAA                          `protobuf:"bytes,16,rep,name=archivedVaaList,proto3" json:"archivedVaaList"`
+	GuardianSetWeightsList          []GuardianSetWeights                   `protobuf:"bytes,17,rep,name=guardianSetWeightsList,proto3" json:"guardianSetWeightsList"`
+	ObservationTallyList            []ObservationTally                     `protobuf:"bytes,18,rep,name=observationTallyList,proto3" json:"observationTallyList"`
+	BridgePaused                    bool                                   `protobuf:"varint,19,opt,name=bridgePaused,proto3" json:"bridgePaused,omitempty"`
+	ChainRateLimitList              []ChainRateLimit                       `protobuf:"bytes,20,rep,name=chainRateLimitList,proto3" json:"chainRateLimitList"`
+	RateLimitFlowList               []RateLimitFlow                        `protobuf:"bytes,21,rep,name=rateLimitFlowList,proto3" json:"rateLimitFlowList"`
+	QueuedObservationList           []QueuedObservation                    `protobuf:"bytes,22,rep,name=queuedObservationList,proto3" json:"queuedObservationList"`
+	// type URLs of the messages that are shut down
+	MsgShutdownList       []string                `protobuf:"bytes,23,rep,name=msgShutdownList,proto3" json:"msgShutdownList,omitempty"`
+	GuardianHeartbeatList []GuardianHeartbeat     `protobuf:"bytes,24,rep,name=guardianHeartbeatList,proto3" json:"guardianHeartbeatList"`
+	IcaHostAllowlist      []IcaHostAllowlistEntry `protobuf:"bytes,25,rep,name=icaHostAllowlist,proto3" json:"icaHostAllowlist"`
+	ForwardVolumeList     []ForwardVolume         `protobuf:"bytes,26,rep,name=forwardVolumeList,proto3" json:"forwardVolumeList"`
+	// not set if no maintenance window was declared
+	MaintenanceWindow               *MaintenanceWindow            `protobuf:"bytes,27,opt,name=maintenanceWindow,proto3" json:"maintenanceWindow,omitempty"`
+	SequenceReservationList         []SequenceReservation         `protobuf:"bytes,28,rep,name=sequenceReservationList,proto3" json:"sequenceReservationList"`
+	CoreContract                    CoreContract                  `protobuf:"bytes,29,opt,name=coreContract,proto3" json:"coreContract"`
+	ApprovedCodeHashList            []ApprovedCodeHash            `protobuf:"bytes,30,rep,name=approvedCodeHashList,proto3" json:"approvedCodeHashList"`
+	GuardianValidatorTransitionList []GuardianValidatorTransition `protobuf:"bytes,31,rep,name=guardianValidatorTransitionList,proto3" json:"guardianValidatorTransitionList"`
+	FinalizedObservationList        []FinalizedObservation        `protobuf:"bytes,32,rep,name=finalizedObservationList,proto3" json:"finalizedObservationList"`
+	PendingForwardFeeList           []PendingForwardFee           `protobuf:"bytes,33,rep,name=pendingForwardFeeList,proto3" json:"pendingForwardFeeList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -132,6 +158,174 @@ func (m *GenesisState) GetIbcComposabilityMwContract() IbcComposabilityMwContrac
 	return IbcComposabilityMwContract{}
 }
 
+func (m *GenesisState) GetGuardianSetActivation() *GuardianSetActivation {
+	if m != nil {
+		return m.GuardianSetActivation
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRegisteredEmitterList() []RegisteredEmitter {
+	if m != nil {
+		return m.RegisteredEmitterList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExecutedGovernanceVaaList() []ExecutedGovernanceVAA {
+	if m != nil {
+		return m.ExecutedGovernanceVaaList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGuardianSetActivationHeightList() []GuardianSetActivationHeight {
+	if m != nil {
+		return m.GuardianSetActivationHeightList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGovernanceSubmitterList() []GovernanceSubmitter {
+	if m != nil {
+		return m.GovernanceSubmitterList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetArchivedVaaList() []ArchivedVAA {
+	if m != nil {
+		return m.ArchivedVaaList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGuardianSetWeightsList() []GuardianSetWeights {
+	if m != nil {
+		return m.GuardianSetWeightsList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetObservationTallyList() []ObservationTally {
+	if m != nil {
+		return m.ObservationTallyList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBridgePaused() bool {
+	if m != nil {
+		return m.BridgePaused
+	}
+	return false
+}
+
+func (m *GenesisState) GetChainRateLimitList() []ChainRateLimit {
+	if m != nil {
+		return m.ChainRateLimitList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRateLimitFlowList() []RateLimitFlow {
+	if m != nil {
+		return m.RateLimitFlowList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQueuedObservationList() []QueuedObservation {
+	if m != nil {
+		return m.QueuedObservationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMsgShutdownList() []string {
+	if m != nil {
+		return m.MsgShutdownList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGuardianHeartbeatList() []GuardianHeartbeat {
+	if m != nil {
+		return m.GuardianHeartbeatList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIcaHostAllowlist() []IcaHostAllowlistEntry {
+	if m != nil {
+		return m.IcaHostAllowlist
+	}
+	return nil
+}
+
+func (m *GenesisState) GetForwardVolumeList() []ForwardVolume {
+	if m != nil {
+		return m.ForwardVolumeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMaintenanceWindow() *MaintenanceWindow {
+	if m != nil {
+		return m.MaintenanceWindow
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSequenceReservationList() []SequenceReservation {
+	if m != nil {
+		return m.SequenceReservationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCoreContract() CoreContract {
+	if m != nil {
+		return m.CoreContract
+	}
+	return CoreContract{}
+}
+
+func (m *GenesisState) GetApprovedCodeHashList() []ApprovedCodeHash {
+	if m != nil {
+		return m.ApprovedCodeHashList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGuardianValidatorTransitionList() []GuardianValidatorTransition {
+	if m != nil {
+		return m.GuardianValidatorTransitionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFinalizedObservationList() []FinalizedObservation {
+	if m != nil {
+		return m.FinalizedObservationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingForwardFeeList() []PendingForwardFee {
+	if m != nil {
+		return m.PendingForwardFeeList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "wormhole_foundation.wormchain.wormhole.GenesisState")
 }
@@ -139,40 +333,80 @@ func init() {
 func init() { proto.RegisterFile("wormhole/genesis.proto", fileDescriptor_9a7ced3fe0304831) }
 
 var fileDescriptor_9a7ced3fe0304831 = []byte{
-	// 528 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0xb3, 0xb6, 0xc6, 0x3a, 0x15, 0x94, 0xb1, 0xd5, 0x35, 0x87, 0x6d, 0xf0, 0x20, 0x05,
-	0x71, 0x17, 0xda, 0x83, 0xf6, 0x24, 0x49, 0xc0, 0x12, 0xa8, 0x20, 0x09, 0x28, 0x78, 0x59, 0x26,
-	0x3b, 0xaf, 0x9b, 0x81, 0xcd, 0x4c, 0xba, 0x33, 0x4b, 0x9a, 0x93, 0x57, 0x4f, 0xe2, 0xb7, 0xf0,
-	0xab, 0xf4, 0xd8, 0xa3, 0x27, 0x91, 0xe4, 0x8b, 0xc8, 0xce, 0xce, 0x4e, 0xda, 0xba, 0x29, 0xdb,
-	0xdb, 0xf0, 0x66, 0xde, 0xef, 0xff, 0x7f, 0xff, 0xb7, 0x2c, 0x7a, 0x36, 0x13, 0xe9, 0x64, 0x2c,
-	0x12, 0x08, 0x62, 0xe0, 0x20, 0x99, 0xf4, 0xa7, 0xa9, 0x50, 0x02, 0xbf, 0x2a, 0xeb, 0xe1, 0xa9,
-	0xc8, 0x38, 0x25, 0x8a, 0x09, 0xee, 0xe7, 0xb5, 0x68, 0x4c, 0x58, 0x71, 0xca, 0x6f, 0x5b, 0xcf,
-	0x57, 0xfd, 0x19, 0x49, 0x29, 0x23, 0xbc, 0x00, 0xb4, 0x76, 0xed, 0x45, 0x24, 0xf8, 0x29, 0x8b,
-	0x4d, 0xb9, 0x6d, 0xcb, 0x29, 0x4c, 0x13, 0x32, 0x0f, 0xf3, 0x32, 0x44, 0x1a, 0x5f, 0xbc, 0xd8,
-	0xb3, 0x2f, 0x24, 0x9c, 0x65, 0xc0, 0x23, 0x08, 0x23, 0x91, 0x71, 0x05, 0xa9, 0x79, 0xf0, 0xfa,
-	0x2a, 0x59, 0x02, 0x97, 0x99, 0x0c, 0x4b, 0xf1, 0x50, 0x82, 0x0a, 0x19, 0xa7, 0x70, 0x6e, 0x1e,
-	0xef, 0xc4, 0x22, 0x16, 0xfa, 0x18, 0xe4, 0xa7, 0xa2, 0xfa, 0xf2, 0xd7, 0x16, 0x7a, 0x74, 0x5c,
-	0xcc, 0x3b, 0x54, 0x44, 0x01, 0x8e, 0xd0, 0xe3, 0x12, 0x31, 0x04, 0x75, 0xc2, 0xa4, 0x72, 0x9d,
-	0xf6, 0xc6, 0xfe, 0xf6, 0xc1, 0xa1, 0x5f, 0x2f, 0x08, 0xff, 0x78, 0xd5, 0xde, 0xdd, 0xbc, 0xf8,
-	0xb3, 0xd7, 0x18, 0xdc, 0x24, 0xe2, 0x0f, 0xa8, 0x59, 0x64, 0xe1, 0xde, 0x6b, 0x3b, 0xfb, 0xdb,
-	0x07, 0x7e, 0x5d, 0x76, 0x4f, 0x77, 0x0d, 0x4c, 0x37, 0x4e, 0xd1, 0x4e, 0x11, 0xde, 0x27, 0x9b,
-	0x9d, 0x76, 0xbc, 0xa1, 0x1d, 0xbf, 0xab, 0x4b, 0x1d, 0xdc, 0x60, 0x18, 0xdb, 0x95, 0x6c, 0x2c,
-	0xd0, 0xd3, 0x72, 0x1d, 0xbd, 0x62, 0x1b, 0x5a, 0x72, 0x53, 0x4b, 0xbe, 0xad, 0x2b, 0x39, 0xbc,
-	0x8e, 0x30, 0x8a, 0x55, 0x64, 0xfc, 0x0d, 0xbd, 0xb0, 0xeb, 0xbd, 0x92, 0x6d, 0x3f, 0xdf, 0xad,
-	0x7b, 0x5f, 0xe7, 0xd7, 0xb9, 0x43, 0x7e, 0xd5, 0xa0, 0xc1, 0x7a, 0x0d, 0x9c, 0xa1, 0xdd, 0x72,
-	0x81, 0x9f, 0x49, 0xc2, 0x28, 0x51, 0xa2, 0x98, 0xb9, 0xa9, 0x67, 0x3e, 0xba, 0xeb, 0x87, 0x61,
-	0x21, 0x66, 0xea, 0x6a, 0x3a, 0x3e, 0x43, 0x4f, 0x48, 0x92, 0x88, 0x19, 0xd0, 0x0e, 0xa5, 0x29,
-	0x48, 0x09, 0xd2, 0x7d, 0xa0, 0x15, 0xdf, 0xd7, 0x55, 0xb4, 0xc0, 0xce, 0x35, 0x90, 0xd1, 0xfd,
-	0x0f, 0x8f, 0x7f, 0x38, 0xc8, 0x9d, 0x11, 0x39, 0xe9, 0x73, 0xa9, 0x08, 0x57, 0x8c, 0x28, 0xd0,
-	0x9d, 0x49, 0x3e, 0xed, 0x96, 0xd6, 0x3e, 0xa9, 0xab, 0xfd, 0xa5, 0x82, 0x03, 0xb4, 0x27, 0xb8,
-	0x4a, 0x49, 0xa4, 0x7a, 0x82, 0x42, 0x9f, 0x1a, 0x23, 0x6b, 0x35, 0xf1, 0x77, 0x07, 0xb5, 0xd8,
-	0x28, 0xea, 0x89, 0xc9, 0x54, 0x48, 0x32, 0x62, 0x09, 0x53, 0xf3, 0x8f, 0xb3, 0x12, 0xe2, 0x3e,
-	0xd4, 0xdb, 0xef, 0xd6, 0xb5, 0xd4, 0x5f, 0x4b, 0x32, 0x46, 0x6e, 0xd1, 0xea, 0x0e, 0x2f, 0x16,
-	0x9e, 0x73, 0xb9, 0xf0, 0x9c, 0xbf, 0x0b, 0xcf, 0xf9, 0xb9, 0xf4, 0x1a, 0x97, 0x4b, 0xaf, 0xf1,
-	0x7b, 0xe9, 0x35, 0xbe, 0x1e, 0xc5, 0x4c, 0x8d, 0xb3, 0x91, 0x1f, 0x89, 0x49, 0x50, 0x6a, 0xbd,
-	0x59, 0x39, 0x09, 0xac, 0x93, 0xe0, 0xdc, 0xde, 0x07, 0x6a, 0x3e, 0x05, 0x39, 0x6a, 0xea, 0xbf,
-	0xd0, 0xe1, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1f, 0xa6, 0x7e, 0xa5, 0x7d, 0x05, 0x00, 0x00,
+	// 1166 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0xdb, 0x6f, 0xe3, 0xc4,
+	0x17, 0xc7, 0x9b, 0x5f, 0xf7, 0x57, 0x76, 0xa7, 0x85, 0xb6, 0xb3, 0xbd, 0xb8, 0x61, 0x49, 0xb3,
+	0xfb, 0x80, 0x2a, 0x21, 0x12, 0x69, 0x97, 0xdb, 0x72, 0x11, 0x4a, 0xa3, 0xde, 0xa4, 0xae, 0x28,
+	0xce, 0xaa, 0x95, 0x78, 0x20, 0x9a, 0xd8, 0xa7, 0xce, 0x48, 0xb6, 0x27, 0xf5, 0x8c, 0x9b, 0x16,
+	0x24, 0x10, 0x6f, 0x3c, 0x21, 0x24, 0xfe, 0xa9, 0x7d, 0xdc, 0x47, 0x9e, 0x10, 0x6a, 0xff, 0x0e,
+	0x24, 0xe4, 0xf1, 0x8c, 0xe3, 0xd8, 0x0e, 0xd8, 0xe5, 0xad, 0x1a, 0xcf, 0x7c, 0xbe, 0x67, 0xce,
+	0x39, 0xf9, 0x9e, 0x29, 0xda, 0x18, 0xb3, 0xc0, 0x1b, 0x32, 0x17, 0xda, 0x0e, 0xf8, 0xc0, 0x29,
+	0x6f, 0x8d, 0x02, 0x26, 0x18, 0x7e, 0x57, 0xaf, 0xf7, 0xcf, 0x59, 0xe8, 0xdb, 0x44, 0x50, 0xe6,
+	0xb7, 0xa2, 0x35, 0x6b, 0x48, 0xa8, 0xdf, 0xd2, 0x5f, 0xeb, 0x9b, 0x93, 0xf3, 0x21, 0x09, 0x6c,
+	0x4a, 0xfc, 0x18, 0x50, 0x5f, 0x4f, 0x3e, 0x58, 0xcc, 0x3f, 0xa7, 0x8e, 0x5a, 0x6e, 0x26, 0xcb,
+	0x01, 0x8c, 0x5c, 0x72, 0xdd, 0x8f, 0x96, 0xc1, 0x92, 0xf8, 0x78, 0xc7, 0x76, 0xb2, 0x83, 0xc3,
+	0x45, 0x08, 0xbe, 0x05, 0x7d, 0x8b, 0x85, 0xbe, 0x80, 0x40, 0x6d, 0x78, 0x2f, 0x4d, 0xe6, 0xe0,
+	0xf3, 0x90, 0xf7, 0xb5, 0x78, 0x9f, 0x83, 0xe8, 0x53, 0xdf, 0x86, 0xab, 0x5c, 0x18, 0x23, 0x12,
+	0x10, 0x4f, 0x5d, 0xaf, 0xfe, 0x38, 0x15, 0x86, 0x43, 0xb9, 0x80, 0x00, 0xec, 0x3e, 0x78, 0x54,
+	0x4c, 0x64, 0xea, 0xc9, 0x96, 0x4b, 0x42, 0xfa, 0x24, 0xb0, 0x86, 0xf4, 0x12, 0x72, 0xdf, 0xd8,
+	0x80, 0x43, 0x70, 0x49, 0x52, 0xf1, 0x1b, 0xc9, 0xb7, 0x21, 0x90, 0x40, 0x0c, 0x80, 0x08, 0xf5,
+	0x65, 0x6b, 0x22, 0x4a, 0x04, 0xf4, 0x5d, 0xea, 0x51, 0x91, 0x03, 0x9e, 0xb3, 0x60, 0x4c, 0x02,
+	0xbb, 0x7f, 0x0e, 0x90, 0x8b, 0xd5, 0x23, 0xd4, 0x17, 0xe0, 0x93, 0x28, 0x27, 0x63, 0xea, 0xdb,
+	0x6c, 0xac, 0xb6, 0xac, 0x39, 0xcc, 0x61, 0xf2, 0xcf, 0x76, 0xf4, 0x57, 0xbc, 0xfa, 0xe4, 0xaf,
+	0x47, 0x68, 0xe9, 0x20, 0xae, 0x6a, 0x4f, 0x10, 0x01, 0xd8, 0x42, 0xcb, 0x3a, 0x51, 0x3d, 0x10,
+	0xc7, 0x94, 0x0b, 0xa3, 0xd6, 0x9c, 0xdf, 0x59, 0x7c, 0xfa, 0xac, 0x55, 0xae, 0xdc, 0xad, 0x83,
+	0xc9, 0xf1, 0xdd, 0x7b, 0xaf, 0xfe, 0xd8, 0x9e, 0x33, 0xb3, 0x44, 0xbc, 0x8f, 0x16, 0xe2, 0x8a,
+	0x1b, 0xff, 0x6b, 0xd6, 0x76, 0x16, 0x9f, 0xb6, 0xca, 0xb2, 0xbb, 0xf2, 0x94, 0xa9, 0x4e, 0xe3,
+	0x00, 0xad, 0xc5, 0x2d, 0x72, 0x92, 0x74, 0x88, 0x8c, 0x78, 0x5e, 0x46, 0xfc, 0x49, 0x59, 0xaa,
+	0x99, 0x61, 0xa8, 0xb0, 0x0b, 0xd9, 0x98, 0xa1, 0x87, 0xba, 0xe9, 0xba, 0x71, 0xcf, 0x49, 0xc9,
+	0x7b, 0x52, 0xf2, 0xe3, 0xb2, 0x92, 0xbd, 0x69, 0x84, 0x52, 0x2c, 0x22, 0xe3, 0x1f, 0xd1, 0x56,
+	0xd2, 0xc4, 0xa9, 0xdc, 0x1e, 0x45, 0x1d, 0x6c, 0xfc, 0x5f, 0xe6, 0xaf, 0x53, 0x21, 0x7f, 0xc5,
+	0x20, 0x73, 0xb6, 0x06, 0x0e, 0xd1, 0xba, 0x2e, 0xe0, 0x29, 0x71, 0xa9, 0x4d, 0x04, 0x8b, 0xef,
+	0xbc, 0x20, 0xef, 0xfc, 0xbc, 0x6a, 0x63, 0x24, 0x10, 0x75, 0xeb, 0x62, 0x3a, 0xbe, 0x40, 0x2b,
+	0xc4, 0x75, 0xd9, 0x18, 0xec, 0x8e, 0x6d, 0x07, 0xc0, 0x39, 0x70, 0xe3, 0x0d, 0xa9, 0xf8, 0x65,
+	0x59, 0xc5, 0x04, 0xd8, 0x99, 0x02, 0x29, 0xdd, 0x1c, 0x1e, 0xff, 0x52, 0x43, 0xc6, 0x98, 0x70,
+	0xef, 0xc8, 0xe7, 0x82, 0xf8, 0x82, 0x12, 0x01, 0xf2, 0xa4, 0x1b, 0xdd, 0xf6, 0xbe, 0xd4, 0x3e,
+	0x2e, 0xab, 0x7d, 0x56, 0xc0, 0x01, 0xbb, 0xcb, 0x7c, 0x11, 0x10, 0x4b, 0x74, 0x99, 0x0d, 0x47,
+	0xb6, 0x0a, 0x64, 0xa6, 0x26, 0xfe, 0xb9, 0x86, 0xea, 0x74, 0x60, 0x75, 0x99, 0x37, 0x62, 0x9c,
+	0x0c, 0xa8, 0x4b, 0xc5, 0xf5, 0x8b, 0xb1, 0x86, 0x18, 0x0f, 0x64, 0xf5, 0x77, 0xcb, 0x86, 0x74,
+	0x34, 0x93, 0xa4, 0x02, 0xf9, 0x07, 0x2d, 0xcc, 0x27, 0x5d, 0xd0, 0x03, 0xd1, 0xb1, 0x04, 0x8d,
+	0x2d, 0xcd, 0x40, 0x32, 0x88, 0x2f, 0xee, 0x60, 0x0f, 0x13, 0x88, 0x59, 0xcc, 0x8e, 0x8c, 0x22,
+	0xf6, 0x64, 0x63, 0xb1, 0x9a, 0x51, 0x9c, 0xc8, 0x53, 0xa6, 0x3a, 0x1d, 0xb5, 0xf0, 0xc4, 0xc4,
+	0xf7, 0x62, 0x0f, 0x97, 0x2d, 0xbc, 0x54, 0xad, 0x85, 0xcd, 0x2c, 0x44, 0xb7, 0x70, 0x21, 0x1d,
+	0xff, 0x54, 0x43, 0x5b, 0x70, 0x05, 0x56, 0x28, 0xc0, 0x3e, 0x60, 0x97, 0x10, 0x48, 0x5f, 0x3e,
+	0x25, 0x44, 0x6a, 0xbf, 0xd9, 0x9c, 0xaf, 0x92, 0xb8, 0xbd, 0x3c, 0xa8, 0xd3, 0x51, 0xfa, 0xb3,
+	0x55, 0xf0, 0x6f, 0x35, 0xb4, 0x5d, 0x98, 0xdc, 0x43, 0xa0, 0xce, 0x30, 0x76, 0xf8, 0xb7, 0x64,
+	0x24, 0xdd, 0xff, 0x54, 0xc2, 0x18, 0xa7, 0xe2, 0xf9, 0x37, 0x45, 0xfc, 0x3d, 0xda, 0x74, 0x92,
+	0x50, 0x7b, 0xe1, 0x20, 0x55, 0x92, 0x65, 0x19, 0xcc, 0x67, 0xa5, 0x83, 0xc9, 0x63, 0x54, 0x10,
+	0xb3, 0x14, 0xa2, 0x19, 0xa7, 0x66, 0xb5, 0xad, 0x6b, 0xb1, 0x52, 0x6d, 0xc6, 0x75, 0xf4, 0xf1,
+	0xa4, 0x02, 0x59, 0x22, 0xbe, 0x42, 0x1b, 0xa9, 0x24, 0x9c, 0xc9, 0xab, 0x73, 0xa9, 0xb5, 0x2a,
+	0xb5, 0x3e, 0xbd, 0x43, 0xb6, 0x15, 0x45, 0x49, 0xce, 0xe0, 0x47, 0x53, 0x31, 0xf5, 0xe4, 0x78,
+	0x49, 0x5c, 0xf7, 0x5a, 0xea, 0xe2, 0x6a, 0x53, 0xf1, 0xab, 0x0c, 0x43, 0x4f, 0xc5, 0x22, 0x36,
+	0x7e, 0x82, 0x96, 0x06, 0x01, 0xb5, 0x1d, 0x38, 0x21, 0x21, 0x07, 0xdb, 0x78, 0xd8, 0xac, 0xed,
+	0xdc, 0x37, 0xa7, 0xd6, 0xb0, 0x8b, 0xb0, 0x94, 0x30, 0x89, 0x80, 0x63, 0xea, 0xd1, 0xb8, 0xf7,
+	0xd6, 0x64, 0x54, 0x1f, 0x95, 0x9e, 0x60, 0x53, 0x04, 0x15, 0x53, 0x01, 0x17, 0x53, 0xb4, 0x1a,
+	0xe8, 0x85, 0x7d, 0x97, 0x8d, 0xa5, 0xd8, 0xba, 0x14, 0xfb, 0xb0, 0xf4, 0xcf, 0x3d, 0x0d, 0x50,
+	0x5a, 0x79, 0x6a, 0xe4, 0x2e, 0x17, 0x21, 0x84, 0x60, 0xa7, 0x52, 0x26, 0xe5, 0x36, 0xaa, 0xb9,
+	0xcb, 0xd7, 0x59, 0x88, 0x76, 0x97, 0x42, 0x3a, 0xde, 0x41, 0xcb, 0x1e, 0x77, 0x7a, 0xc3, 0x50,
+	0xd8, 0x6c, 0x1c, 0x0b, 0x6e, 0x36, 0xe7, 0x77, 0x1e, 0x98, 0xd9, 0xe5, 0xf4, 0x04, 0x3f, 0xd4,
+	0x0f, 0x4e, 0xb9, 0xdf, 0xb8, 0xdb, 0x04, 0x4f, 0x20, 0xd9, 0x09, 0x3e, 0x45, 0xc7, 0x0c, 0xad,
+	0x50, 0x8b, 0x1c, 0x32, 0x2e, 0x26, 0x53, 0x74, 0xab, 0x9a, 0xe9, 0x1d, 0x65, 0xce, 0xef, 0xf9,
+	0x22, 0xd0, 0x9d, 0x98, 0x83, 0x47, 0x35, 0x57, 0x6f, 0xe3, 0x53, 0xe6, 0x86, 0x1e, 0xc8, 0x3b,
+	0xd6, 0xab, 0xd5, 0x7c, 0x3f, 0x0d, 0xd0, 0x35, 0xcf, 0x51, 0xb1, 0x83, 0x56, 0x53, 0x4f, 0xed,
+	0x33, 0xf9, 0xd2, 0x36, 0xde, 0x6e, 0xd6, 0xaa, 0xa4, 0xf3, 0x45, 0x16, 0x60, 0xe6, 0x99, 0x91,
+	0x53, 0xea, 0x57, 0xa1, 0x09, 0xd3, 0xed, 0xf5, 0xa8, 0x9a, 0x53, 0xf6, 0xf2, 0x18, 0xed, 0x94,
+	0x33, 0x14, 0xf0, 0xb7, 0x68, 0xc9, 0x62, 0x01, 0x24, 0x0f, 0x8e, 0x77, 0xe4, 0x05, 0x3f, 0x28,
+	0xff, 0xdc, 0x9c, 0x9c, 0x55, 0x52, 0x53, 0xbc, 0xc8, 0xaa, 0xc8, 0x68, 0x14, 0xb0, 0xcb, 0xe8,
+	0x65, 0x64, 0xc3, 0x21, 0xe1, 0x43, 0x79, 0xb3, 0x46, 0x35, 0xab, 0xea, 0x64, 0x18, 0xda, 0xaa,
+	0x8a, 0xd8, 0x53, 0x03, 0x31, 0x79, 0x20, 0xbe, 0x0c, 0x88, 0xcf, 0x69, 0x92, 0xd9, 0xed, 0xbb,
+	0x0d, 0xc4, 0x02, 0x5c, 0x76, 0x20, 0xce, 0x50, 0xc4, 0x3f, 0x20, 0xe3, 0x9c, 0xfa, 0xc4, 0xa5,
+	0xdf, 0xe5, 0x6d, 0xa4, 0x29, 0xa3, 0xf9, 0xbc, 0x74, 0x07, 0x17, 0x70, 0xf4, 0x4b, 0x73, 0x96,
+	0x46, 0x64, 0x11, 0x23, 0xf0, 0x6d, 0xea, 0x3b, 0xea, 0x07, 0xb0, 0x0f, 0xf1, 0xcf, 0xe7, 0x71,
+	0x35, 0x8b, 0x38, 0xc9, 0x42, 0xb4, 0x45, 0x14, 0xd2, 0x77, 0x7b, 0xaf, 0x6e, 0x1a, 0xb5, 0xd7,
+	0x37, 0x8d, 0xda, 0x9f, 0x37, 0x8d, 0xda, 0xaf, 0xb7, 0x8d, 0xb9, 0xd7, 0xb7, 0x8d, 0xb9, 0xdf,
+	0x6f, 0x1b, 0x73, 0xdf, 0x3c, 0x77, 0xa8, 0x18, 0x86, 0x83, 0x96, 0xc5, 0xbc, 0xb6, 0xa6, 0xbf,
+	0x3f, 0xd1, 0x6e, 0x27, 0xda, 0xed, 0xab, 0xe4, 0x7b, 0x5b, 0x5c, 0x8f, 0x80, 0x0f, 0x16, 0xe4,
+	0xff, 0xb6, 0xcf, 0xfe, 0x1e, 0x00, 0x7a, 0x6b, 0xd5, 0x6a, 0xb9, 0x10, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -195,8 +429,72 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingForwardFeeList) > 0 {
+		for iNdEx := len(m.PendingForwardFeeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingForwardFeeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.FinalizedObservationList) > 0 {
+		for iNdEx := len(m.FinalizedObservationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalizedObservationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.GuardianValidatorTransitionList) > 0 {
+		for iNdEx := len(m.GuardianValidatorTransitionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GuardianValidatorTransitionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.ApprovedCodeHashList) > 0 {
+		for iNdEx := len(m.ApprovedCodeHashList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ApprovedCodeHashList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
 	{
-		size, err := m.IbcComposabilityMwContract.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.CoreContract.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -204,11 +502,13 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x4a
-	if len(m.WasmInstantiateAllowlist) > 0 {
-		for iNdEx := len(m.WasmInstantiateAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xea
+	if len(m.SequenceReservationList) > 0 {
+		for iNdEx := len(m.SequenceReservationList) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.WasmInstantiateAllowlist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.SequenceReservationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -216,210 +516,1435 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x42
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
 		}
 	}
-	if len(m.AllowedAddresses) > 0 {
-		for iNdEx := len(m.AllowedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+	if m.MaintenanceWindow != nil {
+		{
+			size, err := m.MaintenanceWindow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if len(m.ForwardVolumeList) > 0 {
+		for iNdEx := len(m.ForwardVolumeList) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AllowedAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ForwardVolumeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.IcaHostAllowlist) > 0 {
+		for iNdEx := len(m.IcaHostAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IcaHostAllowlist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.GuardianHeartbeatList) > 0 {
+		for iNdEx := len(m.GuardianHeartbeatList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GuardianHeartbeatList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.MsgShutdownList) > 0 {
+		for iNdEx := len(m.MsgShutdownList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgShutdownList[iNdEx])
+			copy(dAtA[i:], m.MsgShutdownList[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.MsgShutdownList[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.QueuedObservationList) > 0 {
+		for iNdEx := len(m.QueuedObservationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedObservationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.RateLimitFlowList) > 0 {
+		for iNdEx := len(m.RateLimitFlowList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimitFlowList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.ChainRateLimitList) > 0 {
+		for iNdEx := len(m.ChainRateLimitList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainRateLimitList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
 				i -= size
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if len(m.GuardianValidatorList) > 0 {
-		for iNdEx := len(m.GuardianValidatorList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.GuardianValidatorList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.BridgePaused {
+		i--
+		if m.BridgePaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.ObservationTallyList) > 0 {
+		for iNdEx := len(m.ObservationTallyList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ObservationTallyList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.GuardianSetWeightsList) > 0 {
+		for iNdEx := len(m.GuardianSetWeightsList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GuardianSetWeightsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.ArchivedVaaList) > 0 {
+		for iNdEx := len(m.ArchivedVaaList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ArchivedVaaList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.GovernanceSubmitterList) > 0 {
+		for iNdEx := len(m.GovernanceSubmitterList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GovernanceSubmitterList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.GuardianSetActivationHeightList) > 0 {
+		for iNdEx := len(m.GuardianSetActivationHeightList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GuardianSetActivationHeightList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.ExecutedGovernanceVaaList) > 0 {
+		for iNdEx := len(m.ExecutedGovernanceVaaList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutedGovernanceVaaList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.RegisteredEmitterList) > 0 {
+		for iNdEx := len(m.RegisteredEmitterList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegisteredEmitterList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.GuardianSetActivation != nil {
+		{
+			size, err := m.GuardianSetActivation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	{
+		size, err := m.IbcComposabilityMwContract.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.WasmInstantiateAllowlist) > 0 {
+		for iNdEx := len(m.WasmInstantiateAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WasmInstantiateAllowlist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.AllowedAddresses) > 0 {
+		for iNdEx := len(m.AllowedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.GuardianValidatorList) > 0 {
+		for iNdEx := len(m.GuardianValidatorList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GuardianValidatorList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.ConsensusGuardianSetIndex != nil {
+		{
+			size, err := m.ConsensusGuardianSetIndex.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SequenceCounterList) > 0 {
+		for iNdEx := len(m.SequenceCounterList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SequenceCounterList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ReplayProtectionList) > 0 {
+		for iNdEx := len(m.ReplayProtectionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReplayProtectionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Config != nil {
+		{
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GuardianSetList) > 0 {
+		for iNdEx := len(m.GuardianSetList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GuardianSetList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GuardianSetList) > 0 {
+		for _, e := range m.GuardianSetList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.ReplayProtectionList) > 0 {
+		for _, e := range m.ReplayProtectionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SequenceCounterList) > 0 {
+		for _, e := range m.SequenceCounterList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ConsensusGuardianSetIndex != nil {
+		l = m.ConsensusGuardianSetIndex.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.GuardianValidatorList) > 0 {
+		for _, e := range m.GuardianValidatorList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AllowedAddresses) > 0 {
+		for _, e := range m.AllowedAddresses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WasmInstantiateAllowlist) > 0 {
+		for _, e := range m.WasmInstantiateAllowlist {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.IbcComposabilityMwContract.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.GuardianSetActivation != nil {
+		l = m.GuardianSetActivation.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.RegisteredEmitterList) > 0 {
+		for _, e := range m.RegisteredEmitterList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExecutedGovernanceVaaList) > 0 {
+		for _, e := range m.ExecutedGovernanceVaaList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GuardianSetActivationHeightList) > 0 {
+		for _, e := range m.GuardianSetActivationHeightList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GovernanceSubmitterList) > 0 {
+		for _, e := range m.GovernanceSubmitterList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ArchivedVaaList) > 0 {
+		for _, e := range m.ArchivedVaaList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GuardianSetWeightsList) > 0 {
+		for _, e := range m.GuardianSetWeightsList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ObservationTallyList) > 0 {
+		for _, e := range m.ObservationTallyList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.BridgePaused {
+		n += 3
+	}
+	if len(m.ChainRateLimitList) > 0 {
+		for _, e := range m.ChainRateLimitList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RateLimitFlowList) > 0 {
+		for _, e := range m.RateLimitFlowList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.QueuedObservationList) > 0 {
+		for _, e := range m.QueuedObservationList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MsgShutdownList) > 0 {
+		for _, s := range m.MsgShutdownList {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GuardianHeartbeatList) > 0 {
+		for _, e := range m.GuardianHeartbeatList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IcaHostAllowlist) > 0 {
+		for _, e := range m.IcaHostAllowlist {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ForwardVolumeList) > 0 {
+		for _, e := range m.ForwardVolumeList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MaintenanceWindow != nil {
+		l = m.MaintenanceWindow.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.SequenceReservationList) > 0 {
+		for _, e := range m.SequenceReservationList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.CoreContract.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.ApprovedCodeHashList) > 0 {
+		for _, e := range m.ApprovedCodeHashList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GuardianValidatorTransitionList) > 0 {
+		for _, e := range m.GuardianValidatorTransitionList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FinalizedObservationList) > 0 {
+		for _, e := range m.FinalizedObservationList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingForwardFeeList) > 0 {
+		for _, e := range m.PendingForwardFeeList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianSetList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GuardianSetList = append(m.GuardianSetList, GuardianSet{})
+			if err := m.GuardianSetList[len(m.GuardianSetList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &Config{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplayProtectionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplayProtectionList = append(m.ReplayProtectionList, ReplayProtection{})
+			if err := m.ReplayProtectionList[len(m.ReplayProtectionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceCounterList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SequenceCounterList = append(m.SequenceCounterList, SequenceCounter{})
+			if err := m.SequenceCounterList[len(m.SequenceCounterList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusGuardianSetIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusGuardianSetIndex == nil {
+				m.ConsensusGuardianSetIndex = &ConsensusGuardianSetIndex{}
+			}
+			if err := m.ConsensusGuardianSetIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianValidatorList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GuardianValidatorList = append(m.GuardianValidatorList, GuardianValidator{})
+			if err := m.GuardianValidatorList[len(m.GuardianValidatorList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedAddresses = append(m.AllowedAddresses, ValidatorAllowedAddress{})
+			if err := m.AllowedAddresses[len(m.AllowedAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmInstantiateAllowlist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WasmInstantiateAllowlist = append(m.WasmInstantiateAllowlist, WasmInstantiateAllowedContractCodeId{})
+			if err := m.WasmInstantiateAllowlist[len(m.WasmInstantiateAllowlist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcComposabilityMwContract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IbcComposabilityMwContract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianSetActivation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GuardianSetActivation == nil {
+				m.GuardianSetActivation = &GuardianSetActivation{}
+			}
+			if err := m.GuardianSetActivation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredEmitterList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisteredEmitterList = append(m.RegisteredEmitterList, RegisteredEmitter{})
+			if err := m.RegisteredEmitterList[len(m.RegisteredEmitterList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedGovernanceVaaList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutedGovernanceVaaList = append(m.ExecutedGovernanceVaaList, ExecutedGovernanceVAA{})
+			if err := m.ExecutedGovernanceVaaList[len(m.ExecutedGovernanceVaaList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianSetActivationHeightList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GuardianSetActivationHeightList = append(m.GuardianSetActivationHeightList, GuardianSetActivationHeight{})
+			if err := m.GuardianSetActivationHeightList[len(m.GuardianSetActivationHeightList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernanceSubmitterList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernanceSubmitterList = append(m.GovernanceSubmitterList, GovernanceSubmitter{})
+			if err := m.GovernanceSubmitterList[len(m.GovernanceSubmitterList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedVaaList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArchivedVaaList = append(m.ArchivedVaaList, ArchivedVAA{})
+			if err := m.ArchivedVaaList[len(m.ArchivedVaaList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianSetWeightsList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GuardianSetWeightsList = append(m.GuardianSetWeightsList, GuardianSetWeights{})
+			if err := m.GuardianSetWeightsList[len(m.GuardianSetWeightsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationTallyList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObservationTallyList = append(m.ObservationTallyList, ObservationTally{})
+			if err := m.ObservationTallyList[len(m.ObservationTallyList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgePaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BridgePaused = bool(v != 0)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainRateLimitList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainRateLimitList = append(m.ChainRateLimitList, ChainRateLimit{})
+			if err := m.ChainRateLimitList[len(m.ChainRateLimitList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitFlowList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimitFlowList = append(m.RateLimitFlowList, RateLimitFlow{})
+			if err := m.RateLimitFlowList[len(m.RateLimitFlowList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedObservationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedObservationList = append(m.QueuedObservationList, QueuedObservation{})
+			if err := m.QueuedObservationList[len(m.QueuedObservationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgShutdownList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if m.ConsensusGuardianSetIndex != nil {
-		{
-			size, err := m.ConsensusGuardianSetIndex.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.SequenceCounterList) > 0 {
-		for iNdEx := len(m.SequenceCounterList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SequenceCounterList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.ReplayProtectionList) > 0 {
-		for iNdEx := len(m.ReplayProtectionList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ReplayProtectionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.Config != nil {
-		{
-			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+			m.MsgShutdownList = append(m.MsgShutdownList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianHeartbeatList", wireType)
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.GuardianSetList) > 0 {
-		for iNdEx := len(m.GuardianSetList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.GuardianSetList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.GuardianSetList) > 0 {
-		for _, e := range m.GuardianSetList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if m.Config != nil {
-		l = m.Config.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if len(m.ReplayProtectionList) > 0 {
-		for _, e := range m.ReplayProtectionList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.SequenceCounterList) > 0 {
-		for _, e := range m.SequenceCounterList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if m.ConsensusGuardianSetIndex != nil {
-		l = m.ConsensusGuardianSetIndex.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if len(m.GuardianValidatorList) > 0 {
-		for _, e := range m.GuardianValidatorList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.AllowedAddresses) > 0 {
-		for _, e := range m.AllowedAddresses {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.WasmInstantiateAllowlist) > 0 {
-		for _, e := range m.WasmInstantiateAllowlist {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	l = m.IbcComposabilityMwContract.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.GuardianHeartbeatList = append(m.GuardianHeartbeatList, GuardianHeartbeat{})
+			if err := m.GuardianHeartbeatList[len(m.GuardianHeartbeatList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GuardianSetList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IcaHostAllowlist", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -446,14 +1971,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GuardianSetList = append(m.GuardianSetList, GuardianSet{})
-			if err := m.GuardianSetList[len(m.GuardianSetList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IcaHostAllowlist = append(m.IcaHostAllowlist, IcaHostAllowlistEntry{})
+			if err := m.IcaHostAllowlist[len(m.IcaHostAllowlist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardVolumeList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -480,16 +2005,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = &Config{}
-			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ForwardVolumeList = append(m.ForwardVolumeList, ForwardVolume{})
+			if err := m.ForwardVolumeList[len(m.ForwardVolumeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplayProtectionList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceWindow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -516,14 +2039,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReplayProtectionList = append(m.ReplayProtectionList, ReplayProtection{})
-			if err := m.ReplayProtectionList[len(m.ReplayProtectionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.MaintenanceWindow == nil {
+				m.MaintenanceWindow = &MaintenanceWindow{}
+			}
+			if err := m.MaintenanceWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SequenceCounterList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceReservationList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -550,14 +2075,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SequenceCounterList = append(m.SequenceCounterList, SequenceCounter{})
-			if err := m.SequenceCounterList[len(m.SequenceCounterList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SequenceReservationList = append(m.SequenceReservationList, SequenceReservation{})
+			if err := m.SequenceReservationList[len(m.SequenceReservationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusGuardianSetIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoreContract", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -584,16 +2109,13 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsensusGuardianSetIndex == nil {
-				m.ConsensusGuardianSetIndex = &ConsensusGuardianSetIndex{}
-			}
-			if err := m.ConsensusGuardianSetIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CoreContract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GuardianValidatorList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedCodeHashList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -620,14 +2142,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GuardianValidatorList = append(m.GuardianValidatorList, GuardianValidator{})
-			if err := m.GuardianValidatorList[len(m.GuardianValidatorList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ApprovedCodeHashList = append(m.ApprovedCodeHashList, ApprovedCodeHash{})
+			if err := m.ApprovedCodeHashList[len(m.ApprovedCodeHashList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianValidatorTransitionList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -654,14 +2176,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedAddresses = append(m.AllowedAddresses, ValidatorAllowedAddress{})
-			if err := m.AllowedAddresses[len(m.AllowedAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GuardianValidatorTransitionList = append(m.GuardianValidatorTransitionList, GuardianValidatorTransition{})
+			if err := m.GuardianValidatorTransitionList[len(m.GuardianValidatorTransitionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WasmInstantiateAllowlist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedObservationList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -688,14 +2210,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WasmInstantiateAllowlist = append(m.WasmInstantiateAllowlist, WasmInstantiateAllowedContractCodeId{})
-			if err := m.WasmInstantiateAllowlist[len(m.WasmInstantiateAllowlist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FinalizedObservationList = append(m.FinalizedObservationList, FinalizedObservation{})
+			if err := m.FinalizedObservationList[len(m.FinalizedObservationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcComposabilityMwContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingForwardFeeList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -722,7 +2244,8 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IbcComposabilityMwContract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingForwardFeeList = append(m.PendingForwardFeeList, PendingForwardFee{})
+			if err := m.PendingForwardFeeList[len(m.PendingForwardFeeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1,13 +1,20 @@
 package types_test
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/stretchr/testify/require"
 	"github.com/wormhole-foundation/wormchain/x/wormhole/types"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	maintenanceContract := sdk.AccAddress(bytes.Repeat([]byte{1}, 32)).String()
+	reservationEmitter := strings.Repeat("ab", 32)
+
 	for _, tc := range []struct {
 		desc     string
 		genState *types.GenesisState
@@ -121,6 +128,506 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "duplicated executedGovernanceVAA",
+			genState: &types.GenesisState{
+				ExecutedGovernanceVaaList: []types.ExecutedGovernanceVAA{
+					{
+						Digest: make([]byte, 32),
+					},
+					{
+						Digest: make([]byte, 32),
+						Height: 1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid executedGovernanceVAA digest",
+			genState: &types.GenesisState{
+				ExecutedGovernanceVaaList: []types.ExecutedGovernanceVAA{
+					{
+						Digest: []byte{1},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "activation height of unknown guardianSet",
+			genState: &types.GenesisState{
+				GuardianSetList: []types.GuardianSet{
+					{
+						Index: 0,
+					},
+				},
+				GuardianSetActivationHeightList: []types.GuardianSetActivationHeight{
+					{
+						Index:  1,
+						Height: 10,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated guardianSetActivationHeight",
+			genState: &types.GenesisState{
+				GuardianSetList: []types.GuardianSet{
+					{
+						Index: 0,
+					},
+				},
+				GuardianSetActivationHeightList: []types.GuardianSetActivationHeight{
+					{
+						Index:  0,
+						Height: 10,
+					},
+					{
+						Index:  0,
+						Height: 11,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid governanceSubmitter",
+			genState: &types.GenesisState{
+				GovernanceSubmitterList: []types.GovernanceSubmitter{
+					{
+						Address: "not an address",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated archivedVAA",
+			genState: &types.GenesisState{
+				ArchivedVaaList: []types.ArchivedVAA{
+					{
+						Digest:         bytes.Repeat([]byte{1}, 32),
+						EmitterChain:   2,
+						EmitterAddress: bytes.Repeat([]byte{2}, 32),
+						Sequence:       1,
+					},
+					{
+						Digest:         bytes.Repeat([]byte{3}, 32),
+						EmitterChain:   2,
+						EmitterAddress: bytes.Repeat([]byte{2}, 32),
+						Sequence:       1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid archivedVAA emitter address",
+			genState: &types.GenesisState{
+				ArchivedVaaList: []types.ArchivedVAA{
+					{
+						Digest:         bytes.Repeat([]byte{1}, 32),
+						EmitterChain:   2,
+						EmitterAddress: []byte{2},
+						Sequence:       1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "guardianSetWeights for unknown guardianSet",
+			genState: &types.GenesisState{
+				GuardianSetWeightsList: []types.GuardianSetWeights{
+					{
+						GuardianSetIndex: 1,
+						Weights:          []uint64{1},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "guardianSetWeights not matching guardianSet size",
+			genState: &types.GenesisState{
+				GuardianSetList: []types.GuardianSet{
+					{
+						Index: 0,
+						Keys:  [][]byte{bytes.Repeat([]byte{1}, 20)},
+					},
+				},
+				GuardianSetWeightsList: []types.GuardianSetWeights{
+					{
+						GuardianSetIndex: 0,
+						Weights:          []uint64{1, 1},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated observationTally",
+			genState: &types.GenesisState{
+				ObservationTallyList: []types.ObservationTally{
+					{
+						Digest: bytes.Repeat([]byte{1}, 32),
+					},
+					{
+						Digest: bytes.Repeat([]byte{1}, 32),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated finalizedObservation",
+			genState: &types.GenesisState{
+				FinalizedObservationList: []types.FinalizedObservation{
+					{
+						Digest: bytes.Repeat([]byte{1}, 32),
+					},
+					{
+						Digest: bytes.Repeat([]byte{1}, 32),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated chainRateLimit",
+			genState: &types.GenesisState{
+				ChainRateLimitList: []types.ChainRateLimit{
+					{
+						ChainId:      2,
+						Limit:        1,
+						WindowBlocks: 1,
+					},
+					{
+						ChainId:      2,
+						Limit:        2,
+						WindowBlocks: 2,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "chainRateLimit without window",
+			genState: &types.GenesisState{
+				ChainRateLimitList: []types.ChainRateLimit{
+					{
+						ChainId: 2,
+						Limit:   1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated rateLimitFlow",
+			genState: &types.GenesisState{
+				RateLimitFlowList: []types.RateLimitFlow{
+					{
+						ChainId: 2,
+						Height:  1,
+						Count:   1,
+					},
+					{
+						ChainId: 2,
+						Height:  1,
+						Count:   2,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "queuedObservation without observationTally",
+			genState: &types.GenesisState{
+				QueuedObservationList: []types.QueuedObservation{
+					{
+						Digest:       bytes.Repeat([]byte{1}, 32),
+						EmitterChain: 2,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated msgShutdown",
+			genState: &types.GenesisState{
+				MsgShutdownList: []string{
+					"/wormhole_foundation.wormchain.wormhole.MsgSubmitObservation",
+					"/wormhole_foundation.wormchain.wormhole.MsgSubmitObservation",
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "msgShutdown of MsgExecuteGovernanceVAA",
+			genState: &types.GenesisState{
+				MsgShutdownList: []string{
+					"/wormhole_foundation.wormchain.wormhole.MsgExecuteGovernanceVAA",
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated guardianHeartbeat",
+			genState: &types.GenesisState{
+				GuardianHeartbeatList: []types.GuardianHeartbeat{
+					{GuardianKey: make([]byte, 20)},
+					{GuardianKey: make([]byte, 20)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "guardianHeartbeat with duplicated chain height",
+			genState: &types.GenesisState{
+				GuardianHeartbeatList: []types.GuardianHeartbeat{
+					{
+						GuardianKey: make([]byte, 20),
+						Heights:     []types.ChainHeight{{ChainId: 2, Height: 1}, {ChainId: 2, Height: 2}},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid icaHostAllowlist",
+			genState: &types.GenesisState{
+				IcaHostAllowlist: []types.IcaHostAllowlistEntry{
+					{ConnectionId: "connection-0", MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend"},
+					{ConnectionId: "connection-1", MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend"},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated icaHostAllowlist",
+			genState: &types.GenesisState{
+				IcaHostAllowlist: []types.IcaHostAllowlistEntry{
+					{ConnectionId: "connection-0", MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend"},
+					{ConnectionId: "connection-0", MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "icaHostAllowlist with invalid message type url",
+			genState: &types.GenesisState{
+				IcaHostAllowlist: []types.IcaHostAllowlistEntry{
+					{ConnectionId: "connection-0", MsgTypeUrl: "cosmos.bank.v1beta1.MsgSend"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid forwardVolumeList",
+			genState: &types.GenesisState{
+				ForwardVolumeList: []types.ForwardVolume{
+					{ChannelId: "channel-0", Denom: "uworm", Amount: sdk.NewInt(100), Fees: sdk.NewInt(1), Transfers: 1},
+					{ChannelId: "channel-1", Denom: "uworm", Amount: sdk.NewInt(100), Fees: sdk.ZeroInt(), Transfers: 1},
+				},
+				Params: &types.Params{ForwardFeeBps: 25},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated forwardVolume",
+			genState: &types.GenesisState{
+				ForwardVolumeList: []types.ForwardVolume{
+					{ChannelId: "channel-0", Denom: "uworm", Amount: sdk.NewInt(100), Fees: sdk.NewInt(1), Transfers: 1},
+					{ChannelId: "channel-0", Denom: "uworm", Amount: sdk.NewInt(100), Fees: sdk.NewInt(1), Transfers: 1},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "forwardVolume with negative amount",
+			genState: &types.GenesisState{
+				ForwardVolumeList: []types.ForwardVolume{
+					{ChannelId: "channel-0", Denom: "uworm", Amount: sdk.NewInt(-1), Fees: sdk.ZeroInt(), Transfers: 1},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid pendingForwardFeeList",
+			genState: &types.GenesisState{
+				PendingForwardFeeList: []types.PendingForwardFee{
+					{PortId: "transfer", ChannelId: "channel-0", Sequence: 1, Denom: "uworm", Amount: sdk.NewInt(990), Fee: sdk.NewInt(10), RefundPortId: "transfer", RefundChannelId: "channel-1"},
+					{PortId: "transfer", ChannelId: "channel-0", Sequence: 2, Denom: "uworm", Amount: sdk.NewInt(990), Fee: sdk.NewInt(10), RefundPortId: "transfer", RefundChannelId: "channel-1"},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated pendingForwardFee",
+			genState: &types.GenesisState{
+				PendingForwardFeeList: []types.PendingForwardFee{
+					{PortId: "transfer", ChannelId: "channel-0", Sequence: 1, Denom: "uworm", Amount: sdk.NewInt(990), Fee: sdk.NewInt(10), RefundPortId: "transfer", RefundChannelId: "channel-1"},
+					{PortId: "transfer", ChannelId: "channel-0", Sequence: 1, Denom: "uworm", Amount: sdk.NewInt(990), Fee: sdk.NewInt(10), RefundPortId: "transfer", RefundChannelId: "channel-1"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "pendingForwardFee without refund channel",
+			genState: &types.GenesisState{
+				PendingForwardFeeList: []types.PendingForwardFee{
+					{PortId: "transfer", ChannelId: "channel-0", Sequence: 1, Denom: "uworm", Amount: sdk.NewInt(990), Fee: sdk.NewInt(10)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "forward fee over 100%",
+			genState: &types.GenesisState{
+				Params: &types.Params{ForwardFeeBps: types.MaxForwardFeeBps + 1},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid maintenanceWindow",
+			genState: &types.GenesisState{
+				MaintenanceWindow: &types.MaintenanceWindow{StartHeight: 10, EndHeight: 20, Contracts: []string{maintenanceContract}},
+			},
+			valid: true,
+		},
+		{
+			desc: "maintenanceWindow ending before it starts",
+			genState: &types.GenesisState{
+				MaintenanceWindow: &types.MaintenanceWindow{StartHeight: 20, EndHeight: 10, Contracts: []string{maintenanceContract}},
+			},
+			valid: false,
+		},
+		{
+			desc: "maintenanceWindow with duplicated contract",
+			genState: &types.GenesisState{
+				MaintenanceWindow: &types.MaintenanceWindow{StartHeight: 10, EndHeight: 20, Contracts: []string{maintenanceContract, maintenanceContract}},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid sequenceReservationList",
+			genState: &types.GenesisState{
+				SequenceCounterList: []types.SequenceCounter{{Index: reservationEmitter, Sequence: 10}},
+				SequenceReservationList: []types.SequenceReservation{
+					{Emitter: reservationEmitter, Start: 2, Count: 3, Used: 1},
+					{Emitter: reservationEmitter, Start: 5, Count: 5},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated sequenceReservation",
+			genState: &types.GenesisState{
+				SequenceCounterList: []types.SequenceCounter{{Index: reservationEmitter, Sequence: 10}},
+				SequenceReservationList: []types.SequenceReservation{
+					{Emitter: reservationEmitter, Start: 2, Count: 3},
+					{Emitter: reservationEmitter, Start: 2, Count: 3},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "sequenceReservation past the sequence counter",
+			genState: &types.GenesisState{
+				SequenceCounterList:     []types.SequenceCounter{{Index: reservationEmitter, Sequence: 4}},
+				SequenceReservationList: []types.SequenceReservation{{Emitter: reservationEmitter, Start: 2, Count: 3}},
+			},
+			valid: false,
+		},
+		{
+			desc: "exhausted sequenceReservation",
+			genState: &types.GenesisState{
+				SequenceCounterList:     []types.SequenceCounter{{Index: reservationEmitter, Sequence: 10}},
+				SequenceReservationList: []types.SequenceReservation{{Emitter: reservationEmitter, Start: 2, Count: 3, Used: 3}},
+			},
+			valid: false,
+		},
+		{
+			desc: "sequenceReservation with invalid emitter",
+			genState: &types.GenesisState{
+				SequenceCounterList:     []types.SequenceCounter{{Index: "abab", Sequence: 10}},
+				SequenceReservationList: []types.SequenceReservation{{Emitter: "abab", Start: 2, Count: 3}},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid guardianValidatorTransitionList",
+			genState: &types.GenesisState{
+				GuardianValidatorTransitionList: []types.GuardianValidatorTransition{
+					{ValidatorAddr: []byte{1}, GuardianKey: bytes.Repeat([]byte{1}, 20), UnbondHeight: 10},
+					{ValidatorAddr: []byte{2}, GuardianKey: bytes.Repeat([]byte{2}, 20), UnbondHeight: 20},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated guardianValidatorTransition",
+			genState: &types.GenesisState{
+				GuardianValidatorTransitionList: []types.GuardianValidatorTransition{
+					{ValidatorAddr: []byte{1}, GuardianKey: bytes.Repeat([]byte{1}, 20), UnbondHeight: 10},
+					{ValidatorAddr: []byte{1}, GuardianKey: bytes.Repeat([]byte{2}, 20), UnbondHeight: 20},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "guardianValidatorTransition with invalid guardian key",
+			genState: &types.GenesisState{
+				GuardianValidatorTransitionList: []types.GuardianValidatorTransition{
+					{ValidatorAddr: []byte{1}, GuardianKey: []byte{1}, UnbondHeight: 10},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid coreContract",
+			genState: &types.GenesisState{
+				CoreContract: types.CoreContract{ContractAddress: sdk.AccAddress(bytes.Repeat([]byte{2}, 32)).String()},
+			},
+			valid: true,
+		},
+		{
+			desc: "coreContract with invalid address",
+			genState: &types.GenesisState{
+				CoreContract: types.CoreContract{ContractAddress: "wormhole1invalid"},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid approvedCodeHashList",
+			genState: &types.GenesisState{
+				ApprovedCodeHashList: []types.ApprovedCodeHash{
+					{CodeId: 1, CodeHash: bytes.Repeat([]byte{1}, 32)},
+					{CodeId: 2, CodeHash: bytes.Repeat([]byte{1}, 32)},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated approvedCodeHash",
+			genState: &types.GenesisState{
+				ApprovedCodeHashList: []types.ApprovedCodeHash{
+					{CodeId: 1, CodeHash: bytes.Repeat([]byte{1}, 32)},
+					{CodeId: 1, CodeHash: bytes.Repeat([]byte{2}, 32)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "approvedCodeHash with invalid hash",
+			genState: &types.GenesisState{
+				ApprovedCodeHashList: []types.ApprovedCodeHash{{CodeId: 1, CodeHash: []byte{1}}},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
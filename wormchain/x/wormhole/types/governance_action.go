@@ -0,0 +1,164 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/holiman/uint256"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// governancePayloadBody is a governance action payload of the sdk.
+type governancePayloadBody interface {
+	Deserialize(bz []byte) error
+}
+
+type governanceAction struct {
+	name string
+	// returns the body the payload decodes into, nil for actions without payload
+	body func() governancePayloadBody
+}
+
+var (
+	coreModule [32]byte
+
+	governanceModuleNames = map[[32]byte]string{}
+
+	governanceActions = map[[32]byte]map[byte]governanceAction{}
+)
+
+func init() {
+	copy(coreModule[:], vaa.CoreModule)
+
+	governanceModuleNames[coreModule] = "Core"
+	governanceModuleNames[vaa.GatewayModule] = "Gateway"
+	governanceModuleNames[vaa.WasmdModule] = "WasmdModule"
+
+	governanceActions[coreModule] = map[byte]governanceAction{
+		byte(vaa.ActionGuardianSetUpdate):          {"GuardianSetUpdate", func() governancePayloadBody { return &vaa.BodyGuardianSetUpdate{} }},
+		byte(vaa.ActionUpdateGovernanceEmitter):    {"UpdateGovernanceEmitter", func() governancePayloadBody { return &vaa.BodyWormchainUpdateGovernanceEmitter{} }},
+		byte(vaa.ActionPruneGuardianSets):          {"PruneGuardianSets", func() governancePayloadBody { return &vaa.BodyWormchainPruneGuardianSets{} }},
+		byte(vaa.ActionConsensusParamsUpdate):      {"ConsensusParamsUpdate", func() governancePayloadBody { return &vaa.BodyWormchainConsensusParamsUpdate{} }},
+		byte(vaa.ActionScheduledGuardianSetUpdate): {"ScheduledGuardianSetUpdate", func() governancePayloadBody { return &vaa.BodyScheduledGuardianSetUpdate{} }},
+		byte(vaa.ActionFeeParamsUpdate):            {"FeeParamsUpdate", func() governancePayloadBody { return &vaa.BodyWormchainFeeParamsUpdate{} }},
+		byte(vaa.ActionSignatureGasUpdate):         {"SignatureGasUpdate", func() governancePayloadBody { return &vaa.BodyWormchainSignatureGasUpdate{} }},
+		byte(vaa.ActionRegisterEmitter):            {"RegisterEmitter", func() governancePayloadBody { return &vaa.BodyWormchainRegisterEmitter{} }},
+		byte(vaa.ActionQuorumThresholdUpdate):      {"QuorumThresholdUpdate", func() governancePayloadBody { return &vaa.BodyWormchainQuorumThresholdUpdate{} }},
+		byte(vaa.ActionGovernanceSubmitterUpdate):  {"GovernanceSubmitterUpdate", func() governancePayloadBody { return &vaa.BodyWormchainGovernanceSubmitterUpdate{} }},
+		byte(vaa.ActionVAAArchiveRetentionUpdate):  {"VAAArchiveRetentionUpdate", func() governancePayloadBody { return &vaa.BodyWormchainVAAArchiveRetentionUpdate{} }},
+		byte(vaa.ActionGuardianSetWeightsUpdate):   {"GuardianSetWeightsUpdate", func() governancePayloadBody { return &vaa.BodyWormchainGuardianSetWeightsUpdate{} }},
+		byte(vaa.ActionPauseBridge):                {"PauseBridge", nil},
+		byte(vaa.ActionResumeBridge):               {"ResumeBridge", nil},
+		byte(vaa.ActionChainRateLimitUpdate):       {"ChainRateLimitUpdate", func() governancePayloadBody { return &vaa.BodyWormchainChainRateLimitUpdate{} }},
+		byte(vaa.ActionMsgShutdownUpdate):          {"MsgShutdownUpdate", func() governancePayloadBody { return &vaa.BodyWormchainMsgShutdownUpdate{} }},
+		byte(vaa.ActionForwardFeeUpdate):           {"ForwardFeeUpdate", func() governancePayloadBody { return &vaa.BodyWormchainForwardFeeUpdate{} }},
+		byte(vaa.ActionMaintenanceWindowUpdate):    {"MaintenanceWindowUpdate", func() governancePayloadBody { return &vaa.BodyWormchainMaintenanceWindowUpdate{} }},
+		byte(vaa.ActionValidatorTransitionWindowUpdate): {"ValidatorTransitionWindowUpdate", func() governancePayloadBody {
+			return &vaa.BodyWormchainValidatorTransitionWindowUpdate{}
+		}},
+	}
+
+	governanceActions[vaa.GatewayModule] = map[byte]governanceAction{
+		byte(vaa.ActionScheduleUpgrade):               {"ScheduleUpgrade", func() governancePayloadBody { return &vaa.BodyGatewayScheduleUpgrade{} }},
+		byte(vaa.ActionCancelUpgrade):                 {"CancelUpgrade", nil},
+		byte(vaa.ActionSetIbcComposabilityMwContract): {"SetIbcComposabilityMwContract", func() governancePayloadBody { return &vaa.BodyGatewayIbcComposabilityMwContract{} }},
+		byte(vaa.ActionSlashingParamsUpdate):          {"SlashingParamsUpdate", func() governancePayloadBody { return &vaa.BodyGatewaySlashingParamsUpdate{} }},
+		byte(vaa.ActionStakingParamsUpdate):           {"StakingParamsUpdate", func() governancePayloadBody { return &vaa.BodyGatewayStakingParamsUpdate{} }},
+		byte(vaa.ActionIcaHostAllowlistUpdate):        {"IcaHostAllowlistUpdate", func() governancePayloadBody { return &vaa.BodyGatewayIcaHostAllowlistUpdate{} }},
+		byte(vaa.ActionTokenFactoryAdminUpdate):       {"TokenFactoryAdminUpdate", func() governancePayloadBody { return &vaa.BodyGatewayTokenFactoryAdminUpdate{} }},
+		byte(vaa.ActionTokenFactoryMetadataUpdate):    {"TokenFactoryMetadataUpdate", func() governancePayloadBody { return &vaa.BodyGatewayTokenFactoryMetadataUpdate{} }},
+		byte(vaa.ActionSetCoreContract):               {"SetCoreContract", func() governancePayloadBody { return &vaa.BodyGatewayCoreContract{} }},
+		byte(vaa.ActionApprovedCodeHashUpdate):        {"ApprovedCodeHashUpdate", func() governancePayloadBody { return &vaa.BodyGatewayApprovedCodeHashUpdate{} }},
+	}
+
+	governanceActions[vaa.WasmdModule] = map[byte]governanceAction{
+		byte(vaa.ActionStoreCode):                      {"StoreCode", func() governancePayloadBody { return &vaa.BodyWormchainStoreCode{} }},
+		byte(vaa.ActionInstantiateContract):            {"InstantiateContract", func() governancePayloadBody { return &vaa.BodyWormchainInstantiateContract{} }},
+		byte(vaa.ActionMigrateContract):                {"MigrateContract", func() governancePayloadBody { return &vaa.BodyWormchainMigrateContract{} }},
+		byte(vaa.ActionAddWasmInstantiateAllowlist):    {"AddWasmInstantiateAllowlist", func() governancePayloadBody { return &vaa.BodyWormchainWasmAllowlistInstantiate{} }},
+		byte(vaa.ActionDeleteWasmInstantiateAllowlist): {"DeleteWasmInstantiateAllowlist", func() governancePayloadBody { return &vaa.BodyWormchainWasmAllowlistInstantiate{} }},
+	}
+}
+
+// GovernanceModuleName returns the name of a wormchain governance module, or
+// the hex encoded module if it is not executed on wormchain.
+func GovernanceModuleName(module [32]byte) string {
+	if name, ok := governanceModuleNames[module]; ok {
+		return name
+	}
+	return hex.EncodeToString(module[:])
+}
+
+// GovernanceActionName returns the name of a wormchain governance action, or
+// an empty string if the action is unknown.
+func GovernanceActionName(module [32]byte, action byte) string {
+	return governanceActions[module][action].name
+}
+
+// DecodeGovernancePayload decodes the payload of a wormchain governance action
+// into its named fields. Byte strings are hex encoded and the other values are
+// formatted as text.
+func DecodeGovernancePayload(module [32]byte, action byte, payload []byte) ([]GovernancePayloadField, error) {
+	a, ok := governanceActions[module][action]
+	if !ok {
+		return nil, ErrUnknownGovernanceAction
+	}
+	if a.body == nil {
+		if len(payload) != 0 {
+			return nil, ErrInvalidGovernancePayloadLength
+		}
+		return nil, nil
+	}
+
+	body := a.body()
+	if err := body.Deserialize(payload); err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidGovernancePayloadLength, err.Error())
+	}
+
+	v := reflect.ValueOf(body).Elem()
+	fields := make([]GovernancePayloadField, 0, v.NumField())
+	for i := 0; i < v.NumField(); i++ {
+		fields = append(fields, GovernancePayloadField{
+			Name:  v.Type().Field(i).Name,
+			Value: formatGovernancePayloadValue(v.Field(i)),
+		})
+	}
+	return fields, nil
+}
+
+func formatGovernancePayloadValue(v reflect.Value) string {
+	switch x := v.Interface().(type) {
+	case fmt.Stringer:
+		// addresses, chain ids and durations
+		if u, ok := x.(*uint256.Int); ok {
+			return u.ToBig().String()
+		}
+		return x.String()
+	case []byte:
+		return hex.EncodeToString(x)
+	}
+
+	switch v.Kind() {
+	case reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			bz := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(bz), v)
+			return hex.EncodeToString(bz)
+		}
+	case reflect.Slice:
+		var buf bytes.Buffer
+		buf.WriteByte('[')
+		for i := 0; i < v.Len(); i++ {
+			if i > 0 {
+				buf.WriteByte(' ')
+			}
+			buf.WriteString(formatGovernancePayloadValue(v.Index(i)))
+		}
+		buf.WriteByte(']')
+		return buf.String()
+	}
+	return fmt.Sprint(v.Interface())
+}
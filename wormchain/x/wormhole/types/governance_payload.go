@@ -0,0 +1,206 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// GovernancePayloadDecoder validates the length of an action payload of one
+// version and returns it in the wire format the action handler expects, so
+// older versions of an action keep executing after its format evolved.
+type GovernancePayloadDecoder func(payload []byte) ([]byte, error)
+
+type governancePayloadKey struct {
+	module  [32]byte
+	action  byte
+	version uint8
+}
+
+type governanceActionKey struct {
+	module [32]byte
+	action byte
+}
+
+// GovernancePayloadRegistry maps (module, action, version) to the decoder of
+// the action payload.
+//
+// Versioned actions carry the payload version in the first byte of the
+// action payload. Legacy actions predate the version byte, their payload is
+// implicitly version 0. An action is either legacy or versioned for its whole
+// lifetime, since a version byte could not be told apart from the first byte
+// of a legacy payload.
+type GovernancePayloadRegistry struct {
+	decoders  map[governancePayloadKey]GovernancePayloadDecoder
+	versioned map[governanceActionKey]bool
+}
+
+func NewGovernancePayloadRegistry() *GovernancePayloadRegistry {
+	return &GovernancePayloadRegistry{
+		decoders:  make(map[governancePayloadKey]GovernancePayloadDecoder),
+		versioned: make(map[governanceActionKey]bool),
+	}
+}
+
+// RegisterLegacy registers the decoder of an action whose payload has no
+// version byte. It panics if the action is already registered.
+func (r *GovernancePayloadRegistry) RegisterLegacy(module [32]byte, action byte, decoder GovernancePayloadDecoder) {
+	r.registerAction(module, action, false)
+	r.decoders[governancePayloadKey{module, action, 0}] = decoder
+}
+
+// RegisterVersioned registers an action whose payload starts with a version
+// byte. Its versions are registered with Register. It panics if the action is
+// already registered.
+func (r *GovernancePayloadRegistry) RegisterVersioned(module [32]byte, action byte) {
+	r.registerAction(module, action, true)
+}
+
+func (r *GovernancePayloadRegistry) registerAction(module [32]byte, action byte, versioned bool) {
+	actionKey := governanceActionKey{module, action}
+	if _, found := r.versioned[actionKey]; found {
+		panic("governance action already registered")
+	}
+	r.versioned[actionKey] = versioned
+}
+
+// Register registers the decoder of a version of a versioned action. Versions
+// start at 1. It panics if the version is already registered or the action is
+// not registered as versioned.
+func (r *GovernancePayloadRegistry) Register(module [32]byte, action byte, version uint8, decoder GovernancePayloadDecoder) {
+	if version == 0 {
+		panic("versioned governance payloads start at version 1")
+	}
+	if versioned := r.versioned[governanceActionKey{module, action}]; !versioned {
+		panic("governance action is not registered as versioned")
+	}
+	key := governancePayloadKey{module, action, version}
+	if _, found := r.decoders[key]; found {
+		panic("governance payload version already registered")
+	}
+	r.decoders[key] = decoder
+}
+
+// IsRegistered returns whether an action is registered.
+func (r *GovernancePayloadRegistry) IsRegistered(module [32]byte, action byte) bool {
+	_, found := r.versioned[governanceActionKey{module, action}]
+	return found
+}
+
+// Decode strips the version byte of a versioned action payload and decodes it
+// with the decoder registered for its version.
+func (r *GovernancePayloadRegistry) Decode(module [32]byte, action byte, payload []byte) (version uint8, decoded []byte, err error) {
+	versioned, found := r.versioned[governanceActionKey{module, action}]
+	if !found {
+		return 0, nil, ErrUnknownGovernanceAction
+	}
+
+	if versioned {
+		if len(payload) == 0 {
+			return 0, nil, ErrInvalidGovernancePayloadLength
+		}
+		version, payload = payload[0], payload[1:]
+	}
+
+	decoder, found := r.decoders[governancePayloadKey{module, action, version}]
+	if !found {
+		return 0, nil, sdkerrors.Wrapf(ErrUnknownGovernancePayloadVersion, "action %d version %d", action, version)
+	}
+
+	decoded, err = decoder(payload)
+	if err != nil {
+		return 0, nil, err
+	}
+	return version, decoded, nil
+}
+
+// payloadLength returns a decoder that accepts payloads of one of the given
+// lengths as is.
+func payloadLength(lengths ...int) GovernancePayloadDecoder {
+	return func(payload []byte) ([]byte, error) {
+		for _, length := range lengths {
+			if len(payload) == length {
+				return payload, nil
+			}
+		}
+		return nil, ErrInvalidGovernancePayloadLength
+	}
+}
+
+// countedPayloadLength returns a decoder that accepts payloads of a header
+// whose last byte counts the entries that follow it, and a fixed length
+// trailer, as is.
+func countedPayloadLength(headerLength int, entryLength int, trailerLength int) GovernancePayloadDecoder {
+	return func(payload []byte) ([]byte, error) {
+		if len(payload) < headerLength || len(payload) != headerLength+entryLength*int(payload[headerLength-1])+trailerLength {
+			return nil, ErrInvalidGovernancePayloadLength
+		}
+		return payload, nil
+	}
+}
+
+// CoreGovernancePayloads is the registry of the wormchain core governance
+// actions executed by MsgExecuteGovernanceVAA.
+var CoreGovernancePayloads = NewGovernancePayloadRegistry()
+
+func init() {
+	var coreModule [32]byte
+	copy(coreModule[:], vaa.CoreModule)
+
+	for action, decoder := range map[vaa.GovernanceAction]GovernancePayloadDecoder{
+		// [uint32 new_index][uint8 num_guardians][20-byte key]*num_guardians
+		vaa.ActionGuardianSetUpdate: countedPayloadLength(5, 20, 0),
+		// [uint16 new_governance_chain][32-byte new_governance_emitter]
+		vaa.ActionUpdateGovernanceEmitter: payloadLength(34),
+		// [uint32 keep_from_index]
+		vaa.ActionPruneGuardianSets: payloadLength(4),
+		// [int64 block_max_bytes][int64 block_max_gas]
+		// [int64 evidence_max_age_num_blocks][int64 evidence_max_age_duration_ns][int64 evidence_max_bytes]
+		vaa.ActionConsensusParamsUpdate: payloadLength(40),
+		// [guardian set update][uint64 activation_height][uint64 activation_time]
+		vaa.ActionScheduledGuardianSetUpdate: countedPayloadLength(5, 20, 16),
+		// [uint256 message_fee][uint256 gateway_transfer_fee]
+		vaa.ActionFeeParamsUpdate: payloadLength(64),
+		// [uint64 gas_per_signature]
+		vaa.ActionSignatureGasUpdate: payloadLength(8),
+		// [uint16 chain_id][32-byte emitter_address][32-byte module]
+		vaa.ActionRegisterEmitter: payloadLength(66),
+		// [uint32 numerator][uint32 denominator]
+		vaa.ActionQuorumThresholdUpdate: payloadLength(8),
+		// [uint8 allowed][20 or 32 byte address]
+		vaa.ActionGovernanceSubmitterUpdate: payloadLength(1+20, 1+32),
+		// [uint64 retention_blocks]
+		vaa.ActionVAAArchiveRetentionUpdate: payloadLength(8),
+		// [uint32 guardian_set_index][uint8 num_guardians][uint64 weight]*num_guardians
+		vaa.ActionGuardianSetWeightsUpdate: countedPayloadLength(5, 8, 0),
+		vaa.ActionPauseBridge:              payloadLength(0),
+		vaa.ActionResumeBridge:             payloadLength(0),
+		// [uint16 chain_id][uint64 limit][uint64 window_blocks]
+		vaa.ActionChainRateLimitUpdate: payloadLength(18),
+	} {
+		CoreGovernancePayloads.RegisterLegacy(coreModule, byte(action), decoder)
+	}
+
+	// [uint8 shutdown][message type URL]
+	CoreGovernancePayloads.RegisterVersioned(coreModule, byte(vaa.ActionMsgShutdownUpdate))
+	CoreGovernancePayloads.Register(coreModule, byte(vaa.ActionMsgShutdownUpdate), 1, func(payload []byte) ([]byte, error) {
+		if len(payload) < 2 {
+			return nil, ErrInvalidGovernancePayloadLength
+		}
+		if payload[0] > 1 {
+			return nil, sdkerrors.Wrapf(ErrInvalidMsgShutdown, "invalid shutdown flag %d", payload[0])
+		}
+		return payload, nil
+	})
+
+	// [uint16 fee_bps]
+	CoreGovernancePayloads.RegisterVersioned(coreModule, byte(vaa.ActionForwardFeeUpdate))
+	CoreGovernancePayloads.Register(coreModule, byte(vaa.ActionForwardFeeUpdate), 1, payloadLength(2))
+
+	// [uint64 start_height][uint64 end_height][uint8 num_contracts][[32]byte contract]...
+	CoreGovernancePayloads.RegisterVersioned(coreModule, byte(vaa.ActionMaintenanceWindowUpdate))
+	CoreGovernancePayloads.Register(coreModule, byte(vaa.ActionMaintenanceWindowUpdate), 1, countedPayloadLength(17, 32, 0))
+
+	// [uint64 transition_blocks]
+	CoreGovernancePayloads.RegisterVersioned(coreModule, byte(vaa.ActionValidatorTransitionWindowUpdate))
+	CoreGovernancePayloads.Register(coreModule, byte(vaa.ActionValidatorTransitionWindowUpdate), 1, payloadLength(8))
+}
@@ -0,0 +1,108 @@
+package types
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestGovernancePayloadRegistry(t *testing.T) {
+	registry := NewGovernancePayloadRegistry()
+	module := vaa.GatewayModule
+
+	registry.RegisterLegacy(module, 1, payloadLength(2))
+	// version 1 carries a uint32, version 2 widened it to a uint64
+	registry.RegisterVersioned(module, 2)
+	registry.Register(module, 2, 1, func(payload []byte) ([]byte, error) {
+		if len(payload) != 4 {
+			return nil, ErrInvalidGovernancePayloadLength
+		}
+		return binary.BigEndian.AppendUint64(nil, uint64(binary.BigEndian.Uint32(payload))), nil
+	})
+	registry.Register(module, 2, 2, payloadLength(8))
+
+	// Legacy payloads have no version byte
+	version, decoded, err := registry.Decode(module, 1, []byte{2, 3})
+	require.NoError(t, err)
+	assert.Equal(t, uint8(0), version)
+	assert.Equal(t, []byte{2, 3}, decoded)
+	_, _, err = registry.Decode(module, 1, []byte{2})
+	assert.ErrorIs(t, err, ErrInvalidGovernancePayloadLength)
+
+	version, decoded, err = registry.Decode(module, 2, []byte{1, 0, 0, 0, 7})
+	require.NoError(t, err)
+	assert.Equal(t, uint8(1), version)
+	assert.Equal(t, binary.BigEndian.AppendUint64(nil, 7), decoded)
+
+	version, decoded, err = registry.Decode(module, 2, append([]byte{2}, binary.BigEndian.AppendUint64(nil, 7)...))
+	require.NoError(t, err)
+	assert.Equal(t, uint8(2), version)
+	assert.Equal(t, binary.BigEndian.AppendUint64(nil, 7), decoded)
+
+	_, _, err = registry.Decode(module, 2, []byte{1, 0, 0, 7})
+	assert.ErrorIs(t, err, ErrInvalidGovernancePayloadLength)
+	_, _, err = registry.Decode(module, 2, []byte{})
+	assert.ErrorIs(t, err, ErrInvalidGovernancePayloadLength)
+	_, _, err = registry.Decode(module, 2, []byte{3, 0, 0, 0, 7})
+	assert.ErrorIs(t, err, ErrUnknownGovernancePayloadVersion)
+	_, _, err = registry.Decode(module, 3, []byte{1})
+	assert.ErrorIs(t, err, ErrUnknownGovernanceAction)
+	_, _, err = registry.Decode(vaa.WasmdModule, 1, []byte{1})
+	assert.ErrorIs(t, err, ErrUnknownGovernanceAction)
+
+	// An action is either legacy or versioned
+	assert.Panics(t, func() { registry.RegisterLegacy(module, 2, nil) })
+	assert.Panics(t, func() { registry.RegisterVersioned(module, 1) })
+	assert.Panics(t, func() { registry.Register(module, 1, 1, nil) })
+	assert.Panics(t, func() { registry.Register(module, 2, 2, nil) })
+	assert.Panics(t, func() { registry.Register(module, 3, 1, nil) })
+	registry.RegisterVersioned(module, 3)
+	assert.Panics(t, func() { registry.Register(module, 3, 0, nil) })
+}
+
+func TestCoreGovernancePayloads(t *testing.T) {
+	var coreModule [32]byte
+	copy(coreModule[:], vaa.CoreModule)
+
+	tests := []struct {
+		action  vaa.GovernanceAction
+		payload []byte
+		valid   bool
+	}{
+		// Legacy actions are decoded as is, after checking their length
+		{vaa.ActionGuardianSetUpdate, append([]byte{0, 0, 0, 1, 1}, make([]byte, 20)...), true},
+		{vaa.ActionGuardianSetUpdate, append([]byte{0, 0, 0, 1, 2}, make([]byte, 20)...), false},
+		{vaa.ActionGuardianSetUpdate, []byte{0, 0, 0, 1}, false},
+		{vaa.ActionScheduledGuardianSetUpdate, append([]byte{0, 0, 0, 1, 1}, make([]byte, 20+16)...), true},
+		{vaa.ActionScheduledGuardianSetUpdate, append([]byte{0, 0, 0, 1, 1}, make([]byte, 20+8)...), false},
+		{vaa.ActionGuardianSetWeightsUpdate, append([]byte{0, 0, 0, 1, 2}, make([]byte, 16)...), true},
+		{vaa.ActionGuardianSetWeightsUpdate, append([]byte{0, 0, 0, 1, 2}, make([]byte, 8)...), false},
+		{vaa.ActionGovernanceSubmitterUpdate, make([]byte, 1+20), true},
+		{vaa.ActionGovernanceSubmitterUpdate, make([]byte, 1+32), true},
+		{vaa.ActionGovernanceSubmitterUpdate, make([]byte, 1+31), false},
+		{vaa.ActionChainRateLimitUpdate, make([]byte, 18), true},
+		{vaa.ActionChainRateLimitUpdate, make([]byte, 17), false},
+		{vaa.ActionPauseBridge, nil, true},
+		{vaa.ActionPauseBridge, []byte{0}, false},
+		// Versioned actions start with their version
+		{vaa.ActionForwardFeeUpdate, []byte{1, 0, 20}, true},
+		{vaa.ActionForwardFeeUpdate, []byte{1, 20}, false},
+		{vaa.ActionMaintenanceWindowUpdate, append([]byte{1}, make([]byte, 17)...), true},
+		{vaa.ActionMaintenanceWindowUpdate, append(append([]byte{1}, make([]byte, 16)...), 1), false},
+	}
+	for _, tc := range tests {
+		_, _, err := CoreGovernancePayloads.Decode(coreModule, byte(tc.action), tc.payload)
+		if tc.valid {
+			require.NoError(t, err, "action %d payload %x", tc.action, tc.payload)
+		} else {
+			assert.ErrorIs(t, err, ErrInvalidGovernancePayloadLength, "action %d payload %x", tc.action, tc.payload)
+		}
+	}
+
+	// Actions of the core bridge that wormchain does not execute are unknown
+	_, _, err := CoreGovernancePayloads.Decode(coreModule, byte(vaa.ActionContractUpgrade), nil)
+	assert.ErrorIs(t, err, ErrUnknownGovernanceAction)
+}
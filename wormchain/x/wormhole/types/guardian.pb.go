@@ -180,6 +180,60 @@ func (m *GuardianSet) GetExpirationTime() uint64 {
 	return 0
 }
 
+// GuardianSetActivationHeight records the block height a guardian set was
+// added at.
+type GuardianSetActivationHeight struct {
+	Index  uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Height int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GuardianSetActivationHeight) Reset()         { *m = GuardianSetActivationHeight{} }
+func (m *GuardianSetActivationHeight) String() string { return proto.CompactTextString(m) }
+func (*GuardianSetActivationHeight) ProtoMessage()    {}
+func (*GuardianSetActivationHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95afcf26fc23dcb3, []int{3}
+}
+func (m *GuardianSetActivationHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GuardianSetActivationHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GuardianSetActivationHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GuardianSetActivationHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GuardianSetActivationHeight.Merge(m, src)
+}
+func (m *GuardianSetActivationHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *GuardianSetActivationHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_GuardianSetActivationHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GuardianSetActivationHeight proto.InternalMessageInfo
+
+func (m *GuardianSetActivationHeight) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *GuardianSetActivationHeight) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 type ValidatorAllowedAddress struct {
 	// the validator/guardian that controls this entry
 	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
@@ -187,13 +241,15 @@ type ValidatorAllowedAddress struct {
 	AllowedAddress string `protobuf:"bytes,2,opt,name=allowed_address,json=allowedAddress,proto3" json:"allowed_address,omitempty"`
 	// human readable name
 	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// block height at which the entry expires and is pruned, 0 if it never expires
+	ExpirationHeight uint64 `protobuf:"varint,4,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
 }
 
 func (m *ValidatorAllowedAddress) Reset()         { *m = ValidatorAllowedAddress{} }
 func (m *ValidatorAllowedAddress) String() string { return proto.CompactTextString(m) }
 func (*ValidatorAllowedAddress) ProtoMessage()    {}
 func (*ValidatorAllowedAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95afcf26fc23dcb3, []int{3}
+	return fileDescriptor_95afcf26fc23dcb3, []int{4}
 }
 func (m *ValidatorAllowedAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -243,6 +299,61 @@ func (m *ValidatorAllowedAddress) GetName() string {
 	return ""
 }
 
+func (m *ValidatorAllowedAddress) GetExpirationHeight() uint64 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
+}
+
+// GovernanceSubmitter is an account allowed to submit governance VAAs and
+// other guardian-only messages. While no account is allowlisted, anyone can
+// submit them.
+type GovernanceSubmitter struct {
+	// bech32 address of the allowlisted account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *GovernanceSubmitter) Reset()         { *m = GovernanceSubmitter{} }
+func (m *GovernanceSubmitter) String() string { return proto.CompactTextString(m) }
+func (*GovernanceSubmitter) ProtoMessage()    {}
+func (*GovernanceSubmitter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95afcf26fc23dcb3, []int{5}
+}
+func (m *GovernanceSubmitter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovernanceSubmitter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovernanceSubmitter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GovernanceSubmitter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernanceSubmitter.Merge(m, src)
+}
+func (m *GovernanceSubmitter) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovernanceSubmitter) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernanceSubmitter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernanceSubmitter proto.InternalMessageInfo
+
+func (m *GovernanceSubmitter) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 type WasmInstantiateAllowedContractCodeId struct {
 	// bech32 address of the contract that can call wasm instantiate without a VAA
 	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
@@ -254,7 +365,7 @@ func (m *WasmInstantiateAllowedContractCodeId) Reset()         { *m = WasmInstan
 func (m *WasmInstantiateAllowedContractCodeId) String() string { return proto.CompactTextString(m) }
 func (*WasmInstantiateAllowedContractCodeId) ProtoMessage()    {}
 func (*WasmInstantiateAllowedContractCodeId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95afcf26fc23dcb3, []int{4}
+	return fileDescriptor_95afcf26fc23dcb3, []int{6}
 }
 func (m *WasmInstantiateAllowedContractCodeId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -297,6 +408,60 @@ func (m *WasmInstantiateAllowedContractCodeId) GetCodeId() uint64 {
 	return 0
 }
 
+type IcaHostAllowlistEntry struct {
+	// IBC connection of the interchain accounts the entry applies to
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// type URL of the message the interchain accounts may execute
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+}
+
+func (m *IcaHostAllowlistEntry) Reset()         { *m = IcaHostAllowlistEntry{} }
+func (m *IcaHostAllowlistEntry) String() string { return proto.CompactTextString(m) }
+func (*IcaHostAllowlistEntry) ProtoMessage()    {}
+func (*IcaHostAllowlistEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95afcf26fc23dcb3, []int{7}
+}
+func (m *IcaHostAllowlistEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IcaHostAllowlistEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IcaHostAllowlistEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IcaHostAllowlistEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IcaHostAllowlistEntry.Merge(m, src)
+}
+func (m *IcaHostAllowlistEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *IcaHostAllowlistEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_IcaHostAllowlistEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IcaHostAllowlistEntry proto.InternalMessageInfo
+
+func (m *IcaHostAllowlistEntry) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *IcaHostAllowlistEntry) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
 type IbcComposabilityMwContract struct {
 	// bech32 address of the contract that is used by the ibc composability middleware
 	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
@@ -306,7 +471,7 @@ func (m *IbcComposabilityMwContract) Reset()         { *m = IbcComposabilityMwCo
 func (m *IbcComposabilityMwContract) String() string { return proto.CompactTextString(m) }
 func (*IbcComposabilityMwContract) ProtoMessage()    {}
 func (*IbcComposabilityMwContract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95afcf26fc23dcb3, []int{5}
+	return fileDescriptor_95afcf26fc23dcb3, []int{8}
 }
 func (m *IbcComposabilityMwContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -342,46 +507,229 @@ func (m *IbcComposabilityMwContract) GetContractAddress() string {
 	return ""
 }
 
+type ApprovedCodeHash struct {
+	CodeId uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// wasm checksum of the code that instantiations and migrations to the code
+	// id must match
+	CodeHash []byte `protobuf:"bytes,2,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+}
+
+func (m *ApprovedCodeHash) Reset()         { *m = ApprovedCodeHash{} }
+func (m *ApprovedCodeHash) String() string { return proto.CompactTextString(m) }
+func (*ApprovedCodeHash) ProtoMessage()    {}
+func (*ApprovedCodeHash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95afcf26fc23dcb3, []int{9}
+}
+func (m *ApprovedCodeHash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApprovedCodeHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApprovedCodeHash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApprovedCodeHash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApprovedCodeHash.Merge(m, src)
+}
+func (m *ApprovedCodeHash) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApprovedCodeHash) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApprovedCodeHash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApprovedCodeHash proto.InternalMessageInfo
+
+func (m *ApprovedCodeHash) GetCodeId() uint64 {
+	if m != nil {
+		return m.CodeId
+	}
+	return 0
+}
+
+func (m *ApprovedCodeHash) GetCodeHash() []byte {
+	if m != nil {
+		return m.CodeHash
+	}
+	return nil
+}
+
+type CoreContract struct {
+	// bech32 address of the core contract whose published messages are
+	// reported to the message posted hooks
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *CoreContract) Reset()         { *m = CoreContract{} }
+func (m *CoreContract) String() string { return proto.CompactTextString(m) }
+func (*CoreContract) ProtoMessage()    {}
+func (*CoreContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95afcf26fc23dcb3, []int{10}
+}
+func (m *CoreContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CoreContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CoreContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CoreContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CoreContract.Merge(m, src)
+}
+func (m *CoreContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *CoreContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_CoreContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CoreContract proto.InternalMessageInfo
+
+func (m *CoreContract) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+// GuardianValidatorTransition keeps the validator of a guardian removed from
+// the consensus guardian set bonded until the end of the transition window.
+type GuardianValidatorTransition struct {
+	ValidatorAddr []byte `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	GuardianKey   []byte `protobuf:"bytes,2,opt,name=guardian_key,json=guardianKey,proto3" json:"guardian_key,omitempty"`
+	// height of the first block the validator is no longer bonded in
+	UnbondHeight int64 `protobuf:"varint,3,opt,name=unbond_height,json=unbondHeight,proto3" json:"unbond_height,omitempty"`
+}
+
+func (m *GuardianValidatorTransition) Reset()         { *m = GuardianValidatorTransition{} }
+func (m *GuardianValidatorTransition) String() string { return proto.CompactTextString(m) }
+func (*GuardianValidatorTransition) ProtoMessage()    {}
+func (*GuardianValidatorTransition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95afcf26fc23dcb3, []int{11}
+}
+func (m *GuardianValidatorTransition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GuardianValidatorTransition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GuardianValidatorTransition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GuardianValidatorTransition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GuardianValidatorTransition.Merge(m, src)
+}
+func (m *GuardianValidatorTransition) XXX_Size() int {
+	return m.Size()
+}
+func (m *GuardianValidatorTransition) XXX_DiscardUnknown() {
+	xxx_messageInfo_GuardianValidatorTransition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GuardianValidatorTransition proto.InternalMessageInfo
+
+func (m *GuardianValidatorTransition) GetValidatorAddr() []byte {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return nil
+}
+
+func (m *GuardianValidatorTransition) GetGuardianKey() []byte {
+	if m != nil {
+		return m.GuardianKey
+	}
+	return nil
+}
+
+func (m *GuardianValidatorTransition) GetUnbondHeight() int64 {
+	if m != nil {
+		return m.UnbondHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GuardianKey)(nil), "wormhole_foundation.wormchain.wormhole.GuardianKey")
 	proto.RegisterType((*GuardianValidator)(nil), "wormhole_foundation.wormchain.wormhole.GuardianValidator")
 	proto.RegisterType((*GuardianSet)(nil), "wormhole_foundation.wormchain.wormhole.GuardianSet")
+	proto.RegisterType((*GuardianSetActivationHeight)(nil), "wormhole_foundation.wormchain.wormhole.GuardianSetActivationHeight")
 	proto.RegisterType((*ValidatorAllowedAddress)(nil), "wormhole_foundation.wormchain.wormhole.ValidatorAllowedAddress")
+	proto.RegisterType((*GovernanceSubmitter)(nil), "wormhole_foundation.wormchain.wormhole.GovernanceSubmitter")
 	proto.RegisterType((*WasmInstantiateAllowedContractCodeId)(nil), "wormhole_foundation.wormchain.wormhole.WasmInstantiateAllowedContractCodeId")
+	proto.RegisterType((*IcaHostAllowlistEntry)(nil), "wormhole_foundation.wormchain.wormhole.IcaHostAllowlistEntry")
 	proto.RegisterType((*IbcComposabilityMwContract)(nil), "wormhole_foundation.wormchain.wormhole.IbcComposabilityMwContract")
+	proto.RegisterType((*ApprovedCodeHash)(nil), "wormhole_foundation.wormchain.wormhole.ApprovedCodeHash")
+	proto.RegisterType((*CoreContract)(nil), "wormhole_foundation.wormchain.wormhole.CoreContract")
+	proto.RegisterType((*GuardianValidatorTransition)(nil), "wormhole_foundation.wormchain.wormhole.GuardianValidatorTransition")
 }
 
 func init() { proto.RegisterFile("wormhole/guardian.proto", fileDescriptor_95afcf26fc23dcb3) }
 
 var fileDescriptor_95afcf26fc23dcb3 = []byte{
-	// 432 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x4d, 0x6f, 0xd3, 0x40,
-	0x14, 0xcc, 0x26, 0xa6, 0xa8, 0xaf, 0x5f, 0xe9, 0xaa, 0x52, 0xa2, 0x1e, 0x5c, 0xcb, 0xaa, 0x4a,
-	0x10, 0x22, 0x3e, 0x70, 0x82, 0x5b, 0xc9, 0xa1, 0x8a, 0x10, 0x17, 0x17, 0x81, 0x04, 0x87, 0x68,
-	0xed, 0x5d, 0x9c, 0xa5, 0xf6, 0x3e, 0xcb, 0xde, 0x90, 0xf8, 0xcc, 0x1f, 0xe0, 0x27, 0xf0, 0x73,
-	0x38, 0xf6, 0xc8, 0x11, 0x25, 0x17, 0x7e, 0x06, 0xf2, 0xfa, 0x23, 0xa4, 0x37, 0x6e, 0xb3, 0xf3,
-	0xe6, 0xbd, 0x99, 0x95, 0x06, 0x06, 0x4b, 0xcc, 0x92, 0x39, 0xc6, 0xc2, 0x8b, 0x16, 0x2c, 0xe3,
-	0x92, 0xa9, 0x71, 0x9a, 0xa1, 0x46, 0x7a, 0xd5, 0x0c, 0x66, 0x9f, 0x71, 0xa1, 0x38, 0xd3, 0x12,
-	0xd5, 0xb8, 0xe4, 0xc2, 0x39, 0x93, 0x15, 0x2a, 0xa7, 0xe7, 0x67, 0x11, 0x46, 0x68, 0x56, 0xbc,
-	0x12, 0x55, 0xdb, 0xee, 0x05, 0x1c, 0xdc, 0xd4, 0xf7, 0xde, 0x88, 0x82, 0xf6, 0xa1, 0x77, 0x27,
-	0x8a, 0x21, 0x71, 0xc8, 0xe8, 0xd0, 0x2f, 0xa1, 0xfb, 0x09, 0x4e, 0x1b, 0xc1, 0x7b, 0x16, 0x4b,
-	0xce, 0x34, 0x66, 0xd4, 0x81, 0x83, 0x68, 0xbb, 0x55, 0xcb, 0xff, 0xa5, 0xe8, 0x25, 0x1c, 0x7d,
-	0x6d, 0xe4, 0xd7, 0x9c, 0x67, 0xc3, 0xae, 0xd1, 0xec, 0x92, 0xae, 0xd8, 0xba, 0xdf, 0x0a, 0x4d,
-	0xcf, 0xe0, 0x91, 0x54, 0x5c, 0xac, 0xcc, 0xc1, 0x23, 0xbf, 0x7a, 0x50, 0x0a, 0xd6, 0x9d, 0x28,
-	0xf2, 0x61, 0xd7, 0xe9, 0x8d, 0x0e, 0x7d, 0x83, 0xe9, 0x15, 0x1c, 0x8b, 0x55, 0x2a, 0x33, 0xf3,
-	0xdb, 0x77, 0x32, 0x11, 0xc3, 0x9e, 0x43, 0x46, 0x96, 0xff, 0x80, 0x7d, 0x65, 0xfd, 0xf9, 0x71,
-	0x41, 0xdc, 0x6f, 0x04, 0x06, 0x6d, 0xf8, 0xeb, 0x38, 0xc6, 0xa5, 0xe0, 0xa5, 0xbf, 0xc8, 0x73,
-	0xfa, 0x0c, 0x4e, 0xdb, 0x4c, 0x33, 0x56, 0x91, 0xc6, 0x7f, 0xdf, 0xef, 0xef, 0x84, 0x2d, 0xc5,
-	0x4f, 0xe0, 0x84, 0x55, 0xeb, 0xad, 0xb4, 0x6b, 0xa4, 0xc7, 0x6c, 0xf7, 0x2a, 0x05, 0x4b, 0xb1,
-	0x3a, 0xd5, 0xbe, 0x6f, 0xb0, 0xfb, 0x05, 0x2e, 0x3f, 0xb0, 0x3c, 0x99, 0xaa, 0x5c, 0x33, 0xa5,
-	0x25, 0xd3, 0xa2, 0x8e, 0x32, 0x41, 0xa5, 0x33, 0x16, 0xea, 0x09, 0x72, 0x31, 0xe5, 0xf4, 0x29,
-	0xf4, 0xc3, 0x9a, 0x79, 0x10, 0xe8, 0xa4, 0xe1, 0x1b, 0x9b, 0x01, 0x3c, 0x0e, 0x91, 0x8b, 0x99,
-	0xe4, 0x26, 0x87, 0xe5, 0xef, 0x85, 0xe6, 0x86, 0x7b, 0x03, 0xe7, 0xd3, 0x20, 0x9c, 0x60, 0x92,
-	0x62, 0xce, 0x02, 0x19, 0x4b, 0x5d, 0xbc, 0x5d, 0x36, 0x3e, 0xff, 0xe1, 0xf0, 0xfa, 0xf6, 0xe7,
-	0xda, 0x26, 0xf7, 0x6b, 0x9b, 0xfc, 0x5e, 0xdb, 0xe4, 0xfb, 0xc6, 0xee, 0xdc, 0x6f, 0xec, 0xce,
-	0xaf, 0x8d, 0xdd, 0xf9, 0xf8, 0x32, 0x92, 0x7a, 0xbe, 0x08, 0xc6, 0x21, 0x26, 0x5e, 0x53, 0xb2,
-	0xe7, 0xdb, 0x0a, 0x7a, 0x6d, 0x05, 0xbd, 0x55, 0x3b, 0xf7, 0x74, 0x91, 0x8a, 0x3c, 0xd8, 0x33,
-	0xdd, 0x7b, 0xf1, 0x37, 0x00, 0x00, 0xff, 0xff, 0xb7, 0x97, 0xbd, 0x09, 0xd4, 0x02, 0x00, 0x00,
+	// 656 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0xc7, 0xeb, 0x24, 0xb7, 0xbd, 0x3d, 0x4d, 0xda, 0x74, 0x6e, 0xef, 0x6d, 0xd4, 0x4a, 0x69,
+	0xae, 0x5b, 0x4a, 0x11, 0x22, 0x59, 0xb0, 0x2a, 0xbb, 0x10, 0xa1, 0x26, 0xaa, 0xd8, 0xb8, 0x05,
+	0x24, 0x90, 0xb0, 0x26, 0x9e, 0xc1, 0x1e, 0x6a, 0xcf, 0x58, 0x33, 0x93, 0xb4, 0x7e, 0x07, 0x16,
+	0x3c, 0x02, 0x8f, 0xc0, 0x63, 0xb0, 0xec, 0x92, 0x25, 0x6a, 0x37, 0x3c, 0x06, 0xf2, 0xd8, 0x8e,
+	0x93, 0x22, 0x16, 0xb0, 0x1b, 0xff, 0xcf, 0xd7, 0xfc, 0x8e, 0xcf, 0x19, 0xd8, 0xbe, 0x14, 0x32,
+	0x0a, 0x44, 0x48, 0x7b, 0xfe, 0x04, 0x4b, 0xc2, 0x30, 0xef, 0xc6, 0x52, 0x68, 0x81, 0x0e, 0x0b,
+	0x83, 0xfb, 0x4e, 0x4c, 0x38, 0xc1, 0x9a, 0x09, 0xde, 0x4d, 0x35, 0x2f, 0xc0, 0x8c, 0x77, 0x0b,
+	0xeb, 0xce, 0x96, 0x2f, 0x7c, 0x61, 0x42, 0x7a, 0xe9, 0x29, 0x8b, 0xb6, 0xf7, 0x60, 0xed, 0x24,
+	0xcf, 0x77, 0x4a, 0x13, 0xd4, 0x84, 0xea, 0x05, 0x4d, 0x5a, 0x56, 0xc7, 0x3a, 0xaa, 0x3b, 0xe9,
+	0xd1, 0x7e, 0x03, 0x9b, 0x85, 0xc3, 0x4b, 0x1c, 0x32, 0x82, 0xb5, 0x90, 0xa8, 0x03, 0x6b, 0x7e,
+	0x19, 0x95, 0xbb, 0xcf, 0x4b, 0xe8, 0x00, 0x1a, 0xd3, 0xc2, 0xbd, 0x4f, 0x88, 0x6c, 0x55, 0x8c,
+	0xcf, 0xa2, 0x68, 0xd3, 0xb2, 0xfa, 0x19, 0xd5, 0x68, 0x0b, 0xfe, 0x62, 0x9c, 0xd0, 0x2b, 0x93,
+	0xb0, 0xe1, 0x64, 0x1f, 0x08, 0x41, 0xed, 0x82, 0x26, 0xaa, 0x55, 0xe9, 0x54, 0x8f, 0xea, 0x8e,
+	0x39, 0xa3, 0x43, 0x58, 0xa7, 0x57, 0x31, 0x93, 0x86, 0xf6, 0x9c, 0x45, 0xb4, 0x55, 0xed, 0x58,
+	0x47, 0x35, 0xe7, 0x8e, 0xfa, 0xa4, 0xf6, 0xfd, 0xd3, 0x9e, 0x65, 0x9f, 0xc2, 0xee, 0x5c, 0x99,
+	0xbe, 0xa7, 0xd9, 0xd4, 0xb8, 0x0c, 0x29, 0xf3, 0x83, 0x5f, 0x95, 0xfd, 0x0f, 0x96, 0x03, 0x63,
+	0x37, 0x57, 0xaf, 0x3a, 0xf9, 0x97, 0xfd, 0xd9, 0x82, 0xed, 0x59, 0x27, 0xfa, 0x61, 0x28, 0x2e,
+	0x29, 0x49, 0x61, 0xa8, 0x52, 0xe8, 0x21, 0x6c, 0xce, 0x00, 0x5d, 0x9c, 0x89, 0x26, 0xeb, 0xaa,
+	0xd3, 0x5c, 0x20, 0x4f, 0x9d, 0xef, 0xc3, 0x06, 0xce, 0xc2, 0x67, 0xae, 0x15, 0xe3, 0xba, 0x8e,
+	0x17, 0xb3, 0x22, 0xa8, 0x71, 0x9c, 0x23, 0xae, 0x3a, 0xe6, 0x9c, 0x56, 0x2a, 0x51, 0xdd, 0xfc,
+	0xa2, 0x35, 0xd3, 0x83, 0x66, 0x69, 0xc8, 0x00, 0xed, 0x1e, 0xfc, 0x73, 0x22, 0xa6, 0x54, 0x72,
+	0xcc, 0x3d, 0x7a, 0x36, 0x19, 0x47, 0x4c, 0x6b, 0x2a, 0x51, 0x0b, 0x56, 0x16, 0xef, 0x58, 0x7c,
+	0xda, 0xef, 0xe1, 0xe0, 0x15, 0x56, 0xd1, 0x88, 0x2b, 0x8d, 0xb9, 0x66, 0x58, 0xd3, 0x1c, 0x74,
+	0x20, 0xb8, 0x96, 0xd8, 0xd3, 0x03, 0x41, 0xe8, 0x88, 0xa0, 0x07, 0xd0, 0xf4, 0x72, 0xe5, 0x0e,
+	0xee, 0x46, 0xa1, 0x17, 0x10, 0xdb, 0xb0, 0xe2, 0x09, 0x42, 0x5d, 0x46, 0x0c, 0x65, 0xcd, 0x59,
+	0xf6, 0x4c, 0x0e, 0xfb, 0x2d, 0xfc, 0x3b, 0xf2, 0xf0, 0x50, 0x28, 0x6d, 0x6a, 0x84, 0x4c, 0xe9,
+	0x67, 0x5c, 0xcb, 0x04, 0xed, 0x43, 0xc3, 0x13, 0x9c, 0x53, 0xcf, 0x20, 0x32, 0x92, 0x67, 0xae,
+	0x97, 0xe2, 0x88, 0xa0, 0x0e, 0xd4, 0x23, 0xe5, 0xbb, 0x3a, 0x89, 0xa9, 0x3b, 0x91, 0x61, 0xde,
+	0x41, 0x88, 0x94, 0x7f, 0x9e, 0xc4, 0xf4, 0x85, 0x0c, 0xed, 0x13, 0xd8, 0x19, 0x8d, 0xbd, 0x81,
+	0x88, 0x62, 0xa1, 0xf0, 0x98, 0x85, 0x4c, 0x27, 0xcf, 0x2f, 0x0b, 0x8e, 0xdf, 0x20, 0xb0, 0x87,
+	0xd0, 0xec, 0xc7, 0xb1, 0x14, 0xd3, 0xb4, 0x0d, 0x84, 0x0e, 0xb1, 0x0a, 0xe6, 0xa9, 0xac, 0x79,
+	0x2a, 0xb4, 0x0b, 0xab, 0xc6, 0x10, 0x60, 0x15, 0xe4, 0xb3, 0xff, 0xb7, 0x97, 0x47, 0xd9, 0xc7,
+	0x50, 0x1f, 0x08, 0x49, 0xff, 0xe4, 0x12, 0x1f, 0xac, 0x72, 0x96, 0x67, 0x53, 0x78, 0x2e, 0x31,
+	0x57, 0x2c, 0xed, 0x08, 0xba, 0x07, 0xeb, 0x8b, 0x13, 0x98, 0x2f, 0xe7, 0xe2, 0xe2, 0xa1, 0xff,
+	0xa1, 0x5e, 0x6c, 0xab, 0x9b, 0x2e, 0x7c, 0xe5, 0xe7, 0x0d, 0xde, 0x87, 0xc6, 0x84, 0x8f, 0x05,
+	0x27, 0xc5, 0x74, 0x55, 0xcd, 0x1a, 0xd4, 0x33, 0x31, 0x9b, 0xac, 0xa7, 0x67, 0x5f, 0x6e, 0xda,
+	0xd6, 0xf5, 0x4d, 0xdb, 0xfa, 0x76, 0xd3, 0xb6, 0x3e, 0xde, 0xb6, 0x97, 0xae, 0x6f, 0xdb, 0x4b,
+	0x5f, 0x6f, 0xdb, 0x4b, 0xaf, 0x8f, 0x7d, 0xa6, 0x83, 0xc9, 0xb8, 0xeb, 0x89, 0xa8, 0x57, 0xbc,
+	0x41, 0x8f, 0xca, 0x17, 0xaa, 0x37, 0x7b, 0xa1, 0x7a, 0x57, 0x33, 0x7b, 0x2f, 0xfd, 0x8d, 0x6a,
+	0xbc, 0x6c, 0x9e, 0xa6, 0xc7, 0x3f, 0x06, 0x00, 0x5a, 0x84, 0xad, 0x9e, 0xf3, 0x04, 0x00, 0x00,
 }
 
 func (this *GuardianSet) Equal(that interface{}) bool {
@@ -528,6 +876,39 @@ func (m *GuardianSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GuardianSetActivationHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GuardianSetActivationHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GuardianSetActivationHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Index != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ValidatorAllowedAddress) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -548,6 +929,11 @@ func (m *ValidatorAllowedAddress) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Name) > 0 {
 		i -= len(m.Name)
 		copy(dAtA[i:], m.Name)
@@ -572,6 +958,36 @@ func (m *ValidatorAllowedAddress) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *GovernanceSubmitter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GovernanceSubmitter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovernanceSubmitter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *WasmInstantiateAllowedContractCodeId) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -607,7 +1023,7 @@ func (m *WasmInstantiateAllowedContractCodeId) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *IbcComposabilityMwContract) Marshal() (dAtA []byte, err error) {
+func (m *IcaHostAllowlistEntry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -617,18 +1033,120 @@ func (m *IbcComposabilityMwContract) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *IbcComposabilityMwContract) MarshalTo(dAtA []byte) (int, error) {
+func (m *IcaHostAllowlistEntry) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IbcComposabilityMwContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *IcaHostAllowlistEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IbcComposabilityMwContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IbcComposabilityMwContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IbcComposabilityMwContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApprovedCodeHash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApprovedCodeHash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApprovedCodeHash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CodeId != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.CodeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CoreContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CoreContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CoreContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
 		copy(dAtA[i:], m.ContractAddress)
 		i = encodeVarintGuardian(dAtA, i, uint64(len(m.ContractAddress)))
 		i--
@@ -637,6 +1155,48 @@ func (m *IbcComposabilityMwContract) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *GuardianValidatorTransition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GuardianValidatorTransition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GuardianValidatorTransition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnbondHeight != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.UnbondHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.GuardianKey) > 0 {
+		i -= len(m.GuardianKey)
+		copy(dAtA[i:], m.GuardianKey)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.GuardianKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGuardian(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGuardian(v)
 	base := offset
@@ -699,6 +1259,21 @@ func (m *GuardianSet) Size() (n int) {
 	return n
 }
 
+func (m *GuardianSetActivationHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovGuardian(uint64(m.Index))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGuardian(uint64(m.Height))
+	}
+	return n
+}
+
 func (m *ValidatorAllowedAddress) Size() (n int) {
 	if m == nil {
 		return 0
@@ -717,6 +1292,22 @@ func (m *ValidatorAllowedAddress) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGuardian(uint64(l))
 	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovGuardian(uint64(m.ExpirationHeight))
+	}
+	return n
+}
+
+func (m *GovernanceSubmitter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
 	return n
 }
 
@@ -736,6 +1327,23 @@ func (m *WasmInstantiateAllowedContractCodeId) Size() (n int) {
 	return n
 }
 
+func (m *IcaHostAllowlistEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	return n
+}
+
 func (m *IbcComposabilityMwContract) Size() (n int) {
 	if m == nil {
 		return 0
@@ -749,6 +1357,55 @@ func (m *IbcComposabilityMwContract) Size() (n int) {
 	return n
 }
 
+func (m *ApprovedCodeHash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeId != 0 {
+		n += 1 + sovGuardian(uint64(m.CodeId))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	return n
+}
+
+func (m *CoreContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	return n
+}
+
+func (m *GuardianValidatorTransition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.GuardianKey)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	if m.UnbondHeight != 0 {
+		n += 1 + sovGuardian(uint64(m.UnbondHeight))
+	}
+	return n
+}
+
 func sovGuardian(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1077,7 +1734,7 @@ func (m *GuardianSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorAllowedAddress) Unmarshal(dAtA []byte) error {
+func (m *GuardianSetActivationHeight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1100,17 +1757,17 @@ func (m *ValidatorAllowedAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorAllowedAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: GuardianSetActivationHeight: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorAllowedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GuardianSetActivationHeight: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var stringLen uint64
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGuardian
@@ -1120,29 +1777,16 @@ func (m *ValidatorAllowedAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Index |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGuardian
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGuardian
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGuardian
@@ -1152,32 +1796,133 @@ func (m *ValidatorAllowedAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGuardian
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGuardian
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGuardian
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorAllowedAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorAllowedAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorAllowedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
 				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
@@ -1202,6 +1947,107 @@ func (m *ValidatorAllowedAddress) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GovernanceSubmitter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernanceSubmitter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernanceSubmitter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGuardian(dAtA[iNdEx:])
@@ -1324,7 +2170,7 @@ func (m *WasmInstantiateAllowedContractCodeId) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IbcComposabilityMwContract) Unmarshal(dAtA []byte) error {
+func (m *IcaHostAllowlistEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1347,15 +2193,15 @@ func (m *IbcComposabilityMwContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IbcComposabilityMwContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: IcaHostAllowlistEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IbcComposabilityMwContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IcaHostAllowlistEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1383,7 +2229,39 @@ func (m *IbcComposabilityMwContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1406,6 +2284,410 @@ func (m *IbcComposabilityMwContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *IbcComposabilityMwContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IbcComposabilityMwContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IbcComposabilityMwContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApprovedCodeHash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApprovedCodeHash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApprovedCodeHash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+			}
+			m.CodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeHash == nil {
+				m.CodeHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CoreContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoreContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoreContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GuardianValidatorTransition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GuardianValidatorTransition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GuardianValidatorTransition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = append(m.ValidatorAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddr == nil {
+				m.ValidatorAddr = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GuardianKey = append(m.GuardianKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.GuardianKey == nil {
+				m.GuardianKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondHeight", wireType)
+			}
+			m.UnbondHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGuardian(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
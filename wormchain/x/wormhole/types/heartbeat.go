@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+const (
+	// GuardianHeartbeatTTL is how long a heartbeat is considered live after it
+	// was submitted. Guardians are expected to submit heartbeats well within it.
+	GuardianHeartbeatTTL = time.Hour
+
+	MaxHeartbeatNodeVersionLength = 64
+	MaxHeartbeatHeights           = 64
+	MaxHeartbeatFeatures          = 32
+	MaxHeartbeatFeatureLength     = 64
+)
+
+// ValidateHeartbeatStatus checks the status a guardian reports in a heartbeat.
+func ValidateHeartbeatStatus(nodeVersion string, heights []ChainHeight, features []string) error {
+	if len(nodeVersion) > MaxHeartbeatNodeVersionLength {
+		return fmt.Errorf("node version is longer than %d bytes", MaxHeartbeatNodeVersionLength)
+	}
+
+	if len(heights) > MaxHeartbeatHeights {
+		return fmt.Errorf("more than %d chain heights", MaxHeartbeatHeights)
+	}
+	chains := make(map[uint32]bool)
+	for _, h := range heights {
+		if _, err := vaa.ChainIDFromNumber(h.ChainId); err != nil {
+			return fmt.Errorf("invalid chain id %d", h.ChainId)
+		}
+		if chains[h.ChainId] {
+			return fmt.Errorf("duplicate height for chain %d", h.ChainId)
+		}
+		chains[h.ChainId] = true
+	}
+
+	if len(features) > MaxHeartbeatFeatures {
+		return fmt.Errorf("more than %d features", MaxHeartbeatFeatures)
+	}
+	for _, f := range features {
+		if len(f) == 0 || len(f) > MaxHeartbeatFeatureLength {
+			return fmt.Errorf("feature must be between 1 and %d bytes", MaxHeartbeatFeatureLength)
+		}
+	}
+
+	return nil
+}
+
+// Expired returns whether the heartbeat is older than GuardianHeartbeatTTL at
+// the given time.
+func (h GuardianHeartbeat) Expired(now time.Time) bool {
+	return time.Unix(h.Expiry(), 0).Before(now)
+}
+
+// Expiry returns the unix time in seconds at which the heartbeat expires.
+func (h GuardianHeartbeat) Expiry() int64 {
+	return h.Timestamp + int64(GuardianHeartbeatTTL/time.Second)
+}
@@ -0,0 +1,813 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: wormhole/heartbeat.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ChainHeight is the latest block height a guardian observed on a chain.
+type ChainHeight struct {
+	ChainId uint32 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Height  uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ChainHeight) Reset()         { *m = ChainHeight{} }
+func (m *ChainHeight) String() string { return proto.CompactTextString(m) }
+func (*ChainHeight) ProtoMessage()    {}
+func (*ChainHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d18e18db32075a52, []int{0}
+}
+func (m *ChainHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainHeight.Merge(m, src)
+}
+func (m *ChainHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainHeight proto.InternalMessageInfo
+
+func (m *ChainHeight) GetChainId() uint32 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *ChainHeight) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// GuardianHeartbeat is the latest heartbeat submitted by the validator of a
+// guardian. It expires GuardianHeartbeatTTL after it was submitted.
+type GuardianHeartbeat struct {
+	GuardianKey []byte `protobuf:"bytes,1,opt,name=guardian_key,json=guardianKey,proto3" json:"guardian_key,omitempty"`
+	// account of the guardian validator that submitted the heartbeat
+	ValidatorAddress string        `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	NodeVersion      string        `protobuf:"bytes,3,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	Heights          []ChainHeight `protobuf:"bytes,4,rep,name=heights,proto3" json:"heights"`
+	Features         []string      `protobuf:"bytes,5,rep,name=features,proto3" json:"features,omitempty"`
+	// block height and time (unix seconds) the heartbeat was submitted at
+	Height    int64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Timestamp int64 `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *GuardianHeartbeat) Reset()         { *m = GuardianHeartbeat{} }
+func (m *GuardianHeartbeat) String() string { return proto.CompactTextString(m) }
+func (*GuardianHeartbeat) ProtoMessage()    {}
+func (*GuardianHeartbeat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d18e18db32075a52, []int{1}
+}
+func (m *GuardianHeartbeat) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GuardianHeartbeat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GuardianHeartbeat.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GuardianHeartbeat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GuardianHeartbeat.Merge(m, src)
+}
+func (m *GuardianHeartbeat) XXX_Size() int {
+	return m.Size()
+}
+func (m *GuardianHeartbeat) XXX_DiscardUnknown() {
+	xxx_messageInfo_GuardianHeartbeat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GuardianHeartbeat proto.InternalMessageInfo
+
+func (m *GuardianHeartbeat) GetGuardianKey() []byte {
+	if m != nil {
+		return m.GuardianKey
+	}
+	return nil
+}
+
+func (m *GuardianHeartbeat) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *GuardianHeartbeat) GetNodeVersion() string {
+	if m != nil {
+		return m.NodeVersion
+	}
+	return ""
+}
+
+func (m *GuardianHeartbeat) GetHeights() []ChainHeight {
+	if m != nil {
+		return m.Heights
+	}
+	return nil
+}
+
+func (m *GuardianHeartbeat) GetFeatures() []string {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
+func (m *GuardianHeartbeat) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GuardianHeartbeat) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ChainHeight)(nil), "wormhole_foundation.wormchain.wormhole.ChainHeight")
+	proto.RegisterType((*GuardianHeartbeat)(nil), "wormhole_foundation.wormchain.wormhole.GuardianHeartbeat")
+}
+
+func init() { proto.RegisterFile("wormhole/heartbeat.proto", fileDescriptor_d18e18db32075a52) }
+
+var fileDescriptor_d18e18db32075a52 = []byte{
+	// 360 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xcd, 0x4e, 0xc2, 0x40,
+	0x14, 0x85, 0x5b, 0x8a, 0xfc, 0x4c, 0x31, 0x91, 0x89, 0x31, 0x95, 0x98, 0x5a, 0x59, 0x98, 0x26,
+	0xc6, 0x36, 0x91, 0x95, 0x3b, 0xc5, 0x85, 0x18, 0x77, 0x25, 0x71, 0xe1, 0xa6, 0x19, 0x98, 0xa1,
+	0x9d, 0x48, 0x3b, 0x64, 0x3a, 0x45, 0x79, 0x0b, 0x5f, 0xc4, 0xf7, 0x60, 0xc9, 0xd2, 0x95, 0x31,
+	0xf0, 0x22, 0xa6, 0x53, 0x5a, 0x58, 0xba, 0x9b, 0xfb, 0x9d, 0x7b, 0x4f, 0x4e, 0xce, 0x00, 0xe3,
+	0x9d, 0xf1, 0x28, 0x64, 0x53, 0xe2, 0x86, 0x04, 0x71, 0x31, 0x22, 0x48, 0x38, 0x33, 0xce, 0x04,
+	0x83, 0x97, 0x85, 0xe2, 0x4f, 0x58, 0x1a, 0x63, 0x24, 0x28, 0x8b, 0x9d, 0x8c, 0x8d, 0x43, 0x44,
+	0x63, 0xa7, 0x50, 0x3b, 0xc7, 0x01, 0x0b, 0x98, 0x3c, 0x71, 0xb3, 0x57, 0x7e, 0xdd, 0xbd, 0x03,
+	0xfa, 0x43, 0xb6, 0x37, 0x20, 0x34, 0x08, 0x05, 0x3c, 0x05, 0x0d, 0x79, 0xe6, 0x53, 0x6c, 0xa8,
+	0x96, 0x6a, 0x1f, 0x7a, 0x75, 0x39, 0x3f, 0x61, 0x78, 0x02, 0x6a, 0xa1, 0x5c, 0x32, 0x2a, 0x96,
+	0x6a, 0x57, 0xbd, 0xed, 0xd4, 0xfd, 0xaa, 0x80, 0xf6, 0x63, 0x8a, 0x38, 0xa6, 0x28, 0x1e, 0x14,
+	0xd9, 0xe0, 0x05, 0x68, 0x05, 0x5b, 0xe8, 0xbf, 0x91, 0x85, 0x34, 0x6b, 0x79, 0x7a, 0xc1, 0x9e,
+	0xc9, 0x02, 0x5e, 0x81, 0xf6, 0x1c, 0x4d, 0x29, 0x46, 0x82, 0x71, 0x1f, 0x61, 0xcc, 0x49, 0x92,
+	0x48, 0xef, 0xa6, 0x77, 0x54, 0x0a, 0xf7, 0x39, 0xcf, 0xfc, 0x62, 0x86, 0x89, 0x3f, 0x27, 0x3c,
+	0xa1, 0x2c, 0x36, 0x34, 0xb9, 0xa7, 0x67, 0xec, 0x25, 0x47, 0x70, 0x08, 0xea, 0x79, 0xa4, 0xc4,
+	0xa8, 0x5a, 0x9a, 0xad, 0xdf, 0xf4, 0x9c, 0xff, 0x55, 0xe3, 0xec, 0x35, 0xd0, 0xaf, 0x2e, 0x7f,
+	0xce, 0x15, 0xaf, 0x70, 0x82, 0x1d, 0xd0, 0x98, 0x10, 0x24, 0x52, 0x4e, 0x12, 0xe3, 0xc0, 0xd2,
+	0xec, 0xa6, 0x57, 0xce, 0x7b, 0x8d, 0xd4, 0x2c, 0xd5, 0xd6, 0x8a, 0x46, 0xe0, 0x19, 0x68, 0x0a,
+	0x1a, 0x91, 0x44, 0xa0, 0x68, 0x66, 0xd4, 0xa5, 0xb4, 0x03, 0xfd, 0xe1, 0x72, 0x6d, 0xaa, 0xab,
+	0xb5, 0xa9, 0xfe, 0xae, 0x4d, 0xf5, 0x73, 0x63, 0x2a, 0xab, 0x8d, 0xa9, 0x7c, 0x6f, 0x4c, 0xe5,
+	0xf5, 0x36, 0xa0, 0x22, 0x4c, 0x47, 0xce, 0x98, 0x45, 0x6e, 0x91, 0xed, 0x7a, 0x97, 0xdc, 0x2d,
+	0x93, 0xbb, 0x1f, 0xa5, 0xee, 0x8a, 0xc5, 0x8c, 0x24, 0xa3, 0x9a, 0xfc, 0xcd, 0xde, 0xdf, 0x00,
+	0x55, 0x45, 0xbc, 0x0b, 0x27, 0x02, 0x00, 0x00,
+}
+
+func (m *ChainHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintHeartbeat(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintHeartbeat(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GuardianHeartbeat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GuardianHeartbeat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GuardianHeartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintHeartbeat(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Height != 0 {
+		i = encodeVarintHeartbeat(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Features) > 0 {
+		for iNdEx := len(m.Features) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Features[iNdEx])
+			copy(dAtA[i:], m.Features[iNdEx])
+			i = encodeVarintHeartbeat(dAtA, i, uint64(len(m.Features[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Heights) > 0 {
+		for iNdEx := len(m.Heights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Heights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHeartbeat(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.NodeVersion) > 0 {
+		i -= len(m.NodeVersion)
+		copy(dAtA[i:], m.NodeVersion)
+		i = encodeVarintHeartbeat(dAtA, i, uint64(len(m.NodeVersion)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintHeartbeat(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GuardianKey) > 0 {
+		i -= len(m.GuardianKey)
+		copy(dAtA[i:], m.GuardianKey)
+		i = encodeVarintHeartbeat(dAtA, i, uint64(len(m.GuardianKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHeartbeat(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHeartbeat(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ChainHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovHeartbeat(uint64(m.ChainId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovHeartbeat(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *GuardianHeartbeat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GuardianKey)
+	if l > 0 {
+		n += 1 + l + sovHeartbeat(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovHeartbeat(uint64(l))
+	}
+	l = len(m.NodeVersion)
+	if l > 0 {
+		n += 1 + l + sovHeartbeat(uint64(l))
+	}
+	if len(m.Heights) > 0 {
+		for _, e := range m.Heights {
+			l = e.Size()
+			n += 1 + l + sovHeartbeat(uint64(l))
+		}
+	}
+	if len(m.Features) > 0 {
+		for _, s := range m.Features {
+			l = len(s)
+			n += 1 + l + sovHeartbeat(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovHeartbeat(uint64(m.Height))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovHeartbeat(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func sovHeartbeat(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHeartbeat(x uint64) (n int) {
+	return sovHeartbeat(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ChainHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHeartbeat
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHeartbeat(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GuardianHeartbeat) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHeartbeat
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GuardianHeartbeat: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GuardianHeartbeat: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GuardianKey = append(m.GuardianKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.GuardianKey == nil {
+				m.GuardianKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Heights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Heights = append(m.Heights, ChainHeight{})
+			if err := m.Heights[len(m.Heights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Features = append(m.Features, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHeartbeat(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHeartbeat(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHeartbeat
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHeartbeat
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHeartbeat
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHeartbeat
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHeartbeat        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHeartbeat          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHeartbeat = fmt.Errorf("proto: unexpected end of group")
+)
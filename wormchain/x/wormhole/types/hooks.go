@@ -0,0 +1,42 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PostedMessage is a wormhole message published on wormchain.
+type PostedMessage struct {
+	// bech32 address of the core contract that published the message, empty
+	// if it was posted by the wormhole module
+	Contract string
+	// 32 byte emitter address
+	Emitter  []byte
+	Sequence uint64
+	Nonce    uint32
+	// seconds since the unix epoch of the block the message was published in
+	Time    uint64
+	Payload []byte
+}
+
+// MessagePostedHook is implemented by modules that need to be notified of the
+// wormhole messages published on wormchain, by the core contract or by the
+// wormhole module itself, without scraping events.
+type MessagePostedHook interface {
+	// AfterMessagePosted is called after a message was published. An error
+	// fails the publication of the message.
+	AfterMessagePosted(ctx sdk.Context, msg PostedMessage) error
+}
+
+var _ MessagePostedHook = MessagePostedHooks{}
+
+// MessagePostedHooks notifies several hooks in order.
+type MessagePostedHooks []MessagePostedHook
+
+func (h MessagePostedHooks) AfterMessagePosted(ctx sdk.Context, msg PostedMessage) error {
+	for _, hook := range h {
+		if err := hook.AfterMessagePosted(ctx, msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
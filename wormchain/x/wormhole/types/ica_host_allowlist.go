@@ -0,0 +1,35 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+)
+
+// IcaHostAllowlistEntryKey returns the store key of an interchain accounts
+// host allowlist entry. Connection IDs can not contain a slash and message
+// type URLs start with one, so the keys of a connection share the prefix
+// IcaHostAllowlistConnectionKey(connectionID).
+func IcaHostAllowlistEntryKey(connectionID string, msgTypeURL string) []byte {
+	return []byte(connectionID + msgTypeURL)
+}
+
+// IcaHostAllowlistConnectionKey returns the key prefix of the interchain
+// accounts host allowlist entries of a connection.
+func IcaHostAllowlistConnectionKey(connectionID string) []byte {
+	return []byte(connectionID + "/")
+}
+
+// Validate checks that the entry names a valid IBC connection and message
+// type URL. Message types of other modules are not known to the wormhole
+// module, so the type URL is not resolved.
+func (e IcaHostAllowlistEntry) Validate() error {
+	if err := host.ConnectionIdentifierValidator(e.ConnectionId); err != nil {
+		return fmt.Errorf("invalid connection id: %w", err)
+	}
+	if len(e.MsgTypeUrl) < 2 || !strings.HasPrefix(e.MsgTypeUrl, "/") || strings.ContainsAny(e.MsgTypeUrl, " \t\n") {
+		return fmt.Errorf("invalid message type url %q", e.MsgTypeUrl)
+	}
+	return nil
+}
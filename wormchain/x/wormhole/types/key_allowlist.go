@@ -0,0 +1,16 @@
+package types
+
+import "encoding/binary"
+
+// ValidatorAllowlistExpirationIndexKey returns the key of an allowlist entry
+// in the expiration index. Heights are big endian so that iterating the index
+// returns the entries in the order they expire.
+func ValidatorAllowlistExpirationIndexKey(
+	expirationHeight uint64,
+	allowedAddress string,
+) []byte {
+	key := binary.BigEndian.AppendUint64(nil, expirationHeight)
+	key = append(key, allowedAddress...)
+
+	return key
+}
@@ -0,0 +1,16 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// ApprovedCodeHashKeyPrefix is the prefix to retrieve all ApprovedCodeHash
+	ApprovedCodeHashKeyPrefix = "ApprovedCodeHash/value/"
+)
+
+// ApprovedCodeHashKey returns the store key to retrieve an ApprovedCodeHash from
+// its code id. The code id is big endian so the approvals iterate in order.
+func ApprovedCodeHashKey(
+	codeID uint64,
+) []byte {
+	return binary.BigEndian.AppendUint64(nil, codeID)
+}
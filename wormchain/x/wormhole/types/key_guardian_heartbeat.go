@@ -0,0 +1,20 @@
+package types
+
+import "encoding/binary"
+
+// GuardianHeartbeatExpiryKeyPrefix is the prefix of the index of guardian
+// heartbeats by the time they expire, used to prune them
+const GuardianHeartbeatExpiryKeyPrefix = "GuardianHeartbeat-expiry-"
+
+// GuardianHeartbeatExpiryKey returns the key of a guardian heartbeat in the
+// expiry index. Times are big endian so that iterating the index returns the
+// heartbeats in the order they expire.
+func GuardianHeartbeatExpiryKey(
+	expiry int64,
+	guardianKey []byte,
+) []byte {
+	key := binary.BigEndian.AppendUint64(nil, uint64(expiry))
+	key = append(key, guardianKey...)
+
+	return key
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// guardianSetIndexLen is the length of the big endian guardian set index
+const guardianSetIndexLen = 4
+
+// GuardianSetIndexKey returns the store key to retrieve a GuardianSet from its
+// index. The big endian index is length prefixed, so the key can be extended
+// without colliding with the keys of other guardian sets.
+func GuardianSetIndexKey(index uint32) []byte {
+	key := []byte{guardianSetIndexLen}
+	key = binary.BigEndian.AppendUint32(key, index)
+
+	return key
+}
+
+// GuardianSetIndexFromKey returns the index of a GuardianSet from its store key
+func GuardianSetIndexFromKey(key []byte) (uint32, error) {
+	if len(key) != 1+guardianSetIndexLen || key[0] != guardianSetIndexLen {
+		return 0, fmt.Errorf("invalid guardian set key %x", key)
+	}
+
+	return binary.BigEndian.Uint32(key[1:]), nil
+}
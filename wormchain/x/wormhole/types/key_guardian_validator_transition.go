@@ -0,0 +1,20 @@
+package types
+
+import "encoding/binary"
+
+// GuardianValidatorTransitionHeightKeyPrefix is the prefix of the index of
+// guardian validator transitions by their unbond height, used to complete them
+const GuardianValidatorTransitionHeightKeyPrefix = "GuardianValidatorTransition-height-"
+
+// GuardianValidatorTransitionHeightKey returns the key of a guardian validator
+// transition in the unbond height index. Heights are big endian so that
+// iterating the index returns the transitions in the order they complete.
+func GuardianValidatorTransitionHeightKey(
+	unbondHeight int64,
+	validatorAddr []byte,
+) []byte {
+	key := binary.BigEndian.AppendUint64(nil, uint64(unbondHeight))
+	key = append(key, validatorAddr...)
+
+	return key
+}
@@ -0,0 +1,25 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// ObservationTallyHeightKeyPrefix is the prefix of the index of observation
+	// tallies by the height they were started at, used to prune tallies that
+	// did not reach quorum
+	ObservationTallyHeightKeyPrefix = "ObservationTally-height-"
+	// FinalizedObservationKeyPrefix is the prefix to retrieve all
+	// FinalizedObservation
+	FinalizedObservationKeyPrefix = "FinalizedObservation-value-"
+)
+
+// ObservationTallyHeightKey returns the key of an observation tally in its
+// height index.
+func ObservationTallyHeightKey(
+	height int64,
+	digest []byte,
+) []byte {
+	key := binary.BigEndian.AppendUint64(nil, uint64(height))
+	key = append(key, digest...)
+
+	return key
+}
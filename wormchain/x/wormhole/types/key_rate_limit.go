@@ -0,0 +1,31 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// ChainRateLimitKeyPrefix is the prefix to retrieve all ChainRateLimit
+	ChainRateLimitKeyPrefix = "ChainRateLimit/value/"
+	// RateLimitFlowKeyPrefix is the prefix to retrieve all RateLimitFlow
+	RateLimitFlowKeyPrefix = "ChainRateLimit/flow/"
+	// QueuedObservationKeyPrefix is the prefix to retrieve all QueuedObservation
+	QueuedObservationKeyPrefix = "ChainRateLimit/queue/"
+)
+
+// ChainRateLimitKey returns the store key to retrieve a ChainRateLimit. It is
+// also the key prefix of the flows and queued observations of the chain.
+func ChainRateLimitKey(chainId uint16) []byte {
+	return binary.BigEndian.AppendUint16(nil, chainId)
+}
+
+// RateLimitFlowKey returns the store key to retrieve the RateLimitFlow of a
+// chain at a block height.
+func RateLimitFlowKey(chainId uint16, height int64) []byte {
+	return binary.BigEndian.AppendUint64(ChainRateLimitKey(chainId), uint64(height))
+}
+
+// QueuedObservationKey returns the store key to retrieve a QueuedObservation.
+// Observations are keyed by the height they were queued at so that iterating
+// the queue of a chain returns them in order.
+func QueuedObservationKey(emitterChain uint16, queuedHeight int64, digest []byte) []byte {
+	return append(RateLimitFlowKey(emitterChain, queuedHeight), digest...)
+}
@@ -0,0 +1,25 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// RegisteredEmitterKeyPrefix is the prefix to retrieve all RegisteredEmitter
+	RegisteredEmitterKeyPrefix = "RegisteredEmitter/value/"
+)
+
+// RegisteredEmitterKey returns the store key to retrieve a RegisteredEmitter from the index fields
+func RegisteredEmitterKey(
+	module string,
+	chainID uint16,
+) []byte {
+	var key []byte
+
+	key = append(key, []byte(module)...)
+	key = append(key, []byte("/")...)
+	chainBytes := make([]byte, 2)
+	binary.BigEndian.PutUint16(chainBytes, chainID)
+	key = append(key, chainBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -21,3 +21,20 @@ func ReplayProtectionKey(
 
 	return key
 }
+
+const (
+	// ExecutedGovernanceVAAKeyPrefix is the prefix to retrieve all ExecutedGovernanceVAA
+	ExecutedGovernanceVAAKeyPrefix = "ExecutedGovernanceVAA/value/"
+)
+
+// ExecutedGovernanceVAAKey returns the store key to retrieve an ExecutedGovernanceVAA from its digest
+func ExecutedGovernanceVAAKey(
+	digest []byte,
+) []byte {
+	var key []byte
+
+	key = append(key, digest...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
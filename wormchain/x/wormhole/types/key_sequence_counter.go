@@ -2,11 +2,11 @@ package types
 
 import "encoding/binary"
 
-var _ binary.ByteOrder
-
 const (
 	// SequenceCounterKeyPrefix is the prefix to retrieve all SequenceCounter
 	SequenceCounterKeyPrefix = "SequenceCounter/value/"
+	// SequenceReservationKeyPrefix is the prefix to retrieve all SequenceReservation
+	SequenceReservationKeyPrefix = "SequenceReservation/value/"
 )
 
 // SequenceCounterKey returns the store key to retrieve a SequenceCounter from the index fields
@@ -21,3 +21,29 @@ func SequenceCounterKey(
 
 	return key
 }
+
+// SequenceReservationKey returns the store key to retrieve a SequenceReservation
+// from its emitter and first sequence. The sequence is big endian so the
+// reservations of an emitter iterate in order.
+func SequenceReservationKey(
+	emitter string,
+	start uint64,
+) []byte {
+	var key []byte
+
+	key = append(key, SequenceReservationEmitterPrefix(emitter)...)
+	key = binary.BigEndian.AppendUint64(key, start)
+
+	return key
+}
+
+// SequenceReservationEmitterPrefix returns the store prefix of all the
+// SequenceReservation of an emitter.
+func SequenceReservationEmitterPrefix(emitter string) []byte {
+	var key []byte
+
+	key = append(key, []byte(emitter)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
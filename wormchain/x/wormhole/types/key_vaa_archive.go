@@ -0,0 +1,49 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// ArchivedVAAKeyPrefix is the prefix to retrieve all ArchivedVAA
+	ArchivedVAAKeyPrefix = "ArchivedVAA/value/"
+	// ArchivedVAAHeightKeyPrefix is the prefix of the index of archived VAAs
+	// by the height they were verified at, used to prune the archive
+	ArchivedVAAHeightKeyPrefix = "ArchivedVAA/height/"
+)
+
+// ArchivedVAAEmitterKey returns the key prefix of all archived VAAs from an
+// emitter. The emitter address is always 32 bytes.
+func ArchivedVAAEmitterKey(
+	emitterChain uint16,
+	emitterAddress []byte,
+) []byte {
+	key := make([]byte, 2, 2+32+8)
+	binary.BigEndian.PutUint16(key, emitterChain)
+	key = append(key, emitterAddress...)
+
+	return key
+}
+
+// ArchivedVAAKey returns the store key to retrieve an ArchivedVAA from the
+// emitter chain, emitter address and sequence. Sequences are big endian so
+// that iterating an emitter's VAAs returns them in order.
+func ArchivedVAAKey(
+	emitterChain uint16,
+	emitterAddress []byte,
+	sequence uint64,
+) []byte {
+	key := ArchivedVAAEmitterKey(emitterChain, emitterAddress)
+	key = binary.BigEndian.AppendUint64(key, sequence)
+
+	return key
+}
+
+// ArchivedVAAHeightKey returns the key of an archived VAA in the height index.
+func ArchivedVAAHeightKey(
+	height int64,
+	archivedVAAKey []byte,
+) []byte {
+	key := binary.BigEndian.AppendUint64(nil, uint64(height))
+	key = append(key, archivedVAAKey...)
+
+	return key
+}
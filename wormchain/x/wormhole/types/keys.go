@@ -15,6 +15,13 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_wormhole"
+
+	// ForwardFeeCollectorName is the module account the forward fees are paid to
+	ForwardFeeCollectorName = "wormhole_forward_fee_collector"
+
+	// ForwardFeeEscrowName is the module account the forward fees are held in
+	// until the forwarded packets are acknowledged
+	ForwardFeeEscrowName = "wormhole_forward_fee_escrow"
 )
 
 func KeyPrefix(p string) []byte {
@@ -24,18 +31,48 @@ func KeyPrefix(p string) []byte {
 const (
 	GuardianSetKey      = "GuardianSet-value-"
 	GuardianSetCountKey = "GuardianSet-count-"
+	// GuardianSetActivationHeightKey stores the block height each guardian set was added at
+	GuardianSetActivationHeightKey = "GuardianSet-activation-height-"
 )
 
 const (
 	ConfigKey = "Config-value-"
+	ParamsKey = "Params-value-"
 )
 
 const (
 	ConsensusGuardianSetIndexKey = "ConsensusGuardianSetIndex-value-"
+	GuardianSetActivationKey     = "GuardianSetActivation-value-"
+	// BondedGuardianSetIndexKey is the index of the consensus guardian set
+	// whose validators EndBlock last reconciled
+	BondedGuardianSetIndexKey      = "BondedGuardianSetIndex-value-"
+	GuardianValidatorTransitionKey = "GuardianValidatorTransition-value-"
+)
+
+const (
+	ValidatorAllowlistKey = "VAK"
+	// ValidatorAllowlistExpirationKey indexes allowlist entries by expiration height
+	ValidatorAllowlistExpirationKey = "ValidatorAllowlistExpiration-value-"
+	WasmInstantiateAllowlistKey     = "WasmInstiantiateAllowlist"
+	IbcComposabilityMwContractKey   = "IbcComposabilityMwContract"
+	CoreContractKey                 = "CoreContract-value-"
+	GovernanceSubmitterKey          = "GovernanceSubmitter-value-"
+	BridgePausedKey                 = "BridgePaused-value-"
+	MsgShutdownKey                  = "MsgShutdown-value-"
+	IcaHostAllowlistKey             = "IcaHostAllowlist-value-"
+	MaintenanceWindowKey            = "MaintenanceWindow-value-"
 )
 
 const (
-	ValidatorAllowlistKey         = "VAK"
-	WasmInstantiateAllowlistKey   = "WasmInstiantiateAllowlist"
-	IbcComposabilityMwContractKey = "IbcComposabilityMwContract"
+	GuardianSetWeightsKey = "GuardianSetWeights-value-"
+	ObservationTallyKey   = "ObservationTally-value-"
+	GuardianHeartbeatKey  = "GuardianHeartbeat-value-"
+	ForwardVolumeKey      = "ForwardVolume-value-"
+	PendingForwardFeeKey  = "PendingForwardFee-value-"
+	// ForwardFeeReceiveKey holds the port and channel of the packet the
+	// packet forward middleware is receiving while it forwards the transfer
+	ForwardFeeReceiveKey = "ForwardFeeReceive-value-"
+	// ForwardFeeRetryKey holds the pending fee of the packet the packet
+	// forward middleware retries while it handles the timeout
+	ForwardFeeRetryKey = "ForwardFeeRetry-value-"
 )
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsActive returns whether the maintenance window covers the block height.
+// Both the start and end heights are part of the window.
+func (w MaintenanceWindow) IsActive(height int64) bool {
+	return height >= 0 && uint64(height) >= w.StartHeight && uint64(height) <= w.EndHeight
+}
+
+// HasContract returns whether the contract is under maintenance during the
+// window.
+func (w MaintenanceWindow) HasContract(contract string) bool {
+	for _, c := range w.Contracts {
+		if c == contract {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks that the window does not end before it starts and that it
+// lists at least one contract, without duplicates.
+func (w MaintenanceWindow) Validate() error {
+	if w.EndHeight < w.StartHeight {
+		return fmt.Errorf("end height %d is before start height %d", w.EndHeight, w.StartHeight)
+	}
+	if len(w.Contracts) == 0 {
+		return fmt.Errorf("no contracts")
+	}
+	contracts := make(map[string]struct{}, len(w.Contracts))
+	for _, contract := range w.Contracts {
+		if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+			return fmt.Errorf("invalid contract address %s: %w", contract, err)
+		}
+		if _, ok := contracts[contract]; ok {
+			return fmt.Errorf("duplicated contract %s", contract)
+		}
+		contracts[contract] = struct{}{}
+	}
+	return nil
+}
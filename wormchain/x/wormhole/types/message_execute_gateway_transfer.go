@@ -0,0 +1,181 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+var _ sdk.Msg = &MsgExecuteGatewayTransfer{}
+var _ sdk.Msg = &MsgExecuteGatewayTransferWithPayload{}
+
+// GatewayExecuteMsg is the execute message of the gateway (ibc translator) contract, which converts the
+// tokenfactory tokens sent along into their cw20 tokens and transfers them through the token bridge.
+type GatewayExecuteMsg struct {
+	GatewayConvertAndTransfer            *GatewayConvertAndTransfer            `json:"gateway_convert_and_transfer,omitempty"`
+	GatewayConvertAndTransferWithPayload *GatewayConvertAndTransferWithPayload `json:"gateway_convert_and_transfer_with_payload,omitempty"`
+}
+
+type GatewayConvertAndTransfer struct {
+	Recipient []byte `json:"recipient"`
+	Chain     uint16 `json:"chain"`
+	Fee       string `json:"fee"`
+	Nonce     uint32 `json:"nonce"`
+}
+
+type GatewayConvertAndTransferWithPayload struct {
+	Contract []byte `json:"contract"`
+	Chain    uint16 `json:"chain"`
+	Payload  []byte `json:"payload"`
+	Nonce    uint32 `json:"nonce"`
+}
+
+func NewMsgExecuteGatewayTransfer(signer string, amount sdk.Coin, recipientChain uint32, recipient []byte, fee sdk.Int, nonce uint32) *MsgExecuteGatewayTransfer {
+	return &MsgExecuteGatewayTransfer{
+		Signer:         signer,
+		Amount:         amount,
+		RecipientChain: recipientChain,
+		Recipient:      recipient,
+		Fee:            fee,
+		Nonce:          nonce,
+	}
+}
+
+func (msg *MsgExecuteGatewayTransfer) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgExecuteGatewayTransfer) Type() string {
+	return "MsgExecuteGatewayTransfer"
+}
+
+func (msg *MsgExecuteGatewayTransfer) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg *MsgExecuteGatewayTransfer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgExecuteGatewayTransfer) ValidateBasic() error {
+	if err := validateGatewayTransfer(msg.Signer, msg.Amount, msg.RecipientChain, msg.Recipient); err != nil {
+		return err
+	}
+
+	if msg.Fee.IsNil() || msg.Fee.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidGatewayTransfer, "fee must not be negative")
+	}
+	if msg.Fee.GT(msg.Amount.Amount) {
+		return sdkerrors.Wrap(ErrInvalidGatewayTransfer, "fee must not exceed the amount")
+	}
+
+	return nil
+}
+
+// GatewayExecuteMsg returns the execute message of the gateway contract for the transfer.
+func (msg *MsgExecuteGatewayTransfer) GatewayExecuteMsg() ([]byte, error) {
+	return json.Marshal(GatewayExecuteMsg{
+		GatewayConvertAndTransfer: &GatewayConvertAndTransfer{
+			Recipient: msg.Recipient,
+			Chain:     uint16(msg.RecipientChain),
+			Fee:       msg.Fee.String(),
+			Nonce:     msg.Nonce,
+		},
+	})
+}
+
+func NewMsgExecuteGatewayTransferWithPayload(signer string, amount sdk.Coin, recipientChain uint32, contract []byte, payload []byte, nonce uint32) *MsgExecuteGatewayTransferWithPayload {
+	return &MsgExecuteGatewayTransferWithPayload{
+		Signer:         signer,
+		Amount:         amount,
+		RecipientChain: recipientChain,
+		Contract:       contract,
+		Payload:        payload,
+		Nonce:          nonce,
+	}
+}
+
+func (msg *MsgExecuteGatewayTransferWithPayload) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgExecuteGatewayTransferWithPayload) Type() string {
+	return "MsgExecuteGatewayTransferWithPayload"
+}
+
+func (msg *MsgExecuteGatewayTransferWithPayload) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg *MsgExecuteGatewayTransferWithPayload) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgExecuteGatewayTransferWithPayload) ValidateBasic() error {
+	if err := validateGatewayTransfer(msg.Signer, msg.Amount, msg.RecipientChain, msg.Contract); err != nil {
+		return err
+	}
+
+	if len(msg.Payload) == 0 {
+		return sdkerrors.Wrap(ErrInvalidGatewayTransfer, "payload must not be empty")
+	}
+
+	return nil
+}
+
+// GatewayExecuteMsg returns the execute message of the gateway contract for the transfer.
+func (msg *MsgExecuteGatewayTransferWithPayload) GatewayExecuteMsg() ([]byte, error) {
+	return json.Marshal(GatewayExecuteMsg{
+		GatewayConvertAndTransferWithPayload: &GatewayConvertAndTransferWithPayload{
+			Contract: msg.Contract,
+			Chain:    uint16(msg.RecipientChain),
+			Payload:  msg.Payload,
+			Nonce:    msg.Nonce,
+		},
+	})
+}
+
+// validateGatewayTransfer validates the fields shared by both gateway transfer messages.
+func validateGatewayTransfer(signer string, amount sdk.Coin, recipientChain uint32, recipient []byte) error {
+	_, err := sdk.AccAddressFromBech32(signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+
+	if err := amount.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	if !amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount must be positive")
+	}
+
+	chainID, err := vaa.ChainIDFromNumber(recipientChain)
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalidGatewayTransfer, err.Error())
+	}
+	if chainID == vaa.ChainIDUnset || chainID == vaa.ChainIDWormchain {
+		return sdkerrors.Wrapf(ErrInvalidGatewayTransfer, "invalid recipient chain %d", recipientChain)
+	}
+
+	if len(recipient) != 32 {
+		return sdkerrors.Wrapf(ErrInvalidGatewayTransfer, "recipient must be 32 bytes, got %d", len(recipient))
+	}
+	if bytes.Equal(recipient, make([]byte, 32)) {
+		return sdkerrors.Wrap(ErrInvalidGatewayTransfer, "recipient must not be zero")
+	}
+
+	return nil
+}
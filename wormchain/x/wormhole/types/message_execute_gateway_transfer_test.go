@@ -0,0 +1,88 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormchain/testutil/sample"
+)
+
+func TestMsgExecuteGatewayTransfer_ValidateBasic(t *testing.T) {
+	recipient := make([]byte, 32)
+	recipient[31] = 1
+	amount := sdk.NewInt64Coin("factory/wormhole1contract/token", 100)
+
+	tests := []struct {
+		name string
+		msg  *MsgExecuteGatewayTransfer
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  NewMsgExecuteGatewayTransfer("invalid_address", amount, 2, recipient, sdk.ZeroInt(), 0),
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "zero amount",
+			msg:  NewMsgExecuteGatewayTransfer(sample.AccAddress(), sdk.NewInt64Coin("uworm", 0), 2, recipient, sdk.ZeroInt(), 0),
+			err:  sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "unset chain",
+			msg:  NewMsgExecuteGatewayTransfer(sample.AccAddress(), amount, 0, recipient, sdk.ZeroInt(), 0),
+			err:  ErrInvalidGatewayTransfer,
+		}, {
+			name: "wormchain",
+			msg:  NewMsgExecuteGatewayTransfer(sample.AccAddress(), amount, 3104, recipient, sdk.ZeroInt(), 0),
+			err:  ErrInvalidGatewayTransfer,
+		}, {
+			name: "chain out of range",
+			msg:  NewMsgExecuteGatewayTransfer(sample.AccAddress(), amount, 70000, recipient, sdk.ZeroInt(), 0),
+			err:  ErrInvalidGatewayTransfer,
+		}, {
+			name: "short recipient",
+			msg:  NewMsgExecuteGatewayTransfer(sample.AccAddress(), amount, 2, recipient[:20], sdk.ZeroInt(), 0),
+			err:  ErrInvalidGatewayTransfer,
+		}, {
+			name: "zero recipient",
+			msg:  NewMsgExecuteGatewayTransfer(sample.AccAddress(), amount, 2, make([]byte, 32), sdk.ZeroInt(), 0),
+			err:  ErrInvalidGatewayTransfer,
+		}, {
+			name: "negative fee",
+			msg:  NewMsgExecuteGatewayTransfer(sample.AccAddress(), amount, 2, recipient, sdk.NewInt(-1), 0),
+			err:  ErrInvalidGatewayTransfer,
+		}, {
+			name: "fee exceeds amount",
+			msg:  NewMsgExecuteGatewayTransfer(sample.AccAddress(), amount, 2, recipient, sdk.NewInt(101), 0),
+			err:  ErrInvalidGatewayTransfer,
+		}, {
+			name: "valid",
+			msg:  NewMsgExecuteGatewayTransfer(sample.AccAddress(), amount, 2, recipient, sdk.NewInt(100), 0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgExecuteGatewayTransferWithPayload_ValidateBasic(t *testing.T) {
+	contract := make([]byte, 32)
+	contract[0] = 1
+	amount := sdk.NewInt64Coin("factory/wormhole1contract/token", 100)
+
+	err := NewMsgExecuteGatewayTransferWithPayload(sample.AccAddress(), amount, 2, contract, []byte{1}, 0).ValidateBasic()
+	require.NoError(t, err)
+
+	err = NewMsgExecuteGatewayTransferWithPayload(sample.AccAddress(), amount, 2, contract, nil, 0).ValidateBasic()
+	require.ErrorIs(t, err, ErrInvalidGatewayTransfer)
+
+	err = NewMsgExecuteGatewayTransferWithPayload(sample.AccAddress(), amount, 2, contract[:31], []byte{1}, 0).ValidateBasic()
+	require.ErrorIs(t, err, ErrInvalidGatewayTransfer)
+}
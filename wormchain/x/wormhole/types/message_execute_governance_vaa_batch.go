@@ -0,0 +1,49 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgExecuteGovernanceVAABatch{}
+
+func NewMsgExecuteGovernanceVAABatch(vaas [][]byte, signer string) *MsgExecuteGovernanceVAABatch {
+	return &MsgExecuteGovernanceVAABatch{
+		Vaas:   vaas,
+		Signer: signer,
+	}
+}
+
+func (msg *MsgExecuteGovernanceVAABatch) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgExecuteGovernanceVAABatch) Type() string {
+	return "ExecuteGovernanceVAABatch"
+}
+
+func (msg *MsgExecuteGovernanceVAABatch) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg *MsgExecuteGovernanceVAABatch) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgExecuteGovernanceVAABatch) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if len(msg.Vaas) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "batch must contain at least one VAA")
+	}
+
+	return nil
+}
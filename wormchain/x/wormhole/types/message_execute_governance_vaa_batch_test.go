@@ -0,0 +1,48 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormchain/testutil/sample"
+)
+
+func TestMsgExecuteGovernanceVAABatch_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgExecuteGovernanceVAABatch
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgExecuteGovernanceVAABatch{
+				Signer: "invalid_address",
+				Vaas:   [][]byte{{1}},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty batch",
+			msg: MsgExecuteGovernanceVAABatch{
+				Signer: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgExecuteGovernanceVAABatch{
+				Signer: sample.AccAddress(),
+				Vaas:   [][]byte{{1}, {2}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgGuardianHeartbeat{}
+
+func NewMsgGuardianHeartbeat(signer string, nodeVersion string, heights []ChainHeight, features []string) *MsgGuardianHeartbeat {
+	return &MsgGuardianHeartbeat{
+		Signer:      signer,
+		NodeVersion: nodeVersion,
+		Heights:     heights,
+		Features:    features,
+	}
+}
+
+func (msg *MsgGuardianHeartbeat) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgGuardianHeartbeat) Type() string {
+	return "GuardianHeartbeat"
+}
+
+func (msg *MsgGuardianHeartbeat) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg *MsgGuardianHeartbeat) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgGuardianHeartbeat) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if err := ValidateHeartbeatStatus(msg.NodeVersion, msg.Heights, msg.Features); err != nil {
+		return sdkerrors.Wrap(ErrInvalidGuardianHeartbeat, err.Error())
+	}
+
+	return nil
+}
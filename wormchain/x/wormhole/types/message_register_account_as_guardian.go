@@ -3,6 +3,7 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 const TypeMsgRegisterAccountAsGuardian = "register_account_as_guardian"
@@ -42,5 +43,8 @@ func (msg *MsgRegisterAccountAsGuardian) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
 	}
+	if len(msg.Signature) != crypto.SignatureLength {
+		return sdkerrors.Wrapf(ErrInvalidProofOfPossession, "signature must be %d bytes", crypto.SignatureLength)
+	}
 	return nil
 }
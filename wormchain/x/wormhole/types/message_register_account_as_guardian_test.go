@@ -20,10 +20,18 @@ func TestMsgRegisterAccountAsGuardian_ValidateBasic(t *testing.T) {
 				Signer: "invalid_address",
 			},
 			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid signature",
+			msg: MsgRegisterAccountAsGuardian{
+				Signer:    sample.AccAddress(),
+				Signature: make([]byte, 64),
+			},
+			err: ErrInvalidProofOfPossession,
 		}, {
 			name: "valid address",
 			msg: MsgRegisterAccountAsGuardian{
-				Signer: sample.AccAddress(),
+				Signer:    sample.AccAddress(),
+				Signature: make([]byte, 65),
 			},
 		},
 	}
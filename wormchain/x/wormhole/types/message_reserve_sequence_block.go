@@ -0,0 +1,48 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgReserveSequenceBlock{}
+
+func NewMsgReserveSequenceBlock(signer string, count uint64) *MsgReserveSequenceBlock {
+	return &MsgReserveSequenceBlock{
+		Signer: signer,
+		Count:  count,
+	}
+}
+
+func (msg *MsgReserveSequenceBlock) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgReserveSequenceBlock) Type() string {
+	return "ReserveSequenceBlock"
+}
+
+func (msg *MsgReserveSequenceBlock) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg *MsgReserveSequenceBlock) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgReserveSequenceBlock) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if err := ValidateSequenceReservationCount(msg.Count); err != nil {
+		return sdkerrors.Wrap(ErrInvalidSequenceReservation, err.Error())
+	}
+
+	return nil
+}
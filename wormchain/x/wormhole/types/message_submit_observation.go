@@ -0,0 +1,48 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSubmitObservation{}
+
+func NewMsgSubmitObservation(vaa []byte, signer string) *MsgSubmitObservation {
+	return &MsgSubmitObservation{
+		Vaa:    vaa,
+		Signer: signer,
+	}
+}
+
+func (msg *MsgSubmitObservation) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSubmitObservation) Type() string {
+	return "SubmitObservation"
+}
+
+func (msg *MsgSubmitObservation) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg *MsgSubmitObservation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSubmitObservation) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if len(msg.Vaa) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty vaa")
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"fmt"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateShutdownMsgTypeURL checks that a message type URL names a message
+// of the module that can be shut down by governance. MsgExecuteGovernanceVAA
+// can not be shut down, since it executes the governance VAA that recovers
+// shut down messages.
+func ValidateShutdownMsgTypeURL(msgTypeURL string) error {
+	registry := cdctypes.NewInterfaceRegistry()
+	RegisterInterfaces(registry)
+
+	resolved, err := registry.Resolve(msgTypeURL)
+	if err != nil {
+		return fmt.Errorf("unknown message type %s", msgTypeURL)
+	}
+	msg, ok := resolved.(sdk.Msg)
+	if !ok {
+		return fmt.Errorf("%s is not a message", msgTypeURL)
+	}
+	if msgTypeURL != sdk.MsgTypeURL(msg) {
+		return fmt.Errorf("unknown message type %s", msgTypeURL)
+	}
+	if _, ok := msg.(*MsgExecuteGovernanceVAA); ok {
+		return fmt.Errorf("%s can not be shut down", msgTypeURL)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+	"math"
+)
+
+// MaxTotalGuardianWeight bounds the total weight of a guardian set so that
+// the quorum calculation cannot overflow.
+const MaxTotalGuardianWeight = math.MaxUint32
+
+// Validate checks that there is a weight for each of the numGuardians
+// guardians and that the total weight is non-zero and within bounds.
+func (w GuardianSetWeights) Validate(numGuardians int) error {
+	if len(w.Weights) != numGuardians {
+		return fmt.Errorf("got %d weights for %d guardians", len(w.Weights), numGuardians)
+	}
+	var total uint64
+	for i, weight := range w.Weights {
+		if weight > MaxTotalGuardianWeight {
+			return fmt.Errorf("weight [%d]: %d exceeds %d", i, weight, uint64(MaxTotalGuardianWeight))
+		}
+		total += weight
+	}
+	if total == 0 {
+		return fmt.Errorf("total weight must not be 0")
+	}
+	if total > MaxTotalGuardianWeight {
+		return fmt.Errorf("total weight %d exceeds %d", total, uint64(MaxTotalGuardianWeight))
+	}
+	return nil
+}
+
+// HasSigned returns whether the guardian with the given index signed the
+// observation.
+func (t ObservationTally) HasSigned(guardianIndex uint8) bool {
+	i := int(guardianIndex) / 8
+	return i < len(t.Signers) && t.Signers[i]&(1<<(guardianIndex%8)) != 0
+}
+
+// SetSigned marks the guardian with the given index as having signed the
+// observation.
+func (t *ObservationTally) SetSigned(guardianIndex uint8) {
+	i := int(guardianIndex) / 8
+	if i >= len(t.Signers) {
+		signers := make([]byte, i+1)
+		copy(signers, t.Signers)
+		t.Signers = signers
+	}
+	t.Signers[i] |= 1 << (guardianIndex % 8)
+}
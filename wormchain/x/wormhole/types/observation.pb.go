@@ -0,0 +1,1055 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: wormhole/observation.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GuardianSetWeights are the voting weights of the guardians of a guardian set
+// when tallying observations, in guardian index order. Guardian sets without
+// weights give every guardian a weight of 1.
+type GuardianSetWeights struct {
+	GuardianSetIndex uint32   `protobuf:"varint,1,opt,name=guardian_set_index,json=guardianSetIndex,proto3" json:"guardian_set_index,omitempty"`
+	Weights          []uint64 `protobuf:"varint,2,rep,packed,name=weights,proto3" json:"weights,omitempty"`
+}
+
+func (m *GuardianSetWeights) Reset()         { *m = GuardianSetWeights{} }
+func (m *GuardianSetWeights) String() string { return proto.CompactTextString(m) }
+func (*GuardianSetWeights) ProtoMessage()    {}
+func (*GuardianSetWeights) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f79c89d0a32157a, []int{0}
+}
+func (m *GuardianSetWeights) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GuardianSetWeights) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GuardianSetWeights.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GuardianSetWeights) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GuardianSetWeights.Merge(m, src)
+}
+func (m *GuardianSetWeights) XXX_Size() int {
+	return m.Size()
+}
+func (m *GuardianSetWeights) XXX_DiscardUnknown() {
+	xxx_messageInfo_GuardianSetWeights.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GuardianSetWeights proto.InternalMessageInfo
+
+func (m *GuardianSetWeights) GetGuardianSetIndex() uint32 {
+	if m != nil {
+		return m.GuardianSetIndex
+	}
+	return 0
+}
+
+func (m *GuardianSetWeights) GetWeights() []uint64 {
+	if m != nil {
+		return m.Weights
+	}
+	return nil
+}
+
+// FinalizedObservation records that the observation with the digest was
+// finalized, so that it is not finalized again while guardians keep
+// submitting it. It is never pruned.
+type FinalizedObservation struct {
+	Digest []byte `protobuf:"bytes,1,opt,name=digest,proto3" json:"digest,omitempty"`
+	// block height at which the observation was finalized
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *FinalizedObservation) Reset()         { *m = FinalizedObservation{} }
+func (m *FinalizedObservation) String() string { return proto.CompactTextString(m) }
+func (*FinalizedObservation) ProtoMessage()    {}
+func (*FinalizedObservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f79c89d0a32157a, []int{1}
+}
+func (m *FinalizedObservation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalizedObservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalizedObservation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalizedObservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizedObservation.Merge(m, src)
+}
+func (m *FinalizedObservation) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalizedObservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizedObservation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalizedObservation proto.InternalMessageInfo
+
+func (m *FinalizedObservation) GetDigest() []byte {
+	if m != nil {
+		return m.Digest
+	}
+	return nil
+}
+
+func (m *FinalizedObservation) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// ObservationTally is the running tally of the guardians that signed an
+// observation, keyed by the signing digest of the observed VAA body. Tallies
+// are replaced by a FinalizedObservation once the observation is finalized, or
+// removed when they did not reach quorum within the observation tally
+// retention window.
+type ObservationTally struct {
+	Digest           []byte `protobuf:"bytes,1,opt,name=digest,proto3" json:"digest,omitempty"`
+	GuardianSetIndex uint32 `protobuf:"varint,2,opt,name=guardian_set_index,json=guardianSetIndex,proto3" json:"guardian_set_index,omitempty"`
+	// bitmap of the indices of the guardians that signed the observation
+	Signers []byte `protobuf:"bytes,3,opt,name=signers,proto3" json:"signers,omitempty"`
+	// sum of the weights of the guardians that signed the observation
+	Weight uint64 `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	// set once the weight exceeds the quorum threshold
+	Finalized bool `protobuf:"varint,5,opt,name=finalized,proto3" json:"finalized,omitempty"`
+	// set while the observation is held back by the rate limit of its emitter
+	// chain after reaching quorum
+	Queued bool `protobuf:"varint,6,opt,name=queued,proto3" json:"queued,omitempty"`
+	// block height at which the first signature was tallied
+	Height int64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ObservationTally) Reset()         { *m = ObservationTally{} }
+func (m *ObservationTally) String() string { return proto.CompactTextString(m) }
+func (*ObservationTally) ProtoMessage()    {}
+func (*ObservationTally) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f79c89d0a32157a, []int{2}
+}
+func (m *ObservationTally) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObservationTally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObservationTally.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ObservationTally) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObservationTally.Merge(m, src)
+}
+func (m *ObservationTally) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObservationTally) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObservationTally.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObservationTally proto.InternalMessageInfo
+
+func (m *ObservationTally) GetDigest() []byte {
+	if m != nil {
+		return m.Digest
+	}
+	return nil
+}
+
+func (m *ObservationTally) GetGuardianSetIndex() uint32 {
+	if m != nil {
+		return m.GuardianSetIndex
+	}
+	return 0
+}
+
+func (m *ObservationTally) GetSigners() []byte {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+func (m *ObservationTally) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *ObservationTally) GetFinalized() bool {
+	if m != nil {
+		return m.Finalized
+	}
+	return false
+}
+
+func (m *ObservationTally) GetQueued() bool {
+	if m != nil {
+		return m.Queued
+	}
+	return false
+}
+
+func (m *ObservationTally) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GuardianSetWeights)(nil), "wormhole_foundation.wormchain.wormhole.GuardianSetWeights")
+	proto.RegisterType((*FinalizedObservation)(nil), "wormhole_foundation.wormchain.wormhole.FinalizedObservation")
+	proto.RegisterType((*ObservationTally)(nil), "wormhole_foundation.wormchain.wormhole.ObservationTally")
+}
+
+func init() { proto.RegisterFile("wormhole/observation.proto", fileDescriptor_8f79c89d0a32157a) }
+
+var fileDescriptor_8f79c89d0a32157a = []byte{
+	// 324 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0x41, 0x4b, 0xc3, 0x30,
+	0x18, 0x5d, 0xb6, 0xb9, 0x69, 0x50, 0x18, 0x41, 0x24, 0x88, 0x94, 0xb2, 0x83, 0xf4, 0xa0, 0xeb,
+	0xc1, 0x93, 0x57, 0x0f, 0x13, 0x4f, 0x42, 0x27, 0x08, 0x22, 0x8c, 0x6e, 0xf9, 0x96, 0x06, 0xba,
+	0x64, 0x36, 0xa9, 0xdb, 0xfc, 0x15, 0xfe, 0x2c, 0x8f, 0x3b, 0xee, 0x28, 0xdb, 0x1f, 0x91, 0x76,
+	0xcd, 0xda, 0x83, 0x3b, 0xbe, 0xf7, 0xf2, 0x1e, 0xdf, 0xe3, 0x05, 0x5f, 0xce, 0x55, 0x32, 0x8d,
+	0x54, 0x0c, 0xbe, 0x1a, 0x69, 0x48, 0x3e, 0x43, 0x23, 0x94, 0xec, 0xcd, 0x12, 0x65, 0x14, 0xb9,
+	0xb6, 0xda, 0x70, 0xa2, 0x52, 0xc9, 0x76, 0x52, 0xc6, 0x8d, 0xa3, 0x50, 0xc8, 0x9e, 0x55, 0xbb,
+	0xef, 0x98, 0x3c, 0xa6, 0x61, 0xc2, 0x44, 0x28, 0x07, 0x60, 0x5e, 0x41, 0xf0, 0xc8, 0x68, 0x72,
+	0x83, 0x09, 0x2f, 0xd8, 0xa1, 0x06, 0x33, 0x14, 0x92, 0xc1, 0x82, 0x22, 0x17, 0x79, 0x67, 0x41,
+	0x87, 0x97, 0xef, 0x9f, 0x32, 0x9e, 0x50, 0xdc, 0x9e, 0xef, 0x8c, 0xb4, 0xee, 0x36, 0xbc, 0x66,
+	0x60, 0x61, 0xb7, 0x8f, 0xcf, 0xfb, 0x42, 0x86, 0xb1, 0xf8, 0x02, 0xf6, 0x5c, 0xde, 0x48, 0x2e,
+	0x70, 0x8b, 0x09, 0x0e, 0xda, 0xe4, 0x99, 0xa7, 0x41, 0x81, 0x32, 0x3e, 0xca, 0xad, 0xb4, 0xee,
+	0x22, 0xaf, 0x11, 0x14, 0xa8, 0xbb, 0x46, 0xb8, 0x53, 0xf1, 0xbf, 0x84, 0x71, 0xbc, 0x3c, 0x18,
+	0xf2, 0xff, 0xf1, 0xf5, 0xc3, 0xc7, 0x6b, 0xc1, 0x25, 0x24, 0x9a, 0x36, 0xf2, 0x18, 0x0b, 0xb3,
+	0xfc, 0x5d, 0x0f, 0xda, 0x74, 0x91, 0xd7, 0x0c, 0x0a, 0x44, 0xae, 0xf0, 0xc9, 0xc4, 0x96, 0xa2,
+	0x47, 0x2e, 0xf2, 0x8e, 0x83, 0x92, 0xc8, 0x5c, 0x1f, 0x29, 0xa4, 0xc0, 0x68, 0x2b, 0x97, 0x0a,
+	0x54, 0xa9, 0xd6, 0xae, 0x56, 0x7b, 0x18, 0xfc, 0x6c, 0x1c, 0xb4, 0xda, 0x38, 0xe8, 0x77, 0xe3,
+	0xa0, 0xef, 0xad, 0x53, 0x5b, 0x6d, 0x9d, 0xda, 0x7a, 0xeb, 0xd4, 0xde, 0xee, 0xb9, 0x30, 0x51,
+	0x3a, 0xea, 0x8d, 0xd5, 0xd4, 0xb7, 0x7b, 0xdd, 0x96, 0x6b, 0xfa, 0xfb, 0x35, 0xfd, 0xc5, 0x5e,
+	0xf7, 0xcd, 0x72, 0x06, 0x7a, 0xd4, 0xca, 0x3f, 0xc1, 0xdd, 0xdf, 0x00, 0x7e, 0xae, 0xb9, 0x1e,
+	0x22, 0x02, 0x00, 0x00,
+}
+
+func (m *GuardianSetWeights) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GuardianSetWeights) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GuardianSetWeights) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weights) > 0 {
+		dAtA2 := make([]byte, len(m.Weights)*10)
+		var j1 int
+		for _, num := range m.Weights {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintObservation(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GuardianSetIndex != 0 {
+		i = encodeVarintObservation(dAtA, i, uint64(m.GuardianSetIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalizedObservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalizedObservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalizedObservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintObservation(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarintObservation(dAtA, i, uint64(len(m.Digest)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ObservationTally) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObservationTally) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObservationTally) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintObservation(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Queued {
+		i--
+		if m.Queued {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Finalized {
+		i--
+		if m.Finalized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Weight != 0 {
+		i = encodeVarintObservation(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Signers) > 0 {
+		i -= len(m.Signers)
+		copy(dAtA[i:], m.Signers)
+		i = encodeVarintObservation(dAtA, i, uint64(len(m.Signers)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GuardianSetIndex != 0 {
+		i = encodeVarintObservation(dAtA, i, uint64(m.GuardianSetIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarintObservation(dAtA, i, uint64(len(m.Digest)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintObservation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovObservation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GuardianSetWeights) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GuardianSetIndex != 0 {
+		n += 1 + sovObservation(uint64(m.GuardianSetIndex))
+	}
+	if len(m.Weights) > 0 {
+		l = 0
+		for _, e := range m.Weights {
+			l += sovObservation(uint64(e))
+		}
+		n += 1 + sovObservation(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *FinalizedObservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sovObservation(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovObservation(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *ObservationTally) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sovObservation(uint64(l))
+	}
+	if m.GuardianSetIndex != 0 {
+		n += 1 + sovObservation(uint64(m.GuardianSetIndex))
+	}
+	l = len(m.Signers)
+	if l > 0 {
+		n += 1 + l + sovObservation(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovObservation(uint64(m.Weight))
+	}
+	if m.Finalized {
+		n += 2
+	}
+	if m.Queued {
+		n += 2
+	}
+	if m.Height != 0 {
+		n += 1 + sovObservation(uint64(m.Height))
+	}
+	return n
+}
+
+func sovObservation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozObservation(x uint64) (n int) {
+	return sovObservation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GuardianSetWeights) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObservation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GuardianSetWeights: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GuardianSetWeights: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianSetIndex", wireType)
+			}
+			m.GuardianSetIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObservation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GuardianSetIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowObservation
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Weights = append(m.Weights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowObservation
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthObservation
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthObservation
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Weights) == 0 {
+					m.Weights = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowObservation
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Weights = append(m.Weights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObservation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthObservation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalizedObservation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObservation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalizedObservation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalizedObservation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObservation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthObservation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObservation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = append(m.Digest[:0], dAtA[iNdEx:postIndex]...)
+			if m.Digest == nil {
+				m.Digest = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObservation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObservation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthObservation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObservationTally) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObservation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObservationTally: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObservationTally: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObservation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthObservation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObservation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = append(m.Digest[:0], dAtA[iNdEx:postIndex]...)
+			if m.Digest == nil {
+				m.Digest = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianSetIndex", wireType)
+			}
+			m.GuardianSetIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObservation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GuardianSetIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObservation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthObservation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObservation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signers == nil {
+				m.Signers = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObservation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObservation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finalized = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObservation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Queued = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObservation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObservation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthObservation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipObservation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowObservation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowObservation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowObservation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthObservation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupObservation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthObservation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthObservation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowObservation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupObservation = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,570 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: wormhole/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the governable parameters of the wormhole module.
+type Params struct {
+	// fee in uworm for posting a message
+	MessageFee uint64 `protobuf:"varint,1,opt,name=message_fee,json=messageFee,proto3" json:"message_fee,omitempty"`
+	// fee in uworm for a transfer through the gateway
+	GatewayTransferFee uint64 `protobuf:"varint,2,opt,name=gateway_transfer_fee,json=gatewayTransferFee,proto3" json:"gateway_transfer_fee,omitempty"`
+	// gas consumed per guardian signature when verifying a VAA, 0 uses the default
+	SignatureVerificationGas uint64 `protobuf:"varint,3,opt,name=signature_verification_gas,json=signatureVerificationGas,proto3" json:"signature_verification_gas,omitempty"`
+	// quorum override for VAA verification: a VAA needs more than
+	// quorum_numerator/quorum_denominator of the guardian signatures. Both 0
+	// uses the default 2/3.
+	QuorumNumerator   uint32 `protobuf:"varint,4,opt,name=quorum_numerator,json=quorumNumerator,proto3" json:"quorum_numerator,omitempty"`
+	QuorumDenominator uint32 `protobuf:"varint,5,opt,name=quorum_denominator,json=quorumDenominator,proto3" json:"quorum_denominator,omitempty"`
+	// number of blocks verified VAAs are kept in the VAA archive, 0 disables
+	// the archive
+	VaaArchiveRetentionBlocks uint64 `protobuf:"varint,6,opt,name=vaa_archive_retention_blocks,json=vaaArchiveRetentionBlocks,proto3" json:"vaa_archive_retention_blocks,omitempty"`
+	// fee in basis points deducted from the transfers the packet forward
+	// middleware forwards through wormchain
+	ForwardFeeBps uint32 `protobuf:"varint,7,opt,name=forward_fee_bps,json=forwardFeeBps,proto3" json:"forward_fee_bps,omitempty"`
+	// number of blocks the validators of guardians removed from the consensus
+	// guardian set stay bonded after the switch, 0 unbonds them at the switch
+	ValidatorTransitionBlocks uint64 `protobuf:"varint,8,opt,name=validator_transition_blocks,json=validatorTransitionBlocks,proto3" json:"validator_transition_blocks,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3072d10cc8da00b5, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMessageFee() uint64 {
+	if m != nil {
+		return m.MessageFee
+	}
+	return 0
+}
+
+func (m *Params) GetGatewayTransferFee() uint64 {
+	if m != nil {
+		return m.GatewayTransferFee
+	}
+	return 0
+}
+
+func (m *Params) GetSignatureVerificationGas() uint64 {
+	if m != nil {
+		return m.SignatureVerificationGas
+	}
+	return 0
+}
+
+func (m *Params) GetQuorumNumerator() uint32 {
+	if m != nil {
+		return m.QuorumNumerator
+	}
+	return 0
+}
+
+func (m *Params) GetQuorumDenominator() uint32 {
+	if m != nil {
+		return m.QuorumDenominator
+	}
+	return 0
+}
+
+func (m *Params) GetVaaArchiveRetentionBlocks() uint64 {
+	if m != nil {
+		return m.VaaArchiveRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetForwardFeeBps() uint32 {
+	if m != nil {
+		return m.ForwardFeeBps
+	}
+	return 0
+}
+
+func (m *Params) GetValidatorTransitionBlocks() uint64 {
+	if m != nil {
+		return m.ValidatorTransitionBlocks
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "wormhole_foundation.wormchain.wormhole.Params")
+}
+
+func init() { proto.RegisterFile("wormhole/params.proto", fileDescriptor_3072d10cc8da00b5) }
+
+var fileDescriptor_3072d10cc8da00b5 = []byte{
+	// 377 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x92, 0xcd, 0xca, 0xd3, 0x40,
+	0x14, 0x86, 0x1b, 0x5b, 0xab, 0x8c, 0x94, 0xea, 0xa0, 0x10, 0x7f, 0x88, 0xc5, 0x45, 0xa9, 0x8b,
+	0x26, 0x82, 0x2b, 0x41, 0x14, 0x8b, 0xd4, 0x9d, 0x48, 0x2d, 0x2e, 0xdc, 0x0c, 0x27, 0xc9, 0x49,
+	0x32, 0xd8, 0xcc, 0xc4, 0x99, 0x49, 0x6a, 0xef, 0xc2, 0x0b, 0xf0, 0x82, 0x5c, 0x76, 0xe9, 0x52,
+	0xda, 0x1b, 0xf9, 0xc8, 0xe4, 0xa7, 0xdd, 0xbe, 0xcf, 0x73, 0x78, 0x0f, 0x73, 0x86, 0x3c, 0xda,
+	0x4b, 0x95, 0x67, 0x72, 0x87, 0x41, 0x01, 0x0a, 0x72, 0xed, 0x17, 0x4a, 0x1a, 0x49, 0xe7, 0x5d,
+	0xcc, 0x12, 0x59, 0x8a, 0x18, 0x0c, 0x97, 0xc2, 0xaf, 0xb3, 0x28, 0x03, 0x2e, 0xfc, 0x8e, 0xbe,
+	0xf8, 0x33, 0x24, 0xe3, 0x2f, 0x76, 0x90, 0x3e, 0x27, 0xf7, 0x72, 0xd4, 0x1a, 0x52, 0x64, 0x09,
+	0xa2, 0xeb, 0xcc, 0x9c, 0xc5, 0x68, 0x43, 0xda, 0x68, 0x8d, 0x48, 0x5f, 0x91, 0x87, 0x29, 0x18,
+	0xdc, 0xc3, 0x81, 0x19, 0x05, 0x42, 0x27, 0xa8, 0xac, 0x79, 0xcb, 0x9a, 0xb4, 0x65, 0xdb, 0x16,
+	0xd5, 0x13, 0x6f, 0xc9, 0x13, 0xcd, 0x53, 0x01, 0xa6, 0x54, 0xc8, 0x2a, 0x54, 0x3c, 0xe1, 0x91,
+	0x5d, 0x85, 0xa5, 0xa0, 0xdd, 0xa1, 0x9d, 0x73, 0x7b, 0xe3, 0xdb, 0x95, 0xf0, 0x09, 0x34, 0x7d,
+	0x49, 0xee, 0xff, 0x2c, 0xa5, 0x2a, 0x73, 0x26, 0xca, 0x1c, 0x15, 0x18, 0xa9, 0xdc, 0xd1, 0xcc,
+	0x59, 0x4c, 0x36, 0xd3, 0x26, 0xff, 0xdc, 0xc5, 0x74, 0x49, 0x68, 0xab, 0xc6, 0x28, 0x64, 0xce,
+	0x85, 0x95, 0x6f, 0x5b, 0xf9, 0x41, 0x43, 0x3e, 0x5e, 0x00, 0x7d, 0x4f, 0x9e, 0x55, 0x00, 0x0c,
+	0x54, 0x94, 0xf1, 0x0a, 0x99, 0x42, 0x83, 0xc2, 0xae, 0x15, 0xee, 0x64, 0xf4, 0x43, 0xbb, 0x63,
+	0xbb, 0xd9, 0xe3, 0x0a, 0xe0, 0x43, 0xa3, 0x6c, 0x3a, 0x63, 0x65, 0x05, 0x3a, 0x27, 0xd3, 0x44,
+	0xaa, 0x3d, 0xa8, 0xb8, 0x7e, 0x01, 0x16, 0x16, 0xda, 0xbd, 0x63, 0xcb, 0x26, 0x6d, 0xbc, 0x46,
+	0x5c, 0x15, 0x9a, 0xbe, 0x23, 0x4f, 0x2b, 0xd8, 0xf1, 0xb8, 0x6e, 0x6d, 0x1e, 0x8d, 0x5f, 0xf7,
+	0xdc, 0xed, 0x7a, 0x5a, 0x65, 0xdb, 0x1b, 0x4d, 0xcf, 0xea, 0xeb, 0xdf, 0x93, 0xe7, 0x1c, 0x4f,
+	0x9e, 0xf3, 0xff, 0xe4, 0x39, 0xbf, 0xcf, 0xde, 0xe0, 0x78, 0xf6, 0x06, 0xff, 0xce, 0xde, 0xe0,
+	0xfb, 0x9b, 0x94, 0x9b, 0xac, 0x0c, 0xfd, 0x48, 0xe6, 0x41, 0x77, 0xcd, 0xe5, 0xe5, 0xd6, 0x41,
+	0x7f, 0xeb, 0xe0, 0x57, 0xcf, 0x03, 0x73, 0x28, 0x50, 0x87, 0x63, 0xfb, 0x45, 0x5e, 0xdf, 0x0c,
+	0x00, 0xe9, 0x5a, 0xde, 0xad, 0x3b, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidatorTransitionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ValidatorTransitionBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ForwardFeeBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ForwardFeeBps))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.VaaArchiveRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.VaaArchiveRetentionBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.QuorumDenominator != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.QuorumDenominator))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.QuorumNumerator != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.QuorumNumerator))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SignatureVerificationGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SignatureVerificationGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GatewayTransferFee != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GatewayTransferFee))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MessageFee != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MessageFee))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MessageFee != 0 {
+		n += 1 + sovParams(uint64(m.MessageFee))
+	}
+	if m.GatewayTransferFee != 0 {
+		n += 1 + sovParams(uint64(m.GatewayTransferFee))
+	}
+	if m.SignatureVerificationGas != 0 {
+		n += 1 + sovParams(uint64(m.SignatureVerificationGas))
+	}
+	if m.QuorumNumerator != 0 {
+		n += 1 + sovParams(uint64(m.QuorumNumerator))
+	}
+	if m.QuorumDenominator != 0 {
+		n += 1 + sovParams(uint64(m.QuorumDenominator))
+	}
+	if m.VaaArchiveRetentionBlocks != 0 {
+		n += 1 + sovParams(uint64(m.VaaArchiveRetentionBlocks))
+	}
+	if m.ForwardFeeBps != 0 {
+		n += 1 + sovParams(uint64(m.ForwardFeeBps))
+	}
+	if m.ValidatorTransitionBlocks != 0 {
+		n += 1 + sovParams(uint64(m.ValidatorTransitionBlocks))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageFee", wireType)
+			}
+			m.MessageFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessageFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayTransferFee", wireType)
+			}
+			m.GatewayTransferFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GatewayTransferFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureVerificationGas", wireType)
+			}
+			m.SignatureVerificationGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignatureVerificationGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumNumerator", wireType)
+			}
+			m.QuorumNumerator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumNumerator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumDenominator", wireType)
+			}
+			m.QuorumDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumDenominator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaaArchiveRetentionBlocks", wireType)
+			}
+			m.VaaArchiveRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VaaArchiveRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardFeeBps", wireType)
+			}
+			m.ForwardFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwardFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorTransitionBlocks", wireType)
+			}
+			m.ValidatorTransitionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorTransitionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -424,6 +426,173 @@ func (m *QueryAllGuardianSetResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+type QueryGuardianSetHistoryRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGuardianSetHistoryRequest) Reset()         { *m = QueryGuardianSetHistoryRequest{} }
+func (m *QueryGuardianSetHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGuardianSetHistoryRequest) ProtoMessage()    {}
+func (*QueryGuardianSetHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{8}
+}
+func (m *QueryGuardianSetHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGuardianSetHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGuardianSetHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGuardianSetHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGuardianSetHistoryRequest.Merge(m, src)
+}
+func (m *QueryGuardianSetHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGuardianSetHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGuardianSetHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGuardianSetHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryGuardianSetHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type GuardianSetHistoryEntry struct {
+	GuardianSet GuardianSet `protobuf:"bytes,1,opt,name=guardianSet,proto3" json:"guardianSet"`
+	// block height at which the guardian set was added, 0 if unknown
+	ActivationHeight int64 `protobuf:"varint,2,opt,name=activationHeight,proto3" json:"activationHeight,omitempty"`
+	// unix time after which the guardian set expires, 0 if it has no expiry
+	ExpirationTime uint64 `protobuf:"varint,3,opt,name=expirationTime,proto3" json:"expirationTime,omitempty"`
+	// whether VAAs signed by the guardian set are still accepted
+	Valid bool `protobuf:"varint,4,opt,name=valid,proto3" json:"valid,omitempty"`
+}
+
+func (m *GuardianSetHistoryEntry) Reset()         { *m = GuardianSetHistoryEntry{} }
+func (m *GuardianSetHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*GuardianSetHistoryEntry) ProtoMessage()    {}
+func (*GuardianSetHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{9}
+}
+func (m *GuardianSetHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GuardianSetHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GuardianSetHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GuardianSetHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GuardianSetHistoryEntry.Merge(m, src)
+}
+func (m *GuardianSetHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *GuardianSetHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_GuardianSetHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GuardianSetHistoryEntry proto.InternalMessageInfo
+
+func (m *GuardianSetHistoryEntry) GetGuardianSet() GuardianSet {
+	if m != nil {
+		return m.GuardianSet
+	}
+	return GuardianSet{}
+}
+
+func (m *GuardianSetHistoryEntry) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *GuardianSetHistoryEntry) GetExpirationTime() uint64 {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return 0
+}
+
+func (m *GuardianSetHistoryEntry) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+type QueryGuardianSetHistoryResponse struct {
+	GuardianSets []GuardianSetHistoryEntry `protobuf:"bytes,1,rep,name=guardianSets,proto3" json:"guardianSets"`
+	Pagination   *query.PageResponse       `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGuardianSetHistoryResponse) Reset()         { *m = QueryGuardianSetHistoryResponse{} }
+func (m *QueryGuardianSetHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGuardianSetHistoryResponse) ProtoMessage()    {}
+func (*QueryGuardianSetHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{10}
+}
+func (m *QueryGuardianSetHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGuardianSetHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGuardianSetHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGuardianSetHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGuardianSetHistoryResponse.Merge(m, src)
+}
+func (m *QueryGuardianSetHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGuardianSetHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGuardianSetHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGuardianSetHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryGuardianSetHistoryResponse) GetGuardianSets() []GuardianSetHistoryEntry {
+	if m != nil {
+		return m.GuardianSets
+	}
+	return nil
+}
+
+func (m *QueryGuardianSetHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryGetConfigRequest struct {
 }
 
@@ -431,7 +600,7 @@ func (m *QueryGetConfigRequest) Reset()         { *m = QueryGetConfigRequest{} }
 func (m *QueryGetConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetConfigRequest) ProtoMessage()    {}
 func (*QueryGetConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_273185ecc792fa38, []int{8}
+	return fileDescriptor_273185ecc792fa38, []int{11}
 }
 func (m *QueryGetConfigRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -468,7 +637,7 @@ func (m *QueryGetConfigResponse) Reset()         { *m = QueryGetConfigResponse{}
 func (m *QueryGetConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetConfigResponse) ProtoMessage()    {}
 func (*QueryGetConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_273185ecc792fa38, []int{9}
+	return fileDescriptor_273185ecc792fa38, []int{12}
 }
 func (m *QueryGetConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -512,7 +681,7 @@ func (m *QueryGetReplayProtectionRequest) Reset()         { *m = QueryGetReplayP
 func (m *QueryGetReplayProtectionRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetReplayProtectionRequest) ProtoMessage()    {}
 func (*QueryGetReplayProtectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_273185ecc792fa38, []int{10}
+	return fileDescriptor_273185ecc792fa38, []int{13}
 }
 func (m *QueryGetReplayProtectionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -556,7 +725,7 @@ func (m *QueryGetReplayProtectionResponse) Reset()         { *m = QueryGetReplay
 func (m *QueryGetReplayProtectionResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetReplayProtectionResponse) ProtoMessage()    {}
 func (*QueryGetReplayProtectionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_273185ecc792fa38, []int{11}
+	return fileDescriptor_273185ecc792fa38, []int{14}
 }
 func (m *QueryGetReplayProtectionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -600,7 +769,7 @@ func (m *QueryAllReplayProtectionRequest) Reset()         { *m = QueryAllReplayP
 func (m *QueryAllReplayProtectionRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllReplayProtectionRequest) ProtoMessage()    {}
 func (*QueryAllReplayProtectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_273185ecc792fa38, []int{12}
+	return fileDescriptor_273185ecc792fa38, []int{15}
 }
 func (m *QueryAllReplayProtectionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -645,7 +814,7 @@ func (m *QueryAllReplayProtectionResponse) Reset()         { *m = QueryAllReplay
 func (m *QueryAllReplayProtectionResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllReplayProtectionResponse) ProtoMessage()    {}
 func (*QueryAllReplayProtectionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_273185ecc792fa38, []int{13}
+	return fileDescriptor_273185ecc792fa38, []int{16}
 }
 func (m *QueryAllReplayProtectionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -688,6 +857,102 @@ func (m *QueryAllReplayProtectionResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+type QueryAllExecutedGovernanceVAARequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllExecutedGovernanceVAARequest) Reset()         { *m = QueryAllExecutedGovernanceVAARequest{} }
+func (m *QueryAllExecutedGovernanceVAARequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllExecutedGovernanceVAARequest) ProtoMessage()    {}
+func (*QueryAllExecutedGovernanceVAARequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{17}
+}
+func (m *QueryAllExecutedGovernanceVAARequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllExecutedGovernanceVAARequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllExecutedGovernanceVAARequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllExecutedGovernanceVAARequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllExecutedGovernanceVAARequest.Merge(m, src)
+}
+func (m *QueryAllExecutedGovernanceVAARequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllExecutedGovernanceVAARequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllExecutedGovernanceVAARequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllExecutedGovernanceVAARequest proto.InternalMessageInfo
+
+func (m *QueryAllExecutedGovernanceVAARequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllExecutedGovernanceVAAResponse struct {
+	ExecutedGovernanceVAA []ExecutedGovernanceVAA `protobuf:"bytes,1,rep,name=executedGovernanceVAA,proto3" json:"executedGovernanceVAA"`
+	Pagination            *query.PageResponse     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllExecutedGovernanceVAAResponse) Reset()         { *m = QueryAllExecutedGovernanceVAAResponse{} }
+func (m *QueryAllExecutedGovernanceVAAResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllExecutedGovernanceVAAResponse) ProtoMessage()    {}
+func (*QueryAllExecutedGovernanceVAAResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{18}
+}
+func (m *QueryAllExecutedGovernanceVAAResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllExecutedGovernanceVAAResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllExecutedGovernanceVAAResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllExecutedGovernanceVAAResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllExecutedGovernanceVAAResponse.Merge(m, src)
+}
+func (m *QueryAllExecutedGovernanceVAAResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllExecutedGovernanceVAAResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllExecutedGovernanceVAAResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllExecutedGovernanceVAAResponse proto.InternalMessageInfo
+
+func (m *QueryAllExecutedGovernanceVAAResponse) GetExecutedGovernanceVAA() []ExecutedGovernanceVAA {
+	if m != nil {
+		return m.ExecutedGovernanceVAA
+	}
+	return nil
+}
+
+func (m *QueryAllExecutedGovernanceVAAResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryGetSequenceCounterRequest struct {
 	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
 }
@@ -696,7 +961,7 @@ func (m *QueryGetSequenceCounterRequest) Reset()         { *m = QueryGetSequence
 func (m *QueryGetSequenceCounterRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetSequenceCounterRequest) ProtoMessage()    {}
 func (*QueryGetSequenceCounterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_273185ecc792fa38, []int{14}
+	return fileDescriptor_273185ecc792fa38, []int{19}
 }
 func (m *QueryGetSequenceCounterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -740,7 +1005,7 @@ func (m *QueryGetSequenceCounterResponse) Reset()         { *m = QueryGetSequenc
 func (m *QueryGetSequenceCounterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetSequenceCounterResponse) ProtoMessage()    {}
 func (*QueryGetSequenceCounterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_273185ecc792fa38, []int{15}
+	return fileDescriptor_273185ecc792fa38, []int{20}
 }
 func (m *QueryGetSequenceCounterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -784,7 +1049,7 @@ func (m *QueryAllSequenceCounterRequest) Reset()         { *m = QueryAllSequence
 func (m *QueryAllSequenceCounterRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllSequenceCounterRequest) ProtoMessage()    {}
 func (*QueryAllSequenceCounterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_273185ecc792fa38, []int{16}
+	return fileDescriptor_273185ecc792fa38, []int{21}
 }
 func (m *QueryAllSequenceCounterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -829,7 +1094,7 @@ func (m *QueryAllSequenceCounterResponse) Reset()         { *m = QueryAllSequenc
 func (m *QueryAllSequenceCounterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllSequenceCounterResponse) ProtoMessage()    {}
 func (*QueryAllSequenceCounterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_273185ecc792fa38, []int{17}
+	return fileDescriptor_273185ecc792fa38, []int{22}
 }
 func (m *QueryAllSequenceCounterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -881,7 +1146,7 @@ func (m *QueryGetConsensusGuardianSetIndexRequest) Reset() {
 func (m *QueryGetConsensusGuardianSetIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetConsensusGuardianSetIndexRequest) ProtoMessage()    {}
 func (*QueryGetConsensusGuardianSetIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_273185ecc792fa38, []int{18}
+	return fileDescriptor_273185ecc792fa38, []int{23}
 }
 func (m *QueryGetConsensusGuardianSetIndexRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -922,7 +1187,7 @@ func (m *QueryGetConsensusGuardianSetIndexResponse) String() string {
 }
 func (*QueryGetConsensusGuardianSetIndexResponse) ProtoMessage() {}
 func (*QueryGetConsensusGuardianSetIndexResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_273185ecc792fa38, []int{19}
+	return fileDescriptor_273185ecc792fa38, []int{24}
 }
 func (m *QueryGetConsensusGuardianSetIndexResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -966,7 +1231,7 @@ func (m *QueryGetGuardianValidatorRequest) Reset()         { *m = QueryGetGuardi
 func (m *QueryGetGuardianValidatorRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetGuardianValidatorRequest) ProtoMessage()    {}
 func (*QueryGetGuardianValidatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_273185ecc792fa38, []int{20}
+	return fileDescriptor_273185ecc792fa38, []int{25}
 }
 func (m *QueryGetGuardianValidatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1010,7 +1275,7 @@ func (m *QueryGetGuardianValidatorResponse) Reset()         { *m = QueryGetGuard
 func (m *QueryGetGuardianValidatorResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetGuardianValidatorResponse) ProtoMessage()    {}
 func (*QueryGetGuardianValidatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_273185ecc792fa38, []int{21}
+	return fileDescriptor_273185ecc792fa38, []int{26}
 }
 func (m *QueryGetGuardianValidatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1054,7 +1319,7 @@ func (m *QueryAllGuardianValidatorRequest) Reset()         { *m = QueryAllGuardi
 func (m *QueryAllGuardianValidatorRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllGuardianValidatorRequest) ProtoMessage()    {}
 func (*QueryAllGuardianValidatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_273185ecc792fa38, []int{22}
+	return fileDescriptor_273185ecc792fa38, []int{27}
 }
 func (m *QueryAllGuardianValidatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1099,7 +1364,7 @@ func (m *QueryAllGuardianValidatorResponse) Reset()         { *m = QueryAllGuard
 func (m *QueryAllGuardianValidatorResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllGuardianValidatorResponse) ProtoMessage()    {}
 func (*QueryAllGuardianValidatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_273185ecc792fa38, []int{23}
+	return fileDescriptor_273185ecc792fa38, []int{28}
 }
 func (m *QueryAllGuardianValidatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1149,7 +1414,7 @@ func (m *QueryLatestGuardianSetIndexRequest) Reset()         { *m = QueryLatestG
 func (m *QueryLatestGuardianSetIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLatestGuardianSetIndexRequest) ProtoMessage()    {}
 func (*QueryLatestGuardianSetIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_273185ecc792fa38, []int{24}
+	return fileDescriptor_273185ecc792fa38, []int{29}
 }
 func (m *QueryLatestGuardianSetIndexRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1186,7 +1451,7 @@ func (m *QueryLatestGuardianSetIndexResponse) Reset()         { *m = QueryLatest
 func (m *QueryLatestGuardianSetIndexResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLatestGuardianSetIndexResponse) ProtoMessage()    {}
 func (*QueryLatestGuardianSetIndexResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_273185ecc792fa38, []int{25}
+	return fileDescriptor_273185ecc792fa38, []int{30}
 }
 func (m *QueryLatestGuardianSetIndexResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1231,7 +1496,7 @@ func (m *QueryIbcComposabilityMwContractRequest) Reset() {
 func (m *QueryIbcComposabilityMwContractRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryIbcComposabilityMwContractRequest) ProtoMessage()    {}
 func (*QueryIbcComposabilityMwContractRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_273185ecc792fa38, []int{26}
+	return fileDescriptor_273185ecc792fa38, []int{31}
 }
 func (m *QueryIbcComposabilityMwContractRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1270,7 +1535,7 @@ func (m *QueryIbcComposabilityMwContractResponse) Reset() {
 func (m *QueryIbcComposabilityMwContractResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryIbcComposabilityMwContractResponse) ProtoMessage()    {}
 func (*QueryIbcComposabilityMwContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_273185ecc792fa38, []int{27}
+	return fileDescriptor_273185ecc792fa38, []int{32}
 }
 func (m *QueryIbcComposabilityMwContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1306,6 +1571,86 @@ func (m *QueryIbcComposabilityMwContractResponse) GetContractAddress() string {
 	return ""
 }
 
+type QueryBridgePausedRequest struct {
+}
+
+func (m *QueryBridgePausedRequest) Reset()         { *m = QueryBridgePausedRequest{} }
+func (m *QueryBridgePausedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgePausedRequest) ProtoMessage()    {}
+func (*QueryBridgePausedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{33}
+}
+func (m *QueryBridgePausedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBridgePausedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgePausedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBridgePausedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgePausedRequest.Merge(m, src)
+}
+func (m *QueryBridgePausedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBridgePausedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgePausedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBridgePausedRequest proto.InternalMessageInfo
+
+type QueryBridgePausedResponse struct {
+	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (m *QueryBridgePausedResponse) Reset()         { *m = QueryBridgePausedResponse{} }
+func (m *QueryBridgePausedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgePausedResponse) ProtoMessage()    {}
+func (*QueryBridgePausedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{34}
+}
+func (m *QueryBridgePausedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBridgePausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgePausedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBridgePausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgePausedResponse.Merge(m, src)
+}
+func (m *QueryBridgePausedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBridgePausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgePausedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBridgePausedResponse proto.InternalMessageInfo
+
+func (m *QueryBridgePausedResponse) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
 type QueryAllWasmInstantiateAllowlist struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
@@ -1314,7 +1659,7 @@ func (m *QueryAllWasmInstantiateAllowlist) Reset()         { *m = QueryAllWasmIn
 func (m *QueryAllWasmInstantiateAllowlist) String() string { return proto.CompactTextString(m) }
 func (*QueryAllWasmInstantiateAllowlist) ProtoMessage()    {}
 func (*QueryAllWasmInstantiateAllowlist) Descriptor() ([]byte, []int) {
-	return fileDescriptor_273185ecc792fa38, []int{28}
+	return fileDescriptor_273185ecc792fa38, []int{35}
 }
 func (m *QueryAllWasmInstantiateAllowlist) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1362,7 +1707,7 @@ func (m *QueryAllWasmInstantiateAllowlistResponse) Reset() {
 func (m *QueryAllWasmInstantiateAllowlistResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllWasmInstantiateAllowlistResponse) ProtoMessage()    {}
 func (*QueryAllWasmInstantiateAllowlistResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_273185ecc792fa38, []int{29}
+	return fileDescriptor_273185ecc792fa38, []int{36}
 }
 func (m *QueryAllWasmInstantiateAllowlistResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1405,2238 +1750,19463 @@ func (m *QueryAllWasmInstantiateAllowlistResponse) GetPagination() *query.PageRe
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryAllValidatorAllowlist)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllValidatorAllowlist")
-	proto.RegisterType((*QueryAllValidatorAllowlistResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllValidatorAllowlistResponse")
-	proto.RegisterType((*QueryValidatorAllowlist)(nil), "wormhole_foundation.wormchain.wormhole.QueryValidatorAllowlist")
-	proto.RegisterType((*QueryValidatorAllowlistResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryValidatorAllowlistResponse")
-	proto.RegisterType((*QueryGetGuardianSetRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetGuardianSetRequest")
-	proto.RegisterType((*QueryGetGuardianSetResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetGuardianSetResponse")
-	proto.RegisterType((*QueryAllGuardianSetRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllGuardianSetRequest")
-	proto.RegisterType((*QueryAllGuardianSetResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllGuardianSetResponse")
-	proto.RegisterType((*QueryGetConfigRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetConfigRequest")
-	proto.RegisterType((*QueryGetConfigResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetConfigResponse")
-	proto.RegisterType((*QueryGetReplayProtectionRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetReplayProtectionRequest")
-	proto.RegisterType((*QueryGetReplayProtectionResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetReplayProtectionResponse")
-	proto.RegisterType((*QueryAllReplayProtectionRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllReplayProtectionRequest")
-	proto.RegisterType((*QueryAllReplayProtectionResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllReplayProtectionResponse")
-	proto.RegisterType((*QueryGetSequenceCounterRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetSequenceCounterRequest")
-	proto.RegisterType((*QueryGetSequenceCounterResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetSequenceCounterResponse")
-	proto.RegisterType((*QueryAllSequenceCounterRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllSequenceCounterRequest")
-	proto.RegisterType((*QueryAllSequenceCounterResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllSequenceCounterResponse")
-	proto.RegisterType((*QueryGetConsensusGuardianSetIndexRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetConsensusGuardianSetIndexRequest")
-	proto.RegisterType((*QueryGetConsensusGuardianSetIndexResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetConsensusGuardianSetIndexResponse")
-	proto.RegisterType((*QueryGetGuardianValidatorRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetGuardianValidatorRequest")
-	proto.RegisterType((*QueryGetGuardianValidatorResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetGuardianValidatorResponse")
-	proto.RegisterType((*QueryAllGuardianValidatorRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllGuardianValidatorRequest")
-	proto.RegisterType((*QueryAllGuardianValidatorResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllGuardianValidatorResponse")
-	proto.RegisterType((*QueryLatestGuardianSetIndexRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryLatestGuardianSetIndexRequest")
-	proto.RegisterType((*QueryLatestGuardianSetIndexResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryLatestGuardianSetIndexResponse")
-	proto.RegisterType((*QueryIbcComposabilityMwContractRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryIbcComposabilityMwContractRequest")
-	proto.RegisterType((*QueryIbcComposabilityMwContractResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryIbcComposabilityMwContractResponse")
-	proto.RegisterType((*QueryAllWasmInstantiateAllowlist)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllWasmInstantiateAllowlist")
-	proto.RegisterType((*QueryAllWasmInstantiateAllowlistResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllWasmInstantiateAllowlistResponse")
+type QueryGetRegisteredEmitterRequest struct {
+	Module  string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	ChainId uint32 `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("wormhole/query.proto", fileDescriptor_273185ecc792fa38) }
-
-var fileDescriptor_273185ecc792fa38 = []byte{
-	// 1448 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x99, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0xc7, 0x33, 0xbb, 0xb4, 0x52, 0x26, 0x85, 0xb6, 0x43, 0xfa, 0x03, 0xb7, 0xda, 0x06, 0x83,
-	0xda, 0xd0, 0x8a, 0x35, 0x4d, 0x44, 0xd3, 0xb4, 0x94, 0x74, 0x77, 0x43, 0x36, 0x9b, 0xa6, 0x90,
-	0x6e, 0x24, 0x90, 0x40, 0x95, 0xe5, 0xf5, 0x4e, 0x36, 0xae, 0xbc, 0xf6, 0x76, 0xed, 0x6d, 0x1a,
-	0xa2, 0x5c, 0x90, 0x7a, 0x41, 0x28, 0x20, 0xf8, 0x53, 0xf8, 0x03, 0x38, 0x70, 0xe9, 0x81, 0x43,
-	0xa5, 0x4a, 0xfc, 0x50, 0x25, 0x84, 0x92, 0xc2, 0x01, 0x0e, 0xdc, 0x38, 0x20, 0x0e, 0xc8, 0xe3,
-	0x67, 0xaf, 0xd7, 0x6b, 0x6f, 0x6c, 0xaf, 0x73, 0xdb, 0xcc, 0x8c, 0xbf, 0xf3, 0x3e, 0xdf, 0x79,
-	0x9e, 0x79, 0xe3, 0xe0, 0xf1, 0x0d, 0xbd, 0xdd, 0x5c, 0xd7, 0x55, 0x2a, 0xdc, 0xef, 0xd0, 0xf6,
-	0x66, 0xbe, 0xd5, 0xd6, 0x4d, 0x9d, 0x9c, 0x77, 0x5a, 0xc5, 0x35, 0xbd, 0xa3, 0xd5, 0x25, 0x53,
-	0xd1, 0xb5, 0xbc, 0xd5, 0x26, 0xaf, 0x4b, 0x8a, 0xfd, 0xcb, 0xea, 0xe5, 0xce, 0x36, 0x74, 0xbd,
-	0xa1, 0x52, 0x41, 0x6a, 0x29, 0x82, 0xa4, 0x69, 0xba, 0xc9, 0x46, 0x1a, 0xb6, 0x0a, 0x77, 0x51,
-	0xd6, 0x8d, 0xa6, 0x6e, 0x08, 0x35, 0xc9, 0x00, 0x79, 0xe1, 0xc1, 0xe5, 0x1a, 0x35, 0xa5, 0xcb,
-	0x42, 0x4b, 0x6a, 0x28, 0x9a, 0x2d, 0x6b, 0x8f, 0x3d, 0xe5, 0xc6, 0xd1, 0xe8, 0x48, 0xed, 0xba,
-	0x22, 0x39, 0x1d, 0x27, 0xdc, 0x0e, 0x59, 0xd7, 0xd6, 0x94, 0x06, 0x34, 0x4f, 0xb8, 0xcd, 0x6d,
-	0xda, 0x52, 0xa5, 0x4d, 0xd1, 0x6a, 0xa6, 0xb2, 0x47, 0xf1, 0x9c, 0x3b, 0xc2, 0xa0, 0xf7, 0x3b,
-	0x54, 0x93, 0xa9, 0x28, 0xeb, 0x1d, 0xcd, 0xa4, 0x6d, 0x18, 0x70, 0xc9, 0xab, 0x6c, 0x50, 0xcd,
-	0xe8, 0x18, 0xa2, 0x33, 0xb9, 0x68, 0x50, 0x53, 0x54, 0xb4, 0x3a, 0x7d, 0x08, 0x83, 0xc7, 0x1b,
-	0x7a, 0x43, 0x67, 0x3f, 0x05, 0xeb, 0x97, 0xdd, 0xca, 0xd7, 0x31, 0x77, 0xc7, 0xe2, 0x2a, 0xa8,
-	0xea, 0x87, 0x92, 0xaa, 0xd4, 0x25, 0x53, 0x6f, 0x17, 0x54, 0x55, 0xdf, 0x50, 0x15, 0xc3, 0x24,
-	0x0b, 0x18, 0x77, 0x39, 0x4f, 0xa3, 0x09, 0x34, 0x39, 0x36, 0x75, 0x3e, 0x6f, 0x9b, 0x92, 0xb7,
-	0x4c, 0xc9, 0xdb, 0x9e, 0x83, 0x29, 0xf9, 0x15, 0xa9, 0x41, 0xab, 0x56, 0xac, 0x86, 0x59, 0xf5,
-	0x3c, 0xc9, 0xff, 0x80, 0x30, 0x1f, 0x3e, 0x4d, 0x95, 0x1a, 0x2d, 0x2b, 0x7e, 0x72, 0x17, 0x8f,
-	0x4a, 0x4e, 0xe3, 0x69, 0x34, 0x91, 0x9d, 0x1c, 0x9b, 0x9a, 0xcb, 0x47, 0x5b, 0xc8, 0x7c, 0xaf,
-	0x2c, 0xad, 0x17, 0xea, 0xf5, 0x36, 0x35, 0x8c, 0x6a, 0x57, 0x91, 0x94, 0x7b, 0x68, 0x32, 0x8c,
-	0xe6, 0xc2, 0xbe, 0x34, 0x76, 0x6c, 0x3d, 0x38, 0x3b, 0x08, 0x9f, 0x62, 0x38, 0x01, 0x96, 0x5d,
-	0xc2, 0xc7, 0x1f, 0x38, 0xad, 0xa2, 0x64, 0x07, 0xc1, 0x9c, 0x1b, 0xad, 0x1e, 0x73, 0x3b, 0x20,
-	0x38, 0x9f, 0xbf, 0x99, 0xc4, 0xfe, 0xfe, 0x83, 0xf0, 0xb9, 0x90, 0x80, 0x5c, 0x73, 0x63, 0x05,
-	0xd6, 0xb3, 0x12, 0x99, 0x03, 0x5e, 0x89, 0x6c, 0xf2, 0x95, 0x98, 0x82, 0xf4, 0x2d, 0x53, 0xb3,
-	0x0c, 0x89, 0xbf, 0x4a, 0x4d, 0xb0, 0x88, 0x8c, 0xe3, 0x43, 0xec, 0x0d, 0x60, 0x98, 0x2f, 0x56,
-	0xed, 0x3f, 0xf8, 0x4f, 0xf1, 0x99, 0xc0, 0x67, 0xc0, 0xa7, 0x4f, 0xf0, 0x98, 0xa7, 0x19, 0x92,
-	0x7e, 0x3a, 0x2a, 0xbc, 0xe7, 0xd1, 0xe2, 0x0b, 0x8f, 0x7f, 0x3d, 0x37, 0x52, 0xf5, 0xaa, 0x79,
-	0x5f, 0xb7, 0x80, 0x78, 0xd3, 0x7a, 0xdd, 0xbe, 0x47, 0x80, 0xe8, 0x9f, 0x26, 0x0c, 0x31, 0x9b,
-	0x1e, 0x62, 0x7a, 0x6f, 0xd9, 0x29, 0x7c, 0xc2, 0x59, 0xa7, 0x12, 0xdb, 0x38, 0x01, 0x95, 0x5f,
-	0xc3, 0x27, 0xfd, 0x1d, 0x00, 0xb6, 0x8c, 0x0f, 0xdb, 0x2d, 0x60, 0x5e, 0x3e, 0x2a, 0x93, 0xfd,
-	0x14, 0xe0, 0x80, 0x06, 0x3f, 0x03, 0x2f, 0x55, 0xd9, 0xb2, 0xce, 0xda, 0xa2, 0x57, 0xdc, 0x1d,
-	0x3a, 0x30, 0xc3, 0x46, 0x9d, 0x0c, 0xdb, 0x41, 0x78, 0x22, 0xfc, 0x49, 0x88, 0xf5, 0x1e, 0x3e,
-	0xd6, 0xf6, 0xf5, 0x41, 0xd4, 0x57, 0xa3, 0x46, 0xed, 0xd7, 0x86, 0xf8, 0xfb, 0x74, 0x79, 0x05,
-	0x48, 0x0a, 0xaa, 0x1a, 0x46, 0x92, 0x56, 0xee, 0xfd, 0xe4, 0xb0, 0x07, 0xce, 0x35, 0x90, 0x3d,
-	0x7b, 0x10, 0xec, 0xe9, 0xe5, 0xe3, 0x15, 0x9c, 0x73, 0x16, 0x75, 0x15, 0xce, 0xe3, 0x92, 0x7d,
-	0x1c, 0x0f, 0xce, 0x86, 0xcf, 0x51, 0x37, 0x8f, 0xfa, 0x1e, 0x04, 0x43, 0x1a, 0xf8, 0xa8, 0xd1,
-	0xdb, 0x05, 0x4b, 0x30, 0x13, 0xd5, 0x0f, 0x9f, 0x32, 0xd8, 0xe1, 0x57, 0xe5, 0xd7, 0x01, 0xa2,
-	0xa0, 0xaa, 0x21, 0x10, 0x69, 0x25, 0xc2, 0x53, 0xd4, 0x4d, 0xba, 0x58, 0xd8, 0xd9, 0xf4, 0xb1,
-	0xd3, 0x4b, 0x82, 0x8b, 0x78, 0xd2, 0xb3, 0xf7, 0xd8, 0x35, 0x97, 0x67, 0xf7, 0xab, 0x58, 0x2b,
-	0xee, 0xec, 0x53, 0xdf, 0x22, 0xfc, 0x46, 0x84, 0xc1, 0xe0, 0xc5, 0x23, 0x84, 0x5f, 0x09, 0x1d,
-	0x05, 0xeb, 0x50, 0x88, 0xb1, 0x9f, 0x05, 0x0b, 0x81, 0x41, 0xe1, 0x33, 0xf1, 0xf3, 0xdd, 0xbd,
-	0xcb, 0xe9, 0x73, 0x4f, 0x74, 0x27, 0x47, 0x26, 0xf0, 0x98, 0x53, 0x67, 0xde, 0xa2, 0x9b, 0x2c,
-	0xb8, 0x23, 0x55, 0x6f, 0x13, 0xff, 0x35, 0xc2, 0xaf, 0x0e, 0x90, 0x01, 0xe6, 0x26, 0x3e, 0xde,
-	0xf0, 0x77, 0x02, 0xea, 0x6c, 0xdc, 0xe3, 0xc8, 0x15, 0x00, 0xc4, 0x7e, 0x65, 0xfe, 0x5e, 0x77,
-	0x6b, 0x0a, 0x45, 0x4b, 0x2b, 0xfd, 0x9f, 0x39, 0x06, 0x04, 0x4f, 0x36, 0xd8, 0x80, 0xec, 0xc1,
-	0x18, 0x90, 0xde, 0x6b, 0xf0, 0x3a, 0xd4, 0xf3, 0xcb, 0x92, 0x49, 0x0d, 0x33, 0xec, 0x05, 0xb8,
-	0x8b, 0x5f, 0x1b, 0x38, 0x0a, 0x4c, 0xb8, 0x82, 0x4f, 0xaa, 0x81, 0x23, 0xa0, 0x6e, 0x0b, 0xe9,
-	0xe5, 0x27, 0xf1, 0x79, 0x26, 0x5f, 0xa9, 0xc9, 0x25, 0xbd, 0xd9, 0xd2, 0x0d, 0xa9, 0xa6, 0xa8,
-	0x8a, 0xb9, 0x79, 0x7b, 0xa3, 0xa4, 0x6b, 0x66, 0x5b, 0x92, 0x9d, 0xc2, 0x8a, 0x5f, 0xc5, 0x17,
-	0xf6, 0x1d, 0x09, 0xc1, 0x4c, 0xe2, 0xa3, 0x32, 0xb4, 0x15, 0x7a, 0x8a, 0x64, 0x7f, 0xb3, 0x37,
-	0x9b, 0x3e, 0x92, 0x8c, 0x66, 0x45, 0x33, 0x4c, 0x49, 0x33, 0x15, 0xc9, 0xa4, 0xe9, 0x5f, 0xa0,
-	0x7e, 0x47, 0xb0, 0xef, 0x0c, 0x98, 0xcc, 0x45, 0x68, 0xf5, 0x5f, 0xa3, 0x96, 0xa3, 0x26, 0x53,
-	0x90, 0x38, 0xad, 0x3b, 0x2e, 0x95, 0xf4, 0x3a, 0xad, 0xd4, 0x21, 0xbf, 0x0e, 0xe0, 0x66, 0x35,
-	0xf5, 0xe5, 0x59, 0x7c, 0x88, 0x71, 0x92, 0x67, 0xa8, 0xa7, 0x48, 0x25, 0xc5, 0xa8, 0x04, 0xe1,
-	0xf7, 0x01, 0xae, 0x34, 0x94, 0x86, 0x1d, 0x2e, 0x5f, 0xfa, 0xec, 0xe9, 0xf3, 0x6f, 0x32, 0x37,
-	0xc8, 0x75, 0x21, 0x40, 0x4c, 0x70, 0xc5, 0x84, 0xbe, 0xcf, 0x01, 0xab, 0xd4, 0x14, 0xb6, 0x58,
-	0x49, 0xb0, 0x4d, 0x7e, 0x44, 0xf8, 0x25, 0x8f, 0x78, 0x41, 0x55, 0x63, 0x02, 0x06, 0x5e, 0x20,
-	0x62, 0x02, 0x06, 0xdf, 0x0e, 0xf8, 0xeb, 0x0c, 0xf0, 0x6d, 0x32, 0x9d, 0x00, 0x90, 0x7c, 0x87,
-	0x9c, 0x12, 0x9c, 0xdc, 0x88, 0xeb, 0x76, 0x4f, 0x95, 0xcf, 0xbd, 0x9b, 0xf4, 0x71, 0xc0, 0xb8,
-	0xc2, 0x30, 0xde, 0x22, 0xf9, 0xa8, 0x18, 0xf6, 0xd7, 0x19, 0xf2, 0x37, 0xc2, 0xc7, 0xaa, 0x7d,
-	0x45, 0x64, 0xdc, 0x60, 0x42, 0xca, 0x6c, 0x6e, 0x71, 0x78, 0x21, 0xe0, 0x5b, 0x64, 0x7c, 0x45,
-	0x72, 0x33, 0x2a, 0x9f, 0xbf, 0x32, 0x76, 0x93, 0xf1, 0x4f, 0x84, 0x5f, 0xf6, 0x4f, 0x63, 0x65,
-	0x64, 0x39, 0x6e, 0x36, 0xa5, 0x03, 0x3d, 0xe0, 0xe2, 0xc0, 0xdf, 0x64, 0xd0, 0xd7, 0xc8, 0xd5,
-	0xa4, 0xd0, 0xe4, 0x2f, 0x84, 0x8f, 0xfa, 0x8a, 0x46, 0xb2, 0x10, 0x77, 0x51, 0x82, 0x4b, 0x67,
-	0xae, 0x3c, 0xb4, 0x0e, 0x60, 0x96, 0x19, 0x66, 0x81, 0xcc, 0x45, 0xc5, 0xf4, 0xd5, 0xbb, 0xee,
-	0xd2, 0xfe, 0x81, 0x30, 0xf1, 0x4d, 0x62, 0xad, 0xec, 0x42, 0xdc, 0x05, 0x49, 0x05, 0x38, 0xfc,
-	0x22, 0xc0, 0xcf, 0x31, 0xe0, 0x59, 0x32, 0x93, 0x10, 0x98, 0xec, 0x64, 0x06, 0x54, 0xcf, 0x64,
-	0x25, 0xc1, 0x5e, 0x32, 0xb0, 0xb6, 0xe7, 0xee, 0xa4, 0xa8, 0x08, 0x1e, 0x2c, 0x33, 0x0f, 0x16,
-	0xc8, 0x7c, 0x8c, 0x0d, 0x2b, 0xf4, 0xa3, 0x2f, 0xf9, 0x17, 0xe1, 0xe3, 0x7d, 0x95, 0x21, 0x59,
-	0x4c, 0x7a, 0x02, 0xfa, 0xeb, 0x64, 0xae, 0x92, 0x82, 0x12, 0x80, 0xaf, 0x30, 0xf0, 0x25, 0xb2,
-	0x18, 0xf7, 0xc0, 0x11, 0xdd, 0xef, 0x96, 0xc2, 0x96, 0xe7, 0xf2, 0xb1, 0x6d, 0xed, 0xe1, 0xe3,
-	0x7d, 0xf3, 0x59, 0x89, 0xbf, 0x98, 0xf4, 0x80, 0x1c, 0x92, 0x7f, 0xd0, 0x25, 0x80, 0x2f, 0x32,
-	0xfe, 0x77, 0xc8, 0xb5, 0xe4, 0xfc, 0xe4, 0x3f, 0x84, 0x4f, 0x06, 0x97, 0xd9, 0x64, 0x29, 0x56,
-	0xa4, 0x03, 0x2b, 0x7a, 0xee, 0x56, 0x2a, 0x5a, 0xc0, 0x5d, 0x61, 0xdc, 0x25, 0x52, 0x88, 0xca,
-	0x6d, 0xdf, 0x03, 0x82, 0xb2, 0xfd, 0x17, 0x84, 0x8f, 0xb8, 0x85, 0x70, 0xa2, 0x6a, 0xaa, 0xff,
-	0xcb, 0x39, 0xb7, 0x34, 0xbc, 0x86, 0xcb, 0x3a, 0xcb, 0x58, 0xa7, 0xc9, 0xe5, 0xa8, 0xac, 0xdd,
-	0xe2, 0xfa, 0x39, 0xc2, 0xa3, 0xdd, 0x1b, 0xc5, 0x5c, 0xac, 0xa0, 0x02, 0xa8, 0xca, 0x43, 0x0a,
-	0xb8, 0x48, 0xb7, 0x19, 0x52, 0x99, 0xbc, 0x17, 0x1b, 0x49, 0xd8, 0xea, 0xfb, 0x4f, 0xc4, 0x36,
-	0xf9, 0x22, 0x83, 0xb9, 0xf0, 0xfb, 0x19, 0x79, 0x3f, 0x56, 0xd8, 0xfb, 0x5e, 0x09, 0xb9, 0x0f,
-	0x52, 0xd3, 0x4b, 0x6a, 0x87, 0x52, 0x93, 0x45, 0xd9, 0x2b, 0x2a, 0x36, 0x37, 0x44, 0xe7, 0x92,
-	0x49, 0x1e, 0x65, 0xf0, 0x99, 0xb0, 0x9b, 0x5e, 0xa2, 0x9d, 0x2c, 0x4c, 0x8c, 0x5b, 0x49, 0x4b,
-	0xc9, 0xb5, 0x62, 0x89, 0x59, 0x31, 0x4f, 0x8a, 0x51, 0xad, 0xd8, 0x90, 0x8c, 0xa6, 0xa8, 0x74,
-	0x25, 0x45, 0x37, 0x55, 0x8a, 0xab, 0x8f, 0x77, 0x73, 0xe8, 0xc9, 0x6e, 0x0e, 0xfd, 0xb6, 0x9b,
-	0x43, 0x5f, 0xed, 0xe5, 0x46, 0x9e, 0xec, 0xe5, 0x46, 0x7e, 0xde, 0xcb, 0x8d, 0x7c, 0x3c, 0xdb,
-	0x50, 0xcc, 0xf5, 0x4e, 0x2d, 0x2f, 0xeb, 0x4d, 0x57, 0xe9, 0xcd, 0xc0, 0x79, 0x1e, 0x76, 0x67,
-	0x32, 0x37, 0x5b, 0xd4, 0xa8, 0x1d, 0x66, 0xff, 0xfc, 0x9c, 0xfe, 0x3f, 0x00, 0x00, 0xff, 0xff,
-	0x1d, 0xa1, 0x1f, 0x18, 0x3c, 0x1e, 0x00, 0x00,
+func (m *QueryGetRegisteredEmitterRequest) Reset()         { *m = QueryGetRegisteredEmitterRequest{} }
+func (m *QueryGetRegisteredEmitterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRegisteredEmitterRequest) ProtoMessage()    {}
+func (*QueryGetRegisteredEmitterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{37}
+}
+func (m *QueryGetRegisteredEmitterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRegisteredEmitterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRegisteredEmitterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetRegisteredEmitterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRegisteredEmitterRequest.Merge(m, src)
+}
+func (m *QueryGetRegisteredEmitterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRegisteredEmitterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRegisteredEmitterRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryGetRegisteredEmitterRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Queries a guardianSet by index.
-	GuardianSet(ctx context.Context, in *QueryGetGuardianSetRequest, opts ...grpc.CallOption) (*QueryGetGuardianSetResponse, error)
-	// Queries a list of guardianSet items.
-	GuardianSetAll(ctx context.Context, in *QueryAllGuardianSetRequest, opts ...grpc.CallOption) (*QueryAllGuardianSetResponse, error)
-	// Queries a config by index.
-	Config(ctx context.Context, in *QueryGetConfigRequest, opts ...grpc.CallOption) (*QueryGetConfigResponse, error)
-	// Queries a replayProtection by index.
-	ReplayProtection(ctx context.Context, in *QueryGetReplayProtectionRequest, opts ...grpc.CallOption) (*QueryGetReplayProtectionResponse, error)
-	// Queries a list of replayProtection items.
-	ReplayProtectionAll(ctx context.Context, in *QueryAllReplayProtectionRequest, opts ...grpc.CallOption) (*QueryAllReplayProtectionResponse, error)
-	// Queries a sequenceCounter by index.
-	SequenceCounter(ctx context.Context, in *QueryGetSequenceCounterRequest, opts ...grpc.CallOption) (*QueryGetSequenceCounterResponse, error)
-	// Queries a list of sequenceCounter items.
-	SequenceCounterAll(ctx context.Context, in *QueryAllSequenceCounterRequest, opts ...grpc.CallOption) (*QueryAllSequenceCounterResponse, error)
-	// Queries a ConsensusGuardianSetIndex by index.
-	ConsensusGuardianSetIndex(ctx context.Context, in *QueryGetConsensusGuardianSetIndexRequest, opts ...grpc.CallOption) (*QueryGetConsensusGuardianSetIndexResponse, error)
-	// Queries a GuardianValidator by index.
-	GuardianValidator(ctx context.Context, in *QueryGetGuardianValidatorRequest, opts ...grpc.CallOption) (*QueryGetGuardianValidatorResponse, error)
-	// Queries a list of GuardianValidator items.
-	GuardianValidatorAll(ctx context.Context, in *QueryAllGuardianValidatorRequest, opts ...grpc.CallOption) (*QueryAllGuardianValidatorResponse, error)
-	// Queries a list of LatestGuardianSetIndex items.
-	LatestGuardianSetIndex(ctx context.Context, in *QueryLatestGuardianSetIndexRequest, opts ...grpc.CallOption) (*QueryLatestGuardianSetIndexResponse, error)
-	AllowlistAll(ctx context.Context, in *QueryAllValidatorAllowlist, opts ...grpc.CallOption) (*QueryAllValidatorAllowlistResponse, error)
-	Allowlist(ctx context.Context, in *QueryValidatorAllowlist, opts ...grpc.CallOption) (*QueryValidatorAllowlistResponse, error)
-	IbcComposabilityMwContract(ctx context.Context, in *QueryIbcComposabilityMwContractRequest, opts ...grpc.CallOption) (*QueryIbcComposabilityMwContractResponse, error)
-	WasmInstantiateAllowlistAll(ctx context.Context, in *QueryAllWasmInstantiateAllowlist, opts ...grpc.CallOption) (*QueryAllWasmInstantiateAllowlistResponse, error)
+func (m *QueryGetRegisteredEmitterRequest) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryGetRegisteredEmitterRequest) GetChainId() uint32 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+type QueryGetRegisteredEmitterResponse struct {
+	RegisteredEmitter RegisteredEmitter `protobuf:"bytes,1,opt,name=registeredEmitter,proto3" json:"registeredEmitter"`
 }
 
-func (c *queryClient) GuardianSet(ctx context.Context, in *QueryGetGuardianSetRequest, opts ...grpc.CallOption) (*QueryGetGuardianSetResponse, error) {
-	out := new(QueryGetGuardianSetResponse)
-	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/GuardianSet", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetRegisteredEmitterResponse) Reset()         { *m = QueryGetRegisteredEmitterResponse{} }
+func (m *QueryGetRegisteredEmitterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRegisteredEmitterResponse) ProtoMessage()    {}
+func (*QueryGetRegisteredEmitterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{38}
+}
+func (m *QueryGetRegisteredEmitterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRegisteredEmitterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRegisteredEmitterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetRegisteredEmitterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRegisteredEmitterResponse.Merge(m, src)
+}
+func (m *QueryGetRegisteredEmitterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRegisteredEmitterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRegisteredEmitterResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GuardianSetAll(ctx context.Context, in *QueryAllGuardianSetRequest, opts ...grpc.CallOption) (*QueryAllGuardianSetResponse, error) {
-	out := new(QueryAllGuardianSetResponse)
-	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/GuardianSetAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetRegisteredEmitterResponse proto.InternalMessageInfo
+
+func (m *QueryGetRegisteredEmitterResponse) GetRegisteredEmitter() RegisteredEmitter {
+	if m != nil {
+		return m.RegisteredEmitter
 	}
-	return out, nil
+	return RegisteredEmitter{}
 }
 
-func (c *queryClient) Config(ctx context.Context, in *QueryGetConfigRequest, opts ...grpc.CallOption) (*QueryGetConfigResponse, error) {
-	out := new(QueryGetConfigResponse)
-	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/Config", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryAllRegisteredEmitterRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) ReplayProtection(ctx context.Context, in *QueryGetReplayProtectionRequest, opts ...grpc.CallOption) (*QueryGetReplayProtectionResponse, error) {
-	out := new(QueryGetReplayProtectionResponse)
-	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/ReplayProtection", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllRegisteredEmitterRequest) Reset()         { *m = QueryAllRegisteredEmitterRequest{} }
+func (m *QueryAllRegisteredEmitterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRegisteredEmitterRequest) ProtoMessage()    {}
+func (*QueryAllRegisteredEmitterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{39}
+}
+func (m *QueryAllRegisteredEmitterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRegisteredEmitterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRegisteredEmitterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllRegisteredEmitterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRegisteredEmitterRequest.Merge(m, src)
+}
+func (m *QueryAllRegisteredEmitterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRegisteredEmitterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRegisteredEmitterRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ReplayProtectionAll(ctx context.Context, in *QueryAllReplayProtectionRequest, opts ...grpc.CallOption) (*QueryAllReplayProtectionResponse, error) {
-	out := new(QueryAllReplayProtectionResponse)
-	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/ReplayProtectionAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllRegisteredEmitterRequest proto.InternalMessageInfo
+
+func (m *QueryAllRegisteredEmitterRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) SequenceCounter(ctx context.Context, in *QueryGetSequenceCounterRequest, opts ...grpc.CallOption) (*QueryGetSequenceCounterResponse, error) {
-	out := new(QueryGetSequenceCounterResponse)
-	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/SequenceCounter", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryAllRegisteredEmitterResponse struct {
+	RegisteredEmitter []RegisteredEmitter `protobuf:"bytes,1,rep,name=registeredEmitter,proto3" json:"registeredEmitter"`
+	Pagination        *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) SequenceCounterAll(ctx context.Context, in *QueryAllSequenceCounterRequest, opts ...grpc.CallOption) (*QueryAllSequenceCounterResponse, error) {
-	out := new(QueryAllSequenceCounterResponse)
-	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/SequenceCounterAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllRegisteredEmitterResponse) Reset()         { *m = QueryAllRegisteredEmitterResponse{} }
+func (m *QueryAllRegisteredEmitterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRegisteredEmitterResponse) ProtoMessage()    {}
+func (*QueryAllRegisteredEmitterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{40}
+}
+func (m *QueryAllRegisteredEmitterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRegisteredEmitterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRegisteredEmitterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllRegisteredEmitterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRegisteredEmitterResponse.Merge(m, src)
+}
+func (m *QueryAllRegisteredEmitterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRegisteredEmitterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRegisteredEmitterResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ConsensusGuardianSetIndex(ctx context.Context, in *QueryGetConsensusGuardianSetIndexRequest, opts ...grpc.CallOption) (*QueryGetConsensusGuardianSetIndexResponse, error) {
-	out := new(QueryGetConsensusGuardianSetIndexResponse)
-	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/ConsensusGuardianSetIndex", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllRegisteredEmitterResponse proto.InternalMessageInfo
+
+func (m *QueryAllRegisteredEmitterResponse) GetRegisteredEmitter() []RegisteredEmitter {
+	if m != nil {
+		return m.RegisteredEmitter
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GuardianValidator(ctx context.Context, in *QueryGetGuardianValidatorRequest, opts ...grpc.CallOption) (*QueryGetGuardianValidatorResponse, error) {
-	out := new(QueryGetGuardianValidatorResponse)
-	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/GuardianValidator", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllRegisteredEmitterResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GuardianValidatorAll(ctx context.Context, in *QueryAllGuardianValidatorRequest, opts ...grpc.CallOption) (*QueryAllGuardianValidatorResponse, error) {
-	out := new(QueryAllGuardianValidatorResponse)
-	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/GuardianValidatorAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryGetGovernanceSubmitterRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (c *queryClient) LatestGuardianSetIndex(ctx context.Context, in *QueryLatestGuardianSetIndexRequest, opts ...grpc.CallOption) (*QueryLatestGuardianSetIndexResponse, error) {
-	out := new(QueryLatestGuardianSetIndexResponse)
-	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/LatestGuardianSetIndex", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetGovernanceSubmitterRequest) Reset()         { *m = QueryGetGovernanceSubmitterRequest{} }
+func (m *QueryGetGovernanceSubmitterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetGovernanceSubmitterRequest) ProtoMessage()    {}
+func (*QueryGetGovernanceSubmitterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{41}
+}
+func (m *QueryGetGovernanceSubmitterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetGovernanceSubmitterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetGovernanceSubmitterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetGovernanceSubmitterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetGovernanceSubmitterRequest.Merge(m, src)
+}
+func (m *QueryGetGovernanceSubmitterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetGovernanceSubmitterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetGovernanceSubmitterRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) AllowlistAll(ctx context.Context, in *QueryAllValidatorAllowlist, opts ...grpc.CallOption) (*QueryAllValidatorAllowlistResponse, error) {
-	out := new(QueryAllValidatorAllowlistResponse)
-	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/AllowlistAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetGovernanceSubmitterRequest proto.InternalMessageInfo
+
+func (m *QueryGetGovernanceSubmitterRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Allowlist(ctx context.Context, in *QueryValidatorAllowlist, opts ...grpc.CallOption) (*QueryValidatorAllowlistResponse, error) {
-	out := new(QueryValidatorAllowlistResponse)
-	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/Allowlist", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryGetGovernanceSubmitterResponse struct {
+	GovernanceSubmitter GovernanceSubmitter `protobuf:"bytes,1,opt,name=governanceSubmitter,proto3" json:"governanceSubmitter"`
 }
 
-func (c *queryClient) IbcComposabilityMwContract(ctx context.Context, in *QueryIbcComposabilityMwContractRequest, opts ...grpc.CallOption) (*QueryIbcComposabilityMwContractResponse, error) {
-	out := new(QueryIbcComposabilityMwContractResponse)
-	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/IbcComposabilityMwContract", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetGovernanceSubmitterResponse) Reset()         { *m = QueryGetGovernanceSubmitterResponse{} }
+func (m *QueryGetGovernanceSubmitterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetGovernanceSubmitterResponse) ProtoMessage()    {}
+func (*QueryGetGovernanceSubmitterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{42}
+}
+func (m *QueryGetGovernanceSubmitterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetGovernanceSubmitterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetGovernanceSubmitterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetGovernanceSubmitterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetGovernanceSubmitterResponse.Merge(m, src)
+}
+func (m *QueryGetGovernanceSubmitterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetGovernanceSubmitterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetGovernanceSubmitterResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) WasmInstantiateAllowlistAll(ctx context.Context, in *QueryAllWasmInstantiateAllowlist, opts ...grpc.CallOption) (*QueryAllWasmInstantiateAllowlistResponse, error) {
-	out := new(QueryAllWasmInstantiateAllowlistResponse)
-	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/WasmInstantiateAllowlistAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetGovernanceSubmitterResponse proto.InternalMessageInfo
+
+func (m *QueryGetGovernanceSubmitterResponse) GetGovernanceSubmitter() GovernanceSubmitter {
+	if m != nil {
+		return m.GovernanceSubmitter
 	}
-	return out, nil
+	return GovernanceSubmitter{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Queries a guardianSet by index.
-	GuardianSet(context.Context, *QueryGetGuardianSetRequest) (*QueryGetGuardianSetResponse, error)
-	// Queries a list of guardianSet items.
-	GuardianSetAll(context.Context, *QueryAllGuardianSetRequest) (*QueryAllGuardianSetResponse, error)
-	// Queries a config by index.
-	Config(context.Context, *QueryGetConfigRequest) (*QueryGetConfigResponse, error)
-	// Queries a replayProtection by index.
-	ReplayProtection(context.Context, *QueryGetReplayProtectionRequest) (*QueryGetReplayProtectionResponse, error)
-	// Queries a list of replayProtection items.
-	ReplayProtectionAll(context.Context, *QueryAllReplayProtectionRequest) (*QueryAllReplayProtectionResponse, error)
-	// Queries a sequenceCounter by index.
-	SequenceCounter(context.Context, *QueryGetSequenceCounterRequest) (*QueryGetSequenceCounterResponse, error)
-	// Queries a list of sequenceCounter items.
-	SequenceCounterAll(context.Context, *QueryAllSequenceCounterRequest) (*QueryAllSequenceCounterResponse, error)
-	// Queries a ConsensusGuardianSetIndex by index.
-	ConsensusGuardianSetIndex(context.Context, *QueryGetConsensusGuardianSetIndexRequest) (*QueryGetConsensusGuardianSetIndexResponse, error)
-	// Queries a GuardianValidator by index.
-	GuardianValidator(context.Context, *QueryGetGuardianValidatorRequest) (*QueryGetGuardianValidatorResponse, error)
-	// Queries a list of GuardianValidator items.
-	GuardianValidatorAll(context.Context, *QueryAllGuardianValidatorRequest) (*QueryAllGuardianValidatorResponse, error)
-	// Queries a list of LatestGuardianSetIndex items.
-	LatestGuardianSetIndex(context.Context, *QueryLatestGuardianSetIndexRequest) (*QueryLatestGuardianSetIndexResponse, error)
-	AllowlistAll(context.Context, *QueryAllValidatorAllowlist) (*QueryAllValidatorAllowlistResponse, error)
-	Allowlist(context.Context, *QueryValidatorAllowlist) (*QueryValidatorAllowlistResponse, error)
-	IbcComposabilityMwContract(context.Context, *QueryIbcComposabilityMwContractRequest) (*QueryIbcComposabilityMwContractResponse, error)
-	WasmInstantiateAllowlistAll(context.Context, *QueryAllWasmInstantiateAllowlist) (*QueryAllWasmInstantiateAllowlistResponse, error)
+type QueryAllGovernanceSubmitterRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryAllGovernanceSubmitterRequest) Reset()         { *m = QueryAllGovernanceSubmitterRequest{} }
+func (m *QueryAllGovernanceSubmitterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllGovernanceSubmitterRequest) ProtoMessage()    {}
+func (*QueryAllGovernanceSubmitterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{43}
 }
-
-func (*UnimplementedQueryServer) GuardianSet(ctx context.Context, req *QueryGetGuardianSetRequest) (*QueryGetGuardianSetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GuardianSet not implemented")
+func (m *QueryAllGovernanceSubmitterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GuardianSetAll(ctx context.Context, req *QueryAllGuardianSetRequest) (*QueryAllGuardianSetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GuardianSetAll not implemented")
+func (m *QueryAllGovernanceSubmitterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllGovernanceSubmitterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Config(ctx context.Context, req *QueryGetConfigRequest) (*QueryGetConfigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Config not implemented")
+func (m *QueryAllGovernanceSubmitterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllGovernanceSubmitterRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ReplayProtection(ctx context.Context, req *QueryGetReplayProtectionRequest) (*QueryGetReplayProtectionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReplayProtection not implemented")
+func (m *QueryAllGovernanceSubmitterRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ReplayProtectionAll(ctx context.Context, req *QueryAllReplayProtectionRequest) (*QueryAllReplayProtectionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReplayProtectionAll not implemented")
+func (m *QueryAllGovernanceSubmitterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllGovernanceSubmitterRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) SequenceCounter(ctx context.Context, req *QueryGetSequenceCounterRequest) (*QueryGetSequenceCounterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SequenceCounter not implemented")
+
+var xxx_messageInfo_QueryAllGovernanceSubmitterRequest proto.InternalMessageInfo
+
+func (m *QueryAllGovernanceSubmitterRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) SequenceCounterAll(ctx context.Context, req *QueryAllSequenceCounterRequest) (*QueryAllSequenceCounterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SequenceCounterAll not implemented")
+
+type QueryAllGovernanceSubmitterResponse struct {
+	GovernanceSubmitter []GovernanceSubmitter `protobuf:"bytes,1,rep,name=governanceSubmitter,proto3" json:"governanceSubmitter"`
+	Pagination          *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) ConsensusGuardianSetIndex(ctx context.Context, req *QueryGetConsensusGuardianSetIndexRequest) (*QueryGetConsensusGuardianSetIndexResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConsensusGuardianSetIndex not implemented")
+
+func (m *QueryAllGovernanceSubmitterResponse) Reset()         { *m = QueryAllGovernanceSubmitterResponse{} }
+func (m *QueryAllGovernanceSubmitterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllGovernanceSubmitterResponse) ProtoMessage()    {}
+func (*QueryAllGovernanceSubmitterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{44}
 }
-func (*UnimplementedQueryServer) GuardianValidator(ctx context.Context, req *QueryGetGuardianValidatorRequest) (*QueryGetGuardianValidatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GuardianValidator not implemented")
+func (m *QueryAllGovernanceSubmitterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GuardianValidatorAll(ctx context.Context, req *QueryAllGuardianValidatorRequest) (*QueryAllGuardianValidatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GuardianValidatorAll not implemented")
+func (m *QueryAllGovernanceSubmitterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllGovernanceSubmitterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) LatestGuardianSetIndex(ctx context.Context, req *QueryLatestGuardianSetIndexRequest) (*QueryLatestGuardianSetIndexResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LatestGuardianSetIndex not implemented")
+func (m *QueryAllGovernanceSubmitterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllGovernanceSubmitterResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) AllowlistAll(ctx context.Context, req *QueryAllValidatorAllowlist) (*QueryAllValidatorAllowlistResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllowlistAll not implemented")
+func (m *QueryAllGovernanceSubmitterResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Allowlist(ctx context.Context, req *QueryValidatorAllowlist) (*QueryValidatorAllowlistResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Allowlist not implemented")
+func (m *QueryAllGovernanceSubmitterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllGovernanceSubmitterResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) IbcComposabilityMwContract(ctx context.Context, req *QueryIbcComposabilityMwContractRequest) (*QueryIbcComposabilityMwContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IbcComposabilityMwContract not implemented")
+
+var xxx_messageInfo_QueryAllGovernanceSubmitterResponse proto.InternalMessageInfo
+
+func (m *QueryAllGovernanceSubmitterResponse) GetGovernanceSubmitter() []GovernanceSubmitter {
+	if m != nil {
+		return m.GovernanceSubmitter
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) WasmInstantiateAllowlistAll(ctx context.Context, req *QueryAllWasmInstantiateAllowlist) (*QueryAllWasmInstantiateAllowlistResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WasmInstantiateAllowlistAll not implemented")
+
+func (m *QueryAllGovernanceSubmitterResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+type QueryVerifyVAARequest struct {
+	Vaa []byte `protobuf:"bytes,1,opt,name=vaa,proto3" json:"vaa,omitempty"`
 }
 
-func _Query_GuardianSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetGuardianSetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GuardianSet(ctx, in)
+func (m *QueryVerifyVAARequest) Reset()         { *m = QueryVerifyVAARequest{} }
+func (m *QueryVerifyVAARequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyVAARequest) ProtoMessage()    {}
+func (*QueryVerifyVAARequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{45}
+}
+func (m *QueryVerifyVAARequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyVAARequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyVAARequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/GuardianSet",
+}
+func (m *QueryVerifyVAARequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyVAARequest.Merge(m, src)
+}
+func (m *QueryVerifyVAARequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyVAARequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyVAARequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyVAARequest proto.InternalMessageInfo
+
+func (m *QueryVerifyVAARequest) GetVaa() []byte {
+	if m != nil {
+		return m.Vaa
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GuardianSet(ctx, req.(*QueryGetGuardianSetRequest))
+	return nil
+}
+
+type QueryVerifyVAAResponse struct {
+	// whether the VAA is signed by a quorum of a valid guardian set
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// why the VAA is not valid
+	Error            string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Digest           []byte `protobuf:"bytes,3,opt,name=digest,proto3" json:"digest,omitempty"`
+	Version          uint32 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	GuardianSetIndex uint32 `protobuf:"varint,5,opt,name=guardian_set_index,json=guardianSetIndex,proto3" json:"guardian_set_index,omitempty"`
+	NumSignatures    uint32 `protobuf:"varint,6,opt,name=num_signatures,json=numSignatures,proto3" json:"num_signatures,omitempty"`
+	Timestamp        uint32 `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Nonce            uint32 `protobuf:"varint,8,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	EmitterChain     uint32 `protobuf:"varint,9,opt,name=emitter_chain,json=emitterChain,proto3" json:"emitter_chain,omitempty"`
+	EmitterAddress   []byte `protobuf:"bytes,10,opt,name=emitter_address,json=emitterAddress,proto3" json:"emitter_address,omitempty"`
+	Sequence         uint64 `protobuf:"varint,11,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	ConsistencyLevel uint32 `protobuf:"varint,12,opt,name=consistency_level,json=consistencyLevel,proto3" json:"consistency_level,omitempty"`
+	Payload          []byte `protobuf:"bytes,13,opt,name=payload,proto3" json:"payload,omitempty"`
+	// ABCI codespace and code of the error, 0 if the VAA is valid
+	Codespace string `protobuf:"bytes,14,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	Code      uint32 `protobuf:"varint,15,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (m *QueryVerifyVAAResponse) Reset()         { *m = QueryVerifyVAAResponse{} }
+func (m *QueryVerifyVAAResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyVAAResponse) ProtoMessage()    {}
+func (*QueryVerifyVAAResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{46}
+}
+func (m *QueryVerifyVAAResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyVAAResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyVAAResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryVerifyVAAResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyVAAResponse.Merge(m, src)
+}
+func (m *QueryVerifyVAAResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyVAAResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyVAAResponse.DiscardUnknown(m)
 }
 
-func _Query_GuardianSetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllGuardianSetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVerifyVAAResponse proto.InternalMessageInfo
+
+func (m *QueryVerifyVAAResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GuardianSetAll(ctx, in)
+	return false
+}
+
+func (m *QueryVerifyVAAResponse) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/GuardianSetAll",
+	return ""
+}
+
+func (m *QueryVerifyVAAResponse) GetDigest() []byte {
+	if m != nil {
+		return m.Digest
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GuardianSetAll(ctx, req.(*QueryAllGuardianSetRequest))
+	return nil
+}
+
+func (m *QueryVerifyVAAResponse) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Config_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetConfigRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryVerifyVAAResponse) GetGuardianSetIndex() uint32 {
+	if m != nil {
+		return m.GuardianSetIndex
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Config(ctx, in)
+	return 0
+}
+
+func (m *QueryVerifyVAAResponse) GetNumSignatures() uint32 {
+	if m != nil {
+		return m.NumSignatures
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/Config",
+	return 0
+}
+
+func (m *QueryVerifyVAAResponse) GetTimestamp() uint32 {
+	if m != nil {
+		return m.Timestamp
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Config(ctx, req.(*QueryGetConfigRequest))
+	return 0
+}
+
+func (m *QueryVerifyVAAResponse) GetNonce() uint32 {
+	if m != nil {
+		return m.Nonce
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_ReplayProtection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetReplayProtectionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryVerifyVAAResponse) GetEmitterChain() uint32 {
+	if m != nil {
+		return m.EmitterChain
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ReplayProtection(ctx, in)
+	return 0
+}
+
+func (m *QueryVerifyVAAResponse) GetEmitterAddress() []byte {
+	if m != nil {
+		return m.EmitterAddress
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/ReplayProtection",
+	return nil
+}
+
+func (m *QueryVerifyVAAResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ReplayProtection(ctx, req.(*QueryGetReplayProtectionRequest))
+	return 0
+}
+
+func (m *QueryVerifyVAAResponse) GetConsistencyLevel() uint32 {
+	if m != nil {
+		return m.ConsistencyLevel
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_ReplayProtectionAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllReplayProtectionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ReplayProtectionAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/ReplayProtectionAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ReplayProtectionAll(ctx, req.(*QueryAllReplayProtectionRequest))
+func (m *QueryVerifyVAAResponse) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_SequenceCounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetSequenceCounterRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SequenceCounter(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/SequenceCounter",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SequenceCounter(ctx, req.(*QueryGetSequenceCounterRequest))
+func (m *QueryVerifyVAAResponse) GetCodespace() string {
+	if m != nil {
+		return m.Codespace
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_SequenceCounterAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllSequenceCounterRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SequenceCounterAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/SequenceCounterAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SequenceCounterAll(ctx, req.(*QueryAllSequenceCounterRequest))
+func (m *QueryVerifyVAAResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_ConsensusGuardianSetIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetConsensusGuardianSetIndexRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ConsensusGuardianSetIndex(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/ConsensusGuardianSetIndex",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ConsensusGuardianSetIndex(ctx, req.(*QueryGetConsensusGuardianSetIndexRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryAllArchivedVAARequest struct {
+	// only return VAAs from this chain, 0 returns VAAs from all chains
+	EmitterChain uint32 `protobuf:"varint,1,opt,name=emitter_chain,json=emitterChain,proto3" json:"emitter_chain,omitempty"`
+	// only return VAAs from this emitter, requires emitter_chain
+	EmitterAddress []byte `protobuf:"bytes,2,opt,name=emitter_address,json=emitterAddress,proto3" json:"emitter_address,omitempty"`
+	// only return VAAs with sequence_start <= sequence <= sequence_end,
+	// requires emitter_address. sequence_end 0 means no upper bound.
+	SequenceStart uint64             `protobuf:"varint,3,opt,name=sequence_start,json=sequenceStart,proto3" json:"sequence_start,omitempty"`
+	SequenceEnd   uint64             `protobuf:"varint,4,opt,name=sequence_end,json=sequenceEnd,proto3" json:"sequence_end,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_GuardianValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetGuardianValidatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GuardianValidator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/GuardianValidator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GuardianValidator(ctx, req.(*QueryGetGuardianValidatorRequest))
+func (m *QueryAllArchivedVAARequest) Reset()         { *m = QueryAllArchivedVAARequest{} }
+func (m *QueryAllArchivedVAARequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllArchivedVAARequest) ProtoMessage()    {}
+func (*QueryAllArchivedVAARequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{47}
+}
+func (m *QueryAllArchivedVAARequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllArchivedVAARequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllArchivedVAARequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAllArchivedVAARequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllArchivedVAARequest.Merge(m, src)
+}
+func (m *QueryAllArchivedVAARequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllArchivedVAARequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllArchivedVAARequest.DiscardUnknown(m)
 }
 
-func _Query_GuardianValidatorAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllGuardianValidatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GuardianValidatorAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/GuardianValidatorAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GuardianValidatorAll(ctx, req.(*QueryAllGuardianValidatorRequest))
+var xxx_messageInfo_QueryAllArchivedVAARequest proto.InternalMessageInfo
+
+func (m *QueryAllArchivedVAARequest) GetEmitterChain() uint32 {
+	if m != nil {
+		return m.EmitterChain
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_LatestGuardianSetIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLatestGuardianSetIndexRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LatestGuardianSetIndex(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/LatestGuardianSetIndex",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LatestGuardianSetIndex(ctx, req.(*QueryLatestGuardianSetIndexRequest))
+func (m *QueryAllArchivedVAARequest) GetEmitterAddress() []byte {
+	if m != nil {
+		return m.EmitterAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_AllowlistAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllValidatorAllowlist)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllowlistAll(ctx, in)
+func (m *QueryAllArchivedVAARequest) GetSequenceStart() uint64 {
+	if m != nil {
+		return m.SequenceStart
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/AllowlistAll",
+	return 0
+}
+
+func (m *QueryAllArchivedVAARequest) GetSequenceEnd() uint64 {
+	if m != nil {
+		return m.SequenceEnd
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllowlistAll(ctx, req.(*QueryAllValidatorAllowlist))
+	return 0
+}
+
+func (m *QueryAllArchivedVAARequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Allowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorAllowlist)
-	if err := dec(in); err != nil {
-		return nil, err
+type QueryAllArchivedVAAResponse struct {
+	ArchivedVaa []ArchivedVAA       `protobuf:"bytes,1,rep,name=archivedVaa,proto3" json:"archivedVaa"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllArchivedVAAResponse) Reset()         { *m = QueryAllArchivedVAAResponse{} }
+func (m *QueryAllArchivedVAAResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllArchivedVAAResponse) ProtoMessage()    {}
+func (*QueryAllArchivedVAAResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{48}
+}
+func (m *QueryAllArchivedVAAResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllArchivedVAAResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllArchivedVAAResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Allowlist(ctx, in)
+}
+func (m *QueryAllArchivedVAAResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllArchivedVAAResponse.Merge(m, src)
+}
+func (m *QueryAllArchivedVAAResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllArchivedVAAResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllArchivedVAAResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllArchivedVAAResponse proto.InternalMessageInfo
+
+func (m *QueryAllArchivedVAAResponse) GetArchivedVaa() []ArchivedVAA {
+	if m != nil {
+		return m.ArchivedVaa
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/Allowlist",
+	return nil
+}
+
+func (m *QueryAllArchivedVAAResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Allowlist(ctx, req.(*QueryValidatorAllowlist))
+	return nil
+}
+
+type QueryGetObservationTallyRequest struct {
+	Digest []byte `protobuf:"bytes,1,opt,name=digest,proto3" json:"digest,omitempty"`
+}
+
+func (m *QueryGetObservationTallyRequest) Reset()         { *m = QueryGetObservationTallyRequest{} }
+func (m *QueryGetObservationTallyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetObservationTallyRequest) ProtoMessage()    {}
+func (*QueryGetObservationTallyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{49}
+}
+func (m *QueryGetObservationTallyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetObservationTallyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetObservationTallyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetObservationTallyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetObservationTallyRequest.Merge(m, src)
+}
+func (m *QueryGetObservationTallyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetObservationTallyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetObservationTallyRequest.DiscardUnknown(m)
 }
 
-func _Query_IbcComposabilityMwContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIbcComposabilityMwContractRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetObservationTallyRequest proto.InternalMessageInfo
+
+func (m *QueryGetObservationTallyRequest) GetDigest() []byte {
+	if m != nil {
+		return m.Digest
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).IbcComposabilityMwContract(ctx, in)
+	return nil
+}
+
+type QueryGetObservationTallyResponse struct {
+	ObservationTally ObservationTally `protobuf:"bytes,1,opt,name=observationTally,proto3" json:"observationTally"`
+	// weight needed to finalize the observation
+	QuorumWeight uint64 `protobuf:"varint,2,opt,name=quorum_weight,json=quorumWeight,proto3" json:"quorum_weight,omitempty"`
+}
+
+func (m *QueryGetObservationTallyResponse) Reset()         { *m = QueryGetObservationTallyResponse{} }
+func (m *QueryGetObservationTallyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetObservationTallyResponse) ProtoMessage()    {}
+func (*QueryGetObservationTallyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{50}
+}
+func (m *QueryGetObservationTallyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetObservationTallyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetObservationTallyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/IbcComposabilityMwContract",
+}
+func (m *QueryGetObservationTallyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetObservationTallyResponse.Merge(m, src)
+}
+func (m *QueryGetObservationTallyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetObservationTallyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetObservationTallyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetObservationTallyResponse proto.InternalMessageInfo
+
+func (m *QueryGetObservationTallyResponse) GetObservationTally() ObservationTally {
+	if m != nil {
+		return m.ObservationTally
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IbcComposabilityMwContract(ctx, req.(*QueryIbcComposabilityMwContractRequest))
+	return ObservationTally{}
+}
+
+func (m *QueryGetObservationTallyResponse) GetQuorumWeight() uint64 {
+	if m != nil {
+		return m.QuorumWeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_WasmInstantiateAllowlistAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllWasmInstantiateAllowlist)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).WasmInstantiateAllowlistAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/WasmInstantiateAllowlistAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).WasmInstantiateAllowlistAll(ctx, req.(*QueryAllWasmInstantiateAllowlist))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "wormhole_foundation.wormchain.wormhole.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GuardianSet",
-			Handler:    _Query_GuardianSet_Handler,
-		},
-		{
-			MethodName: "GuardianSetAll",
-			Handler:    _Query_GuardianSetAll_Handler,
-		},
-		{
-			MethodName: "Config",
-			Handler:    _Query_Config_Handler,
-		},
-		{
-			MethodName: "ReplayProtection",
-			Handler:    _Query_ReplayProtection_Handler,
-		},
-		{
-			MethodName: "ReplayProtectionAll",
-			Handler:    _Query_ReplayProtectionAll_Handler,
-		},
-		{
-			MethodName: "SequenceCounter",
-			Handler:    _Query_SequenceCounter_Handler,
-		},
-		{
-			MethodName: "SequenceCounterAll",
-			Handler:    _Query_SequenceCounterAll_Handler,
-		},
-		{
-			MethodName: "ConsensusGuardianSetIndex",
-			Handler:    _Query_ConsensusGuardianSetIndex_Handler,
-		},
-		{
-			MethodName: "GuardianValidator",
-			Handler:    _Query_GuardianValidator_Handler,
-		},
-		{
-			MethodName: "GuardianValidatorAll",
-			Handler:    _Query_GuardianValidatorAll_Handler,
-		},
-		{
-			MethodName: "LatestGuardianSetIndex",
-			Handler:    _Query_LatestGuardianSetIndex_Handler,
-		},
-		{
-			MethodName: "AllowlistAll",
-			Handler:    _Query_AllowlistAll_Handler,
-		},
-		{
-			MethodName: "Allowlist",
-			Handler:    _Query_Allowlist_Handler,
-		},
-		{
-			MethodName: "IbcComposabilityMwContract",
-			Handler:    _Query_IbcComposabilityMwContract_Handler,
-		},
-		{
-			MethodName: "WasmInstantiateAllowlistAll",
-			Handler:    _Query_WasmInstantiateAllowlistAll_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "wormhole/query.proto",
+type QueryAllObservationTallyRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllValidatorAllowlist) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAllObservationTallyRequest) Reset()         { *m = QueryAllObservationTallyRequest{} }
+func (m *QueryAllObservationTallyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllObservationTallyRequest) ProtoMessage()    {}
+func (*QueryAllObservationTallyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{51}
 }
-
-func (m *QueryAllValidatorAllowlist) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllObservationTallyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryAllValidatorAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAllObservationTallyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllObservationTallyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllObservationTallyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllObservationTallyRequest.Merge(m, src)
+}
+func (m *QueryAllObservationTallyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllObservationTallyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllObservationTallyRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllValidatorAllowlistResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllObservationTallyRequest proto.InternalMessageInfo
+
+func (m *QueryAllObservationTallyRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllValidatorAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllObservationTallyResponse struct {
+	ObservationTally []ObservationTally  `protobuf:"bytes,1,rep,name=observationTally,proto3" json:"observationTally"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllValidatorAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAllObservationTallyResponse) Reset()         { *m = QueryAllObservationTallyResponse{} }
+func (m *QueryAllObservationTallyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllObservationTallyResponse) ProtoMessage()    {}
+func (*QueryAllObservationTallyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{52}
+}
+func (m *QueryAllObservationTallyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllObservationTallyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllObservationTallyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Allowlist) > 0 {
-		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Allowlist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryAllObservationTallyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllObservationTallyResponse.Merge(m, src)
+}
+func (m *QueryAllObservationTallyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllObservationTallyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllObservationTallyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllObservationTallyResponse proto.InternalMessageInfo
+
+func (m *QueryAllObservationTallyResponse) GetObservationTally() []ObservationTally {
+	if m != nil {
+		return m.ObservationTally
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryValidatorAllowlist) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllObservationTallyResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryValidatorAllowlist) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetGuardianSetWeightsRequest struct {
+	GuardianSetIndex uint32 `protobuf:"varint,1,opt,name=guardian_set_index,json=guardianSetIndex,proto3" json:"guardian_set_index,omitempty"`
 }
 
-func (m *QueryValidatorAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryGetGuardianSetWeightsRequest) Reset()         { *m = QueryGetGuardianSetWeightsRequest{} }
+func (m *QueryGetGuardianSetWeightsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetGuardianSetWeightsRequest) ProtoMessage()    {}
+func (*QueryGetGuardianSetWeightsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{53}
+}
+func (m *QueryGetGuardianSetWeightsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetGuardianSetWeightsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetGuardianSetWeightsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetGuardianSetWeightsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetGuardianSetWeightsRequest.Merge(m, src)
+}
+func (m *QueryGetGuardianSetWeightsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetGuardianSetWeightsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetGuardianSetWeightsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorAllowlistResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetGuardianSetWeightsRequest proto.InternalMessageInfo
+
+func (m *QueryGetGuardianSetWeightsRequest) GetGuardianSetIndex() uint32 {
+	if m != nil {
+		return m.GuardianSetIndex
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryValidatorAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetGuardianSetWeightsResponse struct {
+	GuardianSetWeights GuardianSetWeights `protobuf:"bytes,1,opt,name=guardianSetWeights,proto3" json:"guardianSetWeights"`
+	TotalWeight        uint64             `protobuf:"varint,2,opt,name=total_weight,json=totalWeight,proto3" json:"total_weight,omitempty"`
+	// weight needed to finalize an observation
+	QuorumWeight uint64 `protobuf:"varint,3,opt,name=quorum_weight,json=quorumWeight,proto3" json:"quorum_weight,omitempty"`
 }
 
-func (m *QueryValidatorAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Allowlist) > 0 {
-		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Allowlist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *QueryGetGuardianSetWeightsResponse) Reset()         { *m = QueryGetGuardianSetWeightsResponse{} }
+func (m *QueryGetGuardianSetWeightsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetGuardianSetWeightsResponse) ProtoMessage()    {}
+func (*QueryGetGuardianSetWeightsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{54}
+}
+func (m *QueryGetGuardianSetWeightsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetGuardianSetWeightsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetGuardianSetWeightsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetGuardianSetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetGuardianSetWeightsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetGuardianSetWeightsResponse.Merge(m, src)
+}
+func (m *QueryGetGuardianSetWeightsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetGuardianSetWeightsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetGuardianSetWeightsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetGuardianSetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryGetGuardianSetWeightsResponse proto.InternalMessageInfo
+
+func (m *QueryGetGuardianSetWeightsResponse) GetGuardianSetWeights() GuardianSetWeights {
+	if m != nil {
+		return m.GuardianSetWeights
+	}
+	return GuardianSetWeights{}
 }
 
-func (m *QueryGetGuardianSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Index != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryGetGuardianSetWeightsResponse) GetTotalWeight() uint64 {
+	if m != nil {
+		return m.TotalWeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryGetGuardianSetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetGuardianSetWeightsResponse) GetQuorumWeight() uint64 {
+	if m != nil {
+		return m.QuorumWeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetGuardianSetResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetChainRateLimitRequest struct {
+	ChainId uint32 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QueryGetGuardianSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.GuardianSet.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetChainRateLimitRequest) Reset()         { *m = QueryGetChainRateLimitRequest{} }
+func (m *QueryGetChainRateLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetChainRateLimitRequest) ProtoMessage()    {}
+func (*QueryGetChainRateLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{55}
+}
+func (m *QueryGetChainRateLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetChainRateLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetChainRateLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetChainRateLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetChainRateLimitRequest.Merge(m, src)
+}
+func (m *QueryGetChainRateLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetChainRateLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetChainRateLimitRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllGuardianSetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetChainRateLimitRequest proto.InternalMessageInfo
+
+func (m *QueryGetChainRateLimitRequest) GetChainId() uint32 {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllGuardianSetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetChainRateLimitResponse struct {
+	ChainRateLimit ChainRateLimit `protobuf:"bytes,1,opt,name=chainRateLimit,proto3" json:"chainRateLimit"`
+	// observations finalized within the current window
+	Flow uint64 `protobuf:"varint,2,opt,name=flow,proto3" json:"flow,omitempty"`
+	// observations that can still be finalized within the current window
+	Available uint64 `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty"`
 }
 
-func (m *QueryAllGuardianSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryGetChainRateLimitResponse) Reset()         { *m = QueryGetChainRateLimitResponse{} }
+func (m *QueryGetChainRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetChainRateLimitResponse) ProtoMessage()    {}
+func (*QueryGetChainRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{56}
+}
+func (m *QueryGetChainRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetChainRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetChainRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllGuardianSetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetChainRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetChainRateLimitResponse.Merge(m, src)
 }
-
-func (m *QueryAllGuardianSetResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetChainRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetChainRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetChainRateLimitResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllGuardianSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.GuardianSet) > 0 {
-		for iNdEx := len(m.GuardianSet) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.GuardianSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_QueryGetChainRateLimitResponse proto.InternalMessageInfo
+
+func (m *QueryGetChainRateLimitResponse) GetChainRateLimit() ChainRateLimit {
+	if m != nil {
+		return m.ChainRateLimit
 	}
-	return len(dAtA) - i, nil
+	return ChainRateLimit{}
 }
 
-func (m *QueryGetConfigRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetChainRateLimitResponse) GetFlow() uint64 {
+	if m != nil {
+		return m.Flow
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetConfigRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetChainRateLimitResponse) GetAvailable() uint64 {
+	if m != nil {
+		return m.Available
+	}
+	return 0
 }
 
-func (m *QueryGetConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type QueryAllChainRateLimitRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetConfigResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAllChainRateLimitRequest) Reset()         { *m = QueryAllChainRateLimitRequest{} }
+func (m *QueryAllChainRateLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllChainRateLimitRequest) ProtoMessage()    {}
+func (*QueryAllChainRateLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{57}
 }
-
-func (m *QueryGetConfigResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllChainRateLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryGetConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllChainRateLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllChainRateLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetReplayProtectionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAllChainRateLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllChainRateLimitRequest.Merge(m, src)
 }
-
-func (m *QueryGetReplayProtectionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllChainRateLimitRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryGetReplayProtectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryAllChainRateLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllChainRateLimitRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetReplayProtectionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllChainRateLimitRequest proto.InternalMessageInfo
+
+func (m *QueryAllChainRateLimitRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetReplayProtectionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllChainRateLimitResponse struct {
+	ChainRateLimit []ChainRateLimit    `protobuf:"bytes,1,rep,name=chainRateLimit,proto3" json:"chainRateLimit"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetReplayProtectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ReplayProtection.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllChainRateLimitResponse) Reset()         { *m = QueryAllChainRateLimitResponse{} }
+func (m *QueryAllChainRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllChainRateLimitResponse) ProtoMessage()    {}
+func (*QueryAllChainRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{58}
+}
+func (m *QueryAllChainRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllChainRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllChainRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllChainRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllChainRateLimitResponse.Merge(m, src)
+}
+func (m *QueryAllChainRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllChainRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllChainRateLimitResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllReplayProtectionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllChainRateLimitResponse proto.InternalMessageInfo
+
+func (m *QueryAllChainRateLimitResponse) GetChainRateLimit() []ChainRateLimit {
+	if m != nil {
+		return m.ChainRateLimit
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllReplayProtectionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllChainRateLimitResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllReplayProtectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+type QueryAllQueuedObservationRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllQueuedObservationRequest) Reset()         { *m = QueryAllQueuedObservationRequest{} }
+func (m *QueryAllQueuedObservationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllQueuedObservationRequest) ProtoMessage()    {}
+func (*QueryAllQueuedObservationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{59}
+}
+func (m *QueryAllQueuedObservationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllQueuedObservationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllQueuedObservationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllQueuedObservationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllQueuedObservationRequest.Merge(m, src)
+}
+func (m *QueryAllQueuedObservationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllQueuedObservationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllQueuedObservationRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllReplayProtectionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllQueuedObservationRequest proto.InternalMessageInfo
+
+func (m *QueryAllQueuedObservationRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllReplayProtectionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllQueuedObservationResponse struct {
+	QueuedObservation []QueuedObservation `protobuf:"bytes,1,rep,name=queuedObservation,proto3" json:"queuedObservation"`
+	Pagination        *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllReplayProtectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ReplayProtection) > 0 {
-		for iNdEx := len(m.ReplayProtection) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ReplayProtection[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAllQueuedObservationResponse) Reset()         { *m = QueryAllQueuedObservationResponse{} }
+func (m *QueryAllQueuedObservationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllQueuedObservationResponse) ProtoMessage()    {}
+func (*QueryAllQueuedObservationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{60}
+}
+func (m *QueryAllQueuedObservationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllQueuedObservationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllQueuedObservationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetSequenceCounterRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAllQueuedObservationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllQueuedObservationResponse.Merge(m, src)
 }
-
-func (m *QueryGetSequenceCounterRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllQueuedObservationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllQueuedObservationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllQueuedObservationResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetSequenceCounterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryAllQueuedObservationResponse proto.InternalMessageInfo
+
+func (m *QueryAllQueuedObservationResponse) GetQueuedObservation() []QueuedObservation {
+	if m != nil {
+		return m.QueuedObservation
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetSequenceCounterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllQueuedObservationResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetSequenceCounterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllMsgShutdownRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetSequenceCounterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.SequenceCounter.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllMsgShutdownRequest) Reset()         { *m = QueryAllMsgShutdownRequest{} }
+func (m *QueryAllMsgShutdownRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllMsgShutdownRequest) ProtoMessage()    {}
+func (*QueryAllMsgShutdownRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{61}
+}
+func (m *QueryAllMsgShutdownRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllMsgShutdownRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllMsgShutdownRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllMsgShutdownRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllMsgShutdownRequest.Merge(m, src)
+}
+func (m *QueryAllMsgShutdownRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllMsgShutdownRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllMsgShutdownRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllSequenceCounterRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllMsgShutdownRequest proto.InternalMessageInfo
+
+func (m *QueryAllMsgShutdownRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllSequenceCounterRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllMsgShutdownResponse struct {
+	MsgTypeUrls []string            `protobuf:"bytes,1,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllSequenceCounterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAllMsgShutdownResponse) Reset()         { *m = QueryAllMsgShutdownResponse{} }
+func (m *QueryAllMsgShutdownResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllMsgShutdownResponse) ProtoMessage()    {}
+func (*QueryAllMsgShutdownResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{62}
+}
+func (m *QueryAllMsgShutdownResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllMsgShutdownResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllMsgShutdownResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllMsgShutdownResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllMsgShutdownResponse.Merge(m, src)
+}
+func (m *QueryAllMsgShutdownResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllMsgShutdownResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllMsgShutdownResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllSequenceCounterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllMsgShutdownResponse proto.InternalMessageInfo
+
+func (m *QueryAllMsgShutdownResponse) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllSequenceCounterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllMsgShutdownResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllSequenceCounterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SequenceCounter) > 0 {
-		for iNdEx := len(m.SequenceCounter) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SequenceCounter[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+type QueryAllIcaHostAllowlistRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// optional connection to list the allowed message types of
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
 }
 
-func (m *QueryGetConsensusGuardianSetIndexRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllIcaHostAllowlistRequest) Reset()         { *m = QueryAllIcaHostAllowlistRequest{} }
+func (m *QueryAllIcaHostAllowlistRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllIcaHostAllowlistRequest) ProtoMessage()    {}
+func (*QueryAllIcaHostAllowlistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{63}
+}
+func (m *QueryAllIcaHostAllowlistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllIcaHostAllowlistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllIcaHostAllowlistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryGetConsensusGuardianSetIndexRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllIcaHostAllowlistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllIcaHostAllowlistRequest.Merge(m, src)
+}
+func (m *QueryAllIcaHostAllowlistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllIcaHostAllowlistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllIcaHostAllowlistRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetConsensusGuardianSetIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryAllIcaHostAllowlistRequest proto.InternalMessageInfo
+
+func (m *QueryAllIcaHostAllowlistRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryGetConsensusGuardianSetIndexResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllIcaHostAllowlistRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetConsensusGuardianSetIndexResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllIcaHostAllowlistResponse struct {
+	Allowlist  []IcaHostAllowlistEntry `protobuf:"bytes,1,rep,name=allowlist,proto3" json:"allowlist"`
+	Pagination *query.PageResponse     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetConsensusGuardianSetIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ConsensusGuardianSetIndex.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllIcaHostAllowlistResponse) Reset()         { *m = QueryAllIcaHostAllowlistResponse{} }
+func (m *QueryAllIcaHostAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllIcaHostAllowlistResponse) ProtoMessage()    {}
+func (*QueryAllIcaHostAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{64}
+}
+func (m *QueryAllIcaHostAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllIcaHostAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllIcaHostAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetGuardianValidatorRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAllIcaHostAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllIcaHostAllowlistResponse.Merge(m, src)
 }
-
-func (m *QueryGetGuardianValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllIcaHostAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllIcaHostAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllIcaHostAllowlistResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetGuardianValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GuardianKey) > 0 {
-		i -= len(m.GuardianKey)
-		copy(dAtA[i:], m.GuardianKey)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GuardianKey)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryAllIcaHostAllowlistResponse proto.InternalMessageInfo
+
+func (m *QueryAllIcaHostAllowlistResponse) GetAllowlist() []IcaHostAllowlistEntry {
+	if m != nil {
+		return m.Allowlist
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetGuardianValidatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllIcaHostAllowlistResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetGuardianValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllForwardVolumeRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// optional channel to list the forwarded volume of
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 }
 
-func (m *QueryGetGuardianValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.GuardianValidator.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllForwardVolumeRequest) Reset()         { *m = QueryAllForwardVolumeRequest{} }
+func (m *QueryAllForwardVolumeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllForwardVolumeRequest) ProtoMessage()    {}
+func (*QueryAllForwardVolumeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{65}
+}
+func (m *QueryAllForwardVolumeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllForwardVolumeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllForwardVolumeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllForwardVolumeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllForwardVolumeRequest.Merge(m, src)
+}
+func (m *QueryAllForwardVolumeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllForwardVolumeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllForwardVolumeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllGuardianValidatorRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllForwardVolumeRequest proto.InternalMessageInfo
+
+func (m *QueryAllForwardVolumeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllGuardianValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllForwardVolumeRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
 }
 
-func (m *QueryAllGuardianValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type QueryAllForwardVolumeResponse struct {
+	ForwardVolume []ForwardVolume     `protobuf:"bytes,1,rep,name=forwardVolume,proto3" json:"forwardVolume"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllGuardianValidatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAllForwardVolumeResponse) Reset()         { *m = QueryAllForwardVolumeResponse{} }
+func (m *QueryAllForwardVolumeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllForwardVolumeResponse) ProtoMessage()    {}
+func (*QueryAllForwardVolumeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{66}
 }
-
-func (m *QueryAllGuardianValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllForwardVolumeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryAllGuardianValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.GuardianValidator) > 0 {
-		for iNdEx := len(m.GuardianValidator) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.GuardianValidator[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAllForwardVolumeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllForwardVolumeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllForwardVolumeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllForwardVolumeResponse.Merge(m, src)
+}
+func (m *QueryAllForwardVolumeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllForwardVolumeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllForwardVolumeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryLatestGuardianSetIndexRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllForwardVolumeResponse proto.InternalMessageInfo
+
+func (m *QueryAllForwardVolumeResponse) GetForwardVolume() []ForwardVolume {
+	if m != nil {
+		return m.ForwardVolume
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryLatestGuardianSetIndexRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllForwardVolumeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryLatestGuardianSetIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type QueryMaintenanceWindowRequest struct {
 }
 
-func (m *QueryLatestGuardianSetIndexResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMaintenanceWindowRequest) Reset()         { *m = QueryMaintenanceWindowRequest{} }
+func (m *QueryMaintenanceWindowRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMaintenanceWindowRequest) ProtoMessage()    {}
+func (*QueryMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{67}
+}
+func (m *QueryMaintenanceWindowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMaintenanceWindowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMaintenanceWindowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryMaintenanceWindowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMaintenanceWindowRequest.Merge(m, src)
+}
+func (m *QueryMaintenanceWindowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMaintenanceWindowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMaintenanceWindowRequest.DiscardUnknown(m)
 }
 
-func (m *QueryLatestGuardianSetIndexResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryMaintenanceWindowRequest proto.InternalMessageInfo
+
+type QueryMaintenanceWindowResponse struct {
+	// not set if no maintenance window was declared
+	Window *MaintenanceWindow `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	Active bool               `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
 }
 
-func (m *QueryLatestGuardianSetIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LatestGuardianSetIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LatestGuardianSetIndex))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryMaintenanceWindowResponse) Reset()         { *m = QueryMaintenanceWindowResponse{} }
+func (m *QueryMaintenanceWindowResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMaintenanceWindowResponse) ProtoMessage()    {}
+func (*QueryMaintenanceWindowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{68}
+}
+func (m *QueryMaintenanceWindowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMaintenanceWindowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMaintenanceWindowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryMaintenanceWindowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMaintenanceWindowResponse.Merge(m, src)
+}
+func (m *QueryMaintenanceWindowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMaintenanceWindowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMaintenanceWindowResponse.DiscardUnknown(m)
 }
 
-func (m *QueryIbcComposabilityMwContractRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMaintenanceWindowResponse proto.InternalMessageInfo
+
+func (m *QueryMaintenanceWindowResponse) GetWindow() *MaintenanceWindow {
+	if m != nil {
+		return m.Window
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryIbcComposabilityMwContractRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMaintenanceWindowResponse) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
 }
 
-func (m *QueryIbcComposabilityMwContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type QueryConsensusGuardianSetValidatorsRequest struct {
 }
 
-func (m *QueryIbcComposabilityMwContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryConsensusGuardianSetValidatorsRequest) Reset() {
+	*m = QueryConsensusGuardianSetValidatorsRequest{}
+}
+func (m *QueryConsensusGuardianSetValidatorsRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryConsensusGuardianSetValidatorsRequest) ProtoMessage() {}
+func (*QueryConsensusGuardianSetValidatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{69}
+}
+func (m *QueryConsensusGuardianSetValidatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsensusGuardianSetValidatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsensusGuardianSetValidatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryConsensusGuardianSetValidatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsensusGuardianSetValidatorsRequest.Merge(m, src)
+}
+func (m *QueryConsensusGuardianSetValidatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsensusGuardianSetValidatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsensusGuardianSetValidatorsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryIbcComposabilityMwContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryConsensusGuardianSetValidatorsRequest proto.InternalMessageInfo
+
+type ConsensusGuardianValidator struct {
+	// index of the guardian in the guardian set
+	Index       uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	GuardianKey []byte `protobuf:"bytes,2,opt,name=guardian_key,json=guardianKey,proto3" json:"guardian_key,omitempty"`
+	// valoper address of the validator registered by the guardian, empty if
+	// the guardian has not registered a validator
+	ValidatorAddress string `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Registered       bool   `protobuf:"varint,4,opt,name=registered,proto3" json:"registered,omitempty"`
 }
 
-func (m *QueryIbcComposabilityMwContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *ConsensusGuardianValidator) Reset()         { *m = ConsensusGuardianValidator{} }
+func (m *ConsensusGuardianValidator) String() string { return proto.CompactTextString(m) }
+func (*ConsensusGuardianValidator) ProtoMessage()    {}
+func (*ConsensusGuardianValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{70}
+}
+func (m *ConsensusGuardianValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsensusGuardianValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsensusGuardianValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ConsensusGuardianValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsensusGuardianValidator.Merge(m, src)
+}
+func (m *ConsensusGuardianValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsensusGuardianValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsensusGuardianValidator.DiscardUnknown(m)
 }
 
-func (m *QueryAllWasmInstantiateAllowlist) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConsensusGuardianValidator proto.InternalMessageInfo
+
+func (m *ConsensusGuardianValidator) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllWasmInstantiateAllowlist) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConsensusGuardianValidator) GetGuardianKey() []byte {
+	if m != nil {
+		return m.GuardianKey
+	}
+	return nil
 }
 
-func (m *QueryAllWasmInstantiateAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *ConsensusGuardianValidator) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryAllWasmInstantiateAllowlistResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConsensusGuardianValidator) GetRegistered() bool {
+	if m != nil {
+		return m.Registered
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryAllWasmInstantiateAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryConsensusGuardianSetValidatorsResponse struct {
+	GuardianSetIndex uint32                       `protobuf:"varint,1,opt,name=guardian_set_index,json=guardianSetIndex,proto3" json:"guardian_set_index,omitempty"`
+	Guardians        []ConsensusGuardianValidator `protobuf:"bytes,2,rep,name=guardians,proto3" json:"guardians"`
+	// number of guardians that have not registered a validator
+	UnregisteredCount uint32 `protobuf:"varint,3,opt,name=unregistered_count,json=unregisteredCount,proto3" json:"unregistered_count,omitempty"`
 }
 
-func (m *QueryAllWasmInstantiateAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Allowlist) > 0 {
-		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Allowlist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryConsensusGuardianSetValidatorsResponse) Reset() {
+	*m = QueryConsensusGuardianSetValidatorsResponse{}
+}
+func (m *QueryConsensusGuardianSetValidatorsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryConsensusGuardianSetValidatorsResponse) ProtoMessage() {}
+func (*QueryConsensusGuardianSetValidatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{71}
+}
+func (m *QueryConsensusGuardianSetValidatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsensusGuardianSetValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsensusGuardianSetValidatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryConsensusGuardianSetValidatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsensusGuardianSetValidatorsResponse.Merge(m, src)
+}
+func (m *QueryConsensusGuardianSetValidatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsensusGuardianSetValidatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsensusGuardianSetValidatorsResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryConsensusGuardianSetValidatorsResponse proto.InternalMessageInfo
+
+func (m *QueryConsensusGuardianSetValidatorsResponse) GetGuardianSetIndex() uint32 {
+	if m != nil {
+		return m.GuardianSetIndex
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *QueryAllValidatorAllowlist) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+
+func (m *QueryConsensusGuardianSetValidatorsResponse) GetGuardians() []ConsensusGuardianValidator {
+	if m != nil {
+		return m.Guardians
 	}
-	return n
+	return nil
 }
 
-func (m *QueryAllValidatorAllowlistResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Allowlist) > 0 {
-		for _, e := range m.Allowlist {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryConsensusGuardianSetValidatorsResponse) GetUnregisteredCount() uint32 {
+	if m != nil {
+		return m.UnregisteredCount
 	}
-	return n
+	return 0
 }
 
-func (m *QueryValidatorAllowlist) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryGetGuardianHeartbeatRequest struct {
+	GuardianKey []byte `protobuf:"bytes,1,opt,name=guardian_key,json=guardianKey,proto3" json:"guardian_key,omitempty"`
 }
 
-func (m *QueryValidatorAllowlistResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Allowlist) > 0 {
-		for _, e := range m.Allowlist {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetGuardianHeartbeatRequest) Reset()         { *m = QueryGetGuardianHeartbeatRequest{} }
+func (m *QueryGetGuardianHeartbeatRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetGuardianHeartbeatRequest) ProtoMessage()    {}
+func (*QueryGetGuardianHeartbeatRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{72}
+}
+func (m *QueryGetGuardianHeartbeatRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetGuardianHeartbeatRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetGuardianHeartbeatRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
 }
-
-func (m *QueryGetGuardianSetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Index != 0 {
-		n += 1 + sovQuery(uint64(m.Index))
-	}
-	return n
+func (m *QueryGetGuardianHeartbeatRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetGuardianHeartbeatRequest.Merge(m, src)
+}
+func (m *QueryGetGuardianHeartbeatRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetGuardianHeartbeatRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetGuardianHeartbeatRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetGuardianSetResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryGetGuardianHeartbeatRequest proto.InternalMessageInfo
+
+func (m *QueryGetGuardianHeartbeatRequest) GetGuardianKey() []byte {
+	if m != nil {
+		return m.GuardianKey
 	}
-	var l int
-	_ = l
-	l = m.GuardianSet.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryAllGuardianSetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryGetGuardianHeartbeatResponse struct {
+	GuardianHeartbeat GuardianHeartbeat `protobuf:"bytes,1,opt,name=guardianHeartbeat,proto3" json:"guardianHeartbeat"`
 }
 
-func (m *QueryAllGuardianSetResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.GuardianSet) > 0 {
-		for _, e := range m.GuardianSet {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetGuardianHeartbeatResponse) Reset()         { *m = QueryGetGuardianHeartbeatResponse{} }
+func (m *QueryGetGuardianHeartbeatResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetGuardianHeartbeatResponse) ProtoMessage()    {}
+func (*QueryGetGuardianHeartbeatResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{73}
+}
+func (m *QueryGetGuardianHeartbeatResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetGuardianHeartbeatResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetGuardianHeartbeatResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryGetGuardianHeartbeatResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetGuardianHeartbeatResponse.Merge(m, src)
+}
+func (m *QueryGetGuardianHeartbeatResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetGuardianHeartbeatResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetGuardianHeartbeatResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetConfigRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryGetGuardianHeartbeatResponse proto.InternalMessageInfo
+
+func (m *QueryGetGuardianHeartbeatResponse) GetGuardianHeartbeat() GuardianHeartbeat {
+	if m != nil {
+		return m.GuardianHeartbeat
 	}
-	var l int
-	_ = l
-	return n
+	return GuardianHeartbeat{}
 }
 
-func (m *QueryGetConfigResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Config.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+type QueryAllGuardianHeartbeatRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetReplayProtectionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllGuardianHeartbeatRequest) Reset()         { *m = QueryAllGuardianHeartbeatRequest{} }
+func (m *QueryAllGuardianHeartbeatRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllGuardianHeartbeatRequest) ProtoMessage()    {}
+func (*QueryAllGuardianHeartbeatRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{74}
+}
+func (m *QueryAllGuardianHeartbeatRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllGuardianHeartbeatRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllGuardianHeartbeatRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryAllGuardianHeartbeatRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllGuardianHeartbeatRequest.Merge(m, src)
+}
+func (m *QueryAllGuardianHeartbeatRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllGuardianHeartbeatRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllGuardianHeartbeatRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetReplayProtectionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryAllGuardianHeartbeatRequest proto.InternalMessageInfo
+
+func (m *QueryAllGuardianHeartbeatRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.ReplayProtection.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryAllReplayProtectionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryAllGuardianHeartbeatResponse struct {
+	GuardianHeartbeat []GuardianHeartbeat `protobuf:"bytes,1,rep,name=guardianHeartbeat,proto3" json:"guardianHeartbeat"`
+	Pagination        *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllReplayProtectionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ReplayProtection) > 0 {
-		for _, e := range m.ReplayProtection {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllGuardianHeartbeatResponse) Reset()         { *m = QueryAllGuardianHeartbeatResponse{} }
+func (m *QueryAllGuardianHeartbeatResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllGuardianHeartbeatResponse) ProtoMessage()    {}
+func (*QueryAllGuardianHeartbeatResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{75}
+}
+func (m *QueryAllGuardianHeartbeatResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllGuardianHeartbeatResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllGuardianHeartbeatResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryAllGuardianHeartbeatResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllGuardianHeartbeatResponse.Merge(m, src)
+}
+func (m *QueryAllGuardianHeartbeatResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllGuardianHeartbeatResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllGuardianHeartbeatResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetSequenceCounterRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryAllGuardianHeartbeatResponse proto.InternalMessageInfo
+
+func (m *QueryAllGuardianHeartbeatResponse) GetGuardianHeartbeat() []GuardianHeartbeat {
+	if m != nil {
+		return m.GuardianHeartbeat
 	}
-	return n
+	return nil
 }
 
-func (m *QueryGetSequenceCounterResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllGuardianHeartbeatResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.SequenceCounter.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryAllSequenceCounterRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryAccountantBalanceRequest struct {
+	// address of the global accountant contract
+	Contract string               `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Key      AccountantAccountKey `protobuf:"bytes,2,opt,name=key,proto3" json:"key"`
 }
 
-func (m *QueryAllSequenceCounterResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.SequenceCounter) > 0 {
-		for _, e := range m.SequenceCounter {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAccountantBalanceRequest) Reset()         { *m = QueryAccountantBalanceRequest{} }
+func (m *QueryAccountantBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountantBalanceRequest) ProtoMessage()    {}
+func (*QueryAccountantBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{76}
+}
+func (m *QueryAccountantBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountantBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountantBalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryAccountantBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountantBalanceRequest.Merge(m, src)
+}
+func (m *QueryAccountantBalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountantBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountantBalanceRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetConsensusGuardianSetIndexRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryAccountantBalanceRequest proto.InternalMessageInfo
+
+func (m *QueryAccountantBalanceRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *QueryGetConsensusGuardianSetIndexResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAccountantBalanceRequest) GetKey() AccountantAccountKey {
+	if m != nil {
+		return m.Key
 	}
-	var l int
-	_ = l
-	l = m.ConsensusGuardianSetIndex.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return AccountantAccountKey{}
 }
 
-func (m *QueryGetGuardianValidatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.GuardianKey)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryAccountantBalanceResponse struct {
+	Account AccountantAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account"`
 }
 
-func (m *QueryGetGuardianValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAccountantBalanceResponse) Reset()         { *m = QueryAccountantBalanceResponse{} }
+func (m *QueryAccountantBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountantBalanceResponse) ProtoMessage()    {}
+func (*QueryAccountantBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{77}
+}
+func (m *QueryAccountantBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountantBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountantBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.GuardianValidator.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryAccountantBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountantBalanceResponse.Merge(m, src)
+}
+func (m *QueryAccountantBalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountantBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountantBalanceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllGuardianValidatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryAccountantBalanceResponse proto.InternalMessageInfo
+
+func (m *QueryAccountantBalanceResponse) GetAccount() AccountantAccount {
+	if m != nil {
+		return m.Account
 	}
-	return n
+	return AccountantAccount{}
 }
 
-func (m *QueryAllGuardianValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.GuardianValidator) > 0 {
-		for _, e := range m.GuardianValidator {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// The accountant queries page through the contract state with the contract's
+// own cursor: the results start after the optional start_after key and hold at
+// most limit entries, or the contract's default when limit is 0.
+type QueryAllAccountantAccountRequest struct {
+	Contract   string                `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	StartAfter *AccountantAccountKey `protobuf:"bytes,2,opt,name=start_after,json=startAfter,proto3" json:"start_after,omitempty"`
+	Limit      uint32                `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (m *QueryLatestGuardianSetIndexRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllAccountantAccountRequest) Reset()         { *m = QueryAllAccountantAccountRequest{} }
+func (m *QueryAllAccountantAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAccountantAccountRequest) ProtoMessage()    {}
+func (*QueryAllAccountantAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{78}
+}
+func (m *QueryAllAccountantAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAccountantAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAccountantAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryAllAccountantAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAccountantAccountRequest.Merge(m, src)
+}
+func (m *QueryAllAccountantAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAccountantAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAccountantAccountRequest.DiscardUnknown(m)
 }
 
-func (m *QueryLatestGuardianSetIndexResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LatestGuardianSetIndex != 0 {
-		n += 1 + sovQuery(uint64(m.LatestGuardianSetIndex))
+var xxx_messageInfo_QueryAllAccountantAccountRequest proto.InternalMessageInfo
+
+func (m *QueryAllAccountantAccountRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
 	}
-	return n
+	return ""
 }
 
-func (m *QueryIbcComposabilityMwContractRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllAccountantAccountRequest) GetStartAfter() *AccountantAccountKey {
+	if m != nil {
+		return m.StartAfter
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryIbcComposabilityMwContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllAccountantAccountRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
 	}
-	return n
+	return 0
 }
 
-func (m *QueryAllWasmInstantiateAllowlist) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryAllAccountantAccountResponse struct {
+	Accounts []AccountantAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
 }
 
-func (m *QueryAllWasmInstantiateAllowlistResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Allowlist) > 0 {
-		for _, e := range m.Allowlist {
+func (m *QueryAllAccountantAccountResponse) Reset()         { *m = QueryAllAccountantAccountResponse{} }
+func (m *QueryAllAccountantAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAccountantAccountResponse) ProtoMessage()    {}
+func (*QueryAllAccountantAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{79}
+}
+func (m *QueryAllAccountantAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAccountantAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAccountantAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllAccountantAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAccountantAccountResponse.Merge(m, src)
+}
+func (m *QueryAllAccountantAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAccountantAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAccountantAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllAccountantAccountResponse proto.InternalMessageInfo
+
+func (m *QueryAllAccountantAccountResponse) GetAccounts() []AccountantAccount {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+type QueryAllAccountantTransferRequest struct {
+	Contract   string                 `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	StartAfter *AccountantTransferKey `protobuf:"bytes,2,opt,name=start_after,json=startAfter,proto3" json:"start_after,omitempty"`
+	Limit      uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *QueryAllAccountantTransferRequest) Reset()         { *m = QueryAllAccountantTransferRequest{} }
+func (m *QueryAllAccountantTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAccountantTransferRequest) ProtoMessage()    {}
+func (*QueryAllAccountantTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{80}
+}
+func (m *QueryAllAccountantTransferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAccountantTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAccountantTransferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllAccountantTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAccountantTransferRequest.Merge(m, src)
+}
+func (m *QueryAllAccountantTransferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAccountantTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAccountantTransferRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllAccountantTransferRequest proto.InternalMessageInfo
+
+func (m *QueryAllAccountantTransferRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *QueryAllAccountantTransferRequest) GetStartAfter() *AccountantTransferKey {
+	if m != nil {
+		return m.StartAfter
+	}
+	return nil
+}
+
+func (m *QueryAllAccountantTransferRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type QueryAllAccountantTransferResponse struct {
+	Transfers []AccountantTransfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers"`
+}
+
+func (m *QueryAllAccountantTransferResponse) Reset()         { *m = QueryAllAccountantTransferResponse{} }
+func (m *QueryAllAccountantTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAccountantTransferResponse) ProtoMessage()    {}
+func (*QueryAllAccountantTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{81}
+}
+func (m *QueryAllAccountantTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAccountantTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAccountantTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllAccountantTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAccountantTransferResponse.Merge(m, src)
+}
+func (m *QueryAllAccountantTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAccountantTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAccountantTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllAccountantTransferResponse proto.InternalMessageInfo
+
+func (m *QueryAllAccountantTransferResponse) GetTransfers() []AccountantTransfer {
+	if m != nil {
+		return m.Transfers
+	}
+	return nil
+}
+
+type QueryAllAccountantPendingTransferRequest struct {
+	Contract   string                 `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	StartAfter *AccountantTransferKey `protobuf:"bytes,2,opt,name=start_after,json=startAfter,proto3" json:"start_after,omitempty"`
+	Limit      uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *QueryAllAccountantPendingTransferRequest) Reset() {
+	*m = QueryAllAccountantPendingTransferRequest{}
+}
+func (m *QueryAllAccountantPendingTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAccountantPendingTransferRequest) ProtoMessage()    {}
+func (*QueryAllAccountantPendingTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{82}
+}
+func (m *QueryAllAccountantPendingTransferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAccountantPendingTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAccountantPendingTransferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllAccountantPendingTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAccountantPendingTransferRequest.Merge(m, src)
+}
+func (m *QueryAllAccountantPendingTransferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAccountantPendingTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAccountantPendingTransferRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllAccountantPendingTransferRequest proto.InternalMessageInfo
+
+func (m *QueryAllAccountantPendingTransferRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *QueryAllAccountantPendingTransferRequest) GetStartAfter() *AccountantTransferKey {
+	if m != nil {
+		return m.StartAfter
+	}
+	return nil
+}
+
+func (m *QueryAllAccountantPendingTransferRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type QueryAllAccountantPendingTransferResponse struct {
+	Pending []AccountantPendingTransfer `protobuf:"bytes,1,rep,name=pending,proto3" json:"pending"`
+}
+
+func (m *QueryAllAccountantPendingTransferResponse) Reset() {
+	*m = QueryAllAccountantPendingTransferResponse{}
+}
+func (m *QueryAllAccountantPendingTransferResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryAllAccountantPendingTransferResponse) ProtoMessage() {}
+func (*QueryAllAccountantPendingTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{83}
+}
+func (m *QueryAllAccountantPendingTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAccountantPendingTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAccountantPendingTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllAccountantPendingTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAccountantPendingTransferResponse.Merge(m, src)
+}
+func (m *QueryAllAccountantPendingTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAccountantPendingTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAccountantPendingTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllAccountantPendingTransferResponse proto.InternalMessageInfo
+
+func (m *QueryAllAccountantPendingTransferResponse) GetPending() []AccountantPendingTransfer {
+	if m != nil {
+		return m.Pending
+	}
+	return nil
+}
+
+type QueryAccountantTransferStatusRequest struct {
+	Contract string                `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Key      AccountantTransferKey `protobuf:"bytes,2,opt,name=key,proto3" json:"key"`
+}
+
+func (m *QueryAccountantTransferStatusRequest) Reset()         { *m = QueryAccountantTransferStatusRequest{} }
+func (m *QueryAccountantTransferStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountantTransferStatusRequest) ProtoMessage()    {}
+func (*QueryAccountantTransferStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{84}
+}
+func (m *QueryAccountantTransferStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountantTransferStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountantTransferStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountantTransferStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountantTransferStatusRequest.Merge(m, src)
+}
+func (m *QueryAccountantTransferStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountantTransferStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountantTransferStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountantTransferStatusRequest proto.InternalMessageInfo
+
+func (m *QueryAccountantTransferStatusRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *QueryAccountantTransferStatusRequest) GetKey() AccountantTransferKey {
+	if m != nil {
+		return m.Key
+	}
+	return AccountantTransferKey{}
+}
+
+type QueryAccountantTransferStatusResponse struct {
+	// set if the transfer is committed
+	Committed *AccountantTransfer `protobuf:"bytes,1,opt,name=committed,proto3" json:"committed,omitempty"`
+	// observations of the transfer if it is pending
+	Pending []AccountantObservation `protobuf:"bytes,2,rep,name=pending,proto3" json:"pending"`
+}
+
+func (m *QueryAccountantTransferStatusResponse) Reset()         { *m = QueryAccountantTransferStatusResponse{} }
+func (m *QueryAccountantTransferStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountantTransferStatusResponse) ProtoMessage()    {}
+func (*QueryAccountantTransferStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{85}
+}
+func (m *QueryAccountantTransferStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountantTransferStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountantTransferStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountantTransferStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountantTransferStatusResponse.Merge(m, src)
+}
+func (m *QueryAccountantTransferStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountantTransferStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountantTransferStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountantTransferStatusResponse proto.InternalMessageInfo
+
+func (m *QueryAccountantTransferStatusResponse) GetCommitted() *AccountantTransfer {
+	if m != nil {
+		return m.Committed
+	}
+	return nil
+}
+
+func (m *QueryAccountantTransferStatusResponse) GetPending() []AccountantObservation {
+	if m != nil {
+		return m.Pending
+	}
+	return nil
+}
+
+type QueryNextSequenceRequest struct {
+	// hex encoded 32 byte emitter address
+	Emitter string `protobuf:"bytes,1,opt,name=emitter,proto3" json:"emitter,omitempty"`
+	// optional core contract whose emitter sequence to query instead of the one
+	// of the wormhole module
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *QueryNextSequenceRequest) Reset()         { *m = QueryNextSequenceRequest{} }
+func (m *QueryNextSequenceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNextSequenceRequest) ProtoMessage()    {}
+func (*QueryNextSequenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{86}
+}
+func (m *QueryNextSequenceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextSequenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextSequenceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextSequenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextSequenceRequest.Merge(m, src)
+}
+func (m *QueryNextSequenceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextSequenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextSequenceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextSequenceRequest proto.InternalMessageInfo
+
+func (m *QueryNextSequenceRequest) GetEmitter() string {
+	if m != nil {
+		return m.Emitter
+	}
+	return ""
+}
+
+func (m *QueryNextSequenceRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+type QueryNextSequenceResponse struct {
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *QueryNextSequenceResponse) Reset()         { *m = QueryNextSequenceResponse{} }
+func (m *QueryNextSequenceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNextSequenceResponse) ProtoMessage()    {}
+func (*QueryNextSequenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{87}
+}
+func (m *QueryNextSequenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextSequenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextSequenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextSequenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextSequenceResponse.Merge(m, src)
+}
+func (m *QueryNextSequenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextSequenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextSequenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextSequenceResponse proto.InternalMessageInfo
+
+func (m *QueryNextSequenceResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+type QueryAllSequenceReservationRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// optional hex encoded emitter to list the reservations of
+	Emitter string `protobuf:"bytes,2,opt,name=emitter,proto3" json:"emitter,omitempty"`
+}
+
+func (m *QueryAllSequenceReservationRequest) Reset()         { *m = QueryAllSequenceReservationRequest{} }
+func (m *QueryAllSequenceReservationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllSequenceReservationRequest) ProtoMessage()    {}
+func (*QueryAllSequenceReservationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{88}
+}
+func (m *QueryAllSequenceReservationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllSequenceReservationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllSequenceReservationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllSequenceReservationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllSequenceReservationRequest.Merge(m, src)
+}
+func (m *QueryAllSequenceReservationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllSequenceReservationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllSequenceReservationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllSequenceReservationRequest proto.InternalMessageInfo
+
+func (m *QueryAllSequenceReservationRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryAllSequenceReservationRequest) GetEmitter() string {
+	if m != nil {
+		return m.Emitter
+	}
+	return ""
+}
+
+type QueryAllSequenceReservationResponse struct {
+	SequenceReservation []SequenceReservation `protobuf:"bytes,1,rep,name=sequenceReservation,proto3" json:"sequenceReservation"`
+	Pagination          *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllSequenceReservationResponse) Reset()         { *m = QueryAllSequenceReservationResponse{} }
+func (m *QueryAllSequenceReservationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllSequenceReservationResponse) ProtoMessage()    {}
+func (*QueryAllSequenceReservationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{89}
+}
+func (m *QueryAllSequenceReservationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllSequenceReservationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllSequenceReservationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllSequenceReservationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllSequenceReservationResponse.Merge(m, src)
+}
+func (m *QueryAllSequenceReservationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllSequenceReservationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllSequenceReservationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllSequenceReservationResponse proto.InternalMessageInfo
+
+func (m *QueryAllSequenceReservationResponse) GetSequenceReservation() []SequenceReservation {
+	if m != nil {
+		return m.SequenceReservation
+	}
+	return nil
+}
+
+func (m *QueryAllSequenceReservationResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryCoreContractRequest struct {
+}
+
+func (m *QueryCoreContractRequest) Reset()         { *m = QueryCoreContractRequest{} }
+func (m *QueryCoreContractRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCoreContractRequest) ProtoMessage()    {}
+func (*QueryCoreContractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{90}
+}
+func (m *QueryCoreContractRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCoreContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCoreContractRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCoreContractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCoreContractRequest.Merge(m, src)
+}
+func (m *QueryCoreContractRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCoreContractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCoreContractRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCoreContractRequest proto.InternalMessageInfo
+
+type QueryCoreContractResponse struct {
+	ContractAddress string `protobuf:"bytes,1,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
+}
+
+func (m *QueryCoreContractResponse) Reset()         { *m = QueryCoreContractResponse{} }
+func (m *QueryCoreContractResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCoreContractResponse) ProtoMessage()    {}
+func (*QueryCoreContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{91}
+}
+func (m *QueryCoreContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCoreContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCoreContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCoreContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCoreContractResponse.Merge(m, src)
+}
+func (m *QueryCoreContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCoreContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCoreContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCoreContractResponse proto.InternalMessageInfo
+
+func (m *QueryCoreContractResponse) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+type QueryGetApprovedCodeHashRequest struct {
+	CodeId uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+}
+
+func (m *QueryGetApprovedCodeHashRequest) Reset()         { *m = QueryGetApprovedCodeHashRequest{} }
+func (m *QueryGetApprovedCodeHashRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetApprovedCodeHashRequest) ProtoMessage()    {}
+func (*QueryGetApprovedCodeHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{92}
+}
+func (m *QueryGetApprovedCodeHashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetApprovedCodeHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetApprovedCodeHashRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetApprovedCodeHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetApprovedCodeHashRequest.Merge(m, src)
+}
+func (m *QueryGetApprovedCodeHashRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetApprovedCodeHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetApprovedCodeHashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetApprovedCodeHashRequest proto.InternalMessageInfo
+
+func (m *QueryGetApprovedCodeHashRequest) GetCodeId() uint64 {
+	if m != nil {
+		return m.CodeId
+	}
+	return 0
+}
+
+type QueryGetApprovedCodeHashResponse struct {
+	ApprovedCodeHash ApprovedCodeHash `protobuf:"bytes,1,opt,name=approvedCodeHash,proto3" json:"approvedCodeHash"`
+}
+
+func (m *QueryGetApprovedCodeHashResponse) Reset()         { *m = QueryGetApprovedCodeHashResponse{} }
+func (m *QueryGetApprovedCodeHashResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetApprovedCodeHashResponse) ProtoMessage()    {}
+func (*QueryGetApprovedCodeHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{93}
+}
+func (m *QueryGetApprovedCodeHashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetApprovedCodeHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetApprovedCodeHashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetApprovedCodeHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetApprovedCodeHashResponse.Merge(m, src)
+}
+func (m *QueryGetApprovedCodeHashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetApprovedCodeHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetApprovedCodeHashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetApprovedCodeHashResponse proto.InternalMessageInfo
+
+func (m *QueryGetApprovedCodeHashResponse) GetApprovedCodeHash() ApprovedCodeHash {
+	if m != nil {
+		return m.ApprovedCodeHash
+	}
+	return ApprovedCodeHash{}
+}
+
+type QueryAllApprovedCodeHashRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllApprovedCodeHashRequest) Reset()         { *m = QueryAllApprovedCodeHashRequest{} }
+func (m *QueryAllApprovedCodeHashRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllApprovedCodeHashRequest) ProtoMessage()    {}
+func (*QueryAllApprovedCodeHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{94}
+}
+func (m *QueryAllApprovedCodeHashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllApprovedCodeHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllApprovedCodeHashRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllApprovedCodeHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllApprovedCodeHashRequest.Merge(m, src)
+}
+func (m *QueryAllApprovedCodeHashRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllApprovedCodeHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllApprovedCodeHashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllApprovedCodeHashRequest proto.InternalMessageInfo
+
+func (m *QueryAllApprovedCodeHashRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllApprovedCodeHashResponse struct {
+	ApprovedCodeHash []ApprovedCodeHash  `protobuf:"bytes,1,rep,name=approvedCodeHash,proto3" json:"approvedCodeHash"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllApprovedCodeHashResponse) Reset()         { *m = QueryAllApprovedCodeHashResponse{} }
+func (m *QueryAllApprovedCodeHashResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllApprovedCodeHashResponse) ProtoMessage()    {}
+func (*QueryAllApprovedCodeHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{95}
+}
+func (m *QueryAllApprovedCodeHashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllApprovedCodeHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllApprovedCodeHashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllApprovedCodeHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllApprovedCodeHashResponse.Merge(m, src)
+}
+func (m *QueryAllApprovedCodeHashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllApprovedCodeHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllApprovedCodeHashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllApprovedCodeHashResponse proto.InternalMessageInfo
+
+func (m *QueryAllApprovedCodeHashResponse) GetApprovedCodeHash() []ApprovedCodeHash {
+	if m != nil {
+		return m.ApprovedCodeHash
+	}
+	return nil
+}
+
+func (m *QueryAllApprovedCodeHashResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryWrappedAssetSupplyRequest struct {
+	// origin chain of the token
+	Chain uint32 `protobuf:"varint,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	// hex encoded 32 byte address of the token on its origin chain
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// paginates the holders
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryWrappedAssetSupplyRequest) Reset()         { *m = QueryWrappedAssetSupplyRequest{} }
+func (m *QueryWrappedAssetSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWrappedAssetSupplyRequest) ProtoMessage()    {}
+func (*QueryWrappedAssetSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{96}
+}
+func (m *QueryWrappedAssetSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWrappedAssetSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWrappedAssetSupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWrappedAssetSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWrappedAssetSupplyRequest.Merge(m, src)
+}
+func (m *QueryWrappedAssetSupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWrappedAssetSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWrappedAssetSupplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWrappedAssetSupplyRequest proto.InternalMessageInfo
+
+func (m *QueryWrappedAssetSupplyRequest) GetChain() uint32 {
+	if m != nil {
+		return m.Chain
+	}
+	return 0
+}
+
+func (m *QueryWrappedAssetSupplyRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryWrappedAssetSupplyRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type WrappedAssetHolder struct {
+	Address string                                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *WrappedAssetHolder) Reset()         { *m = WrappedAssetHolder{} }
+func (m *WrappedAssetHolder) String() string { return proto.CompactTextString(m) }
+func (*WrappedAssetHolder) ProtoMessage()    {}
+func (*WrappedAssetHolder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{97}
+}
+func (m *WrappedAssetHolder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WrappedAssetHolder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WrappedAssetHolder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WrappedAssetHolder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WrappedAssetHolder.Merge(m, src)
+}
+func (m *WrappedAssetHolder) XXX_Size() int {
+	return m.Size()
+}
+func (m *WrappedAssetHolder) XXX_DiscardUnknown() {
+	xxx_messageInfo_WrappedAssetHolder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WrappedAssetHolder proto.InternalMessageInfo
+
+func (m *WrappedAssetHolder) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryWrappedAssetSupplyResponse struct {
+	// tokenfactory denom of the wrapped asset
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// cw20 contract of the wrapped asset, which the denom is bridged from
+	Cw20Contract string     `protobuf:"bytes,2,opt,name=cw20_contract,json=cw20Contract,proto3" json:"cw20_contract,omitempty"`
+	TotalSupply  types.Coin `protobuf:"bytes,3,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply"`
+	// number of accounts with a positive balance of the denom
+	HolderCount uint64               `protobuf:"varint,4,opt,name=holder_count,json=holderCount,proto3" json:"holder_count,omitempty"`
+	Holders     []WrappedAssetHolder `protobuf:"bytes,5,rep,name=holders,proto3" json:"holders"`
+	Pagination  *query.PageResponse  `protobuf:"bytes,6,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryWrappedAssetSupplyResponse) Reset()         { *m = QueryWrappedAssetSupplyResponse{} }
+func (m *QueryWrappedAssetSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWrappedAssetSupplyResponse) ProtoMessage()    {}
+func (*QueryWrappedAssetSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{98}
+}
+func (m *QueryWrappedAssetSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWrappedAssetSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWrappedAssetSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWrappedAssetSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWrappedAssetSupplyResponse.Merge(m, src)
+}
+func (m *QueryWrappedAssetSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWrappedAssetSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWrappedAssetSupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWrappedAssetSupplyResponse proto.InternalMessageInfo
+
+func (m *QueryWrappedAssetSupplyResponse) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryWrappedAssetSupplyResponse) GetCw20Contract() string {
+	if m != nil {
+		return m.Cw20Contract
+	}
+	return ""
+}
+
+func (m *QueryWrappedAssetSupplyResponse) GetTotalSupply() types.Coin {
+	if m != nil {
+		return m.TotalSupply
+	}
+	return types.Coin{}
+}
+
+func (m *QueryWrappedAssetSupplyResponse) GetHolderCount() uint64 {
+	if m != nil {
+		return m.HolderCount
+	}
+	return 0
+}
+
+func (m *QueryWrappedAssetSupplyResponse) GetHolders() []WrappedAssetHolder {
+	if m != nil {
+		return m.Holders
+	}
+	return nil
+}
+
+func (m *QueryWrappedAssetSupplyResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QuerySimulateGovernanceVAARequest struct {
+	Vaa []byte `protobuf:"bytes,1,opt,name=vaa,proto3" json:"vaa,omitempty"`
+}
+
+func (m *QuerySimulateGovernanceVAARequest) Reset()         { *m = QuerySimulateGovernanceVAARequest{} }
+func (m *QuerySimulateGovernanceVAARequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateGovernanceVAARequest) ProtoMessage()    {}
+func (*QuerySimulateGovernanceVAARequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{99}
+}
+func (m *QuerySimulateGovernanceVAARequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateGovernanceVAARequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateGovernanceVAARequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateGovernanceVAARequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateGovernanceVAARequest.Merge(m, src)
+}
+func (m *QuerySimulateGovernanceVAARequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateGovernanceVAARequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateGovernanceVAARequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateGovernanceVAARequest proto.InternalMessageInfo
+
+func (m *QuerySimulateGovernanceVAARequest) GetVaa() []byte {
+	if m != nil {
+		return m.Vaa
+	}
+	return nil
+}
+
+// GovernancePayloadField is a field of a decoded governance action payload.
+type GovernancePayloadField struct {
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *GovernancePayloadField) Reset()         { *m = GovernancePayloadField{} }
+func (m *GovernancePayloadField) String() string { return proto.CompactTextString(m) }
+func (*GovernancePayloadField) ProtoMessage()    {}
+func (*GovernancePayloadField) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{100}
+}
+func (m *GovernancePayloadField) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovernancePayloadField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovernancePayloadField.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GovernancePayloadField) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernancePayloadField.Merge(m, src)
+}
+func (m *GovernancePayloadField) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovernancePayloadField) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernancePayloadField.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernancePayloadField proto.InternalMessageInfo
+
+func (m *GovernancePayloadField) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GovernancePayloadField) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type QuerySimulateGovernanceVAAResponse struct {
+	// whether the VAA would be executed successfully
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// why the VAA would fail
+	Error  string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Digest []byte `protobuf:"bytes,3,opt,name=digest,proto3" json:"digest,omitempty"`
+	// governance module the VAA is addressed to, i.e. Core, Gateway or WasmdModule
+	Module      string `protobuf:"bytes,4,opt,name=module,proto3" json:"module,omitempty"`
+	Action      uint32 `protobuf:"varint,5,opt,name=action,proto3" json:"action,omitempty"`
+	ActionName  string `protobuf:"bytes,6,opt,name=action_name,json=actionName,proto3" json:"action_name,omitempty"`
+	TargetChain uint32 `protobuf:"varint,7,opt,name=target_chain,json=targetChain,proto3" json:"target_chain,omitempty"`
+	// fields of the action payload, empty if the payload can't be decoded
+	PayloadFields []GovernancePayloadField `protobuf:"bytes,8,rep,name=payload_fields,json=payloadFields,proto3" json:"payload_fields"`
+	// events emitted by the execution
+	Events []types.StringEvent `protobuf:"bytes,9,rep,name=events,proto3" json:"events"`
+	// ABCI codespace and code of the error, 0 if the VAA is valid
+	Codespace string `protobuf:"bytes,10,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	Code      uint32 `protobuf:"varint,11,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (m *QuerySimulateGovernanceVAAResponse) Reset()         { *m = QuerySimulateGovernanceVAAResponse{} }
+func (m *QuerySimulateGovernanceVAAResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateGovernanceVAAResponse) ProtoMessage()    {}
+func (*QuerySimulateGovernanceVAAResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_273185ecc792fa38, []int{101}
+}
+func (m *QuerySimulateGovernanceVAAResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateGovernanceVAAResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateGovernanceVAAResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateGovernanceVAAResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateGovernanceVAAResponse.Merge(m, src)
+}
+func (m *QuerySimulateGovernanceVAAResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateGovernanceVAAResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateGovernanceVAAResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateGovernanceVAAResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateGovernanceVAAResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *QuerySimulateGovernanceVAAResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *QuerySimulateGovernanceVAAResponse) GetDigest() []byte {
+	if m != nil {
+		return m.Digest
+	}
+	return nil
+}
+
+func (m *QuerySimulateGovernanceVAAResponse) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *QuerySimulateGovernanceVAAResponse) GetAction() uint32 {
+	if m != nil {
+		return m.Action
+	}
+	return 0
+}
+
+func (m *QuerySimulateGovernanceVAAResponse) GetActionName() string {
+	if m != nil {
+		return m.ActionName
+	}
+	return ""
+}
+
+func (m *QuerySimulateGovernanceVAAResponse) GetTargetChain() uint32 {
+	if m != nil {
+		return m.TargetChain
+	}
+	return 0
+}
+
+func (m *QuerySimulateGovernanceVAAResponse) GetPayloadFields() []GovernancePayloadField {
+	if m != nil {
+		return m.PayloadFields
+	}
+	return nil
+}
+
+func (m *QuerySimulateGovernanceVAAResponse) GetEvents() []types.StringEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *QuerySimulateGovernanceVAAResponse) GetCodespace() string {
+	if m != nil {
+		return m.Codespace
+	}
+	return ""
+}
+
+func (m *QuerySimulateGovernanceVAAResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryAllValidatorAllowlist)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllValidatorAllowlist")
+	proto.RegisterType((*QueryAllValidatorAllowlistResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllValidatorAllowlistResponse")
+	proto.RegisterType((*QueryValidatorAllowlist)(nil), "wormhole_foundation.wormchain.wormhole.QueryValidatorAllowlist")
+	proto.RegisterType((*QueryValidatorAllowlistResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryValidatorAllowlistResponse")
+	proto.RegisterType((*QueryGetGuardianSetRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetGuardianSetRequest")
+	proto.RegisterType((*QueryGetGuardianSetResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetGuardianSetResponse")
+	proto.RegisterType((*QueryAllGuardianSetRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllGuardianSetRequest")
+	proto.RegisterType((*QueryAllGuardianSetResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllGuardianSetResponse")
+	proto.RegisterType((*QueryGuardianSetHistoryRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryGuardianSetHistoryRequest")
+	proto.RegisterType((*GuardianSetHistoryEntry)(nil), "wormhole_foundation.wormchain.wormhole.GuardianSetHistoryEntry")
+	proto.RegisterType((*QueryGuardianSetHistoryResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryGuardianSetHistoryResponse")
+	proto.RegisterType((*QueryGetConfigRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetConfigRequest")
+	proto.RegisterType((*QueryGetConfigResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetConfigResponse")
+	proto.RegisterType((*QueryGetReplayProtectionRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetReplayProtectionRequest")
+	proto.RegisterType((*QueryGetReplayProtectionResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetReplayProtectionResponse")
+	proto.RegisterType((*QueryAllReplayProtectionRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllReplayProtectionRequest")
+	proto.RegisterType((*QueryAllReplayProtectionResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllReplayProtectionResponse")
+	proto.RegisterType((*QueryAllExecutedGovernanceVAARequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllExecutedGovernanceVAARequest")
+	proto.RegisterType((*QueryAllExecutedGovernanceVAAResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllExecutedGovernanceVAAResponse")
+	proto.RegisterType((*QueryGetSequenceCounterRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetSequenceCounterRequest")
+	proto.RegisterType((*QueryGetSequenceCounterResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetSequenceCounterResponse")
+	proto.RegisterType((*QueryAllSequenceCounterRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllSequenceCounterRequest")
+	proto.RegisterType((*QueryAllSequenceCounterResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllSequenceCounterResponse")
+	proto.RegisterType((*QueryGetConsensusGuardianSetIndexRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetConsensusGuardianSetIndexRequest")
+	proto.RegisterType((*QueryGetConsensusGuardianSetIndexResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetConsensusGuardianSetIndexResponse")
+	proto.RegisterType((*QueryGetGuardianValidatorRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetGuardianValidatorRequest")
+	proto.RegisterType((*QueryGetGuardianValidatorResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetGuardianValidatorResponse")
+	proto.RegisterType((*QueryAllGuardianValidatorRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllGuardianValidatorRequest")
+	proto.RegisterType((*QueryAllGuardianValidatorResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllGuardianValidatorResponse")
+	proto.RegisterType((*QueryLatestGuardianSetIndexRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryLatestGuardianSetIndexRequest")
+	proto.RegisterType((*QueryLatestGuardianSetIndexResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryLatestGuardianSetIndexResponse")
+	proto.RegisterType((*QueryIbcComposabilityMwContractRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryIbcComposabilityMwContractRequest")
+	proto.RegisterType((*QueryIbcComposabilityMwContractResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryIbcComposabilityMwContractResponse")
+	proto.RegisterType((*QueryBridgePausedRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryBridgePausedRequest")
+	proto.RegisterType((*QueryBridgePausedResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryBridgePausedResponse")
+	proto.RegisterType((*QueryAllWasmInstantiateAllowlist)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllWasmInstantiateAllowlist")
+	proto.RegisterType((*QueryAllWasmInstantiateAllowlistResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllWasmInstantiateAllowlistResponse")
+	proto.RegisterType((*QueryGetRegisteredEmitterRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetRegisteredEmitterRequest")
+	proto.RegisterType((*QueryGetRegisteredEmitterResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetRegisteredEmitterResponse")
+	proto.RegisterType((*QueryAllRegisteredEmitterRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllRegisteredEmitterRequest")
+	proto.RegisterType((*QueryAllRegisteredEmitterResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllRegisteredEmitterResponse")
+	proto.RegisterType((*QueryGetGovernanceSubmitterRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetGovernanceSubmitterRequest")
+	proto.RegisterType((*QueryGetGovernanceSubmitterResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetGovernanceSubmitterResponse")
+	proto.RegisterType((*QueryAllGovernanceSubmitterRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllGovernanceSubmitterRequest")
+	proto.RegisterType((*QueryAllGovernanceSubmitterResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllGovernanceSubmitterResponse")
+	proto.RegisterType((*QueryVerifyVAARequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryVerifyVAARequest")
+	proto.RegisterType((*QueryVerifyVAAResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryVerifyVAAResponse")
+	proto.RegisterType((*QueryAllArchivedVAARequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllArchivedVAARequest")
+	proto.RegisterType((*QueryAllArchivedVAAResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllArchivedVAAResponse")
+	proto.RegisterType((*QueryGetObservationTallyRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetObservationTallyRequest")
+	proto.RegisterType((*QueryGetObservationTallyResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetObservationTallyResponse")
+	proto.RegisterType((*QueryAllObservationTallyRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllObservationTallyRequest")
+	proto.RegisterType((*QueryAllObservationTallyResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllObservationTallyResponse")
+	proto.RegisterType((*QueryGetGuardianSetWeightsRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetGuardianSetWeightsRequest")
+	proto.RegisterType((*QueryGetGuardianSetWeightsResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetGuardianSetWeightsResponse")
+	proto.RegisterType((*QueryGetChainRateLimitRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetChainRateLimitRequest")
+	proto.RegisterType((*QueryGetChainRateLimitResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetChainRateLimitResponse")
+	proto.RegisterType((*QueryAllChainRateLimitRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllChainRateLimitRequest")
+	proto.RegisterType((*QueryAllChainRateLimitResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllChainRateLimitResponse")
+	proto.RegisterType((*QueryAllQueuedObservationRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllQueuedObservationRequest")
+	proto.RegisterType((*QueryAllQueuedObservationResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllQueuedObservationResponse")
+	proto.RegisterType((*QueryAllMsgShutdownRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllMsgShutdownRequest")
+	proto.RegisterType((*QueryAllMsgShutdownResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllMsgShutdownResponse")
+	proto.RegisterType((*QueryAllIcaHostAllowlistRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllIcaHostAllowlistRequest")
+	proto.RegisterType((*QueryAllIcaHostAllowlistResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllIcaHostAllowlistResponse")
+	proto.RegisterType((*QueryAllForwardVolumeRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllForwardVolumeRequest")
+	proto.RegisterType((*QueryAllForwardVolumeResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllForwardVolumeResponse")
+	proto.RegisterType((*QueryMaintenanceWindowRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryMaintenanceWindowRequest")
+	proto.RegisterType((*QueryMaintenanceWindowResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryMaintenanceWindowResponse")
+	proto.RegisterType((*QueryConsensusGuardianSetValidatorsRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryConsensusGuardianSetValidatorsRequest")
+	proto.RegisterType((*ConsensusGuardianValidator)(nil), "wormhole_foundation.wormchain.wormhole.ConsensusGuardianValidator")
+	proto.RegisterType((*QueryConsensusGuardianSetValidatorsResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryConsensusGuardianSetValidatorsResponse")
+	proto.RegisterType((*QueryGetGuardianHeartbeatRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetGuardianHeartbeatRequest")
+	proto.RegisterType((*QueryGetGuardianHeartbeatResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetGuardianHeartbeatResponse")
+	proto.RegisterType((*QueryAllGuardianHeartbeatRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllGuardianHeartbeatRequest")
+	proto.RegisterType((*QueryAllGuardianHeartbeatResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllGuardianHeartbeatResponse")
+	proto.RegisterType((*QueryAccountantBalanceRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryAccountantBalanceRequest")
+	proto.RegisterType((*QueryAccountantBalanceResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryAccountantBalanceResponse")
+	proto.RegisterType((*QueryAllAccountantAccountRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllAccountantAccountRequest")
+	proto.RegisterType((*QueryAllAccountantAccountResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllAccountantAccountResponse")
+	proto.RegisterType((*QueryAllAccountantTransferRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllAccountantTransferRequest")
+	proto.RegisterType((*QueryAllAccountantTransferResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllAccountantTransferResponse")
+	proto.RegisterType((*QueryAllAccountantPendingTransferRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllAccountantPendingTransferRequest")
+	proto.RegisterType((*QueryAllAccountantPendingTransferResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllAccountantPendingTransferResponse")
+	proto.RegisterType((*QueryAccountantTransferStatusRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryAccountantTransferStatusRequest")
+	proto.RegisterType((*QueryAccountantTransferStatusResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryAccountantTransferStatusResponse")
+	proto.RegisterType((*QueryNextSequenceRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryNextSequenceRequest")
+	proto.RegisterType((*QueryNextSequenceResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryNextSequenceResponse")
+	proto.RegisterType((*QueryAllSequenceReservationRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllSequenceReservationRequest")
+	proto.RegisterType((*QueryAllSequenceReservationResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllSequenceReservationResponse")
+	proto.RegisterType((*QueryCoreContractRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryCoreContractRequest")
+	proto.RegisterType((*QueryCoreContractResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryCoreContractResponse")
+	proto.RegisterType((*QueryGetApprovedCodeHashRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetApprovedCodeHashRequest")
+	proto.RegisterType((*QueryGetApprovedCodeHashResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryGetApprovedCodeHashResponse")
+	proto.RegisterType((*QueryAllApprovedCodeHashRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllApprovedCodeHashRequest")
+	proto.RegisterType((*QueryAllApprovedCodeHashResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryAllApprovedCodeHashResponse")
+	proto.RegisterType((*QueryWrappedAssetSupplyRequest)(nil), "wormhole_foundation.wormchain.wormhole.QueryWrappedAssetSupplyRequest")
+	proto.RegisterType((*WrappedAssetHolder)(nil), "wormhole_foundation.wormchain.wormhole.WrappedAssetHolder")
+	proto.RegisterType((*QueryWrappedAssetSupplyResponse)(nil), "wormhole_foundation.wormchain.wormhole.QueryWrappedAssetSupplyResponse")
+	proto.RegisterType((*QuerySimulateGovernanceVAARequest)(nil), "wormhole_foundation.wormchain.wormhole.QuerySimulateGovernanceVAARequest")
+	proto.RegisterType((*GovernancePayloadField)(nil), "wormhole_foundation.wormchain.wormhole.GovernancePayloadField")
+	proto.RegisterType((*QuerySimulateGovernanceVAAResponse)(nil), "wormhole_foundation.wormchain.wormhole.QuerySimulateGovernanceVAAResponse")
+}
+
+func init() { proto.RegisterFile("wormhole/query.proto", fileDescriptor_273185ecc792fa38) }
+
+var fileDescriptor_273185ecc792fa38 = []byte{
+	// 4694 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5d, 0xd9, 0x8f, 0xdc, 0x46,
+	0x7e, 0x36, 0xbb, 0xa5, 0xd1, 0x4c, 0xcd, 0xa1, 0x51, 0xe9, 0x6a, 0xd1, 0xf6, 0x48, 0xa6, 0xd7,
+	0xb2, 0x56, 0x5e, 0x4f, 0xaf, 0xa5, 0x58, 0xb2, 0x64, 0x4b, 0x76, 0xcf, 0x68, 0x8e, 0xd6, 0x39,
+	0xd3, 0x23, 0x4b, 0xd9, 0x4d, 0xbc, 0x44, 0x4d, 0xb3, 0xa6, 0x87, 0x6b, 0x36, 0xd9, 0x26, 0xd9,
+	0x3d, 0x9a, 0x0c, 0x04, 0x18, 0x41, 0xbc, 0x0f, 0x9b, 0x40, 0xc8, 0xf1, 0x92, 0x04, 0x79, 0xca,
+	0xbf, 0x10, 0x04, 0xc8, 0x43, 0x80, 0x04, 0xc8, 0xcb, 0x06, 0x09, 0x92, 0x45, 0x16, 0xc9, 0x26,
+	0xd9, 0xc0, 0x31, 0x6c, 0x27, 0x08, 0xb2, 0x01, 0x82, 0x24, 0x48, 0x02, 0xec, 0x3a, 0x07, 0xaa,
+	0x58, 0x45, 0x16, 0xaf, 0x16, 0xc9, 0xe6, 0x00, 0xfb, 0xa4, 0x66, 0x15, 0xf9, 0x55, 0x7d, 0x5f,
+	0x1d, 0x2c, 0xfe, 0x8e, 0x11, 0x38, 0xb6, 0x63, 0xd9, 0xdd, 0x6d, 0xcb, 0xc0, 0xf5, 0x0f, 0xfa,
+	0xd8, 0xde, 0x9d, 0xef, 0xd9, 0x96, 0x6b, 0xc1, 0xb3, 0xbc, 0x54, 0xdd, 0xb2, 0xfa, 0xa6, 0x86,
+	0x5c, 0xdd, 0x32, 0xe7, 0x49, 0x59, 0x7b, 0x1b, 0xe9, 0xe6, 0x3c, 0xaf, 0x95, 0x9f, 0xeb, 0x58,
+	0x56, 0xc7, 0xc0, 0x75, 0xd4, 0xd3, 0xeb, 0xc8, 0x34, 0x2d, 0x97, 0xde, 0xe9, 0x78, 0x28, 0xf2,
+	0xf9, 0xb6, 0xe5, 0x74, 0x2d, 0xa7, 0xbe, 0x89, 0x1c, 0x06, 0x5f, 0x1f, 0xbc, 0xb6, 0x89, 0x5d,
+	0xf4, 0x5a, 0xbd, 0x87, 0x3a, 0xba, 0xe9, 0xc1, 0x7a, 0xf7, 0xce, 0x89, 0xf7, 0xf2, 0xbb, 0xda,
+	0x96, 0xce, 0xeb, 0x5f, 0x14, 0xeb, 0xd1, 0x66, 0x5b, 0xf7, 0x6f, 0x22, 0x17, 0xec, 0xa6, 0x93,
+	0x3e, 0x99, 0x4e, 0x1f, 0xd9, 0x9a, 0x8e, 0xf8, 0xd3, 0xc7, 0xfd, 0x8a, 0xb6, 0x65, 0x6e, 0xe9,
+	0x1d, 0x56, 0x7c, 0xc6, 0x2f, 0xb6, 0x71, 0xcf, 0x40, 0xbb, 0x2a, 0x29, 0xc6, 0x6d, 0xa1, 0x5b,
+	0xa7, 0xfd, 0x3b, 0x1c, 0xfc, 0x41, 0x1f, 0x9b, 0x6d, 0xac, 0xb6, 0xad, 0xbe, 0xe9, 0x62, 0x9b,
+	0xdd, 0xf0, 0x8a, 0x88, 0xec, 0x60, 0xd3, 0xe9, 0x3b, 0x2a, 0x6f, 0x5c, 0x75, 0xb0, 0xab, 0xea,
+	0xa6, 0x86, 0x1f, 0xb1, 0x9b, 0x5f, 0x10, 0xda, 0xeb, 0xe8, 0x8e, 0x8b, 0x6d, 0xac, 0xa9, 0xb8,
+	0xab, 0xbb, 0x01, 0x9e, 0xec, 0xdf, 0x32, 0x40, 0x48, 0x45, 0x76, 0x7b, 0x5b, 0x1f, 0xe0, 0x58,
+	0x9d, 0xb5, 0xe9, 0x60, 0x7b, 0x20, 0xea, 0x77, 0x2a, 0x80, 0x46, 0x2e, 0x56, 0x0d, 0xbd, 0xab,
+	0xbb, 0xac, 0xaa, 0xe6, 0x57, 0x6d, 0x63, 0x64, 0xbb, 0x9b, 0x18, 0xb9, 0x31, 0xc0, 0x2d, 0xcb,
+	0xde, 0x41, 0xb6, 0xa6, 0x6e, 0x61, 0x1c, 0xeb, 0x6b, 0x17, 0xe9, 0xa6, 0x8b, 0x4d, 0x44, 0xc8,
+	0xef, 0xe8, 0xa6, 0x66, 0xed, 0xc4, 0xda, 0x44, 0x6d, 0xaa, 0x0a, 0x32, 0x39, 0xf2, 0xb1, 0x8e,
+	0xd5, 0xb1, 0xe8, 0xcf, 0x3a, 0xf9, 0xe5, 0x95, 0x2a, 0x1a, 0x90, 0xd7, 0xc9, 0x34, 0x68, 0x18,
+	0xc6, 0x03, 0x64, 0xe8, 0x1a, 0x72, 0x2d, 0xbb, 0x61, 0x18, 0xd6, 0x8e, 0xa1, 0x3b, 0x2e, 0x5c,
+	0x06, 0x20, 0x98, 0x16, 0x35, 0xe9, 0x8c, 0x74, 0x6e, 0xf2, 0xc2, 0xd9, 0x79, 0x6f, 0xdc, 0xe7,
+	0xc9, 0xb8, 0xcf, 0x7b, 0x53, 0x94, 0x0d, 0xfc, 0xfc, 0x1a, 0xea, 0xe0, 0x16, 0x19, 0x15, 0xc7,
+	0x6d, 0x09, 0x4f, 0x2a, 0x7f, 0x2a, 0x01, 0x25, 0xbd, 0x99, 0x16, 0x76, 0x7a, 0x64, 0xa4, 0xe0,
+	0x7b, 0x60, 0x02, 0xf1, 0xc2, 0x9a, 0x74, 0xa6, 0x7a, 0x6e, 0xf2, 0xc2, 0xdb, 0xf3, 0xd9, 0xe6,
+	0xfd, 0x7c, 0x18, 0x16, 0x6b, 0x0d, 0x4d, 0xb3, 0xb1, 0xe3, 0xb4, 0x02, 0x44, 0xb8, 0x12, 0x62,
+	0x53, 0xa1, 0x6c, 0x5e, 0x7e, 0x2a, 0x1b, 0xaf, 0x6f, 0x21, 0x3a, 0x4f, 0x24, 0x70, 0x92, 0xd2,
+	0x49, 0x90, 0xec, 0x15, 0x70, 0x64, 0xc0, 0x4b, 0x55, 0xe4, 0x75, 0x82, 0x2a, 0x37, 0xd1, 0x9a,
+	0xf5, 0x2b, 0x58, 0xe7, 0xe0, 0x72, 0x42, 0x8f, 0x8a, 0xe8, 0xfb, 0x5f, 0x12, 0x38, 0x9d, 0xd2,
+	0x21, 0x5f, 0xdc, 0x5c, 0x1d, 0x0b, 0x8d, 0x44, 0x65, 0x9f, 0x47, 0xa2, 0x5a, 0x7c, 0x24, 0x2e,
+	0xb0, 0xe9, 0xbb, 0x82, 0xdd, 0x15, 0xb6, 0xc4, 0x37, 0xb0, 0xcb, 0x24, 0x82, 0xc7, 0xc0, 0x41,
+	0xba, 0xd6, 0x29, 0xcd, 0xe9, 0x96, 0x77, 0xa1, 0xfc, 0x1c, 0x78, 0x36, 0xf1, 0x19, 0xa6, 0xd3,
+	0xcf, 0x80, 0x49, 0xa1, 0x98, 0x4d, 0xfa, 0x8b, 0x59, 0xc9, 0x0b, 0x8f, 0x2e, 0x1c, 0xf8, 0xce,
+	0xc7, 0xa7, 0x9f, 0x69, 0x89, 0x68, 0xe2, 0x72, 0x4b, 0xe8, 0x6f, 0x59, 0xcb, 0xed, 0x8f, 0x24,
+	0xf0, 0x6c, 0x62, 0x33, 0x69, 0x14, 0xab, 0xe5, 0x51, 0x2c, 0x6f, 0x95, 0x6d, 0x83, 0x39, 0x6f,
+	0x9c, 0x02, 0xf0, 0x55, 0xdd, 0x71, 0x2d, 0x7b, 0xb7, 0x6c, 0xbd, 0x3e, 0x91, 0xc0, 0xc9, 0x78,
+	0x2b, 0x4b, 0xa6, 0x6b, 0xef, 0x12, 0xad, 0x3a, 0xa5, 0x4e, 0x07, 0x01, 0x0d, 0x9e, 0x07, 0xb3,
+	0xa8, 0xed, 0xea, 0xde, 0x6b, 0x63, 0x15, 0xeb, 0x9d, 0x6d, 0x97, 0x2a, 0x56, 0x6d, 0xc5, 0xca,
+	0xe1, 0x59, 0x30, 0x83, 0x1f, 0xf5, 0x74, 0x9b, 0x96, 0xdd, 0xd7, 0xbb, 0x98, 0xae, 0x9b, 0x03,
+	0xad, 0x48, 0x29, 0x99, 0xf4, 0x74, 0x39, 0xd7, 0x0e, 0x9c, 0x91, 0xce, 0x8d, 0xb7, 0xbc, 0x0b,
+	0xe5, 0x2f, 0xf9, 0x0e, 0x91, 0xa4, 0x26, 0x9b, 0x16, 0x3a, 0x98, 0x12, 0x3a, 0xe7, 0xe4, 0xdd,
+	0x81, 0x53, 0x14, 0x64, 0xbc, 0x43, 0xd0, 0xe5, 0x4d, 0x92, 0x93, 0xe0, 0x38, 0x5f, 0xcc, 0x8b,
+	0xf4, 0x1c, 0xc1, 0xc6, 0x57, 0xd9, 0x02, 0x27, 0xa2, 0x15, 0x8c, 0xe6, 0x6d, 0x30, 0xe6, 0x95,
+	0xb0, 0xc1, 0x9c, 0xcf, 0x4a, 0xd0, 0x7b, 0x8a, 0xf1, 0x61, 0x18, 0xca, 0x65, 0xae, 0x2b, 0x59,
+	0x5f, 0xe4, 0xc4, 0xb2, 0xe6, 0x1f, 0x58, 0x12, 0xb7, 0xa1, 0x09, 0xbe, 0x0d, 0x3d, 0x91, 0xc0,
+	0x99, 0xf4, 0x27, 0x59, 0x5f, 0xbf, 0x09, 0x66, 0xed, 0x48, 0x1d, 0xeb, 0xf5, 0x1b, 0x59, 0x7b,
+	0x1d, 0xc5, 0x66, 0xfd, 0x8f, 0xe1, 0x2a, 0x3a, 0x63, 0xd2, 0x30, 0x8c, 0x34, 0x26, 0x65, 0x2d,
+	0xb8, 0xef, 0x73, 0xee, 0x89, 0x6d, 0x0d, 0xe5, 0x5e, 0xdd, 0x0f, 0xee, 0xe5, 0xcd, 0x47, 0x13,
+	0x7c, 0x89, 0x13, 0x5b, 0x7a, 0x84, 0xdb, 0x7d, 0x17, 0x6b, 0x2b, 0xd6, 0x00, 0xdb, 0xf4, 0xac,
+	0xf6, 0xa0, 0xd1, 0x28, 0x5b, 0xc9, 0x1f, 0x4a, 0xe0, 0xa5, 0xa7, 0x34, 0xc8, 0xe4, 0xdc, 0x05,
+	0xc7, 0x71, 0xd2, 0x0d, 0x4c, 0xd3, 0x6b, 0x59, 0x35, 0x4d, 0x6c, 0x85, 0x09, 0x9b, 0xdc, 0x42,
+	0x79, 0xea, 0x5e, 0xe2, 0xaf, 0x04, 0xec, 0x6e, 0xb0, 0xc3, 0xff, 0xa2, 0x77, 0xf6, 0x1f, 0xbe,
+	0xd6, 0xbe, 0x2d, 0x81, 0xd3, 0xa9, 0x0f, 0x32, 0x7d, 0x3a, 0xe0, 0xb0, 0x13, 0xae, 0x62, 0xc3,
+	0x72, 0x39, 0xab, 0x32, 0x11, 0x64, 0xa6, 0x49, 0x14, 0xd5, 0x7f, 0xaf, 0x35, 0x0c, 0x23, 0x85,
+	0x44, 0x59, 0x93, 0xe3, 0x7b, 0x12, 0x38, 0x9d, 0xda, 0xd4, 0x30, 0xda, 0xd5, 0xf2, 0x69, 0x97,
+	0x37, 0x09, 0xce, 0x83, 0x73, 0xc2, 0xce, 0xee, 0x7d, 0xe0, 0x09, 0xef, 0x9e, 0x26, 0x19, 0x71,
+	0xfe, 0x16, 0xf8, 0x1d, 0x09, 0x7c, 0x39, 0xc3, 0xcd, 0x4c, 0x8b, 0x8f, 0x24, 0x70, 0x2a, 0xf5,
+	0x2e, 0x36, 0x0e, 0x8d, 0x1c, 0x6f, 0x8b, 0x64, 0x20, 0x26, 0x50, 0x7a, 0x4b, 0xca, 0x8d, 0xe0,
+	0xcd, 0xc0, 0xeb, 0xfc, 0x43, 0x35, 0x9f, 0x23, 0x67, 0x82, 0x73, 0xc9, 0x2d, 0xbc, 0x4b, 0x3b,
+	0x37, 0xd5, 0x12, 0x8b, 0x94, 0x5f, 0x95, 0xc0, 0x0b, 0x43, 0x60, 0x18, 0xe7, 0x2e, 0x38, 0xd2,
+	0x89, 0x56, 0x32, 0xaa, 0x57, 0xf2, 0xbe, 0xf9, 0x7d, 0x00, 0x46, 0x31, 0x8e, 0xac, 0x7c, 0x33,
+	0xd8, 0xf8, 0x53, 0xa9, 0x95, 0x35, 0xfd, 0x7f, 0xc0, 0x05, 0x48, 0x6e, 0x6c, 0xb8, 0x00, 0xd5,
+	0xfd, 0x11, 0xa0, 0xbc, 0x65, 0xf0, 0x25, 0xf6, 0x49, 0x7d, 0x1b, 0xb9, 0xd8, 0x71, 0xd3, 0x16,
+	0xc0, 0x7b, 0xe0, 0xc5, 0xa1, 0x77, 0x31, 0x11, 0x2e, 0x81, 0x13, 0x46, 0xe2, 0x1d, 0xec, 0xd3,
+	0x29, 0xa5, 0x56, 0x39, 0x07, 0xce, 0x52, 0xf8, 0xe6, 0x66, 0x7b, 0xd1, 0xea, 0xf6, 0x2c, 0x07,
+	0x6d, 0xea, 0x86, 0xee, 0xee, 0xde, 0xd9, 0x59, 0xb4, 0x4c, 0xd7, 0x46, 0x6d, 0xfe, 0x6d, 0xa3,
+	0x6c, 0x80, 0x97, 0x9f, 0x7a, 0x27, 0xeb, 0xcc, 0x39, 0x70, 0xb8, 0xcd, 0xca, 0x1a, 0xa1, 0xef,
+	0xd4, 0x68, 0xb1, 0x22, 0x83, 0x1a, 0x05, 0x5d, 0xb0, 0x75, 0xad, 0x83, 0xd7, 0x50, 0xdf, 0xc1,
+	0x1a, 0x6f, 0xf0, 0x22, 0x38, 0x95, 0x50, 0xc7, 0x9a, 0x38, 0x01, 0xc6, 0x7a, 0xb4, 0x84, 0x22,
+	0x8f, 0xb7, 0xd8, 0x95, 0x38, 0x3d, 0x1f, 0x22, 0xa7, 0xdb, 0x34, 0x1d, 0x17, 0x99, 0xae, 0x8e,
+	0x5c, 0x5c, 0xbe, 0x51, 0xe4, 0x1f, 0x24, 0x70, 0xee, 0x69, 0x8d, 0xf9, 0x1d, 0xee, 0xc5, 0x4d,
+	0x23, 0xb7, 0xb3, 0xce, 0xce, 0x24, 0x70, 0xac, 0x71, 0xd9, 0x17, 0x2d, 0x0d, 0x37, 0x35, 0x36,
+	0x61, 0xf7, 0xc3, 0x5a, 0xf2, 0xae, 0x78, 0xce, 0xe5, 0x36, 0xb6, 0x25, 0xcf, 0xc4, 0xc6, 0x97,
+	0xfc, 0x09, 0x30, 0xd6, 0xb5, 0xb4, 0xbe, 0x81, 0xd9, 0x48, 0xb3, 0x2b, 0x78, 0x0a, 0x8c, 0x53,
+	0x32, 0xaa, 0xae, 0xd1, 0x2e, 0x4c, 0xb7, 0x0e, 0xd1, 0xeb, 0xa6, 0x16, 0xda, 0xde, 0x12, 0x70,
+	0x83, 0xd5, 0x6d, 0x47, 0x2b, 0xf3, 0x6e, 0x6f, 0x31, 0x74, 0xbe, 0xba, 0x63, 0xc8, 0xe2, 0xfc,
+	0x49, 0xe5, 0xba, 0x1f, 0xdb, 0x5b, 0x6e, 0x01, 0xaa, 0xfb, 0x23, 0x40, 0x79, 0xb3, 0xe6, 0x3a,
+	0x50, 0xfc, 0x97, 0x97, 0x7f, 0x98, 0xdc, 0xe8, 0x6f, 0x86, 0xb5, 0xac, 0x81, 0x43, 0x61, 0x53,
+	0x16, 0xbf, 0x54, 0x7e, 0x53, 0x02, 0x2f, 0x0e, 0x05, 0x60, 0xfa, 0x38, 0xe0, 0x68, 0x27, 0x5e,
+	0xcd, 0x86, 0xe5, 0xcd, 0xcc, 0x2f, 0x80, 0x38, 0x04, 0xd3, 0x28, 0x09, 0x5d, 0x31, 0x02, 0x73,
+	0xe8, 0x10, 0x72, 0x65, 0x4d, 0x94, 0xcf, 0xb8, 0x14, 0x69, 0xcd, 0x3d, 0x4d, 0x8a, 0xea, 0xfe,
+	0x49, 0x51, 0xde, 0x84, 0xf9, 0x32, 0xb3, 0x04, 0x3c, 0xc0, 0xb6, 0xbe, 0xb5, 0x2b, 0x7c, 0x6a,
+	0xcd, 0x82, 0xea, 0x00, 0x21, 0x76, 0x42, 0x22, 0x3f, 0x95, 0x4f, 0xab, 0xe0, 0x44, 0xf4, 0x5e,
+	0xa6, 0x81, 0x6f, 0x3d, 0x91, 0x04, 0xeb, 0x09, 0x29, 0xc5, 0xb6, 0x6d, 0xd9, 0xb4, 0x7f, 0x13,
+	0x2d, 0xef, 0x82, 0x6c, 0x5a, 0x9a, 0xde, 0xc1, 0x8e, 0x4b, 0x2d, 0x31, 0x53, 0x2d, 0x76, 0x45,
+	0x26, 0xe5, 0x00, 0xdb, 0x0e, 0xe1, 0x73, 0xc0, 0xdb, 0xb3, 0xd8, 0x25, 0xfc, 0x0a, 0x80, 0x71,
+	0x4f, 0x44, 0xed, 0x20, 0xbd, 0x69, 0xb6, 0x13, 0x79, 0xb9, 0xc2, 0x97, 0xc0, 0x8c, 0xd9, 0xef,
+	0xaa, 0x8e, 0xde, 0x31, 0x91, 0xdb, 0xb7, 0xb1, 0x53, 0x1b, 0xa3, 0x77, 0x4e, 0x9b, 0xfd, 0xee,
+	0x86, 0x5f, 0x08, 0x9f, 0x03, 0x13, 0xae, 0xde, 0xc5, 0x8e, 0x8b, 0xba, 0xbd, 0xda, 0x21, 0x7a,
+	0x47, 0x50, 0x40, 0xba, 0x6e, 0x5a, 0x66, 0x1b, 0xd7, 0xc6, 0x3d, 0x1b, 0x28, 0xbd, 0x80, 0x2f,
+	0x82, 0x69, 0xe6, 0xe4, 0x50, 0xe9, 0xf0, 0xd5, 0x26, 0x68, 0xed, 0x14, 0x2b, 0x5c, 0x24, 0x65,
+	0xf0, 0x65, 0x70, 0x98, 0xdf, 0xc4, 0x17, 0x19, 0xa0, 0x44, 0x67, 0x58, 0x31, 0xb7, 0x16, 0xcb,
+	0x60, 0x9c, 0x9f, 0xf6, 0x6b, 0x93, 0xd4, 0x28, 0xe5, 0x5f, 0x13, 0xb3, 0x73, 0xdb, 0x32, 0x1d,
+	0xb2, 0x4d, 0x98, 0xed, 0x5d, 0xd5, 0xc0, 0x03, 0x6c, 0xd4, 0xa6, 0x3c, 0xc6, 0x42, 0xc5, 0x6d,
+	0x52, 0x4e, 0x94, 0xeb, 0xa1, 0x5d, 0xc3, 0x42, 0x5a, 0x6d, 0x9a, 0xb6, 0xc4, 0x2f, 0x09, 0xc9,
+	0xb6, 0xa5, 0x61, 0xa7, 0x87, 0xda, 0xb8, 0x36, 0x43, 0x47, 0x21, 0x28, 0x80, 0x10, 0x1c, 0x20,
+	0x17, 0xb5, 0xc3, 0x14, 0x97, 0xfe, 0x56, 0xbe, 0x90, 0x02, 0x5b, 0x6b, 0xc3, 0x73, 0xda, 0x68,
+	0xc2, 0xac, 0x88, 0x29, 0x20, 0x65, 0x53, 0xa0, 0x92, 0xa8, 0xc0, 0x4b, 0x60, 0xc6, 0xf7, 0x46,
+	0x39, 0x2e, 0xb2, 0x5d, 0x66, 0x9c, 0x9b, 0xe6, 0xa5, 0x1b, 0xa4, 0x10, 0xbe, 0x00, 0xa6, 0xfc,
+	0xdb, 0xb0, 0xe9, 0x99, 0xe8, 0x0e, 0xb4, 0x26, 0x79, 0xd9, 0x92, 0xa9, 0x45, 0x16, 0xfd, 0xc1,
+	0x52, 0x6c, 0xc0, 0x21, 0xfa, 0x81, 0x0d, 0x18, 0xf1, 0x62, 0x84, 0xd8, 0x22, 0xcf, 0x6c, 0xd7,
+	0x14, 0x10, 0xb9, 0x5d, 0x53, 0x40, 0x2b, 0x6f, 0x51, 0x5f, 0x09, 0xbe, 0xdb, 0xef, 0x05, 0x0e,
+	0xb6, 0xfb, 0xc8, 0x30, 0x76, 0x85, 0xa3, 0x03, 0x5b, 0x85, 0x92, 0xb8, 0x0a, 0xc9, 0xa7, 0xdf,
+	0x99, 0xf4, 0x67, 0x03, 0x1b, 0x93, 0x15, 0xa9, 0xcb, 0x6b, 0x5f, 0x8b, 0x62, 0x73, 0x1b, 0x53,
+	0x14, 0x97, 0xcc, 0xb8, 0x0f, 0xfa, 0x96, 0xdd, 0xef, 0xaa, 0x3b, 0x81, 0xa5, 0xf7, 0x40, 0x6b,
+	0xca, 0x2b, 0x7c, 0x48, 0xcb, 0x44, 0x23, 0x5c, 0x1a, 0xe1, 0xfd, 0x30, 0xc2, 0xe5, 0x14, 0xa8,
+	0xba, 0x2f, 0x02, 0x95, 0x36, 0x6b, 0xd6, 0xe3, 0x1f, 0xbe, 0x1b, 0xd8, 0xf5, 0x14, 0x76, 0xb8,
+	0x8c, 0xc9, 0x7b, 0xb1, 0x94, 0xbc, 0x17, 0x2b, 0x1f, 0x4b, 0xc2, 0x79, 0x24, 0x01, 0xd3, 0x3f,
+	0xa6, 0xc3, 0x4e, 0xac, 0x96, 0x8d, 0xd1, 0xd5, 0x02, 0x86, 0x74, 0x86, 0xc0, 0x24, 0x4b, 0xc0,
+	0x26, 0x5b, 0x8a, 0x6b, 0xb9, 0xc8, 0x08, 0x4f, 0xaa, 0x49, 0x5a, 0xe6, 0xdd, 0x13, 0x9f, 0x78,
+	0xd5, 0x84, 0x89, 0x77, 0x15, 0x3c, 0xef, 0x1b, 0x4a, 0x48, 0x77, 0x5a, 0xc8, 0xc5, 0xb7, 0x89,
+	0xcb, 0x9a, 0xeb, 0x25, 0x1e, 0xc5, 0xa5, 0xf0, 0x51, 0xfc, 0xf7, 0x24, 0x30, 0x97, 0xf6, 0x30,
+	0x13, 0x46, 0x03, 0x33, 0xed, 0x50, 0x0d, 0x13, 0xe5, 0x52, 0x66, 0x73, 0x4a, 0xe8, 0x69, 0x26,
+	0x48, 0x04, 0x93, 0xbc, 0x07, 0xb6, 0x0c, 0x6b, 0x87, 0x89, 0x40, 0x7f, 0x93, 0x37, 0x07, 0x1a,
+	0x20, 0xdd, 0x40, 0x9b, 0x06, 0x77, 0x99, 0x04, 0x05, 0x4a, 0x87, 0xd1, 0x6e, 0x18, 0x46, 0x32,
+	0xed, 0xb2, 0x56, 0xdb, 0x9f, 0x4b, 0x60, 0x2e, 0xad, 0xa5, 0x21, 0x1a, 0x55, 0x4b, 0xd7, 0xa8,
+	0xb4, 0x55, 0x26, 0x7c, 0xeb, 0xac, 0xf7, 0x71, 0x1f, 0x6b, 0xc2, 0x42, 0xdf, 0xcf, 0x6f, 0x9d,
+	0x84, 0xc6, 0x82, 0x6f, 0x9d, 0x0f, 0xa2, 0x95, 0x79, 0xbf, 0x75, 0x62, 0xe8, 0xfc, 0x5b, 0x27,
+	0x86, 0x5c, 0x9e, 0x92, 0x82, 0x57, 0xf8, 0x8e, 0xd3, 0xd9, 0xd8, 0xee, 0xbb, 0x9a, 0xb5, 0x53,
+	0xba, 0x86, 0xdf, 0x16, 0x4e, 0x04, 0xa1, 0x66, 0x98, 0x7a, 0x0a, 0x98, 0xee, 0x3a, 0x1d, 0xd5,
+	0xdd, 0xed, 0x61, 0xb5, 0x6f, 0x1b, 0x9e, 0xff, 0x6f, 0xa2, 0x35, 0xd9, 0x75, 0x3a, 0xf7, 0x77,
+	0x7b, 0xf8, 0x5d, 0xdb, 0x70, 0x4a, 0x0d, 0xa1, 0xf0, 0x5f, 0x74, 0xcd, 0x36, 0x5a, 0xb5, 0x1c,
+	0x57, 0x30, 0x7a, 0x94, 0x4a, 0x9c, 0xec, 0x7f, 0x6d, 0xcb, 0x34, 0x3d, 0x57, 0x0f, 0xb7, 0x24,
+	0x4c, 0xb4, 0xa6, 0x82, 0xc2, 0xa6, 0xa6, 0xfc, 0x99, 0xf0, 0x36, 0x8c, 0x77, 0x88, 0x49, 0x84,
+	0xe2, 0x56, 0x98, 0xcc, 0x7e, 0x93, 0x28, 0xa8, 0xe8, 0x1c, 0xdd, 0x0f, 0xb3, 0xcb, 0x47, 0x12,
+	0x78, 0x8e, 0x13, 0x5a, 0xf6, 0x62, 0x89, 0x1e, 0x58, 0x46, 0xbf, 0x8b, 0xcb, 0x96, 0xf7, 0x79,
+	0x00, 0xda, 0xdb, 0xc8, 0x34, 0xb1, 0x11, 0x68, 0x3b, 0xc1, 0x4a, 0x9a, 0x9a, 0xf2, 0x27, 0x12,
+	0x78, 0x3e, 0xa5, 0x1f, 0xbe, 0xaa, 0xd3, 0x5b, 0x62, 0x05, 0x53, 0xf6, 0xf5, 0xac, 0xca, 0x86,
+	0x50, 0x99, 0xa2, 0x61, 0xc4, 0xf2, 0x54, 0x3d, 0xcd, 0xc8, 0xdc, 0x09, 0x22, 0xb0, 0x1e, 0xd2,
+	0x00, 0x2c, 0x6e, 0x76, 0xfc, 0x45, 0xbe, 0xcf, 0x27, 0xdc, 0xc1, 0xf8, 0xae, 0x83, 0x31, 0x2f,
+	0x68, 0x2b, 0xaf, 0x21, 0x2a, 0x0e, 0xc9, 0x80, 0xc8, 0x21, 0x98, 0x06, 0x0c, 0x60, 0xca, 0x6d,
+	0xbc, 0xc5, 0xae, 0x94, 0xaf, 0x80, 0xf3, 0xb4, 0x33, 0x49, 0xbe, 0x06, 0xdf, 0x26, 0xcd, 0x8f,
+	0x44, 0xca, 0x6f, 0x4b, 0x40, 0x8e, 0xdd, 0xe9, 0xdf, 0x96, 0x1c, 0x4e, 0x43, 0x0e, 0x20, 0xfe,
+	0x39, 0xea, 0x7d, 0xbc, 0x5b, 0xab, 0xc4, 0x3c, 0x11, 0xc9, 0xa1, 0x47, 0xd5, 0x94, 0xd0, 0xa3,
+	0x39, 0x00, 0x02, 0xb3, 0x12, 0x0b, 0x62, 0x10, 0x4a, 0x94, 0x7f, 0x97, 0xc0, 0x2b, 0x99, 0x38,
+	0x31, 0xb5, 0x73, 0x9d, 0xf3, 0xe0, 0x16, 0x98, 0xe0, 0x65, 0x0e, 0x0b, 0x7c, 0x5a, 0x28, 0xec,
+	0xf1, 0x89, 0xba, 0x03, 0x02, 0x68, 0xf8, 0x2a, 0x80, 0x7d, 0x33, 0x60, 0xe5, 0x85, 0x30, 0x52,
+	0x4d, 0xa6, 0x5b, 0x47, 0xc4, 0x1a, 0xea, 0x3e, 0x53, 0x96, 0xe2, 0x1e, 0xa1, 0x55, 0x1e, 0x39,
+	0xc8, 0xd7, 0x73, 0x74, 0x20, 0x32, 0xba, 0x84, 0x04, 0x9c, 0xb8, 0x47, 0xc4, 0xaf, 0x2c, 0xea,
+	0x12, 0xf2, 0x01, 0xa2, 0x1e, 0x11, 0xbf, 0x22, 0xc9, 0x25, 0x14, 0xe3, 0xb6, 0x9f, 0x2e, 0xa1,
+	0xcc, 0x02, 0x54, 0xf7, 0x47, 0x80, 0xf2, 0x36, 0xa7, 0x5f, 0xf1, 0xb7, 0x5a, 0x3f, 0xf8, 0x73,
+	0x01, 0x19, 0x64, 0xbb, 0xe0, 0x3a, 0xca, 0x60, 0x9c, 0xfb, 0x50, 0x98, 0xc1, 0xd4, 0xbf, 0x86,
+	0xf7, 0x41, 0x95, 0xaf, 0xdf, 0xc9, 0x0b, 0x6f, 0x65, 0xb6, 0x04, 0xf8, 0x4d, 0xb1, 0x5f, 0xb7,
+	0x30, 0x7f, 0xab, 0x11, 0x38, 0x65, 0x8f, 0x1f, 0x7b, 0xe3, 0x5d, 0x62, 0x6a, 0x7f, 0x0d, 0x1c,
+	0x62, 0xc1, 0xaa, 0x79, 0x27, 0x59, 0xac, 0x6d, 0xd6, 0x30, 0xc7, 0x0b, 0x6c, 0x00, 0xc4, 0x08,
+	0x12, 0xbd, 0x39, 0x8b, 0x26, 0xef, 0x81, 0x49, 0x6a, 0xce, 0x51, 0xd1, 0x16, 0x31, 0x85, 0x96,
+	0xa0, 0x4d, 0x0b, 0x50, 0xc0, 0x06, 0xc1, 0x23, 0x3b, 0x2a, 0x0d, 0x0b, 0x66, 0x0b, 0xdf, 0xbb,
+	0x50, 0x3e, 0x14, 0x26, 0x69, 0x42, 0xaf, 0x7d, 0x03, 0xce, 0x38, 0xa3, 0xe9, 0xe4, 0x9d, 0x9b,
+	0x69, 0xba, 0xf9, 0x80, 0xca, 0xef, 0x26, 0x76, 0xe1, 0xbe, 0x8d, 0x4c, 0x67, 0x0b, 0xdb, 0x59,
+	0x94, 0xfb, 0x46, 0x92, 0x72, 0xd7, 0xf2, 0xf7, 0x90, 0xb7, 0x99, 0x4d, 0xba, 0x5f, 0x10, 0x02,
+	0x8d, 0x93, 0xfa, 0xcd, 0xb4, 0xfb, 0x06, 0x98, 0x70, 0x59, 0x19, 0x17, 0xef, 0x6a, 0xf1, 0xae,
+	0xf1, 0xdd, 0xdd, 0x87, 0x54, 0x7e, 0x5f, 0x70, 0xed, 0x05, 0xf7, 0xaf, 0x61, 0x53, 0xd3, 0xcd,
+	0xce, 0x4f, 0xbe, 0x8a, 0x4f, 0x78, 0xd4, 0xc4, 0xf0, 0xee, 0xfb, 0xc7, 0xb7, 0x43, 0x3d, 0xaf,
+	0x8a, 0x49, 0xd9, 0xc8, 0xdf, 0xbf, 0x08, 0x36, 0x5f, 0xc7, 0x0c, 0x57, 0xf9, 0x0d, 0x89, 0x87,
+	0x55, 0xc5, 0x18, 0x6d, 0xb8, 0xc8, 0xed, 0x3b, 0x59, 0xb4, 0x7c, 0x57, 0xdc, 0xdf, 0x46, 0xd3,
+	0x50, 0xdc, 0xe0, 0x3e, 0xf1, 0x23, 0xb0, 0x52, 0xfb, 0xc6, 0x84, 0xfa, 0x69, 0x62, 0xc3, 0xee,
+	0x52, 0xc3, 0xb1, 0x96, 0xd7, 0x26, 0x94, 0x30, 0x99, 0x03, 0x30, 0xf8, 0x5e, 0x30, 0x04, 0x95,
+	0x7c, 0x5f, 0x25, 0x01, 0x6e, 0xfc, 0x93, 0xd7, 0x97, 0x7f, 0x8d, 0xb9, 0xd9, 0xef, 0xe2, 0x47,
+	0x7e, 0xf8, 0x94, 0xe0, 0x81, 0xc3, 0x82, 0xcf, 0x6c, 0xa2, 0xc5, 0x2f, 0x43, 0x63, 0x51, 0x09,
+	0x8f, 0x85, 0x72, 0x19, 0x9c, 0x4a, 0x40, 0x64, 0x3a, 0x89, 0xee, 0x04, 0x29, 0xec, 0x4e, 0x50,
+	0xbe, 0x25, 0x2c, 0x70, 0xe1, 0xc1, 0x7d, 0xb2, 0x3b, 0x88, 0xec, 0x2a, 0x21, 0x76, 0x21, 0xa7,
+	0x5a, 0x62, 0x47, 0x02, 0xa7, 0x9a, 0x13, 0xaf, 0xce, 0xeb, 0x54, 0x4b, 0x68, 0x81, 0x3b, 0xd5,
+	0x12, 0xd0, 0xcb, 0x3b, 0x51, 0xf0, 0x00, 0x8b, 0x45, 0xcb, 0xc6, 0xd1, 0x88, 0x8e, 0x25, 0x70,
+	0x2a, 0xa1, 0x2e, 0x77, 0x0c, 0xc7, 0xd5, 0xc0, 0xc4, 0xdf, 0xe8, 0xf5, 0x6c, 0x6b, 0x40, 0xce,
+	0xbc, 0x1a, 0x5e, 0x45, 0xce, 0x36, 0x1f, 0xcd, 0x93, 0xe0, 0x50, 0xdb, 0xd2, 0x30, 0xb7, 0x3c,
+	0x1e, 0x68, 0x8d, 0xb5, 0x69, 0xd0, 0x42, 0x28, 0x86, 0x36, 0xfe, 0x70, 0x60, 0xc2, 0x46, 0x91,
+	0xba, 0xbc, 0x36, 0xfe, 0x28, 0x36, 0x37, 0x61, 0x47, 0x71, 0x45, 0xf3, 0x7d, 0x1a, 0x99, 0xfd,
+	0x30, 0xdf, 0xe7, 0xe4, 0x5e, 0xdd, 0x0f, 0xee, 0xe5, 0x4d, 0xba, 0x5f, 0xe7, 0x9f, 0xd0, 0x0f,
+	0x6d, 0xd4, 0xeb, 0x61, 0xad, 0xe1, 0x38, 0xd8, 0xdd, 0xe8, 0xf7, 0x7a, 0x81, 0x0f, 0xe4, 0x18,
+	0x38, 0x28, 0x7a, 0xed, 0xbc, 0x0b, 0x31, 0x1a, 0xa0, 0x12, 0x8a, 0x06, 0x88, 0x88, 0x5e, 0x2d,
+	0x2c, 0xfa, 0x00, 0x40, 0xb1, 0x53, 0xab, 0x96, 0xa1, 0x61, 0x3b, 0x3d, 0x0a, 0x01, 0x2e, 0x83,
+	0x31, 0xd4, 0xa5, 0x47, 0x5b, 0xda, 0xa1, 0x85, 0x79, 0xa2, 0xdd, 0xdf, 0x7e, 0x7c, 0xfa, 0x6c,
+	0x47, 0x77, 0xb7, 0xfb, 0x9b, 0xf3, 0x6d, 0xab, 0x5b, 0x67, 0x69, 0x74, 0xde, 0x3f, 0xaf, 0x3a,
+	0xda, 0xfb, 0x75, 0x62, 0x82, 0x73, 0xe6, 0x9b, 0xa6, 0xdb, 0x62, 0x4f, 0x2b, 0xff, 0x54, 0x61,
+	0x13, 0x2b, 0x49, 0x92, 0xc0, 0x75, 0xad, 0x61, 0xd3, 0xea, 0xf2, 0xd0, 0x57, 0x7a, 0x41, 0x8d,
+	0x5f, 0x3b, 0x17, 0xbe, 0xaa, 0x46, 0xb6, 0xe2, 0x29, 0x52, 0xc8, 0x57, 0x2d, 0x5c, 0xe0, 0x4e,
+	0x04, 0x87, 0x42, 0x32, 0x81, 0x4e, 0x85, 0x04, 0xe2, 0xd2, 0x2c, 0x5a, 0x3a, 0xdf, 0x7b, 0x3c,
+	0x2f, 0x83, 0xd7, 0x0d, 0xf2, 0xf9, 0xb9, 0x4d, 0xe5, 0x60, 0xdf, 0xb2, 0xcc, 0xb7, 0xe9, 0x95,
+	0xd1, 0xaf, 0x58, 0xf8, 0x75, 0x70, 0xc8, 0xbb, 0x74, 0x6a, 0x07, 0xf3, 0x1d, 0xba, 0xe2, 0xa2,
+	0xf3, 0x77, 0x14, 0x03, 0x8c, 0xcc, 0xbe, 0xb1, 0xe2, 0xb3, 0xef, 0x75, 0x76, 0xf2, 0xdd, 0xd0,
+	0xbb, 0x7d, 0x03, 0xb9, 0x38, 0x31, 0x7c, 0x3b, 0x1e, 0x53, 0xb0, 0x00, 0x4e, 0x04, 0x77, 0xae,
+	0x79, 0x5e, 0xeb, 0x65, 0x1d, 0x1b, 0x1a, 0x71, 0x4a, 0x98, 0xa8, 0xcb, 0x23, 0x9b, 0xe8, 0x6f,
+	0x16, 0x66, 0xd0, 0xc7, 0x3c, 0xa0, 0x80, 0x5e, 0x28, 0x7f, 0x58, 0x05, 0xca, 0xb0, 0xb6, 0x4b,
+	0x8c, 0x51, 0x08, 0x02, 0xae, 0x0e, 0x84, 0x02, 0xae, 0x98, 0x21, 0x89, 0xb9, 0x9e, 0xa7, 0x5b,
+	0xec, 0x0a, 0x9e, 0x06, 0x93, 0xde, 0x2f, 0x95, 0x72, 0x19, 0xa3, 0x0f, 0x01, 0xaf, 0xe8, 0x2e,
+	0x61, 0x44, 0xfc, 0x50, 0xc8, 0xee, 0x60, 0x97, 0xb9, 0xd3, 0xbd, 0x40, 0x84, 0x49, 0xaf, 0xcc,
+	0xf3, 0xa6, 0xbf, 0x0f, 0x66, 0x98, 0x3b, 0x5f, 0xdd, 0x22, 0xca, 0x38, 0xb5, 0x71, 0x3a, 0x0b,
+	0xae, 0xe7, 0x0f, 0x2d, 0x11, 0x05, 0xe6, 0x16, 0xbf, 0x9e, 0x50, 0xe6, 0xc0, 0x45, 0x30, 0x86,
+	0x07, 0x98, 0x7c, 0x1c, 0x4d, 0xd0, 0x46, 0x5e, 0x0a, 0xcd, 0x05, 0x9a, 0xa1, 0xca, 0xa7, 0xc2,
+	0x86, 0x6b, 0xeb, 0x66, 0x67, 0x89, 0xdc, 0xcd, 0x93, 0x3b, 0xbc, 0x47, 0xc3, 0x51, 0x07, 0x20,
+	0x2d, 0xea, 0x60, 0x32, 0x88, 0x3a, 0xb8, 0xf0, 0x9f, 0x3f, 0x0b, 0x0e, 0xd2, 0x21, 0x84, 0x3f,
+	0x90, 0x42, 0x59, 0x56, 0x70, 0x21, 0x87, 0x07, 0x22, 0x25, 0xa1, 0x4d, 0x5e, 0x1c, 0x09, 0xc3,
+	0x9b, 0x3e, 0xca, 0xe2, 0xcf, 0x7f, 0xef, 0xf3, 0x5f, 0xab, 0x5c, 0x83, 0x6f, 0xd6, 0x13, 0xc0,
+	0xea, 0x3e, 0x58, 0x3d, 0x96, 0xb9, 0xbb, 0x81, 0xdd, 0xfa, 0x1e, 0x35, 0x9f, 0x3d, 0x86, 0x7f,
+	0x25, 0x81, 0x19, 0x01, 0xbc, 0x61, 0x18, 0x39, 0x09, 0x26, 0x66, 0xc0, 0xc9, 0x8b, 0x23, 0x61,
+	0x30, 0x82, 0x6f, 0x52, 0x82, 0xaf, 0xc3, 0x8b, 0x05, 0x08, 0xc2, 0x1f, 0x4a, 0x00, 0xc6, 0x33,
+	0x99, 0xe0, 0x72, 0x3e, 0xe5, 0xd3, 0x52, 0xd6, 0xe4, 0x95, 0x91, 0x71, 0x18, 0xc9, 0x1b, 0x94,
+	0xe4, 0x75, 0xf8, 0x56, 0x5e, 0x92, 0xd4, 0x08, 0xba, 0xcd, 0x68, 0xfd, 0x81, 0xc4, 0x93, 0xa1,
+	0xe0, 0xb5, 0xbc, 0x73, 0x2b, 0x94, 0x6f, 0x25, 0x5f, 0x2f, 0xfa, 0x38, 0xe3, 0x73, 0x89, 0xf2,
+	0xf9, 0x2a, 0x9c, 0xcf, 0xca, 0xc7, 0x4b, 0x1b, 0x87, 0xff, 0x2a, 0x81, 0xd9, 0x56, 0x2c, 0x9d,
+	0x27, 0x6f, 0x67, 0x52, 0x12, 0x9e, 0xe4, 0xd5, 0xd1, 0x81, 0x18, 0xbf, 0x55, 0xca, 0x6f, 0x01,
+	0xbe, 0x93, 0x95, 0x5f, 0x34, 0x47, 0xc9, 0x5f, 0x7a, 0xff, 0x2c, 0x81, 0xa3, 0xd1, 0x66, 0xc8,
+	0xfa, 0x5b, 0xc9, 0xbb, 0x76, 0xca, 0x21, 0x3d, 0x24, 0x85, 0x4b, 0x79, 0x87, 0x92, 0xbe, 0x0a,
+	0xdf, 0x28, 0x4a, 0x1a, 0x7e, 0x58, 0x01, 0xb5, 0xc4, 0x8c, 0x23, 0xc2, 0xf8, 0x76, 0xde, 0x8e,
+	0x0e, 0x4b, 0xc9, 0x92, 0xef, 0x94, 0x84, 0xc6, 0xb8, 0xaf, 0x50, 0xee, 0x0d, 0xf8, 0x76, 0x56,
+	0xee, 0x3c, 0x77, 0x4a, 0x0d, 0xc2, 0x24, 0xd5, 0x01, 0x42, 0x64, 0x47, 0x3a, 0x1c, 0xc9, 0xb1,
+	0xc9, 0xbb, 0x1d, 0xa5, 0xa5, 0x4b, 0xc9, 0x2b, 0x23, 0xe3, 0x14, 0x65, 0x1b, 0x49, 0x0f, 0xf2,
+	0x67, 0xf7, 0x3f, 0x4a, 0x00, 0x46, 0x1a, 0x21, 0x43, 0xbd, 0x9c, 0x77, 0x70, 0x4a, 0x21, 0x9c,
+	0x9e, 0x37, 0xa5, 0xbc, 0x4d, 0x09, 0x5f, 0x81, 0x97, 0x0b, 0x12, 0x86, 0x4f, 0x2a, 0x43, 0x92,
+	0x8d, 0xe0, 0x5a, 0x81, 0xed, 0x74, 0x68, 0x2a, 0x94, 0xbc, 0x5e, 0x22, 0x22, 0xd3, 0xe0, 0x36,
+	0xd5, 0x60, 0x19, 0xde, 0xc8, 0xb1, 0x67, 0xa7, 0xfe, 0x41, 0x0e, 0xf8, 0x23, 0x09, 0x1c, 0x89,
+	0x3b, 0x1d, 0x57, 0x8b, 0x1e, 0x79, 0xa2, 0x69, 0x45, 0x72, 0xb3, 0x04, 0x24, 0x46, 0x7c, 0x8d,
+	0x12, 0xbf, 0x09, 0x57, 0x73, 0xbf, 0x7c, 0x7d, 0x77, 0x67, 0x7d, 0x4f, 0x70, 0xcc, 0x3d, 0x26,
+	0xaf, 0xb1, 0x63, 0xb1, 0xf6, 0xc8, 0xc4, 0x5f, 0x2d, 0x7a, 0x22, 0x1a, 0x91, 0xff, 0xb0, 0x9c,
+	0x29, 0x65, 0x81, 0xf2, 0x7f, 0x0b, 0x5e, 0x2d, 0xce, 0x1f, 0x7e, 0x21, 0x81, 0x13, 0xc9, 0x59,
+	0x49, 0xf0, 0x66, 0xae, 0x9e, 0x0e, 0x4d, 0x80, 0x92, 0x6f, 0x95, 0x82, 0xc5, 0x78, 0x37, 0x29,
+	0xef, 0x45, 0xd8, 0xc8, 0xca, 0xdb, 0x4b, 0x9b, 0x4a, 0x9a, 0xed, 0x7f, 0x23, 0x81, 0x29, 0x3f,
+	0x16, 0xa4, 0xd0, 0xf1, 0x39, 0xfe, 0xb7, 0x3e, 0xe4, 0x9b, 0xa3, 0x63, 0xf8, 0x5c, 0xaf, 0x50,
+	0xae, 0x17, 0xe1, 0x6b, 0x59, 0xb9, 0x06, 0x31, 0x2c, 0x9f, 0x4b, 0x60, 0xc2, 0x07, 0x84, 0x6f,
+	0xe7, 0xea, 0x54, 0x02, 0xab, 0x95, 0x11, 0x01, 0x7c, 0x4a, 0x77, 0x28, 0xa5, 0x15, 0xb8, 0x94,
+	0x9b, 0x52, 0x7d, 0x2f, 0x16, 0xc0, 0xf0, 0x18, 0xfe, 0x52, 0x05, 0xc8, 0xe9, 0xe9, 0x6c, 0xf0,
+	0x6e, 0xae, 0x6e, 0x3f, 0x35, 0x83, 0x4e, 0xbe, 0x57, 0x1a, 0x5e, 0x51, 0x39, 0xf4, 0xcd, 0xb6,
+	0xda, 0x16, 0x41, 0xd5, 0xee, 0x8e, 0x6f, 0x58, 0x82, 0x7f, 0x21, 0x81, 0x29, 0x31, 0xd9, 0x0e,
+	0xbe, 0x93, 0xab, 0xc3, 0x09, 0x39, 0x7c, 0x72, 0x63, 0x04, 0x04, 0x46, 0xf2, 0x1a, 0x25, 0x79,
+	0x19, 0xbe, 0x9e, 0x95, 0xe4, 0x26, 0x45, 0x51, 0xbd, 0x84, 0x40, 0xf8, 0x51, 0x05, 0x3c, 0x9b,
+	0x96, 0x9c, 0x57, 0x68, 0x7b, 0x4e, 0x03, 0x93, 0xd7, 0xca, 0x42, 0xf2, 0xa9, 0xdf, 0xa4, 0xd4,
+	0x6f, 0xc0, 0x85, 0xac, 0xd4, 0x77, 0x90, 0xd3, 0x55, 0xf5, 0x00, 0x52, 0x0d, 0x96, 0xf4, 0x87,
+	0x15, 0x70, 0x24, 0x96, 0x06, 0x06, 0x0b, 0x7c, 0x1e, 0x25, 0x27, 0xc5, 0xc9, 0xcd, 0x12, 0x90,
+	0x18, 0xed, 0x07, 0x94, 0xf6, 0x1a, 0xbc, 0x9b, 0xfd, 0xa3, 0x23, 0xfa, 0x97, 0xbf, 0xea, 0x7b,
+	0x9e, 0x39, 0xec, 0x71, 0x7d, 0x8f, 0xc7, 0x3c, 0x7b, 0xaf, 0xe8, 0x58, 0xab, 0x85, 0xe6, 0x40,
+	0x49, 0x2a, 0x0c, 0xcb, 0xfb, 0xcb, 0xff, 0x8a, 0x8e, 0xab, 0x00, 0xff, 0x57, 0x02, 0x47, 0x13,
+	0xd2, 0xb9, 0xe0, 0xcd, 0xdc, 0x27, 0xa9, 0xd4, 0x24, 0x37, 0xf9, 0x56, 0x29, 0x58, 0x8c, 0xf4,
+	0x5d, 0x4a, 0x7a, 0x15, 0x2e, 0x67, 0x3e, 0x97, 0x04, 0x9f, 0x5a, 0x0e, 0x47, 0xab, 0xef, 0xf9,
+	0x3b, 0xfc, 0x7f, 0x4b, 0xe0, 0x44, 0x42, 0x7b, 0x64, 0xd0, 0x73, 0xbf, 0x6a, 0x4b, 0xd3, 0x60,
+	0x78, 0x16, 0x5f, 0x01, 0xc3, 0x50, 0x82, 0x06, 0xf0, 0x8f, 0x25, 0x30, 0xc1, 0xb2, 0xe3, 0x10,
+	0xca, 0x69, 0x1b, 0x8a, 0x66, 0xe0, 0xc9, 0xd7, 0x8b, 0x3e, 0x1e, 0xde, 0xc3, 0x95, 0x0b, 0x59,
+	0x29, 0x0d, 0x28, 0x04, 0xf9, 0x7a, 0xbe, 0x2a, 0x9d, 0x87, 0xdf, 0x97, 0xc0, 0x8c, 0x90, 0xb0,
+	0x54, 0xe8, 0xb0, 0x15, 0xcf, 0x20, 0x93, 0x17, 0x47, 0xc2, 0x60, 0xd4, 0xde, 0xa2, 0xd4, 0x2e,
+	0xc1, 0x9f, 0xca, 0x4a, 0x8d, 0xa7, 0x59, 0x51, 0xd3, 0xc0, 0xbf, 0x49, 0x60, 0xf6, 0x5e, 0x2c,
+	0x8d, 0x26, 0xef, 0x8a, 0x4a, 0x49, 0x34, 0x92, 0x57, 0x47, 0x07, 0x2a, 0xfa, 0x26, 0x12, 0x72,
+	0x83, 0x54, 0x97, 0x40, 0xd5, 0xf7, 0x3c, 0xc7, 0xc5, 0x63, 0x62, 0x0e, 0x39, 0x1a, 0x6d, 0xa8,
+	0x90, 0xf9, 0xab, 0x1c, 0xda, 0x43, 0x92, 0xa7, 0x94, 0x06, 0xa5, 0xfd, 0x26, 0xbc, 0x52, 0x98,
+	0x36, 0xfc, 0x56, 0x25, 0x64, 0x8e, 0xe6, 0x59, 0x3f, 0xcd, 0x11, 0x1c, 0x01, 0xe1, 0x3c, 0x28,
+	0xf9, 0x66, 0x19, 0x50, 0x8c, 0xf0, 0xd7, 0x28, 0xe1, 0x0d, 0xb8, 0x5e, 0xc8, 0x28, 0xed, 0x65,
+	0x27, 0x39, 0xf5, 0xbd, 0x50, 0x29, 0xb3, 0x0b, 0xfd, 0x8b, 0x04, 0x66, 0xc2, 0xf9, 0x2d, 0x70,
+	0x29, 0xb7, 0x45, 0x23, 0x29, 0xc3, 0x47, 0x5e, 0x1e, 0x15, 0x86, 0x91, 0xbf, 0x45, 0xc9, 0x2f,
+	0xc1, 0xc5, 0xac, 0xe4, 0xe9, 0xa5, 0x1a, 0xfc, 0x71, 0x50, 0xf1, 0xb0, 0xf1, 0xb9, 0x04, 0x8e,
+	0x84, 0xdb, 0x21, 0x73, 0x7c, 0x29, 0xef, 0xd4, 0x2c, 0x83, 0x71, 0x6a, 0xc2, 0x52, 0x7e, 0xf3,
+	0x6e, 0x94, 0x31, 0x3d, 0x53, 0xc5, 0x32, 0x6e, 0x0a, 0x9d, 0xa9, 0xd2, 0x52, 0x90, 0xe4, 0x66,
+	0x09, 0x48, 0x45, 0xcf, 0x54, 0x5e, 0xce, 0x90, 0x2a, 0x2c, 0x6b, 0xfa, 0x32, 0x12, 0xb2, 0x6f,
+	0x0a, 0xbd, 0x8c, 0xe2, 0x49, 0x42, 0xf2, 0xe2, 0x48, 0x18, 0x45, 0x5f, 0x46, 0x24, 0x5f, 0xc8,
+	0x61, 0x28, 0x64, 0x85, 0x1e, 0x8d, 0x26, 0xb9, 0x14, 0xda, 0x98, 0x53, 0xf2, 0x81, 0xe4, 0xd5,
+	0xd1, 0x81, 0x8a, 0x0e, 0xa4, 0xde, 0x46, 0xea, 0xb6, 0xe5, 0xb8, 0xc2, 0x17, 0xd1, 0xdf, 0x4b,
+	0x60, 0x36, 0x94, 0x79, 0x42, 0xb8, 0xde, 0xc8, 0xdb, 0xc5, 0xa4, 0xcc, 0x1c, 0x79, 0x69, 0x44,
+	0x14, 0xc6, 0xf2, 0x3a, 0x65, 0xf9, 0x06, 0xbc, 0x94, 0x95, 0x25, 0xff, 0x93, 0xc3, 0x03, 0x8a,
+	0x43, 0x4c, 0xf1, 0x47, 0x62, 0x29, 0x27, 0x39, 0xf7, 0xa0, 0xb4, 0x3c, 0x19, 0x79, 0x79, 0x54,
+	0x98, 0xa2, 0x43, 0x19, 0xff, 0xdb, 0xc9, 0xf0, 0xb7, 0x2a, 0x60, 0x6e, 0x78, 0x36, 0x09, 0x6c,
+	0xe5, 0xea, 0x6e, 0xa6, 0x74, 0x1b, 0x79, 0xa3, 0x54, 0x4c, 0xa6, 0xc7, 0x3a, 0xd5, 0xe3, 0x16,
+	0x6c, 0x8e, 0x68, 0x93, 0x1f, 0x04, 0xdc, 0x7f, 0x2c, 0x18, 0xe6, 0x83, 0xac, 0x85, 0xc2, 0x86,
+	0xf9, 0x68, 0x72, 0x87, 0xdc, 0x2c, 0x01, 0xa9, 0x28, 0x7b, 0x9f, 0xb3, 0xff, 0x87, 0xb8, 0x85,
+	0xe3, 0xc7, 0xfb, 0x51, 0xcb, 0xbc, 0xdf, 0xe0, 0x48, 0x96, 0xf9, 0x11, 0x05, 0x18, 0x96, 0xba,
+	0x32, 0x82, 0x65, 0xde, 0x17, 0x80, 0x7c, 0x55, 0x1c, 0x89, 0xa5, 0x6b, 0xe4, 0x3d, 0x7b, 0xa4,
+	0x64, 0xa0, 0xc8, 0xcb, 0xa3, 0xc2, 0x14, 0xb6, 0xe5, 0xfa, 0x50, 0xf5, 0x3d, 0x6e, 0xb3, 0x7c,
+	0x5c, 0xdf, 0x64, 0xec, 0x7e, 0x2c, 0x81, 0x63, 0xb1, 0xb4, 0x88, 0x42, 0xa3, 0x9c, 0x96, 0x67,
+	0x92, 0x7f, 0x94, 0x53, 0x73, 0x3f, 0xf2, 0xdb, 0x39, 0x92, 0xc9, 0xb3, 0x52, 0x07, 0xfe, 0x9f,
+	0x04, 0x8e, 0xc7, 0x23, 0xcc, 0x09, 0xfd, 0x11, 0x3a, 0x1d, 0xc9, 0x73, 0x90, 0x6f, 0x96, 0x01,
+	0xc5, 0x04, 0xb8, 0x47, 0x05, 0x68, 0xc2, 0x95, 0xd1, 0x04, 0xf0, 0x33, 0x36, 0xc8, 0x2b, 0xe0,
+	0xb9, 0xd4, 0x74, 0x04, 0x22, 0xc4, 0x5a, 0xf1, 0xde, 0x27, 0xe7, 0x7d, 0xc8, 0xeb, 0x25, 0x22,
+	0x32, 0x59, 0x1e, 0x52, 0x59, 0xd6, 0xe1, 0xbd, 0xd1, 0x64, 0x61, 0x71, 0xff, 0x6a, 0x20, 0xcf,
+	0x93, 0x0a, 0xa8, 0xa5, 0xe5, 0x37, 0xe4, 0x0d, 0xc3, 0x18, 0x9e, 0xc2, 0x21, 0xdf, 0x29, 0x09,
+	0x8d, 0x49, 0xf2, 0x2e, 0x95, 0xe4, 0x1e, 0xbc, 0x53, 0xce, 0x4c, 0x51, 0x1d, 0x8f, 0x33, 0x71,
+	0x76, 0x88, 0x81, 0xef, 0x39, 0x9d, 0x1d, 0x09, 0xf1, 0xf4, 0x72, 0x63, 0x04, 0x84, 0xa2, 0xce,
+	0x8e, 0xb6, 0x65, 0xe3, 0xc0, 0x83, 0xf3, 0x77, 0x12, 0x98, 0x12, 0x33, 0x32, 0x72, 0x92, 0x4a,
+	0x48, 0x0f, 0x91, 0x1b, 0x23, 0x20, 0x14, 0x0d, 0x2d, 0x31, 0xf1, 0x23, 0x57, 0xe5, 0xe1, 0x16,
+	0xf5, 0x3d, 0x66, 0xcd, 0xf6, 0xac, 0xb9, 0x09, 0x89, 0x14, 0x85, 0xac, 0xb9, 0xe9, 0xb9, 0x27,
+	0xf2, 0xad, 0x52, 0xb0, 0x8a, 0x5a, 0x73, 0x39, 0x6f, 0xd5, 0x0e, 0xd0, 0xe0, 0x7f, 0x48, 0x60,
+	0xb6, 0x11, 0x8b, 0xd7, 0xcf, 0x7b, 0xec, 0x4a, 0xc9, 0x68, 0x90, 0x57, 0x47, 0x07, 0x2a, 0x1a,
+	0x50, 0xc2, 0x93, 0x10, 0x54, 0x9a, 0x1f, 0xb2, 0x8d, 0x9c, 0xed, 0xfa, 0x1e, 0x4b, 0x15, 0xa1,
+	0x26, 0xa3, 0xa3, 0xd1, 0xa6, 0x0a, 0x7d, 0x90, 0x96, 0x43, 0x7c, 0x48, 0x9e, 0x46, 0xfe, 0x63,
+	0x5b, 0x9c, 0x38, 0xfc, 0x1f, 0x29, 0x9c, 0x9c, 0xc0, 0xe2, 0xf2, 0xf3, 0x1d, 0xb8, 0x52, 0x53,
+	0x2e, 0xe4, 0x95, 0x91, 0x71, 0x8a, 0xfa, 0xe7, 0x76, 0x3c, 0x2c, 0x15, 0x11, 0x30, 0x96, 0xa2,
+	0xc0, 0x6c, 0x65, 0x8f, 0x05, 0x67, 0xcd, 0x8f, 0x24, 0x70, 0x3c, 0x31, 0x70, 0x3e, 0xe7, 0x21,
+	0x66, 0x58, 0xe0, 0xbf, 0x7c, 0xb3, 0x0c, 0xa8, 0xb0, 0x55, 0x5c, 0xc9, 0x1e, 0x33, 0xc7, 0xe0,
+	0x22, 0x11, 0x82, 0x57, 0xa5, 0xf3, 0x0b, 0x1b, 0xdf, 0xf9, 0x74, 0x4e, 0xfa, 0xee, 0xa7, 0x73,
+	0xd2, 0x27, 0x9f, 0xce, 0x49, 0xbf, 0xfc, 0xd9, 0xdc, 0x33, 0xdf, 0xfd, 0x6c, 0xee, 0x99, 0xbf,
+	0xfe, 0x6c, 0xee, 0x99, 0xaf, 0x5f, 0x11, 0x52, 0x4d, 0x38, 0xd2, 0xab, 0x89, 0xed, 0x3c, 0x0a,
+	0x5a, 0xa2, 0x19, 0x28, 0x9b, 0x63, 0xf4, 0xff, 0x08, 0xba, 0xf8, 0xff, 0x03, 0x00, 0xd7, 0x8a,
+	0x7f, 0xf3, 0x92, 0x6a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Queries a guardianSet by index.
+	GuardianSet(ctx context.Context, in *QueryGetGuardianSetRequest, opts ...grpc.CallOption) (*QueryGetGuardianSetResponse, error)
+	// Queries a list of guardianSet items.
+	GuardianSetAll(ctx context.Context, in *QueryAllGuardianSetRequest, opts ...grpc.CallOption) (*QueryAllGuardianSetResponse, error)
+	// Queries all guardian sets along with when they were added and whether
+	// they can still be used to verify VAAs.
+	GuardianSetHistory(ctx context.Context, in *QueryGuardianSetHistoryRequest, opts ...grpc.CallOption) (*QueryGuardianSetHistoryResponse, error)
+	// Queries a config by index.
+	Config(ctx context.Context, in *QueryGetConfigRequest, opts ...grpc.CallOption) (*QueryGetConfigResponse, error)
+	// Queries a replayProtection by index.
+	ReplayProtection(ctx context.Context, in *QueryGetReplayProtectionRequest, opts ...grpc.CallOption) (*QueryGetReplayProtectionResponse, error)
+	// Queries a list of replayProtection items.
+	ReplayProtectionAll(ctx context.Context, in *QueryAllReplayProtectionRequest, opts ...grpc.CallOption) (*QueryAllReplayProtectionResponse, error)
+	// Queries a list of executed governance VAA digests.
+	ExecutedGovernanceVAAAll(ctx context.Context, in *QueryAllExecutedGovernanceVAARequest, opts ...grpc.CallOption) (*QueryAllExecutedGovernanceVAAResponse, error)
+	// Queries a sequenceCounter by index.
+	SequenceCounter(ctx context.Context, in *QueryGetSequenceCounterRequest, opts ...grpc.CallOption) (*QueryGetSequenceCounterResponse, error)
+	// Queries a list of sequenceCounter items.
+	SequenceCounterAll(ctx context.Context, in *QueryAllSequenceCounterRequest, opts ...grpc.CallOption) (*QueryAllSequenceCounterResponse, error)
+	// Queries a ConsensusGuardianSetIndex by index.
+	ConsensusGuardianSetIndex(ctx context.Context, in *QueryGetConsensusGuardianSetIndexRequest, opts ...grpc.CallOption) (*QueryGetConsensusGuardianSetIndexResponse, error)
+	// Queries a GuardianValidator by index.
+	GuardianValidator(ctx context.Context, in *QueryGetGuardianValidatorRequest, opts ...grpc.CallOption) (*QueryGetGuardianValidatorResponse, error)
+	// Queries a list of GuardianValidator items.
+	GuardianValidatorAll(ctx context.Context, in *QueryAllGuardianValidatorRequest, opts ...grpc.CallOption) (*QueryAllGuardianValidatorResponse, error)
+	// Queries a list of LatestGuardianSetIndex items.
+	LatestGuardianSetIndex(ctx context.Context, in *QueryLatestGuardianSetIndexRequest, opts ...grpc.CallOption) (*QueryLatestGuardianSetIndexResponse, error)
+	AllowlistAll(ctx context.Context, in *QueryAllValidatorAllowlist, opts ...grpc.CallOption) (*QueryAllValidatorAllowlistResponse, error)
+	Allowlist(ctx context.Context, in *QueryValidatorAllowlist, opts ...grpc.CallOption) (*QueryValidatorAllowlistResponse, error)
+	IbcComposabilityMwContract(ctx context.Context, in *QueryIbcComposabilityMwContractRequest, opts ...grpc.CallOption) (*QueryIbcComposabilityMwContractResponse, error)
+	// Queries whether the token bridge is paused.
+	BridgePaused(ctx context.Context, in *QueryBridgePausedRequest, opts ...grpc.CallOption) (*QueryBridgePausedResponse, error)
+	WasmInstantiateAllowlistAll(ctx context.Context, in *QueryAllWasmInstantiateAllowlist, opts ...grpc.CallOption) (*QueryAllWasmInstantiateAllowlistResponse, error)
+	// Queries the emitter registered for a module on a chain.
+	RegisteredEmitter(ctx context.Context, in *QueryGetRegisteredEmitterRequest, opts ...grpc.CallOption) (*QueryGetRegisteredEmitterResponse, error)
+	// Queries a list of registered emitters.
+	RegisteredEmitterAll(ctx context.Context, in *QueryAllRegisteredEmitterRequest, opts ...grpc.CallOption) (*QueryAllRegisteredEmitterResponse, error)
+	// Queries an account allowlisted to submit governance VAAs.
+	GovernanceSubmitter(ctx context.Context, in *QueryGetGovernanceSubmitterRequest, opts ...grpc.CallOption) (*QueryGetGovernanceSubmitterResponse, error)
+	// Queries the accounts allowlisted to submit governance VAAs.
+	GovernanceSubmitterAll(ctx context.Context, in *QueryAllGovernanceSubmitterRequest, opts ...grpc.CallOption) (*QueryAllGovernanceSubmitterResponse, error)
+	// Verifies the guardian signatures of a VAA and returns its parsed body.
+	VerifyVaa(ctx context.Context, in *QueryVerifyVAARequest, opts ...grpc.CallOption) (*QueryVerifyVAAResponse, error)
+	// Queries archived VAAs by emitter chain, emitter address and sequence range.
+	ArchivedVAAAll(ctx context.Context, in *QueryAllArchivedVAARequest, opts ...grpc.CallOption) (*QueryAllArchivedVAAResponse, error)
+	// Queries the observation tally of a VAA digest.
+	ObservationTally(ctx context.Context, in *QueryGetObservationTallyRequest, opts ...grpc.CallOption) (*QueryGetObservationTallyResponse, error)
+	// Queries all observation tallies.
+	ObservationTallyAll(ctx context.Context, in *QueryAllObservationTallyRequest, opts ...grpc.CallOption) (*QueryAllObservationTallyResponse, error)
+	// Queries the guardian weights of a guardian set.
+	GuardianSetWeights(ctx context.Context, in *QueryGetGuardianSetWeightsRequest, opts ...grpc.CallOption) (*QueryGetGuardianSetWeightsResponse, error)
+	// Queries the rate limit of an emitter chain and its current flow.
+	ChainRateLimit(ctx context.Context, in *QueryGetChainRateLimitRequest, opts ...grpc.CallOption) (*QueryGetChainRateLimitResponse, error)
+	// Queries all emitter chain rate limits.
+	ChainRateLimitAll(ctx context.Context, in *QueryAllChainRateLimitRequest, opts ...grpc.CallOption) (*QueryAllChainRateLimitResponse, error)
+	// Queries the observations queued by the emitter chain rate limits.
+	QueuedObservationAll(ctx context.Context, in *QueryAllQueuedObservationRequest, opts ...grpc.CallOption) (*QueryAllQueuedObservationResponse, error)
+	// Queries the type URLs of the messages that are shut down.
+	MsgShutdownAll(ctx context.Context, in *QueryAllMsgShutdownRequest, opts ...grpc.CallOption) (*QueryAllMsgShutdownResponse, error)
+	// Queries the message types the interchain accounts of IBC connections may
+	// execute, optionally of a single connection.
+	IcaHostAllowlistAll(ctx context.Context, in *QueryAllIcaHostAllowlistRequest, opts ...grpc.CallOption) (*QueryAllIcaHostAllowlistResponse, error)
+	// Queries the volume the packet forward middleware forwarded through
+	// wormchain, optionally over a single channel.
+	ForwardVolumeAll(ctx context.Context, in *QueryAllForwardVolumeRequest, opts ...grpc.CallOption) (*QueryAllForwardVolumeResponse, error)
+	// Queries the maintenance window declared by governance and whether it is
+	// active at the current block.
+	MaintenanceWindow(ctx context.Context, in *QueryMaintenanceWindowRequest, opts ...grpc.CallOption) (*QueryMaintenanceWindowResponse, error)
+	// Queries the consensus guardian set together with the validators
+	// registered by its guardians.
+	ConsensusGuardianSetValidators(ctx context.Context, in *QueryConsensusGuardianSetValidatorsRequest, opts ...grpc.CallOption) (*QueryConsensusGuardianSetValidatorsResponse, error)
+	// Queries the latest heartbeat of a guardian.
+	GuardianHeartbeat(ctx context.Context, in *QueryGetGuardianHeartbeatRequest, opts ...grpc.CallOption) (*QueryGetGuardianHeartbeatResponse, error)
+	// Queries the latest heartbeats of all guardians that did not expire.
+	GuardianHeartbeatAll(ctx context.Context, in *QueryAllGuardianHeartbeatRequest, opts ...grpc.CallOption) (*QueryAllGuardianHeartbeatResponse, error)
+	// Queries the balance of an account of a global accountant contract.
+	AccountantBalance(ctx context.Context, in *QueryAccountantBalanceRequest, opts ...grpc.CallOption) (*QueryAccountantBalanceResponse, error)
+	// Queries the accounts of a global accountant contract.
+	AccountantAccountAll(ctx context.Context, in *QueryAllAccountantAccountRequest, opts ...grpc.CallOption) (*QueryAllAccountantAccountResponse, error)
+	// Queries the transfers committed by a global accountant contract, together
+	// with their digests.
+	AccountantTransferAll(ctx context.Context, in *QueryAllAccountantTransferRequest, opts ...grpc.CallOption) (*QueryAllAccountantTransferResponse, error)
+	// Queries the transfers a global accountant contract has not committed yet.
+	AccountantPendingTransferAll(ctx context.Context, in *QueryAllAccountantPendingTransferRequest, opts ...grpc.CallOption) (*QueryAllAccountantPendingTransferResponse, error)
+	// Queries whether a transfer is committed or pending in a global accountant
+	// contract.
+	AccountantTransferStatus(ctx context.Context, in *QueryAccountantTransferStatusRequest, opts ...grpc.CallOption) (*QueryAccountantTransferStatusResponse, error)
+	// Queries the core contract whose published messages are reported to the
+	// message posted hooks.
+	CoreContract(ctx context.Context, in *QueryCoreContractRequest, opts ...grpc.CallOption) (*QueryCoreContractResponse, error)
+	// Queries the next sequence of an emitter, either of the wormhole module or
+	// of a core contract.
+	NextSequence(ctx context.Context, in *QueryNextSequenceRequest, opts ...grpc.CallOption) (*QueryNextSequenceResponse, error)
+	// Queries the outstanding sequence reservations.
+	SequenceReservationAll(ctx context.Context, in *QueryAllSequenceReservationRequest, opts ...grpc.CallOption) (*QueryAllSequenceReservationResponse, error)
+	// Queries the approved code hash of a wasm code id.
+	ApprovedCodeHash(ctx context.Context, in *QueryGetApprovedCodeHashRequest, opts ...grpc.CallOption) (*QueryGetApprovedCodeHashResponse, error)
+	// Queries a list of approved code hashes.
+	ApprovedCodeHashAll(ctx context.Context, in *QueryAllApprovedCodeHashRequest, opts ...grpc.CallOption) (*QueryAllApprovedCodeHashResponse, error)
+	// Queries the denom, total supply and holders on wormchain of the wrapped
+	// asset of a token from another chain.
+	WrappedAssetSupply(ctx context.Context, in *QueryWrappedAssetSupplyRequest, opts ...grpc.CallOption) (*QueryWrappedAssetSupplyResponse, error)
+	// Runs the verification and execution of a governance VAA without
+	// committing its state changes, and returns the decoded action and the
+	// events its execution would emit.
+	SimulateGovernanceVAA(ctx context.Context, in *QuerySimulateGovernanceVAARequest, opts ...grpc.CallOption) (*QuerySimulateGovernanceVAAResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) GuardianSet(ctx context.Context, in *QueryGetGuardianSetRequest, opts ...grpc.CallOption) (*QueryGetGuardianSetResponse, error) {
+	out := new(QueryGetGuardianSetResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/GuardianSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GuardianSetAll(ctx context.Context, in *QueryAllGuardianSetRequest, opts ...grpc.CallOption) (*QueryAllGuardianSetResponse, error) {
+	out := new(QueryAllGuardianSetResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/GuardianSetAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GuardianSetHistory(ctx context.Context, in *QueryGuardianSetHistoryRequest, opts ...grpc.CallOption) (*QueryGuardianSetHistoryResponse, error) {
+	out := new(QueryGuardianSetHistoryResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/GuardianSetHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Config(ctx context.Context, in *QueryGetConfigRequest, opts ...grpc.CallOption) (*QueryGetConfigResponse, error) {
+	out := new(QueryGetConfigResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/Config", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReplayProtection(ctx context.Context, in *QueryGetReplayProtectionRequest, opts ...grpc.CallOption) (*QueryGetReplayProtectionResponse, error) {
+	out := new(QueryGetReplayProtectionResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/ReplayProtection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReplayProtectionAll(ctx context.Context, in *QueryAllReplayProtectionRequest, opts ...grpc.CallOption) (*QueryAllReplayProtectionResponse, error) {
+	out := new(QueryAllReplayProtectionResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/ReplayProtectionAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExecutedGovernanceVAAAll(ctx context.Context, in *QueryAllExecutedGovernanceVAARequest, opts ...grpc.CallOption) (*QueryAllExecutedGovernanceVAAResponse, error) {
+	out := new(QueryAllExecutedGovernanceVAAResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/ExecutedGovernanceVAAAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SequenceCounter(ctx context.Context, in *QueryGetSequenceCounterRequest, opts ...grpc.CallOption) (*QueryGetSequenceCounterResponse, error) {
+	out := new(QueryGetSequenceCounterResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/SequenceCounter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SequenceCounterAll(ctx context.Context, in *QueryAllSequenceCounterRequest, opts ...grpc.CallOption) (*QueryAllSequenceCounterResponse, error) {
+	out := new(QueryAllSequenceCounterResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/SequenceCounterAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ConsensusGuardianSetIndex(ctx context.Context, in *QueryGetConsensusGuardianSetIndexRequest, opts ...grpc.CallOption) (*QueryGetConsensusGuardianSetIndexResponse, error) {
+	out := new(QueryGetConsensusGuardianSetIndexResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/ConsensusGuardianSetIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GuardianValidator(ctx context.Context, in *QueryGetGuardianValidatorRequest, opts ...grpc.CallOption) (*QueryGetGuardianValidatorResponse, error) {
+	out := new(QueryGetGuardianValidatorResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/GuardianValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GuardianValidatorAll(ctx context.Context, in *QueryAllGuardianValidatorRequest, opts ...grpc.CallOption) (*QueryAllGuardianValidatorResponse, error) {
+	out := new(QueryAllGuardianValidatorResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/GuardianValidatorAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LatestGuardianSetIndex(ctx context.Context, in *QueryLatestGuardianSetIndexRequest, opts ...grpc.CallOption) (*QueryLatestGuardianSetIndexResponse, error) {
+	out := new(QueryLatestGuardianSetIndexResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/LatestGuardianSetIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowlistAll(ctx context.Context, in *QueryAllValidatorAllowlist, opts ...grpc.CallOption) (*QueryAllValidatorAllowlistResponse, error) {
+	out := new(QueryAllValidatorAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/AllowlistAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Allowlist(ctx context.Context, in *QueryValidatorAllowlist, opts ...grpc.CallOption) (*QueryValidatorAllowlistResponse, error) {
+	out := new(QueryValidatorAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/Allowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IbcComposabilityMwContract(ctx context.Context, in *QueryIbcComposabilityMwContractRequest, opts ...grpc.CallOption) (*QueryIbcComposabilityMwContractResponse, error) {
+	out := new(QueryIbcComposabilityMwContractResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/IbcComposabilityMwContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BridgePaused(ctx context.Context, in *QueryBridgePausedRequest, opts ...grpc.CallOption) (*QueryBridgePausedResponse, error) {
+	out := new(QueryBridgePausedResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/BridgePaused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WasmInstantiateAllowlistAll(ctx context.Context, in *QueryAllWasmInstantiateAllowlist, opts ...grpc.CallOption) (*QueryAllWasmInstantiateAllowlistResponse, error) {
+	out := new(QueryAllWasmInstantiateAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/WasmInstantiateAllowlistAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RegisteredEmitter(ctx context.Context, in *QueryGetRegisteredEmitterRequest, opts ...grpc.CallOption) (*QueryGetRegisteredEmitterResponse, error) {
+	out := new(QueryGetRegisteredEmitterResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/RegisteredEmitter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RegisteredEmitterAll(ctx context.Context, in *QueryAllRegisteredEmitterRequest, opts ...grpc.CallOption) (*QueryAllRegisteredEmitterResponse, error) {
+	out := new(QueryAllRegisteredEmitterResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/RegisteredEmitterAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GovernanceSubmitter(ctx context.Context, in *QueryGetGovernanceSubmitterRequest, opts ...grpc.CallOption) (*QueryGetGovernanceSubmitterResponse, error) {
+	out := new(QueryGetGovernanceSubmitterResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/GovernanceSubmitter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GovernanceSubmitterAll(ctx context.Context, in *QueryAllGovernanceSubmitterRequest, opts ...grpc.CallOption) (*QueryAllGovernanceSubmitterResponse, error) {
+	out := new(QueryAllGovernanceSubmitterResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/GovernanceSubmitterAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifyVaa(ctx context.Context, in *QueryVerifyVAARequest, opts ...grpc.CallOption) (*QueryVerifyVAAResponse, error) {
+	out := new(QueryVerifyVAAResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/VerifyVaa", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ArchivedVAAAll(ctx context.Context, in *QueryAllArchivedVAARequest, opts ...grpc.CallOption) (*QueryAllArchivedVAAResponse, error) {
+	out := new(QueryAllArchivedVAAResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/ArchivedVAAAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ObservationTally(ctx context.Context, in *QueryGetObservationTallyRequest, opts ...grpc.CallOption) (*QueryGetObservationTallyResponse, error) {
+	out := new(QueryGetObservationTallyResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/ObservationTally", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ObservationTallyAll(ctx context.Context, in *QueryAllObservationTallyRequest, opts ...grpc.CallOption) (*QueryAllObservationTallyResponse, error) {
+	out := new(QueryAllObservationTallyResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/ObservationTallyAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GuardianSetWeights(ctx context.Context, in *QueryGetGuardianSetWeightsRequest, opts ...grpc.CallOption) (*QueryGetGuardianSetWeightsResponse, error) {
+	out := new(QueryGetGuardianSetWeightsResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/GuardianSetWeights", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChainRateLimit(ctx context.Context, in *QueryGetChainRateLimitRequest, opts ...grpc.CallOption) (*QueryGetChainRateLimitResponse, error) {
+	out := new(QueryGetChainRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/ChainRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChainRateLimitAll(ctx context.Context, in *QueryAllChainRateLimitRequest, opts ...grpc.CallOption) (*QueryAllChainRateLimitResponse, error) {
+	out := new(QueryAllChainRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/ChainRateLimitAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueuedObservationAll(ctx context.Context, in *QueryAllQueuedObservationRequest, opts ...grpc.CallOption) (*QueryAllQueuedObservationResponse, error) {
+	out := new(QueryAllQueuedObservationResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/QueuedObservationAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MsgShutdownAll(ctx context.Context, in *QueryAllMsgShutdownRequest, opts ...grpc.CallOption) (*QueryAllMsgShutdownResponse, error) {
+	out := new(QueryAllMsgShutdownResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/MsgShutdownAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IcaHostAllowlistAll(ctx context.Context, in *QueryAllIcaHostAllowlistRequest, opts ...grpc.CallOption) (*QueryAllIcaHostAllowlistResponse, error) {
+	out := new(QueryAllIcaHostAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/IcaHostAllowlistAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ForwardVolumeAll(ctx context.Context, in *QueryAllForwardVolumeRequest, opts ...grpc.CallOption) (*QueryAllForwardVolumeResponse, error) {
+	out := new(QueryAllForwardVolumeResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/ForwardVolumeAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MaintenanceWindow(ctx context.Context, in *QueryMaintenanceWindowRequest, opts ...grpc.CallOption) (*QueryMaintenanceWindowResponse, error) {
+	out := new(QueryMaintenanceWindowResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/MaintenanceWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ConsensusGuardianSetValidators(ctx context.Context, in *QueryConsensusGuardianSetValidatorsRequest, opts ...grpc.CallOption) (*QueryConsensusGuardianSetValidatorsResponse, error) {
+	out := new(QueryConsensusGuardianSetValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/ConsensusGuardianSetValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GuardianHeartbeat(ctx context.Context, in *QueryGetGuardianHeartbeatRequest, opts ...grpc.CallOption) (*QueryGetGuardianHeartbeatResponse, error) {
+	out := new(QueryGetGuardianHeartbeatResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/GuardianHeartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GuardianHeartbeatAll(ctx context.Context, in *QueryAllGuardianHeartbeatRequest, opts ...grpc.CallOption) (*QueryAllGuardianHeartbeatResponse, error) {
+	out := new(QueryAllGuardianHeartbeatResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/GuardianHeartbeatAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountantBalance(ctx context.Context, in *QueryAccountantBalanceRequest, opts ...grpc.CallOption) (*QueryAccountantBalanceResponse, error) {
+	out := new(QueryAccountantBalanceResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/AccountantBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountantAccountAll(ctx context.Context, in *QueryAllAccountantAccountRequest, opts ...grpc.CallOption) (*QueryAllAccountantAccountResponse, error) {
+	out := new(QueryAllAccountantAccountResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/AccountantAccountAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountantTransferAll(ctx context.Context, in *QueryAllAccountantTransferRequest, opts ...grpc.CallOption) (*QueryAllAccountantTransferResponse, error) {
+	out := new(QueryAllAccountantTransferResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/AccountantTransferAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountantPendingTransferAll(ctx context.Context, in *QueryAllAccountantPendingTransferRequest, opts ...grpc.CallOption) (*QueryAllAccountantPendingTransferResponse, error) {
+	out := new(QueryAllAccountantPendingTransferResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/AccountantPendingTransferAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountantTransferStatus(ctx context.Context, in *QueryAccountantTransferStatusRequest, opts ...grpc.CallOption) (*QueryAccountantTransferStatusResponse, error) {
+	out := new(QueryAccountantTransferStatusResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/AccountantTransferStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CoreContract(ctx context.Context, in *QueryCoreContractRequest, opts ...grpc.CallOption) (*QueryCoreContractResponse, error) {
+	out := new(QueryCoreContractResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/CoreContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NextSequence(ctx context.Context, in *QueryNextSequenceRequest, opts ...grpc.CallOption) (*QueryNextSequenceResponse, error) {
+	out := new(QueryNextSequenceResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/NextSequence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SequenceReservationAll(ctx context.Context, in *QueryAllSequenceReservationRequest, opts ...grpc.CallOption) (*QueryAllSequenceReservationResponse, error) {
+	out := new(QueryAllSequenceReservationResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/SequenceReservationAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ApprovedCodeHash(ctx context.Context, in *QueryGetApprovedCodeHashRequest, opts ...grpc.CallOption) (*QueryGetApprovedCodeHashResponse, error) {
+	out := new(QueryGetApprovedCodeHashResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/ApprovedCodeHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ApprovedCodeHashAll(ctx context.Context, in *QueryAllApprovedCodeHashRequest, opts ...grpc.CallOption) (*QueryAllApprovedCodeHashResponse, error) {
+	out := new(QueryAllApprovedCodeHashResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/ApprovedCodeHashAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WrappedAssetSupply(ctx context.Context, in *QueryWrappedAssetSupplyRequest, opts ...grpc.CallOption) (*QueryWrappedAssetSupplyResponse, error) {
+	out := new(QueryWrappedAssetSupplyResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/WrappedAssetSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateGovernanceVAA(ctx context.Context, in *QuerySimulateGovernanceVAARequest, opts ...grpc.CallOption) (*QuerySimulateGovernanceVAAResponse, error) {
+	out := new(QuerySimulateGovernanceVAAResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Query/SimulateGovernanceVAA", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Queries a guardianSet by index.
+	GuardianSet(context.Context, *QueryGetGuardianSetRequest) (*QueryGetGuardianSetResponse, error)
+	// Queries a list of guardianSet items.
+	GuardianSetAll(context.Context, *QueryAllGuardianSetRequest) (*QueryAllGuardianSetResponse, error)
+	// Queries all guardian sets along with when they were added and whether
+	// they can still be used to verify VAAs.
+	GuardianSetHistory(context.Context, *QueryGuardianSetHistoryRequest) (*QueryGuardianSetHistoryResponse, error)
+	// Queries a config by index.
+	Config(context.Context, *QueryGetConfigRequest) (*QueryGetConfigResponse, error)
+	// Queries a replayProtection by index.
+	ReplayProtection(context.Context, *QueryGetReplayProtectionRequest) (*QueryGetReplayProtectionResponse, error)
+	// Queries a list of replayProtection items.
+	ReplayProtectionAll(context.Context, *QueryAllReplayProtectionRequest) (*QueryAllReplayProtectionResponse, error)
+	// Queries a list of executed governance VAA digests.
+	ExecutedGovernanceVAAAll(context.Context, *QueryAllExecutedGovernanceVAARequest) (*QueryAllExecutedGovernanceVAAResponse, error)
+	// Queries a sequenceCounter by index.
+	SequenceCounter(context.Context, *QueryGetSequenceCounterRequest) (*QueryGetSequenceCounterResponse, error)
+	// Queries a list of sequenceCounter items.
+	SequenceCounterAll(context.Context, *QueryAllSequenceCounterRequest) (*QueryAllSequenceCounterResponse, error)
+	// Queries a ConsensusGuardianSetIndex by index.
+	ConsensusGuardianSetIndex(context.Context, *QueryGetConsensusGuardianSetIndexRequest) (*QueryGetConsensusGuardianSetIndexResponse, error)
+	// Queries a GuardianValidator by index.
+	GuardianValidator(context.Context, *QueryGetGuardianValidatorRequest) (*QueryGetGuardianValidatorResponse, error)
+	// Queries a list of GuardianValidator items.
+	GuardianValidatorAll(context.Context, *QueryAllGuardianValidatorRequest) (*QueryAllGuardianValidatorResponse, error)
+	// Queries a list of LatestGuardianSetIndex items.
+	LatestGuardianSetIndex(context.Context, *QueryLatestGuardianSetIndexRequest) (*QueryLatestGuardianSetIndexResponse, error)
+	AllowlistAll(context.Context, *QueryAllValidatorAllowlist) (*QueryAllValidatorAllowlistResponse, error)
+	Allowlist(context.Context, *QueryValidatorAllowlist) (*QueryValidatorAllowlistResponse, error)
+	IbcComposabilityMwContract(context.Context, *QueryIbcComposabilityMwContractRequest) (*QueryIbcComposabilityMwContractResponse, error)
+	// Queries whether the token bridge is paused.
+	BridgePaused(context.Context, *QueryBridgePausedRequest) (*QueryBridgePausedResponse, error)
+	WasmInstantiateAllowlistAll(context.Context, *QueryAllWasmInstantiateAllowlist) (*QueryAllWasmInstantiateAllowlistResponse, error)
+	// Queries the emitter registered for a module on a chain.
+	RegisteredEmitter(context.Context, *QueryGetRegisteredEmitterRequest) (*QueryGetRegisteredEmitterResponse, error)
+	// Queries a list of registered emitters.
+	RegisteredEmitterAll(context.Context, *QueryAllRegisteredEmitterRequest) (*QueryAllRegisteredEmitterResponse, error)
+	// Queries an account allowlisted to submit governance VAAs.
+	GovernanceSubmitter(context.Context, *QueryGetGovernanceSubmitterRequest) (*QueryGetGovernanceSubmitterResponse, error)
+	// Queries the accounts allowlisted to submit governance VAAs.
+	GovernanceSubmitterAll(context.Context, *QueryAllGovernanceSubmitterRequest) (*QueryAllGovernanceSubmitterResponse, error)
+	// Verifies the guardian signatures of a VAA and returns its parsed body.
+	VerifyVaa(context.Context, *QueryVerifyVAARequest) (*QueryVerifyVAAResponse, error)
+	// Queries archived VAAs by emitter chain, emitter address and sequence range.
+	ArchivedVAAAll(context.Context, *QueryAllArchivedVAARequest) (*QueryAllArchivedVAAResponse, error)
+	// Queries the observation tally of a VAA digest.
+	ObservationTally(context.Context, *QueryGetObservationTallyRequest) (*QueryGetObservationTallyResponse, error)
+	// Queries all observation tallies.
+	ObservationTallyAll(context.Context, *QueryAllObservationTallyRequest) (*QueryAllObservationTallyResponse, error)
+	// Queries the guardian weights of a guardian set.
+	GuardianSetWeights(context.Context, *QueryGetGuardianSetWeightsRequest) (*QueryGetGuardianSetWeightsResponse, error)
+	// Queries the rate limit of an emitter chain and its current flow.
+	ChainRateLimit(context.Context, *QueryGetChainRateLimitRequest) (*QueryGetChainRateLimitResponse, error)
+	// Queries all emitter chain rate limits.
+	ChainRateLimitAll(context.Context, *QueryAllChainRateLimitRequest) (*QueryAllChainRateLimitResponse, error)
+	// Queries the observations queued by the emitter chain rate limits.
+	QueuedObservationAll(context.Context, *QueryAllQueuedObservationRequest) (*QueryAllQueuedObservationResponse, error)
+	// Queries the type URLs of the messages that are shut down.
+	MsgShutdownAll(context.Context, *QueryAllMsgShutdownRequest) (*QueryAllMsgShutdownResponse, error)
+	// Queries the message types the interchain accounts of IBC connections may
+	// execute, optionally of a single connection.
+	IcaHostAllowlistAll(context.Context, *QueryAllIcaHostAllowlistRequest) (*QueryAllIcaHostAllowlistResponse, error)
+	// Queries the volume the packet forward middleware forwarded through
+	// wormchain, optionally over a single channel.
+	ForwardVolumeAll(context.Context, *QueryAllForwardVolumeRequest) (*QueryAllForwardVolumeResponse, error)
+	// Queries the maintenance window declared by governance and whether it is
+	// active at the current block.
+	MaintenanceWindow(context.Context, *QueryMaintenanceWindowRequest) (*QueryMaintenanceWindowResponse, error)
+	// Queries the consensus guardian set together with the validators
+	// registered by its guardians.
+	ConsensusGuardianSetValidators(context.Context, *QueryConsensusGuardianSetValidatorsRequest) (*QueryConsensusGuardianSetValidatorsResponse, error)
+	// Queries the latest heartbeat of a guardian.
+	GuardianHeartbeat(context.Context, *QueryGetGuardianHeartbeatRequest) (*QueryGetGuardianHeartbeatResponse, error)
+	// Queries the latest heartbeats of all guardians that did not expire.
+	GuardianHeartbeatAll(context.Context, *QueryAllGuardianHeartbeatRequest) (*QueryAllGuardianHeartbeatResponse, error)
+	// Queries the balance of an account of a global accountant contract.
+	AccountantBalance(context.Context, *QueryAccountantBalanceRequest) (*QueryAccountantBalanceResponse, error)
+	// Queries the accounts of a global accountant contract.
+	AccountantAccountAll(context.Context, *QueryAllAccountantAccountRequest) (*QueryAllAccountantAccountResponse, error)
+	// Queries the transfers committed by a global accountant contract, together
+	// with their digests.
+	AccountantTransferAll(context.Context, *QueryAllAccountantTransferRequest) (*QueryAllAccountantTransferResponse, error)
+	// Queries the transfers a global accountant contract has not committed yet.
+	AccountantPendingTransferAll(context.Context, *QueryAllAccountantPendingTransferRequest) (*QueryAllAccountantPendingTransferResponse, error)
+	// Queries whether a transfer is committed or pending in a global accountant
+	// contract.
+	AccountantTransferStatus(context.Context, *QueryAccountantTransferStatusRequest) (*QueryAccountantTransferStatusResponse, error)
+	// Queries the core contract whose published messages are reported to the
+	// message posted hooks.
+	CoreContract(context.Context, *QueryCoreContractRequest) (*QueryCoreContractResponse, error)
+	// Queries the next sequence of an emitter, either of the wormhole module or
+	// of a core contract.
+	NextSequence(context.Context, *QueryNextSequenceRequest) (*QueryNextSequenceResponse, error)
+	// Queries the outstanding sequence reservations.
+	SequenceReservationAll(context.Context, *QueryAllSequenceReservationRequest) (*QueryAllSequenceReservationResponse, error)
+	// Queries the approved code hash of a wasm code id.
+	ApprovedCodeHash(context.Context, *QueryGetApprovedCodeHashRequest) (*QueryGetApprovedCodeHashResponse, error)
+	// Queries a list of approved code hashes.
+	ApprovedCodeHashAll(context.Context, *QueryAllApprovedCodeHashRequest) (*QueryAllApprovedCodeHashResponse, error)
+	// Queries the denom, total supply and holders on wormchain of the wrapped
+	// asset of a token from another chain.
+	WrappedAssetSupply(context.Context, *QueryWrappedAssetSupplyRequest) (*QueryWrappedAssetSupplyResponse, error)
+	// Runs the verification and execution of a governance VAA without
+	// committing its state changes, and returns the decoded action and the
+	// events its execution would emit.
+	SimulateGovernanceVAA(context.Context, *QuerySimulateGovernanceVAARequest) (*QuerySimulateGovernanceVAAResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) GuardianSet(ctx context.Context, req *QueryGetGuardianSetRequest) (*QueryGetGuardianSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GuardianSet not implemented")
+}
+func (*UnimplementedQueryServer) GuardianSetAll(ctx context.Context, req *QueryAllGuardianSetRequest) (*QueryAllGuardianSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GuardianSetAll not implemented")
+}
+func (*UnimplementedQueryServer) GuardianSetHistory(ctx context.Context, req *QueryGuardianSetHistoryRequest) (*QueryGuardianSetHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GuardianSetHistory not implemented")
+}
+func (*UnimplementedQueryServer) Config(ctx context.Context, req *QueryGetConfigRequest) (*QueryGetConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Config not implemented")
+}
+func (*UnimplementedQueryServer) ReplayProtection(ctx context.Context, req *QueryGetReplayProtectionRequest) (*QueryGetReplayProtectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayProtection not implemented")
+}
+func (*UnimplementedQueryServer) ReplayProtectionAll(ctx context.Context, req *QueryAllReplayProtectionRequest) (*QueryAllReplayProtectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayProtectionAll not implemented")
+}
+func (*UnimplementedQueryServer) ExecutedGovernanceVAAAll(ctx context.Context, req *QueryAllExecutedGovernanceVAARequest) (*QueryAllExecutedGovernanceVAAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecutedGovernanceVAAAll not implemented")
+}
+func (*UnimplementedQueryServer) SequenceCounter(ctx context.Context, req *QueryGetSequenceCounterRequest) (*QueryGetSequenceCounterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SequenceCounter not implemented")
+}
+func (*UnimplementedQueryServer) SequenceCounterAll(ctx context.Context, req *QueryAllSequenceCounterRequest) (*QueryAllSequenceCounterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SequenceCounterAll not implemented")
+}
+func (*UnimplementedQueryServer) ConsensusGuardianSetIndex(ctx context.Context, req *QueryGetConsensusGuardianSetIndexRequest) (*QueryGetConsensusGuardianSetIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsensusGuardianSetIndex not implemented")
+}
+func (*UnimplementedQueryServer) GuardianValidator(ctx context.Context, req *QueryGetGuardianValidatorRequest) (*QueryGetGuardianValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GuardianValidator not implemented")
+}
+func (*UnimplementedQueryServer) GuardianValidatorAll(ctx context.Context, req *QueryAllGuardianValidatorRequest) (*QueryAllGuardianValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GuardianValidatorAll not implemented")
+}
+func (*UnimplementedQueryServer) LatestGuardianSetIndex(ctx context.Context, req *QueryLatestGuardianSetIndexRequest) (*QueryLatestGuardianSetIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestGuardianSetIndex not implemented")
+}
+func (*UnimplementedQueryServer) AllowlistAll(ctx context.Context, req *QueryAllValidatorAllowlist) (*QueryAllValidatorAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowlistAll not implemented")
+}
+func (*UnimplementedQueryServer) Allowlist(ctx context.Context, req *QueryValidatorAllowlist) (*QueryValidatorAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Allowlist not implemented")
+}
+func (*UnimplementedQueryServer) IbcComposabilityMwContract(ctx context.Context, req *QueryIbcComposabilityMwContractRequest) (*QueryIbcComposabilityMwContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IbcComposabilityMwContract not implemented")
+}
+func (*UnimplementedQueryServer) BridgePaused(ctx context.Context, req *QueryBridgePausedRequest) (*QueryBridgePausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgePaused not implemented")
+}
+func (*UnimplementedQueryServer) WasmInstantiateAllowlistAll(ctx context.Context, req *QueryAllWasmInstantiateAllowlist) (*QueryAllWasmInstantiateAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WasmInstantiateAllowlistAll not implemented")
+}
+func (*UnimplementedQueryServer) RegisteredEmitter(ctx context.Context, req *QueryGetRegisteredEmitterRequest) (*QueryGetRegisteredEmitterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisteredEmitter not implemented")
+}
+func (*UnimplementedQueryServer) RegisteredEmitterAll(ctx context.Context, req *QueryAllRegisteredEmitterRequest) (*QueryAllRegisteredEmitterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisteredEmitterAll not implemented")
+}
+func (*UnimplementedQueryServer) GovernanceSubmitter(ctx context.Context, req *QueryGetGovernanceSubmitterRequest) (*QueryGetGovernanceSubmitterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovernanceSubmitter not implemented")
+}
+func (*UnimplementedQueryServer) GovernanceSubmitterAll(ctx context.Context, req *QueryAllGovernanceSubmitterRequest) (*QueryAllGovernanceSubmitterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovernanceSubmitterAll not implemented")
+}
+func (*UnimplementedQueryServer) VerifyVaa(ctx context.Context, req *QueryVerifyVAARequest) (*QueryVerifyVAAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyVaa not implemented")
+}
+func (*UnimplementedQueryServer) ArchivedVAAAll(ctx context.Context, req *QueryAllArchivedVAARequest) (*QueryAllArchivedVAAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchivedVAAAll not implemented")
+}
+func (*UnimplementedQueryServer) ObservationTally(ctx context.Context, req *QueryGetObservationTallyRequest) (*QueryGetObservationTallyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObservationTally not implemented")
+}
+func (*UnimplementedQueryServer) ObservationTallyAll(ctx context.Context, req *QueryAllObservationTallyRequest) (*QueryAllObservationTallyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObservationTallyAll not implemented")
+}
+func (*UnimplementedQueryServer) GuardianSetWeights(ctx context.Context, req *QueryGetGuardianSetWeightsRequest) (*QueryGetGuardianSetWeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GuardianSetWeights not implemented")
+}
+func (*UnimplementedQueryServer) ChainRateLimit(ctx context.Context, req *QueryGetChainRateLimitRequest) (*QueryGetChainRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainRateLimit not implemented")
+}
+func (*UnimplementedQueryServer) ChainRateLimitAll(ctx context.Context, req *QueryAllChainRateLimitRequest) (*QueryAllChainRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainRateLimitAll not implemented")
+}
+func (*UnimplementedQueryServer) QueuedObservationAll(ctx context.Context, req *QueryAllQueuedObservationRequest) (*QueryAllQueuedObservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedObservationAll not implemented")
+}
+func (*UnimplementedQueryServer) MsgShutdownAll(ctx context.Context, req *QueryAllMsgShutdownRequest) (*QueryAllMsgShutdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgShutdownAll not implemented")
+}
+func (*UnimplementedQueryServer) IcaHostAllowlistAll(ctx context.Context, req *QueryAllIcaHostAllowlistRequest) (*QueryAllIcaHostAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IcaHostAllowlistAll not implemented")
+}
+func (*UnimplementedQueryServer) ForwardVolumeAll(ctx context.Context, req *QueryAllForwardVolumeRequest) (*QueryAllForwardVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForwardVolumeAll not implemented")
+}
+func (*UnimplementedQueryServer) MaintenanceWindow(ctx context.Context, req *QueryMaintenanceWindowRequest) (*QueryMaintenanceWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MaintenanceWindow not implemented")
+}
+func (*UnimplementedQueryServer) ConsensusGuardianSetValidators(ctx context.Context, req *QueryConsensusGuardianSetValidatorsRequest) (*QueryConsensusGuardianSetValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsensusGuardianSetValidators not implemented")
+}
+func (*UnimplementedQueryServer) GuardianHeartbeat(ctx context.Context, req *QueryGetGuardianHeartbeatRequest) (*QueryGetGuardianHeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GuardianHeartbeat not implemented")
+}
+func (*UnimplementedQueryServer) GuardianHeartbeatAll(ctx context.Context, req *QueryAllGuardianHeartbeatRequest) (*QueryAllGuardianHeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GuardianHeartbeatAll not implemented")
+}
+func (*UnimplementedQueryServer) AccountantBalance(ctx context.Context, req *QueryAccountantBalanceRequest) (*QueryAccountantBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountantBalance not implemented")
+}
+func (*UnimplementedQueryServer) AccountantAccountAll(ctx context.Context, req *QueryAllAccountantAccountRequest) (*QueryAllAccountantAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountantAccountAll not implemented")
+}
+func (*UnimplementedQueryServer) AccountantTransferAll(ctx context.Context, req *QueryAllAccountantTransferRequest) (*QueryAllAccountantTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountantTransferAll not implemented")
+}
+func (*UnimplementedQueryServer) AccountantPendingTransferAll(ctx context.Context, req *QueryAllAccountantPendingTransferRequest) (*QueryAllAccountantPendingTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountantPendingTransferAll not implemented")
+}
+func (*UnimplementedQueryServer) AccountantTransferStatus(ctx context.Context, req *QueryAccountantTransferStatusRequest) (*QueryAccountantTransferStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountantTransferStatus not implemented")
+}
+func (*UnimplementedQueryServer) CoreContract(ctx context.Context, req *QueryCoreContractRequest) (*QueryCoreContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CoreContract not implemented")
+}
+func (*UnimplementedQueryServer) NextSequence(ctx context.Context, req *QueryNextSequenceRequest) (*QueryNextSequenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextSequence not implemented")
+}
+func (*UnimplementedQueryServer) SequenceReservationAll(ctx context.Context, req *QueryAllSequenceReservationRequest) (*QueryAllSequenceReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SequenceReservationAll not implemented")
+}
+func (*UnimplementedQueryServer) ApprovedCodeHash(ctx context.Context, req *QueryGetApprovedCodeHashRequest) (*QueryGetApprovedCodeHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApprovedCodeHash not implemented")
+}
+func (*UnimplementedQueryServer) ApprovedCodeHashAll(ctx context.Context, req *QueryAllApprovedCodeHashRequest) (*QueryAllApprovedCodeHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApprovedCodeHashAll not implemented")
+}
+func (*UnimplementedQueryServer) WrappedAssetSupply(ctx context.Context, req *QueryWrappedAssetSupplyRequest) (*QueryWrappedAssetSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WrappedAssetSupply not implemented")
+}
+func (*UnimplementedQueryServer) SimulateGovernanceVAA(ctx context.Context, req *QuerySimulateGovernanceVAARequest) (*QuerySimulateGovernanceVAAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateGovernanceVAA not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_GuardianSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetGuardianSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GuardianSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/GuardianSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GuardianSet(ctx, req.(*QueryGetGuardianSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GuardianSetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllGuardianSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GuardianSetAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/GuardianSetAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GuardianSetAll(ctx, req.(*QueryAllGuardianSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GuardianSetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGuardianSetHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GuardianSetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/GuardianSetHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GuardianSetHistory(ctx, req.(*QueryGuardianSetHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Config_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Config(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/Config",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Config(ctx, req.(*QueryGetConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReplayProtection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetReplayProtectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReplayProtection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/ReplayProtection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReplayProtection(ctx, req.(*QueryGetReplayProtectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReplayProtectionAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllReplayProtectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReplayProtectionAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/ReplayProtectionAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReplayProtectionAll(ctx, req.(*QueryAllReplayProtectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExecutedGovernanceVAAAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllExecutedGovernanceVAARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExecutedGovernanceVAAAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/ExecutedGovernanceVAAAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExecutedGovernanceVAAAll(ctx, req.(*QueryAllExecutedGovernanceVAARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SequenceCounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSequenceCounterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SequenceCounter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/SequenceCounter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SequenceCounter(ctx, req.(*QueryGetSequenceCounterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SequenceCounterAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllSequenceCounterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SequenceCounterAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/SequenceCounterAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SequenceCounterAll(ctx, req.(*QueryAllSequenceCounterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConsensusGuardianSetIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetConsensusGuardianSetIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConsensusGuardianSetIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/ConsensusGuardianSetIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConsensusGuardianSetIndex(ctx, req.(*QueryGetConsensusGuardianSetIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GuardianValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetGuardianValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GuardianValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/GuardianValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GuardianValidator(ctx, req.(*QueryGetGuardianValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GuardianValidatorAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllGuardianValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GuardianValidatorAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/GuardianValidatorAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GuardianValidatorAll(ctx, req.(*QueryAllGuardianValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LatestGuardianSetIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestGuardianSetIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestGuardianSetIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/LatestGuardianSetIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestGuardianSetIndex(ctx, req.(*QueryLatestGuardianSetIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowlistAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllValidatorAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowlistAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/AllowlistAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowlistAll(ctx, req.(*QueryAllValidatorAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Allowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Allowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/Allowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Allowlist(ctx, req.(*QueryValidatorAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IbcComposabilityMwContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIbcComposabilityMwContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IbcComposabilityMwContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/IbcComposabilityMwContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IbcComposabilityMwContract(ctx, req.(*QueryIbcComposabilityMwContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BridgePaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgePausedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgePaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/BridgePaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgePaused(ctx, req.(*QueryBridgePausedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WasmInstantiateAllowlistAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllWasmInstantiateAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WasmInstantiateAllowlistAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/WasmInstantiateAllowlistAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WasmInstantiateAllowlistAll(ctx, req.(*QueryAllWasmInstantiateAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RegisteredEmitter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRegisteredEmitterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RegisteredEmitter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/RegisteredEmitter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RegisteredEmitter(ctx, req.(*QueryGetRegisteredEmitterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RegisteredEmitterAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRegisteredEmitterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RegisteredEmitterAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/RegisteredEmitterAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RegisteredEmitterAll(ctx, req.(*QueryAllRegisteredEmitterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GovernanceSubmitter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetGovernanceSubmitterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GovernanceSubmitter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/GovernanceSubmitter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GovernanceSubmitter(ctx, req.(*QueryGetGovernanceSubmitterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GovernanceSubmitterAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllGovernanceSubmitterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GovernanceSubmitterAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/GovernanceSubmitterAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GovernanceSubmitterAll(ctx, req.(*QueryAllGovernanceSubmitterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyVaa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyVAARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyVaa(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/VerifyVaa",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyVaa(ctx, req.(*QueryVerifyVAARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ArchivedVAAAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllArchivedVAARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ArchivedVAAAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/ArchivedVAAAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ArchivedVAAAll(ctx, req.(*QueryAllArchivedVAARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ObservationTally_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetObservationTallyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ObservationTally(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/ObservationTally",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ObservationTally(ctx, req.(*QueryGetObservationTallyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ObservationTallyAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllObservationTallyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ObservationTallyAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/ObservationTallyAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ObservationTallyAll(ctx, req.(*QueryAllObservationTallyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GuardianSetWeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetGuardianSetWeightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GuardianSetWeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/GuardianSetWeights",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GuardianSetWeights(ctx, req.(*QueryGetGuardianSetWeightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChainRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetChainRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChainRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/ChainRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChainRateLimit(ctx, req.(*QueryGetChainRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChainRateLimitAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllChainRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChainRateLimitAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/ChainRateLimitAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChainRateLimitAll(ctx, req.(*QueryAllChainRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueuedObservationAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllQueuedObservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedObservationAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/QueuedObservationAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedObservationAll(ctx, req.(*QueryAllQueuedObservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MsgShutdownAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllMsgShutdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MsgShutdownAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/MsgShutdownAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MsgShutdownAll(ctx, req.(*QueryAllMsgShutdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IcaHostAllowlistAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllIcaHostAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IcaHostAllowlistAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/IcaHostAllowlistAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IcaHostAllowlistAll(ctx, req.(*QueryAllIcaHostAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ForwardVolumeAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllForwardVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ForwardVolumeAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/ForwardVolumeAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ForwardVolumeAll(ctx, req.(*QueryAllForwardVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/MaintenanceWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MaintenanceWindow(ctx, req.(*QueryMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConsensusGuardianSetValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsensusGuardianSetValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConsensusGuardianSetValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/ConsensusGuardianSetValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConsensusGuardianSetValidators(ctx, req.(*QueryConsensusGuardianSetValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GuardianHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetGuardianHeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GuardianHeartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/GuardianHeartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GuardianHeartbeat(ctx, req.(*QueryGetGuardianHeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GuardianHeartbeatAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllGuardianHeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GuardianHeartbeatAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/GuardianHeartbeatAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GuardianHeartbeatAll(ctx, req.(*QueryAllGuardianHeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountantBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountantBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountantBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/AccountantBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountantBalance(ctx, req.(*QueryAccountantBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountantAccountAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAccountantAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountantAccountAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/AccountantAccountAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountantAccountAll(ctx, req.(*QueryAllAccountantAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountantTransferAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAccountantTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountantTransferAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/AccountantTransferAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountantTransferAll(ctx, req.(*QueryAllAccountantTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountantPendingTransferAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAccountantPendingTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountantPendingTransferAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/AccountantPendingTransferAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountantPendingTransferAll(ctx, req.(*QueryAllAccountantPendingTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountantTransferStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountantTransferStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountantTransferStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/AccountantTransferStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountantTransferStatus(ctx, req.(*QueryAccountantTransferStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CoreContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCoreContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CoreContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/CoreContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CoreContract(ctx, req.(*QueryCoreContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NextSequence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextSequenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextSequence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/NextSequence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextSequence(ctx, req.(*QueryNextSequenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SequenceReservationAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllSequenceReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SequenceReservationAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/SequenceReservationAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SequenceReservationAll(ctx, req.(*QueryAllSequenceReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ApprovedCodeHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetApprovedCodeHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ApprovedCodeHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/ApprovedCodeHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ApprovedCodeHash(ctx, req.(*QueryGetApprovedCodeHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ApprovedCodeHashAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllApprovedCodeHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ApprovedCodeHashAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/ApprovedCodeHashAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ApprovedCodeHashAll(ctx, req.(*QueryAllApprovedCodeHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WrappedAssetSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWrappedAssetSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WrappedAssetSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/WrappedAssetSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WrappedAssetSupply(ctx, req.(*QueryWrappedAssetSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateGovernanceVAA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateGovernanceVAARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateGovernanceVAA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Query/SimulateGovernanceVAA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateGovernanceVAA(ctx, req.(*QuerySimulateGovernanceVAARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "wormhole_foundation.wormchain.wormhole.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GuardianSet",
+			Handler:    _Query_GuardianSet_Handler,
+		},
+		{
+			MethodName: "GuardianSetAll",
+			Handler:    _Query_GuardianSetAll_Handler,
+		},
+		{
+			MethodName: "GuardianSetHistory",
+			Handler:    _Query_GuardianSetHistory_Handler,
+		},
+		{
+			MethodName: "Config",
+			Handler:    _Query_Config_Handler,
+		},
+		{
+			MethodName: "ReplayProtection",
+			Handler:    _Query_ReplayProtection_Handler,
+		},
+		{
+			MethodName: "ReplayProtectionAll",
+			Handler:    _Query_ReplayProtectionAll_Handler,
+		},
+		{
+			MethodName: "ExecutedGovernanceVAAAll",
+			Handler:    _Query_ExecutedGovernanceVAAAll_Handler,
+		},
+		{
+			MethodName: "SequenceCounter",
+			Handler:    _Query_SequenceCounter_Handler,
+		},
+		{
+			MethodName: "SequenceCounterAll",
+			Handler:    _Query_SequenceCounterAll_Handler,
+		},
+		{
+			MethodName: "ConsensusGuardianSetIndex",
+			Handler:    _Query_ConsensusGuardianSetIndex_Handler,
+		},
+		{
+			MethodName: "GuardianValidator",
+			Handler:    _Query_GuardianValidator_Handler,
+		},
+		{
+			MethodName: "GuardianValidatorAll",
+			Handler:    _Query_GuardianValidatorAll_Handler,
+		},
+		{
+			MethodName: "LatestGuardianSetIndex",
+			Handler:    _Query_LatestGuardianSetIndex_Handler,
+		},
+		{
+			MethodName: "AllowlistAll",
+			Handler:    _Query_AllowlistAll_Handler,
+		},
+		{
+			MethodName: "Allowlist",
+			Handler:    _Query_Allowlist_Handler,
+		},
+		{
+			MethodName: "IbcComposabilityMwContract",
+			Handler:    _Query_IbcComposabilityMwContract_Handler,
+		},
+		{
+			MethodName: "BridgePaused",
+			Handler:    _Query_BridgePaused_Handler,
+		},
+		{
+			MethodName: "WasmInstantiateAllowlistAll",
+			Handler:    _Query_WasmInstantiateAllowlistAll_Handler,
+		},
+		{
+			MethodName: "RegisteredEmitter",
+			Handler:    _Query_RegisteredEmitter_Handler,
+		},
+		{
+			MethodName: "RegisteredEmitterAll",
+			Handler:    _Query_RegisteredEmitterAll_Handler,
+		},
+		{
+			MethodName: "GovernanceSubmitter",
+			Handler:    _Query_GovernanceSubmitter_Handler,
+		},
+		{
+			MethodName: "GovernanceSubmitterAll",
+			Handler:    _Query_GovernanceSubmitterAll_Handler,
+		},
+		{
+			MethodName: "VerifyVaa",
+			Handler:    _Query_VerifyVaa_Handler,
+		},
+		{
+			MethodName: "ArchivedVAAAll",
+			Handler:    _Query_ArchivedVAAAll_Handler,
+		},
+		{
+			MethodName: "ObservationTally",
+			Handler:    _Query_ObservationTally_Handler,
+		},
+		{
+			MethodName: "ObservationTallyAll",
+			Handler:    _Query_ObservationTallyAll_Handler,
+		},
+		{
+			MethodName: "GuardianSetWeights",
+			Handler:    _Query_GuardianSetWeights_Handler,
+		},
+		{
+			MethodName: "ChainRateLimit",
+			Handler:    _Query_ChainRateLimit_Handler,
+		},
+		{
+			MethodName: "ChainRateLimitAll",
+			Handler:    _Query_ChainRateLimitAll_Handler,
+		},
+		{
+			MethodName: "QueuedObservationAll",
+			Handler:    _Query_QueuedObservationAll_Handler,
+		},
+		{
+			MethodName: "MsgShutdownAll",
+			Handler:    _Query_MsgShutdownAll_Handler,
+		},
+		{
+			MethodName: "IcaHostAllowlistAll",
+			Handler:    _Query_IcaHostAllowlistAll_Handler,
+		},
+		{
+			MethodName: "ForwardVolumeAll",
+			Handler:    _Query_ForwardVolumeAll_Handler,
+		},
+		{
+			MethodName: "MaintenanceWindow",
+			Handler:    _Query_MaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "ConsensusGuardianSetValidators",
+			Handler:    _Query_ConsensusGuardianSetValidators_Handler,
+		},
+		{
+			MethodName: "GuardianHeartbeat",
+			Handler:    _Query_GuardianHeartbeat_Handler,
+		},
+		{
+			MethodName: "GuardianHeartbeatAll",
+			Handler:    _Query_GuardianHeartbeatAll_Handler,
+		},
+		{
+			MethodName: "AccountantBalance",
+			Handler:    _Query_AccountantBalance_Handler,
+		},
+		{
+			MethodName: "AccountantAccountAll",
+			Handler:    _Query_AccountantAccountAll_Handler,
+		},
+		{
+			MethodName: "AccountantTransferAll",
+			Handler:    _Query_AccountantTransferAll_Handler,
+		},
+		{
+			MethodName: "AccountantPendingTransferAll",
+			Handler:    _Query_AccountantPendingTransferAll_Handler,
+		},
+		{
+			MethodName: "AccountantTransferStatus",
+			Handler:    _Query_AccountantTransferStatus_Handler,
+		},
+		{
+			MethodName: "CoreContract",
+			Handler:    _Query_CoreContract_Handler,
+		},
+		{
+			MethodName: "NextSequence",
+			Handler:    _Query_NextSequence_Handler,
+		},
+		{
+			MethodName: "SequenceReservationAll",
+			Handler:    _Query_SequenceReservationAll_Handler,
+		},
+		{
+			MethodName: "ApprovedCodeHash",
+			Handler:    _Query_ApprovedCodeHash_Handler,
+		},
+		{
+			MethodName: "ApprovedCodeHashAll",
+			Handler:    _Query_ApprovedCodeHashAll_Handler,
+		},
+		{
+			MethodName: "WrappedAssetSupply",
+			Handler:    _Query_WrappedAssetSupply_Handler,
+		},
+		{
+			MethodName: "SimulateGovernanceVAA",
+			Handler:    _Query_SimulateGovernanceVAA_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "wormhole/query.proto",
+}
+
+func (m *QueryAllValidatorAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllValidatorAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllValidatorAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllValidatorAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllValidatorAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllValidatorAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowlist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowlist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetGuardianSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetGuardianSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetGuardianSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetGuardianSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetGuardianSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetGuardianSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.GuardianSet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllGuardianSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllGuardianSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllGuardianSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllGuardianSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllGuardianSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllGuardianSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GuardianSet) > 0 {
+		for iNdEx := len(m.GuardianSet) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GuardianSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGuardianSetHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGuardianSetHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGuardianSetHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GuardianSetHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GuardianSetHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GuardianSetHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExpirationTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExpirationTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ActivationHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.GuardianSet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGuardianSetHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGuardianSetHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGuardianSetHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GuardianSets) > 0 {
+		for iNdEx := len(m.GuardianSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GuardianSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetReplayProtectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetReplayProtectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetReplayProtectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetReplayProtectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetReplayProtectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetReplayProtectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ReplayProtection.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllReplayProtectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllReplayProtectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllReplayProtectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllReplayProtectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllReplayProtectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllReplayProtectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ReplayProtection) > 0 {
+		for iNdEx := len(m.ReplayProtection) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReplayProtection[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllExecutedGovernanceVAARequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllExecutedGovernanceVAARequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllExecutedGovernanceVAARequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllExecutedGovernanceVAAResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllExecutedGovernanceVAAResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllExecutedGovernanceVAAResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ExecutedGovernanceVAA) > 0 {
+		for iNdEx := len(m.ExecutedGovernanceVAA) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutedGovernanceVAA[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSequenceCounterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSequenceCounterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSequenceCounterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSequenceCounterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSequenceCounterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSequenceCounterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SequenceCounter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSequenceCounterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSequenceCounterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSequenceCounterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSequenceCounterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSequenceCounterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSequenceCounterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SequenceCounter) > 0 {
+		for iNdEx := len(m.SequenceCounter) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SequenceCounter[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetConsensusGuardianSetIndexRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetConsensusGuardianSetIndexRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetConsensusGuardianSetIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetConsensusGuardianSetIndexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetConsensusGuardianSetIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetConsensusGuardianSetIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ConsensusGuardianSetIndex.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetGuardianValidatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetGuardianValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetGuardianValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GuardianKey) > 0 {
+		i -= len(m.GuardianKey)
+		copy(dAtA[i:], m.GuardianKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GuardianKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetGuardianValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetGuardianValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetGuardianValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.GuardianValidator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllGuardianValidatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllGuardianValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllGuardianValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllGuardianValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllGuardianValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllGuardianValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GuardianValidator) > 0 {
+		for iNdEx := len(m.GuardianValidator) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GuardianValidator[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestGuardianSetIndexRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestGuardianSetIndexRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestGuardianSetIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestGuardianSetIndexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestGuardianSetIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestGuardianSetIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LatestGuardianSetIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LatestGuardianSetIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIbcComposabilityMwContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIbcComposabilityMwContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIbcComposabilityMwContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIbcComposabilityMwContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIbcComposabilityMwContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIbcComposabilityMwContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgePausedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgePausedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgePausedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgePausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgePausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgePausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllWasmInstantiateAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllWasmInstantiateAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllWasmInstantiateAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllWasmInstantiateAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllWasmInstantiateAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllWasmInstantiateAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowlist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRegisteredEmitterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRegisteredEmitterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRegisteredEmitterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRegisteredEmitterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRegisteredEmitterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRegisteredEmitterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RegisteredEmitter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRegisteredEmitterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRegisteredEmitterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRegisteredEmitterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRegisteredEmitterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRegisteredEmitterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRegisteredEmitterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RegisteredEmitter) > 0 {
+		for iNdEx := len(m.RegisteredEmitter) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegisteredEmitter[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetGovernanceSubmitterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetGovernanceSubmitterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetGovernanceSubmitterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetGovernanceSubmitterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetGovernanceSubmitterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetGovernanceSubmitterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.GovernanceSubmitter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllGovernanceSubmitterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllGovernanceSubmitterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllGovernanceSubmitterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllGovernanceSubmitterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllGovernanceSubmitterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllGovernanceSubmitterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GovernanceSubmitter) > 0 {
+		for iNdEx := len(m.GovernanceSubmitter) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GovernanceSubmitter[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyVAARequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyVAARequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyVAARequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Vaa) > 0 {
+		i -= len(m.Vaa)
+		copy(dAtA[i:], m.Vaa)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Vaa)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyVAAResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyVAAResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyVAAResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.ConsistencyLevel != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConsistencyLevel))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.EmitterAddress) > 0 {
+		i -= len(m.EmitterAddress)
+		copy(dAtA[i:], m.EmitterAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EmitterAddress)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.EmitterChain != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EmitterChain))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NumSignatures != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumSignatures))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.GuardianSetIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GuardianSetIndex))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Digest)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllArchivedVAARequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllArchivedVAARequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllArchivedVAARequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SequenceEnd != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SequenceEnd))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SequenceStart != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SequenceStart))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.EmitterAddress) > 0 {
+		i -= len(m.EmitterAddress)
+		copy(dAtA[i:], m.EmitterAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EmitterAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EmitterChain != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EmitterChain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllArchivedVAAResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllArchivedVAAResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllArchivedVAAResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ArchivedVaa) > 0 {
+		for iNdEx := len(m.ArchivedVaa) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ArchivedVaa[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetObservationTallyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetObservationTallyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetObservationTallyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Digest)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetObservationTallyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetObservationTallyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetObservationTallyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QuorumWeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QuorumWeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.ObservationTally.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllObservationTallyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllObservationTallyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllObservationTallyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllObservationTallyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllObservationTallyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllObservationTallyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ObservationTally) > 0 {
+		for iNdEx := len(m.ObservationTally) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ObservationTally[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetGuardianSetWeightsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetGuardianSetWeightsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetGuardianSetWeightsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GuardianSetIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GuardianSetIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetGuardianSetWeightsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetGuardianSetWeightsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetGuardianSetWeightsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QuorumWeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QuorumWeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalWeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalWeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.GuardianSetWeights.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetChainRateLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetChainRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetChainRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetChainRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetChainRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetChainRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Available != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Available))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Flow != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Flow))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.ChainRateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllChainRateLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllChainRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllChainRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllChainRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllChainRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllChainRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainRateLimit) > 0 {
+		for iNdEx := len(m.ChainRateLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainRateLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllQueuedObservationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllQueuedObservationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllQueuedObservationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllQueuedObservationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllQueuedObservationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllQueuedObservationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.QueuedObservation) > 0 {
+		for iNdEx := len(m.QueuedObservation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedObservation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMsgShutdownRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMsgShutdownRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMsgShutdownRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMsgShutdownResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMsgShutdownResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMsgShutdownResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllIcaHostAllowlistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllIcaHostAllowlistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllIcaHostAllowlistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllIcaHostAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllIcaHostAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllIcaHostAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowlist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllForwardVolumeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllForwardVolumeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllForwardVolumeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllForwardVolumeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllForwardVolumeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllForwardVolumeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ForwardVolume) > 0 {
+		for iNdEx := len(m.ForwardVolume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForwardVolume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMaintenanceWindowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMaintenanceWindowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMaintenanceWindowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMaintenanceWindowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMaintenanceWindowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMaintenanceWindowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Window != nil {
+		{
+			size, err := m.Window.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsensusGuardianSetValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsensusGuardianSetValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsensusGuardianSetValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsensusGuardianValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsensusGuardianValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsensusGuardianValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Registered {
+		i--
+		if m.Registered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GuardianKey) > 0 {
+		i -= len(m.GuardianKey)
+		copy(dAtA[i:], m.GuardianKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GuardianKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsensusGuardianSetValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsensusGuardianSetValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsensusGuardianSetValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnregisteredCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnregisteredCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Guardians) > 0 {
+		for iNdEx := len(m.Guardians) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Guardians[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.GuardianSetIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GuardianSetIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetGuardianHeartbeatRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetGuardianHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetGuardianHeartbeatRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GuardianKey) > 0 {
+		i -= len(m.GuardianKey)
+		copy(dAtA[i:], m.GuardianKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GuardianKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetGuardianHeartbeatResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetGuardianHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetGuardianHeartbeatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.GuardianHeartbeat.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllGuardianHeartbeatRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllGuardianHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllGuardianHeartbeatRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllGuardianHeartbeatResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllGuardianHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllGuardianHeartbeatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GuardianHeartbeat) > 0 {
+		for iNdEx := len(m.GuardianHeartbeat) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GuardianHeartbeat[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountantBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountantBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountantBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountantBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountantBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountantBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAccountantAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAccountantAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAccountantAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartAfter != nil {
+		{
+			size, err := m.StartAfter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAccountantAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAccountantAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAccountantAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAccountantTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAccountantTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAccountantTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartAfter != nil {
+		{
+			size, err := m.StartAfter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAccountantTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAccountantTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAccountantTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Transfers) > 0 {
+		for iNdEx := len(m.Transfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAccountantPendingTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAccountantPendingTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAccountantPendingTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartAfter != nil {
+		{
+			size, err := m.StartAfter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAccountantPendingTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAccountantPendingTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAccountantPendingTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pending) > 0 {
+		for iNdEx := len(m.Pending) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountantTransferStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountantTransferStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountantTransferStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountantTransferStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountantTransferStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountantTransferStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pending) > 0 {
+		for iNdEx := len(m.Pending) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Committed != nil {
+		{
+			size, err := m.Committed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextSequenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextSequenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextSequenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Emitter) > 0 {
+		i -= len(m.Emitter)
+		copy(dAtA[i:], m.Emitter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Emitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextSequenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextSequenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextSequenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSequenceReservationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSequenceReservationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSequenceReservationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Emitter) > 0 {
+		i -= len(m.Emitter)
+		copy(dAtA[i:], m.Emitter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Emitter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSequenceReservationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSequenceReservationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSequenceReservationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SequenceReservation) > 0 {
+		for iNdEx := len(m.SequenceReservation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SequenceReservation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCoreContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCoreContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCoreContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCoreContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCoreContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCoreContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetApprovedCodeHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetApprovedCodeHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetApprovedCodeHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CodeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CodeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetApprovedCodeHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetApprovedCodeHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetApprovedCodeHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ApprovedCodeHash.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllApprovedCodeHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllApprovedCodeHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllApprovedCodeHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllApprovedCodeHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllApprovedCodeHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllApprovedCodeHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ApprovedCodeHash) > 0 {
+		for iNdEx := len(m.ApprovedCodeHash) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ApprovedCodeHash[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWrappedAssetSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWrappedAssetSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWrappedAssetSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Chain != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Chain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WrappedAssetHolder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WrappedAssetHolder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WrappedAssetHolder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWrappedAssetSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWrappedAssetSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWrappedAssetSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Holders) > 0 {
+		for iNdEx := len(m.Holders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Holders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.HolderCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HolderCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.TotalSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Cw20Contract) > 0 {
+		i -= len(m.Cw20Contract)
+		copy(dAtA[i:], m.Cw20Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Cw20Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateGovernanceVAARequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateGovernanceVAARequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateGovernanceVAARequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Vaa) > 0 {
+		i -= len(m.Vaa)
+		copy(dAtA[i:], m.Vaa)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Vaa)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GovernancePayloadField) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GovernancePayloadField) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovernancePayloadField) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateGovernanceVAAResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateGovernanceVAAResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateGovernanceVAAResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.PayloadFields) > 0 {
+		for iNdEx := len(m.PayloadFields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PayloadFields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.TargetChain != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TargetChain))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ActionName) > 0 {
+		i -= len(m.ActionName)
+		copy(dAtA[i:], m.ActionName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ActionName)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Action != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Digest)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAllValidatorAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllValidatorAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allowlist) > 0 {
+		for _, e := range m.Allowlist {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Allowlist) > 0 {
+		for _, e := range m.Allowlist {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetGuardianSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *QueryGetGuardianSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GuardianSet.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllGuardianSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllGuardianSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GuardianSet) > 0 {
+		for _, e := range m.GuardianSet {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGuardianSetHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GuardianSetHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GuardianSet.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ActivationHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ActivationHeight))
+	}
+	if m.ExpirationTime != 0 {
+		n += 1 + sovQuery(uint64(m.ExpirationTime))
+	}
+	if m.Valid {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryGuardianSetHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GuardianSets) > 0 {
+		for _, e := range m.GuardianSets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Config.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetReplayProtectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetReplayProtectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ReplayProtection.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllReplayProtectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllReplayProtectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReplayProtection) > 0 {
+		for _, e := range m.ReplayProtection {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllExecutedGovernanceVAARequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllExecutedGovernanceVAAResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExecutedGovernanceVAA) > 0 {
+		for _, e := range m.ExecutedGovernanceVAA {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetSequenceCounterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetSequenceCounterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SequenceCounter.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllSequenceCounterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllSequenceCounterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SequenceCounter) > 0 {
+		for _, e := range m.SequenceCounter {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetConsensusGuardianSetIndexRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetConsensusGuardianSetIndexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ConsensusGuardianSetIndex.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetGuardianValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GuardianKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetGuardianValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GuardianValidator.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllGuardianValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllGuardianValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GuardianValidator) > 0 {
+		for _, e := range m.GuardianValidator {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLatestGuardianSetIndexRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLatestGuardianSetIndexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LatestGuardianSetIndex != 0 {
+		n += 1 + sovQuery(uint64(m.LatestGuardianSetIndex))
+	}
+	return n
+}
+
+func (m *QueryIbcComposabilityMwContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryIbcComposabilityMwContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBridgePausedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBridgePausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryAllWasmInstantiateAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllWasmInstantiateAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allowlist) > 0 {
+		for _, e := range m.Allowlist {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRegisteredEmitterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryGetRegisteredEmitterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RegisteredEmitter.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllRegisteredEmitterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRegisteredEmitterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RegisteredEmitter) > 0 {
+		for _, e := range m.RegisteredEmitter {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetGovernanceSubmitterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetGovernanceSubmitterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GovernanceSubmitter.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllGovernanceSubmitterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllGovernanceSubmitterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GovernanceSubmitter) > 0 {
+		for _, e := range m.GovernanceSubmitter {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyVAARequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Vaa)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyVAAResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	if m.GuardianSetIndex != 0 {
+		n += 1 + sovQuery(uint64(m.GuardianSetIndex))
+	}
+	if m.NumSignatures != 0 {
+		n += 1 + sovQuery(uint64(m.NumSignatures))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovQuery(uint64(m.Timestamp))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	if m.EmitterChain != 0 {
+		n += 1 + sovQuery(uint64(m.EmitterChain))
+	}
+	l = len(m.EmitterAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	if m.ConsistencyLevel != 0 {
+		n += 1 + sovQuery(uint64(m.ConsistencyLevel))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovQuery(uint64(m.Code))
+	}
+	return n
+}
+
+func (m *QueryAllArchivedVAARequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EmitterChain != 0 {
+		n += 1 + sovQuery(uint64(m.EmitterChain))
+	}
+	l = len(m.EmitterAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SequenceStart != 0 {
+		n += 1 + sovQuery(uint64(m.SequenceStart))
+	}
+	if m.SequenceEnd != 0 {
+		n += 1 + sovQuery(uint64(m.SequenceEnd))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllArchivedVAAResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ArchivedVaa) > 0 {
+		for _, e := range m.ArchivedVaa {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetObservationTallyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetObservationTallyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObservationTally.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.QuorumWeight != 0 {
+		n += 1 + sovQuery(uint64(m.QuorumWeight))
+	}
+	return n
+}
+
+func (m *QueryAllObservationTallyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllObservationTallyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ObservationTally) > 0 {
+		for _, e := range m.ObservationTally {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetGuardianSetWeightsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GuardianSetIndex != 0 {
+		n += 1 + sovQuery(uint64(m.GuardianSetIndex))
+	}
+	return n
+}
+
+func (m *QueryGetGuardianSetWeightsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GuardianSetWeights.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.TotalWeight != 0 {
+		n += 1 + sovQuery(uint64(m.TotalWeight))
+	}
+	if m.QuorumWeight != 0 {
+		n += 1 + sovQuery(uint64(m.QuorumWeight))
+	}
+	return n
+}
+
+func (m *QueryGetChainRateLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryGetChainRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ChainRateLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Flow != 0 {
+		n += 1 + sovQuery(uint64(m.Flow))
+	}
+	if m.Available != 0 {
+		n += 1 + sovQuery(uint64(m.Available))
+	}
+	return n
+}
+
+func (m *QueryAllChainRateLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllChainRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChainRateLimit) > 0 {
+		for _, e := range m.ChainRateLimit {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllQueuedObservationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllQueuedObservationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.QueuedObservation) > 0 {
+		for _, e := range m.QueuedObservation {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllMsgShutdownRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllMsgShutdownResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllIcaHostAllowlistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllIcaHostAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allowlist) > 0 {
+		for _, e := range m.Allowlist {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllForwardVolumeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllForwardVolumeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ForwardVolume) > 0 {
+		for _, e := range m.ForwardVolume {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMaintenanceWindowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMaintenanceWindowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != nil {
+		l = m.Window.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Active {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryConsensusGuardianSetValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ConsensusGuardianValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	l = len(m.GuardianKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Registered {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryConsensusGuardianSetValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GuardianSetIndex != 0 {
+		n += 1 + sovQuery(uint64(m.GuardianSetIndex))
+	}
+	if len(m.Guardians) > 0 {
+		for _, e := range m.Guardians {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.UnregisteredCount != 0 {
+		n += 1 + sovQuery(uint64(m.UnregisteredCount))
+	}
+	return n
+}
+
+func (m *QueryGetGuardianHeartbeatRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GuardianKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetGuardianHeartbeatResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GuardianHeartbeat.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllGuardianHeartbeatRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllGuardianHeartbeatResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GuardianHeartbeat) > 0 {
+		for _, e := range m.GuardianHeartbeat {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountantBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Key.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAccountantBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Account.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllAccountantAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartAfter != nil {
+		l = m.StartAfter.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryAllAccountantAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllAccountantTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartAfter != nil {
+		l = m.StartAfter.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryAllAccountantTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transfers) > 0 {
+		for _, e := range m.Transfers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllAccountantPendingTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartAfter != nil {
+		l = m.StartAfter.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryAllAccountantPendingTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pending) > 0 {
+		for _, e := range m.Pending {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAccountantTransferStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Key.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAccountantTransferStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Committed != nil {
+		l = m.Committed.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Pending) > 0 {
+		for _, e := range m.Pending {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryNextSequenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Emitter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNextSequenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryAllSequenceReservationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Emitter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllSequenceReservationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SequenceReservation) > 0 {
+		for _, e := range m.SequenceReservation {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCoreContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCoreContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetApprovedCodeHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeId != 0 {
+		n += 1 + sovQuery(uint64(m.CodeId))
+	}
+	return n
+}
+
+func (m *QueryGetApprovedCodeHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApprovedCodeHash.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllApprovedCodeHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllApprovedCodeHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ApprovedCodeHash) > 0 {
+		for _, e := range m.ApprovedCodeHash {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWrappedAssetSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Chain != 0 {
+		n += 1 + sovQuery(uint64(m.Chain))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *WrappedAssetHolder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryWrappedAssetSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Cw20Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.HolderCount != 0 {
+		n += 1 + sovQuery(uint64(m.HolderCount))
+	}
+	if len(m.Holders) > 0 {
+		for _, e := range m.Holders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateGovernanceVAARequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Vaa)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GovernancePayloadField) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateGovernanceVAAResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovQuery(uint64(m.Action))
+	}
+	l = len(m.ActionName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TargetChain != 0 {
+		n += 1 + sovQuery(uint64(m.TargetChain))
+	}
+	if len(m.PayloadFields) > 0 {
+		for _, e := range m.PayloadFields {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovQuery(uint64(m.Code))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAllValidatorAllowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllValidatorAllowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllValidatorAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllValidatorAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllValidatorAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllValidatorAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, &ValidatorAllowedAddress{})
+			if err := m.Allowlist[len(m.Allowlist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorAllowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorAllowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, &ValidatorAllowedAddress{})
+			if err := m.Allowlist[len(m.Allowlist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetGuardianSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetGuardianSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetGuardianSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetGuardianSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetGuardianSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetGuardianSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GuardianSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllGuardianSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllGuardianSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllGuardianSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllGuardianSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllGuardianSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllGuardianSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GuardianSet = append(m.GuardianSet, GuardianSet{})
+			if err := m.GuardianSet[len(m.GuardianSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGuardianSetHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGuardianSetHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGuardianSetHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GuardianSetHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GuardianSetHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GuardianSetHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GuardianSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			m.ExpirationTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGuardianSetHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGuardianSetHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGuardianSetHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianSets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GuardianSets = append(m.GuardianSets, GuardianSetHistoryEntry{})
+			if err := m.GuardianSets[len(m.GuardianSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetReplayProtectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetReplayProtectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetReplayProtectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetReplayProtectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetReplayProtectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetReplayProtectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplayProtection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReplayProtection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllReplayProtectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllReplayProtectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllReplayProtectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllReplayProtectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllReplayProtectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllReplayProtectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplayProtection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplayProtection = append(m.ReplayProtection, ReplayProtection{})
+			if err := m.ReplayProtection[len(m.ReplayProtection)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllExecutedGovernanceVAARequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllExecutedGovernanceVAARequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllExecutedGovernanceVAARequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllExecutedGovernanceVAAResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllExecutedGovernanceVAAResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllExecutedGovernanceVAAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedGovernanceVAA", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutedGovernanceVAA = append(m.ExecutedGovernanceVAA, ExecutedGovernanceVAA{})
+			if err := m.ExecutedGovernanceVAA[len(m.ExecutedGovernanceVAA)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSequenceCounterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSequenceCounterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSequenceCounterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSequenceCounterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSequenceCounterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSequenceCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceCounter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SequenceCounter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSequenceCounterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSequenceCounterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSequenceCounterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSequenceCounterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSequenceCounterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSequenceCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceCounter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SequenceCounter = append(m.SequenceCounter, SequenceCounter{})
+			if err := m.SequenceCounter[len(m.SequenceCounter)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetConsensusGuardianSetIndexRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetConsensusGuardianSetIndexRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetConsensusGuardianSetIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetConsensusGuardianSetIndexResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetConsensusGuardianSetIndexResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetConsensusGuardianSetIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusGuardianSetIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsensusGuardianSetIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetGuardianValidatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetGuardianValidatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetGuardianValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GuardianKey = append(m.GuardianKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.GuardianKey == nil {
+				m.GuardianKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetGuardianValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetGuardianValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetGuardianValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianValidator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GuardianValidator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllGuardianValidatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllGuardianValidatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllGuardianValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllGuardianValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllGuardianValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllGuardianValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianValidator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GuardianValidator = append(m.GuardianValidator, GuardianValidator{})
+			if err := m.GuardianValidator[len(m.GuardianValidator)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestGuardianSetIndexRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestGuardianSetIndexRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestGuardianSetIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestGuardianSetIndexResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestGuardianSetIndexResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestGuardianSetIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestGuardianSetIndex", wireType)
+			}
+			m.LatestGuardianSetIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestGuardianSetIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIbcComposabilityMwContractRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIbcComposabilityMwContractRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIbcComposabilityMwContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIbcComposabilityMwContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIbcComposabilityMwContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIbcComposabilityMwContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBridgePausedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBridgePausedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBridgePausedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBridgePausedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBridgePausedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBridgePausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllWasmInstantiateAllowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllWasmInstantiateAllowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllWasmInstantiateAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllWasmInstantiateAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllWasmInstantiateAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllWasmInstantiateAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, WasmInstantiateAllowedContractCodeId{})
+			if err := m.Allowlist[len(m.Allowlist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRegisteredEmitterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRegisteredEmitterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRegisteredEmitterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRegisteredEmitterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRegisteredEmitterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRegisteredEmitterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredEmitter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RegisteredEmitter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRegisteredEmitterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRegisteredEmitterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRegisteredEmitterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRegisteredEmitterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRegisteredEmitterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRegisteredEmitterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredEmitter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisteredEmitter = append(m.RegisteredEmitter, RegisteredEmitter{})
+			if err := m.RegisteredEmitter[len(m.RegisteredEmitter)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetGovernanceSubmitterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetGovernanceSubmitterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetGovernanceSubmitterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetGovernanceSubmitterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetGovernanceSubmitterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetGovernanceSubmitterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernanceSubmitter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GovernanceSubmitter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllGovernanceSubmitterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllGovernanceSubmitterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllGovernanceSubmitterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllGovernanceSubmitterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllGovernanceSubmitterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllGovernanceSubmitterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernanceSubmitter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernanceSubmitter = append(m.GovernanceSubmitter, GovernanceSubmitter{})
+			if err := m.GovernanceSubmitter[len(m.GovernanceSubmitter)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyVAARequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyVAARequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyVAARequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vaa", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vaa = append(m.Vaa[:0], dAtA[iNdEx:postIndex]...)
+			if m.Vaa == nil {
+				m.Vaa = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyVAAResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyVAAResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyVAAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = append(m.Digest[:0], dAtA[iNdEx:postIndex]...)
+			if m.Digest == nil {
+				m.Digest = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianSetIndex", wireType)
+			}
+			m.GuardianSetIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GuardianSetIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumSignatures", wireType)
+			}
+			m.NumSignatures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumSignatures |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmitterChain", wireType)
+			}
+			m.EmitterChain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EmitterChain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmitterAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmitterAddress = append(m.EmitterAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.EmitterAddress == nil {
+				m.EmitterAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsistencyLevel", wireType)
+			}
+			m.ConsistencyLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsistencyLevel |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllArchivedVAARequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllArchivedVAARequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllArchivedVAARequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmitterChain", wireType)
+			}
+			m.EmitterChain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EmitterChain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmitterAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmitterAddress = append(m.EmitterAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.EmitterAddress == nil {
+				m.EmitterAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceStart", wireType)
+			}
+			m.SequenceStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SequenceStart |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceEnd", wireType)
+			}
+			m.SequenceEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SequenceEnd |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllArchivedVAAResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllArchivedVAAResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllArchivedVAAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedVaa", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArchivedVaa = append(m.ArchivedVaa, ArchivedVAA{})
+			if err := m.ArchivedVaa[len(m.ArchivedVaa)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetObservationTallyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetObservationTallyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetObservationTallyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = append(m.Digest[:0], dAtA[iNdEx:postIndex]...)
+			if m.Digest == nil {
+				m.Digest = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetObservationTallyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetObservationTallyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetObservationTallyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationTally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObservationTally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumWeight", wireType)
+			}
+			m.QuorumWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumWeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllObservationTallyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllObservationTallyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllObservationTallyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllObservationTallyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllObservationTallyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllObservationTallyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationTally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObservationTally = append(m.ObservationTally, ObservationTally{})
+			if err := m.ObservationTally[len(m.ObservationTally)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetGuardianSetWeightsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetGuardianSetWeightsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetGuardianSetWeightsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianSetIndex", wireType)
+			}
+			m.GuardianSetIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GuardianSetIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetGuardianSetWeightsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetGuardianSetWeightsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetGuardianSetWeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianSetWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GuardianSetWeights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
+			}
+			m.TotalWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalWeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumWeight", wireType)
+			}
+			m.QuorumWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumWeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetChainRateLimitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetChainRateLimitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetChainRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetChainRateLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetChainRateLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetChainRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainRateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChainRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flow", wireType)
+			}
+			m.Flow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Flow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
+			}
+			m.Available = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Available |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllChainRateLimitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllChainRateLimitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllChainRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllChainRateLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllChainRateLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllChainRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainRateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainRateLimit = append(m.ChainRateLimit, ChainRateLimit{})
+			if err := m.ChainRateLimit[len(m.ChainRateLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllQueuedObservationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllQueuedObservationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllQueuedObservationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllQueuedObservationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllQueuedObservationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllQueuedObservationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedObservation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedObservation = append(m.QueuedObservation, QueuedObservation{})
+			if err := m.QueuedObservation[len(m.QueuedObservation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMsgShutdownRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMsgShutdownRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMsgShutdownRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMsgShutdownResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMsgShutdownResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMsgShutdownResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllIcaHostAllowlistRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllIcaHostAllowlistRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllIcaHostAllowlistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllIcaHostAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllIcaHostAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllIcaHostAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, IcaHostAllowlistEntry{})
+			if err := m.Allowlist[len(m.Allowlist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllForwardVolumeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllForwardVolumeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllForwardVolumeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllForwardVolumeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllForwardVolumeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllForwardVolumeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardVolume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardVolume = append(m.ForwardVolume, ForwardVolume{})
+			if err := m.ForwardVolume[len(m.ForwardVolume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMaintenanceWindowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMaintenanceWindowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMaintenanceWindowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMaintenanceWindowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMaintenanceWindowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMaintenanceWindowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Window == nil {
+				m.Window = &MaintenanceWindow{}
+			}
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsensusGuardianSetValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsensusGuardianSetValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsensusGuardianSetValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsensusGuardianValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsensusGuardianValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsensusGuardianValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GuardianKey = append(m.GuardianKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.GuardianKey == nil {
+				m.GuardianKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Registered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Registered = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsensusGuardianSetValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsensusGuardianSetValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsensusGuardianSetValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianSetIndex", wireType)
+			}
+			m.GuardianSetIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GuardianSetIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardians", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardians = append(m.Guardians, ConsensusGuardianValidator{})
+			if err := m.Guardians[len(m.Guardians)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnregisteredCount", wireType)
+			}
+			m.UnregisteredCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnregisteredCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetGuardianHeartbeatRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetGuardianHeartbeatRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetGuardianHeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GuardianKey = append(m.GuardianKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.GuardianKey == nil {
+				m.GuardianKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetGuardianHeartbeatResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetGuardianHeartbeatResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetGuardianHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianHeartbeat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GuardianHeartbeat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllGuardianHeartbeatRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllGuardianHeartbeatRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllGuardianHeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllGuardianHeartbeatResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllGuardianHeartbeatResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllGuardianHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianHeartbeat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GuardianHeartbeat = append(m.GuardianHeartbeat, GuardianHeartbeat{})
+			if err := m.GuardianHeartbeat[len(m.GuardianHeartbeat)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountantBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountantBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountantBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountantBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountantBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountantBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllAccountantAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAccountantAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAccountantAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartAfter == nil {
+				m.StartAfter = &AccountantAccountKey{}
+			}
+			if err := m.StartAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryAllValidatorAllowlist) Unmarshal(dAtA []byte) error {
+func (m *QueryAllAccountantAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3659,15 +21229,15 @@ func (m *QueryAllValidatorAllowlist) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllValidatorAllowlist: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllAccountantAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllValidatorAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllAccountantAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3694,10 +21264,8 @@ func (m *QueryAllValidatorAllowlist) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Accounts = append(m.Accounts, AccountantAccount{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3722,7 +21290,7 @@ func (m *QueryAllValidatorAllowlist) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllValidatorAllowlistResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllAccountantTransferRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3745,17 +21313,17 @@ func (m *QueryAllValidatorAllowlistResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllValidatorAllowlistResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllAccountantTransferRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllValidatorAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllAccountantTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3765,29 +21333,27 @@ func (m *QueryAllValidatorAllowlistResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allowlist = append(m.Allowlist, &ValidatorAllowedAddress{})
-			if err := m.Allowlist[len(m.Allowlist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartAfter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3814,13 +21380,32 @@ func (m *QueryAllValidatorAllowlistResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.StartAfter == nil {
+				m.StartAfter = &AccountantTransferKey{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StartAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3842,7 +21427,7 @@ func (m *QueryAllValidatorAllowlistResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorAllowlist) Unmarshal(dAtA []byte) error {
+func (m *QueryAllAccountantTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3865,47 +21450,15 @@ func (m *QueryValidatorAllowlist) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorAllowlist: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllAccountantTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllAccountantTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3932,10 +21485,8 @@ func (m *QueryValidatorAllowlist) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Transfers = append(m.Transfers, AccountantTransfer{})
+			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3960,7 +21511,7 @@ func (m *QueryValidatorAllowlist) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorAllowlistResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllAccountantPendingTransferRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3983,15 +21534,15 @@ func (m *QueryValidatorAllowlistResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorAllowlistResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllAccountantPendingTransferRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllAccountantPendingTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4019,11 +21570,11 @@ func (m *QueryValidatorAllowlistResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartAfter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4050,16 +21601,18 @@ func (m *QueryValidatorAllowlistResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allowlist = append(m.Allowlist, &ValidatorAllowedAddress{})
-			if err := m.Allowlist[len(m.Allowlist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.StartAfter == nil {
+				m.StartAfter = &AccountantTransferKey{}
+			}
+			if err := m.StartAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var msglen int
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4069,28 +21622,11 @@ func (m *QueryValidatorAllowlistResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Limit |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4112,7 +21648,7 @@ func (m *QueryValidatorAllowlistResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetGuardianSetRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllAccountantPendingTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4135,17 +21671,17 @@ func (m *QueryGetGuardianSetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetGuardianSetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllAccountantPendingTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetGuardianSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllAccountantPendingTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
 			}
-			m.Index = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4155,11 +21691,26 @@ func (m *QueryGetGuardianSetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pending = append(m.Pending, AccountantPendingTransfer{})
+			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4181,7 +21732,7 @@ func (m *QueryGetGuardianSetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetGuardianSetResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountantTransferStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4200,19 +21751,51 @@ func (m *QueryGetGuardianSetResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetGuardianSetResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetGuardianSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountantTransferStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountantTransferStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GuardianSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4239,7 +21822,7 @@ func (m *QueryGetGuardianSetResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GuardianSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4264,7 +21847,7 @@ func (m *QueryGetGuardianSetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllGuardianSetRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountantTransferStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4287,15 +21870,15 @@ func (m *QueryAllGuardianSetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllGuardianSetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountantTransferStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllGuardianSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountantTransferStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Committed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4322,10 +21905,44 @@ func (m *QueryAllGuardianSetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.Committed == nil {
+				m.Committed = &AccountantTransfer{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Committed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pending = append(m.Pending, AccountantObservation{})
+			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4350,7 +21967,7 @@ func (m *QueryAllGuardianSetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllGuardianSetResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNextSequenceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4373,17 +21990,17 @@ func (m *QueryAllGuardianSetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllGuardianSetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextSequenceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllGuardianSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextSequenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GuardianSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Emitter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4393,31 +22010,29 @@ func (m *QueryAllGuardianSetResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GuardianSet = append(m.GuardianSet, GuardianSet{})
-			if err := m.GuardianSet[len(m.GuardianSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Emitter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4427,27 +22042,23 @@ func (m *QueryAllGuardianSetResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4470,7 +22081,7 @@ func (m *QueryAllGuardianSetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetConfigRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNextSequenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4493,12 +22104,31 @@ func (m *QueryGetConfigRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetConfigRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextSequenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextSequenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4520,7 +22150,7 @@ func (m *QueryGetConfigRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetConfigResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllSequenceReservationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4543,15 +22173,15 @@ func (m *QueryGetConfigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetConfigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllSequenceReservationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllSequenceReservationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4578,63 +22208,16 @@ func (m *QueryGetConfigResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetReplayProtectionRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetReplayProtectionRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetReplayProtectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Emitter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4662,7 +22245,7 @@ func (m *QueryGetReplayProtectionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.Emitter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4685,7 +22268,7 @@ func (m *QueryGetReplayProtectionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetReplayProtectionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllSequenceReservationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4708,15 +22291,15 @@ func (m *QueryGetReplayProtectionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetReplayProtectionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllSequenceReservationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetReplayProtectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllSequenceReservationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplayProtection", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceReservation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4743,7 +22326,44 @@ func (m *QueryGetReplayProtectionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReplayProtection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SequenceReservation = append(m.SequenceReservation, SequenceReservation{})
+			if err := m.SequenceReservation[len(m.SequenceReservation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4768,7 +22388,7 @@ func (m *QueryGetReplayProtectionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllReplayProtectionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCoreContractRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4791,48 +22411,12 @@ func (m *QueryAllReplayProtectionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllReplayProtectionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCoreContractRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllReplayProtectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCoreContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4854,7 +22438,7 @@ func (m *QueryAllReplayProtectionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllReplayProtectionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCoreContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4877,51 +22461,17 @@ func (m *QueryAllReplayProtectionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllReplayProtectionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCoreContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllReplayProtectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCoreContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplayProtection", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ReplayProtection = append(m.ReplayProtection, ReplayProtection{})
-			if err := m.ReplayProtection[len(m.ReplayProtection)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4931,27 +22481,23 @@ func (m *QueryAllReplayProtectionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4974,7 +22520,7 @@ func (m *QueryAllReplayProtectionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetSequenceCounterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetApprovedCodeHashRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4997,17 +22543,17 @@ func (m *QueryGetSequenceCounterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetSequenceCounterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetApprovedCodeHashRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetSequenceCounterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetApprovedCodeHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
 			}
-			var stringLen uint64
+			m.CodeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5017,24 +22563,11 @@ func (m *QueryGetSequenceCounterRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CodeId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Index = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5056,7 +22589,7 @@ func (m *QueryGetSequenceCounterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetSequenceCounterResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetApprovedCodeHashResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5079,15 +22612,15 @@ func (m *QueryGetSequenceCounterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetSequenceCounterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetApprovedCodeHashResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetSequenceCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetApprovedCodeHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SequenceCounter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedCodeHash", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5114,7 +22647,7 @@ func (m *QueryGetSequenceCounterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SequenceCounter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApprovedCodeHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5139,7 +22672,7 @@ func (m *QueryGetSequenceCounterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllSequenceCounterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllApprovedCodeHashRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5162,10 +22695,10 @@ func (m *QueryAllSequenceCounterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllSequenceCounterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllApprovedCodeHashRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllSequenceCounterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllApprovedCodeHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5225,7 +22758,7 @@ func (m *QueryAllSequenceCounterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllSequenceCounterResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllApprovedCodeHashResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5248,15 +22781,15 @@ func (m *QueryAllSequenceCounterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllSequenceCounterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllApprovedCodeHashResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllSequenceCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllApprovedCodeHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SequenceCounter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedCodeHash", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5283,8 +22816,8 @@ func (m *QueryAllSequenceCounterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SequenceCounter = append(m.SequenceCounter, SequenceCounter{})
-			if err := m.SequenceCounter[len(m.SequenceCounter)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ApprovedCodeHash = append(m.ApprovedCodeHash, ApprovedCodeHash{})
+			if err := m.ApprovedCodeHash[len(m.ApprovedCodeHash)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5345,7 +22878,7 @@ func (m *QueryAllSequenceCounterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetConsensusGuardianSetIndexRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWrappedAssetSupplyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5368,65 +22901,66 @@ func (m *QueryGetConsensusGuardianSetIndexRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetConsensusGuardianSetIndexRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWrappedAssetSupplyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetConsensusGuardianSetIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWrappedAssetSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			m.Chain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Chain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetConsensusGuardianSetIndexResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetConsensusGuardianSetIndexResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetConsensusGuardianSetIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusGuardianSetIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5453,7 +22987,10 @@ func (m *QueryGetConsensusGuardianSetIndexResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConsensusGuardianSetIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5478,7 +23015,7 @@ func (m *QueryGetConsensusGuardianSetIndexResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetGuardianValidatorRequest) Unmarshal(dAtA []byte) error {
+func (m *WrappedAssetHolder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5501,17 +23038,17 @@ func (m *QueryGetGuardianValidatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetGuardianValidatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WrappedAssetHolder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetGuardianValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WrappedAssetHolder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GuardianKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5521,81 +23058,29 @@ func (m *QueryGetGuardianValidatorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GuardianKey = append(m.GuardianKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.GuardianKey == nil {
-				m.GuardianKey = []byte{}
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetGuardianValidatorResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetGuardianValidatorResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetGuardianValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GuardianValidator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5605,22 +23090,23 @@ func (m *QueryGetGuardianValidatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GuardianValidator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5645,7 +23131,7 @@ func (m *QueryGetGuardianValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllGuardianValidatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWrappedAssetSupplyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5668,17 +23154,17 @@ func (m *QueryAllGuardianValidatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllGuardianValidatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWrappedAssetSupplyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllGuardianValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWrappedAssetSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5688,81 +23174,111 @@ func (m *QueryAllGuardianValidatorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cw20Contract", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllGuardianValidatorResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Cw20Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllGuardianValidatorResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllGuardianValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderCount", wireType)
+			}
+			m.HolderCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HolderCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GuardianValidator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5789,12 +23305,12 @@ func (m *QueryAllGuardianValidatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GuardianValidator = append(m.GuardianValidator, GuardianValidator{})
-			if err := m.GuardianValidator[len(m.GuardianValidator)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Holders = append(m.Holders, WrappedAssetHolder{})
+			if err := m.Holders[len(m.Holders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -5851,7 +23367,7 @@ func (m *QueryAllGuardianValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLatestGuardianSetIndexRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateGovernanceVAARequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5874,12 +23390,46 @@ func (m *QueryLatestGuardianSetIndexRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestGuardianSetIndexRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateGovernanceVAARequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestGuardianSetIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateGovernanceVAARequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vaa", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vaa = append(m.Vaa[:0], dAtA[iNdEx:postIndex]...)
+			if m.Vaa == nil {
+				m.Vaa = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5901,7 +23451,7 @@ func (m *QueryLatestGuardianSetIndexRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLatestGuardianSetIndexResponse) Unmarshal(dAtA []byte) error {
+func (m *GovernancePayloadField) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5924,17 +23474,17 @@ func (m *QueryLatestGuardianSetIndexResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestGuardianSetIndexResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GovernancePayloadField: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestGuardianSetIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GovernancePayloadField: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestGuardianSetIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.LatestGuardianSetIndex = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5944,61 +23494,56 @@ func (m *QueryLatestGuardianSetIndexResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LatestGuardianSetIndex |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryIbcComposabilityMwContractRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIbcComposabilityMwContractRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIbcComposabilityMwContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6020,7 +23565,7 @@ func (m *QueryIbcComposabilityMwContractRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIbcComposabilityMwContractResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateGovernanceVAAResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6043,15 +23588,35 @@ func (m *QueryIbcComposabilityMwContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIbcComposabilityMwContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateGovernanceVAAResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIbcComposabilityMwContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateGovernanceVAAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6079,63 +23644,47 @@ func (m *QueryIbcComposabilityMwContractResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllWasmInstantiateAllowlist) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Digest = append(m.Digest[:0], dAtA[iNdEx:postIndex]...)
+			if m.Digest == nil {
+				m.Digest = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllWasmInstantiateAllowlist: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllWasmInstantiateAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6145,81 +23694,97 @@ func (m *QueryAllWasmInstantiateAllowlist) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionName", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllWasmInstantiateAllowlistResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ActionName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetChain", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllWasmInstantiateAllowlistResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllWasmInstantiateAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.TargetChain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetChain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadFields", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6246,14 +23811,14 @@ func (m *QueryAllWasmInstantiateAllowlistResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allowlist = append(m.Allowlist, WasmInstantiateAllowedContractCodeId{})
-			if err := m.Allowlist[len(m.Allowlist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PayloadFields = append(m.PayloadFields, GovernancePayloadField{})
+			if err := m.PayloadFields[len(m.PayloadFields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6280,13 +23845,62 @@ func (m *QueryAllWasmInstantiateAllowlistResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Events = append(m.Events, types.StringEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// Validate checks that the rate limit applies to a valid chain and allows
+// observations within a non-empty window.
+func (r ChainRateLimit) Validate() error {
+	if _, err := vaa.ChainIDFromNumber(r.ChainId); err != nil {
+		return fmt.Errorf("invalid chain id %d", r.ChainId)
+	}
+	if r.Limit == 0 {
+		return fmt.Errorf("limit must be positive")
+	}
+	if r.WindowBlocks == 0 {
+		return fmt.Errorf("window must be at least one block")
+	}
+	return nil
+}
@@ -74,26 +74,105 @@ func (m *SequenceCounter) GetSequence() uint64 {
 	return 0
 }
 
+// SequenceReservation is a contiguous range of sequences of an emitter that
+// was pre-allocated so its messages can be posted without contending for the
+// sequence counter.
+type SequenceReservation struct {
+	// hex encoded 32 byte emitter address
+	Emitter string `protobuf:"bytes,1,opt,name=emitter,proto3" json:"emitter,omitempty"`
+	// first sequence of the range
+	Start uint64 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Count uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// number of sequences of the range that were already used
+	Used uint64 `protobuf:"varint,4,opt,name=used,proto3" json:"used,omitempty"`
+}
+
+func (m *SequenceReservation) Reset()         { *m = SequenceReservation{} }
+func (m *SequenceReservation) String() string { return proto.CompactTextString(m) }
+func (*SequenceReservation) ProtoMessage()    {}
+func (*SequenceReservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adec725923edb1a5, []int{1}
+}
+func (m *SequenceReservation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SequenceReservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SequenceReservation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SequenceReservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SequenceReservation.Merge(m, src)
+}
+func (m *SequenceReservation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SequenceReservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SequenceReservation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SequenceReservation proto.InternalMessageInfo
+
+func (m *SequenceReservation) GetEmitter() string {
+	if m != nil {
+		return m.Emitter
+	}
+	return ""
+}
+
+func (m *SequenceReservation) GetStart() uint64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *SequenceReservation) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *SequenceReservation) GetUsed() uint64 {
+	if m != nil {
+		return m.Used
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*SequenceCounter)(nil), "wormhole_foundation.wormchain.wormhole.SequenceCounter")
+	proto.RegisterType((*SequenceReservation)(nil), "wormhole_foundation.wormchain.wormhole.SequenceReservation")
 }
 
 func init() { proto.RegisterFile("wormhole/sequence_counter.proto", fileDescriptor_adec725923edb1a5) }
 
 var fileDescriptor_adec725923edb1a5 = []byte{
-	// 190 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2f, 0xcf, 0x2f, 0xca,
-	0xcd, 0xc8, 0xcf, 0x49, 0xd5, 0x2f, 0x4e, 0x2d, 0x2c, 0x4d, 0xcd, 0x4b, 0x4e, 0x8d, 0x4f, 0xce,
-	0x2f, 0xcd, 0x2b, 0x49, 0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x52, 0x83, 0x29, 0x88,
-	0x4f, 0xcb, 0x2f, 0xcd, 0x4b, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x03, 0x89, 0x25, 0x67, 0x24,
-	0x66, 0x42, 0x58, 0x20, 0x59, 0x25, 0x67, 0x2e, 0xfe, 0x60, 0xa8, 0x09, 0xce, 0x10, 0x03, 0x84,
-	0x44, 0xb8, 0x58, 0x33, 0xf3, 0x52, 0x52, 0x2b, 0x24, 0x18, 0x15, 0x18, 0x35, 0x38, 0x83, 0x20,
-	0x1c, 0x21, 0x29, 0x2e, 0x0e, 0x98, 0x55, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0x2c, 0x41, 0x70, 0xbe,
-	0x53, 0xf0, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1,
-	0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x59, 0xa6, 0x67, 0x96,
-	0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xc3, 0xec, 0xd4, 0x45, 0xb8, 0x48, 0x1f, 0xee,
-	0x22, 0xfd, 0x0a, 0xb8, 0xbc, 0x7e, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x23, 0xc6,
-	0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0f, 0x9e, 0xfe, 0x1f, 0xeb, 0x00, 0x00, 0x00,
+	// 248 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0xbf, 0x4e, 0xc3, 0x30,
+	0x10, 0xc6, 0x63, 0x08, 0xff, 0xbc, 0x20, 0x19, 0x06, 0x8b, 0xc1, 0x54, 0x1d, 0x50, 0x17, 0xe2,
+	0x81, 0x89, 0x95, 0xbe, 0x41, 0xba, 0xb1, 0x54, 0x69, 0x72, 0x10, 0x4b, 0xc4, 0x2e, 0xf6, 0x19,
+	0xca, 0x5b, 0xf0, 0x58, 0x8c, 0x1d, 0x19, 0x51, 0xf2, 0x22, 0x28, 0x4e, 0x9c, 0x6e, 0xfe, 0x7d,
+	0x67, 0xfd, 0x4e, 0xf7, 0xd1, 0xdb, 0x4f, 0x63, 0x9b, 0xda, 0xbc, 0x81, 0x74, 0xf0, 0xee, 0x41,
+	0x97, 0xb0, 0x2e, 0x8d, 0xd7, 0x08, 0x36, 0xdb, 0x5a, 0x83, 0x86, 0xdd, 0xc5, 0x0f, 0xeb, 0x17,
+	0xe3, 0x75, 0x55, 0xa0, 0x32, 0x3a, 0xeb, 0xb3, 0xb2, 0x2e, 0x94, 0xce, 0xe2, 0x74, 0xbe, 0xa4,
+	0x97, 0xab, 0xd1, 0xb0, 0x1c, 0x04, 0xec, 0x9a, 0x9e, 0x28, 0x5d, 0xc1, 0x8e, 0x93, 0x19, 0x59,
+	0x5c, 0xe4, 0x03, 0xb0, 0x1b, 0x7a, 0x1e, 0x57, 0xf1, 0xa3, 0x19, 0x59, 0xa4, 0xf9, 0xc4, 0x73,
+	0x43, 0xaf, 0xa2, 0x24, 0x07, 0x07, 0xf6, 0x23, 0xac, 0x63, 0x9c, 0x9e, 0x41, 0xa3, 0x10, 0xc1,
+	0x8e, 0xaa, 0x88, 0xfd, 0x0a, 0x87, 0x85, 0xc5, 0xd1, 0x34, 0x40, 0x9f, 0x86, 0x23, 0xf8, 0xf1,
+	0x90, 0x06, 0x60, 0x8c, 0xa6, 0xde, 0x41, 0xc5, 0xd3, 0x10, 0x86, 0xf7, 0xd3, 0xea, 0xa7, 0x15,
+	0x64, 0xdf, 0x0a, 0xf2, 0xd7, 0x0a, 0xf2, 0xdd, 0x89, 0x64, 0xdf, 0x89, 0xe4, 0xb7, 0x13, 0xc9,
+	0xf3, 0xe3, 0xab, 0xc2, 0xda, 0x6f, 0xb2, 0xd2, 0x34, 0x32, 0x1e, 0x79, 0x7f, 0xa8, 0x40, 0x4e,
+	0x15, 0xc8, 0xdd, 0x34, 0x97, 0xf8, 0xb5, 0x05, 0xb7, 0x39, 0x0d, 0xcd, 0x3d, 0xfc, 0x0f, 0x00,
+	0x5a, 0x70, 0xab, 0xe4, 0x5c, 0x01, 0x00, 0x00,
 }
 
 func (m *SequenceCounter) Marshal() (dAtA []byte, err error) {
@@ -131,6 +210,51 @@ func (m *SequenceCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SequenceReservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SequenceReservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SequenceReservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Used != 0 {
+		i = encodeVarintSequenceCounter(dAtA, i, uint64(m.Used))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Count != 0 {
+		i = encodeVarintSequenceCounter(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Start != 0 {
+		i = encodeVarintSequenceCounter(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Emitter) > 0 {
+		i -= len(m.Emitter)
+		copy(dAtA[i:], m.Emitter)
+		i = encodeVarintSequenceCounter(dAtA, i, uint64(len(m.Emitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSequenceCounter(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSequenceCounter(v)
 	base := offset
@@ -158,6 +282,28 @@ func (m *SequenceCounter) Size() (n int) {
 	return n
 }
 
+func (m *SequenceReservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Emitter)
+	if l > 0 {
+		n += 1 + l + sovSequenceCounter(uint64(l))
+	}
+	if m.Start != 0 {
+		n += 1 + sovSequenceCounter(uint64(m.Start))
+	}
+	if m.Count != 0 {
+		n += 1 + sovSequenceCounter(uint64(m.Count))
+	}
+	if m.Used != 0 {
+		n += 1 + sovSequenceCounter(uint64(m.Used))
+	}
+	return n
+}
+
 func sovSequenceCounter(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -265,6 +411,145 @@ func (m *SequenceCounter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SequenceReservation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSequenceCounter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SequenceReservation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SequenceReservation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Emitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequenceCounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSequenceCounter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSequenceCounter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Emitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequenceCounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequenceCounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			m.Used = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequenceCounter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Used |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSequenceCounter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSequenceCounter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSequenceCounter(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
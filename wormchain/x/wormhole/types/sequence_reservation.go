@@ -0,0 +1,55 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+// MaxSequenceReservationCount bounds the number of sequences a single
+// reservation may pre-allocate.
+const MaxSequenceReservationCount = 10_000
+
+// NextSequence returns the first sequence of the reservation that was not used
+// yet.
+func (r SequenceReservation) NextSequence() uint64 {
+	return r.Start + r.Used
+}
+
+// End returns the sequence following the last sequence of the reservation.
+func (r SequenceReservation) End() uint64 {
+	return r.Start + r.Count
+}
+
+// Validate checks that the emitter is a hex encoded 32 byte address and that
+// the reservation has sequences left that do not overflow.
+func (r SequenceReservation) Validate() error {
+	emitter, err := hex.DecodeString(r.Emitter)
+	if err != nil {
+		return fmt.Errorf("invalid emitter %s: %w", r.Emitter, err)
+	}
+	if len(emitter) != 32 {
+		return fmt.Errorf("emitter %s must be 32 bytes long", r.Emitter)
+	}
+	if err := ValidateSequenceReservationCount(r.Count); err != nil {
+		return err
+	}
+	if r.Start+r.Count < r.Start {
+		return fmt.Errorf("reservation of %d sequences starting at %d overflows", r.Count, r.Start)
+	}
+	if r.Used >= r.Count {
+		return fmt.Errorf("all %d sequences of the reservation were used", r.Count)
+	}
+	return nil
+}
+
+// ValidateSequenceReservationCount checks that a reservation pre-allocates at
+// least one and at most MaxSequenceReservationCount sequences.
+func ValidateSequenceReservationCount(count uint64) error {
+	if count == 0 {
+		return fmt.Errorf("count must be positive")
+	}
+	if count > MaxSequenceReservationCount {
+		return fmt.Errorf("count %d exceeds the maximum of %d", count, MaxSequenceReservationCount)
+	}
+	return nil
+}
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -71,6 +73,8 @@ type MsgCreateAllowlistEntryRequest struct {
 	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	// optional human readable name for the entry
 	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// optional block height at which the entry expires, 0 if it never expires
+	ExpirationHeight uint64 `protobuf:"varint,4,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
 }
 
 func (m *MsgCreateAllowlistEntryRequest) Reset()         { *m = MsgCreateAllowlistEntryRequest{} }
@@ -127,6 +131,13 @@ func (m *MsgCreateAllowlistEntryRequest) GetName() string {
 	return ""
 }
 
+func (m *MsgCreateAllowlistEntryRequest) GetExpirationHeight() uint64 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
+}
+
 type MsgDeleteAllowlistEntryRequest struct {
 	// signer should be a guardian validator in a current set or future set.
 	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
@@ -305,23 +316,24 @@ func (m *MsgExecuteGovernanceVAAResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgExecuteGovernanceVAAResponse proto.InternalMessageInfo
 
-type MsgRegisterAccountAsGuardian struct {
-	Signer    string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+type MsgExecuteGovernanceVAABatch struct {
+	// vaas are executed in order; if any of them fails, none of them take effect.
+	Vaas   [][]byte `protobuf:"bytes,1,rep,name=vaas,proto3" json:"vaas,omitempty"`
+	Signer string   `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *MsgRegisterAccountAsGuardian) Reset()         { *m = MsgRegisterAccountAsGuardian{} }
-func (m *MsgRegisterAccountAsGuardian) String() string { return proto.CompactTextString(m) }
-func (*MsgRegisterAccountAsGuardian) ProtoMessage()    {}
-func (*MsgRegisterAccountAsGuardian) Descriptor() ([]byte, []int) {
+func (m *MsgExecuteGovernanceVAABatch) Reset()         { *m = MsgExecuteGovernanceVAABatch{} }
+func (m *MsgExecuteGovernanceVAABatch) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteGovernanceVAABatch) ProtoMessage()    {}
+func (*MsgExecuteGovernanceVAABatch) Descriptor() ([]byte, []int) {
 	return fileDescriptor_55f7aa067b0c517b, []int{6}
 }
-func (m *MsgRegisterAccountAsGuardian) XXX_Unmarshal(b []byte) error {
+func (m *MsgExecuteGovernanceVAABatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRegisterAccountAsGuardian) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgExecuteGovernanceVAABatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRegisterAccountAsGuardian.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgExecuteGovernanceVAABatch.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -331,47 +343,47 @@ func (m *MsgRegisterAccountAsGuardian) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgRegisterAccountAsGuardian) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRegisterAccountAsGuardian.Merge(m, src)
+func (m *MsgExecuteGovernanceVAABatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteGovernanceVAABatch.Merge(m, src)
 }
-func (m *MsgRegisterAccountAsGuardian) XXX_Size() int {
+func (m *MsgExecuteGovernanceVAABatch) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRegisterAccountAsGuardian) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRegisterAccountAsGuardian.DiscardUnknown(m)
+func (m *MsgExecuteGovernanceVAABatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteGovernanceVAABatch.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRegisterAccountAsGuardian proto.InternalMessageInfo
+var xxx_messageInfo_MsgExecuteGovernanceVAABatch proto.InternalMessageInfo
 
-func (m *MsgRegisterAccountAsGuardian) GetSigner() string {
+func (m *MsgExecuteGovernanceVAABatch) GetVaas() [][]byte {
 	if m != nil {
-		return m.Signer
+		return m.Vaas
 	}
-	return ""
+	return nil
 }
 
-func (m *MsgRegisterAccountAsGuardian) GetSignature() []byte {
+func (m *MsgExecuteGovernanceVAABatch) GetSigner() string {
 	if m != nil {
-		return m.Signature
+		return m.Signer
 	}
-	return nil
+	return ""
 }
 
-type MsgRegisterAccountAsGuardianResponse struct {
+type MsgExecuteGovernanceVAABatchResponse struct {
 }
 
-func (m *MsgRegisterAccountAsGuardianResponse) Reset()         { *m = MsgRegisterAccountAsGuardianResponse{} }
-func (m *MsgRegisterAccountAsGuardianResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRegisterAccountAsGuardianResponse) ProtoMessage()    {}
-func (*MsgRegisterAccountAsGuardianResponse) Descriptor() ([]byte, []int) {
+func (m *MsgExecuteGovernanceVAABatchResponse) Reset()         { *m = MsgExecuteGovernanceVAABatchResponse{} }
+func (m *MsgExecuteGovernanceVAABatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteGovernanceVAABatchResponse) ProtoMessage()    {}
+func (*MsgExecuteGovernanceVAABatchResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_55f7aa067b0c517b, []int{7}
 }
-func (m *MsgRegisterAccountAsGuardianResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgExecuteGovernanceVAABatchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRegisterAccountAsGuardianResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgExecuteGovernanceVAABatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRegisterAccountAsGuardianResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgExecuteGovernanceVAABatchResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -381,40 +393,37 @@ func (m *MsgRegisterAccountAsGuardianResponse) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *MsgRegisterAccountAsGuardianResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRegisterAccountAsGuardianResponse.Merge(m, src)
+func (m *MsgExecuteGovernanceVAABatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteGovernanceVAABatchResponse.Merge(m, src)
 }
-func (m *MsgRegisterAccountAsGuardianResponse) XXX_Size() int {
+func (m *MsgExecuteGovernanceVAABatchResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRegisterAccountAsGuardianResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRegisterAccountAsGuardianResponse.DiscardUnknown(m)
+func (m *MsgExecuteGovernanceVAABatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteGovernanceVAABatchResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRegisterAccountAsGuardianResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgExecuteGovernanceVAABatchResponse proto.InternalMessageInfo
 
-// Same as from x/wasmd but with vaa auth
-type MsgStoreCode struct {
-	// Signer is the that actor that signed the messages
+type MsgSubmitObservation struct {
 	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	// WASMByteCode can be raw or gzip compressed
-	WASMByteCode []byte `protobuf:"bytes,2,opt,name=wasm_byte_code,json=wasmByteCode,proto3" json:"wasm_byte_code,omitempty"`
-	// vaa must be governance msg with payload containing sha3 256 hash of `wasm_byte_code`
-	Vaa []byte `protobuf:"bytes,3,opt,name=vaa,proto3" json:"vaa,omitempty"`
+	// the observed VAA, signed by one or more guardians. Signatures of guardians
+	// that already signed the observation are ignored.
+	Vaa []byte `protobuf:"bytes,2,opt,name=vaa,proto3" json:"vaa,omitempty"`
 }
 
-func (m *MsgStoreCode) Reset()         { *m = MsgStoreCode{} }
-func (m *MsgStoreCode) String() string { return proto.CompactTextString(m) }
-func (*MsgStoreCode) ProtoMessage()    {}
-func (*MsgStoreCode) Descriptor() ([]byte, []int) {
+func (m *MsgSubmitObservation) Reset()         { *m = MsgSubmitObservation{} }
+func (m *MsgSubmitObservation) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitObservation) ProtoMessage()    {}
+func (*MsgSubmitObservation) Descriptor() ([]byte, []int) {
 	return fileDescriptor_55f7aa067b0c517b, []int{8}
 }
-func (m *MsgStoreCode) XXX_Unmarshal(b []byte) error {
+func (m *MsgSubmitObservation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgStoreCode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSubmitObservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgStoreCode.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSubmitObservation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -424,58 +433,55 @@ func (m *MsgStoreCode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *MsgStoreCode) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgStoreCode.Merge(m, src)
+func (m *MsgSubmitObservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitObservation.Merge(m, src)
 }
-func (m *MsgStoreCode) XXX_Size() int {
+func (m *MsgSubmitObservation) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgStoreCode) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgStoreCode.DiscardUnknown(m)
+func (m *MsgSubmitObservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitObservation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgStoreCode proto.InternalMessageInfo
+var xxx_messageInfo_MsgSubmitObservation proto.InternalMessageInfo
 
-func (m *MsgStoreCode) GetSigner() string {
+func (m *MsgSubmitObservation) GetSigner() string {
 	if m != nil {
 		return m.Signer
 	}
 	return ""
 }
 
-func (m *MsgStoreCode) GetWASMByteCode() []byte {
-	if m != nil {
-		return m.WASMByteCode
-	}
-	return nil
-}
-
-func (m *MsgStoreCode) GetVaa() []byte {
+func (m *MsgSubmitObservation) GetVaa() []byte {
 	if m != nil {
 		return m.Vaa
 	}
 	return nil
 }
 
-type MsgStoreCodeResponse struct {
-	// CodeID is the reference to the stored WASM code
-	CodeID uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
-	// Checksum is the sha256 hash of the stored code
-	Checksum []byte `protobuf:"bytes,2,opt,name=checksum,proto3" json:"checksum,omitempty"`
+type MsgGuardianHeartbeat struct {
+	// signer must be the validator of a guardian in the current or a future
+	// guardian set
+	Signer      string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	NodeVersion string `protobuf:"bytes,2,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	// latest block heights observed per chain
+	Heights []ChainHeight `protobuf:"bytes,3,rep,name=heights,proto3" json:"heights"`
+	// feature flags enabled on the node
+	Features []string `protobuf:"bytes,4,rep,name=features,proto3" json:"features,omitempty"`
 }
 
-func (m *MsgStoreCodeResponse) Reset()         { *m = MsgStoreCodeResponse{} }
-func (m *MsgStoreCodeResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgStoreCodeResponse) ProtoMessage()    {}
-func (*MsgStoreCodeResponse) Descriptor() ([]byte, []int) {
+func (m *MsgGuardianHeartbeat) Reset()         { *m = MsgGuardianHeartbeat{} }
+func (m *MsgGuardianHeartbeat) String() string { return proto.CompactTextString(m) }
+func (*MsgGuardianHeartbeat) ProtoMessage()    {}
+func (*MsgGuardianHeartbeat) Descriptor() ([]byte, []int) {
 	return fileDescriptor_55f7aa067b0c517b, []int{9}
 }
-func (m *MsgStoreCodeResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgGuardianHeartbeat) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgStoreCodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgGuardianHeartbeat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgStoreCodeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgGuardianHeartbeat.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -485,58 +491,61 @@ func (m *MsgStoreCodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *MsgStoreCodeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgStoreCodeResponse.Merge(m, src)
+func (m *MsgGuardianHeartbeat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGuardianHeartbeat.Merge(m, src)
 }
-func (m *MsgStoreCodeResponse) XXX_Size() int {
+func (m *MsgGuardianHeartbeat) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgStoreCodeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgStoreCodeResponse.DiscardUnknown(m)
+func (m *MsgGuardianHeartbeat) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGuardianHeartbeat.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgStoreCodeResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgGuardianHeartbeat proto.InternalMessageInfo
 
-func (m *MsgStoreCodeResponse) GetCodeID() uint64 {
+func (m *MsgGuardianHeartbeat) GetSigner() string {
 	if m != nil {
-		return m.CodeID
+		return m.Signer
 	}
-	return 0
+	return ""
 }
 
-func (m *MsgStoreCodeResponse) GetChecksum() []byte {
+func (m *MsgGuardianHeartbeat) GetNodeVersion() string {
 	if m != nil {
-		return m.Checksum
+		return m.NodeVersion
+	}
+	return ""
+}
+
+func (m *MsgGuardianHeartbeat) GetHeights() []ChainHeight {
+	if m != nil {
+		return m.Heights
 	}
 	return nil
 }
 
-// Same as from x/wasmd but with vaa auth
-type MsgInstantiateContract struct {
-	// Signer is the that actor that signed the messages
-	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	// CodeID is the reference to the stored WASM code
-	CodeID uint64 `protobuf:"varint,3,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
-	// Label is optional metadata to be stored with a contract instance.
-	Label string `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
-	// Msg json encoded message to be passed to the contract on instantiation
-	Msg []byte `protobuf:"bytes,5,opt,name=msg,proto3" json:"msg,omitempty"`
-	// vaa must be governance msg with payload containing keccak256 hash(hash(hash(BigEndian(CodeID)), Label), Msg)
-	Vaa []byte `protobuf:"bytes,6,opt,name=vaa,proto3" json:"vaa,omitempty"`
+func (m *MsgGuardianHeartbeat) GetFeatures() []string {
+	if m != nil {
+		return m.Features
+	}
+	return nil
 }
 
-func (m *MsgInstantiateContract) Reset()         { *m = MsgInstantiateContract{} }
-func (m *MsgInstantiateContract) String() string { return proto.CompactTextString(m) }
-func (*MsgInstantiateContract) ProtoMessage()    {}
-func (*MsgInstantiateContract) Descriptor() ([]byte, []int) {
+type MsgGuardianHeartbeatResponse struct {
+}
+
+func (m *MsgGuardianHeartbeatResponse) Reset()         { *m = MsgGuardianHeartbeatResponse{} }
+func (m *MsgGuardianHeartbeatResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGuardianHeartbeatResponse) ProtoMessage()    {}
+func (*MsgGuardianHeartbeatResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_55f7aa067b0c517b, []int{10}
 }
-func (m *MsgInstantiateContract) XXX_Unmarshal(b []byte) error {
+func (m *MsgGuardianHeartbeatResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgInstantiateContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgGuardianHeartbeatResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgInstantiateContract.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgGuardianHeartbeatResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -546,72 +555,126 @@ func (m *MsgInstantiateContract) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *MsgInstantiateContract) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgInstantiateContract.Merge(m, src)
+func (m *MsgGuardianHeartbeatResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGuardianHeartbeatResponse.Merge(m, src)
 }
-func (m *MsgInstantiateContract) XXX_Size() int {
+func (m *MsgGuardianHeartbeatResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgInstantiateContract) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgInstantiateContract.DiscardUnknown(m)
+func (m *MsgGuardianHeartbeatResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGuardianHeartbeatResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgInstantiateContract proto.InternalMessageInfo
+var xxx_messageInfo_MsgGuardianHeartbeatResponse proto.InternalMessageInfo
 
-func (m *MsgInstantiateContract) GetSigner() string {
+type MsgExecuteGatewayTransfer struct {
+	// signer sends the tokens
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// the tokenfactory tokens to transfer
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// the wormhole chain id of the recipient
+	RecipientChain uint32 `protobuf:"varint,3,opt,name=recipient_chain,json=recipientChain,proto3" json:"recipient_chain,omitempty"`
+	// the 32 byte wormhole address of the recipient
+	Recipient []byte `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// the part of the amount paid to the relayer on the recipient chain
+	Fee   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"fee"`
+	Nonce uint32                                 `protobuf:"varint,6,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *MsgExecuteGatewayTransfer) Reset()         { *m = MsgExecuteGatewayTransfer{} }
+func (m *MsgExecuteGatewayTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteGatewayTransfer) ProtoMessage()    {}
+func (*MsgExecuteGatewayTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f7aa067b0c517b, []int{11}
+}
+func (m *MsgExecuteGatewayTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecuteGatewayTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecuteGatewayTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExecuteGatewayTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteGatewayTransfer.Merge(m, src)
+}
+func (m *MsgExecuteGatewayTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecuteGatewayTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteGatewayTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecuteGatewayTransfer proto.InternalMessageInfo
+
+func (m *MsgExecuteGatewayTransfer) GetSigner() string {
 	if m != nil {
 		return m.Signer
 	}
 	return ""
 }
 
-func (m *MsgInstantiateContract) GetCodeID() uint64 {
+func (m *MsgExecuteGatewayTransfer) GetAmount() types.Coin {
 	if m != nil {
-		return m.CodeID
+		return m.Amount
 	}
-	return 0
+	return types.Coin{}
 }
 
-func (m *MsgInstantiateContract) GetLabel() string {
+func (m *MsgExecuteGatewayTransfer) GetRecipientChain() uint32 {
 	if m != nil {
-		return m.Label
+		return m.RecipientChain
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgInstantiateContract) GetMsg() []byte {
+func (m *MsgExecuteGatewayTransfer) GetRecipient() []byte {
 	if m != nil {
-		return m.Msg
+		return m.Recipient
 	}
 	return nil
 }
 
-func (m *MsgInstantiateContract) GetVaa() []byte {
+func (m *MsgExecuteGatewayTransfer) GetNonce() uint32 {
 	if m != nil {
-		return m.Vaa
+		return m.Nonce
 	}
-	return nil
-}
-
-type MsgInstantiateContractResponse struct {
-	// Address is the bech32 address of the new contract instance.
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// Data contains base64-encoded bytes to returned from the contract
-	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	return 0
 }
 
-func (m *MsgInstantiateContractResponse) Reset()         { *m = MsgInstantiateContractResponse{} }
-func (m *MsgInstantiateContractResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgInstantiateContractResponse) ProtoMessage()    {}
-func (*MsgInstantiateContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f7aa067b0c517b, []int{11}
+type MsgExecuteGatewayTransferWithPayload struct {
+	// signer sends the tokens
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// the tokenfactory tokens to transfer
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// the wormhole chain id of the recipient contract
+	RecipientChain uint32 `protobuf:"varint,3,opt,name=recipient_chain,json=recipientChain,proto3" json:"recipient_chain,omitempty"`
+	// the 32 byte wormhole address of the recipient contract
+	Contract []byte `protobuf:"bytes,4,opt,name=contract,proto3" json:"contract,omitempty"`
+	// the payload delivered to the recipient contract
+	Payload []byte `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	Nonce   uint32 `protobuf:"varint,6,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *MsgExecuteGatewayTransferWithPayload) Reset()         { *m = MsgExecuteGatewayTransferWithPayload{} }
+func (m *MsgExecuteGatewayTransferWithPayload) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteGatewayTransferWithPayload) ProtoMessage()    {}
+func (*MsgExecuteGatewayTransferWithPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f7aa067b0c517b, []int{12}
 }
-func (m *MsgInstantiateContractResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgExecuteGatewayTransferWithPayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgInstantiateContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgExecuteGatewayTransferWithPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgInstantiateContractResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgExecuteGatewayTransferWithPayload.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -621,55 +684,77 @@ func (m *MsgInstantiateContractResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *MsgInstantiateContractResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgInstantiateContractResponse.Merge(m, src)
+func (m *MsgExecuteGatewayTransferWithPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteGatewayTransferWithPayload.Merge(m, src)
 }
-func (m *MsgInstantiateContractResponse) XXX_Size() int {
+func (m *MsgExecuteGatewayTransferWithPayload) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgInstantiateContractResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgInstantiateContractResponse.DiscardUnknown(m)
+func (m *MsgExecuteGatewayTransferWithPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteGatewayTransferWithPayload.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgInstantiateContractResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgExecuteGatewayTransferWithPayload proto.InternalMessageInfo
 
-func (m *MsgInstantiateContractResponse) GetAddress() string {
+func (m *MsgExecuteGatewayTransferWithPayload) GetSigner() string {
 	if m != nil {
-		return m.Address
+		return m.Signer
 	}
 	return ""
 }
 
-func (m *MsgInstantiateContractResponse) GetData() []byte {
+func (m *MsgExecuteGatewayTransferWithPayload) GetAmount() types.Coin {
 	if m != nil {
-		return m.Data
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *MsgExecuteGatewayTransferWithPayload) GetRecipientChain() uint32 {
+	if m != nil {
+		return m.RecipientChain
+	}
+	return 0
+}
+
+func (m *MsgExecuteGatewayTransferWithPayload) GetContract() []byte {
+	if m != nil {
+		return m.Contract
 	}
 	return nil
 }
 
-type MsgAddWasmInstantiateAllowlist struct {
-	// Signer is the actor that signed the messages
-	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	// Address is the bech32 address of the contract that can call wasm instantiate without a VAA
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	// CodeID is the reference to the stored WASM code that can be instantiated
-	CodeId uint64 `protobuf:"varint,3,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
-	// vaa is the WormchainAddWasmInstantiateAllowlist governance message
-	Vaa []byte `protobuf:"bytes,4,opt,name=vaa,proto3" json:"vaa,omitempty"`
+func (m *MsgExecuteGatewayTransferWithPayload) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
 }
 
-func (m *MsgAddWasmInstantiateAllowlist) Reset()         { *m = MsgAddWasmInstantiateAllowlist{} }
-func (m *MsgAddWasmInstantiateAllowlist) String() string { return proto.CompactTextString(m) }
-func (*MsgAddWasmInstantiateAllowlist) ProtoMessage()    {}
-func (*MsgAddWasmInstantiateAllowlist) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f7aa067b0c517b, []int{12}
+func (m *MsgExecuteGatewayTransferWithPayload) GetNonce() uint32 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
 }
-func (m *MsgAddWasmInstantiateAllowlist) XXX_Unmarshal(b []byte) error {
+
+type MsgExecuteGatewayTransferResponse struct {
+	// the data returned by the gateway contract
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *MsgExecuteGatewayTransferResponse) Reset()         { *m = MsgExecuteGatewayTransferResponse{} }
+func (m *MsgExecuteGatewayTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteGatewayTransferResponse) ProtoMessage()    {}
+func (*MsgExecuteGatewayTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f7aa067b0c517b, []int{13}
+}
+func (m *MsgExecuteGatewayTransferResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddWasmInstantiateAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgExecuteGatewayTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddWasmInstantiateAllowlist.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgExecuteGatewayTransferResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -679,68 +764,96 @@ func (m *MsgAddWasmInstantiateAllowlist) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *MsgAddWasmInstantiateAllowlist) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddWasmInstantiateAllowlist.Merge(m, src)
+func (m *MsgExecuteGatewayTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteGatewayTransferResponse.Merge(m, src)
 }
-func (m *MsgAddWasmInstantiateAllowlist) XXX_Size() int {
+func (m *MsgExecuteGatewayTransferResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddWasmInstantiateAllowlist) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddWasmInstantiateAllowlist.DiscardUnknown(m)
+func (m *MsgExecuteGatewayTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteGatewayTransferResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddWasmInstantiateAllowlist proto.InternalMessageInfo
+var xxx_messageInfo_MsgExecuteGatewayTransferResponse proto.InternalMessageInfo
 
-func (m *MsgAddWasmInstantiateAllowlist) GetSigner() string {
+func (m *MsgExecuteGatewayTransferResponse) GetData() []byte {
 	if m != nil {
-		return m.Signer
+		return m.Data
 	}
-	return ""
+	return nil
 }
 
-func (m *MsgAddWasmInstantiateAllowlist) GetAddress() string {
-	if m != nil {
-		return m.Address
-	}
-	return ""
+type MsgSubmitObservationResponse struct {
+	Finalized bool `protobuf:"varint,1,opt,name=finalized,proto3" json:"finalized,omitempty"`
+	// set if the observation reached quorum but is queued by the rate limit of
+	// its emitter chain
+	Queued bool `protobuf:"varint,2,opt,name=queued,proto3" json:"queued,omitempty"`
 }
 
-func (m *MsgAddWasmInstantiateAllowlist) GetCodeId() uint64 {
-	if m != nil {
-		return m.CodeId
-	}
-	return 0
+func (m *MsgSubmitObservationResponse) Reset()         { *m = MsgSubmitObservationResponse{} }
+func (m *MsgSubmitObservationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitObservationResponse) ProtoMessage()    {}
+func (*MsgSubmitObservationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f7aa067b0c517b, []int{14}
+}
+func (m *MsgSubmitObservationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitObservationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitObservationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitObservationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitObservationResponse.Merge(m, src)
+}
+func (m *MsgSubmitObservationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitObservationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitObservationResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddWasmInstantiateAllowlist) GetVaa() []byte {
+var xxx_messageInfo_MsgSubmitObservationResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitObservationResponse) GetFinalized() bool {
 	if m != nil {
-		return m.Vaa
+		return m.Finalized
 	}
-	return nil
+	return false
 }
 
-type MsgDeleteWasmInstantiateAllowlist struct {
-	// signer should be a guardian validator in a current set or future set.
-	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	// the <contract, code_id> pair to remove
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	CodeId  uint64 `protobuf:"varint,3,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
-	// vaa is the WormchainDeleteWasmInstantiateAllowlist governance message
-	Vaa []byte `protobuf:"bytes,4,opt,name=vaa,proto3" json:"vaa,omitempty"`
+func (m *MsgSubmitObservationResponse) GetQueued() bool {
+	if m != nil {
+		return m.Queued
+	}
+	return false
 }
 
-func (m *MsgDeleteWasmInstantiateAllowlist) Reset()         { *m = MsgDeleteWasmInstantiateAllowlist{} }
-func (m *MsgDeleteWasmInstantiateAllowlist) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteWasmInstantiateAllowlist) ProtoMessage()    {}
-func (*MsgDeleteWasmInstantiateAllowlist) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f7aa067b0c517b, []int{13}
+type MsgRegisterAccountAsGuardian struct {
+	Signer    string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
 }
-func (m *MsgDeleteWasmInstantiateAllowlist) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgRegisterAccountAsGuardian) Reset()         { *m = MsgRegisterAccountAsGuardian{} }
+func (m *MsgRegisterAccountAsGuardian) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterAccountAsGuardian) ProtoMessage()    {}
+func (*MsgRegisterAccountAsGuardian) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f7aa067b0c517b, []int{15}
+}
+func (m *MsgRegisterAccountAsGuardian) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteWasmInstantiateAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRegisterAccountAsGuardian) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteWasmInstantiateAllowlist.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRegisterAccountAsGuardian.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -750,61 +863,47 @@ func (m *MsgDeleteWasmInstantiateAllowlist) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteWasmInstantiateAllowlist) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteWasmInstantiateAllowlist.Merge(m, src)
+func (m *MsgRegisterAccountAsGuardian) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterAccountAsGuardian.Merge(m, src)
 }
-func (m *MsgDeleteWasmInstantiateAllowlist) XXX_Size() int {
+func (m *MsgRegisterAccountAsGuardian) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteWasmInstantiateAllowlist) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteWasmInstantiateAllowlist.DiscardUnknown(m)
+func (m *MsgRegisterAccountAsGuardian) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterAccountAsGuardian.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteWasmInstantiateAllowlist proto.InternalMessageInfo
+var xxx_messageInfo_MsgRegisterAccountAsGuardian proto.InternalMessageInfo
 
-func (m *MsgDeleteWasmInstantiateAllowlist) GetSigner() string {
+func (m *MsgRegisterAccountAsGuardian) GetSigner() string {
 	if m != nil {
 		return m.Signer
 	}
 	return ""
 }
 
-func (m *MsgDeleteWasmInstantiateAllowlist) GetAddress() string {
-	if m != nil {
-		return m.Address
-	}
-	return ""
-}
-
-func (m *MsgDeleteWasmInstantiateAllowlist) GetCodeId() uint64 {
-	if m != nil {
-		return m.CodeId
-	}
-	return 0
-}
-
-func (m *MsgDeleteWasmInstantiateAllowlist) GetVaa() []byte {
+func (m *MsgRegisterAccountAsGuardian) GetSignature() []byte {
 	if m != nil {
-		return m.Vaa
+		return m.Signature
 	}
 	return nil
 }
 
-type MsgWasmInstantiateAllowlistResponse struct {
+type MsgRegisterAccountAsGuardianResponse struct {
 }
 
-func (m *MsgWasmInstantiateAllowlistResponse) Reset()         { *m = MsgWasmInstantiateAllowlistResponse{} }
-func (m *MsgWasmInstantiateAllowlistResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgWasmInstantiateAllowlistResponse) ProtoMessage()    {}
-func (*MsgWasmInstantiateAllowlistResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f7aa067b0c517b, []int{14}
+func (m *MsgRegisterAccountAsGuardianResponse) Reset()         { *m = MsgRegisterAccountAsGuardianResponse{} }
+func (m *MsgRegisterAccountAsGuardianResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterAccountAsGuardianResponse) ProtoMessage()    {}
+func (*MsgRegisterAccountAsGuardianResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f7aa067b0c517b, []int{16}
 }
-func (m *MsgWasmInstantiateAllowlistResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRegisterAccountAsGuardianResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWasmInstantiateAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRegisterAccountAsGuardianResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWasmInstantiateAllowlistResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRegisterAccountAsGuardianResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -814,44 +913,40 @@ func (m *MsgWasmInstantiateAllowlistResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *MsgWasmInstantiateAllowlistResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWasmInstantiateAllowlistResponse.Merge(m, src)
+func (m *MsgRegisterAccountAsGuardianResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterAccountAsGuardianResponse.Merge(m, src)
 }
-func (m *MsgWasmInstantiateAllowlistResponse) XXX_Size() int {
+func (m *MsgRegisterAccountAsGuardianResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWasmInstantiateAllowlistResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWasmInstantiateAllowlistResponse.DiscardUnknown(m)
+func (m *MsgRegisterAccountAsGuardianResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterAccountAsGuardianResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWasmInstantiateAllowlistResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRegisterAccountAsGuardianResponse proto.InternalMessageInfo
 
-// MsgMigrateContract runs a code upgrade/ downgrade for a smart contract
-type MsgMigrateContract struct {
-	// Sender is the actor that signs the messages
+// Same as from x/wasmd but with vaa auth
+type MsgStoreCode struct {
+	// Signer is the that actor that signed the messages
 	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	// Contract is the address of the smart contract
-	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
-	// CodeID references the new WASM code
-	CodeID uint64 `protobuf:"varint,3,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
-	// Msg json encoded message to be passed to the contract on migration
-	Msg []byte `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
-	// vaa must be governance msg with payload containing keccak256 hash(hash(hash(BigEndian(CodeID)), Contract), Msg)
-	Vaa []byte `protobuf:"bytes,6,opt,name=vaa,proto3" json:"vaa,omitempty"`
+	// WASMByteCode can be raw or gzip compressed
+	WASMByteCode []byte `protobuf:"bytes,2,opt,name=wasm_byte_code,json=wasmByteCode,proto3" json:"wasm_byte_code,omitempty"`
+	// vaa must be governance msg with payload containing sha3 256 hash of `wasm_byte_code`
+	Vaa []byte `protobuf:"bytes,3,opt,name=vaa,proto3" json:"vaa,omitempty"`
 }
 
-func (m *MsgMigrateContract) Reset()         { *m = MsgMigrateContract{} }
-func (m *MsgMigrateContract) String() string { return proto.CompactTextString(m) }
-func (*MsgMigrateContract) ProtoMessage()    {}
-func (*MsgMigrateContract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f7aa067b0c517b, []int{15}
+func (m *MsgStoreCode) Reset()         { *m = MsgStoreCode{} }
+func (m *MsgStoreCode) String() string { return proto.CompactTextString(m) }
+func (*MsgStoreCode) ProtoMessage()    {}
+func (*MsgStoreCode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f7aa067b0c517b, []int{17}
 }
-func (m *MsgMigrateContract) XXX_Unmarshal(b []byte) error {
+func (m *MsgStoreCode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMigrateContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgStoreCode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMigrateContract.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgStoreCode.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -861,72 +956,58 @@ func (m *MsgMigrateContract) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgMigrateContract) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMigrateContract.Merge(m, src)
+func (m *MsgStoreCode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStoreCode.Merge(m, src)
 }
-func (m *MsgMigrateContract) XXX_Size() int {
+func (m *MsgStoreCode) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMigrateContract) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMigrateContract.DiscardUnknown(m)
+func (m *MsgStoreCode) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStoreCode.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMigrateContract proto.InternalMessageInfo
+var xxx_messageInfo_MsgStoreCode proto.InternalMessageInfo
 
-func (m *MsgMigrateContract) GetSigner() string {
+func (m *MsgStoreCode) GetSigner() string {
 	if m != nil {
 		return m.Signer
 	}
 	return ""
 }
 
-func (m *MsgMigrateContract) GetContract() string {
-	if m != nil {
-		return m.Contract
-	}
-	return ""
-}
-
-func (m *MsgMigrateContract) GetCodeID() uint64 {
-	if m != nil {
-		return m.CodeID
-	}
-	return 0
-}
-
-func (m *MsgMigrateContract) GetMsg() []byte {
+func (m *MsgStoreCode) GetWASMByteCode() []byte {
 	if m != nil {
-		return m.Msg
+		return m.WASMByteCode
 	}
 	return nil
 }
 
-func (m *MsgMigrateContract) GetVaa() []byte {
+func (m *MsgStoreCode) GetVaa() []byte {
 	if m != nil {
 		return m.Vaa
 	}
 	return nil
 }
 
-// MsgMigrateContractResponse returns contract migration result data.
-type MsgMigrateContractResponse struct {
-	// Data contains same raw bytes returned as data from the wasm contract.
-	// (May be empty)
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+type MsgStoreCodeResponse struct {
+	// CodeID is the reference to the stored WASM code
+	CodeID uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// Checksum is the sha256 hash of the stored code
+	Checksum []byte `protobuf:"bytes,2,opt,name=checksum,proto3" json:"checksum,omitempty"`
 }
 
-func (m *MsgMigrateContractResponse) Reset()         { *m = MsgMigrateContractResponse{} }
-func (m *MsgMigrateContractResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgMigrateContractResponse) ProtoMessage()    {}
-func (*MsgMigrateContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f7aa067b0c517b, []int{16}
+func (m *MsgStoreCodeResponse) Reset()         { *m = MsgStoreCodeResponse{} }
+func (m *MsgStoreCodeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStoreCodeResponse) ProtoMessage()    {}
+func (*MsgStoreCodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f7aa067b0c517b, []int{18}
 }
-func (m *MsgMigrateContractResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgStoreCodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMigrateContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgStoreCodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMigrateContractResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgStoreCodeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -936,44 +1017,58 @@ func (m *MsgMigrateContractResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgMigrateContractResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMigrateContractResponse.Merge(m, src)
+func (m *MsgStoreCodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStoreCodeResponse.Merge(m, src)
 }
-func (m *MsgMigrateContractResponse) XXX_Size() int {
+func (m *MsgStoreCodeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMigrateContractResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMigrateContractResponse.DiscardUnknown(m)
+func (m *MsgStoreCodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStoreCodeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMigrateContractResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgStoreCodeResponse proto.InternalMessageInfo
 
-func (m *MsgMigrateContractResponse) GetData() []byte {
+func (m *MsgStoreCodeResponse) GetCodeID() uint64 {
 	if m != nil {
-		return m.Data
+		return m.CodeID
+	}
+	return 0
+}
+
+func (m *MsgStoreCodeResponse) GetChecksum() []byte {
+	if m != nil {
+		return m.Checksum
 	}
 	return nil
 }
 
-type MsgExecuteGatewayGovernanceVaa struct {
-	// Sender is the actor that signs the messages
+// Same as from x/wasmd but with vaa auth
+type MsgInstantiateContract struct {
+	// Signer is the that actor that signed the messages
 	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	// vaa must be governance msg with valid module, action, and payload
-	Vaa []byte `protobuf:"bytes,2,opt,name=vaa,proto3" json:"vaa,omitempty"`
+	// CodeID is the reference to the stored WASM code
+	CodeID uint64 `protobuf:"varint,3,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// Label is optional metadata to be stored with a contract instance.
+	Label string `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	// Msg json encoded message to be passed to the contract on instantiation
+	Msg []byte `protobuf:"bytes,5,opt,name=msg,proto3" json:"msg,omitempty"`
+	// vaa must be governance msg with payload containing keccak256 hash(hash(hash(BigEndian(CodeID)), Label), Msg)
+	Vaa []byte `protobuf:"bytes,6,opt,name=vaa,proto3" json:"vaa,omitempty"`
 }
 
-func (m *MsgExecuteGatewayGovernanceVaa) Reset()         { *m = MsgExecuteGatewayGovernanceVaa{} }
-func (m *MsgExecuteGatewayGovernanceVaa) String() string { return proto.CompactTextString(m) }
-func (*MsgExecuteGatewayGovernanceVaa) ProtoMessage()    {}
-func (*MsgExecuteGatewayGovernanceVaa) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55f7aa067b0c517b, []int{17}
+func (m *MsgInstantiateContract) Reset()         { *m = MsgInstantiateContract{} }
+func (m *MsgInstantiateContract) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantiateContract) ProtoMessage()    {}
+func (*MsgInstantiateContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f7aa067b0c517b, []int{19}
 }
-func (m *MsgExecuteGatewayGovernanceVaa) XXX_Unmarshal(b []byte) error {
+func (m *MsgInstantiateContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgExecuteGatewayGovernanceVaa) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgInstantiateContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgExecuteGatewayGovernanceVaa.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgInstantiateContract.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -983,1103 +1078,1405 @@ func (m *MsgExecuteGatewayGovernanceVaa) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *MsgExecuteGatewayGovernanceVaa) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgExecuteGatewayGovernanceVaa.Merge(m, src)
+func (m *MsgInstantiateContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantiateContract.Merge(m, src)
 }
-func (m *MsgExecuteGatewayGovernanceVaa) XXX_Size() int {
+func (m *MsgInstantiateContract) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgExecuteGatewayGovernanceVaa) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgExecuteGatewayGovernanceVaa.DiscardUnknown(m)
+func (m *MsgInstantiateContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantiateContract.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgExecuteGatewayGovernanceVaa proto.InternalMessageInfo
+var xxx_messageInfo_MsgInstantiateContract proto.InternalMessageInfo
 
-func (m *MsgExecuteGatewayGovernanceVaa) GetSigner() string {
+func (m *MsgInstantiateContract) GetSigner() string {
 	if m != nil {
 		return m.Signer
 	}
 	return ""
 }
 
-func (m *MsgExecuteGatewayGovernanceVaa) GetVaa() []byte {
+func (m *MsgInstantiateContract) GetCodeID() uint64 {
 	if m != nil {
-		return m.Vaa
+		return m.CodeID
 	}
-	return nil
+	return 0
 }
 
-func init() {
-	proto.RegisterType((*EmptyResponse)(nil), "wormhole_foundation.wormchain.wormhole.EmptyResponse")
-	proto.RegisterType((*MsgCreateAllowlistEntryRequest)(nil), "wormhole_foundation.wormchain.wormhole.MsgCreateAllowlistEntryRequest")
-	proto.RegisterType((*MsgDeleteAllowlistEntryRequest)(nil), "wormhole_foundation.wormchain.wormhole.MsgDeleteAllowlistEntryRequest")
-	proto.RegisterType((*MsgAllowlistResponse)(nil), "wormhole_foundation.wormchain.wormhole.MsgAllowlistResponse")
-	proto.RegisterType((*MsgExecuteGovernanceVAA)(nil), "wormhole_foundation.wormchain.wormhole.MsgExecuteGovernanceVAA")
-	proto.RegisterType((*MsgExecuteGovernanceVAAResponse)(nil), "wormhole_foundation.wormchain.wormhole.MsgExecuteGovernanceVAAResponse")
-	proto.RegisterType((*MsgRegisterAccountAsGuardian)(nil), "wormhole_foundation.wormchain.wormhole.MsgRegisterAccountAsGuardian")
-	proto.RegisterType((*MsgRegisterAccountAsGuardianResponse)(nil), "wormhole_foundation.wormchain.wormhole.MsgRegisterAccountAsGuardianResponse")
-	proto.RegisterType((*MsgStoreCode)(nil), "wormhole_foundation.wormchain.wormhole.MsgStoreCode")
-	proto.RegisterType((*MsgStoreCodeResponse)(nil), "wormhole_foundation.wormchain.wormhole.MsgStoreCodeResponse")
-	proto.RegisterType((*MsgInstantiateContract)(nil), "wormhole_foundation.wormchain.wormhole.MsgInstantiateContract")
-	proto.RegisterType((*MsgInstantiateContractResponse)(nil), "wormhole_foundation.wormchain.wormhole.MsgInstantiateContractResponse")
-	proto.RegisterType((*MsgAddWasmInstantiateAllowlist)(nil), "wormhole_foundation.wormchain.wormhole.MsgAddWasmInstantiateAllowlist")
-	proto.RegisterType((*MsgDeleteWasmInstantiateAllowlist)(nil), "wormhole_foundation.wormchain.wormhole.MsgDeleteWasmInstantiateAllowlist")
-	proto.RegisterType((*MsgWasmInstantiateAllowlistResponse)(nil), "wormhole_foundation.wormchain.wormhole.MsgWasmInstantiateAllowlistResponse")
-	proto.RegisterType((*MsgMigrateContract)(nil), "wormhole_foundation.wormchain.wormhole.MsgMigrateContract")
-	proto.RegisterType((*MsgMigrateContractResponse)(nil), "wormhole_foundation.wormchain.wormhole.MsgMigrateContractResponse")
-	proto.RegisterType((*MsgExecuteGatewayGovernanceVaa)(nil), "wormhole_foundation.wormchain.wormhole.MsgExecuteGatewayGovernanceVaa")
+func (m *MsgInstantiateContract) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("wormhole/tx.proto", fileDescriptor_55f7aa067b0c517b) }
-
-var fileDescriptor_55f7aa067b0c517b = []byte{
-	// 848 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x6e, 0xeb, 0x44,
-	0x14, 0xee, 0x34, 0xb9, 0xb9, 0xf4, 0x28, 0x70, 0x2f, 0x26, 0xea, 0x0d, 0xbe, 0x57, 0x2e, 0x75,
-	0xa1, 0x62, 0x43, 0x82, 0xf8, 0x93, 0x40, 0x08, 0x94, 0xf4, 0x4f, 0x01, 0xcc, 0xc2, 0x45, 0x54,
-	0x62, 0x13, 0x4d, 0xec, 0xe9, 0xc4, 0x22, 0xf6, 0x04, 0xcf, 0xb8, 0x69, 0x16, 0x08, 0xde, 0x00,
-	0xba, 0x07, 0x89, 0x37, 0x40, 0x62, 0xc5, 0x23, 0xb0, 0xec, 0x92, 0x05, 0xaa, 0x50, 0xfa, 0x22,
-	0xc8, 0x93, 0x78, 0xe2, 0x36, 0x71, 0x1a, 0xb7, 0x15, 0x77, 0x37, 0x33, 0xf6, 0xf9, 0xbe, 0xef,
-	0x1c, 0x7f, 0x73, 0x8e, 0x0c, 0x2f, 0x0f, 0x58, 0xe8, 0x77, 0x59, 0x8f, 0xd4, 0xc5, 0x69, 0xad,
-	0x1f, 0x32, 0xc1, 0xb4, 0xed, 0xe4, 0xa8, 0x7d, 0xcc, 0xa2, 0xc0, 0xc5, 0xc2, 0x63, 0x41, 0x2d,
-	0x3e, 0x73, 0xba, 0xd8, 0x1b, 0xaf, 0xe2, 0xa7, 0x7a, 0x85, 0x32, 0xca, 0x64, 0x48, 0x3d, 0x5e,
-	0x8d, 0xa3, 0xcd, 0x47, 0xf0, 0xe2, 0x9e, 0xdf, 0x17, 0x43, 0x9b, 0xf0, 0x3e, 0x0b, 0x38, 0x31,
-	0x8f, 0xc1, 0xb0, 0x38, 0xdd, 0x09, 0x09, 0x16, 0xa4, 0xd1, 0xeb, 0xb1, 0x41, 0xcf, 0xe3, 0x62,
-	0x2f, 0x10, 0xe1, 0xd0, 0x26, 0xdf, 0x45, 0x84, 0x0b, 0x6d, 0x1d, 0x4a, 0xdc, 0xa3, 0x01, 0x09,
-	0xab, 0xe8, 0x35, 0xf4, 0xe6, 0x9a, 0x3d, 0xd9, 0x69, 0x55, 0x78, 0x88, 0x5d, 0x37, 0x24, 0x9c,
-	0x57, 0x57, 0xe5, 0x83, 0x64, 0xab, 0x69, 0x50, 0x0c, 0xb0, 0x4f, 0xaa, 0x05, 0x79, 0x2c, 0xd7,
-	0xa6, 0x2d, 0x79, 0x76, 0x49, 0x8f, 0xdc, 0x1b, 0x8f, 0xb9, 0x0e, 0x15, 0x8b, 0x53, 0x85, 0xa6,
-	0x72, 0xda, 0x81, 0x27, 0x16, 0xa7, 0x7b, 0xa7, 0xc4, 0x89, 0x04, 0x39, 0x60, 0x27, 0x24, 0x0c,
-	0x70, 0xe0, 0x90, 0xaf, 0x1b, 0x0d, 0xed, 0x31, 0x14, 0x4e, 0x30, 0x96, 0x0c, 0x65, 0x3b, 0x5e,
-	0xa6, 0x68, 0x57, 0xd3, 0xb4, 0xe6, 0x26, 0x6c, 0x64, 0x80, 0x28, 0x9e, 0xaf, 0xe0, 0x99, 0xc5,
-	0xa9, 0x4d, 0xa8, 0xc7, 0x05, 0x09, 0x1b, 0x8e, 0xc3, 0xa2, 0x40, 0x34, 0xf8, 0x41, 0x84, 0x43,
-	0xd7, 0xc3, 0x41, 0x66, 0x46, 0xcf, 0x60, 0x2d, 0x5e, 0x61, 0x11, 0x85, 0xe3, 0x22, 0x95, 0xed,
-	0xe9, 0x81, 0xb9, 0x0d, 0xaf, 0x2f, 0x42, 0x55, 0xec, 0x7d, 0x28, 0x5b, 0x9c, 0x1e, 0x0a, 0x16,
-	0x92, 0x1d, 0xe6, 0x92, 0x4c, 0xb6, 0x0f, 0xe0, 0xa5, 0x01, 0xe6, 0x7e, 0xbb, 0x33, 0x14, 0xa4,
-	0xed, 0x30, 0x97, 0xc8, 0x44, 0xcb, 0xcd, 0xc7, 0xa3, 0x8b, 0x8d, 0xf2, 0x51, 0xe3, 0xd0, 0x6a,
-	0x0e, 0x85, 0x44, 0xb0, 0xcb, 0xf1, 0x7b, 0xc9, 0x2e, 0x29, 0x55, 0x41, 0x95, 0xca, 0x3c, 0x92,
-	0xf5, 0x56, 0x8c, 0x89, 0x12, 0x6d, 0x0b, 0x1e, 0xc6, 0xb8, 0x6d, 0xcf, 0x95, 0xd4, 0xc5, 0x26,
-	0x8c, 0x2e, 0x36, 0x4a, 0xf1, 0x2b, 0xad, 0x5d, 0xbb, 0x14, 0x3f, 0x6a, 0xb9, 0x9a, 0x0e, 0x2f,
-	0x38, 0x5d, 0xe2, 0x7c, 0xcb, 0x23, 0x7f, 0x2c, 0xc0, 0x56, 0x7b, 0xf3, 0x27, 0x04, 0xeb, 0x16,
-	0xa7, 0xad, 0x80, 0x0b, 0x1c, 0x08, 0x0f, 0xc7, 0x0a, 0x02, 0x11, 0x62, 0x27, 0xdb, 0x15, 0x29,
-	0xce, 0x42, 0x26, 0x67, 0x05, 0x1e, 0xf4, 0x70, 0x87, 0xf4, 0xaa, 0x45, 0x19, 0x3b, 0xde, 0xc4,
-	0x89, 0xf9, 0x9c, 0x56, 0x1f, 0x8c, 0x13, 0xf3, 0x39, 0x4d, 0x52, 0x2d, 0x4d, 0x53, 0xfd, 0x52,
-	0xda, 0x75, 0x8e, 0x20, 0x95, 0x74, 0xca, 0x96, 0x68, 0xc6, 0xfe, 0x2e, 0x16, 0x78, 0x92, 0xa5,
-	0x5c, 0x9b, 0xdf, 0x4b, 0xbc, 0x86, 0xeb, 0x1e, 0x61, 0xee, 0xa7, 0x60, 0x95, 0x79, 0x6f, 0x71,
-	0xcd, 0x9e, 0x5c, 0x2b, 0x81, 0x4a, 0x7b, 0x92, 0x4e, 0x71, 0x9a, 0xce, 0x8f, 0x08, 0x36, 0xd5,
-	0xf5, 0x7b, 0x3e, 0x12, 0xde, 0x80, 0x2d, 0x8b, 0xd3, 0x2c, 0x6e, 0xe5, 0xea, 0x33, 0x04, 0x9a,
-	0xc5, 0xa9, 0xe5, 0xd1, 0x70, 0x19, 0x1b, 0xc4, 0xae, 0x9a, 0xbc, 0x33, 0xd1, 0xa6, 0xf6, 0xcb,
-	0x59, 0x64, 0x62, 0x86, 0xe2, 0x22, 0x33, 0xbc, 0x0d, 0xfa, 0xac, 0x24, 0x65, 0x84, 0xe4, 0x73,
-	0xa3, 0xd4, 0xe7, 0xfe, 0x4c, 0x7e, 0xee, 0xa4, 0x79, 0x60, 0x41, 0x06, 0x78, 0x98, 0xea, 0x21,
-	0x57, 0xda, 0xce, 0xd5, 0x84, 0x26, 0xec, 0xab, 0x8a, 0xfd, 0x9d, 0x7f, 0xca, 0x50, 0xb0, 0x38,
-	0xd5, 0x7e, 0x43, 0x50, 0x99, 0xdb, 0xd3, 0x3e, 0xad, 0x2d, 0x37, 0x12, 0x6a, 0x19, 0xfd, 0x4c,
-	0x3f, 0xb8, 0x23, 0x80, 0x2a, 0xc5, 0xef, 0x08, 0x5e, 0xcd, 0x6e, 0x87, 0xbb, 0x39, 0x68, 0x32,
-	0x51, 0xf4, 0x2f, 0xee, 0x03, 0x45, 0x29, 0xfe, 0x05, 0x41, 0x65, 0xde, 0xf0, 0xd3, 0xf6, 0x73,
-	0xd0, 0x2c, 0x98, 0x9e, 0xfa, 0xc7, 0x39, 0x70, 0x66, 0x6e, 0x83, 0x94, 0x37, 0x6f, 0x66, 0xe6,
-	0x92, 0xb7, 0x60, 0xe8, 0xde, 0x51, 0xde, 0x0f, 0xb0, 0x36, 0x9d, 0x3f, 0xef, 0xe5, 0x80, 0x52,
-	0x51, 0xb9, 0x04, 0xcc, 0x4e, 0x9e, 0x5f, 0x11, 0xbc, 0x32, 0x6f, 0x6a, 0x7c, 0x92, 0x03, 0x75,
-	0x4e, 0xbc, 0xbe, 0x7f, 0xb7, 0x78, 0xa5, 0xef, 0x0f, 0x04, 0x4f, 0x17, 0x35, 0xfd, 0x3c, 0x3c,
-	0x0b, 0x70, 0xf4, 0xcf, 0x73, 0xe0, 0xdc, 0xd4, 0x82, 0xb5, 0x3f, 0x11, 0x18, 0x37, 0x4c, 0x8a,
-	0x56, 0x6e, 0xfb, 0xfd, 0x3f, 0xd2, 0xcf, 0x10, 0x3c, 0xba, 0x3e, 0x3a, 0x3e, 0xca, 0x41, 0x70,
-	0x2d, 0x56, 0x6f, 0xde, 0x3e, 0x36, 0x7d, 0x87, 0x9f, 0x2e, 0x9a, 0x04, 0xfb, 0xb7, 0xe8, 0xbe,
-	0x73, 0x70, 0xf4, 0xf7, 0x97, 0xc5, 0xb9, 0xf2, 0x03, 0xd0, 0x3c, 0xfc, 0x6b, 0x64, 0xa0, 0xf3,
-	0x91, 0x81, 0xfe, 0x1d, 0x19, 0xe8, 0xe7, 0x4b, 0x63, 0xe5, 0xfc, 0xd2, 0x58, 0xf9, 0xfb, 0xd2,
-	0x58, 0xf9, 0xe6, 0x43, 0xea, 0x89, 0x6e, 0xd4, 0xa9, 0x39, 0xcc, 0xaf, 0x27, 0xc1, 0x6f, 0x4d,
-	0xa1, 0xeb, 0x0a, 0xba, 0x7e, 0x5a, 0x9f, 0xfe, 0xa7, 0x0c, 0xfb, 0x84, 0x77, 0x4a, 0xf2, 0x6f,
-	0xe3, 0xdd, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x79, 0xba, 0x1f, 0x32, 0xc0, 0x0c, 0x00, 0x00,
+func (m *MsgInstantiateContract) GetMsg() []byte {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	ExecuteGovernanceVAA(ctx context.Context, in *MsgExecuteGovernanceVAA, opts ...grpc.CallOption) (*MsgExecuteGovernanceVAAResponse, error)
-	RegisterAccountAsGuardian(ctx context.Context, in *MsgRegisterAccountAsGuardian, opts ...grpc.CallOption) (*MsgRegisterAccountAsGuardianResponse, error)
-	CreateAllowlistEntry(ctx context.Context, in *MsgCreateAllowlistEntryRequest, opts ...grpc.CallOption) (*MsgAllowlistResponse, error)
-	DeleteAllowlistEntry(ctx context.Context, in *MsgDeleteAllowlistEntryRequest, opts ...grpc.CallOption) (*MsgAllowlistResponse, error)
-	// StoreCode to submit Wasm code to the system
-	StoreCode(ctx context.Context, in *MsgStoreCode, opts ...grpc.CallOption) (*MsgStoreCodeResponse, error)
-	//  Instantiate creates a new smart contract instance for the given code id.
-	InstantiateContract(ctx context.Context, in *MsgInstantiateContract, opts ...grpc.CallOption) (*MsgInstantiateContractResponse, error)
-	AddWasmInstantiateAllowlist(ctx context.Context, in *MsgAddWasmInstantiateAllowlist, opts ...grpc.CallOption) (*MsgWasmInstantiateAllowlistResponse, error)
-	DeleteWasmInstantiateAllowlist(ctx context.Context, in *MsgDeleteWasmInstantiateAllowlist, opts ...grpc.CallOption) (*MsgWasmInstantiateAllowlistResponse, error)
-	MigrateContract(ctx context.Context, in *MsgMigrateContract, opts ...grpc.CallOption) (*MsgMigrateContractResponse, error)
-	ExecuteGatewayGovernanceVaa(ctx context.Context, in *MsgExecuteGatewayGovernanceVaa, opts ...grpc.CallOption) (*EmptyResponse, error)
+func (m *MsgInstantiateContract) GetVaa() []byte {
+	if m != nil {
+		return m.Vaa
+	}
+	return nil
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+type MsgInstantiateContractResponse struct {
+	// Address is the bech32 address of the new contract instance.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Data contains base64-encoded bytes to returned from the contract
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgInstantiateContractResponse) Reset()         { *m = MsgInstantiateContractResponse{} }
+func (m *MsgInstantiateContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantiateContractResponse) ProtoMessage()    {}
+func (*MsgInstantiateContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f7aa067b0c517b, []int{20}
 }
-
-func (c *msgClient) ExecuteGovernanceVAA(ctx context.Context, in *MsgExecuteGovernanceVAA, opts ...grpc.CallOption) (*MsgExecuteGovernanceVAAResponse, error) {
-	out := new(MsgExecuteGovernanceVAAResponse)
-	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Msg/ExecuteGovernanceVAA", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgInstantiateContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInstantiateContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstantiateContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgInstantiateContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantiateContractResponse.Merge(m, src)
+}
+func (m *MsgInstantiateContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInstantiateContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantiateContractResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) RegisterAccountAsGuardian(ctx context.Context, in *MsgRegisterAccountAsGuardian, opts ...grpc.CallOption) (*MsgRegisterAccountAsGuardianResponse, error) {
-	out := new(MsgRegisterAccountAsGuardianResponse)
-	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Msg/RegisterAccountAsGuardian", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgInstantiateContractResponse proto.InternalMessageInfo
+
+func (m *MsgInstantiateContractResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CreateAllowlistEntry(ctx context.Context, in *MsgCreateAllowlistEntryRequest, opts ...grpc.CallOption) (*MsgAllowlistResponse, error) {
-	out := new(MsgAllowlistResponse)
-	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Msg/CreateAllowlistEntry", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgInstantiateContractResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) DeleteAllowlistEntry(ctx context.Context, in *MsgDeleteAllowlistEntryRequest, opts ...grpc.CallOption) (*MsgAllowlistResponse, error) {
-	out := new(MsgAllowlistResponse)
-	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Msg/DeleteAllowlistEntry", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgAddWasmInstantiateAllowlist struct {
+	// Signer is the actor that signed the messages
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Address is the bech32 address of the contract that can call wasm instantiate without a VAA
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// CodeID is the reference to the stored WASM code that can be instantiated
+	CodeId uint64 `protobuf:"varint,3,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// vaa is the WormchainAddWasmInstantiateAllowlist governance message
+	Vaa []byte `protobuf:"bytes,4,opt,name=vaa,proto3" json:"vaa,omitempty"`
 }
 
-func (c *msgClient) StoreCode(ctx context.Context, in *MsgStoreCode, opts ...grpc.CallOption) (*MsgStoreCodeResponse, error) {
-	out := new(MsgStoreCodeResponse)
-	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Msg/StoreCode", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddWasmInstantiateAllowlist) Reset()         { *m = MsgAddWasmInstantiateAllowlist{} }
+func (m *MsgAddWasmInstantiateAllowlist) String() string { return proto.CompactTextString(m) }
+func (*MsgAddWasmInstantiateAllowlist) ProtoMessage()    {}
+func (*MsgAddWasmInstantiateAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f7aa067b0c517b, []int{21}
+}
+func (m *MsgAddWasmInstantiateAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddWasmInstantiateAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddWasmInstantiateAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgAddWasmInstantiateAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddWasmInstantiateAllowlist.Merge(m, src)
+}
+func (m *MsgAddWasmInstantiateAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddWasmInstantiateAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddWasmInstantiateAllowlist.DiscardUnknown(m)
 }
 
-func (c *msgClient) InstantiateContract(ctx context.Context, in *MsgInstantiateContract, opts ...grpc.CallOption) (*MsgInstantiateContractResponse, error) {
-	out := new(MsgInstantiateContractResponse)
-	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Msg/InstantiateContract", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAddWasmInstantiateAllowlist proto.InternalMessageInfo
+
+func (m *MsgAddWasmInstantiateAllowlist) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) AddWasmInstantiateAllowlist(ctx context.Context, in *MsgAddWasmInstantiateAllowlist, opts ...grpc.CallOption) (*MsgWasmInstantiateAllowlistResponse, error) {
-	out := new(MsgWasmInstantiateAllowlistResponse)
-	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Msg/AddWasmInstantiateAllowlist", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddWasmInstantiateAllowlist) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) DeleteWasmInstantiateAllowlist(ctx context.Context, in *MsgDeleteWasmInstantiateAllowlist, opts ...grpc.CallOption) (*MsgWasmInstantiateAllowlistResponse, error) {
-	out := new(MsgWasmInstantiateAllowlistResponse)
-	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Msg/DeleteWasmInstantiateAllowlist", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddWasmInstantiateAllowlist) GetCodeId() uint64 {
+	if m != nil {
+		return m.CodeId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) MigrateContract(ctx context.Context, in *MsgMigrateContract, opts ...grpc.CallOption) (*MsgMigrateContractResponse, error) {
-	out := new(MsgMigrateContractResponse)
-	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Msg/MigrateContract", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddWasmInstantiateAllowlist) GetVaa() []byte {
+	if m != nil {
+		return m.Vaa
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) ExecuteGatewayGovernanceVaa(ctx context.Context, in *MsgExecuteGatewayGovernanceVaa, opts ...grpc.CallOption) (*EmptyResponse, error) {
-	out := new(EmptyResponse)
-	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Msg/ExecuteGatewayGovernanceVaa", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgDeleteWasmInstantiateAllowlist struct {
+	// signer should be a guardian validator in a current set or future set.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// the <contract, code_id> pair to remove
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	CodeId  uint64 `protobuf:"varint,3,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// vaa is the WormchainDeleteWasmInstantiateAllowlist governance message
+	Vaa []byte `protobuf:"bytes,4,opt,name=vaa,proto3" json:"vaa,omitempty"`
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	ExecuteGovernanceVAA(context.Context, *MsgExecuteGovernanceVAA) (*MsgExecuteGovernanceVAAResponse, error)
-	RegisterAccountAsGuardian(context.Context, *MsgRegisterAccountAsGuardian) (*MsgRegisterAccountAsGuardianResponse, error)
-	CreateAllowlistEntry(context.Context, *MsgCreateAllowlistEntryRequest) (*MsgAllowlistResponse, error)
-	DeleteAllowlistEntry(context.Context, *MsgDeleteAllowlistEntryRequest) (*MsgAllowlistResponse, error)
-	// StoreCode to submit Wasm code to the system
-	StoreCode(context.Context, *MsgStoreCode) (*MsgStoreCodeResponse, error)
-	//  Instantiate creates a new smart contract instance for the given code id.
-	InstantiateContract(context.Context, *MsgInstantiateContract) (*MsgInstantiateContractResponse, error)
-	AddWasmInstantiateAllowlist(context.Context, *MsgAddWasmInstantiateAllowlist) (*MsgWasmInstantiateAllowlistResponse, error)
-	DeleteWasmInstantiateAllowlist(context.Context, *MsgDeleteWasmInstantiateAllowlist) (*MsgWasmInstantiateAllowlistResponse, error)
-	MigrateContract(context.Context, *MsgMigrateContract) (*MsgMigrateContractResponse, error)
-	ExecuteGatewayGovernanceVaa(context.Context, *MsgExecuteGatewayGovernanceVaa) (*EmptyResponse, error)
+func (m *MsgDeleteWasmInstantiateAllowlist) Reset()         { *m = MsgDeleteWasmInstantiateAllowlist{} }
+func (m *MsgDeleteWasmInstantiateAllowlist) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteWasmInstantiateAllowlist) ProtoMessage()    {}
+func (*MsgDeleteWasmInstantiateAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f7aa067b0c517b, []int{22}
+}
+func (m *MsgDeleteWasmInstantiateAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteWasmInstantiateAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteWasmInstantiateAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteWasmInstantiateAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteWasmInstantiateAllowlist.Merge(m, src)
+}
+func (m *MsgDeleteWasmInstantiateAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteWasmInstantiateAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteWasmInstantiateAllowlist.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgDeleteWasmInstantiateAllowlist proto.InternalMessageInfo
+
+func (m *MsgDeleteWasmInstantiateAllowlist) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
 
-func (*UnimplementedMsgServer) ExecuteGovernanceVAA(ctx context.Context, req *MsgExecuteGovernanceVAA) (*MsgExecuteGovernanceVAAResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExecuteGovernanceVAA not implemented")
+func (m *MsgDeleteWasmInstantiateAllowlist) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) RegisterAccountAsGuardian(ctx context.Context, req *MsgRegisterAccountAsGuardian) (*MsgRegisterAccountAsGuardianResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterAccountAsGuardian not implemented")
+
+func (m *MsgDeleteWasmInstantiateAllowlist) GetCodeId() uint64 {
+	if m != nil {
+		return m.CodeId
+	}
+	return 0
 }
-func (*UnimplementedMsgServer) CreateAllowlistEntry(ctx context.Context, req *MsgCreateAllowlistEntryRequest) (*MsgAllowlistResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateAllowlistEntry not implemented")
+
+func (m *MsgDeleteWasmInstantiateAllowlist) GetVaa() []byte {
+	if m != nil {
+		return m.Vaa
+	}
+	return nil
 }
-func (*UnimplementedMsgServer) DeleteAllowlistEntry(ctx context.Context, req *MsgDeleteAllowlistEntryRequest) (*MsgAllowlistResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteAllowlistEntry not implemented")
+
+type MsgWasmInstantiateAllowlistResponse struct {
 }
-func (*UnimplementedMsgServer) StoreCode(ctx context.Context, req *MsgStoreCode) (*MsgStoreCodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StoreCode not implemented")
+
+func (m *MsgWasmInstantiateAllowlistResponse) Reset()         { *m = MsgWasmInstantiateAllowlistResponse{} }
+func (m *MsgWasmInstantiateAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWasmInstantiateAllowlistResponse) ProtoMessage()    {}
+func (*MsgWasmInstantiateAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f7aa067b0c517b, []int{23}
 }
-func (*UnimplementedMsgServer) InstantiateContract(ctx context.Context, req *MsgInstantiateContract) (*MsgInstantiateContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InstantiateContract not implemented")
+func (m *MsgWasmInstantiateAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) AddWasmInstantiateAllowlist(ctx context.Context, req *MsgAddWasmInstantiateAllowlist) (*MsgWasmInstantiateAllowlistResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddWasmInstantiateAllowlist not implemented")
+func (m *MsgWasmInstantiateAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWasmInstantiateAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) DeleteWasmInstantiateAllowlist(ctx context.Context, req *MsgDeleteWasmInstantiateAllowlist) (*MsgWasmInstantiateAllowlistResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteWasmInstantiateAllowlist not implemented")
+func (m *MsgWasmInstantiateAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWasmInstantiateAllowlistResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) MigrateContract(ctx context.Context, req *MsgMigrateContract) (*MsgMigrateContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MigrateContract not implemented")
+func (m *MsgWasmInstantiateAllowlistResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) ExecuteGatewayGovernanceVaa(ctx context.Context, req *MsgExecuteGatewayGovernanceVaa) (*EmptyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExecuteGatewayGovernanceVaa not implemented")
+func (m *MsgWasmInstantiateAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWasmInstantiateAllowlistResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgWasmInstantiateAllowlistResponse proto.InternalMessageInfo
+
+// MsgMigrateContract runs a code upgrade/ downgrade for a smart contract
+type MsgMigrateContract struct {
+	// Sender is the actor that signs the messages
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// CodeID references the new WASM code
+	CodeID uint64 `protobuf:"varint,3,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// Msg json encoded message to be passed to the contract on migration
+	Msg []byte `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
+	// vaa must be governance msg with payload containing keccak256 hash(hash(hash(BigEndian(CodeID)), Contract), Msg)
+	Vaa []byte `protobuf:"bytes,6,opt,name=vaa,proto3" json:"vaa,omitempty"`
 }
 
-func _Msg_ExecuteGovernanceVAA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgExecuteGovernanceVAA)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ExecuteGovernanceVAA(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/wormhole_foundation.wormchain.wormhole.Msg/ExecuteGovernanceVAA",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ExecuteGovernanceVAA(ctx, req.(*MsgExecuteGovernanceVAA))
+func (m *MsgMigrateContract) Reset()         { *m = MsgMigrateContract{} }
+func (m *MsgMigrateContract) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateContract) ProtoMessage()    {}
+func (*MsgMigrateContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f7aa067b0c517b, []int{24}
+}
+func (m *MsgMigrateContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgMigrateContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateContract.Merge(m, src)
+}
+func (m *MsgMigrateContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateContract.DiscardUnknown(m)
 }
 
-func _Msg_RegisterAccountAsGuardian_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterAccountAsGuardian)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterAccountAsGuardian(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/wormhole_foundation.wormchain.wormhole.Msg/RegisterAccountAsGuardian",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterAccountAsGuardian(ctx, req.(*MsgRegisterAccountAsGuardian))
+var xxx_messageInfo_MsgMigrateContract proto.InternalMessageInfo
+
+func (m *MsgMigrateContract) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CreateAllowlistEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateAllowlistEntryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateAllowlistEntry(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/wormhole_foundation.wormchain.wormhole.Msg/CreateAllowlistEntry",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateAllowlistEntry(ctx, req.(*MsgCreateAllowlistEntryRequest))
+func (m *MsgMigrateContract) GetContract() string {
+	if m != nil {
+		return m.Contract
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_DeleteAllowlistEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteAllowlistEntryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteAllowlistEntry(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/wormhole_foundation.wormchain.wormhole.Msg/DeleteAllowlistEntry",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteAllowlistEntry(ctx, req.(*MsgDeleteAllowlistEntryRequest))
+func (m *MsgMigrateContract) GetCodeID() uint64 {
+	if m != nil {
+		return m.CodeID
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_StoreCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgStoreCode)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).StoreCode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/wormhole_foundation.wormchain.wormhole.Msg/StoreCode",
+func (m *MsgMigrateContract) GetMsg() []byte {
+	if m != nil {
+		return m.Msg
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).StoreCode(ctx, req.(*MsgStoreCode))
+	return nil
+}
+
+func (m *MsgMigrateContract) GetVaa() []byte {
+	if m != nil {
+		return m.Vaa
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_InstantiateContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgInstantiateContract)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).InstantiateContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/wormhole_foundation.wormchain.wormhole.Msg/InstantiateContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).InstantiateContract(ctx, req.(*MsgInstantiateContract))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgMigrateContractResponse returns contract migration result data.
+type MsgMigrateContractResponse struct {
+	// Data contains same raw bytes returned as data from the wasm contract.
+	// (May be empty)
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func _Msg_AddWasmInstantiateAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddWasmInstantiateAllowlist)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddWasmInstantiateAllowlist(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/wormhole_foundation.wormchain.wormhole.Msg/AddWasmInstantiateAllowlist",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddWasmInstantiateAllowlist(ctx, req.(*MsgAddWasmInstantiateAllowlist))
+func (m *MsgMigrateContractResponse) Reset()         { *m = MsgMigrateContractResponse{} }
+func (m *MsgMigrateContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateContractResponse) ProtoMessage()    {}
+func (*MsgMigrateContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f7aa067b0c517b, []int{25}
+}
+func (m *MsgMigrateContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgMigrateContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateContractResponse.Merge(m, src)
+}
+func (m *MsgMigrateContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateContractResponse.DiscardUnknown(m)
 }
 
-func _Msg_DeleteWasmInstantiateAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteWasmInstantiateAllowlist)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteWasmInstantiateAllowlist(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/wormhole_foundation.wormchain.wormhole.Msg/DeleteWasmInstantiateAllowlist",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteWasmInstantiateAllowlist(ctx, req.(*MsgDeleteWasmInstantiateAllowlist))
+var xxx_messageInfo_MsgMigrateContractResponse proto.InternalMessageInfo
+
+func (m *MsgMigrateContractResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_MigrateContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMigrateContract)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MigrateContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/wormhole_foundation.wormchain.wormhole.Msg/MigrateContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MigrateContract(ctx, req.(*MsgMigrateContract))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgExecuteGatewayGovernanceVaa struct {
+	// Sender is the actor that signs the messages
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// vaa must be governance msg with valid module, action, and payload
+	Vaa []byte `protobuf:"bytes,2,opt,name=vaa,proto3" json:"vaa,omitempty"`
 }
 
-func _Msg_ExecuteGatewayGovernanceVaa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgExecuteGatewayGovernanceVaa)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ExecuteGatewayGovernanceVaa(ctx, in)
+func (m *MsgExecuteGatewayGovernanceVaa) Reset()         { *m = MsgExecuteGatewayGovernanceVaa{} }
+func (m *MsgExecuteGatewayGovernanceVaa) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteGatewayGovernanceVaa) ProtoMessage()    {}
+func (*MsgExecuteGatewayGovernanceVaa) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f7aa067b0c517b, []int{26}
+}
+func (m *MsgExecuteGatewayGovernanceVaa) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecuteGatewayGovernanceVaa) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecuteGatewayGovernanceVaa.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/wormhole_foundation.wormchain.wormhole.Msg/ExecuteGatewayGovernanceVaa",
+}
+func (m *MsgExecuteGatewayGovernanceVaa) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteGatewayGovernanceVaa.Merge(m, src)
+}
+func (m *MsgExecuteGatewayGovernanceVaa) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecuteGatewayGovernanceVaa) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteGatewayGovernanceVaa.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecuteGatewayGovernanceVaa proto.InternalMessageInfo
+
+func (m *MsgExecuteGatewayGovernanceVaa) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ExecuteGatewayGovernanceVaa(ctx, req.(*MsgExecuteGatewayGovernanceVaa))
+	return ""
+}
+
+func (m *MsgExecuteGatewayGovernanceVaa) GetVaa() []byte {
+	if m != nil {
+		return m.Vaa
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "wormhole_foundation.wormchain.wormhole.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ExecuteGovernanceVAA",
-			Handler:    _Msg_ExecuteGovernanceVAA_Handler,
-		},
-		{
-			MethodName: "RegisterAccountAsGuardian",
-			Handler:    _Msg_RegisterAccountAsGuardian_Handler,
-		},
-		{
-			MethodName: "CreateAllowlistEntry",
-			Handler:    _Msg_CreateAllowlistEntry_Handler,
-		},
-		{
-			MethodName: "DeleteAllowlistEntry",
-			Handler:    _Msg_DeleteAllowlistEntry_Handler,
-		},
-		{
-			MethodName: "StoreCode",
-			Handler:    _Msg_StoreCode_Handler,
-		},
-		{
-			MethodName: "InstantiateContract",
-			Handler:    _Msg_InstantiateContract_Handler,
-		},
-		{
-			MethodName: "AddWasmInstantiateAllowlist",
-			Handler:    _Msg_AddWasmInstantiateAllowlist_Handler,
-		},
-		{
-			MethodName: "DeleteWasmInstantiateAllowlist",
-			Handler:    _Msg_DeleteWasmInstantiateAllowlist_Handler,
-		},
-		{
-			MethodName: "MigrateContract",
-			Handler:    _Msg_MigrateContract_Handler,
-		},
-		{
-			MethodName: "ExecuteGatewayGovernanceVaa",
-			Handler:    _Msg_ExecuteGatewayGovernanceVaa_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "wormhole/tx.proto",
+type MsgReserveSequenceBlock struct {
+	// the emitter of the reservation is the signer padded to 32 bytes
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// number of sequences to reserve
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (m *EmptyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgReserveSequenceBlock) Reset()         { *m = MsgReserveSequenceBlock{} }
+func (m *MsgReserveSequenceBlock) String() string { return proto.CompactTextString(m) }
+func (*MsgReserveSequenceBlock) ProtoMessage()    {}
+func (*MsgReserveSequenceBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f7aa067b0c517b, []int{27}
+}
+func (m *MsgReserveSequenceBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReserveSequenceBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReserveSequenceBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EmptyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgReserveSequenceBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReserveSequenceBlock.Merge(m, src)
+}
+func (m *MsgReserveSequenceBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReserveSequenceBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReserveSequenceBlock.DiscardUnknown(m)
 }
 
-func (m *EmptyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgReserveSequenceBlock proto.InternalMessageInfo
+
+func (m *MsgReserveSequenceBlock) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
 
-func (m *MsgCreateAllowlistEntryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgReserveSequenceBlock) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCreateAllowlistEntryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgReserveSequenceBlockResponse struct {
+	// first sequence of the reserved range
+	Start uint64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (m *MsgCreateAllowlistEntryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgReserveSequenceBlockResponse) Reset()         { *m = MsgReserveSequenceBlockResponse{} }
+func (m *MsgReserveSequenceBlockResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReserveSequenceBlockResponse) ProtoMessage()    {}
+func (*MsgReserveSequenceBlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55f7aa067b0c517b, []int{28}
+}
+func (m *MsgReserveSequenceBlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReserveSequenceBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReserveSequenceBlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgReserveSequenceBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReserveSequenceBlockResponse.Merge(m, src)
+}
+func (m *MsgReserveSequenceBlockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReserveSequenceBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReserveSequenceBlockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReserveSequenceBlockResponse proto.InternalMessageInfo
+
+func (m *MsgReserveSequenceBlockResponse) GetStart() uint64 {
+	if m != nil {
+		return m.Start
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgDeleteAllowlistEntryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgReserveSequenceBlockResponse) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgDeleteAllowlistEntryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*EmptyResponse)(nil), "wormhole_foundation.wormchain.wormhole.EmptyResponse")
+	proto.RegisterType((*MsgCreateAllowlistEntryRequest)(nil), "wormhole_foundation.wormchain.wormhole.MsgCreateAllowlistEntryRequest")
+	proto.RegisterType((*MsgDeleteAllowlistEntryRequest)(nil), "wormhole_foundation.wormchain.wormhole.MsgDeleteAllowlistEntryRequest")
+	proto.RegisterType((*MsgAllowlistResponse)(nil), "wormhole_foundation.wormchain.wormhole.MsgAllowlistResponse")
+	proto.RegisterType((*MsgExecuteGovernanceVAA)(nil), "wormhole_foundation.wormchain.wormhole.MsgExecuteGovernanceVAA")
+	proto.RegisterType((*MsgExecuteGovernanceVAAResponse)(nil), "wormhole_foundation.wormchain.wormhole.MsgExecuteGovernanceVAAResponse")
+	proto.RegisterType((*MsgExecuteGovernanceVAABatch)(nil), "wormhole_foundation.wormchain.wormhole.MsgExecuteGovernanceVAABatch")
+	proto.RegisterType((*MsgExecuteGovernanceVAABatchResponse)(nil), "wormhole_foundation.wormchain.wormhole.MsgExecuteGovernanceVAABatchResponse")
+	proto.RegisterType((*MsgSubmitObservation)(nil), "wormhole_foundation.wormchain.wormhole.MsgSubmitObservation")
+	proto.RegisterType((*MsgGuardianHeartbeat)(nil), "wormhole_foundation.wormchain.wormhole.MsgGuardianHeartbeat")
+	proto.RegisterType((*MsgGuardianHeartbeatResponse)(nil), "wormhole_foundation.wormchain.wormhole.MsgGuardianHeartbeatResponse")
+	proto.RegisterType((*MsgExecuteGatewayTransfer)(nil), "wormhole_foundation.wormchain.wormhole.MsgExecuteGatewayTransfer")
+	proto.RegisterType((*MsgExecuteGatewayTransferWithPayload)(nil), "wormhole_foundation.wormchain.wormhole.MsgExecuteGatewayTransferWithPayload")
+	proto.RegisterType((*MsgExecuteGatewayTransferResponse)(nil), "wormhole_foundation.wormchain.wormhole.MsgExecuteGatewayTransferResponse")
+	proto.RegisterType((*MsgSubmitObservationResponse)(nil), "wormhole_foundation.wormchain.wormhole.MsgSubmitObservationResponse")
+	proto.RegisterType((*MsgRegisterAccountAsGuardian)(nil), "wormhole_foundation.wormchain.wormhole.MsgRegisterAccountAsGuardian")
+	proto.RegisterType((*MsgRegisterAccountAsGuardianResponse)(nil), "wormhole_foundation.wormchain.wormhole.MsgRegisterAccountAsGuardianResponse")
+	proto.RegisterType((*MsgStoreCode)(nil), "wormhole_foundation.wormchain.wormhole.MsgStoreCode")
+	proto.RegisterType((*MsgStoreCodeResponse)(nil), "wormhole_foundation.wormchain.wormhole.MsgStoreCodeResponse")
+	proto.RegisterType((*MsgInstantiateContract)(nil), "wormhole_foundation.wormchain.wormhole.MsgInstantiateContract")
+	proto.RegisterType((*MsgInstantiateContractResponse)(nil), "wormhole_foundation.wormchain.wormhole.MsgInstantiateContractResponse")
+	proto.RegisterType((*MsgAddWasmInstantiateAllowlist)(nil), "wormhole_foundation.wormchain.wormhole.MsgAddWasmInstantiateAllowlist")
+	proto.RegisterType((*MsgDeleteWasmInstantiateAllowlist)(nil), "wormhole_foundation.wormchain.wormhole.MsgDeleteWasmInstantiateAllowlist")
+	proto.RegisterType((*MsgWasmInstantiateAllowlistResponse)(nil), "wormhole_foundation.wormchain.wormhole.MsgWasmInstantiateAllowlistResponse")
+	proto.RegisterType((*MsgMigrateContract)(nil), "wormhole_foundation.wormchain.wormhole.MsgMigrateContract")
+	proto.RegisterType((*MsgMigrateContractResponse)(nil), "wormhole_foundation.wormchain.wormhole.MsgMigrateContractResponse")
+	proto.RegisterType((*MsgExecuteGatewayGovernanceVaa)(nil), "wormhole_foundation.wormchain.wormhole.MsgExecuteGatewayGovernanceVaa")
+	proto.RegisterType((*MsgReserveSequenceBlock)(nil), "wormhole_foundation.wormchain.wormhole.MsgReserveSequenceBlock")
+	proto.RegisterType((*MsgReserveSequenceBlockResponse)(nil), "wormhole_foundation.wormchain.wormhole.MsgReserveSequenceBlockResponse")
 }
 
-func (m *MsgDeleteAllowlistEntryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("wormhole/tx.proto", fileDescriptor_55f7aa067b0c517b) }
+
+var fileDescriptor_55f7aa067b0c517b = []byte{
+	// 1400 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xc6, 0x4e, 0x1a, 0xbf, 0xba, 0xbf, 0xf6, 0x6b, 0xa5, 0xee, 0x36, 0x72, 0x12, 0xb7,
+	0xdf, 0x12, 0x09, 0xd5, 0xa6, 0x2d, 0x50, 0x81, 0x2a, 0x5a, 0x3b, 0x69, 0xd3, 0x94, 0x1a, 0xd0,
+	0xa6, 0x6a, 0x24, 0x2e, 0xd6, 0x78, 0x77, 0xb2, 0x5e, 0xd5, 0x9e, 0x71, 0x77, 0xc6, 0x49, 0x8c,
+	0x84, 0xe0, 0xce, 0x01, 0x2a, 0x0e, 0x5c, 0x40, 0xe2, 0xc4, 0x15, 0x89, 0x13, 0x07, 0x4e, 0x9c,
+	0x7a, 0x41, 0xea, 0x11, 0x71, 0x88, 0x50, 0x7a, 0xe5, 0x8f, 0x40, 0x33, 0xbb, 0x3b, 0xde, 0xc6,
+	0x5e, 0x27, 0x1b, 0x47, 0xf4, 0x94, 0x79, 0xb3, 0x7e, 0x9f, 0xf7, 0x79, 0x2f, 0xef, 0xc7, 0xcc,
+	0xc0, 0xb9, 0x6d, 0xea, 0xb5, 0x9b, 0xb4, 0x85, 0xcb, 0x7c, 0xa7, 0xd4, 0xf1, 0x28, 0xa7, 0xfa,
+	0x95, 0x70, 0xab, 0xbe, 0x49, 0xbb, 0xc4, 0x46, 0xdc, 0xa5, 0xa4, 0x24, 0xf6, 0xac, 0x26, 0x72,
+	0x49, 0x29, 0xfc, 0x6a, 0xe4, 0x1c, 0xea, 0x50, 0xa9, 0x52, 0x16, 0x2b, 0x5f, 0xdb, 0x28, 0x58,
+	0x94, 0xb5, 0x29, 0x2b, 0x37, 0x10, 0xc3, 0xe5, 0xad, 0x6b, 0x0d, 0xcc, 0xd1, 0xb5, 0xb2, 0x45,
+	0x5d, 0x12, 0x7c, 0xcf, 0x2b, 0x83, 0x4d, 0x8c, 0x3c, 0xde, 0xc0, 0x88, 0xfb, 0x5f, 0x8a, 0x67,
+	0xe0, 0xd4, 0xdd, 0x76, 0x87, 0xf7, 0x4c, 0xcc, 0x3a, 0x94, 0x30, 0x5c, 0xfc, 0x56, 0x83, 0x42,
+	0x8d, 0x39, 0xcb, 0x1e, 0x46, 0x1c, 0x57, 0x5a, 0x2d, 0xba, 0xdd, 0x72, 0x19, 0xbf, 0x4b, 0xb8,
+	0xd7, 0x33, 0xf1, 0xd3, 0x2e, 0x66, 0x5c, 0x9f, 0x85, 0x69, 0xe6, 0x3a, 0x04, 0x7b, 0x79, 0x6d,
+	0x41, 0x5b, 0xca, 0x98, 0x81, 0xa4, 0xe7, 0xe1, 0x04, 0xb2, 0x6d, 0x0f, 0x33, 0x96, 0x9f, 0x94,
+	0x1f, 0x42, 0x51, 0xd7, 0x21, 0x4d, 0x50, 0x1b, 0xe7, 0x53, 0x72, 0x5b, 0xae, 0xf5, 0x37, 0xe1,
+	0x1c, 0xde, 0xe9, 0xb8, 0x9e, 0x74, 0xb5, 0xde, 0xc4, 0xae, 0xd3, 0xe4, 0xf9, 0xf4, 0x82, 0xb6,
+	0x94, 0x36, 0xcf, 0xf6, 0x3f, 0xdc, 0x97, 0xfb, 0x45, 0x53, 0x92, 0x5a, 0xc1, 0x2d, 0x7c, 0x6c,
+	0xa4, 0x8a, 0xb3, 0x90, 0xab, 0x31, 0x47, 0xa1, 0xa9, 0x08, 0x2c, 0xc3, 0xf9, 0x1a, 0x73, 0xee,
+	0xee, 0x60, 0xab, 0xcb, 0xf1, 0x2a, 0xdd, 0xc2, 0x1e, 0x41, 0xc4, 0xc2, 0x8f, 0x2b, 0x15, 0xfd,
+	0x2c, 0xa4, 0xb6, 0x10, 0x92, 0x16, 0xb2, 0xa6, 0x58, 0x46, 0xcc, 0x4e, 0x46, 0xcd, 0x16, 0x17,
+	0x61, 0x3e, 0x06, 0x44, 0xd9, 0x79, 0x00, 0x73, 0x31, 0x3f, 0xa9, 0x22, 0x6e, 0x35, 0x45, 0xd0,
+	0xb6, 0x10, 0x62, 0x79, 0x6d, 0x21, 0xb5, 0x94, 0x35, 0xe5, 0x3a, 0xd6, 0xdc, 0x15, 0xb8, 0x3c,
+	0x0a, 0x4b, 0xd9, 0xbc, 0x23, 0x7d, 0x5e, 0xef, 0x36, 0xda, 0x2e, 0xff, 0xb8, 0xc1, 0xb0, 0xb7,
+	0x25, 0xa3, 0x1c, 0x1b, 0xbd, 0xc0, 0xe1, 0x49, 0xe5, 0x70, 0xf1, 0x77, 0x4d, 0x42, 0xac, 0x76,
+	0x91, 0x67, 0xbb, 0x88, 0xdc, 0x0f, 0xf3, 0x29, 0x16, 0x62, 0x11, 0xb2, 0x84, 0xda, 0xb8, 0xbe,
+	0x85, 0x3d, 0xe6, 0x52, 0x12, 0x10, 0x3f, 0x29, 0xf6, 0x1e, 0xfb, 0x5b, 0xfa, 0x3a, 0x9c, 0xf0,
+	0xff, 0xff, 0x2c, 0x9f, 0x5a, 0x48, 0x2d, 0x9d, 0xbc, 0x7e, 0xa3, 0x74, 0xb8, 0x72, 0x28, 0x2d,
+	0x0b, 0xd1, 0xcf, 0x91, 0x6a, 0xfa, 0xf9, 0xee, 0xfc, 0x84, 0x19, 0x22, 0xe9, 0x06, 0xcc, 0x6c,
+	0x62, 0xc4, 0xbb, 0x1e, 0x66, 0xf9, 0xf4, 0x42, 0x6a, 0x29, 0x63, 0x2a, 0xb9, 0x58, 0x80, 0xb9,
+	0x61, 0x3e, 0xa8, 0x30, 0x7d, 0x35, 0x09, 0x17, 0x22, 0xf1, 0x44, 0x1c, 0x6f, 0xa3, 0xde, 0x23,
+	0x0f, 0x11, 0xb6, 0x89, 0xbd, 0x58, 0x4f, 0x6f, 0xc2, 0x34, 0x6a, 0xd3, 0x2e, 0xe1, 0xd2, 0xc7,
+	0x93, 0xd7, 0x2f, 0x94, 0xfc, 0xb2, 0x2c, 0x89, 0xb2, 0x2c, 0x05, 0x65, 0x59, 0x5a, 0xa6, 0x2e,
+	0x09, 0xb8, 0x06, 0x3f, 0xd7, 0xdf, 0x80, 0x33, 0x1e, 0xb6, 0xdc, 0x8e, 0x8b, 0x09, 0xaf, 0x4b,
+	0x0f, 0x65, 0xa5, 0x9c, 0x32, 0x4f, 0xab, 0x6d, 0xe9, 0xa8, 0x3e, 0x07, 0x19, 0xb5, 0x23, 0x6b,
+	0x25, 0x6b, 0xf6, 0x37, 0xf4, 0x3b, 0x90, 0xda, 0xc4, 0x38, 0x3f, 0x25, 0x48, 0x55, 0x4b, 0xc2,
+	0xc2, 0x5f, 0xbb, 0xf3, 0x57, 0x1c, 0x97, 0x37, 0xbb, 0x8d, 0x92, 0x45, 0xdb, 0xe5, 0xa0, 0x4b,
+	0xf8, 0x7f, 0xae, 0x32, 0xfb, 0x49, 0x99, 0xf7, 0x3a, 0x98, 0x95, 0xd6, 0x08, 0x37, 0x85, 0xaa,
+	0x9e, 0x83, 0x29, 0x42, 0x89, 0x85, 0xf3, 0xd3, 0xd2, 0xbc, 0x2f, 0x14, 0xff, 0xd1, 0xe0, 0x72,
+	0x6c, 0x34, 0x36, 0x5c, 0xde, 0xfc, 0x04, 0xf5, 0x5a, 0x14, 0xd9, 0xaf, 0x31, 0x30, 0x06, 0xcc,
+	0x58, 0x94, 0x70, 0x0f, 0x59, 0x61, 0x5c, 0x94, 0x2c, 0x3a, 0x40, 0xc7, 0x27, 0x28, 0x43, 0x93,
+	0x35, 0x43, 0x31, 0xc6, 0xdd, 0x9b, 0xb0, 0x18, 0xeb, 0x6d, 0x98, 0x21, 0xa2, 0x38, 0x6d, 0xc4,
+	0xc3, 0x56, 0x20, 0xd7, 0xc5, 0x47, 0x30, 0x37, 0xac, 0xb8, 0x94, 0xce, 0x1c, 0x64, 0x36, 0x5d,
+	0x82, 0x5a, 0xee, 0x67, 0xd8, 0x96, 0x8a, 0x33, 0x66, 0x7f, 0x43, 0x04, 0xef, 0x69, 0x17, 0x77,
+	0xb1, 0x2d, 0x83, 0x34, 0x63, 0x06, 0x52, 0x80, 0x6a, 0x62, 0xc7, 0x65, 0x1c, 0x7b, 0x15, 0xcb,
+	0x12, 0x91, 0xa9, 0xb0, 0x30, 0x79, 0x63, 0x83, 0x3e, 0x07, 0x19, 0xb1, 0x92, 0x19, 0x2f, 0xa3,
+	0x96, 0x35, 0xfb, 0x1b, 0x41, 0xc3, 0x88, 0x45, 0x55, 0x95, 0xd0, 0x81, 0xac, 0xf0, 0x89, 0x53,
+	0x0f, 0x2f, 0x53, 0x1b, 0xc7, 0x5a, 0x7b, 0x17, 0x4e, 0x6f, 0x23, 0xd6, 0xae, 0x37, 0x7a, 0x1c,
+	0xd7, 0x2d, 0x6a, 0x63, 0xbf, 0x67, 0x54, 0xcf, 0xee, 0xed, 0xce, 0x67, 0x37, 0x2a, 0xeb, 0xb5,
+	0x6a, 0x8f, 0x4b, 0x04, 0x33, 0x2b, 0x7e, 0x17, 0x4a, 0x61, 0x83, 0x49, 0xf5, 0x1b, 0xcc, 0x06,
+	0xe4, 0xa2, 0x16, 0x55, 0xf4, 0x2e, 0xc1, 0x09, 0x81, 0x5b, 0x77, 0xfd, 0xd8, 0xa5, 0xab, 0xb0,
+	0xb7, 0x3b, 0x3f, 0x2d, 0x7e, 0xb2, 0xb6, 0x62, 0x4e, 0x8b, 0x4f, 0x6b, 0xb6, 0xcc, 0x83, 0x26,
+	0xb6, 0x9e, 0xb0, 0x6e, 0x3b, 0x68, 0x5a, 0x4a, 0x2e, 0x7e, 0xad, 0xc1, 0x6c, 0x8d, 0x39, 0x6b,
+	0x84, 0x71, 0x44, 0xb8, 0x8b, 0x04, 0x83, 0x20, 0x45, 0xe2, 0xbc, 0x8a, 0xd8, 0x4c, 0xc5, 0xda,
+	0xcc, 0xc1, 0x54, 0x0b, 0x35, 0x70, 0x4b, 0x26, 0x5e, 0xc6, 0xf4, 0x05, 0xe1, 0x58, 0x9b, 0x39,
+	0x41, 0xc6, 0x89, 0x65, 0xe8, 0xea, 0x74, 0xdf, 0xd5, 0x8f, 0xa0, 0x30, 0x9c, 0x90, 0x72, 0x3a,
+	0x32, 0xbd, 0xb4, 0x81, 0x91, 0x2a, 0x13, 0x70, 0x32, 0x92, 0x80, 0x9f, 0x4b, 0xbc, 0x8a, 0x6d,
+	0x6f, 0x20, 0xd6, 0x8e, 0xc0, 0xaa, 0x19, 0x77, 0x84, 0xd1, 0x7d, 0x7e, 0x5f, 0x08, 0x94, 0xdb,
+	0x81, 0x3b, 0xe9, 0xbe, 0x3b, 0x5f, 0x6a, 0xb0, 0xa8, 0xa6, 0xf4, 0xeb, 0xa1, 0xf0, 0x7f, 0xb8,
+	0x54, 0x63, 0x4e, 0x9c, 0x6d, 0x95, 0xd5, 0xcf, 0x34, 0xd0, 0x6b, 0xcc, 0xa9, 0xb9, 0x8e, 0x77,
+	0x98, 0x34, 0x88, 0x76, 0x17, 0x9f, 0x9b, 0x92, 0x0f, 0x97, 0x22, 0x41, 0x32, 0xa4, 0x47, 0x25,
+	0xc3, 0x5b, 0x60, 0x0c, 0x52, 0x1a, 0xd9, 0x6f, 0x1e, 0x40, 0x61, 0xa0, 0x51, 0x45, 0x66, 0xff,
+	0x2b, 0xa7, 0x93, 0x83, 0xc6, 0xfa, 0xaa, 0x3c, 0xf4, 0x98, 0x58, 0x74, 0x2d, 0xbc, 0x2e, 0xce,
+	0x54, 0xc4, 0xc2, 0xd5, 0x16, 0xb5, 0x9e, 0xc4, 0x82, 0xe4, 0x60, 0xca, 0x52, 0x4d, 0x3d, 0x6d,
+	0xfa, 0x42, 0xb1, 0x06, 0xf3, 0x31, 0x40, 0xca, 0x97, 0x1c, 0x4c, 0x31, 0x8e, 0x3c, 0xee, 0xd7,
+	0xb1, 0xe9, 0x0b, 0xc3, 0xe1, 0xae, 0xff, 0x91, 0x83, 0x54, 0x8d, 0x39, 0xfa, 0x8f, 0x1a, 0xe4,
+	0x86, 0x1e, 0xc9, 0x6e, 0x1f, 0xf6, 0xa8, 0x10, 0x73, 0x3e, 0x32, 0x56, 0xc7, 0x04, 0x50, 0x6e,
+	0xfd, 0xac, 0xc1, 0x85, 0xf8, 0x36, 0xbd, 0x92, 0xc0, 0x4c, 0x2c, 0x8a, 0xf1, 0xf0, 0x38, 0x50,
+	0x14, 0xe3, 0xef, 0x35, 0xc8, 0x0d, 0x3b, 0xe8, 0xeb, 0xf7, 0x12, 0x98, 0x19, 0x71, 0x53, 0x30,
+	0x6e, 0x25, 0xc0, 0x19, 0xa8, 0x52, 0x49, 0x6f, 0xd8, 0x91, 0x3f, 0x11, 0xbd, 0x11, 0x77, 0x86,
+	0x31, 0xe9, 0x7d, 0x01, 0x99, 0xfe, 0x5c, 0x7c, 0x3b, 0x01, 0x94, 0xd2, 0x32, 0x6e, 0x1d, 0x45,
+	0x4b, 0x11, 0xf8, 0x41, 0x83, 0xff, 0x0d, 0x9b, 0x66, 0x1f, 0x24, 0x40, 0x1d, 0xa2, 0x6f, 0xdc,
+	0x1b, 0x4f, 0x5f, 0xf1, 0xfb, 0x45, 0x83, 0x8b, 0xa3, 0x86, 0x51, 0x12, 0x3b, 0x23, 0x70, 0x8c,
+	0x0f, 0x13, 0xe0, 0x1c, 0x34, 0x1a, 0xf4, 0x5f, 0x35, 0x28, 0x1c, 0x30, 0xc1, 0xd6, 0x12, 0xa7,
+	0xdf, 0x7f, 0x43, 0xfd, 0x99, 0x06, 0x67, 0xf6, 0x8f, 0xb4, 0xf7, 0x13, 0x18, 0xd8, 0xa7, 0x6b,
+	0x54, 0x8f, 0xae, 0x1b, 0xad, 0xe1, 0x8b, 0xa3, 0x26, 0xd4, 0xbd, 0x23, 0x74, 0xdf, 0x21, 0x38,
+	0xc6, 0x3b, 0x87, 0xc5, 0x79, 0xe5, 0xb5, 0x43, 0xf6, 0xec, 0xf8, 0x1b, 0xf8, 0xca, 0x98, 0xa3,
+	0x41, 0xa2, 0x18, 0x0f, 0x8f, 0x03, 0x45, 0x31, 0xfe, 0x4e, 0x83, 0x73, 0x83, 0xf7, 0xf7, 0x44,
+	0x8d, 0x64, 0xbf, 0xb6, 0xb1, 0x32, 0x8e, 0xf6, 0x2b, 0xcc, 0x06, 0x9f, 0x05, 0x92, 0x30, 0x1b,
+	0xd0, 0x36, 0x56, 0xc6, 0xd1, 0x56, 0xcc, 0x7e, 0xd2, 0x60, 0x36, 0xe6, 0x2e, 0x5f, 0x39, 0x72,
+	0xfe, 0x85, 0x10, 0xc6, 0xda, 0xd8, 0x10, 0x8a, 0xe8, 0x6f, 0x1a, 0x2c, 0x1e, 0x7c, 0xcd, 0x7e,
+	0x38, 0xb6, 0xc1, 0x08, 0xda, 0x71, 0xd2, 0x17, 0x87, 0xb4, 0xa1, 0x47, 0xc8, 0xdb, 0x89, 0x8e,
+	0x2d, 0x83, 0x00, 0xc6, 0xea, 0x98, 0x00, 0x21, 0xc5, 0xea, 0xfa, 0xf3, 0xbd, 0x82, 0xf6, 0x62,
+	0xaf, 0xa0, 0xfd, 0xbd, 0x57, 0xd0, 0xbe, 0x79, 0x59, 0x98, 0x78, 0xf1, 0xb2, 0x30, 0xf1, 0xe7,
+	0xcb, 0xc2, 0xc4, 0xa7, 0xef, 0x45, 0x1e, 0x4a, 0x42, 0xb8, 0xab, 0x7d, 0x63, 0x65, 0x65, 0xac,
+	0xbc, 0x53, 0xee, 0xbf, 0xdf, 0x8a, 0xf7, 0x93, 0xc6, 0xb4, 0x7c, 0x4b, 0xbd, 0xf1, 0xef, 0x00,
+	0x53, 0x08, 0x5e, 0x8c, 0xd8, 0x15, 0x00, 0x00,
 }
 
-func (m *MsgAllowlistResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	ExecuteGovernanceVAA(ctx context.Context, in *MsgExecuteGovernanceVAA, opts ...grpc.CallOption) (*MsgExecuteGovernanceVAAResponse, error)
+	RegisterAccountAsGuardian(ctx context.Context, in *MsgRegisterAccountAsGuardian, opts ...grpc.CallOption) (*MsgRegisterAccountAsGuardianResponse, error)
+	CreateAllowlistEntry(ctx context.Context, in *MsgCreateAllowlistEntryRequest, opts ...grpc.CallOption) (*MsgAllowlistResponse, error)
+	DeleteAllowlistEntry(ctx context.Context, in *MsgDeleteAllowlistEntryRequest, opts ...grpc.CallOption) (*MsgAllowlistResponse, error)
+	// StoreCode to submit Wasm code to the system
+	StoreCode(ctx context.Context, in *MsgStoreCode, opts ...grpc.CallOption) (*MsgStoreCodeResponse, error)
+	//  Instantiate creates a new smart contract instance for the given code id.
+	InstantiateContract(ctx context.Context, in *MsgInstantiateContract, opts ...grpc.CallOption) (*MsgInstantiateContractResponse, error)
+	AddWasmInstantiateAllowlist(ctx context.Context, in *MsgAddWasmInstantiateAllowlist, opts ...grpc.CallOption) (*MsgWasmInstantiateAllowlistResponse, error)
+	DeleteWasmInstantiateAllowlist(ctx context.Context, in *MsgDeleteWasmInstantiateAllowlist, opts ...grpc.CallOption) (*MsgWasmInstantiateAllowlistResponse, error)
+	MigrateContract(ctx context.Context, in *MsgMigrateContract, opts ...grpc.CallOption) (*MsgMigrateContractResponse, error)
+	ExecuteGatewayGovernanceVaa(ctx context.Context, in *MsgExecuteGatewayGovernanceVaa, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// ExecuteGovernanceVAABatch executes several core governance VAAs atomically.
+	ExecuteGovernanceVAABatch(ctx context.Context, in *MsgExecuteGovernanceVAABatch, opts ...grpc.CallOption) (*MsgExecuteGovernanceVAABatchResponse, error)
+	// SubmitObservation tallies the guardian signatures on an observed VAA.
+	SubmitObservation(ctx context.Context, in *MsgSubmitObservation, opts ...grpc.CallOption) (*MsgSubmitObservationResponse, error)
+	// GuardianHeartbeat records the liveness and status of the node of a
+	// guardian.
+	GuardianHeartbeat(ctx context.Context, in *MsgGuardianHeartbeat, opts ...grpc.CallOption) (*MsgGuardianHeartbeatResponse, error)
+	// ExecuteGatewayTransfer sends tokens through the gateway to another chain
+	// by executing the gateway contract on behalf of the signer.
+	ExecuteGatewayTransfer(ctx context.Context, in *MsgExecuteGatewayTransfer, opts ...grpc.CallOption) (*MsgExecuteGatewayTransferResponse, error)
+	// ExecuteGatewayTransferWithPayload sends tokens and a payload through the
+	// gateway to a contract on another chain.
+	ExecuteGatewayTransferWithPayload(ctx context.Context, in *MsgExecuteGatewayTransferWithPayload, opts ...grpc.CallOption) (*MsgExecuteGatewayTransferResponse, error)
+	// ReserveSequenceBlock pre-allocates a contiguous range of sequences for the
+	// emitter of the signer.
+	ReserveSequenceBlock(ctx context.Context, in *MsgReserveSequenceBlock, opts ...grpc.CallOption) (*MsgReserveSequenceBlockResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ExecuteGovernanceVAA(ctx context.Context, in *MsgExecuteGovernanceVAA, opts ...grpc.CallOption) (*MsgExecuteGovernanceVAAResponse, error) {
+	out := new(MsgExecuteGovernanceVAAResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Msg/ExecuteGovernanceVAA", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RegisterAccountAsGuardian(ctx context.Context, in *MsgRegisterAccountAsGuardian, opts ...grpc.CallOption) (*MsgRegisterAccountAsGuardianResponse, error) {
+	out := new(MsgRegisterAccountAsGuardianResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Msg/RegisterAccountAsGuardian", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) CreateAllowlistEntry(ctx context.Context, in *MsgCreateAllowlistEntryRequest, opts ...grpc.CallOption) (*MsgAllowlistResponse, error) {
+	out := new(MsgAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Msg/CreateAllowlistEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgExecuteGovernanceVAA) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeleteAllowlistEntry(ctx context.Context, in *MsgDeleteAllowlistEntryRequest, opts ...grpc.CallOption) (*MsgAllowlistResponse, error) {
+	out := new(MsgAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Msg/DeleteAllowlistEntry", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgExecuteGovernanceVAA) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) StoreCode(ctx context.Context, in *MsgStoreCode, opts ...grpc.CallOption) (*MsgStoreCodeResponse, error) {
+	out := new(MsgStoreCodeResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Msg/StoreCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgExecuteGovernanceVAA) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Vaa) > 0 {
-		i -= len(m.Vaa)
-		copy(dAtA[i:], m.Vaa)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Vaa)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) InstantiateContract(ctx context.Context, in *MsgInstantiateContract, opts ...grpc.CallOption) (*MsgInstantiateContractResponse, error) {
+	out := new(MsgInstantiateContractResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Msg/InstantiateContract", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgExecuteGovernanceVAAResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddWasmInstantiateAllowlist(ctx context.Context, in *MsgAddWasmInstantiateAllowlist, opts ...grpc.CallOption) (*MsgWasmInstantiateAllowlistResponse, error) {
+	out := new(MsgWasmInstantiateAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Msg/AddWasmInstantiateAllowlist", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgExecuteGovernanceVAAResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeleteWasmInstantiateAllowlist(ctx context.Context, in *MsgDeleteWasmInstantiateAllowlist, opts ...grpc.CallOption) (*MsgWasmInstantiateAllowlistResponse, error) {
+	out := new(MsgWasmInstantiateAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Msg/DeleteWasmInstantiateAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgExecuteGovernanceVAAResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) MigrateContract(ctx context.Context, in *MsgMigrateContract, opts ...grpc.CallOption) (*MsgMigrateContractResponse, error) {
+	out := new(MsgMigrateContractResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Msg/MigrateContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRegisterAccountAsGuardian) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ExecuteGatewayGovernanceVaa(ctx context.Context, in *MsgExecuteGatewayGovernanceVaa, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Msg/ExecuteGatewayGovernanceVaa", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRegisterAccountAsGuardian) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ExecuteGovernanceVAABatch(ctx context.Context, in *MsgExecuteGovernanceVAABatch, opts ...grpc.CallOption) (*MsgExecuteGovernanceVAABatchResponse, error) {
+	out := new(MsgExecuteGovernanceVAABatchResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Msg/ExecuteGovernanceVAABatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRegisterAccountAsGuardian) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) SubmitObservation(ctx context.Context, in *MsgSubmitObservation, opts ...grpc.CallOption) (*MsgSubmitObservationResponse, error) {
+	out := new(MsgSubmitObservationResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Msg/SubmitObservation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRegisterAccountAsGuardianResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) GuardianHeartbeat(ctx context.Context, in *MsgGuardianHeartbeat, opts ...grpc.CallOption) (*MsgGuardianHeartbeatResponse, error) {
+	out := new(MsgGuardianHeartbeatResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Msg/GuardianHeartbeat", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRegisterAccountAsGuardianResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ExecuteGatewayTransfer(ctx context.Context, in *MsgExecuteGatewayTransfer, opts ...grpc.CallOption) (*MsgExecuteGatewayTransferResponse, error) {
+	out := new(MsgExecuteGatewayTransferResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Msg/ExecuteGatewayTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRegisterAccountAsGuardianResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) ExecuteGatewayTransferWithPayload(ctx context.Context, in *MsgExecuteGatewayTransferWithPayload, opts ...grpc.CallOption) (*MsgExecuteGatewayTransferResponse, error) {
+	out := new(MsgExecuteGatewayTransferResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Msg/ExecuteGatewayTransferWithPayload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgStoreCode) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ReserveSequenceBlock(ctx context.Context, in *MsgReserveSequenceBlock, opts ...grpc.CallOption) (*MsgReserveSequenceBlockResponse, error) {
+	out := new(MsgReserveSequenceBlockResponse)
+	err := c.cc.Invoke(ctx, "/wormhole_foundation.wormchain.wormhole.Msg/ReserveSequenceBlock", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgStoreCode) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	ExecuteGovernanceVAA(context.Context, *MsgExecuteGovernanceVAA) (*MsgExecuteGovernanceVAAResponse, error)
+	RegisterAccountAsGuardian(context.Context, *MsgRegisterAccountAsGuardian) (*MsgRegisterAccountAsGuardianResponse, error)
+	CreateAllowlistEntry(context.Context, *MsgCreateAllowlistEntryRequest) (*MsgAllowlistResponse, error)
+	DeleteAllowlistEntry(context.Context, *MsgDeleteAllowlistEntryRequest) (*MsgAllowlistResponse, error)
+	// StoreCode to submit Wasm code to the system
+	StoreCode(context.Context, *MsgStoreCode) (*MsgStoreCodeResponse, error)
+	//  Instantiate creates a new smart contract instance for the given code id.
+	InstantiateContract(context.Context, *MsgInstantiateContract) (*MsgInstantiateContractResponse, error)
+	AddWasmInstantiateAllowlist(context.Context, *MsgAddWasmInstantiateAllowlist) (*MsgWasmInstantiateAllowlistResponse, error)
+	DeleteWasmInstantiateAllowlist(context.Context, *MsgDeleteWasmInstantiateAllowlist) (*MsgWasmInstantiateAllowlistResponse, error)
+	MigrateContract(context.Context, *MsgMigrateContract) (*MsgMigrateContractResponse, error)
+	ExecuteGatewayGovernanceVaa(context.Context, *MsgExecuteGatewayGovernanceVaa) (*EmptyResponse, error)
+	// ExecuteGovernanceVAABatch executes several core governance VAAs atomically.
+	ExecuteGovernanceVAABatch(context.Context, *MsgExecuteGovernanceVAABatch) (*MsgExecuteGovernanceVAABatchResponse, error)
+	// SubmitObservation tallies the guardian signatures on an observed VAA.
+	SubmitObservation(context.Context, *MsgSubmitObservation) (*MsgSubmitObservationResponse, error)
+	// GuardianHeartbeat records the liveness and status of the node of a
+	// guardian.
+	GuardianHeartbeat(context.Context, *MsgGuardianHeartbeat) (*MsgGuardianHeartbeatResponse, error)
+	// ExecuteGatewayTransfer sends tokens through the gateway to another chain
+	// by executing the gateway contract on behalf of the signer.
+	ExecuteGatewayTransfer(context.Context, *MsgExecuteGatewayTransfer) (*MsgExecuteGatewayTransferResponse, error)
+	// ExecuteGatewayTransferWithPayload sends tokens and a payload through the
+	// gateway to a contract on another chain.
+	ExecuteGatewayTransferWithPayload(context.Context, *MsgExecuteGatewayTransferWithPayload) (*MsgExecuteGatewayTransferResponse, error)
+	// ReserveSequenceBlock pre-allocates a contiguous range of sequences for the
+	// emitter of the signer.
+	ReserveSequenceBlock(context.Context, *MsgReserveSequenceBlock) (*MsgReserveSequenceBlockResponse, error)
 }
 
-func (m *MsgStoreCode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Vaa) > 0 {
-		i -= len(m.Vaa)
-		copy(dAtA[i:], m.Vaa)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Vaa)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.WASMByteCode) > 0 {
-		i -= len(m.WASMByteCode)
-		copy(dAtA[i:], m.WASMByteCode)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.WASMByteCode)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgStoreCodeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) ExecuteGovernanceVAA(ctx context.Context, req *MsgExecuteGovernanceVAA) (*MsgExecuteGovernanceVAAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteGovernanceVAA not implemented")
+}
+func (*UnimplementedMsgServer) RegisterAccountAsGuardian(ctx context.Context, req *MsgRegisterAccountAsGuardian) (*MsgRegisterAccountAsGuardianResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterAccountAsGuardian not implemented")
+}
+func (*UnimplementedMsgServer) CreateAllowlistEntry(ctx context.Context, req *MsgCreateAllowlistEntryRequest) (*MsgAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAllowlistEntry not implemented")
+}
+func (*UnimplementedMsgServer) DeleteAllowlistEntry(ctx context.Context, req *MsgDeleteAllowlistEntryRequest) (*MsgAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAllowlistEntry not implemented")
+}
+func (*UnimplementedMsgServer) StoreCode(ctx context.Context, req *MsgStoreCode) (*MsgStoreCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreCode not implemented")
+}
+func (*UnimplementedMsgServer) InstantiateContract(ctx context.Context, req *MsgInstantiateContract) (*MsgInstantiateContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantiateContract not implemented")
+}
+func (*UnimplementedMsgServer) AddWasmInstantiateAllowlist(ctx context.Context, req *MsgAddWasmInstantiateAllowlist) (*MsgWasmInstantiateAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWasmInstantiateAllowlist not implemented")
+}
+func (*UnimplementedMsgServer) DeleteWasmInstantiateAllowlist(ctx context.Context, req *MsgDeleteWasmInstantiateAllowlist) (*MsgWasmInstantiateAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWasmInstantiateAllowlist not implemented")
+}
+func (*UnimplementedMsgServer) MigrateContract(ctx context.Context, req *MsgMigrateContract) (*MsgMigrateContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateContract not implemented")
+}
+func (*UnimplementedMsgServer) ExecuteGatewayGovernanceVaa(ctx context.Context, req *MsgExecuteGatewayGovernanceVaa) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteGatewayGovernanceVaa not implemented")
+}
+func (*UnimplementedMsgServer) ExecuteGovernanceVAABatch(ctx context.Context, req *MsgExecuteGovernanceVAABatch) (*MsgExecuteGovernanceVAABatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteGovernanceVAABatch not implemented")
+}
+func (*UnimplementedMsgServer) SubmitObservation(ctx context.Context, req *MsgSubmitObservation) (*MsgSubmitObservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitObservation not implemented")
+}
+func (*UnimplementedMsgServer) GuardianHeartbeat(ctx context.Context, req *MsgGuardianHeartbeat) (*MsgGuardianHeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GuardianHeartbeat not implemented")
+}
+func (*UnimplementedMsgServer) ExecuteGatewayTransfer(ctx context.Context, req *MsgExecuteGatewayTransfer) (*MsgExecuteGatewayTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteGatewayTransfer not implemented")
+}
+func (*UnimplementedMsgServer) ExecuteGatewayTransferWithPayload(ctx context.Context, req *MsgExecuteGatewayTransferWithPayload) (*MsgExecuteGatewayTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteGatewayTransferWithPayload not implemented")
+}
+func (*UnimplementedMsgServer) ReserveSequenceBlock(ctx context.Context, req *MsgReserveSequenceBlock) (*MsgReserveSequenceBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveSequenceBlock not implemented")
 }
 
-func (m *MsgStoreCodeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgStoreCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Checksum) > 0 {
-		i -= len(m.Checksum)
-		copy(dAtA[i:], m.Checksum)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Checksum)))
-		i--
-		dAtA[i] = 0x12
+func _Msg_ExecuteGovernanceVAA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecuteGovernanceVAA)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.CodeID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CodeID))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(MsgServer).ExecuteGovernanceVAA(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Msg/ExecuteGovernanceVAA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecuteGovernanceVAA(ctx, req.(*MsgExecuteGovernanceVAA))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInstantiateContract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RegisterAccountAsGuardian_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterAccountAsGuardian)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgInstantiateContract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterAccountAsGuardian(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Msg/RegisterAccountAsGuardian",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterAccountAsGuardian(ctx, req.(*MsgRegisterAccountAsGuardian))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInstantiateContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Vaa) > 0 {
-		i -= len(m.Vaa)
-		copy(dAtA[i:], m.Vaa)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Vaa)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x2a
+func _Msg_CreateAllowlistEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateAllowlistEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Label) > 0 {
-		i -= len(m.Label)
-		copy(dAtA[i:], m.Label)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Label)))
-		i--
-		dAtA[i] = 0x22
+	if interceptor == nil {
+		return srv.(MsgServer).CreateAllowlistEntry(ctx, in)
 	}
-	if m.CodeID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CodeID))
-		i--
-		dAtA[i] = 0x18
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Msg/CreateAllowlistEntry",
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateAllowlistEntry(ctx, req.(*MsgCreateAllowlistEntryRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInstantiateContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DeleteAllowlistEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteAllowlistEntryRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgInstantiateContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgInstantiateContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteAllowlistEntry(ctx, in)
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Msg/DeleteAllowlistEntry",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteAllowlistEntry(ctx, req.(*MsgDeleteAllowlistEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddWasmInstantiateAllowlist) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_StoreCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStoreCode)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).StoreCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Msg/StoreCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StoreCode(ctx, req.(*MsgStoreCode))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddWasmInstantiateAllowlist) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_InstantiateContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInstantiateContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InstantiateContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Msg/InstantiateContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InstantiateContract(ctx, req.(*MsgInstantiateContract))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddWasmInstantiateAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Vaa) > 0 {
-		i -= len(m.Vaa)
-		copy(dAtA[i:], m.Vaa)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Vaa)))
-		i--
-		dAtA[i] = 0x22
+func _Msg_AddWasmInstantiateAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddWasmInstantiateAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.CodeId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CodeId))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(MsgServer).AddWasmInstantiateAllowlist(ctx, in)
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Msg/AddWasmInstantiateAllowlist",
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddWasmInstantiateAllowlist(ctx, req.(*MsgAddWasmInstantiateAllowlist))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteWasmInstantiateAllowlist) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DeleteWasmInstantiateAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteWasmInstantiateAllowlist)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteWasmInstantiateAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Msg/DeleteWasmInstantiateAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteWasmInstantiateAllowlist(ctx, req.(*MsgDeleteWasmInstantiateAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteWasmInstantiateAllowlist) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_MigrateContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Msg/MigrateContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateContract(ctx, req.(*MsgMigrateContract))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteWasmInstantiateAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Vaa) > 0 {
-		i -= len(m.Vaa)
-		copy(dAtA[i:], m.Vaa)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Vaa)))
-		i--
-		dAtA[i] = 0x22
+func _Msg_ExecuteGatewayGovernanceVaa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecuteGatewayGovernanceVaa)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.CodeId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CodeId))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(MsgServer).ExecuteGatewayGovernanceVaa(ctx, in)
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Msg/ExecuteGatewayGovernanceVaa",
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecuteGatewayGovernanceVaa(ctx, req.(*MsgExecuteGatewayGovernanceVaa))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWasmInstantiateAllowlistResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_ExecuteGovernanceVAABatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecuteGovernanceVAABatch)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).ExecuteGovernanceVAABatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Msg/ExecuteGovernanceVAABatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecuteGovernanceVAABatch(ctx, req.(*MsgExecuteGovernanceVAABatch))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWasmInstantiateAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_SubmitObservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitObservation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitObservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Msg/SubmitObservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitObservation(ctx, req.(*MsgSubmitObservation))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWasmInstantiateAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_GuardianHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGuardianHeartbeat)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GuardianHeartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Msg/GuardianHeartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GuardianHeartbeat(ctx, req.(*MsgGuardianHeartbeat))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMigrateContract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_ExecuteGatewayTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecuteGatewayTransfer)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).ExecuteGatewayTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Msg/ExecuteGatewayTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecuteGatewayTransfer(ctx, req.(*MsgExecuteGatewayTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMigrateContract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+func _Msg_ExecuteGatewayTransferWithPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecuteGatewayTransferWithPayload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExecuteGatewayTransferWithPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Msg/ExecuteGatewayTransferWithPayload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecuteGatewayTransferWithPayload(ctx, req.(*MsgExecuteGatewayTransferWithPayload))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (m *MsgMigrateContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func _Msg_ReserveSequenceBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReserveSequenceBlock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReserveSequenceBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wormhole_foundation.wormchain.wormhole.Msg/ReserveSequenceBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReserveSequenceBlock(ctx, req.(*MsgReserveSequenceBlock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "wormhole_foundation.wormchain.wormhole.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExecuteGovernanceVAA",
+			Handler:    _Msg_ExecuteGovernanceVAA_Handler,
+		},
+		{
+			MethodName: "RegisterAccountAsGuardian",
+			Handler:    _Msg_RegisterAccountAsGuardian_Handler,
+		},
+		{
+			MethodName: "CreateAllowlistEntry",
+			Handler:    _Msg_CreateAllowlistEntry_Handler,
+		},
+		{
+			MethodName: "DeleteAllowlistEntry",
+			Handler:    _Msg_DeleteAllowlistEntry_Handler,
+		},
+		{
+			MethodName: "StoreCode",
+			Handler:    _Msg_StoreCode_Handler,
+		},
+		{
+			MethodName: "InstantiateContract",
+			Handler:    _Msg_InstantiateContract_Handler,
+		},
+		{
+			MethodName: "AddWasmInstantiateAllowlist",
+			Handler:    _Msg_AddWasmInstantiateAllowlist_Handler,
+		},
+		{
+			MethodName: "DeleteWasmInstantiateAllowlist",
+			Handler:    _Msg_DeleteWasmInstantiateAllowlist_Handler,
+		},
+		{
+			MethodName: "MigrateContract",
+			Handler:    _Msg_MigrateContract_Handler,
+		},
+		{
+			MethodName: "ExecuteGatewayGovernanceVaa",
+			Handler:    _Msg_ExecuteGatewayGovernanceVaa_Handler,
+		},
+		{
+			MethodName: "ExecuteGovernanceVAABatch",
+			Handler:    _Msg_ExecuteGovernanceVAABatch_Handler,
+		},
+		{
+			MethodName: "SubmitObservation",
+			Handler:    _Msg_SubmitObservation_Handler,
+		},
+		{
+			MethodName: "GuardianHeartbeat",
+			Handler:    _Msg_GuardianHeartbeat_Handler,
+		},
+		{
+			MethodName: "ExecuteGatewayTransfer",
+			Handler:    _Msg_ExecuteGatewayTransfer_Handler,
+		},
+		{
+			MethodName: "ExecuteGatewayTransferWithPayload",
+			Handler:    _Msg_ExecuteGatewayTransferWithPayload_Handler,
+		},
+		{
+			MethodName: "ReserveSequenceBlock",
+			Handler:    _Msg_ReserveSequenceBlock_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "wormhole/tx.proto",
+}
+
+func (m *EmptyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmptyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmptyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Vaa) > 0 {
-		i -= len(m.Vaa)
-		copy(dAtA[i:], m.Vaa)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Vaa)))
-		i--
-		dAtA[i] = 0x32
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateAllowlistEntryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Msg)))
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateAllowlistEntryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateAllowlistEntryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpirationHeight))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x20
 	}
-	if m.CodeID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CodeID))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2093,7 +2490,7 @@ func (m *MsgMigrateContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgMigrateContractResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteAllowlistEntryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2103,27 +2500,34 @@ func (m *MsgMigrateContractResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgMigrateContractResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteAllowlistEntryRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMigrateContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteAllowlistEntryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgExecuteGatewayGovernanceVaa) Marshal() (dAtA []byte, err error) {
+func (m *MsgAllowlistResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2133,356 +2537,2538 @@ func (m *MsgExecuteGatewayGovernanceVaa) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgExecuteGatewayGovernanceVaa) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgExecuteGatewayGovernanceVaa) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Vaa) > 0 {
-		i -= len(m.Vaa)
-		copy(dAtA[i:], m.Vaa)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Vaa)))
-		i--
-		dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteGovernanceVAA) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteGovernanceVAA) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteGovernanceVAA) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if len(m.Signer) > 0 {
 		i -= len(m.Signer)
 		copy(dAtA[i:], m.Signer)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Vaa) > 0 {
+		i -= len(m.Vaa)
+		copy(dAtA[i:], m.Vaa)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Vaa)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgExecuteGovernanceVAAResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EmptyResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgExecuteGovernanceVAAResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteGovernanceVAAResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateAllowlistEntryRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgExecuteGovernanceVAABatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteAllowlistEntryRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgExecuteGovernanceVAABatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteGovernanceVAABatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Vaas) > 0 {
+		for iNdEx := len(m.Vaas) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Vaas[iNdEx])
+			copy(dAtA[i:], m.Vaas[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Vaas[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAllowlistResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgExecuteGovernanceVAABatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgExecuteGovernanceVAA) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Vaa)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgExecuteGovernanceVAABatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgExecuteGovernanceVAAResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgExecuteGovernanceVAABatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterAccountAsGuardian) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSubmitObservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitObservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitObservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Vaa) > 0 {
+		i -= len(m.Vaa)
+		copy(dAtA[i:], m.Vaa)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Vaa)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterAccountAsGuardianResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgGuardianHeartbeat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgStoreCode) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgGuardianHeartbeat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGuardianHeartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Features) > 0 {
+		for iNdEx := len(m.Features) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Features[iNdEx])
+			copy(dAtA[i:], m.Features[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Features[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.WASMByteCode)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Heights) > 0 {
+		for iNdEx := len(m.Heights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Heights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.Vaa)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.NodeVersion) > 0 {
+		i -= len(m.NodeVersion)
+		copy(dAtA[i:], m.NodeVersion)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NodeVersion)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgStoreCodeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgGuardianHeartbeatResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGuardianHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGuardianHeartbeatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CodeID != 0 {
-		n += 1 + sovTx(uint64(m.CodeID))
-	}
-	l = len(m.Checksum)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgInstantiateContract) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgExecuteGatewayTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteGatewayTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteGatewayTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.CodeID != 0 {
-		n += 1 + sovTx(uint64(m.CodeID))
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Label)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.RecipientChain != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RecipientChain))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.Vaa)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgInstantiateContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgExecuteGatewayTransferWithPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteGatewayTransferWithPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteGatewayTransferWithPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x30
 	}
-	return n
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RecipientChain != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RecipientChain))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddWasmInstantiateAllowlist) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgExecuteGatewayTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteGatewayTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteGatewayTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitObservationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.CodeId != 0 {
-		n += 1 + sovTx(uint64(m.CodeId))
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitObservationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitObservationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Queued {
+		i--
+		if m.Queued {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Vaa)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Finalized {
+		i--
+		if m.Finalized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteWasmInstantiateAllowlist) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRegisterAccountAsGuardian) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterAccountAsGuardian) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterAccountAsGuardian) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.CodeId != 0 {
-		n += 1 + sovTx(uint64(m.CodeId))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Vaa)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWasmInstantiateAllowlistResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRegisterAccountAsGuardianResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterAccountAsGuardianResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterAccountAsGuardianResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMigrateContract) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgStoreCode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStoreCode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStoreCode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.CodeID != 0 {
-		n += 1 + sovTx(uint64(m.CodeID))
+	if len(m.Vaa) > 0 {
+		i -= len(m.Vaa)
+		copy(dAtA[i:], m.Vaa)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Vaa)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.WASMByteCode) > 0 {
+		i -= len(m.WASMByteCode)
+		copy(dAtA[i:], m.WASMByteCode)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WASMByteCode)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Vaa)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMigrateContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgStoreCodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStoreCodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStoreCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstantiateContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstantiateContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantiateContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Vaa) > 0 {
+		i -= len(m.Vaa)
+		copy(dAtA[i:], m.Vaa)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Vaa)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstantiateContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstantiateContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantiateContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddWasmInstantiateAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddWasmInstantiateAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddWasmInstantiateAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Vaa) > 0 {
+		i -= len(m.Vaa)
+		copy(dAtA[i:], m.Vaa)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Vaa)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CodeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CodeId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteWasmInstantiateAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteWasmInstantiateAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteWasmInstantiateAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Vaa) > 0 {
+		i -= len(m.Vaa)
+		copy(dAtA[i:], m.Vaa)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Vaa)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CodeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CodeId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWasmInstantiateAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWasmInstantiateAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWasmInstantiateAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Vaa) > 0 {
+		i -= len(m.Vaa)
+		copy(dAtA[i:], m.Vaa)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Vaa)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteGatewayGovernanceVaa) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteGatewayGovernanceVaa) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteGatewayGovernanceVaa) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Vaa) > 0 {
+		i -= len(m.Vaa)
+		copy(dAtA[i:], m.Vaa)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Vaa)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReserveSequenceBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReserveSequenceBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReserveSequenceBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReserveSequenceBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReserveSequenceBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReserveSequenceBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Start != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EmptyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateAllowlistEntryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpirationHeight))
+	}
+	return n
+}
+
+func (m *MsgDeleteAllowlistEntryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExecuteGovernanceVAA) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Vaa)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgExecuteGovernanceVAAResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExecuteGovernanceVAABatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Vaas) > 0 {
+		for _, b := range m.Vaas {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgExecuteGovernanceVAABatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitObservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Vaa)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGuardianHeartbeat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NodeVersion)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Heights) > 0 {
+		for _, e := range m.Heights {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Features) > 0 {
+		for _, s := range m.Features {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgGuardianHeartbeatResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExecuteGatewayTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.RecipientChain != 0 {
+		n += 1 + sovTx(uint64(m.RecipientChain))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *MsgExecuteGatewayTransferWithPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.RecipientChain != 0 {
+		n += 1 + sovTx(uint64(m.RecipientChain))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *MsgExecuteGatewayTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitObservationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Finalized {
+		n += 2
+	}
+	if m.Queued {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRegisterAccountAsGuardian) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterAccountAsGuardianResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgStoreCode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WASMByteCode)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Vaa)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStoreCodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovTx(uint64(m.CodeID))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgInstantiateContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CodeID != 0 {
+		n += 1 + sovTx(uint64(m.CodeID))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Vaa)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgInstantiateContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddWasmInstantiateAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CodeId != 0 {
+		n += 1 + sovTx(uint64(m.CodeId))
+	}
+	l = len(m.Vaa)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteWasmInstantiateAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CodeId != 0 {
+		n += 1 + sovTx(uint64(m.CodeId))
+	}
+	l = len(m.Vaa)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWasmInstantiateAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CodeID != 0 {
+		n += 1 + sovTx(uint64(m.CodeID))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Vaa)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMigrateContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgExecuteGatewayGovernanceVaa) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Vaa)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReserveSequenceBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovTx(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *MsgReserveSequenceBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovTx(uint64(m.Start))
+	}
+	if m.Count != 0 {
+		n += 1 + sovTx(uint64(m.Count))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EmptyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmptyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmptyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateAllowlistEntryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateAllowlistEntryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateAllowlistEntryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteAllowlistEntryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteAllowlistEntryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteAllowlistEntryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteGovernanceVAA) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteGovernanceVAA: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteGovernanceVAA: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vaa", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vaa = append(m.Vaa[:0], dAtA[iNdEx:postIndex]...)
+			if m.Vaa == nil {
+				m.Vaa = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteGovernanceVAAResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteGovernanceVAAResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteGovernanceVAAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteGovernanceVAABatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteGovernanceVAABatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteGovernanceVAABatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vaas", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vaas = append(m.Vaas, make([]byte, postIndex-iNdEx))
+			copy(m.Vaas[len(m.Vaas)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteGovernanceVAABatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteGovernanceVAABatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteGovernanceVAABatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitObservation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitObservation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitObservation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vaa", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vaa = append(m.Vaa[:0], dAtA[iNdEx:postIndex]...)
+			if m.Vaa == nil {
+				m.Vaa = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGuardianHeartbeat) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGuardianHeartbeat: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGuardianHeartbeat: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Heights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Heights = append(m.Heights, ChainHeight{})
+			if err := m.Heights[len(m.Heights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Features = append(m.Features, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgExecuteGatewayGovernanceVaa) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Vaa)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EmptyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGuardianHeartbeatResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2505,10 +5091,10 @@ func (m *EmptyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EmptyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGuardianHeartbeatResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EmptyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGuardianHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2532,7 +5118,7 @@ func (m *EmptyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateAllowlistEntryRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgExecuteGatewayTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2555,10 +5141,10 @@ func (m *MsgCreateAllowlistEntryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateAllowlistEntryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecuteGatewayTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateAllowlistEntryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecuteGatewayTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2595,9 +5181,9 @@ func (m *MsgCreateAllowlistEntryRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2607,27 +5193,81 @@ func (m *MsgCreateAllowlistEntryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientChain", wireType)
+			}
+			m.RecipientChain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecipientChain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = append(m.Recipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.Recipient == nil {
+				m.Recipient = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2655,8 +5295,29 @@ func (m *MsgCreateAllowlistEntryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2678,7 +5339,7 @@ func (m *MsgCreateAllowlistEntryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteAllowlistEntryRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgExecuteGatewayTransferWithPayload) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2701,10 +5362,10 @@ func (m *MsgDeleteAllowlistEntryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteAllowlistEntryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecuteGatewayTransferWithPayload: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteAllowlistEntryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecuteGatewayTransferWithPayload: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2741,9 +5402,9 @@ func (m *MsgDeleteAllowlistEntryRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2753,74 +5414,131 @@ func (m *MsgDeleteAllowlistEntryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientChain", wireType)
+			}
+			m.RecipientChain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecipientChain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgAllowlistResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			m.Contract = append(m.Contract[:0], dAtA[iNdEx:postIndex]...)
+			if m.Contract == nil {
+				m.Contract = []byte{}
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAllowlistResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2842,7 +5560,7 @@ func (m *MsgAllowlistResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecuteGovernanceVAA) Unmarshal(dAtA []byte) error {
+func (m *MsgExecuteGatewayTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2865,15 +5583,15 @@ func (m *MsgExecuteGovernanceVAA) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecuteGovernanceVAA: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecuteGatewayTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecuteGovernanceVAA: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecuteGatewayTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vaa", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2900,42 +5618,10 @@ func (m *MsgExecuteGovernanceVAA) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vaa = append(m.Vaa[:0], dAtA[iNdEx:postIndex]...)
-			if m.Vaa == nil {
-				m.Vaa = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2958,7 +5644,7 @@ func (m *MsgExecuteGovernanceVAA) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecuteGovernanceVAAResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitObservationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2981,12 +5667,52 @@ func (m *MsgExecuteGovernanceVAAResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecuteGovernanceVAAResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitObservationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecuteGovernanceVAAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitObservationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finalized = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Queued = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4529,6 +7255,195 @@ func (m *MsgExecuteGatewayGovernanceVaa) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgReserveSequenceBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReserveSequenceBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReserveSequenceBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReserveSequenceBlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReserveSequenceBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReserveSequenceBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,11 @@
 package types
 
-import "encoding/binary"
+import (
+	"bytes"
+	"encoding/binary"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
 
 type GovernanceMessage struct {
 	Module  [32]byte
@@ -29,3 +34,27 @@ func (gm *GovernanceMessage) MarshalBinary() []byte {
 	bz = append(bz, gm.Payload...)
 	return bz
 }
+
+// ParseGovernancePayload checks the governance header
+// [32-byte module][uint8 action][uint16 target_chain]
+// of a governance VAA payload and returns the action and the action payload.
+// The module is compared over the full 32 bytes, including the zero padding,
+// so a module that merely shares a suffix or prefix with the expected one is
+// rejected. The target chain must be 0 (all chains) or chainID.
+func ParseGovernancePayload(payload []byte, module [32]byte, chainID uint16) (action byte, actionPayload []byte, err error) {
+	if len(payload) < 35 {
+		return 0, nil, ErrGovernanceHeaderTooShort
+	}
+
+	if !bytes.Equal(payload[:32], module[:]) {
+		return 0, nil, sdkerrors.Wrapf(ErrInvalidGovernanceModule, "expected module %x, got %x", module, payload[:32])
+	}
+
+	action = payload[32]
+	chain := binary.BigEndian.Uint16(payload[33:35])
+	if chain != 0 && chain != chainID {
+		return 0, nil, ErrInvalidGovernanceTargetChain
+	}
+
+	return action, payload[35:], nil
+}
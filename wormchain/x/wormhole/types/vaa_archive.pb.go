@@ -0,0 +1,556 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: wormhole/vaa_archive.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ArchivedVAA is the metadata of a VAA that was verified on wormchain. It is
+// kept for vaa_archive_retention_blocks blocks after the height it was
+// verified at.
+type ArchivedVAA struct {
+	Digest           []byte `protobuf:"bytes,1,opt,name=digest,proto3" json:"digest,omitempty"`
+	EmitterChain     uint32 `protobuf:"varint,2,opt,name=emitter_chain,json=emitterChain,proto3" json:"emitter_chain,omitempty"`
+	EmitterAddress   []byte `protobuf:"bytes,3,opt,name=emitter_address,json=emitterAddress,proto3" json:"emitter_address,omitempty"`
+	Sequence         uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	GuardianSetIndex uint32 `protobuf:"varint,5,opt,name=guardian_set_index,json=guardianSetIndex,proto3" json:"guardian_set_index,omitempty"`
+	Timestamp        uint32 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Height           int64  `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ArchivedVAA) Reset()         { *m = ArchivedVAA{} }
+func (m *ArchivedVAA) String() string { return proto.CompactTextString(m) }
+func (*ArchivedVAA) ProtoMessage()    {}
+func (*ArchivedVAA) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3eacb396e4c15f96, []int{0}
+}
+func (m *ArchivedVAA) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivedVAA) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedVAA.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchivedVAA) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedVAA.Merge(m, src)
+}
+func (m *ArchivedVAA) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivedVAA) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedVAA.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchivedVAA proto.InternalMessageInfo
+
+func (m *ArchivedVAA) GetDigest() []byte {
+	if m != nil {
+		return m.Digest
+	}
+	return nil
+}
+
+func (m *ArchivedVAA) GetEmitterChain() uint32 {
+	if m != nil {
+		return m.EmitterChain
+	}
+	return 0
+}
+
+func (m *ArchivedVAA) GetEmitterAddress() []byte {
+	if m != nil {
+		return m.EmitterAddress
+	}
+	return nil
+}
+
+func (m *ArchivedVAA) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *ArchivedVAA) GetGuardianSetIndex() uint32 {
+	if m != nil {
+		return m.GuardianSetIndex
+	}
+	return 0
+}
+
+func (m *ArchivedVAA) GetTimestamp() uint32 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ArchivedVAA) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ArchivedVAA)(nil), "wormhole_foundation.wormchain.wormhole.ArchivedVAA")
+}
+
+func init() { proto.RegisterFile("wormhole/vaa_archive.proto", fileDescriptor_3eacb396e4c15f96) }
+
+var fileDescriptor_3eacb396e4c15f96 = []byte{
+	// 301 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0xb1, 0x4e, 0xeb, 0x30,
+	0x14, 0x86, 0xeb, 0xdb, 0xde, 0x02, 0xa6, 0x05, 0xe4, 0x01, 0x59, 0x15, 0x8a, 0x22, 0x90, 0x20,
+	0x03, 0x24, 0x03, 0x13, 0x63, 0x60, 0x62, 0x4d, 0x25, 0x06, 0x96, 0xc8, 0x8d, 0x0f, 0x89, 0x25,
+	0x12, 0x17, 0xfb, 0xa4, 0x94, 0xb7, 0xe0, 0xb1, 0x18, 0x3b, 0x32, 0xa2, 0xf6, 0x11, 0x78, 0x01,
+	0x94, 0x34, 0x49, 0xc7, 0xff, 0xfb, 0x8f, 0x8f, 0x75, 0x3e, 0x3a, 0x79, 0xd7, 0x26, 0xcf, 0xf4,
+	0x2b, 0x04, 0x0b, 0x21, 0x62, 0x61, 0x92, 0x4c, 0x2d, 0xc0, 0x9f, 0x1b, 0x8d, 0x9a, 0x5d, 0xb6,
+	0x5d, 0xfc, 0xa2, 0xcb, 0x42, 0x0a, 0x54, 0xba, 0xf0, 0x2b, 0x96, 0x64, 0x42, 0x15, 0x7e, 0xdb,
+	0x9e, 0xff, 0x12, 0x7a, 0x18, 0x6e, 0x5f, 0xca, 0xa7, 0x30, 0x64, 0xa7, 0x74, 0x28, 0x55, 0x0a,
+	0x16, 0x39, 0x71, 0x89, 0x37, 0x8a, 0x9a, 0xc4, 0x2e, 0xe8, 0x18, 0x72, 0x85, 0x08, 0x26, 0xae,
+	0x37, 0xf0, 0x7f, 0x2e, 0xf1, 0xc6, 0xd1, 0xa8, 0x81, 0x0f, 0x15, 0x63, 0x57, 0xf4, 0xb8, 0x1d,
+	0x12, 0x52, 0x1a, 0xb0, 0x96, 0xf7, 0xeb, 0x2d, 0x47, 0x0d, 0x0e, 0xb7, 0x94, 0x4d, 0xe8, 0xbe,
+	0x85, 0xb7, 0x12, 0x8a, 0x04, 0xf8, 0xc0, 0x25, 0xde, 0x20, 0xea, 0x32, 0xbb, 0xa6, 0x2c, 0x2d,
+	0x85, 0x91, 0x4a, 0x14, 0xb1, 0x05, 0x8c, 0x55, 0x21, 0x61, 0xc9, 0xff, 0xd7, 0xdf, 0x9d, 0xb4,
+	0xcd, 0x14, 0xf0, 0xb1, 0xe2, 0xec, 0x8c, 0x1e, 0xa0, 0xca, 0xc1, 0xa2, 0xc8, 0xe7, 0x7c, 0x58,
+	0x0f, 0xed, 0x40, 0x75, 0x4d, 0x06, 0x2a, 0xcd, 0x90, 0xef, 0xb9, 0xc4, 0xeb, 0x47, 0x4d, 0xba,
+	0x9f, 0x7e, 0xad, 0x1d, 0xb2, 0x5a, 0x3b, 0xe4, 0x67, 0xed, 0x90, 0xcf, 0x8d, 0xd3, 0x5b, 0x6d,
+	0x9c, 0xde, 0xf7, 0xc6, 0xe9, 0x3d, 0xdf, 0xa5, 0x0a, 0xb3, 0x72, 0xe6, 0x27, 0x3a, 0x0f, 0x5a,
+	0x49, 0x37, 0x3b, 0x85, 0x41, 0xa7, 0x30, 0x58, 0x76, 0x7d, 0x80, 0x1f, 0x73, 0xb0, 0xb3, 0x61,
+	0x6d, 0xfe, 0xf6, 0x6f, 0x00, 0x1b, 0xf6, 0x78, 0x61, 0x97, 0x01, 0x00, 0x00,
+}
+
+func (m *ArchivedVAA) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedVAA) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedVAA) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintVaaArchive(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintVaaArchive(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.GuardianSetIndex != 0 {
+		i = encodeVarintVaaArchive(dAtA, i, uint64(m.GuardianSetIndex))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintVaaArchive(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.EmitterAddress) > 0 {
+		i -= len(m.EmitterAddress)
+		copy(dAtA[i:], m.EmitterAddress)
+		i = encodeVarintVaaArchive(dAtA, i, uint64(len(m.EmitterAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EmitterChain != 0 {
+		i = encodeVarintVaaArchive(dAtA, i, uint64(m.EmitterChain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarintVaaArchive(dAtA, i, uint64(len(m.Digest)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVaaArchive(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVaaArchive(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ArchivedVAA) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sovVaaArchive(uint64(l))
+	}
+	if m.EmitterChain != 0 {
+		n += 1 + sovVaaArchive(uint64(m.EmitterChain))
+	}
+	l = len(m.EmitterAddress)
+	if l > 0 {
+		n += 1 + l + sovVaaArchive(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovVaaArchive(uint64(m.Sequence))
+	}
+	if m.GuardianSetIndex != 0 {
+		n += 1 + sovVaaArchive(uint64(m.GuardianSetIndex))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovVaaArchive(uint64(m.Timestamp))
+	}
+	if m.Height != 0 {
+		n += 1 + sovVaaArchive(uint64(m.Height))
+	}
+	return n
+}
+
+func sovVaaArchive(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVaaArchive(x uint64) (n int) {
+	return sovVaaArchive(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ArchivedVAA) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVaaArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchivedVAA: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchivedVAA: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVaaArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVaaArchive
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVaaArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = append(m.Digest[:0], dAtA[iNdEx:postIndex]...)
+			if m.Digest == nil {
+				m.Digest = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmitterChain", wireType)
+			}
+			m.EmitterChain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVaaArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EmitterChain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmitterAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVaaArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVaaArchive
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVaaArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmitterAddress = append(m.EmitterAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.EmitterAddress == nil {
+				m.EmitterAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVaaArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianSetIndex", wireType)
+			}
+			m.GuardianSetIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVaaArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GuardianSetIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVaaArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVaaArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVaaArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVaaArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipVaaArchive(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowVaaArchive
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVaaArchive
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVaaArchive
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthVaaArchive
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupVaaArchive
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthVaaArchive
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthVaaArchive        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowVaaArchive          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupVaaArchive = fmt.Errorf("proto: unexpected end of group")
+)
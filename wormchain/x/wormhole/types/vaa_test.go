@@ -0,0 +1,36 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestParseGovernancePayload(t *testing.T) {
+	module := [32]byte{}
+	copy(module[:], vaa.CoreModule)
+	chainID := uint16(vaa.ChainIDWormchain)
+
+	msg := NewGovernanceMessage(module, byte(vaa.ActionGuardianSetUpdate), chainID, []byte{1, 2, 3})
+	action, payload, err := ParseGovernancePayload(msg.MarshalBinary(), module, chainID)
+	require.NoError(t, err)
+	require.Equal(t, byte(vaa.ActionGuardianSetUpdate), action)
+	require.Equal(t, []byte{1, 2, 3}, payload)
+
+	// all chains
+	msg = NewGovernanceMessage(module, byte(vaa.ActionGuardianSetUpdate), 0, nil)
+	_, payload, err = ParseGovernancePayload(msg.MarshalBinary(), module, chainID)
+	require.NoError(t, err)
+	require.Empty(t, payload)
+
+	msg = NewGovernanceMessage(module, byte(vaa.ActionGuardianSetUpdate), uint16(vaa.ChainIDEthereum), nil)
+	_, _, err = ParseGovernancePayload(msg.MarshalBinary(), module, chainID)
+	require.ErrorIs(t, err, ErrInvalidGovernanceTargetChain)
+
+	_, _, err = ParseGovernancePayload(msg.MarshalBinary(), vaa.GatewayModule, chainID)
+	require.ErrorIs(t, err, ErrInvalidGovernanceModule)
+
+	_, _, err = ParseGovernancePayload(msg.MarshalBinary()[:34], module, chainID)
+	require.ErrorIs(t, err, ErrGovernanceHeaderTooShort)
+}